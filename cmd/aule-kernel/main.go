@@ -2,11 +2,17 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"os"
 	"os/signal"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 
 	"net/http"
@@ -16,32 +22,66 @@ import (
 
 	"path/filepath"
 
+	"github.com/manthysbr/auleOS/internal/adapters/calendar"
 	"github.com/manthysbr/auleOS/internal/adapters/docker"
 	"github.com/manthysbr/auleOS/internal/adapters/duckdb"
+	"github.com/manthysbr/auleOS/internal/adapters/email"
+	"github.com/manthysbr/auleOS/internal/adapters/fswatch"
+	"github.com/manthysbr/auleOS/internal/adapters/host"
+	"github.com/manthysbr/auleOS/internal/adapters/llm"
+	"github.com/manthysbr/auleOS/internal/adapters/postgres"
 	"github.com/manthysbr/auleOS/internal/adapters/providers"
+	"github.com/manthysbr/auleOS/internal/adapters/remote"
 	appconfig "github.com/manthysbr/auleOS/internal/config"
 	"github.com/manthysbr/auleOS/internal/core/domain"
 	"github.com/manthysbr/auleOS/internal/core/ports"
 	"github.com/manthysbr/auleOS/internal/core/services"
 	"github.com/manthysbr/auleOS/internal/synapse"
 	"github.com/manthysbr/auleOS/pkg/kernel"
-	"github.com/rs/cors"
+)
+
+// Set at release build time with
+// -ldflags "-X main.version=v1.2.3 -X main.gitSHA=... -X main.buildDate=..."
+var (
+	version   string
+	gitSHA    string
+	buildDate string
 )
 
 func main() {
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	// Logs go to stdout, an in-memory buffer queryable at /v1/system/logs and,
+	// if AULE_LOG_FILE is set, a JSON lines file
+	logBuffer := services.NewLogBuffer(0)
+	handlers := []slog.Handler{slog.NewJSONHandler(os.Stdout, nil), logBuffer.Handler(slog.LevelInfo)}
+	if path := os.Getenv("AULE_LOG_FILE"); path != "" {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "cannot open log file %s: %v\n", path, err)
+		} else {
+			defer f.Close()
+			handlers = append(handlers, slog.NewJSONHandler(f, nil))
+		}
+	}
+	// Secrets and PII are masked before records reach any of them
+	redactor := services.NewRedactor()
+	logger := slog.New(services.NewRequestIDLogHandler(redactor.LogHandler(services.NewFanoutLogHandler(handlers...))))
 	logger.Info("starting auleOS kernel")
 
-	if err := run(logger); err != nil {
+	if err := run(logger, logBuffer, redactor); err != nil {
 		logger.Error("kernel startup failed", "error", err)
 		os.Exit(1)
 	}
 }
 
-func run(logger *slog.Logger) error {
+func run(logger *slog.Logger, logBuffer *services.LogBuffer, redactor *services.Redactor) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// In-flight work (jobs, chat requests) runs on its own context so a
+	// shutdown signal starts a drain instead of killing it mid-step
+	workCtx, stopWork := context.WithCancel(context.Background())
+	defer stopWork()
+
 	// Handle signals
 	go func() {
 		sig := make(chan os.Signal, 1)
@@ -61,11 +101,21 @@ func run(logger *slog.Logger) error {
 	if err != nil {
 		return fmt.Errorf("failed to init repository: %w", err)
 	}
+	repo.Instrument(logger, time.Duration(envInt("AULE_SLOW_QUERY_MS", 250))*time.Millisecond)
 
 	workerMgr, err := docker.NewManager()
 	if err != nil {
 		return fmt.Errorf("failed to init docker manager: %w", err)
 	}
+	if path := os.Getenv("AULE_SECCOMP_PROFILE"); path != "" {
+		if err := workerMgr.SetDefaultSeccompProfile(path); err != nil {
+			return fmt.Errorf("failed to load seccomp profile: %w", err)
+		}
+	}
+	securityPolicy := workerSecurityPolicyFromEnv()
+	if err := workerMgr.SetSecurityPolicy(securityPolicy); err != nil {
+		return fmt.Errorf("failed to load worker security policy: %w", err)
+	}
 
 	// Run Zombie Reaping (Strategy Phase A)
 	if err := reapZombies(ctx, logger, workerMgr, repo); err != nil {
@@ -76,7 +126,18 @@ func run(logger *slog.Logger) error {
 
 	// Initialize Core Services
 	eventBus := services.NewEventBus(logger) // Telemetry
+	eventBus.SetBufferSize(envInt("AULE_EVENT_BUFFER", 0))
 	workspaceMgr := services.NewWorkspaceManager()
+	// How long file tools wait for another writer of the same file
+	workspaceMgr.SetLockTimeout(time.Duration(envInt("AULE_FILE_LOCK_TIMEOUT_SECONDS", 0)) * time.Second)
+	// Read-only host directories the file tools see under mounts/, from project settings
+	workspaceMgr.SetMountLookup(func(ctx context.Context, projectID string) []domain.WorkspaceMount {
+		proj, err := repo.GetProject(ctx, domain.ProjectID(projectID))
+		if err != nil {
+			return nil
+		}
+		return proj.Settings.Mounts
+	})
 
 	jobScheduler := services.NewJobScheduler(logger, services.SchedulerConfig{
 		MaxConcurrentJobs: 10,
@@ -97,15 +158,69 @@ func run(logger *slog.Logger) error {
 
 	config := settingsStore.GetConfig()
 
-	llmProvider, imageProvider, err := providers.Build(config)
+	// Per-provider request queues: interactive chat is served before background work
+	llmLimiters := providers.LLMLimiters{
+		Local:  llm.NewRequestLimiter("ollama", envInt("AULE_OLLAMA_MAX_CONCURRENCY", 2), envInt("AULE_LLM_MAX_QUEUE", 256)),
+		Remote: llm.NewRequestLimiter("remote", envInt("AULE_LLM_REMOTE_MAX_CONCURRENCY", 8), envInt("AULE_LLM_MAX_QUEUE", 256)),
+	}
+	built, err := providers.Build(config, llmLimiters)
 	if err != nil {
 		return fmt.Errorf("failed to build providers from config: %w", err)
 	}
+	llmProvider, imageProvider := built.LLM, built.Image
+	webSearch := services.NewWebSearch(built.Search)
+
+	// Trace Collector — observability engine (Genkit-style tracing)
+	traceCollector := services.NewTraceCollector(logger, eventBus, repo)
+	traceCollector.SetRedactor(redactor)
+	// Record mode captures raw provider exchanges on the calling span
+	llmProvider = traceCollector.WrapLLM(llmProvider)
+
+	// LLM response cache — serves repeated temperature-0 prompts (evals, deterministic workflow steps)
+	llmCache := services.NewLLMCache(logger,
+		envInt("AULE_LLM_CACHE_SIZE", 512),
+		time.Duration(envInt("AULE_LLM_CACHE_TTL_MINUTES", 60))*time.Minute,
+	)
+	llmProvider = llmCache.Wrap(llmProvider)
+	// Redaction — secrets and PII are masked before prompts reach a remote provider
+	if err := redactor.SetPolicy(config.Runtime.Redaction); err != nil {
+		logger.Warn("invalid redaction policy, using defaults", "error", err)
+	}
+	llmProvider = redactor.WrapLLM(llmProvider, built.RemoteLLM)
+
+	// Node federation — remote muscle nodes reached over mTLS.
+	// Client identity: AULE_NODE_CLIENT_CERT / AULE_NODE_CLIENT_KEY / AULE_NODE_CLIENT_CA.
+	var nodeTLS *tls.Config
+	if certFile := os.Getenv("AULE_NODE_CLIENT_CERT"); certFile != "" {
+		nodeTLS, err = remote.LoadTLSConfig(certFile, os.Getenv("AULE_NODE_CLIENT_KEY"), os.Getenv("AULE_NODE_CLIENT_CA"), false)
+		if err != nil {
+			return fmt.Errorf("failed to load node client tls: %w", err)
+		}
+	}
+	nodeRegistry := services.NewNodeRegistry(logger, repo, eventBus, func(n domain.Node) services.NodeClient {
+		return remote.NewClient(n.URL, nodeTLS)
+	})
+	if err := nodeRegistry.Load(ctx); err != nil {
+		return fmt.Errorf("failed to load nodes: %w", err)
+	}
+	federatedMgr := services.NewFederatedWorkerManager(logger, workerMgr, nodeRegistry)
 
-	lifecycle := services.NewWorkerLifecycle(logger, jobScheduler, workerMgr, repo, workspaceMgr, eventBus, llmProvider, imageProvider)
+	lifecycle := services.NewWorkerLifecycle(logger, jobScheduler, federatedMgr, repo, workspaceMgr, eventBus, llmProvider, imageProvider)
+	// Docker events wake job wait loops on worker exit; HealthCheck polling stays as a fallback
+	workerEvents := services.NewWorkerEventWatcher(logger, workerMgr, repo)
+	lifecycle.SetWorkerEvents(workerEvents)
+	lifecycle.SetWorkerSecurityPolicy(securityPolicy)
 
 	// Tool Registry - register available tools
 	toolRegistry := domain.NewToolRegistry()
+	// Runtime changes (forge hot-loads, deletions) reach clients as tools_changed events
+	defer eventBus.PublishToolChanges(toolRegistry)()
+	// Tool timeouts: AULE_TOOL_TIMEOUT_SECONDS for tools that declare none,
+	// AULE_TOOL_TIMEOUTS ("run_build=900,web_fetch=45") per tool
+	toolRegistry.SetTimeouts(domain.ToolTimeouts{
+		Default: time.Duration(envInt("AULE_TOOL_TIMEOUT_SECONDS", 0)) * time.Second,
+		PerTool: envToolTimeouts(logger, os.Getenv("AULE_TOOL_TIMEOUTS")),
+	})
 	generateImageTool := services.NewGenerateImageTool(lifecycle)
 	if err := toolRegistry.Register(generateImageTool); err != nil {
 		logger.Error("failed to register generate_image tool", "error", err)
@@ -116,6 +231,12 @@ func run(logger *slog.Logger) error {
 		logger.Error("failed to register generate_text tool", "error", err)
 		return err
 	}
+	for _, mediaTool := range []*domain.Tool{services.NewGenerateVideoTool(lifecycle), services.NewGenerateAudioTool(lifecycle)} {
+		if err := toolRegistry.Register(mediaTool); err != nil {
+			logger.Error("failed to register media tool", "tool", mediaTool.Name, "error", err)
+			return err
+		}
+	}
 
 	// Synapse Runtime — lightweight Wasm plugin engine
 	wasmRT, err := synapse.NewRuntime(ctx, logger)
@@ -123,10 +244,22 @@ func run(logger *slog.Logger) error {
 		return fmt.Errorf("failed to init synapse runtime: %w", err)
 	}
 	defer wasmRT.Close(ctx)
+	wasmRT.SetTracer(traceCollector)
 
 	// Host Services — The Bridge between Synapse (Wasm) and Muscle (Docker)
 	// Allows plugins to call `aule.delegate` to spawn heavy tasks.
 	hostServices := synapse.NewHostServices(logger, lifecycle)
+	// Plugin state (aule.kv_get / aule.kv_set), per plugin and project
+	hostServices.SetKVStore(synapse.NewRepoKVStore(repo), func(ctx context.Context) string {
+		id, _ := services.GetProjectFromContext(ctx)
+		return string(id)
+	})
+	// Network access (aule.http_fetch) to the hosts a plugin's manifest
+	// declares, once the user approves them in the plugin_hosts setting
+	pluginHTTP := synapse.NewHTTPProxy(logger)
+	hostServices.SetHTTPProxy(pluginHTTP)
+	wasmRT.SetHTTPProxy(pluginHTTP)
+	wasmRT.SetHostApprovals(config.Runtime.PluginHosts)
 	if err := wasmRT.RegisterHostServices(ctx, hostServices); err != nil {
 		return fmt.Errorf("failed to register host services: %w", err)
 	}
@@ -137,21 +270,27 @@ func run(logger *slog.Logger) error {
 	if envDir := os.Getenv("AULE_PLUGIN_DIR"); envDir != "" {
 		pluginDir = envDir
 	}
-	pluginRegistry := synapse.NewRegistry(logger, wasmRT, pluginDir)
-	wasmTools, err := pluginRegistry.DiscoverAndLoad(ctx)
-	if err != nil {
-		logger.Warn("synapse plugin discovery failed (non-fatal)", "error", err)
-	} else if len(wasmTools) > 0 {
-		for _, tool := range wasmTools {
-			if err := toolRegistry.Register(tool); err != nil {
-				logger.Error("failed to register wasm tool", "tool", tool.Name, "error", err)
+	loadPlugins := func(ctx context.Context, dir string) {
+		wasmTools, err := synapse.NewRegistry(logger, wasmRT, dir).DiscoverAndLoad(ctx)
+		if err != nil {
+			logger.Warn("synapse plugin discovery failed (non-fatal)", "dir", dir, "error", err)
+		} else if len(wasmTools) > 0 {
+			for _, tool := range wasmTools {
+				if err := toolRegistry.Register(tool); err != nil {
+					logger.Error("failed to register wasm tool", "tool", tool.Name, "error", err)
+				}
 			}
+			logger.Info("synapse plugins loaded", "dir", dir, "count", len(wasmTools))
 		}
-		logger.Info("synapse plugins loaded", "count", len(wasmTools))
 	}
+	loadPlugins(ctx, pluginDir)
 
-	// Conversation Store - in-memory cache backed by DuckDB (64 conversations cached)
-	convStore := services.NewConversationStore(repo, 64)
+	// Conversation Store - in-memory LRU cache backed by DuckDB.
+	// AULE_CONVERSATION_WRITE_BEHIND_MS batches in-progress checkpoint writes
+	convStore := services.NewConversationStore(repo, envInt("AULE_CONVERSATION_CACHE_SIZE", 64))
+	convStore.SetLogger(logger)
+	convStore.SetRedactor(redactor)
+	convStore.SetWriteBehind(time.Duration(envInt("AULE_CONVERSATION_WRITE_BEHIND_MS", 0)) * time.Millisecond)
 
 	// Wire conversation store into lifecycle for async job → chat notifications
 	lifecycle.SetConversationStore(convStore)
@@ -159,22 +298,74 @@ func run(logger *slog.Logger) error {
 	// SystemChat — proactive kernel notification channel (Kernel inbox in UI)
 	systemChat := services.NewSystemChat(logger, convStore, eventBus, llmProvider)
 	lifecycle.SetSystemChat(systemChat)
+	wasmRT.SetOnHostsPending(func(plugin string, hosts []string) {
+		systemChat.NotifyPluginHosts(context.Background(), plugin, hosts)
+	})
 
 	// Model Router - resolves which model to use per persona/role
 	modelRouter := services.NewModelRouter(logger, llmProvider)
+	modelRouter.UpdateEmbedder(built.Embeddings) // separate from chat: small local model for memory/RAG
+	modelRouter.SetSystemChat(systemChat)        // warns when a persona's model is uninstalled
 
-	// Trace Collector — observability engine (Genkit-style tracing)
-	traceCollector := services.NewTraceCollector(logger, eventBus, repo)
+	// Model warm-up — preloads the default local models at startup and pings
+	// them within model_warmup.keep_alive_minutes so the first chat does not
+	// wait for a load
+	modelWarmup := services.NewModelWarmup(logger, providers.BuildPreloader(config, llmLimiters))
+	warmDefaults := func(p domain.ProviderConfig) []string {
+		var models []string
+		if mode := strings.ToLower(strings.TrimSpace(p.LLM.Mode)); mode == "" || mode == "local" {
+			models = append(models, modelRouter.ResolveModel(nil, domain.ModelRoleGeneral))
+		}
+		if mode := strings.ToLower(strings.TrimSpace(p.Embeddings.Mode)); mode == "" || mode == "local" {
+			models = append(models, modelRouter.EmbeddingModel())
+		}
+		return models
+	}
+	modelWarmup.SetPolicy(config.Runtime.ModelWarmup, warmDefaults(config.Providers))
+	lastWarmup := config.Runtime.ModelWarmup
+	settingsStore.OnChange(func(cfg *domain.AppConfig) {
+		if reflect.DeepEqual(cfg.Runtime.ModelWarmup, lastWarmup) {
+			return
+		}
+		lastWarmup = cfg.Runtime.ModelWarmup
+		modelWarmup.SetPolicy(cfg.Runtime.ModelWarmup, warmDefaults(cfg.Providers))
+	})
+
+	// Model installer — POST /v1/models/pull downloads into the local Ollama,
+	// checking free space where it keeps models (AULE_OLLAMA_MODELS_DIR,
+	// else OLLAMA_MODELS, else ~/.ollama/models when that exists)
+	modelsDir := os.Getenv("AULE_OLLAMA_MODELS_DIR")
+	if modelsDir == "" {
+		modelsDir = os.Getenv("OLLAMA_MODELS")
+	}
+	if home, err := os.UserHomeDir(); modelsDir == "" && err == nil {
+		if info, err := os.Stat(filepath.Join(home, ".ollama", "models")); err == nil && info.IsDir() {
+			modelsDir = filepath.Join(home, ".ollama", "models")
+		}
+	}
+	modelInstaller := services.NewModelInstaller(logger, providers.BuildPuller(config), host.NewSampler(), modelsDir)
 
 	// Hot-reload: when settings change, rebuild providers and swap in lifecycle + model router
+	lastProviders := config.Providers
 	settingsStore.OnChange(func(cfg *domain.AppConfig) {
-		newLLM, newImage, err := providers.Build(cfg)
+		if reflect.DeepEqual(cfg.Providers, lastProviders) {
+			return // only runtime settings changed
+		}
+		lastProviders = cfg.Providers
+		rebuilt, err := providers.Build(cfg, llmLimiters)
 		if err != nil {
 			logger.Error("failed to rebuild providers on settings change", "error", err)
 			return
 		}
-		lifecycle.UpdateProviders(newLLM, newImage)
+		newLLM := redactor.WrapLLM(llmCache.Wrap(traceCollector.WrapLLM(rebuilt.LLM)), rebuilt.RemoteLLM)
+		llmCache.Purge() // cached answers may come from the previous backend
+		lifecycle.UpdateProviders(newLLM, rebuilt.Image)
 		modelRouter.UpdateProvider(newLLM)
+		modelRouter.UpdateEmbedder(rebuilt.Embeddings)
+		webSearch.UpdateProvider(rebuilt.Search)
+		modelWarmup.UpdatePreloader(providers.BuildPreloader(cfg, llmLimiters))
+		modelInstaller.UpdatePuller(providers.BuildPuller(cfg))
+		modelWarmup.SetPolicy(cfg.Runtime.ModelWarmup, warmDefaults(cfg.Providers))
 		logger.Info("providers hot-reloaded from settings change")
 	})
 
@@ -196,9 +387,24 @@ func run(logger *slog.Logger) error {
 		logger.Warn("ollama model discovery failed (non-fatal)", "error", err)
 	}
 
+	// Refresh the catalog once a pulled model is installed
+	modelInstaller.SetOnInstalled(func(ctx context.Context, model string) {
+		if discovered, err := discovery.DiscoverOllama(ctx, ollamaURL); err == nil && len(discovered) > 0 {
+			modelRouter.SetCatalog(discovered)
+		} else if err != nil {
+			logger.Warn("model catalog refresh after pull failed", "model", model, "error", err)
+		}
+	})
+
 	// Sub-Agent Orchestrator - parallel delegation engine
 	subOrchestrator := services.NewSubAgentOrchestrator(logger, modelRouter, toolRegistry, repo, eventBus, wasmRT)
 	subOrchestrator.SetTracer(traceCollector) // wire span instrumentation
+	subOrchestrator.SetStore(repo)
+	subOrchestrator.SetLimits(services.SubAgentLimits{
+		MaxDepth:           envInt("AULE_SUBAGENT_MAX_DEPTH", 0),
+		MaxPerConversation: envInt("AULE_SUBAGENT_MAX_PER_CONVERSATION", 0),
+	})
+	convStore.OnDelete(subOrchestrator.ForgetConversation)
 
 	// Register delegate tool (must be after orchestrator creation)
 	delegateTool := services.NewDelegateTool(subOrchestrator)
@@ -217,30 +423,66 @@ func run(logger *slog.Logger) error {
 	if err := toolRegistry.Register(listForgedTool); err != nil {
 		logger.Error("failed to register list_forged_tools tool", "error", err)
 	}
+	if err := toolRegistry.Register(services.NewDeleteForgedToolTool(forge)); err != nil {
+		logger.Error("failed to register delete_forged_tool tool", "error", err)
+	}
 	logger.Info("tool forge initialized", "plugin_dir", pluginDir)
 
 	// Core Agent Tools (M10)
 	// FS Tools
+	// Agent edits in project workspaces are committed to a git repository
+	// there (AULE_WORKSPACE_GIT=false turns it off)
+	var workspaceAudit *services.WorkspaceAudit
+	if os.Getenv("AULE_WORKSPACE_GIT") != "false" {
+		workspaceAudit = services.NewWorkspaceAudit(logger, workspaceMgr)
+	}
 	if err := toolRegistry.Register(services.NewReadFileTool(workspaceMgr)); err != nil {
 		logger.Error("failed to register read_file tool", "error", err)
 	}
-	if err := toolRegistry.Register(services.NewWriteFileTool(workspaceMgr)); err != nil {
+	// Significant files written during a chat become conversation artifacts
+	chatArtifacts := services.NewChatArtifacts(logger, repo, workspaceMgr)
+	if err := toolRegistry.Register(chatArtifacts.Wrap(workspaceAudit.Wrap(services.NewWriteFileTool(workspaceMgr)))); err != nil {
 		logger.Error("failed to register write_file tool", "error", err)
 	}
 	if err := toolRegistry.Register(services.NewListDirTool(workspaceMgr)); err != nil {
 		logger.Error("failed to register list_dir tool", "error", err)
 	}
 	// Exec Tool
-	execTool := services.NewExecTool(workspaceMgr)
+	// AULE_EXEC_ENV_ALLOWLIST adds env var names the agent may set (comma-separated)
+	execTool := services.NewExecTool(workspaceMgr, strings.Split(os.Getenv("AULE_EXEC_ENV_ALLOWLIST"), ",")...)
 	if err := toolRegistry.Register(execTool); err != nil {
 		logger.Error("failed to register exec tool", "error", err)
 	}
+	// Shell Session Tools — persistent per-conversation shell in a container
+	// AULE_SHELL_IMAGE overrides the shell image (default alpine)
+	shellSessions := services.NewShellSessionManager(logger, workerMgr, workspaceMgr, os.Getenv("AULE_SHELL_IMAGE"), 0)
+	for _, tool := range []*domain.Tool{
+		services.NewShellOpenTool(shellSessions),
+		services.NewShellRunTool(shellSessions),
+		services.NewShellCloseTool(shellSessions),
+	} {
+		if err := toolRegistry.Register(tool); err != nil {
+			logger.Error("failed to register shell session tool", "tool", tool.Name, "error", err)
+		}
+	}
+	// Build Tool — the project's build/test command in a throwaway sandbox
+	buildRunner := services.NewBuildRunner(logger, workerMgr, workspaceMgr, repo, os.Getenv("AULE_SHELL_IMAGE"))
+	if err := toolRegistry.Register(services.NewRunBuildTool(buildRunner)); err != nil {
+		logger.Error("failed to register run_build tool", "error", err)
+	}
 	// Web Search Tool
-	if err := toolRegistry.Register(services.NewWebSearchTool()); err != nil {
+	if err := toolRegistry.Register(services.NewWebSearchTool(webSearch)); err != nil {
 		logger.Error("failed to register web_search tool", "error", err)
 	}
 	// Web Fetch Tool
-	if err := toolRegistry.Register(services.NewWebFetchTool()); err != nil {
+	// AULE_WEB_FETCH_MAX_BYTES / AULE_WEB_FETCH_MAX_REDIRECTS bound downloads;
+	// AULE_WEB_FETCH_RESPECT_ROBOTS=true honours robots.txt
+	webFetcher := services.NewWebFetcher(services.WebFetchOptions{
+		MaxBytes:      int64(envInt("AULE_WEB_FETCH_MAX_BYTES", services.DefaultWebFetchMaxBytes)),
+		MaxRedirects:  envInt("AULE_WEB_FETCH_MAX_REDIRECTS", services.DefaultWebFetchMaxRedirects),
+		RespectRobots: os.Getenv("AULE_WEB_FETCH_RESPECT_ROBOTS") == "true",
+	})
+	if err := toolRegistry.Register(services.NewWebFetchTool(webFetcher)); err != nil {
 		logger.Error("failed to register web_fetch tool", "error", err)
 	}
 	// Memory Tools
@@ -253,16 +495,62 @@ func run(logger *slog.Logger) error {
 	if err := toolRegistry.Register(services.NewMemorySearchTool(workspaceMgr)); err != nil {
 		logger.Error("failed to register memory_search tool", "error", err)
 	}
-	// FS Tools — edit_file, append_file
-	if err := toolRegistry.Register(services.NewEditFileTool(workspaceMgr)); err != nil {
+	// Scratchpad Tools — ephemeral per-conversation storage, kept out of MEMORY.md
+	scratchpad := services.NewScratchpad()
+	if err := toolRegistry.Register(services.NewScratchpadWriteTool(scratchpad)); err != nil {
+		logger.Error("failed to register scratchpad_write tool", "error", err)
+	}
+	if err := toolRegistry.Register(services.NewScratchpadReadTool(scratchpad)); err != nil {
+		logger.Error("failed to register scratchpad_read tool", "error", err)
+	}
+	// ask_user — the agent pauses on a clarifying question until the user answers
+	clarifier := services.NewClarifier(logger, eventBus, time.Duration(envInt("AULE_ASK_USER_TIMEOUT_SECONDS", 0))*time.Second)
+	if err := toolRegistry.Register(services.NewAskUserTool(clarifier)); err != nil {
+		logger.Error("failed to register ask_user tool", "error", err)
+	}
+	// FS Tools — edit_file, append_file, apply_patch
+	if err := toolRegistry.Register(workspaceAudit.Wrap(services.NewEditFileTool(workspaceMgr))); err != nil {
 		logger.Error("failed to register edit_file tool", "error", err)
 	}
-	if err := toolRegistry.Register(services.NewAppendFileTool(workspaceMgr)); err != nil {
+	if err := toolRegistry.Register(workspaceAudit.Wrap(services.NewAppendFileTool(workspaceMgr))); err != nil {
 		logger.Error("failed to register append_file tool", "error", err)
 	}
+	if err := toolRegistry.Register(workspaceAudit.Wrap(services.NewApplyPatchTool(workspaceMgr))); err != nil {
+		logger.Error("failed to register apply_patch tool", "error", err)
+	}
 
 	// ReAct Agent Service - agentic reasoning with tools + model routing + tracing
 	reactAgent := services.NewReActAgentService(logger, llmProvider, modelRouter, toolRegistry, convStore, repo, workspaceMgr, traceCollector)
+	// Context window of local models without a num_ctx of their own (match
+	// the Ollama server default), and of models missing from the catalog
+	reactAgent.SetContextTokens(envInt("AULE_CONTEXT_TOKENS", 0))
+	reactAgent.SetEventBus(eventBus)
+	reactAgent.SetAutoTitles(os.Getenv("AULE_AUTO_TITLES") != "false")
+	// Tool outputs over the limit are saved to the workspace (or scratchpad)
+	// and replaced in the prompt by a preview plus a reference
+	reactAgent.SetObservationOffloader(services.NewObservationOffloader(workspaceMgr, scratchpad, envInt("AULE_OBSERVATION_MAX_BYTES", 0)))
+	// Token/cost accounting; limits are set per conversation or project via /v1/usage
+	usageMeter := services.NewUsageMeter(logger, repo, modelRouter)
+	usageMeter.SetEventBus(eventBus)
+	reactAgent.SetUsageMeter(usageMeter)
+	// Content moderation of chat input and answers; the classifier follows
+	// provider and runtime settings
+	moderation := services.NewModerationService(logger, traceCollector)
+	reactAgent.SetModeration(moderation)
+	applyModeration := func(cfg *domain.AppConfig) {
+		moderator, err := providers.BuildModerator(cfg, llmLimiters)
+		if err != nil {
+			logger.Error("failed to build moderation classifier, moderation disabled", "error", err)
+		}
+		moderation.Configure(moderator, cfg.Runtime.Moderation)
+	}
+	applyModeration(config)
+	settingsStore.OnChange(applyModeration)
+	if n, err := reactAgent.RecoverCheckpoints(ctx); err != nil {
+		logger.Warn("failed to recover agent checkpoints", "error", err)
+	} else if n > 0 {
+		logger.Info("marked unfinished agent loops as interrupted", "count", n)
+	}
 
 	// Seed built-in personas (idempotent — ON CONFLICT DO NOTHING)
 	for _, p := range domain.BuiltinPersonas() {
@@ -274,6 +562,10 @@ func run(logger *slog.Logger) error {
 
 	// Capability Router — decides Synapse vs Muscle per capability
 	capRouter := services.NewCapabilityRouter(logger, wasmRT)
+	if os.Getenv("AULE_CAPABILITY_AUTO_DEMOTE") == "true" {
+		capRouter.SetAutoDemote(services.AutoDemotePolicy{Enabled: true})
+	}
+	lifecycle.SetCapabilityRouter(capRouter)
 
 	// Workflow Engine (M12)
 	workflowExec := services.NewWorkflowExecutor(logger, repo, reactAgent, eventBus, traceCollector)
@@ -289,6 +581,44 @@ func run(logger *slog.Logger) error {
 		logger.Error("failed to register list_workflows tool", "error", err)
 	}
 
+	// Feed Monitor — RSS/Atom feeds that trigger a prompt or workflow on new items
+	feedMonitor := services.NewFeedMonitor(logger, repo, reactAgent, convStore, repo, workflowExec)
+	if err := toolRegistry.Register(services.NewWatchFeedTool(feedMonitor)); err != nil {
+		logger.Error("failed to register watch_feed tool", "error", err)
+	}
+	if err := toolRegistry.Register(services.NewListFeedsTool(repo)); err != nil {
+		logger.Error("failed to register list_feeds tool", "error", err)
+	}
+	if err := toolRegistry.Register(services.NewUnwatchFeedTool(repo)); err != nil {
+		logger.Error("failed to register unwatch_feed tool", "error", err)
+	}
+
+	// File Triggers — workspace directories that trigger a prompt or workflow on changes
+	fileTriggers := services.NewFileTriggerService(logger, repo, workspaceMgr, reactAgent, convStore, repo, workflowExec, fswatch.New)
+	if err := toolRegistry.Register(services.NewWatchFilesTool(fileTriggers)); err != nil {
+		logger.Error("failed to register watch_files tool", "error", err)
+	}
+	if err := toolRegistry.Register(services.NewListFileTriggersTool(repo)); err != nil {
+		logger.Error("failed to register list_file_triggers tool", "error", err)
+	}
+	if err := toolRegistry.Register(services.NewUnwatchFilesTool(fileTriggers)); err != nil {
+		logger.Error("failed to register unwatch_files tool", "error", err)
+	}
+
+	// Automations — trigger + condition + action rules over cron, webhooks, feeds and files
+	automations := services.NewAutomationService(logger, repo, reactAgent, convStore, repo, workflowExec, toolRegistry, feedMonitor, fileTriggers)
+	feedMonitor.SetAutomations(automations)
+	fileTriggers.SetAutomations(automations)
+	for _, tool := range []*domain.Tool{
+		services.NewCreateAutomationTool(automations),
+		services.NewListAutomationsTool(automations),
+		services.NewDeleteAutomationTool(automations),
+	} {
+		if err := toolRegistry.Register(tool); err != nil {
+			logger.Error("failed to register automation tool", "tool", tool.Name, "error", err)
+		}
+	}
+
 	// Scheduled Task Tools (M11)
 	if err := toolRegistry.Register(services.NewScheduleTaskTool(repo)); err != nil {
 		logger.Error("failed to register schedule_task tool", "error", err)
@@ -308,14 +638,99 @@ func run(logger *slog.Logger) error {
 		logger.Error("failed to register message tool", "error", err)
 	}
 
+	// Email — send_email over SMTP, plus an optional IMAP poller that runs
+	// incoming mail from allowed senders as conversations
+	emailSvc := services.NewEmailService(logger, reactAgent, convStore)
+	applyEmail := func(cfg domain.EmailConfig) {
+		var sender ports.EmailSender
+		if cfg.SMTP.Host != "" {
+			sender = email.NewSMTPSender(cfg.SMTP)
+		}
+		var inbox ports.EmailInbox
+		if cfg.IMAP.Enabled {
+			inbox = email.NewIMAPInbox(cfg.IMAP)
+		}
+		emailSvc.Update(cfg, sender, inbox)
+	}
+	applyEmail(config.Email)
+	lastEmail := config.Email
+	settingsStore.OnChange(func(cfg *domain.AppConfig) {
+		if reflect.DeepEqual(cfg.Email, lastEmail) {
+			return
+		}
+		lastEmail = cfg.Email
+		applyEmail(cfg.Email)
+		logger.Info("email settings applied", "smtp", cfg.Email.SMTP.Host != "", "imap_poller", cfg.Email.IMAP.Enabled)
+	})
+	if err := toolRegistry.Register(services.NewSendEmailTool(emailSvc)); err != nil {
+		logger.Error("failed to register send_email tool", "error", err)
+	}
+
+	// Calendar — list and create events on CalDAV or Google Calendar
+	calendarSvc := services.NewCalendar(nil, config.Calendar.Location())
+	applyCalendar := func(cfg domain.CalendarConfig) {
+		provider, err := calendar.New(cfg)
+		if err != nil {
+			logger.Error("failed to configure calendar", "error", err)
+		}
+		calendarSvc.UpdateProvider(provider, cfg.Location())
+	}
+	applyCalendar(config.Calendar)
+	lastCalendar := config.Calendar
+	settingsStore.OnChange(func(cfg *domain.AppConfig) {
+		if reflect.DeepEqual(cfg.Calendar, lastCalendar) {
+			return
+		}
+		lastCalendar = cfg.Calendar
+		applyCalendar(cfg.Calendar)
+		logger.Info("calendar settings applied", "kind", cfg.Calendar.Kind)
+	})
+	for _, tool := range []*domain.Tool{services.NewCalendarListTool(calendarSvc), services.NewCalendarCreateTool(calendarSvc)} {
+		if err := toolRegistry.Register(tool); err != nil {
+			logger.Error("failed to register calendar tool", "tool", tool.Name, "error", err)
+		}
+	}
+
 	// Spawn Tool — async background sub-agent (PicoClaw pattern)
-	if err := toolRegistry.Register(services.NewSpawnTool(subOrchestrator, eventBus, logger)); err != nil {
+	if err := toolRegistry.Register(services.NewSpawnTool(subOrchestrator, convStore, eventBus, logger)); err != nil {
 		logger.Error("failed to register spawn tool", "error", err)
 	}
+	if err := toolRegistry.Register(services.NewCheckSpawnTool(subOrchestrator)); err != nil {
+		logger.Error("failed to register check_spawn tool", "error", err)
+	}
 
 	// Initialize Kernel API Server
-	apiServer := kernel.NewServer(logger, lifecycle, reactAgent, eventBus, settingsStore, convStore, modelRouter, discovery, capRouter, wasmRT, workflowExec, traceCollector, toolRegistry, workerMgr, repo)
+	apiServer := kernel.NewServer(logger, lifecycle, reactAgent, eventBus, settingsStore, convStore, modelRouter, discovery, capRouter, wasmRT, workflowExec, traceCollector, toolRegistry, federatedMgr, repo)
+	apiServer.SetMaxBodyBytes(int64(envInt("AULE_MAX_BODY_BYTES", 0)))
+	apiServer.SetSSEConfig(kernel.SSEConfig{
+		Heartbeat:    time.Duration(envInt("AULE_SSE_HEARTBEAT_SECONDS", 0)) * time.Second,
+		IdleTimeout:  time.Duration(envInt("AULE_SSE_IDLE_MINUTES", 0)) * time.Minute,
+		MaxConns:     envInt("AULE_SSE_MAX_CONNS", 0),
+		MaxPerClient: envInt("AULE_SSE_MAX_PER_CLIENT", 0),
+	})
 	apiServer.SetSystemChat(systemChat)
+	apiServer.SetNodeRegistry(nodeRegistry)
+	apiServer.SetLLMCache(llmCache)
+	apiServer.SetLLMLimiters(llmLimiters.Local, llmLimiters.Remote)
+	apiServer.SetFeedMonitor(feedMonitor)
+	apiServer.SetFileTriggers(fileTriggers)
+	apiServer.SetAutomations(automations)
+	apiServer.SetUsageMeter(usageMeter)
+	apiServer.SetIDECompanion(services.NewIDECompanion(logger, modelRouter, workspaceMgr))
+	apiServer.SetArtifactDiscussion(services.NewArtifactDiscussion(convStore, workspaceMgr))
+	apiServer.SetWorkspaceAudit(workspaceAudit)
+	apiServer.SetClarifier(clarifier)
+	apiServer.SetTraceReplayer(services.NewTraceReplayer(logger, traceCollector, convStore, reactAgent))
+	apiServer.SetBuildLoops(services.NewBuildLoopService(logger, buildRunner, reactAgent, convStore, traceCollector))
+	apiServer.SetEvalService(services.NewEvalService(logger, repo, reactAgent, convStore))
+
+	// Template gallery — built-in starters plus community bundles from ~/.aule/templates/
+	templateDir := filepath.Join(homeDir, ".aule", "templates")
+	if envDir := os.Getenv("AULE_TEMPLATE_DIR"); envDir != "" {
+		templateDir = envDir
+	}
+	apiServer.SetTemplateGallery(services.NewTemplateGallery(logger, repo, repo, templateDir))
+	apiServer.SetCleanup(services.NewCleanupService(logger, repo, convStore, traceCollector, workspaceMgr))
 
 	// Post welcome message into kernel inbox on first boot (idempotent)
 	go systemChat.WelcomeIfNew(context.Background())
@@ -326,20 +741,97 @@ func run(logger *slog.Logger) error {
 	// HeartbeatService — processes HEARTBEAT.md checklists (M11)
 	heartbeatSvc := services.NewHeartbeatService(logger, workspaceMgr, reactAgent, repo, 30*time.Minute)
 
-	// Setup HTTP Server
-	// CORS Configuration
-	c := cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:5173", "http://localhost:5174"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"*"},
-		AllowCredentials: true,
+	// Maintenance mode — POST /v1/system/pause stops new work without exiting
+	maintenance := services.NewMaintenanceMode(eventBus)
+	jobScheduler.SetMaintenance(maintenance)
+	cronScheduler.SetMaintenance(maintenance)
+	heartbeatSvc.SetMaintenance(maintenance)
+	emailSvc.SetMaintenance(maintenance)
+	feedMonitor.SetMaintenance(maintenance)
+	fileTriggers.SetMaintenance(maintenance)
+	automations.SetMaintenance(maintenance)
+	reactAgent.SetMaintenance(maintenance)
+	apiServer.SetMaintenance(maintenance)
+	apiServer.SetLogBuffer(logBuffer)
+
+	// Resource monitor — host CPU/memory/disk, DB size and Docker stats;
+	// threshold warnings go to the kernel inbox
+	resourceMonitor := services.NewResourceMonitor(logger, host.NewSampler(), workspaceMgr.BaseDir(), dbPath, time.Minute)
+	resourceMonitor.SetRuntimeStats(workerMgr)
+	resourceMonitor.SetSystemChat(systemChat)
+	resourceMonitor.SetThresholds(services.ResourceThresholds{
+		DiskPercent:   float64(envInt("AULE_DISK_WARN_PERCENT", 90)),
+		MemoryPercent: float64(envInt("AULE_MEMORY_WARN_PERCENT", 90)),
+		CPUPercent:    float64(envInt("AULE_CPU_WARN_PERCENT", 95)),
 	})
+	apiServer.SetResourceMonitor(resourceMonitor)
+	apiServer.SetModelWarmup(modelWarmup)
+	apiServer.SetModelInstaller(modelInstaller)
 
-	handler := c.Handler(apiServer.Handler())
+	// Version info, and release checks against GitHub when AULE_UPDATE_CHECK=true
+	buildInfo := domain.ReadBuildInfo(version, gitSHA, buildDate)
+	buildInfo.SchemaVersion = duckdb.SchemaVersion()
+	apiServer.SetBuildInfo(buildInfo)
+	var updateChecker *services.UpdateChecker
+	if os.Getenv("AULE_UPDATE_CHECK") == "true" {
+		updateChecker = services.NewUpdateChecker(logger, buildInfo.Version, os.Getenv("AULE_UPDATE_REPO"))
+		updateChecker.SetSystemChat(systemChat)
+		apiServer.SetUpdateChecker(updateChecker)
+	}
+
+	// Setup HTTP Server
+	// CORS Configuration — origins can change at runtime via settings
+	// Rate limits per API key and route class, also set by runtime settings
+	rateLimiter := kernel.NewRateLimiter(apiServer.Handler())
+	corsHandler := kernel.NewCORS(rateLimiter, nil)
+
+	// Runtime settings hot-reload: scheduler concurrency and project
+	// shares, CORS origins, tool deny list and strict names, rate limits,
+	// trace retention, redaction policy, chat artifacts, locale and plugin
+	// directory apply without a restart
+	toolPolicy := services.NewToolPolicy(logger)
+	reactAgent.SetToolPolicy(toolPolicy)
+	automations.SetToolPolicy(toolPolicy)
+	subOrchestrator.SetToolPolicy(toolPolicy)
+	apiServer.SetToolPolicy(toolPolicy)
+	loadedPluginDir := pluginDir
+	applyRuntime := func(rt domain.RuntimeConfig) {
+		jobScheduler.SetMaxConcurrency(int64(rt.MaxConcurrentJobs))
+		jobScheduler.SetProjectShares(rt.ProjectShares)
+		corsHandler.SetOrigins(rt.CORSOrigins)
+		rateLimiter.SetLimits(rt.RateLimits, rt.KeyRateLimits)
+		toolPolicy.SetDisabled(rt.DisabledTools)
+		toolPolicy.SetStrictNames(rt.StrictToolNames)
+		workerMgr.SetImagePolicy(rt.Images)
+		lifecycle.SetImagePolicy(rt.Images)
+		chatArtifacts.SetPolicy(rt.ChatArtifacts)
+		traceCollector.SetRetention(time.Duration(rt.TraceRetentionDays) * 24 * time.Hour)
+		traceCollector.SetRecordProviderCalls(rt.RecordProviderCalls)
+		reactAgent.SetLocale(rt.Locale)
+		wasmRT.SetHostApprovals(rt.PluginHosts)
+		if err := redactor.SetPolicy(rt.Redaction); err != nil {
+			logger.Error("invalid redaction policy", "error", err)
+		}
+		dir := pluginDir
+		if rt.PluginDir != "" {
+			dir = rt.PluginDir
+		}
+		if dir != loadedPluginDir {
+			// Tools from the previous directory stay registered until restart
+			loadPlugins(context.Background(), dir)
+			loadedPluginDir = dir
+		}
+	}
+	applyRuntime(config.Runtime)
+	settingsStore.OnChange(func(cfg *domain.AppConfig) {
+		applyRuntime(cfg.Runtime)
+		logger.Info("runtime settings applied")
+	})
 
 	httpServer := &http.Server{
-		Addr:    ":8080",
-		Handler: handler,
+		Addr:        ":8080",
+		Handler:     corsHandler,
+		BaseContext: func(net.Listener) context.Context { return workCtx },
 	}
 
 	// Application Loop (using errgroup as per rules)
@@ -347,7 +839,15 @@ func run(logger *slog.Logger) error {
 
 	// 1. Start Worker Lifecycle (Scheduler Loop)
 	g.Go(func() error {
-		return lifecycle.Run(gCtx)
+		if err := lifecycle.Run(workCtx); err != nil {
+			return err
+		}
+		if n, err := lifecycle.RecoverInterruptedJobs(gCtx); err != nil {
+			logger.Warn("failed to recover interrupted jobs", "error", err)
+		} else if n > 0 {
+			logger.Info("resumed interrupted jobs", "count", n)
+		}
+		return nil
 	})
 
 	// 2. Start API Server
@@ -359,28 +859,223 @@ func run(logger *slog.Logger) error {
 		return nil
 	})
 
-	// 3. Graceful Shutdown for API Server
+	// 3. Graceful Shutdown: stop accepting requests and jobs, give running
+	// jobs and ReAct loops a grace period, then cancel and record the rest
 	g.Go(func() error {
 		<-gCtx.Done()
-		logger.Info("shutting down api server")
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		grace := time.Duration(envInt("AULE_SHUTDOWN_GRACE_SECONDS", 30)) * time.Second
+		logger.Info("draining in-flight work", "grace", grace)
+		drainCtx, cancel := context.WithTimeout(context.Background(), grace)
 		defer cancel()
-		return httpServer.Shutdown(shutdownCtx)
+
+		shutdownErr := make(chan error, 1)
+		go func() { shutdownErr <- httpServer.Shutdown(drainCtx) }()
+
+		var drainWG sync.WaitGroup
+		drainWG.Add(2)
+		go func() {
+			defer drainWG.Done()
+			if n := lifecycle.Drain(drainCtx); n > 0 {
+				logger.Warn("jobs marked interrupted", "count", n)
+			}
+		}()
+		go func() {
+			defer drainWG.Done()
+			if n := reactAgent.Drain(drainCtx); n > 0 {
+				logger.Warn("agent loops still running after grace period", "count", n)
+			}
+		}()
+		drainWG.Wait()
+
+		// Cancel whatever is left; loops checkpoint on the way out
+		stopWork()
+		flushCtx, flushCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer flushCancel()
+		reactAgent.Drain(flushCtx)
+
+		if err := <-shutdownErr; err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		return nil
+	})
+
+	// Docker event stream for worker state sync
+	g.Go(func() error {
+		return workerEvents.Run(gCtx)
+	})
+
+	// Conversation write-behind flusher (no-op unless enabled); flushes on shutdown
+	g.Go(func() error {
+		return convStore.RunWriteBehind(gCtx)
+	})
+
+	// Singleton loops run on the leading kernel only. A lone kernel always
+	// leads; kernels deployed side by side elect leaders with Postgres
+	// advisory locks (AULE_LEADER_POSTGRES_DSN=postgres://user:pw@host/db).
+	// Only the election goes through Postgres: each kernel still keeps its
+	// own DuckDB repository, so kernels do not share state.
+	var elector ports.LeaderElector = services.LocalElector{}
+	if dsn := os.Getenv("AULE_LEADER_POSTGRES_DSN"); dsn != "" {
+		pgElector, err := postgres.NewElector(logger, dsn)
+		if err != nil {
+			logger.Error("invalid AULE_LEADER_POSTGRES_DSN", "error", err)
+			os.Exit(1)
+		}
+		pingCtx, cancelPing := context.WithTimeout(ctx, 5*time.Second)
+		if err := pgElector.Ping(pingCtx); err != nil {
+			logger.Warn("leader election database not reachable yet, singleton loops wait for it", "error", err)
+		}
+		cancelPing()
+		elector = pgElector
+		logger.Info("singleton loops elect leaders through postgres advisory locks")
+	}
+
+	// Trace retention — prunes persisted traces past trace_retention_days
+	g.Go(func() error {
+		return services.RunAsLeader(gCtx, logger, elector, "trace_retention", traceCollector.RunRetention)
 	})
 
 	// 4. CronScheduler loop (M11)
 	g.Go(func() error {
-		return cronScheduler.Run(gCtx)
+		return services.RunAsLeader(gCtx, logger, elector, "cron", cronScheduler.Run)
 	})
 
 	// 5. HeartbeatService loop (M11)
 	g.Go(func() error {
-		return heartbeatSvc.Run(gCtx)
+		return services.RunAsLeader(gCtx, logger, elector, "heartbeat", heartbeatSvc.Run)
 	})
 
+	// Feed monitor loop
+	g.Go(func() error {
+		return services.RunAsLeader(gCtx, logger, elector, "feeds", feedMonitor.Run)
+	})
+
+	// File trigger watcher
+	g.Go(func() error {
+		return services.RunAsLeader(gCtx, logger, elector, "file_triggers", fileTriggers.Run)
+	})
+
+	// Automation cron rules
+	g.Go(func() error {
+		return services.RunAsLeader(gCtx, logger, elector, "automations", automations.Run)
+	})
+
+	// Email inbox poller (idle unless IMAP is enabled in settings)
+	g.Go(func() error {
+		return services.RunAsLeader(gCtx, logger, elector, "email", emailSvc.Run)
+	})
+
+	// Shell session reaper — closes idle shells, and all of them on shutdown
+	g.Go(func() error {
+		return shellSessions.RunReaper(gCtx)
+	})
+
+	// Resource monitor loop
+	g.Go(func() error {
+		return services.RunAsLeader(gCtx, logger, elector, "resource_monitor", resourceMonitor.Run)
+	})
+
+	// Model warm-up loop (idle when no local models are configured)
+	g.Go(func() error {
+		return services.RunAsLeader(gCtx, logger, elector, "model_warmup", modelWarmup.Run)
+	})
+
+	// Update check loop
+	if updateChecker != nil {
+		interval := time.Duration(envInt("AULE_UPDATE_CHECK_HOURS", 0)) * time.Hour
+		g.Go(func() error {
+			return services.RunAsLeader(gCtx, logger, elector, "update_check", func(ctx context.Context) error {
+				return updateChecker.Run(ctx, interval)
+			})
+		})
+	}
+
+	// 6. Node registry health loop
+	g.Go(func() error {
+		return nodeRegistry.Run(gCtx)
+	})
+
+	// 7. Muscle node API — lets another kernel schedule workers on this host.
+	// Enabled by AULE_NODE_ADDR; requires AULE_TLS_CERT / AULE_TLS_KEY / AULE_TLS_CLIENT_CA (mTLS).
+	if nodeAddr := os.Getenv("AULE_NODE_ADDR"); nodeAddr != "" {
+		serverTLS, err := remote.LoadTLSConfig(os.Getenv("AULE_TLS_CERT"), os.Getenv("AULE_TLS_KEY"), os.Getenv("AULE_TLS_CLIENT_CA"), true)
+		if err != nil {
+			return fmt.Errorf("failed to load node server tls: %w", err)
+		}
+		nodeServer := &http.Server{
+			Addr:      nodeAddr,
+			Handler:   kernel.NodeHandler(workerMgr),
+			TLSConfig: serverTLS,
+		}
+		g.Go(func() error {
+			logger.Info("starting muscle node api", "addr", nodeAddr)
+			if err := nodeServer.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+				return fmt.Errorf("node api server failed: %w", err)
+			}
+			return nil
+		})
+		g.Go(func() error {
+			<-gCtx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			return nodeServer.Shutdown(shutdownCtx)
+		})
+	}
+
 	return g.Wait()
 }
 
+// envInt reads an integer environment variable, returning fallback when unset or invalid.
+// workerSecurityPolicyFromEnv reads what per-worker security settings may
+// ask for. AULE_SECCOMP_PROFILES names seccomp profile files
+// (build=/etc/aule/build.json,…), AULE_APPARMOR_PROFILES lists the AppArmor
+// profiles loaded on the hosts, and AULE_WORKER_ALLOW_UNCONFINED /
+// AULE_WORKER_ALLOW_PRIVILEGE_ESCALATION ("true") unlock the rest.
+func workerSecurityPolicyFromEnv() domain.WorkerSecurityPolicy {
+	p := domain.WorkerSecurityPolicy{
+		SeccompProfiles:          map[string]string{},
+		AllowUnconfined:          os.Getenv("AULE_WORKER_ALLOW_UNCONFINED") == "true",
+		AllowPrivilegeEscalation: os.Getenv("AULE_WORKER_ALLOW_PRIVILEGE_ESCALATION") == "true",
+	}
+	for _, entry := range strings.Split(os.Getenv("AULE_SECCOMP_PROFILES"), ",") {
+		if name, path, ok := strings.Cut(strings.TrimSpace(entry), "="); ok && name != "" && path != "" {
+			p.SeccompProfiles[name] = path
+		}
+	}
+	for _, name := range strings.Split(os.Getenv("AULE_APPARMOR_PROFILES"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			p.AppArmorProfiles = append(p.AppArmorProfiles, name)
+		}
+	}
+	return p
+}
+
+func envInt(key string, fallback int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return fallback
+}
+
+// envToolTimeouts parses "name=seconds" pairs separated by commas,
+// skipping invalid ones.
+func envToolTimeouts(logger *slog.Logger, v string) map[string]time.Duration {
+	timeouts := map[string]time.Duration{}
+	for _, pair := range strings.Split(v, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, secs, _ := strings.Cut(pair, "=")
+		n, err := strconv.Atoi(strings.TrimSpace(secs))
+		if name = strings.TrimSpace(name); name == "" || err != nil || n <= 0 {
+			logger.Warn("ignoring invalid AULE_TOOL_TIMEOUTS entry", "entry", pair)
+			continue
+		}
+		timeouts[name] = time.Duration(n) * time.Second
+	}
+	return timeouts
+}
+
 // reapZombies implements the startup cleanup strategy
 func reapZombies(ctx context.Context, logger *slog.Logger, mgr ports.WorkerManager, repo ports.Repository) error {
 	logger.Info("running zombie reaper")
@@ -0,0 +1,152 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// CalDAV talks to one calendar collection (RFC 4791): a calendar-query
+// REPORT to list events and a PUT of an .ics resource to create one.
+type CalDAV struct {
+	cfg    domain.CalDAVConfig
+	loc    *time.Location
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewCalDAV(cfg domain.CalDAVConfig, loc *time.Location) *CalDAV {
+	if !strings.HasSuffix(cfg.URL, "/") {
+		cfg.URL += "/"
+	}
+	return &CalDAV{cfg: cfg, loc: loc, client: &http.Client{Timeout: requestTimeout}, now: time.Now}
+}
+
+const calendarQuery = `<?xml version="1.0" encoding="utf-8"?>
+<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:prop>
+    <C:calendar-data><C:expand start="%[1]s" end="%[2]s"/></C:calendar-data>
+  </D:prop>
+  <C:filter>
+    <C:comp-filter name="VCALENDAR">
+      <C:comp-filter name="VEVENT"><C:time-range start="%[1]s" end="%[2]s"/></C:comp-filter>
+    </C:comp-filter>
+  </C:filter>
+</C:calendar-query>`
+
+type multistatus struct {
+	Responses []struct {
+		Href     string `xml:"href"`
+		Propstat []struct {
+			Status string `xml:"status"`
+			Prop   struct {
+				CalendarData string `xml:"calendar-data"`
+			} `xml:"prop"`
+		} `xml:"propstat"`
+	} `xml:"response"`
+}
+
+// ListEvents implements ports.CalendarProvider. The server expands
+// recurring events into the instances within the range.
+func (c *CalDAV) ListEvents(ctx context.Context, from, to time.Time) ([]domain.CalendarEvent, error) {
+	body := fmt.Sprintf(calendarQuery, from.UTC().Format(icsUTC), to.UTC().Format(icsUTC))
+	req, err := c.request(ctx, "REPORT", c.cfg.URL, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+	req.Header.Set("Depth", "1")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("caldav report: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusMultiStatus && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("caldav report: %w", statusError(resp))
+	}
+	var ms multistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&ms); err != nil {
+		return nil, fmt.Errorf("caldav report: decode: %w", err)
+	}
+
+	var events []domain.CalendarEvent
+	for _, r := range ms.Responses {
+		for _, ps := range r.Propstat {
+			if ps.Prop.CalendarData == "" || (ps.Status != "" && !strings.Contains(ps.Status, " 200 ")) {
+				continue
+			}
+			evs, err := parseICS(ps.Prop.CalendarData, c.loc)
+			if err != nil {
+				return nil, fmt.Errorf("caldav %s: %w", r.Href, err)
+			}
+			for _, ev := range evs {
+				if ev.URL == "" {
+					ev.URL = c.resolve(r.Href)
+				}
+				events = append(events, ev)
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	return events, nil
+}
+
+// CreateEvent implements ports.CalendarProvider.
+func (c *CalDAV) CreateEvent(ctx context.Context, ev domain.CalendarEvent) (domain.CalendarEvent, error) {
+	uid := uuid.NewString()
+	target := c.cfg.URL + uid + ".ics"
+	req, err := c.request(ctx, http.MethodPut, target, bytes.NewReader(buildICS(ev, uid, c.now())))
+	if err != nil {
+		return ev, err
+	}
+	req.Header.Set("Content-Type", "text/calendar; charset=utf-8")
+	req.Header.Set("If-None-Match", "*")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return ev, fmt.Errorf("caldav put: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return ev, fmt.Errorf("caldav put: %w", statusError(resp))
+	}
+	ev.ID = uid
+	ev.URL = target
+	return ev, nil
+}
+
+func (c *CalDAV) request(ctx context.Context, method, target string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	if c.cfg.Username != "" {
+		req.SetBasicAuth(c.cfg.Username, c.cfg.Password)
+	}
+	return req, nil
+}
+
+// resolve turns a multistatus href (usually an absolute path) into a URL.
+func (c *CalDAV) resolve(href string) string {
+	base, err := url.Parse(c.cfg.URL)
+	if err != nil {
+		return href
+	}
+	ref, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	return base.ResolveReference(ref).String()
+}
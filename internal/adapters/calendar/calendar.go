@@ -0,0 +1,42 @@
+// Package calendar implements ports.CalendarProvider for CalDAV servers and
+// Google Calendar.
+package calendar
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+const requestTimeout = 30 * time.Second
+
+// New builds the provider selected by cfg.Kind, or nil when the calendar is
+// disabled.
+func New(cfg domain.CalendarConfig) (ports.CalendarProvider, error) {
+	switch cfg.Kind {
+	case "":
+		return nil, nil
+	case domain.CalendarKindCalDAV:
+		return NewCalDAV(cfg.CalDAV, cfg.Location()), nil
+	case domain.CalendarKindGoogle:
+		return NewGoogle(cfg.Google), nil
+	default:
+		return nil, fmt.Errorf("unsupported calendar kind %q", cfg.Kind)
+	}
+}
+
+// statusError describes a failed response, including the start of its body
+// since calendar servers explain rejections there.
+func statusError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	msg := strings.TrimSpace(string(body))
+	if msg == "" {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return fmt.Errorf("status %d: %s", resp.StatusCode, msg)
+}
@@ -0,0 +1,164 @@
+package calendar
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func TestICSRoundTrip(t *testing.T) {
+	ev := domain.CalendarEvent{
+		Title:       "Planning; Q3, budget",
+		Description: "Agenda:\n- numbers\n- " + strings.Repeat("long ", 30),
+		Location:    "Room 2",
+		Start:       time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC),
+		End:         time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC),
+		Attendees:   []string{"ana@example.com"},
+	}
+	raw := buildICS(ev, "uid-1", time.Now())
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+
+	events, err := parseICS(string(raw), time.UTC)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	got := events[0]
+	assert.Equal(t, "uid-1", got.ID)
+	assert.Equal(t, ev.Title, got.Title)
+	assert.Equal(t, ev.Description, got.Description)
+	assert.True(t, ev.Start.Equal(got.Start))
+	assert.True(t, ev.End.Equal(got.End))
+	assert.Equal(t, ev.Attendees, got.Attendees)
+}
+
+func TestParseICSDatesAndAlarms(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:b\r\nSUMMARY:Holiday\r\nDTSTART;VALUE=DATE:20260401\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:a\r\nSUMMARY:Standup\r\nDTSTART;TZID=\"Custom/Zone\":20260331T090000\r\nDTEND:20260331T091500Z\r\n" +
+		"BEGIN:VALARM\r\nDESCRIPTION:ignored\r\nEND:VALARM\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	loc := time.FixedZone("test", 3600)
+
+	events, err := parseICS(data, loc)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "Standup", events[0].Title)
+	assert.Empty(t, events[0].Description)
+	assert.True(t, events[0].Start.Equal(time.Date(2026, 3, 31, 8, 0, 0, 0, time.UTC)), "unknown TZID falls back to loc")
+	assert.True(t, events[1].AllDay)
+	assert.Equal(t, 24*time.Hour, events[1].End.Sub(events[1].Start))
+}
+
+func TestCalDAVListAndCreate(t *testing.T) {
+	var put string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		if user != "me" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case "REPORT":
+			body, _ := io.ReadAll(r.Body)
+			assert.Contains(t, string(body), `start="20260301T000000Z"`)
+			assert.Equal(t, "1", r.Header.Get("Depth"))
+			w.WriteHeader(http.StatusMultiStatus)
+			io.WriteString(w, `<?xml version="1.0"?>
+<d:multistatus xmlns:d="DAV:" xmlns:cal="urn:ietf:params:xml:ns:caldav">
+ <d:response><d:href>/cal/work/x.ics</d:href>
+  <d:propstat><d:prop><cal:calendar-data>BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:x
+SUMMARY:Review
+DTSTART:20260302T100000Z
+DTEND:20260302T110000Z
+END:VEVENT
+END:VCALENDAR
+</cal:calendar-data></d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat>
+ </d:response>
+</d:multistatus>`)
+		case http.MethodPut:
+			assert.Equal(t, "*", r.Header.Get("If-None-Match"))
+			body, _ := io.ReadAll(r.Body)
+			put = r.URL.Path + "\n" + string(body)
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewCalDAV(domain.CalDAVConfig{URL: srv.URL + "/cal/work", Username: "me", Password: "secret"}, time.UTC)
+	ctx := context.Background()
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	events, err := c.ListEvents(ctx, from, from.AddDate(0, 0, 7))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "Review", events[0].Title)
+	assert.Equal(t, srv.URL+"/cal/work/x.ics", events[0].URL)
+
+	created, err := c.CreateEvent(ctx, domain.CalendarEvent{Title: "1:1", Start: from, End: from.Add(30 * time.Minute)})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.True(t, strings.HasPrefix(put, "/cal/work/"+created.ID+".ics\n"))
+	assert.Contains(t, put, "SUMMARY:1:1")
+}
+
+func TestGoogleRefreshesTokenAndCreates(t *testing.T) {
+	tokenCalls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			tokenCalls++
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "refresh-1", r.Form.Get("refresh_token"))
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "tok", "expires_in": 3600})
+			return
+		}
+		assert.Equal(t, "Bearer tok", r.Header.Get("Authorization"))
+		assert.Equal(t, "/calendars/team@group/events", r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "true", r.URL.Query().Get("singleEvents"))
+			io.WriteString(w, `{"items":[
+				{"id":"1","summary":"Offsite","start":{"date":"2026-03-05"},"end":{"date":"2026-03-07"}},
+				{"id":"2","summary":"Sync","start":{"dateTime":"2026-03-05T10:00:00-03:00"},"end":{"dateTime":"2026-03-05T10:30:00-03:00"},"attendees":[{"email":"a@x"}]}
+			]}`)
+		case http.MethodPost:
+			assert.Equal(t, "all", r.URL.Query().Get("sendUpdates"))
+			var body googleEvent
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "Lunch", body.Summary)
+			assert.Equal(t, "2026-03-06T12:00:00Z", body.Start.DateTime)
+			io.WriteString(w, `{"id":"new1","htmlLink":"https://calendar.example/e/new1"}`)
+		}
+	}))
+	defer srv.Close()
+
+	g := NewGoogle(domain.GoogleCalendarConfig{CalendarID: "team@group", ClientID: "c", ClientSecret: "s", RefreshToken: "refresh-1"})
+	g.tokenURL = srv.URL + "/token"
+	g.apiURL = srv.URL
+	ctx := context.Background()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	events, err := g.ListEvents(ctx, from, from.AddDate(0, 0, 7))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.True(t, events[0].AllDay)
+	assert.Equal(t, []string{"a@x"}, events[1].Attendees)
+	assert.True(t, events[1].Start.Equal(time.Date(2026, 3, 5, 13, 0, 0, 0, time.UTC)))
+
+	start := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+	created, err := g.CreateEvent(ctx, domain.CalendarEvent{Title: "Lunch", Start: start, End: start.Add(time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, "new1", created.ID)
+	assert.Equal(t, "https://calendar.example/e/new1", created.URL)
+	assert.Equal(t, 1, tokenCalls, "access token is cached")
+}
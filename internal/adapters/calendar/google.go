@@ -0,0 +1,231 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	googleTokenURL  = "https://oauth2.googleapis.com/token"
+	googleAPIURL    = "https://www.googleapis.com/calendar/v3"
+	googleMaxEvents = 500
+)
+
+// Google uses the Calendar v3 REST API. Access tokens are minted from the
+// stored OAuth refresh token and cached until shortly before they expire.
+type Google struct {
+	cfg      domain.GoogleCalendarConfig
+	client   *http.Client
+	tokenURL string
+	apiURL   string
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func NewGoogle(cfg domain.GoogleCalendarConfig) *Google {
+	if cfg.CalendarID == "" {
+		cfg.CalendarID = "primary"
+	}
+	return &Google{
+		cfg:      cfg,
+		client:   &http.Client{Timeout: requestTimeout},
+		tokenURL: googleTokenURL,
+		apiURL:   googleAPIURL,
+	}
+}
+
+type googleTime struct {
+	DateTime string `json:"dateTime,omitempty"`
+	Date     string `json:"date,omitempty"`
+}
+
+type googleEvent struct {
+	ID          string     `json:"id,omitempty"`
+	Summary     string     `json:"summary"`
+	Description string     `json:"description,omitempty"`
+	Location    string     `json:"location,omitempty"`
+	HTMLLink    string     `json:"htmlLink,omitempty"`
+	Start       googleTime `json:"start"`
+	End         googleTime `json:"end"`
+	Attendees   []struct {
+		Email string `json:"email"`
+	} `json:"attendees,omitempty"`
+}
+
+// ListEvents implements ports.CalendarProvider.
+func (g *Google) ListEvents(ctx context.Context, from, to time.Time) ([]domain.CalendarEvent, error) {
+	q := url.Values{
+		"timeMin":      {from.UTC().Format(time.RFC3339)},
+		"timeMax":      {to.UTC().Format(time.RFC3339)},
+		"singleEvents": {"true"},
+		"orderBy":      {"startTime"},
+		"maxResults":   {"250"},
+	}
+	var events []domain.CalendarEvent
+	for {
+		var page struct {
+			Items         []googleEvent `json:"items"`
+			NextPageToken string        `json:"nextPageToken"`
+		}
+		if err := g.do(ctx, http.MethodGet, g.eventsURL()+"?"+q.Encode(), nil, &page); err != nil {
+			return nil, fmt.Errorf("google calendar list: %w", err)
+		}
+		for _, item := range page.Items {
+			ev, err := item.toDomain()
+			if err != nil {
+				return nil, fmt.Errorf("google calendar event %s: %w", item.ID, err)
+			}
+			events = append(events, ev)
+		}
+		if page.NextPageToken == "" || len(events) >= googleMaxEvents {
+			return events, nil
+		}
+		q.Set("pageToken", page.NextPageToken)
+	}
+}
+
+// CreateEvent implements ports.CalendarProvider. Attendees are sent
+// invitations.
+func (g *Google) CreateEvent(ctx context.Context, ev domain.CalendarEvent) (domain.CalendarEvent, error) {
+	body := googleEvent{
+		Summary:     ev.Title,
+		Description: ev.Description,
+		Location:    ev.Location,
+	}
+	if ev.AllDay {
+		body.Start.Date = ev.Start.Format(time.DateOnly)
+		body.End.Date = ev.End.Format(time.DateOnly)
+	} else {
+		body.Start.DateTime = ev.Start.Format(time.RFC3339)
+		body.End.DateTime = ev.End.Format(time.RFC3339)
+	}
+	for _, a := range ev.Attendees {
+		body.Attendees = append(body.Attendees, struct {
+			Email string `json:"email"`
+		}{a})
+	}
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return ev, err
+	}
+
+	var created googleEvent
+	if err := g.do(ctx, http.MethodPost, g.eventsURL()+"?sendUpdates=all", raw, &created); err != nil {
+		return ev, fmt.Errorf("google calendar create: %w", err)
+	}
+	ev.ID = created.ID
+	ev.URL = created.HTMLLink
+	return ev, nil
+}
+
+func (g *Google) eventsURL() string {
+	return g.apiURL + "/calendars/" + url.PathEscape(g.cfg.CalendarID) + "/events"
+}
+
+func (g *Google) do(ctx context.Context, method, target string, body []byte, out interface{}) error {
+	token, err := g.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized {
+		g.mu.Lock()
+		g.token = "" // revoked or expired early; refresh on the next call
+		g.mu.Unlock()
+	}
+	if resp.StatusCode/100 != 2 {
+		return statusError(resp)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// accessToken returns a cached access token, refreshing it when it is
+// about to expire.
+func (g *Google) accessToken(ctx context.Context) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.token != "" && time.Now().Before(g.expires) {
+		return g.token, nil
+	}
+
+	form := url.Values{
+		"client_id":     {g.cfg.ClientID},
+		"client_secret": {g.cfg.ClientSecret},
+		"refresh_token": {g.cfg.RefreshToken},
+		"grant_type":    {"refresh_token"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("google token refresh: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("google token refresh: %w", statusError(resp))
+	}
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil || tok.AccessToken == "" {
+		return "", fmt.Errorf("google token refresh: invalid response")
+	}
+	g.token = tok.AccessToken
+	g.expires = time.Now().Add(time.Duration(tok.ExpiresIn)*time.Second - time.Minute)
+	return g.token, nil
+}
+
+func (e googleEvent) toDomain() (domain.CalendarEvent, error) {
+	ev := domain.CalendarEvent{
+		ID:          e.ID,
+		Title:       e.Summary,
+		Description: e.Description,
+		Location:    e.Location,
+		URL:         e.HTMLLink,
+	}
+	for _, a := range e.Attendees {
+		ev.Attendees = append(ev.Attendees, a.Email)
+	}
+	var err error
+	if e.Start.Date != "" {
+		ev.AllDay = true
+		if ev.Start, err = time.Parse(time.DateOnly, e.Start.Date); err != nil {
+			return ev, err
+		}
+		ev.End, err = time.Parse(time.DateOnly, e.End.Date)
+		return ev, err
+	}
+	if ev.Start, err = time.Parse(time.RFC3339, e.Start.DateTime); err != nil {
+		return ev, err
+	}
+	ev.End, err = time.Parse(time.RFC3339, e.End.DateTime)
+	return ev, err
+}
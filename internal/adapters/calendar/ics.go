@@ -0,0 +1,220 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	icsUTC  = "20060102T150405Z"
+	icsTime = "20060102T150405"
+	icsDate = "20060102"
+)
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`, "\r", "")
+
+// buildICS renders ev as a VCALENDAR holding a single VEVENT with the given
+// UID. Times are written in UTC.
+func buildICS(ev domain.CalendarEvent, uid string, now time.Time) []byte {
+	var b strings.Builder
+	line := func(s string) { b.WriteString(foldICS(s)) }
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//auleOS//calendar//EN")
+	line("BEGIN:VEVENT")
+	line("UID:" + uid)
+	line("DTSTAMP:" + now.UTC().Format(icsUTC))
+	if ev.AllDay {
+		line("DTSTART;VALUE=DATE:" + ev.Start.Format(icsDate))
+		line("DTEND;VALUE=DATE:" + ev.End.Format(icsDate))
+	} else {
+		line("DTSTART:" + ev.Start.UTC().Format(icsUTC))
+		line("DTEND:" + ev.End.UTC().Format(icsUTC))
+	}
+	line("SUMMARY:" + icsEscaper.Replace(ev.Title))
+	if ev.Description != "" {
+		line("DESCRIPTION:" + icsEscaper.Replace(ev.Description))
+	}
+	if ev.Location != "" {
+		line("LOCATION:" + icsEscaper.Replace(ev.Location))
+	}
+	for _, a := range ev.Attendees {
+		line("ATTENDEE;RSVP=TRUE:mailto:" + a)
+	}
+	line("END:VEVENT")
+	line("END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// foldICS terminates a content line, folding it at 75 octets without
+// splitting UTF-8 sequences (RFC 5545 3.1).
+func foldICS(s string) string {
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		n := len(string(r))
+		if width+n > 75 {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += n
+	}
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+// icsProp is one unfolded content line: NAME;PARAM=V:value.
+type icsProp struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICS returns the VEVENTs in an iCalendar document, sorted by start.
+// Times with a TZID are interpreted in that zone when it is known, else
+// in loc.
+func parseICS(data string, loc *time.Location) ([]domain.CalendarEvent, error) {
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\n ", "")
+	data = strings.ReplaceAll(data, "\n\t", "")
+
+	var events []domain.CalendarEvent
+	var cur *domain.CalendarEvent
+	depth := 0 // nested components inside the VEVENT (VALARM)
+	for _, raw := range strings.Split(data, "\n") {
+		if raw == "" {
+			continue
+		}
+		p := parseICSLine(raw)
+		switch {
+		case p.name == "BEGIN" && p.value == "VEVENT":
+			cur = &domain.CalendarEvent{}
+			depth = 0
+			continue
+		case cur == nil:
+			continue
+		case p.name == "BEGIN":
+			depth++
+			continue
+		case p.name == "END" && p.value == "VEVENT":
+			if cur.End.IsZero() {
+				cur.End = cur.Start
+				if cur.AllDay {
+					cur.End = cur.Start.AddDate(0, 0, 1)
+				}
+			}
+			events = append(events, *cur)
+			cur = nil
+			continue
+		case p.name == "END":
+			depth--
+			continue
+		case depth > 0:
+			continue
+		}
+
+		switch p.name {
+		case "UID":
+			cur.ID = p.value
+		case "SUMMARY":
+			cur.Title = unescapeICS(p.value)
+		case "DESCRIPTION":
+			cur.Description = unescapeICS(p.value)
+		case "LOCATION":
+			cur.Location = unescapeICS(p.value)
+		case "URL":
+			cur.URL = p.value
+		case "ATTENDEE":
+			if addr, ok := cutPrefixFold(p.value, "mailto:"); ok {
+				cur.Attendees = append(cur.Attendees, addr)
+			}
+		case "DTSTART", "DTEND":
+			t, allDay, err := parseICSTime(p, loc)
+			if err != nil {
+				return nil, fmt.Errorf("event %q: %s: %w", cur.ID, p.name, err)
+			}
+			if p.name == "DTSTART" {
+				cur.Start, cur.AllDay = t, allDay
+			} else {
+				cur.End = t
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	return events, nil
+}
+
+func parseICSLine(line string) icsProp {
+	// The value starts at the first colon outside a quoted parameter value
+	inQuote := false
+	colon := -1
+	for i, c := range line {
+		if c == '"' {
+			inQuote = !inQuote
+		} else if c == ':' && !inQuote {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return icsProp{name: strings.ToUpper(line)}
+	}
+	head := strings.Split(line[:colon], ";")
+	p := icsProp{name: strings.ToUpper(head[0]), params: map[string]string{}, value: line[colon+1:]}
+	for _, param := range head[1:] {
+		if k, v, ok := strings.Cut(param, "="); ok {
+			p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return p
+}
+
+func parseICSTime(p icsProp, loc *time.Location) (time.Time, bool, error) {
+	v := p.value
+	if p.params["VALUE"] == "DATE" || len(v) == len(icsDate) {
+		t, err := time.ParseInLocation(icsDate, v, loc)
+		return t, true, err
+	}
+	if strings.HasSuffix(v, "Z") {
+		t, err := time.Parse(icsUTC, v)
+		return t, false, err
+	}
+	if tzid := p.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation(icsTime, v, loc)
+	return t, false, err
+}
+
+func unescapeICS(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n', 'N':
+				b.WriteByte('\n')
+			default:
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+		return s[len(prefix):], true
+	}
+	return s, false
+}
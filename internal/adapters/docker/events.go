@@ -0,0 +1,74 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+var _ ports.WorkerEventSource = (*Manager)(nil)
+
+// WatchEvents streams lifecycle events of aule.managed containers from the
+// Docker daemon.
+func (m *Manager) WatchEvents(ctx context.Context, fn func(domain.WorkerEvent)) error {
+	args := filters.NewArgs()
+	args.Add("type", string(events.ContainerEventType))
+	args.Add("label", "aule.managed=true")
+	for _, a := range []events.Action{events.ActionStart, events.ActionDie, events.ActionOOM, events.ActionDestroy} {
+		args.Add("event", string(a))
+	}
+
+	msgs, errs := m.cli.Events(ctx, events.ListOptions{Filters: args})
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			if ctx.Err() != nil {
+				return nil
+			}
+			if err == nil {
+				err = errors.New("stream closed")
+			}
+			return fmt.Errorf("docker event stream: %w", err)
+		case msg := <-msgs:
+			if ev, ok := workerEvent(msg); ok {
+				fn(ev)
+			}
+		}
+	}
+}
+
+// workerEvent maps a Docker container event to a worker event.
+func workerEvent(msg events.Message) (domain.WorkerEvent, bool) {
+	id := msg.Actor.Attributes["aule.job_id"]
+	if id == "" {
+		return domain.WorkerEvent{}, false
+	}
+	ev := domain.WorkerEvent{
+		WorkerID: domain.WorkerID(id),
+		Action:   string(msg.Action),
+		Time:     time.Unix(0, msg.TimeNano),
+	}
+	switch msg.Action {
+	case events.ActionStart:
+		ev.Status = domain.HealthStatusStarting // healthy once the watchdog answers
+	case events.ActionDie, events.ActionDestroy:
+		ev.Status = domain.HealthStatusExited
+		if code, err := strconv.Atoi(msg.Actor.Attributes["exitCode"]); err == nil {
+			ev.ExitCode = &code
+		}
+	case events.ActionOOM:
+		ev.Status = domain.HealthStatusUnhealthy // a "die" follows if the process was killed
+	default:
+		return domain.WorkerEvent{}, false
+	}
+	return ev, true
+}
@@ -0,0 +1,31 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerEvent(t *testing.T) {
+	ev, ok := workerEvent(events.Message{
+		Action: events.ActionDie,
+		Actor:  events.Actor{Attributes: map[string]string{"aule.job_id": "w1", "exitCode": "137"}},
+	})
+	require.True(t, ok)
+	assert.Equal(t, domain.WorkerID("w1"), ev.WorkerID)
+	assert.True(t, ev.Exited())
+	require.NotNil(t, ev.ExitCode)
+	assert.Equal(t, 137, *ev.ExitCode)
+
+	ev, ok = workerEvent(events.Message{Action: events.ActionStart, Actor: events.Actor{Attributes: map[string]string{"aule.job_id": "w1"}}})
+	require.True(t, ok)
+	assert.Equal(t, domain.HealthStatusStarting, ev.Status)
+
+	_, ok = workerEvent(events.Message{Action: events.ActionDie, Actor: events.Actor{Attributes: map[string]string{}}})
+	assert.False(t, ok, "containers without a worker label are ignored")
+	_, ok = workerEvent(events.Message{Action: events.ActionPause, Actor: events.Actor{Attributes: map[string]string{"aule.job_id": "w1"}}})
+	assert.False(t, ok)
+}
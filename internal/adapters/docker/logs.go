@@ -0,0 +1,142 @@
+package docker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+var _ ports.WorkerLogReader = (*Manager)(nil)
+
+// TailLogs returns the last tail lines a worker wrote, without following.
+// It works on exited workers as long as the container still exists.
+func (m *Manager) TailLogs(ctx context.Context, id domain.WorkerID, tail int) ([]domain.WorkerLogLine, error) {
+	cID := "aule-worker-" + string(id)
+	rc, err := m.cli.ContainerLogs(ctx, cID, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Tail:       strconv.Itoa(domain.ClampWorkerLogTail(tail)),
+	})
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return nil, domain.ErrWorkerNotFound
+		}
+		return nil, fmt.Errorf("failed to read container logs: %w", err)
+	}
+	defer rc.Close()
+	return demuxLogs(rc)
+}
+
+// demuxLogs splits Docker's multiplexed log stream (8-byte frame headers
+// carrying the stream and length) into lines, keeping the order in which
+// they were written. Lines longer than domain.MaxWorkerLogLineBytes are cut
+// so a runaway line cannot exhaust memory.
+func demuxLogs(r io.Reader) ([]domain.WorkerLogLine, error) {
+	var (
+		lines   []domain.WorkerLogLine
+		partial = map[string]*lineBuffer{}
+		br      = bufio.NewReader(r)
+		header  [8]byte
+		chunk   = make([]byte, 32*1024)
+	)
+	emit := func(stream string, raw string) {
+		line := domain.WorkerLogLine{Stream: stream, Text: raw}
+		if ts, rest, ok := strings.Cut(raw, " "); ok {
+			if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+				line.Time, line.Text = t, rest
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	for {
+		if _, err := io.ReadFull(br, header[:]); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return lines, err
+		}
+		stream := "stdout"
+		if header[0] == 2 {
+			stream = "stderr"
+		}
+		buf := partial[stream]
+		if buf == nil {
+			buf = &lineBuffer{}
+			partial[stream] = buf
+		}
+		frame := io.LimitReader(br, int64(binary.BigEndian.Uint32(header[4:])))
+		for {
+			n, err := frame.Read(chunk)
+			for _, text := range buf.write(chunk[:n]) {
+				emit(stream, text)
+			}
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return lines, err
+			}
+		}
+	}
+	for _, stream := range []string{"stdout", "stderr"} {
+		if buf := partial[stream]; buf != nil && buf.len() > 0 {
+			emit(stream, buf.flush())
+		}
+	}
+	return lines, nil
+}
+
+// lineBuffer collects a stream's bytes until newlines complete them,
+// keeping at most domain.MaxWorkerLogLineBytes of each line.
+type lineBuffer struct {
+	sb  strings.Builder
+	cut bool
+}
+
+func (b *lineBuffer) write(p []byte) []string {
+	var done []string
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		part := p
+		if i >= 0 {
+			part = p[:i]
+		}
+		if room := domain.MaxWorkerLogLineBytes - b.sb.Len(); room < len(part) {
+			part = part[:max(room, 0)]
+			b.cut = true
+		}
+		b.sb.Write(part)
+		if i < 0 {
+			break
+		}
+		done = append(done, b.flush())
+		p = p[i+1:]
+	}
+	return done
+}
+
+func (b *lineBuffer) len() int { return b.sb.Len() }
+
+func (b *lineBuffer) flush() string {
+	s := strings.TrimSuffix(b.sb.String(), "\r")
+	if b.cut {
+		s = strings.ToValidUTF8(s, "") + "…"
+	}
+	b.sb.Reset()
+	b.cut = false
+	return s
+}
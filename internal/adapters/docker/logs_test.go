@@ -0,0 +1,52 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func frame(stream byte, payload string) []byte {
+	hdr := make([]byte, 8)
+	hdr[0] = stream
+	binary.BigEndian.PutUint32(hdr[4:], uint32(len(payload)))
+	return append(hdr, payload...)
+}
+
+func TestDemuxLogs(t *testing.T) {
+	var buf bytes.Buffer
+	buf.Write(frame(1, "2026-01-02T03:04:05.000000006Z starting\n"))
+	buf.Write(frame(2, "2026-01-02T03:04:06Z warn: low "))
+	buf.Write(frame(2, "memory\n"))
+	buf.Write(frame(1, "no timestamp\r\n"))
+	buf.Write(frame(1, strings.Repeat("x", domain.MaxWorkerLogLineBytes+10)+"\n"))
+	buf.Write(frame(1, "unterminated"))
+
+	lines, err := demuxLogs(&buf)
+	require.NoError(t, err)
+	require.Len(t, lines, 5)
+
+	assert.Equal(t, domain.WorkerLogLine{
+		Time: time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC), Stream: "stdout", Text: "starting",
+	}, lines[0])
+	assert.Equal(t, "stderr", lines[1].Stream)
+	assert.Equal(t, "warn: low memory", lines[1].Text, "lines split across frames are joined")
+	assert.Equal(t, "no timestamp", lines[2].Text)
+	assert.True(t, lines[2].Time.IsZero())
+	assert.Len(t, lines[3].Text, domain.MaxWorkerLogLineBytes+len("…"))
+	assert.Equal(t, "unterminated", lines[4].Text)
+}
+
+func TestDemuxLogs_TruncatedFrame(t *testing.T) {
+	data := frame(1, "complete\npartial line\n")
+	lines, err := demuxLogs(bytes.NewReader(data[:len(data)-6]))
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "partial", lines[1].Text)
+}
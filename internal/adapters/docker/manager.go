@@ -2,12 +2,14 @@ package docker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/docker/docker/api/types/container"
@@ -31,6 +33,12 @@ type Manager struct {
 	baseSocketDir    string
 	baseWorkspaceDir string
 	hostUser         string
+	defaultSeccomp   string // seccomp profile JSON; empty = runtime default
+	security         domain.WorkerSecurityPolicy
+	seccompProfiles  map[string]string // policy profile name → JSON
+
+	policyMu sync.RWMutex
+	images   domain.ImagePolicy
 }
 
 // NewManager creates a new Docker manager
@@ -58,8 +66,32 @@ func NewManager() (*Manager, error) {
 
 // Ensure Manager implements WorkerManager
 var _ ports.WorkerManager = (*Manager)(nil)
+var _ ports.ProgressReader = (*Manager)(nil)
+var _ ports.RuntimeStatsReader = (*Manager)(nil)
+var _ ports.WorkerInspector = (*Manager)(nil)
+
+// SetImagePolicy replaces the image policy enforced on every spawn.
+func (m *Manager) SetImagePolicy(p domain.ImagePolicy) {
+	m.policyMu.Lock()
+	m.images = p
+	m.policyMu.Unlock()
+}
+
+func (m *Manager) imagePolicy() domain.ImagePolicy {
+	m.policyMu.RLock()
+	defer m.policyMu.RUnlock()
+	return m.images
+}
 
 func (m *Manager) Spawn(ctx context.Context, spec domain.WorkerSpec) (domain.WorkerID, error) {
+	// 0. Image policy — shells, builds and media workers come through here too
+	policy := m.imagePolicy()
+	img, err := policy.Resolve(spec.Image)
+	if err != nil {
+		return "", err
+	}
+	spec.Image = img
+
 	id := domain.WorkerID(uuid.New().String())
 
 	// 1. Prepare Host Directories
@@ -82,6 +114,7 @@ func (m *Manager) Spawn(ctx context.Context, spec domain.WorkerSpec) (domain.Wor
 	// Convert Env map to slice
 	envSlice := []string{
 		fmt.Sprintf("WATCHDOG_SOCKET_PATH=%s/%s", containerSockDir, watchdogSockName),
+		"AULE_PROGRESS_FILE=/workspace/" + domain.WorkerProgressFile,
 		"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
 	}
 	for k, v := range spec.Env {
@@ -117,6 +150,10 @@ func (m *Manager) Spawn(ctx context.Context, spec domain.WorkerSpec) (domain.Wor
 	for hostPath, containerPath := range spec.BindMounts {
 		binds = append(binds, fmt.Sprintf("%s:%s:ro", hostPath, containerPath)) // Default to ReadOnly for safety
 	}
+	// 3. Writable output mounts — only for dirs the kernel created for this job
+	for hostPath, containerPath := range spec.WritableMounts {
+		binds = append(binds, fmt.Sprintf("%s:%s:rw", hostPath, containerPath))
+	}
 
 	hostCfg := &container.HostConfig{
 		NetworkMode: "none", // STRICT SECURITY RULE
@@ -132,32 +169,21 @@ func (m *Manager) Spawn(ctx context.Context, spec domain.WorkerSpec) (domain.Wor
 		},
 	}
 
-	netCfg := &network.NetworkingConfig{} // None
-
-	// 3. Create Container
-	// We might need to pull image first if not present, but for now assuming it exists or implicit pull
-	// (Client.ContainerCreate doesn't auto-pull, usually. But let's assume images are pre-pulled for M1)
-
-	resp, err := m.cli.ContainerCreate(ctx, cfg, hostCfg, netCfg, nil, "aule-worker-"+string(id))
-	if client.IsErrNotFound(err) {
-		// Try to pull
-		reader, pullErr := m.cli.ImagePull(ctx, spec.Image, image.PullOptions{})
-		if pullErr != nil {
-			m.cleanup(socketDir, workspaceDir)
-			return "", fmt.Errorf("failed to pull image %s: %w", spec.Image, pullErr)
-		}
-		io.Copy(io.Discard, reader)
-		reader.Close()
-		// Retry create
-		resp, err = m.cli.ContainerCreate(ctx, cfg, hostCfg, netCfg, nil, "aule-worker-"+string(id))
+	if err := m.harden(hostCfg, spec.Security); err != nil {
+		m.cleanup(socketDir, workspaceDir)
+		return "", err
 	}
 
+	netCfg := &network.NetworkingConfig{} // None
+
+	// 4. Create Container
+	resp, err := m.createContainer(ctx, policy.Pull(), cfg, hostCfg, netCfg, "aule-worker-"+string(id))
 	if err != nil {
 		m.cleanup(socketDir, workspaceDir)
 		return "", fmt.Errorf("failed to create container: %w", err)
 	}
 
-	// 4. Start
+	// 5. Start
 	if err := m.cli.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
 		_ = m.cli.ContainerRemove(ctx, resp.ID, container.RemoveOptions{Force: true})
 		m.cleanup(socketDir, workspaceDir)
@@ -172,6 +198,38 @@ func (m *Manager) Spawn(ctx context.Context, spec domain.WorkerSpec) (domain.Wor
 	return id, nil
 }
 
+// createContainer creates a container, pulling its image as pull allows
+// (ContainerCreate does not pull by itself).
+func (m *Manager) createContainer(ctx context.Context, pull domain.PullPolicy, cfg *container.Config, hostCfg *container.HostConfig, netCfg *network.NetworkingConfig, name string) (container.CreateResponse, error) {
+	if pull == domain.PullAlways {
+		if err := m.pullImage(ctx, cfg.Image); err != nil {
+			return container.CreateResponse{}, err
+		}
+	}
+	resp, err := m.cli.ContainerCreate(ctx, cfg, hostCfg, netCfg, nil, name)
+	if !client.IsErrNotFound(err) {
+		return resp, err
+	}
+	if pull == domain.PullNever {
+		return resp, fmt.Errorf("image %s is not present and pull_policy is never: %w", cfg.Image, err)
+	}
+	if err := m.pullImage(ctx, cfg.Image); err != nil {
+		return resp, err
+	}
+	return m.cli.ContainerCreate(ctx, cfg, hostCfg, netCfg, nil, name)
+}
+
+// pullImage pulls ref and waits for the pull to finish.
+func (m *Manager) pullImage(ctx context.Context, ref string) error {
+	reader, err := m.cli.ImagePull(ctx, ref, image.PullOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", ref, err)
+	}
+	defer reader.Close()
+	_, err = io.Copy(io.Discard, reader)
+	return err
+}
+
 func (m *Manager) cleanup(paths ...string) {
 	for _, p := range paths {
 		_ = os.RemoveAll(p)
@@ -316,3 +374,147 @@ func (m *Manager) GetWorkerIP(ctx context.Context, workerID domain.WorkerID) (st
 
 	return "", fmt.Errorf("no IP address found for worker %s", workerID)
 }
+
+// ReadProgress reads the progress file the worker writes into its /workspace.
+func (m *Manager) ReadProgress(ctx context.Context, id domain.WorkerID) (domain.WorkerProgress, error) {
+	raw, err := os.ReadFile(filepath.Join(m.baseWorkspaceDir, string(id), domain.WorkerProgressFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return domain.WorkerProgress{}, domain.ErrNoProgress
+		}
+		return domain.WorkerProgress{}, fmt.Errorf("failed to read progress file: %w", err)
+	}
+
+	var p domain.WorkerProgress
+	if err := json.Unmarshal(raw, &p); err != nil {
+		// Likely caught mid-write; the next poll will see the full document
+		return domain.WorkerProgress{}, domain.ErrNoProgress
+	}
+	if p.Percent < 0 {
+		p.Percent = 0
+	}
+	if p.Percent > 100 {
+		p.Percent = 100
+	}
+	return p, nil
+}
+
+// Inspect reports the container state of a worker, with a resource sample
+// while it runs.
+func (m *Manager) Inspect(ctx context.Context, id domain.WorkerID) (domain.WorkerInspection, error) {
+	cID := "aule-worker-" + string(id)
+	inspect, err := m.cli.ContainerInspect(ctx, cID)
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return domain.WorkerInspection{}, domain.ErrWorkerNotFound
+		}
+		return domain.WorkerInspection{}, fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	out := domain.WorkerInspection{
+		ContainerID:  inspect.ID,
+		Image:        inspect.Image,
+		RestartCount: inspect.RestartCount,
+		Mounts:       make([]domain.WorkerMount, 0, len(inspect.Mounts)),
+	}
+	if inspect.Config != nil {
+		out.Image = inspect.Config.Image
+	}
+	for _, mp := range inspect.Mounts {
+		out.Mounts = append(out.Mounts, domain.WorkerMount{Source: mp.Source, Destination: mp.Destination, ReadOnly: !mp.RW})
+	}
+	if st := inspect.State; st != nil {
+		out.State = string(st.Status)
+		out.Running = st.Running
+		out.OOMKilled = st.OOMKilled
+		out.Error = st.Error
+		out.StartedAt = parseDockerTime(st.StartedAt)
+		out.FinishedAt = parseDockerTime(st.FinishedAt)
+		if st.Running {
+			if !out.StartedAt.IsZero() {
+				out.UptimeSeconds = int64(time.Since(out.StartedAt).Seconds())
+			}
+		} else if !out.FinishedAt.IsZero() {
+			code := st.ExitCode
+			out.ExitCode = &code
+		}
+	}
+
+	if out.Running {
+		res, err := m.sampleResources(ctx, cID)
+		if err != nil {
+			// The container may have exited between the two calls
+			return out, nil
+		}
+		out.Resources = &res
+	}
+	return out, nil
+}
+
+// sampleResources takes one stats reading; the daemon includes the
+// previous CPU reading so usage can be computed from a single call.
+func (m *Manager) sampleResources(ctx context.Context, cID string) (domain.WorkerResources, error) {
+	resp, err := m.cli.ContainerStats(ctx, cID, false)
+	if err != nil {
+		return domain.WorkerResources{}, err
+	}
+	defer resp.Body.Close()
+
+	var st container.StatsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&st); err != nil {
+		return domain.WorkerResources{}, fmt.Errorf("failed to decode stats: %w", err)
+	}
+
+	res := domain.WorkerResources{
+		MemoryBytes:      st.MemoryStats.Usage,
+		MemoryLimitBytes: st.MemoryStats.Limit,
+		PIDs:             st.PidsStats.Current,
+	}
+	// Page cache is reclaimable; report it the way `docker stats` does
+	if cache, ok := st.MemoryStats.Stats["inactive_file"]; ok && cache < res.MemoryBytes {
+		res.MemoryBytes -= cache
+	}
+	cpuDelta := float64(st.CPUStats.CPUUsage.TotalUsage) - float64(st.PreCPUStats.CPUUsage.TotalUsage)
+	sysDelta := float64(st.CPUStats.SystemUsage) - float64(st.PreCPUStats.SystemUsage)
+	if cpuDelta > 0 && sysDelta > 0 {
+		cpus := float64(st.CPUStats.OnlineCPUs)
+		if cpus == 0 {
+			cpus = float64(len(st.CPUStats.CPUUsage.PercpuUsage))
+		}
+		res.CPUPercent = cpuDelta / sysDelta * cpus * 100
+	}
+	for _, n := range st.Networks {
+		res.NetRxBytes += n.RxBytes
+		res.NetTxBytes += n.TxBytes
+	}
+	return res, nil
+}
+
+// parseDockerTime parses the RFC 3339 timestamps of container state; the
+// daemon reports "0001-01-01T00:00:00Z" for unset ones.
+func parseDockerTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil || t.Year() <= 1 {
+		return time.Time{}
+	}
+	return t
+}
+
+// RuntimeStats reports container and image counts from the Docker daemon.
+func (m *Manager) RuntimeStats(ctx context.Context) (domain.ContainerRuntimeStats, error) {
+	info, err := m.cli.Info(ctx)
+	if err != nil {
+		return domain.ContainerRuntimeStats{}, fmt.Errorf("docker info failed: %w", err)
+	}
+	return domain.ContainerRuntimeStats{
+		Available:         true,
+		Version:           info.ServerVersion,
+		Containers:        info.Containers,
+		ContainersRunning: info.ContainersRunning,
+		ContainersPaused:  info.ContainersPaused,
+		ContainersStopped: info.ContainersStopped,
+		Images:            info.Images,
+		CPUs:              info.NCPU,
+		MemoryBytes:       info.MemTotal,
+	}, nil
+}
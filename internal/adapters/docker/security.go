@@ -0,0 +1,85 @@
+package docker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SetDefaultSeccompProfile loads the seccomp profile (a Docker seccomp JSON
+// file) applied to workers and shells that do not choose their own. Without
+// one the runtime's default profile applies.
+func (m *Manager) SetDefaultSeccompProfile(path string) error {
+	profile, err := readSeccompProfile(path)
+	if err != nil {
+		return err
+	}
+	m.defaultSeccomp = profile
+	return nil
+}
+
+// SetSecurityPolicy sets what per-worker security settings may ask for and
+// loads the policy's seccomp profiles. It is operator configuration, set
+// once at startup.
+func (m *Manager) SetSecurityPolicy(p domain.WorkerSecurityPolicy) error {
+	profiles := make(map[string]string, len(p.SeccompProfiles))
+	for name, path := range p.SeccompProfiles {
+		profile, err := readSeccompProfile(path)
+		if err != nil {
+			return fmt.Errorf("seccomp profile %s: %w", name, err)
+		}
+		profiles[name] = profile
+	}
+	m.security = p
+	m.seccompProfiles = profiles
+	return nil
+}
+
+// harden applies the worker hardening to hostCfg: every capability
+// dropped except those sec adds back, no-new-privileges, and the seccomp
+// and AppArmor profiles. A nil sec is the fully hardened default.
+func (m *Manager) harden(hostCfg *container.HostConfig, sec *domain.WorkerSecurity) error {
+	if err := sec.Validate(m.security); err != nil {
+		return err
+	}
+	if sec == nil {
+		sec = &domain.WorkerSecurity{}
+	}
+
+	hostCfg.CapDrop = []string{"ALL"}
+	hostCfg.CapAdd = append([]string(nil), sec.CapAdd...)
+	if !sec.AllowPrivilegeEscalation {
+		hostCfg.SecurityOpt = append(hostCfg.SecurityOpt, "no-new-privileges:true")
+	}
+
+	// The daemon takes the profile itself, not a path
+	switch sec.SeccompProfile {
+	case domain.SeccompRuntimeDefault:
+		if m.defaultSeccomp != "" {
+			hostCfg.SecurityOpt = append(hostCfg.SecurityOpt, "seccomp="+m.defaultSeccomp)
+		}
+	case domain.SeccompUnconfined:
+		hostCfg.SecurityOpt = append(hostCfg.SecurityOpt, "seccomp=unconfined")
+	default:
+		hostCfg.SecurityOpt = append(hostCfg.SecurityOpt, "seccomp="+m.seccompProfiles[sec.SeccompProfile])
+	}
+
+	if sec.AppArmorProfile != "" {
+		hostCfg.SecurityOpt = append(hostCfg.SecurityOpt, "apparmor="+sec.AppArmorProfile)
+	}
+	return nil
+}
+
+func readSeccompProfile(path string) (string, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read seccomp profile: %w", err)
+	}
+	if !json.Valid(raw) {
+		return "", fmt.Errorf("seccomp profile %s is not valid JSON", path)
+	}
+	return string(raw), nil
+}
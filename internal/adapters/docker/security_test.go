@@ -0,0 +1,80 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHarden_Defaults(t *testing.T) {
+	m := &Manager{}
+	hostCfg := &container.HostConfig{}
+	require.NoError(t, m.harden(hostCfg, nil))
+	assert.Equal(t, []string{"ALL"}, []string(hostCfg.CapDrop))
+	assert.Empty(t, hostCfg.CapAdd)
+	assert.Equal(t, []string{"no-new-privileges:true"}, hostCfg.SecurityOpt)
+
+	profile := filepath.Join(t.TempDir(), "seccomp.json")
+	require.NoError(t, os.WriteFile(profile, []byte(`{"defaultAction":"SCMP_ACT_ERRNO"}`), 0644))
+	require.NoError(t, m.SetDefaultSeccompProfile(profile))
+	hostCfg = &container.HostConfig{}
+	require.NoError(t, m.harden(hostCfg, nil))
+	assert.Contains(t, hostCfg.SecurityOpt, `seccomp={"defaultAction":"SCMP_ACT_ERRNO"}`)
+}
+
+func TestHarden_PerWorkerOverrides(t *testing.T) {
+	profile := filepath.Join(t.TempDir(), "build.json")
+	require.NoError(t, os.WriteFile(profile, []byte(`{"defaultAction":"SCMP_ACT_LOG"}`), 0644))
+	m := &Manager{}
+	require.NoError(t, m.SetSecurityPolicy(domain.WorkerSecurityPolicy{
+		SeccompProfiles:          map[string]string{"build": profile},
+		AppArmorProfiles:         []string{"aule-build"},
+		AllowPrivilegeEscalation: true,
+	}))
+	hostCfg := &container.HostConfig{}
+	sec := &domain.WorkerSecurity{
+		CapAdd:                   []string{"cap_chown", "NET_BIND_SERVICE"},
+		SeccompProfile:           "build",
+		AppArmorProfile:          "aule-build",
+		AllowPrivilegeEscalation: true,
+	}
+	require.NoError(t, m.harden(hostCfg, sec))
+	assert.Equal(t, []string{"ALL"}, []string(hostCfg.CapDrop))
+	assert.Equal(t, []string{"CHOWN", "NET_BIND_SERVICE"}, []string(hostCfg.CapAdd))
+	assert.Equal(t, []string{`seccomp={"defaultAction":"SCMP_ACT_LOG"}`, "apparmor=aule-build"}, hostCfg.SecurityOpt)
+
+	assert.Error(t, m.harden(&container.HostConfig{}, &domain.WorkerSecurity{CapAdd: []string{"all"}}))
+	for _, c := range []string{"SYS_ADMIN", "cap_sys_ptrace", "sys_module", "NET_ADMIN", "SYS_CHROOT", "NET_RAW", "SYS_TIME", "CHECKPOINT_RESTORE", "NOT_YET_INVENTED"} {
+		assert.ErrorIs(t, m.harden(&container.HostConfig{}, &domain.WorkerSecurity{CapAdd: []string{c}}), domain.ErrInvalidWorkerSecurity, c)
+	}
+	assert.NoError(t, m.harden(&container.HostConfig{}, &domain.WorkerSecurity{CapAdd: []string{"SETUID"}}))
+	assert.ErrorIs(t, m.harden(&container.HostConfig{}, &domain.WorkerSecurity{CapAdd: []string{"SETUID"}, AllowPrivilegeEscalation: true}), domain.ErrInvalidWorkerSecurity)
+	for _, p := range []string{"unconfined", "docker-default", "aule-build,x"} {
+		assert.ErrorIs(t, m.harden(&container.HostConfig{}, &domain.WorkerSecurity{AppArmorProfile: p}), domain.ErrInvalidWorkerSecurity, p)
+	}
+	// Host paths are not profile names
+	for _, p := range []string{profile, "/etc/shadow", "unconfined"} {
+		assert.ErrorIs(t, m.harden(&container.HostConfig{}, &domain.WorkerSecurity{SeccompProfile: p}), domain.ErrInvalidWorkerSecurity, p)
+	}
+}
+
+func TestHarden_PolicyGatesUnconfinedAndEscalation(t *testing.T) {
+	m := &Manager{}
+	assert.ErrorIs(t, m.harden(&container.HostConfig{}, &domain.WorkerSecurity{AllowPrivilegeEscalation: true}), domain.ErrInvalidWorkerSecurity)
+
+	require.NoError(t, m.SetSecurityPolicy(domain.WorkerSecurityPolicy{AllowUnconfined: true, AllowPrivilegeEscalation: true}))
+	hostCfg := &container.HostConfig{}
+	require.NoError(t, m.harden(hostCfg, &domain.WorkerSecurity{
+		SeccompProfile:           domain.SeccompUnconfined,
+		AppArmorProfile:          domain.SeccompUnconfined,
+		AllowPrivilegeEscalation: true,
+	}))
+	assert.Equal(t, []string{"seccomp=unconfined", "apparmor=unconfined"}, hostCfg.SecurityOpt)
+
+	assert.Error(t, m.SetSecurityPolicy(domain.WorkerSecurityPolicy{SeccompProfiles: map[string]string{"x": "/does/not/exist.json"}}))
+}
@@ -0,0 +1,133 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/google/uuid"
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+var _ ports.ShellRuntime = (*Manager)(nil)
+
+// StartShell runs an interactive /bin/sh in a sandboxed container with the
+// spec's workspace mounted at /workspace. The container is removed on Close.
+func (m *Manager) StartShell(ctx context.Context, spec domain.ShellSpec) (ports.ShellProcess, error) {
+	policy := m.imagePolicy()
+	img, err := policy.Resolve(spec.Image)
+	if err != nil {
+		return nil, err
+	}
+	spec.Image = img
+
+	if err := os.MkdirAll(spec.WorkspaceDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create workspace dir: %w", err)
+	}
+
+	env := []string{
+		"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
+		"HOME=/workspace",
+		"PS1=",
+		"PS2=",
+	}
+	for k, v := range spec.Env {
+		env = append(env, fmt.Sprintf("%s=%s", k, v))
+	}
+	labels := map[string]string{"aule.shell": "true"}
+	for k, v := range spec.Labels {
+		labels[k] = v
+	}
+
+	cfg := &container.Config{
+		Image:        spec.Image,
+		Cmd:          []string{"/bin/sh", "-i"}, // interactive: a syntax error must not end the session
+		Env:          env,
+		User:         m.hostUser,
+		WorkingDir:   "/workspace",
+		Tty:          false,
+		OpenStdin:    true,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Labels:       labels,
+	}
+	hostCfg := &container.HostConfig{
+		NetworkMode: "none", // STRICT SECURITY RULE
+		Binds:       []string{fmt.Sprintf("%s:/workspace", spec.WorkspaceDir)},
+		Tmpfs: map[string]string{
+			"/tmp": "rw,nosuid,size=256m",
+		},
+	}
+
+	if err := m.harden(hostCfg, nil); err != nil {
+		return nil, err
+	}
+
+	name := "aule-shell-" + uuid.New().String()
+	resp, err := m.createContainer(ctx, policy.Pull(), cfg, hostCfg, &network.NetworkingConfig{}, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shell container: %w", err)
+	}
+
+	// Attach before start so no output is lost
+	hijack, err := m.cli.ContainerAttach(ctx, resp.ID, container.AttachOptions{
+		Stream: true,
+		Stdin:  true,
+		Stdout: true,
+		Stderr: true,
+	})
+	if err != nil {
+		_ = m.cli.ContainerRemove(context.Background(), resp.ID, container.RemoveOptions{Force: true})
+		return nil, fmt.Errorf("failed to attach to shell container: %w", err)
+	}
+	if err := m.cli.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		hijack.Close()
+		_ = m.cli.ContainerRemove(context.Background(), resp.ID, container.RemoveOptions{Force: true})
+		return nil, fmt.Errorf("failed to start shell container: %w", err)
+	}
+
+	outR, outW := io.Pipe()
+	errR, errW := io.Pipe()
+	go func() {
+		// Non-TTY attach multiplexes stdout and stderr on one stream
+		_, err := stdcopy.StdCopy(outW, errW, hijack.Reader)
+		if err == nil {
+			err = io.EOF
+		}
+		outW.CloseWithError(err)
+		errW.CloseWithError(err)
+	}()
+
+	return &dockerShell{cli: m.cli, containerID: resp.ID, hijack: hijack, stdout: outR, stderr: errR}, nil
+}
+
+type dockerShell struct {
+	cli         *client.Client
+	containerID string
+	hijack      types.HijackedResponse
+	stdout      io.Reader
+	stderr      io.Reader
+}
+
+func (s *dockerShell) Write(p []byte) (int, error) { return s.hijack.Conn.Write(p) }
+func (s *dockerShell) Stdout() io.Reader           { return s.stdout }
+func (s *dockerShell) Stderr() io.Reader           { return s.stderr }
+
+func (s *dockerShell) Close() error {
+	s.hijack.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.cli.ContainerRemove(ctx, s.containerID, container.RemoveOptions{Force: true}); err != nil {
+		return fmt.Errorf("failed to remove shell container: %w", err)
+	}
+	return nil
+}
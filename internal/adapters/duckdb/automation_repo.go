@@ -0,0 +1,125 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SaveAutomation upserts an automation rule.
+func (r *Repository) SaveAutomation(ctx context.Context, a *domain.Automation) error {
+	triggerJSON, err := json.Marshal(a.Trigger)
+	if err != nil {
+		return fmt.Errorf("failed to marshal trigger: %w", err)
+	}
+	conditionsJSON, err := json.Marshal(a.Conditions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal conditions: %w", err)
+	}
+	actionJSON, err := json.Marshal(a.Action)
+	if err != nil {
+		return fmt.Errorf("failed to marshal action: %w", err)
+	}
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO automations (id, project_id, name, enabled, trigger, conditions, action, next_run, last_run, last_result, last_error, run_count, created_at, created_by)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			name        = excluded.name,
+			enabled     = excluded.enabled,
+			trigger     = excluded.trigger,
+			conditions  = excluded.conditions,
+			action      = excluded.action,
+			next_run    = excluded.next_run,
+			last_run    = excluded.last_run,
+			last_result = excluded.last_result,
+			last_error  = excluded.last_error,
+			run_count   = excluded.run_count`,
+		string(a.ID), string(a.ProjectID), a.Name, a.Enabled, string(triggerJSON), string(conditionsJSON), string(actionJSON),
+		a.NextRun, a.LastRun, a.LastResult, a.LastError, a.RunCount, a.CreatedAt, a.CreatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert automation: %w", err)
+	}
+	return nil
+}
+
+// GetAutomation returns an automation rule by ID.
+func (r *Repository) GetAutomation(ctx context.Context, id domain.AutomationID) (*domain.Automation, error) {
+	automations, err := r.queryAutomations(ctx, `WHERE id = ?`, string(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(automations) == 0 {
+		return nil, domain.ErrAutomationNotFound
+	}
+	return &automations[0], nil
+}
+
+// ListAutomations returns all rules, or only the project's when projectID
+// is set.
+func (r *Repository) ListAutomations(ctx context.Context, projectID domain.ProjectID) ([]domain.Automation, error) {
+	if projectID != "" {
+		return r.queryAutomations(ctx, `WHERE project_id = ?`, string(projectID))
+	}
+	return r.queryAutomations(ctx, ``)
+}
+
+// DeleteAutomation removes an automation rule.
+func (r *Repository) DeleteAutomation(ctx context.Context, id domain.AutomationID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM automations WHERE id = ?`, string(id))
+	if err != nil {
+		return fmt.Errorf("delete automation: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrAutomationNotFound
+	}
+	return nil
+}
+
+func (r *Repository) queryAutomations(ctx context.Context, where string, args ...interface{}) ([]domain.Automation, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, project_id, name, enabled, CAST(trigger AS TEXT), CAST(conditions AS TEXT), CAST(action AS TEXT),
+			next_run, last_run, last_result, last_error, run_count, created_at, created_by
+		FROM automations `+where+`
+		ORDER BY created_at ASC`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list automations: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.Automation{}
+	for rows.Next() {
+		var a domain.Automation
+		var id, projectID, triggerJSON, actionJSON string
+		var conditionsJSON sql.NullString
+		var nextRun, lastRun sql.NullTime
+		if err := rows.Scan(&id, &projectID, &a.Name, &a.Enabled, &triggerJSON, &conditionsJSON, &actionJSON,
+			&nextRun, &lastRun, &a.LastResult, &a.LastError, &a.RunCount, &a.CreatedAt, &a.CreatedBy); err != nil {
+			return nil, fmt.Errorf("scan automation: %w", err)
+		}
+		a.ID = domain.AutomationID(id)
+		a.ProjectID = domain.ProjectID(projectID)
+		if err := json.Unmarshal([]byte(triggerJSON), &a.Trigger); err != nil {
+			return nil, fmt.Errorf("automation %s: trigger: %w", id, err)
+		}
+		if err := json.Unmarshal([]byte(actionJSON), &a.Action); err != nil {
+			return nil, fmt.Errorf("automation %s: action: %w", id, err)
+		}
+		if conditionsJSON.Valid {
+			_ = json.Unmarshal([]byte(conditionsJSON.String), &a.Conditions)
+		}
+		if nextRun.Valid {
+			t := nextRun.Time
+			a.NextRun = &t
+		}
+		if lastRun.Valid {
+			t := lastRun.Time
+			a.LastRun = &t
+		}
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
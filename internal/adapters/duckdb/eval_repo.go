@@ -0,0 +1,183 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SaveEvalSuite upserts an evaluation suite.
+func (r *Repository) SaveEvalSuite(ctx context.Context, suite domain.EvalSuite) error {
+	casesJSON, err := json.Marshal(suite.Cases)
+	if err != nil {
+		return fmt.Errorf("failed to marshal eval cases: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO eval_suites (id, name, description, cases, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			name        = excluded.name,
+			description = excluded.description,
+			cases       = excluded.cases,
+			updated_at  = excluded.updated_at`,
+		string(suite.ID), suite.Name, suite.Description, string(casesJSON), suite.CreatedAt, suite.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert eval suite: %w", err)
+	}
+	return nil
+}
+
+// GetEvalSuite returns a suite by ID.
+func (r *Repository) GetEvalSuite(ctx context.Context, id domain.EvalSuiteID) (domain.EvalSuite, error) {
+	suites, err := r.queryEvalSuites(ctx, `WHERE id = ?`, string(id))
+	if err != nil {
+		return domain.EvalSuite{}, err
+	}
+	if len(suites) == 0 {
+		return domain.EvalSuite{}, domain.ErrEvalSuiteNotFound
+	}
+	return suites[0], nil
+}
+
+// ListEvalSuites returns all suites ordered by name.
+func (r *Repository) ListEvalSuites(ctx context.Context) ([]domain.EvalSuite, error) {
+	return r.queryEvalSuites(ctx, ``)
+}
+
+// DeleteEvalSuite removes a suite and its runs.
+func (r *Repository) DeleteEvalSuite(ctx context.Context, id domain.EvalSuiteID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM eval_suites WHERE id = ?`, string(id))
+	if err != nil {
+		return fmt.Errorf("delete eval suite: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrEvalSuiteNotFound
+	}
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM eval_runs WHERE suite_id = ?`, string(id)); err != nil {
+		return fmt.Errorf("delete eval runs: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) queryEvalSuites(ctx context.Context, where string, args ...interface{}) ([]domain.EvalSuite, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, name, description, CAST(cases AS TEXT), created_at, updated_at
+		FROM eval_suites `+where+`
+		ORDER BY name ASC`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list eval suites: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.EvalSuite{}
+	for rows.Next() {
+		var s domain.EvalSuite
+		var cases sql.NullString
+		if err := rows.Scan(&s.ID, &s.Name, &s.Description, &cases, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if cases.Valid && cases.String != "" && cases.String != "null" {
+			_ = json.Unmarshal([]byte(cases.String), &s.Cases)
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+// SaveEvalRun upserts an eval run (called when it starts and when it finishes).
+func (r *Repository) SaveEvalRun(ctx context.Context, run domain.EvalRun) error {
+	resultsJSON, err := json.Marshal(run.Results)
+	if err != nil {
+		return fmt.Errorf("failed to marshal eval results: %w", err)
+	}
+
+	personaID := ""
+	if run.PersonaID != nil {
+		personaID = string(*run.PersonaID)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO eval_runs (id, suite_id, persona_id, model, status, score, passed, total, results, error, started_at, finished_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			status      = excluded.status,
+			score       = excluded.score,
+			passed      = excluded.passed,
+			total       = excluded.total,
+			results     = excluded.results,
+			error       = excluded.error,
+			finished_at = excluded.finished_at`,
+		string(run.ID), string(run.SuiteID), personaID, run.Model, string(run.Status),
+		run.Score, run.Passed, run.Total, string(resultsJSON), run.Error, run.StartedAt, run.FinishedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert eval run: %w", err)
+	}
+	return nil
+}
+
+// GetEvalRun returns a run by ID, including per-case results.
+func (r *Repository) GetEvalRun(ctx context.Context, id domain.EvalRunID) (domain.EvalRun, error) {
+	runs, err := r.queryEvalRuns(ctx, `WHERE id = ?`, string(id))
+	if err != nil {
+		return domain.EvalRun{}, err
+	}
+	if len(runs) == 0 {
+		return domain.EvalRun{}, domain.ErrEvalRunNotFound
+	}
+	return runs[0], nil
+}
+
+// ListEvalRuns returns runs newest first, optionally restricted to one suite.
+func (r *Repository) ListEvalRuns(ctx context.Context, suiteID domain.EvalSuiteID, limit int) ([]domain.EvalRun, error) {
+	where := ``
+	args := []interface{}{}
+	if suiteID != "" {
+		where = `WHERE suite_id = ?`
+		args = append(args, string(suiteID))
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+	args = append(args, limit)
+	return r.queryEvalRuns(ctx, where+` ORDER BY started_at DESC LIMIT ?`, args...)
+}
+
+func (r *Repository) queryEvalRuns(ctx context.Context, tail string, args ...interface{}) ([]domain.EvalRun, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, suite_id, persona_id, model, status, score, passed, total,
+		       CAST(results AS TEXT), error, started_at, finished_at
+		FROM eval_runs `+tail, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list eval runs: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.EvalRun{}
+	for rows.Next() {
+		var run domain.EvalRun
+		var personaID, status string
+		var results sql.NullString
+		if err := rows.Scan(
+			&run.ID, &run.SuiteID, &personaID, &run.Model, &status, &run.Score, &run.Passed, &run.Total,
+			&results, &run.Error, &run.StartedAt, &run.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		run.Status = domain.EvalRunStatus(status)
+		if personaID != "" {
+			pid := domain.PersonaID(personaID)
+			run.PersonaID = &pid
+		}
+		if results.Valid && results.String != "" && results.String != "null" {
+			_ = json.Unmarshal([]byte(results.String), &run.Results)
+		}
+		out = append(out, run)
+	}
+	return out, rows.Err()
+}
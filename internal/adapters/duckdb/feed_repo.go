@@ -0,0 +1,165 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SaveFeed upserts a watched feed.
+func (r *Repository) SaveFeed(ctx context.Context, f *domain.FeedWatch) error {
+	var personaID *string
+	if f.PersonaID != nil {
+		s := string(*f.PersonaID)
+		personaID = &s
+	}
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO feeds (id, project_id, name, url, prompt, workflow_id, automation_id, persona_id, interval_sec, paused, last_checked, last_error, trigger_count, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			name          = excluded.name,
+			url           = excluded.url,
+			prompt        = excluded.prompt,
+			workflow_id   = excluded.workflow_id,
+			automation_id = excluded.automation_id,
+			persona_id    = excluded.persona_id,
+			interval_sec  = excluded.interval_sec,
+			paused        = excluded.paused,
+			last_checked  = excluded.last_checked,
+			last_error    = excluded.last_error,
+			trigger_count = excluded.trigger_count`,
+		string(f.ID), string(f.ProjectID), f.Name, f.URL, f.Prompt, string(f.WorkflowID), string(f.AutomationID), personaID,
+		f.IntervalSec, f.Paused, f.LastChecked, f.LastError, f.TriggerCount, f.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert feed: %w", err)
+	}
+	return nil
+}
+
+// GetFeed returns a feed by ID.
+func (r *Repository) GetFeed(ctx context.Context, id domain.FeedID) (*domain.FeedWatch, error) {
+	feeds, err := r.queryFeeds(ctx, `WHERE id = ?`, string(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(feeds) == 0 {
+		return nil, domain.ErrFeedNotFound
+	}
+	return &feeds[0], nil
+}
+
+// ListFeeds returns all feeds, or only the project's when projectID is set.
+func (r *Repository) ListFeeds(ctx context.Context, projectID domain.ProjectID) ([]domain.FeedWatch, error) {
+	if projectID != "" {
+		return r.queryFeeds(ctx, `WHERE project_id = ?`, string(projectID))
+	}
+	return r.queryFeeds(ctx, ``)
+}
+
+// DeleteFeed removes a feed and its dedup state.
+func (r *Repository) DeleteFeed(ctx context.Context, id domain.FeedID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM feeds WHERE id = ?`, string(id))
+	if err != nil {
+		return fmt.Errorf("delete feed: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrFeedNotFound
+	}
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM feed_items_seen WHERE feed_id = ?`, string(id)); err != nil {
+		return fmt.Errorf("delete feed items: %w", err)
+	}
+	return nil
+}
+
+// FilterUnseenFeedItems returns the keys that have not been marked seen
+// for the feed, in their original order.
+func (r *Repository) FilterUnseenFeedItems(ctx context.Context, id domain.FeedID, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	args := make([]interface{}, 0, len(keys)+1)
+	args = append(args, string(id))
+	for _, k := range keys {
+		args = append(args, k)
+	}
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT item_key FROM feed_items_seen
+		WHERE feed_id = ? AND item_key IN (?`+strings.Repeat(", ?", len(keys)-1)+`)`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query seen feed items: %w", err)
+	}
+	defer rows.Close()
+
+	seen := map[string]bool{}
+	for rows.Next() {
+		var k string
+		if err := rows.Scan(&k); err != nil {
+			return nil, err
+		}
+		seen[k] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	var unseen []string
+	for _, k := range keys {
+		if !seen[k] {
+			unseen = append(unseen, k)
+		}
+	}
+	return unseen, nil
+}
+
+// MarkFeedItemsSeen records item keys so they do not trigger again.
+func (r *Repository) MarkFeedItemsSeen(ctx context.Context, id domain.FeedID, keys []string, at time.Time) error {
+	for _, k := range keys {
+		if _, err := r.db.ExecContext(ctx, `
+			INSERT INTO feed_items_seen (feed_id, item_key, seen_at) VALUES (?, ?, ?)
+			ON CONFLICT (feed_id, item_key) DO NOTHING`, string(id), k, at); err != nil {
+			return fmt.Errorf("mark feed item seen: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *Repository) queryFeeds(ctx context.Context, where string, args ...interface{}) ([]domain.FeedWatch, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, project_id, name, url, prompt, workflow_id, COALESCE(automation_id, ''), persona_id, interval_sec, paused, last_checked, last_error, trigger_count, created_at
+		FROM feeds `+where+`
+		ORDER BY created_at ASC`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list feeds: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.FeedWatch{}
+	for rows.Next() {
+		var f domain.FeedWatch
+		var id, projectID, workflowID, automationID string
+		var personaID sql.NullString
+		var lastChecked sql.NullTime
+		if err := rows.Scan(&id, &projectID, &f.Name, &f.URL, &f.Prompt, &workflowID, &automationID, &personaID,
+			&f.IntervalSec, &f.Paused, &lastChecked, &f.LastError, &f.TriggerCount, &f.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan feed: %w", err)
+		}
+		f.ID = domain.FeedID(id)
+		f.ProjectID = domain.ProjectID(projectID)
+		f.WorkflowID = domain.WorkflowID(workflowID)
+		f.AutomationID = domain.AutomationID(automationID)
+		if personaID.Valid && personaID.String != "" {
+			pid := domain.PersonaID(personaID.String)
+			f.PersonaID = &pid
+		}
+		if lastChecked.Valid {
+			t := lastChecked.Time
+			f.LastChecked = &t
+		}
+		out = append(out, f)
+	}
+	return out, rows.Err()
+}
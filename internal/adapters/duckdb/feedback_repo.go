@@ -0,0 +1,102 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SaveMessageFeedback upserts the rating for a message; a new rating
+// replaces the previous one but keeps its created_at.
+func (r *Repository) SaveMessageFeedback(ctx context.Context, fb domain.MessageFeedback) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO message_feedback (message_id, conversation_id, trace_id, rating, comment, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (message_id) DO UPDATE SET
+			trace_id   = excluded.trace_id,
+			rating     = excluded.rating,
+			comment    = excluded.comment,
+			updated_at = excluded.updated_at`,
+		string(fb.MessageID), string(fb.ConversationID), string(fb.TraceID), string(fb.Rating), fb.Comment, fb.CreatedAt, fb.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert feedback: %w", err)
+	}
+	return nil
+}
+
+// GetMessageFeedback returns the rating for a message.
+func (r *Repository) GetMessageFeedback(ctx context.Context, id domain.MessageID) (domain.MessageFeedback, error) {
+	var fb domain.MessageFeedback
+	var msgID, convID, traceID, rating string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT message_id, conversation_id, trace_id, rating, comment, created_at, updated_at
+		FROM message_feedback WHERE message_id = ?`, string(id)).
+		Scan(&msgID, &convID, &traceID, &rating, &fb.Comment, &fb.CreatedAt, &fb.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return fb, domain.ErrFeedbackNotFound
+	}
+	if err != nil {
+		return fb, err
+	}
+	fb.MessageID = domain.MessageID(msgID)
+	fb.ConversationID = domain.ConversationID(convID)
+	fb.TraceID = domain.TraceID(traceID)
+	fb.Rating = domain.FeedbackRating(rating)
+	return fb, nil
+}
+
+// ListFeedbackRecords returns feedback joined with the rated reply, the user
+// prompt before it, and the model and persona that produced it, oldest first.
+func (r *Repository) ListFeedbackRecords(ctx context.Context, filter domain.FeedbackFilter) ([]domain.FeedbackRecord, error) {
+	where := `WHERE 1=1`
+	var args []any
+	if filter.Since != nil {
+		where += ` AND f.updated_at >= ?`
+		args = append(args, *filter.Since)
+	}
+	if filter.Rating != "" {
+		where += ` AND f.rating = ?`
+		args = append(args, string(filter.Rating))
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT f.message_id, f.conversation_id, f.trace_id, f.rating, f.comment, f.created_at, f.updated_at,
+			COALESCE(m.content, ''),
+			COALESCE((SELECT u.content FROM messages u
+				WHERE u.conversation_id = f.conversation_id AND u.role = 'user' AND u.created_at <= m.created_at
+				ORDER BY u.created_at DESC, u.id DESC LIMIT 1), ''),
+			COALESCE(json_extract_string(m.metadata, '$.model'), ''),
+			COALESCE(c.persona_id, '')
+		FROM message_feedback f
+		LEFT JOIN messages m ON m.id = f.message_id
+		LEFT JOIN conversations c ON c.id = f.conversation_id
+		`+where+`
+		ORDER BY f.updated_at ASC`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list feedback: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.FeedbackRecord{}
+	for rows.Next() {
+		var rec domain.FeedbackRecord
+		var msgID, convID, traceID, rating, personaID string
+		if err := rows.Scan(&msgID, &convID, &traceID, &rating, &rec.Comment, &rec.CreatedAt, &rec.UpdatedAt,
+			&rec.Response, &rec.Prompt, &rec.Model, &personaID); err != nil {
+			return nil, err
+		}
+		rec.MessageID = domain.MessageID(msgID)
+		rec.ConversationID = domain.ConversationID(convID)
+		rec.TraceID = domain.TraceID(traceID)
+		rec.Rating = domain.FeedbackRating(rating)
+		if personaID != "" {
+			pid := domain.PersonaID(personaID)
+			rec.PersonaID = &pid
+		}
+		out = append(out, rec)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,129 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SaveFileTrigger upserts a file-system watch trigger.
+func (r *Repository) SaveFileTrigger(ctx context.Context, t *domain.FileTrigger) error {
+	patternsJSON, err := json.Marshal(t.Patterns)
+	if err != nil {
+		return fmt.Errorf("failed to marshal patterns: %w", err)
+	}
+	eventsJSON, err := json.Marshal(t.Events)
+	if err != nil {
+		return fmt.Errorf("failed to marshal events: %w", err)
+	}
+	var personaID *string
+	if t.PersonaID != nil {
+		s := string(*t.PersonaID)
+		personaID = &s
+	}
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO file_triggers (id, project_id, name, path, patterns, recursive, events, prompt, workflow_id, automation_id, persona_id, debounce_sec, paused, last_triggered, last_error, trigger_count, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			name           = excluded.name,
+			path           = excluded.path,
+			patterns       = excluded.patterns,
+			recursive      = excluded.recursive,
+			events         = excluded.events,
+			prompt         = excluded.prompt,
+			workflow_id    = excluded.workflow_id,
+			automation_id  = excluded.automation_id,
+			persona_id     = excluded.persona_id,
+			debounce_sec   = excluded.debounce_sec,
+			paused         = excluded.paused,
+			last_triggered = excluded.last_triggered,
+			last_error     = excluded.last_error,
+			trigger_count  = excluded.trigger_count`,
+		string(t.ID), string(t.ProjectID), t.Name, t.Path, string(patternsJSON), t.Recursive, string(eventsJSON),
+		t.Prompt, string(t.WorkflowID), string(t.AutomationID), personaID, t.DebounceSec, t.Paused, t.LastTriggered, t.LastError, t.TriggerCount, t.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert file trigger: %w", err)
+	}
+	return nil
+}
+
+// GetFileTrigger returns a file trigger by ID.
+func (r *Repository) GetFileTrigger(ctx context.Context, id domain.FileTriggerID) (*domain.FileTrigger, error) {
+	triggers, err := r.queryFileTriggers(ctx, `WHERE id = ?`, string(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(triggers) == 0 {
+		return nil, domain.ErrFileTriggerNotFound
+	}
+	return &triggers[0], nil
+}
+
+// ListFileTriggers returns all file triggers, or only the project's when
+// projectID is set.
+func (r *Repository) ListFileTriggers(ctx context.Context, projectID domain.ProjectID) ([]domain.FileTrigger, error) {
+	if projectID != "" {
+		return r.queryFileTriggers(ctx, `WHERE project_id = ?`, string(projectID))
+	}
+	return r.queryFileTriggers(ctx, ``)
+}
+
+// DeleteFileTrigger removes a file trigger.
+func (r *Repository) DeleteFileTrigger(ctx context.Context, id domain.FileTriggerID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM file_triggers WHERE id = ?`, string(id))
+	if err != nil {
+		return fmt.Errorf("delete file trigger: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrFileTriggerNotFound
+	}
+	return nil
+}
+
+func (r *Repository) queryFileTriggers(ctx context.Context, where string, args ...interface{}) ([]domain.FileTrigger, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, project_id, name, path, CAST(patterns AS TEXT), recursive, CAST(events AS TEXT), prompt, workflow_id, COALESCE(automation_id, ''), persona_id,
+			debounce_sec, paused, last_triggered, last_error, trigger_count, created_at
+		FROM file_triggers `+where+`
+		ORDER BY created_at ASC`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list file triggers: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.FileTrigger{}
+	for rows.Next() {
+		var t domain.FileTrigger
+		var id, projectID, workflowID, automationID string
+		var patternsJSON, eventsJSON, personaID sql.NullString
+		var lastTriggered sql.NullTime
+		if err := rows.Scan(&id, &projectID, &t.Name, &t.Path, &patternsJSON, &t.Recursive, &eventsJSON, &t.Prompt, &workflowID, &automationID, &personaID,
+			&t.DebounceSec, &t.Paused, &lastTriggered, &t.LastError, &t.TriggerCount, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan file trigger: %w", err)
+		}
+		t.ID = domain.FileTriggerID(id)
+		t.ProjectID = domain.ProjectID(projectID)
+		t.WorkflowID = domain.WorkflowID(workflowID)
+		t.AutomationID = domain.AutomationID(automationID)
+		if patternsJSON.Valid {
+			_ = json.Unmarshal([]byte(patternsJSON.String), &t.Patterns)
+		}
+		if eventsJSON.Valid {
+			_ = json.Unmarshal([]byte(eventsJSON.String), &t.Events)
+		}
+		if personaID.Valid && personaID.String != "" {
+			pid := domain.PersonaID(personaID.String)
+			t.PersonaID = &pid
+		}
+		if lastTriggered.Valid {
+			ts := lastTriggered.Time
+			t.LastTriggered = &ts
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
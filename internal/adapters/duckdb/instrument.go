@@ -0,0 +1,190 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	defaultSlowQuery = 250 * time.Millisecond
+	slowLogSize      = 50
+)
+
+// instrumentedDB wraps *sql.DB so every repository query is timed and
+// attributed to the calling repository method and the ctx's subsystem.
+// Query timings cover execution up to the first row, not row iteration.
+type instrumentedDB struct {
+	*sql.DB
+
+	mu      sync.Mutex
+	logger  *slog.Logger
+	slow    time.Duration
+	stats   map[queryKey]*domain.QueryStat
+	slowLog []domain.SlowQuery // ring buffer, next write at slowPos
+	slowPos int
+}
+
+type queryKey struct {
+	method    string
+	subsystem string
+}
+
+func newInstrumentedDB(db *sql.DB) *instrumentedDB {
+	return &instrumentedDB{
+		DB:     db,
+		logger: slog.Default(),
+		slow:   defaultSlowQuery,
+		stats:  make(map[queryKey]*domain.QueryStat),
+	}
+}
+
+func (d *instrumentedDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	method, start := callerMethod(), time.Now()
+	res, err := d.DB.ExecContext(ctx, query, args...)
+	d.observe(ctx, method, query, time.Since(start), err)
+	return res, err
+}
+
+func (d *instrumentedDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	method, start := callerMethod(), time.Now()
+	rows, err := d.DB.QueryContext(ctx, query, args...)
+	d.observe(ctx, method, query, time.Since(start), err)
+	return rows, err
+}
+
+func (d *instrumentedDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	method, start := callerMethod(), time.Now()
+	row := d.DB.QueryRowContext(ctx, query, args...)
+	d.observe(ctx, method, query, time.Since(start), row.Err())
+	return row
+}
+
+// callerMethod names the repository method that issued the query.
+func callerMethod() string {
+	pc, _, _, ok := runtime.Caller(2)
+	if !ok {
+		return "unknown"
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return "unknown"
+	}
+	name := fn.Name() // .../duckdb.(*Repository).SaveJob
+	if i := strings.LastIndex(name, ")."); i >= 0 {
+		return name[i+2:]
+	}
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func (d *instrumentedDB) observe(ctx context.Context, method, query string, elapsed time.Duration, err error) {
+	subsystem := domain.SubsystemFrom(ctx)
+	ms := float64(elapsed.Microseconds()) / 1000
+
+	d.mu.Lock()
+	key := queryKey{method: method, subsystem: subsystem}
+	st, ok := d.stats[key]
+	if !ok {
+		st = &domain.QueryStat{Method: method, Subsystem: subsystem}
+		d.stats[key] = st
+	}
+	st.Count++
+	st.TotalMs += ms
+	if ms > st.MaxMs {
+		st.MaxMs = ms
+	}
+	if err != nil {
+		st.Errors++
+	}
+	slow := d.slow > 0 && elapsed >= d.slow
+	if slow {
+		st.Slow++
+		entry := domain.SlowQuery{
+			Method:     method,
+			Subsystem:  subsystem,
+			DurationMs: ms,
+			Query:      compactQuery(query),
+			At:         time.Now(),
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		if len(d.slowLog) < slowLogSize {
+			d.slowLog = append(d.slowLog, entry)
+		} else {
+			d.slowLog[d.slowPos] = entry
+		}
+		d.slowPos = (d.slowPos + 1) % slowLogSize
+	}
+	logger := d.logger
+	d.mu.Unlock()
+
+	if slow {
+		logger.Warn("slow query", "method", method, "subsystem", subsystem, "duration_ms", ms, "query", compactQuery(query))
+	}
+}
+
+// compactQuery collapses whitespace and caps the length for logs.
+func compactQuery(q string) string {
+	q = strings.Join(strings.Fields(q), " ")
+	if len(q) > 300 {
+		q = q[:300] + "..."
+	}
+	return q
+}
+
+// Instrument sets the logger and threshold for the slow-query log.
+// A threshold <= 0 disables slow-query logging; timings are always kept.
+func (r *Repository) Instrument(logger *slog.Logger, slowThreshold time.Duration) {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+	if logger != nil {
+		r.db.logger = logger
+	}
+	r.db.slow = slowThreshold
+}
+
+// QueryMetrics returns per-method query timings and the recent slow queries.
+func (r *Repository) QueryMetrics() domain.QueryMetrics {
+	d := r.db
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	m := domain.QueryMetrics{
+		SlowThresholdMs: float64(d.slow.Microseconds()) / 1000,
+		Queries:         make([]domain.QueryStat, 0, len(d.stats)),
+		RecentSlow:      make([]domain.SlowQuery, 0, len(d.slowLog)),
+	}
+	for _, st := range d.stats {
+		s := *st
+		s.AvgMs = s.TotalMs / float64(s.Count)
+		m.Queries = append(m.Queries, s)
+	}
+	sort.Slice(m.Queries, func(i, j int) bool { return m.Queries[i].TotalMs > m.Queries[j].TotalMs })
+
+	// Walk the ring backwards from the newest entry
+	for i := 0; i < len(d.slowLog); i++ {
+		idx := (d.slowPos - 1 - i + len(d.slowLog)) % len(d.slowLog)
+		m.RecentSlow = append(m.RecentSlow, d.slowLog[idx])
+	}
+	return m
+}
+
+// ResetQueryMetrics clears the collected timings and slow-query log.
+func (r *Repository) ResetQueryMetrics() {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+	r.db.stats = make(map[queryKey]*domain.QueryStat)
+	r.db.slowLog = nil
+	r.db.slowPos = 0
+}
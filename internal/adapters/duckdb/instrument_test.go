@@ -0,0 +1,45 @@
+package duckdb
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_QueryMetrics(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	repo.ResetQueryMetrics() // drop migration-time queries
+	repo.Instrument(slog.New(slog.NewTextHandler(io.Discard, nil)), time.Nanosecond)
+
+	ctx := domain.WithSubsystem(context.Background(), "api/jobs")
+	job := domain.Job{ID: "job-1", Status: domain.JobStatusPending, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	require.NoError(t, repo.SaveJob(ctx, job))
+	_, err = repo.GetJob(ctx, job.ID)
+	require.NoError(t, err)
+	_, err = repo.ListJobs(context.Background())
+	require.NoError(t, err)
+
+	m := repo.QueryMetrics()
+	byKey := map[string]domain.QueryStat{}
+	for _, q := range m.Queries {
+		byKey[q.Method+"@"+q.Subsystem] = q
+	}
+	assert.Equal(t, int64(1), byKey["SaveJob@api/jobs"].Count)
+	assert.Equal(t, int64(1), byKey["GetJob@api/jobs"].Count)
+	assert.Equal(t, int64(1), byKey["ListJobs@other"].Count)
+
+	// Every query crosses a 1ns threshold; newest first
+	require.Len(t, m.RecentSlow, 3)
+	assert.Equal(t, "ListJobs", m.RecentSlow[0].Method)
+	assert.Equal(t, "SaveJob", m.RecentSlow[2].Method)
+
+	repo.ResetQueryMetrics()
+	assert.Empty(t, repo.QueryMetrics().Queries)
+}
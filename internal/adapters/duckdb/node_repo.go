@@ -0,0 +1,67 @@
+package duckdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func (r *Repository) SaveNode(ctx context.Context, node domain.Node) error {
+	labelsJSON, err := json.Marshal(node.Labels)
+	if err != nil {
+		return fmt.Errorf("failed to marshal node labels: %w", err)
+	}
+
+	query := `
+	INSERT INTO nodes (id, name, url, labels, status, last_seen, created_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT (id) DO UPDATE SET
+		name = excluded.name,
+		url = excluded.url,
+		labels = excluded.labels,
+		status = excluded.status,
+		last_seen = excluded.last_seen;
+	`
+	_, err = r.db.ExecContext(ctx, query,
+		node.ID, node.Name, node.URL, string(labelsJSON), node.Status, node.LastSeen, node.CreatedAt,
+	)
+	return err
+}
+
+func (r *Repository) ListNodes(ctx context.Context) ([]domain.Node, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, name, url, CAST(labels AS TEXT), status, last_seen, created_at FROM nodes ORDER BY created_at ASC`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var nodes []domain.Node
+	for rows.Next() {
+		var n domain.Node
+		var idStr, labelsJSON, status string
+		if err := rows.Scan(&idStr, &n.Name, &n.URL, &labelsJSON, &status, &n.LastSeen, &n.CreatedAt); err != nil {
+			return nil, err
+		}
+		n.ID = domain.NodeID(idStr)
+		n.Status = domain.NodeStatus(status)
+		_ = json.Unmarshal([]byte(labelsJSON), &n.Labels)
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+func (r *Repository) DeleteNode(ctx context.Context, id domain.NodeID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM nodes WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	n, _ := result.RowsAffected()
+	if n == 0 {
+		return domain.ErrNodeNotFound
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// GetPluginKV returns the value a Wasm plugin stored under key.
+func (r *Repository) GetPluginKV(ctx context.Context, namespace, key string) ([]byte, error) {
+	var value []byte
+	err := r.db.QueryRowContext(ctx, `SELECT value FROM plugin_kv WHERE namespace = ? AND key = ?`, namespace, key).Scan(&value)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrKVKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get plugin kv: %w", err)
+	}
+	return value, nil
+}
+
+// SetPluginKV upserts a value of a Wasm plugin.
+func (r *Repository) SetPluginKV(ctx context.Context, namespace, key string, value []byte) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO plugin_kv (namespace, key, value, updated_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (namespace, key) DO UPDATE SET
+			value      = excluded.value,
+			updated_at = excluded.updated_at`,
+		namespace, key, value, time.Now())
+	if err != nil {
+		return fmt.Errorf("set plugin kv: %w", err)
+	}
+	return nil
+}
+
+// DeletePluginKV removes a value of a Wasm plugin; missing keys are fine.
+func (r *Repository) DeletePluginKV(ctx context.Context, namespace, key string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM plugin_kv WHERE namespace = ? AND key = ?`, namespace, key); err != nil {
+		return fmt.Errorf("delete plugin kv: %w", err)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
@@ -13,7 +14,7 @@ import (
 )
 
 type Repository struct {
-	db *sql.DB
+	db *instrumentedDB
 }
 
 // NewRepository creates a new DuckDB repository and runs migrations
@@ -27,7 +28,7 @@ func NewRepository(path string) (*Repository, error) {
 		return nil, fmt.Errorf("failed to ping duckdb: %w", err)
 	}
 
-	repo := &Repository{db: db}
+	repo := &Repository{db: newInstrumentedDB(db)}
 	if err := repo.migrate(); err != nil {
 		_ = db.Close()
 		return nil, fmt.Errorf("failed to migrate duckdb: %w", err)
@@ -36,6 +37,42 @@ func NewRepository(path string) (*Repository, error) {
 	return repo, nil
 }
 
+// migrations are additive schema changes applied after the base tables.
+// Append only: their count is the schema version.
+var migrations = []string{
+	`ALTER TABLE conversations ADD COLUMN IF NOT EXISTS project_id TEXT`,
+	`ALTER TABLE conversations ADD COLUMN IF NOT EXISTS persona_id TEXT`,
+	`ALTER TABLE personas ADD COLUMN IF NOT EXISTS model_override TEXT DEFAULT ''`,
+	`ALTER TABLE personas ADD COLUMN IF NOT EXISTS locale TEXT DEFAULT ''`,
+	`ALTER TABLE projects ADD COLUMN IF NOT EXISTS settings JSON`,
+	`ALTER TABLE jobs ADD COLUMN IF NOT EXISTS depends_on JSON`,
+	`ALTER TABLE jobs ADD COLUMN IF NOT EXISTS output JSON`,
+	`CREATE INDEX IF NOT EXISTS idx_messages_conversation_created ON messages (conversation_id, created_at)`,
+	`ALTER TABLE conversations ADD COLUMN IF NOT EXISTS tags JSON`,
+	`ALTER TABLE conversations ADD COLUMN IF NOT EXISTS pinned BOOLEAN DEFAULT false`,
+	`ALTER TABLE conversations ADD COLUMN IF NOT EXISTS folder TEXT DEFAULT ''`,
+	`ALTER TABLE conversations ADD COLUMN IF NOT EXISTS context JSON`,
+	`ALTER TABLE conversations ADD COLUMN IF NOT EXISTS style JSON`,
+	`ALTER TABLE messages ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP`,
+	`ALTER TABLE traces ADD COLUMN IF NOT EXISTS request_id TEXT DEFAULT ''`,
+	`ALTER TABLE feeds ADD COLUMN IF NOT EXISTS automation_id TEXT DEFAULT ''`,
+	`ALTER TABLE file_triggers ADD COLUMN IF NOT EXISTS automation_id TEXT DEFAULT ''`,
+	`CREATE INDEX IF NOT EXISTS idx_llm_usage_conversation ON llm_usage (conversation_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_llm_usage_project ON llm_usage (project_id)`,
+	`CREATE TABLE IF NOT EXISTS plugin_kv (
+		namespace TEXT NOT NULL,
+		key TEXT NOT NULL,
+		value BLOB NOT NULL,
+		updated_at TIMESTAMP NOT NULL,
+		PRIMARY KEY (namespace, key)
+	)`,
+}
+
+// SchemaVersion is the database schema version of this build.
+func SchemaVersion() int {
+	return len(migrations)
+}
+
 // migrate creates necessary tables
 func (r *Repository) migrate() error {
 	queries := []string{
@@ -177,6 +214,148 @@ func (r *Repository) migrate() error {
 			end_time TIMESTAMP,
 			duration_ms BIGINT NOT NULL DEFAULT 0
 		);`,
+		`CREATE TABLE IF NOT EXISTS provider_captures (
+			id TEXT PRIMARY KEY,
+			trace_id TEXT NOT NULL,
+			span_id TEXT NOT NULL DEFAULT '',
+			method TEXT NOT NULL DEFAULT '',
+			url TEXT NOT NULL DEFAULT '',
+			status_code INTEGER NOT NULL DEFAULT 0,
+			request TEXT NOT NULL DEFAULT '',
+			response TEXT NOT NULL DEFAULT '',
+			error TEXT NOT NULL DEFAULT '',
+			duration_ms BIGINT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS sub_agent_tasks (
+			id TEXT PRIMARY KEY,
+			parent_id TEXT NOT NULL DEFAULT '',
+			conversation_id TEXT NOT NULL DEFAULT '',
+			persona_id TEXT NOT NULL DEFAULT '',
+			persona_name TEXT NOT NULL DEFAULT '',
+			model_id TEXT NOT NULL DEFAULT '',
+			prompt TEXT NOT NULL DEFAULT '',
+			status TEXT NOT NULL,
+			result TEXT NOT NULL DEFAULT '',
+			error TEXT NOT NULL DEFAULT '',
+			steps JSON,
+			started_at TIMESTAMP NOT NULL,
+			finished_at TIMESTAMP
+		);`,
+		`CREATE TABLE IF NOT EXISTS nodes (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL DEFAULT '',
+			url TEXT NOT NULL,
+			labels JSON,
+			status TEXT NOT NULL DEFAULT 'unknown',
+			last_seen TIMESTAMP,
+			created_at TIMESTAMP NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS message_feedback (
+			message_id TEXT PRIMARY KEY,
+			conversation_id TEXT NOT NULL,
+			trace_id TEXT NOT NULL DEFAULT '',
+			rating TEXT NOT NULL,
+			comment TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS eval_suites (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			cases JSON,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS eval_runs (
+			id TEXT PRIMARY KEY,
+			suite_id TEXT NOT NULL,
+			persona_id TEXT NOT NULL DEFAULT '',
+			model TEXT NOT NULL DEFAULT '',
+			status TEXT NOT NULL,
+			score DOUBLE NOT NULL DEFAULT 0,
+			passed INTEGER NOT NULL DEFAULT 0,
+			total INTEGER NOT NULL DEFAULT 0,
+			results JSON,
+			error TEXT NOT NULL DEFAULT '',
+			started_at TIMESTAMP NOT NULL,
+			finished_at TIMESTAMP
+		);`,
+		`CREATE TABLE IF NOT EXISTS feeds (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL DEFAULT '',
+			name TEXT NOT NULL DEFAULT '',
+			url TEXT NOT NULL,
+			prompt TEXT NOT NULL DEFAULT '',
+			workflow_id TEXT NOT NULL DEFAULT '',
+			automation_id TEXT NOT NULL DEFAULT '',
+			persona_id TEXT,
+			interval_sec INTEGER NOT NULL DEFAULT 900,
+			paused BOOLEAN NOT NULL DEFAULT false,
+			last_checked TIMESTAMP,
+			last_error TEXT NOT NULL DEFAULT '',
+			trigger_count INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS feed_items_seen (
+			feed_id TEXT NOT NULL,
+			item_key TEXT NOT NULL,
+			seen_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (feed_id, item_key)
+		);`,
+		`CREATE TABLE IF NOT EXISTS file_triggers (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL DEFAULT '',
+			name TEXT NOT NULL DEFAULT '',
+			path TEXT NOT NULL,
+			patterns JSON,
+			recursive BOOLEAN NOT NULL DEFAULT false,
+			events JSON,
+			prompt TEXT NOT NULL DEFAULT '',
+			workflow_id TEXT NOT NULL DEFAULT '',
+			automation_id TEXT NOT NULL DEFAULT '',
+			persona_id TEXT,
+			debounce_sec INTEGER NOT NULL DEFAULT 5,
+			paused BOOLEAN NOT NULL DEFAULT false,
+			last_triggered TIMESTAMP,
+			last_error TEXT NOT NULL DEFAULT '',
+			trigger_count INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS automations (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL DEFAULT '',
+			name TEXT NOT NULL DEFAULT '',
+			enabled BOOLEAN NOT NULL DEFAULT true,
+			trigger JSON NOT NULL,
+			conditions JSON,
+			action JSON NOT NULL,
+			next_run TIMESTAMP,
+			last_run TIMESTAMP,
+			last_result TEXT NOT NULL DEFAULT '',
+			last_error TEXT NOT NULL DEFAULT '',
+			run_count INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL,
+			created_by TEXT NOT NULL DEFAULT ''
+		);`,
+		`CREATE TABLE IF NOT EXISTS llm_usage (
+			conversation_id TEXT NOT NULL,
+			project_id TEXT NOT NULL DEFAULT '',
+			model TEXT NOT NULL DEFAULT '',
+			prompt_tokens INTEGER NOT NULL DEFAULT 0,
+			completion_tokens INTEGER NOT NULL DEFAULT 0,
+			cost_usd DOUBLE NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL
+		);`,
+		`CREATE TABLE IF NOT EXISTS usage_limits (
+			scope TEXT NOT NULL,
+			scope_id TEXT NOT NULL,
+			max_tokens BIGINT NOT NULL DEFAULT 0,
+			max_cost_usd DOUBLE NOT NULL DEFAULT 0,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (scope, scope_id)
+		);`,
 	}
 
 	for _, q := range queries {
@@ -186,11 +365,6 @@ func (r *Repository) migrate() error {
 	}
 
 	// Additive migrations — safe to re-run
-	migrations := []string{
-		`ALTER TABLE conversations ADD COLUMN IF NOT EXISTS project_id TEXT`,
-		`ALTER TABLE conversations ADD COLUMN IF NOT EXISTS persona_id TEXT`,
-		`ALTER TABLE personas ADD COLUMN IF NOT EXISTS model_override TEXT DEFAULT ''`,
-	}
 	for _, m := range migrations {
 		_, _ = r.db.Exec(m) // ignore errors; DuckDB may not support IF NOT EXISTS on ALTER
 	}
@@ -308,12 +482,26 @@ func (r *Repository) SaveJob(ctx context.Context, job domain.Job) error {
 	if err != nil {
 		return fmt.Errorf("failed to marshal metadata: %w", err)
 	}
+	depsJSON, err := json.Marshal(job.DependsOn)
+	if err != nil {
+		return fmt.Errorf("failed to marshal depends_on: %w", err)
+	}
+	var outputJSON *string
+	if job.Output != nil {
+		b, err := json.Marshal(job.Output)
+		if err != nil {
+			return fmt.Errorf("failed to marshal output: %w", err)
+		}
+		s := string(b)
+		outputJSON = &s
+	}
 
 	query := `
-	INSERT INTO jobs (id, result, error, status, worker_id, spec, created_at, updated_at, metadata)
-	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	INSERT INTO jobs (id, result, error, status, worker_id, spec, created_at, updated_at, metadata, depends_on, output)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	ON CONFLICT (id) DO UPDATE SET
 		result = excluded.result,
+		output = excluded.output,
 		error = excluded.error,
 		status = excluded.status,
 		worker_id = excluded.worker_id,
@@ -338,20 +526,36 @@ func (r *Repository) SaveJob(ctx context.Context, job domain.Job) error {
 		job.CreatedAt,
 		job.UpdatedAt,
 		string(metaJSON),
+		string(depsJSON),
+		outputJSON,
 	)
 	return err
 }
 
+// DeleteJob removes a job record.
+func (r *Repository) DeleteJob(ctx context.Context, id domain.JobID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM jobs WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	n, _ := result.RowsAffected()
+	if n == 0 {
+		return domain.ErrJobNotFound
+	}
+	return nil
+}
+
 func (r *Repository) GetJob(ctx context.Context, id domain.JobID) (domain.Job, error) {
-	query := `SELECT id, result, error, status, worker_id, CAST(spec AS TEXT), created_at, updated_at, CAST(metadata AS TEXT) FROM jobs WHERE id = ?`
+	query := `SELECT id, result, error, status, worker_id, CAST(spec AS TEXT), created_at, updated_at, CAST(metadata AS TEXT), CAST(depends_on AS TEXT), CAST(output AS TEXT) FROM jobs WHERE id = ?`
 	row := r.db.QueryRowContext(ctx, query, id)
 
 	var j domain.Job
 	var specJSON, metaJSON string
+	var depsJSON, outputJSON *string
 	var workerIDStr *string
 	var idStr string
 
-	if err := row.Scan(&idStr, &j.Result, &j.Error, &j.Status, &workerIDStr, &specJSON, &j.CreatedAt, &j.UpdatedAt, &metaJSON); err != nil {
+	if err := row.Scan(&idStr, &j.Result, &j.Error, &j.Status, &workerIDStr, &specJSON, &j.CreatedAt, &j.UpdatedAt, &metaJSON, &depsJSON, &outputJSON); err != nil {
 		if err == sql.ErrNoRows {
 			return domain.Job{}, domain.ErrJobNotFound
 		}
@@ -370,6 +574,14 @@ func (r *Repository) GetJob(ctx context.Context, id domain.JobID) (domain.Job, e
 	if err := json.Unmarshal([]byte(metaJSON), &j.Metadata); err != nil {
 		return domain.Job{}, fmt.Errorf("failed to unmarshal metadata: %w", err)
 	}
+	if depsJSON != nil {
+		_ = json.Unmarshal([]byte(*depsJSON), &j.DependsOn)
+	}
+	if outputJSON != nil {
+		if err := json.Unmarshal([]byte(*outputJSON), &j.Output); err != nil {
+			return domain.Job{}, fmt.Errorf("failed to unmarshal output: %w", err)
+		}
+	}
 
 	return j, nil
 }
@@ -400,7 +612,7 @@ func (r *Repository) SaveSetting(ctx context.Context, key string, value string)
 }
 
 func (r *Repository) ListJobs(ctx context.Context) ([]domain.Job, error) {
-	query := `SELECT id, result, error, status, worker_id, CAST(spec AS TEXT), created_at, updated_at, CAST(metadata AS TEXT) FROM jobs ORDER BY created_at DESC`
+	query := `SELECT id, result, error, status, worker_id, CAST(spec AS TEXT), created_at, updated_at, CAST(metadata AS TEXT), CAST(depends_on AS TEXT), CAST(output AS TEXT) FROM jobs ORDER BY created_at DESC`
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
@@ -411,10 +623,11 @@ func (r *Repository) ListJobs(ctx context.Context) ([]domain.Job, error) {
 	for rows.Next() {
 		var j domain.Job
 		var specJSON, metaJSON string
+		var depsJSON, outputJSON *string
 		var workerIDStr *string
 		var idStr string
 
-		if err := rows.Scan(&idStr, &j.Result, &j.Error, &j.Status, &workerIDStr, &specJSON, &j.CreatedAt, &j.UpdatedAt, &metaJSON); err != nil {
+		if err := rows.Scan(&idStr, &j.Result, &j.Error, &j.Status, &workerIDStr, &specJSON, &j.CreatedAt, &j.UpdatedAt, &metaJSON, &depsJSON, &outputJSON); err != nil {
 			return nil, err
 		}
 
@@ -425,6 +638,12 @@ func (r *Repository) ListJobs(ctx context.Context) ([]domain.Job, error) {
 		}
 		_ = json.Unmarshal([]byte(specJSON), &j.Spec)
 		_ = json.Unmarshal([]byte(metaJSON), &j.Metadata)
+		if depsJSON != nil {
+			_ = json.Unmarshal([]byte(*depsJSON), &j.DependsOn)
+		}
+		if outputJSON != nil {
+			_ = json.Unmarshal([]byte(*outputJSON), &j.Output)
+		}
 
 		jobs = append(jobs, j)
 	}
@@ -434,42 +653,124 @@ func (r *Repository) ListJobs(ctx context.Context) ([]domain.Job, error) {
 // Conversation Management
 
 func (r *Repository) CreateConversation(ctx context.Context, conv domain.Conversation) error {
-	var personaID *string
+	var projectID, personaID *string
+	if conv.ProjectID != nil {
+		s := string(*conv.ProjectID)
+		projectID = &s
+	}
 	if conv.PersonaID != nil {
 		s := string(*conv.PersonaID)
 		personaID = &s
 	}
+	tagsJSON, _ := json.Marshal(domain.NormalizeTags(conv.Tags))
 	_, err := r.db.ExecContext(ctx,
-		`INSERT INTO conversations (id, title, persona_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
-		conv.ID, conv.Title, personaID, conv.CreatedAt, conv.UpdatedAt,
+		`INSERT INTO conversations (id, title, project_id, persona_id, tags, pinned, folder, context, style, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		conv.ID, conv.Title, projectID, personaID, string(tagsJSON), conv.Pinned, strings.TrimSpace(conv.Folder), contextVarsJSON(conv.Context), styleJSON(conv.Style), conv.CreatedAt, conv.UpdatedAt,
 	)
 	return err
 }
 
-func (r *Repository) GetConversation(ctx context.Context, id domain.ConversationID) (domain.Conversation, error) {
+const conversationColumns = `id, title, project_id, persona_id, CAST(tags AS TEXT), COALESCE(pinned, false), COALESCE(folder, ''), CAST(context AS TEXT), CAST(style AS TEXT), created_at, updated_at`
+
+// rowScanner is satisfied by *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanConversation(row rowScanner) (domain.Conversation, error) {
 	var c domain.Conversation
 	var idStr string
-	var personaID *string
-	err := r.db.QueryRowContext(ctx,
-		`SELECT id, title, persona_id, created_at, updated_at FROM conversations WHERE id = ?`, id,
-	).Scan(&idStr, &c.Title, &personaID, &c.CreatedAt, &c.UpdatedAt)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return domain.Conversation{}, domain.ErrConversationNotFound
-		}
+	var projectID, personaID, tagsJSON, contextJSON, styleData *string
+	if err := row.Scan(&idStr, &c.Title, &projectID, &personaID, &tagsJSON, &c.Pinned, &c.Folder, &contextJSON, &styleData, &c.CreatedAt, &c.UpdatedAt); err != nil {
 		return domain.Conversation{}, err
 	}
 	c.ID = domain.ConversationID(idStr)
+	if projectID != nil {
+		pid := domain.ProjectID(*projectID)
+		c.ProjectID = &pid
+	}
 	if personaID != nil {
 		pid := domain.PersonaID(*personaID)
 		c.PersonaID = &pid
 	}
+	if tagsJSON != nil {
+		_ = json.Unmarshal([]byte(*tagsJSON), &c.Tags)
+	}
+	if contextJSON != nil {
+		_ = json.Unmarshal([]byte(*contextJSON), &c.Context)
+	}
+	if styleData != nil {
+		var style domain.ConversationStyle
+		if json.Unmarshal([]byte(*styleData), &style) == nil {
+			c.Style = &style
+		}
+	}
+	return c, nil
+}
+
+// contextVarsJSON encodes context variables for the context column; none is NULL.
+func contextVarsJSON(vars domain.ContextVars) *string {
+	if len(vars) == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(vars)
+	s := string(data)
+	return &s
+}
+
+// styleJSON encodes style preferences for the style column; none is NULL.
+func styleJSON(style *domain.ConversationStyle) *string {
+	if style == nil || style.IsZero() {
+		return nil
+	}
+	data, _ := json.Marshal(style)
+	s := string(data)
+	return &s
+}
+
+func (r *Repository) GetConversation(ctx context.Context, id domain.ConversationID) (domain.Conversation, error) {
+	c, err := scanConversation(r.db.QueryRowContext(ctx,
+		`SELECT `+conversationColumns+` FROM conversations WHERE id = ?`, id,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Conversation{}, domain.ErrConversationNotFound
+		}
+		return domain.Conversation{}, err
+	}
 	return c, nil
 }
 
 func (r *Repository) ListConversations(ctx context.Context) ([]domain.Conversation, error) {
+	return r.ListConversationsFiltered(ctx, domain.ConversationFilter{})
+}
+
+// ListConversationsFiltered lists conversations matching the filter, pinned
+// ones first, then most recently updated.
+func (r *Repository) ListConversationsFiltered(ctx context.Context, f domain.ConversationFilter) ([]domain.Conversation, error) {
+	where := []string{"1 = 1"}
+	var args []any
+	if f.ProjectID != nil {
+		where = append(where, "project_id = ?")
+		args = append(args, string(*f.ProjectID))
+	}
+	if f.Tag != "" {
+		tag, _ := json.Marshal(strings.ToLower(strings.TrimSpace(f.Tag)))
+		where = append(where, "json_contains(tags, ?)")
+		args = append(args, string(tag))
+	}
+	if f.Folder != nil {
+		where = append(where, "COALESCE(folder, '') = ?")
+		args = append(args, strings.TrimSpace(*f.Folder))
+	}
+	if f.Pinned != nil {
+		where = append(where, "COALESCE(pinned, false) = ?")
+		args = append(args, *f.Pinned)
+	}
+
 	rows, err := r.db.QueryContext(ctx,
-		`SELECT id, title, persona_id, created_at, updated_at FROM conversations ORDER BY updated_at DESC`,
+		`SELECT `+conversationColumns+` FROM conversations WHERE `+strings.Join(where, " AND ")+
+			` ORDER BY COALESCE(pinned, false) DESC, updated_at DESC`, args...,
 	)
 	if err != nil {
 		return nil, err
@@ -478,20 +779,49 @@ func (r *Repository) ListConversations(ctx context.Context) ([]domain.Conversati
 
 	var convs []domain.Conversation
 	for rows.Next() {
-		var c domain.Conversation
-		var idStr string
-		var personaID *string
-		if err := rows.Scan(&idStr, &c.Title, &personaID, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		c, err := scanConversation(rows)
+		if err != nil {
 			return nil, err
 		}
-		c.ID = domain.ConversationID(idStr)
-		if personaID != nil {
-			pid := domain.PersonaID(*personaID)
-			c.PersonaID = &pid
-		}
 		convs = append(convs, c)
 	}
-	return convs, nil
+	return convs, rows.Err()
+}
+
+// UpdateConversationOrganization applies tag, pin and folder changes without
+// touching updated_at, so organizing does not reorder by recency.
+func (r *Repository) UpdateConversationOrganization(ctx context.Context, id domain.ConversationID, p domain.ConversationPatch) error {
+	sets := []string{}
+	var args []any
+	if p.Tags != nil {
+		tags, _ := json.Marshal(domain.NormalizeTags(*p.Tags))
+		sets = append(sets, "tags = ?")
+		args = append(args, string(tags))
+	}
+	if p.Pinned != nil {
+		sets = append(sets, "pinned = ?")
+		args = append(args, *p.Pinned)
+	}
+	if p.Folder != nil {
+		sets = append(sets, "folder = ?")
+		args = append(args, strings.TrimSpace(*p.Folder))
+	}
+	if len(sets) == 0 {
+		_, err := r.GetConversation(ctx, id)
+		return err
+	}
+
+	args = append(args, id)
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE conversations SET `+strings.Join(sets, ", ")+` WHERE id = ?`, args...,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return domain.ErrConversationNotFound
+	}
+	return nil
 }
 
 func (r *Repository) UpdateConversationTitle(ctx context.Context, id domain.ConversationID, title string) error {
@@ -508,6 +838,34 @@ func (r *Repository) UpdateConversationTitle(ctx context.Context, id domain.Conv
 	return nil
 }
 
+// UpdateConversationContext replaces a conversation's context variables.
+func (r *Repository) UpdateConversationContext(ctx context.Context, id domain.ConversationID, vars domain.ContextVars) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE conversations SET context = ? WHERE id = ?`, contextVarsJSON(vars), id,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return domain.ErrConversationNotFound
+	}
+	return nil
+}
+
+// UpdateConversationStyle replaces a conversation's style preferences.
+func (r *Repository) UpdateConversationStyle(ctx context.Context, id domain.ConversationID, style *domain.ConversationStyle) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE conversations SET style = ? WHERE id = ?`, styleJSON(style), id,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return domain.ErrConversationNotFound
+	}
+	return nil
+}
+
 func (r *Repository) DeleteConversation(ctx context.Context, id domain.ConversationID) error {
 	// Delete messages first, then conversation
 	if _, err := r.db.ExecContext(ctx, `DELETE FROM messages WHERE conversation_id = ?`, id); err != nil {
@@ -540,35 +898,183 @@ func (r *Repository) AddMessage(ctx context.Context, msg domain.Message) error {
 	return err
 }
 
+const messageColumns = `id, conversation_id, role, content, thought,
+	CAST(steps AS TEXT), CAST(tool_call AS TEXT), CAST(metadata AS TEXT), created_at, archived_at`
+
 func (r *Repository) ListMessages(ctx context.Context, convID domain.ConversationID, limit int) ([]domain.Message, error) {
-	query := `SELECT id, conversation_id, role, content, thought,
-	          CAST(steps AS TEXT), CAST(tool_call AS TEXT), CAST(metadata AS TEXT), created_at
-	          FROM messages WHERE conversation_id = ? ORDER BY created_at ASC`
 	if limit > 0 {
-		// Get last N messages: subquery to get latest, then order ASC
-		query = fmt.Sprintf(`SELECT * FROM (
-			SELECT id, conversation_id, role, content, thought,
-			       CAST(steps AS TEXT), CAST(tool_call AS TEXT), CAST(metadata AS TEXT), created_at
-			FROM messages WHERE conversation_id = ? ORDER BY created_at DESC LIMIT %d
-		) sub ORDER BY created_at ASC`, limit)
+		msgs, _, err := r.ListMessagesPage(ctx, convID, domain.MessagePage{Limit: limit})
+		return msgs, err
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+messageColumns+` FROM messages WHERE conversation_id = ? AND archived_at IS NULL
+		 ORDER BY created_at ASC, id ASC`, convID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanMessages(rows)
+}
+
+// ListMessagesPage returns one page of a conversation in chronological order.
+// Without a cursor it returns the latest messages; Before pages backwards and
+// After forwards from the given message. hasMore reports whether another page
+// exists in the paging direction.
+func (r *Repository) ListMessagesPage(ctx context.Context, convID domain.ConversationID, page domain.MessagePage) ([]domain.Message, bool, error) {
+	if page.Before != "" && page.After != "" {
+		return nil, false, fmt.Errorf("before and after are mutually exclusive")
+	}
+	limit := page.Limit
+	if limit <= 0 {
+		limit = domain.DefaultMessagePageSize
+	}
+
+	where := `conversation_id = ?`
+	if !page.IncludeArchived {
+		where += ` AND archived_at IS NULL`
+	}
+	args := []any{convID}
+	order := `DESC` // newest first, reversed below
+	cursor := page.Before
+	if page.After != "" {
+		cursor = page.After
+		order = `ASC`
+	}
+	if cursor != "" {
+		at, err := r.messageCreatedAt(ctx, convID, cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		// (created_at, id) keeps the order total when timestamps collide
+		if page.After != "" {
+			where += ` AND (created_at > ? OR (created_at = ? AND id > ?))`
+		} else {
+			where += ` AND (created_at < ? OR (created_at = ? AND id < ?))`
+		}
+		args = append(args, at, at, cursor)
+	}
+	args = append(args, limit+1)
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+messageColumns+` FROM messages WHERE `+where+
+			` ORDER BY created_at `+order+`, id `+order+` LIMIT ?`, args...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+	msgs, err := scanMessages(rows)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(msgs) > limit
+	if hasMore {
+		msgs = msgs[:limit]
+	}
+	if order == `DESC` {
+		for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+			msgs[i], msgs[j] = msgs[j], msgs[i]
+		}
+	}
+	return msgs, hasMore, nil
+}
+
+func (r *Repository) messageCreatedAt(ctx context.Context, convID domain.ConversationID, id domain.MessageID) (time.Time, error) {
+	var at time.Time
+	err := r.db.QueryRowContext(ctx,
+		`SELECT created_at FROM messages WHERE id = ? AND conversation_id = ?`, id, convID).Scan(&at)
+	if err == sql.ErrNoRows {
+		return at, domain.ErrMessageNotFound
+	}
+	return at, err
+}
+
+// ArchiveMessagesFrom archives the given message and every later live
+// message of the conversation, hiding them from listings and agent context.
+func (r *Repository) ArchiveMessagesFrom(ctx context.Context, convID domain.ConversationID, id domain.MessageID) (int, error) {
+	at, err := r.messageCreatedAt(ctx, convID, id)
+	if err != nil {
+		return 0, err
+	}
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE messages SET archived_at = ?
+		 WHERE conversation_id = ? AND archived_at IS NULL
+		   AND (created_at > ? OR (created_at = ? AND id >= ?))`,
+		time.Now(), convID, at, at, id)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
+
+// DeleteMessagesAfter removes every message that follows the given one,
+// archived or not.
+func (r *Repository) DeleteMessagesAfter(ctx context.Context, convID domain.ConversationID, id domain.MessageID) (int, error) {
+	at, err := r.messageCreatedAt(ctx, convID, id)
+	if err != nil {
+		return 0, err
+	}
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM messages
+		 WHERE conversation_id = ? AND (created_at > ? OR (created_at = ? AND id > ?))`,
+		convID, at, at, id)
+	if err != nil {
+		return 0, err
 	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
 
-	rows, err := r.db.QueryContext(ctx, query, convID)
+// UpdateMessage rewrites a message's body in place (used for ReAct checkpoints).
+func (r *Repository) UpdateMessage(ctx context.Context, msg domain.Message) error {
+	stepsJSON, _ := json.Marshal(msg.Steps)
+	toolCallJSON, _ := json.Marshal(msg.ToolCall)
+	metaJSON, _ := json.Marshal(msg.Metadata)
+
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE messages SET content = ?, thought = ?, steps = ?, tool_call = ?, metadata = ? WHERE id = ?`,
+		msg.Content, msg.Thought, string(stepsJSON), string(toolCallJSON), string(metaJSON), msg.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrMessageNotFound
+	}
+	return nil
+}
+
+// ListInProgressMessages returns checkpoint messages of ReAct loops that
+// never finished, across all conversations.
+func (r *Repository) ListInProgressMessages(ctx context.Context) ([]domain.Message, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+messageColumns+`
+		 FROM messages WHERE json_extract_string(metadata, '$.in_progress') = 'true'
+		 ORDER BY created_at ASC`)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
+	return scanMessages(rows)
+}
 
+func scanMessages(rows *sql.Rows) ([]domain.Message, error) {
 	var msgs []domain.Message
 	for rows.Next() {
 		var m domain.Message
 		var idStr, convIDStr, roleStr string
 		var stepsJSON, toolCallJSON, metaJSON string
+		var archivedAt sql.NullTime
 
 		if err := rows.Scan(&idStr, &convIDStr, &roleStr, &m.Content, &m.Thought,
-			&stepsJSON, &toolCallJSON, &metaJSON, &m.CreatedAt); err != nil {
+			&stepsJSON, &toolCallJSON, &metaJSON, &m.CreatedAt, &archivedAt); err != nil {
 			return nil, err
 		}
+		if archivedAt.Valid {
+			m.ArchivedAt = &archivedAt.Time
+		}
 		m.ID = domain.MessageID(idStr)
 		m.ConversationID = domain.ConversationID(convIDStr)
 		m.Role = domain.MessageRole(roleStr)
@@ -579,15 +1085,19 @@ func (r *Repository) ListMessages(ctx context.Context, convID domain.Conversatio
 
 		msgs = append(msgs, m)
 	}
-	return msgs, nil
+	return msgs, rows.Err()
 }
 
 // --- Project Management ---
 
 func (r *Repository) CreateProject(ctx context.Context, proj domain.Project) error {
-	_, err := r.db.ExecContext(ctx,
-		`INSERT INTO projects (id, name, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
-		proj.ID, proj.Name, proj.Description, proj.CreatedAt, proj.UpdatedAt,
+	settingsJSON, err := json.Marshal(proj.Settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal project settings: %w", err)
+	}
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO projects (id, name, description, settings, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		proj.ID, proj.Name, proj.Description, string(settingsJSON), proj.CreatedAt, proj.UpdatedAt,
 	)
 	return err
 }
@@ -595,9 +1105,10 @@ func (r *Repository) CreateProject(ctx context.Context, proj domain.Project) err
 func (r *Repository) GetProject(ctx context.Context, id domain.ProjectID) (domain.Project, error) {
 	var p domain.Project
 	var idStr string
+	var settingsJSON sql.NullString
 	err := r.db.QueryRowContext(ctx,
-		`SELECT id, name, description, created_at, updated_at FROM projects WHERE id = ?`, id,
-	).Scan(&idStr, &p.Name, &p.Description, &p.CreatedAt, &p.UpdatedAt)
+		`SELECT id, name, description, CAST(settings AS TEXT), created_at, updated_at FROM projects WHERE id = ?`, id,
+	).Scan(&idStr, &p.Name, &p.Description, &settingsJSON, &p.CreatedAt, &p.UpdatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return domain.Project{}, domain.ErrProjectNotFound
@@ -605,12 +1116,15 @@ func (r *Repository) GetProject(ctx context.Context, id domain.ProjectID) (domai
 		return domain.Project{}, err
 	}
 	p.ID = domain.ProjectID(idStr)
+	if settingsJSON.Valid {
+		_ = json.Unmarshal([]byte(settingsJSON.String), &p.Settings)
+	}
 	return p, nil
 }
 
 func (r *Repository) ListProjects(ctx context.Context) ([]domain.Project, error) {
 	rows, err := r.db.QueryContext(ctx,
-		`SELECT id, name, description, created_at, updated_at FROM projects ORDER BY updated_at DESC`,
+		`SELECT id, name, description, CAST(settings AS TEXT), created_at, updated_at FROM projects ORDER BY updated_at DESC`,
 	)
 	if err != nil {
 		return nil, err
@@ -621,19 +1135,27 @@ func (r *Repository) ListProjects(ctx context.Context) ([]domain.Project, error)
 	for rows.Next() {
 		var p domain.Project
 		var idStr string
-		if err := rows.Scan(&idStr, &p.Name, &p.Description, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		var settingsJSON sql.NullString
+		if err := rows.Scan(&idStr, &p.Name, &p.Description, &settingsJSON, &p.CreatedAt, &p.UpdatedAt); err != nil {
 			return nil, err
 		}
 		p.ID = domain.ProjectID(idStr)
+		if settingsJSON.Valid {
+			_ = json.Unmarshal([]byte(settingsJSON.String), &p.Settings)
+		}
 		projects = append(projects, p)
 	}
 	return projects, nil
 }
 
 func (r *Repository) UpdateProject(ctx context.Context, proj domain.Project) error {
+	settingsJSON, err := json.Marshal(proj.Settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal project settings: %w", err)
+	}
 	result, err := r.db.ExecContext(ctx,
-		`UPDATE projects SET name = ?, description = ?, updated_at = ? WHERE id = ?`,
-		proj.Name, proj.Description, proj.UpdatedAt, proj.ID,
+		`UPDATE projects SET name = ?, description = ?, settings = ?, updated_at = ? WHERE id = ?`,
+		proj.Name, proj.Description, string(settingsJSON), proj.UpdatedAt, proj.ID,
 	)
 	if err != nil {
 		return err
@@ -666,30 +1188,7 @@ func (r *Repository) DeleteProject(ctx context.Context, id domain.ProjectID) err
 }
 
 func (r *Repository) ListProjectConversations(ctx context.Context, projectID domain.ProjectID) ([]domain.Conversation, error) {
-	rows, err := r.db.QueryContext(ctx,
-		`SELECT id, title, project_id, created_at, updated_at FROM conversations WHERE project_id = ? ORDER BY updated_at DESC`, projectID,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var convs []domain.Conversation
-	for rows.Next() {
-		var c domain.Conversation
-		var idStr string
-		var projID *string
-		if err := rows.Scan(&idStr, &c.Title, &projID, &c.CreatedAt, &c.UpdatedAt); err != nil {
-			return nil, err
-		}
-		c.ID = domain.ConversationID(idStr)
-		if projID != nil {
-			pid := domain.ProjectID(*projID)
-			c.ProjectID = &pid
-		}
-		convs = append(convs, c)
-	}
-	return convs, nil
+	return r.ListConversationsFiltered(ctx, domain.ConversationFilter{ProjectID: &projectID})
 }
 
 // --- Artifact Management ---
@@ -825,8 +1324,8 @@ func (r *Repository) CreatePersona(ctx context.Context, p domain.Persona) error
 	// User-created personas use ON CONFLICT DO NOTHING.
 	var query string
 	if p.IsBuiltin {
-		query = `INSERT INTO personas (id, name, description, system_prompt, icon, color, allowed_tools, model_override, is_builtin, created_at, updated_at)
-		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		query = `INSERT INTO personas (id, name, description, system_prompt, icon, color, allowed_tools, model_override, locale, is_builtin, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		 ON CONFLICT (id) DO UPDATE SET
 			name = excluded.name,
 			description = excluded.description,
@@ -837,13 +1336,13 @@ func (r *Repository) CreatePersona(ctx context.Context, p domain.Persona) error
 			model_override = excluded.model_override,
 			updated_at = excluded.updated_at`
 	} else {
-		query = `INSERT INTO personas (id, name, description, system_prompt, icon, color, allowed_tools, model_override, is_builtin, created_at, updated_at)
-		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		query = `INSERT INTO personas (id, name, description, system_prompt, icon, color, allowed_tools, model_override, locale, is_builtin, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		 ON CONFLICT (id) DO NOTHING`
 	}
 
 	_, err := r.db.ExecContext(ctx, query,
-		p.ID, p.Name, p.Description, p.SystemPrompt, p.Icon, p.Color, string(allowedJSON), p.ModelOverride, p.IsBuiltin, p.CreatedAt, p.UpdatedAt,
+		p.ID, p.Name, p.Description, p.SystemPrompt, p.Icon, p.Color, string(allowedJSON), p.ModelOverride, p.Locale, p.IsBuiltin, p.CreatedAt, p.UpdatedAt,
 	)
 	return err
 }
@@ -853,9 +1352,9 @@ func (r *Repository) GetPersona(ctx context.Context, id domain.PersonaID) (domai
 	var idStr, allowedJSON string
 	var modelOverride sql.NullString
 	err := r.db.QueryRowContext(ctx,
-		`SELECT id, name, description, system_prompt, icon, color, CAST(allowed_tools AS TEXT), model_override, is_builtin, created_at, updated_at
+		`SELECT id, name, description, system_prompt, icon, color, CAST(allowed_tools AS TEXT), model_override, COALESCE(locale, ''), is_builtin, created_at, updated_at
 		 FROM personas WHERE id = ?`, id,
-	).Scan(&idStr, &p.Name, &p.Description, &p.SystemPrompt, &p.Icon, &p.Color, &allowedJSON, &modelOverride, &p.IsBuiltin, &p.CreatedAt, &p.UpdatedAt)
+	).Scan(&idStr, &p.Name, &p.Description, &p.SystemPrompt, &p.Icon, &p.Color, &allowedJSON, &modelOverride, &p.Locale, &p.IsBuiltin, &p.CreatedAt, &p.UpdatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return domain.Persona{}, domain.ErrPersonaNotFound
@@ -872,7 +1371,7 @@ func (r *Repository) GetPersona(ctx context.Context, id domain.PersonaID) (domai
 
 func (r *Repository) ListPersonas(ctx context.Context) ([]domain.Persona, error) {
 	rows, err := r.db.QueryContext(ctx,
-		`SELECT id, name, description, system_prompt, icon, color, CAST(allowed_tools AS TEXT), model_override, is_builtin, created_at, updated_at
+		`SELECT id, name, description, system_prompt, icon, color, CAST(allowed_tools AS TEXT), model_override, COALESCE(locale, ''), is_builtin, created_at, updated_at
 		 FROM personas ORDER BY is_builtin DESC, name ASC`,
 	)
 	if err != nil {
@@ -885,7 +1384,7 @@ func (r *Repository) ListPersonas(ctx context.Context) ([]domain.Persona, error)
 		var p domain.Persona
 		var idStr, allowedJSON string
 		var modelOverride sql.NullString
-		if err := rows.Scan(&idStr, &p.Name, &p.Description, &p.SystemPrompt, &p.Icon, &p.Color, &allowedJSON, &modelOverride, &p.IsBuiltin, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		if err := rows.Scan(&idStr, &p.Name, &p.Description, &p.SystemPrompt, &p.Icon, &p.Color, &allowedJSON, &modelOverride, &p.Locale, &p.IsBuiltin, &p.CreatedAt, &p.UpdatedAt); err != nil {
 			return nil, err
 		}
 		p.ID = domain.PersonaID(idStr)
@@ -901,8 +1400,8 @@ func (r *Repository) ListPersonas(ctx context.Context) ([]domain.Persona, error)
 func (r *Repository) UpdatePersona(ctx context.Context, p domain.Persona) error {
 	allowedJSON, _ := json.Marshal(p.AllowedTools)
 	result, err := r.db.ExecContext(ctx,
-		`UPDATE personas SET name = ?, description = ?, system_prompt = ?, icon = ?, color = ?, allowed_tools = ?, model_override = ?, updated_at = ? WHERE id = ?`,
-		p.Name, p.Description, p.SystemPrompt, p.Icon, p.Color, string(allowedJSON), p.ModelOverride, p.UpdatedAt, p.ID,
+		`UPDATE personas SET name = ?, description = ?, system_prompt = ?, icon = ?, color = ?, allowed_tools = ?, model_override = ?, locale = ?, updated_at = ? WHERE id = ?`,
+		p.Name, p.Description, p.SystemPrompt, p.Icon, p.Color, string(allowedJSON), p.ModelOverride, p.Locale, p.UpdatedAt, p.ID,
 	)
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package duckdb
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -62,6 +63,30 @@ func TestRepository_Jobs(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, jobs, 1)
 	assert.Equal(t, jobID, jobs[0].ID)
+	assert.Nil(t, jobs[0].Output)
+
+	// 5. Structured output round-trips and keeps the legacy result URL
+	job.Status = domain.JobStatusCompleted
+	job.SetOutput(domain.JobResult{
+		Summary: "Generated 2 images",
+		Files: []domain.JobFile{
+			{Name: "result-v1-1.png", URL: "http://k/v1/jobs/job-1/files/result-v1-1.png", MimeType: "image/png", SizeBytes: 10, ArtifactID: "art-1"},
+			{Name: "result-v1-2.png", URL: "http://k/v1/jobs/job-1/files/result-v1-2.png", MimeType: "image/png"},
+		},
+		Data: map[string]interface{}{"seed": float64(7)},
+	})
+	require.NoError(t, repo.SaveJob(ctx, job))
+
+	fetched3, err := repo.GetJob(ctx, jobID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched3.Result)
+	assert.Equal(t, "http://k/v1/jobs/job-1/files/result-v1-1.png", *fetched3.Result)
+	assert.Equal(t, job.Output, fetched3.Output)
+
+	jobs, err = repo.ListJobs(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, jobs[0].Output)
+	assert.Len(t, jobs[0].Output.Files, 2)
 }
 
 func TestRepository_Workers(t *testing.T) {
@@ -96,3 +121,680 @@ func TestRepository_Workers(t *testing.T) {
     require.NoError(t, err)
     assert.Equal(t, domain.HealthStatusHealthy, got2.Status)
 }
+
+func TestRepository_ProjectSettings(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/projects.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	persona := domain.PersonaID("pers-coder")
+	proj := domain.Project{
+		ID:        domain.NewProjectID(),
+		Name:      "backend",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Settings: domain.ProjectSettings{
+			DefaultPersonaID:  &persona,
+			DefaultModel:      "qwen2.5-coder:3b",
+			AllowedTools:      []string{"read_file", "exec"},
+			HeartbeatInterval: 600,
+		},
+	}
+	require.NoError(t, repo.CreateProject(ctx, proj))
+
+	got, err := repo.GetProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.Settings.DefaultPersonaID)
+	assert.Equal(t, persona, *got.Settings.DefaultPersonaID)
+	assert.Equal(t, "qwen2.5-coder:3b", got.Settings.DefaultModel)
+	assert.Equal(t, []string{"read_file", "exec"}, got.Settings.AllowedTools)
+	assert.Equal(t, 10*time.Minute, got.Settings.HeartbeatEvery(time.Hour))
+
+	// Update clears the defaults
+	got.Settings = domain.ProjectSettings{}
+	require.NoError(t, repo.UpdateProject(ctx, got))
+	got, err = repo.GetProject(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.Settings.DefaultPersonaID)
+	assert.Equal(t, time.Hour, got.Settings.HeartbeatEvery(time.Hour))
+
+	// Conversations keep their project link
+	conv := domain.Conversation{
+		ID:        domain.NewConversationID(),
+		ProjectID: &proj.ID,
+		PersonaID: &persona,
+		Title:     "in project",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	require.NoError(t, repo.CreateConversation(ctx, conv))
+	fetched, err := repo.GetConversation(ctx, conv.ID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.ProjectID)
+	assert.Equal(t, proj.ID, *fetched.ProjectID)
+
+	convs, err := repo.ListProjectConversations(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, convs, 1)
+	require.NotNil(t, convs[0].PersonaID)
+	assert.Equal(t, persona, *convs[0].PersonaID)
+}
+
+func TestRepository_SubAgentTasks(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/subagents.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	conv := domain.ConversationID("conv-tree")
+	start := time.Now().Add(-time.Minute)
+	root := domain.SubAgentTask{ID: "sa-root", ConversationID: conv, PersonaName: "Researcher", Prompt: "plan", Status: domain.SubAgentStatusRunning, StartedAt: start}
+	child := domain.SubAgentTask{ID: "sa-child", ParentID: "sa-root", ConversationID: conv, PersonaName: "Coder", Prompt: "code", Status: domain.SubAgentStatusRunning, StartedAt: start.Add(time.Second)}
+	require.NoError(t, repo.SaveSubAgentTask(ctx, root))
+	require.NoError(t, repo.SaveSubAgentTask(ctx, child))
+
+	// Completion updates the same row
+	fin := time.Now()
+	child.Status = domain.SubAgentStatusDone
+	child.Result = "done"
+	child.Steps = []domain.ReActStep{{Thought: "write it", IsFinalAnswer: true, FinalAnswer: "done"}}
+	child.FinishedAt = &fin
+	require.NoError(t, repo.SaveSubAgentTask(ctx, child))
+
+	tasks, err := repo.ListConversationSubAgents(ctx, conv)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, domain.SubAgentStatusDone, tasks[1].Status)
+	require.Len(t, tasks[1].Steps, 1)
+	assert.Equal(t, "write it", tasks[1].Steps[0].Thought)
+	assert.NotNil(t, tasks[1].FinishedAt)
+
+	tree := domain.BuildSubAgentTree(tasks)
+	require.Len(t, tree, 1)
+	assert.Equal(t, domain.SubAgentID("sa-root"), tree[0].ID)
+	require.Len(t, tree[0].Children, 1)
+	assert.Equal(t, domain.SubAgentID("sa-child"), tree[0].Children[0].ID)
+}
+
+func TestRepository_TraceFilters(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/traces.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+	save := func(id, conv, persona string, status domain.SpanStatus, offset time.Duration) {
+		end := base.Add(offset + time.Second)
+		require.NoError(t, repo.SaveTrace(ctx, &domain.Trace{
+			ID: domain.TraceID(id), RootSpanID: domain.SpanID(id + "-root"), Name: "chat: " + id,
+			Status: status, ConversationID: conv, PersonaID: persona, RequestID: "req-" + id,
+			StartTime: base.Add(offset), EndTime: &end, DurationMs: 1000, SpanCount: 1,
+		}))
+	}
+	save("t1", "conv-a", "pers-1", domain.SpanStatusOK, 0)
+	save("t2", "conv-a", "pers-2", domain.SpanStatusError, time.Minute)
+	save("t3", "conv-b", "pers-1", domain.SpanStatusOK, 2*time.Minute)
+
+	all, err := repo.ListTraces(ctx, domain.TraceFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, domain.TraceID("t3"), all[0].ID, "newest first")
+
+	byConv, err := repo.ListTraces(ctx, domain.TraceFilter{ConversationID: "conv-a", Status: domain.SpanStatusError})
+	require.NoError(t, err)
+	require.Len(t, byConv, 1)
+	assert.Equal(t, domain.TraceID("t2"), byConv[0].ID)
+	assert.Equal(t, "pers-2", byConv[0].PersonaID)
+
+	window, err := repo.ListTraces(ctx, domain.TraceFilter{PersonaID: "pers-1", Since: base.Add(30 * time.Second)})
+	require.NoError(t, err)
+	require.Len(t, window, 1)
+	assert.Equal(t, domain.TraceID("t3"), window[0].ID)
+
+	byRequest, err := repo.ListTraces(ctx, domain.TraceFilter{RequestID: "req-t1"})
+	require.NoError(t, err)
+	require.Len(t, byRequest, 1)
+	assert.Equal(t, domain.TraceID("t1"), byRequest[0].ID)
+
+	full, err := repo.GetTrace(ctx, "t2")
+	require.NoError(t, err)
+	assert.Equal(t, "req-t2", full.RequestID)
+
+	_, err = repo.GetTrace(ctx, "missing")
+	assert.ErrorIs(t, err, domain.ErrTraceNotFound)
+}
+
+func TestRepository_SearchSpans(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/spans.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	start := time.Now().Add(-time.Minute).Truncate(time.Second)
+	end := start.Add(5 * time.Second)
+	require.NoError(t, repo.SaveTrace(ctx, &domain.Trace{
+		ID: "tr-1", RootSpanID: "sp-root", Name: "chat", Status: domain.SpanStatusError,
+		ConversationID: "conv-x", StartTime: start, EndTime: &end, DurationMs: 5000, SpanCount: 3,
+		Spans: []domain.Span{
+			{ID: "sp-root", TraceID: "tr-1", Name: "agent.chat", Kind: domain.SpanKindAgent, Status: domain.SpanStatusError, StartTime: start, DurationMs: 5000},
+			{ID: "sp-llm", TraceID: "tr-1", ParentID: "sp-root", Name: "llm.generate", Kind: domain.SpanKindLLM, Status: domain.SpanStatusError, StartTime: start.Add(time.Second), DurationMs: 300},
+			{ID: "sp-tool", TraceID: "tr-1", ParentID: "sp-root", Name: "tool.web_fetch", Kind: domain.SpanKindTool, Status: domain.SpanStatusOK, StartTime: start.Add(2 * time.Second), DurationMs: 2500},
+		},
+	}))
+
+	slowTools, err := repo.SearchSpans(ctx, domain.SpanFilter{Kind: domain.SpanKindTool, MinDurationMs: 1000})
+	require.NoError(t, err)
+	require.Len(t, slowTools, 1)
+	assert.Equal(t, domain.SpanID("sp-tool"), slowTools[0].ID)
+
+	failedLLM, err := repo.SearchSpans(ctx, domain.SpanFilter{NamePrefix: "llm.", Status: domain.SpanStatusError, ConversationID: "conv-x"})
+	require.NoError(t, err)
+	require.Len(t, failedLLM, 1)
+	assert.Equal(t, domain.SpanID("sp-llm"), failedLLM[0].ID)
+
+	none, err := repo.SearchSpans(ctx, domain.SpanFilter{ConversationID: "conv-other"})
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	slowTraces, err := repo.ListTraces(ctx, domain.TraceFilter{MinDurationMs: 4000})
+	require.NoError(t, err)
+	assert.Len(t, slowTraces, 1)
+}
+
+func TestRepository_MessageCheckpoints(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	convID := domain.ConversationID("conv-1")
+	require.NoError(t, repo.CreateConversation(ctx, domain.Conversation{ID: convID, Title: "t", CreatedAt: time.Now(), UpdatedAt: time.Now()}))
+
+	msg := domain.Message{
+		ID:             domain.NewMessageID(),
+		ConversationID: convID,
+		Role:           domain.RoleAssistant,
+		Content:        "Working… (step 1)",
+		Steps:          []domain.ReActStep{{Action: "list_files", Observation: "[]"}},
+		Metadata:       map[string]interface{}{"in_progress": true},
+		CreatedAt:      time.Now(),
+	}
+	require.NoError(t, repo.AddMessage(ctx, msg))
+
+	pending, err := repo.ListInProgressMessages(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, msg.ID, pending[0].ID)
+
+	msg.Content = "done"
+	msg.Metadata = nil
+	require.NoError(t, repo.UpdateMessage(ctx, msg))
+
+	pending, err = repo.ListInProgressMessages(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	msgs, err := repo.ListMessages(ctx, convID, 0)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "done", msgs[0].Content)
+	assert.Equal(t, "[]", msgs[0].Steps[0].Observation)
+
+	assert.ErrorIs(t, repo.UpdateMessage(ctx, domain.Message{ID: "missing"}), domain.ErrMessageNotFound)
+}
+
+func TestRepository_ListMessagesPage(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	convID := domain.ConversationID("conv-1")
+	require.NoError(t, repo.CreateConversation(ctx, domain.Conversation{ID: convID, Title: "t", CreatedAt: time.Now(), UpdatedAt: time.Now()}))
+	base := time.Now().Add(-time.Hour)
+	ids := make([]domain.MessageID, 5)
+	for i := range ids {
+		ids[i] = domain.MessageID(fmt.Sprintf("msg-%d", i))
+		require.NoError(t, repo.AddMessage(ctx, domain.Message{
+			ID: ids[i], ConversationID: convID, Role: domain.RoleUser,
+			Content: fmt.Sprint(i), CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+	pageIDs := func(msgs []domain.Message) []domain.MessageID {
+		out := make([]domain.MessageID, len(msgs))
+		for i, m := range msgs {
+			out[i] = m.ID
+		}
+		return out
+	}
+
+	latest, more, err := repo.ListMessagesPage(ctx, convID, domain.MessagePage{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, ids[3:5], pageIDs(latest))
+	assert.True(t, more)
+
+	older, more, err := repo.ListMessagesPage(ctx, convID, domain.MessagePage{Before: ids[3], Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, ids[1:3], pageIDs(older))
+	assert.True(t, more)
+
+	oldest, more, err := repo.ListMessagesPage(ctx, convID, domain.MessagePage{Before: ids[1], Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, ids[0:1], pageIDs(oldest))
+	assert.False(t, more)
+
+	newer, more, err := repo.ListMessagesPage(ctx, convID, domain.MessagePage{After: ids[2], Limit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, ids[3:5], pageIDs(newer))
+	assert.False(t, more)
+
+	_, _, err = repo.ListMessagesPage(ctx, convID, domain.MessagePage{Before: "nope"})
+	assert.ErrorIs(t, err, domain.ErrMessageNotFound)
+}
+
+func TestRepository_ConversationOrganization(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	now := time.Now()
+	for i, id := range []domain.ConversationID{"conv-a", "conv-b", "conv-c"} {
+		require.NoError(t, repo.CreateConversation(ctx, domain.Conversation{
+			ID: id, Title: string(id), CreatedAt: now, UpdatedAt: now.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+
+	pinned, folder := true, "Research"
+	tags := []string{" Go ", "duckdb", "go"}
+	require.NoError(t, repo.UpdateConversationOrganization(ctx, "conv-a", domain.ConversationPatch{Tags: &tags, Pinned: &pinned, Folder: &folder}))
+
+	got, err := repo.GetConversation(ctx, "conv-a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "duckdb"}, got.Tags)
+	assert.True(t, got.Pinned)
+	assert.Equal(t, "Research", got.Folder)
+
+	// Pinned first, then most recently updated
+	all, err := repo.ListConversations(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, []domain.ConversationID{"conv-a", "conv-c", "conv-b"}, []domain.ConversationID{all[0].ID, all[1].ID, all[2].ID})
+
+	byTag, err := repo.ListConversationsFiltered(ctx, domain.ConversationFilter{Tag: "GO"})
+	require.NoError(t, err)
+	require.Len(t, byTag, 1)
+	assert.Equal(t, domain.ConversationID("conv-a"), byTag[0].ID)
+
+	unfiled := ""
+	loose, err := repo.ListConversationsFiltered(ctx, domain.ConversationFilter{Folder: &unfiled})
+	require.NoError(t, err)
+	assert.Len(t, loose, 2)
+
+	notPinned := false
+	rest, err := repo.ListConversationsFiltered(ctx, domain.ConversationFilter{Pinned: &notPinned, Folder: &folder})
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+
+	assert.ErrorIs(t, repo.UpdateConversationOrganization(ctx, "missing", domain.ConversationPatch{Pinned: &pinned}), domain.ErrConversationNotFound)
+}
+
+func TestRepository_ConversationContext(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	now := time.Now()
+	require.NoError(t, repo.CreateConversation(ctx, domain.Conversation{ID: "conv-ctx", Title: "ctx", CreatedAt: now, UpdatedAt: now}))
+
+	vars := domain.ContextVars{"open_file": "main.go", "location": map[string]interface{}{"city": "Lisbon"}}
+	require.NoError(t, repo.UpdateConversationContext(ctx, "conv-ctx", vars))
+	got, err := repo.GetConversation(ctx, "conv-ctx")
+	require.NoError(t, err)
+	assert.Equal(t, vars, got.Context)
+
+	require.NoError(t, repo.UpdateConversationContext(ctx, "conv-ctx", nil))
+	got, err = repo.GetConversation(ctx, "conv-ctx")
+	require.NoError(t, err)
+	assert.Empty(t, got.Context)
+
+	assert.ErrorIs(t, repo.UpdateConversationContext(ctx, "missing", vars), domain.ErrConversationNotFound)
+}
+
+func TestRepository_ConversationStyle(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	now := time.Now()
+	require.NoError(t, repo.CreateConversation(ctx, domain.Conversation{ID: "conv-style", Title: "style", CreatedAt: now, UpdatedAt: now}))
+	got, err := repo.GetConversation(ctx, "conv-style")
+	require.NoError(t, err)
+	assert.Nil(t, got.Style)
+
+	style := &domain.ConversationStyle{Tone: "friendly", Verbosity: domain.VerbosityBrief, Language: "pt-BR"}
+	require.NoError(t, repo.UpdateConversationStyle(ctx, "conv-style", style))
+	got, err = repo.GetConversation(ctx, "conv-style")
+	require.NoError(t, err)
+	assert.Equal(t, style, got.Style)
+
+	// An empty style clears the column
+	require.NoError(t, repo.UpdateConversationStyle(ctx, "conv-style", &domain.ConversationStyle{}))
+	got, err = repo.GetConversation(ctx, "conv-style")
+	require.NoError(t, err)
+	assert.Nil(t, got.Style)
+
+	assert.ErrorIs(t, repo.UpdateConversationStyle(ctx, "missing", style), domain.ErrConversationNotFound)
+}
+
+func TestRepository_ArchiveAndTruncateMessages(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	convID := domain.ConversationID("conv-1")
+	require.NoError(t, repo.CreateConversation(ctx, domain.Conversation{ID: convID, Title: "t", CreatedAt: time.Now(), UpdatedAt: time.Now()}))
+	base := time.Now().Add(-time.Hour)
+	ids := make([]domain.MessageID, 4)
+	for i := range ids {
+		ids[i] = domain.MessageID(fmt.Sprintf("msg-%d", i))
+		require.NoError(t, repo.AddMessage(ctx, domain.Message{
+			ID: ids[i], ConversationID: convID, Role: domain.RoleUser,
+			Content: fmt.Sprint(i), CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+
+	n, err := repo.ArchiveMessagesFrom(ctx, convID, ids[2])
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	live, err := repo.ListMessages(ctx, convID, 0)
+	require.NoError(t, err)
+	assert.Len(t, live, 2)
+
+	all, _, err := repo.ListMessagesPage(ctx, convID, domain.MessagePage{IncludeArchived: true})
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+	assert.Nil(t, all[1].ArchivedAt)
+	assert.NotNil(t, all[2].ArchivedAt)
+
+	n, err = repo.DeleteMessagesAfter(ctx, convID, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, 3, n, "archived messages are truncated too")
+
+	_, err = repo.ArchiveMessagesFrom(ctx, convID, "missing")
+	assert.ErrorIs(t, err, domain.ErrMessageNotFound)
+}
+
+func TestRepository_ProviderCaptures(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/captures.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	start := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	require.NoError(t, repo.SaveTrace(ctx, &domain.Trace{ID: "tr-old", RootSpanID: "sp-root", Name: "chat", Status: domain.SpanStatusOK, StartTime: start}))
+	for i, id := range []string{"cap-1", "cap-2"} {
+		require.NoError(t, repo.SaveProviderCapture(ctx, domain.ProviderCapture{
+			ID: id, TraceID: "tr-old", SpanID: "sp-llm", Method: "POST", URL: "http://llm/api/generate",
+			StatusCode: 200, Request: `{"prompt":"hi"}`, Response: `{"response":"hello"}`, DurationMs: 12,
+			CreatedAt: start.Add(time.Duration(i) * time.Second),
+		}))
+	}
+
+	captures, err := repo.ListProviderCaptures(ctx, "tr-old")
+	require.NoError(t, err)
+	require.Len(t, captures, 2)
+	assert.Equal(t, "cap-1", captures[0].ID, "oldest first")
+	assert.Equal(t, domain.SpanID("sp-llm"), captures[0].SpanID)
+	assert.Equal(t, `{"prompt":"hi"}`, captures[0].Request)
+	assert.Equal(t, 200, captures[0].StatusCode)
+
+	n, err := repo.CountTracesBefore(ctx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	// Pruning a trace drops its captures
+	_, err = repo.DeleteTracesBefore(ctx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	captures, err = repo.ListProviderCaptures(ctx, "tr-old")
+	require.NoError(t, err)
+	assert.Empty(t, captures)
+}
+
+func TestRepository_DeleteJob(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/jobs.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	require.NoError(t, repo.SaveJob(ctx, domain.Job{ID: "job-1", Status: domain.JobStatusFailed, CreatedAt: now, UpdatedAt: now}))
+	require.NoError(t, repo.DeleteJob(ctx, "job-1"))
+	_, err = repo.GetJob(ctx, "job-1")
+	assert.ErrorIs(t, err, domain.ErrJobNotFound)
+	assert.ErrorIs(t, repo.DeleteJob(ctx, "job-1"), domain.ErrJobNotFound)
+}
+
+func TestRepository_MessageFeedback(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	persona := domain.PersonaID("p-1")
+	convID := domain.ConversationID("conv-1")
+	now := time.Now()
+	require.NoError(t, repo.CreateConversation(ctx, domain.Conversation{ID: convID, PersonaID: &persona, Title: "t", CreatedAt: now, UpdatedAt: now}))
+	require.NoError(t, repo.AddMessage(ctx, domain.Message{ID: "msg-q", ConversationID: convID, Role: domain.RoleUser, Content: "what is 2+2?", CreatedAt: now}))
+	require.NoError(t, repo.AddMessage(ctx, domain.Message{
+		ID: "msg-a", ConversationID: convID, Role: domain.RoleAssistant, Content: "5",
+		Metadata: map[string]interface{}{"trace_id": "tr-1", "model": "llama3.2"}, CreatedAt: now.Add(time.Second),
+	}))
+
+	_, err = repo.GetMessageFeedback(ctx, "msg-a")
+	assert.ErrorIs(t, err, domain.ErrFeedbackNotFound)
+
+	fb := domain.MessageFeedback{MessageID: "msg-a", ConversationID: convID, TraceID: "tr-1", Rating: domain.FeedbackUp, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, repo.SaveMessageFeedback(ctx, fb))
+	fb.Rating, fb.Comment, fb.UpdatedAt = domain.FeedbackDown, "wrong answer", now.Add(time.Minute)
+	require.NoError(t, repo.SaveMessageFeedback(ctx, fb))
+
+	got, err := repo.GetMessageFeedback(ctx, "msg-a")
+	require.NoError(t, err)
+	assert.Equal(t, domain.FeedbackDown, got.Rating)
+	assert.Equal(t, "wrong answer", got.Comment)
+
+	records, err := repo.ListFeedbackRecords(ctx, domain.FeedbackFilter{Rating: domain.FeedbackDown})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "what is 2+2?", records[0].Prompt)
+	assert.Equal(t, "5", records[0].Response)
+	assert.Equal(t, "llama3.2", records[0].Model)
+	assert.Equal(t, domain.TraceID("tr-1"), records[0].TraceID)
+	assert.Equal(t, &persona, records[0].PersonaID)
+
+	records, err = repo.ListFeedbackRecords(ctx, domain.FeedbackFilter{Rating: domain.FeedbackUp})
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestRepository_Feeds(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	persona := domain.PersonaID("pers-researcher")
+	now := time.Now().UTC().Truncate(time.Second)
+	f := &domain.FeedWatch{ID: "feed-1", ProjectID: "proj-1", Name: "Go blog", URL: "https://go.dev/blog/feed.atom",
+		Prompt: "summarize", PersonaID: &persona, IntervalSec: 900, CreatedAt: now}
+	require.NoError(t, repo.SaveFeed(ctx, f))
+	require.NoError(t, repo.SaveFeed(ctx, &domain.FeedWatch{ID: "feed-2", URL: "https://example.com/rss", Prompt: "x", IntervalSec: 600, CreatedAt: now.Add(time.Second)}))
+
+	f.LastChecked, f.LastError, f.TriggerCount = &now, "timeout", 2
+	require.NoError(t, repo.SaveFeed(ctx, f))
+	got, err := repo.GetFeed(ctx, "feed-1")
+	require.NoError(t, err)
+	assert.Equal(t, "timeout", got.LastError)
+	assert.Equal(t, 2, got.TriggerCount)
+	assert.Equal(t, &persona, got.PersonaID)
+	require.NotNil(t, got.LastChecked)
+	assert.True(t, now.Equal(*got.LastChecked))
+
+	feeds, err := repo.ListFeeds(ctx, "proj-1")
+	require.NoError(t, err)
+	assert.Len(t, feeds, 1)
+	feeds, err = repo.ListFeeds(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, feeds, 2)
+
+	require.NoError(t, repo.MarkFeedItemsSeen(ctx, "feed-1", []string{"a", "b"}, now))
+	require.NoError(t, repo.MarkFeedItemsSeen(ctx, "feed-1", []string{"b"}, now))
+	unseen, err := repo.FilterUnseenFeedItems(ctx, "feed-1", []string{"c", "a", "d", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, unseen)
+	unseen, err = repo.FilterUnseenFeedItems(ctx, "feed-2", []string{"a"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, unseen, "dedup state is per feed")
+
+	require.NoError(t, repo.DeleteFeed(ctx, "feed-1"))
+	assert.ErrorIs(t, repo.DeleteFeed(ctx, "feed-1"), domain.ErrFeedNotFound)
+	_, err = repo.GetFeed(ctx, "feed-1")
+	assert.ErrorIs(t, err, domain.ErrFeedNotFound)
+	unseen, err = repo.FilterUnseenFeedItems(ctx, "feed-1", []string{"a"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, unseen)
+}
+
+func TestRepository_FileTriggers(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	ft := &domain.FileTrigger{ID: "ft-1", ProjectID: "proj-1", Name: "inbox", Path: "/home/u/inbox",
+		Patterns: []string{"*.pdf", "docs/*.md"}, Recursive: true, Events: []domain.FileOp{domain.FileOpCreate},
+		Prompt: "summarize", DebounceSec: 10, CreatedAt: now}
+	require.NoError(t, repo.SaveFileTrigger(ctx, ft))
+	require.NoError(t, repo.SaveFileTrigger(ctx, &domain.FileTrigger{ID: "ft-2", Path: "/tmp/drop", WorkflowID: "wf-1", DebounceSec: 5, CreatedAt: now.Add(time.Second)}))
+
+	ft.LastTriggered, ft.TriggerCount, ft.Paused = &now, 3, true
+	require.NoError(t, repo.SaveFileTrigger(ctx, ft))
+	got, err := repo.GetFileTrigger(ctx, "ft-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"*.pdf", "docs/*.md"}, got.Patterns)
+	assert.Equal(t, []domain.FileOp{domain.FileOpCreate}, got.Events)
+	assert.True(t, got.Recursive)
+	assert.True(t, got.Paused)
+	assert.Equal(t, 3, got.TriggerCount)
+	require.NotNil(t, got.LastTriggered)
+	assert.True(t, now.Equal(*got.LastTriggered))
+
+	triggers, err := repo.ListFileTriggers(ctx, "proj-1")
+	require.NoError(t, err)
+	assert.Len(t, triggers, 1)
+	triggers, err = repo.ListFileTriggers(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, triggers, 2)
+	assert.Empty(t, triggers[1].Patterns)
+	assert.Equal(t, domain.WorkflowID("wf-1"), triggers[1].WorkflowID)
+
+	require.NoError(t, repo.DeleteFileTrigger(ctx, "ft-1"))
+	assert.ErrorIs(t, repo.DeleteFileTrigger(ctx, "ft-1"), domain.ErrFileTriggerNotFound)
+	_, err = repo.GetFileTrigger(ctx, "ft-1")
+	assert.ErrorIs(t, err, domain.ErrFileTriggerNotFound)
+}
+
+func TestRepository_Automations(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	a := &domain.Automation{ID: "auto-1", ProjectID: "proj-1", Name: "nightly digest", Enabled: true,
+		Trigger:    domain.AutomationTrigger{Kind: domain.AutomationTriggerCron, CronExpr: "0 7 * * *"},
+		Conditions: []domain.AutomationCondition{{Field: "title", Op: "contains", Value: "release"}},
+		Action:     domain.AutomationAction{Kind: domain.AutomationActionTool, Tool: "send_message", Args: map[string]interface{}{"text": "{{event.title}}"}},
+		NextRun:    &now, CreatedAt: now, CreatedBy: "agent"}
+	require.NoError(t, repo.SaveAutomation(ctx, a))
+	require.NoError(t, repo.SaveAutomation(ctx, &domain.Automation{ID: "auto-2", Name: "hook", CreatedAt: now.Add(time.Second),
+		Trigger: domain.AutomationTrigger{Kind: domain.AutomationTriggerWebhook, WebhookToken: "tok"},
+		Action:  domain.AutomationAction{Kind: domain.AutomationActionPrompt, Prompt: "x"}}))
+
+	a.LastRun, a.RunCount, a.LastError = &now, 2, "boom"
+	require.NoError(t, repo.SaveAutomation(ctx, a))
+	got, err := repo.GetAutomation(ctx, "auto-1")
+	require.NoError(t, err)
+	assert.Equal(t, a.Trigger, got.Trigger)
+	assert.Equal(t, a.Conditions, got.Conditions)
+	assert.Equal(t, "{{event.title}}", got.Action.Args["text"])
+	assert.Equal(t, 2, got.RunCount)
+	assert.Equal(t, "boom", got.LastError)
+	assert.True(t, got.Enabled)
+	require.NotNil(t, got.NextRun)
+	assert.True(t, now.Equal(*got.NextRun))
+
+	list, err := repo.ListAutomations(ctx, "proj-1")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+	list, err = repo.ListAutomations(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "tok", list[1].Trigger.WebhookToken)
+	assert.False(t, list[1].Enabled)
+
+	require.NoError(t, repo.DeleteAutomation(ctx, "auto-1"))
+	assert.ErrorIs(t, repo.DeleteAutomation(ctx, "auto-1"), domain.ErrAutomationNotFound)
+	_, err = repo.GetAutomation(ctx, "auto-1")
+	assert.ErrorIs(t, err, domain.ErrAutomationNotFound)
+}
+
+func TestRepository_Usage(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	require.NoError(t, repo.RecordUsage(ctx, domain.UsageRecord{ConversationID: "conv-1", ProjectID: "proj-1", Model: "m", PromptTokens: 100, CompletionTokens: 20, CostUSD: 0.5, CreatedAt: now}))
+	require.NoError(t, repo.RecordUsage(ctx, domain.UsageRecord{ConversationID: "conv-1", ProjectID: "proj-1", PromptTokens: 150, CompletionTokens: 30, CreatedAt: now}))
+	require.NoError(t, repo.RecordUsage(ctx, domain.UsageRecord{ConversationID: "conv-2", ProjectID: "proj-1", PromptTokens: 10, CompletionTokens: 1, CostUSD: 0.25, CreatedAt: now}))
+
+	tot, err := repo.UsageTotals(ctx, domain.UsageScopeConversation, "conv-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.UsageTotals{Calls: 2, PromptTokens: 250, CompletionTokens: 50, CostUSD: 0.5}, tot)
+	tot, err = repo.UsageTotals(ctx, domain.UsageScopeProject, "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, 311, tot.Tokens())
+	assert.InDelta(t, 0.75, tot.CostUSD, 1e-9)
+	tot, err = repo.UsageTotals(ctx, domain.UsageScopeConversation, "none")
+	require.NoError(t, err)
+	assert.Zero(t, tot)
+
+	_, err = repo.GetUsageLimit(ctx, domain.UsageScopeProject, "proj-1")
+	assert.ErrorIs(t, err, domain.ErrUsageLimitNotFound)
+	require.NoError(t, repo.SaveUsageLimit(ctx, domain.UsageLimit{Scope: domain.UsageScopeProject, ScopeID: "proj-1", MaxTokens: 1000, UpdatedAt: now}))
+	require.NoError(t, repo.SaveUsageLimit(ctx, domain.UsageLimit{Scope: domain.UsageScopeProject, ScopeID: "proj-1", MaxTokens: 2000, MaxCostUSD: 1.5, UpdatedAt: now}))
+	l, err := repo.GetUsageLimit(ctx, domain.UsageScopeProject, "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2000, l.MaxTokens)
+	assert.Equal(t, 1.5, l.MaxCostUSD)
+	_, err = repo.GetUsageLimit(ctx, domain.UsageScopeConversation, "proj-1")
+	assert.ErrorIs(t, err, domain.ErrUsageLimitNotFound, "limits are per scope")
+
+	require.NoError(t, repo.DeleteUsageLimit(ctx, domain.UsageScopeProject, "proj-1"))
+	assert.ErrorIs(t, repo.DeleteUsageLimit(ctx, domain.UsageScopeProject, "proj-1"), domain.ErrUsageLimitNotFound)
+}
+
+func TestRepository_PluginKV(t *testing.T) {
+	repo, err := NewRepository(t.TempDir() + "/test.db")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = repo.GetPluginKV(ctx, "counter/p1", "n")
+	assert.ErrorIs(t, err, domain.ErrKVKeyNotFound)
+
+	require.NoError(t, repo.SetPluginKV(ctx, "counter/p1", "n", []byte("1")))
+	require.NoError(t, repo.SetPluginKV(ctx, "counter/p1", "n", []byte("2")))
+	require.NoError(t, repo.SetPluginKV(ctx, "counter/p2", "n", []byte("9")))
+	got, err := repo.GetPluginKV(ctx, "counter/p1", "n")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), got)
+
+	require.NoError(t, repo.DeletePluginKV(ctx, "counter/p1", "n"))
+	_, err = repo.GetPluginKV(ctx, "counter/p1", "n")
+	assert.ErrorIs(t, err, domain.ErrKVKeyNotFound)
+	got, err = repo.GetPluginKV(ctx, "counter/p2", "n")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("9"), got, "namespaces are separate")
+}
@@ -43,7 +43,7 @@ func (r *Repository) GetScheduledTask(ctx context.Context, id domain.ScheduledTa
 	task, err := scanScheduledTask(row)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("scheduled task not found: %s", id)
+			return nil, fmt.Errorf("%w: %s", domain.ErrScheduledTaskNotFound, id)
 		}
 		return nil, err
 	}
@@ -70,8 +70,14 @@ func (r *Repository) ListScheduledTasks(ctx context.Context) ([]domain.Scheduled
 }
 
 func (r *Repository) DeleteScheduledTask(ctx context.Context, id domain.ScheduledTaskID) error {
-	_, err := r.db.ExecContext(ctx, `DELETE FROM scheduled_tasks WHERE id = ?`, id)
-	return err
+	res, err := r.db.ExecContext(ctx, `DELETE FROM scheduled_tasks WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("delete scheduled task: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrScheduledTaskNotFound
+	}
+	return nil
 }
 
 func (r *Repository) GetDueTasks(ctx context.Context, now time.Time) ([]domain.ScheduledTask, error) {
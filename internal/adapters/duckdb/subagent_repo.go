@@ -0,0 +1,98 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SaveSubAgentTask upserts a sub-agent task (called on every status transition).
+func (r *Repository) SaveSubAgentTask(ctx context.Context, task domain.SubAgentTask) error {
+	stepsJSON, err := json.Marshal(task.Steps)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sub-agent steps: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO sub_agent_tasks (id, parent_id, conversation_id, persona_id, persona_name, model_id,
+		                             prompt, status, result, error, steps, started_at, finished_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			persona_id   = excluded.persona_id,
+			persona_name = excluded.persona_name,
+			model_id     = excluded.model_id,
+			status       = excluded.status,
+			result       = excluded.result,
+			error        = excluded.error,
+			steps        = excluded.steps,
+			finished_at  = excluded.finished_at`,
+		string(task.ID),
+		string(task.ParentID),
+		string(task.ConversationID),
+		string(task.PersonaID),
+		task.PersonaName,
+		task.ModelID,
+		task.Prompt,
+		string(task.Status),
+		task.Result,
+		task.Error,
+		string(stepsJSON),
+		task.StartedAt,
+		task.FinishedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert sub-agent task: %w", err)
+	}
+	return nil
+}
+
+// GetSubAgentTask returns a single sub-agent task by ID.
+func (r *Repository) GetSubAgentTask(ctx context.Context, id domain.SubAgentID) (domain.SubAgentTask, error) {
+	tasks, err := r.querySubAgentTasks(ctx, `WHERE id = ?`, string(id))
+	if err != nil {
+		return domain.SubAgentTask{}, err
+	}
+	if len(tasks) == 0 {
+		return domain.SubAgentTask{}, domain.ErrSubAgentNotFound
+	}
+	return tasks[0], nil
+}
+
+// ListConversationSubAgents returns all sub-agent tasks of a conversation, oldest first.
+func (r *Repository) ListConversationSubAgents(ctx context.Context, convID domain.ConversationID) ([]domain.SubAgentTask, error) {
+	return r.querySubAgentTasks(ctx, `WHERE conversation_id = ?`, string(convID))
+}
+
+func (r *Repository) querySubAgentTasks(ctx context.Context, where string, args ...interface{}) ([]domain.SubAgentTask, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, parent_id, conversation_id, persona_id, persona_name, model_id,
+		       prompt, status, result, error, CAST(steps AS TEXT), started_at, finished_at
+		FROM sub_agent_tasks `+where+`
+		ORDER BY started_at ASC`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list sub-agent tasks: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.SubAgentTask{}
+	for rows.Next() {
+		var t domain.SubAgentTask
+		var status string
+		var steps sql.NullString
+		if err := rows.Scan(
+			&t.ID, &t.ParentID, &t.ConversationID, &t.PersonaID, &t.PersonaName, &t.ModelID,
+			&t.Prompt, &status, &t.Result, &t.Error, &steps, &t.StartedAt, &t.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		t.Status = domain.SubAgentStatus(status)
+		if steps.Valid && steps.String != "" && steps.String != "null" {
+			_ = json.Unmarshal([]byte(steps.String), &t.Steps)
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
@@ -20,9 +21,9 @@ func (r *Repository) SaveTrace(ctx context.Context, trace *domain.Trace) error {
 
 	// Upsert trace row
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO traces (id, name, status, conversation_id, persona_id, root_span_id,
+		INSERT INTO traces (id, name, status, conversation_id, persona_id, request_id, root_span_id,
 		                    start_time, end_time, duration_ms, span_count)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT (id) DO UPDATE SET
 			status       = excluded.status,
 			end_time     = excluded.end_time,
@@ -33,6 +34,7 @@ func (r *Repository) SaveTrace(ctx context.Context, trace *domain.Trace) error {
 		string(trace.Status),
 		trace.ConversationID,
 		trace.PersonaID,
+		trace.RequestID,
 		string(trace.RootSpanID),
 		trace.StartTime,
 		trace.EndTime,
@@ -79,17 +81,55 @@ func (r *Repository) SaveTrace(ctx context.Context, trace *domain.Trace) error {
 	return tx.Commit()
 }
 
-// ListTraces returns summaries of the most recent traces (newest first).
-func (r *Repository) ListTraces(ctx context.Context, limit int) ([]domain.TraceSummary, error) {
+// ListTraces returns summaries of persisted traces matching the filter (newest first).
+func (r *Repository) ListTraces(ctx context.Context, filter domain.TraceFilter) ([]domain.TraceSummary, error) {
+	limit := filter.Limit
 	if limit <= 0 {
 		limit = 50
 	}
 
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, name, status, start_time, end_time, duration_ms, span_count
-		FROM traces
-		ORDER BY start_time DESC
-		LIMIT ?`, limit)
+	var where []string
+	var args []interface{}
+	if filter.Status != "" {
+		where = append(where, "status = ?")
+		args = append(args, string(filter.Status))
+	}
+	if filter.ConversationID != "" {
+		where = append(where, "conversation_id = ?")
+		args = append(args, filter.ConversationID)
+	}
+	if filter.PersonaID != "" {
+		where = append(where, "persona_id = ?")
+		args = append(args, filter.PersonaID)
+	}
+	if filter.RequestID != "" {
+		where = append(where, "request_id = ?")
+		args = append(args, filter.RequestID)
+	}
+	if !filter.Since.IsZero() {
+		where = append(where, "start_time >= ?")
+		args = append(args, filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		where = append(where, "start_time < ?")
+		args = append(args, filter.Until)
+	}
+	if filter.MinDurationMs > 0 {
+		where = append(where, "duration_ms >= ?")
+		args = append(args, filter.MinDurationMs)
+	}
+
+	query := `
+		SELECT id, name, status, conversation_id, persona_id, COALESCE(request_id, ''),
+		       start_time, end_time, duration_ms, span_count
+		FROM traces`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY start_time DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("list traces: %w", err)
 	}
@@ -100,7 +140,7 @@ func (r *Repository) ListTraces(ctx context.Context, limit int) ([]domain.TraceS
 		var s domain.TraceSummary
 		var statusStr string
 		var endTime *time.Time
-		err := rows.Scan(&s.ID, &s.Name, &statusStr, &s.StartTime, &endTime, &s.DurationMs, &s.SpanCount)
+		err := rows.Scan(&s.ID, &s.Name, &statusStr, &s.ConversationID, &s.PersonaID, &s.RequestID, &s.StartTime, &endTime, &s.DurationMs, &s.SpanCount)
 		if err != nil {
 			return nil, err
 		}
@@ -116,18 +156,18 @@ func (r *Repository) ListTraces(ctx context.Context, limit int) ([]domain.TraceS
 // GetTrace returns a full trace with all its spans.
 func (r *Repository) GetTrace(ctx context.Context, id domain.TraceID) (*domain.Trace, error) {
 	row := r.db.QueryRowContext(ctx, `
-		SELECT id, name, status, conversation_id, persona_id, root_span_id,
+		SELECT id, name, status, conversation_id, persona_id, COALESCE(request_id, ''), root_span_id,
 		       start_time, end_time, duration_ms, span_count
 		FROM traces WHERE id = ?`, string(id))
 
 	var t domain.Trace
 	var statusStr, convID, personaID, rootSpanID string
 	err := row.Scan(
-		&t.ID, &t.Name, &statusStr, &convID, &personaID, &rootSpanID,
+		&t.ID, &t.Name, &statusStr, &convID, &personaID, &t.RequestID, &rootSpanID,
 		&t.StartTime, &t.EndTime, &t.DurationMs, &t.SpanCount,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("trace not found: %s", id)
+		return nil, fmt.Errorf("%w: %s", domain.ErrTraceNotFound, id)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("get trace: %w", err)
@@ -146,6 +186,66 @@ func (r *Repository) GetTrace(ctx context.Context, id domain.TraceID) (*domain.T
 	return &t, nil
 }
 
+// SearchSpans returns persisted spans matching the filter across all traces (newest first).
+func (r *Repository) SearchSpans(ctx context.Context, filter domain.SpanFilter) ([]domain.Span, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	var where []string
+	var args []interface{}
+	if filter.Kind != "" {
+		where = append(where, "s.kind = ?")
+		args = append(args, string(filter.Kind))
+	}
+	if filter.NamePrefix != "" {
+		where = append(where, "starts_with(s.name, ?)")
+		args = append(args, filter.NamePrefix)
+	}
+	if filter.Status != "" {
+		where = append(where, "s.status = ?")
+		args = append(args, string(filter.Status))
+	}
+	if filter.MinDurationMs > 0 {
+		where = append(where, "s.duration_ms >= ?")
+		args = append(args, filter.MinDurationMs)
+	}
+	if filter.TraceID != "" {
+		where = append(where, "s.trace_id = ?")
+		args = append(args, string(filter.TraceID))
+	}
+	if filter.ConversationID != "" {
+		where = append(where, "t.conversation_id = ?")
+		args = append(args, filter.ConversationID)
+	}
+	if !filter.Since.IsZero() {
+		where = append(where, "s.start_time >= ?")
+		args = append(args, filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		where = append(where, "s.start_time < ?")
+		args = append(args, filter.Until)
+	}
+
+	query := `
+		SELECT s.id, s.trace_id, s.parent_id, s.name, s.kind, s.status,
+		       s.input, s.output, s.error, s.model, s.attributes, s.start_time, s.end_time, s.duration_ms
+		FROM spans s LEFT JOIN traces t ON t.id = s.trace_id`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY s.start_time DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search spans: %w", err)
+	}
+	defer rows.Close()
+	return scanSpans(rows)
+}
+
 func (r *Repository) loadSpansForTrace(ctx context.Context, traceID domain.TraceID) ([]domain.Span, error) {
 	rows, err := r.db.QueryContext(ctx, `
 		SELECT id, trace_id, parent_id, name, kind, status,
@@ -156,11 +256,15 @@ func (r *Repository) loadSpansForTrace(ctx context.Context, traceID domain.Trace
 		return nil, fmt.Errorf("load spans: %w", err)
 	}
 	defer rows.Close()
+	return scanSpans(rows)
+}
 
+func scanSpans(rows *sql.Rows) ([]domain.Span, error) {
 	var out []domain.Span
 	for rows.Next() {
 		var s domain.Span
-		var kindStr, statusStr, attrJSON string
+		var kindStr, statusStr string
+		var attrJSON sql.NullString
 		err := rows.Scan(
 			&s.ID, &s.TraceID, &s.ParentID,
 			&s.Name, &kindStr, &statusStr,
@@ -172,10 +276,77 @@ func (r *Repository) loadSpansForTrace(ctx context.Context, traceID domain.Trace
 		}
 		s.Kind = domain.SpanKind(kindStr)
 		s.Status = domain.SpanStatus(statusStr)
-		if attrJSON != "" && attrJSON != "null" {
-			_ = json.Unmarshal([]byte(attrJSON), &s.Attributes)
+		if attrJSON.Valid && attrJSON.String != "" && attrJSON.String != "null" {
+			_ = json.Unmarshal([]byte(attrJSON.String), &s.Attributes)
 		}
 		out = append(out, s)
 	}
 	return out, rows.Err()
 }
+
+// CountTracesBefore returns how many persisted traces started before cutoff.
+func (r *Repository) CountTracesBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	var n int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM traces WHERE start_time < ?`, cutoff).Scan(&n); err != nil {
+		return 0, fmt.Errorf("count traces: %w", err)
+	}
+	return n, nil
+}
+
+// DeleteTracesBefore removes persisted traces that started before cutoff,
+// together with their spans and provider captures. It returns the number of traces removed.
+func (r *Repository) DeleteTracesBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	if _, err := r.db.ExecContext(ctx,
+		`DELETE FROM spans WHERE trace_id IN (SELECT id FROM traces WHERE start_time < ?)`, cutoff); err != nil {
+		return 0, fmt.Errorf("delete spans: %w", err)
+	}
+	if _, err := r.db.ExecContext(ctx,
+		`DELETE FROM provider_captures WHERE trace_id IN (SELECT id FROM traces WHERE start_time < ?)`, cutoff); err != nil {
+		return 0, fmt.Errorf("delete provider captures: %w", err)
+	}
+	res, err := r.db.ExecContext(ctx, `DELETE FROM traces WHERE start_time < ?`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("delete traces: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
+
+// SaveProviderCapture stores one recorded provider exchange.
+func (r *Repository) SaveProviderCapture(ctx context.Context, c domain.ProviderCapture) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO provider_captures (id, trace_id, span_id, method, url, status_code,
+		                               request, response, error, duration_ms, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		c.ID, string(c.TraceID), string(c.SpanID), c.Method, c.URL, c.StatusCode,
+		c.Request, c.Response, c.Error, c.DurationMs, c.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert provider capture: %w", err)
+	}
+	return nil
+}
+
+// ListProviderCaptures returns the recorded exchanges of a trace, oldest first.
+func (r *Repository) ListProviderCaptures(ctx context.Context, traceID domain.TraceID) ([]domain.ProviderCapture, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, trace_id, span_id, method, url, status_code, request, response, error, duration_ms, created_at
+		FROM provider_captures WHERE trace_id = ? ORDER BY created_at`, string(traceID))
+	if err != nil {
+		return nil, fmt.Errorf("query provider captures: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.ProviderCapture, 0)
+	for rows.Next() {
+		var c domain.ProviderCapture
+		var tid, sid string
+		if err := rows.Scan(&c.ID, &tid, &sid, &c.Method, &c.URL, &c.StatusCode,
+			&c.Request, &c.Response, &c.Error, &c.DurationMs, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan provider capture: %w", err)
+		}
+		c.TraceID, c.SpanID = domain.TraceID(tid), domain.SpanID(sid)
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
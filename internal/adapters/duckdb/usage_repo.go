@@ -0,0 +1,85 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// RecordUsage stores the accounting of one LLM call.
+func (r *Repository) RecordUsage(ctx context.Context, rec domain.UsageRecord) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO llm_usage (conversation_id, project_id, model, prompt_tokens, completion_tokens, cost_usd, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		string(rec.ConversationID), string(rec.ProjectID), rec.Model, rec.PromptTokens, rec.CompletionTokens, rec.CostUSD, rec.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert usage: %w", err)
+	}
+	return nil
+}
+
+// UsageTotals sums the recorded usage of a conversation or project.
+func (r *Repository) UsageTotals(ctx context.Context, scope domain.UsageScope, id string) (domain.UsageTotals, error) {
+	column := "conversation_id"
+	if scope == domain.UsageScopeProject {
+		column = "project_id"
+	}
+	var t domain.UsageTotals
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*), COALESCE(SUM(prompt_tokens), 0), COALESCE(SUM(completion_tokens), 0), COALESCE(SUM(cost_usd), 0)
+		FROM llm_usage WHERE `+column+` = ?`, id,
+	).Scan(&t.Calls, &t.PromptTokens, &t.CompletionTokens, &t.CostUSD)
+	if err != nil {
+		return t, fmt.Errorf("sum usage: %w", err)
+	}
+	return t, nil
+}
+
+// SaveUsageLimit upserts the limit of a conversation or project.
+func (r *Repository) SaveUsageLimit(ctx context.Context, l domain.UsageLimit) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO usage_limits (scope, scope_id, max_tokens, max_cost_usd, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (scope, scope_id) DO UPDATE SET
+			max_tokens   = excluded.max_tokens,
+			max_cost_usd = excluded.max_cost_usd,
+			updated_at   = excluded.updated_at`,
+		string(l.Scope), l.ScopeID, l.MaxTokens, l.MaxCostUSD, l.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert usage limit: %w", err)
+	}
+	return nil
+}
+
+// GetUsageLimit returns the limit of a conversation or project.
+func (r *Repository) GetUsageLimit(ctx context.Context, scope domain.UsageScope, id string) (domain.UsageLimit, error) {
+	l := domain.UsageLimit{Scope: scope, ScopeID: id}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT max_tokens, max_cost_usd, updated_at FROM usage_limits WHERE scope = ? AND scope_id = ?`,
+		string(scope), id,
+	).Scan(&l.MaxTokens, &l.MaxCostUSD, &l.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return l, domain.ErrUsageLimitNotFound
+	}
+	if err != nil {
+		return l, fmt.Errorf("get usage limit: %w", err)
+	}
+	return l, nil
+}
+
+// DeleteUsageLimit removes the limit of a conversation or project.
+func (r *Repository) DeleteUsageLimit(ctx context.Context, scope domain.UsageScope, id string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM usage_limits WHERE scope = ? AND scope_id = ?`, string(scope), id)
+	if err != nil {
+		return fmt.Errorf("delete usage limit: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrUsageLimitNotFound
+	}
+	return nil
+}
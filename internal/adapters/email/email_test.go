@@ -0,0 +1,121 @@
+package email
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-imap/backend/memory"
+	"github.com/emersion/go-imap/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func TestComposeParseRoundTrip(t *testing.T) {
+	msg := domain.EmailMessage{
+		To:         []string{"me@example.com"},
+		Cc:         []string{"team@example.com"},
+		Subject:    "Relatório diário",
+		Body:       "Olá!\nA long line " + strings.Repeat("x", 120) + "\nend",
+		InReplyTo:  "<root@example.com>",
+		References: []string{"<root@example.com>"},
+	}
+	raw := composeMessage("aule@example.com", msg, "<id1@example.com>", time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	got, err := parseMessage(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "aule@example.com", got.From)
+	assert.Equal(t, msg.Subject, got.Subject)
+	assert.Equal(t, msg.Body, strings.ReplaceAll(got.Body, "\r\n", "\n"))
+	assert.Equal(t, "<id1@example.com>", got.MessageID)
+	assert.Equal(t, "<root@example.com>", got.InReplyTo)
+	assert.Equal(t, "<root@example.com>", got.ThreadRoot())
+}
+
+func TestParseMultipartPrefersPlainText(t *testing.T) {
+	raw := "From: Alice <alice@example.com>\r\n" +
+		"Subject: =?UTF-8?Q?Ol=C3=A1?=\r\n" +
+		"Message-ID: <m1@example.com>\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: multipart/alternative; boundary=XX\r\n\r\n" +
+		"--XX\r\nContent-Type: text/html\r\n\r\n<p>html version</p>\r\n" +
+		"--XX\r\nContent-Type: text/plain; charset=utf-8\r\nContent-Transfer-Encoding: base64\r\n\r\n" +
+		"cGxhaW4gdmVyc2lvbg==\r\n" +
+		"--XX--\r\n"
+
+	got, err := parseMessage([]byte(raw))
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", got.From)
+	assert.Equal(t, "Alice", got.FromName)
+	assert.Equal(t, "Olá", got.Subject)
+	assert.Equal(t, "plain version", strings.TrimSpace(got.Body))
+	assert.Equal(t, "<m1@example.com>", got.ThreadRoot())
+}
+
+// serveIMAP runs a go-imap server over its in-memory backend, whose one
+// user is "username"/"password", with the given messages in INBOX.
+func serveIMAP(t *testing.T, messages map[uint32]string) int {
+	t.Helper()
+	be := memory.New()
+	user, err := be.Login(nil, "username", "password")
+	require.NoError(t, err)
+	mbox, err := user.GetMailbox("INBOX")
+	require.NoError(t, err)
+	inbox := mbox.(*memory.Mailbox)
+	inbox.Messages = nil
+	for uid, body := range messages {
+		inbox.Messages = append(inbox.Messages, &memory.Message{
+			Uid: uid, Date: time.Now(), Size: uint32(len(body)), Body: []byte(body), Flags: []string{},
+		})
+	}
+	sort.Slice(inbox.Messages, func(i, j int) bool { return inbox.Messages[i].Uid < inbox.Messages[j].Uid })
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := server.New(be)
+	srv.AllowInsecureAuth = true
+	go srv.Serve(ln)
+	t.Cleanup(func() { srv.Close() })
+	return ln.Addr().(*net.TCPAddr).Port
+}
+
+func TestIMAPInboxFetchAndMarkSeen(t *testing.T) {
+	port := serveIMAP(t, map[uint32]string{
+		7: "From: bob@example.com\r\nSubject: report\r\nMessage-ID: <b@x>\r\n\r\nsend me the report\r\n",
+		3: "From: carol@example.com\r\nSubject: hi\r\n\r\nhello\r\n",
+	})
+	inbox := NewIMAPInbox(domain.IMAPConfig{
+		Host: "127.0.0.1", Port: port, Insecure: true,
+		Username: "username", Password: "password",
+	})
+
+	ctx := context.Background()
+	emails, err := inbox.FetchUnseen(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, emails, 2)
+	assert.Equal(t, uint32(3), emails[0].UID)
+	assert.Equal(t, uint32(7), emails[1].UID)
+	assert.Equal(t, "bob@example.com", emails[1].From)
+	assert.Equal(t, "send me the report", strings.TrimSpace(emails[1].Body))
+
+	require.NoError(t, inbox.MarkSeen(ctx, []uint32{3, 7}))
+	emails, err = inbox.FetchUnseen(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, emails)
+}
+
+func TestIMAPInboxLoginFailure(t *testing.T) {
+	port := serveIMAP(t, nil)
+	inbox := NewIMAPInbox(domain.IMAPConfig{
+		Host: "127.0.0.1", Port: port, Insecure: true,
+		Username: "username", Password: "wrong",
+	})
+	_, err := inbox.FetchUnseen(context.Background(), 10)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "imap login")
+}
@@ -0,0 +1,152 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// IMAPInbox reads a mailbox over IMAP4rev1 with go-imap, one connection per
+// call.
+type IMAPInbox struct {
+	cfg domain.IMAPConfig
+}
+
+func NewIMAPInbox(cfg domain.IMAPConfig) *IMAPInbox {
+	if cfg.Port == 0 {
+		cfg.Port = 993
+		if cfg.Insecure {
+			cfg.Port = 143
+		}
+	}
+	if cfg.Mailbox == "" {
+		cfg.Mailbox = "INBOX"
+	}
+	return &IMAPInbox{cfg: cfg}
+}
+
+// FetchUnseen implements ports.EmailInbox. BODY.PEEK leaves messages unread.
+func (b *IMAPInbox) FetchUnseen(ctx context.Context, max int) ([]domain.InboundEmail, error) {
+	c, err := b.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer logout(c)
+
+	criteria := imap.NewSearchCriteria()
+	criteria.WithoutFlags = []string{imap.SeenFlag}
+	uids, err := c.UidSearch(criteria)
+	if err != nil {
+		return nil, fmt.Errorf("imap search: %w", err)
+	}
+	if len(uids) == 0 {
+		return nil, nil
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	if max > 0 && len(uids) > max {
+		uids = uids[:max]
+	}
+
+	set := new(imap.SeqSet)
+	set.AddNum(uids...)
+	section := &imap.BodySectionName{Peek: true}
+	messages := make(chan *imap.Message, len(uids))
+	done := make(chan error, 1)
+	go func() {
+		done <- c.UidFetch(set, []imap.FetchItem{imap.FetchUid, section.FetchItem()}, messages)
+	}()
+
+	emails := make([]domain.InboundEmail, 0, len(uids))
+	var parseErr error
+	for msg := range messages {
+		body := msg.GetBody(section)
+		if body == nil || parseErr != nil {
+			continue // drain the channel so the fetch can finish
+		}
+		raw, err := io.ReadAll(body)
+		if err != nil {
+			parseErr = fmt.Errorf("imap message %d: %w", msg.Uid, err)
+			continue
+		}
+		e, err := parseMessage(raw)
+		if err != nil {
+			parseErr = fmt.Errorf("imap message %d: %w", msg.Uid, err)
+			continue
+		}
+		e.UID = msg.Uid
+		emails = append(emails, e)
+	}
+	sort.Slice(emails, func(i, j int) bool { return emails[i].UID < emails[j].UID })
+	if err := <-done; err != nil {
+		return emails, fmt.Errorf("imap fetch: %w", err)
+	}
+	return emails, parseErr
+}
+
+// MarkSeen implements ports.EmailInbox.
+func (b *IMAPInbox) MarkSeen(ctx context.Context, uids []uint32) error {
+	if len(uids) == 0 {
+		return nil
+	}
+	c, err := b.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer logout(c)
+
+	set := new(imap.SeqSet)
+	set.AddNum(uids...)
+	flags := []interface{}{imap.SeenFlag}
+	if err := c.UidStore(set, imap.FormatFlagsOp(imap.AddFlags, true), flags, nil); err != nil {
+		return fmt.Errorf("imap store: %w", err)
+	}
+	return nil
+}
+
+// open connects, logs in and selects the mailbox.
+func (b *IMAPInbox) open(ctx context.Context) (*client.Client, error) {
+	addr := net.JoinHostPort(b.cfg.Host, strconv.Itoa(b.cfg.Port))
+	dialer := &net.Dialer{Timeout: defaultTimeout}
+	var conn net.Conn
+	var err error
+	if b.cfg.Insecure {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: b.cfg.Host}}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("imap connect: %w", err)
+	}
+	setDeadline(ctx, conn)
+
+	c, err := client.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("imap greeting: %w", err)
+	}
+	if err := c.Login(b.cfg.Username, b.cfg.Password); err != nil {
+		c.Logout()
+		return nil, fmt.Errorf("imap login: %w", err)
+	}
+	if _, err := c.Select(b.cfg.Mailbox, false); err != nil {
+		c.Logout()
+		return nil, fmt.Errorf("imap select %s: %w", b.cfg.Mailbox, err)
+	}
+	return c, nil
+}
+
+// logout ends the session; the connection is closed even if LOGOUT fails.
+func logout(c *client.Client) {
+	if err := c.Logout(); err != nil {
+		c.Terminate()
+	}
+}
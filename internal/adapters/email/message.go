@@ -0,0 +1,157 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// maxBodyBytes caps the text kept from an incoming message.
+const maxBodyBytes = 64 * 1024
+
+var (
+	wordDecoder = &mime.WordDecoder{}
+	tagRe       = regexp.MustCompile(`<[^>]*>`)
+)
+
+// newMessageID returns a Message-ID in the sender's domain.
+func newMessageID(from string) string {
+	host := "auleos.local"
+	if i := strings.LastIndex(from, "@"); i >= 0 {
+		host = strings.Trim(from[i+1:], "> ")
+	}
+	return "<" + uuid.NewString() + "@" + host + ">"
+}
+
+// headerValue strips line breaks so values cannot inject headers.
+func headerValue(s string) string {
+	return strings.NewReplacer("\r", "", "\n", " ").Replace(s)
+}
+
+// composeMessage renders msg as an RFC 5322 plain-text message.
+func composeMessage(from string, msg domain.EmailMessage, messageID string, now time.Time) []byte {
+	var b bytes.Buffer
+	header := func(k, v string) {
+		if v != "" {
+			fmt.Fprintf(&b, "%s: %s\r\n", k, headerValue(v))
+		}
+	}
+	header("From", from)
+	header("To", strings.Join(msg.To, ", "))
+	header("Cc", strings.Join(msg.Cc, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", messageID)
+	header("In-Reply-To", msg.InReplyTo)
+	header("References", strings.Join(msg.References, " "))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	b.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&b)
+	qp.Write([]byte(strings.ReplaceAll(msg.Body, "\n", "\r\n")))
+	qp.Close()
+	return b.Bytes()
+}
+
+// parseMessage extracts the fields the poller needs from a raw message.
+func parseMessage(raw []byte) (domain.InboundEmail, error) {
+	m, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return domain.InboundEmail{}, fmt.Errorf("parse message: %w", err)
+	}
+	e := domain.InboundEmail{
+		MessageID:  strings.TrimSpace(m.Header.Get("Message-ID")),
+		InReplyTo:  strings.TrimSpace(m.Header.Get("In-Reply-To")),
+		References: strings.Fields(m.Header.Get("References")),
+	}
+	parser := mail.AddressParser{WordDecoder: wordDecoder}
+	if from, err := parser.Parse(m.Header.Get("From")); err == nil {
+		e.From, e.FromName = from.Address, from.Name
+	}
+	if subject, err := wordDecoder.DecodeHeader(m.Header.Get("Subject")); err == nil {
+		e.Subject = subject
+	} else {
+		e.Subject = m.Header.Get("Subject")
+	}
+	if date, err := m.Header.Date(); err == nil {
+		e.Date = date
+	}
+
+	body, err := textBody(m.Header.Get("Content-Type"), m.Header.Get("Content-Transfer-Encoding"), m.Body)
+	if err != nil {
+		return e, err
+	}
+	if len(body) > maxBodyBytes {
+		body = body[:maxBodyBytes]
+	}
+	e.Body = strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n"))
+	return e, nil
+}
+
+// textBody returns the text/plain content of a (possibly multipart) body,
+// falling back to tag-stripped text/html.
+func textBody(contentType, encoding string, r io.Reader) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+	}
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(r, params["boundary"])
+		var html string
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", fmt.Errorf("read multipart body: %w", err)
+			}
+			text, err := textBody(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+			if err != nil {
+				return "", err
+			}
+			partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+			switch {
+			case partType == "text/html":
+				if html == "" {
+					html = text
+				}
+			case text != "":
+				return text, nil
+			}
+		}
+		return html, nil
+	}
+	if !strings.HasPrefix(mediaType, "text/") {
+		return "", nil // attachment
+	}
+
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		r = quotedprintable.NewReader(r)
+	case "base64":
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	}
+	data, err := io.ReadAll(io.LimitReader(r, maxBodyBytes*4))
+	if err != nil {
+		return "", fmt.Errorf("read body: %w", err)
+	}
+	text := string(data)
+	if mediaType == "text/html" {
+		text = tagRe.ReplaceAllString(text, " ")
+	}
+	return text, nil
+}
@@ -0,0 +1,106 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// SMTPSender delivers mail through an SMTP server: implicit TLS on port
+// 465, STARTTLS when offered on any other port.
+type SMTPSender struct {
+	cfg domain.SMTPConfig
+	now func() time.Time
+}
+
+func NewSMTPSender(cfg domain.SMTPConfig) *SMTPSender {
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+	return &SMTPSender{cfg: cfg, now: time.Now}
+}
+
+// SendEmail implements ports.EmailSender.
+func (s *SMTPSender) SendEmail(ctx context.Context, msg domain.EmailMessage) (string, error) {
+	from := s.cfg.Sender()
+	messageID := newMessageID(from)
+	data := composeMessage(from, msg, messageID, s.now())
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return "", fmt.Errorf("smtp connect: %w", err)
+	}
+	c, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return "", fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer c.Close()
+
+	if s.cfg.Port != 465 {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{ServerName: s.cfg.Host}); err != nil {
+				return "", fmt.Errorf("smtp starttls: %w", err)
+			}
+		}
+	}
+	if s.cfg.Username != "" {
+		// PlainAuth refuses to send credentials over an unencrypted remote connection
+		if err := c.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return "", fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return "", fmt.Errorf("smtp MAIL FROM: %w", err)
+	}
+	for _, rcpt := range append(append([]string(nil), msg.To...), msg.Cc...) {
+		if err := c.Rcpt(rcpt); err != nil {
+			return "", fmt.Errorf("smtp RCPT TO %s: %w", rcpt, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return "", fmt.Errorf("smtp DATA: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return "", fmt.Errorf("smtp write: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("smtp send: %w", err)
+	}
+	return messageID, c.Quit()
+}
+
+func (s *SMTPSender) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	dialer := &net.Dialer{Timeout: defaultTimeout}
+	var conn net.Conn
+	var err error
+	if s.cfg.Port == 465 {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: s.cfg.Host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	setDeadline(ctx, conn)
+	return conn, nil
+}
+
+// setDeadline bounds the whole exchange by ctx, or defaultTimeout.
+func setDeadline(ctx context.Context, conn net.Conn) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultTimeout)
+	}
+	conn.SetDeadline(deadline)
+}
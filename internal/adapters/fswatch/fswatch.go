@@ -0,0 +1,163 @@
+// Package fswatch implements ports.FileWatcher with fsnotify, and by polling
+// directory listings where fsnotify is unavailable.
+package fswatch
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// DefaultPollInterval is how often a Poller rescans its directories.
+const DefaultPollInterval = 2 * time.Second
+
+const eventBuffer = 256
+
+// ErrClosed is returned by Add after Close.
+var ErrClosed = errors.New("file watcher closed")
+
+type fileState struct {
+	size  int64
+	mod   time.Time
+	isDir bool
+}
+
+// Poller is a portable FileWatcher that compares directory listings every
+// interval. Changes within one interval are reported as a single event.
+type Poller struct {
+	interval time.Duration
+	events   chan domain.FileEvent
+	done     chan struct{}
+	once     sync.Once
+
+	mu   sync.Mutex
+	dirs map[string]map[string]fileState // dir -> entry name -> state
+}
+
+// NewPoller starts a Poller.
+func NewPoller(interval time.Duration) *Poller {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	p := &Poller{
+		interval: interval,
+		events:   make(chan domain.FileEvent, eventBuffer),
+		done:     make(chan struct{}),
+		dirs:     make(map[string]map[string]fileState),
+	}
+	go p.loop()
+	return p
+}
+
+var _ ports.FileWatcher = (*Poller)(nil)
+
+func (p *Poller) Add(dir string) error {
+	select {
+	case <-p.done:
+		return ErrClosed
+	default:
+	}
+	snap, err := snapshot(dir)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.dirs[dir]; !ok {
+		p.dirs[dir] = snap
+	}
+	return nil
+}
+
+func (p *Poller) Remove(dir string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.dirs, dir)
+	return nil
+}
+
+func (p *Poller) Events() <-chan domain.FileEvent {
+	return p.events
+}
+
+// Close stops polling and closes the event channel.
+func (p *Poller) Close() error {
+	p.once.Do(func() { close(p.done) })
+	return nil
+}
+
+func (p *Poller) loop() {
+	defer close(p.events)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			for _, ev := range p.scan() {
+				select {
+				case p.events <- ev:
+				case <-p.done:
+					return
+				}
+			}
+		}
+	}
+}
+
+// scan rescans every directory and returns the differences since the last
+// scan. A directory that disappeared is dropped; its parent, if watched,
+// reports the removal.
+func (p *Poller) scan() []domain.FileEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var out []domain.FileEvent
+	for dir, prev := range p.dirs {
+		cur, err := snapshot(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				delete(p.dirs, dir)
+			}
+			continue
+		}
+		for name, st := range cur {
+			old, ok := prev[name]
+			switch {
+			case !ok:
+				out = append(out, domain.FileEvent{Path: filepath.Join(dir, name), Op: domain.FileOpCreate, IsDir: st.isDir})
+			case !st.isDir && (st.size != old.size || !st.mod.Equal(old.mod)):
+				out = append(out, domain.FileEvent{Path: filepath.Join(dir, name), Op: domain.FileOpWrite})
+			}
+		}
+		for name, st := range prev {
+			if _, ok := cur[name]; !ok {
+				out = append(out, domain.FileEvent{Path: filepath.Join(dir, name), Op: domain.FileOpRemove, IsDir: st.isDir})
+			}
+		}
+		p.dirs[dir] = cur
+	}
+	return out
+}
+
+func snapshot(dir string) (map[string]fileState, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	snap := make(map[string]fileState, len(entries))
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil {
+			continue // removed between ReadDir and Info
+		}
+		snap[e.Name()] = fileState{size: info.Size(), mod: info.ModTime(), isDir: e.IsDir()}
+	}
+	return snap, nil
+}
@@ -0,0 +1,156 @@
+package fswatch
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// New returns a Notify watcher, or a Poller where fsnotify has no backend
+// or the kernel refuses another instance.
+func New() (ports.FileWatcher, error) {
+	w, err := NewNotify()
+	if err != nil {
+		return NewPoller(DefaultPollInterval), nil
+	}
+	return w, nil
+}
+
+// Notify is a FileWatcher backed by fsnotify (inotify on Linux, kqueue on
+// macOS and the BSDs, ReadDirectoryChangesW on Windows). Every write is
+// reported, so one save may yield several write events; the file trigger
+// service debounces them.
+type Notify struct {
+	watcher *fsnotify.Watcher
+	events  chan domain.FileEvent
+	done    chan struct{}
+
+	mu     sync.Mutex
+	closed bool
+	dirs   map[string]bool
+}
+
+// NewNotify opens an fsnotify watcher and starts reading its events.
+func NewNotify() (*Notify, error) {
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("fsnotify: %w", err)
+	}
+	w := &Notify{
+		watcher: fw,
+		events:  make(chan domain.FileEvent, eventBuffer),
+		done:    make(chan struct{}),
+		dirs:    make(map[string]bool),
+	}
+	go w.read()
+	return w, nil
+}
+
+var _ ports.FileWatcher = (*Notify)(nil)
+
+func (w *Notify) Add(dir string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return ErrClosed
+	}
+	if w.dirs[dir] {
+		return nil
+	}
+	if err := w.watcher.Add(dir); err != nil {
+		return fmt.Errorf("watch %s: %w", dir, err)
+	}
+	w.dirs[dir] = true
+	return nil
+}
+
+func (w *Notify) Remove(dir string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.dirs[dir] || w.closed {
+		return nil
+	}
+	delete(w.dirs, dir)
+	// A removed directory has already lost its watch
+	if err := w.watcher.Remove(dir); err != nil && !errors.Is(err, fsnotify.ErrNonExistentWatch) {
+		return fmt.Errorf("unwatch %s: %w", dir, err)
+	}
+	return nil
+}
+
+func (w *Notify) Events() <-chan domain.FileEvent {
+	return w.events
+}
+
+// Close releases the watcher and closes the event channel.
+func (w *Notify) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	close(w.done)
+	return w.watcher.Close()
+}
+
+func (w *Notify) read() {
+	defer close(w.events)
+	for {
+		select {
+		case e, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			ev, ok := w.translate(e)
+			if !ok {
+				continue
+			}
+			select {
+			case w.events <- ev:
+			case <-w.done:
+				return
+			}
+		case _, ok := <-w.watcher.Errors:
+			// Overflows drop events; the watch itself keeps working
+			if !ok {
+				return
+			}
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// translate maps an fsnotify event to a FileEvent. fsnotify does not say
+// whether the path is a directory: a created path is checked on disk, and
+// a removed or renamed one is a directory if it was watched.
+func (w *Notify) translate(e fsnotify.Event) (domain.FileEvent, bool) {
+	ev := domain.FileEvent{Path: e.Name}
+	switch {
+	case e.Has(fsnotify.Create):
+		ev.Op = domain.FileOpCreate
+		info, err := os.Lstat(e.Name)
+		ev.IsDir = err == nil && info.IsDir()
+		return ev, true
+	case e.Has(fsnotify.Write):
+		ev.Op = domain.FileOpWrite
+		return ev, true
+	case e.Has(fsnotify.Remove):
+		ev.Op = domain.FileOpRemove
+	case e.Has(fsnotify.Rename):
+		ev.Op = domain.FileOpRename
+	default:
+		return domain.FileEvent{}, false // chmod
+	}
+	w.mu.Lock()
+	ev.IsDir = w.dirs[e.Name]
+	w.mu.Unlock()
+	return ev, true
+}
@@ -0,0 +1,46 @@
+package fswatch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func TestNotify_ReportsChanges(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewNotify()
+	require.NoError(t, err)
+	defer w.Close()
+	require.NoError(t, w.Add(dir))
+
+	file := filepath.Join(dir, "report.pdf")
+	require.NoError(t, os.WriteFile(file, []byte("pdf"), 0o644))
+	assert.Equal(t, domain.FileOpCreate, nextEvent(t, w, file).Op)
+	assert.Equal(t, domain.FileOpWrite, nextEvent(t, w, file).Op)
+
+	moved := filepath.Join(dir, "renamed.pdf")
+	require.NoError(t, os.Rename(file, moved))
+	assert.Equal(t, domain.FileOpRename, nextEvent(t, w, file).Op)
+	assert.Equal(t, domain.FileOpCreate, nextEvent(t, w, moved).Op)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o755))
+	assert.True(t, nextEvent(t, w, filepath.Join(dir, "sub")).IsDir)
+
+	require.NoError(t, w.Remove(dir))
+	require.NoError(t, w.Close())
+	select {
+	case _, ok := <-w.Events():
+		for ok {
+			_, ok = <-w.Events()
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("event channel not closed")
+	}
+	assert.ErrorIs(t, w.Add(dir), ErrClosed)
+}
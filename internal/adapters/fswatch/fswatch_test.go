@@ -0,0 +1,59 @@
+package fswatch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// nextEvent waits for the next event about path, skipping others.
+func nextEvent(t *testing.T, w ports.FileWatcher, path string) domain.FileEvent {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case ev, ok := <-w.Events():
+			require.True(t, ok, "event channel closed")
+			if ev.Path == path {
+				return ev
+			}
+		case <-timeout:
+			t.Fatalf("no event for %s", path)
+		}
+	}
+}
+
+func TestPoller_ReportsChanges(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "old.txt")
+	require.NoError(t, os.WriteFile(existing, []byte("a"), 0o644))
+
+	p := NewPoller(20 * time.Millisecond)
+	defer p.Close()
+	require.NoError(t, p.Add(dir))
+
+	file := filepath.Join(dir, "new.pdf")
+	require.NoError(t, os.WriteFile(file, []byte("pdf"), 0o644))
+	assert.Equal(t, domain.FileOpCreate, nextEvent(t, p, file).Op)
+
+	require.NoError(t, os.WriteFile(existing, []byte("longer"), 0o644))
+	assert.Equal(t, domain.FileOpWrite, nextEvent(t, p, existing).Op)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o755))
+	ev := nextEvent(t, p, filepath.Join(dir, "sub"))
+	assert.Equal(t, domain.FileOpCreate, ev.Op)
+	assert.True(t, ev.IsDir)
+
+	require.NoError(t, os.Remove(file))
+	assert.Equal(t, domain.FileOpRemove, nextEvent(t, p, file).Op)
+
+	require.NoError(t, p.Close())
+	assert.ErrorIs(t, p.Add(dir), ErrClosed)
+}
@@ -0,0 +1,33 @@
+// Package host samples resource usage of the machine the kernel runs on.
+package host
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// ErrUnsupported is returned on platforms without a sampler implementation.
+var ErrUnsupported = errors.New("host stats not supported on this platform")
+
+// Sampler reads host CPU, memory and disk usage.
+type Sampler struct {
+	mu        sync.Mutex
+	prevBusy  uint64 // cumulative CPU ticks at the previous CPU sample
+	prevTotal uint64
+}
+
+// NewSampler creates a host Sampler.
+func NewSampler() *Sampler {
+	return &Sampler{}
+}
+
+var _ ports.HostStatsReader = (*Sampler)(nil)
+
+func percent(part, whole uint64) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return float64(part) / float64(whole) * 100
+}
@@ -0,0 +1,132 @@
+//go:build linux
+
+package host
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// CPU reads /proc/stat and /proc/loadavg. Usage covers the interval since
+// the previous call.
+func (s *Sampler) CPU(_ context.Context) (domain.CPUStats, error) {
+	stats := domain.CPUStats{Cores: runtime.NumCPU()}
+
+	busy, total, err := readCPUTicks()
+	if err != nil {
+		return stats, err
+	}
+	s.mu.Lock()
+	if s.prevTotal > 0 && total > s.prevTotal {
+		stats.UsagePercent = percent(busy-s.prevBusy, total-s.prevTotal)
+	}
+	s.prevBusy, s.prevTotal = busy, total
+	s.mu.Unlock()
+
+	if data, err := os.ReadFile("/proc/loadavg"); err == nil {
+		fields := strings.Fields(string(data))
+		if len(fields) >= 3 {
+			stats.Load1, _ = strconv.ParseFloat(fields[0], 64)
+			stats.Load5, _ = strconv.ParseFloat(fields[1], 64)
+			stats.Load15, _ = strconv.ParseFloat(fields[2], 64)
+		}
+	}
+	return stats, nil
+}
+
+// readCPUTicks returns the busy and total jiffies from the aggregate cpu line.
+func readCPUTicks() (busy, total uint64, err error) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 5 || fields[0] != "cpu" {
+			continue
+		}
+		var idle uint64
+		for i, field := range fields[1:] {
+			v, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return 0, 0, fmt.Errorf("parse /proc/stat: %w", err)
+			}
+			total += v
+			if i == 3 || i == 4 { // idle, iowait
+				idle += v
+			}
+		}
+		return total - idle, total, nil
+	}
+	return 0, 0, fmt.Errorf("parse /proc/stat: no cpu line")
+}
+
+// Memory reads /proc/meminfo.
+func (s *Sampler) Memory(_ context.Context) (domain.MemoryStats, error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return domain.MemoryStats{}, err
+	}
+	defer f.Close()
+
+	values := map[string]uint64{}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		key, rest, ok := strings.Cut(sc.Text(), ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[0], 10, 64); err == nil {
+			values[key] = v * 1024 // kB
+		}
+	}
+	total, ok := values["MemTotal"]
+	if !ok {
+		return domain.MemoryStats{}, fmt.Errorf("parse /proc/meminfo: no MemTotal")
+	}
+	avail, ok := values["MemAvailable"]
+	if !ok {
+		avail = values["MemFree"] + values["Buffers"] + values["Cached"]
+	}
+	used := total - min(avail, total)
+	return domain.MemoryStats{
+		TotalBytes:     total,
+		AvailableBytes: avail,
+		UsedBytes:      used,
+		UsedPercent:    percent(used, total),
+	}, nil
+}
+
+// Disk reports usage of the filesystem holding path.
+func (s *Sampler) Disk(path string) (domain.DiskStats, error) {
+	var fs syscall.Statfs_t
+	if err := syscall.Statfs(path, &fs); err != nil {
+		return domain.DiskStats{}, err
+	}
+	total := fs.Blocks * uint64(fs.Bsize)
+	free := fs.Bavail * uint64(fs.Bsize)
+	used := total - fs.Bfree*uint64(fs.Bsize)
+	return domain.DiskStats{
+		Path:       path,
+		TotalBytes: total,
+		FreeBytes:  free,
+		UsedBytes:  used,
+		// Like df: used / (used + available to unprivileged users)
+		UsedPercent: percent(used, used+free),
+	}, nil
+}
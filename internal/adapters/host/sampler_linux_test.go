@@ -0,0 +1,31 @@
+//go:build linux
+
+package host
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampler_ReadsProcAndStatfs(t *testing.T) {
+	s := NewSampler()
+	ctx := context.Background()
+
+	cpu, err := s.CPU(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, cpu.Cores, 0)
+	_, err = s.CPU(ctx)
+	require.NoError(t, err)
+
+	mem, err := s.Memory(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, mem.TotalBytes, uint64(0))
+	assert.LessOrEqual(t, mem.UsedPercent, 100.0)
+
+	disk, err := s.Disk(t.TempDir())
+	require.NoError(t, err)
+	assert.Greater(t, disk.TotalBytes, uint64(0))
+}
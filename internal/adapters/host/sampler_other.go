@@ -0,0 +1,21 @@
+//go:build !linux
+
+package host
+
+import (
+	"context"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func (s *Sampler) CPU(_ context.Context) (domain.CPUStats, error) {
+	return domain.CPUStats{}, ErrUnsupported
+}
+
+func (s *Sampler) Memory(_ context.Context) (domain.MemoryStats, error) {
+	return domain.MemoryStats{}, ErrUnsupported
+}
+
+func (s *Sampler) Disk(_ string) (domain.DiskStats, error) {
+	return domain.DiskStats{}, ErrUnsupported
+}
@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
 )
 
 // DirectComfyUIProvider calls persistent ComfyUI container
@@ -30,9 +33,33 @@ func NewDirectComfyUIProvider(comfyHost string) *DirectComfyUIProvider {
 
 // GenerateImage calls ComfyUI's /prompt endpoint with workflow
 func (p *DirectComfyUIProvider) GenerateImage(ctx context.Context, prompt string) (string, error) {
-	// Build simple workflow for SD 1.5
-	workflow := p.buildWorkflow(prompt)
+	return p.GenerateImageWithModel(ctx, prompt, "")
+}
+
+// GenerateImageWithModel renders with a specific checkpoint. If model is empty, uses the default.
+func (p *DirectComfyUIProvider) GenerateImageWithModel(ctx context.Context, prompt string, model string) (string, error) {
+	return p.GenerateImageWithOptions(ctx, prompt, model, domain.ImageOptions{})
+}
 
+// GenerateImageWithOptions renders with a checkpoint (default if empty) and
+// sampler settings; unset options keep the SD 1.5 defaults.
+func (p *DirectComfyUIProvider) GenerateImageWithOptions(ctx context.Context, prompt string, model string, opts domain.ImageOptions) (string, error) {
+	checkpoint := p.checkpoint
+	if model != "" {
+		checkpoint = model
+	}
+	// Build simple workflow for SD 1.5; SaveImage is node "9"
+	return p.submit(ctx, p.buildWorkflow(prompt, checkpoint, opts), "9")
+}
+
+// GenerateImageFromWorkflow submits a rendered workflow template graph.
+// outputNode selects the node whose images are the result; empty = first with images.
+func (p *DirectComfyUIProvider) GenerateImageFromWorkflow(ctx context.Context, graph map[string]interface{}, outputNode string) (string, error) {
+	return p.submit(ctx, map[string]interface{}{"prompt": graph}, outputNode)
+}
+
+// submit posts a workflow to /prompt and waits for its image
+func (p *DirectComfyUIProvider) submit(ctx context.Context, workflow map[string]interface{}, outputNode string) (string, error) {
 	url := fmt.Sprintf("%s/prompt", p.comfyHost)
 
 	payloadBytes, err := json.Marshal(workflow)
@@ -70,7 +97,7 @@ func (p *DirectComfyUIProvider) GenerateImage(ctx context.Context, prompt string
 	}
 
 	// Wait for generation to complete and fetch image
-	imageURL, err := p.waitAndFetchImage(ctx, result.PromptID)
+	imageURL, err := p.waitAndFetchImage(ctx, result.PromptID, outputNode)
 	if err != nil {
 		return "", fmt.Errorf("failed to fetch image: %w", err)
 	}
@@ -78,8 +105,13 @@ func (p *DirectComfyUIProvider) GenerateImage(ctx context.Context, prompt string
 	return imageURL, nil
 }
 
+// HealthURL is probed by the image router; ComfyUI serves its UI on GET /.
+func (p *DirectComfyUIProvider) HealthURL() string {
+	return p.comfyHost
+}
+
 // waitAndFetchImage polls /history until generation completes and returns image URL
-func (p *DirectComfyUIProvider) waitAndFetchImage(ctx context.Context, promptID string) (string, error) {
+func (p *DirectComfyUIProvider) waitAndFetchImage(ctx context.Context, promptID string, outputNode string) (string, error) {
 	maxAttempts := 60 // 60 attempts * 2s = 120s max wait
 	
 	for i := 0; i < maxAttempts; i++ {
@@ -119,16 +151,9 @@ func (p *DirectComfyUIProvider) waitAndFetchImage(ctx context.Context, promptID
 			continue
 		}
 
-		// Find SaveImage node output (node "9" in our workflow)
-		saveImageOutput, ok := outputs["9"].(map[string]interface{})
-		if !ok {
-			time.Sleep(2 * time.Second)
-			continue
-		}
-
-		// Get images array
-		images, ok := saveImageOutput["images"].([]interface{})
-		if !ok || len(images) == 0 {
+		// Find the SaveImage node output
+		images := outputImages(outputs, outputNode)
+		if len(images) == 0 {
 			time.Sleep(2 * time.Second)
 			continue
 		}
@@ -154,15 +179,57 @@ func (p *DirectComfyUIProvider) waitAndFetchImage(ctx context.Context, promptID
 	return "", fmt.Errorf("timeout waiting for image generation")
 }
 
+// outputImages returns the images of outputNode, or of the first output
+// that has any when outputNode is empty (sorted by node ID for determinism).
+func outputImages(outputs map[string]interface{}, outputNode string) []interface{} {
+	nodes := []string{outputNode}
+	if outputNode == "" {
+		nodes = make([]string, 0, len(outputs))
+		for id := range outputs {
+			nodes = append(nodes, id)
+		}
+		sort.Strings(nodes)
+	}
+	for _, id := range nodes {
+		out, ok := outputs[id].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if images, ok := out["images"].([]interface{}); ok && len(images) > 0 {
+			return images
+		}
+	}
+	return nil
+}
+
 // buildWorkflow creates a simple SD 1.5 workflow
-func (p *DirectComfyUIProvider) buildWorkflow(prompt string) map[string]interface{} {
+func (p *DirectComfyUIProvider) buildWorkflow(prompt, checkpoint string, opts domain.ImageOptions) map[string]interface{} {
+	seed := int64(42)
+	if opts.Seed != nil {
+		seed = *opts.Seed
+	}
+	steps := 20
+	if opts.Steps > 0 {
+		steps = opts.Steps
+	}
+	width, height := 512, 512
+	if opts.Width > 0 {
+		width = opts.Width
+	}
+	if opts.Height > 0 {
+		height = opts.Height
+	}
+	negative := "bad quality, blurry, ugly"
+	if opts.NegativePrompt != "" {
+		negative = opts.NegativePrompt
+	}
 	return map[string]interface{}{
 		"prompt": map[string]interface{}{
 			// KSampler
 			"3": map[string]interface{}{
 				"inputs": map[string]interface{}{
-					"seed":         42,
-					"steps":        20,
+					"seed":         seed,
+					"steps":        steps,
 					"cfg":          7.0,
 					"sampler_name": "euler",
 					"scheduler":    "normal",
@@ -177,15 +244,15 @@ func (p *DirectComfyUIProvider) buildWorkflow(prompt string) map[string]interfac
 			// CheckpointLoader
 			"4": map[string]interface{}{
 				"inputs": map[string]interface{}{
-					"ckpt_name": p.checkpoint,
+					"ckpt_name": checkpoint,
 				},
 				"class_type": "CheckpointLoaderSimple",
 			},
 			// EmptyLatentImage
 			"5": map[string]interface{}{
 				"inputs": map[string]interface{}{
-					"width":      512,
-					"height":     512,
+					"width":      width,
+					"height":     height,
 					"batch_size": 1,
 				},
 				"class_type": "EmptyLatentImage",
@@ -201,7 +268,7 @@ func (p *DirectComfyUIProvider) buildWorkflow(prompt string) map[string]interfac
 			// Negative prompt
 			"7": map[string]interface{}{
 				"inputs": map[string]interface{}{
-					"text": "bad quality, blurry, ugly",
+					"text": negative,
 					"clip": []interface{}{"4", 1},
 				},
 				"class_type": "CLIPTextEncode",
@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
 )
 
 // OpenAIImageProvider implements image generation via OpenAI-compatible API.
@@ -34,12 +36,33 @@ func NewOpenAIImageProvider(baseURL, apiKey, model string) *OpenAIImageProvider
 }
 
 func (p *OpenAIImageProvider) GenerateImage(ctx context.Context, prompt string) (string, error) {
+	return p.GenerateImageWithModel(ctx, prompt, "")
+}
+
+// GenerateImageWithModel uses a specific model override. If model is empty, uses the default.
+func (p *OpenAIImageProvider) GenerateImageWithModel(ctx context.Context, prompt string, model string) (string, error) {
+	return p.GenerateImageWithOptions(ctx, prompt, model, domain.ImageOptions{})
+}
+
+// GenerateImageWithOptions honours the size; the API has no negative prompt,
+// so one is appended to the prompt. Steps and seed are not supported.
+func (p *OpenAIImageProvider) GenerateImageWithOptions(ctx context.Context, prompt string, model string, opts domain.ImageOptions) (string, error) {
 	url := fmt.Sprintf("%s/images/generations", p.baseURL)
+	if model == "" {
+		model = p.model
+	}
+	size := "1024x1024"
+	if opts.Width > 0 && opts.Height > 0 {
+		size = fmt.Sprintf("%dx%d", opts.Width, opts.Height)
+	}
+	if opts.NegativePrompt != "" {
+		prompt += "\n\nAvoid: " + opts.NegativePrompt
+	}
 
 	payload := map[string]interface{}{
-		"model":  p.model,
+		"model":  model,
 		"prompt": prompt,
-		"size":   "1024x1024",
+		"size":   size,
 	}
 
 	payloadBytes, err := json.Marshal(payload)
@@ -83,3 +106,8 @@ func (p *OpenAIImageProvider) GenerateImage(ctx context.Context, prompt string)
 
 	return result.Data[0].URL, nil
 }
+
+// HealthURL is probed by the image router; GET /models is cheap on OpenAI-compatible APIs.
+func (p *OpenAIImageProvider) HealthURL() string {
+	return p.baseURL + "/models"
+}
@@ -0,0 +1,209 @@
+package imagegen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// modelAwareProvider is implemented by backends that accept a per-request model.
+type modelAwareProvider interface {
+	GenerateImageWithModel(ctx context.Context, prompt string, model string) (string, error)
+}
+
+// optionsProvider is implemented by backends that honour generation
+// parameters (size, steps, seed, negative prompt). model "" means default.
+type optionsProvider interface {
+	GenerateImageWithOptions(ctx context.Context, prompt string, model string, opts domain.ImageOptions) (string, error)
+}
+
+// workflowProvider is implemented by backends that run ComfyUI workflow graphs.
+type workflowProvider interface {
+	GenerateImageFromWorkflow(ctx context.Context, graph map[string]interface{}, outputNode string) (string, error)
+}
+
+// healthURLProvider is implemented by backends that expose a cheap probe URL.
+type healthURLProvider interface {
+	HealthURL() string
+}
+
+// Backend is one named image backend behind a Router.
+type Backend struct {
+	Name     string
+	Mode     string
+	Model    string   // default model/checkpoint
+	Models   []string // other models this backend serves
+	Provider domain.ImageProvider
+}
+
+func (b Backend) serves(model string) bool {
+	if strings.EqualFold(b.Model, model) {
+		return true
+	}
+	for _, m := range b.Models {
+		if strings.EqualFold(m, model) {
+			return true
+		}
+	}
+	return false
+}
+
+// Router implements domain.ImageRouter over several backends.
+// Backends are tried in order; the first one is the default.
+type Router struct {
+	backends []Backend
+	client   *http.Client // health probes
+}
+
+// NewRouter creates a router over the given backends, in failover order.
+func NewRouter(backends ...Backend) *Router {
+	return &Router{
+		backends: backends,
+		client:   &http.Client{Timeout: 2 * time.Second},
+	}
+}
+
+// Backends returns the configured backends in failover order.
+func (r *Router) Backends() []Backend {
+	out := make([]Backend, len(r.backends))
+	copy(out, r.backends)
+	return out
+}
+
+// GenerateImage implements domain.ImageProvider using the default failover order.
+func (r *Router) GenerateImage(ctx context.Context, prompt string) (string, error) {
+	url, _, err := r.GenerateImageWith(ctx, prompt, domain.ImageRequest{})
+	return url, err
+}
+
+// GenerateImageWith implements domain.ImageRouter.
+// A named backend is used alone. Otherwise backends serving the requested
+// model are tried first, then the rest, each failure falling over to the next.
+func (r *Router) GenerateImageWith(ctx context.Context, prompt string, req domain.ImageRequest) (string, string, error) {
+	candidates, err := r.candidates(req)
+	if err != nil {
+		return "", "", err
+	}
+
+	var errs []error
+	for _, b := range candidates {
+		if err := ctx.Err(); err != nil {
+			return "", "", err
+		}
+		url, err := generate(ctx, b, prompt, req)
+		if err == nil {
+			return url, b.Name, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", b.Name, err))
+	}
+	return "", "", fmt.Errorf("all image backends failed: %w", errors.Join(errs...))
+}
+
+func (r *Router) candidates(req domain.ImageRequest) ([]Backend, error) {
+	if len(r.backends) == 0 {
+		return nil, fmt.Errorf("no image backends configured")
+	}
+	backends := r.backends
+	if req.Workflow != nil {
+		backends = nil
+		for _, b := range r.backends {
+			if _, ok := b.Provider.(workflowProvider); ok {
+				backends = append(backends, b)
+			}
+		}
+		if len(backends) == 0 {
+			return nil, fmt.Errorf("no image backend supports workflow templates")
+		}
+	}
+	if req.Backend != "" {
+		for _, b := range backends {
+			if b.Name == req.Backend {
+				return []Backend{b}, nil
+			}
+		}
+		if req.Workflow != nil {
+			return nil, fmt.Errorf("image backend %s does not support workflow templates or is unknown", req.Backend)
+		}
+		return nil, fmt.Errorf("unknown image backend: %s", req.Backend)
+	}
+	if req.Model == "" {
+		return backends, nil
+	}
+
+	var preferred, rest []Backend
+	for _, b := range backends {
+		if b.serves(req.Model) {
+			preferred = append(preferred, b)
+		} else {
+			rest = append(rest, b)
+		}
+	}
+	return append(preferred, rest...), nil
+}
+
+// generate runs a workflow graph as-is. Otherwise it passes the model only to
+// backends that list it as an extra model; for its default model or one it
+// does not serve, a backend renders with its own default rather than fail on
+// an unknown name. Options go to backends that take them.
+func generate(ctx context.Context, b Backend, prompt string, req domain.ImageRequest) (string, error) {
+	if req.Workflow != nil {
+		return b.Provider.(workflowProvider).GenerateImageFromWorkflow(ctx, req.Workflow, req.OutputNode)
+	}
+	model := req.Model
+	if model == "" || !b.serves(model) || strings.EqualFold(b.Model, model) {
+		model = ""
+	}
+	if p, ok := b.Provider.(optionsProvider); ok && !req.Options.IsZero() {
+		return p.GenerateImageWithOptions(ctx, prompt, model, req.Options)
+	}
+	if model != "" {
+		if p, ok := b.Provider.(modelAwareProvider); ok {
+			return p.GenerateImageWithModel(ctx, prompt, model)
+		}
+	}
+	return b.Provider.GenerateImage(ctx, prompt)
+}
+
+// Health implements domain.ImageRouter with a HEAD probe per backend.
+func (r *Router) Health(ctx context.Context) []domain.ImageBackendHealth {
+	out := make([]domain.ImageBackendHealth, 0, len(r.backends))
+	for _, b := range r.backends {
+		h := domain.ImageBackendHealth{Name: b.Name, Mode: b.Mode, Model: b.Model}
+		hp, ok := b.Provider.(healthURLProvider)
+		if !ok {
+			// Nothing to probe; assume reachable
+			h.Healthy = true
+			out = append(out, h)
+			continue
+		}
+		h.URL = hp.HealthURL()
+		if err := r.probe(ctx, h.URL); err != nil {
+			h.Error = err.Error()
+		} else {
+			h.Healthy = true
+		}
+		out = append(out, h)
+	}
+	return out
+}
+
+func (r *Router) probe(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unreachable: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 500 {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
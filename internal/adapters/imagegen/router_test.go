@@ -0,0 +1,143 @@
+package imagegen
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeImageProvider struct {
+	url   string
+	err   error
+	model string // last model override
+}
+
+func (f *fakeImageProvider) GenerateImage(ctx context.Context, prompt string) (string, error) {
+	return f.GenerateImageWithModel(ctx, prompt, "")
+}
+
+func (f *fakeImageProvider) GenerateImageWithModel(_ context.Context, _ string, model string) (string, error) {
+	f.model = model
+	return f.url, f.err
+}
+
+func TestRouter_FailsOverInOrder(t *testing.T) {
+	down := &fakeImageProvider{err: fmt.Errorf("connection refused")}
+	up := &fakeImageProvider{url: "http://b/img.png"}
+	r := NewRouter(Backend{Name: "primary", Provider: down}, Backend{Name: "cloud", Provider: up})
+
+	url, backend, err := r.GenerateImageWith(context.Background(), "cat", domain.ImageRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "http://b/img.png", url)
+	assert.Equal(t, "cloud", backend)
+}
+
+func TestRouter_NamedBackendDisablesFailover(t *testing.T) {
+	down := &fakeImageProvider{err: fmt.Errorf("boom")}
+	up := &fakeImageProvider{url: "http://a/img.png"}
+	r := NewRouter(Backend{Name: "primary", Provider: up}, Backend{Name: "cloud", Provider: down})
+
+	_, _, err := r.GenerateImageWith(context.Background(), "cat", domain.ImageRequest{Backend: "cloud"})
+	assert.Error(t, err)
+
+	_, _, err = r.GenerateImageWith(context.Background(), "cat", domain.ImageRequest{Backend: "nope"})
+	assert.ErrorContains(t, err, "unknown image backend")
+}
+
+func TestRouter_PrefersBackendServingModel(t *testing.T) {
+	local := &fakeImageProvider{url: "http://local/img.png"}
+	sdxl := &fakeImageProvider{url: "http://sdxl/img.png"}
+	r := NewRouter(
+		Backend{Name: "primary", Model: "sd-1.5", Provider: local},
+		Backend{Name: "big", Model: "sdxl", Models: []string{"sdxl-turbo"}, Provider: sdxl},
+	)
+
+	_, backend, err := r.GenerateImageWith(context.Background(), "cat", domain.ImageRequest{Model: "sdxl-turbo"})
+	require.NoError(t, err)
+	assert.Equal(t, "big", backend)
+	assert.Equal(t, "sdxl-turbo", sdxl.model)
+
+	// The default model is left to the backend's own configuration
+	_, backend, err = r.GenerateImageWith(context.Background(), "cat", domain.ImageRequest{Model: "sd-1.5"})
+	require.NoError(t, err)
+	assert.Equal(t, "primary", backend)
+	assert.Equal(t, "", local.model)
+}
+
+type fakeWorkflowProvider struct {
+	fakeImageProvider
+	graph map[string]interface{}
+}
+
+func (f *fakeWorkflowProvider) GenerateImageFromWorkflow(_ context.Context, graph map[string]interface{}, _ string) (string, error) {
+	f.graph = graph
+	return f.url, f.err
+}
+
+func TestRouter_WorkflowOnlyOnCapableBackends(t *testing.T) {
+	remote := &fakeImageProvider{url: "http://remote/img.png"}
+	comfy := &fakeWorkflowProvider{fakeImageProvider: fakeImageProvider{url: "http://comfy/img.png"}}
+	r := NewRouter(Backend{Name: "primary", Provider: remote}, Backend{Name: "comfy", Provider: comfy})
+
+	graph := map[string]interface{}{"9": map[string]interface{}{"class_type": "SaveImage"}}
+	_, backend, err := r.GenerateImageWith(context.Background(), "cat", domain.ImageRequest{Workflow: graph})
+	require.NoError(t, err)
+	assert.Equal(t, "comfy", backend)
+	assert.Equal(t, graph, comfy.graph)
+
+	_, _, err = r.GenerateImageWith(context.Background(), "cat", domain.ImageRequest{Workflow: graph, Backend: "primary"})
+	assert.Error(t, err)
+}
+
+type fakeOptionsProvider struct {
+	fakeImageProvider
+	opts *domain.ImageOptions
+}
+
+func (f *fakeOptionsProvider) GenerateImageWithOptions(_ context.Context, _ string, model string, opts domain.ImageOptions) (string, error) {
+	f.model = model
+	f.opts = &opts
+	return f.url, f.err
+}
+
+func TestRouter_PassesOptionsToCapableBackends(t *testing.T) {
+	comfy := &fakeOptionsProvider{fakeImageProvider: fakeImageProvider{url: "http://comfy/img.png"}}
+	plain := &fakeImageProvider{url: "http://plain/img.png"}
+	r := NewRouter(Backend{Name: "comfy", Model: "sd-1.5", Models: []string{"sdxl"}, Provider: comfy}, Backend{Name: "plain", Provider: plain})
+
+	seed := int64(7)
+	opts := domain.ImageOptions{NegativePrompt: "blurry", Width: 768, Height: 512, Steps: 30, Seed: &seed}
+	_, _, err := r.GenerateImageWith(context.Background(), "cat", domain.ImageRequest{Model: "sdxl", Options: opts})
+	require.NoError(t, err)
+	require.NotNil(t, comfy.opts)
+	assert.Equal(t, opts, *comfy.opts)
+	assert.Equal(t, "sdxl", comfy.model)
+
+	// Backends without option support still render
+	_, backend, err := r.GenerateImageWith(context.Background(), "cat", domain.ImageRequest{Backend: "plain", Options: opts})
+	require.NoError(t, err)
+	assert.Equal(t, "plain", backend)
+}
+
+func TestDirectComfyUI_BuildWorkflowOptions(t *testing.T) {
+	p := NewDirectComfyUIProvider("http://comfy")
+	inputs := func(wf map[string]interface{}, node string) map[string]interface{} {
+		return wf["prompt"].(map[string]interface{})[node].(map[string]interface{})["inputs"].(map[string]interface{})
+	}
+
+	wf := p.buildWorkflow("cat", "sd.safetensors", domain.ImageOptions{})
+	assert.Equal(t, int64(42), inputs(wf, "3")["seed"])
+	assert.Equal(t, 512, inputs(wf, "5")["width"])
+
+	seed := int64(99)
+	wf = p.buildWorkflow("cat", "sd.safetensors", domain.ImageOptions{NegativePrompt: "dogs", Width: 768, Height: 640, Steps: 12, Seed: &seed})
+	assert.Equal(t, int64(99), inputs(wf, "3")["seed"])
+	assert.Equal(t, 12, inputs(wf, "3")["steps"])
+	assert.Equal(t, 768, inputs(wf, "5")["width"])
+	assert.Equal(t, 640, inputs(wf, "5")["height"])
+	assert.Equal(t, "dogs", inputs(wf, "7")["text"])
+}
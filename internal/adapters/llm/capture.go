@@ -0,0 +1,89 @@
+package llm
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// maxCaptureBody bounds each recorded request or response body.
+const maxCaptureBody = 4 << 20
+
+// recordingTransport reports raw exchanges to the domain.ProviderRecorder on
+// the request context (record mode). Without one it is a plain pass-through.
+// Headers are never recorded, so API keys stay out of captures.
+type recordingTransport struct {
+	base http.RoundTripper // nil = http.DefaultTransport
+}
+
+func (t recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	rec := domain.ProviderRecorderFrom(req.Context())
+	if rec == nil {
+		return base.RoundTrip(req)
+	}
+
+	capture := domain.ProviderCapture{Method: req.Method, URL: req.URL.String(), CreatedAt: time.Now()}
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(io.LimitReader(body, maxCaptureBody))
+			body.Close()
+			capture.Request = string(b)
+		}
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		capture.Error = err.Error()
+		capture.DurationMs = time.Since(capture.CreatedAt).Milliseconds()
+		rec(capture)
+		return nil, err
+	}
+	capture.StatusCode = resp.StatusCode
+	// The exchange is reported once the caller is done with the body, so
+	// streamed responses are recorded whole.
+	resp.Body = &recordingBody{ReadCloser: resp.Body, capture: capture, rec: rec}
+	return resp, nil
+}
+
+// recordingBody copies what the caller reads and reports the exchange on
+// Close.
+type recordingBody struct {
+	io.ReadCloser
+	capture domain.ProviderCapture
+	rec     domain.ProviderRecorder
+	buf     bytes.Buffer
+	readErr error
+	once    sync.Once
+}
+
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if room := maxCaptureBody - b.buf.Len(); room > 0 {
+		b.buf.Write(p[:min(n, room)])
+	}
+	if err != nil && err != io.EOF {
+		b.readErr = err
+	}
+	return n, err
+}
+
+func (b *recordingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.capture.Response = b.buf.String()
+		if b.readErr != nil {
+			b.capture.Error = b.readErr.Error()
+		}
+		b.capture.DurationMs = time.Since(b.capture.CreatedAt).Milliseconds()
+		b.rec(b.capture)
+	})
+	return err
+}
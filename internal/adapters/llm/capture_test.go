@@ -0,0 +1,55 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"response":"Hel","done":false}`)
+		fmt.Fprintln(w, `{"response":"lo","done":true}`)
+	}))
+	defer srv.Close()
+
+	var mu sync.Mutex
+	var captures []domain.ProviderCapture
+	ctx := domain.WithProviderRecorder(context.Background(), func(c domain.ProviderCapture) {
+		mu.Lock()
+		captures = append(captures, c)
+		mu.Unlock()
+	})
+
+	p := NewOllamaProvider(srv.URL)
+	ch, err := p.GenerateTextStreamWithModel(ctx, "say hello", "m")
+	require.NoError(t, err)
+	text, err := domain.CollectStream(ch)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+
+	mu.Lock()
+	require.Len(t, captures, 1)
+	c := captures[0]
+	mu.Unlock()
+	assert.Equal(t, "POST", c.Method)
+	assert.Equal(t, srv.URL+"/api/generate", c.URL)
+	assert.Equal(t, http.StatusOK, c.StatusCode)
+	assert.Contains(t, c.Request, `"prompt":"say hello"`)
+	assert.Contains(t, c.Response, `{"response":"Hel","done":false}`)
+	assert.Contains(t, c.Response, `{"response":"lo","done":true}`)
+
+	// Without a recorder nothing is captured
+	_, err = p.Generate(context.Background(), "again", "m")
+	require.NoError(t, err)
+	mu.Lock()
+	assert.Len(t, captures, 1)
+	mu.Unlock()
+}
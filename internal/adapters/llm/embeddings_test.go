@@ -0,0 +1,36 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIEmbedder_OrdersByIndex(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/embeddings", r.URL.Path)
+		fmt.Fprint(w, `{"data":[{"index":1,"embedding":[2]},{"index":0,"embedding":[1]}]}`)
+	}))
+	defer srv.Close()
+
+	vecs, err := NewOpenAIEmbedder(srv.URL, "", "").Embed(context.Background(), []string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, [][]float32{{1}, {2}}, vecs)
+}
+
+func TestOllamaEmbedder_CountMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"embeddings":[[1,2]]}`)
+	}))
+	defer srv.Close()
+
+	e := NewOllamaEmbedder(srv.URL, "")
+	assert.Equal(t, "nomic-embed-text", e.Model())
+	_, err := e.Embed(context.Background(), []string{"a", "b"})
+	assert.Error(t, err)
+}
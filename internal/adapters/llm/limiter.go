@@ -0,0 +1,196 @@
+package llm
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// ErrQueueFull is returned when a provider's request queue is at capacity.
+var ErrQueueFull = errors.New("llm request queue is full")
+
+// LimiterStats is a snapshot of a provider's request queue.
+type LimiterStats struct {
+	Name          string         `json:"name"`
+	MaxConcurrent int            `json:"max_concurrent"`
+	MaxQueue      int            `json:"max_queue"`
+	InFlight      int            `json:"in_flight"`
+	QueueDepth    int            `json:"queue_depth"`
+	QueuedBy      map[string]int `json:"queued_by_priority"`
+	Completed     int64          `json:"completed"`
+	Rejected      int64          `json:"rejected"`
+	Cancelled     int64          `json:"cancelled"`
+	AvgWaitMs     float64        `json:"avg_wait_ms"`
+	MaxWaitMs     int64          `json:"max_wait_ms"`
+}
+
+// RequestLimiter bounds concurrent requests to one provider. Requests beyond
+// the limit wait in a queue ordered by priority (interactive chat first),
+// then by arrival.
+type RequestLimiter struct {
+	name          string
+	maxConcurrent int
+	maxQueue      int // 0 = unbounded
+
+	mu        sync.Mutex
+	inFlight  int
+	queue     waiterQueue
+	seq       uint64
+	completed int64
+	rejected  int64
+	cancelled int64
+	waited    int64 // requests that had to queue
+	totalWait time.Duration
+	maxWait   time.Duration
+}
+
+// NewRequestLimiter creates a limiter; maxConcurrent <= 0 means 1.
+func NewRequestLimiter(name string, maxConcurrent, maxQueue int) *RequestLimiter {
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+	return &RequestLimiter{name: name, maxConcurrent: maxConcurrent, maxQueue: maxQueue}
+}
+
+// Acquire waits for a slot according to the priority carried by ctx.
+// The returned release func must be called exactly once when the request ends.
+func (l *RequestLimiter) Acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	if l.inFlight < l.maxConcurrent && l.queue.Len() == 0 {
+		l.inFlight++
+		l.mu.Unlock()
+		return l.release, nil
+	}
+	if l.maxQueue > 0 && l.queue.Len() >= l.maxQueue {
+		l.rejected++
+		l.mu.Unlock()
+		return nil, ErrQueueFull
+	}
+	w := &waiter{
+		priority: domain.PriorityFrom(ctx),
+		seq:      l.seq,
+		ready:    make(chan struct{}),
+		enqueued: time.Now(),
+	}
+	l.seq++
+	heap.Push(&l.queue, w)
+	l.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		l.mu.Lock()
+		wait := time.Since(w.enqueued)
+		l.waited++
+		l.totalWait += wait
+		if wait > l.maxWait {
+			l.maxWait = wait
+		}
+		l.mu.Unlock()
+		return l.release, nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if w.index >= 0 {
+			heap.Remove(&l.queue, w.index)
+			l.cancelled++
+			return nil, ctx.Err()
+		}
+		// Slot was handed over concurrently — pass it on
+		l.inFlight--
+		l.dispatchLocked()
+		l.cancelled++
+		return nil, ctx.Err()
+	}
+}
+
+func (l *RequestLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight--
+	l.completed++
+	l.dispatchLocked()
+}
+
+// dispatchLocked hands free slots to the highest-priority waiters.
+func (l *RequestLimiter) dispatchLocked() {
+	for l.inFlight < l.maxConcurrent && l.queue.Len() > 0 {
+		w := heap.Pop(&l.queue).(*waiter)
+		l.inFlight++
+		close(w.ready)
+	}
+}
+
+// Stats returns the current queue metrics.
+func (l *RequestLimiter) Stats() LimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	s := LimiterStats{
+		Name:          l.name,
+		MaxConcurrent: l.maxConcurrent,
+		MaxQueue:      l.maxQueue,
+		InFlight:      l.inFlight,
+		QueueDepth:    l.queue.Len(),
+		QueuedBy:      map[string]int{},
+		Completed:     l.completed,
+		Rejected:      l.rejected,
+		Cancelled:     l.cancelled,
+		MaxWaitMs:     l.maxWait.Milliseconds(),
+	}
+	for _, w := range l.queue {
+		s.QueuedBy[w.priority.String()]++
+	}
+	if l.waited > 0 {
+		s.AvgWaitMs = float64(l.totalWait.Milliseconds()) / float64(l.waited)
+	}
+	return s
+}
+
+type waiter struct {
+	priority domain.RequestPriority
+	seq      uint64
+	ready    chan struct{}
+	enqueued time.Time
+	index    int // position in the heap; -1 once popped
+}
+
+// waiterQueue is a min-heap on (priority, seq).
+type waiterQueue []*waiter
+
+func (q waiterQueue) Len() int { return len(q) }
+
+func (q waiterQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority < q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q waiterQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *waiterQueue) Push(x any) {
+	w := x.(*waiter)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *waiterQueue) Pop() any {
+	old := *q
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	w.index = -1
+	*q = old[:n-1]
+	return w
+}
@@ -0,0 +1,65 @@
+package llm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLimiter_PriorityOrder(t *testing.T) {
+	l := NewRequestLimiter("test", 1, 0)
+
+	release, err := l.Acquire(context.Background())
+	require.NoError(t, err)
+
+	order := make(chan string, 2)
+	acquire := func(name string, p domain.RequestPriority) {
+		rel, err := l.Acquire(domain.WithPriority(context.Background(), p))
+		if err == nil {
+			order <- name
+			rel()
+		}
+	}
+	go acquire("background", domain.PriorityBackground)
+	require.Eventually(t, func() bool { return l.Stats().QueueDepth == 1 }, time.Second, time.Millisecond)
+	go acquire("interactive", domain.PriorityInteractive)
+	require.Eventually(t, func() bool { return l.Stats().QueueDepth == 2 }, time.Second, time.Millisecond)
+
+	stats := l.Stats()
+	assert.Equal(t, 1, stats.InFlight)
+	assert.Equal(t, map[string]int{"background": 1, "interactive": 1}, stats.QueuedBy)
+
+	release()
+	assert.Equal(t, "interactive", <-order)
+	assert.Equal(t, "background", <-order)
+	assert.Equal(t, int64(3), l.Stats().Completed)
+}
+
+func TestRequestLimiter_QueueFullAndCancel(t *testing.T) {
+	l := NewRequestLimiter("test", 1, 1)
+	release, err := l.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := l.Acquire(ctx)
+		done <- err
+	}()
+	require.Eventually(t, func() bool { return l.Stats().QueueDepth == 1 }, time.Second, time.Millisecond)
+
+	_, err = l.Acquire(context.Background())
+	assert.ErrorIs(t, err, ErrQueueFull)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	stats := l.Stats()
+	assert.Equal(t, 0, stats.QueueDepth)
+	assert.Equal(t, int64(1), stats.Rejected)
+	assert.Equal(t, int64(1), stats.Cancelled)
+}
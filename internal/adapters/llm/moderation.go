@@ -0,0 +1,159 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// OpenAIModerator implements domain.Moderator using an OpenAI-compatible
+// /moderations endpoint
+type OpenAIModerator struct {
+	client  *http.Client
+	baseURL string
+	apiKey  string
+	model   string
+	limiter *RequestLimiter // nil = unlimited
+}
+
+func NewOpenAIModerator(baseURL, apiKey, model string) *OpenAIModerator {
+	if model == "" {
+		model = "omni-moderation-latest"
+	}
+	return &OpenAIModerator{
+		client:  &http.Client{Timeout: 30 * time.Second},
+		baseURL: baseURL,
+		apiKey:  apiKey,
+		model:   model,
+	}
+}
+
+// SetLimiter bounds concurrent requests to the remote endpoint.
+func (m *OpenAIModerator) SetLimiter(l *RequestLimiter) {
+	m.limiter = l
+}
+
+// Moderate implements domain.Moderator.
+func (m *OpenAIModerator) Moderate(ctx context.Context, text string) (domain.ModerationResult, error) {
+	release, err := m.limiter.Acquire(ctx)
+	if err != nil {
+		return domain.ModerationResult{}, fmt.Errorf("llm: %w", err)
+	}
+	defer release()
+
+	payloadBytes, err := json.Marshal(map[string]interface{}{"model": m.model, "input": text})
+	if err != nil {
+		return domain.ModerationResult{}, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/moderations", m.baseURL), bytes.NewReader(payloadBytes))
+	if err != nil {
+		return domain.ModerationResult{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if m.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return domain.ModerationResult{}, fmt.Errorf("failed to call API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		return domain.ModerationResult{}, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Results []struct {
+			Flagged    bool            `json:"flagged"`
+			Categories map[string]bool `json:"categories"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return domain.ModerationResult{}, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(result.Results) == 0 {
+		return domain.ModerationResult{}, fmt.Errorf("API returned no moderation results")
+	}
+
+	r := result.Results[0]
+	out := domain.ModerationResult{Flagged: r.Flagged}
+	for name, hit := range r.Categories {
+		if hit {
+			out.Categories = append(out.Categories, name)
+		}
+	}
+	sort.Strings(out.Categories)
+	return out, nil
+}
+
+// llamaGuardCategories names the hazard codes Llama Guard 3 answers with.
+var llamaGuardCategories = map[string]string{
+	"S1": "violent_crimes", "S2": "non_violent_crimes", "S3": "sex_crimes",
+	"S4": "child_exploitation", "S5": "defamation", "S6": "specialized_advice",
+	"S7": "privacy", "S8": "intellectual_property", "S9": "indiscriminate_weapons",
+	"S10": "hate", "S11": "self_harm", "S12": "sexual_content",
+	"S13": "elections", "S14": "code_interpreter_abuse",
+}
+
+// GuardModerator implements domain.Moderator with a local Llama Guard
+// model: the model answers "safe", or "unsafe" followed by hazard codes.
+type GuardModerator struct {
+	llm   domain.LLMProvider
+	model string
+}
+
+func NewGuardModerator(llm domain.LLMProvider, model string) *GuardModerator {
+	if model == "" {
+		model = "llama-guard3:1b"
+	}
+	return &GuardModerator{llm: llm, model: model}
+}
+
+// Moderate implements domain.Moderator. Ollama applies Llama Guard's own
+// prompt template, so the text is sent as the user turn.
+func (m *GuardModerator) Moderate(ctx context.Context, text string) (domain.ModerationResult, error) {
+	resp, err := m.llm.GenerateTextWithModel(domain.WithDeterministic(ctx), text, m.model)
+	if err != nil {
+		return domain.ModerationResult{}, fmt.Errorf("guard model: %w", err)
+	}
+	return parseGuardVerdict(resp)
+}
+
+func parseGuardVerdict(resp string) (domain.ModerationResult, error) {
+	fields := strings.Fields(strings.ToLower(resp))
+	if len(fields) == 0 {
+		return domain.ModerationResult{}, fmt.Errorf("guard model returned an empty verdict")
+	}
+	switch fields[0] {
+	case "safe":
+		return domain.ModerationResult{}, nil
+	case "unsafe":
+	default:
+		return domain.ModerationResult{}, fmt.Errorf("unexpected guard verdict %q", resp)
+	}
+	out := domain.ModerationResult{Flagged: true}
+	for _, f := range fields[1:] {
+		for _, code := range strings.Split(f, ",") {
+			code = strings.ToUpper(strings.TrimSpace(code))
+			if code == "" {
+				continue
+			}
+			if name, ok := llamaGuardCategories[code]; ok {
+				code = name
+			}
+			out.Categories = append(out.Categories, code)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,44 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIModerator(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/moderations", r.URL.Path)
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "omni-moderation-latest", body["model"])
+		fmt.Fprint(w, `{"results":[{"flagged":true,"categories":{"violence":true,"hate":false,"harassment":true}}]}`)
+	}))
+	defer srv.Close()
+
+	res, err := NewOpenAIModerator(srv.URL, "key", "").Moderate(context.Background(), "text")
+	require.NoError(t, err)
+	assert.True(t, res.Flagged)
+	assert.Equal(t, []string{"harassment", "violence"}, res.Categories)
+}
+
+func TestParseGuardVerdict(t *testing.T) {
+	res, err := parseGuardVerdict("safe")
+	require.NoError(t, err)
+	assert.False(t, res.Flagged)
+
+	res, err = parseGuardVerdict("\nunsafe\nS1,S10")
+	require.NoError(t, err)
+	assert.True(t, res.Flagged)
+	assert.Equal(t, []string{"violent_crimes", "hate"}, res.Categories)
+
+	_, err = parseGuardVerdict("I think it's fine")
+	assert.Error(t, err)
+}
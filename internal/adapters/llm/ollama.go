@@ -5,8 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
 )
 
 // Provider abstracts the LLM backend
@@ -18,6 +22,7 @@ type Provider interface {
 type OllamaProvider struct {
 	baseURL string
 	client  *http.Client
+	limiter *RequestLimiter // nil = unlimited
 }
 
 func NewOllamaProvider(baseURL string) *OllamaProvider {
@@ -26,27 +31,59 @@ func NewOllamaProvider(baseURL string) *OllamaProvider {
 	}
 	return &OllamaProvider{
 		baseURL: baseURL,
-		client:  &http.Client{Timeout: 120 * time.Second},
+		client:  &http.Client{Timeout: 120 * time.Second, Transport: recordingTransport{}},
 	}
 }
 
 type generateRequest struct {
-	Model  string `json:"model"`
-	Prompt string `json:"prompt"`
-	Stream bool   `json:"stream"`
+	Model   string                 `json:"model"`
+	Prompt  string                 `json:"prompt"`
+	Stream  bool                   `json:"stream"`
+	Options map[string]interface{} `json:"options,omitempty"`
 }
 
 type generateResponse struct {
 	Response string `json:"response"`
 	Done     bool   `json:"done"`
+	Error    string `json:"error,omitempty"`
+}
+
+// generateOptions maps the sampling settings on ctx to Ollama options.
+func generateOptions(ctx context.Context) map[string]interface{} {
+	var opts map[string]interface{}
+	set := func(k string, v interface{}) {
+		if opts == nil {
+			opts = make(map[string]interface{}, 2)
+		}
+		opts[k] = v
+	}
+	if domain.IsDeterministic(ctx) {
+		set("temperature", 0)
+	}
+	if seed, ok := domain.SeedFrom(ctx); ok {
+		set("seed", seed)
+	}
+	return opts
+}
+
+// SetLimiter bounds concurrent requests to this Ollama instance.
+func (p *OllamaProvider) SetLimiter(l *RequestLimiter) {
+	p.limiter = l
 }
 
 func (p *OllamaProvider) Generate(ctx context.Context, prompt string, model string) (string, error) {
+	release, err := p.limiter.Acquire(ctx)
+	if err != nil {
+		return "", fmt.Errorf("ollama: %w", err)
+	}
+	defer release()
+
 	reqBody := generateRequest{
 		Model:  model,
 		Prompt: prompt,
 		Stream: false,
 	}
+	reqBody.Options = generateOptions(ctx)
 
 	jsonData, err := json.Marshal(reqBody)
 	if err != nil {
@@ -89,3 +126,125 @@ func (p *OllamaProvider) GenerateTextWithModel(ctx context.Context, prompt strin
 	}
 	return p.Generate(ctx, prompt, modelID)
 }
+
+// GenerateStream streams tokens from /api/generate (NDJSON, one object per line).
+// The limiter slot is held until the stream ends.
+func (p *OllamaProvider) GenerateStream(ctx context.Context, prompt string, model string) (<-chan domain.Chunk, error) {
+	release, err := p.limiter.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ollama: %w", err)
+	}
+
+	reqBody := generateRequest{
+		Model:  model,
+		Prompt: prompt,
+		Stream: true,
+	}
+	reqBody.Options = generateOptions(ctx)
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+"/api/generate", bytes.NewBuffer(jsonData))
+	if err != nil {
+		release()
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// No client timeout: the stream lasts as long as generation; ctx cancels it.
+	resp, err := (&http.Client{Transport: p.client.Transport}).Do(req)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("ollama connection failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		release()
+		return nil, fmt.Errorf("ollama returned status: %d", resp.StatusCode)
+	}
+
+	return streamLines(ctx, resp.Body, release, func(line []byte) (domain.Chunk, bool) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			return domain.Chunk{}, false
+		}
+		var genResp generateResponse
+		if err := json.Unmarshal(line, &genResp); err != nil {
+			return domain.Chunk{Err: fmt.Errorf("failed to decode stream chunk: %w", err)}, true
+		}
+		if genResp.Error != "" {
+			return domain.Chunk{Err: fmt.Errorf("ollama: %s", genResp.Error)}, true
+		}
+		return domain.Chunk{Text: genResp.Response, Done: genResp.Done}, true
+	}), nil
+}
+
+// GenerateTextStream implements domain.LLMProvider using the default model
+func (p *OllamaProvider) GenerateTextStream(ctx context.Context, prompt string) (<-chan domain.Chunk, error) {
+	return p.GenerateStream(ctx, prompt, "qwen2.5:latest")
+}
+
+// GenerateTextStreamWithModel implements domain.LLMProvider — streams with a specific model, falls back to default if empty
+func (p *OllamaProvider) GenerateTextStreamWithModel(ctx context.Context, prompt string, modelID string) (<-chan domain.Chunk, error) {
+	if modelID == "" {
+		return p.GenerateTextStream(ctx, prompt)
+	}
+	return p.GenerateStream(ctx, prompt, modelID)
+}
+
+// Preload implements ports.ModelPreloader. A request without a prompt loads
+// the model and sets how long Ollama keeps it in memory; embedding models
+// reject /api/generate, so they are loaded through /api/embed instead.
+func (p *OllamaProvider) Preload(ctx context.Context, model string, keepAlive time.Duration) error {
+	release, err := p.limiter.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("ollama: %w", err)
+	}
+	defer release()
+
+	body := map[string]interface{}{"model": model, "keep_alive": keepAlive.String()}
+	status, msg, err := p.postJSON(ctx, "/api/generate", body)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusBadRequest && strings.Contains(msg, "does not support generate") {
+		body["input"] = []string{}
+		status, msg, err = p.postJSON(ctx, "/api/embed", body)
+		if err != nil {
+			return err
+		}
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("ollama preload of %s returned status %d: %s", model, status, strings.TrimSpace(msg))
+	}
+	return nil
+}
+
+// postJSON sends body to path and returns the status and, on failure, the
+// response body.
+func (p *OllamaProvider) postJSON(ctx context.Context, path string, body interface{}) (int, string, error) {
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		return 0, "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+path, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, "", fmt.Errorf("ollama connection failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, "", nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return resp.StatusCode, string(msg), nil
+}
@@ -0,0 +1,83 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// pullResponse is one NDJSON line of /api/pull.
+type pullResponse struct {
+	Status    string `json:"status"`
+	Digest    string `json:"digest"`
+	Total     int64  `json:"total"`
+	Completed int64  `json:"completed"`
+	Error     string `json:"error"`
+}
+
+// Pull implements ports.ModelPuller via /api/pull. It does not take a
+// limiter slot: downloads do not compete with inference.
+func (p *OllamaProvider) Pull(ctx context.Context, model string, progress func(domain.ModelPullProgress)) error {
+	jsonData, err := json.Marshal(map[string]interface{}{"model": model, "stream": true})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+"/api/pull", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// No client timeout: large models take a long time; ctx cancels it.
+	resp, err := (&http.Client{Transport: p.client.Transport}).Do(req)
+	if err != nil {
+		return fmt.Errorf("ollama connection failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("ollama pull returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLine)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var pr pullResponse
+		if err := json.Unmarshal(line, &pr); err != nil {
+			return fmt.Errorf("failed to decode pull progress: %w", err)
+		}
+		if pr.Error != "" {
+			return fmt.Errorf("ollama: %s", pr.Error)
+		}
+		if progress != nil {
+			progress(domain.ModelPullProgress{
+				Model:     model,
+				Status:    pr.Status,
+				Digest:    pr.Digest,
+				Total:     pr.Total,
+				Completed: pr.Completed,
+			})
+		}
+		if pr.Status == "success" {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return fmt.Errorf("ollama pull of %s ended without success", model)
+}
@@ -0,0 +1,42 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOllamaPull_ReportsProgress(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/pull", r.URL.Path)
+		fmt.Fprintln(w, `{"status":"pulling manifest"}`)
+		fmt.Fprintln(w, `{"status":"downloading","digest":"sha256:ab","total":100,"completed":40}`)
+		fmt.Fprintln(w, `{"status":"success"}`)
+	}))
+	defer srv.Close()
+
+	var got []domain.ModelPullProgress
+	err := NewOllamaProvider(srv.URL).Pull(context.Background(), "llama3.2", func(p domain.ModelPullProgress) {
+		got = append(got, p)
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, int64(40), got[1].Completed)
+	assert.Equal(t, "llama3.2", got[1].Model)
+}
+
+func TestOllamaPull_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"error":"pull model manifest: file does not exist"}`)
+	}))
+	defer srv.Close()
+
+	err := NewOllamaProvider(srv.URL).Pull(context.Background(), "nope", nil)
+	assert.ErrorContains(t, err, "file does not exist")
+}
@@ -8,6 +8,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
 )
 
 // OpenAIProvider implements LLM provider using OpenAI-compatible API
@@ -17,6 +19,7 @@ type OpenAIProvider struct {
 	baseURL string
 	apiKey  string
 	model   string
+	limiter *RequestLimiter // nil = unlimited
 }
 
 // NewOpenAIProvider creates a new OpenAI-compatible provider
@@ -27,7 +30,8 @@ func NewOpenAIProvider(baseURL, apiKey, model string) *OpenAIProvider {
 
 	return &OpenAIProvider{
 		client: &http.Client{
-			Timeout: 60 * time.Second,
+			Timeout:   60 * time.Second,
+			Transport: recordingTransport{},
 		},
 		baseURL: baseURL,
 		apiKey:  apiKey,
@@ -48,8 +52,19 @@ func (p *OpenAIProvider) GenerateTextWithModel(ctx context.Context, prompt strin
 	return p.generate(ctx, prompt, modelID)
 }
 
+// SetLimiter bounds concurrent requests to this endpoint.
+func (p *OpenAIProvider) SetLimiter(l *RequestLimiter) {
+	p.limiter = l
+}
+
 // generate is the internal implementation that accepts an explicit model parameter (thread-safe).
 func (p *OpenAIProvider) generate(ctx context.Context, prompt string, model string) (string, error) {
+	release, err := p.limiter.Acquire(ctx)
+	if err != nil {
+		return "", fmt.Errorf("llm: %w", err)
+	}
+	defer release()
+
 	url := fmt.Sprintf("%s/chat/completions", p.baseURL)
 
 	payload := map[string]interface{}{
@@ -58,6 +73,12 @@ func (p *OpenAIProvider) generate(ctx context.Context, prompt string, model stri
 			{"role": "user", "content": prompt},
 		},
 	}
+	if domain.IsDeterministic(ctx) {
+		payload["temperature"] = 0
+	}
+	if seed, ok := domain.SeedFrom(ctx); ok {
+		payload["seed"] = seed
+	}
 
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
@@ -103,3 +124,97 @@ func (p *OpenAIProvider) generate(ctx context.Context, prompt string, model stri
 
 	return result.Choices[0].Message.Content, nil
 }
+
+// GenerateTextStream streams a chat completion using the configured default model.
+func (p *OpenAIProvider) GenerateTextStream(ctx context.Context, prompt string) (<-chan domain.Chunk, error) {
+	return p.generateStream(ctx, prompt, p.model)
+}
+
+// GenerateTextStreamWithModel streams with a model override. If modelID is empty, uses the configured default.
+func (p *OpenAIProvider) GenerateTextStreamWithModel(ctx context.Context, prompt string, modelID string) (<-chan domain.Chunk, error) {
+	if modelID == "" {
+		return p.GenerateTextStream(ctx, prompt)
+	}
+	return p.generateStream(ctx, prompt, modelID)
+}
+
+// generateStream calls chat completions with stream=true and decodes the SSE
+// "data:" lines until "[DONE]". The limiter slot is held until the stream ends.
+func (p *OpenAIProvider) generateStream(ctx context.Context, prompt string, model string) (<-chan domain.Chunk, error) {
+	release, err := p.limiter.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("llm: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/chat/completions", p.baseURL)
+	payload := map[string]interface{}{
+		"model": model,
+		"messages": []map[string]string{
+			{"role": "user", "content": prompt},
+		},
+		"stream": true,
+	}
+	if domain.IsDeterministic(ctx) {
+		payload["temperature"] = 0
+	}
+	if seed, ok := domain.SeedFrom(ctx); ok {
+		payload["seed"] = seed
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payloadBytes))
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	// No client timeout: a long generation may legitimately stream for minutes;
+	// cancellation comes from ctx.
+	resp, err := (&http.Client{Transport: p.client.Transport}).Do(req)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("failed to call API: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		release()
+		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return streamLines(ctx, resp.Body, release, func(line []byte) (domain.Chunk, bool) {
+		data, ok := bytes.CutPrefix(bytes.TrimSpace(line), []byte("data:"))
+		if !ok {
+			return domain.Chunk{}, false // blank separators, comments, event: lines
+		}
+		data = bytes.TrimSpace(data)
+		if string(data) == "[DONE]" {
+			return domain.Chunk{Done: true}, true
+		}
+		var event struct {
+			Choices []struct {
+				Delta struct {
+					Content string `json:"content"`
+				} `json:"delta"`
+				FinishReason *string `json:"finish_reason"`
+			} `json:"choices"`
+		}
+		if err := json.Unmarshal(data, &event); err != nil {
+			return domain.Chunk{Err: fmt.Errorf("failed to decode stream chunk: %w", err)}, true
+		}
+		if len(event.Choices) == 0 {
+			return domain.Chunk{}, false
+		}
+		return domain.Chunk{Text: event.Choices[0].Delta.Content}, true
+	}), nil
+}
@@ -0,0 +1,56 @@
+package llm
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// maxStreamLine bounds a single NDJSON/SSE line of a streamed response.
+const maxStreamLine = 1 << 20
+
+// streamLines reads body line by line on a goroutine, turning each line into
+// chunks via parse until it reports done. The body is closed and release is
+// called when the stream ends. parse returns ok=false to skip a line.
+func streamLines(ctx context.Context, body io.ReadCloser, release func(), parse func(line []byte) (chunk domain.Chunk, ok bool)) <-chan domain.Chunk {
+	ch := make(chan domain.Chunk, 16)
+	go func() {
+		defer close(ch)
+		defer release()
+		defer body.Close()
+
+		send := func(c domain.Chunk) bool {
+			select {
+			case ch <- c:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		scanner := bufio.NewScanner(body)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLine)
+		for scanner.Scan() {
+			c, ok := parse(scanner.Bytes())
+			if !ok {
+				continue
+			}
+			if !send(c) || c.Done || c.Err != nil {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			send(domain.Chunk{Err: err})
+			return
+		}
+		if ctx.Err() != nil {
+			send(domain.Chunk{Err: ctx.Err()})
+			return
+		}
+		// Backend closed the stream without an explicit end marker
+		send(domain.Chunk{Done: true})
+	}()
+	return ch
+}
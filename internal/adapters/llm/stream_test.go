@@ -0,0 +1,80 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOllamaProvider_GenerateStream(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"response":"Hel","done":false}`)
+		fmt.Fprintln(w, `{"response":"lo","done":false}`)
+		fmt.Fprintln(w, `{"response":"","done":true}`)
+	}))
+	defer srv.Close()
+
+	ch, err := NewOllamaProvider(srv.URL).GenerateTextStreamWithModel(context.Background(), "hi", "m")
+	require.NoError(t, err)
+	text, err := domain.CollectStream(ch)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+}
+
+func TestOpenAIProvider_GenerateStream(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"Hel\"}}]}\n\n")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"lo\"}}]}\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer srv.Close()
+
+	limiter := NewRequestLimiter("remote", 1, 0)
+	p := NewOpenAIProvider(srv.URL, "", "m")
+	p.SetLimiter(limiter)
+	ch, err := p.GenerateTextStream(context.Background(), "hi")
+	require.NoError(t, err)
+	text, err := domain.CollectStream(ch)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", text)
+
+	// The limiter slot is released once the stream ends
+	require.Eventually(t, func() bool { return limiter.Stats().InFlight == 0 }, time.Second, time.Millisecond)
+}
+
+func TestProviders_SendSeed(t *testing.T) {
+	var bodies []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies = append(bodies, body)
+		if r.URL.Path == "/api/generate" {
+			fmt.Fprintln(w, `{"response":"ok","done":true}`)
+			return
+		}
+		fmt.Fprint(w, `{"choices":[{"message":{"content":"ok"}}]}`)
+	}))
+	defer srv.Close()
+
+	ctx := domain.WithSeed(domain.WithDeterministic(context.Background()), 42)
+	_, err := NewOllamaProvider(srv.URL).Generate(ctx, "hi", "m")
+	require.NoError(t, err)
+	_, err = NewOpenAIProvider(srv.URL, "", "m").GenerateText(ctx, "hi")
+	require.NoError(t, err)
+	_, err = NewOllamaProvider(srv.URL).Generate(context.Background(), "hi", "m")
+	require.NoError(t, err)
+
+	require.Len(t, bodies, 3)
+	assert.Equal(t, map[string]interface{}{"temperature": float64(0), "seed": float64(42)}, bodies[0]["options"])
+	assert.Equal(t, float64(42), bodies[1]["seed"])
+	assert.NotContains(t, bodies[2], "options", "no sampling settings, no options")
+}
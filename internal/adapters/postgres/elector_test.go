@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDSN returns the database for the election tests, which need a real
+// Postgres server.
+func testDSN(t *testing.T) string {
+	dsn := os.Getenv("AULE_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("AULE_TEST_POSTGRES_DSN not set")
+	}
+	return dsn
+}
+
+func newTestElector(t *testing.T, dsn string) *Elector {
+	e, err := NewElector(slog.New(slog.NewTextHandler(io.Discard, nil)), dsn)
+	require.NoError(t, err)
+	e.retry, e.check = 10*time.Millisecond, 10*time.Millisecond
+	return e
+}
+
+func TestElector_OneLeaderPerLoopAndFailover(t *testing.T) {
+	dsn := testDSN(t)
+	kernel1 := newTestElector(t, dsn)
+	kernel2 := newTestElector(t, dsn)
+	loop := "test-" + t.Name() + time.Now().Format(time.RFC3339Nano)
+
+	ctx1, stop1 := context.WithCancel(context.Background())
+	lost1, err := kernel1.Campaign(ctx1, loop)
+	require.NoError(t, err)
+
+	ctx2, stop2 := context.WithCancel(context.Background())
+	defer stop2()
+	won := make(chan (<-chan struct{}), 1)
+	go func() {
+		lost, err := kernel2.Campaign(ctx2, loop)
+		assert.NoError(t, err)
+		won <- lost
+	}()
+
+	// Other loops are free; this one waits for kernel 1
+	_, err = kernel2.Campaign(ctx2, loop+"-other")
+	require.NoError(t, err)
+	select {
+	case <-won:
+		t.Fatal("two kernels lead one loop")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Kernel 1 shuts down: its session ends and kernel 2 takes over
+	stop1()
+	select {
+	case <-lost1:
+	case <-time.After(time.Second):
+		t.Fatal("kernel 1 kept leading after shutdown")
+	}
+	select {
+	case <-won:
+	case <-time.After(time.Second):
+		t.Fatal("kernel 2 did not take over the loop")
+	}
+}
+
+func TestNewElector_RejectsBadDSNs(t *testing.T) {
+	for _, dsn := range []string{"postgres://u@h:notaport/db", "host=h sslmode=bogus"} {
+		_, err := NewElector(slog.New(slog.NewTextHandler(io.Discard, nil)), dsn)
+		assert.Error(t, err, dsn)
+	}
+}
+
+func TestLockKey_StablePerLoop(t *testing.T) {
+	assert.Equal(t, lockKey("cron"), lockKey("cron"))
+	assert.NotEqual(t, lockKey("cron"), lockKey("heartbeat"))
+}
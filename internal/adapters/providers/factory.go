@@ -7,30 +7,65 @@ import (
 
 	"github.com/manthysbr/auleOS/internal/adapters/imagegen"
 	"github.com/manthysbr/auleOS/internal/adapters/llm"
+	"github.com/manthysbr/auleOS/internal/adapters/websearch"
 	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
 )
 
-// Build creates LLM and Image providers from app configuration.
+// LLMLimiters bound concurrent requests per LLM backend. They outlive provider
+// rebuilds so queues and metrics survive a settings hot-reload. Nil = unlimited.
+type LLMLimiters struct {
+	Local  *llm.RequestLimiter // Ollama
+	Remote *llm.RequestLimiter // OpenAI-compatible endpoint
+}
+
+// Set is the group of providers built from one app configuration.
+type Set struct {
+	LLM        domain.LLMProvider
+	Image      domain.ImageProvider
+	Embeddings domain.EmbeddingProvider
+	Search     domain.SearchProvider
+
+	RemoteLLM bool // LLM is a remote (OpenAI-compatible) endpoint, not local Ollama
+}
+
+// Build creates LLM, Image, Embedding and Search providers from app configuration.
 // It hides local/remote provider selection from callers.
-func Build(config *domain.AppConfig) (domain.LLMProvider, domain.ImageProvider, error) {
+func Build(config *domain.AppConfig, limiters LLMLimiters) (Set, error) {
 	if config == nil {
 		config = domain.DefaultConfig()
 	}
 
-	llmProvider, err := buildLLMProvider(config)
+	llmProvider, err := buildLLMProvider(config, limiters)
 	if err != nil {
-		return nil, nil, err
+		return Set{}, err
 	}
 
 	imageProvider, err := buildImageProvider(config)
 	if err != nil {
-		return nil, nil, err
+		return Set{}, err
+	}
+
+	embeddingProvider, err := buildEmbeddingProvider(config, limiters)
+	if err != nil {
+		return Set{}, err
+	}
+
+	searchProvider, err := buildSearchProvider(config)
+	if err != nil {
+		return Set{}, err
 	}
 
-	return llmProvider, imageProvider, nil
+	return Set{
+		LLM:        llmProvider,
+		Image:      imageProvider,
+		Embeddings: embeddingProvider,
+		Search:     searchProvider,
+		RemoteLLM:  strings.EqualFold(strings.TrimSpace(config.Providers.LLM.Mode), "remote"),
+	}, nil
 }
 
-func buildLLMProvider(config *domain.AppConfig) (domain.LLMProvider, error) {
+func buildLLMProvider(config *domain.AppConfig, limiters LLMLimiters) (domain.LLMProvider, error) {
 	mode := strings.ToLower(strings.TrimSpace(config.Providers.LLM.Mode))
 	switch mode {
 	case "", "local":
@@ -39,45 +74,241 @@ func buildLLMProvider(config *domain.AppConfig) (domain.LLMProvider, error) {
 			baseURL = strings.TrimSpace(config.Providers.LLM.LocalURL)
 		}
 		baseURL = normalizeOllamaBaseURL(baseURL)
-		return llm.NewOllamaProvider(baseURL), nil
+		p := llm.NewOllamaProvider(baseURL)
+		p.SetLimiter(limiters.Local)
+		return p, nil
 	case "remote":
 		if strings.TrimSpace(config.Providers.LLM.RemoteURL) == "" {
 			return nil, fmt.Errorf("llm remote_url is required when mode=remote")
 		}
-		return llm.NewOpenAIProvider(
+		p := llm.NewOpenAIProvider(
 			strings.TrimSpace(config.Providers.LLM.RemoteURL),
 			strings.TrimSpace(config.Providers.LLM.APIKey),
 			strings.TrimSpace(config.Providers.LLM.DefaultModel),
-		), nil
+		)
+		p.SetLimiter(limiters.Remote)
+		return p, nil
 	default:
 		return nil, fmt.Errorf("unsupported llm provider mode: %s", config.Providers.LLM.Mode)
 	}
 }
 
+// buildEmbeddingProvider shares the LLM limiters: embedding calls compete for
+// the same backend as chat.
+func buildEmbeddingProvider(config *domain.AppConfig, limiters LLMLimiters) (domain.EmbeddingProvider, error) {
+	cfg := config.Providers.Embeddings
+	mode := strings.ToLower(strings.TrimSpace(cfg.Mode))
+	switch mode {
+	case "", "local":
+		baseURL := strings.TrimSpace(os.Getenv("OLLAMA_HOST"))
+		if baseURL == "" {
+			baseURL = strings.TrimSpace(cfg.LocalURL)
+		}
+		p := llm.NewOllamaEmbedder(normalizeOllamaBaseURL(baseURL), strings.TrimSpace(cfg.Model))
+		p.SetLimiter(limiters.Local)
+		return p, nil
+	case "remote":
+		if strings.TrimSpace(cfg.RemoteURL) == "" {
+			return nil, fmt.Errorf("embeddings remote_url is required when mode=remote")
+		}
+		p := llm.NewOpenAIEmbedder(
+			strings.TrimSpace(cfg.RemoteURL),
+			strings.TrimSpace(cfg.APIKey),
+			strings.TrimSpace(cfg.Model),
+		)
+		p.SetLimiter(limiters.Remote)
+		return p, nil
+	default:
+		return nil, fmt.Errorf("unsupported embeddings provider mode: %s", cfg.Mode)
+	}
+}
+
+// BuildModerator creates the content moderation classifier, or nil when
+// moderation has no classifier. The local classifier is a Llama Guard model
+// on the local Ollama; the remote one uses the remote LLM endpoint and key.
+// It is built apart from Build because its settings are runtime settings.
+func BuildModerator(config *domain.AppConfig, limiters LLMLimiters) (domain.Moderator, error) {
+	cfg := config.Runtime.Moderation
+	switch cfg.Classifier {
+	case "":
+		return nil, nil
+	case domain.ModerationClassifierLocal:
+		baseURL := strings.TrimSpace(os.Getenv("OLLAMA_HOST"))
+		if baseURL == "" {
+			baseURL = strings.TrimSpace(config.Providers.LLM.LocalURL)
+		}
+		p := llm.NewOllamaProvider(normalizeOllamaBaseURL(baseURL))
+		p.SetLimiter(limiters.Local)
+		return llm.NewGuardModerator(p, strings.TrimSpace(cfg.Model)), nil
+	case domain.ModerationClassifierRemote:
+		if strings.TrimSpace(config.Providers.LLM.RemoteURL) == "" {
+			return nil, fmt.Errorf("llm remote_url is required for remote moderation")
+		}
+		m := llm.NewOpenAIModerator(
+			strings.TrimSpace(config.Providers.LLM.RemoteURL),
+			strings.TrimSpace(config.Providers.LLM.APIKey),
+			strings.TrimSpace(cfg.Model),
+		)
+		m.SetLimiter(limiters.Remote)
+		return m, nil
+	default:
+		return nil, fmt.Errorf("unsupported moderation classifier: %s", cfg.Classifier)
+	}
+}
+
+// BuildPreloader creates the model warm-up client for the local Ollama, or
+// nil when neither chat nor embeddings run locally.
+func BuildPreloader(config *domain.AppConfig, limiters LLMLimiters) ports.ModelPreloader {
+	p := localOllama(config)
+	if p == nil {
+		return nil
+	}
+	p.SetLimiter(limiters.Local)
+	return p
+}
+
+// BuildPuller creates the model download client for the local Ollama, or
+// nil when neither chat nor embeddings run locally. Downloads are not
+// limited: they do not compete with inference.
+func BuildPuller(config *domain.AppConfig) ports.ModelPuller {
+	if p := localOllama(config); p != nil {
+		return p
+	}
+	return nil
+}
+
+// localOllama returns a client for the Ollama serving chat, or else
+// embeddings; nil when both are remote.
+func localOllama(config *domain.AppConfig) *llm.OllamaProvider {
+	llmCfg, embCfg := config.Providers.LLM, config.Providers.Embeddings
+	baseURL := strings.TrimSpace(os.Getenv("OLLAMA_HOST"))
+	switch {
+	case isLocalMode(llmCfg.Mode):
+		if baseURL == "" {
+			baseURL = strings.TrimSpace(llmCfg.LocalURL)
+		}
+	case isLocalMode(embCfg.Mode):
+		if baseURL == "" {
+			baseURL = strings.TrimSpace(embCfg.LocalURL)
+		}
+	default:
+		return nil
+	}
+	return llm.NewOllamaProvider(normalizeOllamaBaseURL(baseURL))
+}
+
+func isLocalMode(mode string) bool {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	return mode == "" || mode == "local"
+}
+
+// buildImageProvider fronts the primary image backend and any extra ones with
+// a router, so jobs can pick a backend and fail over between them.
 func buildImageProvider(config *domain.AppConfig) (domain.ImageProvider, error) {
-	mode := strings.ToLower(strings.TrimSpace(config.Providers.Image.Mode))
+	img := config.Providers.Image
+	primary, err := buildImageBackend("primary", img.Mode, img.LocalURL, img.RemoteURL, img.APIKey, img.DefaultModel)
+	if err != nil {
+		return nil, err
+	}
+	backends := []imagegen.Backend{primary}
+
+	seen := map[string]bool{"primary": true}
+	for _, bc := range img.Backends {
+		name := strings.TrimSpace(bc.Name)
+		if name == "" || seen[name] {
+			return nil, fmt.Errorf("image backend names must be unique and non-empty: %q", bc.Name)
+		}
+		seen[name] = true
+		b, err := buildImageBackend(name, bc.Mode, bc.URL, bc.URL, bc.APIKey, bc.Model)
+		if err != nil {
+			return nil, err
+		}
+		b.Models = bc.Models
+		backends = append(backends, b)
+	}
+
+	return imagegen.NewRouter(backends...), nil
+}
+
+func buildImageBackend(name, mode, localURL, remoteURL, apiKey, model string) (imagegen.Backend, error) {
+	mode = strings.ToLower(strings.TrimSpace(mode))
 	switch mode {
 	case "", "local":
-		comfyHost := strings.TrimSpace(os.Getenv("COMFYUI_HOST"))
+		// COMFYUI_HOST only overrides the primary backend
+		comfyHost := ""
+		if name == "primary" {
+			comfyHost = strings.TrimSpace(os.Getenv("COMFYUI_HOST"))
+		}
 		if comfyHost == "" {
-			comfyHost = strings.TrimSpace(config.Providers.Image.LocalURL)
+			comfyHost = strings.TrimSpace(localURL)
 		}
 		if comfyHost == "" {
 			comfyHost = "http://localhost:8188"
 		}
-		return imagegen.NewDirectComfyUIProvider(comfyHost), nil
+		return imagegen.Backend{
+			Name:     name,
+			Mode:     "local",
+			Model:    strings.TrimSpace(model),
+			Provider: imagegen.NewDirectComfyUIProvider(comfyHost),
+		}, nil
 	case "remote":
-		if strings.TrimSpace(config.Providers.Image.RemoteURL) == "" {
-			return nil, fmt.Errorf("image remote_url is required when mode=remote")
-		}
-		return imagegen.NewOpenAIImageProvider(
-			strings.TrimSpace(config.Providers.Image.RemoteURL),
-			strings.TrimSpace(config.Providers.Image.APIKey),
-			strings.TrimSpace(config.Providers.Image.DefaultModel),
-		), nil
+		if strings.TrimSpace(remoteURL) == "" {
+			return imagegen.Backend{}, fmt.Errorf("image backend %s: remote_url is required when mode=remote", name)
+		}
+		return imagegen.Backend{
+			Name:  name,
+			Mode:  "remote",
+			Model: strings.TrimSpace(model),
+			Provider: imagegen.NewOpenAIImageProvider(
+				strings.TrimSpace(remoteURL),
+				strings.TrimSpace(apiKey),
+				strings.TrimSpace(model),
+			),
+		}, nil
 	default:
-		return nil, fmt.Errorf("unsupported image provider mode: %s", config.Providers.Image.Mode)
+		return imagegen.Backend{}, fmt.Errorf("unsupported image provider mode for backend %s: %s", name, mode)
+	}
+}
+
+// buildSearchProvider fronts the configured search backends with a failover
+// router. Without configured backends it keeps the historical behaviour:
+// Brave when BRAVE_SEARCH_API_KEY is set, then DuckDuckGo.
+func buildSearchProvider(config *domain.AppConfig) (domain.SearchProvider, error) {
+	cfg := config.Providers.Search
+	backendCfgs := cfg.Backends
+	if len(backendCfgs) == 0 {
+		if apiKey := strings.TrimSpace(os.Getenv("BRAVE_SEARCH_API_KEY")); apiKey != "" {
+			backendCfgs = append(backendCfgs, domain.SearchBackendConfig{Name: "brave", Kind: domain.SearchKindBrave, APIKey: apiKey})
+		}
+		backendCfgs = append(backendCfgs, domain.SearchBackendConfig{Name: "duckduckgo", Kind: domain.SearchKindDuckDuckGo})
+	}
+
+	backends := make([]websearch.Backend, 0, len(backendCfgs))
+	for _, bc := range backendCfgs {
+		b := websearch.Backend{
+			Name:              strings.TrimSpace(bc.Name),
+			Kind:              bc.Kind,
+			RequestsPerMinute: bc.RequestsPerMinute,
+		}
+		baseURL, apiKey := strings.TrimSpace(bc.URL), strings.TrimSpace(bc.APIKey)
+		switch bc.Kind {
+		case domain.SearchKindSearxNG:
+			if baseURL == "" {
+				return nil, fmt.Errorf("search backend %s: url is required for searxng", bc.Name)
+			}
+			b.Provider = websearch.NewSearxNGProvider(baseURL)
+		case domain.SearchKindBrave:
+			b.Provider = websearch.NewBraveProvider(baseURL, apiKey)
+		case domain.SearchKindTavily:
+			b.Provider = websearch.NewTavilyProvider(baseURL, apiKey)
+		case domain.SearchKindDuckDuckGo:
+			b.Provider = websearch.NewDuckDuckGoProvider(baseURL)
+		default:
+			return nil, fmt.Errorf("unsupported search backend kind for %s: %s", bc.Name, bc.Kind)
+		}
+		backends = append(backends, b)
 	}
+	return websearch.NewRouter(cfg.MaxResults, backends...), nil
 }
 
 func normalizeOllamaBaseURL(baseURL string) string {
@@ -0,0 +1,202 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// Client talks to a remote muscle node over the node API (/v1/node/*).
+// It implements ports.WorkerManager so the kernel can schedule workers on it
+// exactly as it does on the local Docker daemon.
+type Client struct {
+	baseURL string
+	http    *http.Client
+}
+
+// Ensure Client implements WorkerManager
+var _ ports.WorkerManager = (*Client)(nil)
+var _ ports.WorkerInspector = (*Client)(nil)
+var _ ports.WorkerLogReader = (*Client)(nil)
+
+// NewClient creates a node client. tlsCfg may be nil for plain HTTP (dev only).
+func NewClient(baseURL string, tlsCfg *tls.Config) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsCfg
+	return &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		http: &http.Client{
+			Transport: transport,
+			Timeout:   30 * time.Second,
+		},
+	}
+}
+
+// LoadTLSConfig builds an mTLS config from PEM files.
+// certFile/keyFile is this side's identity; caFile is the CA that signs the peer.
+// When server is true the config requires and verifies client certificates.
+func LoadTLSConfig(certFile, keyFile, caFile string, server bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load key pair: %w", err)
+	}
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("read ca: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if server {
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// Ping checks that the node API is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	resp, err := c.do(ctx, http.MethodGet, "/v1/node/health", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (c *Client) Spawn(ctx context.Context, spec domain.WorkerSpec) (domain.WorkerID, error) {
+	var out struct {
+		ID string `json:"id"`
+	}
+	if err := c.doJSON(ctx, http.MethodPost, "/v1/node/workers", spec, &out); err != nil {
+		return "", fmt.Errorf("remote spawn: %w", err)
+	}
+	return domain.WorkerID(out.ID), nil
+}
+
+func (c *Client) HealthCheck(ctx context.Context, id domain.WorkerID) (domain.HealthStatus, error) {
+	var out struct {
+		Status domain.HealthStatus `json:"status"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/v1/node/workers/"+string(id)+"/health", nil, &out); err != nil {
+		return domain.HealthStatusUnknown, fmt.Errorf("remote health check: %w", err)
+	}
+	return out.Status, nil
+}
+
+func (c *Client) Kill(ctx context.Context, id domain.WorkerID) error {
+	resp, err := c.do(ctx, http.MethodDelete, "/v1/node/workers/"+string(id), nil)
+	if err != nil {
+		return fmt.Errorf("remote kill: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (c *Client) List(ctx context.Context) ([]domain.Worker, error) {
+	var workers []domain.Worker
+	if err := c.doJSON(ctx, http.MethodGet, "/v1/node/workers", nil, &workers); err != nil {
+		return nil, fmt.Errorf("remote list: %w", err)
+	}
+	return workers, nil
+}
+
+func (c *Client) GetLogs(ctx context.Context, id domain.WorkerID) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, "/v1/node/workers/"+string(id)+"/logs", nil)
+	if err != nil {
+		return nil, fmt.Errorf("remote logs: %w", err)
+	}
+	return resp.Body, nil
+}
+
+func (c *Client) GetWorkerIP(ctx context.Context, id domain.WorkerID) (string, error) {
+	var out struct {
+		IP string `json:"ip"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/v1/node/workers/"+string(id)+"/ip", nil, &out); err != nil {
+		return "", fmt.Errorf("remote worker ip: %w", err)
+	}
+	return out.IP, nil
+}
+
+// TailLogs fetches the worker's recent log lines from the node.
+func (c *Client) TailLogs(ctx context.Context, id domain.WorkerID, tail int) ([]domain.WorkerLogLine, error) {
+	var lines []domain.WorkerLogLine
+	path := fmt.Sprintf("/v1/node/workers/%s/logs?tail=%d", id, tail)
+	if err := c.doJSON(ctx, http.MethodGet, path, nil, &lines); err != nil {
+		return nil, fmt.Errorf("remote log tail: %w", err)
+	}
+	return lines, nil
+}
+
+// Inspect asks the node for the worker's container state. Nodes serve it
+// only when their runtime supports inspection.
+func (c *Client) Inspect(ctx context.Context, id domain.WorkerID) (domain.WorkerInspection, error) {
+	var out domain.WorkerInspection
+	if err := c.doJSON(ctx, http.MethodGet, "/v1/node/workers/"+string(id)+"/inspect", nil, &out); err != nil {
+		return domain.WorkerInspection{}, fmt.Errorf("remote inspect: %w", err)
+	}
+	return out, nil
+}
+
+// do sends a request and returns the response; non-2xx statuses become errors.
+func (c *Client) do(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("node connection failed: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, fmt.Errorf("node returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return resp, nil
+}
+
+func (c *Client) doJSON(ctx context.Context, method, path string, body, out interface{}) error {
+	resp, err := c.do(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package websearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// BraveProvider searches with the Brave Search API.
+// Endpoint: GET {baseURL}/res/v1/web/search?q=...&count=N
+type BraveProvider struct {
+	client  *http.Client
+	baseURL string
+	apiKey  string
+}
+
+// NewBraveProvider uses the public Brave API when baseURL is empty.
+func NewBraveProvider(baseURL, apiKey string) *BraveProvider {
+	if baseURL == "" {
+		baseURL = "https://api.search.brave.com"
+	}
+	return &BraveProvider{
+		client:  &http.Client{Timeout: requestTimeout},
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+	}
+}
+
+func (p *BraveProvider) Search(ctx context.Context, query string, count int) ([]domain.SearchResult, error) {
+	reqURL := fmt.Sprintf("%s/res/v1/web/search?q=%s&count=%d", p.baseURL, url.QueryEscape(query), count)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Subscription-Token", p.apiKey)
+
+	var body struct {
+		Web struct {
+			Results []struct {
+				Title       string `json:"title"`
+				URL         string `json:"url"`
+				Description string `json:"description"`
+			} `json:"results"`
+		} `json:"web"`
+	}
+	if err := getJSON(p.client, req, &body); err != nil {
+		return nil, fmt.Errorf("brave api: %w", err)
+	}
+
+	results := make([]domain.SearchResult, 0, len(body.Web.Results))
+	for _, r := range body.Web.Results {
+		results = append(results, domain.SearchResult{Title: r.Title, Link: r.URL, Snippet: r.Description})
+	}
+	return results, nil
+}
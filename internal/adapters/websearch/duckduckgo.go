@@ -0,0 +1,111 @@
+package websearch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+var (
+	// Title link: <a class="result__a" href="(url)">(title)</a>
+	ddgLinkRe = regexp.MustCompile(`<a[^>]+class="[^"]*result__a[^"]*"[^>]+href="([^"]+)"[^>]*>([^<]+)</a>`)
+	// Snippet: <a class="result__snippet" ...>(text)</a>
+	ddgSnippetRe = regexp.MustCompile(`<a[^>]+class="[^"]*result__snippet[^"]*"[^>]*>([^<]+)</a>`)
+)
+
+// DuckDuckGoProvider scrapes the non-JS DuckDuckGo HTML page. It needs no
+// API key but breaks when the page layout changes.
+type DuckDuckGoProvider struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewDuckDuckGoProvider uses html.duckduckgo.com when baseURL is empty.
+func NewDuckDuckGoProvider(baseURL string) *DuckDuckGoProvider {
+	if baseURL == "" {
+		baseURL = "https://html.duckduckgo.com"
+	}
+	return &DuckDuckGoProvider{
+		client:  &http.Client{Timeout: requestTimeout},
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+func (p *DuckDuckGoProvider) Search(ctx context.Context, query string, count int) ([]domain.SearchResult, error) {
+	reqURL := p.baseURL + "/html/?q=" + url.QueryEscape(query)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Use a modern User-Agent to avoid being blocked or served mobile version
+	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,*/*;q=0.8")
+	req.Header.Set("Accept-Language", "en-US,en;q=0.5")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ddg error: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	results := parseDuckDuckGo(string(body), count)
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no results found on DuckDuckGo (layout likely changed or blocked)")
+	}
+	return results, nil
+}
+
+func parseDuckDuckGo(html string, count int) []domain.SearchResult {
+	linkMatches := ddgLinkRe.FindAllStringSubmatch(html, -1)
+	snippetMatches := ddgSnippetRe.FindAllStringSubmatch(html, -1)
+
+	var results []domain.SearchResult
+	for i, match := range linkMatches {
+		if len(results) == count {
+			break
+		}
+		rawLink := match[1]
+		title := match[2]
+
+		// Decode URL if it is a DDG redirect (//duckduckgo.com/l/?kh=-1&uddg=...)
+		link := rawLink
+		if strings.Contains(rawLink, "uddg=") {
+			if u, err := url.Parse(rawLink); err == nil {
+				if val := u.Query().Get("uddg"); val != "" {
+					link = val
+				}
+			}
+		}
+
+		snippet := ""
+		if i < len(snippetMatches) {
+			snippet = snippetMatches[i][1]
+		}
+
+		title = stripBold(strings.TrimSpace(title))
+		snippet = stripBold(strings.TrimSpace(snippet))
+
+		if title != "" && link != "" {
+			results = append(results, domain.SearchResult{Title: title, Link: link, Snippet: snippet})
+		}
+	}
+	return results
+}
+
+func stripBold(s string) string {
+	return strings.NewReplacer("<b>", "", "</b>", "").Replace(s)
+}
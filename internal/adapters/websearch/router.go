@@ -0,0 +1,136 @@
+package websearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// DefaultMaxResults is the result count when neither the caller nor the
+// settings choose one.
+const DefaultMaxResults = 5
+
+const requestTimeout = 10 * time.Second
+
+// Backend is one named search backend behind a Router.
+type Backend struct {
+	Name              string
+	Kind              string
+	RequestsPerMinute int // 0 = unlimited
+	Provider          domain.SearchProvider
+}
+
+// Router implements domain.SearchProvider over several backends, tried in
+// order. Backends over their per-minute budget are skipped.
+type Router struct {
+	backends   []Backend
+	limiters   []*rateLimiter
+	maxResults int
+}
+
+// NewRouter creates a router returning maxResults results by default
+// (DefaultMaxResults if <= 0).
+func NewRouter(maxResults int, backends ...Backend) *Router {
+	if maxResults <= 0 {
+		maxResults = DefaultMaxResults
+	}
+	limiters := make([]*rateLimiter, len(backends))
+	for i, b := range backends {
+		limiters[i] = newRateLimiter(b.RequestsPerMinute)
+	}
+	return &Router{backends: backends, limiters: limiters, maxResults: maxResults}
+}
+
+// Backends returns the configured backends in failover order.
+func (r *Router) Backends() []Backend {
+	out := make([]Backend, len(r.backends))
+	copy(out, r.backends)
+	return out
+}
+
+// Search implements domain.SearchProvider.
+func (r *Router) Search(ctx context.Context, query string, count int) ([]domain.SearchResult, error) {
+	if len(r.backends) == 0 {
+		return nil, fmt.Errorf("no search backends configured")
+	}
+	if count <= 0 {
+		count = r.maxResults
+	}
+	count = min(count, domain.MaxSearchResults)
+
+	var errs []error
+	for i, b := range r.backends {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if !r.limiters[i].allow(time.Now()) {
+			errs = append(errs, fmt.Errorf("%s: %w", b.Name, domain.ErrSearchRateLimited))
+			continue
+		}
+		results, err := b.Provider.Search(ctx, query, count)
+		if err == nil {
+			if len(results) > count {
+				results = results[:count]
+			}
+			return results, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", b.Name, err))
+	}
+	return nil, fmt.Errorf("all search backends failed: %w", errors.Join(errs...))
+}
+
+// rateLimiter admits at most perMinute calls in any sliding minute.
+type rateLimiter struct {
+	perMinute int
+
+	mu    sync.Mutex
+	calls []time.Time
+}
+
+func newRateLimiter(perMinute int) *rateLimiter {
+	return &rateLimiter{perMinute: perMinute}
+}
+
+func (l *rateLimiter) allow(now time.Time) bool {
+	if l.perMinute <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cutoff := now.Add(-time.Minute)
+	kept := l.calls[:0]
+	for _, t := range l.calls {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	l.calls = kept
+	if len(l.calls) >= l.perMinute {
+		return false
+	}
+	l.calls = append(l.calls, now)
+	return true
+}
+
+// getJSON runs req and decodes a 200 JSON response into out.
+func getJSON(client *http.Client, req *http.Request, out interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return domain.ErrSearchRateLimited
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
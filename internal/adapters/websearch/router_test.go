@@ -0,0 +1,137 @@
+package websearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSearch struct {
+	results []domain.SearchResult
+	err     error
+	calls   int
+	count   int // last requested count
+}
+
+func (f *fakeSearch) Search(_ context.Context, _ string, count int) ([]domain.SearchResult, error) {
+	f.calls++
+	f.count = count
+	return f.results, f.err
+}
+
+func TestRouter_FailsOverAndCapsCount(t *testing.T) {
+	down := &fakeSearch{err: fmt.Errorf("connection refused")}
+	up := &fakeSearch{results: []domain.SearchResult{{Title: "a"}, {Title: "b"}, {Title: "c"}}}
+	r := NewRouter(2, Backend{Name: "searx", Provider: down}, Backend{Name: "ddg", Provider: up})
+
+	results, err := r.Search(context.Background(), "go", 0)
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, 2, up.count)
+
+	_, err = r.Search(context.Background(), "go", 500)
+	require.NoError(t, err)
+	assert.Equal(t, domain.MaxSearchResults, up.count)
+}
+
+func TestRouter_SkipsRateLimitedBackends(t *testing.T) {
+	brave := &fakeSearch{results: []domain.SearchResult{{Title: "brave"}}}
+	ddg := &fakeSearch{results: []domain.SearchResult{{Title: "ddg"}}}
+	r := NewRouter(0,
+		Backend{Name: "brave", RequestsPerMinute: 1, Provider: brave},
+		Backend{Name: "ddg", Provider: ddg},
+	)
+
+	first, err := r.Search(context.Background(), "q", 0)
+	require.NoError(t, err)
+	second, err := r.Search(context.Background(), "q", 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, "brave", first[0].Title)
+	assert.Equal(t, "ddg", second[0].Title)
+	assert.Equal(t, 1, brave.calls)
+}
+
+func TestRouter_AllFailing(t *testing.T) {
+	r := NewRouter(0, Backend{Name: "only", RequestsPerMinute: 1, Provider: &fakeSearch{}})
+	_, err := r.Search(context.Background(), "q", 0)
+	require.NoError(t, err)
+
+	_, err = r.Search(context.Background(), "q", 0)
+	assert.ErrorIs(t, err, domain.ErrSearchRateLimited)
+
+	_, err = NewRouter(0).Search(context.Background(), "q", 0)
+	assert.ErrorContains(t, err, "no search backends")
+}
+
+func TestRateLimiter_SlidingMinute(t *testing.T) {
+	l := newRateLimiter(2)
+	now := time.Now()
+	assert.True(t, l.allow(now))
+	assert.True(t, l.allow(now.Add(10*time.Second)))
+	assert.False(t, l.allow(now.Add(30*time.Second)))
+	assert.True(t, l.allow(now.Add(61*time.Second)))
+}
+
+func TestProviders_ParseResponses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search":
+			if r.Method == http.MethodPost { // tavily
+				assert.Equal(t, "Bearer tv-key", r.Header.Get("Authorization"))
+				var body map[string]interface{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				assert.Equal(t, float64(3), body["max_results"])
+				fmt.Fprint(w, `{"results":[{"title":"T","url":"https://t","content":"tavily"}]}`)
+				return
+			}
+			assert.Equal(t, "json", r.URL.Query().Get("format"))
+			fmt.Fprint(w, `{"results":[{"title":"S1","url":"https://s1","content":"one"},{"title":"S2","url":"https://s2","content":"two"}]}`)
+		case "/res/v1/web/search":
+			assert.Equal(t, "br-key", r.Header.Get("X-Subscription-Token"))
+			assert.Equal(t, "3", r.URL.Query().Get("count"))
+			fmt.Fprint(w, `{"web":{"results":[{"title":"B","url":"https://b","description":"brave"}]}}`)
+		case "/html/":
+			fmt.Fprint(w, `<a rel="nofollow" class="result__a" href="//duckduckgo.com/l/?uddg=https%3A%2F%2Fd.example">D title</a>`+
+				`<a class="result__snippet" href="x">ddg snippet</a>`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	searx, err := NewSearxNGProvider(srv.URL).Search(ctx, "q", 1)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SearchResult{{Title: "S1", Link: "https://s1", Snippet: "one"}}, searx)
+
+	brave, err := NewBraveProvider(srv.URL, "br-key").Search(ctx, "q", 3)
+	require.NoError(t, err)
+	assert.Equal(t, "https://b", brave[0].Link)
+
+	tavily, err := NewTavilyProvider(srv.URL, "tv-key").Search(ctx, "q", 3)
+	require.NoError(t, err)
+	assert.Equal(t, "tavily", tavily[0].Snippet)
+
+	ddg, err := NewDuckDuckGoProvider(srv.URL).Search(ctx, "q", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SearchResult{{Title: "D title", Link: "https://d.example", Snippet: "ddg snippet"}}, ddg)
+}
+
+func TestProviders_RateLimitStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	_, err := NewBraveProvider(srv.URL, "k").Search(context.Background(), "q", 1)
+	assert.ErrorIs(t, err, domain.ErrSearchRateLimited)
+}
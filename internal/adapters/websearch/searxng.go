@@ -0,0 +1,54 @@
+package websearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SearxNGProvider searches a SearxNG instance through its JSON API.
+// The instance must have the json format enabled in settings.yml.
+// Endpoint: GET {baseURL}/search?q=...&format=json
+type SearxNGProvider struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewSearxNGProvider(baseURL string) *SearxNGProvider {
+	return &SearxNGProvider{
+		client:  &http.Client{Timeout: requestTimeout},
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+func (p *SearxNGProvider) Search(ctx context.Context, query string, count int) ([]domain.SearchResult, error) {
+	reqURL := fmt.Sprintf("%s/search?q=%s&format=json", p.baseURL, url.QueryEscape(query))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		Results []struct {
+			Title   string `json:"title"`
+			URL     string `json:"url"`
+			Content string `json:"content"`
+		} `json:"results"`
+	}
+	if err := getJSON(p.client, req, &body); err != nil {
+		return nil, fmt.Errorf("searxng: %w", err)
+	}
+
+	results := make([]domain.SearchResult, 0, min(len(body.Results), count))
+	for _, r := range body.Results {
+		if len(results) == count {
+			break
+		}
+		results = append(results, domain.SearchResult{Title: r.Title, Link: r.URL, Snippet: r.Content})
+	}
+	return results, nil
+}
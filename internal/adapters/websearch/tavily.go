@@ -0,0 +1,65 @@
+package websearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// TavilyProvider searches with the Tavily API.
+// Endpoint: POST {baseURL}/search {"query":"...","max_results":N}
+type TavilyProvider struct {
+	client  *http.Client
+	baseURL string
+	apiKey  string
+}
+
+// NewTavilyProvider uses the public Tavily API when baseURL is empty.
+func NewTavilyProvider(baseURL, apiKey string) *TavilyProvider {
+	if baseURL == "" {
+		baseURL = "https://api.tavily.com"
+	}
+	return &TavilyProvider{
+		client:  &http.Client{Timeout: requestTimeout},
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+	}
+}
+
+func (p *TavilyProvider) Search(ctx context.Context, query string, count int) ([]domain.SearchResult, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"query":       query,
+		"max_results": count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/search", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.apiKey)
+
+	var body struct {
+		Results []struct {
+			Title   string `json:"title"`
+			URL     string `json:"url"`
+			Content string `json:"content"`
+		} `json:"results"`
+	}
+	if err := getJSON(p.client, req, &body); err != nil {
+		return nil, fmt.Errorf("tavily api: %w", err)
+	}
+
+	results := make([]domain.SearchResult, 0, len(body.Results))
+	for _, r := range body.Results {
+		results = append(results, domain.SearchResult{Title: r.Title, Link: r.URL, Snippet: r.Content})
+	}
+	return results, nil
+}
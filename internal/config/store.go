@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"sync"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
@@ -69,9 +71,50 @@ func (s *SettingsStore) GetConfig() *domain.AppConfig {
 	cp := *s.config
 	cp.Providers.LLM = s.config.Providers.LLM
 	cp.Providers.Image = s.config.Providers.Image
+	cp.Providers.Image.Backends = append([]domain.ImageBackendConfig(nil), s.config.Providers.Image.Backends...)
+	cp.Providers.Embeddings = s.config.Providers.Embeddings
+	cp.Providers.Search.Backends = append([]domain.SearchBackendConfig(nil), s.config.Providers.Search.Backends...)
+	cp.Runtime = copyRuntime(s.config.Runtime)
+	cp.Email = copyEmail(s.config.Email)
+	cp.Calendar = s.config.Calendar
 	return &cp
 }
 
+func copyEmail(e domain.EmailConfig) domain.EmailConfig {
+	e.SMTP.AllowedRecipients = append([]string(nil), e.SMTP.AllowedRecipients...)
+	e.IMAP.AllowedSenders = append([]string(nil), e.IMAP.AllowedSenders...)
+	return e
+}
+
+func copyRuntime(rt domain.RuntimeConfig) domain.RuntimeConfig {
+	rt.CORSOrigins = append([]string(nil), rt.CORSOrigins...)
+	rt.DisabledTools = append([]string(nil), rt.DisabledTools...)
+	rt.Redaction.Detectors = append([]string(nil), rt.Redaction.Detectors...)
+	rt.Redaction.Patterns = append([]domain.RedactionPattern(nil), rt.Redaction.Patterns...)
+	rt.RateLimits = maps.Clone(rt.RateLimits)
+	rt.Images.Allow = append([]string(nil), rt.Images.Allow...)
+	rt.Images.Deny = append([]string(nil), rt.Images.Deny...)
+	rt.Images.Pins = maps.Clone(rt.Images.Pins)
+	rt.ChatArtifacts.Extensions = append([]string(nil), rt.ChatArtifacts.Extensions...)
+	rt.ProjectShares = maps.Clone(rt.ProjectShares)
+	rt.ModelWarmup.Models = append([]string(nil), rt.ModelWarmup.Models...)
+	if rt.PluginHosts != nil {
+		hosts := make(map[string][]string, len(rt.PluginHosts))
+		for plugin, h := range rt.PluginHosts {
+			hosts[plugin] = append([]string(nil), h...)
+		}
+		rt.PluginHosts = hosts
+	}
+	if rt.KeyRateLimits != nil {
+		keys := make(map[string]map[string]domain.RateLimit, len(rt.KeyRateLimits))
+		for k, limits := range rt.KeyRateLimits {
+			keys[k] = maps.Clone(limits)
+		}
+		rt.KeyRateLimits = keys
+	}
+	return rt
+}
+
 // GetMaskedConfig returns config safe for API response (secrets masked).
 func (s *SettingsStore) GetMaskedConfig() *domain.AppConfig {
 	s.mu.RLock()
@@ -82,9 +125,67 @@ func (s *SettingsStore) GetMaskedConfig() *domain.AppConfig {
 	cp.Providers.LLM.APIKey = MaskSecret(s.config.Providers.LLM.APIKey)
 	cp.Providers.Image = s.config.Providers.Image
 	cp.Providers.Image.APIKey = MaskSecret(s.config.Providers.Image.APIKey)
+	cp.Providers.Image.Backends = make([]domain.ImageBackendConfig, len(s.config.Providers.Image.Backends))
+	for i, b := range s.config.Providers.Image.Backends {
+		b.APIKey = MaskSecret(b.APIKey)
+		cp.Providers.Image.Backends[i] = b
+	}
+	cp.Providers.Embeddings = s.config.Providers.Embeddings
+	cp.Providers.Embeddings.APIKey = MaskSecret(s.config.Providers.Embeddings.APIKey)
+	cp.Providers.Search.Backends = make([]domain.SearchBackendConfig, len(s.config.Providers.Search.Backends))
+	for i, b := range s.config.Providers.Search.Backends {
+		b.APIKey = MaskSecret(b.APIKey)
+		cp.Providers.Search.Backends[i] = b
+	}
+	cp.Runtime = copyRuntime(s.config.Runtime)
+	cp.Email = copyEmail(s.config.Email)
+	cp.Email.SMTP.Password = MaskSecret(s.config.Email.SMTP.Password)
+	cp.Email.IMAP.Password = MaskSecret(s.config.Email.IMAP.Password)
+	cp.Calendar = s.config.Calendar
+	cp.Calendar.CalDAV.Password = MaskSecret(s.config.Calendar.CalDAV.Password)
+	cp.Calendar.Google.ClientSecret = MaskSecret(s.config.Calendar.Google.ClientSecret)
+	cp.Calendar.Google.RefreshToken = MaskSecret(s.config.Calendar.Google.RefreshToken)
 	return &cp
 }
 
+// StrippedSecret replaces secrets in GetStrippedConfig.
+const StrippedSecret = "[stripped]"
+
+// GetStrippedConfig returns config for diagnostic bundles shared outside
+// the installation: secrets are removed rather than masked, and the API
+// keys that key rate limit overrides are filed under become placeholders.
+func (s *SettingsStore) GetStrippedConfig() *domain.AppConfig {
+	cfg := s.GetMaskedConfig()
+	strip := func(v *string) {
+		if *v != "" {
+			*v = StrippedSecret
+		}
+	}
+	strip(&cfg.Providers.LLM.APIKey)
+	strip(&cfg.Providers.Image.APIKey)
+	for i := range cfg.Providers.Image.Backends {
+		strip(&cfg.Providers.Image.Backends[i].APIKey)
+	}
+	strip(&cfg.Providers.Embeddings.APIKey)
+	for i := range cfg.Providers.Search.Backends {
+		strip(&cfg.Providers.Search.Backends[i].APIKey)
+	}
+	strip(&cfg.Email.SMTP.Password)
+	strip(&cfg.Email.IMAP.Password)
+	strip(&cfg.Calendar.CalDAV.Password)
+	strip(&cfg.Calendar.Google.ClientSecret)
+	strip(&cfg.Calendar.Google.RefreshToken)
+	if len(cfg.Runtime.KeyRateLimits) > 0 {
+		keys := slices.Sorted(maps.Keys(cfg.Runtime.KeyRateLimits))
+		limits := make(map[string]map[string]domain.RateLimit, len(keys))
+		for i, k := range keys {
+			limits[fmt.Sprintf("key-%d", i+1)] = cfg.Runtime.KeyRateLimits[k]
+		}
+		cfg.Runtime.KeyRateLimits = limits
+	}
+	return cfg
+}
+
 // UpdateConfig validates, encrypts secrets, persists, and triggers onChange callbacks.
 // Smart merge: if apiKey is empty or masked, keeps existing key.
 func (s *SettingsStore) UpdateConfig(ctx context.Context, update *domain.AppConfig) error {
@@ -98,6 +199,49 @@ func (s *SettingsStore) UpdateConfig(ctx context.Context, update *domain.AppConf
 	if update.Providers.Image.APIKey == "" || isMasked(update.Providers.Image.APIKey) {
 		update.Providers.Image.APIKey = s.config.Providers.Image.APIKey
 	}
+	if update.Providers.Embeddings.APIKey == "" || isMasked(update.Providers.Embeddings.APIKey) {
+		update.Providers.Embeddings.APIKey = s.config.Providers.Embeddings.APIKey
+	}
+	// Image backends are matched by name
+	for i, b := range update.Providers.Image.Backends {
+		if b.APIKey != "" && !isMasked(b.APIKey) {
+			continue
+		}
+		update.Providers.Image.Backends[i].APIKey = ""
+		for _, old := range s.config.Providers.Image.Backends {
+			if old.Name == b.Name {
+				update.Providers.Image.Backends[i].APIKey = old.APIKey
+			}
+		}
+	}
+	// Search backends too
+	for i, b := range update.Providers.Search.Backends {
+		if b.APIKey != "" && !isMasked(b.APIKey) {
+			continue
+		}
+		update.Providers.Search.Backends[i].APIKey = ""
+		for _, old := range s.config.Providers.Search.Backends {
+			if old.Name == b.Name {
+				update.Providers.Search.Backends[i].APIKey = old.APIKey
+			}
+		}
+	}
+
+	if update.Email.SMTP.Password == "" || isMasked(update.Email.SMTP.Password) {
+		update.Email.SMTP.Password = s.config.Email.SMTP.Password
+	}
+	if update.Email.IMAP.Password == "" || isMasked(update.Email.IMAP.Password) {
+		update.Email.IMAP.Password = s.config.Email.IMAP.Password
+	}
+	if update.Calendar.CalDAV.Password == "" || isMasked(update.Calendar.CalDAV.Password) {
+		update.Calendar.CalDAV.Password = s.config.Calendar.CalDAV.Password
+	}
+	if update.Calendar.Google.ClientSecret == "" || isMasked(update.Calendar.Google.ClientSecret) {
+		update.Calendar.Google.ClientSecret = s.config.Calendar.Google.ClientSecret
+	}
+	if update.Calendar.Google.RefreshToken == "" || isMasked(update.Calendar.Google.RefreshToken) {
+		update.Calendar.Google.RefreshToken = s.config.Calendar.Google.RefreshToken
+	}
 
 	// Validate required fields for remote mode
 	if update.Providers.LLM.Mode == "remote" {
@@ -113,6 +257,33 @@ func (s *SettingsStore) UpdateConfig(ctx context.Context, update *domain.AppConf
 			return fmt.Errorf("Image remote_url is required when mode=remote")
 		}
 	}
+	names := map[string]bool{"primary": true}
+	for _, b := range update.Providers.Image.Backends {
+		if b.Name == "" || names[b.Name] {
+			return fmt.Errorf("Image backend name must be unique, non-empty and not \"primary\": %q", b.Name)
+		}
+		names[b.Name] = true
+		if b.URL == "" {
+			return fmt.Errorf("Image backend %s: url is required", b.Name)
+		}
+	}
+	if update.Providers.Embeddings.Mode == "remote" {
+		if update.Providers.Embeddings.RemoteURL == "" {
+			return fmt.Errorf("Embeddings remote_url is required when mode=remote")
+		}
+	}
+	if err := update.Providers.Search.Validate(); err != nil {
+		return err
+	}
+	if err := update.Runtime.Validate(); err != nil {
+		return err
+	}
+	if err := update.Email.Validate(); err != nil {
+		return err
+	}
+	if err := update.Calendar.Validate(); err != nil {
+		return err
+	}
 
 	// Defaults
 	if update.Providers.LLM.Mode == "" {
@@ -121,6 +292,12 @@ func (s *SettingsStore) UpdateConfig(ctx context.Context, update *domain.AppConf
 	if update.Providers.Image.Mode == "" {
 		update.Providers.Image.Mode = "local"
 	}
+	if update.Providers.Embeddings.Mode == "" {
+		update.Providers.Embeddings.Mode = "local"
+	}
+	if update.Providers.Embeddings.Model == "" {
+		update.Providers.Embeddings.Model = domain.DefaultConfig().Providers.Embeddings.Model
+	}
 
 	if err := s.saveToDB(ctx, update); err != nil {
 		return err
@@ -130,6 +307,12 @@ func (s *SettingsStore) UpdateConfig(ctx context.Context, update *domain.AppConf
 	s.logger.Info("settings updated",
 		"llm_mode", update.Providers.LLM.Mode,
 		"image_mode", update.Providers.Image.Mode,
+		"image_backends", len(update.Providers.Image.Backends),
+		"embeddings_mode", update.Providers.Embeddings.Mode,
+		"search_backends", len(update.Providers.Search.Backends),
+		"smtp", update.Email.SMTP.Host != "",
+		"imap_poller", update.Email.IMAP.Enabled,
+		"calendar", update.Calendar.Kind,
 	)
 
 	// Trigger callbacks (outside lock would deadlock if callback reads config)
@@ -165,9 +348,62 @@ func (s *SettingsStore) loadFromDB(ctx context.Context) (*domain.AppConfig, erro
 				RemoteURL:    stored.Image.RemoteURL,
 				DefaultModel: stored.Image.DefaultModel,
 			},
+			Embeddings: domain.EmbeddingProviderConfig{
+				Mode:      stored.Embeddings.Mode,
+				LocalURL:  stored.Embeddings.LocalURL,
+				RemoteURL: stored.Embeddings.RemoteURL,
+				Model:     stored.Embeddings.DefaultModel,
+			},
 		},
 	}
 
+	cfg.Runtime = stored.Runtime
+
+	cfg.Email.SMTP = stored.Email.SMTP
+	cfg.Email.IMAP = stored.Email.IMAP
+	if stored.Email.EncryptedSMTPPassword != "" {
+		password, err := s.secret.Decrypt(stored.Email.EncryptedSMTPPassword)
+		if err != nil {
+			s.logger.Warn("failed to decrypt SMTP password", "error", err)
+		} else {
+			cfg.Email.SMTP.Password = password
+		}
+	}
+	if stored.Email.EncryptedIMAPPassword != "" {
+		password, err := s.secret.Decrypt(stored.Email.EncryptedIMAPPassword)
+		if err != nil {
+			s.logger.Warn("failed to decrypt IMAP password", "error", err)
+		} else {
+			cfg.Email.IMAP.Password = password
+		}
+	}
+
+	cfg.Calendar = stored.Calendar.CalendarConfig
+	for _, sec := range []struct {
+		enc   string
+		plain *string
+		name  string
+	}{
+		{stored.Calendar.EncryptedCalDAVPassword, &cfg.Calendar.CalDAV.Password, "CalDAV password"},
+		{stored.Calendar.EncryptedGoogleClientSecret, &cfg.Calendar.Google.ClientSecret, "Google client secret"},
+		{stored.Calendar.EncryptedGoogleRefreshToken, &cfg.Calendar.Google.RefreshToken, "Google refresh token"},
+	} {
+		if sec.enc == "" {
+			continue
+		}
+		plain, err := s.secret.Decrypt(sec.enc)
+		if err != nil {
+			s.logger.Warn("failed to decrypt "+sec.name, "error", err)
+			continue
+		}
+		*sec.plain = plain
+	}
+
+	// Settings saved before embeddings were configurable
+	if stored.Embeddings.Mode == "" {
+		cfg.Providers.Embeddings = domain.DefaultConfig().Providers.Embeddings
+	}
+
 	// Decrypt secrets
 	if stored.LLM.EncryptedAPIKey != "" {
 		key, err := s.secret.Decrypt(stored.LLM.EncryptedAPIKey)
@@ -187,6 +423,53 @@ func (s *SettingsStore) loadFromDB(ctx context.Context) (*domain.AppConfig, erro
 		}
 	}
 
+	if stored.Embeddings.EncryptedAPIKey != "" {
+		key, err := s.secret.Decrypt(stored.Embeddings.EncryptedAPIKey)
+		if err != nil {
+			s.logger.Warn("failed to decrypt Embeddings API key", "error", err)
+		} else {
+			cfg.Providers.Embeddings.APIKey = key
+		}
+	}
+
+	for _, b := range stored.ImageBackends {
+		backend := domain.ImageBackendConfig{
+			Name:   b.Name,
+			Mode:   b.Mode,
+			URL:    b.URL,
+			Model:  b.Model,
+			Models: b.Models,
+		}
+		if b.EncryptedAPIKey != "" {
+			key, err := s.secret.Decrypt(b.EncryptedAPIKey)
+			if err != nil {
+				s.logger.Warn("failed to decrypt Image backend API key", "backend", b.Name, "error", err)
+			} else {
+				backend.APIKey = key
+			}
+		}
+		cfg.Providers.Image.Backends = append(cfg.Providers.Image.Backends, backend)
+	}
+
+	cfg.Providers.Search.MaxResults = stored.Search.MaxResults
+	for _, b := range stored.Search.Backends {
+		backend := domain.SearchBackendConfig{
+			Name:              b.Name,
+			Kind:              b.Kind,
+			URL:               b.URL,
+			RequestsPerMinute: b.RequestsPerMinute,
+		}
+		if b.EncryptedAPIKey != "" {
+			key, err := s.secret.Decrypt(b.EncryptedAPIKey)
+			if err != nil {
+				s.logger.Warn("failed to decrypt search backend API key", "backend", b.Name, "error", err)
+			} else {
+				backend.APIKey = key
+			}
+		}
+		cfg.Providers.Search.Backends = append(cfg.Providers.Search.Backends, backend)
+	}
+
 	return cfg, nil
 }
 
@@ -204,6 +487,56 @@ func (s *SettingsStore) saveToDB(ctx context.Context, cfg *domain.AppConfig) err
 			RemoteURL:    cfg.Providers.Image.RemoteURL,
 			DefaultModel: cfg.Providers.Image.DefaultModel,
 		},
+		Embeddings: storedProviderConfig{
+			Mode:         cfg.Providers.Embeddings.Mode,
+			LocalURL:     cfg.Providers.Embeddings.LocalURL,
+			RemoteURL:    cfg.Providers.Embeddings.RemoteURL,
+			DefaultModel: cfg.Providers.Embeddings.Model,
+		},
+		Runtime: cfg.Runtime,
+	}
+
+	// Passwords are stored only in encrypted form
+	stored.Email.SMTP = cfg.Email.SMTP
+	stored.Email.SMTP.Password = ""
+	stored.Email.IMAP = cfg.Email.IMAP
+	stored.Email.IMAP.Password = ""
+	if cfg.Email.SMTP.Password != "" {
+		enc, err := s.secret.Encrypt(cfg.Email.SMTP.Password)
+		if err != nil {
+			return fmt.Errorf("encrypt SMTP password: %w", err)
+		}
+		stored.Email.EncryptedSMTPPassword = enc
+	}
+	if cfg.Email.IMAP.Password != "" {
+		enc, err := s.secret.Encrypt(cfg.Email.IMAP.Password)
+		if err != nil {
+			return fmt.Errorf("encrypt IMAP password: %w", err)
+		}
+		stored.Email.EncryptedIMAPPassword = enc
+	}
+
+	stored.Calendar.CalendarConfig = cfg.Calendar
+	stored.Calendar.CalDAV.Password = ""
+	stored.Calendar.Google.ClientSecret = ""
+	stored.Calendar.Google.RefreshToken = ""
+	for _, sec := range []struct {
+		plain string
+		enc   *string
+		name  string
+	}{
+		{cfg.Calendar.CalDAV.Password, &stored.Calendar.EncryptedCalDAVPassword, "CalDAV password"},
+		{cfg.Calendar.Google.ClientSecret, &stored.Calendar.EncryptedGoogleClientSecret, "Google client secret"},
+		{cfg.Calendar.Google.RefreshToken, &stored.Calendar.EncryptedGoogleRefreshToken, "Google refresh token"},
+	} {
+		if sec.plain == "" {
+			continue
+		}
+		enc, err := s.secret.Encrypt(sec.plain)
+		if err != nil {
+			return fmt.Errorf("encrypt %s: %w", sec.name, err)
+		}
+		*sec.enc = enc
 	}
 
 	if cfg.Providers.LLM.APIKey != "" {
@@ -222,6 +555,50 @@ func (s *SettingsStore) saveToDB(ctx context.Context, cfg *domain.AppConfig) err
 		stored.Image.EncryptedAPIKey = enc
 	}
 
+	if cfg.Providers.Embeddings.APIKey != "" {
+		enc, err := s.secret.Encrypt(cfg.Providers.Embeddings.APIKey)
+		if err != nil {
+			return fmt.Errorf("encrypt Embeddings API key: %w", err)
+		}
+		stored.Embeddings.EncryptedAPIKey = enc
+	}
+
+	for _, b := range cfg.Providers.Image.Backends {
+		sb := storedImageBackend{
+			Name:   b.Name,
+			Mode:   b.Mode,
+			URL:    b.URL,
+			Model:  b.Model,
+			Models: b.Models,
+		}
+		if b.APIKey != "" {
+			enc, err := s.secret.Encrypt(b.APIKey)
+			if err != nil {
+				return fmt.Errorf("encrypt Image backend %s API key: %w", b.Name, err)
+			}
+			sb.EncryptedAPIKey = enc
+		}
+		stored.ImageBackends = append(stored.ImageBackends, sb)
+	}
+
+	stored.Search.MaxResults = cfg.Providers.Search.MaxResults
+	for _, b := range cfg.Providers.Search.Backends {
+		sb := storedSearchBackend{
+			Name:              b.Name,
+			Kind:              b.Kind,
+			URL:               b.URL,
+			RequestsPerMinute: b.RequestsPerMinute,
+		}
+		if b.APIKey != "" {
+			enc, err := s.secret.Encrypt(b.APIKey)
+			if err != nil {
+				return fmt.Errorf("encrypt search backend %s API key: %w", b.Name, err)
+			}
+			sb.EncryptedAPIKey = enc
+		}
+		stored.Search.Backends = append(stored.Search.Backends, sb)
+	}
+
 	raw, err := json.Marshal(stored)
 	if err != nil {
 		return fmt.Errorf("marshal settings: %w", err)
@@ -232,8 +609,52 @@ func (s *SettingsStore) saveToDB(ctx context.Context, cfg *domain.AppConfig) err
 
 // storedConfig is the DB representation with encrypted fields
 type storedConfig struct {
-	LLM   storedProviderConfig `json:"llm"`
-	Image storedProviderConfig `json:"image"`
+	LLM        storedProviderConfig `json:"llm"`
+	Image      storedProviderConfig `json:"image"`
+	Embeddings storedProviderConfig `json:"embeddings"`
+
+	ImageBackends []storedImageBackend `json:"image_backends,omitempty"`
+	Search        storedSearchConfig   `json:"search"`
+
+	Runtime  domain.RuntimeConfig `json:"runtime"` // no secrets; stored as-is
+	Email    storedEmailConfig    `json:"email"`
+	Calendar storedCalendarConfig `json:"calendar"`
+}
+
+type storedCalendarConfig struct {
+	domain.CalendarConfig              // secrets always empty
+	EncryptedCalDAVPassword     string `json:"encrypted_caldav_password,omitempty"`
+	EncryptedGoogleClientSecret string `json:"encrypted_google_client_secret,omitempty"`
+	EncryptedGoogleRefreshToken string `json:"encrypted_google_refresh_token,omitempty"`
+}
+
+type storedEmailConfig struct {
+	SMTP                  domain.SMTPConfig `json:"smtp"` // password always empty
+	IMAP                  domain.IMAPConfig `json:"imap"` // password always empty
+	EncryptedSMTPPassword string            `json:"encrypted_smtp_password,omitempty"`
+	EncryptedIMAPPassword string            `json:"encrypted_imap_password,omitempty"`
+}
+
+type storedImageBackend struct {
+	Name            string   `json:"name"`
+	Mode            string   `json:"mode"`
+	URL             string   `json:"url"`
+	EncryptedAPIKey string   `json:"encrypted_api_key,omitempty"`
+	Model           string   `json:"model"`
+	Models          []string `json:"models,omitempty"`
+}
+
+type storedSearchConfig struct {
+	MaxResults int                   `json:"max_results,omitempty"`
+	Backends   []storedSearchBackend `json:"backends,omitempty"`
+}
+
+type storedSearchBackend struct {
+	Name              string `json:"name"`
+	Kind              string `json:"kind"`
+	URL               string `json:"url,omitempty"`
+	EncryptedAPIKey   string `json:"encrypted_api_key,omitempty"`
+	RequestsPerMinute int    `json:"requests_per_minute,omitempty"`
 }
 
 type storedProviderConfig struct {
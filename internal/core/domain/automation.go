@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// AutomationID uniquely identifies an automation rule
+type AutomationID string
+
+var ErrAutomationNotFound = errors.New("automation not found")
+
+// AutomationTriggerKind is the event source of a rule
+type AutomationTriggerKind string
+
+const (
+	AutomationTriggerCron    AutomationTriggerKind = "cron"    // 5-field cron expression
+	AutomationTriggerWebhook AutomationTriggerKind = "webhook" // POST /v1/automations/{id}/webhook
+	AutomationTriggerFeed    AutomationTriggerKind = "feed"    // new items in an RSS/Atom feed
+	AutomationTriggerFile    AutomationTriggerKind = "file"    // changes in a workspace directory
+)
+
+// AutomationActionKind is what a rule does when it fires
+type AutomationActionKind string
+
+const (
+	AutomationActionPrompt   AutomationActionKind = "prompt"   // run a prompt through the agent
+	AutomationActionWorkflow AutomationActionKind = "workflow" // start a copy of a workflow
+	AutomationActionTool     AutomationActionKind = "tool"     // call a tool directly, without the LLM
+)
+
+// AutomationTrigger configures when a rule fires. Only the fields of Kind
+// are used. Feed and file triggers are backed by a FeedWatch or FileTrigger
+// whose ID is kept in SourceID.
+type AutomationTrigger struct {
+	Kind AutomationTriggerKind `json:"kind"`
+
+	CronExpr string `json:"cron_expr,omitempty"`
+
+	WebhookToken string `json:"webhook_token,omitempty"` // generated; sent as X-Automation-Token or ?token=
+
+	FeedURL         string `json:"feed_url,omitempty"`
+	FeedIntervalSec int    `json:"feed_interval_sec,omitempty"`
+
+	Path        string   `json:"path,omitempty"`
+	Patterns    []string `json:"patterns,omitempty"`
+	Recursive   bool     `json:"recursive,omitempty"`
+	FileEvents  []FileOp `json:"file_events,omitempty"`
+	DebounceSec int      `json:"debounce_sec,omitempty"`
+
+	SourceID string `json:"source_id,omitempty"` // backing feed or file trigger
+}
+
+// AutomationCondition filters events: Field of the event's data is compared
+// with Value. Ops: equals, not_equals, contains, not_contains, matches
+// (regular expression).
+type AutomationCondition struct {
+	Field string `json:"field"`
+	Op    string `json:"op"`
+	Value string `json:"value"`
+}
+
+// AutomationAction configures what a rule does. Prompt and tool argument
+// strings may reference event data as {{event.<field>}}.
+type AutomationAction struct {
+	Kind       AutomationActionKind   `json:"kind"`
+	Prompt     string                 `json:"prompt,omitempty"`
+	WorkflowID WorkflowID             `json:"workflow_id,omitempty"`
+	Tool       string                 `json:"tool,omitempty"`
+	Args       map[string]interface{} `json:"args,omitempty"`
+	PersonaID  *PersonaID             `json:"persona_id,omitempty"`
+}
+
+// Automation is a rule: when Trigger fires and every condition holds, Action
+// runs.
+type Automation struct {
+	ID         AutomationID          `json:"id"`
+	ProjectID  ProjectID             `json:"project_id"`
+	Name       string                `json:"name"`
+	Enabled    bool                  `json:"enabled"`
+	Trigger    AutomationTrigger     `json:"trigger"`
+	Conditions []AutomationCondition `json:"conditions,omitempty"`
+	Action     AutomationAction      `json:"action"`
+	NextRun    *time.Time            `json:"next_run,omitempty"` // cron triggers
+	LastRun    *time.Time            `json:"last_run,omitempty"`
+	LastResult string                `json:"last_result,omitempty"`
+	LastError  string                `json:"last_error,omitempty"`
+	RunCount   int                   `json:"run_count"`
+	CreatedAt  time.Time             `json:"created_at"`
+	CreatedBy  string                `json:"created_by,omitempty"` // "agent" or "user"
+}
+
+// AutomationEvent is one firing of a trigger. Data holds the fields
+// conditions and templates can reference; Text is a readable summary
+// appended to prompts.
+type AutomationEvent struct {
+	Kind AutomationTriggerKind `json:"kind"`
+	Data map[string]string     `json:"data"`
+	Text string                `json:"text"`
+}
+
+// Validate checks the condition's operator and, for matches, its pattern.
+func (c AutomationCondition) Validate() error {
+	if c.Field == "" {
+		return fmt.Errorf("condition field is required")
+	}
+	switch c.Op {
+	case "equals", "not_equals", "contains", "not_contains":
+		return nil
+	case "matches":
+		if _, err := regexp.Compile(c.Value); err != nil {
+			return fmt.Errorf("condition on %s: invalid pattern: %w", c.Field, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("condition on %s: unknown op %q", c.Field, c.Op)
+	}
+}
+
+// Holds evaluates the condition against event data. Text comparisons are
+// case-insensitive; a missing field is the empty string.
+func (c AutomationCondition) Holds(data map[string]string) bool {
+	v := data[c.Field]
+	switch c.Op {
+	case "equals":
+		return strings.EqualFold(v, c.Value)
+	case "not_equals":
+		return !strings.EqualFold(v, c.Value)
+	case "contains":
+		return strings.Contains(strings.ToLower(v), strings.ToLower(c.Value))
+	case "not_contains":
+		return !strings.Contains(strings.ToLower(v), strings.ToLower(c.Value))
+	case "matches":
+		re, err := regexp.Compile(c.Value)
+		return err == nil && re.MatchString(v)
+	}
+	return false
+}
+
+// Matches reports whether every condition holds for ev.
+func (a Automation) Matches(ev AutomationEvent) bool {
+	for _, c := range a.Conditions {
+		if !c.Holds(ev.Data) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// BuildSettings is a project's build/test command, run in a sandboxed shell
+// with the project workspace mounted at /workspace.
+type BuildSettings struct {
+	Command        string `json:"command"`                   // e.g. "go build ./... && go test ./..."
+	Image          string `json:"image,omitempty"`           // sandbox image; empty = the shell image
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty"` // per run; 0 = 10 minutes
+	MaxCycles      int    `json:"max_cycles,omitempty"`      // build loop budget; 0 = 5
+}
+
+// Build defaults and limits.
+const (
+	DefaultBuildTimeout   = 10 * time.Minute
+	DefaultBuildMaxCycles = 5
+	MaxBuildCycles        = 20
+)
+
+// Timeout returns the per-run timeout.
+func (b BuildSettings) Timeout() time.Duration {
+	if b.TimeoutSeconds <= 0 {
+		return DefaultBuildTimeout
+	}
+	return time.Duration(b.TimeoutSeconds) * time.Second
+}
+
+// Cycles returns the loop budget, capped at MaxBuildCycles.
+func (b BuildSettings) Cycles() int {
+	if b.MaxCycles <= 0 {
+		return DefaultBuildMaxCycles
+	}
+	return min(b.MaxCycles, MaxBuildCycles)
+}
+
+// BuildResult is the outcome of one build/test run.
+type BuildResult struct {
+	Command    string `json:"command"`
+	ExitCode   int    `json:"exit_code"`
+	Passed     bool   `json:"passed"`
+	Output     string `json:"output"` // stdout then stderr, tail-truncated
+	Truncated  bool   `json:"truncated,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// BuildLoopID identifies a build loop run.
+type BuildLoopID string
+
+// NewBuildLoopID generates a compact random ID (build-<12 hex>).
+func NewBuildLoopID() BuildLoopID {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return BuildLoopID("build-" + hex.EncodeToString(b))
+}
+
+// BuildLoopStatus is where a build loop stands.
+type BuildLoopStatus string
+
+const (
+	BuildLoopRunning   BuildLoopStatus = "running"
+	BuildLoopPassed    BuildLoopStatus = "passed"    // the build went green
+	BuildLoopExhausted BuildLoopStatus = "exhausted" // still failing after the cycle budget
+	BuildLoopFailed    BuildLoopStatus = "failed"    // the loop itself broke (sandbox or agent error)
+	BuildLoopCancelled BuildLoopStatus = "cancelled"
+)
+
+// BuildCycle is one edit-then-build iteration of a build loop.
+type BuildCycle struct {
+	N     int          `json:"n"`
+	Fix   string       `json:"fix,omitempty"` // the agent's reply for this cycle's edits; empty when none were asked for
+	Build *BuildResult `json:"build,omitempty"`
+}
+
+// BuildLoop has the coder persona edit a project until its build passes
+// or the cycle budget runs out.
+type BuildLoop struct {
+	ID             BuildLoopID     `json:"id"`
+	ProjectID      ProjectID       `json:"project_id"`
+	Goal           string          `json:"goal,omitempty"` // initial change request; empty = just make the build pass
+	Status         BuildLoopStatus `json:"status"`
+	MaxCycles      int             `json:"max_cycles"`
+	Cycles         []BuildCycle    `json:"cycles"`
+	ConversationID ConversationID  `json:"conversation_id"`
+	TraceID        TraceID         `json:"trace_id,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	StartedAt      time.Time       `json:"started_at"`
+	FinishedAt     *time.Time      `json:"finished_at,omitempty"`
+}
+
+var (
+	ErrNoBuildCommand     = errors.New("project has no build command")
+	ErrBuildLoopNotFound  = errors.New("build loop not found")
+	ErrBuildLoopRunning   = errors.New("a build loop is already running for this project")
+	ErrBuildLoopNotActive = errors.New("build loop is not running")
+)
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ErrCalendarNotConfigured is returned by the calendar tools when no
+// calendar backend is set up in settings.
+var ErrCalendarNotConfigured = errors.New("calendar is not configured")
+
+// CalendarEvent is one event on an external calendar.
+type CalendarEvent struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	Location    string    `json:"location,omitempty"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	AllDay      bool      `json:"all_day,omitempty"` // Start and End are dates; End is exclusive
+	Attendees   []string  `json:"attendees,omitempty"`
+	URL         string    `json:"url,omitempty"` // link to the event, when the backend has one
+}
+
+// Calendar backend kinds
+const (
+	CalendarKindCalDAV = "caldav"
+	CalendarKindGoogle = "google"
+)
+
+// CalendarConfig configures the external calendar used by the calendar
+// tools. An empty Kind disables them.
+type CalendarConfig struct {
+	Kind     string               `json:"kind"`               // "caldav", "google" or "" (disabled)
+	Timezone string               `json:"timezone,omitempty"` // IANA zone for times given without an offset; default: server local
+	CalDAV   CalDAVConfig         `json:"caldav"`
+	Google   GoogleCalendarConfig `json:"google"`
+}
+
+// CalDAVConfig configures a CalDAV calendar collection
+type CalDAVConfig struct {
+	URL      string `json:"url"` // calendar collection, e.g. https://dav.example.com/calendars/me/work/
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"` // Encrypted in storage; app password for hosted providers
+}
+
+// GoogleCalendarConfig configures Google Calendar through an OAuth refresh token
+type GoogleCalendarConfig struct {
+	CalendarID   string `json:"calendar_id,omitempty"` // default "primary"
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret,omitempty"` // Encrypted in storage
+	RefreshToken string `json:"refresh_token,omitempty"` // Encrypted in storage
+}
+
+// Location returns the zone for times given without an offset.
+func (c CalendarConfig) Location() *time.Location {
+	if c.Timezone != "" {
+		if loc, err := time.LoadLocation(c.Timezone); err == nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+// Validate checks the calendar settings. Secrets are checked after masked
+// values have been merged with the stored ones.
+func (c CalendarConfig) Validate() error {
+	if c.Timezone != "" {
+		if _, err := time.LoadLocation(c.Timezone); err != nil {
+			return fmt.Errorf("calendar timezone: %w", err)
+		}
+	}
+	switch c.Kind {
+	case "":
+	case CalendarKindCalDAV:
+		u, err := url.Parse(c.CalDAV.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("calendar caldav url must be an http(s) URL")
+		}
+	case CalendarKindGoogle:
+		if c.Google.ClientID == "" || c.Google.ClientSecret == "" || c.Google.RefreshToken == "" {
+			return fmt.Errorf("calendar google client_id, client_secret and refresh_token are required")
+		}
+	default:
+		return fmt.Errorf("unsupported calendar kind %q", c.Kind)
+	}
+	return nil
+}
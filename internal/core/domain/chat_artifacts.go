@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultChatArtifactExtensions are the file types registered as artifacts
+// when the policy lists none.
+var DefaultChatArtifactExtensions = []string{
+	".md", ".txt", ".csv", ".json", ".yaml", ".yml", ".html", ".svg",
+	".py", ".js", ".ts", ".go", ".sql", ".sh",
+}
+
+// DefaultChatArtifactMinBytes skips placeholder and scratch files.
+const DefaultChatArtifactMinBytes = 64
+
+// ChatArtifactPolicy decides which files the agent writes during a chat
+// are registered as artifacts of the conversation.
+type ChatArtifactPolicy struct {
+	Disabled   bool     `json:"disabled,omitempty"`
+	Extensions []string `json:"extensions,omitempty"` // e.g. ".md" or "csv"; empty = DefaultChatArtifactExtensions
+	MinBytes   int64    `json:"min_bytes,omitempty"`  // 0 = DefaultChatArtifactMinBytes
+	MaxBytes   int64    `json:"max_bytes,omitempty"`  // 0 = no limit
+}
+
+// Validate checks the size bounds and extensions.
+func (p ChatArtifactPolicy) Validate() error {
+	if p.MinBytes < 0 || p.MaxBytes < 0 {
+		return fmt.Errorf("chat artifacts: min_bytes and max_bytes must not be negative")
+	}
+	if p.MaxBytes > 0 && p.MaxBytes < p.MinBytes {
+		return fmt.Errorf("chat artifacts: max_bytes must not be below min_bytes")
+	}
+	for _, ext := range p.Extensions {
+		if strings.Trim(ext, ". ") == "" || strings.ContainsAny(ext, `/\`) {
+			return fmt.Errorf("chat artifacts: invalid extension %q", ext)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether a written file of size bytes is significant
+// enough to become an artifact.
+func (p ChatArtifactPolicy) Matches(path string, size int64) bool {
+	if p.Disabled {
+		return false
+	}
+	min := p.MinBytes
+	if min == 0 {
+		min = DefaultChatArtifactMinBytes
+	}
+	if size < min || (p.MaxBytes > 0 && size > p.MaxBytes) {
+		return false
+	}
+	exts := p.Extensions
+	if len(exts) == 0 {
+		exts = DefaultChatArtifactExtensions
+	}
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range exts {
+		if strings.ToLower("."+strings.TrimLeft(strings.TrimSpace(e), ".")) == ext {
+			return true
+		}
+	}
+	return false
+}
+
+// ArtifactTypeForPath classifies a file by its extension.
+func ArtifactTypeForPath(path string) ArtifactType {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".png", ".jpg", ".jpeg", ".gif", ".webp", ".svg":
+		return ArtifactTypeImage
+	case ".pdf", ".doc", ".docx", ".odt", ".html", ".htm":
+		return ArtifactTypeDocument
+	case ".mp3", ".wav", ".ogg", ".flac":
+		return ArtifactTypeAudio
+	case ".mp4", ".webm", ".mov":
+		return ArtifactTypeVideo
+	case ".md", ".txt", ".csv", ".json", ".yaml", ".yml", ".xml",
+		".py", ".js", ".ts", ".go", ".sql", ".sh":
+		return ArtifactTypeText
+	}
+	return ArtifactTypeOther
+}
@@ -0,0 +1,14 @@
+package domain
+
+// Citation is a tool observation an answer draws on, for UIs to show as a
+// source. Step is the index of the ReAct step that produced it.
+type Citation struct {
+	Step       int                    `json:"step"`
+	Tool       string                 `json:"tool"`
+	Params     map[string]interface{} `json:"params,omitempty"`
+	URL        string                 `json:"url,omitempty"`
+	Path       string                 `json:"path,omitempty"`
+	ArtifactID ArtifactID             `json:"artifact_id,omitempty"`
+	Snippet    string                 `json:"snippet,omitempty"` // part of the observation the answer matched
+	Score      float64                `json:"score"`             // 0–1, how much of the answer it supports
+}
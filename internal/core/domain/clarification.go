@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// QuestionID identifies a clarifying question the agent asked.
+type QuestionID string
+
+// NewQuestionID generates a compact random question ID (q-<12 hex>)
+func NewQuestionID() QuestionID {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return QuestionID("q-" + hex.EncodeToString(b))
+}
+
+var (
+	// ErrNoPendingQuestion is returned when answering a conversation that
+	// is not waiting for one, or with a stale question ID.
+	ErrNoPendingQuestion = errors.New("no pending question")
+	// ErrQuestionPending is returned when the agent asks while a question
+	// of the same conversation is still open.
+	ErrQuestionPending = errors.New("a question is already waiting for an answer")
+)
+
+// Question is a clarifying question the agent asked with ask_user; its
+// ReAct loop is paused until the user answers or ExpiresAt passes.
+type Question struct {
+	ID             QuestionID     `json:"id"`
+	ConversationID ConversationID `json:"conversation_id"`
+	Question       string         `json:"question"`
+	Options        []string       `json:"options,omitempty"` // suggested answers; free text is accepted too
+	AskedAt        time.Time      `json:"asked_at"`
+	ExpiresAt      time.Time      `json:"expires_at"`
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CleanupKind names a bulk cleanup operation.
+type CleanupKind string
+
+const (
+	CleanupConversations    CleanupKind = "conversations"     // conversations idle for older_than_days
+	CleanupFailedJobs       CleanupKind = "failed_jobs"       // FAILED jobs and their workspaces
+	CleanupTraces           CleanupKind = "traces"            // finished traces; all of them without older_than_days
+	CleanupProjectArtifacts CleanupKind = "project_artifacts" // the artifacts of project_id
+)
+
+// CleanupTokenTTL is how long a dry run's confirmation token stays valid.
+const CleanupTokenTTL = 5 * time.Minute
+
+var (
+	ErrInvalidCleanup = errors.New("invalid cleanup request")
+	// ErrCleanupToken is returned when the confirmation token is unknown,
+	// expired, already used or issued for different parameters.
+	ErrCleanupToken = errors.New("invalid or expired confirmation token")
+)
+
+// CleanupRequest selects what a bulk cleanup deletes.
+type CleanupRequest struct {
+	Kind          CleanupKind `json:"kind"`
+	OlderThanDays int         `json:"older_than_days,omitempty"` // by last update; 0 = any age
+	ProjectID     ProjectID   `json:"project_id,omitempty"`
+}
+
+// Validate checks the kind and its required parameters.
+func (r CleanupRequest) Validate() error {
+	if r.OlderThanDays < 0 {
+		return fmt.Errorf("%w: older_than_days must be >= 0", ErrInvalidCleanup)
+	}
+	switch r.Kind {
+	case CleanupConversations:
+		if r.OlderThanDays == 0 {
+			return fmt.Errorf("%w: older_than_days is required for conversations", ErrInvalidCleanup)
+		}
+	case CleanupProjectArtifacts:
+		if r.ProjectID == "" {
+			return fmt.Errorf("%w: project_id is required for project_artifacts", ErrInvalidCleanup)
+		}
+	case CleanupFailedJobs, CleanupTraces:
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidCleanup, r.Kind)
+	}
+	return nil
+}
+
+// Cutoff returns the time before which items are deleted; the zero time
+// when any age matches.
+func (r CleanupRequest) Cutoff(now time.Time) time.Time {
+	if r.OlderThanDays == 0 {
+		return time.Time{}
+	}
+	return now.AddDate(0, 0, -r.OlderThanDays)
+}
+
+// CleanupPlan is the result of a dry run: what would be deleted and the
+// token that confirms it.
+type CleanupPlan struct {
+	Request      CleanupRequest `json:"request"`
+	Count        int            `json:"count"`
+	Sample       []string       `json:"sample,omitempty"` // a few of the IDs, for review
+	ConfirmToken string         `json:"confirm_token"`
+	ExpiresAt    time.Time      `json:"expires_at"`
+}
+
+// CleanupResult reports a confirmed cleanup.
+type CleanupResult struct {
+	Request CleanupRequest `json:"request"`
+	Deleted int            `json:"deleted"`
+	Skipped int            `json:"skipped,omitempty"` // e.g. conversations with a turn running
+	Errors  []string       `json:"errors,omitempty"`
+}
@@ -1,9 +1,16 @@
 package domain
 
+import (
+	"fmt"
+	"strings"
+)
+
 // ProviderConfig holds configuration for all AI providers
 type ProviderConfig struct {
-	LLM   LLMProviderConfig   `json:"llm"`
-	Image ImageProviderConfig `json:"image"`
+	LLM        LLMProviderConfig       `json:"llm"`
+	Image      ImageProviderConfig     `json:"image"`
+	Embeddings EmbeddingProviderConfig `json:"embeddings"`
+	Search     SearchProviderConfig    `json:"search"`
 }
 
 // LLMProviderConfig configures the LLM provider
@@ -22,11 +29,230 @@ type ImageProviderConfig struct {
 	RemoteURL    string `json:"remote_url"`    // "https://api.replicate.com/v1"
 	APIKey       string `json:"api_key"`       // Encrypted in storage
 	DefaultModel string `json:"default_model"` // "sd-1.5" or "sdxl-turbo"
+
+	// Backends are additional image backends, tried in order after the primary
+	// one above when it fails or when a job selects them by name or model.
+	Backends []ImageBackendConfig `json:"backends,omitempty"`
+}
+
+// ImageBackendConfig configures one extra image backend
+type ImageBackendConfig struct {
+	Name   string   `json:"name"`             // unique, selectable via job "backend" metadata
+	Mode   string   `json:"mode"`             // "local" (ComfyUI) or "remote" (OpenAI-compatible)
+	URL    string   `json:"url"`              // ComfyUI host or remote API base URL
+	APIKey string   `json:"api_key"`          // Encrypted in storage
+	Model  string   `json:"model"`            // default model/checkpoint for this backend
+	Models []string `json:"models,omitempty"` // other models this backend serves
+}
+
+// EmbeddingProviderConfig configures the embedding provider, independent of the chat LLM
+type EmbeddingProviderConfig struct {
+	Mode      string `json:"mode"`       // "local" or "remote"
+	LocalURL  string `json:"local_url"`  // "http://localhost:11434/v1"
+	RemoteURL string `json:"remote_url"` // "https://api.openai.com/v1"
+	APIKey    string `json:"api_key"`    // Encrypted in storage
+	Model     string `json:"model"`      // "nomic-embed-text" or "text-embedding-3-small"
+}
+
+// Search backend kinds
+const (
+	SearchKindSearxNG    = "searxng"
+	SearchKindBrave      = "brave"
+	SearchKindTavily     = "tavily"
+	SearchKindDuckDuckGo = "duckduckgo"
+)
+
+// MaxSearchResults caps how many results one web search may return.
+const MaxSearchResults = 20
+
+// SearchProviderConfig configures the web_search backends. With no backends,
+// Brave (if BRAVE_SEARCH_API_KEY is set) and then DuckDuckGo are used.
+type SearchProviderConfig struct {
+	MaxResults int                   `json:"max_results,omitempty"` // default result count; 0 = 5
+	Backends   []SearchBackendConfig `json:"backends,omitempty"`    // tried in order
+}
+
+// SearchBackendConfig configures one web search backend
+type SearchBackendConfig struct {
+	Name              string `json:"name"`                          // unique
+	Kind              string `json:"kind"`                          // "searxng", "brave", "tavily" or "duckduckgo"
+	URL               string `json:"url,omitempty"`                 // SearxNG instance; optional API base override for the others
+	APIKey            string `json:"api_key,omitempty"`             // Encrypted in storage; brave and tavily
+	RequestsPerMinute int    `json:"requests_per_minute,omitempty"` // 0 = unlimited
+}
+
+// Validate checks the search settings. API keys are checked after masked
+// values have been merged with the stored ones.
+func (c SearchProviderConfig) Validate() error {
+	if c.MaxResults < 0 || c.MaxResults > MaxSearchResults {
+		return fmt.Errorf("search max_results must be between 0 and %d", MaxSearchResults)
+	}
+	names := map[string]bool{}
+	for _, b := range c.Backends {
+		if b.Name == "" || names[b.Name] {
+			return fmt.Errorf("search backend name must be unique and non-empty: %q", b.Name)
+		}
+		names[b.Name] = true
+		if b.RequestsPerMinute < 0 {
+			return fmt.Errorf("search backend %s: requests_per_minute must not be negative", b.Name)
+		}
+		switch b.Kind {
+		case SearchKindSearxNG:
+			if b.URL == "" {
+				return fmt.Errorf("search backend %s: url is required for searxng", b.Name)
+			}
+		case SearchKindBrave, SearchKindTavily:
+			if b.APIKey == "" {
+				return fmt.Errorf("search backend %s: api_key is required for %s", b.Name, b.Kind)
+			}
+		case SearchKindDuckDuckGo:
+		default:
+			return fmt.Errorf("search backend %s: unsupported kind %q", b.Name, b.Kind)
+		}
+	}
+	return nil
+}
+
+// RuntimeConfig holds kernel settings that apply without a restart.
+// Zero values keep the startup defaults (environment variables).
+type RuntimeConfig struct {
+	MaxConcurrentJobs  int      `json:"max_concurrent_jobs,omitempty"`  // job scheduler slots
+	CORSOrigins        []string `json:"cors_origins,omitempty"`         // allowed browser origins
+	DisabledTools      []string `json:"disabled_tools,omitempty"`       // tools never offered to the agent
+	TraceRetentionDays int      `json:"trace_retention_days,omitempty"` // 0 = keep persisted traces forever
+	PluginDir          string   `json:"plugin_dir,omitempty"`           // Wasm plugin directory
+	StrictToolNames    bool     `json:"strict_tool_names,omitempty"`    // refuse fuzzy-matched tool names
+	// Language of the agent's instructions and messages ("en", "pt", "es");
+	// personas and users may override it. Empty = detect from each message
+	Locale string `json:"locale,omitempty"`
+	// Record mode: store raw LLM provider requests and responses (masked)
+	// on the trace of each call, for reproducing prompt-format bugs
+	RecordProviderCalls bool `json:"record_provider_calls,omitempty"`
+	// API rate limits by route class, and overrides for specific API keys
+	// (key → class → limit). Classes without a limit are not limited;
+	// clients whose key isn't listed are limited by IP.
+	RateLimits    map[string]RateLimit            `json:"rate_limits,omitempty"`
+	KeyRateLimits map[string]map[string]RateLimit `json:"key_rate_limits,omitempty"`
+	// Masking of secrets and PII in prompts, messages, spans and logs
+	Redaction RedactionPolicy `json:"redaction,omitempty"`
+	// Content moderation of user messages and agent answers
+	Moderation ModerationConfig `json:"moderation,omitempty"`
+	// Which container images jobs may run, and when they are pulled
+	Images ImagePolicy `json:"images,omitempty"`
+	// Which files written during a chat become artifacts of the conversation
+	ChatArtifacts ChatArtifactPolicy `json:"chat_artifacts,omitempty"`
+	// Job scheduler shares by project ID; projects not listed weigh 1
+	ProjectShares map[string]ProjectShare `json:"project_shares,omitempty"`
+	// Preloading and keep-alive of local models
+	ModelWarmup ModelWarmup `json:"model_warmup,omitempty"`
+	// Hosts the user approved for aule.http_fetch, by plugin name. Only
+	// hosts the plugin's manifest declares are reachable.
+	PluginHosts map[string][]string `json:"plugin_hosts,omitempty"`
+}
+
+// ProjectShare is a project's claim on job scheduler slots. When slots
+// free up, queued jobs of the project furthest below its weighted share
+// start first.
+type ProjectShare struct {
+	Weight  int `json:"weight,omitempty"`   // relative share; 0 = 1
+	MaxJobs int `json:"max_jobs,omitempty"` // cap on running jobs; 0 = none
+}
+
+// Route classes for API rate limiting.
+const (
+	RouteClassChat    = "chat"    // agent chat and message edits (the local LLM)
+	RouteClassJobs    = "jobs"    // jobs, workflow runs and tool runs (Docker)
+	RouteClassAdmin   = "admin"   // settings, system, metrics and nodes
+	RouteClassDefault = "default" // everything else
+)
+
+// RateLimit is a token bucket: PerMinute requests sustained, up to Burst
+// at once.
+type RateLimit struct {
+	PerMinute int `json:"per_minute"`
+	Burst     int `json:"burst,omitempty"` // 0 = PerMinute
+}
+
+func validateRateLimits(limits map[string]RateLimit) error {
+	for class, l := range limits {
+		switch class {
+		case RouteClassChat, RouteClassJobs, RouteClassAdmin, RouteClassDefault:
+		default:
+			return fmt.Errorf("unknown rate limit class %q (want chat, jobs, admin or default)", class)
+		}
+		if l.PerMinute <= 0 || l.Burst < 0 {
+			return fmt.Errorf("rate limit %q: per_minute must be positive and burst not negative", class)
+		}
+	}
+	return nil
+}
+
+// Validate checks the runtime settings for out-of-range values.
+func (c RuntimeConfig) Validate() error {
+	if c.MaxConcurrentJobs < 0 || c.MaxConcurrentJobs > 256 {
+		return fmt.Errorf("max_concurrent_jobs must be between 0 and 256")
+	}
+	if c.TraceRetentionDays < 0 {
+		return fmt.Errorf("trace_retention_days must not be negative")
+	}
+	if _, err := ParseLocale(c.Locale); err != nil {
+		return err
+	}
+	if err := validateRateLimits(c.RateLimits); err != nil {
+		return err
+	}
+	for key, limits := range c.KeyRateLimits {
+		if key == "" {
+			return fmt.Errorf("key_rate_limits: empty API key")
+		}
+		if err := validateRateLimits(limits); err != nil {
+			return fmt.Errorf("key_rate_limits: %w", err)
+		}
+	}
+	for _, o := range c.CORSOrigins {
+		if o != "*" && !strings.HasPrefix(o, "http://") && !strings.HasPrefix(o, "https://") {
+			return fmt.Errorf("cors origin %q must be \"*\" or start with http:// or https://", o)
+		}
+	}
+	if err := c.Moderation.Validate(); err != nil {
+		return err
+	}
+	if err := c.Images.Validate(); err != nil {
+		return err
+	}
+	if err := c.ChatArtifacts.Validate(); err != nil {
+		return err
+	}
+	if err := c.ModelWarmup.Validate(); err != nil {
+		return err
+	}
+	for plugin, hosts := range c.PluginHosts {
+		if plugin == "" {
+			return fmt.Errorf("plugin_hosts: empty plugin name")
+		}
+		for _, h := range hosts {
+			if h == "" || strings.ContainsAny(h, "/:@ ") {
+				return fmt.Errorf("plugin_hosts: %q is not a host name", h)
+			}
+		}
+	}
+	for project, share := range c.ProjectShares {
+		if project == "" {
+			return fmt.Errorf("project_shares: empty project ID")
+		}
+		if share.Weight < 0 || share.MaxJobs < 0 {
+			return fmt.Errorf("project_shares %s: weight and max_jobs must not be negative", project)
+		}
+	}
+	return c.Redaction.Validate()
 }
 
 // AppConfig is the main application configuration
 type AppConfig struct {
 	Providers ProviderConfig `json:"providers"`
+	Runtime   RuntimeConfig  `json:"runtime"`
+	Email     EmailConfig    `json:"email"`
+	Calendar  CalendarConfig `json:"calendar"`
 }
 
 // DefaultConfig returns safe defaults
@@ -43,6 +269,11 @@ func DefaultConfig() *AppConfig {
 				LocalURL:     "http://localhost:8188",
 				DefaultModel: "sd-1.5",
 			},
+			Embeddings: EmbeddingProviderConfig{
+				Mode:     "local",
+				LocalURL: "http://localhost:11434/v1",
+				Model:    "nomic-embed-text",
+			},
 		},
 	}
 }
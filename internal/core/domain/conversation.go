@@ -1,7 +1,11 @@
 package domain
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"crypto/rand"
@@ -30,12 +34,200 @@ const SystemConversationID ConversationID = "conv-kernel-system"
 
 // Conversation represents a multi-turn chat session
 type Conversation struct {
-	ID        ConversationID `json:"id"`
-	ProjectID *ProjectID     `json:"project_id,omitempty"`
-	PersonaID *PersonaID     `json:"persona_id,omitempty"`
-	Title     string         `json:"title"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	ID        ConversationID     `json:"id"`
+	ProjectID *ProjectID         `json:"project_id,omitempty"`
+	PersonaID *PersonaID         `json:"persona_id,omitempty"`
+	Title     string             `json:"title"`
+	Tags      []string           `json:"tags,omitempty"`
+	Pinned    bool               `json:"pinned"`
+	Folder    string             `json:"folder,omitempty"` // "" = not filed
+	Context   ContextVars        `json:"context,omitempty"`
+	Style     *ConversationStyle `json:"style,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// ConversationFilter narrows a conversation listing. Zero fields match all.
+type ConversationFilter struct {
+	ProjectID *ProjectID
+	Tag       string
+	Folder    *string // "" selects conversations outside any folder
+	Pinned    *bool
+}
+
+// ConversationPatch changes how a conversation is organized; nil fields are kept.
+type ConversationPatch struct {
+	Tags   *[]string
+	Pinned *bool
+	Folder *string
+}
+
+// ConversationFacets counts conversations per folder and per tag.
+type ConversationFacets struct {
+	Folders []FacetCount `json:"folders"`
+	Tags    []FacetCount `json:"tags"`
+}
+
+// FacetCount is one folder or tag with the number of conversations in it.
+type FacetCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// NormalizeTags trims, lower-cases and de-duplicates tags, dropping empties.
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+// ContextVars is client-supplied state attached to a conversation, e.g.
+// the file open in an editor or the user's location. The agent sees it in
+// its prompt and tools read it from the context.
+type ContextVars map[string]interface{}
+
+// Context variable limits.
+const (
+	MaxContextVars      = 64
+	MaxContextKeyLen    = 64
+	MaxContextVarsBytes = 16 << 10 // encoded as JSON
+)
+
+// ErrInvalidContextVars is returned for context variables over the limits
+// or with malformed keys.
+var ErrInvalidContextVars = errors.New("invalid context variables")
+
+// Validate checks the number and size of variables and that keys are
+// made of letters, digits, '_', '-' and '.'.
+func (v ContextVars) Validate() error {
+	if len(v) > MaxContextVars {
+		return fmt.Errorf("%w: more than %d keys", ErrInvalidContextVars, MaxContextVars)
+	}
+	for k := range v {
+		if k == "" || len(k) > MaxContextKeyLen {
+			return fmt.Errorf("%w: key %q must be 1-%d characters", ErrInvalidContextVars, k, MaxContextKeyLen)
+		}
+		for _, r := range k {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-' || r == '.') {
+				return fmt.Errorf("%w: key %q has invalid characters", ErrInvalidContextVars, k)
+			}
+		}
+	}
+	if data, err := json.Marshal(v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidContextVars, err)
+	} else if len(data) > MaxContextVarsBytes {
+		return fmt.Errorf("%w: larger than %d bytes", ErrInvalidContextVars, MaxContextVarsBytes)
+	}
+	return nil
+}
+
+// FormatForPrompt lists the variables one per line, sorted by key, with
+// non-string values as JSON. Empty vars give "".
+func (v ContextVars) FormatForPrompt() string {
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		val, ok := v[k].(string)
+		if !ok {
+			data, _ := json.Marshal(v[k])
+			val = string(data)
+		}
+		fmt.Fprintf(&b, "- %s: %s\n", k, val)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// ConversationStyle is how the agent answers in one conversation, so the
+// user does not have to repeat "answer in Portuguese, be brief" every
+// message. Empty fields keep the defaults.
+type ConversationStyle struct {
+	Tone      string `json:"tone,omitempty"`      // free text, e.g. "formal", "friendly"
+	Verbosity string `json:"verbosity,omitempty"` // "brief", "normal" or "detailed"
+	Language  string `json:"language,omitempty"`  // a locale ("pt-BR") or a language name ("French")
+}
+
+// Style verbosity levels.
+const (
+	VerbosityBrief    = "brief"
+	VerbosityNormal   = "normal"
+	VerbosityDetailed = "detailed"
+)
+
+// MaxStyleFieldLen caps the tone and language of a ConversationStyle.
+const MaxStyleFieldLen = 64
+
+// ErrInvalidStyle is returned for style preferences with unknown or
+// oversized values.
+var ErrInvalidStyle = errors.New("invalid conversation style")
+
+// Validate checks the verbosity level and that tone and language are short
+// single lines.
+func (s ConversationStyle) Validate() error {
+	switch s.Verbosity {
+	case "", VerbosityBrief, VerbosityNormal, VerbosityDetailed:
+	default:
+		return fmt.Errorf("%w: verbosity must be brief, normal or detailed", ErrInvalidStyle)
+	}
+	for name, v := range map[string]string{"tone": s.Tone, "language": s.Language} {
+		if len(v) > MaxStyleFieldLen || strings.ContainsAny(v, "\r\n") {
+			return fmt.Errorf("%w: %s must be a single line of at most %d characters", ErrInvalidStyle, name, MaxStyleFieldLen)
+		}
+	}
+	return nil
+}
+
+// IsZero reports whether no preference is set.
+func (s ConversationStyle) IsZero() bool {
+	return strings.TrimSpace(s.Tone) == "" && s.Verbosity == "" && strings.TrimSpace(s.Language) == ""
+}
+
+// Locale is the supported locale of the style's language, "" when the
+// language is unset or not a supported locale.
+func (s ConversationStyle) Locale() string {
+	locale, err := ParseLocale(s.Language)
+	if err != nil {
+		for l, name := range localeNames {
+			if strings.EqualFold(strings.TrimSpace(s.Language), name) {
+				return l
+			}
+		}
+		return ""
+	}
+	return locale
+}
+
+// FormatForPrompt renders the preferences as instructions, one per line.
+// No preferences give "".
+func (s ConversationStyle) FormatForPrompt() string {
+	var lines []string
+	if lang := strings.TrimSpace(s.Language); lang != "" {
+		if locale := s.Locale(); locale != "" {
+			lang = LocaleName(locale)
+		}
+		lines = append(lines, "- Always answer in "+lang+".")
+	}
+	switch s.Verbosity {
+	case VerbosityBrief:
+		lines = append(lines, "- Keep answers brief: a few sentences, no preamble.")
+	case VerbosityDetailed:
+		lines = append(lines, "- Give detailed, thorough answers.")
+	}
+	if tone := strings.TrimSpace(s.Tone); tone != "" {
+		lines = append(lines, "- Tone: "+tone+".")
+	}
+	return strings.Join(lines, "\n")
 }
 
 // Message represents a single turn in a conversation
@@ -49,13 +241,53 @@ type Message struct {
 	ToolCall       *ToolCall              `json:"tool_call,omitempty"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
 	CreatedAt      time.Time              `json:"created_at"`
+	ArchivedAt     *time.Time             `json:"archived_at,omitempty"` // replaced by a regenerated reply
+}
+
+// DefaultMessagePageSize is the page size when a request gives no limit.
+const DefaultMessagePageSize = 50
+
+// MessagePage selects a page of conversation messages by cursor. Before and
+// After are message IDs and are mutually exclusive; with neither set the
+// latest messages are returned.
+type MessagePage struct {
+	Before MessageID
+	After  MessageID
+	Limit  int
+	// IncludeArchived also returns replies replaced by regeneration
+	IncludeArchived bool
 }
 
 var (
 	ErrConversationNotFound = errors.New("conversation not found")
 	ErrMessageNotFound      = errors.New("message not found")
+	ErrMessageNotEditable   = errors.New("message cannot be edited or regenerated")
+	// ErrConversationBusy is returned when the agent is already working on
+	// a turn in the conversation
+	ErrConversationBusy = errors.New("conversation is busy: the agent is still working on the previous message")
+	// ErrRunCancelled is the cause of an agent run stopped on request
+	ErrRunCancelled = errors.New("agent run cancelled")
+	// ErrNoActiveRun is returned when cancelling a conversation the agent
+	// is not working on
+	ErrNoActiveRun = errors.New("no agent run in progress")
 )
 
+// ConversationCacheStats is a snapshot of the conversation message cache.
+type ConversationCacheStats struct {
+	Capacity  int    `json:"capacity"` // conversations kept in memory
+	Cached    int    `json:"cached"`
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+	// Write-behind of in-progress checkpoints; zero values when disabled
+	WriteBehind     bool   `json:"write_behind"`
+	FlushIntervalMs int64  `json:"flush_interval_ms,omitempty"`
+	PendingWrites   int    `json:"pending_writes"`
+	Coalesced       uint64 `json:"coalesced"` // updates replaced before they were written
+	Flushed         uint64 `json:"flushed"`
+	FlushErrors     uint64 `json:"flush_errors"`
+}
+
 // NewConversationID generates a compact random conversation ID (conv-<12 hex>)
 func NewConversationID() ConversationID {
 	b := make([]byte, 6)
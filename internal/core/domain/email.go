@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// EmailMessage is an outgoing email.
+type EmailMessage struct {
+	To         []string
+	Cc         []string
+	Subject    string
+	Body       string // plain text
+	InReplyTo  string // Message-ID being answered, for threading
+	References []string
+}
+
+// InboundEmail is one message read from the IMAP inbox.
+type InboundEmail struct {
+	UID        uint32
+	MessageID  string
+	From       string // bare address
+	FromName   string
+	Subject    string
+	Body       string // text/plain part
+	Date       time.Time
+	InReplyTo  string
+	References []string
+}
+
+// ThreadRoot returns the Message-ID that identifies the email's thread.
+func (e InboundEmail) ThreadRoot() string {
+	if len(e.References) > 0 {
+		return e.References[0]
+	}
+	if e.InReplyTo != "" {
+		return e.InReplyTo
+	}
+	return e.MessageID
+}
+
+// EmailConfig configures outgoing mail for send_email and the optional IMAP
+// poller that turns incoming mail into conversations.
+type EmailConfig struct {
+	SMTP SMTPConfig `json:"smtp"`
+	IMAP IMAPConfig `json:"imap"`
+}
+
+// SMTPConfig configures outgoing mail
+type SMTPConfig struct {
+	Host              string   `json:"host"`                         // empty = email disabled
+	Port              int      `json:"port,omitempty"`               // 587 (STARTTLS) or 465 (implicit TLS); 0 = 587
+	Username          string   `json:"username,omitempty"`           // empty = no AUTH
+	Password          string   `json:"password,omitempty"`           // Encrypted in storage
+	From              string   `json:"from,omitempty"`               // sender address; defaults to username
+	DefaultTo         string   `json:"default_to,omitempty"`         // recipient when the agent names none ("email me")
+	AllowedRecipients []string `json:"allowed_recipients,omitempty"` // addresses or "@domain"; empty = any
+}
+
+// Sender returns the From address.
+func (c SMTPConfig) Sender() string {
+	if c.From != "" {
+		return c.From
+	}
+	return c.Username
+}
+
+// IMAPConfig configures the inbox poller
+type IMAPConfig struct {
+	Enabled         bool     `json:"enabled"`
+	Host            string   `json:"host"`
+	Port            int      `json:"port,omitempty"` // 0 = 993, or 143 when insecure
+	Username        string   `json:"username"`
+	Password        string   `json:"password,omitempty"`          // Encrypted in storage
+	Mailbox         string   `json:"mailbox,omitempty"`           // default INBOX
+	Insecure        bool     `json:"insecure,omitempty"`          // plain TCP, for local bridges only
+	PollIntervalSec int      `json:"poll_interval_sec,omitempty"` // 0 = 60
+	AllowedSenders  []string `json:"allowed_senders,omitempty"`   // addresses or "@domain"; mail from anyone else is ignored
+	Reply           bool     `json:"reply,omitempty"`             // answer each email with the agent's reply via SMTP
+	PersonaID       string   `json:"persona_id,omitempty"`        // persona for email conversations
+}
+
+// Validate checks the email settings. Passwords are checked after masked
+// values have been merged with the stored ones.
+func (c EmailConfig) Validate() error {
+	if c.SMTP.Host != "" {
+		if c.SMTP.Port < 0 || c.SMTP.Port > 65535 {
+			return fmt.Errorf("smtp port must be between 0 and 65535")
+		}
+		if _, err := mail.ParseAddress(c.SMTP.Sender()); err != nil {
+			return fmt.Errorf("smtp from (or username) must be an email address: %w", err)
+		}
+		if c.SMTP.DefaultTo != "" {
+			if _, err := mail.ParseAddress(c.SMTP.DefaultTo); err != nil {
+				return fmt.Errorf("smtp default_to: %w", err)
+			}
+		}
+	}
+	if !c.IMAP.Enabled {
+		return nil
+	}
+	if c.IMAP.Host == "" || c.IMAP.Username == "" {
+		return fmt.Errorf("imap host and username are required when the poller is enabled")
+	}
+	if c.IMAP.Port < 0 || c.IMAP.Port > 65535 {
+		return fmt.Errorf("imap port must be between 0 and 65535")
+	}
+	if c.IMAP.PollIntervalSec != 0 && c.IMAP.PollIntervalSec < 10 {
+		return fmt.Errorf("imap poll_interval_sec must be at least 10")
+	}
+	if len(c.IMAP.AllowedSenders) == 0 {
+		return fmt.Errorf("imap allowed_senders is required: incoming mail is run as agent instructions")
+	}
+	if c.IMAP.Reply && c.SMTP.Host == "" {
+		return fmt.Errorf("imap reply requires smtp to be configured")
+	}
+	return nil
+}
+
+// AddressAllowed reports whether addr matches an entry of list: a full
+// address or an "@domain" suffix, case-insensitive.
+func AddressAllowed(list []string, addr string) bool {
+	addr = strings.ToLower(strings.TrimSpace(addr))
+	for _, entry := range list {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if strings.HasPrefix(entry, "@") {
+			if strings.HasSuffix(addr, entry) {
+				return true
+			}
+		} else if addr == entry {
+			return true
+		}
+	}
+	return false
+}
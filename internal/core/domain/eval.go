@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// EvalSuiteID uniquely identifies an evaluation suite
+type EvalSuiteID string
+
+// EvalRunID uniquely identifies one execution of a suite against a persona/model
+type EvalRunID string
+
+// NewEvalSuiteID generates a compact random suite ID (evs-<12 hex>)
+func NewEvalSuiteID() EvalSuiteID {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return EvalSuiteID("evs-" + hex.EncodeToString(b))
+}
+
+// NewEvalRunID generates a compact random run ID (evr-<12 hex>)
+func NewEvalRunID() EvalRunID {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return EvalRunID("evr-" + hex.EncodeToString(b))
+}
+
+var (
+	ErrEvalSuiteNotFound = errors.New("eval suite not found")
+	ErrEvalRunNotFound   = errors.New("eval run not found")
+)
+
+// EvalAssertionType selects how an assertion checks the agent output.
+type EvalAssertionType string
+
+const (
+	EvalAssertContains    EvalAssertionType = "contains"     // output contains value (case-insensitive)
+	EvalAssertNotContains EvalAssertionType = "not_contains" // output does not contain value (case-insensitive)
+	EvalAssertRegex       EvalAssertionType = "regex"        // output matches regular expression
+	EvalAssertEquals      EvalAssertionType = "equals"       // trimmed output equals value
+	EvalAssertMaxSteps    EvalAssertionType = "max_steps"    // ReAct steps <= value
+)
+
+// EvalAssertion is one check applied to the agent output.
+type EvalAssertion struct {
+	Type  EvalAssertionType `json:"type"`
+	Value string            `json:"value"`
+}
+
+// EvalCase is a single prompt with its expectations.
+type EvalCase struct {
+	Name          string          `json:"name"`
+	Prompt        string          `json:"prompt"`
+	ExpectedTools []string        `json:"expected_tools,omitempty"` // every tool must be called at least once
+	Assertions    []EvalAssertion `json:"assertions,omitempty"`
+}
+
+// EvalSuite groups cases that are run together.
+type EvalSuite struct {
+	ID          EvalSuiteID `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Cases       []EvalCase  `json:"cases"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// Validate checks that the suite is runnable.
+func (s EvalSuite) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("suite name is required")
+	}
+	if len(s.Cases) == 0 {
+		return fmt.Errorf("suite must have at least one case")
+	}
+	seen := make(map[string]bool, len(s.Cases))
+	for i, c := range s.Cases {
+		if strings.TrimSpace(c.Name) == "" {
+			return fmt.Errorf("case %d: name is required", i)
+		}
+		if seen[c.Name] {
+			return fmt.Errorf("case %q: duplicate name", c.Name)
+		}
+		seen[c.Name] = true
+		if strings.TrimSpace(c.Prompt) == "" {
+			return fmt.Errorf("case %q: prompt is required", c.Name)
+		}
+		for _, a := range c.Assertions {
+			switch a.Type {
+			case EvalAssertContains, EvalAssertNotContains, EvalAssertEquals:
+			case EvalAssertRegex:
+				if _, err := regexp.Compile(a.Value); err != nil {
+					return fmt.Errorf("case %q: invalid regex %q: %w", c.Name, a.Value, err)
+				}
+			case EvalAssertMaxSteps:
+				var n int
+				if _, err := fmt.Sscanf(a.Value, "%d", &n); err != nil || n <= 0 {
+					return fmt.Errorf("case %q: max_steps needs a positive integer", c.Name)
+				}
+			default:
+				return fmt.Errorf("case %q: unknown assertion type %q", c.Name, a.Type)
+			}
+		}
+	}
+	return nil
+}
+
+// EvalRunStatus is the lifecycle state of an eval run.
+type EvalRunStatus string
+
+const (
+	EvalRunRunning   EvalRunStatus = "running"
+	EvalRunCompleted EvalRunStatus = "completed"
+	EvalRunFailed    EvalRunStatus = "failed"
+)
+
+// EvalCaseResult is the scored outcome of one case.
+type EvalCaseResult struct {
+	Case           string         `json:"case"`
+	Passed         bool           `json:"passed"`
+	Score          float64        `json:"score"` // passed checks / total checks
+	Output         string         `json:"output"`
+	ToolsUsed      []string       `json:"tools_used"`
+	Failures       []string       `json:"failures,omitempty"`
+	Error          string         `json:"error,omitempty"`
+	ConversationID ConversationID `json:"conversation_id,omitempty"`
+	DurationMs     int64          `json:"duration_ms"`
+}
+
+// EvalRun is one execution of a suite against a persona/model pair.
+type EvalRun struct {
+	ID         EvalRunID        `json:"id"`
+	SuiteID    EvalSuiteID      `json:"suite_id"`
+	PersonaID  *PersonaID       `json:"persona_id,omitempty"`
+	Model      string           `json:"model,omitempty"`
+	Status     EvalRunStatus    `json:"status"`
+	Score      float64          `json:"score"` // mean case score in [0,1]
+	Passed     int              `json:"passed"`
+	Total      int              `json:"total"`
+	Results    []EvalCaseResult `json:"results,omitempty"`
+	Error      string           `json:"error,omitempty"`
+	StartedAt  time.Time        `json:"started_at"`
+	FinishedAt *time.Time       `json:"finished_at,omitempty"`
+}
+
+// ScoreEvalCase checks an agent output against a case's expectations.
+func ScoreEvalCase(c EvalCase, output string, steps []ReActStep) EvalCaseResult {
+	res := EvalCaseResult{Case: c.Name, Output: output, ToolsUsed: []string{}}
+
+	used := make(map[string]bool)
+	for _, st := range steps {
+		if st.Action != "" && !used[st.Action] {
+			used[st.Action] = true
+			res.ToolsUsed = append(res.ToolsUsed, st.Action)
+		}
+	}
+
+	checks, passed := 0, 0
+	check := func(ok bool, failure string) {
+		checks++
+		if ok {
+			passed++
+		} else {
+			res.Failures = append(res.Failures, failure)
+		}
+	}
+
+	for _, tool := range c.ExpectedTools {
+		check(used[tool], fmt.Sprintf("expected tool %q was not called", tool))
+	}
+
+	lower := strings.ToLower(output)
+	for _, a := range c.Assertions {
+		switch a.Type {
+		case EvalAssertContains:
+			check(strings.Contains(lower, strings.ToLower(a.Value)), fmt.Sprintf("output does not contain %q", a.Value))
+		case EvalAssertNotContains:
+			check(!strings.Contains(lower, strings.ToLower(a.Value)), fmt.Sprintf("output contains %q", a.Value))
+		case EvalAssertEquals:
+			check(strings.TrimSpace(output) == strings.TrimSpace(a.Value), fmt.Sprintf("output is not equal to %q", a.Value))
+		case EvalAssertRegex:
+			re, err := regexp.Compile(a.Value)
+			check(err == nil && re.MatchString(output), fmt.Sprintf("output does not match /%s/", a.Value))
+		case EvalAssertMaxSteps:
+			var n int
+			fmt.Sscanf(a.Value, "%d", &n)
+			check(len(steps) <= n, fmt.Sprintf("took %d steps (max %d)", len(steps), n))
+		}
+	}
+
+	if checks == 0 {
+		// No expectations: a non-empty answer counts as a pass
+		check(strings.TrimSpace(output) != "", "empty output")
+	}
+	res.Score = float64(passed) / float64(checks)
+	res.Passed = passed == checks
+	return res
+}
+
+// EvalCaseDiff describes how one case changed between two runs.
+type EvalCaseDiff struct {
+	Case       string  `json:"case"`
+	Change     string  `json:"change"` // "regressed", "improved", "unchanged", "added", "removed"
+	BaseScore  float64 `json:"base_score"`
+	Score      float64 `json:"score"`
+	ScoreDelta float64 `json:"score_delta"`
+}
+
+// EvalDiff compares a run with a baseline run of the same suite.
+type EvalDiff struct {
+	BaseRunID    EvalRunID      `json:"base_run_id"`
+	RunID        EvalRunID      `json:"run_id"`
+	BaseScore    float64        `json:"base_score"`
+	Score        float64        `json:"score"`
+	ScoreDelta   float64        `json:"score_delta"`
+	Regressions  int            `json:"regressions"`
+	Improvements int            `json:"improvements"`
+	Cases        []EvalCaseDiff `json:"cases"`
+}
+
+// DiffEvalRuns compares run against base case by case.
+func DiffEvalRuns(base, run EvalRun) EvalDiff {
+	diff := EvalDiff{
+		BaseRunID:  base.ID,
+		RunID:      run.ID,
+		BaseScore:  base.Score,
+		Score:      run.Score,
+		ScoreDelta: run.Score - base.Score,
+		Cases:      []EvalCaseDiff{},
+	}
+
+	baseByCase := make(map[string]EvalCaseResult, len(base.Results))
+	for _, r := range base.Results {
+		baseByCase[r.Case] = r
+	}
+	seen := make(map[string]bool, len(run.Results))
+
+	for _, r := range run.Results {
+		seen[r.Case] = true
+		cd := EvalCaseDiff{Case: r.Case, Score: r.Score}
+		b, ok := baseByCase[r.Case]
+		switch {
+		case !ok:
+			cd.Change = "added"
+			cd.ScoreDelta = r.Score
+		case b.Passed && !r.Passed, r.Score < b.Score:
+			cd.Change = "regressed"
+			diff.Regressions++
+		case !b.Passed && r.Passed, r.Score > b.Score:
+			cd.Change = "improved"
+			diff.Improvements++
+		default:
+			cd.Change = "unchanged"
+		}
+		if ok {
+			cd.BaseScore = b.Score
+			cd.ScoreDelta = r.Score - b.Score
+		}
+		diff.Cases = append(diff.Cases, cd)
+	}
+	for _, b := range base.Results {
+		if !seen[b.Case] {
+			diff.Cases = append(diff.Cases, EvalCaseDiff{Case: b.Case, Change: "removed", BaseScore: b.Score, ScoreDelta: -b.Score})
+		}
+	}
+	sort.SliceStable(diff.Cases, func(i, j int) bool { return diff.Cases[i].Case < diff.Cases[j].Case })
+	return diff
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// FeedID uniquely identifies a watched feed
+type FeedID string
+
+var ErrFeedNotFound = errors.New("feed not found")
+
+// Feed polling bounds
+const (
+	FeedDefaultIntervalSec = 15 * 60
+	FeedMinIntervalSec     = 5 * 60
+)
+
+// FeedWatch is an RSS/Atom feed polled in the background. New items trigger
+// either Prompt (run by the agent in the feed's conversation), a copy of the
+// workflow WorkflowID with the items in its state, or the automation rule
+// AutomationID.
+type FeedWatch struct {
+	ID           FeedID       `json:"id"`
+	ProjectID    ProjectID    `json:"project_id"`
+	Name         string       `json:"name"`
+	URL          string       `json:"url"`
+	Prompt       string       `json:"prompt,omitempty"`        // instruction run with the new items appended
+	WorkflowID   WorkflowID   `json:"workflow_id,omitempty"`   // template workflow; {{state.feed_items}} holds the new items
+	AutomationID AutomationID `json:"automation_id,omitempty"` // rule the new items are dispatched to instead
+	PersonaID    *PersonaID   `json:"persona_id,omitempty"`
+	IntervalSec  int          `json:"interval_sec"`
+	Paused       bool         `json:"paused"`
+	LastChecked  *time.Time   `json:"last_checked,omitempty"`
+	LastError    string       `json:"last_error,omitempty"`
+	TriggerCount int          `json:"trigger_count"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+// Due reports whether the feed should be polled at now.
+func (f FeedWatch) Due(now time.Time) bool {
+	if f.Paused {
+		return false
+	}
+	if f.LastChecked == nil {
+		return true
+	}
+	interval := f.IntervalSec
+	if interval <= 0 {
+		interval = FeedDefaultIntervalSec
+	}
+	return !now.Before(f.LastChecked.Add(time.Duration(interval) * time.Second))
+}
+
+// FeedItem is one entry of an RSS or Atom feed.
+type FeedItem struct {
+	GUID      string    `json:"guid"` // id/guid, else the link
+	Title     string    `json:"title"`
+	Link      string    `json:"link"`
+	Summary   string    `json:"summary,omitempty"`
+	Published time.Time `json:"published,omitempty"`
+}
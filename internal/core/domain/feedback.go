@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FeedbackRating is a user's thumbs-up or thumbs-down on an assistant reply.
+type FeedbackRating string
+
+const (
+	FeedbackUp   FeedbackRating = "up"
+	FeedbackDown FeedbackRating = "down"
+)
+
+var (
+	ErrFeedbackNotFound = errors.New("feedback not found")
+	ErrInvalidFeedback  = errors.New("invalid feedback")
+)
+
+// MessageFeedback is the latest rating a user gave an assistant message.
+// TraceID links it to the ReAct trace that produced the reply.
+type MessageFeedback struct {
+	MessageID      MessageID      `json:"message_id"`
+	ConversationID ConversationID `json:"conversation_id"`
+	TraceID        TraceID        `json:"trace_id,omitempty"`
+	Rating         FeedbackRating `json:"rating"`
+	Comment        string         `json:"comment,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// Validate checks the rating and comment length.
+func (f MessageFeedback) Validate() error {
+	switch f.Rating {
+	case FeedbackUp, FeedbackDown:
+	default:
+		return fmt.Errorf("%w: rating must be %q or %q", ErrInvalidFeedback, FeedbackUp, FeedbackDown)
+	}
+	if len(f.Comment) > 4000 {
+		return fmt.Errorf("%w: comment is limited to 4000 bytes", ErrInvalidFeedback)
+	}
+	return nil
+}
+
+// FeedbackFilter narrows a feedback export. Zero fields match all.
+type FeedbackFilter struct {
+	Since  *time.Time
+	Rating FeedbackRating
+}
+
+// FeedbackRecord is one exported feedback entry with the exchange it rates,
+// ready to be replayed against a different prompt or model.
+type FeedbackRecord struct {
+	MessageFeedback
+	Prompt    string     `json:"prompt"`   // user message that led to the reply
+	Response  string     `json:"response"` // the rated reply
+	Model     string     `json:"model,omitempty"`
+	PersonaID *PersonaID `json:"persona_id,omitempty"`
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"errors"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileTriggerID uniquely identifies a file-system watch trigger
+type FileTriggerID string
+
+var ErrFileTriggerNotFound = errors.New("file trigger not found")
+
+// File trigger debounce bounds
+const (
+	FileTriggerDefaultDebounceSec = 5
+	FileTriggerMaxDebounceSec     = 3600
+)
+
+// FileTrigger watches a workspace directory. Changes to files matching
+// Patterns, once quiet for DebounceSec, trigger either Prompt (run by the
+// agent in the trigger's conversation), a copy of the workflow WorkflowID,
+// or the automation rule AutomationID.
+type FileTrigger struct {
+	ID            FileTriggerID `json:"id"`
+	ProjectID     ProjectID     `json:"project_id"`
+	Name          string        `json:"name"`
+	Path          string        `json:"path"`               // absolute directory, resolved from the workspace root
+	Patterns      []string      `json:"patterns,omitempty"` // globs; without a slash they match the file name, else the path relative to Path
+	Recursive     bool          `json:"recursive"`
+	Events        []FileOp      `json:"events,omitempty"` // empty = create and write
+	Prompt        string        `json:"prompt,omitempty"`
+	WorkflowID    WorkflowID    `json:"workflow_id,omitempty"`   // template workflow; {{state.changed_files}} holds the changes
+	AutomationID  AutomationID  `json:"automation_id,omitempty"` // rule the changes are dispatched to instead
+	PersonaID     *PersonaID    `json:"persona_id,omitempty"`
+	DebounceSec   int           `json:"debounce_sec"`
+	Paused        bool          `json:"paused"`
+	LastTriggered *time.Time    `json:"last_triggered,omitempty"`
+	LastError     string        `json:"last_error,omitempty"`
+	TriggerCount  int           `json:"trigger_count"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// FileOp is the kind of change a FileEvent reports
+type FileOp string
+
+const (
+	FileOpCreate FileOp = "create"
+	FileOpWrite  FileOp = "write"
+	FileOpRemove FileOp = "remove"
+	FileOpRename FileOp = "rename" // the old name; the new name arrives as a create
+)
+
+// FileEvent is one change reported by a file watcher.
+type FileEvent struct {
+	Path  string `json:"path"`
+	Op    FileOp `json:"op"`
+	IsDir bool   `json:"is_dir,omitempty"`
+}
+
+// Matches reports whether an event for file (an absolute path) fires the
+// trigger, ignoring Paused.
+func (t FileTrigger) Matches(file string, op FileOp) bool {
+	rel, err := filepath.Rel(t.Path, file)
+	if err != nil || rel == "." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || rel == ".." {
+		return false
+	}
+	if !t.Recursive && strings.ContainsRune(rel, filepath.Separator) {
+		return false
+	}
+	if !t.handles(op) {
+		return false
+	}
+	if len(t.Patterns) == 0 {
+		return true
+	}
+	for _, p := range t.Patterns {
+		target := filepath.Base(rel)
+		if strings.ContainsRune(p, '/') {
+			target = filepath.ToSlash(rel)
+		}
+		if ok, _ := path.Match(p, target); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (t FileTrigger) handles(op FileOp) bool {
+	if len(t.Events) == 0 {
+		return op == FileOpCreate || op == FileOpWrite
+	}
+	for _, e := range t.Events {
+		if e == op {
+			return true
+		}
+	}
+	return false
+}
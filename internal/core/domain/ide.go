@@ -0,0 +1,31 @@
+package domain
+
+import "errors"
+
+// IDEEditRequest asks for an edit to a file, or part of one, open in an
+// editor plugin.
+type IDEEditRequest struct {
+	ProjectID   *ProjectID `json:"project_id,omitempty"`
+	Path        string     `json:"path"`                 // relative to the project workspace
+	Content     string     `json:"content,omitempty"`    // editor buffer or selection; empty reads Path from the workspace
+	StartLine   int        `json:"start_line,omitempty"` // line of the file where Content starts; default 1
+	Language    string     `json:"language,omitempty"`   // code fence hint, e.g. "go"
+	Instruction string     `json:"instruction"`
+	Model       string     `json:"model,omitempty"` // default: the code model
+	Apply       bool       `json:"apply,omitempty"` // also write the patch to the workspace file
+}
+
+// IDEEditResult is the proposed edit as a unified diff the editor can
+// preview before applying.
+type IDEEditResult struct {
+	Path        string `json:"path"`
+	Patch       string `json:"patch"` // "" when the model changed nothing
+	Explanation string `json:"explanation"`
+	Applied     bool   `json:"applied"`
+	Model       string `json:"model,omitempty"`
+}
+
+var (
+	ErrInvalidIDEEdit = errors.New("invalid edit request")
+	ErrIDENoCode      = errors.New("model reply has no code block")
+)
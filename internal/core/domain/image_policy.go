@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// PullPolicy decides when worker images are pulled from their registry.
+type PullPolicy string
+
+const (
+	PullIfNotPresent PullPolicy = "if-not-present" // the default
+	PullAlways       PullPolicy = "always"
+	PullNever        PullPolicy = "never"
+)
+
+// ErrImageNotAllowed is returned for worker images the image policy rejects.
+var ErrImageNotAllowed = errors.New("worker image not allowed")
+
+var digestRe = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// ImagePolicy restricts which container images workers may run.
+//
+// Allow and Deny hold glob patterns (path.Match syntax) over image
+// references such as "python:3.12-slim" or "ghcr.io/acme/*". A pattern
+// without a tag or digest matches every tag and digest of the repository.
+// Docker Hub names match with or without the "docker.io/library/" prefix.
+type ImagePolicy struct {
+	Allow      []string   `json:"allow,omitempty"` // empty = every image not denied
+	Deny       []string   `json:"deny,omitempty"`  // checked first
+	PullPolicy PullPolicy `json:"pull_policy,omitempty"`
+	// Pins maps image references to the sha256 digest they must run as,
+	// e.g. "python:3.12-slim" → "sha256:…"
+	Pins map[string]string `json:"pins,omitempty"`
+	// RequireDigest rejects images that are neither referenced by digest
+	// nor pinned.
+	RequireDigest bool `json:"require_digest,omitempty"`
+}
+
+// Validate checks the pull policy, patterns and pins.
+func (p ImagePolicy) Validate() error {
+	switch p.PullPolicy {
+	case "", PullIfNotPresent, PullAlways, PullNever:
+	default:
+		return fmt.Errorf("image pull_policy must be never, if-not-present or always, got %q", p.PullPolicy)
+	}
+	for _, pat := range append(append([]string(nil), p.Allow...), p.Deny...) {
+		if pat == "" {
+			return fmt.Errorf("image policy: empty pattern")
+		}
+		if _, err := path.Match(pat, ""); err != nil {
+			return fmt.Errorf("image policy pattern %q: %w", pat, err)
+		}
+	}
+	for ref, digest := range p.Pins {
+		if ref == "" || !digestRe.MatchString(digest) {
+			return fmt.Errorf("image pin %q: digest must be sha256:<64 hex>, got %q", ref, digest)
+		}
+	}
+	return nil
+}
+
+// Pull returns the effective pull policy.
+func (p ImagePolicy) Pull() PullPolicy {
+	if p.PullPolicy == "" {
+		return PullIfNotPresent
+	}
+	return p.PullPolicy
+}
+
+// Resolve checks image against the policy and returns the reference to
+// run: pinned images come back with their digest appended
+// ("python:3.12-slim@sha256:…"), which Docker resolves by digest. The
+// result resolves to itself, so it can be checked again at spawn time.
+// Rejections wrap ErrImageNotAllowed.
+func (p ImagePolicy) Resolve(image string) (string, error) {
+	image = strings.TrimSpace(image)
+	if image == "" {
+		return "", fmt.Errorf("%w: no image given", ErrImageNotAllowed)
+	}
+	for _, pat := range p.Deny {
+		if imageMatches(pat, image) {
+			return "", fmt.Errorf("%w: %s is denied by %q", ErrImageNotAllowed, image, pat)
+		}
+	}
+	if len(p.Allow) > 0 {
+		allowed := false
+		for _, pat := range p.Allow {
+			if imageMatches(pat, image) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return "", fmt.Errorf("%w: %s is not on the allowlist", ErrImageNotAllowed, image)
+		}
+	}
+
+	repo, tag, digest := ParseImageRef(image)
+	if digest == "" {
+		if pin := p.pinFor(repo, tag); pin != "" {
+			return image + "@" + pin, nil
+		}
+		if p.RequireDigest {
+			return "", fmt.Errorf("%w: %s must be referenced by digest", ErrImageNotAllowed, image)
+		}
+	} else if tag != "" {
+		if pin := p.pinFor(repo, tag); pin != "" && pin != digest {
+			return "", fmt.Errorf("%w: %s:%s is pinned to %s", ErrImageNotAllowed, repo, tag, pin)
+		}
+	}
+	return image, nil
+}
+
+func (p ImagePolicy) pinFor(repo, tag string) string {
+	if tag == "" {
+		tag = "latest"
+	}
+	want := normalizeRepo(repo) + ":" + tag
+	for ref, digest := range p.Pins {
+		r, t, _ := ParseImageRef(ref)
+		if t == "" {
+			t = "latest"
+		}
+		if normalizeRepo(r)+":"+t == want {
+			return digest
+		}
+	}
+	return ""
+}
+
+// ParseImageRef splits an image reference into repository, tag and digest.
+// Tag and digest are empty when absent.
+func ParseImageRef(ref string) (repo, tag, digest string) {
+	repo = ref
+	if i := strings.Index(repo, "@"); i >= 0 {
+		repo, digest = repo[:i], repo[i+1:]
+	}
+	// A colon after the last slash is a tag; before it, a registry port
+	if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+		repo, tag = repo[:i], repo[i+1:]
+	}
+	return repo, tag, digest
+}
+
+// normalizeRepo drops the implicit Docker Hub registry and library
+// namespace, so "docker.io/library/python" and "python" compare equal.
+func normalizeRepo(repo string) string {
+	repo = strings.TrimPrefix(repo, "docker.io/")
+	repo = strings.TrimPrefix(repo, "index.docker.io/")
+	return strings.TrimPrefix(repo, "library/")
+}
+
+func imageMatches(pattern, image string) bool {
+	repo, tag, digest := ParseImageRef(image)
+	name := normalizeRepo(repo)
+	patRepo, patTag, patDigest := ParseImageRef(pattern)
+	patRepo = normalizeRepo(patRepo)
+	if ok, _ := path.Match(patRepo, name); !ok {
+		// Patterns like "ghcr.io/acme/*" should also cover nested paths
+		if !strings.HasSuffix(patRepo, "/*") || !strings.HasPrefix(name, strings.TrimSuffix(patRepo, "*")) {
+			return false
+		}
+	}
+	if patDigest != "" {
+		return patDigest == digest
+	}
+	if patTag != "" {
+		if tag == "" {
+			tag = "latest"
+		}
+		ok, _ := path.Match(patTag, tag)
+		return ok
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImageRef(t *testing.T) {
+	for ref, want := range map[string][3]string{
+		"python":                            {"python", "", ""},
+		"python:3.12-slim":                  {"python", "3.12-slim", ""},
+		"localhost:5000/tools/build:v1":     {"localhost:5000/tools/build", "v1", ""},
+		"ghcr.io/acme/app@sha256:abc":       {"ghcr.io/acme/app", "", "sha256:abc"},
+		"python:3.12@sha256:abc":            {"python", "3.12", "sha256:abc"},
+		"registry.example.com:443/team/img": {"registry.example.com:443/team/img", "", ""},
+	} {
+		repo, tag, digest := ParseImageRef(ref)
+		assert.Equal(t, want, [3]string{repo, tag, digest}, ref)
+	}
+}
+
+func TestImagePolicy_Resolve(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	p := ImagePolicy{
+		Allow: []string{"python:3.12*", "alpine", "ghcr.io/acme/*"},
+		Deny:  []string{"ghcr.io/acme/legacy"},
+		Pins:  map[string]string{"alpine:3.20": digest},
+	}
+	require.NoError(t, p.Validate())
+
+	for _, ok := range []string{"python:3.12-slim", "docker.io/library/alpine:edge", "ghcr.io/acme/tools/build:v2"} {
+		got, err := p.Resolve(ok)
+		require.NoError(t, err, ok)
+		assert.Equal(t, ok, got)
+	}
+	for _, bad := range []string{"python:3.11", "ubuntu", "ghcr.io/acme/legacy:1", ""} {
+		_, err := p.Resolve(bad)
+		assert.ErrorIs(t, err, ErrImageNotAllowed, bad)
+	}
+
+	// Pinned tags run by digest, and the result passes the policy again
+	got, err := p.Resolve("alpine:3.20")
+	require.NoError(t, err)
+	assert.Equal(t, "alpine:3.20@"+digest, got)
+	again, err := p.Resolve(got)
+	require.NoError(t, err)
+	assert.Equal(t, got, again)
+	_, err = p.Resolve("alpine:3.20@sha256:" + strings.Repeat("b", 64))
+	assert.ErrorIs(t, err, ErrImageNotAllowed, "a different digest for a pinned tag")
+
+	p.RequireDigest = true
+	_, err = p.Resolve("alpine:edge")
+	assert.ErrorIs(t, err, ErrImageNotAllowed)
+	_, err = p.Resolve("alpine@" + digest)
+	assert.NoError(t, err)
+
+	// The zero policy allows everything
+	got, err = ImagePolicy{}.Resolve("anything/at:all")
+	require.NoError(t, err)
+	assert.Equal(t, "anything/at:all", got)
+	assert.Equal(t, PullIfNotPresent, ImagePolicy{}.Pull())
+}
+
+func TestImagePolicy_Validate(t *testing.T) {
+	assert.Error(t, ImagePolicy{PullPolicy: "sometimes"}.Validate())
+	assert.Error(t, ImagePolicy{Allow: []string{"[bad"}}.Validate())
+	assert.Error(t, ImagePolicy{Pins: map[string]string{"alpine": "latest"}}.Validate())
+	assert.NoError(t, ImagePolicy{PullPolicy: PullNever, Deny: []string{"*"}}.Validate())
+	assert.Error(t, RuntimeConfig{Images: ImagePolicy{PullPolicy: "x"}}.Validate())
+}
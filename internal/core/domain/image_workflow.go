@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ImageWorkflowTemplate is a ComfyUI API-format graph with parameter slots,
+// stored per project for reproducible, tunable image pipelines.
+//
+// Slots are written as "{{name}}" inside string values of the graph. A value
+// that is exactly one slot takes the parameter's JSON type (so "{{seed}}"
+// becomes a number); slots embedded in longer strings are substituted as text.
+// Common slots: prompt, negative_prompt, seed, width, height, lora, lora_strength.
+type ImageWorkflowTemplate struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Graph       json.RawMessage        `json:"graph"`                 // {"3": {"class_type": "KSampler", "inputs": {...}}, ...}
+	Defaults    map[string]interface{} `json:"defaults,omitempty"`    // slot values used when a job omits them
+	OutputNode  string                 `json:"output_node,omitempty"` // node whose images are the result; empty = first with images
+}
+
+var workflowSlotRe = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_]+)\s*\}\}`)
+
+// Validate checks the graph is a JSON object of nodes and the output node exists.
+func (t ImageWorkflowTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("workflow name is required")
+	}
+	var graph map[string]interface{}
+	if err := json.Unmarshal(t.Graph, &graph); err != nil {
+		return fmt.Errorf("workflow %s: graph must be a JSON object: %w", t.Name, err)
+	}
+	if len(graph) == 0 {
+		return fmt.Errorf("workflow %s: graph is empty", t.Name)
+	}
+	if t.OutputNode != "" {
+		if _, ok := graph[t.OutputNode]; !ok {
+			return fmt.Errorf("workflow %s: output_node %q not in graph", t.Name, t.OutputNode)
+		}
+	}
+	return nil
+}
+
+// Slots returns the sorted, de-duplicated slot names used in the graph.
+func (t ImageWorkflowTemplate) Slots() []string {
+	seen := map[string]bool{}
+	for _, m := range workflowSlotRe.FindAllStringSubmatch(string(t.Graph), -1) {
+		seen[m[1]] = true
+	}
+	out := make([]string, 0, len(seen))
+	for s := range seen {
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Render fills the slots from params (falling back to Defaults) and returns
+// the graph ready to submit. Every slot must resolve to a value.
+func (t ImageWorkflowTemplate) Render(params map[string]interface{}) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(t.Defaults)+len(params))
+	for k, v := range t.Defaults {
+		values[k] = v
+	}
+	for k, v := range params {
+		values[k] = v
+	}
+
+	var missing []string
+	for _, s := range t.Slots() {
+		if _, ok := values[s]; !ok {
+			missing = append(missing, s)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("workflow %s: missing parameters: %s", t.Name, strings.Join(missing, ", "))
+	}
+
+	var graph map[string]interface{}
+	if err := json.Unmarshal(t.Graph, &graph); err != nil {
+		return nil, fmt.Errorf("workflow %s: invalid graph: %w", t.Name, err)
+	}
+	return fillWorkflowSlots(graph, values).(map[string]interface{}), nil
+}
+
+func fillWorkflowSlots(v interface{}, values map[string]interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		for k, child := range x {
+			x[k] = fillWorkflowSlots(child, values)
+		}
+		return x
+	case []interface{}:
+		for i, child := range x {
+			x[i] = fillWorkflowSlots(child, values)
+		}
+		return x
+	case string:
+		if m := workflowSlotRe.FindStringSubmatch(x); m != nil && m[0] == x {
+			return values[m[1]]
+		}
+		return workflowSlotRe.ReplaceAllStringFunc(x, func(slot string) string {
+			name := workflowSlotRe.FindStringSubmatch(slot)[1]
+			return fmt.Sprint(values[name])
+		})
+	default:
+		return v
+	}
+}
+
+// ImageWorkflow returns the project's workflow template with the given name.
+func (s ProjectSettings) ImageWorkflow(name string) (ImageWorkflowTemplate, bool) {
+	for _, t := range s.ImageWorkflows {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return ImageWorkflowTemplate{}, false
+}
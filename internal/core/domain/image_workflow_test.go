@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageWorkflowTemplate_Render(t *testing.T) {
+	tmpl := ImageWorkflowTemplate{
+		Name: "sd",
+		Graph: json.RawMessage(`{
+			"3": {"class_type": "KSampler", "inputs": {"seed": "{{seed}}", "steps": 20}},
+			"5": {"class_type": "EmptyLatentImage", "inputs": {"width": "{{width}}", "height": "{{ height }}"}},
+			"6": {"class_type": "CLIPTextEncode", "inputs": {"text": "{{prompt}}, masterpiece"}}
+		}`),
+		Defaults: map[string]interface{}{"width": 512, "height": 512},
+	}
+	assert.Equal(t, []string{"height", "prompt", "seed", "width"}, tmpl.Slots())
+
+	graph, err := tmpl.Render(map[string]interface{}{"prompt": "a cat", "seed": 42, "width": 768})
+	require.NoError(t, err)
+	sampler := graph["3"].(map[string]interface{})["inputs"].(map[string]interface{})
+	assert.Equal(t, 42, sampler["seed"])
+	latent := graph["5"].(map[string]interface{})["inputs"].(map[string]interface{})
+	assert.Equal(t, 768, latent["width"])
+	assert.Equal(t, 512, latent["height"])
+	text := graph["6"].(map[string]interface{})["inputs"].(map[string]interface{})
+	assert.Equal(t, "a cat, masterpiece", text["text"])
+
+	_, err = tmpl.Render(map[string]interface{}{"prompt": "a cat"})
+	assert.ErrorContains(t, err, "missing parameters: seed")
+}
+
+func TestImageWorkflowTemplate_Validate(t *testing.T) {
+	assert.Error(t, ImageWorkflowTemplate{Name: "x", Graph: json.RawMessage(`[]`)}.Validate())
+	assert.Error(t, ImageWorkflowTemplate{Name: "x", Graph: json.RawMessage(`{"1": {}}`), OutputNode: "9"}.Validate())
+	assert.NoError(t, ImageWorkflowTemplate{Name: "x", Graph: json.RawMessage(`{"9": {}}`), OutputNode: "9"}.Validate())
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"errors"
+	"path"
+	"strings"
 	"time"
 )
 
@@ -10,17 +12,20 @@ type JobID string
 type JobStatus string
 
 const (
-	JobStatusPending   JobStatus = "QUEUED"
-	JobStatusRunning   JobStatus = "RUNNING"
-	JobStatusCompleted JobStatus = "COMPLETED"
-	JobStatusFailed    JobStatus = "FAILED"
-	JobStatusCancelled JobStatus = "CANCELLED"
+	JobStatusPending     JobStatus = "QUEUED"
+	JobStatusRunning     JobStatus = "RUNNING"
+	JobStatusCompleted   JobStatus = "COMPLETED"
+	JobStatusFailed      JobStatus = "FAILED"
+	JobStatusCancelled   JobStatus = "CANCELLED"
+	JobStatusWaiting     JobStatus = "WAITING"     // held until its dependencies complete
+	JobStatusInterrupted JobStatus = "INTERRUPTED" // cut off by a kernel shutdown; resumed on restart
 )
 
 // Job represents a unit of work (AWU - Agentic Work Unit)
 type Job struct {
 	ID        JobID             `json:"id"`
-	Result    *string           `json:"result,omitempty"` // Path to result or output
+	Result    *string           `json:"result,omitempty"` // URL of the primary output; see Output
+	Output    *JobResult        `json:"output,omitempty"` // structured output; nil for jobs from older versions
 	Error     *string           `json:"error,omitempty"`
 	Status    JobStatus         `json:"status"`
 	WorkerID  *WorkerID         `json:"worker_id,omitempty"`
@@ -28,8 +33,91 @@ type Job struct {
 	CreatedAt time.Time         `json:"created_at"`
 	UpdatedAt time.Time         `json:"updated_at"`
 	Metadata  map[string]string `json:"metadata"`
+	DependsOn []JobID           `json:"depends_on,omitempty"` // jobs that must complete successfully first
+}
+
+// JobQueueInfo is where a queued job stands while every scheduler slot is
+// busy.
+type JobQueueInfo struct {
+	Position int   `json:"position"` // 1 = next to start
+	Length   int   `json:"length"`   // jobs queued
+	Running  int   `json:"running"`
+	Slots    int64 `json:"slots"` // max concurrent jobs
+	// ETASeconds is a rough time until the job starts, from recent run
+	// times of the same capability; nil until there is any history
+	ETASeconds *int `json:"eta_seconds,omitempty"`
+}
+
+// JobResult is the structured output of a completed job.
+type JobResult struct {
+	Summary string                 `json:"summary,omitempty"` // one line for lists and notifications
+	Files   []JobFile              `json:"files,omitempty"`
+	Data    map[string]interface{} `json:"data,omitempty"` // job-specific values, e.g. the image seed
+}
+
+// JobFile is one file a job produced, served under /v1/jobs/{id}/files/.
+type JobFile struct {
+	Name       string     `json:"name"`
+	URL        string     `json:"url"`
+	MimeType   string     `json:"mime_type,omitempty"`
+	SizeBytes  int64      `json:"size_bytes,omitempty"`
+	ArtifactID ArtifactID `json:"artifact_id,omitempty"`
+}
+
+// PrimaryURL returns the first file's URL, or "" when there are no files.
+func (r *JobResult) PrimaryURL() string {
+	if r == nil || len(r.Files) == 0 {
+		return ""
+	}
+	return r.Files[0].URL
+}
+
+// SetOutput records res as the job's output and keeps Result, which older
+// clients read, pointing at the primary file.
+func (j *Job) SetOutput(res JobResult) {
+	j.Output = &res
+	j.Result = nil
+	if u := res.PrimaryURL(); u != "" {
+		j.Result = &u
+	}
+}
+
+// StructuredResult returns the job's output, deriving it from the plain
+// Result URL for jobs stored before Output existed. It returns nil when the
+// job has no result.
+func (j Job) StructuredResult() *JobResult {
+	if j.Output != nil {
+		return j.Output
+	}
+	if j.Result == nil || strings.TrimSpace(*j.Result) == "" {
+		return nil
+	}
+	return &JobResult{Files: []JobFile{{Name: path.Base(*j.Result), URL: *j.Result}}}
+}
+
+// JobGraph is the dependency DAG around a job: every job reachable through
+// depends_on edges in either direction.
+type JobGraph struct {
+	Root  JobID          `json:"root"`
+	Nodes []JobGraphNode `json:"nodes"`
+	Edges []JobGraphEdge `json:"edges"`
+}
+
+// JobGraphNode is one job in a JobGraph.
+type JobGraphNode struct {
+	ID        JobID     `json:"id"`
+	Status    JobStatus `json:"status"`
+	Image     string    `json:"image,omitempty"`
+	DependsOn []JobID   `json:"depends_on,omitempty"`
+}
+
+// JobGraphEdge points from a dependency to the job waiting on it.
+type JobGraphEdge struct {
+	From JobID `json:"from"`
+	To   JobID `json:"to"`
 }
 
 var (
-	ErrJobNotFound = errors.New("job not found")
+	ErrJobNotFound      = errors.New("job not found")
+	ErrDependencyFailed = errors.New("job dependency did not complete")
 )
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob_StructuredResult(t *testing.T) {
+	assert.Nil(t, Job{}.StructuredResult())
+
+	// Jobs stored before structured output carry only a URL
+	url := "http://k/v1/jobs/job-1/files/result-v1.png"
+	res := Job{Result: &url}.StructuredResult()
+	require.NotNil(t, res)
+	require.Len(t, res.Files, 1)
+	assert.Equal(t, "result-v1.png", res.Files[0].Name)
+	assert.Equal(t, url, res.PrimaryURL())
+
+	var j Job
+	j.SetOutput(JobResult{Summary: "no files"})
+	assert.Nil(t, j.Result)
+	j.SetOutput(JobResult{Files: []JobFile{{Name: "a.txt", URL: "http://k/a.txt"}}})
+	require.NotNil(t, j.Result)
+	assert.Equal(t, "http://k/a.txt", *j.Result)
+	assert.Same(t, j.Output, j.StructuredResult())
+}
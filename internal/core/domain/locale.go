@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Locales the agent's scaffold instructions, messages and built-in persona
+// prompts are available in.
+const (
+	LocaleEnglish    = "en"
+	LocalePortuguese = "pt"
+	LocaleSpanish    = "es"
+)
+
+// DefaultLocale is used when no locale is set and none can be detected.
+const DefaultLocale = LocaleEnglish
+
+// ContextVarLocale is the conversation context variable holding the user's
+// locale, e.g. set by a client from the browser language.
+const ContextVarLocale = "locale"
+
+var localeNames = map[string]string{
+	LocaleEnglish:    "English",
+	LocalePortuguese: "Portuguese",
+	LocaleSpanish:    "Spanish",
+}
+
+// ParseLocale normalizes a language tag ("pt-BR", "es_ES", "EN") to a
+// supported locale. An empty tag gives "" (not set).
+func ParseLocale(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" {
+		return "", nil
+	}
+	lang, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	if _, ok := localeNames[lang]; !ok {
+		return "", fmt.Errorf("unsupported locale %q (want en, pt or es)", tag)
+	}
+	return lang, nil
+}
+
+// LocaleName is the English name of a supported locale's language.
+func LocaleName(locale string) string {
+	if name, ok := localeNames[locale]; ok {
+		return name
+	}
+	return localeNames[DefaultLocale]
+}
+
+// Common short words of each language. Detection counts them, so it only
+// needs to tell the supported languages apart, not identify any language.
+var localeMarkers = map[string][]string{
+	LocaleEnglish: {
+		"the", "and", "is", "are", "you", "what", "how", "can", "please", "with",
+		"this", "that", "for", "my", "me", "of", "to", "hello", "hi", "thanks",
+	},
+	LocalePortuguese: {
+		"o", "os", "as", "não", "você", "voce", "que", "como", "é", "um", "uma",
+		"meu", "minha", "obrigado", "obrigada", "olá", "ola", "oi", "por", "favor",
+		"isso", "para", "com", "do", "da", "em", "está", "qual", "quero",
+	},
+	LocaleSpanish: {
+		"el", "los", "las", "no", "usted", "tú", "qué", "que", "cómo", "como", "es",
+		"un", "una", "mi", "gracias", "hola", "por", "favor", "eso", "para", "con",
+		"del", "en", "está", "cuál", "quiero", "y", "puedes",
+	},
+}
+
+// DetectLocale guesses the supported locale text is written in from its
+// common words. It returns "" when the text gives no clear answer.
+func DetectLocale(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	scores := map[string]int{}
+	for _, w := range words {
+		for locale, markers := range localeMarkers {
+			for _, m := range markers {
+				if w == m {
+					scores[locale]++
+					break
+				}
+			}
+		}
+	}
+	best, bestScore, tie := "", 0, false
+	for _, locale := range []string{LocaleEnglish, LocalePortuguese, LocaleSpanish} {
+		switch n := scores[locale]; {
+		case n > bestScore:
+			best, bestScore, tie = locale, n, false
+		case n == bestScore && n > 0:
+			tie = true
+		}
+	}
+	if tie {
+		return ""
+	}
+	return best
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocale(t *testing.T) {
+	for tag, want := range map[string]string{"": "", "en": "en", "pt-BR": "pt", "es_ES": "es", " PT ": "pt"} {
+		got, err := ParseLocale(tag)
+		require.NoError(t, err, tag)
+		assert.Equal(t, want, got, tag)
+	}
+	_, err := ParseLocale("fr-FR")
+	assert.Error(t, err)
+	assert.Error(t, RuntimeConfig{Locale: "klingon"}.Validate())
+}
+
+func TestDetectLocale(t *testing.T) {
+	assert.Equal(t, LocaleEnglish, DetectLocale("Can you summarize the report for me?"))
+	assert.Equal(t, LocalePortuguese, DetectLocale("Oi! Você pode resumir o relatório para mim?"))
+	assert.Equal(t, LocaleSpanish, DetectLocale("Hola, ¿puedes resumir el informe? Gracias"))
+	assert.Empty(t, DetectLocale("42"))
+}
+
+func TestPersona_PromptFor(t *testing.T) {
+	coder := BuiltinPersonas()[3]
+	assert.Equal(t, coder.SystemPrompt, coder.PromptFor(LocaleEnglish))
+	assert.Contains(t, coder.PromptFor(LocalePortuguese), "Você é o auleOS Coder")
+	assert.Contains(t, coder.PromptFor(LocaleSpanish), "Eres auleOS Coder")
+
+	// An edited built-in prompt, or a custom persona, is kept as written
+	coder.SystemPrompt = "You only write Rust."
+	assert.Equal(t, "You only write Rust.", coder.PromptFor(LocalePortuguese))
+	custom := Persona{SystemPrompt: "Be terse."}
+	assert.Equal(t, "Be terse.", custom.PromptFor(LocaleSpanish))
+}
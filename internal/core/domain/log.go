@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// LogEntry is one kernel log record kept for inspection through the API.
+type LogEntry struct {
+	Time      time.Time              `json:"time"`
+	Level     slog.Level             `json:"level"`
+	Message   string                 `json:"msg"`
+	Component string                 `json:"component,omitempty"` // "component" attr, or the logging type/package
+	RequestID string                 `json:"request_id,omitempty"`
+	Attrs     map[string]interface{} `json:"attrs,omitempty"`
+}
+
+// LogFilter narrows log queries. Zero values mean "no constraint".
+type LogFilter struct {
+	MinLevel  slog.Level // entries at or above this level; the zero value is INFO
+	Component string     // case-insensitive substring, e.g. "scheduler"
+	RequestID string
+	Since     time.Time
+	Limit     int
+}
+
+// Matches reports whether an entry satisfies the filter (Limit is ignored).
+func (f LogFilter) Matches(e LogEntry) bool {
+	if e.Level < f.MinLevel {
+		return false
+	}
+	if f.Component != "" && !strings.Contains(strings.ToLower(e.Component), strings.ToLower(f.Component)) {
+		return false
+	}
+	if f.RequestID != "" && e.RequestID != f.RequestID {
+		return false
+	}
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	return true
+}
@@ -1,5 +1,12 @@
 package domain
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
 // ModelRole classifies models by their primary use case.
 // The orchestrator picks the best model for each sub-agent task based on role.
 type ModelRole string
@@ -13,13 +20,62 @@ const (
 
 // ModelSpec describes a model available in the system, either local (Ollama) or remote (LiteLLM/OpenAI).
 type ModelSpec struct {
-	ID       string    `json:"id"`       // unique key: "qwen2.5:3b", "gpt-4o-mini"
-	Name     string    `json:"name"`     // human-readable: "Qwen 2.5 3B"
-	Provider string    `json:"provider"` // "ollama", "litellm", "openai", "azure"
-	Role     ModelRole `json:"role"`     // primary use case
-	Size     string    `json:"size"`     // parameter count: "3B", "7B", "70B"
-	BaseURL  string    `json:"base_url"` // endpoint override; empty = use provider default
-	IsLocal  bool      `json:"is_local"` // true = Ollama / local inference
+	ID            string    `json:"id"`                       // unique key: "qwen2.5:3b", "gpt-4o-mini"
+	Name          string    `json:"name"`                     // human-readable: "Qwen 2.5 3B"
+	Provider      string    `json:"provider"`                 // "ollama", "litellm", "openai", "azure"
+	Role          ModelRole `json:"role"`                     // primary use case
+	Size          string    `json:"size"`                     // parameter count: "3B", "7B", "70B"
+	BaseURL       string    `json:"base_url"`                 // endpoint override; empty = use provider default
+	IsLocal       bool      `json:"is_local"`                 // true = Ollama / local inference
+	ContextTokens int       `json:"context_tokens,omitempty"` // context window the server runs it with (Ollama num_ctx); 0 = unknown
+
+	// Metadata reported by the model server (Ollama /api/show)
+	MaxContextTokens int    `json:"max_context_tokens,omitempty"` // window the model was trained for
+	Quantization     string `json:"quantization,omitempty"`       // "Q4_K_M", "F16"
+	Family           string `json:"family,omitempty"`             // "llama", "qwen2"
+	Vision           bool   `json:"vision,omitempty"`             // accepts images
+	Tools            bool   `json:"tools,omitempty"`              // native tool calling
+	Embedding        bool   `json:"embedding,omitempty"`          // embedding model, no text generation
+
+	// Pricing in USD per million tokens; zero for local models
+	PromptCostPerMTok     float64 `json:"prompt_cost_per_mtok,omitempty"`
+	CompletionCostPerMTok float64 `json:"completion_cost_per_mtok,omitempty"`
+}
+
+// CostUSD prices a call with the given token counts.
+func (m ModelSpec) CostUSD(promptTokens, completionTokens int) float64 {
+	return (float64(promptTokens)*m.PromptCostPerMTok + float64(completionTokens)*m.CompletionCostPerMTok) / 1e6
+}
+
+// ContextWindow returns the tokens a prompt may use: the configured window
+// when known, else the trained one, except that local models run with the
+// server default (fallback) unless they were trained for less.
+func (m ModelSpec) ContextWindow(fallback int) int {
+	switch {
+	case m.ContextTokens > 0:
+		return m.ContextTokens
+	case m.MaxContextTokens <= 0:
+		return fallback
+	case !m.IsLocal || fallback <= 0 || m.MaxContextTokens < fallback:
+		return m.MaxContextTokens
+	default:
+		return fallback
+	}
+}
+
+// SameModel reports whether two model IDs name the same model, treating a
+// missing tag as ":latest" as Ollama does.
+func SameModel(a, b string) bool {
+	if a == b {
+		return true
+	}
+	tag := func(id string) string {
+		if !strings.Contains(id, ":") {
+			return id + ":latest"
+		}
+		return id
+	}
+	return tag(a) == tag(b)
 }
 
 // RecommendedLocalModels returns small models suitable for local Ollama testing.
@@ -69,3 +125,79 @@ func RecommendedLocalModels() []ModelSpec {
 		},
 	}
 }
+
+// DefaultModelKeepAlive is how long preloaded models stay in memory
+// without use when ModelWarmup sets no keep-alive.
+const DefaultModelKeepAlive = 30 * time.Minute
+
+// ModelWarmup keeps local models loaded, so the first request after a start
+// or a quiet period does not wait for the model to load.
+type ModelWarmup struct {
+	Disabled bool `json:"disabled,omitempty"`
+	// Models to preload; empty = the default chat and embedding models
+	Models []string `json:"models,omitempty"`
+	// KeepAliveMinutes the models stay loaded after each ping; they are
+	// pinged at half that interval. 0 = DefaultModelKeepAlive
+	KeepAliveMinutes int `json:"keep_alive_minutes,omitempty"`
+}
+
+// Validate checks the keep-alive and model names.
+func (w ModelWarmup) Validate() error {
+	if w.KeepAliveMinutes < 0 {
+		return fmt.Errorf("model_warmup keep_alive_minutes must not be negative")
+	}
+	for _, m := range w.Models {
+		if strings.TrimSpace(m) == "" {
+			return fmt.Errorf("model_warmup: empty model name")
+		}
+	}
+	return nil
+}
+
+// KeepAlive returns the effective keep-alive.
+func (w ModelWarmup) KeepAlive() time.Duration {
+	if w.KeepAliveMinutes == 0 {
+		return DefaultModelKeepAlive
+	}
+	return time.Duration(w.KeepAliveMinutes) * time.Minute
+}
+
+// ModelWarmState is where a preloaded model stands.
+type ModelWarmState string
+
+const (
+	ModelWarmLoading ModelWarmState = "loading"
+	ModelWarmReady   ModelWarmState = "warm"
+	ModelWarmFailed  ModelWarmState = "failed"
+)
+
+// ModelWarmStatus reports the preloading of one model.
+type ModelWarmStatus struct {
+	Model      string         `json:"model"`
+	State      ModelWarmState `json:"state"`
+	LoadedAt   time.Time      `json:"loaded_at,omitempty"` // when it last went from cold to warm
+	LoadMillis int64          `json:"load_ms,omitempty"`   // how long that load took
+	LastPingAt time.Time      `json:"last_ping_at,omitempty"`
+	ExpiresAt  time.Time      `json:"expires_at,omitempty"` // unloaded after this without another ping or use
+	Error      string         `json:"error,omitempty"`
+}
+
+var (
+	// ErrModelPullRunning is returned when the model is already being pulled.
+	ErrModelPullRunning = errors.New("model is already being pulled")
+	// ErrInsufficientStorage is returned when a model does not fit on the
+	// disk holding the model store.
+	ErrInsufficientStorage = errors.New("not enough free disk space for the model")
+)
+
+// ModelPullProgress is one progress report of a model download. Total and
+// Completed count the bytes of the layer named by Digest.
+type ModelPullProgress struct {
+	Model     string `json:"model"`
+	Status    string `json:"status"` // "pulling manifest", "downloading", "verifying sha256 digest", "success", ...
+	Digest    string `json:"digest,omitempty"`
+	Total     int64  `json:"total,omitempty"`
+	Completed int64  `json:"completed,omitempty"`
+	// Percent of all layers seen so far; set by the kernel
+	Percent float64 `json:"percent,omitempty"`
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// ModerationAction is what happens to content the moderation pass flags.
+type ModerationAction string
+
+const (
+	ModerationOff   ModerationAction = "off"   // don't check
+	ModerationLog   ModerationAction = "log"   // record the decision on the trace only
+	ModerationWarn  ModerationAction = "warn"  // also flag the reply to the user
+	ModerationBlock ModerationAction = "block" // refuse the message or withhold the answer
+)
+
+// Valid reports whether a is a known action; empty means inherit.
+func (a ModerationAction) Valid() bool {
+	switch a {
+	case "", ModerationOff, ModerationLog, ModerationWarn, ModerationBlock:
+		return true
+	}
+	return false
+}
+
+// ModerationStage is the point in a chat where content is checked.
+type ModerationStage string
+
+const (
+	ModerationStageInput  ModerationStage = "input"  // the user's message
+	ModerationStageOutput ModerationStage = "output" // the agent's final answer
+)
+
+// Moderation classifiers
+const (
+	ModerationClassifierLocal  = "local"  // a Llama Guard model served by the local Ollama
+	ModerationClassifierRemote = "remote" // the remote LLM endpoint's /moderations API
+)
+
+// ModerationPolicy sets the action per stage. Empty actions inherit.
+type ModerationPolicy struct {
+	Input  ModerationAction `json:"input,omitempty"`
+	Output ModerationAction `json:"output,omitempty"`
+}
+
+// Validate checks the actions.
+func (p ModerationPolicy) Validate() error {
+	if !p.Input.Valid() || !p.Output.Valid() {
+		return fmt.Errorf("moderation actions must be off, log, warn or block")
+	}
+	return nil
+}
+
+// ModerationConfig is the kernel-wide moderation pass. With no classifier
+// nothing is checked; projects can override the actions.
+type ModerationConfig struct {
+	Classifier string `json:"classifier,omitempty"` // "local" or "remote"; empty = off
+	Model      string `json:"model,omitempty"`      // classifier model; empty = the classifier's default
+	ModerationPolicy
+}
+
+// Validate checks the classifier and actions.
+func (c ModerationConfig) Validate() error {
+	switch c.Classifier {
+	case "", ModerationClassifierLocal, ModerationClassifierRemote:
+	default:
+		return fmt.Errorf("moderation classifier must be local or remote, got %q", c.Classifier)
+	}
+	return c.ModerationPolicy.Validate()
+}
+
+// Action resolves the action for stage: the project's policy, then the
+// kernel-wide one. Unset means off.
+func (c ModerationConfig) Action(stage ModerationStage, project *ModerationPolicy) ModerationAction {
+	pick := func(p ModerationPolicy) ModerationAction {
+		if stage == ModerationStageOutput {
+			return p.Output
+		}
+		return p.Input
+	}
+	if project != nil {
+		if a := pick(*project); a != "" {
+			return a
+		}
+	}
+	if a := pick(c.ModerationPolicy); a != "" {
+		return a
+	}
+	return ModerationOff
+}
+
+// ModerationResult is a classifier's verdict on one text.
+type ModerationResult struct {
+	Flagged    bool     `json:"flagged"`
+	Categories []string `json:"categories,omitempty"` // flagged categories, e.g. "violence"
+}
+
+// ModerationDecision records one moderation check and what was done.
+type ModerationDecision struct {
+	Stage      ModerationStage  `json:"stage"`
+	Action     ModerationAction `json:"action"`
+	Flagged    bool             `json:"flagged"`
+	Categories []string         `json:"categories,omitempty"`
+	Blocked    bool             `json:"blocked,omitempty"`
+	Error      string           `json:"error,omitempty"` // the classifier failed; content was let through
+}
+
+// Moderator classifies text for unsafe content.
+type Moderator interface {
+	Moderate(ctx context.Context, text string) (ModerationResult, error)
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// NodeID uniquely identifies a muscle node (a host able to run Docker workers)
+type NodeID string
+
+// LocalNodeID is the implicit node backed by the kernel's own Docker daemon
+const LocalNodeID NodeID = "local"
+
+// NodeStatus reflects the last known reachability of a node
+type NodeStatus string
+
+const (
+	NodeStatusUnknown NodeStatus = "unknown"
+	NodeStatusOnline  NodeStatus = "online"
+	NodeStatusOffline NodeStatus = "offline"
+)
+
+// Node is a remote muscle node — another auleOS kernel or a thin agent exposing
+// the WorkerManager API over mTLS. Jobs are placed on nodes by matching labels.
+type Node struct {
+	ID        NodeID            `json:"id"`
+	Name      string            `json:"name"`
+	URL       string            `json:"url"`    // e.g. "https://gpu-box:8443"
+	Labels    map[string]string `json:"labels"` // e.g. {"gpu": "true", "region": "eu"}
+	Status    NodeStatus        `json:"status"`
+	LastSeen  *time.Time        `json:"last_seen,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+var (
+	ErrNodeNotFound   = errors.New("node not found")
+	ErrNoMatchingNode = errors.New("no online node matches the node selector")
+)
+
+// Matches reports whether the node carries every label in selector.
+// An empty selector matches any node.
+func (n Node) Matches(selector map[string]string) bool {
+	for k, v := range selector {
+		if n.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// NewNodeID generates a compact random node ID (node-<12 hex>)
+func NewNodeID() NodeID {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return NodeID("node-" + hex.EncodeToString(b))
+}
@@ -12,11 +12,61 @@ type ProjectID string
 
 // Project groups conversations and artifacts under a single workspace context
 type Project struct {
-	ID          ProjectID `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          ProjectID       `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Settings    ProjectSettings `json:"settings"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// ProjectSettings holds per-project defaults applied to conversations created in the project.
+// Zero values mean "inherit the global default".
+type ProjectSettings struct {
+	DefaultPersonaID  *PersonaID `json:"default_persona_id,omitempty"`
+	DefaultModel      string     `json:"default_model,omitempty"`      // e.g. "qwen2.5-coder:3b"
+	AllowedTools      []string   `json:"allowed_tools,omitempty"`      // empty = all tools allowed
+	HeartbeatInterval int        `json:"heartbeat_interval,omitempty"` // seconds; 0 = global interval
+
+	ImageWorkflows []ImageWorkflowTemplate `json:"image_workflows,omitempty"` // ComfyUI templates selectable by image jobs
+
+	MemoryScope MemoryScope `json:"memory_scope,omitempty"` // which long-term memory personas share; empty = shared
+
+	Build *BuildSettings `json:"build,omitempty"` // build/test command for run_build and build loops
+
+	Moderation *ModerationPolicy `json:"moderation,omitempty"` // overrides the kernel-wide moderation actions
+
+	Mounts []WorkspaceMount `json:"mounts,omitempty"` // host directories the file tools can read under mounts/
+}
+
+// MemoryScope decides how personas in a project share long-term memory.
+type MemoryScope string
+
+const (
+	// MemoryScopeShared keeps one MEMORY.md for every persona.
+	MemoryScopeShared MemoryScope = "shared"
+	// MemoryScopePersona gives each persona its own memory file; personas
+	// still read, but no longer write, the shared MEMORY.md.
+	MemoryScopePersona MemoryScope = "persona"
+	// MemoryScopeIsolated gives each persona only its own memory file.
+	MemoryScopeIsolated MemoryScope = "isolated"
+)
+
+// Valid reports whether s is a known scope; empty means shared.
+func (s MemoryScope) Valid() bool {
+	switch s {
+	case "", MemoryScopeShared, MemoryScopePersona, MemoryScopeIsolated:
+		return true
+	}
+	return false
+}
+
+// HeartbeatEvery returns the project's heartbeat interval, or fallback when unset.
+func (s ProjectSettings) HeartbeatEvery(fallback time.Duration) time.Duration {
+	if s.HeartbeatInterval <= 0 {
+		return fallback
+	}
+	return time.Duration(s.HeartbeatInterval) * time.Second
 }
 
 // ArtifactID uniquely identifies an artifact
@@ -16,4 +16,10 @@ type AgentResponse struct {
 	Thought  string     `json:"thought"`
 	ToolCall *ToolCall  `json:"tool_call,omitempty"`
 	Steps    []ReActStep `json:"steps"`
+	// Moderation lists flagged checks the user should see (warn and block)
+	Moderation []ModerationDecision `json:"moderation,omitempty"`
+	// Seed the run sampled with; pass it back to reproduce the answer
+	Seed int64 `json:"seed,omitempty"`
+	// Citations are the tool observations the answer draws on
+	Citations []Citation `json:"citations,omitempty"`
 }
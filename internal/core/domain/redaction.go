@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Built-in redaction detectors.
+const (
+	RedactAPIKeys     = "api_keys"     // provider keys and tokens: sk-..., ghp_..., AKIA..., JWTs, bearer tokens
+	RedactPrivateKeys = "private_keys" // PEM private key blocks
+	RedactPasswords   = "passwords"    // values of password=, secret:, token= ... assignments
+	RedactEmails      = "emails"
+	RedactCardNumbers = "card_numbers" // Luhn-valid payment card numbers
+)
+
+// RedactionDetectors lists the built-in detectors.
+var RedactionDetectors = []string{RedactAPIKeys, RedactPrivateKeys, RedactPasswords, RedactEmails, RedactCardNumbers}
+
+// RedactionPolicy configures the redaction layer that masks secrets and
+// PII in user messages, tool observations and traces. The zero value masks
+// everything the built-in detectors find in prompts sent to remote LLM
+// providers and in stored messages, spans and logs.
+type RedactionPolicy struct {
+	Disabled     bool               `json:"disabled,omitempty"`      // turn redaction off entirely
+	Detectors    []string           `json:"detectors,omitempty"`     // built-in detectors to run; empty = all
+	Patterns     []RedactionPattern `json:"patterns,omitempty"`      // extra expressions to mask
+	LocalPrompts bool               `json:"local_prompts,omitempty"` // also mask prompts sent to local models
+	KeepHistory  bool               `json:"keep_history,omitempty"`  // store messages, spans and logs unmasked
+}
+
+// RedactionPattern is a custom detector. Matches are replaced by
+// [REDACTED:<name>].
+type RedactionPattern struct {
+	Name  string `json:"name"`
+	Regex string `json:"regex"`
+}
+
+// Validate checks detector names and compiles the custom patterns.
+func (p RedactionPolicy) Validate() error {
+	for _, d := range p.Detectors {
+		known := false
+		for _, k := range RedactionDetectors {
+			known = known || d == k
+		}
+		if !known {
+			return fmt.Errorf("redaction: unknown detector %q", d)
+		}
+	}
+	names := map[string]bool{}
+	for _, pat := range p.Patterns {
+		if pat.Name == "" || names[pat.Name] {
+			return fmt.Errorf("redaction: pattern name must be unique and non-empty: %q", pat.Name)
+		}
+		names[pat.Name] = true
+		re, err := regexp.Compile(pat.Regex)
+		if err != nil {
+			return fmt.Errorf("redaction: pattern %s: %w", pat.Name, err)
+		}
+		if re.MatchString("") {
+			return fmt.Errorf("redaction: pattern %s matches the empty string", pat.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "errors"
+
+// ErrTraceNotReplayable is returned for traces that are not agent chat runs
+// with a stored answer (workflows, runs whose conversation was deleted...).
+var ErrTraceNotReplayable = errors.New("trace cannot be replayed")
+
+// ReplayRun is one execution of a recorded agent run.
+type ReplayRun struct {
+	TraceID  TraceID     `json:"trace_id,omitempty"`
+	Model    string      `json:"model,omitempty"` // empty = the default model
+	Seed     int64       `json:"seed,omitempty"`  // sampling seed; 0 = not recorded
+	Response string      `json:"response"`
+	Steps    []ReActStep `json:"steps"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// TraceReplay compares a recorded agent run with a re-execution of the same
+// user message and history against another model. Tool calls in the replay
+// are answered with the observations recorded in the original run.
+type TraceReplay struct {
+	Prompt         string         `json:"prompt"`          // the user message of the run
+	ConversationID ConversationID `json:"conversation_id"` // scratch conversation the replay ran in
+	Original       ReplayRun      `json:"original"`
+	Replay         ReplayRun      `json:"replay"`
+	// Tool calls with no matching recorded observation; they were answered
+	// with an error
+	UnmatchedToolCalls int `json:"unmatched_tool_calls"`
+}
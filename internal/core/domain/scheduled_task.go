@@ -1,6 +1,12 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// ErrScheduledTaskNotFound is returned for unknown scheduled task IDs.
+var ErrScheduledTaskNotFound = errors.New("scheduled task not found")
 
 // ScheduledTaskID is the unique identifier for a scheduled task
 type ScheduledTaskID string
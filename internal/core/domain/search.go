@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSearchRateLimited is returned when a search backend's per-minute budget is spent.
+var ErrSearchRateLimited = errors.New("search backend rate limit reached")
+
+// SearchResult is one web search hit.
+type SearchResult struct {
+	Title   string `json:"title"`
+	Link    string `json:"link"`
+	Snippet string `json:"snippet"`
+}
+
+// SearchProvider runs web searches. count <= 0 means the provider's default.
+type SearchProvider interface {
+	Search(ctx context.Context, query string, count int) ([]SearchResult, error)
+}
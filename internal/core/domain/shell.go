@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrShellSessionNotFound = errors.New("no open shell session")
+
+// ShellSpec describes the sandbox a persistent shell session runs in.
+type ShellSpec struct {
+	Image        string            `json:"image"`
+	WorkspaceDir string            `json:"workspace_dir"` // host dir mounted at /workspace
+	Env          map[string]string `json:"env,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+}
+
+// ShellSessionInfo describes an open shell session.
+type ShellSessionInfo struct {
+	ConversationID ConversationID `json:"conversation_id"`
+	Image          string         `json:"image"`
+	Cwd            string         `json:"cwd"`
+	Commands       int            `json:"commands"`
+	StartedAt      time.Time      `json:"started_at"`
+	LastUsedAt     time.Time      `json:"last_used_at"`
+}
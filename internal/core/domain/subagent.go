@@ -3,9 +3,12 @@ package domain
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"time"
 )
 
+var ErrSubAgentNotFound = errors.New("sub-agent not found")
+
 // SubAgentID uniquely identifies a sub-agent execution
 type SubAgentID string
 
@@ -44,6 +47,45 @@ type SubAgentTask struct {
 	FinishedAt     *time.Time     `json:"finished_at,omitempty"`
 }
 
+// SubAgentNode is a SubAgentTask with its delegated children, for tree inspection.
+type SubAgentNode struct {
+	SubAgentTask
+	Children []SubAgentNode `json:"children"`
+}
+
+// BuildSubAgentTree arranges tasks into a forest by ParentID.
+// Tasks whose parent is not in the list become roots. Order follows the input.
+func BuildSubAgentTree(tasks []SubAgentTask) []SubAgentNode {
+	known := make(map[SubAgentID]bool, len(tasks))
+	children := make(map[SubAgentID][]SubAgentTask)
+	for _, t := range tasks {
+		known[t.ID] = true
+	}
+	var roots []SubAgentTask
+	for _, t := range tasks {
+		if t.ParentID != "" && known[t.ParentID] && t.ParentID != t.ID {
+			children[t.ParentID] = append(children[t.ParentID], t)
+		} else {
+			roots = append(roots, t)
+		}
+	}
+
+	var build func(t SubAgentTask) SubAgentNode
+	build = func(t SubAgentTask) SubAgentNode {
+		node := SubAgentNode{SubAgentTask: t, Children: []SubAgentNode{}}
+		for _, c := range children[t.ID] {
+			node.Children = append(node.Children, build(c))
+		}
+		return node
+	}
+
+	out := make([]SubAgentNode, 0, len(roots))
+	for _, r := range roots {
+		out = append(out, build(r))
+	}
+	return out
+}
+
 // SubAgentEvent is emitted on the EventBus so the UI can show sub-agent activity in real time.
 type SubAgentEvent struct {
 	SubAgentID     SubAgentID     `json:"sub_agent_id"`
@@ -62,7 +104,36 @@ type SubAgentEvent struct {
 // DelegateRequest is the structured input to the "delegate" tool.
 // The orchestrator LLM outputs this JSON as Action Input when it wants to spawn sub-agents.
 type DelegateRequest struct {
-	Tasks []DelegateTaskSpec `json:"tasks"`
+	Tasks         []DelegateTaskSpec `json:"tasks"`
+	SharedContext bool               `json:"shared_context,omitempty"` // sub-agents share a read/write scratchpad
+	Aggregate     bool               `json:"aggregate,omitempty"`      // synthesize outputs into one cited answer
+	Goal          string             `json:"goal,omitempty"`           // overall objective used by the aggregation step
+}
+
+// ScratchpadEntry is one key in the shared scratchpad of a delegation.
+type ScratchpadEntry struct {
+	Key         string     `json:"key"`
+	Value       string     `json:"value"`
+	SubAgentID  SubAgentID `json:"sub_agent_id"` // last writer
+	PersonaName string     `json:"persona_name"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// DelegationSource maps a citation marker in an aggregated answer to the sub-agent that produced it.
+type DelegationSource struct {
+	Ref         string         `json:"ref"` // "[1]", "[2]", ...
+	SubAgentID  SubAgentID     `json:"sub_agent_id"`
+	PersonaName string         `json:"persona_name"`
+	Status      SubAgentStatus `json:"status"`
+}
+
+// DelegationResult is the outcome of a delegation: per-task results plus the
+// optional shared scratchpad and aggregated answer.
+type DelegationResult struct {
+	Tasks      []SubAgentTask     `json:"tasks"`
+	Scratchpad []ScratchpadEntry  `json:"scratchpad,omitempty"`
+	Answer     string             `json:"answer,omitempty"`
+	Sources    []DelegationSource `json:"sources,omitempty"`
 }
 
 // DelegateTaskSpec describes one sub-task to delegate.
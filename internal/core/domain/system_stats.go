@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+// CPUStats is host CPU usage. UsagePercent covers the time since the
+// previous sample; it is 0 on the first one.
+type CPUStats struct {
+	Cores        int     `json:"cores"`
+	UsagePercent float64 `json:"usage_percent"`
+	Load1        float64 `json:"load_1"`
+	Load5        float64 `json:"load_5"`
+	Load15       float64 `json:"load_15"`
+}
+
+// MemoryStats is host memory usage.
+type MemoryStats struct {
+	TotalBytes     uint64  `json:"total_bytes"`
+	AvailableBytes uint64  `json:"available_bytes"`
+	UsedBytes      uint64  `json:"used_bytes"`
+	UsedPercent    float64 `json:"used_percent"`
+}
+
+// DiskStats is usage of the filesystem holding Path.
+type DiskStats struct {
+	Label       string  `json:"label"` // "workspace", "database"
+	Path        string  `json:"path"`
+	TotalBytes  uint64  `json:"total_bytes"`
+	FreeBytes   uint64  `json:"free_bytes"`
+	UsedBytes   uint64  `json:"used_bytes"`
+	UsedPercent float64 `json:"used_percent"`
+}
+
+// ContainerRuntimeStats describes the container daemon (Docker).
+type ContainerRuntimeStats struct {
+	Available         bool   `json:"available"`
+	Error             string `json:"error,omitempty"`
+	Version           string `json:"version,omitempty"`
+	Containers        int    `json:"containers"`
+	ContainersRunning int    `json:"containers_running"`
+	ContainersPaused  int    `json:"containers_paused"`
+	ContainersStopped int    `json:"containers_stopped"`
+	Images            int    `json:"images"`
+	CPUs              int    `json:"cpus"`
+	MemoryBytes       int64  `json:"memory_bytes"`
+}
+
+// SystemStats is one sample of host and runtime resources.
+// Sections that could not be read are nil and explained in Errors.
+type SystemStats struct {
+	SampledAt   time.Time              `json:"sampled_at"`
+	CPU         *CPUStats              `json:"cpu,omitempty"`
+	Memory      *MemoryStats           `json:"memory,omitempty"`
+	Disks       []DiskStats            `json:"disks"`
+	DBSizeBytes int64                  `json:"db_size_bytes"`
+	Docker      *ContainerRuntimeStats `json:"docker,omitempty"`
+	Warnings    []string               `json:"warnings"`
+	Errors      map[string]string      `json:"errors,omitempty"`
+}
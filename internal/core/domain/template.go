@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// TemplateID identifies a gallery template, e.g. "daily-digest".
+type TemplateID string
+
+// TemplateSource says where a template comes from.
+type TemplateSource string
+
+const (
+	TemplateSourceBuiltin   TemplateSource = "builtin"
+	TemplateSourceCommunity TemplateSource = "community" // loaded from the templates directory
+)
+
+var (
+	ErrTemplateNotFound = errors.New("template not found")
+	ErrInvalidTemplate  = errors.New("invalid template")
+)
+
+var templateIDRe = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,63}$`)
+
+// Template is a starter bundle of personas and workflow definitions that
+// can be installed with one call. Workflow steps reference the template's
+// personas or the built-in ones by ID.
+type Template struct {
+	ID          TemplateID         `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Source      TemplateSource     `json:"source"`
+	Personas    []Persona          `json:"personas,omitempty"`
+	Workflows   []TemplateWorkflow `json:"workflows,omitempty"`
+}
+
+// TemplateWorkflow is a workflow definition; installing it creates a
+// pending workflow the user runs (or schedules) later.
+type TemplateWorkflow struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Steps       []WorkflowStep `json:"steps"`
+	State       map[string]any `json:"state,omitempty"` // initial {{state.x}} values, e.g. the topic
+}
+
+// TemplateInstall reports what installing a template created. Personas
+// that already existed are reused and listed too.
+type TemplateInstall struct {
+	TemplateID TemplateID   `json:"template_id"`
+	ProjectID  *ProjectID   `json:"project_id,omitempty"`
+	Personas   []PersonaID  `json:"personas"`
+	Workflows  []WorkflowID `json:"workflows"`
+}
+
+// Validate checks the template is installable: a valid ID, something to
+// install, and workflow steps whose dependencies and personas resolve.
+func (t Template) Validate() error {
+	if !templateIDRe.MatchString(string(t.ID)) {
+		return fmt.Errorf("%w: id %q must be lowercase letters, digits and '-'", ErrInvalidTemplate, t.ID)
+	}
+	if t.Name == "" {
+		return fmt.Errorf("%w: %s: name is required", ErrInvalidTemplate, t.ID)
+	}
+	if len(t.Personas) == 0 && len(t.Workflows) == 0 {
+		return fmt.Errorf("%w: %s: no personas or workflows", ErrInvalidTemplate, t.ID)
+	}
+
+	personas := map[PersonaID]bool{}
+	for _, p := range BuiltinPersonas() {
+		personas[p.ID] = true
+	}
+	for _, p := range t.Personas {
+		if p.ID == "" || p.Name == "" || p.SystemPrompt == "" {
+			return fmt.Errorf("%w: %s: personas need an id, name and system_prompt", ErrInvalidTemplate, t.ID)
+		}
+		if _, err := ParseLocale(p.Locale); err != nil {
+			return fmt.Errorf("%w: %s: persona %s: %v", ErrInvalidTemplate, t.ID, p.ID, err)
+		}
+		personas[p.ID] = true
+	}
+
+	for _, wf := range t.Workflows {
+		if wf.Name == "" || len(wf.Steps) == 0 {
+			return fmt.Errorf("%w: %s: workflows need a name and steps", ErrInvalidTemplate, t.ID)
+		}
+		steps := map[string]bool{}
+		for _, s := range wf.Steps {
+			if s.ID == "" || s.Prompt == "" || steps[s.ID] {
+				return fmt.Errorf("%w: %s: workflow %q: steps need a unique id and a prompt", ErrInvalidTemplate, t.ID, wf.Name)
+			}
+			if s.PersonaID != "" && !personas[s.PersonaID] {
+				return fmt.Errorf("%w: %s: workflow %q: step %s uses unknown persona %s", ErrInvalidTemplate, t.ID, wf.Name, s.ID, s.PersonaID)
+			}
+			steps[s.ID] = true
+		}
+		// Dependencies must point to earlier steps, which also rules out cycles
+		seen := map[string]bool{}
+		for _, s := range wf.Steps {
+			for _, dep := range s.DependsOn {
+				if !seen[dep] {
+					return fmt.Errorf("%w: %s: workflow %q: step %s depends on %q, which is not an earlier step", ErrInvalidTemplate, t.ID, wf.Name, s.ID, dep)
+				}
+			}
+			seen[s.ID] = true
+		}
+	}
+	return nil
+}
+
+// BuiltinTemplates returns the curated starter templates shipped with the
+// kernel.
+func BuiltinTemplates() []Template {
+	return []Template{
+		{
+			ID:          "daily-digest",
+			Name:        "Daily digest",
+			Description: "Collects news on your topics and writes a short morning briefing. Schedule the workflow to run every day.",
+			Source:      TemplateSourceBuiltin,
+			Personas: []Persona{{
+				ID:          "pers-tpl-editor",
+				Name:        "Editor",
+				Description: "Turns raw notes and headlines into a tight, readable briefing.",
+				SystemPrompt: `You are auleOS Editor — you write short, skimmable briefings.
+- Lead with what changed and why it matters
+- Group related items, drop duplicates and filler
+- Keep each item to one or two sentences and link the source
+- End with anything that needs the reader's attention today`,
+				Icon:  "newspaper",
+				Color: "sky",
+			}},
+			Workflows: []TemplateWorkflow{{
+				Name:        "Daily digest",
+				Description: "Search the topics, then write the briefing to digests/.",
+				State:       map[string]any{"topics": "AI, open source, self-hosting"},
+				Steps: []WorkflowStep{
+					{
+						ID:        "gather",
+						PersonaID: "pers-researcher",
+						Prompt:    "Search the web for news from the last 24 hours about: {{state.topics}}. List the most relevant items with title, one-line summary and URL.",
+						Tools:     []string{"web_search", "web_fetch"},
+						MaxIters:  8,
+					},
+					{
+						ID:        "write",
+						PersonaID: "pers-tpl-editor",
+						Prompt:    "Write today's briefing from these items, grouped by topic:\n\n{{state.gather}}\n\nSave it with write_file to digests/ named after today's date, then reply with the briefing.",
+						Tools:     []string{"write_file"},
+						DependsOn: []string{"gather"},
+					},
+				},
+			}},
+		},
+		{
+			ID:          "repo-triage",
+			Name:        "Repository triage",
+			Description: "Reviews the project workspace: summarizes the code layout, runs the build and lists what needs fixing first.",
+			Source:      TemplateSourceBuiltin,
+			Personas: []Persona{{
+				ID:          "pers-tpl-triager",
+				Name:        "Triager",
+				Description: "Sorts problems by impact and effort; never edits source code itself.",
+				SystemPrompt: `You are auleOS Triager — you assess a codebase and prioritize work.
+- Read before judging: list the layout, open the key files
+- Separate bugs, risks and cleanup; rank by impact, then effort
+- Quote file paths and line numbers for every finding
+- Do not modify source files; recommend changes instead`,
+				Icon:  "list-checks",
+				Color: "orange",
+			}},
+			Workflows: []TemplateWorkflow{{
+				Name:        "Repository triage",
+				Description: "Survey the workspace and the build, then write a prioritized TRIAGE.md.",
+				Steps: []WorkflowStep{
+					{
+						ID:        "survey",
+						PersonaID: "pers-tpl-triager",
+						Prompt:    "Survey the project workspace: list the directories, read the README and entry points, and summarize the architecture and main components.",
+						Tools:     []string{"list_dir", "read_file"},
+						MaxIters:  10,
+					},
+					{
+						ID:        "build",
+						PersonaID: "pers-coder",
+						Prompt:    "Run the project's build and tests with run_build and summarize every failure with its file and cause.",
+						Tools:     []string{"run_build", "read_file"},
+					},
+					{
+						ID:        "report",
+						PersonaID: "pers-tpl-triager",
+						Prompt:    "Architecture:\n{{state.survey}}\n\nBuild:\n{{state.build}}\n\nWrite TRIAGE.md with write_file: the top issues ranked by impact, each with location, cause and suggested fix.",
+						Tools:     []string{"write_file"},
+						DependsOn: []string{"survey", "build"},
+						Interrupt: &InterruptRule{Before: true, Message: "Review the findings before the triage report is written."},
+					},
+				},
+			}},
+		},
+		{
+			ID:          "research-report",
+			Name:        "Research and report",
+			Description: "Researches a question from several sources and writes a cited report.",
+			Source:      TemplateSourceBuiltin,
+			Workflows: []TemplateWorkflow{{
+				Name:        "Research and report",
+				Description: "Plan, research and write a cited report on the question in state.",
+				State:       map[string]any{"question": "What are the trade-offs of running LLMs locally?"},
+				Steps: []WorkflowStep{
+					{
+						ID:        "plan",
+						PersonaID: "pers-researcher",
+						Prompt:    "Break this question into 3 to 5 sub-questions worth researching: {{state.question}}",
+					},
+					{
+						ID:        "research",
+						PersonaID: "pers-researcher",
+						Prompt:    "Research each sub-question with web_search and web_fetch, noting facts with their source URLs:\n\n{{state.plan}}",
+						Tools:     []string{"web_search", "web_fetch"},
+						DependsOn: []string{"plan"},
+						MaxIters:  12,
+					},
+					{
+						ID:        "report",
+						PersonaID: "pers-researcher",
+						Prompt:    "Write a structured report answering {{state.question}} from these notes, citing sources inline:\n\n{{state.research}}\n\nSave it to reports/ with write_file and reply with a summary.",
+						Tools:     []string{"write_file"},
+						DependsOn: []string{"research"},
+					},
+				},
+			}},
+		},
+	}
+}
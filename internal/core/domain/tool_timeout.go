@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Tool timeout defaults and limits.
+const (
+	DefaultToolTimeout = 2 * time.Minute  // tools that declare none
+	MaxToolTimeout     = 30 * time.Minute // cap on per-call overrides
+	// toolTimeoutGrace lets a tool that enforces its own timeout_seconds
+	// report the timeout itself before the registry gives up on it.
+	toolTimeoutGrace = 5 * time.Second
+)
+
+// ErrToolTimeout is returned when a tool call runs past its timeout. The
+// call's context is cancelled; a tool that ignores it is abandoned.
+var ErrToolTimeout = errors.New("tool timed out")
+
+// ToolTimeouts configures tool timeouts kernel-wide. Per-tool entries
+// override the timeout a tool declares.
+type ToolTimeouts struct {
+	Default time.Duration            // tools that declare none; 0 = DefaultToolTimeout
+	PerTool map[string]time.Duration // full name → timeout
+}
+
+// toolTimeoutConfig is shared by a registry and the filtered views and
+// clones made from it, so configuration changes reach them all.
+type toolTimeoutConfig struct {
+	mu  sync.RWMutex
+	cfg ToolTimeouts
+}
+
+type toolTimeoutKey struct{}
+
+// ContextWithToolTimeout overrides the timeout of the tool calls made with
+// ctx, e.g. from an API request. It is capped at MaxToolTimeout.
+func ContextWithToolTimeout(ctx context.Context, d time.Duration) context.Context {
+	if d <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, toolTimeoutKey{}, min(d, MaxToolTimeout))
+}
+
+// SetTimeouts replaces the timeout configuration.
+func (r *ToolRegistry) SetTimeouts(cfg ToolTimeouts) {
+	perTool := make(map[string]time.Duration, len(cfg.PerTool))
+	for name, d := range cfg.PerTool {
+		if d > 0 {
+			perTool[name] = d
+		}
+	}
+	cfg.PerTool = perTool
+	r.timeouts.mu.Lock()
+	r.timeouts.cfg = cfg
+	r.timeouts.mu.Unlock()
+}
+
+// TimeoutFor returns the timeout of one call, from the first of: the
+// context override, the call's timeout_seconds param (for tools that take
+// one, plus a grace period), the configured per-tool timeout, the tool's
+// own, the configured default.
+func (r *ToolRegistry) TimeoutFor(ctx context.Context, tool *Tool, params map[string]interface{}) time.Duration {
+	if d, ok := ctx.Value(toolTimeoutKey{}).(time.Duration); ok {
+		return d
+	}
+	if _, declared := tool.Parameters.Properties["timeout_seconds"]; declared {
+		if s, ok := params["timeout_seconds"].(float64); ok && s > 0 {
+			return min(time.Duration(s*float64(time.Second))+toolTimeoutGrace, MaxToolTimeout)
+		}
+	}
+
+	r.timeouts.mu.RLock()
+	cfg := r.timeouts.cfg
+	d, ok := cfg.PerTool[tool.FullName()]
+	r.timeouts.mu.RUnlock()
+	switch {
+	case ok:
+		return d
+	case tool.Timeout > 0:
+		return tool.Timeout
+	case cfg.Default > 0:
+		return cfg.Default
+	}
+	return DefaultToolTimeout
+}
+
+// executeWithTimeout runs the tool and waits for it at most timeout. The
+// tool runs on its own goroutine so one that ignores cancellation cannot
+// block the caller; a panic becomes an error.
+func executeWithTimeout(ctx context.Context, tool *Tool, params map[string]interface{}, timeout time.Duration) (interface{}, error) {
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type outcome struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- outcome{err: fmt.Errorf("tool %s panicked: %v", tool.FullName(), p)}
+			}
+		}()
+		result, err := tool.Execute(callCtx, params)
+		done <- outcome{result, err}
+	}()
+
+	select {
+	case o := <-done:
+		if o.err != nil && ctx.Err() == nil && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("%w: %s after %s: %v", ErrToolTimeout, tool.FullName(), timeout, o.err)
+		}
+		return o.result, o.err
+	case <-callCtx.Done():
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s did not finish within %s", ErrToolTimeout, tool.FullName(), timeout)
+	}
+}
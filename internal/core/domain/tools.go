@@ -2,8 +2,12 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 )
 
 // ExecType identifies how a tool is executed.
@@ -18,13 +22,59 @@ const (
 	ExecDocker ExecType = "docker"
 )
 
+// Tool namespaces for tools that do not ship with the kernel
+const (
+	ToolNamespaceForge  = "forge"  // created at runtime by the Tool Forge
+	ToolNamespacePlugin = "plugin" // Synapse Wasm plugins loaded from disk
+	ToolNamespaceMCP    = "mcp"    // tools of MCP servers
+)
+
+// ErrToolNameConflict is returned when a tool or alias name is already taken.
+var ErrToolNameConflict = errors.New("tool name conflict")
+
+// ErrKVKeyNotFound is returned when a plugin reads state it never stored.
+var ErrKVKeyNotFound = errors.New("key not found")
+
+// ErrFileLocked is returned by file tools when another agent kept writing
+// the same file for longer than the lock timeout.
+var ErrFileLocked = errors.New("file is locked")
+
+// ToolCorrection counts how often a tool name the model wrote was
+// fuzzy-matched to a registered tool.
+type ToolCorrection struct {
+	Requested string `json:"requested"`
+	Matched   string `json:"matched"`
+	Applied   int    `json:"applied"`  // the matched tool ran
+	Rejected  int    `json:"rejected"` // strict mode refused the match
+}
+
+// ToolNameMetrics summarises tool-name corrections. Frequent corrections
+// point at prompts or models that get tool names wrong.
+type ToolNameMetrics struct {
+	Strict      bool             `json:"strict"`
+	Applied     int              `json:"applied"`
+	Rejected    int              `json:"rejected"`
+	Corrections []ToolCorrection `json:"corrections"` // most frequent first
+}
+
 // Tool represents an executable capability available to the agent
 type Tool struct {
 	Name          string
+	Namespace     string // "" for built-in tools; see ToolNamespace*
 	Description   string
 	Parameters    ToolParameters
 	Execute       ToolExecutor
-	ExecutionType ExecType // "native", "wasm", or "docker" (default: native)
+	ExecutionType ExecType      // "native", "wasm", or "docker" (default: native)
+	Timeout       time.Duration // per call; 0 = the registry default (see ToolTimeouts)
+}
+
+// FullName is the tool's unique name in a registry: "namespace/name", or
+// just the name for built-in tools.
+func (t *Tool) FullName() string {
+	if t.Namespace == "" {
+		return t.Name
+	}
+	return t.Namespace + "/" + t.Name
 }
 
 // ToolParameters defines the schema for tool inputs
@@ -37,48 +87,279 @@ type ToolParameters struct {
 // ToolExecutor is the function signature for tool execution
 type ToolExecutor func(ctx context.Context, params map[string]interface{}) (interface{}, error)
 
-// ToolRegistry manages available tools
+// ToolChangeKind says how the set of registered tools changed
+type ToolChangeKind string
+
+const (
+	ToolRegistered   ToolChangeKind = "registered"
+	ToolUnregistered ToolChangeKind = "unregistered"
+)
+
+// ToolChange notifies a registry subscriber of an added, replaced or removed
+// tool. Version is the registry's version after the change.
+type ToolChange struct {
+	Kind    ToolChangeKind `json:"kind"`
+	Name    string         `json:"name"`
+	Version uint64         `json:"version"`
+}
+
+// ToolRegistry manages available tools. It is safe for concurrent use:
+// tools can be registered and unregistered at runtime (e.g. by the forge)
+// while agents read and execute them.
+//
+// Tools are keyed by full name. A namespaced tool is also reachable by its
+// short name while that is unambiguous — no built-in tool and no tool of
+// another namespace uses it — so prompts can keep using short names.
+// Explicit aliases set with Alias take precedence.
 type ToolRegistry struct {
-	tools map[string]*Tool
+	mu        sync.RWMutex
+	tools     map[string]*Tool
+	explicit  map[string]string   // alias → full name, set with Alias
+	aliases   map[string]string   // explicit plus unambiguous short names
+	ambiguous map[string][]string // short name → full names sharing it
+	version   uint64
+	timeouts  *toolTimeoutConfig
+
+	subsMu sync.Mutex
+	subs   map[int]func(ToolChange)
+	nextID int
 }
 
 // NewToolRegistry creates a new empty registry
 func NewToolRegistry() *ToolRegistry {
 	return &ToolRegistry{
-		tools: make(map[string]*Tool),
+		tools:     make(map[string]*Tool),
+		explicit:  make(map[string]string),
+		aliases:   make(map[string]string),
+		ambiguous: make(map[string][]string),
+		timeouts:  &toolTimeoutConfig{},
 	}
 }
 
-// Register adds a tool to the registry
+// Register adds a tool to the registry. It fails with ErrToolNameConflict
+// if the full name is taken by another tool or by an explicit alias; use
+// Replace to reload a tool.
 func (r *ToolRegistry) Register(tool *Tool) error {
+	return r.add(tool, false)
+}
+
+// Replace registers a tool, replacing any tool of the same full name (a
+// hot reload).
+func (r *ToolRegistry) Replace(tool *Tool) error {
+	return r.add(tool, true)
+}
+
+func (r *ToolRegistry) add(tool *Tool, replace bool) error {
 	if tool.Name == "" {
 		return fmt.Errorf("tool name cannot be empty")
 	}
-	r.tools[tool.Name] = tool
+	if strings.Contains(tool.Name, "/") || strings.Contains(tool.Namespace, "/") {
+		return fmt.Errorf("tool %q: '/' separates the namespace and cannot be used in names", tool.FullName())
+	}
+	name := tool.FullName()
+
+	r.mu.Lock()
+	if _, ok := r.tools[name]; ok && !replace {
+		r.mu.Unlock()
+		return fmt.Errorf("%w: tool %q is already registered", ErrToolNameConflict, name)
+	}
+	if target, ok := r.explicit[name]; ok {
+		r.mu.Unlock()
+		return fmt.Errorf("%w: %q is an alias of %q", ErrToolNameConflict, name, target)
+	}
+	r.tools[name] = tool
+	r.rebuildAliases()
+	r.version++
+	change := ToolChange{Kind: ToolRegistered, Name: name, Version: r.version}
+	r.mu.Unlock()
+
+	r.notify(change)
 	return nil
 }
 
-// Execute runs a tool with given parameters.
-// If the exact name is not found, it attempts fuzzy matching to handle LLM hallucinated names.
-func (r *ToolRegistry) Execute(ctx context.Context, name string, params map[string]interface{}) (interface{}, error) {
-	tool, ok := r.tools[name]
+// Unregister removes a tool by full name or alias, along with the explicit
+// aliases pointing at it. It reports false if no tool had that name.
+// Executions already running finish normally.
+func (r *ToolRegistry) Unregister(name string) bool {
+	r.mu.Lock()
+	name, ok := r.resolve(name)
 	if !ok {
-		// Fuzzy match: find the closest tool name
-		if match := r.fuzzyMatch(name); match != "" {
-			tool = r.tools[match]
-			// Log the correction for observability
-			fmt.Printf("[tool-fuzzy] corrected %q → %q\n", name, match)
-		} else {
-			return nil, fmt.Errorf("tool not found: %s", name)
+		r.mu.Unlock()
+		return false
+	}
+	delete(r.tools, name)
+	for alias, target := range r.explicit {
+		if target == name {
+			delete(r.explicit, alias)
+		}
+	}
+	r.rebuildAliases()
+	r.version++
+	change := ToolChange{Kind: ToolUnregistered, Name: name, Version: r.version}
+	r.mu.Unlock()
+
+	r.notify(change)
+	return true
+}
+
+// Alias makes alias another name for the tool target (a full name). It
+// fails with ErrToolNameConflict if alias is a tool's full name. An explicit
+// alias overrides an automatic or ambiguous short name.
+func (r *ToolRegistry) Alias(alias, target string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.tools[target]; !ok {
+		return fmt.Errorf("tool not found: %s", target)
+	}
+	if _, ok := r.tools[alias]; ok {
+		return fmt.Errorf("%w: %q is a tool name", ErrToolNameConflict, alias)
+	}
+	r.explicit[alias] = target
+	r.rebuildAliases()
+	r.version++
+	return nil
+}
+
+// Aliases returns every alias with the full name it resolves to.
+func (r *ToolRegistry) Aliases() map[string]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make(map[string]string, len(r.aliases))
+	for alias, target := range r.aliases {
+		out[alias] = target
+	}
+	return out
+}
+
+// Conflicts returns the short names shared by several namespaced tools,
+// with their full names. Such tools are only reachable by full name.
+func (r *ToolRegistry) Conflicts() map[string][]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make(map[string][]string, len(r.ambiguous))
+	for short, names := range r.ambiguous {
+		out[short] = append([]string(nil), names...)
+	}
+	return out
+}
+
+// rebuildAliases recomputes the short-name aliases. The caller holds r.mu
+// for writing.
+func (r *ToolRegistry) rebuildAliases() {
+	byShort := make(map[string][]string)
+	for name, tool := range r.tools {
+		if tool.Namespace != "" {
+			byShort[tool.Name] = append(byShort[tool.Name], name)
+		}
+	}
+	r.aliases = make(map[string]string, len(byShort)+len(r.explicit))
+	r.ambiguous = make(map[string][]string)
+	for short, names := range byShort {
+		if _, builtin := r.tools[short]; builtin {
+			continue // the built-in keeps the short name
 		}
+		if len(names) > 1 {
+			sort.Strings(names)
+			r.ambiguous[short] = names
+			continue
+		}
+		r.aliases[short] = names[0]
+	}
+	for alias, target := range r.explicit {
+		r.aliases[alias] = target
+		delete(r.ambiguous, alias)
 	}
+}
+
+// resolve maps a full name or alias to a full name. The caller holds r.mu.
+func (r *ToolRegistry) resolve(name string) (string, bool) {
+	if _, ok := r.tools[name]; ok {
+		return name, true
+	}
+	if target, ok := r.aliases[name]; ok {
+		return target, true
+	}
+	return "", false
+}
+
+// Version increases with every change to the registered tools, so callers
+// caching a tool list can tell when it is stale.
+func (r *ToolRegistry) Version() uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.version
+}
+
+// Subscribe calls fn after every Register and Unregister, outside the
+// registry's lock. The returned func cancels the subscription. Registries
+// derived with Clone, FilterByNames or Without have no subscribers.
+func (r *ToolRegistry) Subscribe(fn func(ToolChange)) (cancel func()) {
+	r.subsMu.Lock()
+	defer r.subsMu.Unlock()
+	if r.subs == nil {
+		r.subs = make(map[int]func(ToolChange))
+	}
+	id := r.nextID
+	r.nextID++
+	r.subs[id] = fn
+	return func() {
+		r.subsMu.Lock()
+		delete(r.subs, id)
+		r.subsMu.Unlock()
+	}
+}
 
-	return tool.Execute(ctx, params)
+func (r *ToolRegistry) notify(change ToolChange) {
+	r.subsMu.Lock()
+	fns := make([]func(ToolChange), 0, len(r.subs))
+	for _, fn := range r.subs {
+		fns = append(fns, fn)
+	}
+	r.subsMu.Unlock()
+	for _, fn := range fns {
+		fn(change)
+	}
+}
+
+// Execute runs a tool with given parameters, bounded by its timeout (see
+// TimeoutFor). If the exact name is not found, it attempts fuzzy matching
+// to handle LLM hallucinated names.
+func (r *ToolRegistry) Execute(ctx context.Context, name string, params map[string]interface{}) (interface{}, error) {
+	full, _, err := r.Resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	tool, ok := r.tools[full]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("tool not found: %s", name)
+	}
+
+	return executeWithTimeout(ctx, tool, params, r.TimeoutFor(ctx, tool, params))
+}
+
+// Resolve maps a tool name written by the model to a registered full name:
+// the name itself or an alias, else the closest fuzzy match, in which case
+// corrected is true. Ambiguous short names and names without a match fail.
+func (r *ToolRegistry) Resolve(name string) (resolved string, corrected bool, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if full, ok := r.resolve(name); ok {
+		return full, false, nil
+	}
+	if names, ambiguous := r.ambiguous[name]; ambiguous {
+		return "", false, fmt.Errorf("tool name %q is ambiguous, use one of: %s", name, strings.Join(names, ", "))
+	}
+	if match := r.fuzzyMatch(name); match != "" {
+		return match, true, nil
+	}
+	return "", false, fmt.Errorf("tool not found: %s", name)
 }
 
 // fuzzyMatch finds the best matching tool name for a hallucinated/wrong name.
 // It uses word-overlap scoring + Levenshtein distance as tiebreaker.
-// Returns empty string if no reasonable match is found.
+// Returns empty string if no reasonable match is found. The caller holds r.mu.
 func (r *ToolRegistry) fuzzyMatch(input string) string {
 	// Normalize: split by underscore into words
 	inputWords := splitToolWords(input)
@@ -86,7 +367,7 @@ func (r *ToolRegistry) fuzzyMatch(input string) string {
 	bestName := ""
 	bestScore := 0
 
-	for name := range r.tools {
+	for _, name := range r.sortedNames() {
 		nameWords := splitToolWords(name)
 		score := wordOverlapScore(inputWords, nameWords)
 
@@ -111,7 +392,9 @@ func (r *ToolRegistry) fuzzyMatch(input string) string {
 
 func splitToolWords(name string) []string {
 	parts := []string{}
-	for _, p := range strings.Split(strings.ToLower(name), "_") {
+	for _, p := range strings.FieldsFunc(strings.ToLower(name), func(c rune) bool {
+		return c == '_' || c == '/' || c == '.' || c == '-'
+	}) {
 		if p != "" {
 			parts = append(parts, p)
 		}
@@ -160,26 +443,49 @@ func levenshtein(a, b string) int {
 	return prev[lb]
 }
 
-// GetTool returns a tool by name
+// GetTool returns a tool by full name or alias
 func (r *ToolRegistry) GetTool(name string) (*Tool, bool) {
-	tool, ok := r.tools[name]
-	return tool, ok
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	name, ok := r.resolve(name)
+	return r.tools[name], ok
 }
 
-// ListTools returns all registered tools
+// ListTools returns all registered tools, sorted by name
 func (r *ToolRegistry) ListTools() []*Tool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	tools := make([]*Tool, 0, len(r.tools))
-	for _, tool := range r.tools {
-		tools = append(tools, tool)
+	for _, name := range r.sortedNames() {
+		tools = append(tools, r.tools[name])
 	}
 	return tools
 }
 
+// sortedNames returns the tool names in order. The caller holds r.mu.
+func (r *ToolRegistry) sortedNames() []string {
+	names := make([]string, 0, len(r.tools))
+	for name := range r.tools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // FormatToolsForPrompt generates a concise description of available tools for LLM prompt.
 // Uses compact format: name — description (required params) to reduce token usage.
+// Tools and params are listed in a stable order so the prompt only changes
+// when the tools do.
 func (r *ToolRegistry) FormatToolsForPrompt() string {
+	aliases := r.Aliases()
 	result := "Available Tools:\n"
-	for _, tool := range r.tools {
+	for _, tool := range r.ListTools() {
+		// Prefer the short name while it is unambiguous
+		name := tool.FullName()
+		if tool.Namespace != "" && aliases[tool.Name] == name {
+			name = tool.Name
+		}
+
 		// Compact required params list
 		reqParams := ""
 		if len(tool.Parameters.Required) > 0 {
@@ -189,8 +495,14 @@ func (r *ToolRegistry) FormatToolsForPrompt() string {
 		// List all param names with types
 		paramsList := ""
 		if len(tool.Parameters.Properties) > 0 {
-			parts := make([]string, 0, len(tool.Parameters.Properties))
-			for pName, pDef := range tool.Parameters.Properties {
+			pNames := make([]string, 0, len(tool.Parameters.Properties))
+			for pName := range tool.Parameters.Properties {
+				pNames = append(pNames, pName)
+			}
+			sort.Strings(pNames)
+			parts := make([]string, 0, len(pNames))
+			for _, pName := range pNames {
+				pDef := tool.Parameters.Properties[pName]
 				pType := "any"
 				if pm, ok := pDef.(map[string]interface{}); ok {
 					if t, ok := pm["type"].(string); ok {
@@ -208,23 +520,58 @@ func (r *ToolRegistry) FormatToolsForPrompt() string {
 		} else if tool.ExecutionType == ExecDocker {
 			execTag = " [docker]"
 		}
-		result += fmt.Sprintf("- %s%s: %s%s%s\n", tool.Name, execTag, tool.Description, paramsList, reqParams)
+		result += fmt.Sprintf("- %s%s: %s%s%s\n", name, execTag, tool.Description, paramsList, reqParams)
 	}
 	return result
 }
 
 // FilterByNames returns a new ToolRegistry containing only the tools whose names match the given list.
+// Names may be full names or aliases.
 // The new registry shares Tool pointers with the original (same Execute funcs).
 func (r *ToolRegistry) FilterByNames(names []string) *ToolRegistry {
-	allowed := make(map[string]struct{}, len(names))
+	filtered := NewToolRegistry()
+	filtered.timeouts = r.timeouts
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	for _, n := range names {
-		allowed[n] = struct{}{}
+		if name, ok := r.resolve(n); ok {
+			filtered.tools[name] = r.tools[name]
+		}
 	}
-	filtered := NewToolRegistry()
-	for name, tool := range r.tools {
-		if _, ok := allowed[name]; ok {
-			filtered.tools[name] = tool
+	filtered.rebuildAliases()
+	return filtered
+}
+
+// Without returns a new ToolRegistry minus the named tools (full names or aliases).
+func (r *ToolRegistry) Without(names []string) *ToolRegistry {
+	filtered := r.Clone()
+	for _, n := range names {
+		if name, ok := filtered.resolve(n); ok {
+			delete(filtered.tools, name)
+		}
+	}
+	for alias, target := range filtered.explicit {
+		if _, ok := filtered.tools[target]; !ok {
+			delete(filtered.explicit, alias)
 		}
 	}
+	filtered.rebuildAliases()
 	return filtered
 }
+
+// Clone returns a shallow copy of the registry, explicit aliases
+// included, so callers can add scoped tools without mutating the original.
+func (r *ToolRegistry) Clone() *ToolRegistry {
+	clone := NewToolRegistry()
+	clone.timeouts = r.timeouts
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for name, tool := range r.tools {
+		clone.tools[name] = tool
+	}
+	for alias, target := range r.explicit {
+		clone.explicit[alias] = target
+	}
+	clone.rebuildAliases()
+	return clone
+}
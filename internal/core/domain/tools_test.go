@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func echoTool(name string) *Tool {
+	return &Tool{
+		Name: name,
+		Execute: func(_ context.Context, _ map[string]interface{}) (interface{}, error) {
+			return name, nil
+		},
+	}
+}
+
+func TestToolRegistryUnregisterAndNotify(t *testing.T) {
+	r := NewToolRegistry()
+	var changes []ToolChange
+	cancel := r.Subscribe(func(c ToolChange) { changes = append(changes, c) })
+
+	require.NoError(t, r.Register(echoTool("web_search")))
+	require.NoError(t, r.Register(echoTool("read_file")))
+	assert.Equal(t, uint64(2), r.Version())
+	assert.Equal(t, "Available Tools:\n- read_file: \n- web_search: \n", r.FormatToolsForPrompt(), "stable order")
+
+	assert.True(t, r.Unregister("web_search"))
+	assert.False(t, r.Unregister("web_search"))
+	_, ok := r.GetTool("web_search")
+	assert.False(t, ok)
+	_, err := r.Execute(context.Background(), "web_search", nil)
+	assert.Error(t, err, "removed tools are not fuzzy-matched either")
+
+	assert.Equal(t, []ToolChange{
+		{Kind: ToolRegistered, Name: "web_search", Version: 1},
+		{Kind: ToolRegistered, Name: "read_file", Version: 2},
+		{Kind: ToolUnregistered, Name: "web_search", Version: 3},
+	}, changes)
+
+	cancel()
+	require.NoError(t, r.Register(echoTool("write_file")))
+	assert.Len(t, changes, 3)
+
+	// Derived registries are snapshots without subscribers
+	clone := r.Clone()
+	assert.True(t, clone.Unregister("read_file"))
+	_, ok = r.GetTool("read_file")
+	assert.True(t, ok)
+}
+
+func TestToolRegistryConcurrentUse(t *testing.T) {
+	r := NewToolRegistry()
+	require.NoError(t, r.Register(echoTool("base_tool")))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				name := fmt.Sprintf("forged_%d_%d", i, j)
+				_ = r.Register(echoTool(name))
+				r.Unregister(name)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				_, _ = r.Execute(context.Background(), "base_tool", nil)
+				_ = r.FormatToolsForPrompt()
+				_ = r.ListTools()
+				_ = r.FilterByNames([]string{"base_tool"})
+			}
+		}()
+	}
+	wg.Wait()
+
+	tools := r.ListTools()
+	require.Len(t, tools, 1)
+	assert.Equal(t, "base_tool", tools[0].Name)
+	assert.Equal(t, uint64(1+8*50*2), r.Version())
+}
+
+func nsTool(ns, name string) *Tool {
+	t := echoTool(ns + "/" + name)
+	t.Name, t.Namespace = name, ns
+	return t
+}
+
+func TestToolRegistryNamespacesAndAliases(t *testing.T) {
+	r := NewToolRegistry()
+	ctx := context.Background()
+	require.NoError(t, r.Register(echoTool("read_file")))
+	require.NoError(t, r.Register(nsTool(ToolNamespaceForge, "csv_to_json")))
+
+	// Collisions are rejected; Replace is the explicit reload
+	assert.ErrorIs(t, r.Register(nsTool(ToolNamespaceForge, "csv_to_json")), ErrToolNameConflict)
+	require.NoError(t, r.Replace(nsTool(ToolNamespaceForge, "csv_to_json")))
+	assert.Error(t, r.Register(&Tool{Name: "a/b"}))
+
+	// Unambiguous short names are aliases
+	out, err := r.Execute(ctx, "csv_to_json", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "forge/csv_to_json", out)
+	assert.Equal(t, "Available Tools:\n- csv_to_json: \n- read_file: \n", r.FormatToolsForPrompt())
+
+	// A second namespace makes the short name ambiguous
+	require.NoError(t, r.Register(nsTool(ToolNamespaceMCP, "csv_to_json")))
+	_, err = r.Execute(ctx, "csv_to_json", nil)
+	assert.EqualError(t, err, `tool name "csv_to_json" is ambiguous, use one of: forge/csv_to_json, mcp/csv_to_json`)
+	assert.Equal(t, map[string][]string{"csv_to_json": {"forge/csv_to_json", "mcp/csv_to_json"}}, r.Conflicts())
+	assert.Contains(t, r.FormatToolsForPrompt(), "- mcp/csv_to_json: ")
+
+	// Built-ins keep their short name
+	require.NoError(t, r.Register(nsTool(ToolNamespacePlugin, "read_file")))
+	out, err = r.Execute(ctx, "read_file", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "read_file", out)
+
+	// Explicit aliases resolve ambiguity and cannot shadow tools
+	assert.ErrorIs(t, r.Alias("read_file", "mcp/csv_to_json"), ErrToolNameConflict)
+	require.NoError(t, r.Alias("csv_to_json", "mcp/csv_to_json"))
+	out, err = r.Execute(ctx, "csv_to_json", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "mcp/csv_to_json", out)
+	assert.ErrorIs(t, r.Register(echoTool("csv_to_json")), ErrToolNameConflict)
+
+	scoped := r.FilterByNames([]string{"csv_to_json", "forge/csv_to_json"})
+	assert.Len(t, scoped.ListTools(), 2)
+
+	assert.True(t, r.Unregister("csv_to_json"))
+	_, ok := r.GetTool("mcp/csv_to_json")
+	assert.False(t, ok)
+	assert.Equal(t, "forge/csv_to_json", r.Aliases()["csv_to_json"], "explicit alias goes with its tool")
+	out, err = r.Execute(ctx, "csv_to_json", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "forge/csv_to_json", out)
+}
+
+func TestToolRegistryTimeouts(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	r := NewToolRegistry()
+	// hung ignores cancellation; the registry must still return
+	require.NoError(t, r.Register(&Tool{
+		Name:    "hung",
+		Timeout: 20 * time.Millisecond,
+		Execute: func(_ context.Context, _ map[string]interface{}) (interface{}, error) {
+			<-release
+			return nil, nil
+		},
+	}))
+	require.NoError(t, r.Register(&Tool{
+		Name: "waits",
+		Execute: func(ctx context.Context, _ map[string]interface{}) (interface{}, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}))
+	require.NoError(t, r.Register(&Tool{
+		Name: "panics",
+		Execute: func(_ context.Context, _ map[string]interface{}) (interface{}, error) {
+			panic("boom")
+		},
+	}))
+
+	start := time.Now()
+	_, err := r.Execute(context.Background(), "hung", nil)
+	assert.ErrorIs(t, err, ErrToolTimeout)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// Configured per-tool timeouts reach derived registries
+	r.SetTimeouts(ToolTimeouts{PerTool: map[string]time.Duration{"waits": 20 * time.Millisecond}})
+	_, err = r.Clone().Execute(context.Background(), "waits", nil)
+	assert.ErrorIs(t, err, ErrToolTimeout)
+
+	// A per-call override wins over the tool's own timeout
+	ctx := ContextWithToolTimeout(context.Background(), 10*time.Millisecond)
+	tool, _ := r.GetTool("waits")
+	assert.Equal(t, 10*time.Millisecond, r.TimeoutFor(ctx, tool, nil))
+	assert.Equal(t, MaxToolTimeout, r.TimeoutFor(ContextWithToolTimeout(context.Background(), time.Hour), tool, nil))
+
+	// Cancellation by the caller is not reported as a timeout
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = r.FilterByNames([]string{"hung"}).Execute(cancelled, "hung", nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, ErrToolTimeout)
+
+	_, err = r.Execute(context.Background(), "panics", nil)
+	assert.ErrorContains(t, err, "panicked")
+}
+
+func TestToolRegistryTimeoutFor(t *testing.T) {
+	r := NewToolRegistry()
+	plain := echoTool("plain")
+	declared := &Tool{Name: "build", Timeout: 10 * time.Minute}
+	withParam := &Tool{Name: "exec", Parameters: ToolParameters{Properties: map[string]interface{}{"timeout_seconds": map[string]interface{}{}}}}
+	ctx := context.Background()
+
+	assert.Equal(t, DefaultToolTimeout, r.TimeoutFor(ctx, plain, nil))
+	assert.Equal(t, 10*time.Minute, r.TimeoutFor(ctx, declared, nil))
+	assert.Equal(t, 95*time.Second, r.TimeoutFor(ctx, withParam, map[string]interface{}{"timeout_seconds": float64(90)}))
+	assert.Equal(t, DefaultToolTimeout, r.TimeoutFor(ctx, plain, map[string]interface{}{"timeout_seconds": float64(900)}), "only tools that take timeout_seconds")
+
+	r.SetTimeouts(ToolTimeouts{Default: time.Minute, PerTool: map[string]time.Duration{"build": time.Hour}})
+	assert.Equal(t, time.Minute, r.TimeoutFor(ctx, plain, nil))
+	assert.Equal(t, time.Hour, r.TimeoutFor(ctx, declared, nil))
+}
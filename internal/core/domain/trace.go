@@ -1,6 +1,12 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var ErrTraceNotFound = errors.New("trace not found")
 
 // TraceID uniquely identifies a trace (one per agent chat or workflow execution).
 type TraceID string
@@ -18,6 +24,7 @@ const (
 	SpanKindSubAgent SpanKind = "sub_agent" // Delegated sub-agent
 	SpanKindWorkflow SpanKind = "workflow"  // Workflow execution
 	SpanKindStep     SpanKind = "step"      // Workflow step
+	SpanKindGuard    SpanKind = "guard"     // Content moderation check
 )
 
 // SpanStatus indicates completion state of a span.
@@ -59,6 +66,7 @@ type Trace struct {
 	Status         SpanStatus `json:"status"`
 	ConversationID string     `json:"conversation_id,omitempty"`
 	PersonaID      string     `json:"persona_id,omitempty"`
+	RequestID      string     `json:"request_id,omitempty"` // X-Request-ID of the API call that started it
 	StartTime      time.Time  `json:"start_time"`
 	EndTime        *time.Time `json:"end_time,omitempty"`
 	DurationMs     int64      `json:"duration_ms,omitempty"`
@@ -68,10 +76,108 @@ type Trace struct {
 
 // TraceSummary is a lightweight view for listing traces.
 type TraceSummary struct {
-	ID         TraceID    `json:"id"`
-	Name       string     `json:"name"`
-	Status     SpanStatus `json:"status"`
-	StartTime  time.Time  `json:"start_time"`
-	DurationMs int64      `json:"duration_ms"`
-	SpanCount  int        `json:"span_count"`
+	ID             TraceID    `json:"id"`
+	Name           string     `json:"name"`
+	Status         SpanStatus `json:"status"`
+	ConversationID string     `json:"conversation_id,omitempty"`
+	PersonaID      string     `json:"persona_id,omitempty"`
+	RequestID      string     `json:"request_id,omitempty"`
+	StartTime      time.Time  `json:"start_time"`
+	DurationMs     int64      `json:"duration_ms"`
+	SpanCount      int        `json:"span_count"`
+}
+
+// TraceFilter narrows trace listings. Zero values mean "no constraint".
+type TraceFilter struct {
+	Status         SpanStatus
+	ConversationID string
+	PersonaID      string
+	RequestID      string
+	Since          time.Time // traces started at or after
+	Until          time.Time // traces started before
+	MinDurationMs  int64     // only traces that took at least this long
+	Limit          int
+}
+
+// Matches reports whether a summary satisfies the filter (Limit is ignored).
+func (f TraceFilter) Matches(s TraceSummary) bool {
+	if f.Status != "" && s.Status != f.Status {
+		return false
+	}
+	if f.ConversationID != "" && s.ConversationID != f.ConversationID {
+		return false
+	}
+	if f.PersonaID != "" && s.PersonaID != f.PersonaID {
+		return false
+	}
+	if f.RequestID != "" && s.RequestID != f.RequestID {
+		return false
+	}
+	if !f.Since.IsZero() && s.StartTime.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !s.StartTime.Before(f.Until) {
+		return false
+	}
+	if f.MinDurationMs > 0 && s.DurationMs < f.MinDurationMs {
+		return false
+	}
+	return true
+}
+
+// SpanFilter selects spans across traces (e.g. all slow tool calls, failed LLM spans).
+type SpanFilter struct {
+	Kind           SpanKind
+	NamePrefix     string // e.g. "tool." or "llm."
+	Status         SpanStatus
+	MinDurationMs  int64
+	TraceID        TraceID
+	ConversationID string // matched against the owning trace
+	Since          time.Time
+	Until          time.Time
+	Limit          int
+}
+
+// Matches reports whether a span satisfies the filter. ConversationID is
+// checked by callers since it lives on the trace; Limit is ignored.
+func (f SpanFilter) Matches(s Span) bool {
+	if f.Kind != "" && s.Kind != f.Kind {
+		return false
+	}
+	if f.NamePrefix != "" && !strings.HasPrefix(s.Name, f.NamePrefix) {
+		return false
+	}
+	if f.Status != "" && s.Status != f.Status {
+		return false
+	}
+	if f.MinDurationMs > 0 && s.DurationMs < f.MinDurationMs {
+		return false
+	}
+	if f.TraceID != "" && s.TraceID != f.TraceID {
+		return false
+	}
+	if !f.Since.IsZero() && s.StartTime.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !s.StartTime.Before(f.Until) {
+		return false
+	}
+	return true
+}
+
+// ProviderCapture is one raw HTTP exchange with an LLM provider, recorded in
+// record mode and linked to the span that made the call. Bodies are stored
+// as sent and received, with secrets masked.
+type ProviderCapture struct {
+	ID         string    `json:"id"`
+	TraceID    TraceID   `json:"trace_id"`
+	SpanID     SpanID    `json:"span_id"`
+	Method     string    `json:"method"`
+	URL        string    `json:"url"`
+	StatusCode int       `json:"status_code,omitempty"` // 0 = no response
+	Request    string    `json:"request"`
+	Response   string    `json:"response"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at"`
 }
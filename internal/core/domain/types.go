@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 )
 
@@ -29,8 +30,11 @@ type WorkerSpec struct {
 	ResourceMem    int64             `json:"resource_mem"` // in bytes
 	Tags           map[string]string `json:"tags"`
 	BindMounts     map[string]string `json:"bind_mounts"`               // HostPath -> ContainerPath
+	WritableMounts map[string]string `json:"writable_mounts,omitempty"` // HostPath -> ContainerPath, read-write (job output dirs)
 	AgentPrompt    string            `json:"agent_prompt,omitempty"`    // if set, passed as AULE_AGENT_PROMPT env var
 	ReadonlyRootfs bool              `json:"readonly_rootfs,omitempty"` // default false for compatibility
+	NodeSelector   map[string]string `json:"node_selector,omitempty"`   // labels a muscle node must carry; empty = local
+	Security       *WorkerSecurity   `json:"security,omitempty"`        // nil = fully hardened, see WorkerSecurity
 }
 
 // Worker represents a running instance
@@ -43,8 +47,22 @@ type Worker struct {
 	Metadata  map[string]string `json:"metadata"`
 }
 
+// WorkerProgressFile is where a worker reports progress, relative to its
+// /workspace; the path is also passed to workers as AULE_PROGRESS_FILE.
+// Workers overwrite it with a WorkerProgress JSON document as they go.
+const WorkerProgressFile = "progress.json"
+
+// WorkerProgress is a worker's self-reported progress.
+type WorkerProgress struct {
+	Percent int    `json:"progress"`          // 0-100
+	Stage   string `json:"stage,omitempty"`   // e.g. "sampling", "encoding"
+	Message string `json:"message,omitempty"` // relayed as a job log line
+}
+
 var (
-	ErrWorkerNotFound = errors.New("worker not found")
+	ErrWorkerNotFound      = errors.New("worker not found")
+	ErrNoEmbeddingProvider = errors.New("no embedding provider configured")
+	ErrNoProgress          = errors.New("worker has not reported progress")
 )
 
 // ToolCall represents an intent execution by the agent
@@ -58,9 +76,249 @@ type ImageProvider interface {
 	GenerateImage(ctx context.Context, prompt string) (string, error)
 }
 
+// ImageRequest selects where an image job runs. Empty fields mean "any".
+type ImageRequest struct {
+	Backend string // named backend; disables failover to other backends
+	Model   string // model/checkpoint; prefers backends that serve it
+
+	// Workflow is a rendered ComfyUI graph; only workflow-capable backends are used.
+	Workflow   map[string]interface{}
+	OutputNode string // node whose images are the result; empty = first with images
+
+	Options ImageOptions // generation parameters; ignored with a Workflow
+}
+
+// ImageOptions tunes a single generation. Zero fields mean the backend's
+// default; backends ignore what they cannot honour (e.g. steps on OpenAI).
+type ImageOptions struct {
+	NegativePrompt string
+	Width, Height  int
+	Steps          int
+	Seed           *int64
+}
+
+// IsZero reports whether no option is set.
+func (o ImageOptions) IsZero() bool {
+	return o.NegativePrompt == "" && o.Width == 0 && o.Height == 0 && o.Steps == 0 && o.Seed == nil
+}
+
+// ImageBackendHealth is the reachability of one configured image backend.
+type ImageBackendHealth struct {
+	Name    string `json:"name"`
+	Mode    string `json:"mode"`
+	URL     string `json:"url"`
+	Model   string `json:"model"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ImageRouter is an ImageProvider fronting several backends with per-job
+// selection and automatic failover.
+type ImageRouter interface {
+	ImageProvider
+	// GenerateImageWith runs the request and returns the image URL and the backend that produced it.
+	GenerateImageWith(ctx context.Context, prompt string, req ImageRequest) (url string, backend string, err error)
+	// Health probes every backend without generating anything.
+	Health(ctx context.Context) []ImageBackendHealth
+}
+
 // LLMProvider defines the interface for LLM services
 type LLMProvider interface {
 	GenerateText(ctx context.Context, prompt string) (string, error)
 	// GenerateTextWithModel uses a specific model override. If modelID is empty, uses the default.
 	GenerateTextWithModel(ctx context.Context, prompt string, modelID string) (string, error)
+	// GenerateTextStream streams the response as it is generated.
+	GenerateTextStream(ctx context.Context, prompt string) (<-chan Chunk, error)
+	// GenerateTextStreamWithModel streams with a model override. If modelID is empty, uses the default.
+	GenerateTextStreamWithModel(ctx context.Context, prompt string, modelID string) (<-chan Chunk, error)
+}
+
+// EmbeddingProvider turns text into vectors for semantic memory and retrieval.
+// It is configured separately from the chat LLM so a small local embedding
+// model can serve RAG while chat uses a larger one.
+type EmbeddingProvider interface {
+	// Embed returns one vector per input text, in order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	// Model is the embedding model in use; vectors from different models are not comparable.
+	Model() string
+}
+
+// Chunk is one piece of a streamed LLM response. The channel is closed after
+// the chunk with Done set, or after a chunk carrying Err.
+type Chunk struct {
+	Text string
+	Done bool
+	Err  error
+}
+
+// StreamOf returns a closed stream holding text as a single final chunk
+// (for cached answers and providers without native streaming).
+func StreamOf(text string) <-chan Chunk {
+	ch := make(chan Chunk, 1)
+	ch <- Chunk{Text: text, Done: true}
+	close(ch)
+	return ch
+}
+
+// CollectStream drains a stream into the full response text.
+func CollectStream(ch <-chan Chunk) (string, error) {
+	var sb strings.Builder
+	for c := range ch {
+		if c.Err != nil {
+			return sb.String(), c.Err
+		}
+		sb.WriteString(c.Text)
+	}
+	return sb.String(), nil
+}
+
+type deterministicKey struct{}
+
+// WithDeterministic marks LLM calls made with ctx as temperature 0.
+// Providers honor it, and the response cache only serves such calls.
+func WithDeterministic(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deterministicKey{}, true)
+}
+
+// IsDeterministic reports whether ctx requests temperature-0 generation.
+func IsDeterministic(ctx context.Context) bool {
+	v, _ := ctx.Value(deterministicKey{}).(bool)
+	return v
+}
+
+type seedKey struct{}
+
+// WithSeed asks LLM providers to sample with seed, so a generation can be
+// reproduced exactly with the same model and prompt.
+func WithSeed(ctx context.Context, seed int64) context.Context {
+	return context.WithValue(ctx, seedKey{}, seed)
+}
+
+// SeedFrom returns the sampling seed set on ctx.
+func SeedFrom(ctx context.Context) (int64, bool) {
+	seed, ok := ctx.Value(seedKey{}).(int64)
+	return seed, ok
+}
+
+type providerRecorderKey struct{}
+
+// ProviderRecorder receives the raw exchanges of provider calls made with a
+// context carrying it. Providers fill in the exchange; the recorder sets
+// the ID, trace and span.
+type ProviderRecorder func(ProviderCapture)
+
+// WithProviderRecorder asks LLM providers to report their raw HTTP
+// exchanges for calls made with ctx.
+func WithProviderRecorder(ctx context.Context, rec ProviderRecorder) context.Context {
+	return context.WithValue(ctx, providerRecorderKey{}, rec)
+}
+
+// ProviderRecorderFrom returns the recorder set on ctx, or nil.
+func ProviderRecorderFrom(ctx context.Context) ProviderRecorder {
+	rec, _ := ctx.Value(providerRecorderKey{}).(ProviderRecorder)
+	return rec
+}
+
+// RequestPriority orders queued provider requests; lower runs first.
+type RequestPriority int
+
+const (
+	PriorityInteractive RequestPriority = iota // a user is waiting on the answer (chat)
+	PriorityNormal                             // default when unmarked
+	PriorityBackground                         // workflows, cron, heartbeat, spawned agents, evals
+)
+
+func (p RequestPriority) String() string {
+	switch p {
+	case PriorityInteractive:
+		return "interactive"
+	case PriorityBackground:
+		return "background"
+	default:
+		return "normal"
+	}
+}
+
+type priorityKey struct{}
+
+// WithPriority tags provider requests made with ctx.
+func WithPriority(ctx context.Context, p RequestPriority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityFrom returns the request priority of ctx (PriorityNormal if unset).
+func PriorityFrom(ctx context.Context) RequestPriority {
+	if p, ok := ctx.Value(priorityKey{}).(RequestPriority); ok {
+		return p
+	}
+	return PriorityNormal
+}
+
+type subsystemKey struct{}
+
+// WithSubsystem tags work done with ctx with the subsystem that originated it
+// (e.g. "api/jobs", "cron"), so repository metrics can attribute query load.
+func WithSubsystem(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, subsystemKey{}, name)
+}
+
+// SubsystemFrom returns the subsystem tag of ctx ("other" if unset).
+func SubsystemFrom(ctx context.Context) string {
+	if name, ok := ctx.Value(subsystemKey{}).(string); ok && name != "" {
+		return name
+	}
+	return "other"
+}
+
+type requestIDKey struct{}
+
+// WithRequestID tags work done with ctx with the ID of the API request that
+// triggered it, so logs, traces, jobs and events can be correlated.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFrom returns the request ID of ctx ("" if unset).
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// QueryStat aggregates repository query timings for one method and subsystem.
+type QueryStat struct {
+	Method    string  `json:"method"`
+	Subsystem string  `json:"subsystem"`
+	Count     int64   `json:"count"`
+	Errors    int64   `json:"errors"`
+	Slow      int64   `json:"slow"`
+	TotalMs   float64 `json:"total_ms"`
+	AvgMs     float64 `json:"avg_ms"`
+	MaxMs     float64 `json:"max_ms"`
+}
+
+// SlowQuery is one entry of the repository slow-query log.
+type SlowQuery struct {
+	Method     string    `json:"method"`
+	Subsystem  string    `json:"subsystem"`
+	DurationMs float64   `json:"duration_ms"`
+	Query      string    `json:"query"`
+	Error      string    `json:"error,omitempty"`
+	At         time.Time `json:"at"`
+}
+
+// QueryMetrics is a snapshot of repository instrumentation.
+type QueryMetrics struct {
+	SlowThresholdMs float64     `json:"slow_threshold_ms"`
+	Queries         []QueryStat `json:"queries"`     // sorted by total time, descending
+	RecentSlow      []SlowQuery `json:"recent_slow"` // newest first
+}
+
+// MaintenanceStatus reports whether background work and new chats are paused.
+type MaintenanceStatus struct {
+	Paused bool       `json:"paused"`
+	Reason string     `json:"reason,omitempty"`
+	Since  *time.Time `json:"since,omitempty"`
 }
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrUsageLimitNotFound = errors.New("usage limit not found")
+
+// UsageWarnFraction is the share of a limit at which an alert is raised.
+const UsageWarnFraction = 0.8
+
+// UsageScope is what a usage limit applies to
+type UsageScope string
+
+const (
+	UsageScopeConversation UsageScope = "conversation"
+	UsageScopeProject      UsageScope = "project"
+)
+
+// UsageRecord is the accounting of one LLM call. Token counts are the
+// kernel's estimate (see CountTokens); cost comes from the model catalog.
+type UsageRecord struct {
+	ConversationID   ConversationID `json:"conversation_id"`
+	ProjectID        ProjectID      `json:"project_id,omitempty"`
+	Model            string         `json:"model,omitempty"`
+	PromptTokens     int            `json:"prompt_tokens"`
+	CompletionTokens int            `json:"completion_tokens"`
+	CostUSD          float64        `json:"cost_usd"`
+	CreatedAt        time.Time      `json:"created_at"`
+}
+
+// UsageTotals sums the usage of a conversation or project.
+type UsageTotals struct {
+	Calls            int     `json:"calls"`
+	PromptTokens     int     `json:"prompt_tokens"`
+	CompletionTokens int     `json:"completion_tokens"`
+	CostUSD          float64 `json:"cost_usd"`
+}
+
+// Tokens is the total of prompt and completion tokens.
+func (t UsageTotals) Tokens() int {
+	return t.PromptTokens + t.CompletionTokens
+}
+
+// UsageLimit caps the tokens and/or spend of a conversation or project.
+// A zero field is no cap.
+type UsageLimit struct {
+	Scope      UsageScope `json:"scope"`
+	ScopeID    string     `json:"scope_id"`
+	MaxTokens  int        `json:"max_tokens,omitempty"`
+	MaxCostUSD float64    `json:"max_cost_usd,omitempty"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// Fraction returns how much of the limit t uses: the larger of the token
+// and cost shares, 0 when nothing is capped.
+func (l UsageLimit) Fraction(t UsageTotals) float64 {
+	f := 0.0
+	if l.MaxTokens > 0 {
+		f = float64(t.Tokens()) / float64(l.MaxTokens)
+	}
+	if l.MaxCostUSD > 0 {
+		f = max(f, t.CostUSD/l.MaxCostUSD)
+	}
+	return f
+}
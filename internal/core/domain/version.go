@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DevVersion is the version of kernels built without release ldflags.
+const DevVersion = "dev"
+
+// BuildInfo identifies the running kernel build.
+type BuildInfo struct {
+	Version       string `json:"version"`               // release tag, e.g. "v0.9.1", or "dev"
+	GitSHA        string `json:"git_sha,omitempty"`     // commit the kernel was built from
+	Dirty         bool   `json:"dirty,omitempty"`       // built with uncommitted changes
+	BuildDate     string `json:"build_date,omitempty"`  // RFC 3339
+	CommitTime    string `json:"commit_time,omitempty"` // RFC 3339
+	GoVersion     string `json:"go_version"`
+	OS            string `json:"os"`
+	Arch          string `json:"arch"`
+	SchemaVersion int    `json:"schema_version"` // database schema of this build
+}
+
+// ReadBuildInfo combines the values stamped in with -ldflags (which may be
+// empty) with the VCS details the Go toolchain records in the binary.
+func ReadBuildInfo(version, gitSHA, buildDate string) BuildInfo {
+	if version == "" {
+		version = DevVersion
+	}
+	info := BuildInfo{
+		Version:   version,
+		GitSHA:    gitSHA,
+		BuildDate: buildDate,
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range bi.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				if info.GitSHA == "" {
+					info.GitSHA = s.Value
+				}
+			case "vcs.time":
+				info.CommitTime = s.Value
+			case "vcs.modified":
+				info.Dirty = s.Value == "true"
+			}
+		}
+	}
+	return info
+}
+
+// UpdateInfo is the result of comparing the running version with the
+// latest published release.
+type UpdateInfo struct {
+	Current     string    `json:"current"`
+	Latest      string    `json:"latest,omitempty"`
+	Available   bool      `json:"available"` // Latest is newer than Current
+	URL         string    `json:"url,omitempty"`
+	PublishedAt time.Time `json:"published_at,omitempty"`
+	CheckedAt   time.Time `json:"checked_at"`
+	Error       string    `json:"error,omitempty"` // last check failed
+}
+
+// NewerVersion reports whether release version latest is newer than
+// current. Versions are "vMAJOR.MINOR.PATCH" with an optional "-pre"
+// suffix; dev builds and unparsable versions are never outdated.
+func NewerVersion(latest, current string) bool {
+	l, lpre, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+	c, cpre, ok := parseVersion(current)
+	if !ok {
+		return false
+	}
+	for i := range l {
+		if l[i] != c[i] {
+			return l[i] > c[i]
+		}
+	}
+	// 1.2.0 is newer than 1.2.0-rc1
+	return lpre == "" && cpre != ""
+}
+
+func parseVersion(v string) ([3]int, string, bool) {
+	var out [3]int
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	v, pre, _ := strings.Cut(v, "-")
+	v, _, _ = strings.Cut(v, "+")
+	parts := strings.Split(v, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return out, "", false
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return out, "", false
+		}
+		out[i] = n
+	}
+	return out, pre, true
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInspectUnsupported is returned for workers whose runtime cannot be
+// inspected (e.g. a remote node without the inspect API).
+var ErrInspectUnsupported = errors.New("worker runtime does not support inspection")
+
+// WorkerMount is a filesystem mounted into a worker container.
+type WorkerMount struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	ReadOnly    bool   `json:"read_only"`
+}
+
+// WorkerResources is a sample of a running container's resource usage.
+type WorkerResources struct {
+	CPUPercent       float64 `json:"cpu_percent"` // of one core; can exceed 100
+	MemoryBytes      uint64  `json:"memory_bytes"`
+	MemoryLimitBytes uint64  `json:"memory_limit_bytes,omitempty"`
+	PIDs             uint64  `json:"pids"`
+	NetRxBytes       uint64  `json:"net_rx_bytes"`
+	NetTxBytes       uint64  `json:"net_tx_bytes"`
+}
+
+// WorkerInspection is the live container state of a worker as reported by
+// its runtime.
+type WorkerInspection struct {
+	ContainerID   string           `json:"container_id"`
+	Image         string           `json:"image"`
+	State         string           `json:"state"` // runtime state, e.g. "running", "exited"
+	Running       bool             `json:"running"`
+	StartedAt     time.Time        `json:"started_at,omitempty"`
+	FinishedAt    time.Time        `json:"finished_at,omitempty"`
+	UptimeSeconds int64            `json:"uptime_seconds"`      // 0 unless running
+	ExitCode      *int             `json:"exit_code,omitempty"` // set once exited
+	OOMKilled     bool             `json:"oom_killed,omitempty"`
+	Error         string           `json:"error,omitempty"`
+	RestartCount  int              `json:"restart_count"`
+	Mounts        []WorkerMount    `json:"mounts"`
+	Resources     *WorkerResources `json:"resources,omitempty"` // running containers only
+}
+
+// WorkerDetail merges a worker's stored record with its live inspection.
+// Either side may be missing: Worker when the container was never
+// recorded, Container when it is gone or the runtime could not be asked
+// (InspectError says why).
+type WorkerDetail struct {
+	ID           WorkerID          `json:"id"`
+	NodeID       NodeID            `json:"node_id"`
+	Worker       *Worker           `json:"worker,omitempty"`
+	Container    *WorkerInspection `json:"container,omitempty"`
+	InspectError string            `json:"inspect_error,omitempty"`
+}
+
+// WorkerEvent is a state change of a worker container pushed by its
+// runtime.
+type WorkerEvent struct {
+	WorkerID WorkerID     `json:"worker_id"`
+	Action   string       `json:"action"` // runtime action, e.g. "start", "die", "oom"
+	Status   HealthStatus `json:"status"`
+	ExitCode *int         `json:"exit_code,omitempty"` // on "die"
+	Time     time.Time    `json:"time"`
+}
+
+// Exited reports whether the worker's container has stopped.
+func (e WorkerEvent) Exited() bool { return e.Status == HealthStatusExited }
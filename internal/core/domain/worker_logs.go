@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	DefaultWorkerLogTail  = 200  // lines returned when no tail is given
+	MaxWorkerLogTail      = 5000 // upper bound on requested lines
+	MaxWorkerLogLineBytes = 4096 // longer lines are cut
+)
+
+// ErrLogTailUnsupported is returned for workers whose runtime can only
+// stream logs, not return recent ones.
+var ErrLogTailUnsupported = errors.New("worker runtime does not support log retrieval")
+
+// WorkerLogLine is one line of a worker's output.
+type WorkerLogLine struct {
+	Time   time.Time `json:"time,omitempty"`
+	Stream string    `json:"stream"` // "stdout" or "stderr"
+	Text   string    `json:"text"`
+}
+
+// ClampWorkerLogTail maps a requested line count into (0, MaxWorkerLogTail];
+// zero or negative selects DefaultWorkerLogTail.
+func ClampWorkerLogTail(n int) int {
+	if n <= 0 {
+		return DefaultWorkerLogTail
+	}
+	return min(n, MaxWorkerLogTail)
+}
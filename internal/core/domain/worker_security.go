@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Profile values with a special meaning; anything else names a profile in
+// the WorkerSecurityPolicy.
+const (
+	SeccompRuntimeDefault = ""           // the kernel's profile, else the runtime's default
+	SeccompUnconfined     = "unconfined" // no syscall filtering; AppArmor too
+)
+
+// ErrInvalidWorkerSecurity is returned for WorkerSecurity settings that
+// fail validation.
+var ErrInvalidWorkerSecurity = errors.New("invalid worker security")
+
+// allowedCapabilities are the only ones a worker may add back: none of
+// them reaches outside the container's own files, processes and ports.
+// Capabilities missing here, including any the kernel gains later, are
+// rejected.
+var allowedCapabilities = map[string]bool{
+	"AUDIT_WRITE":      true,
+	"CHOWN":            true,
+	"DAC_OVERRIDE":     true,
+	"FOWNER":           true,
+	"FSETID":           true,
+	"KILL":             true,
+	"NET_BIND_SERVICE": true,
+	"SETGID":           true, // not with AllowPrivilegeEscalation
+	"SETUID":           true, // not with AllowPrivilegeEscalation
+}
+
+// WorkerSecurityPolicy is the operator's configuration, on the kernel, of
+// what a WorkerSecurity may ask for.
+type WorkerSecurityPolicy struct {
+	// SeccompProfiles maps profile names to Docker seccomp JSON files on
+	// the kernel host (AULE_SECCOMP_PROFILES)
+	SeccompProfiles  map[string]string
+	AppArmorProfiles []string // profile names loaded on the hosts (AULE_APPARMOR_PROFILES)
+	// AllowUnconfined lets workers turn off seccomp or AppArmor
+	// (AULE_WORKER_ALLOW_UNCONFINED)
+	AllowUnconfined bool
+	// AllowPrivilegeEscalation lets workers clear no-new-privileges
+	// (AULE_WORKER_ALLOW_PRIVILEGE_ESCALATION)
+	AllowPrivilegeEscalation bool
+}
+
+func (p WorkerSecurityPolicy) allowsAppArmor(name string) bool {
+	for _, n := range p.AppArmorProfiles {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// WorkerSecurity loosens the hardening workers run with. By default every
+// Linux capability is dropped, no-new-privileges is set, the seccomp
+// profile is the kernel's (AULE_SECCOMP_PROFILE) or the runtime's default,
+// and AppArmor uses the runtime's default profile. Only set this for the
+// rare worker that needs more; it can only pick from what the kernel's
+// WorkerSecurityPolicy allows.
+type WorkerSecurity struct {
+	CapAdd                   []string `json:"cap_add,omitempty"`                    // capabilities kept, e.g. "CHOWN"
+	SeccompProfile           string   `json:"seccomp_profile,omitempty"`            // "" = kernel default; else a name in WorkerSecurityPolicy.SeccompProfiles
+	AppArmorProfile          string   `json:"apparmor_profile,omitempty"`           // "" = runtime default; else one of WorkerSecurityPolicy.AppArmorProfiles
+	AllowPrivilegeEscalation bool     `json:"allow_privilege_escalation,omitempty"` // clears no-new-privileges
+}
+
+// Validate normalizes capability names ("cap_chown" → "CHOWN") and rejects
+// settings the policy does not allow: capabilities outside
+// allowedCapabilities, profiles the operator did not configure, and
+// unconfined profiles or privilege escalation unless the policy allows them.
+func (s *WorkerSecurity) Validate(policy WorkerSecurityPolicy) error {
+	if s == nil {
+		return nil
+	}
+	for i, c := range s.CapAdd {
+		c = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(c)), "CAP_")
+		if !allowedCapabilities[c] {
+			return fmt.Errorf("%w: capability %q in cap_add", ErrInvalidWorkerSecurity, s.CapAdd[i])
+		}
+		if (c == "SETUID" || c == "SETGID") && s.AllowPrivilegeEscalation {
+			return fmt.Errorf("%w: %s with allow_privilege_escalation", ErrInvalidWorkerSecurity, c)
+		}
+		s.CapAdd[i] = c
+	}
+	if s.AllowPrivilegeEscalation && !policy.AllowPrivilegeEscalation {
+		return fmt.Errorf("%w: allow_privilege_escalation is disabled on the kernel", ErrInvalidWorkerSecurity)
+	}
+	switch s.SeccompProfile {
+	case SeccompRuntimeDefault:
+	case SeccompUnconfined:
+		if !policy.AllowUnconfined {
+			return fmt.Errorf("%w: unconfined seccomp is disabled on the kernel", ErrInvalidWorkerSecurity)
+		}
+	default:
+		if _, ok := policy.SeccompProfiles[s.SeccompProfile]; !ok {
+			return fmt.Errorf("%w: seccomp_profile %q is not configured on the kernel", ErrInvalidWorkerSecurity, s.SeccompProfile)
+		}
+	}
+	switch {
+	case s.AppArmorProfile == "":
+	case s.AppArmorProfile == SeccompUnconfined:
+		if !policy.AllowUnconfined {
+			return fmt.Errorf("%w: unconfined apparmor is disabled on the kernel", ErrInvalidWorkerSecurity)
+		}
+	case !policy.allowsAppArmor(s.AppArmorProfile):
+		return fmt.Errorf("%w: apparmor_profile %q is not configured on the kernel", ErrInvalidWorkerSecurity, s.AppArmorProfile)
+	}
+	return nil
+}
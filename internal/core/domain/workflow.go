@@ -41,18 +41,19 @@ type Workflow struct {
 
 // WorkflowStep is a single unit of work in the DAG
 type WorkflowStep struct {
-	ID          string         `json:"id"`         // Unique ID within the workflow (e.g. "research")
-	PersonaID   PersonaID      `json:"persona_id"` // The agent persona to execute this step
-	Prompt      string         `json:"prompt"`     // The instruction (can use {{state.x}})
-	Tools       []string       `json:"tools"`      // List of allowed tool names for this step
-	DependsOn   []string       `json:"depends_on"` // IDs of steps that must complete first
-	Interrupt   *InterruptRule `json:"interrupt,omitempty"`
-	Status      StepStatus     `json:"status"`
-	Result      *StepResult    `json:"result,omitempty"`
-	MaxIters    int            `json:"max_iters"` // ReAct loop limit (default 5)
-	StartedAt   *time.Time     `json:"started_at,omitempty"`
-	CompletedAt *time.Time     `json:"completed_at,omitempty"`
-	Error       *string        `json:"error,omitempty"`
+	ID            string         `json:"id"`         // Unique ID within the workflow (e.g. "research")
+	PersonaID     PersonaID      `json:"persona_id"` // The agent persona to execute this step
+	Prompt        string         `json:"prompt"`     // The instruction (can use {{state.x}})
+	Tools         []string       `json:"tools"`      // List of allowed tool names for this step
+	DependsOn     []string       `json:"depends_on"` // IDs of steps that must complete first
+	Interrupt     *InterruptRule `json:"interrupt,omitempty"`
+	Deterministic bool           `json:"deterministic,omitempty"` // Temperature 0; repeated runs may be served from the LLM cache
+	Status        StepStatus     `json:"status"`
+	Result        *StepResult    `json:"result,omitempty"`
+	MaxIters      int            `json:"max_iters"` // ReAct loop limit (default 5)
+	StartedAt     *time.Time     `json:"started_at,omitempty"`
+	CompletedAt   *time.Time     `json:"completed_at,omitempty"`
+	Error         *string        `json:"error,omitempty"`
 }
 
 // InterruptRule defines conditions to pause the workflow for human input
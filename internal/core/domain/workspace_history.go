@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrWorkspaceCommitNotFound is returned for unknown commits in a project's
+// workspace history.
+var ErrWorkspaceCommitNotFound = errors.New("workspace commit not found")
+
+// WorkspaceCommit is one agent edit recorded in a project workspace's git
+// history.
+type WorkspaceCommit struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"` // persona name, or the default agent
+	Tool    string    `json:"tool"`   // write_file, edit_file...
+	Message string    `json:"message"`
+	Files   []string  `json:"files"` // paths changed, relative to the workspace
+	Time    time.Time `json:"time"`
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+)
+
+// MountsDir is the virtual directory of a project workspace where its
+// mounts appear to the file tools: mounts/<name>/...
+const MountsDir = "mounts"
+
+// ErrReadOnlyMount is returned when a file tool tries to change a mount.
+var ErrReadOnlyMount = errors.New("path is in a read-only mount")
+
+var mountNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`)
+
+// WorkspaceMount exposes a host directory (e.g. ~/Documents/specs) read-only
+// to a project's file tools, so the agent can reference it without a copy.
+type WorkspaceMount struct {
+	Name     string `json:"name"`      // virtual path is mounts/<name>
+	HostPath string `json:"host_path"` // absolute directory on the kernel host
+}
+
+// Validate checks the mount's name and that its host path is absolute.
+// Whether the directory exists is checked when it is registered.
+func (m WorkspaceMount) Validate() error {
+	if !mountNameRe.MatchString(m.Name) {
+		return fmt.Errorf("invalid mount name %q: use letters, digits, '.', '_' or '-'", m.Name)
+	}
+	if !filepath.IsAbs(m.HostPath) {
+		return fmt.Errorf("mount %q: host_path must be absolute", m.Name)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
 )
@@ -29,6 +30,36 @@ type WorkerManager interface {
 	GetWorkerIP(ctx context.Context, id domain.WorkerID) (string, error)
 }
 
+// ProgressReader is optionally implemented by WorkerManagers whose workers
+// report progress through domain.WorkerProgressFile.
+type ProgressReader interface {
+	// ReadProgress returns the latest report, or domain.ErrNoProgress if none yet.
+	ReadProgress(ctx context.Context, id domain.WorkerID) (domain.WorkerProgress, error)
+}
+
+// WorkerInspector is optionally implemented by WorkerManagers that can
+// report the live container state of a worker.
+type WorkerInspector interface {
+	// Inspect returns domain.ErrWorkerNotFound when no container exists.
+	Inspect(ctx context.Context, id domain.WorkerID) (domain.WorkerInspection, error)
+}
+
+// WorkerEventSource is optionally implemented by WorkerManagers that push
+// container state changes.
+type WorkerEventSource interface {
+	// WatchEvents calls fn for each event of a kernel-managed worker until
+	// ctx is done (returning nil) or the event stream fails.
+	WatchEvents(ctx context.Context, fn func(domain.WorkerEvent)) error
+}
+
+// WorkerLogReader is optionally implemented by WorkerManagers that can
+// return the recent output of a worker, including one that has exited.
+type WorkerLogReader interface {
+	// TailLogs returns up to tail lines, oldest first, or
+	// domain.ErrWorkerNotFound when no container exists.
+	TailLogs(ctx context.Context, id domain.WorkerID, tail int) ([]domain.WorkerLogLine, error)
+}
+
 // Repository abstracts the persistent storage (DuckDB)
 type Repository interface {
 	// SaveWorker persists the worker state.
@@ -47,17 +78,32 @@ type Repository interface {
 	SaveJob(ctx context.Context, job domain.Job) error
 	GetJob(ctx context.Context, id domain.JobID) (domain.Job, error)
 	ListJobs(ctx context.Context) ([]domain.Job, error)
+	DeleteJob(ctx context.Context, id domain.JobID) error
 
 	// Conversations
 	CreateConversation(ctx context.Context, conv domain.Conversation) error
 	GetConversation(ctx context.Context, id domain.ConversationID) (domain.Conversation, error)
 	ListConversations(ctx context.Context) ([]domain.Conversation, error)
+	ListConversationsFiltered(ctx context.Context, filter domain.ConversationFilter) ([]domain.Conversation, error)
+	UpdateConversationOrganization(ctx context.Context, id domain.ConversationID, patch domain.ConversationPatch) error
 	UpdateConversationTitle(ctx context.Context, id domain.ConversationID, title string) error
+	UpdateConversationContext(ctx context.Context, id domain.ConversationID, vars domain.ContextVars) error
+	UpdateConversationStyle(ctx context.Context, id domain.ConversationID, style *domain.ConversationStyle) error
 	DeleteConversation(ctx context.Context, id domain.ConversationID) error
 
 	// Messages
 	AddMessage(ctx context.Context, msg domain.Message) error
 	ListMessages(ctx context.Context, convID domain.ConversationID, limit int) ([]domain.Message, error)
+	ListMessagesPage(ctx context.Context, convID domain.ConversationID, page domain.MessagePage) ([]domain.Message, bool, error)
+	UpdateMessage(ctx context.Context, msg domain.Message) error
+	ListInProgressMessages(ctx context.Context) ([]domain.Message, error)
+	ArchiveMessagesFrom(ctx context.Context, convID domain.ConversationID, id domain.MessageID) (int, error)
+	DeleteMessagesAfter(ctx context.Context, convID domain.ConversationID, id domain.MessageID) (int, error)
+
+	// Message feedback
+	SaveMessageFeedback(ctx context.Context, fb domain.MessageFeedback) error
+	GetMessageFeedback(ctx context.Context, id domain.MessageID) (domain.MessageFeedback, error)
+	ListFeedbackRecords(ctx context.Context, filter domain.FeedbackFilter) ([]domain.FeedbackRecord, error)
 
 	// Projects
 	CreateProject(ctx context.Context, proj domain.Project) error
@@ -85,3 +131,85 @@ type Repository interface {
 	GetSetting(ctx context.Context, key string) (string, error)
 	SaveSetting(ctx context.Context, key string, value string) error
 }
+
+// HostStatsReader samples resource usage of the host the kernel runs on.
+type HostStatsReader interface {
+	CPU(ctx context.Context) (domain.CPUStats, error)
+	Memory(ctx context.Context) (domain.MemoryStats, error)
+	// Disk reports usage of the filesystem holding path.
+	Disk(path string) (domain.DiskStats, error)
+}
+
+// RuntimeStatsReader is optionally implemented by WorkerManagers that can
+// report statistics of their container daemon.
+type RuntimeStatsReader interface {
+	RuntimeStats(ctx context.Context) (domain.ContainerRuntimeStats, error)
+}
+
+// ShellProcess is a running shell. Writes go to its standard input.
+type ShellProcess interface {
+	io.Writer
+	Stdout() io.Reader
+	Stderr() io.Reader
+	// Close stops the shell and releases its sandbox.
+	Close() error
+}
+
+// ShellRuntime starts sandboxed shells for persistent shell sessions.
+type ShellRuntime interface {
+	StartShell(ctx context.Context, spec domain.ShellSpec) (ShellProcess, error)
+}
+
+// EmailSender delivers outgoing email.
+type EmailSender interface {
+	SendEmail(ctx context.Context, msg domain.EmailMessage) (messageID string, err error)
+}
+
+// EmailInbox reads new messages from a mailbox.
+type EmailInbox interface {
+	// FetchUnseen returns up to max unread messages, oldest first, without
+	// marking them read.
+	FetchUnseen(ctx context.Context, max int) ([]domain.InboundEmail, error)
+	// MarkSeen flags the messages as read.
+	MarkSeen(ctx context.Context, uids []uint32) error
+}
+
+// CalendarProvider reads and writes events on an external calendar.
+type CalendarProvider interface {
+	// ListEvents returns events overlapping [from, to), recurring events
+	// expanded into instances, ordered by start time.
+	ListEvents(ctx context.Context, from, to time.Time) ([]domain.CalendarEvent, error)
+	// CreateEvent adds ev and returns it with its backend ID set.
+	CreateEvent(ctx context.Context, ev domain.CalendarEvent) (domain.CalendarEvent, error)
+}
+
+// FileWatcher reports changes to files in watched directories. Watches are
+// not recursive; callers add subdirectories themselves.
+type FileWatcher interface {
+	Add(dir string) error
+	Remove(dir string) error
+	Events() <-chan domain.FileEvent
+	Close() error
+}
+
+// ModelPreloader loads models into a local inference server ahead of use.
+type ModelPreloader interface {
+	// Preload loads model, or refreshes it when loaded, and keeps it in
+	// memory for keepAlive after the call.
+	Preload(ctx context.Context, model string, keepAlive time.Duration) error
+}
+
+// ModelPuller downloads models into a local inference server.
+type ModelPuller interface {
+	// Pull downloads model, calling progress for each report until it
+	// finishes. Cancelling ctx aborts the download.
+	Pull(ctx context.Context, model string, progress func(domain.ModelPullProgress)) error
+}
+
+// LeaderElector decides which of several kernels deployed side by side
+// runs a singleton loop (cron, heartbeat, automations, pollers).
+type LeaderElector interface {
+	// Campaign blocks until this kernel leads name or ctx is cancelled. The
+	// returned channel is closed when the leadership is lost.
+	Campaign(ctx context.Context, name string) (lost <-chan struct{}, err error)
+}
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// ArtifactsDirName is the project workspace directory artifacts are copied
+// into when opened in a conversation.
+const ArtifactsDirName = "artifacts"
+
+// artifactPreviewBytes bounds the text preview put in the conversation
+// context; the agent reads the rest with read_file.
+const artifactPreviewBytes = 8 << 10
+
+// discussionConversations is the part of ConversationStore artifact
+// discussions need.
+type discussionConversations interface {
+	CreateConversationWithPersona(ctx context.Context, title string, personaID *domain.PersonaID) (domain.Conversation, error)
+	CreateProjectConversation(ctx context.Context, title string, projectID domain.ProjectID, personaID *domain.PersonaID) (domain.Conversation, error)
+	SetContext(ctx context.Context, id domain.ConversationID, vars domain.ContextVars) error
+}
+
+// ArtifactDiscussion opens generated artifacts in new conversations, so the
+// user can ask the agent about a result straight away.
+type ArtifactDiscussion struct {
+	convs discussionConversations
+	ws    *WorkspaceManager
+}
+
+func NewArtifactDiscussion(convs discussionConversations, ws *WorkspaceManager) *ArtifactDiscussion {
+	return &ArtifactDiscussion{convs: convs, ws: ws}
+}
+
+// Start creates a conversation about art. In a project (projectID, else the
+// artifact's own) the file is copied into the workspace's artifacts/
+// directory unless it already lives there. The conversation's context
+// variables describe the artifact and, for text, carry a preview.
+func (d *ArtifactDiscussion) Start(ctx context.Context, art domain.Artifact, projectID domain.ProjectID, personaID *domain.PersonaID, title string) (domain.Conversation, error) {
+	if projectID == "" && art.ProjectID != nil {
+		projectID = *art.ProjectID
+	}
+	if title == "" {
+		title = PlaceholderTitle("About " + art.Name)
+	}
+
+	vars := domain.ContextVars{
+		"artifact_id":   string(art.ID),
+		"artifact_name": art.Name,
+		"artifact_type": string(art.Type),
+	}
+	if art.MimeType != "" {
+		vars["artifact_mime_type"] = art.MimeType
+	}
+	if art.Prompt != "" {
+		vars["artifact_prompt"] = truncateRunes(art.Prompt, 1024)
+	}
+
+	var conv domain.Conversation
+	var err error
+	if projectID != "" {
+		conv, err = d.convs.CreateProjectConversation(ctx, title, projectID, personaID)
+		if err != nil {
+			return domain.Conversation{}, err
+		}
+		rel, err := d.copyToProject(art, projectID)
+		if err != nil {
+			return domain.Conversation{}, err
+		}
+		vars["artifact_path"] = rel
+	} else {
+		conv, err = d.convs.CreateConversationWithPersona(ctx, title, personaID)
+		if err != nil {
+			return domain.Conversation{}, err
+		}
+	}
+
+	if preview, cut, ok := artifactPreview(art); ok {
+		vars["artifact_preview"] = preview
+		if cut {
+			vars["artifact_preview_truncated"] = true
+		}
+		// JSON escaping can grow markup past the context size limit
+		for vars.Validate() != nil && len(preview) > 0 {
+			preview = truncateRunes(preview, utf8.RuneCountInString(preview)/2)
+			vars["artifact_preview"] = preview
+			vars["artifact_preview_truncated"] = true
+		}
+	}
+	if err := d.convs.SetContext(ctx, conv.ID, vars); err != nil {
+		return domain.Conversation{}, fmt.Errorf("set conversation context: %w", err)
+	}
+	conv.Context = vars
+	return conv, nil
+}
+
+// copyToProject makes the artifact file available in the project workspace
+// and returns its workspace-relative path.
+func (d *ArtifactDiscussion) copyToProject(art domain.Artifact, projectID domain.ProjectID) (string, error) {
+	root, err := d.ws.PrepareProject(string(projectID))
+	if err != nil {
+		return "", fmt.Errorf("prepare project workspace: %w", err)
+	}
+	if rel, err := filepath.Rel(root, art.FilePath); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel) {
+		return filepath.ToSlash(rel), nil
+	}
+
+	name := string(art.ID) + "-" + safeArtifactName(filepath.Base(art.FilePath))
+	rel := ArtifactsDirName + "/" + name
+	dst := filepath.Join(root, ArtifactsDirName, name)
+	if _, err := os.Stat(dst); err == nil {
+		return rel, nil // copied by an earlier discussion
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return "", fmt.Errorf("create artifacts directory: %w", err)
+	}
+	src, err := os.Open(art.FilePath)
+	if err != nil {
+		return "", fmt.Errorf("open artifact file: %w", err)
+	}
+	defer src.Close()
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".artifact-*")
+	if err != nil {
+		return "", fmt.Errorf("copy artifact: %w", err)
+	}
+	if _, err := io.Copy(tmp, src); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("copy artifact: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("copy artifact: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), dst); err != nil {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("copy artifact: %w", err)
+	}
+	return rel, nil
+}
+
+// safeArtifactName keeps the extension readable while dropping characters
+// that are awkward in paths.
+func safeArtifactName(base string) string {
+	ext := filepath.Ext(base)
+	name := safeFileName(strings.TrimSuffix(base, ext))
+	if ext != "" {
+		name += "." + safeFileName(ext[1:])
+	}
+	return name
+}
+
+// artifactPreview reads the start of a text artifact. It reports false for
+// binary content (images, audio, ...) or an unreadable file.
+func artifactPreview(art domain.Artifact) (string, bool, bool) {
+	if !isTextMime(art.MimeType) && art.Type != domain.ArtifactTypeText {
+		return "", false, false
+	}
+	f, err := os.Open(art.FilePath)
+	if err != nil {
+		return "", false, false
+	}
+	defer f.Close()
+	buf := make([]byte, artifactPreviewBytes+1)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", false, false
+	}
+	data, cut := buf[:n], n > artifactPreviewBytes
+	if cut {
+		data = data[:artifactPreviewBytes]
+		// Don't split the last rune
+		for i := 0; i < utf8.UTFMax && len(data) > 0 && !utf8.Valid(data); i++ {
+			data = data[:len(data)-1]
+		}
+	}
+	if !utf8.Valid(data) {
+		return "", false, false
+	}
+	return string(data), cut, true
+}
+
+func isTextMime(mime string) bool {
+	mime, _, _ = strings.Cut(mime, ";")
+	switch {
+	case strings.HasPrefix(mime, "text/"):
+		return true
+	case mime == "application/json", mime == "application/xml", mime == "application/yaml",
+		mime == "application/x-yaml", mime == "application/javascript", mime == "image/svg+xml":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+type fakeDiscussionConvs struct {
+	created domain.Conversation
+	vars    domain.ContextVars
+}
+
+func (f *fakeDiscussionConvs) CreateConversationWithPersona(_ context.Context, title string, personaID *domain.PersonaID) (domain.Conversation, error) {
+	f.created = domain.Conversation{ID: "conv-1", Title: title, PersonaID: personaID}
+	return f.created, nil
+}
+
+func (f *fakeDiscussionConvs) CreateProjectConversation(_ context.Context, title string, projectID domain.ProjectID, personaID *domain.PersonaID) (domain.Conversation, error) {
+	f.created = domain.Conversation{ID: "conv-1", Title: title, ProjectID: &projectID, PersonaID: personaID}
+	return f.created, nil
+}
+
+func (f *fakeDiscussionConvs) SetContext(_ context.Context, _ domain.ConversationID, vars domain.ContextVars) error {
+	if err := vars.Validate(); err != nil {
+		return err
+	}
+	f.vars = vars
+	return nil
+}
+
+func TestArtifactDiscussion_CopiesIntoProjectWithPreview(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	src := filepath.Join(t.TempDir(), "report final.md")
+	require.NoError(t, os.WriteFile(src, []byte("# Q3 report\nrevenue up\n"), 0o644))
+
+	convs := &fakeDiscussionConvs{}
+	d := NewArtifactDiscussion(convs, ws)
+	proj := domain.ProjectID("proj-1")
+	art := domain.Artifact{ID: "art-1", ProjectID: &proj, Type: domain.ArtifactTypeDocument, Name: "report", FilePath: src, MimeType: "text/markdown"}
+
+	conv, err := d.Start(context.Background(), art, "", nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, "About report", conv.Title)
+	require.NotNil(t, conv.ProjectID)
+	assert.Equal(t, proj, *conv.ProjectID)
+
+	assert.Equal(t, "artifacts/art-1-report_final.md", convs.vars["artifact_path"])
+	assert.Equal(t, "# Q3 report\nrevenue up\n", convs.vars["artifact_preview"])
+	copied, err := os.ReadFile(filepath.Join(ws.GetProjectPath("proj-1"), "artifacts", "art-1-report_final.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Q3 report\nrevenue up\n", string(copied))
+
+	// A file already in the workspace is referenced, not copied
+	inside := filepath.Join(ws.GetProjectPath("proj-1"), "out", "notes.txt")
+	require.NoError(t, os.MkdirAll(filepath.Dir(inside), 0o755))
+	require.NoError(t, os.WriteFile(inside, []byte("hi"), 0o644))
+	art2 := domain.Artifact{ID: "art-2", ProjectID: &proj, Type: domain.ArtifactTypeText, Name: "notes", FilePath: inside}
+	_, err = d.Start(context.Background(), art2, "", nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, "out/notes.txt", convs.vars["artifact_path"])
+}
+
+func TestArtifactDiscussion_BinaryAndLargeArtifacts(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	dir := t.TempDir()
+	img := filepath.Join(dir, "cat.png")
+	require.NoError(t, os.WriteFile(img, []byte{0x89, 'P', 'N', 'G'}, 0o644))
+	big := filepath.Join(dir, "log.txt")
+	require.NoError(t, os.WriteFile(big, []byte(strings.Repeat("é", artifactPreviewBytes)), 0o644))
+
+	convs := &fakeDiscussionConvs{}
+	d := NewArtifactDiscussion(convs, ws)
+
+	conv, err := d.Start(context.Background(), domain.Artifact{ID: "art-1", Type: domain.ArtifactTypeImage, Name: "cat", FilePath: img, MimeType: "image/png", Prompt: "a cat"}, "", nil, "Cat")
+	require.NoError(t, err)
+	assert.Nil(t, conv.ProjectID)
+	assert.NotContains(t, convs.vars, "artifact_preview")
+	assert.NotContains(t, convs.vars, "artifact_path")
+	assert.Equal(t, "a cat", convs.vars["artifact_prompt"])
+
+	_, err = d.Start(context.Background(), domain.Artifact{ID: "art-2", Type: domain.ArtifactTypeText, Name: "log", FilePath: big, MimeType: "text/plain"}, "", nil, "")
+	require.NoError(t, err)
+	preview := convs.vars["artifact_preview"].(string)
+	assert.LessOrEqual(t, len(preview), artifactPreviewBytes)
+	assert.True(t, strings.HasSuffix(preview, "é"))
+	assert.Equal(t, true, convs.vars["artifact_preview_truncated"])
+}
+
+func TestArtifactDiscussion_PreviewFitsContextLimit(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	page := filepath.Join(t.TempDir(), "page.html")
+	require.NoError(t, os.WriteFile(page, []byte(strings.Repeat("<b>", artifactPreviewBytes/3)), 0o644))
+
+	convs := &fakeDiscussionConvs{}
+	_, err := NewArtifactDiscussion(convs, ws).Start(context.Background(), domain.Artifact{ID: "art-1", Name: "page", FilePath: page, MimeType: "text/html"}, "", nil, "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, convs.vars["artifact_preview"])
+	assert.Equal(t, true, convs.vars["artifact_preview_truncated"])
+}
@@ -0,0 +1,584 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	automationTick         = time.Minute
+	automationResultMaxLen = 4096
+	automationWebhookMax   = 64 << 10 // webhook payload kept in the event
+)
+
+// ErrAutomationForbidden is returned for a webhook call with a wrong token.
+var ErrAutomationForbidden = errors.New("invalid automation token")
+
+// AutomationRepository persists automation rules.
+type AutomationRepository interface {
+	SaveAutomation(ctx context.Context, a *domain.Automation) error
+	GetAutomation(ctx context.Context, id domain.AutomationID) (*domain.Automation, error)
+	ListAutomations(ctx context.Context, projectID domain.ProjectID) ([]domain.Automation, error)
+	DeleteAutomation(ctx context.Context, id domain.AutomationID) error
+}
+
+// AutomationService runs automation rules: trigger + conditions + action.
+// Cron rules are checked on its own tick and webhook rules fire through
+// Webhook; feed and file rules are backed by a FeedWatch or FileTrigger
+// whose events come back through Dispatch.
+type AutomationService struct {
+	logger    *slog.Logger
+	repo      AutomationRepository
+	agent     chatAgent
+	convs     conversationEnsurer
+	workflows WorkflowRepository
+	executor  workflowStarter
+	tools     *domain.ToolRegistry
+	feeds     *FeedMonitor        // optional; required for feed triggers
+	files     *FileTriggerService // optional; required for file triggers
+	tick      time.Duration
+	now       func() time.Time
+
+	policy      *ToolPolicy      // optional; tool actions respect the deny list
+	maintenance *MaintenanceMode // optional; cron rules wait while paused
+}
+
+func NewAutomationService(logger *slog.Logger, repo AutomationRepository, agent chatAgent, convs conversationEnsurer, workflows WorkflowRepository, executor workflowStarter, tools *domain.ToolRegistry, feeds *FeedMonitor, files *FileTriggerService) *AutomationService {
+	return &AutomationService{
+		logger:    logger,
+		repo:      repo,
+		agent:     agent,
+		convs:     convs,
+		workflows: workflows,
+		executor:  executor,
+		tools:     tools,
+		feeds:     feeds,
+		files:     files,
+		tick:      automationTick,
+		now:       time.Now,
+	}
+}
+
+// SetToolPolicy applies the kernel tool deny list to tool actions.
+func (s *AutomationService) SetToolPolicy(p *ToolPolicy) {
+	s.policy = p
+}
+
+// SetMaintenance makes cron rules skip ticks while maintenance mode is on.
+// Rules that fall due meanwhile run on the first tick after resume.
+func (s *AutomationService) SetMaintenance(mm *MaintenanceMode) {
+	s.maintenance = mm
+}
+
+// Create validates a rule, sets up its trigger and saves it.
+func (s *AutomationService) Create(ctx context.Context, a *domain.Automation) error {
+	if err := s.validateAction(ctx, a.Action); err != nil {
+		return err
+	}
+	for _, c := range a.Conditions {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	if a.ID == "" {
+		a.ID = domain.AutomationID(uuid.New().String())
+	}
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = s.now()
+	}
+	if err := s.setupTrigger(ctx, a); err != nil {
+		return err
+	}
+	if a.Name == "" {
+		a.Name = fmt.Sprintf("%s → %s", a.Trigger.Kind, a.Action.Kind)
+	}
+	if err := s.repo.SaveAutomation(ctx, a); err != nil {
+		s.teardownTrigger(ctx, a)
+		return err
+	}
+	s.logger.Info("automation created", "automation_id", string(a.ID), "trigger", a.Trigger.Kind, "action", a.Action.Kind)
+	return nil
+}
+
+func (s *AutomationService) validateAction(ctx context.Context, act domain.AutomationAction) error {
+	switch act.Kind {
+	case domain.AutomationActionPrompt:
+		if strings.TrimSpace(act.Prompt) == "" {
+			return fmt.Errorf("prompt action requires a prompt")
+		}
+	case domain.AutomationActionWorkflow:
+		if act.WorkflowID == "" {
+			return fmt.Errorf("workflow action requires workflow_id")
+		}
+		if _, err := s.workflows.GetWorkflow(ctx, act.WorkflowID); err != nil {
+			return fmt.Errorf("workflow %s: %w", act.WorkflowID, err)
+		}
+	case domain.AutomationActionTool:
+		if _, ok := s.tools.GetTool(act.Tool); !ok {
+			return fmt.Errorf("unknown tool %q", act.Tool)
+		}
+	default:
+		return fmt.Errorf("unknown action kind %q (want prompt, workflow or tool)", act.Kind)
+	}
+	return nil
+}
+
+// setupTrigger validates the trigger and creates what backs it: the next
+// cron run, a webhook token, or a feed or file trigger.
+func (s *AutomationService) setupTrigger(ctx context.Context, a *domain.Automation) error {
+	tr := &a.Trigger
+	switch tr.Kind {
+	case domain.AutomationTriggerCron:
+		next, err := nextCronRun(tr.CronExpr, s.now())
+		if err != nil {
+			return fmt.Errorf("invalid cron expression: %w", err)
+		}
+		a.NextRun = &next
+	case domain.AutomationTriggerWebhook:
+		token := make([]byte, 16)
+		if _, err := rand.Read(token); err != nil {
+			return err
+		}
+		tr.WebhookToken = hex.EncodeToString(token)
+	case domain.AutomationTriggerFeed:
+		if s.feeds == nil {
+			return fmt.Errorf("feed triggers not enabled")
+		}
+		f := &domain.FeedWatch{
+			ProjectID:    a.ProjectID,
+			Name:         a.Name,
+			URL:          tr.FeedURL,
+			AutomationID: a.ID,
+			IntervalSec:  tr.FeedIntervalSec,
+			Paused:       !a.Enabled,
+		}
+		if err := s.feeds.Watch(ctx, f); err != nil {
+			return err
+		}
+		tr.SourceID = string(f.ID)
+		tr.FeedIntervalSec = f.IntervalSec
+	case domain.AutomationTriggerFile:
+		if s.files == nil {
+			return fmt.Errorf("file triggers not enabled")
+		}
+		ft := &domain.FileTrigger{
+			ProjectID:    a.ProjectID,
+			Name:         a.Name,
+			Path:         tr.Path,
+			Patterns:     tr.Patterns,
+			Recursive:    tr.Recursive,
+			Events:       tr.FileEvents,
+			AutomationID: a.ID,
+			DebounceSec:  tr.DebounceSec,
+			Paused:       !a.Enabled,
+		}
+		if err := s.files.Watch(ctx, ft); err != nil {
+			return err
+		}
+		tr.SourceID = string(ft.ID)
+		tr.Path = ft.Path
+		tr.DebounceSec = ft.DebounceSec
+	default:
+		return fmt.Errorf("unknown trigger kind %q (want cron, webhook, feed or file)", tr.Kind)
+	}
+	return nil
+}
+
+// teardownTrigger removes the feed or file trigger backing a.
+func (s *AutomationService) teardownTrigger(ctx context.Context, a *domain.Automation) {
+	var err error
+	switch a.Trigger.Kind {
+	case domain.AutomationTriggerFeed:
+		if s.feeds != nil && a.Trigger.SourceID != "" {
+			err = s.feeds.Unwatch(ctx, domain.FeedID(a.Trigger.SourceID))
+		}
+	case domain.AutomationTriggerFile:
+		if s.files != nil && a.Trigger.SourceID != "" {
+			err = s.files.Unwatch(ctx, domain.FileTriggerID(a.Trigger.SourceID))
+		}
+	}
+	if err != nil && !errors.Is(err, domain.ErrFeedNotFound) && !errors.Is(err, domain.ErrFileTriggerNotFound) {
+		s.logger.Warn("failed to remove automation trigger", "automation_id", string(a.ID), "error", err)
+	}
+}
+
+// List returns the rules, or only the project's when projectID is set.
+func (s *AutomationService) List(ctx context.Context, projectID domain.ProjectID) ([]domain.Automation, error) {
+	return s.repo.ListAutomations(ctx, projectID)
+}
+
+// Get returns a rule by ID.
+func (s *AutomationService) Get(ctx context.Context, id domain.AutomationID) (*domain.Automation, error) {
+	return s.repo.GetAutomation(ctx, id)
+}
+
+// Delete removes a rule and its feed or file trigger.
+func (s *AutomationService) Delete(ctx context.Context, id domain.AutomationID) error {
+	a, err := s.repo.GetAutomation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteAutomation(ctx, id); err != nil {
+		return err
+	}
+	s.teardownTrigger(ctx, a)
+	return nil
+}
+
+// SetEnabled enables or disables a rule. The backing feed or file trigger
+// is paused along with it; a re-enabled cron rule is rescheduled from now.
+func (s *AutomationService) SetEnabled(ctx context.Context, id domain.AutomationID, enabled bool) (*domain.Automation, error) {
+	a, err := s.repo.GetAutomation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	a.Enabled = enabled
+	switch a.Trigger.Kind {
+	case domain.AutomationTriggerCron:
+		if enabled {
+			if next, err := nextCronRun(a.Trigger.CronExpr, s.now()); err == nil {
+				a.NextRun = &next
+			}
+		}
+	case domain.AutomationTriggerFeed:
+		if s.feeds != nil {
+			if f, err := s.feeds.repo.GetFeed(ctx, domain.FeedID(a.Trigger.SourceID)); err == nil {
+				if err := s.feeds.SetPaused(ctx, f, !enabled); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case domain.AutomationTriggerFile:
+		if s.files != nil {
+			if t, err := s.files.repo.GetFileTrigger(ctx, domain.FileTriggerID(a.Trigger.SourceID)); err == nil {
+				if err := s.files.SetPaused(ctx, t, !enabled); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if err := s.repo.SaveAutomation(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Run checks cron rules until ctx is cancelled.
+func (s *AutomationService) Run(ctx context.Context) error {
+	ctx = domain.WithSubsystem(ctx, "automations")
+	ticker := time.NewTicker(s.tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if s.maintenance.Paused() {
+				continue
+			}
+			s.checkCron(ctx)
+		}
+	}
+}
+
+func (s *AutomationService) checkCron(ctx context.Context) {
+	rules, err := s.repo.ListAutomations(ctx, "")
+	if err != nil {
+		s.logger.Error("failed to list automations", "error", err)
+		return
+	}
+	now := s.now()
+	for i := range rules {
+		a := &rules[i]
+		if !a.Enabled || a.Trigger.Kind != domain.AutomationTriggerCron || a.NextRun == nil || a.NextRun.After(now) {
+			continue
+		}
+		scheduled := *a.NextRun
+		if next, err := nextCronRun(a.Trigger.CronExpr, now); err == nil {
+			a.NextRun = &next
+		} else {
+			a.Enabled = false
+			a.LastError = fmt.Sprintf("invalid cron expression: %v", err)
+		}
+		if err := s.repo.SaveAutomation(ctx, a); err != nil {
+			s.logger.Error("failed to save automation", "automation_id", string(a.ID), "error", err)
+			continue
+		}
+		ev := domain.AutomationEvent{
+			Kind: domain.AutomationTriggerCron,
+			Data: map[string]string{"scheduled_at": scheduled.Format(time.RFC3339)},
+		}
+		rule := *a
+		go s.fire(ctx, &rule, ev)
+	}
+}
+
+// Dispatch runs rule id for an event from its feed or file trigger. Events
+// for disabled rules are dropped.
+func (s *AutomationService) Dispatch(ctx context.Context, id domain.AutomationID, ev domain.AutomationEvent) error {
+	a, err := s.repo.GetAutomation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !a.Enabled {
+		return nil
+	}
+	s.fire(ctx, a, ev)
+	return nil
+}
+
+// Webhook fires a webhook rule with the request body as its event. The
+// action runs in the background; the caller only learns whether the call
+// was accepted.
+func (s *AutomationService) Webhook(ctx context.Context, id domain.AutomationID, token string, body []byte) error {
+	a, err := s.repo.GetAutomation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if a.Trigger.Kind != domain.AutomationTriggerWebhook {
+		return domain.ErrAutomationNotFound
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(a.Trigger.WebhookToken)) != 1 {
+		return ErrAutomationForbidden
+	}
+	return s.fireWebhook(ctx, a, body)
+}
+
+// Hook fires the webhook rule whose token is token. The token is the
+// hook's address and its only credential, so unknown tokens are reported
+// as not found.
+func (s *AutomationService) Hook(ctx context.Context, token string, body []byte) (*domain.Automation, error) {
+	if token == "" {
+		return nil, domain.ErrAutomationNotFound
+	}
+	rules, err := s.repo.ListAutomations(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		a := &rules[i]
+		if a.Trigger.Kind != domain.AutomationTriggerWebhook {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(a.Trigger.WebhookToken)) == 1 {
+			return a, s.fireWebhook(ctx, a, body)
+		}
+	}
+	return nil, domain.ErrAutomationNotFound
+}
+
+func (s *AutomationService) fireWebhook(ctx context.Context, a *domain.Automation, body []byte) error {
+	if !a.Enabled {
+		return fmt.Errorf("automation %s is disabled", a.ID)
+	}
+	ev := webhookEvent(body)
+	go s.fire(domain.WithSubsystem(context.WithoutCancel(ctx), "automations"), a, ev)
+	return nil
+}
+
+// RunNow fires a rule immediately, skipping its conditions, with an event
+// marked manual.
+func (s *AutomationService) RunNow(ctx context.Context, id domain.AutomationID) (*domain.Automation, error) {
+	a, err := s.repo.GetAutomation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ev := domain.AutomationEvent{Kind: a.Trigger.Kind, Data: map[string]string{"manual": "true"}}
+	a.Conditions = nil
+	s.fire(ctx, a, ev)
+	return s.repo.GetAutomation(ctx, id)
+}
+
+// webhookEvent exposes the body as "body" (and "payload") and, for a JSON
+// object, each top-level field as "body.<field>".
+func webhookEvent(body []byte) domain.AutomationEvent {
+	if len(body) > automationWebhookMax {
+		body = body[:automationWebhookMax]
+	}
+	data := map[string]string{"body": string(body), "payload": string(body)}
+	var obj map[string]interface{}
+	if json.Unmarshal(body, &obj) == nil {
+		for k, v := range obj {
+			if str, ok := v.(string); ok {
+				data["body."+k] = str
+			} else {
+				raw, _ := json.Marshal(v)
+				data["body."+k] = string(raw)
+			}
+		}
+	}
+	text := ""
+	if len(body) > 0 {
+		text = "Webhook payload:\n" + string(body)
+	}
+	return domain.AutomationEvent{Kind: domain.AutomationTriggerWebhook, Data: data, Text: text}
+}
+
+// fire runs the rule's action if its conditions hold and records the
+// outcome on the rule.
+func (s *AutomationService) fire(ctx context.Context, a *domain.Automation, ev domain.AutomationEvent) {
+	if !a.Matches(ev) {
+		s.logger.Debug("automation conditions not met", "automation_id", string(a.ID))
+		return
+	}
+	s.logger.Info("automation fired", "automation_id", string(a.ID), "name", a.Name, "trigger", ev.Kind)
+
+	result, err := s.execute(ctx, a, ev)
+
+	// Reload so concurrent edits (enable/disable) are not overwritten
+	cur, getErr := s.repo.GetAutomation(ctx, a.ID)
+	if getErr != nil {
+		return // deleted meanwhile
+	}
+	now := s.now()
+	cur.LastRun = &now
+	cur.RunCount++
+	cur.LastError = ""
+	if err != nil {
+		cur.LastError = err.Error()
+		s.logger.Error("automation failed", "automation_id", string(a.ID), "error", err)
+	} else {
+		cur.LastResult = truncateRunes(result, automationResultMaxLen)
+	}
+	if err := s.repo.SaveAutomation(ctx, cur); err != nil {
+		s.logger.Error("failed to save automation", "automation_id", string(a.ID), "error", err)
+	}
+}
+
+func (s *AutomationService) execute(ctx context.Context, a *domain.Automation, ev domain.AutomationEvent) (string, error) {
+	ctx = domain.WithPriority(ctx, domain.PriorityBackground)
+	if a.ProjectID != "" {
+		ctx = ContextWithProject(ctx, a.ProjectID)
+	}
+	act := a.Action
+
+	switch act.Kind {
+	case domain.AutomationActionPrompt:
+		convID := domain.ConversationID("automation-" + string(a.ID))
+		if err := s.convs.EnsureConversation(ctx, convID, PlaceholderTitle("Automation: "+a.Name)); err != nil {
+			return "", fmt.Errorf("create conversation: %w", err)
+		}
+		// The event summary is appended unless the prompt places event
+		// data itself
+		msg := expandEventRefs(act.Prompt, ev)
+		if ev.Text != "" && !eventRefRe.MatchString(act.Prompt) {
+			msg += "\n\n" + ev.Text
+		}
+		resp, _, err := s.agent.Chat(ctx, convID, msg, act.PersonaID)
+		if err != nil {
+			return "", err
+		}
+		return resp.Response, nil
+
+	case domain.AutomationActionWorkflow:
+		tmpl, err := s.workflows.GetWorkflow(ctx, act.WorkflowID)
+		if err != nil {
+			return "", fmt.Errorf("load workflow: %w", err)
+		}
+		state := map[string]any{"trigger": string(ev.Kind), "event": ev.Text}
+		for k, v := range ev.Data {
+			state["event_"+strings.ReplaceAll(k, ".", "_")] = v
+		}
+		wf := newWorkflowRun(tmpl, "automation: "+a.Name, state, s.now())
+		for i := range wf.Steps {
+			wf.Steps[i].Prompt = expandEventRefs(wf.Steps[i].Prompt, ev)
+		}
+		if err := s.executor.Start(ctx, wf); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Started workflow run %s", wf.ID), nil
+
+	case domain.AutomationActionTool:
+		args, _ := expandEventArgs(act.Args, ev).(map[string]interface{})
+		if args == nil {
+			args = map[string]interface{}{}
+		}
+		res, err := s.policy.Apply(s.tools).Execute(ctx, act.Tool, args)
+		if err != nil {
+			return "", err
+		}
+		if str, ok := res.(string); ok {
+			return str, nil
+		}
+		out, _ := json.Marshal(res)
+		return string(out), nil
+	}
+	return "", fmt.Errorf("unknown action kind %q", act.Kind)
+}
+
+var eventRefRe = regexp.MustCompile(`\{\{\s*(?:event|params)\.([A-Za-z0-9_.]+)\s*\}\}`)
+
+// expandEventRefs replaces {{event.<field>}} (or {{params.<field>}}) with
+// the event's data; {{event.text}} is the readable summary unless the data
+// defines "text".
+func expandEventRefs(s string, ev domain.AutomationEvent) string {
+	return eventRefRe.ReplaceAllStringFunc(s, func(m string) string {
+		key := eventRefRe.FindStringSubmatch(m)[1]
+		if v, ok := ev.Data[key]; ok {
+			return v
+		}
+		if key == "text" {
+			return ev.Text
+		}
+		if key == "kind" {
+			return string(ev.Kind)
+		}
+		return ""
+	})
+}
+
+// expandEventArgs applies expandEventRefs to every string in a tool
+// argument value, recursively.
+func expandEventArgs(v interface{}, ev domain.AutomationEvent) interface{} {
+	switch x := v.(type) {
+	case string:
+		return expandEventRefs(x, ev)
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(x))
+		for k, val := range x {
+			out[k] = expandEventArgs(val, ev)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(x))
+		for i, val := range x {
+			out[i] = expandEventArgs(val, ev)
+		}
+		return out
+	}
+	return v
+}
+
+// describeTrigger is a one-line summary of a rule's trigger for listings.
+func describeTrigger(tr domain.AutomationTrigger) string {
+	switch tr.Kind {
+	case domain.AutomationTriggerCron:
+		return "cron " + strconv.Quote(tr.CronExpr)
+	case domain.AutomationTriggerWebhook:
+		return "webhook"
+	case domain.AutomationTriggerFeed:
+		return "feed " + tr.FeedURL
+	case domain.AutomationTriggerFile:
+		s := "files in " + tr.Path
+		if len(tr.Patterns) > 0 {
+			s += " [" + strings.Join(tr.Patterns, ", ") + "]"
+		}
+		return s
+	}
+	return string(tr.Kind)
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+type memAutomationRepo struct {
+	mu    sync.Mutex
+	rules map[domain.AutomationID]domain.Automation
+}
+
+func (r *memAutomationRepo) SaveAutomation(_ context.Context, a *domain.Automation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules[a.ID] = *a
+	return nil
+}
+
+func (r *memAutomationRepo) GetAutomation(_ context.Context, id domain.AutomationID) (*domain.Automation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a, ok := r.rules[id]
+	if !ok {
+		return nil, domain.ErrAutomationNotFound
+	}
+	return &a, nil
+}
+
+func (r *memAutomationRepo) ListAutomations(_ context.Context, _ domain.ProjectID) ([]domain.Automation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []domain.Automation
+	for _, a := range r.rules {
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+func (r *memAutomationRepo) DeleteAutomation(_ context.Context, id domain.AutomationID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.rules[id]; !ok {
+		return domain.ErrAutomationNotFound
+	}
+	delete(r.rules, id)
+	return nil
+}
+
+type automationFixture struct {
+	s       *AutomationService
+	repo    *memAutomationRepo
+	agent   *recordingAgent
+	wfs     *memWorkflows
+	feeds   *memFeedRepo
+	feedURL string
+	calls   chan map[string]interface{}
+}
+
+func newTestAutomations(t *testing.T) automationFixture {
+	feeds, feedRepo, _, wfs, url := newTestFeedMonitor(t)
+	agent := &recordingAgent{done: make(chan struct{}, 4)}
+
+	calls := make(chan map[string]interface{}, 4)
+	tools := domain.NewToolRegistry()
+	require.NoError(t, tools.Register(&domain.Tool{
+		Name: "notify",
+		Execute: func(_ context.Context, params map[string]interface{}) (interface{}, error) {
+			calls <- params
+			return "sent", nil
+		},
+	}))
+
+	repo := &memAutomationRepo{rules: map[domain.AutomationID]domain.Automation{}}
+	s := NewAutomationService(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, agent,
+		&fakeEmailConvs{ensured: map[domain.ConversationID]string{}}, wfs, wfs, tools, feeds, nil)
+	feeds.SetAutomations(s)
+	return automationFixture{s: s, repo: repo, agent: agent, wfs: wfs, feeds: feedRepo, feedURL: *url, calls: calls}
+}
+
+// waitRuns waits until rule id has run n times.
+func waitRuns(t *testing.T, repo *memAutomationRepo, id domain.AutomationID, n int) domain.Automation {
+	t.Helper()
+	var a *domain.Automation
+	require.Eventually(t, func() bool {
+		a, _ = repo.GetAutomation(context.Background(), id)
+		return a != nil && a.RunCount >= n
+	}, 5*time.Second, 10*time.Millisecond)
+	return *a
+}
+
+func TestAutomationCronRunsPrompt(t *testing.T) {
+	fx := newTestAutomations(t)
+	s, repo, agent := fx.s, fx.repo, fx.agent
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 8, 59, 30, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	assert.Error(t, s.Create(ctx, &domain.Automation{Trigger: domain.AutomationTrigger{Kind: domain.AutomationTriggerCron, CronExpr: "bad"},
+		Action: domain.AutomationAction{Kind: domain.AutomationActionPrompt, Prompt: "x"}}))
+	assert.Error(t, s.Create(ctx, &domain.Automation{Trigger: domain.AutomationTrigger{Kind: domain.AutomationTriggerCron, CronExpr: "0 9 * * *"},
+		Action: domain.AutomationAction{Kind: domain.AutomationActionTool, Tool: "missing"}}))
+
+	a := &domain.Automation{Name: "standup", Enabled: true,
+		Trigger: domain.AutomationTrigger{Kind: domain.AutomationTriggerCron, CronExpr: "0 9 * * *"},
+		Action:  domain.AutomationAction{Kind: domain.AutomationActionPrompt, Prompt: "Prepare the standup notes ({{event.scheduled_at}})"}}
+	require.NoError(t, s.Create(ctx, a))
+	require.NotNil(t, a.NextRun)
+	assert.Equal(t, 9, a.NextRun.Hour())
+
+	s.checkCron(ctx)
+	assert.Empty(t, agent.done, "not due yet")
+
+	now = now.Add(time.Minute)
+	s.checkCron(ctx)
+	got := waitRuns(t, repo, a.ID, 1)
+	assert.Equal(t, "ok", got.LastResult)
+	assert.Equal(t, now.Add(24*time.Hour).Truncate(time.Hour), *got.NextRun)
+	agent.mu.Lock()
+	assert.Equal(t, "Prepare the standup notes (2026-03-10T09:00:00Z)", agent.messages[0])
+	assert.Equal(t, domain.ConversationID("automation-"+string(a.ID)), agent.convs[0])
+	agent.mu.Unlock()
+
+	_, err := s.SetEnabled(ctx, a.ID, false)
+	require.NoError(t, err)
+	now = now.Add(48 * time.Hour)
+	s.checkCron(ctx)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, 1, waitRuns(t, repo, a.ID, 1).RunCount, "disabled rules do not run")
+}
+
+func TestAutomationWebhookConditionsAndToolAction(t *testing.T) {
+	fx := newTestAutomations(t)
+	s, repo, calls := fx.s, fx.repo, fx.calls
+	ctx := context.Background()
+
+	a := &domain.Automation{Enabled: true,
+		Trigger:    domain.AutomationTrigger{Kind: domain.AutomationTriggerWebhook},
+		Conditions: []domain.AutomationCondition{{Field: "body.action", Op: "equals", Value: "opened"}},
+		Action: domain.AutomationAction{Kind: domain.AutomationActionTool, Tool: "notify",
+			Args: map[string]interface{}{"text": "New issue: {{event.body.title}}", "tags": []interface{}{"{{event.body.repo}}"}}}}
+	require.NoError(t, s.Create(ctx, a))
+	require.Len(t, a.Trigger.WebhookToken, 32)
+	assert.Equal(t, "webhook → tool", a.Name)
+
+	assert.ErrorIs(t, s.Webhook(ctx, a.ID, "wrong", nil), ErrAutomationForbidden)
+
+	require.NoError(t, s.Webhook(ctx, a.ID, a.Trigger.WebhookToken, []byte(`{"action":"closed","title":"x"}`)))
+	require.NoError(t, s.Webhook(ctx, a.ID, a.Trigger.WebhookToken, []byte(`{"action":"opened","title":"Crash on start","repo":"aule"}`)))
+	select {
+	case args := <-calls:
+		assert.Equal(t, "New issue: Crash on start", args["text"])
+		assert.Equal(t, []interface{}{"aule"}, args["tags"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("tool was not called")
+	}
+	assert.Equal(t, "sent", waitRuns(t, repo, a.ID, 1).LastResult)
+	assert.Empty(t, calls, "closed event filtered out")
+
+	got, err := s.RunNow(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.RunCount, "manual runs skip conditions")
+}
+
+func TestAutomationFeedTriggerStartsWorkflow(t *testing.T) {
+	fx := newTestAutomations(t)
+	s, repo, wfs := fx.s, fx.repo, fx.wfs
+	ctx := context.Background()
+	wfs.wfs["wf-digest"] = &domain.Workflow{ID: "wf-digest", Name: "Digest",
+		Steps: []domain.WorkflowStep{{ID: "sum", Prompt: "Summarize {{state.event_titles}}"}}}
+
+	a := &domain.Automation{Enabled: true,
+		Trigger: domain.AutomationTrigger{Kind: domain.AutomationTriggerFeed, FeedURL: fx.feedURL},
+		Action:  domain.AutomationAction{Kind: domain.AutomationActionWorkflow, WorkflowID: "wf-digest"}}
+	require.NoError(t, s.Create(ctx, a))
+	require.NotEmpty(t, a.Trigger.SourceID)
+
+	f, err := fx.feeds.GetFeed(ctx, domain.FeedID(a.Trigger.SourceID))
+	require.NoError(t, err)
+	assert.Equal(t, a.ID, f.AutomationID)
+
+	fx.feeds.seen[string(f.ID)+"|rel-1.2"] = false
+	s.feeds.poll(ctx, f)
+	var wf *domain.Workflow
+	select {
+	case wf = <-wfs.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("workflow was not started")
+	}
+	assert.Equal(t, "v1.2 & fixes", wf.State["event_titles"])
+	assert.Equal(t, "feed", wf.State["trigger"])
+	waitRuns(t, repo, a.ID, 1)
+
+	_, err = s.SetEnabled(ctx, a.ID, false)
+	require.NoError(t, err)
+	f, err = fx.feeds.GetFeed(ctx, f.ID)
+	require.NoError(t, err)
+	assert.True(t, f.Paused, "disabling the rule pauses its feed")
+
+	require.NoError(t, s.Delete(ctx, a.ID))
+	_, err = fx.feeds.GetFeed(ctx, f.ID)
+	assert.ErrorIs(t, err, domain.ErrFeedNotFound)
+}
+
+func TestAutomationConditions(t *testing.T) {
+	data := map[string]string{"title": "Release v2.0", "count": "3"}
+	cases := []struct {
+		c    domain.AutomationCondition
+		want bool
+	}{
+		{domain.AutomationCondition{Field: "title", Op: "contains", Value: "release"}, true},
+		{domain.AutomationCondition{Field: "title", Op: "not_contains", Value: "beta"}, true},
+		{domain.AutomationCondition{Field: "count", Op: "equals", Value: "3"}, true},
+		{domain.AutomationCondition{Field: "count", Op: "not_equals", Value: "3"}, false},
+		{domain.AutomationCondition{Field: "title", Op: "matches", Value: `v\d+\.0$`}, true},
+		{domain.AutomationCondition{Field: "missing", Op: "equals", Value: ""}, true},
+	}
+	for _, tc := range cases {
+		require.NoError(t, tc.c.Validate())
+		assert.Equal(t, tc.want, tc.c.Holds(data), "%+v", tc.c)
+	}
+	assert.Error(t, domain.AutomationCondition{Field: "x", Op: "gt"}.Validate())
+	assert.Error(t, domain.AutomationCondition{Field: "x", Op: "matches", Value: "("}.Validate())
+
+	ev := domain.AutomationEvent{Kind: domain.AutomationTriggerFile, Data: data, Text: "summary"}
+	assert.Equal(t, "Release v2.0 / summary / file / ", expandEventRefs("{{ event.title }} / {{event.text}} / {{event.kind}} / {{event.nope}}", ev))
+}
+
+func TestAutomationHookByToken(t *testing.T) {
+	fx := newTestAutomations(t)
+	s, agent := fx.s, fx.agent
+	ctx := context.Background()
+
+	a := &domain.Automation{Enabled: true,
+		Trigger: domain.AutomationTrigger{Kind: domain.AutomationTriggerWebhook},
+		Action:  domain.AutomationAction{Kind: domain.AutomationActionPrompt, Prompt: "CI failed: {{params.payload}}"}}
+	require.NoError(t, s.Create(ctx, a))
+
+	_, err := s.Hook(ctx, "", nil)
+	assert.ErrorIs(t, err, domain.ErrAutomationNotFound)
+	_, err = s.Hook(ctx, "nope", nil)
+	assert.ErrorIs(t, err, domain.ErrAutomationNotFound)
+
+	got, err := s.Hook(ctx, a.Trigger.WebhookToken, []byte(`{"job":"build"}`))
+	require.NoError(t, err)
+	assert.Equal(t, a.ID, got.ID)
+	select {
+	case <-agent.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("prompt did not run")
+	}
+	agent.mu.Lock()
+	assert.Equal(t, `CI failed: {"job":"build"}`, agent.messages[0], "payload is not appended twice")
+	agent.mu.Unlock()
+
+	_, err = s.SetEnabled(ctx, a.ID, false)
+	require.NoError(t, err)
+	_, err = s.Hook(ctx, a.Trigger.WebhookToken, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,314 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// CoderPersonaID is the built-in persona build loops hand edits to.
+const CoderPersonaID domain.PersonaID = "pers-coder"
+
+// maxBuildLoops bounds finished loops kept in memory; the oldest go first.
+const maxBuildLoops = 100
+
+// buildRunner runs a project's build command; *BuildRunner in production.
+type buildRunner interface {
+	Settings(ctx context.Context, projectID domain.ProjectID) (domain.BuildSettings, error)
+	Run(ctx context.Context, projectID domain.ProjectID, settings domain.BuildSettings) (domain.BuildResult, error)
+}
+
+// BuildLoopService drives the edit → build → read failures cycle: the coder
+// persona edits the project, the build runs in a sandbox, and failures are
+// fed back until the build passes or the cycle budget is spent. Each cycle
+// is a span of the loop's trace, with build and fix child spans.
+type BuildLoopService struct {
+	logger *slog.Logger
+	runner buildRunner
+	agent  chatAgent
+	convs  conversationEnsurer
+	tracer *TraceCollector
+
+	mu      sync.Mutex
+	loops   map[domain.BuildLoopID]*domain.BuildLoop
+	cancels map[domain.BuildLoopID]context.CancelFunc
+}
+
+func NewBuildLoopService(logger *slog.Logger, runner buildRunner, agent chatAgent, convs conversationEnsurer, tracer *TraceCollector) *BuildLoopService {
+	return &BuildLoopService{
+		logger:  logger,
+		runner:  runner,
+		agent:   agent,
+		convs:   convs,
+		tracer:  tracer,
+		loops:   make(map[domain.BuildLoopID]*domain.BuildLoop),
+		cancels: make(map[domain.BuildLoopID]context.CancelFunc),
+	}
+}
+
+// Start launches a build loop for the project in the background. goal is
+// an optional change to make first; maxCycles <= 0 uses the project's budget.
+func (s *BuildLoopService) Start(ctx context.Context, projectID domain.ProjectID, goal string, maxCycles int) (domain.BuildLoop, error) {
+	settings, err := s.runner.Settings(ctx, projectID)
+	if err != nil {
+		return domain.BuildLoop{}, err
+	}
+	if maxCycles <= 0 {
+		maxCycles = settings.Cycles()
+	}
+	maxCycles = min(maxCycles, domain.MaxBuildCycles)
+
+	id := domain.NewBuildLoopID()
+	loop := &domain.BuildLoop{
+		ID:             id,
+		ProjectID:      projectID,
+		Goal:           strings.TrimSpace(goal),
+		Status:         domain.BuildLoopRunning,
+		MaxCycles:      maxCycles,
+		Cycles:         []domain.BuildCycle{},
+		ConversationID: domain.ConversationID(string(id)),
+		StartedAt:      time.Now(),
+	}
+
+	s.mu.Lock()
+	for _, l := range s.loops {
+		if l.ProjectID == projectID && l.Status == domain.BuildLoopRunning {
+			s.mu.Unlock()
+			return domain.BuildLoop{}, domain.ErrBuildLoopRunning
+		}
+	}
+	s.evictLocked()
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	s.loops[id] = loop
+	s.cancels[id] = cancel
+	snapshot := cloneBuildLoop(loop)
+	s.mu.Unlock()
+
+	go s.run(runCtx, id, settings)
+	return snapshot, nil
+}
+
+// Get returns a copy of the loop.
+func (s *BuildLoopService) Get(id domain.BuildLoopID) (domain.BuildLoop, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.loops[id]
+	if !ok {
+		return domain.BuildLoop{}, domain.ErrBuildLoopNotFound
+	}
+	return cloneBuildLoop(l), nil
+}
+
+// List returns the project's loops, newest first.
+func (s *BuildLoopService) List(projectID domain.ProjectID) []domain.BuildLoop {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []domain.BuildLoop{}
+	for _, l := range s.loops {
+		if l.ProjectID == projectID {
+			out = append(out, cloneBuildLoop(l))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartedAt.After(out[j].StartedAt) })
+	return out
+}
+
+// Cancel stops a running loop after its current step.
+func (s *BuildLoopService) Cancel(id domain.BuildLoopID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.loops[id]
+	if !ok {
+		return domain.ErrBuildLoopNotFound
+	}
+	if l.Status != domain.BuildLoopRunning {
+		return domain.ErrBuildLoopNotActive
+	}
+	s.cancels[id]()
+	return nil
+}
+
+func (s *BuildLoopService) run(ctx context.Context, id domain.BuildLoopID, settings domain.BuildSettings) {
+	s.mu.Lock()
+	loop := cloneBuildLoop(s.loops[id])
+	s.mu.Unlock()
+
+	ctx = domain.WithPriority(ContextWithProject(ctx, loop.ProjectID), domain.PriorityBackground)
+	ctx, traceID, _ := s.tracer.StartTrace(ctx, "build loop: "+string(loop.ProjectID), map[string]string{
+		"build_loop_id": string(id),
+		"project_id":    string(loop.ProjectID),
+		"command":       settings.Command,
+		"max_cycles":    strconv.Itoa(loop.MaxCycles),
+	})
+	s.update(id, func(l *domain.BuildLoop) { l.TraceID = traceID })
+
+	status, runErr := s.cycles(ctx, loop, settings)
+	if ctx.Err() != nil && status != domain.BuildLoopPassed {
+		status, runErr = domain.BuildLoopCancelled, nil
+	}
+
+	now := time.Now()
+	s.update(id, func(l *domain.BuildLoop) {
+		l.Status = status
+		l.FinishedAt = &now
+		if runErr != nil {
+			l.Error = runErr.Error()
+		}
+	})
+	s.mu.Lock()
+	if cancel, ok := s.cancels[id]; ok {
+		cancel()
+		delete(s.cancels, id)
+	}
+	s.mu.Unlock()
+
+	traceStatus, traceErr := domain.SpanStatusOK, ""
+	if status != domain.BuildLoopPassed {
+		traceStatus, traceErr = domain.SpanStatusError, string(status)
+		if runErr != nil {
+			traceErr = runErr.Error()
+		}
+	}
+	s.tracer.EndTrace(traceID, traceStatus, traceErr)
+	s.logger.Info("build loop finished", "build_loop_id", string(id), "project_id", string(loop.ProjectID), "status", string(status))
+}
+
+// cycles runs edit/build iterations until the build passes or the budget
+// is spent. The first cycle edits only when the loop has a goal.
+func (s *BuildLoopService) cycles(ctx context.Context, loop domain.BuildLoop, settings domain.BuildSettings) (domain.BuildLoopStatus, error) {
+	if err := s.convs.EnsureConversation(ctx, loop.ConversationID, PlaceholderTitle("Build loop: "+firstNonEmpty(loop.Goal, settings.Command))); err != nil {
+		return domain.BuildLoopFailed, fmt.Errorf("create conversation: %w", err)
+	}
+
+	var last *domain.BuildResult
+	for n := 1; n <= loop.MaxCycles; n++ {
+		if ctx.Err() != nil {
+			return domain.BuildLoopCancelled, nil
+		}
+		cycleCtx, cycleSpan := s.tracer.StartSpan(ctx, fmt.Sprintf("cycle %d", n), domain.SpanKindStep, map[string]string{
+			"cycle": strconv.Itoa(n),
+		})
+		cycle := domain.BuildCycle{N: n}
+
+		if prompt := buildFixPrompt(loop.Goal, settings.Command, last); prompt != "" {
+			fixCtx, fixSpan := s.tracer.StartSpan(cycleCtx, "fix", domain.SpanKindAgent, map[string]string{
+				"persona_id": string(CoderPersonaID),
+			})
+			s.tracer.SetSpanInput(fixSpan, prompt)
+			persona := CoderPersonaID
+			resp, _, err := s.agent.Chat(fixCtx, loop.ConversationID, prompt, &persona)
+			if err != nil {
+				s.tracer.EndSpan(fixSpan, domain.SpanStatusError, "", err.Error())
+				s.tracer.EndSpan(cycleSpan, domain.SpanStatusError, "", err.Error())
+				return domain.BuildLoopFailed, fmt.Errorf("cycle %d: agent: %w", n, err)
+			}
+			cycle.Fix = resp.Response
+			s.tracer.EndSpan(fixSpan, domain.SpanStatusOK, resp.Response, "")
+			s.appendCycle(loop.ID, cycle)
+		}
+
+		_, buildSpan := s.tracer.StartSpan(cycleCtx, "build", domain.SpanKindTool, map[string]string{
+			"command": settings.Command,
+		})
+		res, err := s.runner.Run(cycleCtx, loop.ProjectID, settings)
+		if err != nil {
+			s.tracer.EndSpan(buildSpan, domain.SpanStatusError, "", err.Error())
+			s.tracer.EndSpan(cycleSpan, domain.SpanStatusError, "", err.Error())
+			return domain.BuildLoopFailed, fmt.Errorf("cycle %d: %w", n, err)
+		}
+		cycle.Build = &res
+		s.appendCycle(loop.ID, cycle)
+
+		if res.Passed {
+			s.tracer.EndSpan(buildSpan, domain.SpanStatusOK, res.Output, "")
+			s.tracer.EndSpan(cycleSpan, domain.SpanStatusOK, "build passed", "")
+			return domain.BuildLoopPassed, nil
+		}
+		msg := fmt.Sprintf("exit code %d", res.ExitCode)
+		s.tracer.EndSpan(buildSpan, domain.SpanStatusError, res.Output, msg)
+		s.tracer.EndSpan(cycleSpan, domain.SpanStatusError, "", "build failed: "+msg)
+		last = &res
+	}
+	return domain.BuildLoopExhausted, nil
+}
+
+// buildFixPrompt asks the coder for this cycle's edits: the goal on the
+// first cycle, the failure afterwards. It returns "" when there is nothing
+// to ask (first cycle without a goal).
+func buildFixPrompt(goal, command string, last *domain.BuildResult) string {
+	var b strings.Builder
+	if last == nil {
+		if goal == "" {
+			return ""
+		}
+		fmt.Fprintf(&b, "%s\n\nEdit the project files to do this. The build/test command `%s` runs after you reply; keep it passing.", goal, command)
+		return b.String()
+	}
+	fmt.Fprintf(&b, "The build/test command `%s` failed with exit code %d.\n\n", command, last.ExitCode)
+	b.WriteString("Output:\n```\n")
+	b.WriteString(strings.TrimRight(last.Output, "\n"))
+	b.WriteString("\n```\n\n")
+	if goal != "" {
+		fmt.Fprintf(&b, "The task was: %s\n\n", goal)
+	}
+	b.WriteString("Read the failing files, fix the cause with edit_file, write_file or apply_patch, then reply with a one-line summary of the fix. The build runs again after you reply.")
+	return b.String()
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// appendCycle records cycle, replacing the entry for the same cycle number.
+func (s *BuildLoopService) appendCycle(id domain.BuildLoopID, cycle domain.BuildCycle) {
+	s.update(id, func(l *domain.BuildLoop) {
+		if k := len(l.Cycles); k > 0 && l.Cycles[k-1].N == cycle.N {
+			l.Cycles[k-1] = cycle
+			return
+		}
+		l.Cycles = append(l.Cycles, cycle)
+	})
+}
+
+func (s *BuildLoopService) update(id domain.BuildLoopID, fn func(*domain.BuildLoop)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if l, ok := s.loops[id]; ok {
+		fn(l)
+	}
+}
+
+// evictLocked drops the oldest finished loops past maxBuildLoops.
+func (s *BuildLoopService) evictLocked() {
+	if len(s.loops) < maxBuildLoops {
+		return
+	}
+	var finished []*domain.BuildLoop
+	for _, l := range s.loops {
+		if l.Status != domain.BuildLoopRunning {
+			finished = append(finished, l)
+		}
+	}
+	sort.Slice(finished, func(i, j int) bool { return finished[i].StartedAt.Before(finished[j].StartedAt) })
+	for _, l := range finished[:max(0, min(len(finished), len(s.loops)-maxBuildLoops+1))] {
+		delete(s.loops, l.ID)
+	}
+}
+
+func cloneBuildLoop(l *domain.BuildLoop) domain.BuildLoop {
+	cp := *l
+	cp.Cycles = append([]domain.BuildCycle{}, l.Cycles...)
+	return cp
+}
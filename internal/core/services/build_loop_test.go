@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+type buildTestProjects struct{ settings *domain.BuildSettings }
+
+func (p buildTestProjects) GetProject(_ context.Context, id domain.ProjectID) (domain.Project, error) {
+	if id != "proj-1" {
+		return domain.Project{}, domain.ErrProjectNotFound
+	}
+	return domain.Project{ID: id, Settings: domain.ProjectSettings{Build: p.settings}}, nil
+}
+
+type buildTestConvs struct{}
+
+func (buildTestConvs) EnsureConversation(context.Context, domain.ConversationID, string) error {
+	return nil
+}
+
+// fixingAgent "fixes" the build on its second call by creating the file
+// the build command checks for.
+type fixingAgent struct {
+	dir     string
+	mu      sync.Mutex
+	prompts []string
+}
+
+func (a *fixingAgent) Chat(ctx context.Context, convID domain.ConversationID, message string, personaID *domain.PersonaID) (*domain.AgentResponse, domain.ConversationID, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.prompts = append(a.prompts, message)
+	if len(a.prompts) == 2 {
+		if err := os.WriteFile(filepath.Join(a.dir, "fixed"), []byte("ok"), 0o644); err != nil {
+			return nil, convID, err
+		}
+	}
+	return &domain.AgentResponse{Response: "edited"}, convID, nil
+}
+
+func waitBuildLoop(t *testing.T, svc *BuildLoopService, id domain.BuildLoopID) domain.BuildLoop {
+	t.Helper()
+	deadline := time.After(10 * time.Second)
+	for {
+		loop, err := svc.Get(id)
+		require.NoError(t, err)
+		if loop.Status != domain.BuildLoopRunning {
+			return loop
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("build loop still running: %+v", loop)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestBuildLoop_IteratesUntilGreen(t *testing.T) {
+	if _, err := exec.LookPath("base64"); err != nil {
+		t.Skip("base64 not available")
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ws, _ := testWorkspaceManager(t)
+	settings := &domain.BuildSettings{Command: "echo checking; test -f fixed || { echo 'missing fixed' >&2; exit 3; }", MaxCycles: 4}
+	runner := NewBuildRunner(logger, localShellRuntime{}, ws, buildTestProjects{settings}, "")
+	agent := &fixingAgent{dir: ws.GetProjectPath("proj-1")}
+	tracer := NewTraceCollector(logger, nil, nil)
+	svc := NewBuildLoopService(logger, runner, agent, buildTestConvs{}, tracer)
+
+	started, err := svc.Start(context.Background(), "proj-1", "add the fixed file", 0)
+	require.NoError(t, err)
+	assert.Equal(t, 4, started.MaxCycles)
+
+	loop := waitBuildLoop(t, svc, started.ID)
+	assert.Equal(t, domain.BuildLoopPassed, loop.Status, loop.Error)
+	require.Len(t, loop.Cycles, 2)
+
+	// Cycle 1 works on the goal and fails; cycle 2 sees the failure
+	assert.Equal(t, 3, loop.Cycles[0].Build.ExitCode)
+	assert.Contains(t, loop.Cycles[0].Build.Output, "missing fixed")
+	assert.True(t, loop.Cycles[1].Build.Passed)
+	require.Len(t, agent.prompts, 2)
+	assert.Contains(t, agent.prompts[0], "add the fixed file")
+	assert.Contains(t, agent.prompts[1], "exit code 3")
+	assert.Contains(t, agent.prompts[1], "missing fixed")
+
+	// One span per cycle, each with fix and build children
+	trace, err := tracer.GetTrace(context.Background(), loop.TraceID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SpanStatusOK, trace.Status)
+	names := map[string]int{}
+	for _, s := range trace.Spans {
+		names[s.Name]++
+	}
+	assert.Equal(t, 1, names["cycle 1"])
+	assert.Equal(t, 1, names["cycle 2"])
+	assert.Equal(t, 2, names["fix"])
+	assert.Equal(t, 2, names["build"])
+}
+
+func TestBuildLoop_ExhaustsBudget(t *testing.T) {
+	if _, err := exec.LookPath("base64"); err != nil {
+		t.Skip("base64 not available")
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ws, _ := testWorkspaceManager(t)
+	runner := NewBuildRunner(logger, localShellRuntime{}, ws, buildTestProjects{&domain.BuildSettings{Command: "exit 1", MaxCycles: 2}}, "")
+	agent := &fixingAgent{dir: t.TempDir()}
+	svc := NewBuildLoopService(logger, runner, agent, buildTestConvs{}, NewTraceCollector(logger, nil, nil))
+
+	started, err := svc.Start(context.Background(), "proj-1", "", 0)
+	require.NoError(t, err)
+	loop := waitBuildLoop(t, svc, started.ID)
+	assert.Equal(t, domain.BuildLoopExhausted, loop.Status)
+	assert.Len(t, loop.Cycles, 2)
+	// No goal: the first cycle only builds
+	assert.Len(t, agent.prompts, 1)
+}
+
+func TestBuildLoop_RequiresBuildCommand(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ws, _ := testWorkspaceManager(t)
+	runner := NewBuildRunner(logger, localShellRuntime{}, ws, buildTestProjects{}, "")
+	svc := NewBuildLoopService(logger, runner, &fixingAgent{}, buildTestConvs{}, NewTraceCollector(logger, nil, nil))
+
+	_, err := svc.Start(context.Background(), "proj-1", "", 0)
+	assert.ErrorIs(t, err, domain.ErrNoBuildCommand)
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// buildOutputBytes bounds the output kept from one build run. Failures are
+// usually reported last, so the tail is kept.
+const buildOutputBytes = 16384
+
+// projectReader is the part of the repository build runs need.
+type projectReader interface {
+	GetProject(ctx context.Context, id domain.ProjectID) (domain.Project, error)
+}
+
+// BuildRunner runs a project's build/test command in a throwaway sandboxed
+// shell with the project workspace mounted at /workspace.
+type BuildRunner struct {
+	logger   *slog.Logger
+	runtime  ports.ShellRuntime
+	ws       *WorkspaceManager
+	projects projectReader
+	image    string
+}
+
+// NewBuildRunner starts sandboxes from image (DefaultShellImage if empty)
+// unless the project's build settings name another.
+func NewBuildRunner(logger *slog.Logger, runtime ports.ShellRuntime, ws *WorkspaceManager, projects projectReader, image string) *BuildRunner {
+	if image == "" {
+		image = DefaultShellImage
+	}
+	return &BuildRunner{logger: logger, runtime: runtime, ws: ws, projects: projects, image: image}
+}
+
+// Settings returns the project's build settings, or ErrNoBuildCommand.
+func (b *BuildRunner) Settings(ctx context.Context, projectID domain.ProjectID) (domain.BuildSettings, error) {
+	proj, err := b.projects.GetProject(ctx, projectID)
+	if err != nil {
+		return domain.BuildSettings{}, err
+	}
+	if proj.Settings.Build == nil || strings.TrimSpace(proj.Settings.Build.Command) == "" {
+		return domain.BuildSettings{}, domain.ErrNoBuildCommand
+	}
+	return *proj.Settings.Build, nil
+}
+
+// Run executes the build command once. A failing build is a result, not an
+// error; errors mean the sandbox could not run it.
+func (b *BuildRunner) Run(ctx context.Context, projectID domain.ProjectID, settings domain.BuildSettings) (domain.BuildResult, error) {
+	dir, err := b.ws.PrepareProject(string(projectID))
+	if err != nil {
+		return domain.BuildResult{}, fmt.Errorf("prepare project workspace: %w", err)
+	}
+	image := settings.Image
+	if image == "" {
+		image = b.image
+	}
+
+	start := time.Now()
+	startCtx, cancel := context.WithTimeout(ctx, shellStartTimeout)
+	proc, err := b.runtime.StartShell(startCtx, domain.ShellSpec{
+		Image:        image,
+		WorkspaceDir: dir,
+		Labels:       map[string]string{"aule.project_id": string(projectID), "aule.purpose": "build"},
+	})
+	cancel()
+	if err != nil {
+		return domain.BuildResult{}, fmt.Errorf("start build sandbox: %w", err)
+	}
+	defer func() { _ = proc.Close() }()
+
+	runCtx, cancel := context.WithTimeout(ctx, settings.Timeout())
+	defer cancel()
+	s := newShellSession("", image, proc)
+	// As in shell sessions, a no-op first discards startup noise
+	if _, err := s.run(runCtx, "cd /workspace 2>/dev/null || true", 0); err != nil {
+		return domain.BuildResult{}, fmt.Errorf("build sandbox did not start: %w", err)
+	}
+	// A subshell, so `exit` in the command ends the build rather than the shell
+	res, err := s.run(runCtx, "(\n"+settings.Command+"\n)", 0)
+	if err != nil {
+		if runCtx.Err() == context.DeadlineExceeded {
+			return domain.BuildResult{
+				Command:    settings.Command,
+				ExitCode:   -1,
+				Output:     fmt.Sprintf("build timed out after %s", settings.Timeout()),
+				DurationMs: time.Since(start).Milliseconds(),
+			}, nil
+		}
+		return domain.BuildResult{}, fmt.Errorf("run build: %w", err)
+	}
+
+	out := res.Stdout
+	if res.Stderr != "" {
+		if out != "" && !strings.HasSuffix(out, "\n") {
+			out += "\n"
+		}
+		out += res.Stderr
+	}
+	out, cut := tailOutput(out, buildOutputBytes)
+	result := domain.BuildResult{
+		Command:    settings.Command,
+		ExitCode:   res.ExitCode,
+		Passed:     res.ExitCode == 0,
+		Output:     out,
+		Truncated:  cut,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	b.logger.InfoContext(ctx, "build finished", "project_id", string(projectID), "exit_code", result.ExitCode, "duration_ms", result.DurationMs)
+	return result, nil
+}
+
+// tailOutput keeps the last max bytes of s.
+func tailOutput(s string, max int) (string, bool) {
+	if len(s) <= max {
+		return s, false
+	}
+	return fmt.Sprintf("... (%d earlier bytes truncated)\n", len(s)-max) + s[len(s)-max:], true
+}
+
+// NewRunBuildTool creates run_build, which runs the current project's
+// configured build/test command in a sandbox and reports the result.
+func NewRunBuildTool(runner *BuildRunner) *domain.Tool {
+	return &domain.Tool{
+		Name:          "run_build",
+		Description:   "Runs this project's configured build/test command in a sandbox with the project files at /workspace. Returns exit_code, passed and the tail of the output. Use it after editing code to check your changes.",
+		ExecutionType: domain.ExecNative,
+		// The project's build timeout applies inside; this only backs it up
+		Timeout: domain.MaxToolTimeout,
+		Parameters: domain.ToolParameters{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			projectID, ok := GetProjectFromContext(ctx)
+			if !ok || projectID == "" {
+				return nil, fmt.Errorf("run_build needs a project; this conversation has none")
+			}
+			settings, err := runner.Settings(ctx, projectID)
+			if err != nil {
+				if err == domain.ErrNoBuildCommand {
+					return nil, fmt.Errorf("%w; set settings.build.command on the project", err)
+				}
+				return nil, err
+			}
+			return runner.Run(ctx, projectID, settings)
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// Calendar holds the active calendar backend so the calendar tools follow
+// settings changes without re-registering.
+type Calendar struct {
+	mu       sync.RWMutex
+	provider ports.CalendarProvider
+	loc      *time.Location
+}
+
+func NewCalendar(provider ports.CalendarProvider, loc *time.Location) *Calendar {
+	return &Calendar{provider: provider, loc: loc}
+}
+
+// UpdateProvider hot-swaps the backend and the zone used for times given
+// without an offset. A nil provider disables the calendar tools.
+func (c *Calendar) UpdateProvider(provider ports.CalendarProvider, loc *time.Location) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.provider = provider
+	c.loc = loc
+}
+
+func (c *Calendar) current() (ports.CalendarProvider, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.provider == nil {
+		return nil, domain.ErrCalendarNotConfigured
+	}
+	return c.provider, nil
+}
+
+// ListEvents returns the events overlapping [from, to).
+func (c *Calendar) ListEvents(ctx context.Context, from, to time.Time) ([]domain.CalendarEvent, error) {
+	p, err := c.current()
+	if err != nil {
+		return nil, err
+	}
+	return p.ListEvents(ctx, from, to)
+}
+
+// CreateEvent adds ev to the calendar.
+func (c *Calendar) CreateEvent(ctx context.Context, ev domain.CalendarEvent) (domain.CalendarEvent, error) {
+	p, err := c.current()
+	if err != nil {
+		return ev, err
+	}
+	return p.CreateEvent(ctx, ev)
+}
+
+// Location returns the zone for times given without an offset.
+func (c *Calendar) Location() *time.Location {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.loc == nil {
+		return time.Local
+	}
+	return c.loc
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+type fakeCalendarProvider struct {
+	from, to time.Time
+	created  []domain.CalendarEvent
+}
+
+func (f *fakeCalendarProvider) ListEvents(_ context.Context, from, to time.Time) ([]domain.CalendarEvent, error) {
+	f.from, f.to = from, to
+	return []domain.CalendarEvent{{ID: "1", Title: "Standup", Start: from.Add(time.Hour).UTC(), End: from.Add(2 * time.Hour).UTC()}}, nil
+}
+
+func (f *fakeCalendarProvider) CreateEvent(_ context.Context, ev domain.CalendarEvent) (domain.CalendarEvent, error) {
+	ev.ID = "new"
+	f.created = append(f.created, ev)
+	return ev, nil
+}
+
+func TestCalendarToolsRequireConfiguration(t *testing.T) {
+	cal := NewCalendar(nil, time.UTC)
+	_, err := NewCalendarListTool(cal).Execute(context.Background(), map[string]interface{}{})
+	assert.ErrorIs(t, err, domain.ErrCalendarNotConfigured)
+}
+
+func TestCalendarListToolRange(t *testing.T) {
+	loc := time.FixedZone("BRT", -3*3600)
+	provider := &fakeCalendarProvider{}
+	tool := NewCalendarListTool(NewCalendar(provider, loc))
+
+	out, err := tool.Execute(context.Background(), map[string]interface{}{"from": "2026-03-10", "to": "2026-03-11"})
+	require.NoError(t, err)
+	assert.True(t, provider.from.Equal(time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 48*time.Hour, provider.to.Sub(provider.from), "a date as 'to' includes that day")
+
+	events := out.(map[string]interface{})["events"].([]domain.CalendarEvent)
+	require.Len(t, events, 1)
+	assert.Equal(t, loc, events[0].Start.Location(), "events are shown in the calendar's zone")
+
+	_, err = tool.Execute(context.Background(), map[string]interface{}{"from": "2026-01-01", "to": "2026-12-31"})
+	assert.Error(t, err)
+}
+
+func TestCalendarCreateTool(t *testing.T) {
+	loc := time.FixedZone("BRT", -3*3600)
+	provider := &fakeCalendarProvider{}
+	tool := NewCalendarCreateTool(NewCalendar(provider, loc))
+	ctx := context.Background()
+
+	_, err := tool.Execute(ctx, map[string]interface{}{
+		"title": "Design review", "start": "2026-03-10T14:00", "duration_minutes": float64(45),
+		"attendees": []interface{}{"ana@example.com"},
+	})
+	require.NoError(t, err)
+	ev := provider.created[0]
+	assert.True(t, ev.Start.Equal(time.Date(2026, 3, 10, 17, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 45*time.Minute, ev.End.Sub(ev.Start))
+	assert.Equal(t, []string{"ana@example.com"}, ev.Attendees)
+
+	_, err = tool.Execute(ctx, map[string]interface{}{"title": "Trip", "start": "2026-04-01", "end": "2026-04-03"})
+	require.NoError(t, err)
+	trip := provider.created[1]
+	assert.True(t, trip.AllDay)
+	assert.Equal(t, 3, int(trip.End.Sub(trip.Start).Hours()/24), "end date is inclusive")
+
+	_, err = tool.Execute(ctx, map[string]interface{}{"title": "Bad", "start": "2026-04-01", "end": "2026-04-01T10:00"})
+	assert.Error(t, err)
+	_, err = tool.Execute(ctx, map[string]interface{}{"title": "Bad", "start": "tomorrow"})
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/manthysbr/auleOS/internal/synapse"
 )
@@ -24,6 +25,41 @@ const (
 type CapabilityRoute struct {
 	Runtime     RuntimeKind
 	Description string
+	Plugin      string // Synapse plugin that runs the capability; "" = the capability name
+	Pinned      bool   // set by an operator override; never auto-demoted
+	Demoted     bool   // auto-demoted from Synapse to Muscle after repeated failures
+}
+
+// RouteStats aggregates execution outcomes for a capability route.
+type RouteStats struct {
+	Executions   int       `json:"executions"`
+	Failures     int       `json:"failures"`
+	TotalLatency int64     `json:"total_latency_ms"`
+	LastError    string    `json:"last_error,omitempty"`
+	LastRunAt    time.Time `json:"last_run_at,omitempty"`
+}
+
+// AvgLatencyMs returns the mean execution latency in milliseconds.
+func (s RouteStats) AvgLatencyMs() float64 {
+	if s.Executions == 0 {
+		return 0
+	}
+	return float64(s.TotalLatency) / float64(s.Executions)
+}
+
+// FailureRate returns failures/executions in [0,1].
+func (s RouteStats) FailureRate() float64 {
+	if s.Executions == 0 {
+		return 0
+	}
+	return float64(s.Failures) / float64(s.Executions)
+}
+
+// AutoDemotePolicy controls demotion of failing Synapse routes to Muscle.
+type AutoDemotePolicy struct {
+	Enabled     bool
+	MinSamples  int     // executions required before the rate is trusted
+	FailureRate float64 // demote when failure rate >= this
 }
 
 // CapabilityRouter decides whether a capability runs via Synapse (Wasm)
@@ -34,6 +70,8 @@ type CapabilityRouter struct {
 	mu      sync.RWMutex
 	logger  *slog.Logger
 	routes  map[string]CapabilityRoute
+	stats   map[string]*RouteStats
+	demote  AutoDemotePolicy
 	synapse *synapse.Runtime
 }
 
@@ -42,6 +80,8 @@ func NewCapabilityRouter(logger *slog.Logger, synapseRT *synapse.Runtime) *Capab
 	router := &CapabilityRouter{
 		logger:  logger,
 		routes:  make(map[string]CapabilityRoute),
+		stats:   make(map[string]*RouteStats),
+		demote:  AutoDemotePolicy{MinSamples: 5, FailureRate: 0.5},
 		synapse: synapseRT,
 	}
 
@@ -54,6 +94,14 @@ func NewCapabilityRouter(logger *slog.Logger, synapseRT *synapse.Runtime) *Capab
 		Runtime:     RuntimeMuscle,
 		Description: "LLM text generation via Ollama (requires GPU)",
 	}
+	router.routes["video.generate"] = CapabilityRoute{
+		Runtime:     RuntimeMuscle,
+		Description: "Video generation via AnimateDiff worker (requires GPU)",
+	}
+	router.routes["audio.generate"] = CapabilityRoute{
+		Runtime:     RuntimeMuscle,
+		Description: "Speech/audio generation via Bark worker (requires GPU)",
+	}
 	router.routes["video.transcode"] = CapabilityRoute{
 		Runtime:     RuntimeMuscle,
 		Description: "Video transcoding (heavy I/O)",
@@ -124,6 +172,105 @@ func (r *CapabilityRouter) RegisterRoute(capability string, route CapabilityRout
 	r.routes[strings.TrimSpace(strings.ToLower(capability))] = route
 }
 
+// SetOverride pins a capability to the given runtime at runtime (operator override).
+// Pinned routes are excluded from auto-demotion.
+func (r *CapabilityRouter) SetOverride(capability string, runtime RuntimeKind, description string) (CapabilityRoute, error) {
+	if runtime != RuntimeSynapse && runtime != RuntimeMuscle {
+		return CapabilityRoute{}, fmt.Errorf("invalid runtime %q (want %q or %q)", runtime, RuntimeSynapse, RuntimeMuscle)
+	}
+	capability = strings.TrimSpace(strings.ToLower(capability))
+	if capability == "" {
+		return CapabilityRoute{}, fmt.Errorf("capability name is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	route := r.routes[capability]
+	route.Runtime = runtime
+	route.Pinned = true
+	route.Demoted = false
+	if description != "" {
+		route.Description = description
+	}
+	r.routes[capability] = route
+
+	r.logger.Info("capability route overridden", "capability", capability, "runtime", runtime)
+	return route, nil
+}
+
+// SetAutoDemote configures automatic demotion of failing Synapse routes.
+func (r *CapabilityRouter) SetAutoDemote(policy AutoDemotePolicy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if policy.MinSamples <= 0 {
+		policy.MinSamples = 5
+	}
+	if policy.FailureRate <= 0 || policy.FailureRate > 1 {
+		policy.FailureRate = 0.5
+	}
+	r.demote = policy
+}
+
+// RecordExecution records the outcome of running a capability and, when the
+// auto-demote policy is enabled, moves a failing Synapse route to Muscle.
+func (r *CapabilityRouter) RecordExecution(capability string, latency time.Duration, err error) {
+	capability = strings.TrimSpace(strings.ToLower(capability))
+	if capability == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	st, ok := r.stats[capability]
+	if !ok {
+		st = &RouteStats{}
+		r.stats[capability] = st
+	}
+	st.Executions++
+	st.TotalLatency += latency.Milliseconds()
+	st.LastRunAt = time.Now()
+	if err != nil {
+		st.Failures++
+		st.LastError = err.Error()
+	}
+
+	if !r.demote.Enabled || st.Executions < r.demote.MinSamples || st.FailureRate() < r.demote.FailureRate {
+		return
+	}
+	route, ok := r.routes[capability]
+	if !ok && r.synapse != nil {
+		// Plugins resolve by name without a route of their own
+		if _, found := r.synapse.GetPlugin(capability); found {
+			route, ok = CapabilityRoute{Runtime: RuntimeSynapse, Description: "Synapse plugin " + capability}, true
+		}
+	}
+	if !ok || route.Runtime != RuntimeSynapse || route.Pinned {
+		return
+	}
+	route.Runtime = RuntimeMuscle
+	route.Demoted = true
+	r.routes[capability] = route
+	r.logger.Warn("capability auto-demoted to muscle",
+		"capability", capability,
+		"failure_rate", st.FailureRate(),
+		"executions", st.Executions,
+	)
+}
+
+// RouteStats returns a snapshot of per-route execution stats.
+func (r *CapabilityRouter) RouteStats() map[string]RouteStats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make(map[string]RouteStats, len(r.stats))
+	for k, v := range r.stats {
+		result[k] = *v
+	}
+	return result
+}
+
 // ListRoutes returns all registered capability routes.
 func (r *CapabilityRouter) ListRoutes() map[string]CapabilityRoute {
 	r.mu.RLock()
@@ -136,19 +283,30 @@ func (r *CapabilityRouter) ListRoutes() map[string]CapabilityRoute {
 	return result
 }
 
-// ExecuteSynapse runs a capability via the Synapse Wasm runtime.
-// The plugin must exist and be loaded in the synapse.Runtime.
-func (r *CapabilityRouter) ExecuteSynapse(ctx context.Context, pluginName string, params map[string]interface{}) (interface{}, error) {
-	if r.synapse == nil {
-		return nil, fmt.Errorf("synapse runtime is not available")
+// ExecuteSynapse runs a capability via the Synapse Wasm runtime, in the
+// plugin its route names, and records the outcome against the capability.
+// A missing plugin counts as a failure, so a broken route gets demoted too.
+func (r *CapabilityRouter) ExecuteSynapse(ctx context.Context, capability string, params map[string]interface{}) (interface{}, error) {
+	capability = strings.TrimSpace(strings.ToLower(capability))
+	r.mu.RLock()
+	pluginName := r.routes[capability].Plugin
+	r.mu.RUnlock()
+	if pluginName == "" {
+		pluginName = capability
 	}
 
-	plugin, ok := r.synapse.GetPlugin(pluginName)
-	if !ok {
-		return nil, fmt.Errorf("synapse plugin %q not found", pluginName)
+	start := time.Now()
+	var result interface{}
+	var err error
+	if r.synapse == nil {
+		err = fmt.Errorf("synapse runtime is not available")
+	} else if plugin, ok := r.synapse.GetPlugin(pluginName); !ok {
+		err = fmt.Errorf("synapse plugin %q not found", pluginName)
+	} else {
+		result, err = plugin.Execute(ctx, params)
 	}
-
-	return plugin.Execute(ctx, params)
+	r.RecordExecution(capability, time.Since(start), err)
+	return result, err
 }
 
 // Stats returns router statistics.
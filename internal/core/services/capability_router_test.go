@@ -2,10 +2,15 @@ package services
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
 	"github.com/manthysbr/auleOS/internal/synapse"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -103,3 +108,131 @@ func TestCapabilityRouterListRoutes(t *testing.T) {
 	assert.Equal(t, RuntimeMuscle, routes["image.generate"].Runtime)
 	assert.Equal(t, RuntimeSynapse, routes["prompt.enhance"].Runtime)
 }
+
+func TestCapabilityRouterOverride(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	router := NewCapabilityRouter(logger, nil)
+
+	route, err := router.SetOverride("Image.Generate", RuntimeSynapse, "")
+	require.NoError(t, err)
+	assert.True(t, route.Pinned)
+	assert.Equal(t, "Image generation via ComfyUI (requires GPU)", route.Description)
+	assert.Equal(t, RuntimeSynapse, router.Resolve("image.generate"))
+
+	_, err = router.SetOverride("image.generate", RuntimeKind("gpu"), "")
+	assert.Error(t, err)
+}
+
+func TestCapabilityRouterAutoDemote(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	router := NewCapabilityRouter(logger, nil)
+	router.SetAutoDemote(AutoDemotePolicy{Enabled: true, MinSamples: 4, FailureRate: 0.5})
+
+	fail := errors.New("trap")
+	router.RecordExecution("prompt.enhance", 10*time.Millisecond, nil)
+	router.RecordExecution("prompt.enhance", 30*time.Millisecond, fail)
+	router.RecordExecution("prompt.enhance", 20*time.Millisecond, fail)
+	assert.Equal(t, RuntimeSynapse, router.Resolve("prompt.enhance"), "below min samples")
+
+	router.RecordExecution("prompt.enhance", 20*time.Millisecond, nil)
+	assert.Equal(t, RuntimeMuscle, router.Resolve("prompt.enhance"))
+	assert.True(t, router.ListRoutes()["prompt.enhance"].Demoted)
+
+	st := router.RouteStats()["prompt.enhance"]
+	assert.Equal(t, 4, st.Executions)
+	assert.Equal(t, 2, st.Failures)
+	assert.InDelta(t, 20.0, st.AvgLatencyMs(), 0.01)
+	assert.Equal(t, "trap", st.LastError)
+
+	// Pinned routes are never demoted
+	_, err := router.SetOverride("json.transform", RuntimeSynapse, "")
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		router.RecordExecution("json.transform", time.Millisecond, fail)
+	}
+	assert.Equal(t, RuntimeSynapse, router.Resolve("json.transform"))
+}
+
+// memJobRepo keeps the jobs the dispatch tests save.
+type memJobRepo struct {
+	ports.Repository
+	jobs map[domain.JobID]domain.Job
+}
+
+func (r *memJobRepo) SaveJob(_ context.Context, job domain.Job) error {
+	r.jobs[job.ID] = job
+	return nil
+}
+
+func (r *memJobRepo) GetJob(_ context.Context, id domain.JobID) (domain.Job, error) {
+	job, ok := r.jobs[id]
+	if !ok {
+		return domain.Job{}, domain.ErrJobNotFound
+	}
+	return job, nil
+}
+
+func TestCapabilityJobsFollowRouteAndDemotion(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	wasmRT, err := synapse.NewRuntime(ctx, logger)
+	require.NoError(t, err)
+	defer wasmRT.Close(ctx)
+
+	// _start hits "unreachable", so every run traps
+	trapWasm := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00,
+		0x03, 0x02, 0x01, 0x00,
+		0x05, 0x03, 0x01, 0x00, 0x01,
+		0x07, 0x13, 0x02,
+		0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00,
+		0x06, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x00, 0x00,
+		0x0a, 0x05, 0x01, 0x03, 0x00, 0x00, 0x0b,
+	}
+	_, err = wasmRT.LoadPlugin(ctx, "formatter", trapWasm, synapse.PluginMeta{Name: "formatter", Version: "0.1.0", ToolName: "formatter"})
+	require.NoError(t, err)
+
+	router := NewCapabilityRouter(logger, wasmRT)
+	router.RegisterRoute("text.format", CapabilityRoute{Runtime: RuntimeSynapse, Plugin: "formatter"})
+	router.SetAutoDemote(AutoDemotePolicy{Enabled: true, MinSamples: 2, FailureRate: 0.5})
+
+	repo := &memJobRepo{jobs: map[domain.JobID]domain.Job{}}
+	wl := &WorkerLifecycle{
+		logger:             logger,
+		repo:               repo,
+		eventBus:           NewEventBus(logger),
+		capabilityHandlers: map[string]capabilityJobHandler{},
+		capRouter:          router,
+	}
+	muscleRuns := 0
+	wl.RegisterCapabilityHandler("text.format", func(ctx context.Context, job domain.Job) {
+		muscleRuns++
+		job.Status = domain.JobStatusCompleted
+		repo.SaveJob(ctx, job)
+	})
+
+	run := func(id domain.JobID) domain.Job {
+		job := domain.Job{ID: id, Metadata: map[string]string{"capability": "text.format", "input": "hi"}}
+		require.True(t, wl.dispatchCapabilityJob(ctx, job))
+		job, err := repo.GetJob(ctx, id)
+		require.NoError(t, err)
+		return job
+	}
+
+	assert.Equal(t, domain.JobStatusFailed, run("j1").Status)
+	assert.Equal(t, domain.JobStatusFailed, run("j2").Status)
+	assert.Zero(t, muscleRuns, "synapse routes don't reach the muscle handler")
+	route := router.ListRoutes()["text.format"]
+	assert.True(t, route.Demoted)
+	assert.Equal(t, RuntimeMuscle, route.Runtime)
+
+	assert.Equal(t, domain.JobStatusCompleted, run("j3").Status)
+	assert.Equal(t, 1, muscleRuns)
+
+	st := router.RouteStats()["text.format"]
+	assert.Equal(t, 3, st.Executions)
+	assert.Equal(t, 2, st.Failures)
+	assert.NotContains(t, router.RouteStats(), "formatter", "stats are kept per capability")
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"mime"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// chatArtifactStore is the persistence ChatArtifacts registers files in.
+type chatArtifactStore interface {
+	SaveArtifact(ctx context.Context, art domain.Artifact) error
+	ListArtifacts(ctx context.Context) ([]domain.Artifact, error)
+	ListProjectArtifacts(ctx context.Context, projectID domain.ProjectID) ([]domain.Artifact, error)
+}
+
+// ChatArtifacts registers the files the agent writes during a chat as
+// artifacts of the conversation (and its project), so they show up next to
+// generated images and documents. Which files count is set by the runtime
+// ChatArtifactPolicy.
+type ChatArtifacts struct {
+	logger *slog.Logger
+	repo   chatArtifactStore
+	ws     *WorkspaceManager
+
+	mu     sync.RWMutex
+	policy domain.ChatArtifactPolicy
+}
+
+// NewChatArtifacts creates the registrar with the default policy.
+func NewChatArtifacts(logger *slog.Logger, repo chatArtifactStore, ws *WorkspaceManager) *ChatArtifacts {
+	return &ChatArtifacts{logger: logger, repo: repo, ws: ws}
+}
+
+// SetPolicy replaces the policy; it applies to the next write.
+func (c *ChatArtifacts) SetPolicy(p domain.ChatArtifactPolicy) {
+	c.mu.Lock()
+	c.policy = p
+	c.mu.Unlock()
+}
+
+func (c *ChatArtifacts) currentPolicy() domain.ChatArtifactPolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.policy
+}
+
+// Wrap returns tool registering the file it wrote after every successful
+// call made within a conversation. A failed registration does not fail
+// the tool.
+func (c *ChatArtifacts) Wrap(tool *domain.Tool) *domain.Tool {
+	if c == nil {
+		return tool
+	}
+	wrapped := *tool
+	execute := tool.Execute
+	wrapped.Execute = func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+		result, err := execute(ctx, params)
+		if err != nil {
+			return result, err
+		}
+		path, _ := params["path"].(string)
+		projectID, _ := params["project_id"].(string)
+		if _, err := c.Register(ctx, projectID, path); err != nil {
+			c.logger.WarnContext(ctx, "failed to register written file as artifact", "tool", tool.Name, "path", path, "error", err)
+		}
+		return result, nil
+	}
+	return &wrapped
+}
+
+// Register records the workspace file at path as an artifact of the
+// conversation in ctx, updating the existing one when the conversation
+// wrote it before. It returns "" when the file is outside a conversation
+// or not significant under the policy.
+func (c *ChatArtifacts) Register(ctx context.Context, projectID, path string) (domain.ArtifactID, error) {
+	convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID)
+	if convID == "" || path == "" {
+		return "", nil
+	}
+	if projectID == "" {
+		if pID, found := GetProjectFromContext(ctx); found {
+			projectID = string(pID)
+		}
+	}
+	safePath, err := resolveWorkspacePath(ctx, c.ws, projectID, path)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(safePath)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() || !c.currentPolicy().Matches(safePath, info.Size()) {
+		return "", nil
+	}
+
+	art, err := c.existing(ctx, convID, projectID, safePath)
+	if err != nil {
+		return "", err
+	}
+	if art.ID == "" {
+		art = domain.Artifact{
+			ID:             domain.NewArtifactID(),
+			ConversationID: &convID,
+			Type:           domain.ArtifactTypeForPath(safePath),
+			FilePath:       safePath,
+			CreatedAt:      time.Now(),
+		}
+		if projectID != "" {
+			id := domain.ProjectID(projectID)
+			art.ProjectID = &id
+		}
+	}
+	art.Name = filepath.Base(path)
+	art.SizeBytes = info.Size()
+	art.MimeType = mime.TypeByExtension(filepath.Ext(safePath))
+	if art.MimeType == "" {
+		art.MimeType = "application/octet-stream"
+	}
+	if err := c.repo.SaveArtifact(ctx, art); err != nil {
+		return "", err
+	}
+	recordArtifact(ctx, art.ID)
+	return art.ID, nil
+}
+
+// existing returns the artifact the conversation already registered for
+// filePath, or a zero Artifact.
+func (c *ChatArtifacts) existing(ctx context.Context, convID domain.ConversationID, projectID, filePath string) (domain.Artifact, error) {
+	var (
+		arts []domain.Artifact
+		err  error
+	)
+	if projectID != "" {
+		arts, err = c.repo.ListProjectArtifacts(ctx, domain.ProjectID(projectID))
+	} else {
+		arts, err = c.repo.ListArtifacts(ctx)
+	}
+	if err != nil {
+		return domain.Artifact{}, err
+	}
+	for _, a := range arts {
+		if a.FilePath == filePath && a.JobID == nil && a.ConversationID != nil && *a.ConversationID == convID {
+			return a, nil
+		}
+	}
+	return domain.Artifact{}, nil
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+type memArtifactStore struct {
+	mu   sync.Mutex
+	arts map[domain.ArtifactID]domain.Artifact
+}
+
+func (m *memArtifactStore) SaveArtifact(_ context.Context, art domain.Artifact) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.arts == nil {
+		m.arts = map[domain.ArtifactID]domain.Artifact{}
+	}
+	m.arts[art.ID] = art
+	return nil
+}
+
+func (m *memArtifactStore) ListArtifacts(context.Context) ([]domain.Artifact, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []domain.Artifact
+	for _, a := range m.arts {
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+func (m *memArtifactStore) ListProjectArtifacts(ctx context.Context, projectID domain.ProjectID) ([]domain.Artifact, error) {
+	all, _ := m.ListArtifacts(ctx)
+	var out []domain.Artifact
+	for _, a := range all {
+		if a.ProjectID != nil && *a.ProjectID == projectID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func TestChatArtifacts_RegistersWrittenFiles(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	store := &memArtifactStore{}
+	reg := NewChatArtifacts(slog.New(slog.NewTextHandler(io.Discard, nil)), store, ws)
+	write := reg.Wrap(NewWriteFileTool(ws))
+
+	var recorded []domain.ArtifactID
+	ctx := ContextWithConversation(testProjectCtx("proj-1"), "conv-1")
+	ctx = contextWithArtifactRecorder(ctx, func(id domain.ArtifactID) { recorded = append(recorded, id) })
+
+	report := strings.Repeat("# Report\n", 20)
+	_, err := write.Execute(ctx, map[string]interface{}{"path": "out/report.md", "content": report})
+	require.NoError(t, err)
+	// Too small and wrong type: not significant
+	_, err = write.Execute(ctx, map[string]interface{}{"path": "notes.md", "content": "x"})
+	require.NoError(t, err)
+	_, err = write.Execute(ctx, map[string]interface{}{"path": "data.bin", "content": report})
+	require.NoError(t, err)
+
+	require.Len(t, store.arts, 1)
+	require.Len(t, recorded, 1)
+	art := store.arts[recorded[0]]
+	assert.Equal(t, "report.md", art.Name)
+	assert.Equal(t, domain.ArtifactTypeText, art.Type)
+	assert.Equal(t, int64(len(report)), art.SizeBytes)
+	require.NotNil(t, art.ConversationID)
+	assert.Equal(t, domain.ConversationID("conv-1"), *art.ConversationID)
+	require.NotNil(t, art.ProjectID)
+	assert.Equal(t, domain.ProjectID("proj-1"), *art.ProjectID)
+
+	// Rewriting the file updates the same artifact
+	_, err = write.Execute(ctx, map[string]interface{}{"path": "out/report.md", "content": report + report})
+	require.NoError(t, err)
+	require.Len(t, store.arts, 1)
+	assert.Equal(t, int64(2*len(report)), store.arts[recorded[0]].SizeBytes)
+}
+
+func TestChatArtifacts_PolicyAndScope(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	store := &memArtifactStore{}
+	reg := NewChatArtifacts(slog.New(slog.NewTextHandler(io.Discard, nil)), store, ws)
+	write := reg.Wrap(NewWriteFileTool(ws))
+	content := strings.Repeat("a,b\n", 10)
+
+	// Outside a conversation nothing is registered
+	_, err := write.Execute(testProjectCtx("proj-1"), map[string]interface{}{"path": "a.csv", "content": content})
+	require.NoError(t, err)
+	assert.Empty(t, store.arts)
+
+	ctx := ContextWithConversation(testProjectCtx("proj-1"), "conv-1")
+	reg.SetPolicy(domain.ChatArtifactPolicy{Extensions: []string{"csv"}, MinBytes: 1})
+	_, err = write.Execute(ctx, map[string]interface{}{"path": "a.csv", "content": content})
+	require.NoError(t, err)
+	assert.Len(t, store.arts, 1)
+
+	reg.SetPolicy(domain.ChatArtifactPolicy{Disabled: true})
+	_, err = write.Execute(ctx, map[string]interface{}{"path": "b.csv", "content": content})
+	require.NoError(t, err)
+	assert.Len(t, store.arts, 1)
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	// citationMinShared is how many distinct content words an observation
+	// must share with the answer to be cited.
+	citationMinShared = 3
+	// citationMinScore is the share of the answer's content words an
+	// observation must contain to be cited.
+	citationMinScore = 0.15
+	// maxCitations caps the sources listed on one answer.
+	maxCitations = 8
+	// citationSnippetRunes caps the quoted part of an observation.
+	citationSnippetRunes = 200
+)
+
+var (
+	citationURLRe      = regexp.MustCompile(`https?://[^\s"'<>()\[\]\\]+`)
+	citationArtifactRe = regexp.MustCompile(`\bart-[0-9a-f]{12}\b`)
+	citationWordRe     = regexp.MustCompile(`[\p{L}\p{N}]{4,}`)
+)
+
+// citationSkipTools write or ask rather than inform; the answer cannot
+// draw on what they return.
+var citationSkipTools = map[string]bool{
+	"write_file": true, "edit_file": true, "append_file": true, "apply_patch": true,
+	"scratchpad_write": true, "ask_user": true,
+}
+
+// citationStopwords are frequent words (en, pt, es) that say nothing about
+// where an answer came from.
+var citationStopwords = map[string]bool{
+	"that": true, "this": true, "with": true, "from": true, "have": true, "will": true,
+	"your": true, "which": true, "there": true, "their": true, "about": true, "would": true,
+	"been": true, "were": true, "they": true, "what": true, "when": true, "also": true,
+	"para": true, "como": true, "mais": true, "esta": true, "está": true, "isso": true,
+	"pelo": true, "pela": true, "sobre": true, "pero": true, "porque": true, "este": true,
+	"esto": true, "tiene": true, "true": true, "false": true, "null": true,
+}
+
+// citeObservations returns the tool observations answer draws on, best
+// first. An observation is cited when the answer repeats a URL from it, or
+// when enough of the answer's wording appears in it.
+func citeObservations(steps []domain.ReActStep, answer string) []domain.Citation {
+	answerWords := contentWords(answer)
+	if len(answerWords) == 0 && !strings.Contains(answer, "http") {
+		return nil
+	}
+
+	var cites []domain.Citation
+	for i, step := range steps {
+		obs := step.Observation
+		if step.IsFinalAnswer || step.Action == "" || obs == "" || strings.HasPrefix(obs, "Error:") || citationSkipTools[step.Action] {
+			continue
+		}
+		obsWords := contentWords(obs)
+		shared := 0
+		for w := range answerWords {
+			if obsWords[w] {
+				shared++
+			}
+		}
+		score := 0.0
+		if len(answerWords) > 0 {
+			score = float64(shared) / float64(len(answerWords))
+		}
+
+		base := domain.Citation{Step: i, Tool: step.Action, Params: step.ActionInput, Score: score}
+		base.URL, _ = step.ActionInput["url"].(string)
+		base.Path, _ = step.ActionInput["path"].(string)
+		if id, _ := step.ActionInput["artifact_id"].(string); id != "" {
+			base.ArtifactID = domain.ArtifactID(id)
+		} else if id := citationArtifactRe.FindString(obs); id != "" {
+			base.ArtifactID = domain.ArtifactID(id)
+		}
+
+		// A URL the answer repeats is a source on its own
+		var quoted []string
+		for _, u := range citationURLRe.FindAllString(obs, -1) {
+			u = strings.TrimRight(u, ".,;:!?")
+			if u != base.URL && strings.Contains(answer, u) && !slices.Contains(quoted, u) {
+				quoted = append(quoted, u)
+			}
+		}
+		for _, u := range quoted {
+			c := base
+			c.URL, c.Score = u, 1
+			c.Snippet = citationSnippet(obs, answerWords, u)
+			cites = append(cites, c)
+		}
+		if base.URL != "" && strings.Contains(answer, base.URL) {
+			base.Score = 1
+		} else if len(quoted) > 0 || shared < citationMinShared || score < citationMinScore {
+			continue
+		}
+		base.Snippet = citationSnippet(obs, answerWords, "")
+		cites = append(cites, base)
+	}
+
+	sort.SliceStable(cites, func(a, b int) bool { return cites[a].Score > cites[b].Score })
+	if len(cites) > maxCitations {
+		cites = cites[:maxCitations]
+	}
+	return cites
+}
+
+// contentWords returns the distinct lowercased words of s worth matching.
+func contentWords(s string) map[string]bool {
+	words := map[string]bool{}
+	for _, w := range citationWordRe.FindAllString(strings.ToLower(s), -1) {
+		if !citationStopwords[w] {
+			words[w] = true
+		}
+	}
+	return words
+}
+
+// citationSnippet picks the observation line containing must, or else the
+// one sharing the most words with the answer.
+func citationSnippet(obs string, answerWords map[string]bool, must string) string {
+	best, bestScore := "", 0
+	for _, line := range strings.Split(obs, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		score := 0
+		for w := range contentWords(line) {
+			if answerWords[w] {
+				score++
+			}
+		}
+		if must != "" {
+			if !strings.Contains(line, must) {
+				continue
+			}
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = line, score
+		}
+	}
+	// Long lines (JSON results) are cut around the URL when there is one
+	start := 0
+	if i := strings.Index(best, must); must != "" && i >= 0 {
+		start = max(0, len([]rune(best[:i]))-citationSnippetRunes/3)
+	}
+	r := []rune(best)
+	if len(r)-start <= citationSnippetRunes {
+		start = max(0, len(r)-citationSnippetRunes)
+	}
+	best = string(r[start:min(len(r), start+citationSnippetRunes)])
+	if start > 0 {
+		best = "…" + best
+	}
+	if start+citationSnippetRunes < len(r) {
+		best += "…"
+	}
+	return best
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func TestCiteObservations(t *testing.T) {
+	steps := []domain.ReActStep{
+		{
+			Action:      "web_search",
+			ActionInput: map[string]interface{}{"query": "duckdb release"},
+			Observation: `{"results":[{"title":"DuckDB 1.2 released","url":"https://duckdb.org/2025/02/05/announcing-duckdb-120.html"},{"title":"Other","url":"https://example.com/other"}]}`,
+		},
+		{
+			Action:      "read_file",
+			ActionInput: map[string]interface{}{"path": "notes/budget.md"},
+			Observation: "Quarterly budget for marketing: 12000 dollars\nHeadcount unchanged",
+		},
+		{
+			Action:      "list_dir",
+			ActionInput: map[string]interface{}{"path": "."},
+			Observation: "src/\ndocs/\nMakefile",
+		},
+		{Action: "read_file", Observation: "Error: file not found"},
+		{
+			Action:      "write_file",
+			ActionInput: map[string]interface{}{"path": "report.md"},
+			Observation: "Written to report.md: quarterly budget marketing 12000 dollars",
+		},
+		{IsFinalAnswer: true, FinalAnswer: "ignored"},
+	}
+	answer := "The quarterly marketing budget is 12000 dollars. DuckDB 1.2 was announced at https://duckdb.org/2025/02/05/announcing-duckdb-120.html."
+
+	cites := citeObservations(steps, answer)
+	require.Len(t, cites, 2)
+
+	assert.Equal(t, 0, cites[0].Step)
+	assert.Equal(t, "web_search", cites[0].Tool)
+	assert.Equal(t, "https://duckdb.org/2025/02/05/announcing-duckdb-120.html", cites[0].URL)
+	assert.Equal(t, 1.0, cites[0].Score)
+	assert.Contains(t, cites[0].Snippet, "duckdb.org")
+
+	assert.Equal(t, 1, cites[1].Step)
+	assert.Equal(t, "notes/budget.md", cites[1].Path)
+	assert.Equal(t, "Quarterly budget for marketing: 12000 dollars", cites[1].Snippet)
+	assert.Greater(t, cites[1].Score, citationMinScore)
+}
+
+func TestCiteObservations_NothingShared(t *testing.T) {
+	steps := []domain.ReActStep{{Action: "get_time", Observation: `"2026-01-01T10:00:00Z"`}}
+	assert.Empty(t, citeObservations(steps, "Hello! How can I help you today?"))
+	assert.Empty(t, citeObservations(nil, ""))
+}
+
+func TestCitationSnippet_LongLineAroundURL(t *testing.T) {
+	url := "https://example.com/deep/link"
+	obs := strings.Repeat("filler ", 100) + url + strings.Repeat(" tail", 100)
+	snippet := citationSnippet(obs, nil, url)
+	assert.Contains(t, snippet, url)
+	assert.True(t, strings.HasPrefix(snippet, "…"))
+	assert.True(t, strings.HasSuffix(snippet, "…"))
+	assert.LessOrEqual(t, len([]rune(snippet)), citationSnippetRunes+2)
+}
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// DefaultAskUserTimeout is how long ask_user waits for an answer before
+// the agent proceeds on its own.
+const DefaultAskUserTimeout = 5 * time.Minute
+
+// Question outcomes, as sent in question_closed events.
+const (
+	questionAnswered  = "answered"
+	questionTimedOut  = "timeout"
+	questionCancelled = "cancelled"
+)
+
+// Clarifier pauses an agent on a question to the user (the ask_user tool)
+// until the answer arrives through the API. One question per conversation
+// is open at a time.
+type Clarifier struct {
+	logger   *slog.Logger
+	eventBus *EventBus
+	timeout  time.Duration
+
+	mu      sync.Mutex
+	pending map[domain.ConversationID]*pendingQuestion
+}
+
+type pendingQuestion struct {
+	q      domain.Question
+	answer chan string // buffered; receives at most one answer
+}
+
+// NewClarifier creates the clarifier; timeout <= 0 uses DefaultAskUserTimeout.
+func NewClarifier(logger *slog.Logger, eventBus *EventBus, timeout time.Duration) *Clarifier {
+	if timeout <= 0 {
+		timeout = DefaultAskUserTimeout
+	}
+	return &Clarifier{
+		logger:   logger,
+		eventBus: eventBus,
+		timeout:  timeout,
+		pending:  make(map[domain.ConversationID]*pendingQuestion),
+	}
+}
+
+// Ask publishes question to the conversation's event stream and blocks
+// until it is answered. It returns answered=false when the timeout passes
+// first; the caller should then go on with its best assumption.
+func (c *Clarifier) Ask(ctx context.Context, convID domain.ConversationID, question string, options []string) (answer string, answered bool, err error) {
+	now := time.Now()
+	p := &pendingQuestion{
+		q: domain.Question{
+			ID:             domain.NewQuestionID(),
+			ConversationID: convID,
+			Question:       question,
+			Options:        options,
+			AskedAt:        now,
+			ExpiresAt:      now.Add(c.timeout),
+		},
+		answer: make(chan string, 1),
+	}
+
+	c.mu.Lock()
+	if _, busy := c.pending[convID]; busy {
+		c.mu.Unlock()
+		return "", false, domain.ErrQuestionPending
+	}
+	c.pending[convID] = p
+	c.mu.Unlock()
+
+	notifyAwaitingInput(ctx, &p.q)
+	defer notifyAwaitingInput(ctx, nil)
+	c.publish(ctx, EventTypeQuestion, p.q)
+
+	timer := time.NewTimer(c.timeout)
+	defer timer.Stop()
+	outcome := questionAnswered
+	select {
+	case answer = <-p.answer:
+		answered = true
+	case <-timer.C:
+		outcome = questionTimedOut
+	case <-ctx.Done():
+		outcome, err = questionCancelled, ctx.Err()
+	}
+
+	c.mu.Lock()
+	if c.pending[convID] == p {
+		delete(c.pending, convID)
+	}
+	c.mu.Unlock()
+	if outcome == questionTimedOut {
+		// An answer that raced the timer still counts
+		select {
+		case answer = <-p.answer:
+			answered, outcome = true, questionAnswered
+		default:
+		}
+	}
+	c.publish(ctx, EventTypeQuestionClosed, map[string]interface{}{
+		"conversation_id": string(convID),
+		"question_id":     string(p.q.ID),
+		"status":          outcome,
+	})
+	c.logger.InfoContext(ctx, "clarifying question closed", "conversation_id", string(convID), "question_id", string(p.q.ID), "status", outcome)
+	return answer, answered, err
+}
+
+// Answer delivers the user's answer to the open question of a conversation.
+// questionID may be empty; when given it must match the open question, so
+// an answer to an expired question is not taken for a newer one.
+func (c *Clarifier) Answer(convID domain.ConversationID, questionID domain.QuestionID, answer string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.pending[convID]
+	if !ok || (questionID != "" && questionID != p.q.ID) {
+		return domain.ErrNoPendingQuestion
+	}
+	delete(c.pending, convID)
+	p.answer <- answer
+	return nil
+}
+
+// Pending returns the open question of a conversation, e.g. for a client
+// that reconnects while the agent waits.
+func (c *Clarifier) Pending(convID domain.ConversationID) (domain.Question, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if p, ok := c.pending[convID]; ok {
+		return p.q, true
+	}
+	return domain.Question{}, false
+}
+
+func (c *Clarifier) publish(ctx context.Context, typ EventType, payload interface{}) {
+	if c.eventBus == nil {
+		return
+	}
+	var convID domain.ConversationID
+	switch v := payload.(type) {
+	case domain.Question:
+		convID = v.ConversationID
+	case map[string]interface{}:
+		id, _ := v["conversation_id"].(string)
+		convID = domain.ConversationID(id)
+	}
+	data, _ := json.Marshal(payload)
+	c.eventBus.PublishContext(ctx, Event{
+		JobID:     string(convID), // EventBus key = conversation ID
+		Type:      typ,
+		Data:      string(data),
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
+
+// NewAskUserTool creates the ask_user tool, which pauses the agent on a
+// clarifying question instead of letting it guess.
+func NewAskUserTool(c *Clarifier) *domain.Tool {
+	return &domain.Tool{
+		Name:        "ask_user",
+		Timeout:     c.timeout + time.Minute, // the clarifier times out first
+		Description: "Asks the user a clarifying question and waits for the answer. Use it only when the request is ambiguous and a wrong guess would waste work (e.g. which file, which format, which of two readings). Do not use it for chit-chat or to confirm obvious steps.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"question": map[string]interface{}{
+					"type":        "string",
+					"description": "One short, specific question.",
+				},
+				"options": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Optional suggested answers the user can pick from.",
+				},
+			},
+			Required: []string{"question"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			question, _ := params["question"].(string)
+			question = strings.TrimSpace(question)
+			if question == "" {
+				return nil, fmt.Errorf("question is required")
+			}
+			var options []string
+			if raw, ok := params["options"].([]interface{}); ok {
+				for _, o := range raw {
+					if s := strings.TrimSpace(fmt.Sprint(o)); s != "" {
+						options = append(options, s)
+					}
+				}
+			}
+			convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID)
+			if convID == "" {
+				return nil, fmt.Errorf("ask_user requires a conversation")
+			}
+
+			answer, answered, err := c.Ask(ctx, convID, question, options)
+			if err != nil {
+				return nil, err
+			}
+			if !answered {
+				return fmt.Sprintf("The user did not answer within %s. Proceed with your best assumption and state it in your answer.", c.timeout), nil
+			}
+			return fmt.Sprintf("The user answered: %s", answer), nil
+		},
+	}
+}
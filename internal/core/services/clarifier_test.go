@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitPending polls until conv has an open question.
+func waitPending(t *testing.T, c *Clarifier, convID domain.ConversationID) domain.Question {
+	t.Helper()
+	var q domain.Question
+	require.Eventually(t, func() bool {
+		var ok bool
+		q, ok = c.Pending(convID)
+		return ok
+	}, time.Second, 5*time.Millisecond)
+	return q
+}
+
+func TestClarifier_AskAndAnswer(t *testing.T) {
+	bus := NewEventBus(slog.Default())
+	events, unsubscribe := bus.Subscribe("conv-1")
+	defer unsubscribe()
+	c := NewClarifier(slog.Default(), bus, time.Minute)
+
+	var waiting []*domain.Question
+	ctx := contextWithInputWaiter(ContextWithConversation(context.Background(), "conv-1"), func(q *domain.Question) {
+		waiting = append(waiting, q)
+	})
+
+	type result struct {
+		out interface{}
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		out, err := NewAskUserTool(c).Execute(ctx, map[string]interface{}{
+			"question": "Which format?",
+			"options":  []interface{}{"PDF", "Markdown"},
+		})
+		done <- result{out, err}
+	}()
+
+	q := waitPending(t, c, "conv-1")
+	assert.Equal(t, "Which format?", q.Question)
+	assert.Equal(t, []string{"PDF", "Markdown"}, q.Options)
+
+	ev := <-events
+	assert.Equal(t, EventTypeQuestion, ev.Type)
+	var published domain.Question
+	require.NoError(t, json.Unmarshal([]byte(ev.Data), &published))
+	assert.Equal(t, q.ID, published.ID)
+
+	_, busy, err := c.Ask(ctx, "conv-1", "Another?", nil)
+	assert.ErrorIs(t, err, domain.ErrQuestionPending)
+	assert.False(t, busy)
+
+	assert.ErrorIs(t, c.Answer("conv-1", "q-stale", "PDF"), domain.ErrNoPendingQuestion)
+	require.NoError(t, c.Answer("conv-1", q.ID, "Markdown"))
+
+	res := <-done
+	require.NoError(t, res.err)
+	assert.Equal(t, "The user answered: Markdown", res.out)
+
+	ev = <-events
+	assert.Equal(t, EventTypeQuestionClosed, ev.Type)
+	assert.Contains(t, ev.Data, `"status":"answered"`)
+
+	require.Len(t, waiting, 2)
+	assert.Equal(t, q.ID, waiting[0].ID)
+	assert.Nil(t, waiting[1])
+
+	_, ok := c.Pending("conv-1")
+	assert.False(t, ok)
+	assert.ErrorIs(t, c.Answer("conv-1", "", "late"), domain.ErrNoPendingQuestion)
+}
+
+func TestClarifier_TimeoutAndCancel(t *testing.T) {
+	c := NewClarifier(slog.Default(), nil, 20*time.Millisecond)
+	ctx := ContextWithConversation(context.Background(), "conv-1")
+
+	out, err := NewAskUserTool(c).Execute(ctx, map[string]interface{}{"question": "Which file?"})
+	require.NoError(t, err)
+	assert.Contains(t, out, "did not answer")
+
+	c = NewClarifier(slog.Default(), nil, time.Minute)
+	cancelCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		for {
+			if _, ok := c.Pending("conv-1"); ok {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+	}()
+	_, answered, err := c.Ask(cancelCtx, "conv-1", "Which file?", nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, answered)
+	_, ok := c.Pending("conv-1")
+	assert.False(t, ok)
+
+	_, err = NewAskUserTool(c).Execute(context.Background(), map[string]interface{}{"question": "x"})
+	assert.Error(t, err)
+	_, err = NewAskUserTool(c).Execute(ctx, map[string]interface{}{"question": " "})
+	assert.Error(t, err)
+}
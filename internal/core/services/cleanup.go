@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// cleanupSampleSize is how many IDs a dry run lists for review.
+const cleanupSampleSize = 10
+
+// cleanupRepository is the persistence the bulk cleanups delete from.
+type cleanupRepository interface {
+	ListJobs(ctx context.Context) ([]domain.Job, error)
+	DeleteJob(ctx context.Context, id domain.JobID) error
+	GetProject(ctx context.Context, id domain.ProjectID) (domain.Project, error)
+	ListProjectArtifacts(ctx context.Context, projectID domain.ProjectID) ([]domain.Artifact, error)
+	DeleteArtifact(ctx context.Context, id domain.ArtifactID) error
+}
+
+// cleanupToken is an outstanding dry run. The cutoff is fixed at dry-run
+// time so the confirmed cleanup applies the criteria that were reviewed.
+type cleanupToken struct {
+	req       domain.CleanupRequest
+	cutoff    time.Time
+	expiresAt time.Time
+}
+
+// CleanupService runs bulk deletions in two steps: Plan counts what a
+// request would delete and issues a confirmation token, and Execute
+// deletes once that token is presented. Tokens are single-use and expire
+// after domain.CleanupTokenTTL.
+type CleanupService struct {
+	logger *slog.Logger
+	repo   cleanupRepository
+	convs  *ConversationStore
+	traces *TraceCollector   // optional
+	ws     *WorkspaceManager // optional; job workspaces are removed with failed jobs
+
+	mu     sync.Mutex
+	tokens map[string]cleanupToken
+	now    func() time.Time
+}
+
+// NewCleanupService creates the service.
+func NewCleanupService(logger *slog.Logger, repo cleanupRepository, convs *ConversationStore, traces *TraceCollector, ws *WorkspaceManager) *CleanupService {
+	return &CleanupService{
+		logger: logger,
+		repo:   repo,
+		convs:  convs,
+		traces: traces,
+		ws:     ws,
+		tokens: make(map[string]cleanupToken),
+		now:    time.Now,
+	}
+}
+
+// Plan is the dry run: it counts what req would delete and returns the
+// token that confirms it.
+func (s *CleanupService) Plan(ctx context.Context, req domain.CleanupRequest) (domain.CleanupPlan, error) {
+	if err := req.Validate(); err != nil {
+		return domain.CleanupPlan{}, err
+	}
+	now := s.now()
+	cutoff := req.Cutoff(now)
+
+	plan := domain.CleanupPlan{Request: req}
+	if req.Kind == domain.CleanupTraces {
+		n, err := s.clearTraces(ctx, cutoff, true)
+		if err != nil {
+			return domain.CleanupPlan{}, err
+		}
+		plan.Count = n
+	} else {
+		ids, err := s.targets(ctx, req, cutoff)
+		if err != nil {
+			return domain.CleanupPlan{}, err
+		}
+		plan.Count = len(ids)
+		plan.Sample = ids[:min(len(ids), cleanupSampleSize)]
+	}
+
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	plan.ConfirmToken = hex.EncodeToString(b)
+	plan.ExpiresAt = now.Add(domain.CleanupTokenTTL)
+
+	s.mu.Lock()
+	for t, pending := range s.tokens {
+		if now.After(pending.expiresAt) {
+			delete(s.tokens, t)
+		}
+	}
+	s.tokens[plan.ConfirmToken] = cleanupToken{req: req, cutoff: cutoff, expiresAt: plan.ExpiresAt}
+	s.mu.Unlock()
+	return plan, nil
+}
+
+// Execute deletes what req selects, given the token of a dry run of the
+// same request. Items that fail to delete are reported, not fatal.
+func (s *CleanupService) Execute(ctx context.Context, req domain.CleanupRequest, token string) (domain.CleanupResult, error) {
+	if err := req.Validate(); err != nil {
+		return domain.CleanupResult{}, err
+	}
+	s.mu.Lock()
+	pending, ok := s.tokens[token]
+	if ok && pending.req == req {
+		delete(s.tokens, token)
+	}
+	s.mu.Unlock()
+	if !ok || pending.req != req || s.now().After(pending.expiresAt) {
+		return domain.CleanupResult{}, domain.ErrCleanupToken
+	}
+
+	result := domain.CleanupResult{Request: req}
+	if req.Kind == domain.CleanupTraces {
+		n, err := s.clearTraces(ctx, pending.cutoff, false)
+		if err != nil {
+			return result, err
+		}
+		result.Deleted = n
+	} else {
+		ids, err := s.targets(ctx, req, pending.cutoff)
+		if err != nil {
+			return result, err
+		}
+		for _, id := range ids {
+			skipped, err := s.deleteOne(ctx, req.Kind, id)
+			switch {
+			case err != nil:
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", id, err))
+			case skipped:
+				result.Skipped++
+			default:
+				result.Deleted++
+			}
+		}
+	}
+
+	s.logger.InfoContext(ctx, "bulk cleanup done", "kind", req.Kind, "older_than_days", req.OlderThanDays,
+		"project_id", string(req.ProjectID), "deleted", result.Deleted, "skipped", result.Skipped, "errors", len(result.Errors))
+	return result, nil
+}
+
+// targets lists the IDs req selects, by age against cutoff (zero = any
+// age).
+func (s *CleanupService) targets(ctx context.Context, req domain.CleanupRequest, cutoff time.Time) ([]string, error) {
+	older := func(t time.Time) bool { return cutoff.IsZero() || t.Before(cutoff) }
+	var ids []string
+	switch req.Kind {
+	case domain.CleanupConversations:
+		convs, err := s.convs.ListConversations(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list conversations: %w", err)
+		}
+		for _, c := range convs {
+			// Pinned conversations and the kernel inbox are never bulk-deleted
+			if c.Pinned || c.ID == domain.SystemConversationID || !older(c.UpdatedAt) {
+				continue
+			}
+			ids = append(ids, string(c.ID))
+		}
+	case domain.CleanupFailedJobs:
+		jobs, err := s.repo.ListJobs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list jobs: %w", err)
+		}
+		for _, j := range jobs {
+			if j.Status == domain.JobStatusFailed && older(j.UpdatedAt) {
+				ids = append(ids, string(j.ID))
+			}
+		}
+	case domain.CleanupProjectArtifacts:
+		if _, err := s.repo.GetProject(ctx, req.ProjectID); err != nil {
+			return nil, err
+		}
+		arts, err := s.repo.ListProjectArtifacts(ctx, req.ProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("list artifacts: %w", err)
+		}
+		for _, a := range arts {
+			if older(a.CreatedAt) {
+				ids = append(ids, string(a.ID))
+			}
+		}
+	}
+	return ids, nil
+}
+
+// deleteOne deletes one selected item. Conversations with an agent turn
+// running are skipped.
+func (s *CleanupService) deleteOne(ctx context.Context, kind domain.CleanupKind, id string) (skipped bool, err error) {
+	switch kind {
+	case domain.CleanupConversations:
+		convID := domain.ConversationID(id)
+		release, err := s.convs.AcquireTurn(ctx, convID, 0)
+		if err != nil {
+			return true, nil
+		}
+		defer release()
+		return false, s.convs.DeleteConversation(ctx, convID)
+	case domain.CleanupFailedJobs:
+		if err := s.repo.DeleteJob(ctx, domain.JobID(id)); err != nil {
+			return false, err
+		}
+		if s.ws != nil {
+			if err := s.ws.CleanupWorkspace(id); err != nil {
+				s.logger.WarnContext(ctx, "failed to remove job workspace", "job_id", id, "error", err)
+			}
+		}
+		return false, nil
+	case domain.CleanupProjectArtifacts:
+		return false, s.repo.DeleteArtifact(ctx, domain.ArtifactID(id))
+	}
+	return false, fmt.Errorf("%w: unknown kind %q", domain.ErrInvalidCleanup, kind)
+}
+
+// clearTraces counts (dryRun) or deletes finished traces before cutoff;
+// the zero cutoff means all of them.
+func (s *CleanupService) clearTraces(ctx context.Context, cutoff time.Time, dryRun bool) (int, error) {
+	if s.traces == nil {
+		return 0, fmt.Errorf("%w: tracing is not enabled", domain.ErrInvalidCleanup)
+	}
+	if cutoff.IsZero() {
+		cutoff = s.now()
+	}
+	return s.traces.ClearTraces(ctx, cutoff, dryRun)
+}
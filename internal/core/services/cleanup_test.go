@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memCleanupRepo holds the conversations, jobs and artifacts the cleanup
+// tests delete from.
+type memCleanupRepo struct {
+	ports.Repository
+	convs     []domain.Conversation
+	jobs      []domain.Job
+	artifacts []domain.Artifact
+}
+
+func (r *memCleanupRepo) ListConversations(context.Context) ([]domain.Conversation, error) {
+	return r.convs, nil
+}
+
+func (r *memCleanupRepo) DeleteConversation(_ context.Context, id domain.ConversationID) error {
+	for i, c := range r.convs {
+		if c.ID == id {
+			r.convs = append(r.convs[:i], r.convs[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrConversationNotFound
+}
+
+func (r *memCleanupRepo) ListJobs(context.Context) ([]domain.Job, error) { return r.jobs, nil }
+
+func (r *memCleanupRepo) DeleteJob(_ context.Context, id domain.JobID) error {
+	for i, j := range r.jobs {
+		if j.ID == id {
+			r.jobs = append(r.jobs[:i], r.jobs[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrJobNotFound
+}
+
+func (r *memCleanupRepo) GetProject(_ context.Context, id domain.ProjectID) (domain.Project, error) {
+	if id != "proj-1" {
+		return domain.Project{}, domain.ErrProjectNotFound
+	}
+	return domain.Project{ID: id}, nil
+}
+
+func (r *memCleanupRepo) ListProjectArtifacts(_ context.Context, id domain.ProjectID) ([]domain.Artifact, error) {
+	var out []domain.Artifact
+	for _, a := range r.artifacts {
+		if a.ProjectID != nil && *a.ProjectID == id {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (r *memCleanupRepo) DeleteArtifact(_ context.Context, id domain.ArtifactID) error {
+	for i, a := range r.artifacts {
+		if a.ID == id {
+			r.artifacts = append(r.artifacts[:i], r.artifacts[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrArtifactNotFound
+}
+
+func newTestCleanup(t *testing.T, repo *memCleanupRepo) (*CleanupService, *ConversationStore) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	convs := NewConversationStore(repo, 0)
+	ws, _ := testWorkspaceManager(t)
+	return NewCleanupService(logger, repo, convs, NewTraceCollector(logger, nil, nil), ws), convs
+}
+
+func TestCleanup_Conversations(t *testing.T) {
+	old := time.Now().AddDate(0, 0, -100)
+	repo := &memCleanupRepo{convs: []domain.Conversation{
+		{ID: "conv-old", UpdatedAt: old},
+		{ID: "conv-busy", UpdatedAt: old},
+		{ID: "conv-pinned", UpdatedAt: old, Pinned: true},
+		{ID: domain.SystemConversationID, UpdatedAt: old},
+		{ID: "conv-new", UpdatedAt: time.Now()},
+	}}
+	svc, convs := newTestCleanup(t, repo)
+	ctx := context.Background()
+	req := domain.CleanupRequest{Kind: domain.CleanupConversations, OlderThanDays: 90}
+
+	plan, err := svc.Plan(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 2, plan.Count)
+	assert.ElementsMatch(t, []string{"conv-old", "conv-busy"}, plan.Sample)
+	assert.NotEmpty(t, plan.ConfirmToken)
+	assert.Len(t, repo.convs, 5, "a dry run deletes nothing")
+
+	release, err := convs.AcquireTurn(ctx, "conv-busy", 0)
+	require.NoError(t, err)
+	defer release()
+
+	result, err := svc.Execute(ctx, req, plan.ConfirmToken)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Deleted)
+	assert.Equal(t, 1, result.Skipped)
+	assert.Len(t, repo.convs, 4)
+
+	// Tokens are single-use
+	_, err = svc.Execute(ctx, req, plan.ConfirmToken)
+	assert.ErrorIs(t, err, domain.ErrCleanupToken)
+}
+
+func TestCleanup_TokenChecks(t *testing.T) {
+	svc, _ := newTestCleanup(t, &memCleanupRepo{})
+	ctx := context.Background()
+	req := domain.CleanupRequest{Kind: domain.CleanupFailedJobs}
+
+	plan, err := svc.Plan(ctx, req)
+	require.NoError(t, err)
+
+	// A token only confirms the request it was issued for
+	_, err = svc.Execute(ctx, domain.CleanupRequest{Kind: domain.CleanupFailedJobs, OlderThanDays: 1}, plan.ConfirmToken)
+	assert.ErrorIs(t, err, domain.ErrCleanupToken)
+	_, err = svc.Execute(ctx, req, "bogus")
+	assert.ErrorIs(t, err, domain.ErrCleanupToken)
+
+	// Expired tokens are refused
+	svc.now = func() time.Time { return time.Now().Add(domain.CleanupTokenTTL + time.Second) }
+	_, err = svc.Execute(ctx, req, plan.ConfirmToken)
+	assert.ErrorIs(t, err, domain.ErrCleanupToken)
+
+	_, err = svc.Plan(ctx, domain.CleanupRequest{Kind: domain.CleanupConversations})
+	assert.ErrorIs(t, err, domain.ErrInvalidCleanup)
+	_, err = svc.Plan(ctx, domain.CleanupRequest{Kind: "everything"})
+	assert.ErrorIs(t, err, domain.ErrInvalidCleanup)
+}
+
+func TestCleanup_FailedJobsAndArtifacts(t *testing.T) {
+	proj := domain.ProjectID("proj-1")
+	other := domain.ProjectID("proj-2")
+	repo := &memCleanupRepo{
+		jobs: []domain.Job{
+			{ID: "job-failed", Status: domain.JobStatusFailed},
+			{ID: "job-done", Status: domain.JobStatusCompleted},
+		},
+		artifacts: []domain.Artifact{
+			{ID: "art-1", ProjectID: &proj},
+			{ID: "art-2", ProjectID: &proj},
+			{ID: "art-3", ProjectID: &other},
+		},
+	}
+	svc, _ := newTestCleanup(t, repo)
+	ctx := context.Background()
+	jobDir := svc.ws.GetPath("job-failed")
+	require.NoError(t, os.MkdirAll(jobDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(jobDir, "out.png"), []byte("x"), 0644))
+
+	req := domain.CleanupRequest{Kind: domain.CleanupFailedJobs}
+	plan, err := svc.Plan(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"job-failed"}, plan.Sample)
+	result, err := svc.Execute(ctx, req, plan.ConfirmToken)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Deleted)
+	assert.Len(t, repo.jobs, 1)
+	assert.NoDirExists(t, jobDir)
+
+	req = domain.CleanupRequest{Kind: domain.CleanupProjectArtifacts, ProjectID: proj}
+	plan, err = svc.Plan(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 2, plan.Count)
+	result, err = svc.Execute(ctx, req, plan.ConfirmToken)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Deleted)
+	assert.Len(t, repo.artifacts, 1)
+
+	_, err = svc.Plan(ctx, domain.CleanupRequest{Kind: domain.CleanupProjectArtifacts, ProjectID: "missing"})
+	assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+}
+
+func TestCleanup_Traces(t *testing.T) {
+	svc, _ := newTestCleanup(t, &memCleanupRepo{})
+	ctx := context.Background()
+	_, done, _ := svc.traces.StartTrace(ctx, "chat: done", nil)
+	svc.traces.EndTrace(done, domain.SpanStatusOK, "")
+	_, running, _ := svc.traces.StartTrace(ctx, "chat: running", nil)
+
+	req := domain.CleanupRequest{Kind: domain.CleanupTraces}
+	plan, err := svc.Plan(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 1, plan.Count, "running traces are kept")
+
+	result, err := svc.Execute(ctx, req, plan.ConfirmToken)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Deleted)
+	_, err = svc.traces.GetTrace(ctx, done)
+	assert.Error(t, err)
+	_, err = svc.traces.GetTrace(ctx, running)
+	assert.NoError(t, err)
+}
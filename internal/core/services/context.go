@@ -10,7 +10,13 @@ import (
 type serviceContextKey string
 
 const (
-	ctxKeyProjectID serviceContextKey = "project_id"
+	ctxKeyProjectID     serviceContextKey = "project_id"
+	ctxKeyModelOverride serviceContextKey = "model_override"
+	ctxKeyMemoryScope   serviceContextKey = "memory_scope"
+	ctxKeyContextVars   serviceContextKey = "context_vars"
+	ctxKeyPersona       serviceContextKey = "persona"
+	ctxKeyInputWaiter   serviceContextKey = "input_waiter"
+	ctxKeyArtifacts     serviceContextKey = "turn_artifacts"
 )
 
 // ContextWithProject injects the ProjectID into the context
@@ -23,3 +29,88 @@ func GetProjectFromContext(ctx context.Context) (domain.ProjectID, bool) {
 	id, ok := ctx.Value(ctxKeyProjectID).(domain.ProjectID)
 	return id, ok
 }
+
+// ContextWithModel forces the agent to use the given model for this call,
+// taking precedence over persona and project defaults (used by evals)
+func ContextWithModel(ctx context.Context, modelID string) context.Context {
+	return context.WithValue(ctx, ctxKeyModelOverride, modelID)
+}
+
+// GetModelFromContext retrieves a forced model ID from the context
+func GetModelFromContext(ctx context.Context) (string, bool) {
+	m, ok := ctx.Value(ctxKeyModelOverride).(string)
+	return m, ok && m != ""
+}
+
+// ContextWithConversationVars makes a conversation's context variables
+// available to the tools it runs.
+func ContextWithConversationVars(ctx context.Context, vars domain.ContextVars) context.Context {
+	return context.WithValue(ctx, ctxKeyContextVars, vars)
+}
+
+// GetConversationVarsFromContext returns the context variables of the
+// conversation a tool runs in. Tools must not modify the map.
+func GetConversationVarsFromContext(ctx context.Context) (domain.ContextVars, bool) {
+	vars, ok := ctx.Value(ctxKeyContextVars).(domain.ContextVars)
+	return vars, ok
+}
+
+// ContextWithPersona records the persona an agent loop runs as, so tools
+// can attribute their work to it.
+func ContextWithPersona(ctx context.Context, p domain.Persona) context.Context {
+	return context.WithValue(ctx, ctxKeyPersona, p)
+}
+
+// GetPersonaFromContext returns the persona the calling loop runs as.
+func GetPersonaFromContext(ctx context.Context) (domain.Persona, bool) {
+	p, ok := ctx.Value(ctxKeyPersona).(domain.Persona)
+	return p, ok
+}
+
+// contextWithInputWaiter lets a ReAct loop record in its checkpoint that a
+// tool is waiting for the user: fn gets the open question, then nil once
+// it is closed.
+func contextWithInputWaiter(ctx context.Context, fn func(q *domain.Question)) context.Context {
+	return context.WithValue(ctx, ctxKeyInputWaiter, fn)
+}
+
+// notifyAwaitingInput tells the calling loop, if any, about an open question.
+func notifyAwaitingInput(ctx context.Context, q *domain.Question) {
+	if fn, ok := ctx.Value(ctxKeyInputWaiter).(func(q *domain.Question)); ok && fn != nil {
+		fn(q)
+	}
+}
+
+// contextWithArtifactRecorder lets a ReAct loop collect the artifacts its
+// tools register, to list them on the final answer.
+func contextWithArtifactRecorder(ctx context.Context, fn func(id domain.ArtifactID)) context.Context {
+	return context.WithValue(ctx, ctxKeyArtifacts, fn)
+}
+
+// recordArtifact tells the calling loop, if any, about a registered artifact.
+func recordArtifact(ctx context.Context, id domain.ArtifactID) {
+	if fn, ok := ctx.Value(ctxKeyArtifacts).(func(id domain.ArtifactID)); ok && fn != nil {
+		fn(id)
+	}
+}
+
+// memoryScope is the persona and project rule the memory tools write under.
+type memoryScope struct {
+	persona domain.PersonaID
+	scope   domain.MemoryScope
+}
+
+// ContextWithMemoryScope scopes the memory tools to a persona under the
+// project's memory rule. Without it, memory is shared.
+func ContextWithMemoryScope(ctx context.Context, persona domain.PersonaID, scope domain.MemoryScope) context.Context {
+	return context.WithValue(ctx, ctxKeyMemoryScope, memoryScope{persona: persona, scope: scope})
+}
+
+// getMemoryScopeFromContext returns the persona whose memory is private, if any.
+func getMemoryScopeFromContext(ctx context.Context) (memoryScope, bool) {
+	m, ok := ctx.Value(ctxKeyMemoryScope).(memoryScope)
+	if !ok || m.persona == "" || m.scope == "" || m.scope == domain.MemoryScopeShared {
+		return memoryScope{}, false
+	}
+	return m, true
+}
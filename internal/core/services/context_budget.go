@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// DefaultContextTokens is used when the target model's window is unknown.
+// Matches Ollama's default num_ctx, the most common silent-truncation culprit.
+const DefaultContextTokens = 4096
+
+// CountTokens estimates how many tokens text occupies for a BPE tokenizer.
+// Short words cost 1 token and long ones 1 more per 5 characters; punctuation,
+// symbols and non-Latin runes (CJK etc.) cost 1 each; whitespace merges into
+// the next word except newlines.
+func CountTokens(text string) int {
+	tokens, word := 0, 0
+	flush := func() {
+		if word > 0 {
+			tokens += 1 + (word-1)/5
+			word = 0
+		}
+	}
+	for _, r := range text {
+		switch {
+		case r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			word++
+		case unicode.IsSpace(r):
+			flush()
+			if r == '\n' {
+				tokens++
+			}
+		default:
+			flush()
+			tokens++
+		}
+	}
+	flush()
+	return tokens
+}
+
+// TruncateToTokens keeps the head of text within maxTokens, marking the cut.
+func TruncateToTokens(text string, maxTokens int) string {
+	if maxTokens <= 0 {
+		return ""
+	}
+	if CountTokens(text) <= maxTokens {
+		return text
+	}
+	const marker = "\n[... truncated to fit context window]"
+	limit := maxTokens - CountTokens(marker)
+	if limit <= 0 {
+		return ""
+	}
+	// Binary search the longest rune prefix that fits
+	runes := []rune(text)
+	lo, hi := 0, len(runes)
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		if CountTokens(string(runes[:mid])) <= limit {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return string(runes[:lo]) + marker
+}
+
+// ContextBlocks are the variable-size parts of an agent prompt.
+type ContextBlocks struct {
+	Base      string           // rendered prompt without workspace/history (identity, tools, rules, user message)
+	Workspace WorkspaceContext // memory, preferences, skills...
+	History   []domain.Message // oldest first
+}
+
+// ContextReport describes how a prompt was fitted into the model window.
+type ContextReport struct {
+	MaxTokens        int  `json:"max_tokens"`
+	ReservedTokens   int  `json:"reserved_tokens"`
+	BaseTokens       int  `json:"base_tokens"`
+	WorkspaceTokens  int  `json:"workspace_tokens"`
+	HistoryTokens    int  `json:"history_tokens"`
+	DroppedMessages  int  `json:"dropped_messages"`
+	Summarized       bool `json:"summarized"`
+	WorkspaceTrimmed bool `json:"workspace_trimmed"`
+}
+
+// Total is the estimated prompt size after fitting.
+func (r ContextReport) Total() int {
+	return r.BaseTokens + r.WorkspaceTokens + r.HistoryTokens
+}
+
+// ContextFit is the budgeted workspace block and history text.
+type ContextFit struct {
+	Workspace string
+	History   string
+	Report    ContextReport
+}
+
+// ContextBudgeter trims (and optionally summarizes) prompt blocks so the
+// prompt, plus room for the ReAct loop, fits the target model's context.
+type ContextBudgeter struct {
+	logger *slog.Logger
+	// summarize condenses dropped history; nil = drop with a marker only
+	summarize func(ctx context.Context, text string) (string, error)
+}
+
+func NewContextBudgeter(logger *slog.Logger) *ContextBudgeter {
+	return &ContextBudgeter{logger: logger}
+}
+
+// SetSummarizer enables summarization of history that does not fit.
+func (b *ContextBudgeter) SetSummarizer(fn func(ctx context.Context, text string) (string, error)) {
+	b.summarize = fn
+}
+
+// Fit sizes the workspace and history blocks for a window of maxTokens.
+// A quarter of the window (at least 512 tokens) is reserved for the model's
+// reply and the observations appended by later ReAct iterations.
+// Workspace may use up to half of what remains; history gets the rest,
+// keeping the most recent messages.
+func (b *ContextBudgeter) Fit(ctx context.Context, maxTokens int, blocks ContextBlocks) ContextFit {
+	if maxTokens <= 0 {
+		maxTokens = DefaultContextTokens
+	}
+	report := ContextReport{
+		MaxTokens:      maxTokens,
+		ReservedTokens: max(maxTokens/4, 512),
+		BaseTokens:     CountTokens(blocks.Base),
+	}
+	available := max(maxTokens-report.ReservedTokens-report.BaseTokens, 0)
+
+	// --- Workspace: trim least important sections first ---
+	ws := blocks.Workspace
+	workspace := ws.FormatForPrompt()
+	if wsBudget := available / 2; CountTokens(workspace) > wsBudget {
+		report.WorkspaceTrimmed = true
+		for _, field := range []*string{&ws.Skills, &ws.Mounts, &ws.Tools, &ws.Memory, &ws.Conversation, &ws.User, &ws.Agent, &ws.Identity, &ws.Style} {
+			over := CountTokens(ws.FormatForPrompt()) - wsBudget
+			if over <= 0 {
+				break
+			}
+			*field = TruncateToTokens(*field, CountTokens(*field)-over)
+		}
+		workspace = ws.FormatForPrompt()
+		if CountTokens(workspace) > wsBudget {
+			workspace = TruncateToTokens(workspace, wsBudget)
+		}
+	}
+	report.WorkspaceTokens = CountTokens(workspace)
+
+	// --- History: newest messages first, summarize what falls off ---
+	historyBudget := max(available-report.WorkspaceTokens, 0)
+	if CountTokens(FormatMessages(blocks.History)) <= historyBudget {
+		history := FormatMessages(blocks.History)
+		report.HistoryTokens = CountTokens(history)
+		return ContextFit{Workspace: workspace, History: history, Report: report}
+	}
+
+	summaryBudget := min(historyBudget/4, 512)
+	keptBudget := historyBudget - summaryBudget
+	used, cut := 0, len(blocks.History)
+	for i := len(blocks.History) - 1; i >= 0; i-- {
+		n := CountTokens(FormatMessages(blocks.History[i : i+1]))
+		if used+n > keptBudget {
+			break
+		}
+		used += n
+		cut = i
+	}
+	dropped, kept := blocks.History[:cut], blocks.History[cut:]
+	report.DroppedMessages = len(dropped)
+
+	prefix := fmt.Sprintf("[%d earlier messages omitted to fit the context window]\n", len(dropped))
+	if b.summarize != nil && summaryBudget > 0 {
+		summary, err := b.summarize(ctx, TruncateToTokens(FormatMessages(dropped), DefaultContextTokens))
+		if err != nil {
+			b.logger.Warn("history summarization failed, dropping instead", "messages", len(dropped), "error", err)
+		} else if summary = strings.TrimSpace(summary); summary != "" {
+			prefix = TruncateToTokens("Summary of earlier conversation: "+summary, summaryBudget) + "\n"
+			report.Summarized = true
+		}
+	}
+
+	history := prefix + FormatMessages(kept)
+	report.HistoryTokens = CountTokens(history)
+	b.logger.Info("context window budgeted",
+		"max_tokens", maxTokens,
+		"dropped_messages", report.DroppedMessages,
+		"summarized", report.Summarized,
+		"workspace_trimmed", report.WorkspaceTrimmed,
+		"prompt_tokens", report.Total(),
+	)
+	return ContextFit{Workspace: workspace, History: history, Report: report}
+}
+
+// SummarizeWithRouter returns a summarizer that condenses text with the fast-role model.
+func SummarizeWithRouter(router *ModelRouter) func(ctx context.Context, text string) (string, error) {
+	return func(ctx context.Context, text string) (string, error) {
+		prompt := "Summarize the following conversation excerpt in a few sentences. " +
+			"Keep names, decisions, facts and open questions; drop pleasantries.\n\n" + text + "\n\nSummary:"
+		return router.GenerateText(ctx, prompt, router.ResolveModel(nil, domain.ModelRoleFast))
+	}
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountTokens(t *testing.T) {
+	assert.Equal(t, 0, CountTokens(""))
+	assert.Equal(t, 2, CountTokens("hello world"))
+	assert.Equal(t, 5, CountTokens(`{"a":`)) // punctuation counts individually
+	assert.Equal(t, 4, CountTokens("internationalization"))
+	assert.Equal(t, 2, CountTokens("日本"))
+}
+
+func TestTruncateToTokens(t *testing.T) {
+	text := strings.Repeat("word ", 200)
+	out := TruncateToTokens(text, 50)
+	assert.LessOrEqual(t, CountTokens(out), 50)
+	assert.Contains(t, out, "truncated")
+	assert.Equal(t, "short", TruncateToTokens("short", 50))
+}
+
+func TestContextBudgeter_FitsHistoryAndWorkspace(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	b := NewContextBudgeter(logger)
+
+	var history []domain.Message
+	for i := 0; i < 60; i++ {
+		history = append(history, domain.Message{Role: domain.RoleUser, Content: fmt.Sprintf("message %d %s", i, strings.Repeat("lorem ipsum ", 10))})
+	}
+	ws := WorkspaceContext{Memory: strings.Repeat("remember this fact ", 400), User: "prefers short answers"}
+
+	// Everything fits in a large window
+	fit := b.Fit(context.Background(), 128000, ContextBlocks{Base: "base prompt", Workspace: ws, History: history})
+	assert.Zero(t, fit.Report.DroppedMessages)
+	assert.False(t, fit.Report.WorkspaceTrimmed)
+
+	// Small window: memory trimmed, oldest messages dropped, summary prepended
+	var summarized string
+	b.SetSummarizer(func(_ context.Context, text string) (string, error) {
+		summarized = text
+		return "the user sent numbered messages", nil
+	})
+	fit = b.Fit(context.Background(), 2048, ContextBlocks{Base: "base prompt", Workspace: ws, History: history})
+	assert.True(t, fit.Report.WorkspaceTrimmed)
+	assert.Contains(t, fit.Workspace, "prefers short answers")
+	assert.Greater(t, fit.Report.DroppedMessages, 0)
+	assert.True(t, fit.Report.Summarized)
+	assert.Contains(t, summarized, "message 0 ")
+	assert.Contains(t, fit.History, "Summary of earlier conversation")
+	assert.Contains(t, fit.History, "message 59 ")
+	assert.NotContains(t, fit.History, "message 0 ")
+	assert.LessOrEqual(t, fit.Report.Total(), 2048-fit.Report.ReservedTokens)
+}
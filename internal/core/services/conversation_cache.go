@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SetWriteBehind defers the repository writes of in-progress checkpoint
+// updates: they go to the cache at once, repeated updates of a message are
+// coalesced, and the latest is written every interval (see RunWriteBehind).
+// Final answers and every other write stay synchronous. 0 disables it.
+func (s *ConversationStore) SetWriteBehind(interval time.Duration) {
+	s.wbMu.Lock()
+	s.wbInterval = max(interval, 0)
+	s.wbMu.Unlock()
+}
+
+// RunWriteBehind flushes deferred writes every interval until ctx is done,
+// then flushes the rest and switches to synchronous writes, so checkpoints
+// saved during shutdown still land.
+func (s *ConversationStore) RunWriteBehind(ctx context.Context) error {
+	s.wbMu.Lock()
+	interval := s.wbInterval
+	s.wbMu.Unlock()
+	if interval <= 0 {
+		return nil
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.SetWriteBehind(0)
+			s.Flush(context.WithoutCancel(ctx))
+			return nil
+		case <-ticker.C:
+			s.Flush(ctx)
+		}
+	}
+}
+
+// Flush writes every deferred update now.
+func (s *ConversationStore) Flush(ctx context.Context) {
+	s.flushWhere(ctx, func(domain.Message) bool { return true })
+}
+
+// flushConversation writes the deferred updates of one conversation, before
+// it is read from or changed in the repository.
+func (s *ConversationStore) flushConversation(ctx context.Context, convID domain.ConversationID) {
+	s.flushWhere(ctx, func(m domain.Message) bool { return m.ConversationID == convID })
+}
+
+func (s *ConversationStore) flushWhere(ctx context.Context, match func(domain.Message) bool) {
+	s.wbMu.Lock()
+	defer s.wbMu.Unlock()
+	for id, msg := range s.pending {
+		if !match(msg) {
+			continue
+		}
+		delete(s.pending, id)
+		// A failed write is dropped: a later checkpoint or the final answer
+		// supersedes it anyway
+		if err := s.repo.UpdateMessage(ctx, msg); err != nil {
+			s.flushErrors++
+			s.logger.WarnContext(ctx, "failed to write deferred checkpoint, dropping it",
+				"message_id", id, "conversation_id", msg.ConversationID, "error", err)
+			continue
+		}
+		s.flushed++
+	}
+}
+
+// writeUpdate writes a message update to the repository, or defers it when
+// write-behind is on and the message is an in-progress checkpoint. A
+// synchronous write drops any deferred older version of the message first.
+func (s *ConversationStore) writeUpdate(ctx context.Context, msg domain.Message) error {
+	s.wbMu.Lock()
+	defer s.wbMu.Unlock()
+	if s.wbInterval > 0 {
+		if inProgress, _ := msg.Metadata["in_progress"].(bool); inProgress {
+			if _, ok := s.pending[msg.ID]; ok {
+				s.coalesced++
+			}
+			s.pending[msg.ID] = msg
+			return nil
+		}
+	}
+	delete(s.pending, msg.ID)
+	return s.repo.UpdateMessage(ctx, msg)
+}
+
+// dropPending forgets the deferred writes of a deleted conversation.
+func (s *ConversationStore) dropPending(convID domain.ConversationID) {
+	s.wbMu.Lock()
+	for id, msg := range s.pending {
+		if msg.ConversationID == convID {
+			delete(s.pending, id)
+		}
+	}
+	s.wbMu.Unlock()
+}
+
+// CacheStats reports the cache occupancy, hit rate and write-behind state.
+func (s *ConversationStore) CacheStats() domain.ConversationCacheStats {
+	s.mu.RLock()
+	stats := domain.ConversationCacheStats{
+		Capacity:  s.maxCache,
+		Cached:    len(s.cache),
+		Hits:      s.hits.Load(),
+		Misses:    s.misses.Load(),
+		Evictions: s.evictions,
+	}
+	s.mu.RUnlock()
+
+	s.wbMu.Lock()
+	stats.WriteBehind = s.wbInterval > 0
+	stats.FlushIntervalMs = s.wbInterval.Milliseconds()
+	stats.PendingWrites = len(s.pending)
+	stats.Coalesced = s.coalesced
+	stats.Flushed = s.flushed
+	stats.FlushErrors = s.flushErrors
+	s.wbMu.Unlock()
+	return stats
+}
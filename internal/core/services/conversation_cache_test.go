@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memMessageRepo keeps messages in memory and counts writes; the rest of
+// ports.Repository is not used by these tests.
+type memMessageRepo struct {
+	ports.Repository
+	mu      sync.Mutex
+	msgs    map[domain.ConversationID][]domain.Message
+	updates int
+}
+
+func newMemMessageRepo() *memMessageRepo {
+	return &memMessageRepo{msgs: map[domain.ConversationID][]domain.Message{}}
+}
+
+func (r *memMessageRepo) AddMessage(_ context.Context, msg domain.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.msgs[msg.ConversationID] = append(r.msgs[msg.ConversationID], msg)
+	return nil
+}
+
+func (r *memMessageRepo) UpdateMessage(_ context.Context, msg domain.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.updates++
+	for i, m := range r.msgs[msg.ConversationID] {
+		if m.ID == msg.ID {
+			r.msgs[msg.ConversationID][i] = msg
+		}
+	}
+	return nil
+}
+
+func (r *memMessageRepo) ListMessages(_ context.Context, convID domain.ConversationID, _ int) ([]domain.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]domain.Message(nil), r.msgs[convID]...), nil
+}
+
+func (r *memMessageRepo) DeleteConversation(_ context.Context, convID domain.ConversationID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.msgs, convID)
+	return nil
+}
+
+func (r *memMessageRepo) stored(convID domain.ConversationID) domain.Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.msgs[convID][0]
+}
+
+func TestConversationStore_CacheStats(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemMessageRepo()
+	store := NewConversationStore(repo, 2)
+	for _, id := range []domain.ConversationID{"a", "b", "c"} {
+		require.NoError(t, repo.AddMessage(ctx, domain.Message{ID: "m-" + domain.MessageID(id), ConversationID: id}))
+	}
+
+	for _, id := range []domain.ConversationID{"a", "a", "b", "c", "a"} {
+		_, err := store.GetMessages(ctx, id, 0)
+		require.NoError(t, err)
+	}
+	stats := store.CacheStats()
+	assert.Equal(t, 2, stats.Capacity)
+	assert.Equal(t, 2, stats.Cached)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(4), stats.Misses)
+	assert.Equal(t, uint64(2), stats.Evictions, "a evicted by c, then b by a")
+	assert.False(t, stats.WriteBehind)
+}
+
+func TestConversationStore_ForgetsWriteCountsOfEvictedConversations(t *testing.T) {
+	ctx := context.Background()
+	store := NewConversationStore(newMemMessageRepo(), 2)
+	for _, id := range []domain.ConversationID{"a", "b", "c", "d"} {
+		_, err := store.GetMessages(ctx, id, 0)
+		require.NoError(t, err)
+		require.NoError(t, store.AddMessage(ctx, domain.Message{ID: "m-" + domain.MessageID(id), ConversationID: id}))
+	}
+	require.NoError(t, store.UpdateMessage(ctx, domain.Message{ID: "m-a", ConversationID: "a"}))
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	assert.Len(t, store.gens, 2)
+	assert.Contains(t, store.gens, domain.ConversationID("c"))
+	assert.Contains(t, store.gens, domain.ConversationID("d"))
+}
+
+func TestConversationStore_OnDelete(t *testing.T) {
+	store := NewConversationStore(newMemMessageRepo(), 2)
+	var deleted []domain.ConversationID
+	store.OnDelete(func(id domain.ConversationID) { deleted = append(deleted, id) })
+
+	require.NoError(t, store.DeleteConversation(context.Background(), "a"))
+	assert.Equal(t, []domain.ConversationID{"a"}, deleted)
+}
+
+func TestConversationStore_WriteBehind(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemMessageRepo()
+	store := NewConversationStore(repo, 0)
+	store.SetWriteBehind(time.Hour)
+
+	msg := domain.Message{ID: "m1", ConversationID: "conv-1", Role: domain.RoleAssistant, Content: "Working…"}
+	require.NoError(t, store.AddMessage(ctx, msg))
+	_, err := store.GetMessages(ctx, "conv-1", 0)
+	require.NoError(t, err)
+
+	// In-progress checkpoints are coalesced in memory
+	for i := 1; i <= 3; i++ {
+		msg.Content = "step"
+		msg.Steps = make([]domain.ReActStep, i)
+		msg.Metadata = map[string]interface{}{"in_progress": true}
+		require.NoError(t, store.UpdateMessage(ctx, msg))
+	}
+	assert.Equal(t, 0, repo.updates)
+	cached, err := store.GetMessages(ctx, "conv-1", 0)
+	require.NoError(t, err)
+	assert.Len(t, cached[0].Steps, 3, "the cache has the latest checkpoint")
+	stats := store.CacheStats()
+	assert.Equal(t, 1, stats.PendingWrites)
+	assert.Equal(t, uint64(2), stats.Coalesced)
+
+	store.Flush(ctx)
+	assert.Equal(t, 1, repo.updates)
+	assert.Len(t, repo.stored("conv-1").Steps, 3)
+
+	// The final answer is written through and drops the deferred checkpoint
+	msg.Metadata = map[string]interface{}{"in_progress": true}
+	require.NoError(t, store.UpdateMessage(ctx, msg))
+	msg.Content, msg.Metadata = "done", nil
+	require.NoError(t, store.UpdateMessage(ctx, msg))
+	assert.Equal(t, 2, repo.updates)
+	assert.Equal(t, "done", repo.stored("conv-1").Content)
+	assert.Zero(t, store.CacheStats().PendingWrites)
+
+	// Shutdown flushes the backlog and turns write-behind off
+	msg.Metadata = map[string]interface{}{"in_progress": true}
+	require.NoError(t, store.UpdateMessage(ctx, msg))
+	store.SetWriteBehind(time.Millisecond)
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, store.RunWriteBehind(runCtx))
+	assert.Equal(t, 3, repo.updates)
+	assert.False(t, store.CacheStats().WriteBehind)
+}
+
+func TestConversationStore_ReadsFlushPendingWrites(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemMessageRepo()
+	store := NewConversationStore(repo, 0)
+	store.SetWriteBehind(time.Hour)
+
+	msg := domain.Message{ID: "m1", ConversationID: "conv-1", Content: "Working…"}
+	require.NoError(t, store.AddMessage(ctx, msg))
+	msg.Content = "step 1"
+	msg.Metadata = map[string]interface{}{"in_progress": true}
+	require.NoError(t, store.UpdateMessage(ctx, msg))
+
+	// Not cached: the read goes to the repository, which must see the update
+	msgs, err := store.GetMessages(ctx, "conv-1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "step 1", msgs[0].Content)
+	assert.Zero(t, store.CacheStats().PendingWrites)
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// Regenerate re-runs the agent for the user message that prompted reply.
+// The old reply and everything after it are archived, not deleted, so
+// earlier versions stay retrievable.
+func (s *ReActAgentService) Regenerate(ctx context.Context, convID domain.ConversationID, replyID domain.MessageID) (*domain.AgentResponse, error) {
+	release, err := s.beginTurn(ctx, convID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	conv, msgs, idx, err := s.locateMessage(ctx, convID, replyID)
+	if err != nil {
+		return nil, err
+	}
+	if msgs[idx].Role != domain.RoleAssistant {
+		return nil, fmt.Errorf("%w: only assistant replies can be regenerated", domain.ErrMessageNotEditable)
+	}
+	prompt := -1
+	for i := idx - 1; i >= 0; i-- {
+		if msgs[i].Role == domain.RoleUser {
+			prompt = i
+			break
+		}
+	}
+	if prompt < 0 {
+		return nil, fmt.Errorf("%w: reply has no preceding user message", domain.ErrMessageNotEditable)
+	}
+
+	n, err := s.convs.ArchiveFrom(ctx, convID, replyID)
+	if err != nil {
+		return nil, fmt.Errorf("archive reply: %w", err)
+	}
+	s.logger.Info("regenerating reply", "conversation_id", string(convID), "message_id", string(replyID), "archived", n)
+
+	userMsg := msgs[prompt]
+	resp, _, err := s.run(ctx, convID, userMsg.Content, conv.PersonaID, &userMsg)
+	return resp, err
+}
+
+// EditMessage replaces the content of the conversation's last user message,
+// deletes every message after it and re-runs the agent on the new text.
+func (s *ReActAgentService) EditMessage(ctx context.Context, convID domain.ConversationID, msgID domain.MessageID, content string) (*domain.AgentResponse, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("%w: content is required", domain.ErrMessageNotEditable)
+	}
+	release, err := s.beginTurn(ctx, convID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	conv, msgs, idx, err := s.locateMessage(ctx, convID, msgID)
+	if err != nil {
+		return nil, err
+	}
+	if msgs[idx].Role != domain.RoleUser {
+		return nil, fmt.Errorf("%w: only user messages can be edited", domain.ErrMessageNotEditable)
+	}
+	for _, m := range msgs[idx+1:] {
+		if m.Role == domain.RoleUser {
+			return nil, fmt.Errorf("%w: only the last user message can be edited", domain.ErrMessageNotEditable)
+		}
+	}
+
+	if _, err := s.convs.TruncateAfter(ctx, convID, msgID); err != nil {
+		return nil, fmt.Errorf("truncate conversation: %w", err)
+	}
+	edited := msgs[idx]
+	if edited.Metadata == nil {
+		edited.Metadata = map[string]interface{}{}
+	}
+	edited.Metadata["edited_at"] = time.Now().UTC().Format(time.RFC3339)
+	edited.Content = content
+	if err := s.convs.UpdateMessage(ctx, edited); err != nil {
+		return nil, fmt.Errorf("update message: %w", err)
+	}
+	s.logger.Info("user message edited", "conversation_id", string(convID), "message_id", string(msgID))
+
+	resp, _, err := s.run(ctx, convID, content, conv.PersonaID, &edited)
+	return resp, err
+}
+
+// locateMessage loads the conversation and its live messages and returns the
+// index of id among them.
+func (s *ReActAgentService) locateMessage(ctx context.Context, convID domain.ConversationID, id domain.MessageID) (domain.Conversation, []domain.Message, int, error) {
+	conv, err := s.convs.GetConversation(ctx, convID)
+	if err != nil {
+		return domain.Conversation{}, nil, 0, err
+	}
+	msgs, err := s.convs.GetMessages(ctx, convID, 0)
+	if err != nil {
+		return domain.Conversation{}, nil, 0, err
+	}
+	for i, m := range msgs {
+		if m.ID == id {
+			return conv, msgs, i, nil
+		}
+	}
+	return domain.Conversation{}, nil, 0, domain.ErrMessageNotFound
+}
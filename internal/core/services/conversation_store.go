@@ -2,8 +2,13 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
@@ -13,28 +18,68 @@ import (
 // ConversationStore manages conversations with an in-memory cache backed by DuckDB.
 // Hot conversations stay in memory; cold ones are loaded on-demand.
 type ConversationStore struct {
-	mu   sync.RWMutex
-	repo ports.Repository
+	mu     sync.RWMutex
+	repo   ports.Repository
+	logger *slog.Logger
 
 	// In-memory LRU cache: conversationID -> messages (ordered by time)
 	cache    map[domain.ConversationID][]domain.Message
 	order    []domain.ConversationID // LRU order, most recent last
 	maxCache int                     // max conversations in memory
+	// gens counts writes per cached conversation; a load from the repository
+	// is only cached if no write happened while it ran. Entries leave with
+	// their conversation, bumping dropped so loads running meanwhile aren't
+	// cached either.
+	gens    map[domain.ConversationID]uint64
+	dropped uint64
+
+	hits, misses atomic.Uint64 // full-history reads served from / missing the cache
+	evictions    uint64        // guarded by mu
+
+	// Write-behind of in-progress checkpoints (see SetWriteBehind)
+	wbMu                            sync.Mutex
+	wbInterval                      time.Duration
+	pending                         map[domain.MessageID]domain.Message
+	coalesced, flushed, flushErrors uint64
+
+	// turns holds the conversations with an agent turn running; the channel
+	// closes when the turn ends (see AcquireTurn)
+	turnsMu sync.Mutex
+	turns   map[domain.ConversationID]chan struct{}
+
+	redactor *Redactor // optional; masks secrets before messages are stored
+
+	onDelete []func(domain.ConversationID) // see OnDelete; guarded by mu
 }
 
-// NewConversationStore creates a new store with the given cache capacity.
+// NewConversationStore creates a new store caching up to maxCache
+// conversations (<= 0 = 64), evicting the least recently used.
 func NewConversationStore(repo ports.Repository, maxCache int) *ConversationStore {
 	if maxCache <= 0 {
 		maxCache = 64
 	}
 	return &ConversationStore{
 		repo:     repo,
+		logger:   slog.Default(),
 		cache:    make(map[domain.ConversationID][]domain.Message, maxCache),
 		order:    make([]domain.ConversationID, 0, maxCache),
 		maxCache: maxCache,
+		gens:     make(map[domain.ConversationID]uint64),
+		pending:  make(map[domain.MessageID]domain.Message),
+		turns:    make(map[domain.ConversationID]chan struct{}),
 	}
 }
 
+// SetLogger replaces the logger, slog.Default() until set.
+func (s *ConversationStore) SetLogger(logger *slog.Logger) {
+	s.logger = logger
+}
+
+// SetRedactor masks secrets and PII in messages before they are stored.
+func (s *ConversationStore) SetRedactor(r *Redactor) {
+	s.redactor = r
+}
+
 // CreateConversation initializes a new conversation. Returns its ID.
 func (s *ConversationStore) CreateConversation(ctx context.Context, title string) (domain.Conversation, error) {
 	return s.CreateConversationWithPersona(ctx, title, nil)
@@ -64,6 +109,40 @@ func (s *ConversationStore) CreateConversationWithPersona(ctx context.Context, t
 	return conv, nil
 }
 
+// CreateProjectConversation initializes a new conversation inside a project.
+// When personaID is nil, the project's default persona (if any) is applied.
+func (s *ConversationStore) CreateProjectConversation(ctx context.Context, title string, projectID domain.ProjectID, personaID *domain.PersonaID) (domain.Conversation, error) {
+	proj, err := s.repo.GetProject(ctx, projectID)
+	if err != nil {
+		return domain.Conversation{}, err
+	}
+	if personaID == nil {
+		personaID = proj.Settings.DefaultPersonaID
+	}
+
+	now := time.Now()
+	conv := domain.Conversation{
+		ID:        domain.NewConversationID(),
+		ProjectID: &proj.ID,
+		PersonaID: personaID,
+		Title:     title,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.repo.CreateConversation(ctx, conv); err != nil {
+		return domain.Conversation{}, err
+	}
+
+	s.mu.Lock()
+	s.cache[conv.ID] = nil
+	s.touchLocked(conv.ID)
+	s.evictLocked()
+	s.mu.Unlock()
+
+	return conv, nil
+}
+
 // GetConversation returns conversation metadata.
 func (s *ConversationStore) GetConversation(ctx context.Context, id domain.ConversationID) (domain.Conversation, error) {
 	return s.repo.GetConversation(ctx, id)
@@ -79,15 +158,89 @@ func (s *ConversationStore) DeleteConversation(ctx context.Context, id domain.Co
 	if err := s.repo.DeleteConversation(ctx, id); err != nil {
 		return err
 	}
+	s.dropPending(id)
 
 	s.mu.Lock()
 	delete(s.cache, id)
+	s.dropGenLocked(id)
 	s.removeLRULocked(id)
+	hooks := s.onDelete
 	s.mu.Unlock()
 
+	for _, fn := range hooks {
+		fn(id)
+	}
 	return nil
 }
 
+// OnDelete registers fn to run after a conversation is deleted, so services
+// keeping per-conversation state can drop it.
+func (s *ConversationStore) OnDelete(fn func(domain.ConversationID)) {
+	s.mu.Lock()
+	s.onDelete = append(s.onDelete, fn)
+	s.mu.Unlock()
+}
+
+// ListConversationsFiltered returns conversations matching the filter, pinned first.
+func (s *ConversationStore) ListConversationsFiltered(ctx context.Context, filter domain.ConversationFilter) ([]domain.Conversation, error) {
+	return s.repo.ListConversationsFiltered(ctx, filter)
+}
+
+// Organize applies tag, pin and folder changes and returns the updated conversation.
+func (s *ConversationStore) Organize(ctx context.Context, id domain.ConversationID, patch domain.ConversationPatch) (domain.Conversation, error) {
+	if err := s.repo.UpdateConversationOrganization(ctx, id, patch); err != nil {
+		return domain.Conversation{}, err
+	}
+	return s.repo.GetConversation(ctx, id)
+}
+
+// Facets counts conversations per folder and tag, for building sidebars.
+func (s *ConversationStore) Facets(ctx context.Context) (domain.ConversationFacets, error) {
+	convs, err := s.repo.ListConversations(ctx)
+	if err != nil {
+		return domain.ConversationFacets{}, err
+	}
+	folders, tags := map[string]int{}, map[string]int{}
+	for _, c := range convs {
+		if c.Folder != "" {
+			folders[c.Folder]++
+		}
+		for _, t := range c.Tags {
+			tags[t]++
+		}
+	}
+	return domain.ConversationFacets{Folders: facetCounts(folders), Tags: facetCounts(tags)}, nil
+}
+
+func facetCounts(m map[string]int) []domain.FacetCount {
+	out := make([]domain.FacetCount, 0, len(m))
+	for name, n := range m {
+		out = append(out, domain.FacetCount{Name: name, Count: n})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// SetContext replaces the conversation's context variables; nil or empty
+// clears them.
+func (s *ConversationStore) SetContext(ctx context.Context, id domain.ConversationID, vars domain.ContextVars) error {
+	if err := vars.Validate(); err != nil {
+		return err
+	}
+	return s.repo.UpdateConversationContext(ctx, id, vars)
+}
+
+// SetStyle replaces the conversation's style preferences; nil or empty
+// clears them.
+func (s *ConversationStore) SetStyle(ctx context.Context, id domain.ConversationID, style *domain.ConversationStyle) error {
+	if style != nil {
+		if err := style.Validate(); err != nil {
+			return err
+		}
+	}
+	return s.repo.UpdateConversationStyle(ctx, id, style)
+}
+
 // UpdateTitle updates the conversation title.
 func (s *ConversationStore) UpdateTitle(ctx context.Context, id domain.ConversationID, title string) error {
 	return s.repo.UpdateConversationTitle(ctx, id, title)
@@ -95,21 +248,145 @@ func (s *ConversationStore) UpdateTitle(ctx context.Context, id domain.Conversat
 
 // AddMessage persists a message and updates the in-memory cache.
 func (s *ConversationStore) AddMessage(ctx context.Context, msg domain.Message) error {
+	msg = s.redactor.RedactMessage(msg)
 	if err := s.repo.AddMessage(ctx, msg); err != nil {
 		return err
 	}
 
 	s.mu.Lock()
-	if msgs, ok := s.cache[msg.ConversationID]; ok {
+	// A load that finished after the write may have cached msg already
+	if msgs, ok := s.cache[msg.ConversationID]; ok && !slices.ContainsFunc(msgs, func(m domain.Message) bool { return m.ID == msg.ID }) {
 		s.cache[msg.ConversationID] = append(msgs, msg)
 	}
 	// If not cached, don't load — will be fetched on next GetMessages
+	s.wroteLocked(msg.ConversationID)
 	s.touchLocked(msg.ConversationID)
 	s.mu.Unlock()
 
 	return nil
 }
 
+// GetMessagesPage returns one cursor-paginated page of a conversation
+// straight from the repository (see domain.MessagePage).
+func (s *ConversationStore) GetMessagesPage(ctx context.Context, convID domain.ConversationID, page domain.MessagePage) ([]domain.Message, bool, error) {
+	s.flushConversation(ctx, convID)
+	return s.repo.ListMessagesPage(ctx, convID, page)
+}
+
+// UpdateMessage rewrites a persisted message and its cached copy.
+func (s *ConversationStore) UpdateMessage(ctx context.Context, msg domain.Message) error {
+	msg = s.redactor.RedactMessage(msg)
+	if err := s.writeUpdate(ctx, msg); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.wroteLocked(msg.ConversationID)
+	for i, m := range s.cache[msg.ConversationID] {
+		if m.ID == msg.ID {
+			msg.CreatedAt = m.CreatedAt
+			s.cache[msg.ConversationID][i] = msg
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	return nil
+}
+
+// ArchiveFrom archives a message and everything after it, e.g. a reply that
+// is about to be regenerated.
+func (s *ConversationStore) ArchiveFrom(ctx context.Context, convID domain.ConversationID, id domain.MessageID) (int, error) {
+	s.flushConversation(ctx, convID)
+	n, err := s.repo.ArchiveMessagesFrom(ctx, convID, id)
+	if err != nil {
+		return 0, err
+	}
+	s.invalidate(convID)
+	return n, nil
+}
+
+// TruncateAfter deletes every message that follows the given one.
+func (s *ConversationStore) TruncateAfter(ctx context.Context, convID domain.ConversationID, id domain.MessageID) (int, error) {
+	s.flushConversation(ctx, convID)
+	n, err := s.repo.DeleteMessagesAfter(ctx, convID, id)
+	if err != nil {
+		return 0, err
+	}
+	s.invalidate(convID)
+	return n, nil
+}
+
+// invalidate drops a conversation's cached messages so the next read reloads them.
+func (s *ConversationStore) invalidate(convID domain.ConversationID) {
+	s.mu.Lock()
+	delete(s.cache, convID)
+	s.dropGenLocked(convID)
+	s.removeLRULocked(convID)
+	s.mu.Unlock()
+}
+
+// RateMessage records feedback on an assistant message, linked to the trace
+// that produced it. Rating again replaces the earlier feedback.
+func (s *ConversationStore) RateMessage(ctx context.Context, convID domain.ConversationID, msgID domain.MessageID, rating domain.FeedbackRating, comment string) (domain.MessageFeedback, error) {
+	msgs, err := s.GetMessages(ctx, convID, 0)
+	if err != nil {
+		return domain.MessageFeedback{}, err
+	}
+	var target *domain.Message
+	for i := range msgs {
+		if msgs[i].ID == msgID {
+			target = &msgs[i]
+			break
+		}
+	}
+	if target == nil {
+		return domain.MessageFeedback{}, domain.ErrMessageNotFound
+	}
+	if target.Role != domain.RoleAssistant {
+		return domain.MessageFeedback{}, fmt.Errorf("%w: only assistant messages can be rated", domain.ErrInvalidFeedback)
+	}
+
+	now := time.Now()
+	fb := domain.MessageFeedback{
+		MessageID:      msgID,
+		ConversationID: convID,
+		Rating:         rating,
+		Comment:        strings.TrimSpace(comment),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if traceID, ok := target.Metadata["trace_id"].(string); ok {
+		fb.TraceID = domain.TraceID(traceID)
+	}
+	if err := fb.Validate(); err != nil {
+		return domain.MessageFeedback{}, err
+	}
+	if prev, err := s.repo.GetMessageFeedback(ctx, msgID); err == nil {
+		fb.CreatedAt = prev.CreatedAt
+	}
+	if err := s.repo.SaveMessageFeedback(ctx, fb); err != nil {
+		return domain.MessageFeedback{}, err
+	}
+	return fb, nil
+}
+
+// MessageFeedback returns the feedback recorded for a message.
+func (s *ConversationStore) MessageFeedback(ctx context.Context, msgID domain.MessageID) (domain.MessageFeedback, error) {
+	return s.repo.GetMessageFeedback(ctx, msgID)
+}
+
+// FeedbackRecords exports feedback with the exchanges it rates.
+func (s *ConversationStore) FeedbackRecords(ctx context.Context, filter domain.FeedbackFilter) ([]domain.FeedbackRecord, error) {
+	return s.repo.ListFeedbackRecords(ctx, filter)
+}
+
+// InProgressMessages returns ReAct checkpoints that were never finished.
+func (s *ConversationStore) InProgressMessages(ctx context.Context) ([]domain.Message, error) {
+	s.Flush(ctx)
+	return s.repo.ListInProgressMessages(ctx)
+}
+
 // GetMessages returns messages for a conversation, using cache when available.
 // limit=0 means all messages.
 func (s *ConversationStore) GetMessages(ctx context.Context, convID domain.ConversationID, limit int) ([]domain.Message, error) {
@@ -119,9 +396,15 @@ func (s *ConversationStore) GetMessages(ctx context.Context, convID domain.Conve
 		result := make([]domain.Message, len(msgs))
 		copy(result, msgs)
 		s.mu.RUnlock()
+		s.hits.Add(1)
 		return result, nil
 	}
+	gen, dropped := s.gens[convID], s.dropped
 	s.mu.RUnlock()
+	if limit == 0 {
+		s.misses.Add(1)
+	}
+	s.flushConversation(ctx, convID)
 
 	// Load from DB
 	msgs, err := s.repo.ListMessages(ctx, convID, limit)
@@ -129,12 +412,14 @@ func (s *ConversationStore) GetMessages(ctx context.Context, convID domain.Conve
 		return nil, err
 	}
 
-	// Populate cache (full set only)
+	// Populate cache (full set only), unless a write raced the load
 	if limit == 0 {
 		s.mu.Lock()
-		s.cache[convID] = msgs
-		s.touchLocked(convID)
-		s.evictLocked()
+		if s.gens[convID] == gen && s.dropped == dropped {
+			s.cache[convID] = msgs
+			s.touchLocked(convID)
+			s.evictLocked()
+		}
 		s.mu.Unlock()
 	}
 
@@ -148,6 +433,7 @@ func (s *ConversationStore) BuildContextWindow(ctx context.Context, convID domai
 		maxMessages = 20
 	}
 
+	s.flushConversation(ctx, convID)
 	msgs, err := s.repo.ListMessages(ctx, convID, maxMessages)
 	if err != nil {
 		return "", err
@@ -157,6 +443,11 @@ func (s *ConversationStore) BuildContextWindow(ctx context.Context, convID domai
 		return "", nil
 	}
 
+	return FormatMessages(msgs), nil
+}
+
+// FormatMessages renders messages as "Role: content" prompt lines.
+func FormatMessages(msgs []domain.Message) string {
 	var sb strings.Builder
 	sb.Grow(len(msgs) * 200) // pre-allocate rough estimate
 
@@ -168,6 +459,10 @@ func (s *ConversationStore) BuildContextWindow(ctx context.Context, convID domai
 		case domain.RoleAssistant:
 			sb.WriteString("Assistant: ")
 			sb.WriteString(msg.Content)
+			if last, ok := lastObservedStep(msg); ok {
+				// Lets a "continue" pick up from where an interrupted loop stopped
+				fmt.Fprintf(&sb, " [last action: %s; observation: %s]", last.Action, TruncateToTokens(last.Observation, 200))
+			}
 		case domain.RoleTool:
 			sb.WriteString("Observation: ")
 			sb.WriteString(msg.Content)
@@ -178,7 +473,21 @@ func (s *ConversationStore) BuildContextWindow(ctx context.Context, convID domai
 		sb.WriteByte('\n')
 	}
 
-	return sb.String(), nil
+	return sb.String()
+}
+
+// lastObservedStep returns the last step with an observation of an
+// interrupted ReAct loop's checkpoint message.
+func lastObservedStep(msg domain.Message) (domain.ReActStep, bool) {
+	if interrupted, _ := msg.Metadata["interrupted"].(bool); !interrupted {
+		return domain.ReActStep{}, false
+	}
+	for i := len(msg.Steps) - 1; i >= 0; i-- {
+		if msg.Steps[i].Observation != "" {
+			return msg.Steps[i], true
+		}
+	}
+	return domain.ReActStep{}, false
 }
 
 // EnsureConversation creates a conversation with a specific fixed ID if it does not exist yet.
@@ -231,6 +540,25 @@ func (s *ConversationStore) evictLocked() {
 	for len(s.order) > s.maxCache {
 		oldest := s.order[0]
 		s.order = s.order[1:]
-		delete(s.cache, oldest)
+		if _, ok := s.cache[oldest]; ok {
+			delete(s.cache, oldest)
+			s.evictions++
+		}
+		s.dropGenLocked(oldest)
 	}
 }
+
+// wroteLocked records a write to a conversation for loads racing it.
+func (s *ConversationStore) wroteLocked(id domain.ConversationID) {
+	if _, ok := s.cache[id]; ok {
+		s.gens[id]++
+		return
+	}
+	s.dropGenLocked(id)
+}
+
+// dropGenLocked forgets the write count of a conversation that is not cached.
+func (s *ConversationStore) dropGenLocked(id domain.ConversationID) {
+	delete(s.gens, id)
+	s.dropped++
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMessages_InterruptedCheckpointKeepsLastObservation(t *testing.T) {
+	msgs := []domain.Message{
+		{Role: domain.RoleUser, Content: "list the files"},
+		{
+			Role:    domain.RoleAssistant,
+			Content: interruptedShutdown,
+			Steps: []domain.ReActStep{
+				{Action: "list_files", Observation: `["a.txt","b.txt"]`},
+				{Action: "read_file"},
+			},
+			Metadata: map[string]interface{}{"interrupted": true},
+		},
+	}
+
+	out := FormatMessages(msgs)
+	assert.Contains(t, out, `[last action: list_files; observation: ["a.txt","b.txt"]]`)
+
+	// Finished answers are rendered as plain content
+	msgs[1].Metadata = nil
+	assert.NotContains(t, FormatMessages(msgs), "last action")
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// DefaultConversationTitle is used for conversations created without a title.
+const DefaultConversationTitle = "New Chat"
+
+const (
+	titleTimeout  = 30 * time.Second
+	titleMaxWords = 8
+	titleMaxLen   = 60
+)
+
+// PlaceholderTitle is the provisional title of an auto-created conversation:
+// the first ~50 characters of its first message.
+func PlaceholderTitle(message string) string {
+	if len(message) > 50 {
+		return message[:50] + "..."
+	}
+	return message
+}
+
+// SetAutoTitles toggles background title generation after the first exchange.
+func (s *ReActAgentService) SetAutoTitles(enabled bool) {
+	s.autoTitles = enabled
+}
+
+// maybeAutoTitle starts title generation if this was the conversation's first
+// exchange. It never blocks the chat response.
+func (s *ReActAgentService) maybeAutoTitle(ctx context.Context, convID domain.ConversationID, userMessage, answer string) {
+	if !s.autoTitles || (s.router == nil && s.llm == nil) {
+		return
+	}
+	msgs, err := s.convs.GetMessages(ctx, convID, 3)
+	if err != nil || len(msgs) > 2 {
+		return
+	}
+
+	bg, cancel := context.WithTimeout(domain.WithPriority(context.WithoutCancel(ctx), domain.PriorityBackground), titleTimeout)
+	go func() {
+		defer cancel()
+		if err := s.generateTitle(bg, convID, userMessage, answer); err != nil {
+			s.logger.Warn("auto title failed", "conversation_id", string(convID), "error", err)
+		}
+	}()
+}
+
+// generateTitle asks the fast model for a short title and stores it, unless
+// the user renamed the conversation in the meantime.
+func (s *ReActAgentService) generateTitle(ctx context.Context, convID domain.ConversationID, userMessage, answer string) error {
+	prompt := "Write a short title (at most 6 words) for a conversation that starts with this exchange. " +
+		"Reply with the title only, no quotes or punctuation at the end.\n\n" +
+		"User: " + truncateRunes(userMessage, 1000) + "\n\nAssistant: " + truncateRunes(answer, 1000) + "\n\nTitle:"
+
+	var raw string
+	var err error
+	if s.router != nil {
+		raw, err = s.router.GenerateText(ctx, prompt, s.router.ResolveModel(nil, domain.ModelRoleFast))
+	} else {
+		raw, err = s.llm.GenerateText(ctx, prompt)
+	}
+	if err != nil {
+		return err
+	}
+	title := cleanTitle(raw)
+	if title == "" {
+		return nil
+	}
+
+	conv, err := s.convs.GetConversation(ctx, convID)
+	if err != nil {
+		return err
+	}
+	if conv.Title != DefaultConversationTitle && conv.Title != PlaceholderTitle(userMessage) {
+		return nil // renamed by the user
+	}
+	if err := s.convs.UpdateTitle(ctx, convID, title); err != nil {
+		return err
+	}
+	s.logger.Info("conversation titled", "conversation_id", string(convID), "title", title)
+	s.publishConversationUpdated(ctx, convID, title)
+	return nil
+}
+
+func (s *ReActAgentService) publishConversationUpdated(ctx context.Context, convID domain.ConversationID, title string) {
+	if s.eventBus == nil {
+		return
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"conversation_id": string(convID),
+		"title":           title,
+	})
+	s.eventBus.PublishContext(ctx, Event{
+		JobID:     string(convID),
+		Type:      EventTypeConversationUpdated,
+		Data:      string(data),
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
+
+// cleanTitle reduces a model reply to a single short title line.
+func cleanTitle(raw string) string {
+	title := strings.TrimSpace(raw)
+	if i := strings.IndexByte(title, '\n'); i >= 0 {
+		title = title[:i]
+	}
+	if len(title) >= 6 && strings.EqualFold(title[:6], "title:") {
+		title = title[6:]
+	}
+	title = strings.Trim(title, " \t\"'`*#")
+	title = strings.TrimRightFunc(title, func(r rune) bool {
+		return unicode.IsPunct(r) && r != ')' && r != '?'
+	})
+
+	if words := strings.Fields(title); len(words) > titleMaxWords {
+		title = strings.Join(words[:titleMaxWords], " ")
+	}
+	return truncateRunes(title, titleMaxLen)
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(r[:n]))
+}
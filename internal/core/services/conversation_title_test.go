@@ -0,0 +1,28 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanTitle(t *testing.T) {
+	cases := map[string]string{
+		"Planning a Trip to Lisbon":                        "Planning a Trip to Lisbon",
+		"  \"Debugging Go Race Conditions.\"  ":            "Debugging Go Race Conditions",
+		"Title: Docker Networking Basics\nHope it helps":   "Docker Networking Basics",
+		"**Weekly Budget Spreadsheet**":                    "Weekly Budget Spreadsheet",
+		"What Is Rust?":                                    "What Is Rust?",
+		"one two three four five six seven eight nine ten": "one two three four five six seven eight",
+		"   ": "",
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, cleanTitle(in), "input %q", in)
+	}
+}
+
+func TestPlaceholderTitle(t *testing.T) {
+	assert.Equal(t, "short", PlaceholderTitle("short"))
+	long := "this message is definitely longer than fifty characters in total"
+	assert.Equal(t, long[:50]+"...", PlaceholderTitle(long))
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// backgroundTurnWait is how long non-interactive callers (cron, workflows,
+// automations…) queue behind a running turn before giving up. Interactive
+// chats fail at once so the user gets a clear answer instead of a hang.
+const backgroundTurnWait = 10 * time.Minute
+
+// AcquireTurn reserves the conversation for one agent turn, so two turns
+// never interleave their messages. If a turn is running it waits up to
+// wait for it to end (0 = not at all) and then fails with
+// domain.ErrConversationBusy. The returned release must be called when the
+// turn ends; calling it again is a no-op.
+func (s *ConversationStore) AcquireTurn(ctx context.Context, convID domain.ConversationID, wait time.Duration) (release func(), err error) {
+	var timeout <-chan time.Time
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	for {
+		s.turnsMu.Lock()
+		running, busy := s.turns[convID]
+		if !busy {
+			done := make(chan struct{})
+			s.turns[convID] = done
+			s.turnsMu.Unlock()
+			var once sync.Once
+			return func() {
+				once.Do(func() {
+					s.turnsMu.Lock()
+					delete(s.turns, convID)
+					s.turnsMu.Unlock()
+					close(done)
+				})
+			}, nil
+		}
+		s.turnsMu.Unlock()
+
+		if timeout == nil {
+			return nil, domain.ErrConversationBusy
+		}
+		select {
+		case <-running:
+		case <-timeout:
+			return nil, domain.ErrConversationBusy
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// TurnRunning reports whether an agent turn is running in the conversation.
+func (s *ConversationStore) TurnRunning(convID domain.ConversationID) bool {
+	s.turnsMu.Lock()
+	defer s.turnsMu.Unlock()
+	_, busy := s.turns[convID]
+	return busy
+}
+
+// beginTurn reserves convID for a turn of this agent: interactive chats
+// fail fast when it is busy, everything else queues.
+func (s *ReActAgentService) beginTurn(ctx context.Context, convID domain.ConversationID) (func(), error) {
+	wait := backgroundTurnWait
+	if domain.PriorityFrom(ctx) == domain.PriorityInteractive {
+		wait = 0
+	}
+	release, err := s.convs.AcquireTurn(ctx, convID, wait)
+	if err != nil {
+		s.logger.WarnContext(ctx, "conversation busy", "conversation_id", string(convID), "error", err)
+	}
+	return release, err
+}
+
+// trackRun makes the run in ctx cancellable through Cancel(convID).
+// untrack must be called when the run ends.
+func (s *ReActAgentService) trackRun(ctx context.Context, convID domain.ConversationID) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	s.runsMu.Lock()
+	if s.runs == nil {
+		s.runs = make(map[domain.ConversationID]context.CancelCauseFunc)
+	}
+	s.runs[convID] = cancel
+	s.runsMu.Unlock()
+	return ctx, func() {
+		s.runsMu.Lock()
+		delete(s.runs, convID)
+		s.runsMu.Unlock()
+		cancel(nil)
+	}
+}
+
+// Cancel stops the agent run in progress in convID: the LLM call or tool it
+// is waiting on is cancelled, and the steps taken so far are kept as a
+// cancelled assistant message. It fails with domain.ErrNoActiveRun when
+// nothing is running.
+func (s *ReActAgentService) Cancel(convID domain.ConversationID) error {
+	s.runsMu.Lock()
+	cancel, ok := s.runs[convID]
+	s.runsMu.Unlock()
+	if !ok {
+		return domain.ErrNoActiveRun
+	}
+	s.logger.Info("agent run cancelled", "conversation_id", string(convID))
+	cancel(domain.ErrRunCancelled)
+	return nil
+}
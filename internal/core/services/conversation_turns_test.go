@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversationStore_AcquireTurn(t *testing.T) {
+	store := NewConversationStore(nil, 0)
+	ctx := context.Background()
+
+	release, err := store.AcquireTurn(ctx, "conv-1", 0)
+	require.NoError(t, err)
+	assert.True(t, store.TurnRunning("conv-1"))
+
+	_, err = store.AcquireTurn(ctx, "conv-1", 0)
+	assert.ErrorIs(t, err, domain.ErrConversationBusy)
+	_, err = store.AcquireTurn(ctx, "conv-1", 20*time.Millisecond)
+	assert.ErrorIs(t, err, domain.ErrConversationBusy)
+
+	// Other conversations are independent
+	other, err := store.AcquireTurn(ctx, "conv-2", 0)
+	require.NoError(t, err)
+	other()
+
+	// A waiter gets the turn once the running one ends
+	acquired := make(chan error, 1)
+	go func() {
+		next, err := store.AcquireTurn(ctx, "conv-1", time.Second)
+		if err == nil {
+			next()
+		}
+		acquired <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	release()
+	release() // no-op
+	select {
+	case err := <-acquired:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("waiter never got the turn")
+	}
+	assert.False(t, store.TurnRunning("conv-1"))
+
+	// Cancellation stops the wait
+	held, err := store.AcquireTurn(ctx, "conv-1", 0)
+	require.NoError(t, err)
+	defer held()
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = store.AcquireTurn(cancelled, "conv-1", time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestReActAgent_ChatRefusesBusyConversation(t *testing.T) {
+	store := NewConversationStore(nil, 0)
+	agent := &ReActAgentService{logger: slog.New(slog.NewTextHandler(io.Discard, nil)), convs: store}
+
+	release, err := store.AcquireTurn(context.Background(), "conv-1", 0)
+	require.NoError(t, err)
+	defer release()
+
+	ctx := domain.WithPriority(context.Background(), domain.PriorityInteractive)
+	_, _, err = agent.Chat(ctx, "conv-1", "hello", nil)
+	assert.ErrorIs(t, err, domain.ErrConversationBusy)
+	_, err = agent.Regenerate(ctx, "conv-1", "msg-1")
+	assert.ErrorIs(t, err, domain.ErrConversationBusy)
+	_, err = agent.EditMessage(ctx, "conv-1", "msg-1", "hi")
+	assert.ErrorIs(t, err, domain.ErrConversationBusy)
+}
+
+func TestReActAgent_CancelRun(t *testing.T) {
+	agent := &ReActAgentService{logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	assert.ErrorIs(t, agent.Cancel("conv-1"), domain.ErrNoActiveRun)
+
+	ctx, untrack := agent.trackRun(context.Background(), "conv-1")
+	require.NoError(t, agent.Cancel("conv-1"))
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("run context not cancelled")
+	}
+	assert.ErrorIs(t, context.Cause(ctx), domain.ErrRunCancelled)
+
+	// A finished run can no longer be cancelled
+	untrack()
+	assert.ErrorIs(t, agent.Cancel("conv-1"), domain.ErrNoActiveRun)
+	ctx, untrack = agent.trackRun(context.Background(), "conv-2")
+	untrack()
+	assert.ErrorIs(t, context.Cause(ctx), context.Canceled)
+}
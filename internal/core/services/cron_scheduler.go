@@ -28,6 +28,8 @@ type CronScheduler struct {
 	agent    *ReActAgentService
 	eventBus *EventBus
 	tick     time.Duration // check interval (1 minute default)
+
+	maintenance *MaintenanceMode // optional; due tasks wait while paused
 }
 
 func NewCronScheduler(logger *slog.Logger, repo ScheduledTaskRepository, agent *ReActAgentService, eventBus *EventBus) *CronScheduler {
@@ -40,9 +42,16 @@ func NewCronScheduler(logger *slog.Logger, repo ScheduledTaskRepository, agent *
 	}
 }
 
+// SetMaintenance makes the scheduler skip ticks while maintenance mode is on.
+// Tasks that fall due meanwhile run on the first tick after resume.
+func (s *CronScheduler) SetMaintenance(m *MaintenanceMode) {
+	s.maintenance = m
+}
+
 // Run starts the scheduler loop. Blocks until ctx is cancelled.
 func (s *CronScheduler) Run(ctx context.Context) error {
 	s.logger.Info("cron scheduler started", "check_interval", s.tick)
+	ctx = domain.WithSubsystem(ctx, "cron")
 	ticker := time.NewTicker(s.tick)
 	defer ticker.Stop()
 
@@ -52,6 +61,9 @@ func (s *CronScheduler) Run(ctx context.Context) error {
 			s.logger.Info("cron scheduler stopped")
 			return nil
 		case <-ticker.C:
+			if s.maintenance.Paused() {
+				continue
+			}
 			s.checkAndExecute(ctx)
 		}
 	}
@@ -89,7 +101,7 @@ func (s *CronScheduler) executeTask(ctx context.Context, task *domain.ScheduledT
 	} else {
 		// LLM-based execution via ReAct agent
 		convID := domain.ConversationID(fmt.Sprintf("cron-%s-%d", task.ID, time.Now().Unix()))
-		resp, _, err := s.agent.Chat(ctx, convID, task.Prompt, task.PersonaID)
+		resp, _, err := s.agent.Chat(domain.WithPriority(ctx, domain.PriorityBackground), convID, task.Prompt, task.PersonaID)
 		if err != nil {
 			execErr = err
 		} else {
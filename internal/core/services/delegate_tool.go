@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
 )
@@ -15,6 +16,7 @@ import (
 func NewDelegateTool(orchestrator *SubAgentOrchestrator) *domain.Tool {
 	return &domain.Tool{
 		Name:        "delegate",
+		Timeout:     20 * time.Minute, // sub-agents run full ReAct loops
 		Description: "Delegate sub-tasks to specialized persona agents. Each task runs in parallel with its own model. Use when the request involves multiple distinct sub-tasks (e.g., research + code + creative).",
 		Parameters: domain.ToolParameters{
 			Type: "object",
@@ -37,6 +39,18 @@ func NewDelegateTool(orchestrator *SubAgentOrchestrator) *domain.Tool {
 						"required": []string{"persona", "prompt"},
 					},
 				},
+				"shared_context": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Give sub-agents a shared scratchpad (shared_read/shared_write) to exchange intermediate findings",
+				},
+				"aggregate": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Synthesize all sub-agent outputs into a single answer citing which sub-agent produced what",
+				},
+				"goal": map[string]interface{}{
+					"type":        "string",
+					"description": "Overall objective, used when aggregating results",
+				},
 			},
 			Required: []string{"tasks"},
 		},
@@ -100,11 +114,17 @@ func NewDelegateTool(orchestrator *SubAgentOrchestrator) *domain.Tool {
 			convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID)
 			parentID, _ := ctx.Value(ctxKeySubAgentID).(domain.SubAgentID)
 
+			opts := DelegateOptions{}
+			opts.SharedContext, _ = params["shared_context"].(bool)
+			opts.Aggregate, _ = params["aggregate"].(bool)
+			opts.Goal, _ = params["goal"].(string)
+
 			// Execute all sub-agents in parallel
-			results, err := orchestrator.Delegate(ctx, convID, parentID, taskSpecs)
-			if err != nil {
+			delegation, err := orchestrator.DelegateWithOptions(ctx, convID, parentID, taskSpecs, opts)
+			if err != nil && len(delegation.Tasks) == 0 {
 				return nil, fmt.Errorf("delegation failed: %w", err)
 			}
+			results := delegation.Tasks
 
 			// Format combined results
 			var summary strings.Builder
@@ -119,11 +139,19 @@ func NewDelegateTool(orchestrator *SubAgentOrchestrator) *domain.Tool {
 				}
 			}
 
-			return map[string]interface{}{
+			out := map[string]interface{}{
 				"status":    "completed",
 				"sub_tasks": len(results),
 				"summary":   summary.String(),
-			}, nil
+			}
+			if len(delegation.Scratchpad) > 0 {
+				out["scratchpad"] = delegation.Scratchpad
+			}
+			if delegation.Answer != "" {
+				out["answer"] = delegation.Answer
+				out["sources"] = delegation.Sources
+			}
+			return out, nil
 		},
 	}
 }
@@ -132,8 +160,10 @@ func NewDelegateTool(orchestrator *SubAgentOrchestrator) *domain.Tool {
 type contextKey string
 
 const (
-	ctxKeyConversationID contextKey = "conversation_id"
-	ctxKeySubAgentID     contextKey = "sub_agent_id"
+	ctxKeyConversationID    contextKey = "conversation_id"
+	ctxKeySubAgentID        contextKey = "sub_agent_id"
+	ctxKeyDelegationDepth   contextKey = "delegation_depth"
+	ctxKeyDelegationLineage contextKey = "delegation_lineage"
 )
 
 // ContextWithConversation adds the conversation ID to context for tools to use.
@@ -143,5 +173,35 @@ func ContextWithConversation(ctx context.Context, id domain.ConversationID) cont
 
 // ContextWithSubAgent adds the sub-agent ID to context for nested delegation.
 func ContextWithSubAgent(ctx context.Context, id domain.SubAgentID) context.Context {
+	// Sub-agents run beside the parent loop and must not write its checkpoint
+	ctx = contextWithInputWaiter(ctx, nil)
 	return context.WithValue(ctx, ctxKeySubAgentID, id)
 }
+
+// DelegationDepth returns how many delegation levels deep ctx is (0 = top-level agent).
+func DelegationDepth(ctx context.Context) int {
+	depth, _ := ctx.Value(ctxKeyDelegationDepth).(int)
+	return depth
+}
+
+// delegationLineage returns the persona|prompt keys of all ancestor sub-agents.
+func delegationLineage(ctx context.Context) []string {
+	lineage, _ := ctx.Value(ctxKeyDelegationLineage).([]string)
+	return lineage
+}
+
+// contextWithDelegation records the delegation depth and ancestor lineage on ctx.
+func contextWithDelegation(ctx context.Context, depth int, lineage []string) context.Context {
+	ctx = context.WithValue(ctx, ctxKeyDelegationDepth, depth)
+	return context.WithValue(ctx, ctxKeyDelegationLineage, lineage)
+}
+
+// detachDelegationContext returns a background context that keeps the
+// conversation and delegation bookkeeping of ctx but not its cancellation.
+func detachDelegationContext(ctx context.Context) context.Context {
+	bg := context.Background()
+	if convID, ok := ctx.Value(ctxKeyConversationID).(domain.ConversationID); ok {
+		bg = ContextWithConversation(bg, convID)
+	}
+	return contextWithDelegation(bg, DelegationDepth(ctx), delegationLineage(ctx))
+}
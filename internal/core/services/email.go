@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+const (
+	emailDefaultPoll  = 60 * time.Second
+	emailFetchBatch   = 20
+	emailPromptMaxLen = 16000
+)
+
+// chatAgent is the part of ReActAgentService that background triggers
+// (inbox poller, feed monitor) need.
+type chatAgent interface {
+	Chat(ctx context.Context, convID domain.ConversationID, message string, personaID *domain.PersonaID) (*domain.AgentResponse, domain.ConversationID, error)
+}
+
+// conversationEnsurer is the part of ConversationStore that background
+// triggers need to file their runs under a fixed conversation.
+type conversationEnsurer interface {
+	EnsureConversation(ctx context.Context, id domain.ConversationID, title string) error
+}
+
+// EmailService sends mail for the send_email tool and polls the IMAP inbox,
+// running each email from an allowed sender through the agent in a
+// conversation per thread. Sender and inbox are swapped on settings changes.
+type EmailService struct {
+	logger *slog.Logger
+	agent  chatAgent
+	convs  conversationEnsurer
+
+	mu     sync.RWMutex
+	cfg    domain.EmailConfig
+	sender ports.EmailSender // nil = SMTP not configured
+	inbox  ports.EmailInbox  // nil = poller disabled
+
+	maintenance *MaintenanceMode // optional; polling waits while paused
+}
+
+func NewEmailService(logger *slog.Logger, agent chatAgent, convs conversationEnsurer) *EmailService {
+	return &EmailService{logger: logger, agent: agent, convs: convs}
+}
+
+// SetMaintenance makes the poller skip ticks while maintenance mode is on.
+func (s *EmailService) SetMaintenance(m *MaintenanceMode) {
+	s.maintenance = m
+}
+
+// Update applies new settings. A nil sender disables send_email; a nil
+// inbox stops the poller until the next update.
+func (s *EmailService) Update(cfg domain.EmailConfig, sender ports.EmailSender, inbox ports.EmailInbox) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cfg = cfg
+	s.sender = sender
+	s.inbox = inbox
+}
+
+// SendEmail delivers msg, addressing it to the configured default recipient
+// when it names none. Every recipient must pass the allowlist.
+func (s *EmailService) SendEmail(ctx context.Context, msg domain.EmailMessage) (string, error) {
+	s.mu.RLock()
+	cfg, sender := s.cfg.SMTP, s.sender
+	s.mu.RUnlock()
+	if sender == nil {
+		return "", fmt.Errorf("email is not configured: set SMTP in settings")
+	}
+	if len(msg.To) == 0 && cfg.DefaultTo != "" {
+		msg.To = []string{cfg.DefaultTo}
+	}
+	if len(msg.To) == 0 {
+		return "", fmt.Errorf("no recipient given and no default recipient configured")
+	}
+	if len(cfg.AllowedRecipients) > 0 {
+		for _, addr := range append(append([]string{}, msg.To...), msg.Cc...) {
+			if !domain.AddressAllowed(cfg.AllowedRecipients, addr) {
+				return "", fmt.Errorf("recipient %s is not in the allowed recipients list", addr)
+			}
+		}
+	}
+	id, err := sender.SendEmail(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+	s.logger.Info("email sent", "to", strings.Join(msg.To, ","), "subject", msg.Subject)
+	return id, nil
+}
+
+// Run polls the inbox until ctx is cancelled.
+func (s *EmailService) Run(ctx context.Context) error {
+	ctx = domain.WithSubsystem(ctx, "email")
+	timer := time.NewTimer(s.pollInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			if !s.maintenance.Paused() {
+				s.poll(ctx)
+			}
+			timer.Reset(s.pollInterval())
+		}
+	}
+}
+
+func (s *EmailService) pollInterval() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.cfg.IMAP.PollIntervalSec > 0 {
+		return time.Duration(s.cfg.IMAP.PollIntervalSec) * time.Second
+	}
+	return emailDefaultPoll
+}
+
+// poll handles one batch of unseen mail. Every fetched message is marked
+// seen, including ignored ones, so nothing is processed twice.
+func (s *EmailService) poll(ctx context.Context) {
+	s.mu.RLock()
+	cfg, inbox := s.cfg.IMAP, s.inbox
+	s.mu.RUnlock()
+	if inbox == nil {
+		return
+	}
+
+	emails, err := inbox.FetchUnseen(ctx, emailFetchBatch)
+	if err != nil {
+		s.logger.Warn("email poll failed", "error", err)
+	}
+	if len(emails) == 0 {
+		return
+	}
+
+	uids := make([]uint32, 0, len(emails))
+	for _, e := range emails {
+		uids = append(uids, e.UID)
+	}
+	if err := inbox.MarkSeen(ctx, uids); err != nil {
+		// Leave them unseen rather than risk answering twice on the next poll
+		s.logger.Error("email mark seen failed, skipping batch", "error", err)
+		return
+	}
+
+	for _, e := range emails {
+		if !domain.AddressAllowed(cfg.AllowedSenders, e.From) {
+			s.logger.Warn("email from disallowed sender ignored", "from", e.From, "subject", e.Subject)
+			continue
+		}
+		if err := s.handleInbound(ctx, cfg, e); err != nil {
+			s.logger.Error("inbound email failed", "from", e.From, "subject", e.Subject, "error", err)
+		}
+	}
+}
+
+// handleInbound runs one email through the agent and optionally replies.
+func (s *EmailService) handleInbound(ctx context.Context, cfg domain.IMAPConfig, e domain.InboundEmail) error {
+	convID := emailConversationID(e)
+	title := strings.TrimSpace(e.Subject)
+	if title == "" {
+		title = "Email from " + e.From
+	}
+	if err := s.convs.EnsureConversation(ctx, convID, PlaceholderTitle(title)); err != nil {
+		return fmt.Errorf("create conversation: %w", err)
+	}
+
+	var persona *domain.PersonaID
+	if cfg.PersonaID != "" {
+		p := domain.PersonaID(cfg.PersonaID)
+		persona = &p
+	}
+	s.logger.Info("inbound email", "from", e.From, "subject", e.Subject, "conversation_id", string(convID))
+	resp, _, err := s.agent.Chat(domain.WithPriority(ctx, domain.PriorityBackground), convID, emailPrompt(e), persona)
+	if err != nil {
+		return err
+	}
+	if !cfg.Reply || resp == nil || strings.TrimSpace(resp.Response) == "" {
+		return nil
+	}
+
+	subject := e.Subject
+	if !strings.HasPrefix(strings.ToLower(subject), "re:") {
+		subject = "Re: " + subject
+	}
+	refs := append([]string{}, e.References...)
+	if e.MessageID != "" {
+		refs = append(refs, e.MessageID)
+	}
+	// Replies go to the sender directly: the allowlist for inbound mail
+	// already vouches for them.
+	s.mu.RLock()
+	sender := s.sender
+	s.mu.RUnlock()
+	if sender == nil {
+		return fmt.Errorf("reply: smtp is not configured")
+	}
+	if _, err := sender.SendEmail(ctx, domain.EmailMessage{
+		To:         []string{e.From},
+		Subject:    subject,
+		Body:       resp.Response,
+		InReplyTo:  e.MessageID,
+		References: refs,
+	}); err != nil {
+		return fmt.Errorf("reply: %w", err)
+	}
+	return nil
+}
+
+// emailConversationID maps an email thread to a stable conversation ID.
+func emailConversationID(e domain.InboundEmail) domain.ConversationID {
+	root := e.ThreadRoot()
+	if root == "" {
+		root = e.From + "\x00" + e.Subject
+	}
+	sum := sha256.Sum256([]byte(root))
+	return domain.ConversationID("email-" + hex.EncodeToString(sum[:8]))
+}
+
+func emailPrompt(e domain.InboundEmail) string {
+	from := e.From
+	if e.FromName != "" {
+		from = e.FromName + " <" + e.From + ">"
+	}
+	return fmt.Sprintf("New email received.\nFrom: %s\nSubject: %s\n\n%s",
+		from, e.Subject, truncateRunes(strings.TrimSpace(e.Body), emailPromptMaxLen))
+}
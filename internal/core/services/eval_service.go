@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// evalStore is the persistence subset EvalService needs.
+type evalStore interface {
+	SaveEvalSuite(ctx context.Context, suite domain.EvalSuite) error
+	GetEvalSuite(ctx context.Context, id domain.EvalSuiteID) (domain.EvalSuite, error)
+	ListEvalSuites(ctx context.Context) ([]domain.EvalSuite, error)
+	DeleteEvalSuite(ctx context.Context, id domain.EvalSuiteID) error
+	SaveEvalRun(ctx context.Context, run domain.EvalRun) error
+	GetEvalRun(ctx context.Context, id domain.EvalRunID) (domain.EvalRun, error)
+	ListEvalRuns(ctx context.Context, suiteID domain.EvalSuiteID, limit int) ([]domain.EvalRun, error)
+}
+
+// evalAgent is the agent entry point evals exercise (ReActAgentService.Chat).
+type evalAgent interface {
+	Chat(ctx context.Context, convID domain.ConversationID, message string, personaID *domain.PersonaID) (*domain.AgentResponse, domain.ConversationID, error)
+}
+
+// evalConversations creates the conversation each eval case runs in.
+type evalConversations interface {
+	CreateConversationWithPersona(ctx context.Context, title string, personaID *domain.PersonaID) (domain.Conversation, error)
+}
+
+// EvalRunRequest selects a suite and the persona/model matrix to run it against.
+// One run is produced per persona × model combination.
+type EvalRunRequest struct {
+	SuiteID    domain.EvalSuiteID `json:"suite_id"`
+	PersonaIDs []domain.PersonaID `json:"persona_ids,omitempty"`
+	Models     []string           `json:"models,omitempty"`
+	Wait       bool               `json:"wait,omitempty"` // block until all runs finish
+}
+
+// EvalService manages eval suites and scores agent runs against them.
+type EvalService struct {
+	logger *slog.Logger
+	repo   evalStore
+	agent  evalAgent
+	convs  evalConversations
+}
+
+func NewEvalService(logger *slog.Logger, repo evalStore, agent evalAgent, convs evalConversations) *EvalService {
+	return &EvalService{logger: logger, repo: repo, agent: agent, convs: convs}
+}
+
+// SaveSuite validates and stores a suite, assigning an ID on create.
+func (s *EvalService) SaveSuite(ctx context.Context, suite domain.EvalSuite) (domain.EvalSuite, error) {
+	if err := suite.Validate(); err != nil {
+		return domain.EvalSuite{}, err
+	}
+	now := time.Now()
+	if suite.ID == "" {
+		suite.ID = domain.NewEvalSuiteID()
+	}
+	if suite.CreatedAt.IsZero() {
+		suite.CreatedAt = now
+	}
+	suite.UpdatedAt = now
+	if err := s.repo.SaveEvalSuite(ctx, suite); err != nil {
+		return domain.EvalSuite{}, err
+	}
+	return suite, nil
+}
+
+func (s *EvalService) GetSuite(ctx context.Context, id domain.EvalSuiteID) (domain.EvalSuite, error) {
+	return s.repo.GetEvalSuite(ctx, id)
+}
+
+func (s *EvalService) ListSuites(ctx context.Context) ([]domain.EvalSuite, error) {
+	return s.repo.ListEvalSuites(ctx)
+}
+
+func (s *EvalService) DeleteSuite(ctx context.Context, id domain.EvalSuiteID) error {
+	return s.repo.DeleteEvalSuite(ctx, id)
+}
+
+func (s *EvalService) GetRun(ctx context.Context, id domain.EvalRunID) (domain.EvalRun, error) {
+	return s.repo.GetEvalRun(ctx, id)
+}
+
+func (s *EvalService) ListRuns(ctx context.Context, suiteID domain.EvalSuiteID, limit int) ([]domain.EvalRun, error) {
+	return s.repo.ListEvalRuns(ctx, suiteID, limit)
+}
+
+// Run starts one run per persona × model combination. Without Wait the runs
+// execute in the background and are returned in the "running" state.
+func (s *EvalService) Run(ctx context.Context, req EvalRunRequest) ([]domain.EvalRun, error) {
+	suite, err := s.repo.GetEvalSuite(ctx, req.SuiteID)
+	if err != nil {
+		return nil, err
+	}
+
+	personas := make([]*domain.PersonaID, 0, len(req.PersonaIDs))
+	for i := range req.PersonaIDs {
+		personas = append(personas, &req.PersonaIDs[i])
+	}
+	if len(personas) == 0 {
+		personas = []*domain.PersonaID{nil} // agent default persona
+	}
+	models := req.Models
+	if len(models) == 0 {
+		models = []string{""} // persona/project default model
+	}
+
+	runs := make([]domain.EvalRun, 0, len(personas)*len(models))
+	for _, pid := range personas {
+		for _, model := range models {
+			run := domain.EvalRun{
+				ID:        domain.NewEvalRunID(),
+				SuiteID:   suite.ID,
+				PersonaID: pid,
+				Model:     model,
+				Status:    domain.EvalRunRunning,
+				Total:     len(suite.Cases),
+				StartedAt: time.Now(),
+			}
+			if err := s.repo.SaveEvalRun(ctx, run); err != nil {
+				return nil, fmt.Errorf("save eval run: %w", err)
+			}
+			runs = append(runs, run)
+		}
+	}
+
+	if !req.Wait {
+		go func() {
+			bg := context.WithoutCancel(ctx)
+			for _, run := range runs {
+				s.execute(bg, suite, run)
+			}
+		}()
+		return runs, nil
+	}
+
+	for i, run := range runs {
+		runs[i] = s.execute(ctx, suite, run)
+	}
+	return runs, nil
+}
+
+// Diff compares a run with base. When base is empty, the previous finished run
+// of the same suite, persona and model is used.
+func (s *EvalService) Diff(ctx context.Context, runID, baseID domain.EvalRunID) (domain.EvalDiff, error) {
+	run, err := s.repo.GetEvalRun(ctx, runID)
+	if err != nil {
+		return domain.EvalDiff{}, err
+	}
+
+	var base domain.EvalRun
+	if baseID != "" {
+		if base, err = s.repo.GetEvalRun(ctx, baseID); err != nil {
+			return domain.EvalDiff{}, err
+		}
+		if base.SuiteID != run.SuiteID {
+			return domain.EvalDiff{}, fmt.Errorf("runs belong to different suites")
+		}
+		return domain.DiffEvalRuns(base, run), nil
+	}
+
+	previous, err := s.repo.ListEvalRuns(ctx, run.SuiteID, 200)
+	if err != nil {
+		return domain.EvalDiff{}, err
+	}
+	for _, p := range previous { // newest first
+		if p.ID == run.ID || p.Status != domain.EvalRunCompleted || !p.StartedAt.Before(run.StartedAt) {
+			continue
+		}
+		if p.Model == run.Model && samePersona(p.PersonaID, run.PersonaID) {
+			return domain.DiffEvalRuns(p, run), nil
+		}
+	}
+	return domain.EvalDiff{}, fmt.Errorf("no earlier completed run to compare with: %w", domain.ErrEvalRunNotFound)
+}
+
+// execute runs every case of the suite sequentially and persists the scored run.
+func (s *EvalService) execute(ctx context.Context, suite domain.EvalSuite, run domain.EvalRun) domain.EvalRun {
+	s.logger.Info("eval run started", "run_id", string(run.ID), "suite", suite.Name, "model", run.Model)
+
+	if run.Model != "" {
+		ctx = ContextWithModel(ctx, run.Model)
+	}
+	// Temperature 0 keeps runs comparable (and lets repeated prompts hit the LLM cache)
+	ctx = domain.WithDeterministic(ctx)
+	ctx = domain.WithPriority(ctx, domain.PriorityBackground)
+
+	var total float64
+	run.Results = make([]domain.EvalCaseResult, 0, len(suite.Cases))
+	for _, c := range suite.Cases {
+		res := s.runCase(ctx, suite, c, run.PersonaID)
+		run.Results = append(run.Results, res)
+		total += res.Score
+		if res.Passed {
+			run.Passed++
+		}
+	}
+
+	run.Score = total / float64(len(suite.Cases))
+	run.Status = domain.EvalRunCompleted
+	fin := time.Now()
+	run.FinishedAt = &fin
+
+	if err := s.repo.SaveEvalRun(context.WithoutCancel(ctx), run); err != nil {
+		s.logger.Error("failed to save eval run", "run_id", string(run.ID), "error", err)
+	}
+	s.logger.Info("eval run finished", "run_id", string(run.ID), "score", run.Score, "passed", run.Passed, "total", run.Total)
+	return run
+}
+
+func (s *EvalService) runCase(ctx context.Context, suite domain.EvalSuite, c domain.EvalCase, personaID *domain.PersonaID) domain.EvalCaseResult {
+	start := time.Now()
+
+	var convID domain.ConversationID
+	if s.convs != nil {
+		conv, err := s.convs.CreateConversationWithPersona(ctx, fmt.Sprintf("[eval] %s / %s", suite.Name, c.Name), personaID)
+		if err != nil {
+			return domain.EvalCaseResult{Case: c.Name, ToolsUsed: []string{}, Error: err.Error(), DurationMs: time.Since(start).Milliseconds()}
+		}
+		convID = conv.ID
+	}
+
+	resp, convID, err := s.agent.Chat(ctx, convID, c.Prompt, personaID)
+	if err != nil {
+		return domain.EvalCaseResult{
+			Case:           c.Name,
+			ToolsUsed:      []string{},
+			Error:          err.Error(),
+			Failures:       []string{"agent error: " + err.Error()},
+			ConversationID: convID,
+			DurationMs:     time.Since(start).Milliseconds(),
+		}
+	}
+
+	res := domain.ScoreEvalCase(c, strings.TrimSpace(resp.Response), resp.Steps)
+	res.ConversationID = convID
+	res.DurationMs = time.Since(start).Milliseconds()
+	return res
+}
+
+func samePersona(a, b *domain.PersonaID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
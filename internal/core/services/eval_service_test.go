@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memEvalStore struct {
+	suites map[domain.EvalSuiteID]domain.EvalSuite
+	runs   map[domain.EvalRunID]domain.EvalRun
+}
+
+func (s *memEvalStore) SaveEvalSuite(_ context.Context, suite domain.EvalSuite) error {
+	s.suites[suite.ID] = suite
+	return nil
+}
+
+func (s *memEvalStore) GetEvalSuite(_ context.Context, id domain.EvalSuiteID) (domain.EvalSuite, error) {
+	suite, ok := s.suites[id]
+	if !ok {
+		return domain.EvalSuite{}, domain.ErrEvalSuiteNotFound
+	}
+	return suite, nil
+}
+
+func (s *memEvalStore) ListEvalSuites(context.Context) ([]domain.EvalSuite, error) { return nil, nil }
+
+func (s *memEvalStore) DeleteEvalSuite(context.Context, domain.EvalSuiteID) error { return nil }
+
+func (s *memEvalStore) SaveEvalRun(_ context.Context, run domain.EvalRun) error {
+	s.runs[run.ID] = run
+	return nil
+}
+
+func (s *memEvalStore) GetEvalRun(_ context.Context, id domain.EvalRunID) (domain.EvalRun, error) {
+	run, ok := s.runs[id]
+	if !ok {
+		return domain.EvalRun{}, domain.ErrEvalRunNotFound
+	}
+	return run, nil
+}
+
+func (s *memEvalStore) ListEvalRuns(_ context.Context, suiteID domain.EvalSuiteID, _ int) ([]domain.EvalRun, error) {
+	var out []domain.EvalRun
+	for _, r := range s.runs {
+		if r.SuiteID == suiteID {
+			out = append(out, r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartedAt.After(out[j].StartedAt) })
+	return out, nil
+}
+
+// evalFakeAgent answers with the forced model name and calls web_search for weather prompts.
+type evalFakeAgent struct{}
+
+func (evalFakeAgent) Chat(ctx context.Context, convID domain.ConversationID, message string, _ *domain.PersonaID) (*domain.AgentResponse, domain.ConversationID, error) {
+	model, _ := GetModelFromContext(ctx)
+	resp := &domain.AgentResponse{Response: "answer from " + model}
+	if strings.Contains(message, "weather") {
+		resp.Steps = []domain.ReActStep{{Action: "web_search"}, {}}
+		if model == "good" {
+			resp.Response = "It is sunny in Lisbon"
+		}
+	}
+	return resp, "conv-eval", nil
+}
+
+func TestEvalService_RunAndDiff(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	ctx := context.Background()
+	store := &memEvalStore{suites: map[domain.EvalSuiteID]domain.EvalSuite{}, runs: map[domain.EvalRunID]domain.EvalRun{}}
+	svc := NewEvalService(logger, store, evalFakeAgent{}, nil)
+
+	_, err := svc.SaveSuite(ctx, domain.EvalSuite{Name: "bad", Cases: []domain.EvalCase{{Name: "x", Prompt: "p", Assertions: []domain.EvalAssertion{{Type: "fuzzy"}}}}})
+	require.Error(t, err)
+
+	suite, err := svc.SaveSuite(ctx, domain.EvalSuite{
+		Name: "smoke",
+		Cases: []domain.EvalCase{
+			{
+				Name:          "weather",
+				Prompt:        "what's the weather in Lisbon?",
+				ExpectedTools: []string{"web_search"},
+				Assertions: []domain.EvalAssertion{
+					{Type: domain.EvalAssertContains, Value: "sunny"},
+					{Type: domain.EvalAssertMaxSteps, Value: "3"},
+				},
+			},
+			{Name: "hello", Prompt: "say hi", Assertions: []domain.EvalAssertion{{Type: domain.EvalAssertRegex, Value: `^answer from \w+$`}}},
+		},
+	})
+	require.NoError(t, err)
+
+	good, err := svc.Run(ctx, EvalRunRequest{SuiteID: suite.ID, Models: []string{"good"}, Wait: true})
+	require.NoError(t, err)
+	require.Len(t, good, 1)
+	assert.Equal(t, domain.EvalRunCompleted, good[0].Status)
+	assert.Equal(t, 2, good[0].Passed)
+	assert.InDelta(t, 1.0, good[0].Score, 0.001)
+	assert.Equal(t, []string{"web_search"}, good[0].Results[0].ToolsUsed)
+
+	time.Sleep(time.Millisecond) // ensure the second run starts strictly later
+	bad, err := svc.Run(ctx, EvalRunRequest{SuiteID: suite.ID, Models: []string{"good", "bad"}, Wait: true})
+	require.NoError(t, err)
+	require.Len(t, bad, 2)
+	assert.Equal(t, 1, bad[1].Passed)
+	assert.Contains(t, bad[1].Results[0].Failures, `output does not contain "sunny"`)
+
+	// Explicit base: the "bad" model regressed on the weather case
+	diff, err := svc.Diff(ctx, bad[1].ID, good[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, diff.Regressions)
+	assert.Less(t, diff.ScoreDelta, 0.0)
+
+	// Implicit base: previous run of the same model
+	diff, err = svc.Diff(ctx, bad[0].ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, good[0].ID, diff.BaseRunID)
+	assert.Equal(t, 0, diff.Regressions)
+}
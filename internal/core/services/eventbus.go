@@ -1,8 +1,17 @@
 package services
 
 import (
+	"cmp"
+	"context"
+	"encoding/json"
 	"log/slog"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
 )
 
 type EventType string
@@ -12,6 +21,15 @@ const (
 	EventTypeLog        EventType = "log"
 	EventTypeSubAgent   EventType = "sub_agent"
 	EventTypeNewMessage EventType = "new_message"
+	EventTypeToken      EventType = "token"       // streamed LLM output of a running chat
+	EventTypeToolOutput EventType = "tool_output" // streamed partial output of a running tool
+
+	EventTypeConversationUpdated EventType = "conversation_updated" // title or metadata changed
+	EventTypeMaintenance         EventType = "maintenance"          // system paused or resumed
+	EventTypeUsageAlert          EventType = "usage_alert"          // a usage limit passed its warning threshold or ran out
+	EventTypeToolsChanged        EventType = "tools_changed"        // a tool was registered or unregistered at runtime
+	EventTypeQuestion            EventType = "question"             // the agent asked the user a clarifying question (ask_user)
+	EventTypeQuestionClosed      EventType = "question_closed"      // the question was answered, timed out or cancelled
 )
 
 type Event struct {
@@ -19,105 +37,204 @@ type Event struct {
 	Type      EventType
 	Data      string // JSON payload or raw text
 	Timestamp int64
+	RequestID string // API request that caused the event, if known
+}
+
+// DefaultEventBuffer is how many undelivered events a subscriber may hold
+// before the oldest are dropped.
+const DefaultEventBuffer = 100
+
+// subscriber is one Subscribe call. Its counters are updated without the
+// bus lock, hence atomics.
+type subscriber struct {
+	id      int64
+	topic   string // JobID, or a prefix when prefix is set
+	prefix  bool
+	ch      chan Event
+	since   time.Time
+	sent    atomic.Int64
+	dropped atomic.Int64
+	maxLag  atomic.Int64
 }
 
-type Subscription struct {
-	id     string
-	events chan Event
+// SubscriberStats describes how far behind a subscriber is.
+type SubscriberStats struct {
+	ID        int64     `json:"id"`
+	Topic     string    `json:"topic"` // as passed to Subscribe, e.g. "job-1" or "wf-*"
+	Since     time.Time `json:"since"`
+	Lag       int       `json:"lag"`     // events buffered but not yet read
+	MaxLag    int64     `json:"max_lag"` // highest lag seen
+	Capacity  int       `json:"capacity"`
+	Delivered int64     `json:"delivered"` // events queued, read or not
+	Dropped   int64     `json:"dropped"`   // oldest events discarded because the buffer was full
 }
 
+// EventBus fans events out to subscribers by topic (the event's JobID).
+// Publishing never blocks: a subscriber that falls a full buffer behind
+// loses its oldest events.
 type EventBus struct {
-	logger   *slog.Logger
-	mu       sync.RWMutex
-	subs     map[string][]chan Event // Key: JobID
-	globalCh []chan Event            // Subscribers that receive ALL events
+	logger *slog.Logger
+	mu     sync.RWMutex
+	subs   map[string][]*subscriber // exact topic subscriptions, keyed by JobID
+	prefix []*subscriber            // wildcard subscriptions, including global ones
+	nextID int64
+	buffer int
 }
 
 func NewEventBus(logger *slog.Logger) *EventBus {
 	return &EventBus{
 		logger: logger,
-		subs:   make(map[string][]chan Event),
+		subs:   make(map[string][]*subscriber),
+		buffer: DefaultEventBuffer,
+	}
+}
+
+// SetBufferSize changes the buffer of subscriptions made from now on;
+// n <= 0 restores DefaultEventBuffer.
+func (b *EventBus) SetBufferSize(n int) {
+	if n <= 0 {
+		n = DefaultEventBuffer
 	}
+	b.mu.Lock()
+	b.buffer = n
+	b.mu.Unlock()
 }
 
 // SubscribeGlobal returns a channel that receives all events (broadcast + job events).
 // Useful for the frontend to receive proactive agent messages without knowing job IDs.
 func (b *EventBus) SubscribeGlobal() (<-chan Event, func()) {
+	return b.Subscribe("*")
+}
+
+// Subscribe returns a channel that receives events for a topic, usually a
+// job, conversation or workflow ID. A topic ending in "*" is a wildcard
+// matching every ID with that prefix, so "*" alone receives everything.
+// The returned func unsubscribes and closes the channel.
+func (b *EventBus) Subscribe(topic string) (<-chan Event, func()) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	ch := make(chan Event, 100)
-	b.globalCh = append(b.globalCh, ch)
+	b.nextID++
+	sub := &subscriber{id: b.nextID, topic: topic, ch: make(chan Event, b.buffer), since: time.Now()}
+	if p, ok := strings.CutSuffix(topic, "*"); ok {
+		sub.topic, sub.prefix = p, true
+		b.prefix = append(b.prefix, sub)
+	} else {
+		b.subs[topic] = append(b.subs[topic], sub)
+	}
 
+	var once sync.Once
 	unsub := func() {
-		b.mu.Lock()
-		defer b.mu.Unlock()
-		for i, sub := range b.globalCh {
-			if sub == ch {
-				close(ch)
-				b.globalCh = append(b.globalCh[:i], b.globalCh[i+1:]...)
-				break
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if sub.prefix {
+				b.prefix = slices.DeleteFunc(b.prefix, func(s *subscriber) bool { return s == sub })
+			} else if b.subs[topic] = slices.DeleteFunc(b.subs[topic], func(s *subscriber) bool { return s == sub }); len(b.subs[topic]) == 0 {
+				delete(b.subs, topic)
 			}
-		}
+			close(sub.ch)
+		})
 	}
+	return sub.ch, unsub
+}
 
-	return ch, unsub
+// PublishContext is Publish with the event tagged with ctx's request ID.
+func (b *EventBus) PublishContext(ctx context.Context, e Event) {
+	if e.RequestID == "" {
+		e.RequestID = domain.RequestIDFrom(ctx)
+	}
+	b.Publish(e)
 }
 
-// Subscribe returns a channel that receives events for a specific job
-func (b *EventBus) Subscribe(jobID string) (<-chan Event, func()) {
-	b.mu.Lock()
-	defer b.mu.Unlock()
+// Publish sends an event to the subscribers of its JobID and to every
+// wildcard subscriber whose prefix matches.
+func (b *EventBus) Publish(e Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
 
-	ch := make(chan Event, 100) // Buffer to prevent blocking publisher
-	b.subs[jobID] = append(b.subs[jobID], ch)
+	for _, sub := range b.subs[e.JobID] {
+		b.deliver(sub, e)
+	}
+	for _, sub := range b.prefix {
+		if strings.HasPrefix(e.JobID, sub.topic) {
+			b.deliver(sub, e)
+		}
+	}
+}
 
-	// Unsubscribe function
-	unsub := func() {
-		b.mu.Lock()
-		defer b.mu.Unlock()
-
-		subscribers := b.subs[jobID]
-		for i, sub := range subscribers {
-			if sub == ch {
-				// Close channel
-				close(ch)
-				// Remove from slice
-				b.subs[jobID] = append(subscribers[:i], subscribers[i+1:]...)
-				break
+// deliver hands e to sub without blocking, evicting the oldest buffered
+// event when the buffer is full. The caller holds b.mu for reading, so the
+// channel cannot be closed underneath.
+func (b *EventBus) deliver(sub *subscriber, e Event) {
+	for {
+		select {
+		case sub.ch <- e:
+			sub.sent.Add(1)
+			if lag := int64(len(sub.ch)); lag > sub.maxLag.Load() {
+				sub.maxLag.Store(lag)
 			}
+			return
+		default:
 		}
-		if len(b.subs[jobID]) == 0 {
-			delete(b.subs, jobID)
+		select {
+		case <-sub.ch:
+			if sub.dropped.Add(1) == 1 {
+				b.logger.Warn("event subscriber is falling behind, dropping oldest events", "topic", sub.topicName(), "job_id", e.JobID)
+			}
+		default:
 		}
 	}
+}
 
-	return ch, unsub
+func (s *subscriber) topicName() string {
+	if s.prefix {
+		return s.topic + "*"
+	}
+	return s.topic
 }
 
-// Publish sends an event to all subscribers of the job AND global subscribers
-func (b *EventBus) Publish(e Event) {
+// Stats reports every subscriber's lag, ordered by subscription.
+func (b *EventBus) Stats() []SubscriberStats {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
-	// Send to job-specific subscribers
-	subscribers, ok := b.subs[e.JobID]
-	if ok {
-		for _, ch := range subscribers {
-			select {
-			case ch <- e:
-			default:
-				b.logger.Warn("event bus channel full, dropping event", "job_id", e.JobID)
-			}
-		}
+	out := make([]SubscriberStats, 0, len(b.prefix))
+	add := func(sub *subscriber) {
+		out = append(out, SubscriberStats{
+			ID:        sub.id,
+			Topic:     sub.topicName(),
+			Since:     sub.since,
+			Lag:       len(sub.ch),
+			MaxLag:    sub.maxLag.Load(),
+			Capacity:  cap(sub.ch),
+			Delivered: sub.sent.Load(),
+			Dropped:   sub.dropped.Load(),
+		})
 	}
-
-	// Send to global subscribers (broadcast channel + all events)
-	for _, ch := range b.globalCh {
-		select {
-		case ch <- e:
-		default:
-			b.logger.Warn("event bus global channel full, dropping event", "job_id", e.JobID)
+	for _, subs := range b.subs {
+		for _, sub := range subs {
+			add(sub)
 		}
 	}
+	for _, sub := range b.prefix {
+		add(sub)
+	}
+	slices.SortFunc(out, func(x, y SubscriberStats) int { return cmp.Compare(x.ID, y.ID) })
+	return out
+}
+
+// PublishToolChanges broadcasts every change to tools as a tools_changed
+// event on the system channel, so clients can refresh /v1/tools. The
+// returned func stops it.
+func (b *EventBus) PublishToolChanges(tools *domain.ToolRegistry) (cancel func()) {
+	return tools.Subscribe(func(c domain.ToolChange) {
+		data, _ := json.Marshal(c)
+		b.Publish(Event{
+			JobID:     string(domain.SystemConversationID),
+			Type:      EventTypeToolsChanged,
+			Data:      string(data),
+			Timestamp: time.Now().UnixMilli(),
+		})
+	})
 }
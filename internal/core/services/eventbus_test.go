@@ -92,3 +92,54 @@ func TestEventBus_MultipleSubscribers(t *testing.T) {
 	assert.True(t, got1)
 	assert.True(t, got2)
 }
+
+func TestEventBus_WildcardSubscriptions(t *testing.T) {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	bus := NewEventBus(logger)
+
+	wf, unsubWf := bus.Subscribe("wf-*")
+	defer unsubWf()
+	all, unsubAll := bus.SubscribeGlobal()
+	defer unsubAll()
+
+	bus.Publish(Event{JobID: "wf-1", Data: "a"})
+	bus.Publish(Event{JobID: "job-1", Data: "b"})
+
+	select {
+	case e := <-wf:
+		assert.Equal(t, "a", e.Data)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for wildcard event")
+	}
+	select {
+	case e := <-wf:
+		t.Fatalf("wildcard received unmatched event: %v", e)
+	default:
+	}
+	assert.Len(t, all, 2)
+}
+
+func TestEventBus_DropsOldestWhenFull(t *testing.T) {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	bus := NewEventBus(logger)
+	bus.SetBufferSize(2)
+
+	ch, unsub := bus.Subscribe("job-slow")
+	defer unsub()
+	for _, d := range []string{"1", "2", "3", "4"} {
+		bus.Publish(Event{JobID: "job-slow", Data: d})
+	}
+
+	stats := bus.Stats()
+	if assert.Len(t, stats, 1) {
+		assert.Equal(t, "job-slow", stats[0].Topic)
+		assert.Equal(t, 2, stats[0].Lag)
+		assert.EqualValues(t, 2, stats[0].MaxLag)
+		assert.EqualValues(t, 4, stats[0].Delivered)
+		assert.EqualValues(t, 2, stats[0].Dropped)
+	}
+
+	// The newest events survive
+	assert.Equal(t, "3", (<-ch).Data)
+	assert.Equal(t, "4", (<-ch).Data)
+}
@@ -0,0 +1,371 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	feedTick          = time.Minute
+	feedFetchTimeout  = 30 * time.Second
+	feedMaxBytes      = 4 << 20
+	feedMaxTriggered  = 20 // new items passed to one trigger; the rest are only marked seen
+	feedUserAgent     = "auleOS-feed/1.0"
+	feedPromptItemLen = 300
+)
+
+// FeedRepository persists watched feeds and their dedup state.
+type FeedRepository interface {
+	SaveFeed(ctx context.Context, f *domain.FeedWatch) error
+	GetFeed(ctx context.Context, id domain.FeedID) (*domain.FeedWatch, error)
+	ListFeeds(ctx context.Context, projectID domain.ProjectID) ([]domain.FeedWatch, error)
+	DeleteFeed(ctx context.Context, id domain.FeedID) error
+	FilterUnseenFeedItems(ctx context.Context, id domain.FeedID, keys []string) ([]string, error)
+	MarkFeedItemsSeen(ctx context.Context, id domain.FeedID, keys []string, at time.Time) error
+}
+
+// workflowStarter is the part of WorkflowExecutor the feed monitor needs.
+type workflowStarter interface {
+	Start(ctx context.Context, wf *domain.Workflow) error
+}
+
+// automationDispatcher runs an automation rule for an event from one of its
+// trigger sources.
+type automationDispatcher interface {
+	Dispatch(ctx context.Context, id domain.AutomationID, ev domain.AutomationEvent) error
+}
+
+// FeedMonitor polls watched RSS/Atom feeds and, when new items appear, runs
+// the feed's prompt through the agent or starts a copy of its workflow.
+type FeedMonitor struct {
+	logger    *slog.Logger
+	repo      FeedRepository
+	agent     chatAgent
+	convs     conversationEnsurer
+	workflows WorkflowRepository
+	executor  workflowStarter
+	client    *http.Client
+	tick      time.Duration
+	now       func() time.Time
+
+	allowPrivate bool // tests only: permit loopback feed URLs
+
+	maintenance *MaintenanceMode     // optional; polling waits while paused
+	automations automationDispatcher // optional; receives items of rule-backed feeds
+}
+
+func NewFeedMonitor(logger *slog.Logger, repo FeedRepository, agent chatAgent, convs conversationEnsurer, workflows WorkflowRepository, executor workflowStarter) *FeedMonitor {
+	m := &FeedMonitor{
+		logger:    logger,
+		repo:      repo,
+		agent:     agent,
+		convs:     convs,
+		workflows: workflows,
+		executor:  executor,
+		tick:      feedTick,
+		now:       time.Now,
+	}
+	m.client = &http.Client{Timeout: feedFetchTimeout, CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= DefaultWebFetchMaxRedirects || (!m.allowPrivate && isSSRFTarget(req.URL.String())) {
+			return fmt.Errorf("feed redirect to %s refused", req.URL.Host)
+		}
+		return nil
+	}}
+	return m
+}
+
+// SetMaintenance makes the monitor skip ticks while maintenance mode is on.
+func (m *FeedMonitor) SetMaintenance(mm *MaintenanceMode) {
+	m.maintenance = mm
+}
+
+// SetAutomations routes new items of feeds with an AutomationID to rules.
+func (m *FeedMonitor) SetAutomations(d automationDispatcher) {
+	m.automations = d
+}
+
+// Watch validates and registers a feed. The items it holds now are marked
+// seen, so only items published after this call trigger.
+func (m *FeedMonitor) Watch(ctx context.Context, f *domain.FeedWatch) error {
+	if f.Prompt == "" && f.WorkflowID == "" && f.AutomationID == "" {
+		return fmt.Errorf("either prompt or workflow_id is required")
+	}
+	if f.IntervalSec == 0 {
+		f.IntervalSec = domain.FeedDefaultIntervalSec
+	}
+	if f.IntervalSec < domain.FeedMinIntervalSec {
+		return fmt.Errorf("interval must be at least %d minutes", domain.FeedMinIntervalSec/60)
+	}
+	if f.WorkflowID != "" {
+		if _, err := m.workflows.GetWorkflow(ctx, f.WorkflowID); err != nil {
+			return fmt.Errorf("workflow %s: %w", f.WorkflowID, err)
+		}
+	}
+	title, items, err := m.fetch(ctx, f.URL)
+	if err != nil {
+		return err
+	}
+	if f.Name == "" {
+		f.Name = title
+	}
+	if f.Name == "" {
+		f.Name = f.URL
+	}
+
+	if f.ID == "" {
+		f.ID = domain.FeedID(uuid.New().String())
+	}
+	now := m.now()
+	if err := m.repo.MarkFeedItemsSeen(ctx, f.ID, itemKeys(items), now); err != nil {
+		return err
+	}
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = now
+	}
+	f.LastChecked = &now
+	f.LastError = ""
+	if err := m.repo.SaveFeed(ctx, f); err != nil {
+		return err
+	}
+	m.logger.Info("feed watched", "feed_id", string(f.ID), "name", f.Name, "items", len(items))
+	return nil
+}
+
+// List returns the watched feeds, or only the project's when projectID is set.
+func (m *FeedMonitor) List(ctx context.Context, projectID domain.ProjectID) ([]domain.FeedWatch, error) {
+	return m.repo.ListFeeds(ctx, projectID)
+}
+
+// Unwatch removes a feed and its dedup state.
+func (m *FeedMonitor) Unwatch(ctx context.Context, id domain.FeedID) error {
+	return m.repo.DeleteFeed(ctx, id)
+}
+
+// Toggle pauses or resumes a feed. Items published while paused trigger
+// on the first poll after resuming.
+func (m *FeedMonitor) Toggle(ctx context.Context, id domain.FeedID) (*domain.FeedWatch, error) {
+	f, err := m.repo.GetFeed(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return f, m.SetPaused(ctx, f, !f.Paused)
+}
+
+// SetPaused pauses or resumes f and saves it.
+func (m *FeedMonitor) SetPaused(ctx context.Context, f *domain.FeedWatch, paused bool) error {
+	f.Paused = paused
+	return m.repo.SaveFeed(ctx, f)
+}
+
+// Run polls due feeds until ctx is cancelled.
+func (m *FeedMonitor) Run(ctx context.Context) error {
+	ctx = domain.WithSubsystem(ctx, "feeds")
+	ticker := time.NewTicker(m.tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if m.maintenance.Paused() {
+				continue
+			}
+			m.checkFeeds(ctx)
+		}
+	}
+}
+
+func (m *FeedMonitor) checkFeeds(ctx context.Context) {
+	feeds, err := m.repo.ListFeeds(ctx, "")
+	if err != nil {
+		m.logger.Error("failed to list feeds", "error", err)
+		return
+	}
+	now := m.now()
+	for i := range feeds {
+		if feeds[i].Due(now) {
+			m.poll(ctx, &feeds[i])
+		}
+	}
+}
+
+// poll fetches one feed, records new items as seen and triggers on them.
+func (m *FeedMonitor) poll(ctx context.Context, f *domain.FeedWatch) {
+	now := m.now()
+	f.LastChecked = &now
+	f.LastError = ""
+
+	newItems, err := m.newItems(ctx, f, now)
+	if err != nil {
+		f.LastError = err.Error()
+		m.logger.Warn("feed poll failed", "feed_id", string(f.ID), "url", f.URL, "error", err)
+	} else if len(newItems) > 0 {
+		f.TriggerCount++
+	}
+	if err := m.repo.SaveFeed(ctx, f); err != nil {
+		m.logger.Error("failed to save feed", "feed_id", string(f.ID), "error", err)
+		return
+	}
+	if len(newItems) == 0 {
+		return
+	}
+
+	m.logger.Info("new feed items", "feed_id", string(f.ID), "name", f.Name, "count", len(newItems))
+	feed := *f
+	go func() {
+		if err := m.trigger(ctx, feed, newItems); err != nil {
+			m.logger.Error("feed trigger failed", "feed_id", string(feed.ID), "error", err)
+		}
+	}()
+}
+
+func (m *FeedMonitor) newItems(ctx context.Context, f *domain.FeedWatch, now time.Time) ([]domain.FeedItem, error) {
+	_, items, err := m.fetch(ctx, f.URL)
+	if err != nil {
+		return nil, err
+	}
+	unseen, err := m.repo.FilterUnseenFeedItems(ctx, f.ID, itemKeys(items))
+	if err != nil {
+		return nil, err
+	}
+	if len(unseen) == 0 {
+		return nil, nil
+	}
+	if err := m.repo.MarkFeedItemsSeen(ctx, f.ID, unseen, now); err != nil {
+		return nil, err
+	}
+
+	isNew := make(map[string]bool, len(unseen))
+	for _, k := range unseen {
+		isNew[k] = true
+	}
+	var out []domain.FeedItem
+	for _, it := range items {
+		if isNew[it.GUID] && len(out) < feedMaxTriggered {
+			out = append(out, it)
+			delete(isNew, it.GUID) // duplicate entries within one document
+		}
+	}
+	return out, nil
+}
+
+// trigger dispatches the items to the feed's automation rule, starts its
+// workflow, or runs its prompt in the feed's conversation.
+func (m *FeedMonitor) trigger(ctx context.Context, f domain.FeedWatch, items []domain.FeedItem) error {
+	ctx = domain.WithPriority(ctx, domain.PriorityBackground)
+	if f.AutomationID != "" {
+		if m.automations == nil {
+			return fmt.Errorf("automations not enabled")
+		}
+		return m.automations.Dispatch(ctx, f.AutomationID, feedEvent(f, items))
+	}
+	if f.WorkflowID != "" {
+		tmpl, err := m.workflows.GetWorkflow(ctx, f.WorkflowID)
+		if err != nil {
+			return fmt.Errorf("load workflow: %w", err)
+		}
+		wf := newWorkflowRun(tmpl, "feed: "+f.Name, map[string]any{
+			"feed_items": formatFeedItems(f, items),
+			"feed_name":  f.Name,
+			"feed_url":   f.URL,
+		}, m.now())
+		return m.executor.Start(ctx, wf)
+	}
+
+	convID := domain.ConversationID("feed-" + string(f.ID))
+	if err := m.convs.EnsureConversation(ctx, convID, PlaceholderTitle("Feed: "+f.Name)); err != nil {
+		return fmt.Errorf("create conversation: %w", err)
+	}
+	_, _, err := m.agent.Chat(ctx, convID, f.Prompt+"\n\n"+formatFeedItems(f, items), f.PersonaID)
+	return err
+}
+
+// feedEvent describes new items to an automation rule.
+func feedEvent(f domain.FeedWatch, items []domain.FeedItem) domain.AutomationEvent {
+	titles := make([]string, 0, len(items))
+	links := make([]string, 0, len(items))
+	for _, it := range items {
+		titles = append(titles, it.Title)
+		if it.Link != "" {
+			links = append(links, it.Link)
+		}
+	}
+	text := formatFeedItems(f, items)
+	return domain.AutomationEvent{
+		Kind: domain.AutomationTriggerFeed,
+		Data: map[string]string{
+			"feed_name": f.Name,
+			"feed_url":  f.URL,
+			"titles":    strings.Join(titles, "\n"),
+			"links":     strings.Join(links, "\n"),
+			"items":     text,
+			"count":     strconv.Itoa(len(items)),
+		},
+		Text: text,
+	}
+}
+
+func formatFeedItems(f domain.FeedWatch, items []domain.FeedItem) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "New items in feed %q (%s):\n", f.Name, f.URL)
+	for _, it := range items {
+		fmt.Fprintf(&b, "\n- %s", it.Title)
+		if it.Link != "" {
+			fmt.Fprintf(&b, "\n  Link: %s", it.Link)
+		}
+		if !it.Published.IsZero() {
+			fmt.Fprintf(&b, "\n  Published: %s", it.Published.Format(time.RFC3339))
+		}
+		if it.Summary != "" {
+			fmt.Fprintf(&b, "\n  %s", truncateRunes(it.Summary, feedPromptItemLen))
+		}
+	}
+	return b.String()
+}
+
+// fetch downloads and parses a feed.
+func (m *FeedMonitor) fetch(ctx context.Context, rawURL string) (string, []domain.FeedItem, error) {
+	if !m.allowPrivate && isSSRFTarget(rawURL) {
+		return "", nil, fmt.Errorf("feed URL denied: must be a public http(s) address")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid feed URL: %w", err)
+	}
+	req.Header.Set("User-Agent", feedUserAgent)
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/xml;q=0.9, text/xml;q=0.9, */*;q=0.5")
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("fetch feed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("fetch feed: HTTP %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, feedMaxBytes))
+	if err != nil {
+		return "", nil, fmt.Errorf("read feed: %w", err)
+	}
+	return parseFeed(body)
+}
+
+func itemKeys(items []domain.FeedItem) []string {
+	keys := make([]string, 0, len(items))
+	for _, it := range items {
+		if it.GUID != "" {
+			keys = append(keys, it.GUID)
+		}
+	}
+	return keys
+}
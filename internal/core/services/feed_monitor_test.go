@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const testRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/">
+<channel><title>Release notes</title>
+<item><title>v1.2 &amp; fixes</title><link>https://example.com/v1.2</link><guid>rel-1.2</guid>
+<description><![CDATA[<p>Adds <b>streaming</b>.</p>]]></description><pubDate>Tue, 10 Mar 2026 09:00:00 +0000</pubDate></item>
+<item><title>v1.1</title><link>https://example.com/v1.1</link><content:encoded>Older</content:encoded></item>
+</channel></rss>`
+
+const testAtom = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom"><title>Blog</title>
+<entry><id>tag:blog,2026:1</id><title type="html">Hello &lt;b&gt;world&lt;/b&gt;</title>
+<link rel="self" href="https://blog/self"/><link href="https://blog/1"/>
+<summary>First post</summary><updated>2026-03-09T12:00:00Z</updated></entry>
+</feed>`
+
+func TestParseFeed(t *testing.T) {
+	title, items, err := parseFeed([]byte(testRSS))
+	require.NoError(t, err)
+	assert.Equal(t, "Release notes", title)
+	require.Len(t, items, 2)
+	assert.Equal(t, "rel-1.2", items[0].GUID)
+	assert.Equal(t, "v1.2 & fixes", items[0].Title)
+	assert.Equal(t, "Adds streaming .", items[0].Summary)
+	assert.Equal(t, 2026, items[0].Published.Year())
+	assert.Equal(t, "https://example.com/v1.1", items[1].GUID, "link is the key without a guid")
+	assert.Equal(t, "Older", items[1].Summary)
+
+	title, items, err = parseFeed([]byte(testAtom))
+	require.NoError(t, err)
+	assert.Equal(t, "Blog", title)
+	require.Len(t, items, 1)
+	assert.Equal(t, "tag:blog,2026:1", items[0].GUID)
+	assert.Equal(t, "Hello world", items[0].Title)
+	assert.Equal(t, "https://blog/1", items[0].Link)
+
+	_, _, err = parseFeed([]byte("<html><body>not a feed</body></html>"))
+	assert.Error(t, err)
+}
+
+type memFeedRepo struct {
+	mu    sync.Mutex
+	feeds map[domain.FeedID]domain.FeedWatch
+	seen  map[string]bool
+}
+
+func newMemFeedRepo() *memFeedRepo {
+	return &memFeedRepo{feeds: map[domain.FeedID]domain.FeedWatch{}, seen: map[string]bool{}}
+}
+
+func (r *memFeedRepo) SaveFeed(_ context.Context, f *domain.FeedWatch) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.feeds[f.ID] = *f
+	return nil
+}
+
+func (r *memFeedRepo) GetFeed(_ context.Context, id domain.FeedID) (*domain.FeedWatch, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.feeds[id]
+	if !ok {
+		return nil, domain.ErrFeedNotFound
+	}
+	return &f, nil
+}
+
+func (r *memFeedRepo) ListFeeds(_ context.Context, _ domain.ProjectID) ([]domain.FeedWatch, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []domain.FeedWatch
+	for _, f := range r.feeds {
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+func (r *memFeedRepo) DeleteFeed(_ context.Context, id domain.FeedID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.feeds, id)
+	return nil
+}
+
+func (r *memFeedRepo) FilterUnseenFeedItems(_ context.Context, id domain.FeedID, keys []string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []string
+	for _, k := range keys {
+		if !r.seen[string(id)+"|"+k] {
+			out = append(out, k)
+		}
+	}
+	return out, nil
+}
+
+func (r *memFeedRepo) MarkFeedItemsSeen(_ context.Context, id domain.FeedID, keys []string, _ time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, k := range keys {
+		r.seen[string(id)+"|"+k] = true
+	}
+	return nil
+}
+
+type recordingAgent struct {
+	mu       sync.Mutex
+	convs    []domain.ConversationID
+	messages []string
+	done     chan struct{}
+}
+
+func (a *recordingAgent) Chat(_ context.Context, convID domain.ConversationID, message string, _ *domain.PersonaID) (*domain.AgentResponse, domain.ConversationID, error) {
+	a.mu.Lock()
+	a.convs = append(a.convs, convID)
+	a.messages = append(a.messages, message)
+	a.mu.Unlock()
+	a.done <- struct{}{}
+	return &domain.AgentResponse{Response: "ok"}, convID, nil
+}
+
+type memWorkflows struct {
+	wfs     map[domain.WorkflowID]*domain.Workflow
+	started chan *domain.Workflow
+}
+
+func (m *memWorkflows) GetWorkflow(_ context.Context, id domain.WorkflowID) (*domain.Workflow, error) {
+	wf, ok := m.wfs[id]
+	if !ok {
+		return nil, domain.ErrFeedNotFound
+	}
+	return wf, nil
+}
+func (m *memWorkflows) SaveWorkflow(_ context.Context, wf *domain.Workflow) error { return nil }
+func (m *memWorkflows) ListWorkflows(_ context.Context) ([]domain.Workflow, error) {
+	return nil, nil
+}
+func (m *memWorkflows) Start(_ context.Context, wf *domain.Workflow) error {
+	m.started <- wf
+	return nil
+}
+
+func newTestFeedMonitor(t *testing.T) (*FeedMonitor, *memFeedRepo, *recordingAgent, *memWorkflows, *string) {
+	body := testRSS
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+
+	repo := newMemFeedRepo()
+	agent := &recordingAgent{done: make(chan struct{}, 4)}
+	convs := &fakeEmailConvs{ensured: map[domain.ConversationID]string{}}
+	wfs := &memWorkflows{wfs: map[domain.WorkflowID]*domain.Workflow{}, started: make(chan *domain.Workflow, 4)}
+	m := NewFeedMonitor(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, agent, convs, wfs, wfs)
+	m.allowPrivate = true
+	srvURL := srv.URL
+	return m, repo, agent, wfs, &srvURL
+}
+
+func TestFeedMonitorTriggersOnlyOnNewItems(t *testing.T) {
+	m, repo, agent, _, url := newTestFeedMonitor(t)
+	ctx := context.Background()
+
+	f := &domain.FeedWatch{URL: *url, Prompt: "Summarize the releases"}
+	require.NoError(t, m.Watch(ctx, f))
+	assert.Equal(t, "Release notes", f.Name)
+	assert.Equal(t, domain.FeedDefaultIntervalSec, f.IntervalSec)
+
+	// Nothing new: existing items were seeded as seen
+	m.poll(ctx, f)
+	assert.Equal(t, 0, f.TriggerCount)
+
+	repo.seen[string(f.ID)+"|rel-1.2"] = false
+	m.poll(ctx, f)
+	select {
+	case <-agent.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("prompt was not triggered")
+	}
+	assert.Equal(t, 1, f.TriggerCount)
+	require.Len(t, agent.messages, 1)
+	assert.Contains(t, agent.messages[0], "Summarize the releases")
+	assert.Contains(t, agent.messages[0], "v1.2 & fixes")
+	assert.NotContains(t, agent.messages[0], "v1.1")
+	assert.Equal(t, domain.ConversationID("feed-"+string(f.ID)), agent.convs[0])
+
+	m.poll(ctx, f)
+	assert.Equal(t, 1, f.TriggerCount, "items trigger once")
+}
+
+func TestFeedMonitorStartsWorkflowCopy(t *testing.T) {
+	m, repo, _, wfs, url := newTestFeedMonitor(t)
+	ctx := context.Background()
+	wfs.wfs["wf-digest"] = &domain.Workflow{
+		ID: "wf-digest", Name: "Digest", Status: domain.WorkflowStatusCompleted,
+		State: map[string]any{"audience": "team"},
+		Steps: []domain.WorkflowStep{{ID: "sum", Prompt: "Summarize {{state.feed_items}}", Status: domain.StepStatusDone}},
+	}
+
+	assert.Error(t, m.Watch(ctx, &domain.FeedWatch{URL: *url, WorkflowID: "missing"}))
+	assert.Error(t, m.Watch(ctx, &domain.FeedWatch{URL: *url, Prompt: "x", IntervalSec: 60}), "interval below minimum")
+
+	f := &domain.FeedWatch{URL: *url, WorkflowID: "wf-digest"}
+	require.NoError(t, m.Watch(ctx, f))
+	repo.seen[string(f.ID)+"|https://example.com/v1.1"] = false
+	m.poll(ctx, f)
+
+	var wf *domain.Workflow
+	select {
+	case wf = <-wfs.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("workflow was not started")
+	}
+	assert.NotEqual(t, domain.WorkflowID("wf-digest"), wf.ID)
+	assert.Equal(t, domain.WorkflowStatusPending, wf.Status)
+	assert.Equal(t, domain.StepStatusPending, wf.Steps[0].Status)
+	assert.Equal(t, "team", wf.State["audience"])
+	assert.Contains(t, wf.State["feed_items"], "v1.1")
+	assert.Equal(t, domain.StepStatusDone, wfs.wfs["wf-digest"].Steps[0].Status, "template is untouched")
+}
+
+func TestFeedWatchDue(t *testing.T) {
+	now := time.Now()
+	f := domain.FeedWatch{IntervalSec: 600}
+	assert.True(t, f.Due(now))
+	last := now.Add(-5 * time.Minute)
+	f.LastChecked = &last
+	assert.False(t, f.Due(now))
+	assert.True(t, f.Due(now.Add(5*time.Minute)))
+	f.Paused = true
+	assert.False(t, f.Due(now.Add(time.Hour)))
+}
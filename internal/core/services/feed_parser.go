@@ -0,0 +1,165 @@
+package services
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const feedSummaryMaxLen = 500
+
+// xmlFeed covers RSS 2.0 (<rss><channel><item>), RSS 1.0 (<rdf:RDF><item>)
+// and Atom (<feed><entry>) documents.
+type xmlFeed struct {
+	XMLName xml.Name
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	Items   []rssItem   `xml:"item"`
+	Title   string      `xml:"title"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	GUID        string `xml:"guid"`
+	Description string `xml:"description"`
+	Encoded     string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PubDate     string `xml:"pubDate"`
+	Date        string `xml:"http://purl.org/dc/elements/1.1/ date"`
+}
+
+type atomEntry struct {
+	ID    string `xml:"id"`
+	Title string `xml:"title"`
+	Links []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+	Summary   string `xml:"summary"`
+	Content   string `xml:"content"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+}
+
+// parseFeed returns the feed title and its items in document order.
+func parseFeed(data []byte) (string, []domain.FeedItem, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Strict = false
+	dec.Entity = xml.HTMLEntity
+	dec.CharsetReader = feedCharsetReader
+
+	var doc xmlFeed
+	if err := dec.Decode(&doc); err != nil {
+		return "", nil, fmt.Errorf("not an RSS or Atom feed: %w", err)
+	}
+
+	var items []domain.FeedItem
+	switch strings.ToLower(doc.XMLName.Local) {
+	case "rss", "rdf":
+		rss := doc.Channel.Items
+		if len(rss) == 0 {
+			rss = doc.Items
+		}
+		for _, it := range rss {
+			summary := it.Description
+			if summary == "" {
+				summary = it.Encoded
+			}
+			date := it.PubDate
+			if date == "" {
+				date = it.Date
+			}
+			items = append(items, newFeedItem(it.GUID, it.Title, strings.TrimSpace(it.Link), summary, date))
+		}
+		return cleanFeedText(doc.Channel.Title), items, nil
+	case "feed":
+		for _, e := range doc.Entries {
+			link := ""
+			for _, l := range e.Links {
+				if l.Rel == "" || l.Rel == "alternate" {
+					link = l.Href
+					break
+				}
+			}
+			summary := e.Summary
+			if summary == "" {
+				summary = e.Content
+			}
+			date := e.Published
+			if date == "" {
+				date = e.Updated
+			}
+			items = append(items, newFeedItem(e.ID, e.Title, link, summary, date))
+		}
+		return cleanFeedText(doc.Title), items, nil
+	default:
+		return "", nil, fmt.Errorf("not an RSS or Atom feed: root element <%s>", doc.XMLName.Local)
+	}
+}
+
+func newFeedItem(guid, title, link, summary, date string) domain.FeedItem {
+	item := domain.FeedItem{
+		GUID:    strings.TrimSpace(guid),
+		Title:   cleanFeedText(title),
+		Link:    link,
+		Summary: truncateRunes(cleanFeedText(summary), feedSummaryMaxLen),
+	}
+	if item.GUID == "" {
+		item.GUID = link
+	}
+	if item.GUID == "" {
+		item.GUID = item.Title
+	}
+	item.Published, _ = parseFeedDate(date)
+	return item
+}
+
+// cleanFeedText reduces possibly HTML-bearing feed text to one plain line.
+func cleanFeedText(s string) string {
+	if strings.Contains(s, "<") {
+		s = extractTextFromHTML(s)
+	}
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
+
+var feedDateLayouts = []string{
+	time.RFC1123Z, time.RFC1123, time.RFC3339, time.RFC822Z, time.RFC822,
+	"Mon, 2 Jan 2006 15:04:05 -0700", "Mon, 2 Jan 2006 15:04:05 MST", "2 Jan 2006 15:04:05 -0700", "2006-01-02",
+}
+
+func parseFeedDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range feedDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", s)
+}
+
+// feedCharsetReader accepts the Latin-1 family besides UTF-8; bytes in
+// 0x80-0x9F are mapped as Latin-1, which is close enough for titles.
+func feedCharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "latin1", "windows-1252", "us-ascii":
+		raw, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		runes := make([]rune, len(raw))
+		for i, b := range raw {
+			runes[i] = rune(b)
+		}
+		return strings.NewReader(string(runes)), nil
+	}
+	return nil, fmt.Errorf("unsupported charset %q", charset)
+}
@@ -0,0 +1,541 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+const (
+	fileTriggerTick       = time.Second
+	fileTriggerMaxDirs    = 1000 // watched directories per recursive trigger
+	fileTriggerMaxChanges = 50   // changes listed in one trigger; the rest are counted
+)
+
+// FileTriggerRepository persists file-system watch triggers.
+type FileTriggerRepository interface {
+	SaveFileTrigger(ctx context.Context, t *domain.FileTrigger) error
+	GetFileTrigger(ctx context.Context, id domain.FileTriggerID) (*domain.FileTrigger, error)
+	ListFileTriggers(ctx context.Context, projectID domain.ProjectID) ([]domain.FileTrigger, error)
+	DeleteFileTrigger(ctx context.Context, id domain.FileTriggerID) error
+}
+
+// pendingChanges collects a trigger's changes until it has been quiet for
+// its debounce period.
+type pendingChanges struct {
+	changes map[string]domain.FileOp
+	last    time.Time
+}
+
+// FileTriggerService watches workspace directories and, when matching files
+// change, runs the trigger's prompt through the agent or starts a copy of
+// its workflow. Bursts of changes are debounced into one run.
+type FileTriggerService struct {
+	logger     *slog.Logger
+	repo       FileTriggerRepository
+	ws         *WorkspaceManager
+	agent      chatAgent
+	convs      conversationEnsurer
+	workflows  WorkflowRepository
+	executor   workflowStarter
+	newWatcher func() (ports.FileWatcher, error)
+	now        func() time.Time
+
+	maintenance *MaintenanceMode     // optional; triggers wait while paused
+	automations automationDispatcher // optional; receives changes of rule-backed triggers
+
+	mu       sync.Mutex
+	watcher  ports.FileWatcher // nil until Run starts
+	triggers map[domain.FileTriggerID]*domain.FileTrigger
+	watched  map[string]bool
+	pending  map[domain.FileTriggerID]*pendingChanges
+}
+
+func NewFileTriggerService(logger *slog.Logger, repo FileTriggerRepository, ws *WorkspaceManager, agent chatAgent, convs conversationEnsurer, workflows WorkflowRepository, executor workflowStarter, newWatcher func() (ports.FileWatcher, error)) *FileTriggerService {
+	return &FileTriggerService{
+		logger:     logger,
+		repo:       repo,
+		ws:         ws,
+		agent:      agent,
+		convs:      convs,
+		workflows:  workflows,
+		executor:   executor,
+		newWatcher: newWatcher,
+		now:        time.Now,
+		triggers:   make(map[domain.FileTriggerID]*domain.FileTrigger),
+		watched:    make(map[string]bool),
+		pending:    make(map[domain.FileTriggerID]*pendingChanges),
+	}
+}
+
+// SetMaintenance holds back triggers while maintenance mode is on.
+func (s *FileTriggerService) SetMaintenance(mm *MaintenanceMode) {
+	s.maintenance = mm
+}
+
+// SetAutomations routes changes seen by triggers with an AutomationID to
+// rules.
+func (s *FileTriggerService) SetAutomations(d automationDispatcher) {
+	s.automations = d
+}
+
+// workspaceRoot is the directory trigger paths are relative to: the
+// project's workspace, else the user's home directory, as for the fs tools.
+func (s *FileTriggerService) workspaceRoot(projectID domain.ProjectID) string {
+	if projectID != "" {
+		return s.ws.GetProjectPath(string(projectID))
+	}
+	root, _ := os.UserHomeDir()
+	if root == "" {
+		root = "/tmp"
+	}
+	return root
+}
+
+// resolvePath turns a workspace-relative (or ~/, or absolute inside the
+// workspace) path into an absolute directory, creating it if missing.
+func (s *FileTriggerService) resolvePath(projectID domain.ProjectID, path string) (string, error) {
+	root := s.workspaceRoot(projectID)
+	path = strings.TrimSpace(path)
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		path = strings.TrimPrefix(strings.TrimPrefix(path, "~"), "/")
+	} else if filepath.IsAbs(path) {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return "", err
+		}
+		path = rel
+	}
+	dir, err := ensurePathIsSafe(root, path)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("create watched directory: %w", err)
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", path)
+	}
+	return dir, nil
+}
+
+// Watch validates and registers a trigger, and starts watching its path if
+// the service is running.
+func (s *FileTriggerService) Watch(ctx context.Context, t *domain.FileTrigger) error {
+	if t.Prompt == "" && t.WorkflowID == "" && t.AutomationID == "" {
+		return fmt.Errorf("either prompt or workflow_id is required")
+	}
+	if t.DebounceSec == 0 {
+		t.DebounceSec = domain.FileTriggerDefaultDebounceSec
+	}
+	if t.DebounceSec < 0 || t.DebounceSec > domain.FileTriggerMaxDebounceSec {
+		return fmt.Errorf("debounce must be between 1 and %d seconds", domain.FileTriggerMaxDebounceSec)
+	}
+	for _, p := range t.Patterns {
+		if _, err := filepath.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+	}
+	for _, op := range t.Events {
+		switch op {
+		case domain.FileOpCreate, domain.FileOpWrite, domain.FileOpRemove, domain.FileOpRename:
+		default:
+			return fmt.Errorf("unknown event %q (want create, write, remove or rename)", op)
+		}
+	}
+	if t.WorkflowID != "" {
+		if _, err := s.workflows.GetWorkflow(ctx, t.WorkflowID); err != nil {
+			return fmt.Errorf("workflow %s: %w", t.WorkflowID, err)
+		}
+	}
+	dir, err := s.resolvePath(t.ProjectID, t.Path)
+	if err != nil {
+		return err
+	}
+	t.Path = dir
+	if t.Name == "" {
+		t.Name = filepath.Base(dir)
+	}
+	if t.ID == "" {
+		t.ID = domain.FileTriggerID(uuid.New().String())
+	}
+	if t.CreatedAt.IsZero() {
+		t.CreatedAt = s.now()
+	}
+	if err := s.repo.SaveFileTrigger(ctx, t); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp := *t
+	s.triggers[t.ID] = &cp
+	s.syncWatchesLocked()
+	s.logger.Info("file trigger added", "trigger_id", string(t.ID), "path", t.Path)
+	return nil
+}
+
+// List returns the triggers, or only the project's when projectID is set.
+func (s *FileTriggerService) List(ctx context.Context, projectID domain.ProjectID) ([]domain.FileTrigger, error) {
+	return s.repo.ListFileTriggers(ctx, projectID)
+}
+
+// Unwatch removes a trigger and drops its pending changes.
+func (s *FileTriggerService) Unwatch(ctx context.Context, id domain.FileTriggerID) error {
+	if err := s.repo.DeleteFileTrigger(ctx, id); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.triggers, id)
+	delete(s.pending, id)
+	s.syncWatchesLocked()
+	return nil
+}
+
+// Toggle pauses or resumes a trigger. Changes made while paused are not
+// replayed.
+func (s *FileTriggerService) Toggle(ctx context.Context, id domain.FileTriggerID) (*domain.FileTrigger, error) {
+	t, err := s.repo.GetFileTrigger(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return t, s.SetPaused(ctx, t, !t.Paused)
+}
+
+// SetPaused pauses or resumes t, saves it and updates the watched
+// directories.
+func (s *FileTriggerService) SetPaused(ctx context.Context, t *domain.FileTrigger, paused bool) error {
+	t.Paused = paused
+	if err := s.repo.SaveFileTrigger(ctx, t); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp := *t
+	s.triggers[t.ID] = &cp
+	delete(s.pending, t.ID)
+	s.syncWatchesLocked()
+	return nil
+}
+
+// Run watches the registered triggers' directories until ctx is cancelled.
+// Without a usable watcher the service logs and stays idle.
+func (s *FileTriggerService) Run(ctx context.Context) error {
+	ctx = domain.WithSubsystem(ctx, "file-triggers")
+	watcher, err := s.newWatcher()
+	if err != nil {
+		s.logger.Error("file triggers disabled: cannot create watcher", "error", err)
+		return nil
+	}
+	defer watcher.Close()
+
+	triggers, err := s.repo.ListFileTriggers(ctx, "")
+	if err != nil {
+		s.logger.Error("failed to load file triggers", "error", err)
+	}
+	s.mu.Lock()
+	s.watcher = watcher
+	for i := range triggers {
+		if _, ok := s.triggers[triggers[i].ID]; !ok {
+			s.triggers[triggers[i].ID] = &triggers[i]
+		}
+	}
+	s.syncWatchesLocked()
+	s.mu.Unlock()
+
+	ticker := time.NewTicker(fileTriggerTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.mu.Lock()
+			s.watcher = nil
+			s.mu.Unlock()
+			return nil
+		case ev, ok := <-watcher.Events():
+			if !ok {
+				s.logger.Error("file watcher stopped")
+				return nil
+			}
+			s.handleEvent(ev)
+		case <-ticker.C:
+			if s.maintenance.Paused() {
+				continue
+			}
+			s.flush(ctx)
+		}
+	}
+}
+
+// syncWatchesLocked makes the watcher's directory set match the active
+// triggers. s.mu must be held.
+func (s *FileTriggerService) syncWatchesLocked() {
+	if s.watcher == nil {
+		return
+	}
+	want := make(map[string]bool)
+	for _, t := range s.triggers {
+		if t.Paused {
+			continue
+		}
+		want[t.Path] = true
+		if t.Recursive {
+			for _, d := range subdirs(t.Path, fileTriggerMaxDirs) {
+				want[d] = true
+			}
+		}
+	}
+	for dir := range s.watched {
+		if !want[dir] {
+			_ = s.watcher.Remove(dir)
+			delete(s.watched, dir)
+		}
+	}
+	for dir := range want {
+		if s.watched[dir] {
+			continue
+		}
+		if err := s.watcher.Add(dir); err != nil {
+			s.logger.Warn("cannot watch directory", "path", dir, "error", err)
+			continue
+		}
+		s.watched[dir] = true
+	}
+}
+
+// subdirs lists the directories below root, up to max, skipping hidden ones.
+func subdirs(root string, max int) []string {
+	var out []string
+	_ = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() || path == root {
+			return nil
+		}
+		if strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+		if len(out) >= max {
+			return filepath.SkipAll
+		}
+		out = append(out, path)
+		return nil
+	})
+	return out
+}
+
+// handleEvent records a change for every active trigger it matches. A new
+// directory under a recursive trigger is watched, and the files already in
+// it are recorded as created since they may predate the watch.
+func (s *FileTriggerService) handleEvent(ev domain.FileEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ev.IsDir {
+		switch ev.Op {
+		case domain.FileOpCreate:
+			for _, t := range s.triggers {
+				if !t.Paused && t.Recursive && strings.HasPrefix(ev.Path, t.Path+string(filepath.Separator)) {
+					s.syncWatchesLocked()
+					_ = filepath.WalkDir(ev.Path, func(path string, d fs.DirEntry, err error) error {
+						if err == nil && !d.IsDir() {
+							s.recordLocked(domain.FileEvent{Path: path, Op: domain.FileOpCreate})
+						}
+						return nil
+					})
+					break
+				}
+			}
+		case domain.FileOpRemove, domain.FileOpRename:
+			for dir := range s.watched {
+				if dir == ev.Path || strings.HasPrefix(dir, ev.Path+string(filepath.Separator)) {
+					_ = s.watcher.Remove(dir)
+					delete(s.watched, dir)
+				}
+			}
+		}
+		return
+	}
+	s.recordLocked(ev)
+}
+
+func (s *FileTriggerService) recordLocked(ev domain.FileEvent) {
+	if strings.HasPrefix(filepath.Base(ev.Path), ".") {
+		return // editor swap files, partial downloads and the like
+	}
+	now := s.now()
+	for id, t := range s.triggers {
+		if t.Paused || !t.Matches(ev.Path, ev.Op) {
+			continue
+		}
+		p := s.pending[id]
+		if p == nil {
+			p = &pendingChanges{changes: make(map[string]domain.FileOp)}
+			s.pending[id] = p
+		}
+		// A file created then written within the window is still new
+		if prev, ok := p.changes[ev.Path]; !ok || prev != domain.FileOpCreate || ev.Op != domain.FileOpWrite {
+			p.changes[ev.Path] = ev.Op
+		}
+		p.last = now
+	}
+}
+
+// flush fires every trigger whose changes have been quiet for its debounce
+// period.
+func (s *FileTriggerService) flush(ctx context.Context) {
+	now := s.now()
+	type due struct {
+		trigger domain.FileTrigger
+		changes map[string]domain.FileOp
+	}
+	var ready []due
+
+	s.mu.Lock()
+	for id, p := range s.pending {
+		t := s.triggers[id]
+		if t == nil {
+			delete(s.pending, id)
+			continue
+		}
+		if now.Sub(p.last) < time.Duration(t.DebounceSec)*time.Second {
+			continue
+		}
+		delete(s.pending, id)
+		t.LastTriggered = &now
+		t.TriggerCount++
+		ready = append(ready, due{trigger: *t, changes: p.changes})
+	}
+	s.mu.Unlock()
+
+	for _, d := range ready {
+		t := d.trigger
+		s.logger.Info("file trigger fired", "trigger_id", string(t.ID), "name", t.Name, "changes", len(d.changes))
+		if err := s.repo.SaveFileTrigger(ctx, &t); err != nil {
+			s.logger.Error("failed to save file trigger", "trigger_id", string(t.ID), "error", err)
+		}
+		changes := d.changes
+		go func() {
+			if err := s.trigger(ctx, t, changes); err != nil {
+				s.logger.Error("file trigger failed", "trigger_id", string(t.ID), "error", err)
+				s.recordError(ctx, t.ID, err)
+			}
+		}()
+	}
+}
+
+func (s *FileTriggerService) recordError(ctx context.Context, id domain.FileTriggerID, runErr error) {
+	s.mu.Lock()
+	t := s.triggers[id]
+	if t == nil {
+		s.mu.Unlock()
+		return
+	}
+	t.LastError = runErr.Error()
+	cp := *t
+	s.mu.Unlock()
+	if err := s.repo.SaveFileTrigger(ctx, &cp); err != nil {
+		s.logger.Error("failed to save file trigger", "trigger_id", string(id), "error", err)
+	}
+}
+
+// trigger dispatches the changes to the trigger's automation rule, starts
+// its workflow, or runs its prompt in the trigger's conversation.
+func (s *FileTriggerService) trigger(ctx context.Context, t domain.FileTrigger, changes map[string]domain.FileOp) error {
+	ctx = domain.WithPriority(ctx, domain.PriorityBackground)
+	if t.ProjectID != "" {
+		ctx = ContextWithProject(ctx, t.ProjectID)
+	}
+	summary := s.formatFileChanges(t, changes)
+	if t.AutomationID != "" {
+		if s.automations == nil {
+			return fmt.Errorf("automations not enabled")
+		}
+		return s.automations.Dispatch(ctx, t.AutomationID, s.fileEvent(t, changes, summary))
+	}
+	if t.WorkflowID != "" {
+		tmpl, err := s.workflows.GetWorkflow(ctx, t.WorkflowID)
+		if err != nil {
+			return fmt.Errorf("load workflow: %w", err)
+		}
+		wf := newWorkflowRun(tmpl, "files: "+t.Name, map[string]any{
+			"changed_files": summary,
+			"watch_path":    t.Path,
+		}, s.now())
+		return s.executor.Start(ctx, wf)
+	}
+
+	convID := domain.ConversationID("files-" + string(t.ID))
+	if err := s.convs.EnsureConversation(ctx, convID, PlaceholderTitle("Files: "+t.Name)); err != nil {
+		return fmt.Errorf("create conversation: %w", err)
+	}
+	_, _, err := s.agent.Chat(ctx, convID, t.Prompt+"\n\n"+summary, t.PersonaID)
+	return err
+}
+
+// fileEvent describes changes to an automation rule. paths and ops are
+// parallel newline-separated lists, relative to the workspace.
+func (s *FileTriggerService) fileEvent(t domain.FileTrigger, changes map[string]domain.FileOp, summary string) domain.AutomationEvent {
+	root := s.workspaceRoot(t.ProjectID)
+	paths := make([]string, 0, len(changes))
+	for p := range changes {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	ops := make([]string, len(paths))
+	for i, p := range paths {
+		ops[i] = string(changes[p])
+		if rel, err := filepath.Rel(root, p); err == nil {
+			paths[i] = filepath.ToSlash(rel)
+		}
+	}
+	return domain.AutomationEvent{
+		Kind: domain.AutomationTriggerFile,
+		Data: map[string]string{
+			"watch_path":    t.Path,
+			"paths":         strings.Join(paths, "\n"),
+			"ops":           strings.Join(ops, "\n"),
+			"changed_files": summary,
+			"count":         strconv.Itoa(len(paths)),
+		},
+		Text: summary,
+	}
+}
+
+// formatFileChanges lists the changed files relative to the workspace root,
+// the form the file tools accept.
+func (s *FileTriggerService) formatFileChanges(t domain.FileTrigger, changes map[string]domain.FileOp) string {
+	root := s.workspaceRoot(t.ProjectID)
+	paths := make([]string, 0, len(changes))
+	for p := range changes {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Files changed in %s (paths relative to the workspace):\n", t.Path)
+	for i, p := range paths {
+		if i == fileTriggerMaxChanges {
+			fmt.Fprintf(&b, "\n... and %d more", len(paths)-i)
+			break
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			rel = p
+		}
+		fmt.Fprintf(&b, "\n- %s: %s", changes[p], filepath.ToSlash(rel))
+	}
+	return b.String()
+}
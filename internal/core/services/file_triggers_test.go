@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+type memFileTriggerRepo struct {
+	mu       sync.Mutex
+	triggers map[domain.FileTriggerID]domain.FileTrigger
+}
+
+func (r *memFileTriggerRepo) SaveFileTrigger(_ context.Context, t *domain.FileTrigger) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.triggers[t.ID] = *t
+	return nil
+}
+
+func (r *memFileTriggerRepo) GetFileTrigger(_ context.Context, id domain.FileTriggerID) (*domain.FileTrigger, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.triggers[id]
+	if !ok {
+		return nil, domain.ErrFileTriggerNotFound
+	}
+	return &t, nil
+}
+
+func (r *memFileTriggerRepo) ListFileTriggers(_ context.Context, _ domain.ProjectID) ([]domain.FileTrigger, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []domain.FileTrigger
+	for _, t := range r.triggers {
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+func (r *memFileTriggerRepo) DeleteFileTrigger(_ context.Context, id domain.FileTriggerID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.triggers[id]; !ok {
+		return domain.ErrFileTriggerNotFound
+	}
+	delete(r.triggers, id)
+	return nil
+}
+
+type fakeFileWatcher struct {
+	mu     sync.Mutex
+	dirs   map[string]bool
+	events chan domain.FileEvent
+}
+
+func (w *fakeFileWatcher) Add(dir string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.dirs[dir] = true
+	return nil
+}
+
+func (w *fakeFileWatcher) Remove(dir string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.dirs, dir)
+	return nil
+}
+
+func (w *fakeFileWatcher) Events() <-chan domain.FileEvent { return w.events }
+func (w *fakeFileWatcher) Close() error                    { return nil }
+
+func newTestFileTriggers(t *testing.T) (*FileTriggerService, *fakeFileWatcher, *recordingAgent, *memWorkflows, string) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	watcher := &fakeFileWatcher{dirs: map[string]bool{}, events: make(chan domain.FileEvent)}
+	agent := &recordingAgent{done: make(chan struct{}, 4)}
+	wfs := &memWorkflows{wfs: map[domain.WorkflowID]*domain.Workflow{}, started: make(chan *domain.Workflow, 4)}
+	s := NewFileTriggerService(slog.New(slog.NewTextHandler(io.Discard, nil)),
+		&memFileTriggerRepo{triggers: map[domain.FileTriggerID]domain.FileTrigger{}}, NewWorkspaceManager(),
+		agent, &fakeEmailConvs{ensured: map[domain.ConversationID]string{}}, wfs, wfs,
+		func() (ports.FileWatcher, error) { return watcher, nil })
+	s.watcher = watcher
+	return s, watcher, agent, wfs, home
+}
+
+func TestFileTriggerDebouncesMatchingChanges(t *testing.T) {
+	s, watcher, agent, _, home := newTestFileTriggers(t)
+	ctx := context.Background()
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	assert.Error(t, s.Watch(ctx, &domain.FileTrigger{Path: "../outside", Prompt: "x"}))
+	assert.Error(t, s.Watch(ctx, &domain.FileTrigger{Path: "inbox"}), "prompt or workflow required")
+	assert.Error(t, s.Watch(ctx, &domain.FileTrigger{Path: "inbox", Prompt: "x", Events: []domain.FileOp{"chmod"}}))
+
+	ft := &domain.FileTrigger{Path: "~/inbox", Patterns: []string{"*.pdf"}, Prompt: "Summarize each new PDF"}
+	require.NoError(t, s.Watch(ctx, ft))
+	inbox := filepath.Join(home, "inbox")
+	assert.Equal(t, inbox, ft.Path)
+	assert.Equal(t, "inbox", ft.Name)
+	assert.True(t, watcher.dirs[inbox])
+
+	s.handleEvent(domain.FileEvent{Path: filepath.Join(inbox, "a.pdf"), Op: domain.FileOpCreate})
+	s.handleEvent(domain.FileEvent{Path: filepath.Join(inbox, "notes.txt"), Op: domain.FileOpCreate})
+	s.handleEvent(domain.FileEvent{Path: filepath.Join(inbox, ".b.pdf"), Op: domain.FileOpCreate})
+	s.handleEvent(domain.FileEvent{Path: filepath.Join(inbox, "deep", "c.pdf"), Op: domain.FileOpCreate})
+	now = now.Add(3 * time.Second)
+	s.handleEvent(domain.FileEvent{Path: filepath.Join(inbox, "a.pdf"), Op: domain.FileOpWrite})
+	s.handleEvent(domain.FileEvent{Path: filepath.Join(inbox, "old.pdf"), Op: domain.FileOpRemove})
+
+	now = now.Add(4 * time.Second)
+	s.flush(ctx)
+	assert.Empty(t, agent.done, "still within the debounce window")
+
+	now = now.Add(2 * time.Second)
+	s.flush(ctx)
+	select {
+	case <-agent.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("prompt was not triggered")
+	}
+	agent.mu.Lock()
+	defer agent.mu.Unlock()
+	require.Len(t, agent.messages, 1)
+	msg := agent.messages[0]
+	assert.Contains(t, msg, "Summarize each new PDF")
+	assert.Contains(t, msg, "create: inbox/a.pdf", "created then written is still new")
+	assert.NotContains(t, msg, "notes.txt")
+	assert.NotContains(t, msg, ".b.pdf")
+	assert.NotContains(t, msg, "c.pdf", "not recursive")
+	assert.NotContains(t, msg, "old.pdf", "removals not selected")
+	assert.Equal(t, domain.ConversationID("files-"+string(ft.ID)), agent.convs[0])
+	assert.Equal(t, 1, s.triggers[ft.ID].TriggerCount)
+}
+
+func TestFileTriggerStartsWorkflowForSubdirectories(t *testing.T) {
+	s, watcher, _, wfs, home := newTestFileTriggers(t)
+	ctx := context.Background()
+	wfs.wfs["wf-ingest"] = &domain.Workflow{ID: "wf-ingest", Name: "Ingest",
+		Steps: []domain.WorkflowStep{{ID: "read", Prompt: "Read {{state.changed_files}}", Status: domain.StepStatusDone}}}
+
+	ft := &domain.FileTrigger{Path: "drop", Recursive: true, Patterns: []string{"2026/*.csv"}, WorkflowID: "wf-ingest", DebounceSec: 1}
+	require.NoError(t, s.Watch(ctx, ft))
+	sub := filepath.Join(home, "drop", "2026")
+	s.handleEvent(domain.FileEvent{Path: filepath.Join(sub, "q1.csv"), Op: domain.FileOpCreate})
+	s.handleEvent(domain.FileEvent{Path: filepath.Join(home, "drop", "q2.csv"), Op: domain.FileOpCreate})
+	s.now = func() time.Time { return time.Now().Add(2 * time.Second) }
+	s.flush(ctx)
+
+	var wf *domain.Workflow
+	select {
+	case wf = <-wfs.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("workflow was not started")
+	}
+	assert.NotEqual(t, domain.WorkflowID("wf-ingest"), wf.ID)
+	assert.Equal(t, domain.StepStatusPending, wf.Steps[0].Status)
+	assert.Contains(t, wf.State["changed_files"], "drop/2026/q1.csv")
+	assert.NotContains(t, wf.State["changed_files"], "q2.csv")
+
+	paused, err := s.Toggle(ctx, ft.ID)
+	require.NoError(t, err)
+	assert.True(t, paused.Paused)
+	assert.False(t, watcher.dirs[ft.Path], "paused triggers are not watched")
+	require.NoError(t, s.Unwatch(ctx, ft.ID))
+	assert.Empty(t, s.triggers)
+}
+
+func TestFileTriggerMatches(t *testing.T) {
+	ft := domain.FileTrigger{Path: "/w/inbox", Patterns: []string{"*.pdf", "docs/*.md"}, Recursive: true}
+	assert.True(t, ft.Matches("/w/inbox/a.pdf", domain.FileOpCreate))
+	assert.True(t, ft.Matches("/w/inbox/x/a.pdf", domain.FileOpWrite))
+	assert.True(t, ft.Matches("/w/inbox/docs/readme.md", domain.FileOpCreate))
+	assert.False(t, ft.Matches("/w/inbox/other/readme.md", domain.FileOpCreate))
+	assert.False(t, ft.Matches("/w/inbox/a.pdf", domain.FileOpRemove))
+	assert.False(t, ft.Matches("/w/inboxed/a.pdf", domain.FileOpCreate))
+	assert.False(t, ft.Matches("/w/a.pdf", domain.FileOpCreate))
+
+	ft.Recursive = false
+	assert.False(t, ft.Matches("/w/inbox/x/a.pdf", domain.FileOpCreate))
+	ft.Events = []domain.FileOp{domain.FileOpRemove}
+	assert.True(t, ft.Matches("/w/inbox/a.pdf", domain.FileOpRemove))
+}
@@ -104,3 +104,32 @@ func NewListForgedToolsTool(forge *synapse.Forge) *domain.Tool {
 		},
 	}
 }
+
+// NewDeleteForgedToolTool returns a tool that removes a tool created by the Forge.
+func NewDeleteForgedToolTool(forge *synapse.Forge) *domain.Tool {
+	return &domain.Tool{
+		Name:        "delete_forged_tool",
+		Description: "Deletes a custom tool created by the Tool Forge. It stops being available immediately. Built-in tools cannot be deleted.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the forged tool (see list_forged_tools)",
+				},
+			},
+			Required: []string{"name"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			name, _ := params["name"].(string)
+			if name == "" {
+				return nil, fmt.Errorf("missing required parameter: name")
+			}
+			if err := forge.Remove(ctx, name); err != nil {
+				return nil, err
+			}
+			return fmt.Sprintf("Tool '%s' deleted.", name), nil
+		},
+	}
+}
@@ -21,7 +21,11 @@ type HeartbeatService struct {
 	ws       *WorkspaceManager
 	agent    *ReActAgentService
 	repo     heartbeatProjectLister
-	interval time.Duration // default 30 minutes
+	interval time.Duration // default 30 minutes; projects may override via settings
+
+	lastRun map[domain.ProjectID]time.Time // last heartbeat per project (owned by Run loop)
+
+	maintenance *MaintenanceMode // optional; heartbeats are skipped while paused
 }
 
 // heartbeatProjectLister is the minimal interface to get active projects
@@ -39,13 +43,26 @@ func NewHeartbeatService(logger *slog.Logger, ws *WorkspaceManager, agent *ReAct
 		agent:    agent,
 		repo:     repo,
 		interval: interval,
+		lastRun:  make(map[domain.ProjectID]time.Time),
 	}
 }
 
+// SetMaintenance makes the service skip heartbeats while maintenance mode is on.
+func (h *HeartbeatService) SetMaintenance(m *MaintenanceMode) {
+	h.maintenance = m
+}
+
 // Run starts the heartbeat loop. Blocks until ctx is cancelled.
+// The loop ticks at most once a minute so per-project intervals are honoured.
 func (h *HeartbeatService) Run(ctx context.Context) error {
 	h.logger.Info("heartbeat service started", "interval", h.interval)
-	ticker := time.NewTicker(h.interval)
+	ctx = domain.WithSubsystem(ctx, "heartbeat")
+	started := time.Now()
+	tick := time.Minute
+	if h.interval < tick {
+		tick = h.interval
+	}
+	ticker := time.NewTicker(tick)
 	defer ticker.Stop()
 
 	for {
@@ -53,13 +70,16 @@ func (h *HeartbeatService) Run(ctx context.Context) error {
 		case <-ctx.Done():
 			h.logger.Info("heartbeat service stopped")
 			return nil
-		case <-ticker.C:
-			h.checkAllProjects(ctx)
+		case now := <-ticker.C:
+			if h.maintenance.Paused() {
+				continue
+			}
+			h.checkAllProjects(ctx, started, now)
 		}
 	}
 }
 
-func (h *HeartbeatService) checkAllProjects(ctx context.Context) {
+func (h *HeartbeatService) checkAllProjects(ctx context.Context, started, now time.Time) {
 	projects, err := h.repo.ListProjects(ctx)
 	if err != nil {
 		h.logger.Error("heartbeat: failed to list projects", "error", err)
@@ -67,6 +87,14 @@ func (h *HeartbeatService) checkAllProjects(ctx context.Context) {
 	}
 
 	for _, proj := range projects {
+		last, ok := h.lastRun[proj.ID]
+		if !ok {
+			last = started
+		}
+		if now.Sub(last) < proj.Settings.HeartbeatEvery(h.interval) {
+			continue
+		}
+		h.lastRun[proj.ID] = now
 		h.processProject(ctx, proj)
 	}
 }
@@ -113,7 +141,7 @@ func (h *HeartbeatService) processProject(ctx context.Context, proj domain.Proje
 		prompt := fmt.Sprintf("Heartbeat task for project '%s': %s", proj.Name, task)
 
 		go func(t string) {
-			resp, _, err := h.agent.Chat(ctx, convID, prompt, nil)
+			resp, _, err := h.agent.Chat(domain.WithPriority(ctx, domain.PriorityBackground), convID, prompt, nil)
 			if err != nil {
 				h.logger.Error("heartbeat task failed", "project", proj.Name, "task", t, "error", err)
 				return
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// maxIDEEditBytes caps the code sent to the model in one edit request.
+const maxIDEEditBytes = 256 << 10
+
+var codeFenceRe = regexp.MustCompile("(?s)```[^\n]*\n(.*?)```")
+
+// IDECompanion serves editor plugins: it asks the code model to rewrite a
+// file or snippet and returns the change as a unified diff, optionally
+// applying it to the project workspace the way the apply_patch tool does.
+type IDECompanion struct {
+	logger *slog.Logger
+	router *ModelRouter
+	ws     *WorkspaceManager
+}
+
+func NewIDECompanion(logger *slog.Logger, router *ModelRouter, ws *WorkspaceManager) *IDECompanion {
+	return &IDECompanion{logger: logger, router: router, ws: ws}
+}
+
+// Edit proposes the change req.Instruction asks for.
+func (c *IDECompanion) Edit(ctx context.Context, req domain.IDEEditRequest) (domain.IDEEditResult, error) {
+	if strings.TrimSpace(req.Path) == "" || strings.TrimSpace(req.Instruction) == "" {
+		return domain.IDEEditResult{}, fmt.Errorf("%w: path and instruction are required", domain.ErrInvalidIDEEdit)
+	}
+	if req.StartLine < 1 {
+		req.StartLine = 1
+	}
+	projectID := ""
+	if req.ProjectID != nil {
+		projectID = string(*req.ProjectID)
+		ctx = ContextWithProject(ctx, *req.ProjectID)
+	}
+
+	original := req.Content
+	if original == "" {
+		safePath, err := resolveReadPath(ctx, c.ws, projectID, req.Path)
+		if err != nil {
+			return domain.IDEEditResult{}, fmt.Errorf("%w: %v", domain.ErrInvalidIDEEdit, err)
+		}
+		data, err := os.ReadFile(safePath)
+		if err != nil {
+			return domain.IDEEditResult{}, fmt.Errorf("%w: read %s: %v", domain.ErrInvalidIDEEdit, req.Path, err)
+		}
+		original = string(data)
+	}
+	if len(original) > maxIDEEditBytes {
+		return domain.IDEEditResult{}, fmt.Errorf("%w: code is larger than %d bytes, send a selection", domain.ErrInvalidIDEEdit, maxIDEEditBytes)
+	}
+
+	modelID := req.Model
+	if modelID == "" {
+		modelID = c.router.ResolveModel(nil, domain.ModelRoleCode)
+	}
+	reply, err := c.router.GenerateText(domain.WithPriority(ctx, domain.PriorityInteractive), ideEditPrompt(req, original), modelID)
+	if err != nil {
+		return domain.IDEEditResult{}, fmt.Errorf("generate edit: %w", err)
+	}
+	updated, explanation, err := parseIDEEditReply(reply, original)
+	if err != nil {
+		return domain.IDEEditResult{}, err
+	}
+
+	res := domain.IDEEditResult{
+		Path:        req.Path,
+		Patch:       UnifiedDiff(req.Path, original, updated, req.StartLine),
+		Explanation: explanation,
+		Model:       modelID,
+	}
+	if req.Apply && res.Patch != "" {
+		if _, err := patchFile(ctx, c.ws, projectID, req.Path, res.Patch); err != nil {
+			return res, fmt.Errorf("%w: %v", domain.ErrInvalidIDEEdit, err)
+		}
+		res.Applied = true
+		c.logger.InfoContext(ctx, "ide edit applied", "path", req.Path, "project_id", projectID)
+	}
+	return res, nil
+}
+
+func ideEditPrompt(req domain.IDEEditRequest, code string) string {
+	return "You are a code editing assistant inside an IDE. Apply the instruction to the code below.\n" +
+		"Reply with the complete updated code in one fenced code block, keeping every unchanged line, " +
+		"then a line starting with \"Explanation:\" and one or two sentences on what you changed.\n\n" +
+		"File: " + req.Path + "\n" +
+		"Instruction: " + req.Instruction + "\n\n" +
+		"```" + req.Language + "\n" + strings.TrimRight(code, "\n") + "\n```\n"
+}
+
+// parseIDEEditReply extracts the rewritten code and the explanation. The
+// code keeps the original's trailing newline, or lack of one.
+func parseIDEEditReply(reply, original string) (code, explanation string, err error) {
+	loc := codeFenceRe.FindStringSubmatchIndex(reply)
+	if loc == nil {
+		return "", "", domain.ErrIDENoCode
+	}
+	code = strings.TrimRight(reply[loc[2]:loc[3]], "\n")
+	if strings.HasSuffix(original, "\n") {
+		code += "\n"
+	}
+
+	explanation = strings.TrimSpace(reply[loc[1]:])
+	if explanation == "" {
+		explanation = strings.TrimSpace(reply[:loc[0]])
+	}
+	if i := strings.Index(strings.ToLower(explanation), "explanation:"); i >= 0 {
+		explanation = strings.TrimSpace(explanation[i+len("explanation:"):])
+	}
+	return code, explanation, nil
+}
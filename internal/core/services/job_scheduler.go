@@ -3,12 +3,20 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
-	"golang.org/x/sync/semaphore"
 )
 
+// ErrSchedulerDraining is returned for jobs submitted after shutdown began.
+var ErrSchedulerDraining = errors.New("scheduler is draining for shutdown")
+
 // SchedulerConfig defines concurrency limits
 type SchedulerConfig struct {
 	MaxConcurrentJobs int64
@@ -17,13 +25,50 @@ type SchedulerConfig struct {
 }
 
 type JobScheduler struct {
-	logger        *slog.Logger
-	pendingQueue  chan domain.Job
-	semaphore     *semaphore.Weighted
-	
+	logger *slog.Logger
+	slots  *slotLimiter
+
 	// Real implementation would track resource usage more granularly
 	// For now, we use a simple weighted semaphore based on "1 job = 1 unit"
 	// or we can map CPU/Mem to weight. Let's keep it simple for M2: Global Concurrency.
+
+	// Jobs with depends_on wait here until every dependency has completed
+	heldMu    sync.Mutex
+	held      map[domain.JobID]domain.Job
+	depStatus func(context.Context, domain.JobID) (domain.JobStatus, error)
+	onDepFail func(context.Context, domain.Job, error)
+
+	// Shutdown drain: running jobs are tracked so Drain can wait for them
+	stateMu      sync.Mutex
+	draining     bool
+	drainCh      chan struct{} // closed by Drain; stops the consumer loop
+	consumerDone chan struct{} // closed when the consumer loop exits
+	running      map[domain.JobID]domain.Job
+	inflight     sync.WaitGroup
+
+	// Jobs waiting for a slot, oldest first. They start in project share
+	// order (see pickLocked); wake is signalled whenever that may change.
+	queued    []domain.Job
+	wake      chan struct{}
+	shares    map[string]domain.ProjectShare
+	startedAt map[domain.JobID]time.Time
+	durations map[string][]time.Duration // last jobDurationHistory runs per capability
+	onQueue   func(context.Context, map[domain.JobID]domain.JobQueueInfo)
+
+	maintenance *MaintenanceMode // optional; no jobs start while paused
+}
+
+// maxQueuedJobs is how many jobs may wait for a slot at once.
+const maxQueuedJobs = 100
+
+// jobDurationHistory is how many recent run times per capability the ETA
+// of queued jobs is averaged over.
+const jobDurationHistory = 20
+
+// DrainReport lists the jobs that did not finish within the drain grace period.
+type DrainReport struct {
+	Unstarted []domain.Job // queued or held, never started
+	Running   []domain.Job // still executing when the grace period ran out
 }
 
 func NewJobScheduler(logger *slog.Logger, cfg SchedulerConfig) *JobScheduler {
@@ -34,48 +79,553 @@ func NewJobScheduler(logger *slog.Logger, cfg SchedulerConfig) *JobScheduler {
 	}
 
 	return &JobScheduler{
-		logger:       logger,
-		pendingQueue: make(chan domain.Job, 100), // Buffer
-		semaphore:    semaphore.NewWeighted(limit),
+		logger:    logger,
+		slots:     newSlotLimiter(limit),
+		held:      make(map[domain.JobID]domain.Job),
+		running:   make(map[domain.JobID]domain.Job),
+		wake:      make(chan struct{}, 1),
+		startedAt: make(map[domain.JobID]time.Time),
+		durations: make(map[string][]time.Duration),
+		drainCh:   make(chan struct{}),
+	}
+}
+
+// SetDependencyHooks enables job chaining. status reports a dependency's
+// current state; onFail is called for a held job whose dependency failed,
+// was cancelled or no longer exists.
+func (s *JobScheduler) SetDependencyHooks(status func(context.Context, domain.JobID) (domain.JobStatus, error), onFail func(context.Context, domain.Job, error)) {
+	s.heldMu.Lock()
+	defer s.heldMu.Unlock()
+	s.depStatus = status
+	s.onDepFail = onFail
+}
+
+// SetMaxConcurrency changes how many jobs may run at once. Lowering it lets
+// running jobs finish; new ones start once usage drops below the limit.
+func (s *JobScheduler) SetMaxConcurrency(n int64) {
+	if n <= 0 {
+		n = 10
+	}
+	if n == s.slots.Limit() {
+		return
+	}
+	s.slots.SetLimit(n)
+	s.logger.Info("scheduler concurrency changed", "max_concurrent_jobs", n)
+}
+
+// MaxConcurrency returns the current concurrency limit.
+func (s *JobScheduler) MaxConcurrency() int64 {
+	return s.slots.Limit()
+}
+
+// SetProjectShares sets the per-project weights and caps, by project ID.
+// Jobs already running are not affected.
+func (s *JobScheduler) SetProjectShares(shares map[string]domain.ProjectShare) {
+	s.stateMu.Lock()
+	s.shares = shares
+	s.stateMu.Unlock()
+	s.signal()
+}
+
+// SetMaintenance makes the scheduler hold queued jobs while maintenance mode is on.
+func (s *JobScheduler) SetMaintenance(m *MaintenanceMode) {
+	s.maintenance = m
+}
+
+// SetQueueListener is called with the new queue position of every queued
+// job whose position or ETA changed: a job on being queued, the jobs behind
+// it when one starts.
+func (s *JobScheduler) SetQueueListener(fn func(context.Context, map[domain.JobID]domain.JobQueueInfo)) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	s.onQueue = fn
+}
+
+// HeldJobs returns the IDs of jobs still waiting on dependencies.
+func (s *JobScheduler) HeldJobs() []domain.JobID {
+	s.heldMu.Lock()
+	defer s.heldMu.Unlock()
+	ids := make([]domain.JobID, 0, len(s.held))
+	for id := range s.held {
+		ids = append(ids, id)
 	}
+	return ids
 }
 
 // SubmitJob adds a job to the scheduling queue
 func (s *JobScheduler) SubmitJob(ctx context.Context, job domain.Job) error {
-	select {
-	case s.pendingQueue <- job:
-		s.logger.Info("job submitted", "job_id", job.ID)
+	if s.Draining() {
+		return ErrSchedulerDraining
+	}
+	s.heldMu.Lock()
+	if len(job.DependsOn) > 0 && s.depStatus != nil {
+		s.held[job.ID] = job
+		s.heldMu.Unlock()
+		s.logger.Info("job held for dependencies", "job_id", job.ID, "depends_on", job.DependsOn)
 		return nil
-	default:
+	}
+	s.heldMu.Unlock()
+	return s.enqueue(ctx, job)
+}
+
+func (s *JobScheduler) enqueue(ctx context.Context, job domain.Job) error {
+	s.stateMu.Lock()
+	if len(s.queued) >= maxQueuedJobs {
+		s.stateMu.Unlock()
 		return errors.New("scheduling queue full")
 	}
+	s.queued = append(s.queued, job)
+	s.stateMu.Unlock()
+	s.signal()
+	s.logger.Info("job submitted", "job_id", job.ID)
+	s.notifyQueue(ctx, job.ID)
+	return nil
+}
+
+// signal wakes the consumer to look at the queue again.
+func (s *JobScheduler) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
 }
 
 // StartWorkerPool consumes jobs and executes them using the provided handler
 // handler is a function that spawns the worker and waits for it
 func (s *JobScheduler) Start(ctx context.Context, handler func(context.Context, domain.Job)) {
 	s.logger.Info("starting job scheduler")
-	
+
+	go s.releaseLoop(ctx)
+
 	// We use a long-running goroutine to consume the queue
+	s.stateMu.Lock()
+	s.consumerDone = make(chan struct{})
+	s.stateMu.Unlock()
+	// Draining must also wake a consumer that is waiting for a free slot
+	loopCtx, stopLoop := context.WithCancel(ctx)
 	go func() {
+		select {
+		case <-s.drainCh:
+			stopLoop()
+		case <-loopCtx.Done():
+		}
+	}()
+	go func() {
+		defer close(s.consumerDone)
+		defer stopLoop()
 		for {
-			select {
-			case <-ctx.Done():
+			// Acquire a slot before dequeuing so waiting jobs stay in the
+			// queue, where Drain can find them
+			if err := s.slots.Acquire(loopCtx); err != nil {
 				s.logger.Info("stopping scheduler")
 				return
-			case job := <-s.pendingQueue:
-				// Acquire semaphore
-				if err := s.semaphore.Acquire(ctx, 1); err != nil {
-					s.logger.Error("failed to acquire semaphore", "error", err)
-					return
-				}
+			}
+			if err := s.maintenance.Wait(loopCtx); err != nil {
+				s.slots.Release()
+				s.logger.Info("stopping scheduler")
+				return
+			}
+			job, ok := s.next(loopCtx)
+			if !ok {
+				s.slots.Release()
+				s.logger.Info("stopping scheduler")
+				return
+			}
+			// Everyone behind it moved up
+			s.notifyQueue(ctx, "")
+
+			// Launch job in background so we don't block the consumer loop
+			go func(j domain.Job) {
+				defer func() {
+					s.stateMu.Lock()
+					s.recordDurationLocked(j, time.Since(s.startedAt[j.ID]))
+					delete(s.running, j.ID)
+					delete(s.startedAt, j.ID)
+					s.stateMu.Unlock()
+					s.inflight.Done()
+					s.slots.Release()
+					// A capped project may have room again
+					s.signal()
+				}()
+				handler(ctx, j)
+			}(job)
+		}
+	}()
+}
 
-				// Launch job in background so we don't block the consumer loop
-				go func(j domain.Job) {
-					defer s.semaphore.Release(1)
-					handler(ctx, j)
-				}(job)
+// next waits for a queued job that may start and marks it running; false
+// once ctx is done.
+func (s *JobScheduler) next(ctx context.Context) (domain.Job, bool) {
+	for {
+		s.stateMu.Lock()
+		if i := s.pickLocked(s.queued, s.runningByProjectLocked()); i >= 0 {
+			job := s.queued[i]
+			s.queued = append(s.queued[:i], s.queued[i+1:]...)
+			s.running[job.ID] = job
+			s.startedAt[job.ID] = time.Now()
+			s.inflight.Add(1)
+			s.stateMu.Unlock()
+			return job, true
+		}
+		s.stateMu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return domain.Job{}, false
+		case <-s.wake:
+		}
+	}
+}
+
+// pickLocked returns the index in queue of the job to start next: the
+// oldest job of the project with the fewest running jobs for its weight,
+// skipping projects at their max_jobs cap. -1 when none may start.
+func (s *JobScheduler) pickLocked(queue []domain.Job, running map[string]int) int {
+	best, bestLoad := -1, 0.0
+	for i, job := range queue {
+		project := job.Metadata["project_id"]
+		share := s.shares[project]
+		if share.MaxJobs > 0 && running[project] >= share.MaxJobs {
+			continue
+		}
+		load := float64(running[project]) / float64(max(share.Weight, 1))
+		if best < 0 || load < bestLoad {
+			best, bestLoad = i, load
+		}
+	}
+	return best
+}
+
+func (s *JobScheduler) runningByProjectLocked() map[string]int {
+	counts := make(map[string]int, len(s.running))
+	for _, job := range s.running {
+		counts[job.Metadata["project_id"]]++
+	}
+	return counts
+}
+
+// releaseLoop periodically re-checks held jobs and queues those whose
+// dependencies have all completed.
+func (s *JobScheduler) releaseLoop(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !s.Draining() {
+				s.releaseReady(ctx)
 			}
 		}
+	}
+}
+
+func (s *JobScheduler) releaseReady(ctx context.Context) {
+	s.heldMu.Lock()
+	status, onFail := s.depStatus, s.onDepFail
+	jobs := make([]domain.Job, 0, len(s.held))
+	for _, j := range s.held {
+		jobs = append(jobs, j)
+	}
+	s.heldMu.Unlock()
+	if status == nil {
+		return
+	}
+
+	for _, job := range jobs {
+		ready, err := s.dependenciesDone(ctx, job, status)
+		if err == nil && !ready {
+			continue
+		}
+
+		s.heldMu.Lock()
+		delete(s.held, job.ID)
+		s.heldMu.Unlock()
+
+		if err != nil {
+			if onFail != nil {
+				onFail(ctx, job, err)
+			}
+			continue
+		}
+		if err := s.enqueue(ctx, job); err != nil {
+			// Queue full: hold it again and retry on the next tick
+			s.heldMu.Lock()
+			s.held[job.ID] = job
+			s.heldMu.Unlock()
+			continue
+		}
+		s.logger.Info("job dependencies satisfied", "job_id", job.ID)
+	}
+}
+
+// dependenciesDone reports whether every dependency completed. A dependency
+// that failed, was cancelled or is missing returns ErrDependencyFailed.
+func (s *JobScheduler) dependenciesDone(ctx context.Context, job domain.Job, status func(context.Context, domain.JobID) (domain.JobStatus, error)) (bool, error) {
+	for _, dep := range job.DependsOn {
+		st, err := status(ctx, dep)
+		if err != nil {
+			if errors.Is(err, domain.ErrJobNotFound) {
+				return false, fmt.Errorf("%w: %s not found", domain.ErrDependencyFailed, dep)
+			}
+			s.logger.Warn("dependency status lookup failed", "job_id", job.ID, "depends_on", dep, "error", err)
+			return false, nil
+		}
+		switch st {
+		case domain.JobStatusCompleted:
+		case domain.JobStatusFailed, domain.JobStatusCancelled:
+			return false, fmt.Errorf("%w: %s is %s", domain.ErrDependencyFailed, dep, st)
+		default:
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Draining reports whether Drain has been called.
+func (s *JobScheduler) Draining() bool {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	return s.draining
+}
+
+// Drain stops the scheduler from accepting or starting jobs and waits until
+// running jobs finish or ctx expires. The report lists everything left over.
+func (s *JobScheduler) Drain(ctx context.Context) DrainReport {
+	s.stateMu.Lock()
+	if !s.draining {
+		s.draining = true
+		close(s.drainCh)
+	}
+	consumerDone := s.consumerDone
+	s.stateMu.Unlock()
+	if consumerDone != nil {
+		<-consumerDone
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		close(done)
 	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+
+	var report DrainReport
+	s.stateMu.Lock()
+	report.Unstarted = append(report.Unstarted, s.queued...)
+	s.queued = nil
+	for _, j := range s.running {
+		report.Running = append(report.Running, j)
+	}
+	s.stateMu.Unlock()
+
+	s.heldMu.Lock()
+	for id, j := range s.held {
+		report.Unstarted = append(report.Unstarted, j)
+		delete(s.held, id)
+	}
+	s.heldMu.Unlock()
+
+	return report
+}
+
+// QueueInfo returns the queue position of a job waiting for a slot; false
+// when it is not queued (held, running or done).
+func (s *JobScheduler) QueueInfo(id domain.JobID) (domain.JobQueueInfo, bool) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	info, ok := s.queueInfosLocked()[id]
+	return info, ok
+}
+
+// notifyQueue reports queue positions to the listener: only job's when set,
+// otherwise every queued job's.
+func (s *JobScheduler) notifyQueue(ctx context.Context, job domain.JobID) {
+	s.stateMu.Lock()
+	fn := s.onQueue
+	if fn == nil || len(s.queued) == 0 {
+		s.stateMu.Unlock()
+		return
+	}
+	infos := s.queueInfosLocked()
+	s.stateMu.Unlock()
+	if job != "" {
+		info, ok := infos[job]
+		if !ok {
+			return
+		}
+		infos = map[domain.JobID]domain.JobQueueInfo{job: info}
+	}
+	fn(ctx, infos)
+}
+
+func (s *JobScheduler) recordDurationLocked(job domain.Job, d time.Duration) {
+	key := jobCapability(job)
+	hist := append(s.durations[key], d)
+	if len(hist) > jobDurationHistory {
+		hist = hist[len(hist)-jobDurationHistory:]
+	}
+	s.durations[key] = hist
+}
+
+// queueInfosLocked places every queued job by replaying the scheduler:
+// running jobs end when recent jobs of their capability took (or jobs of
+// any capability, without history), and each freed slot goes to the job
+// pickLocked would choose. Positions follow that start order.
+func (s *JobScheduler) queueInfosLocked() map[domain.JobID]domain.JobQueueInfo {
+	infos := make(map[domain.JobID]domain.JobQueueInfo, len(s.queued))
+	if len(s.queued) == 0 {
+		return infos
+	}
+	limit := s.slots.Limit()
+
+	var all []time.Duration
+	for _, hist := range s.durations {
+		all = append(all, hist...)
+	}
+	overall, known := averageDuration(all)
+	expected := func(job domain.Job) time.Duration {
+		if d, ok := averageDuration(s.durations[jobCapability(job)]); ok {
+			return d
+		}
+		return overall
+	}
+
+	type simJob struct {
+		project string
+		end     time.Duration
+	}
+	// Oldest first, so jobs expected to end together free slots in a
+	// stable order
+	now := time.Now()
+	ids := slices.Collect(maps.Keys(s.running))
+	slices.SortFunc(ids, func(a, b domain.JobID) int { return s.startedAt[a].Compare(s.startedAt[b]) })
+	active := make([]simJob, 0, len(ids))
+	for _, id := range ids {
+		job := s.running[id]
+		active = append(active, simJob{job.Metadata["project_id"], max(expected(job)-now.Sub(s.startedAt[id]), 0)})
+	}
+	counts := s.runningByProjectLocked()
+	pending := slices.Clone(s.queued)
+	var clock time.Duration
+
+	for position := 1; len(pending) > 0; {
+		if int64(len(active)) < limit {
+			if i := s.pickLocked(pending, counts); i >= 0 {
+				job := pending[i]
+				pending = slices.Delete(pending, i, i+1)
+				info := domain.JobQueueInfo{
+					Position: position,
+					Length:   len(s.queued),
+					Running:  len(s.running),
+					Slots:    limit,
+				}
+				if known {
+					eta := int(clock.Round(time.Second) / time.Second)
+					info.ETASeconds = &eta
+				}
+				infos[job.ID] = info
+				position++
+				project := job.Metadata["project_id"]
+				active = append(active, simJob{project, clock + expected(job)})
+				counts[project]++
+				continue
+			}
+		}
+		if len(active) == 0 {
+			break // cannot happen: a project with nothing running is never capped
+		}
+		// Advance to the next job ending
+		first := 0
+		for k := range active {
+			if active[k].end < active[first].end {
+				first = k
+			}
+		}
+		clock = max(clock, active[first].end)
+		counts[active[first].project]--
+		active = slices.Delete(active, first, first+1)
+	}
+	return infos
+}
+
+// jobCapability groups jobs for run time estimates: capability jobs by
+// capability, everything else as a container job.
+func jobCapability(job domain.Job) string {
+	if c := strings.TrimSpace(job.Metadata["capability"]); c != "" {
+		return c
+	}
+	return "container"
+}
+
+func averageDuration(ds []time.Duration) (time.Duration, bool) {
+	if len(ds) == 0 {
+		return 0, false
+	}
+	var sum time.Duration
+	for _, d := range ds {
+		sum += d
+	}
+	return sum / time.Duration(len(ds)), true
+}
+
+// slotLimiter is a counting semaphore whose limit can change at runtime.
+type slotLimiter struct {
+	mu      sync.Mutex
+	limit   int64
+	used    int64
+	changed chan struct{} // closed and replaced whenever a slot may have freed up
+}
+
+func newSlotLimiter(limit int64) *slotLimiter {
+	return &slotLimiter{limit: limit, changed: make(chan struct{})}
+}
+
+// Acquire blocks until a slot is free or ctx is done.
+func (l *slotLimiter) Acquire(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if l.used < l.limit {
+			l.used++
+			l.mu.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (l *slotLimiter) Release() {
+	l.mu.Lock()
+	l.used--
+	l.notifyLocked()
+	l.mu.Unlock()
+}
+
+func (l *slotLimiter) SetLimit(n int64) {
+	l.mu.Lock()
+	l.limit = n
+	l.notifyLocked()
+	l.mu.Unlock()
+}
+
+func (l *slotLimiter) Limit() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
+
+func (l *slotLimiter) notifyLocked() {
+	close(l.changed)
+	l.changed = make(chan struct{})
 }
@@ -74,3 +74,250 @@ func TestJobScheduler_ConcurrencyLimit(t *testing.T) {
 	assert.LessOrEqual(t, peak, int32(2), "Should not exceed max concurrency")
 	assert.Greater(t, peak, int32(0), "Should havrun some jobs")
 }
+
+func TestJobScheduler_HoldsUntilDependenciesComplete(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	scheduler := NewJobScheduler(logger, SchedulerConfig{MaxConcurrentJobs: 2})
+
+	var mu sync.Mutex
+	statuses := map[domain.JobID]domain.JobStatus{"a": domain.JobStatusRunning, "b": domain.JobStatusRunning}
+	var failed []domain.JobID
+	scheduler.SetDependencyHooks(
+		func(_ context.Context, id domain.JobID) (domain.JobStatus, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			st, ok := statuses[id]
+			if !ok {
+				return "", domain.ErrJobNotFound
+			}
+			return st, nil
+		},
+		func(_ context.Context, job domain.Job, err error) {
+			assert.ErrorIs(t, err, domain.ErrDependencyFailed)
+			mu.Lock()
+			failed = append(failed, job.ID)
+			mu.Unlock()
+		},
+	)
+
+	ran := make(chan domain.JobID, 4)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduler.Start(ctx, func(_ context.Context, job domain.Job) { ran <- job.ID })
+
+	assert.NoError(t, scheduler.SubmitJob(ctx, domain.Job{ID: "child", DependsOn: []domain.JobID{"a", "b"}}))
+	assert.NoError(t, scheduler.SubmitJob(ctx, domain.Job{ID: "orphan", DependsOn: []domain.JobID{"missing"}}))
+
+	scheduler.releaseReady(ctx)
+	assert.ElementsMatch(t, []domain.JobID{"child"}, scheduler.HeldJobs())
+	assert.Equal(t, []domain.JobID{"orphan"}, failed)
+
+	mu.Lock()
+	statuses["a"] = domain.JobStatusCompleted
+	mu.Unlock()
+	scheduler.releaseReady(ctx)
+	assert.Len(t, scheduler.HeldJobs(), 1, "still waiting on b")
+
+	mu.Lock()
+	statuses["b"] = domain.JobStatusCompleted
+	mu.Unlock()
+	scheduler.releaseReady(ctx)
+	assert.Empty(t, scheduler.HeldJobs())
+
+	select {
+	case id := <-ran:
+		assert.Equal(t, domain.JobID("child"), id)
+	case <-time.After(2 * time.Second):
+		t.Fatal("dependent job never ran")
+	}
+}
+
+func TestJobScheduler_DrainReportsUnfinishedWork(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	scheduler := NewJobScheduler(logger, SchedulerConfig{MaxConcurrentJobs: 1})
+
+	release := make(chan struct{})
+	started := make(chan domain.JobID, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduler.Start(ctx, func(_ context.Context, job domain.Job) {
+		started <- job.ID
+		<-release
+	})
+
+	assert.NoError(t, scheduler.SubmitJob(ctx, domain.Job{ID: "slow"}))
+	assert.NoError(t, scheduler.SubmitJob(ctx, domain.Job{ID: "queued"}))
+	assert.Equal(t, domain.JobID("slow"), <-started)
+
+	drainCtx, drainCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer drainCancel()
+	report := scheduler.Drain(drainCtx)
+
+	assert.ErrorIs(t, scheduler.SubmitJob(ctx, domain.Job{ID: "late"}), ErrSchedulerDraining)
+	if assert.Len(t, report.Running, 1) {
+		assert.Equal(t, domain.JobID("slow"), report.Running[0].ID)
+	}
+	if assert.Len(t, report.Unstarted, 1) {
+		assert.Equal(t, domain.JobID("queued"), report.Unstarted[0].ID)
+	}
+
+	close(release)
+	select {
+	case id := <-started:
+		t.Fatalf("job %s started after drain", id)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSlotLimiter_RaisingLimitWakesWaiters(t *testing.T) {
+	l := newSlotLimiter(1)
+	ctx := context.Background()
+	assert.NoError(t, l.Acquire(ctx))
+
+	acquired := make(chan struct{})
+	go func() {
+		if l.Acquire(ctx) == nil {
+			close(acquired)
+		}
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("acquired a slot beyond the limit")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	l.SetLimit(2)
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("waiter not woken after the limit was raised")
+	}
+	assert.Equal(t, int64(2), l.Limit())
+}
+
+func TestJobScheduler_QueuePositionAndETA(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	scheduler := NewJobScheduler(logger, SchedulerConfig{MaxConcurrentJobs: 1})
+	scheduler.durations["container"] = []time.Duration{10 * time.Second}
+	scheduler.durations[CapabilityImageGenerate] = []time.Duration{30 * time.Second}
+
+	var mu sync.Mutex
+	published := map[domain.JobID]domain.JobQueueInfo{}
+	scheduler.SetQueueListener(func(_ context.Context, infos map[domain.JobID]domain.JobQueueInfo) {
+		mu.Lock()
+		defer mu.Unlock()
+		for id, info := range infos {
+			published[id] = info
+		}
+	})
+
+	release := make(chan struct{})
+	started := make(chan domain.JobID, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduler.Start(ctx, func(ctx context.Context, job domain.Job) {
+		started <- job.ID
+		<-release
+	})
+
+	assert.NoError(t, scheduler.SubmitJob(ctx, domain.Job{ID: "j1"}))
+	assert.Equal(t, domain.JobID("j1"), <-started)
+	assert.NoError(t, scheduler.SubmitJob(ctx, domain.Job{ID: "j2", Metadata: map[string]string{"capability": CapabilityImageGenerate}}))
+	assert.NoError(t, scheduler.SubmitJob(ctx, domain.Job{ID: "j3"}))
+
+	_, ok := scheduler.QueueInfo("j1")
+	assert.False(t, ok, "running jobs are not queued")
+
+	j2, ok := scheduler.QueueInfo("j2")
+	assert.True(t, ok)
+	assert.Equal(t, 1, j2.Position)
+	assert.Equal(t, 2, j2.Length)
+	assert.Equal(t, 1, j2.Running)
+	assert.Equal(t, int64(1), j2.Slots)
+	if assert.NotNil(t, j2.ETASeconds) {
+		assert.InDelta(t, 10, *j2.ETASeconds, 1) // j1 is a container job
+	}
+	j3, _ := scheduler.QueueInfo("j3")
+	assert.Equal(t, 2, j3.Position)
+	if assert.NotNil(t, j3.ETASeconds) {
+		assert.InDelta(t, 40, *j3.ETASeconds, 1) // behind j1 and the image job
+	}
+	mu.Lock()
+	assert.Equal(t, 2, published["j3"].Position)
+	mu.Unlock()
+
+	// j1 ends: j2 starts and j3 moves up
+	release <- struct{}{}
+	assert.Equal(t, domain.JobID("j2"), <-started)
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return published["j3"].Position == 1
+	}, time.Second, 10*time.Millisecond)
+	close(release)
+}
+
+func TestJobScheduler_QueueETAUnknownWithoutHistory(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	scheduler := NewJobScheduler(logger, SchedulerConfig{MaxConcurrentJobs: 1})
+	assert.NoError(t, scheduler.SubmitJob(context.Background(), domain.Job{ID: "j1"}))
+
+	info, ok := scheduler.QueueInfo("j1")
+	assert.True(t, ok)
+	assert.Equal(t, 1, info.Position)
+	assert.Nil(t, info.ETASeconds)
+}
+
+func TestJobScheduler_ProjectShares(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	scheduler := NewJobScheduler(logger, SchedulerConfig{MaxConcurrentJobs: 2})
+	scheduler.SetProjectShares(map[string]domain.ProjectShare{"batch": {MaxJobs: 1}})
+	job := func(id, project string) domain.Job {
+		return domain.Job{ID: domain.JobID(id), Metadata: map[string]string{"project_id": project}}
+	}
+
+	// A batch project floods the queue before anyone else submits
+	ctx := context.Background()
+	for _, id := range []string{"b1", "b2", "b3"} {
+		assert.NoError(t, scheduler.SubmitJob(ctx, job(id, "batch")))
+	}
+	assert.NoError(t, scheduler.SubmitJob(ctx, job("u1", "ui")))
+	assert.NoError(t, scheduler.SubmitJob(ctx, job("u2", "ui")))
+
+	// Capped at one slot, batch leaves the other to the interactive project
+	info, _ := scheduler.QueueInfo("u1")
+	assert.Equal(t, 2, info.Position)
+
+	release := make(chan struct{})
+	started := make(chan domain.JobID, 5)
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	scheduler.Start(runCtx, func(ctx context.Context, j domain.Job) {
+		started <- j.ID
+		<-release
+	})
+	first := []domain.JobID{<-started, <-started}
+	assert.ElementsMatch(t, []domain.JobID{"b1", "u1"}, first)
+
+	// The interactive project is not stuck behind the rest of the batch
+	info, _ = scheduler.QueueInfo("u2")
+	assert.LessOrEqual(t, info.Position, 2)
+	release <- struct{}{}
+	<-started
+	close(release)
+}
+
+func TestJobScheduler_PickWeightsProjects(t *testing.T) {
+	scheduler := NewJobScheduler(slog.New(slog.NewTextHandler(os.Stdout, nil)), SchedulerConfig{})
+	scheduler.SetProjectShares(map[string]domain.ProjectShare{"heavy": {Weight: 4}})
+	queue := []domain.Job{
+		{ID: "h", Metadata: map[string]string{"project_id": "heavy"}},
+		{ID: "l", Metadata: map[string]string{"project_id": "light"}},
+	}
+	// heavy runs 3 of its 4 shares, light 1 of 1: heavy goes first
+	assert.Equal(t, 0, scheduler.pickLocked(queue, map[string]int{"heavy": 3, "light": 1}))
+	// Tied loads keep queue order; below its share light wins
+	assert.Equal(t, 0, scheduler.pickLocked(queue, map[string]int{"heavy": 4, "light": 1}))
+	assert.Equal(t, 1, scheduler.pickLocked(queue, map[string]int{"heavy": 4}))
+}
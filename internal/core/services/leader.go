@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// LocalElector makes a lone kernel the leader of every singleton loop. It
+// is the elector for the embedded DuckDB repository, which a single kernel
+// owns.
+type LocalElector struct{}
+
+// Campaign implements ports.LeaderElector; leadership is never lost.
+func (LocalElector) Campaign(ctx context.Context, _ string) (<-chan struct{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return make(chan struct{}), nil
+}
+
+// leaderRetry is the pause after a failed campaign.
+const leaderRetry = 5 * time.Second
+
+// RunAsLeader runs fn only while this kernel leads name: fn's context is
+// cancelled when leadership is lost, and the kernel campaigns again. It
+// returns when ctx is cancelled.
+func RunAsLeader(ctx context.Context, logger *slog.Logger, elector ports.LeaderElector, name string, fn func(context.Context) error) error {
+	for {
+		lost, err := elector.Campaign(ctx, name)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			logger.Warn("leader campaign failed", "loop", name, "error", err)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(leaderRetry):
+			}
+			continue
+		}
+
+		logger.Info("leading singleton loop", "loop", name)
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error, 1)
+		go func() { done <- fn(runCtx) }()
+		select {
+		case err = <-done:
+			cancel()
+			return err // the loop stopped on its own or with ctx
+		case <-lost:
+			logger.Warn("lost leadership, pausing singleton loop", "loop", name)
+			cancel()
+			<-done
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyElector grants leadership on every campaign and takes it away
+// when lose is called.
+type flakyElector struct {
+	mu        sync.Mutex
+	campaigns int
+	lost      chan struct{}
+}
+
+func (e *flakyElector) Campaign(ctx context.Context, _ string) (<-chan struct{}, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.campaigns++
+	e.lost = make(chan struct{})
+	return e.lost, nil
+}
+
+func (e *flakyElector) lose() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	close(e.lost)
+}
+
+func TestRunAsLeader_RestartsAfterLosingLeadership(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	elector := &flakyElector{}
+	ctx, cancel := context.WithCancel(context.Background())
+	runs := make(chan struct{}, 4)
+
+	result := make(chan error, 1)
+	go func() {
+		result <- RunAsLeader(ctx, logger, elector, "cron", func(runCtx context.Context) error {
+			runs <- struct{}{}
+			<-runCtx.Done()
+			return nil
+		})
+	}()
+
+	<-runs
+	elector.lose()
+	select {
+	case <-runs:
+	case <-time.After(time.Second):
+		t.Fatal("loop did not restart after a new campaign")
+	}
+	cancel()
+	assert.NoError(t, <-result)
+	assert.Equal(t, 2, elector.campaigns)
+}
+
+func TestLocalElector_AlwaysLeads(t *testing.T) {
+	lost, err := LocalElector{}.Campaign(context.Background(), "heartbeat")
+	assert.NoError(t, err)
+	select {
+	case <-lost:
+		t.Fatal("local leadership must not be lost")
+	default:
+	}
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// LLMCacheStats is a snapshot of cache effectiveness.
+type LLMCacheStats struct {
+	Entries   int     `json:"entries"`
+	MaxSize   int     `json:"max_size"`
+	TTLSec    int64   `json:"ttl_seconds"`
+	Hits      int64   `json:"hits"`
+	Misses    int64   `json:"misses"`
+	Bypassed  int64   `json:"bypassed"` // non-deterministic calls, never cached
+	Evictions int64   `json:"evictions"`
+	HitRate   float64 `json:"hit_rate"` // hits / (hits + misses)
+}
+
+type llmCacheEntry struct {
+	key      string
+	response string
+	storedAt time.Time
+}
+
+// LLMCache memoizes responses of deterministic (temperature 0) LLM calls,
+// keyed by a hash of model + prompt. Least recently used entries are evicted
+// beyond maxSize; entries older than ttl are treated as misses.
+type LLMCache struct {
+	logger  *slog.Logger
+	maxSize int
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // front = most recently used
+	stats   LLMCacheStats
+}
+
+func NewLLMCache(logger *slog.Logger, maxSize int, ttl time.Duration) *LLMCache {
+	return &LLMCache{
+		logger:  logger,
+		maxSize: maxSize,
+		ttl:     ttl,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Wrap returns a provider that serves deterministic calls from the cache.
+func (c *LLMCache) Wrap(p domain.LLMProvider) domain.LLMProvider {
+	if c == nil || c.maxSize <= 0 {
+		return p
+	}
+	return &cachedLLMProvider{cache: c, inner: p}
+}
+
+// Stats returns current counters.
+func (c *LLMCache) Stats() LLMCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats
+	s.Entries = c.lru.Len()
+	s.MaxSize = c.maxSize
+	s.TTLSec = int64(c.ttl / time.Second)
+	if total := s.Hits + s.Misses; total > 0 {
+		s.HitRate = float64(s.Hits) / float64(total)
+	}
+	return s
+}
+
+// Purge drops every cached response and returns how many were removed.
+func (c *LLMCache) Purge() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := c.lru.Len()
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+	c.logger.Info("llm cache purged", "entries", n)
+	return n
+}
+
+func llmCacheKey(modelID, prompt string) string {
+	h := sha256.New()
+	h.Write([]byte(modelID))
+	h.Write([]byte{0})
+	h.Write([]byte(prompt))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *LLMCache) get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if ok {
+		entry := el.Value.(*llmCacheEntry)
+		if c.ttl <= 0 || time.Since(entry.storedAt) < c.ttl {
+			c.lru.MoveToFront(el)
+			c.stats.Hits++
+			return entry.response, true
+		}
+		c.lru.Remove(el)
+		delete(c.entries, key)
+	}
+	c.stats.Misses++
+	return "", false
+}
+
+func (c *LLMCache) put(key, response string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*llmCacheEntry).response = response
+		el.Value.(*llmCacheEntry).storedAt = time.Now()
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&llmCacheEntry{key: key, response: response, storedAt: time.Now()})
+	for c.lru.Len() > c.maxSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*llmCacheEntry).key)
+		c.stats.Evictions++
+	}
+}
+
+func (c *LLMCache) bypass() {
+	c.mu.Lock()
+	c.stats.Bypassed++
+	c.mu.Unlock()
+}
+
+// cachedLLMProvider is the domain.LLMProvider returned by LLMCache.Wrap.
+type cachedLLMProvider struct {
+	cache *LLMCache
+	inner domain.LLMProvider
+}
+
+func (p *cachedLLMProvider) GenerateText(ctx context.Context, prompt string) (string, error) {
+	return p.generate(ctx, prompt, "", func() (string, error) { return p.inner.GenerateText(ctx, prompt) })
+}
+
+func (p *cachedLLMProvider) GenerateTextWithModel(ctx context.Context, prompt string, modelID string) (string, error) {
+	return p.generate(ctx, prompt, modelID, func() (string, error) { return p.inner.GenerateTextWithModel(ctx, prompt, modelID) })
+}
+
+func (p *cachedLLMProvider) generate(ctx context.Context, prompt, modelID string, call func() (string, error)) (string, error) {
+	if !domain.IsDeterministic(ctx) {
+		p.cache.bypass()
+		return call()
+	}
+	key := llmCacheKey(modelID, prompt)
+	if resp, ok := p.cache.get(key); ok {
+		return resp, nil
+	}
+	resp, err := call()
+	if err == nil && resp != "" {
+		p.cache.put(key, resp)
+	}
+	return resp, err
+}
+
+func (p *cachedLLMProvider) GenerateTextStream(ctx context.Context, prompt string) (<-chan domain.Chunk, error) {
+	return p.stream(ctx, prompt, "", func() (<-chan domain.Chunk, error) { return p.inner.GenerateTextStream(ctx, prompt) })
+}
+
+func (p *cachedLLMProvider) GenerateTextStreamWithModel(ctx context.Context, prompt string, modelID string) (<-chan domain.Chunk, error) {
+	return p.stream(ctx, prompt, modelID, func() (<-chan domain.Chunk, error) {
+		return p.inner.GenerateTextStreamWithModel(ctx, prompt, modelID)
+	})
+}
+
+// stream serves a cache hit as a single chunk; on a miss it relays the
+// provider stream and stores the full text once it completes successfully.
+func (p *cachedLLMProvider) stream(ctx context.Context, prompt, modelID string, call func() (<-chan domain.Chunk, error)) (<-chan domain.Chunk, error) {
+	if !domain.IsDeterministic(ctx) {
+		p.cache.bypass()
+		return call()
+	}
+	key := llmCacheKey(modelID, prompt)
+	if resp, ok := p.cache.get(key); ok {
+		return domain.StreamOf(resp), nil
+	}
+	in, err := call()
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan domain.Chunk, cap(in))
+	go func() {
+		defer close(out)
+		var sb strings.Builder
+		for c := range in {
+			sb.WriteString(c.Text)
+			select {
+			case out <- c:
+			case <-ctx.Done():
+				return // consumer gave up; partial output is not cached
+			}
+			if c.Err != nil {
+				return
+			}
+			if c.Done && sb.Len() > 0 {
+				p.cache.put(key, sb.String())
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingLLM returns a fresh answer on every call.
+type countingLLM struct{ calls int }
+
+func (l *countingLLM) GenerateText(ctx context.Context, prompt string) (string, error) {
+	return l.GenerateTextWithModel(ctx, prompt, "")
+}
+
+func (l *countingLLM) GenerateTextWithModel(_ context.Context, prompt string, modelID string) (string, error) {
+	l.calls++
+	return fmt.Sprintf("%s/%s#%d", modelID, prompt, l.calls), nil
+}
+
+func (l *countingLLM) GenerateTextStream(ctx context.Context, prompt string) (<-chan domain.Chunk, error) {
+	return l.GenerateTextStreamWithModel(ctx, prompt, "")
+}
+
+// GenerateTextStreamWithModel streams the answer in two chunks.
+func (l *countingLLM) GenerateTextStreamWithModel(ctx context.Context, prompt string, modelID string) (<-chan domain.Chunk, error) {
+	text, _ := l.GenerateTextWithModel(ctx, prompt, modelID)
+	ch := make(chan domain.Chunk, 2)
+	ch <- domain.Chunk{Text: text[:len(text)/2]}
+	ch <- domain.Chunk{Text: text[len(text)/2:], Done: true}
+	close(ch)
+	return ch, nil
+}
+
+func TestLLMCache_DeterministicOnly(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	inner := &countingLLM{}
+	cache := NewLLMCache(logger, 2, time.Hour)
+	llm := cache.Wrap(inner)
+
+	det := domain.WithDeterministic(context.Background())
+
+	first, err := llm.GenerateTextWithModel(det, "title this", "m1")
+	require.NoError(t, err)
+	second, _ := llm.GenerateTextWithModel(det, "title this", "m1")
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, inner.calls)
+
+	// Different model → different key
+	other, _ := llm.GenerateTextWithModel(det, "title this", "m2")
+	assert.NotEqual(t, first, other)
+
+	// Non-deterministic calls always hit the provider
+	_, _ = llm.GenerateTextWithModel(context.Background(), "title this", "m1")
+	assert.Equal(t, 3, inner.calls)
+
+	// LRU eviction beyond max size
+	_, _ = llm.GenerateTextWithModel(det, "another", "m1")
+	stats := cache.Stats()
+	assert.Equal(t, int64(1), stats.Hits)
+	assert.Equal(t, int64(3), stats.Misses)
+	assert.Equal(t, int64(1), stats.Bypassed)
+	assert.Equal(t, int64(1), stats.Evictions)
+	assert.Equal(t, 2, stats.Entries)
+
+	assert.Equal(t, 2, cache.Purge())
+	_, _ = llm.GenerateTextWithModel(det, "another", "m1")
+	assert.Equal(t, 5, inner.calls)
+}
+
+func TestLLMCache_Stream(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	inner := &countingLLM{}
+	llm := NewLLMCache(logger, 8, time.Hour).Wrap(inner)
+	det := domain.WithDeterministic(context.Background())
+
+	ch, err := llm.GenerateTextStreamWithModel(det, "summarize", "m1")
+	require.NoError(t, err)
+	streamed, err := domain.CollectStream(ch)
+	require.NoError(t, err)
+
+	// Second call is served from the cache as one chunk, equal to the streamed text
+	ch, err = llm.GenerateTextStreamWithModel(det, "summarize", "m1")
+	require.NoError(t, err)
+	cached, err := domain.CollectStream(ch)
+	require.NoError(t, err)
+	assert.Equal(t, streamed, cached)
+	assert.Equal(t, 1, inner.calls)
+
+	text, err := llm.GenerateTextWithModel(det, "summarize", "m1")
+	require.NoError(t, err)
+	assert.Equal(t, streamed, text)
+}
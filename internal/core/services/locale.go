@@ -0,0 +1,156 @@
+package services
+
+import (
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// agentStrings are the ReAct scaffold instructions and the agent's own
+// messages in one locale. The format keywords (Thought, Action, Action
+// Input, Observation, Final Answer) stay in English in every locale: the
+// parser looks for them.
+type agentStrings struct {
+	identity     string // default system identity
+	pattern      string
+	formatTool   string
+	formatAnswer string
+	rules        string
+	jsonRules    string
+	examples     string
+	greeting     string // answer of the simple chat example
+	history      string // heading of the previous conversation block
+	respondTo    string
+	// answerIn asks for answers in the locale's language; empty for English
+	answerIn string
+
+	interrupted   string // loop cut off by a shutdown
+	cancelled     string // loop cancelled on request
+	stoppedEarly  string // %v: the error
+	maxSteps      string // %d: steps taken
+	blockedInput  string // %s: " (categories)" or ""
+	blockedOutput string // %s: " (categories)" or ""
+}
+
+var agentLocales = map[string]agentStrings{
+	domain.LocaleEnglish: {
+		identity:     "You are an AI assistant with access to tools.",
+		pattern:      "You use the ReAct pattern: Thought → Action → Observation → ... → Final Answer.",
+		formatTool:   "FORMAT (tool call):",
+		formatAnswer: "FORMAT (direct answer):",
+		rules: `RULES:
+1. Always start with "Thought:"
+2. For simple chat (greetings, questions, conversation), go DIRECTLY to "Final Answer:" — no tools needed.
+3. Only use tools when the user explicitly asks for something requiring them.
+4. CRITICAL: Use the EXACT tool name from the "Available Tools" list above. Do NOT invent tool names.
+5. Tools generate_image and generate_text are ASYNC. When "status":"queued", tell user to wait.
+6. When "status":"unavailable", the service is down — tell user clearly.
+7. Action Input must be valid JSON on one line.
+8. CHECK MEMORY: If the user asks about something stored in LONG-TERM MEMORY, use it!`,
+		jsonRules: `CRITICAL JSON RULES:
+- ALL JSON keys MUST be wrapped in double quotes: {"key": "value"} NOT {key: "value"}
+- No trailing commas: {"a": 1, "b": 2} NOT {"a": 1, "b": 2,}
+- Action Input must be a single-line JSON object`,
+		examples:      "EXAMPLES:",
+		greeting:      "Hello! How can I help you today?",
+		history:       "Previous conversation:",
+		respondTo:     "Now respond to:",
+		interrupted:   interruptedShutdown,
+		cancelled:     "Stopped: the run was cancelled. Ask me to continue and I'll pick up from the last observation.",
+		stoppedEarly:  "I stopped before finishing: %v",
+		maxSteps:      "I stopped after %d steps without reaching an answer. Ask me to continue and I'll pick up from the last observation.",
+		blockedInput:  "I can't help with that: your message was blocked by content moderation%s.",
+		blockedOutput: "I can't share that answer: it was withheld by content moderation%s.",
+	},
+	domain.LocalePortuguese: {
+		identity:     "Você é um assistente de IA com acesso a ferramentas.",
+		pattern:      "Você usa o padrão ReAct: Thought → Action → Observation → ... → Final Answer.",
+		formatTool:   "FORMATO (chamada de ferramenta):",
+		formatAnswer: "FORMATO (resposta direta):",
+		rules: `REGRAS:
+1. Sempre comece com "Thought:"
+2. Para conversa simples (cumprimentos, perguntas, bate-papo), vá DIRETO para "Final Answer:" — sem ferramentas.
+3. Só use ferramentas quando o usuário pedir explicitamente algo que as exija.
+4. CRÍTICO: Use o nome EXATO da ferramenta da lista "Available Tools" acima. NÃO invente nomes de ferramentas.
+5. As ferramentas generate_image e generate_text são ASSÍNCRONAS. Com "status":"queued", peça ao usuário para aguardar.
+6. Com "status":"unavailable", o serviço está fora do ar — avise o usuário com clareza.
+7. Action Input deve ser um JSON válido em uma linha.
+8. CONSULTE A MEMÓRIA: se o usuário perguntar sobre algo guardado na LONG-TERM MEMORY, use-a!
+9. Mantenha as palavras-chave Thought, Action, Action Input e Final Answer em inglês.`,
+		jsonRules: `REGRAS CRÍTICAS DE JSON:
+- TODAS as chaves JSON DEVEM estar entre aspas duplas: {"key": "value"} e NÃO {key: "value"}
+- Sem vírgulas sobrando no final: {"a": 1, "b": 2} e NÃO {"a": 1, "b": 2,}
+- Action Input deve ser um objeto JSON em uma única linha`,
+		examples:      "EXEMPLOS:",
+		greeting:      "Olá! Como posso ajudar hoje?",
+		history:       "Conversa anterior:",
+		respondTo:     "Agora responda a:",
+		answerIn:      "Escreva o Thought e a Final Answer em português.",
+		interrupted:   "Fui interrompido antes de terminar (o kernel parou). Peça para eu continuar e retomo a partir da última observação.",
+		cancelled:     "Parei: a execução foi cancelada. Peça para eu continuar e retomo a partir da última observação.",
+		stoppedEarly:  "Parei antes de terminar: %v",
+		maxSteps:      "Parei depois de %d passos sem chegar a uma resposta. Peça para eu continuar e retomo a partir da última observação.",
+		blockedInput:  "Não posso ajudar com isso: sua mensagem foi bloqueada pela moderação de conteúdo%s.",
+		blockedOutput: "Não posso compartilhar essa resposta: ela foi retida pela moderação de conteúdo%s.",
+	},
+	domain.LocaleSpanish: {
+		identity:     "Eres un asistente de IA con acceso a herramientas.",
+		pattern:      "Usas el patrón ReAct: Thought → Action → Observation → ... → Final Answer.",
+		formatTool:   "FORMATO (llamada a herramienta):",
+		formatAnswer: "FORMATO (respuesta directa):",
+		rules: `REGLAS:
+1. Empieza siempre con "Thought:"
+2. Para conversación simple (saludos, preguntas, charla), ve DIRECTO a "Final Answer:" — sin herramientas.
+3. Usa herramientas solo cuando el usuario pida explícitamente algo que las requiera.
+4. CRÍTICO: Usa el nombre EXACTO de la herramienta de la lista "Available Tools" de arriba. NO inventes nombres de herramientas.
+5. Las herramientas generate_image y generate_text son ASÍNCRONAS. Con "status":"queued", pide al usuario que espere.
+6. Con "status":"unavailable", el servicio no está disponible — díselo claramente al usuario.
+7. Action Input debe ser un JSON válido en una sola línea.
+8. REVISA LA MEMORIA: si el usuario pregunta por algo guardado en la LONG-TERM MEMORY, ¡úsala!
+9. Mantén las palabras clave Thought, Action, Action Input y Final Answer en inglés.`,
+		jsonRules: `REGLAS CRÍTICAS DE JSON:
+- TODAS las claves JSON DEBEN ir entre comillas dobles: {"key": "value"} y NO {key: "value"}
+- Sin comas finales: {"a": 1, "b": 2} y NO {"a": 1, "b": 2,}
+- Action Input debe ser un objeto JSON en una sola línea`,
+		examples:      "EJEMPLOS:",
+		greeting:      "¡Hola! ¿En qué puedo ayudarte hoy?",
+		history:       "Conversación anterior:",
+		respondTo:     "Ahora responde a:",
+		answerIn:      "Escribe el Thought y la Final Answer en español.",
+		interrupted:   "Me interrumpieron antes de terminar (el kernel se detuvo). Pídeme que continúe y retomaré desde la última observación.",
+		cancelled:     "Me detuve: la ejecución fue cancelada. Pídeme que continúe y retomaré desde la última observación.",
+		stoppedEarly:  "Me detuve antes de terminar: %v",
+		maxSteps:      "Me detuve tras %d pasos sin llegar a una respuesta. Pídeme que continúe y retomaré desde la última observación.",
+		blockedInput:  "No puedo ayudar con eso: tu mensaje fue bloqueado por la moderación de contenido%s.",
+		blockedOutput: "No puedo compartir esa respuesta: fue retenida por la moderación de contenido%s.",
+	},
+}
+
+// stringsFor returns the agent strings of locale, English when unsupported.
+func stringsFor(locale string) agentStrings {
+	if t, ok := agentLocales[locale]; ok {
+		return t
+	}
+	return agentLocales[domain.DefaultLocale]
+}
+
+// resolveLocale picks the locale of a run: the conversation's style language
+// > the user's (conversation context variable) > the persona's > the global
+// setting > detected from message > English. Invalid settings are skipped.
+func resolveLocale(conv domain.Conversation, persona *domain.Persona, global, message string) string {
+	var candidates []string
+	if conv.Style != nil {
+		candidates = append(candidates, conv.Style.Locale())
+	}
+	if v, ok := conv.Context[domain.ContextVarLocale].(string); ok {
+		candidates = append(candidates, v)
+	}
+	if persona != nil {
+		candidates = append(candidates, persona.Locale)
+	}
+	candidates = append(candidates, global, domain.DetectLocale(message))
+	for _, c := range candidates {
+		if locale, err := domain.ParseLocale(c); err == nil && locale != "" {
+			return locale
+		}
+	}
+	return domain.DefaultLocale
+}
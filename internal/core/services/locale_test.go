@@ -0,0 +1,55 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func TestResolveLocale(t *testing.T) {
+	persona := &domain.Persona{Locale: "es"}
+	user := domain.Conversation{Context: domain.ContextVars{domain.ContextVarLocale: "pt-BR"}}
+	none := domain.Conversation{}
+
+	assert.Equal(t, "pt", resolveLocale(user, persona, "en", "hello"), "the user's locale wins")
+	assert.Equal(t, "es", resolveLocale(none, persona, "en", "hello"))
+	assert.Equal(t, "en", resolveLocale(none, nil, "en", "olá, você pode me ajudar?"))
+	assert.Equal(t, "pt", resolveLocale(none, nil, "", "olá, você pode me ajudar?"), "detected without a setting")
+	invalid := domain.Conversation{Context: domain.ContextVars{domain.ContextVarLocale: "xx"}}
+	assert.Equal(t, "en", resolveLocale(invalid, nil, "", "42"), "invalid and undetectable fall back to English")
+
+	user.Style = &domain.ConversationStyle{Language: "Spanish"}
+	assert.Equal(t, "es", resolveLocale(user, nil, "", "hello"), "the conversation's style comes first")
+}
+
+func TestBuildReActPrompt_Localized(t *testing.T) {
+	agent := &ReActAgentService{}
+	tools := domain.NewToolRegistry()
+	coder := domain.BuiltinPersonas()[3]
+
+	en := agent.buildReActPrompt("", "hi", nil, tools, WorkspaceContext{}, "", domain.LocaleEnglish)
+	assert.Contains(t, en, "You are an AI assistant with access to tools.")
+	assert.Contains(t, en, "RULES:\n1. Always start with \"Thought:\"")
+	assert.Contains(t, en, "Final Answer: Hello! How can I help you today?")
+	assert.NotContains(t, en, "português")
+
+	pt := agent.buildReActPrompt("User: oi", "oi", &coder, tools, WorkspaceContext{}, "", domain.LocalePortuguese)
+	assert.Contains(t, pt, "Você é o auleOS Coder")
+	assert.Contains(t, pt, "Escreva o Thought e a Final Answer em português.")
+	assert.Contains(t, pt, "REGRAS:")
+	assert.Contains(t, pt, "Conversa anterior:\nUser: oi")
+	assert.Contains(t, pt, "Agora responda a:\nUser: oi")
+	// The parser's keywords stay in English
+	assert.Contains(t, pt, "Thought: <reasoning>\nAction: <EXACT tool name from list below>")
+
+	blocked := domain.ModerationDecision{Stage: domain.ModerationStageInput, Categories: []string{"violence"}}
+	assert.Equal(t, "No puedo ayudar con eso: tu mensaje fue bloqueado por la moderación de contenido (violence).", moderationRefusal(blocked, stringsFor(domain.LocaleSpanish)))
+}
+
+func TestWorkspaceContext_Style(t *testing.T) {
+	style := domain.ConversationStyle{Verbosity: domain.VerbosityBrief, Language: "pt"}
+	block := WorkspaceContext{Style: style.FormatForPrompt()}.FormatForPrompt()
+	assert.Contains(t, block, "CONVERSATION STYLE (the user's preferences for this conversation):\n- Always answer in Portuguese.\n- Keep answers brief")
+}
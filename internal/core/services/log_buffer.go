@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const defaultLogBufferSize = 2000
+
+// LogBuffer keeps the most recent kernel log records in memory so they can
+// be queried through the API without shell access to the host.
+type LogBuffer struct {
+	mu      sync.Mutex
+	entries []domain.LogEntry // ring buffer, next write at pos
+	pos     int
+	size    int
+}
+
+// NewLogBuffer keeps up to size records (2000 if size <= 0).
+func NewLogBuffer(size int) *LogBuffer {
+	if size <= 0 {
+		size = defaultLogBufferSize
+	}
+	return &LogBuffer{size: size}
+}
+
+func (b *LogBuffer) add(e domain.LogEntry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.entries) < b.size {
+		b.entries = append(b.entries, e)
+	} else {
+		b.entries[b.pos] = e
+	}
+	b.pos = (b.pos + 1) % b.size
+}
+
+// Query returns matching records, newest first.
+func (b *LogBuffer) Query(filter domain.LogFilter) []domain.LogEntry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := []domain.LogEntry{}
+	n := len(b.entries)
+	for i := 0; i < n; i++ {
+		e := b.entries[(b.pos-1-i+n)%n]
+		if !filter.Matches(e) {
+			continue
+		}
+		out = append(out, e)
+		if filter.Limit > 0 && len(out) >= filter.Limit {
+			break
+		}
+	}
+	return out
+}
+
+// Handler returns a slog.Handler that records into the buffer every record
+// at or above level. Combine it with the normal output via NewFanoutLogHandler.
+func (b *LogBuffer) Handler(level slog.Leveler) slog.Handler {
+	return &logBufferHandler{buf: b, level: level}
+}
+
+type logBufferHandler struct {
+	buf    *LogBuffer
+	level  slog.Leveler
+	attrs  []slog.Attr // pre-bound attrs, keys already group-qualified
+	prefix string      // open groups, "a.b."
+}
+
+func (h *logBufferHandler) Enabled(_ context.Context, l slog.Level) bool {
+	return l >= h.level.Level()
+}
+
+func (h *logBufferHandler) Handle(ctx context.Context, r slog.Record) error {
+	e := domain.LogEntry{
+		Time:    r.Time,
+		Level:   r.Level,
+		Message: r.Message,
+		Attrs:   make(map[string]interface{}, len(h.attrs)+r.NumAttrs()),
+	}
+	for _, a := range h.attrs {
+		addLogAttr(e.Attrs, "", a)
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		addLogAttr(e.Attrs, h.prefix, a)
+		return true
+	})
+
+	if c, ok := e.Attrs["component"].(string); ok {
+		e.Component = c
+		delete(e.Attrs, "component")
+	} else {
+		e.Component = logComponent(r.PC)
+	}
+	if id, ok := e.Attrs["request_id"].(string); ok {
+		e.RequestID = id
+		delete(e.Attrs, "request_id")
+	} else {
+		e.RequestID = domain.RequestIDFrom(ctx)
+	}
+	if len(e.Attrs) == 0 {
+		e.Attrs = nil
+	}
+	h.buf.add(e)
+	return nil
+}
+
+func (h *logBufferHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	next := *h
+	next.attrs = make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	next.attrs = append(next.attrs, h.attrs...)
+	for _, a := range attrs {
+		next.attrs = append(next.attrs, slog.Attr{Key: h.prefix + a.Key, Value: a.Value})
+	}
+	return &next
+}
+
+func (h *logBufferHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	next := *h
+	next.prefix = h.prefix + name + "."
+	return &next
+}
+
+// addLogAttr flattens a into m, qualifying group members as "group.key".
+func addLogAttr(m map[string]interface{}, prefix string, a slog.Attr) {
+	v := a.Value.Resolve()
+	if v.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range v.Group() {
+			addLogAttr(m, prefix, ga)
+		}
+		return
+	}
+	if a.Key == "" {
+		return
+	}
+	switch v.Kind() {
+	case slog.KindAny:
+		if err, ok := v.Any().(error); ok {
+			m[prefix+a.Key] = err.Error()
+			return
+		}
+		m[prefix+a.Key] = v.Any()
+	case slog.KindDuration, slog.KindTime:
+		m[prefix+a.Key] = v.String()
+	default:
+		m[prefix+a.Key] = v.Any()
+	}
+}
+
+// logComponent names the code that logged a record: the receiver type for
+// methods ("JobScheduler"), otherwise the package ("main", "duckdb").
+func logComponent(pc uintptr) string {
+	if pc == 0 {
+		return ""
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	name := frame.Function // .../services.(*JobScheduler).Start.func1
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	pkg, rest, _ := strings.Cut(name, ".")
+	if strings.HasPrefix(rest, "(") {
+		if end := strings.Index(rest, ")"); end > 0 {
+			return strings.TrimPrefix(rest[1:end], "*")
+		}
+	}
+	return pkg
+}
+
+// fanoutHandler sends each record to every handler that is enabled for it.
+type fanoutHandler []slog.Handler
+
+// NewFanoutLogHandler returns a handler that writes to all of handlers,
+// e.g. stdout, the in-memory LogBuffer and an optional log file.
+func NewFanoutLogHandler(handlers ...slog.Handler) slog.Handler {
+	return fanoutHandler(handlers)
+}
+
+func (f fanoutHandler) Enabled(ctx context.Context, l slog.Level) bool {
+	for _, h := range f {
+		if h.Enabled(ctx, l) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f fanoutHandler) Handle(ctx context.Context, r slog.Record) error {
+	var first error
+	for _, h := range f {
+		if !h.Enabled(ctx, r.Level) {
+			continue
+		}
+		if err := h.Handle(ctx, r.Clone()); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (f fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	out := make(fanoutHandler, len(f))
+	for i, h := range f {
+		out[i] = h.WithAttrs(attrs)
+	}
+	return out
+}
+
+func (f fanoutHandler) WithGroup(name string) slog.Handler {
+	out := make(fanoutHandler, len(f))
+	for i, h := range f {
+		out[i] = h.WithGroup(name)
+	}
+	return out
+}
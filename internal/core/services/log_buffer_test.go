@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logProbe struct{}
+
+func (*logProbe) log(logger *slog.Logger) { logger.Info("from method") }
+
+func TestLogBuffer_QueryFilters(t *testing.T) {
+	buf := NewLogBuffer(10)
+	logger := slog.New(NewRequestIDLogHandler(buf.Handler(slog.LevelDebug)))
+
+	logger.Debug("noisy")
+	logger.With("component", "scheduler").Warn("queue full", "depth", 100)
+	logger.ErrorContext(domain.WithRequestID(context.Background(), "req-1"), "job failed", "error", errors.New("boom"))
+	(&logProbe{}).log(logger)
+
+	all := buf.Query(domain.LogFilter{MinLevel: slog.LevelDebug})
+	require.Len(t, all, 4)
+	assert.Equal(t, "from method", all[0].Message, "newest first")
+	assert.Equal(t, "logProbe", all[0].Component)
+
+	warn := buf.Query(domain.LogFilter{MinLevel: slog.LevelWarn})
+	require.Len(t, warn, 2)
+
+	sched := buf.Query(domain.LogFilter{Component: "SCHED"})
+	require.Len(t, sched, 1)
+	assert.Equal(t, int64(100), sched[0].Attrs["depth"])
+
+	byReq := buf.Query(domain.LogFilter{RequestID: "req-1"})
+	require.Len(t, byReq, 1)
+	assert.Equal(t, "boom", byReq[0].Attrs["error"])
+
+	assert.Empty(t, buf.Query(domain.LogFilter{Since: time.Now().Add(time.Minute)}))
+	assert.Len(t, buf.Query(domain.LogFilter{MinLevel: slog.LevelDebug, Limit: 2}), 2)
+}
+
+func TestLogBuffer_KeepsMostRecent(t *testing.T) {
+	buf := NewLogBuffer(3)
+	logger := slog.New(buf.Handler(slog.LevelInfo))
+	for _, msg := range []string{"a", "b", "c", "d", "e"} {
+		logger.Info(msg)
+	}
+
+	got := buf.Query(domain.LogFilter{})
+	require.Len(t, got, 3)
+	assert.Equal(t, []string{"e", "d", "c"}, []string{got[0].Message, got[1].Message, got[2].Message})
+}
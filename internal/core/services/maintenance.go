@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// ErrSystemPaused is returned for new work while maintenance mode is on.
+var ErrSystemPaused = errors.New("system is paused for maintenance")
+
+// MaintenanceMode is the kernel-wide kill switch. While paused the job
+// scheduler starts no new jobs, cron and heartbeat skip their ticks and the
+// agent refuses new chats. Work already running is left to finish.
+type MaintenanceMode struct {
+	mu      sync.Mutex
+	status  domain.MaintenanceStatus
+	resumed chan struct{} // closed on Resume; replaced on Pause
+
+	eventBus *EventBus // optional
+}
+
+// NewMaintenanceMode returns a switch in the running (not paused) state.
+func NewMaintenanceMode(eventBus *EventBus) *MaintenanceMode {
+	ch := make(chan struct{})
+	close(ch)
+	return &MaintenanceMode{resumed: ch, eventBus: eventBus}
+}
+
+// Pause enters maintenance mode. It reports false if already paused.
+func (m *MaintenanceMode) Pause(reason string) bool {
+	m.mu.Lock()
+	if m.status.Paused {
+		m.mu.Unlock()
+		return false
+	}
+	now := time.Now()
+	m.status = domain.MaintenanceStatus{Paused: true, Reason: reason, Since: &now}
+	m.resumed = make(chan struct{})
+	status := m.status
+	m.mu.Unlock()
+
+	m.publish(status)
+	return true
+}
+
+// Resume leaves maintenance mode. It reports false if not paused.
+func (m *MaintenanceMode) Resume() bool {
+	m.mu.Lock()
+	if !m.status.Paused {
+		m.mu.Unlock()
+		return false
+	}
+	m.status = domain.MaintenanceStatus{}
+	close(m.resumed)
+	m.mu.Unlock()
+
+	m.publish(domain.MaintenanceStatus{})
+	return true
+}
+
+// Paused reports whether maintenance mode is on. A nil switch is never paused.
+func (m *MaintenanceMode) Paused() bool {
+	if m == nil {
+		return false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status.Paused
+}
+
+// Status returns the current state.
+func (m *MaintenanceMode) Status() domain.MaintenanceStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status
+}
+
+// Wait blocks while paused, returning ctx's error if it ends first.
+func (m *MaintenanceMode) Wait(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	resumed := m.resumed
+	m.mu.Unlock()
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *MaintenanceMode) publish(status domain.MaintenanceStatus) {
+	if m.eventBus == nil {
+		return
+	}
+	data, _ := json.Marshal(status)
+	m.eventBus.Publish(Event{
+		JobID:     string(domain.SystemConversationID),
+		Type:      EventTypeMaintenance,
+		Data:      string(data),
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceMode_HoldsJobsUntilResume(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	maintenance := NewMaintenanceMode(nil)
+	scheduler := NewJobScheduler(logger, SchedulerConfig{MaxConcurrentJobs: 1})
+	scheduler.SetMaintenance(maintenance)
+
+	ran := make(chan domain.JobID, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.True(t, maintenance.Pause("upgrade"))
+	assert.False(t, maintenance.Pause("again"), "already paused")
+	assert.Equal(t, "upgrade", maintenance.Status().Reason)
+
+	scheduler.Start(ctx, func(_ context.Context, job domain.Job) { ran <- job.ID })
+	assert.NoError(t, scheduler.SubmitJob(ctx, domain.Job{ID: "queued"}))
+
+	select {
+	case id := <-ran:
+		t.Fatalf("job %s started while paused", id)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	assert.True(t, maintenance.Resume())
+	assert.False(t, maintenance.Paused())
+	select {
+	case id := <-ran:
+		assert.Equal(t, domain.JobID("queued"), id)
+	case <-time.After(2 * time.Second):
+		t.Fatal("job never started after resume")
+	}
+}
+
+func TestMaintenanceMode_DrainWhilePaused(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	maintenance := NewMaintenanceMode(nil)
+	scheduler := NewJobScheduler(logger, SchedulerConfig{MaxConcurrentJobs: 1})
+	scheduler.SetMaintenance(maintenance)
+	maintenance.Pause("")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduler.Start(ctx, func(context.Context, domain.Job) {})
+	assert.NoError(t, scheduler.SubmitJob(ctx, domain.Job{ID: "queued"}))
+
+	drainCtx, drainCancel := context.WithTimeout(ctx, time.Second)
+	defer drainCancel()
+	report := scheduler.Drain(drainCtx)
+	if assert.Len(t, report.Unstarted, 1) {
+		assert.Equal(t, domain.JobID("queued"), report.Unstarted[0].ID)
+	}
+}
@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	CapabilityVideoGenerate = "video.generate"
+	CapabilityAudioGenerate = "audio.generate"
+)
+
+// MediaWorker describes the Docker worker that renders one media capability.
+//
+// Worker contract: the prompt arrives in AULE_PROMPT, the target file path in
+// AULE_OUTPUT (under /output, a writable mount of the job workspace) and
+// optional knobs in AULE_DURATION_SECONDS / AULE_VOICE. The worker reports
+// progress via AULE_PROGRESS_FILE and exits once the file is written.
+type MediaWorker struct {
+	Image        string
+	Command      []string
+	Timeout      time.Duration
+	Ext          string // output file extension, e.g. ".mp4"
+	MimeType     string
+	ArtifactType domain.ArtifactType
+}
+
+// defaultMediaWorkers returns the video/audio workers, images overridable via env.
+func defaultMediaWorkers() map[string]MediaWorker {
+	videoImage := os.Getenv("AULE_VIDEO_WORKER_IMAGE")
+	if videoImage == "" {
+		videoImage = "auleos/animatediff:latest"
+	}
+	audioImage := os.Getenv("AULE_AUDIO_WORKER_IMAGE")
+	if audioImage == "" {
+		audioImage = "auleos/bark:latest"
+	}
+	return map[string]MediaWorker{
+		CapabilityVideoGenerate: {
+			Image:        videoImage,
+			Timeout:      30 * time.Minute,
+			Ext:          ".mp4",
+			MimeType:     "video/mp4",
+			ArtifactType: domain.ArtifactTypeVideo,
+		},
+		CapabilityAudioGenerate: {
+			Image:        audioImage,
+			Timeout:      10 * time.Minute,
+			Ext:          ".wav",
+			MimeType:     "audio/wav",
+			ArtifactType: domain.ArtifactTypeAudio,
+		},
+	}
+}
+
+// SetMediaWorker overrides the worker used for a media capability.
+func (s *WorkerLifecycle) SetMediaWorker(capability string, w MediaWorker) {
+	s.handlerMu.Lock()
+	defer s.handlerMu.Unlock()
+	s.mediaWorkers[capability] = w
+}
+
+// MediaJobOptions are the optional knobs of a video/audio job.
+type MediaJobOptions struct {
+	DurationSeconds int    // 0 = worker default
+	Voice           string // audio only; e.g. a bark speaker preset
+}
+
+// SubmitMediaJobWithConv queues a video.generate or audio.generate job whose
+// result is pushed back to the conversation as a linked artifact.
+func (s *WorkerLifecycle) SubmitMediaJobWithConv(ctx context.Context, capability, prompt, convID string, opts MediaJobOptions) (domain.JobID, error) {
+	s.handlerMu.RLock()
+	worker, ok := s.mediaWorkers[capability]
+	s.handlerMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("unsupported media capability: %s", capability)
+	}
+
+	id := domain.JobID(uuid.New().String())
+	now := time.Now()
+	tool := strings.Replace(capability, ".", "_", 1) // video.generate -> video_generate
+	job := domain.Job{
+		ID: id,
+		Spec: domain.WorkerSpec{
+			Image: worker.Image,
+			Env:   map[string]string{},
+		},
+		Status:    domain.JobStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Metadata: map[string]string{
+			"capability":      capability,
+			"tool":            tool,
+			"prompt":          prompt,
+			"attempt":         "1",
+			"conversation_id": convID,
+		},
+	}
+	if opts.DurationSeconds > 0 {
+		job.Metadata["duration_seconds"] = strconv.Itoa(opts.DurationSeconds)
+	}
+	if opts.Voice != "" {
+		job.Metadata["voice"] = opts.Voice
+	}
+	if projectID, ok := GetProjectFromContext(ctx); ok {
+		job.Metadata["project_id"] = string(projectID)
+	}
+
+	stampRequestID(ctx, &job)
+	if err := s.repo.SaveJob(ctx, job); err != nil {
+		return "", fmt.Errorf("failed to save %s job: %w", capability, err)
+	}
+
+	s.publishStatus(ctx, string(id), string(domain.JobStatusPending))
+	s.publishLog(ctx, string(id), capability+" job queued")
+
+	if err := s.scheduler.SubmitJob(ctx, job); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// executeMediaJob runs a long-lived media worker container to completion,
+// streaming progress while it renders.
+func (s *WorkerLifecycle) executeMediaJob(ctx context.Context, job domain.Job) {
+	capability := job.Metadata["capability"]
+	s.handlerMu.RLock()
+	worker, ok := s.mediaWorkers[capability]
+	s.handlerMu.RUnlock()
+	if !ok {
+		s.failJob(ctx, job, fmt.Errorf("no worker configured for %s", capability))
+		return
+	}
+
+	prompt := strings.TrimSpace(job.Metadata["prompt"])
+	if prompt == "" {
+		s.failJob(ctx, job, fmt.Errorf("missing prompt metadata for %s job", capability))
+		return
+	}
+
+	progressStart := 5
+	s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusRunning), &progressStart)
+	s.publishLog(ctx, string(job.ID), fmt.Sprintf("%s started on %s", capability, worker.Image))
+
+	workspacePath, err := s.workspace.PrepareWorkspace(string(job.ID))
+	if err != nil {
+		s.failJob(ctx, job, fmt.Errorf("workspace prep failed: %w", err))
+		return
+	}
+
+	attempt := strings.TrimSpace(job.Metadata["attempt"])
+	if attempt == "" {
+		attempt = "1"
+	}
+	resultFileName := fmt.Sprintf("result-v%s%s", attempt, worker.Ext)
+
+	spec := job.Spec
+	spec.Image = worker.Image
+	if len(worker.Command) > 0 {
+		spec.Command = worker.Command
+	}
+	spec.Env = map[string]string{
+		"AULE_PROMPT": prompt,
+		"AULE_OUTPUT": "/output/" + resultFileName,
+	}
+	if d := job.Metadata["duration_seconds"]; d != "" {
+		spec.Env["AULE_DURATION_SECONDS"] = d
+	}
+	if v := job.Metadata["voice"]; v != "" {
+		spec.Env["AULE_VOICE"] = v
+	}
+	spec.WritableMounts = map[string]string{workspacePath: "/output"}
+
+	job.Status = domain.JobStatusRunning
+	job.UpdatedAt = time.Now()
+	if err := s.repo.SaveJob(ctx, job); err != nil {
+		s.logger.Error("failed to save media job running state", "job_id", job.ID, "error", err)
+	}
+
+	workerID, err := s.workerMgr.Spawn(ctx, withRequestEnv(ctx, spec))
+	if err != nil {
+		s.failJob(ctx, job, fmt.Errorf("spawn failed: %w", err))
+		return
+	}
+	if err := s.repo.SaveWorker(ctx, domain.Worker{
+		ID:        workerID,
+		Spec:      spec,
+		Status:    domain.HealthStatusStarting,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Metadata:  map[string]string{"job_id": string(job.ID)},
+	}); err != nil {
+		s.logger.Warn("failed to persist worker record", "worker_id", workerID, "error", err)
+	}
+
+	if err := s.waitMediaWorker(ctx, job, workerID, worker.Timeout); err != nil {
+		s.failJob(ctx, job, err)
+		return
+	}
+
+	resultPath := filepath.Join(workspacePath, resultFileName)
+	info, err := os.Stat(resultPath)
+	if err != nil || info.Size() == 0 {
+		s.failJob(ctx, job, fmt.Errorf("%s worker exited without writing %s", capability, resultFileName))
+		return
+	}
+
+	kind := "audio"
+	if worker.ArtifactType == domain.ArtifactTypeVideo {
+		kind = "video"
+	}
+	artID := s.saveJobArtifact(ctx, job, worker.ArtifactType, worker.MimeType, resultFileName, resultPath, info.Size())
+	job.Status = domain.JobStatusCompleted
+	job.SetOutput(domain.JobResult{
+		Summary: "Generated " + kind,
+		Files:   []domain.JobFile{s.jobFile(job, resultFileName, worker.MimeType, info.Size(), artID)},
+	})
+	job.Error = nil
+	job.UpdatedAt = time.Now()
+	if err := s.repo.SaveJob(ctx, job); err != nil {
+		s.logger.Error("failed to save completed media job", "job_id", job.ID, "error", err)
+	}
+
+	progressDone := 100
+	s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusCompleted), &progressDone)
+	s.publishLog(ctx, string(job.ID), fmt.Sprintf("%s saved: %s", capability, resultPath))
+
+	s.notifyConversation(ctx, job, fmt.Sprintf("Here is your generated %s:", kind))
+}
+
+// waitMediaWorker waits until the worker exits, relaying the worker's own
+// progress reports. Until the worker reports, progress is estimated from
+// elapsed time against the timeout.
+func (s *WorkerLifecycle) waitMediaWorker(ctx context.Context, job domain.Job, workerID domain.WorkerID, timeout time.Duration) error {
+	wait := s.waitWorker(workerID)
+	defer wait.cancel()
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+	start := time.Now()
+	deadline := time.After(timeout)
+	lastEstimate := 0
+	var reported domain.WorkerProgress
+
+	for {
+		select {
+		case <-ctx.Done():
+			_ = s.workerMgr.Kill(context.Background(), workerID)
+			_ = s.repo.UpdateWorkerStatus(context.Background(), workerID, domain.HealthStatusExited)
+			return ctx.Err()
+		case <-deadline:
+			_ = s.workerMgr.Kill(ctx, workerID)
+			_ = s.repo.UpdateWorkerStatus(ctx, workerID, domain.HealthStatusExited)
+			return fmt.Errorf("timeout after %s", timeout)
+		case ev := <-wait.events:
+			if ev.Exited() {
+				_ = s.workerMgr.Kill(ctx, workerID)
+				return nil
+			}
+		case <-ticker.C:
+			if wait.healthCheckDue() {
+				status, err := s.workerMgr.HealthCheck(ctx, workerID)
+				if err != nil {
+					s.logger.Error("health check failed", "worker_id", workerID, "error", err)
+					continue
+				}
+				_ = s.repo.UpdateWorkerStatus(ctx, workerID, status)
+				if status == domain.HealthStatusExited {
+					_ = s.workerMgr.Kill(ctx, workerID)
+					return nil
+				}
+			}
+
+			if s.relayWorkerProgress(ctx, job.ID, workerID, &reported) {
+				continue
+			}
+			progress := 5 + int(85*time.Since(start)/timeout)
+			if progress > lastEstimate {
+				lastEstimate = progress
+				s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusRunning), &progress)
+			}
+		}
+	}
+}
+
+// saveJobArtifact records a result file as an artifact linked to the job,
+// conversation and project so it shows up in the artifact views.
+func (s *WorkerLifecycle) saveJobArtifact(ctx context.Context, job domain.Job, artType domain.ArtifactType, mimeType, name, path string, size int64) domain.ArtifactID {
+	jobID := job.ID
+	art := domain.Artifact{
+		ID:        domain.NewArtifactID(),
+		JobID:     &jobID,
+		Type:      artType,
+		Name:      name,
+		FilePath:  path,
+		MimeType:  mimeType,
+		SizeBytes: size,
+		Prompt:    job.Metadata["prompt"],
+		CreatedAt: time.Now(),
+	}
+	if convID := job.Metadata["conversation_id"]; convID != "" {
+		id := domain.ConversationID(convID)
+		art.ConversationID = &id
+	}
+	if projectID := job.Metadata["project_id"]; projectID != "" {
+		id := domain.ProjectID(projectID)
+		art.ProjectID = &id
+	}
+	if err := s.repo.SaveArtifact(ctx, art); err != nil {
+		s.logger.Error("failed to save job artifact", "job_id", job.ID, "error", err)
+		return ""
+	}
+	return art.ID
+}
@@ -1,11 +1,13 @@
 package services
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,6 +41,18 @@ type ollamaTagsResponse struct {
 	} `json:"models"`
 }
 
+// ollamaShowResponse is the part of Ollama's /api/show JSON we use.
+type ollamaShowResponse struct {
+	Parameters string `json:"parameters"` // Modelfile PARAMETER lines, e.g. "num_ctx 8192"
+	Details    struct {
+		ParameterSize     string `json:"parameter_size"`
+		QuantizationLevel string `json:"quantization_level"`
+		Family            string `json:"family"`
+	} `json:"details"`
+	ModelInfo    map[string]interface{} `json:"model_info"` // "<arch>.context_length", ...
+	Capabilities []string               `json:"capabilities"`
+}
+
 // litellmModelsResponse is the LiteLLM /models or /v1/models response.
 type litellmModelsResponse struct {
 	Data []struct {
@@ -78,21 +92,91 @@ func (d *ModelDiscovery) DiscoverOllama(ctx context.Context, baseURL string) ([]
 	models := make([]domain.ModelSpec, 0, len(tags.Models))
 	for _, m := range tags.Models {
 		role := inferRole(m.Name, m.Details.Family)
-		models = append(models, domain.ModelSpec{
-			ID:       m.Name,
-			Name:     m.Name,
-			Provider: "ollama",
-			Role:     role,
-			Size:     m.Details.ParameterSize,
-			BaseURL:  baseURL,
-			IsLocal:  true,
-		})
+		spec := domain.ModelSpec{
+			ID:           m.Name,
+			Name:         m.Name,
+			Provider:     "ollama",
+			Role:         role,
+			Size:         m.Details.ParameterSize,
+			BaseURL:      baseURL,
+			IsLocal:      true,
+			Quantization: m.Details.QuantizationLevel,
+			Family:       m.Details.Family,
+		}
+		if err := d.showOllama(ctx, baseURL, &spec); err != nil {
+			d.logger.Debug("ollama model details unavailable", "model", m.Name, "error", err)
+		}
+		models = append(models, spec)
 	}
 
 	d.logger.Info("discovered ollama models", "count", len(models), "base_url", baseURL)
 	return models, nil
 }
 
+// showOllama fills context window, quantization, size and capabilities of
+// spec from /api/show.
+func (d *ModelDiscovery) showOllama(ctx context.Context, baseURL string, spec *domain.ModelSpec) error {
+	body, err := json.Marshal(map[string]string{"model": spec.ID})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", baseURL+"/api/show", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("ollama show returned %d", resp.StatusCode)
+	}
+	var show ollamaShowResponse
+	if err := json.NewDecoder(resp.Body).Decode(&show); err != nil {
+		return fmt.Errorf("decode ollama show: %w", err)
+	}
+	applyOllamaShow(spec, show)
+	return nil
+}
+
+// applyOllamaShow copies /api/show metadata onto spec.
+func applyOllamaShow(spec *domain.ModelSpec, show ollamaShowResponse) {
+	if show.Details.ParameterSize != "" {
+		spec.Size = show.Details.ParameterSize
+	}
+	if show.Details.QuantizationLevel != "" {
+		spec.Quantization = show.Details.QuantizationLevel
+	}
+	if show.Details.Family != "" {
+		spec.Family = show.Details.Family
+	}
+	for key, v := range show.ModelInfo {
+		if n, ok := v.(float64); ok && strings.HasSuffix(key, ".context_length") {
+			spec.MaxContextTokens = int(n)
+		}
+	}
+	for _, line := range strings.Split(show.Parameters, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "num_ctx" {
+			if n, err := strconv.Atoi(fields[1]); err == nil && n > 0 {
+				spec.ContextTokens = n
+			}
+		}
+	}
+	for _, c := range show.Capabilities {
+		switch c {
+		case "vision":
+			spec.Vision = true
+		case "tools":
+			spec.Tools = true
+		case "embedding":
+			spec.Embedding = true
+		}
+	}
+}
+
 // DiscoverLiteLLM queries a LiteLLM proxy for available models via OpenAI-compatible /v1/models.
 func (d *ModelDiscovery) DiscoverLiteLLM(ctx context.Context, baseURL string, apiKey string) ([]domain.ModelSpec, error) {
 	if baseURL == "" {
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverOllama_ReadsModelDetails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			fmt.Fprint(w, `{"models":[{"name":"llava:7b","details":{"parameter_size":"7B","family":"llama"}},{"name":"nomic-embed-text:latest"}]}`)
+		case "/api/show":
+			var body struct{ Model string }
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			if body.Model == "llava:7b" {
+				fmt.Fprint(w, `{"parameters":"stop \"</s>\"\nnum_ctx 8192","details":{"quantization_level":"Q4_0"},"model_info":{"general.architecture":"llama","llama.context_length":32768},"capabilities":["completion","vision"]}`)
+				return
+			}
+			fmt.Fprint(w, `{"model_info":{"nomic-bert.context_length":2048},"capabilities":["embedding"]}`)
+		}
+	}))
+	defer srv.Close()
+
+	models, err := NewModelDiscovery(slog.New(slog.NewTextHandler(os.Stdout, nil))).DiscoverOllama(context.Background(), srv.URL)
+	require.NoError(t, err)
+	require.Len(t, models, 2)
+	llava := models[0]
+	assert.Equal(t, 8192, llava.ContextTokens)
+	assert.Equal(t, 32768, llava.MaxContextTokens)
+	assert.Equal(t, "Q4_0", llava.Quantization)
+	assert.Equal(t, "7B", llava.Size)
+	assert.True(t, llava.Vision)
+	assert.False(t, llava.Tools)
+	assert.True(t, models[1].Embedding)
+	assert.Equal(t, 2048, models[1].MaxContextTokens)
+}
+
+func TestModelRouter_ContextWindow(t *testing.T) {
+	r := NewModelRouter(slog.New(slog.NewTextHandler(os.Stdout, nil)), nil)
+	r.SetCatalog([]domain.ModelSpec{
+		{ID: "qwen2.5:latest", IsLocal: true, MaxContextTokens: 32768},
+		{ID: "tiny:1b", IsLocal: true, MaxContextTokens: 2048},
+		{ID: "big:7b", IsLocal: true, ContextTokens: 16384, MaxContextTokens: 32768},
+		{ID: "gpt-4o", MaxContextTokens: 128000},
+	})
+
+	assert.Equal(t, 4096, r.ContextWindow("qwen2.5", 4096), "local models run with the server default")
+	assert.Equal(t, 4096, r.ContextWindow("", 4096), "empty means the general role default")
+	assert.Equal(t, 2048, r.ContextWindow("tiny:1b", 4096), "capped at the trained window")
+	assert.Equal(t, 16384, r.ContextWindow("big:7b", 4096), "num_ctx wins")
+	assert.Equal(t, 128000, r.ContextWindow("gpt-4o", 4096))
+	assert.Equal(t, 4096, r.ContextWindow("unknown", 4096))
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// DefaultModelStoreReserve is the free space a pull must leave on the disk
+// holding the model store.
+const DefaultModelStoreReserve = 1 << 30
+
+// ErrNoModelServer is returned when no local model server is configured.
+var ErrNoModelServer = errors.New("no local model server configured")
+
+// ModelInstaller downloads models into the local Ollama on request. Before
+// the pull and whenever a new layer shows up it checks that the remaining
+// bytes fit on the disk holding the model store, leaving a reserve.
+type ModelInstaller struct {
+	logger    *slog.Logger
+	host      ports.HostStatsReader // nil or no modelsDir = no storage checks
+	modelsDir string
+	reserve   uint64
+
+	mu          sync.Mutex
+	puller      ports.ModelPuller
+	active      map[string]bool
+	onInstalled func(ctx context.Context, model string)
+}
+
+// NewModelInstaller checks storage of modelsDir, where Ollama keeps its
+// models; leave it empty when Ollama runs on another host.
+func NewModelInstaller(logger *slog.Logger, puller ports.ModelPuller, host ports.HostStatsReader, modelsDir string) *ModelInstaller {
+	return &ModelInstaller{
+		logger:    logger,
+		host:      host,
+		modelsDir: modelsDir,
+		reserve:   DefaultModelStoreReserve,
+		puller:    puller,
+		active:    make(map[string]bool),
+	}
+}
+
+// UpdatePuller hot-swaps the model server client (called on settings change).
+func (m *ModelInstaller) UpdatePuller(p ports.ModelPuller) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.puller = p
+}
+
+// SetOnInstalled registers a callback run after each successful pull, e.g.
+// to refresh the model catalog.
+func (m *ModelInstaller) SetOnInstalled(fn func(ctx context.Context, model string)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onInstalled = fn
+}
+
+// Available reports whether pulls can run.
+func (m *ModelInstaller) Available() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.puller != nil
+}
+
+// Pull downloads model, reporting progress with Percent filled in. It fails
+// with domain.ErrModelPullRunning when the model is already being pulled
+// and with domain.ErrInsufficientStorage when it would not fit.
+func (m *ModelInstaller) Pull(ctx context.Context, model string, progress func(domain.ModelPullProgress)) error {
+	model = strings.TrimSpace(model)
+	if model == "" {
+		return fmt.Errorf("model is required")
+	}
+	m.mu.Lock()
+	puller, onInstalled := m.puller, m.onInstalled
+	if puller == nil {
+		m.mu.Unlock()
+		return ErrNoModelServer
+	}
+	if m.active[model] {
+		m.mu.Unlock()
+		return domain.ErrModelPullRunning
+	}
+	m.active[model] = true
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		delete(m.active, model)
+		m.mu.Unlock()
+	}()
+
+	if err := m.checkStorage(0); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	type layer struct{ total, completed int64 }
+	layers := make(map[string]*layer)
+	err := puller.Pull(ctx, model, func(p domain.ModelPullProgress) {
+		if p.Digest != "" && p.Total > 0 {
+			l, seen := layers[p.Digest]
+			if !seen {
+				l = &layer{}
+				layers[p.Digest] = l
+			}
+			l.total, l.completed = p.Total, p.Completed
+			if !seen {
+				var remaining int64
+				for _, l := range layers {
+					remaining += l.total - l.completed
+				}
+				if err := m.checkStorage(remaining); err != nil {
+					cancel(err)
+					return
+				}
+			}
+		}
+		var total, completed int64
+		for _, l := range layers {
+			total += l.total
+			completed += l.completed
+		}
+		if total > 0 {
+			p.Percent = float64(completed) * 100 / float64(total)
+		}
+		if p.Status == "success" {
+			p.Percent = 100
+		}
+		if progress != nil {
+			progress(p)
+		}
+	})
+	if cause := context.Cause(ctx); errors.Is(cause, domain.ErrInsufficientStorage) {
+		err = cause
+	}
+	if err != nil {
+		m.logger.Warn("model pull failed", "model", model, "error", err)
+		return err
+	}
+	m.logger.Info("model pulled", "model", model)
+	if onInstalled != nil {
+		onInstalled(ctx, model)
+	}
+	return nil
+}
+
+// checkStorage fails when need bytes plus the reserve do not fit on the
+// model store disk. Unknown disk usage passes.
+func (m *ModelInstaller) checkStorage(need int64) error {
+	if m.host == nil || m.modelsDir == "" {
+		return nil
+	}
+	disk, err := m.host.Disk(m.modelsDir)
+	if err != nil {
+		m.logger.Debug("model store disk usage unavailable", "dir", m.modelsDir, "error", err)
+		return nil
+	}
+	if need < 0 {
+		need = 0
+	}
+	if disk.FreeBytes < uint64(need)+m.reserve {
+		return fmt.Errorf("%w: %s free, %s needed plus %s reserve",
+			domain.ErrInsufficientStorage, formatBytes(disk.FreeBytes), formatBytes(uint64(need)), formatBytes(m.reserve))
+	}
+	return nil
+}
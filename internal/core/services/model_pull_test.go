@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePuller struct {
+	reports []domain.ModelPullProgress
+}
+
+func (f *fakePuller) Pull(ctx context.Context, model string, progress func(domain.ModelPullProgress)) error {
+	for _, p := range f.reports {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		p.Model = model
+		progress(p)
+	}
+	return nil
+}
+
+func TestModelInstaller_ReportsPercentAndRefreshes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	puller := &fakePuller{reports: []domain.ModelPullProgress{
+		{Status: "pulling manifest"},
+		{Status: "downloading", Digest: "a", Total: 1000, Completed: 500},
+		{Status: "downloading", Digest: "b", Total: 1000, Completed: 0},
+		{Status: "success"},
+	}}
+	m := NewModelInstaller(logger, puller, &fakeHostStats{}, "/models")
+	m.reserve = 0
+	var installed string
+	m.SetOnInstalled(func(_ context.Context, model string) { installed = model })
+
+	var got []domain.ModelPullProgress
+	require.NoError(t, m.Pull(context.Background(), "llama3.2", func(p domain.ModelPullProgress) { got = append(got, p) }))
+	require.Len(t, got, 4)
+	assert.Equal(t, 50.0, got[1].Percent)
+	assert.Equal(t, 25.0, got[2].Percent)
+	assert.Equal(t, 100.0, got[3].Percent)
+	assert.Equal(t, "llama3.2", installed)
+}
+
+func TestModelInstaller_StopsWhenModelDoesNotFit(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	puller := &fakePuller{reports: []domain.ModelPullProgress{
+		{Status: "downloading", Digest: "a", Total: 4096},
+		{Status: "downloading", Digest: "a", Total: 4096, Completed: 4096},
+		{Status: "success"},
+	}}
+	// fakeHostStats reports 2048 free bytes
+	m := NewModelInstaller(logger, puller, &fakeHostStats{}, "/models")
+	m.reserve = 0
+
+	err := m.Pull(context.Background(), "big", nil)
+	assert.ErrorIs(t, err, domain.ErrInsufficientStorage)
+
+	m.reserve = DefaultModelStoreReserve
+	assert.ErrorIs(t, m.Pull(context.Background(), "any", nil), domain.ErrInsufficientStorage, "reserve is checked up front")
+
+	assert.ErrorIs(t, NewModelInstaller(logger, nil, nil, "").Pull(context.Background(), "x", nil), ErrNoModelServer)
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"strings"
 	"sync"
@@ -18,13 +19,18 @@ import (
 type ModelRouter struct {
 	logger   *slog.Logger
 	mu       sync.RWMutex
-	provider domain.LLMProvider // The single provider that handles all requests
+	provider domain.LLMProvider       // The single provider that handles all requests
+	embedder domain.EmbeddingProvider // Configured separately; nil until wired
 
 	// roleDefaults maps ModelRole → preferred model ID
 	roleDefaults map[domain.ModelRole]string
 
 	// catalog of available models (populated by discovery)
-	catalog []domain.ModelSpec
+	catalog    []domain.ModelSpec
+	discovered bool // catalog lists installed models, not just recommendations
+
+	systemChat *SystemChat     // optional: warns about missing override models
+	warned     map[string]bool // persona ID + model already reported
 }
 
 // NewModelRouter creates a router with the given base LLM provider.
@@ -39,26 +45,75 @@ func NewModelRouter(logger *slog.Logger, provider domain.LLMProvider) *ModelRout
 			domain.ModelRoleFast:     "llama3.2:latest",
 		},
 		catalog: domain.RecommendedLocalModels(),
+		warned:  make(map[string]bool),
 	}
 }
 
+// SetSystemChat makes missing persona models show up in the kernel inbox.
+func (r *ModelRouter) SetSystemChat(sc *SystemChat) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.systemChat = sc
+}
+
 // ResolveModel picks the best model ID for a sub-agent task.
 // Priority: persona.ModelOverride > role default > empty (use provider default).
+// An override that is no longer installed falls back to the role default,
+// with a one-time warning in the kernel inbox.
 func (r *ModelRouter) ResolveModel(persona *domain.Persona, role domain.ModelRole) string {
 	// 1. Persona-level override wins
 	if persona != nil && persona.ModelOverride != "" {
-		return persona.ModelOverride
+		if err := r.CheckModel(persona.ModelOverride); err == nil {
+			return persona.ModelOverride
+		}
+		r.warnMissingOverride(persona, r.roleDefault(role))
 	}
+	return r.roleDefault(role)
+}
 
-	// 2. Role-based default
+// roleDefault returns the role's default model, or "" for the provider default.
+func (r *ModelRouter) roleDefault(role domain.ModelRole) string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	if m, ok := r.roleDefaults[role]; ok {
-		return m
+	return r.roleDefaults[role]
+}
+
+// CheckModel returns domain.ErrModelNotInstalled when modelID is missing
+// from the discovered catalog. Before discovery every model passes.
+func (r *ModelRouter) CheckModel(modelID string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if !r.discovered || modelID == "" {
+		return nil
+	}
+	for _, m := range r.catalog {
+		if domain.SameModel(m.ID, modelID) {
+			return nil
+		}
 	}
+	return fmt.Errorf("%w: %s", domain.ErrModelNotInstalled, modelID)
+}
 
-	// 3. Fallback — empty means use provider default
-	return ""
+func (r *ModelRouter) warnMissingOverride(persona *domain.Persona, fallback string) {
+	key := string(persona.ID) + "\x00" + persona.ModelOverride
+	r.mu.Lock()
+	first := !r.warned[key]
+	r.warned[key] = true
+	sc := r.systemChat
+	r.mu.Unlock()
+	if !first {
+		return
+	}
+	if fallback == "" {
+		fallback = "the provider default"
+	}
+	r.logger.Warn("persona model override not installed, falling back",
+		"persona", persona.Name, "model", persona.ModelOverride, "fallback", fallback)
+	if sc != nil {
+		sc.NotifyModelWarning(context.Background(), fmt.Sprintf(
+			"Persona **%s** uses model `%s`, which is not installed; using %s instead. Pull the model or change the persona's model override.",
+			persona.Name, persona.ModelOverride, fallback))
+	}
 }
 
 // GenerateText delegates to the underlying provider with an optional model override.
@@ -67,6 +122,41 @@ func (r *ModelRouter) GenerateText(ctx context.Context, prompt string, modelID s
 	return r.provider.GenerateTextWithModel(ctx, prompt, modelID)
 }
 
+// GenerateTextStream streams from the underlying provider with an optional model override.
+func (r *ModelRouter) GenerateTextStream(ctx context.Context, prompt string, modelID string) (<-chan domain.Chunk, error) {
+	r.logger.Debug("model router streaming text", "model", modelID)
+	return r.provider.GenerateTextStreamWithModel(ctx, prompt, modelID)
+}
+
+// ContextWindow returns the prompt token budget of a model from its catalog
+// metadata (see domain.ModelSpec.ContextWindow); unknown models get fallback.
+// An empty modelID means the general role default the provider falls back to.
+func (r *ModelRouter) ContextWindow(modelID string, fallback int) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if modelID == "" {
+		modelID = r.roleDefaults[domain.ModelRoleGeneral]
+	}
+	for _, m := range r.catalog {
+		if domain.SameModel(m.ID, modelID) {
+			return m.ContextWindow(fallback)
+		}
+	}
+	return fallback
+}
+
+// CostUSD prices a call on modelID from the catalog; unknown models cost 0.
+func (r *ModelRouter) CostUSD(modelID string, promptTokens, completionTokens int) float64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, m := range r.catalog {
+		if domain.SameModel(m.ID, modelID) {
+			return m.CostUSD(promptTokens, completionTokens)
+		}
+	}
+	return 0
+}
+
 // UpdateProvider hot-swaps the underlying LLM provider (called on settings change).
 func (r *ModelRouter) UpdateProvider(p domain.LLMProvider) {
 	r.mu.Lock()
@@ -74,6 +164,35 @@ func (r *ModelRouter) UpdateProvider(p domain.LLMProvider) {
 	r.provider = p
 }
 
+// UpdateEmbedder hot-swaps the embedding provider (called on settings change).
+func (r *ModelRouter) UpdateEmbedder(e domain.EmbeddingProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.embedder = e
+}
+
+// Embed returns one vector per text using the configured embedding provider.
+func (r *ModelRouter) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	r.mu.RLock()
+	e := r.embedder
+	r.mu.RUnlock()
+	if e == nil {
+		return nil, domain.ErrNoEmbeddingProvider
+	}
+	r.logger.Debug("model router embedding", "model", e.Model(), "texts", len(texts))
+	return e.Embed(ctx, texts)
+}
+
+// EmbeddingModel returns the active embedding model, or "" when none is configured.
+func (r *ModelRouter) EmbeddingModel() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.embedder == nil {
+		return ""
+	}
+	return r.embedder.Model()
+}
+
 // SetRoleDefault overrides the default model for a role.
 func (r *ModelRouter) SetRoleDefault(role domain.ModelRole, modelID string) {
 	r.mu.Lock()
@@ -86,6 +205,8 @@ func (r *ModelRouter) SetCatalog(models []domain.ModelSpec) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.catalog = models
+	r.discovered = true
+	r.warned = make(map[string]bool) // report models that disappear again
 }
 
 // GetCatalog returns the current model catalog.
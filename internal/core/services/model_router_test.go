@@ -0,0 +1,27 @@
+package services
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelRouter_MissingOverrideFallsBack(t *testing.T) {
+	r := NewModelRouter(slog.New(slog.NewTextHandler(os.Stdout, nil)), nil)
+	persona := &domain.Persona{ID: "pers-1", Name: "Coder", ModelOverride: "deepseek-coder:6.7b"}
+
+	assert.NoError(t, r.CheckModel("deepseek-coder:6.7b"), "nothing is checked before discovery")
+	assert.Equal(t, "deepseek-coder:6.7b", r.ResolveModel(persona, domain.ModelRoleCode))
+
+	r.SetCatalog([]domain.ModelSpec{{ID: "qwen2.5:latest"}, {ID: "deepseek-coder:6.7b"}})
+	assert.NoError(t, r.CheckModel("qwen2.5"))
+	assert.Equal(t, "deepseek-coder:6.7b", r.ResolveModel(persona, domain.ModelRoleCode))
+
+	r.SetCatalog([]domain.ModelSpec{{ID: "qwen2.5:latest"}})
+	assert.ErrorIs(t, r.CheckModel("deepseek-coder:6.7b"), domain.ErrModelNotInstalled)
+	assert.Equal(t, "qwen2.5:latest", r.ResolveModel(persona, domain.ModelRoleCode))
+	assert.True(t, r.warned["pers-1\x00deepseek-coder:6.7b"])
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// ModelWarmup preloads local models at startup and pings them at half their
+// keep-alive, so they stay in memory between requests.
+type ModelWarmup struct {
+	logger *slog.Logger
+	now    func() time.Time
+
+	mu        sync.Mutex
+	preloader ports.ModelPreloader // nil = no local models to warm
+	policy    domain.ModelWarmup
+	defaults  []string // warmed when the policy lists no models
+	status    map[string]*domain.ModelWarmStatus
+	reload    chan struct{}
+}
+
+// NewModelWarmup creates the service; it does nothing until Run.
+func NewModelWarmup(logger *slog.Logger, preloader ports.ModelPreloader) *ModelWarmup {
+	return &ModelWarmup{
+		logger:    logger,
+		preloader: preloader,
+		now:       time.Now,
+		status:    make(map[string]*domain.ModelWarmStatus),
+		reload:    make(chan struct{}, 1),
+	}
+}
+
+// UpdatePreloader hot-swaps the inference server client (called on settings
+// change); nil stops warming.
+func (w *ModelWarmup) UpdatePreloader(p ports.ModelPreloader) {
+	w.mu.Lock()
+	w.preloader = p
+	w.mu.Unlock()
+	w.wake()
+}
+
+// SetPolicy replaces the warm-up settings and the default models, and warms
+// the resulting set right away.
+func (w *ModelWarmup) SetPolicy(policy domain.ModelWarmup, defaults []string) {
+	w.mu.Lock()
+	w.policy = policy
+	w.defaults = append([]string(nil), defaults...)
+	w.mu.Unlock()
+	w.wake()
+}
+
+func (w *ModelWarmup) wake() {
+	select {
+	case w.reload <- struct{}{}:
+	default:
+	}
+}
+
+// Models returns the models currently kept warm.
+func (w *ModelWarmup) Models() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.modelsLocked()
+}
+
+func (w *ModelWarmup) modelsLocked() []string {
+	if w.policy.Disabled {
+		return nil
+	}
+	src := w.policy.Models
+	if len(src) == 0 {
+		src = w.defaults
+	}
+	seen := make(map[string]bool, len(src))
+	models := make([]string, 0, len(src))
+	for _, m := range src {
+		if m == "" || seen[m] {
+			continue
+		}
+		seen[m] = true
+		models = append(models, m)
+	}
+	return models
+}
+
+// Status reports every warmed model, sorted by name.
+func (w *ModelWarmup) Status() []domain.ModelWarmStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	out := make([]domain.ModelWarmStatus, 0, len(w.status))
+	for _, st := range w.status {
+		out = append(out, *st)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Model < out[j].Model })
+	return out
+}
+
+// StatusOf reports one model, if it is warmed.
+func (w *ModelWarmup) StatusOf(model string) (domain.ModelWarmStatus, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	st, ok := w.status[model]
+	if !ok {
+		return domain.ModelWarmStatus{}, false
+	}
+	return *st, true
+}
+
+// Run warms the models now and then every half keep-alive, and again
+// whenever the policy changes, until ctx is cancelled.
+func (w *ModelWarmup) Run(ctx context.Context) error {
+	ctx = domain.WithSubsystem(ctx, "model_warmup")
+	w.logger.Info("model warm-up started")
+	for {
+		w.WarmAll(ctx)
+		w.mu.Lock()
+		interval := w.policy.KeepAlive() / 2
+		w.mu.Unlock()
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-w.reload:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// WarmAll preloads or pings every configured model once. Models no longer
+// configured are dropped from the status.
+func (w *ModelWarmup) WarmAll(ctx context.Context) {
+	w.mu.Lock()
+	preloader := w.preloader
+	models := w.modelsLocked()
+	if preloader == nil {
+		models = nil
+	}
+	keepAlive := w.policy.KeepAlive()
+	wanted := make(map[string]bool, len(models))
+	for _, m := range models {
+		wanted[m] = true
+	}
+	for m := range w.status {
+		if !wanted[m] {
+			delete(w.status, m)
+		}
+	}
+	w.mu.Unlock()
+
+	for _, m := range models {
+		if ctx.Err() != nil {
+			return
+		}
+		w.warm(ctx, preloader, m, keepAlive)
+	}
+}
+
+func (w *ModelWarmup) warm(ctx context.Context, preloader ports.ModelPreloader, model string, keepAlive time.Duration) {
+	w.mu.Lock()
+	st, ok := w.status[model]
+	if !ok {
+		st = &domain.ModelWarmStatus{Model: model}
+		w.status[model] = st
+	}
+	start := w.now()
+	cold := st.State != domain.ModelWarmReady || start.After(st.ExpiresAt)
+	if cold {
+		st.State = domain.ModelWarmLoading
+	}
+	w.mu.Unlock()
+
+	err := preloader.Preload(ctx, model, keepAlive)
+	end := w.now()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.status[model] != st {
+		return // dropped by a policy change meanwhile
+	}
+	if err != nil && ctx.Err() != nil {
+		return // shutting down; keep the last known state
+	}
+	if err != nil {
+		st.State = domain.ModelWarmFailed
+		st.Error = err.Error()
+		w.logger.Warn("model warm-up failed", "model", model, "error", err)
+		return
+	}
+	if cold {
+		st.LoadedAt = end
+		st.LoadMillis = end.Sub(start).Milliseconds()
+		w.logger.Info("model preloaded", "model", model, "load_ms", st.LoadMillis)
+	}
+	st.State = domain.ModelWarmReady
+	st.Error = ""
+	st.LastPingAt = end
+	st.ExpiresAt = end.Add(keepAlive)
+}
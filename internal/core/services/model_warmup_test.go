@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePreloader struct {
+	calls     []string
+	keepAlive time.Duration
+	fail      map[string]error
+}
+
+func (f *fakePreloader) Preload(_ context.Context, model string, keepAlive time.Duration) error {
+	f.calls = append(f.calls, model)
+	f.keepAlive = keepAlive
+	return f.fail[model]
+}
+
+func TestModelWarmup_WarmsDefaultsAndTracksLoads(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	pre := &fakePreloader{fail: map[string]error{"nomic-embed-text": errors.New("model not found")}}
+	w := NewModelWarmup(logger, pre)
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return clock }
+
+	w.SetPolicy(domain.ModelWarmup{}, []string{"qwen2.5:latest", "nomic-embed-text", "qwen2.5:latest"})
+	w.WarmAll(context.Background())
+
+	assert.Equal(t, []string{"qwen2.5:latest", "nomic-embed-text"}, pre.calls)
+	assert.Equal(t, domain.DefaultModelKeepAlive, pre.keepAlive)
+	st, ok := w.StatusOf("qwen2.5:latest")
+	require.True(t, ok)
+	assert.Equal(t, domain.ModelWarmReady, st.State)
+	assert.Equal(t, clock, st.LoadedAt)
+	assert.Equal(t, clock.Add(30*time.Minute), st.ExpiresAt)
+	failed, _ := w.StatusOf("nomic-embed-text")
+	assert.Equal(t, domain.ModelWarmFailed, failed.State)
+	assert.Contains(t, failed.Error, "not found")
+
+	// A ping before expiry refreshes the keep-alive without counting as a load
+	clock = clock.Add(15 * time.Minute)
+	w.WarmAll(context.Background())
+	st, _ = w.StatusOf("qwen2.5:latest")
+	assert.Equal(t, clock.Add(-15*time.Minute), st.LoadedAt)
+	assert.Equal(t, clock, st.LastPingAt)
+}
+
+func TestModelWarmup_PolicyOverridesAndDisables(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	pre := &fakePreloader{}
+	w := NewModelWarmup(logger, pre)
+
+	w.SetPolicy(domain.ModelWarmup{}, []string{"qwen2.5:latest"})
+	w.WarmAll(context.Background())
+	w.SetPolicy(domain.ModelWarmup{Models: []string{"llama3.2:latest"}, KeepAliveMinutes: 10}, []string{"qwen2.5:latest"})
+	w.WarmAll(context.Background())
+
+	assert.Equal(t, 10*time.Minute, pre.keepAlive)
+	status := w.Status()
+	require.Len(t, status, 1, "models dropped from the policy leave the status")
+	assert.Equal(t, "llama3.2:latest", status[0].Model)
+
+	w.SetPolicy(domain.ModelWarmup{Disabled: true}, []string{"qwen2.5:latest"})
+	w.WarmAll(context.Background())
+	assert.Empty(t, w.Status())
+	assert.Len(t, pre.calls, 2)
+}
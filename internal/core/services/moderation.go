@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// ModerationService runs the optional moderation pass over chat input and
+// output. The classifier and the kernel-wide actions can change at runtime;
+// projects override the actions. Every check is a guard span on the trace.
+type ModerationService struct {
+	logger *slog.Logger
+	tracer *TraceCollector
+
+	mu        sync.RWMutex
+	moderator domain.Moderator
+	config    domain.ModerationConfig
+}
+
+func NewModerationService(logger *slog.Logger, tracer *TraceCollector) *ModerationService {
+	return &ModerationService{logger: logger, tracer: tracer}
+}
+
+// Configure replaces the classifier (nil disables moderation) and the
+// kernel-wide actions.
+func (m *ModerationService) Configure(moderator domain.Moderator, cfg domain.ModerationConfig) {
+	m.mu.Lock()
+	m.moderator = moderator
+	m.config = cfg
+	m.mu.Unlock()
+}
+
+// Check classifies text for stage under the project's policy and reports
+// whether a check ran. With no classifier, or the action off, nothing is
+// checked. A classifier error lets the content through; the decision
+// carries the error. A nil service checks nothing.
+func (m *ModerationService) Check(ctx context.Context, stage domain.ModerationStage, text string, project *domain.ModerationPolicy) (domain.ModerationDecision, bool) {
+	if m == nil || strings.TrimSpace(text) == "" {
+		return domain.ModerationDecision{}, false
+	}
+	m.mu.RLock()
+	moderator, cfg := m.moderator, m.config
+	m.mu.RUnlock()
+	action := cfg.Action(stage, project)
+	if moderator == nil || action == domain.ModerationOff {
+		return domain.ModerationDecision{}, false
+	}
+
+	_, spanID := m.tracer.StartSpan(ctx, "moderation."+string(stage), domain.SpanKindGuard, map[string]string{
+		"moderation.stage":  string(stage),
+		"moderation.action": string(action),
+	})
+	d := domain.ModerationDecision{Stage: stage, Action: action}
+	res, err := moderator.Moderate(ctx, text)
+	if err != nil {
+		d.Error = err.Error()
+		m.logger.WarnContext(ctx, "moderation check failed, letting content through", "stage", stage, "error", err)
+		m.tracer.EndSpan(spanID, domain.SpanStatusError, "", err.Error())
+		return d, true
+	}
+	d.Flagged = res.Flagged
+	d.Categories = res.Categories
+	d.Blocked = res.Flagged && action == domain.ModerationBlock
+
+	m.tracer.SetSpanAttribute(spanID, "moderation.flagged", strconv.FormatBool(d.Flagged))
+	m.tracer.SetSpanAttribute(spanID, "moderation.blocked", strconv.FormatBool(d.Blocked))
+	if len(d.Categories) > 0 {
+		m.tracer.SetSpanAttribute(spanID, "moderation.categories", strings.Join(d.Categories, ","))
+	}
+	out, _ := json.Marshal(d)
+	m.tracer.EndSpan(spanID, domain.SpanStatusOK, string(out), "")
+	if d.Flagged {
+		m.logger.InfoContext(ctx, "content flagged by moderation", "stage", stage, "action", action, "categories", d.Categories)
+	}
+	return d, true
+}
+
+// moderationRefusal is the reply shown in place of blocked content, in the
+// language of text.
+func moderationRefusal(d domain.ModerationDecision, text agentStrings) string {
+	reason := ""
+	if len(d.Categories) > 0 {
+		reason = " (" + strings.Join(d.Categories, ", ") + ")"
+	}
+	if d.Stage == domain.ModerationStageOutput {
+		return fmt.Sprintf(text.blockedOutput, reason)
+	}
+	return fmt.Sprintf(text.blockedInput, reason)
+}
+
+// visibleModeration keeps the flagged decisions the user is told about;
+// "log" decisions only go to the trace.
+func visibleModeration(ds []domain.ModerationDecision) []domain.ModerationDecision {
+	var out []domain.ModerationDecision
+	for _, d := range ds {
+		if d.Flagged && (d.Action == domain.ModerationWarn || d.Action == domain.ModerationBlock) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// keywordModerator flags text containing "attack".
+type keywordModerator struct{ err error }
+
+func (m keywordModerator) Moderate(_ context.Context, text string) (domain.ModerationResult, error) {
+	if m.err != nil {
+		return domain.ModerationResult{}, m.err
+	}
+	if strings.Contains(text, "attack") {
+		return domain.ModerationResult{Flagged: true, Categories: []string{"violence"}}, nil
+	}
+	return domain.ModerationResult{}, nil
+}
+
+func TestModerationService_Actions(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tracer := NewTraceCollector(logger, nil, nil)
+	m := NewModerationService(logger, tracer)
+	ctx, traceID, _ := tracer.StartTrace(context.Background(), "chat", nil)
+
+	// No classifier: nothing is checked
+	_, ok := m.Check(ctx, domain.ModerationStageInput, "attack", nil)
+	assert.False(t, ok)
+
+	m.Configure(keywordModerator{}, domain.ModerationConfig{ModerationPolicy: domain.ModerationPolicy{Input: domain.ModerationBlock}})
+	d, ok := m.Check(ctx, domain.ModerationStageInput, "plan an attack", nil)
+	require.True(t, ok)
+	assert.True(t, d.Blocked)
+	assert.Equal(t, []string{"violence"}, d.Categories)
+	assert.Contains(t, moderationRefusal(d, stringsFor(domain.LocaleEnglish)), "blocked by content moderation (violence)")
+
+	// Output has no kernel-wide action; the project turns it on, and can turn input off
+	_, ok = m.Check(ctx, domain.ModerationStageOutput, "attack", nil)
+	assert.False(t, ok)
+	project := &domain.ModerationPolicy{Input: domain.ModerationOff, Output: domain.ModerationWarn}
+	_, ok = m.Check(ctx, domain.ModerationStageInput, "attack", project)
+	assert.False(t, ok)
+	d, ok = m.Check(ctx, domain.ModerationStageOutput, "attack", project)
+	require.True(t, ok)
+	assert.True(t, d.Flagged)
+	assert.False(t, d.Blocked)
+	assert.Len(t, visibleModeration([]domain.ModerationDecision{d, {Flagged: true, Action: domain.ModerationLog}}), 1)
+
+	// Classifier failures let content through
+	m.Configure(keywordModerator{err: errors.New("down")}, domain.ModerationConfig{ModerationPolicy: domain.ModerationPolicy{Input: domain.ModerationBlock}})
+	d, ok = m.Check(ctx, domain.ModerationStageInput, "attack", nil)
+	require.True(t, ok)
+	assert.False(t, d.Blocked)
+	assert.Equal(t, "down", d.Error)
+
+	// Each check is a guard span carrying the decision
+	trace, err := tracer.GetTrace(context.Background(), traceID)
+	require.NoError(t, err)
+	var guards []domain.Span
+	for _, s := range trace.Spans {
+		if s.Kind == domain.SpanKindGuard {
+			guards = append(guards, s)
+		}
+	}
+	require.Len(t, guards, 3)
+	for _, s := range guards {
+		if s.Name == "moderation.input" && s.Status == domain.SpanStatusOK {
+			assert.Equal(t, "true", s.Attributes["moderation.blocked"])
+			assert.Equal(t, "violence", s.Attributes["moderation.categories"])
+		}
+	}
+}
@@ -0,0 +1,449 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// EventTypeNode is published when a muscle node changes reachability.
+const EventTypeNode EventType = "node"
+
+// nodeStore is the persistence subset NodeRegistry needs.
+type nodeStore interface {
+	SaveNode(ctx context.Context, node domain.Node) error
+	ListNodes(ctx context.Context) ([]domain.Node, error)
+	DeleteNode(ctx context.Context, id domain.NodeID) error
+}
+
+// NodeClient is a WorkerManager reachable over the network.
+type NodeClient interface {
+	ports.WorkerManager
+	Ping(ctx context.Context) error
+}
+
+// NodeClientFactory builds a client for a registered node (e.g. remote.NewClient with mTLS).
+type NodeClientFactory func(node domain.Node) NodeClient
+
+// NodeRegistry tracks remote muscle nodes and their health.
+type NodeRegistry struct {
+	logger    *slog.Logger
+	repo      nodeStore
+	eventBus  *EventBus
+	newClient NodeClientFactory
+	interval  time.Duration
+
+	mu      sync.RWMutex
+	nodes   map[domain.NodeID]domain.Node
+	clients map[domain.NodeID]NodeClient
+}
+
+func NewNodeRegistry(logger *slog.Logger, repo nodeStore, eventBus *EventBus, factory NodeClientFactory) *NodeRegistry {
+	return &NodeRegistry{
+		logger:    logger,
+		repo:      repo,
+		eventBus:  eventBus,
+		newClient: factory,
+		interval:  15 * time.Second,
+		nodes:     make(map[domain.NodeID]domain.Node),
+		clients:   make(map[domain.NodeID]NodeClient),
+	}
+}
+
+// Load restores persisted nodes. Status starts as unknown until the first ping.
+func (r *NodeRegistry) Load(ctx context.Context) error {
+	nodes, err := r.repo.ListNodes(ctx)
+	if err != nil {
+		return fmt.Errorf("list nodes: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, n := range nodes {
+		n.Status = domain.NodeStatusUnknown
+		r.nodes[n.ID] = n
+		r.clients[n.ID] = r.newClient(n)
+	}
+	return nil
+}
+
+// Register adds (or replaces) a node and pings it once.
+func (r *NodeRegistry) Register(ctx context.Context, node domain.Node) (domain.Node, error) {
+	if node.URL == "" {
+		return domain.Node{}, fmt.Errorf("node url is required")
+	}
+	if node.ID == "" {
+		node.ID = domain.NewNodeID()
+	}
+	if node.ID == domain.LocalNodeID {
+		return domain.Node{}, fmt.Errorf("node id %q is reserved", domain.LocalNodeID)
+	}
+	if node.Name == "" {
+		node.Name = string(node.ID)
+	}
+	if node.CreatedAt.IsZero() {
+		node.CreatedAt = time.Now()
+	}
+	node.Status = domain.NodeStatusUnknown
+
+	client := r.newClient(node)
+	r.mu.Lock()
+	r.nodes[node.ID] = node
+	r.clients[node.ID] = client
+	r.mu.Unlock()
+
+	node = r.probe(ctx, node.ID)
+	if err := r.repo.SaveNode(ctx, node); err != nil {
+		return domain.Node{}, fmt.Errorf("save node: %w", err)
+	}
+	return node, nil
+}
+
+// Remove forgets a node. Workers already running on it are not killed.
+func (r *NodeRegistry) Remove(ctx context.Context, id domain.NodeID) error {
+	if err := r.repo.DeleteNode(ctx, id); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	delete(r.nodes, id)
+	delete(r.clients, id)
+	r.mu.Unlock()
+	return nil
+}
+
+// List returns all registered remote nodes.
+func (r *NodeRegistry) List() []domain.Node {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]domain.Node, 0, len(r.nodes))
+	for _, n := range r.nodes {
+		out = append(out, n)
+	}
+	return out
+}
+
+// Client returns the client for a node, if registered.
+func (r *NodeRegistry) Client(id domain.NodeID) (NodeClient, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.clients[id]
+	return c, ok
+}
+
+// Run pings every node periodically until ctx is cancelled.
+func (r *NodeRegistry) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	r.probeAll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.probeAll(ctx)
+		}
+	}
+}
+
+func (r *NodeRegistry) probeAll(ctx context.Context) {
+	for _, n := range r.List() {
+		before := n.Status
+		after := r.probe(ctx, n.ID)
+		if after.Status != before {
+			if err := r.repo.SaveNode(ctx, after); err != nil {
+				r.logger.Warn("failed to persist node status", "node_id", n.ID, "error", err)
+			}
+		}
+	}
+}
+
+// probe pings a node, updates its status and publishes a node event on change.
+func (r *NodeRegistry) probe(ctx context.Context, id domain.NodeID) domain.Node {
+	client, ok := r.Client(id)
+	if !ok {
+		return domain.Node{}
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	err := client.Ping(pingCtx)
+	cancel()
+
+	r.mu.Lock()
+	node := r.nodes[id]
+	prev := node.Status
+	if err != nil {
+		node.Status = domain.NodeStatusOffline
+	} else {
+		now := time.Now()
+		node.Status = domain.NodeStatusOnline
+		node.LastSeen = &now
+	}
+	r.nodes[id] = node
+	r.mu.Unlock()
+
+	if node.Status != prev {
+		r.logger.Info("node status changed", "node_id", id, "from", prev, "to", node.Status)
+		r.publish(node)
+	}
+	return node
+}
+
+func (r *NodeRegistry) publish(node domain.Node) {
+	if r.eventBus == nil {
+		return
+	}
+	data, _ := json.Marshal(node)
+	r.eventBus.Publish(Event{
+		JobID:     string(node.ID),
+		Type:      EventTypeNode,
+		Data:      string(data),
+		Timestamp: time.Now().Unix(),
+	})
+}
+
+// ParseNodeLabels parses "k=v,k2=v2" into a label map (used for AULE_NODE_LABELS).
+func ParseNodeLabels(s string) map[string]string {
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			continue
+		}
+		labels[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return labels
+}
+
+// FederatedWorkerManager places workers on the local runtime or on a remote
+// node whose labels satisfy WorkerSpec.NodeSelector, and routes follow-up
+// calls (health, kill, logs) to whichever node owns the worker.
+type FederatedWorkerManager struct {
+	logger      *slog.Logger
+	local       ports.WorkerManager
+	localLabels map[string]string
+	registry    *NodeRegistry
+
+	mu     sync.RWMutex
+	owners map[domain.WorkerID]domain.NodeID
+}
+
+// Ensure FederatedWorkerManager implements WorkerManager
+var _ ports.WorkerManager = (*FederatedWorkerManager)(nil)
+
+func NewFederatedWorkerManager(logger *slog.Logger, local ports.WorkerManager, registry *NodeRegistry) *FederatedWorkerManager {
+	return &FederatedWorkerManager{
+		logger:      logger,
+		local:       local,
+		localLabels: ParseNodeLabels(os.Getenv("AULE_NODE_LABELS")),
+		registry:    registry,
+		owners:      make(map[domain.WorkerID]domain.NodeID),
+	}
+}
+
+// NodeOf returns the node a worker was placed on.
+func (f *FederatedWorkerManager) NodeOf(id domain.WorkerID) domain.NodeID {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if n, ok := f.owners[id]; ok {
+		return n
+	}
+	return domain.LocalNodeID
+}
+
+func (f *FederatedWorkerManager) Spawn(ctx context.Context, spec domain.WorkerSpec) (domain.WorkerID, error) {
+	nodeID, err := f.selectNode(spec.NodeSelector)
+	if err != nil {
+		return "", err
+	}
+
+	mgr := f.managerFor(nodeID)
+	if mgr == nil {
+		return "", fmt.Errorf("node %s: %w", nodeID, domain.ErrNodeNotFound)
+	}
+	id, err := mgr.Spawn(ctx, spec)
+	if err != nil {
+		return "", err
+	}
+
+	f.mu.Lock()
+	f.owners[id] = nodeID
+	f.mu.Unlock()
+	if nodeID != domain.LocalNodeID {
+		f.logger.Info("worker placed on remote node", "worker_id", id, "node_id", nodeID)
+	}
+	return id, nil
+}
+
+func (f *FederatedWorkerManager) HealthCheck(ctx context.Context, id domain.WorkerID) (domain.HealthStatus, error) {
+	mgr := f.managerFor(f.NodeOf(id))
+	if mgr == nil {
+		return domain.HealthStatusUnknown, domain.ErrNodeNotFound
+	}
+	return mgr.HealthCheck(ctx, id)
+}
+
+func (f *FederatedWorkerManager) Kill(ctx context.Context, id domain.WorkerID) error {
+	mgr := f.managerFor(f.NodeOf(id))
+	if mgr == nil {
+		return domain.ErrNodeNotFound
+	}
+	if err := mgr.Kill(ctx, id); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	delete(f.owners, id)
+	f.mu.Unlock()
+	return nil
+}
+
+// List merges local workers with those of every online node.
+// Remote workers are tagged with metadata["node_id"].
+func (f *FederatedWorkerManager) List(ctx context.Context) ([]domain.Worker, error) {
+	workers, err := f.local.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if f.registry == nil {
+		return workers, nil
+	}
+	for _, n := range f.registry.List() {
+		if n.Status != domain.NodeStatusOnline {
+			continue
+		}
+		client, ok := f.registry.Client(n.ID)
+		if !ok {
+			continue
+		}
+		remote, err := client.List(ctx)
+		if err != nil {
+			f.logger.Warn("failed to list remote workers", "node_id", n.ID, "error", err)
+			continue
+		}
+		for _, w := range remote {
+			if w.Metadata == nil {
+				w.Metadata = map[string]string{}
+			}
+			w.Metadata["node_id"] = string(n.ID)
+			f.mu.Lock()
+			f.owners[w.ID] = n.ID
+			f.mu.Unlock()
+			workers = append(workers, w)
+		}
+	}
+	return workers, nil
+}
+
+func (f *FederatedWorkerManager) GetLogs(ctx context.Context, id domain.WorkerID) (io.ReadCloser, error) {
+	mgr := f.managerFor(f.NodeOf(id))
+	if mgr == nil {
+		return nil, domain.ErrNodeNotFound
+	}
+	return mgr.GetLogs(ctx, id)
+}
+
+// ReadProgress implements ports.ProgressReader for nodes whose manager supports it.
+func (f *FederatedWorkerManager) ReadProgress(ctx context.Context, id domain.WorkerID) (domain.WorkerProgress, error) {
+	mgr := f.managerFor(f.NodeOf(id))
+	if mgr == nil {
+		return domain.WorkerProgress{}, domain.ErrNodeNotFound
+	}
+	pr, ok := mgr.(ports.ProgressReader)
+	if !ok {
+		return domain.WorkerProgress{}, domain.ErrNoProgress
+	}
+	return pr.ReadProgress(ctx, id)
+}
+
+// TailLogs implements ports.WorkerLogReader for nodes whose manager supports it.
+func (f *FederatedWorkerManager) TailLogs(ctx context.Context, id domain.WorkerID, tail int) ([]domain.WorkerLogLine, error) {
+	mgr := f.managerFor(f.NodeOf(id))
+	if mgr == nil {
+		return nil, domain.ErrNodeNotFound
+	}
+	lr, ok := mgr.(ports.WorkerLogReader)
+	if !ok {
+		return nil, domain.ErrLogTailUnsupported
+	}
+	return lr.TailLogs(ctx, id, tail)
+}
+
+// Inspect implements ports.WorkerInspector for nodes whose manager supports it.
+func (f *FederatedWorkerManager) Inspect(ctx context.Context, id domain.WorkerID) (domain.WorkerInspection, error) {
+	mgr := f.managerFor(f.NodeOf(id))
+	if mgr == nil {
+		return domain.WorkerInspection{}, domain.ErrNodeNotFound
+	}
+	wi, ok := mgr.(ports.WorkerInspector)
+	if !ok {
+		return domain.WorkerInspection{}, domain.ErrInspectUnsupported
+	}
+	return wi.Inspect(ctx, id)
+}
+
+func (f *FederatedWorkerManager) GetWorkerIP(ctx context.Context, id domain.WorkerID) (string, error) {
+	mgr := f.managerFor(f.NodeOf(id))
+	if mgr == nil {
+		return "", domain.ErrNodeNotFound
+	}
+	return mgr.GetWorkerIP(ctx, id)
+}
+
+func (f *FederatedWorkerManager) managerFor(id domain.NodeID) ports.WorkerManager {
+	if id == domain.LocalNodeID {
+		return f.local
+	}
+	if f.registry == nil {
+		return nil
+	}
+	client, ok := f.registry.Client(id)
+	if !ok {
+		return nil
+	}
+	return client
+}
+
+// selectNode picks where a spec runs: local when the selector is empty or
+// matched by the local labels, otherwise the least-loaded matching online node.
+func (f *FederatedWorkerManager) selectNode(selector map[string]string) (domain.NodeID, error) {
+	if len(selector) == 0 {
+		return domain.LocalNodeID, nil
+	}
+	local := domain.Node{ID: domain.LocalNodeID, Labels: f.localLabels}
+	if local.Matches(selector) {
+		return domain.LocalNodeID, nil
+	}
+	if f.registry == nil {
+		return "", domain.ErrNoMatchingNode
+	}
+
+	load := make(map[domain.NodeID]int)
+	f.mu.RLock()
+	for _, n := range f.owners {
+		load[n]++
+	}
+	f.mu.RUnlock()
+
+	var best domain.NodeID
+	bestLoad := -1
+	for _, n := range f.registry.List() {
+		if n.Status != domain.NodeStatusOnline || !n.Matches(selector) {
+			continue
+		}
+		if bestLoad < 0 || load[n.ID] < bestLoad || (load[n.ID] == bestLoad && n.ID < best) {
+			best, bestLoad = n.ID, load[n.ID]
+		}
+	}
+	if best == "" {
+		return "", domain.ErrNoMatchingNode
+	}
+	return best, nil
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNodeStore struct {
+	nodes map[domain.NodeID]domain.Node
+}
+
+func (s *fakeNodeStore) SaveNode(_ context.Context, n domain.Node) error {
+	s.nodes[n.ID] = n
+	return nil
+}
+
+func (s *fakeNodeStore) ListNodes(_ context.Context) ([]domain.Node, error) {
+	var out []domain.Node
+	for _, n := range s.nodes {
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+func (s *fakeNodeStore) DeleteNode(_ context.Context, id domain.NodeID) error {
+	if _, ok := s.nodes[id]; !ok {
+		return domain.ErrNodeNotFound
+	}
+	delete(s.nodes, id)
+	return nil
+}
+
+// fakeWorkerManager records spawns and doubles as a NodeClient.
+type fakeWorkerManager struct {
+	prefix  string
+	down    bool
+	spawned []domain.WorkerSpec
+}
+
+func (m *fakeWorkerManager) Ping(context.Context) error {
+	if m.down {
+		return errors.New("unreachable")
+	}
+	return nil
+}
+
+func (m *fakeWorkerManager) Spawn(_ context.Context, spec domain.WorkerSpec) (domain.WorkerID, error) {
+	m.spawned = append(m.spawned, spec)
+	return domain.WorkerID(m.prefix + "-" + strings.Repeat("w", len(m.spawned))), nil
+}
+
+func (m *fakeWorkerManager) HealthCheck(context.Context, domain.WorkerID) (domain.HealthStatus, error) {
+	return domain.HealthStatusHealthy, nil
+}
+
+func (m *fakeWorkerManager) Kill(context.Context, domain.WorkerID) error { return nil }
+
+func (m *fakeWorkerManager) List(context.Context) ([]domain.Worker, error) { return nil, nil }
+
+func (m *fakeWorkerManager) GetLogs(context.Context, domain.WorkerID) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+func (m *fakeWorkerManager) GetWorkerIP(context.Context, domain.WorkerID) (string, error) {
+	return m.prefix, nil
+}
+
+func TestFederatedWorkerManager_PlacesByLabels(t *testing.T) {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	ctx := context.Background()
+
+	clients := map[string]*fakeWorkerManager{
+		"https://gpu":     {prefix: "gpu"},
+		"https://offline": {prefix: "offline", down: true},
+	}
+	registry := NewNodeRegistry(logger, &fakeNodeStore{nodes: map[domain.NodeID]domain.Node{}}, nil, func(n domain.Node) NodeClient {
+		return clients[n.URL]
+	})
+
+	gpuNode, err := registry.Register(ctx, domain.Node{URL: "https://gpu", Labels: map[string]string{"gpu": "true"}})
+	require.NoError(t, err)
+	assert.Equal(t, domain.NodeStatusOnline, gpuNode.Status)
+
+	offNode, err := registry.Register(ctx, domain.Node{URL: "https://offline", Labels: map[string]string{"region": "eu"}})
+	require.NoError(t, err)
+	assert.Equal(t, domain.NodeStatusOffline, offNode.Status)
+
+	local := &fakeWorkerManager{prefix: "local"}
+	fed := NewFederatedWorkerManager(logger, local, registry)
+
+	// No selector → local
+	id, err := fed.Spawn(ctx, domain.WorkerSpec{Image: "alpine"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.LocalNodeID, fed.NodeOf(id))
+
+	// gpu selector → remote gpu node, follow-up calls routed there
+	id, err = fed.Spawn(ctx, domain.WorkerSpec{Image: "comfy", NodeSelector: map[string]string{"gpu": "true"}})
+	require.NoError(t, err)
+	assert.Equal(t, gpuNode.ID, fed.NodeOf(id))
+	ip, err := fed.GetWorkerIP(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "gpu", ip)
+
+	// Only an offline node matches → no placement
+	_, err = fed.Spawn(ctx, domain.WorkerSpec{Image: "x", NodeSelector: map[string]string{"region": "eu"}})
+	assert.ErrorIs(t, err, domain.ErrNoMatchingNode)
+}
+
+// inspectableWorkerManager adds container inspection to fakeWorkerManager.
+type inspectableWorkerManager struct {
+	fakeWorkerManager
+}
+
+func (m *inspectableWorkerManager) Inspect(_ context.Context, id domain.WorkerID) (domain.WorkerInspection, error) {
+	return domain.WorkerInspection{ContainerID: m.prefix + "/" + string(id), Running: true}, nil
+}
+
+func TestFederatedWorkerManager_Inspect(t *testing.T) {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	ctx := context.Background()
+	registry := NewNodeRegistry(logger, &fakeNodeStore{nodes: map[domain.NodeID]domain.Node{}}, nil, func(domain.Node) NodeClient {
+		return &fakeWorkerManager{prefix: "plain"}
+	})
+	node, err := registry.Register(ctx, domain.Node{URL: "https://plain", Labels: map[string]string{"plain": "true"}})
+	require.NoError(t, err)
+
+	fed := NewFederatedWorkerManager(logger, &inspectableWorkerManager{fakeWorkerManager{prefix: "local"}}, registry)
+	localID, err := fed.Spawn(ctx, domain.WorkerSpec{Image: "alpine"})
+	require.NoError(t, err)
+	info, err := fed.Inspect(ctx, localID)
+	require.NoError(t, err)
+	assert.Equal(t, "local/"+string(localID), info.ContainerID)
+
+	// The remote node's manager cannot inspect
+	remoteID, err := fed.Spawn(ctx, domain.WorkerSpec{Image: "alpine", NodeSelector: map[string]string{"plain": "true"}})
+	require.NoError(t, err)
+	assert.Equal(t, node.ID, fed.NodeOf(remoteID))
+	_, err = fed.Inspect(ctx, remoteID)
+	assert.ErrorIs(t, err, domain.ErrInspectUnsupported)
+}
+
+func TestParseNodeLabels(t *testing.T) {
+	labels := ParseNodeLabels("gpu=true, region = eu,bogus,")
+	assert.Equal(t, map[string]string{"gpu": "true", "region": "eu"}, labels)
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// DefaultObservationMaxBytes is the largest tool observation put in the
+// prompt as is; bigger ones are offloaded.
+const DefaultObservationMaxBytes = 16384
+
+// observationPreviewBytes is how much of an offloaded observation stays in
+// the prompt, split between its head and its tail.
+const observationPreviewBytes = 2048
+
+// observationsDir holds offloaded observations in a project workspace.
+const observationsDir = ".aule/observations"
+
+// ObservationOffloader keeps large tool outputs out of the prompt. An
+// observation over the limit is saved whole — to the project workspace,
+// or to the conversation's scratchpad without a project — and the prompt
+// gets a preview with a reference the agent can read in chunks.
+type ObservationOffloader struct {
+	ws       *WorkspaceManager
+	pad      *Scratchpad
+	maxBytes int
+}
+
+// NewObservationOffloader creates the offloader; maxBytes <= 0 uses
+// DefaultObservationMaxBytes.
+func NewObservationOffloader(ws *WorkspaceManager, pad *Scratchpad, maxBytes int) *ObservationOffloader {
+	if maxBytes <= 0 {
+		maxBytes = DefaultObservationMaxBytes
+	}
+	return &ObservationOffloader{ws: ws, pad: pad, maxBytes: maxBytes}
+}
+
+// Offload returns observation unchanged when it fits, else saves it and
+// returns the preview and reference that replace it. turn (the loop's
+// message ID) and step name the saved copy. If it cannot be saved the
+// observation is cut to fit instead.
+func (o *ObservationOffloader) Offload(ctx context.Context, convID domain.ConversationID, turn domain.MessageID, step int, tool, observation string) string {
+	if o == nil || len(observation) <= o.maxBytes {
+		return observation
+	}
+	name := sanitizeObservationName(fmt.Sprintf("%s-step-%d-%s", turn, step, tool))
+
+	var reference string
+	if pID, ok := GetProjectFromContext(ctx); ok && o.ws != nil {
+		rel := filepath.ToSlash(filepath.Join(observationsDir, string(convID), name+".txt"))
+		path := filepath.Join(o.ws.GetProjectPath(string(pID)), filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err == nil && os.WriteFile(path, []byte(observation), 0644) == nil {
+			reference = fmt.Sprintf(`read_file with {"path": %q, "offset": %d}`, rel, observationPreviewBytes/2)
+		}
+	} else if o.pad != nil && convID != "" {
+		if _, err := o.pad.Write(convID, name, observation, false); err == nil {
+			reference = fmt.Sprintf(`scratchpad_read with {"key": %q, "offset": %d}`, name, observationPreviewBytes/2)
+		}
+	}
+	if reference == "" {
+		cut, _ := truncateOutput(observation, o.maxBytes)
+		return cut
+	}
+
+	head, _ := sliceUTF8(observation, 0, observationPreviewBytes/2)
+	tail := observation[len(observation)-observationPreviewBytes/2:]
+	for len(tail) > 0 && !utf8.RuneStart(tail[0]) {
+		tail = tail[1:]
+	}
+	return fmt.Sprintf("[Output too large for the prompt: %d bytes, %d lines. Saved in full; read the rest with %s and continue from the returned next_offset.]\n%s\n... (%d bytes omitted) ...\n%s",
+		len(observation), strings.Count(observation, "\n")+1, reference,
+		head, len(observation)-len(head)-len(tail), tail)
+}
+
+// sanitizeObservationName makes a tool name safe for a file name or key.
+func sanitizeObservationName(tool string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		}
+		return '_'
+	}, tool)
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObservationOffloader_SmallUnchanged(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	o := NewObservationOffloader(ws, NewScratchpad(), 100)
+	assert.Equal(t, "short", o.Offload(testProjectCtx("proj1"), "conv-1", "msg-1", 1, "exec", "short"))
+
+	var nilOffloader *ObservationOffloader
+	big := strings.Repeat("x", DefaultObservationMaxBytes*2)
+	assert.Equal(t, big, nilOffloader.Offload(context.Background(), "conv-1", "msg-1", 1, "exec", big))
+}
+
+func TestObservationOffloader_ProjectFile(t *testing.T) {
+	ws, tmpDir := testWorkspaceManager(t)
+	o := NewObservationOffloader(ws, NewScratchpad(), 4096)
+	ctx := testProjectCtx("proj1")
+
+	big := "HEAD" + strings.Repeat("line of build output\n", 1000) + "TAIL"
+	got := o.Offload(ctx, "conv-1", "msg-1", 2, "run_build", big)
+	assert.Less(t, len(got), 4096)
+	assert.Contains(t, got, "HEAD")
+	assert.Contains(t, got, "TAIL")
+	assert.Contains(t, got, "read_file")
+
+	rel := ".aule/observations/conv-1/msg-1-step-2-run_build.txt"
+	assert.Contains(t, got, rel)
+	data, err := os.ReadFile(filepath.Join(tmpDir, "projects", "proj1", filepath.FromSlash(rel)))
+	require.NoError(t, err)
+	assert.Equal(t, big, string(data))
+
+	// The saved copy can be paged through with read_file
+	res, err := NewReadFileTool(ws).Execute(ctx, map[string]interface{}{"path": rel, "offset": float64(0), "max_bytes": float64(100)})
+	require.NoError(t, err)
+	chunk := res.(map[string]interface{})
+	assert.Equal(t, big[:100], chunk["content"])
+	assert.Equal(t, len(big), chunk["bytes"])
+	assert.Equal(t, 100, chunk["next_offset"])
+}
+
+func TestObservationOffloader_Scratchpad(t *testing.T) {
+	pad := NewScratchpad()
+	o := NewObservationOffloader(nil, pad, 1000)
+	convID := domain.ConversationID("conv-2")
+
+	big := strings.Repeat("é", 2000)
+	got := o.Offload(context.Background(), convID, "msg-9", 1, "web_fetch", big)
+	assert.Contains(t, got, "scratchpad_read")
+	assert.Contains(t, got, "msg-9-step-1-web_fetch")
+
+	entry, ok := pad.Read(convID, "msg-9-step-1-web_fetch")
+	require.True(t, ok)
+	assert.Equal(t, big, entry.Content)
+}
+
+func TestObservationOffloader_FallbackTruncates(t *testing.T) {
+	o := NewObservationOffloader(nil, nil, 1000)
+	got := o.Offload(context.Background(), "", "msg-1", 1, "exec", strings.Repeat("x", 5000))
+	assert.LessOrEqual(t, len(got), 1200)
+}
+
+func TestReadFileTool_WholeFileWithoutChunkParams(t *testing.T) {
+	ws, tmpDir := testWorkspaceManager(t)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "projects", "proj1"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "projects", "proj1", "a.txt"), []byte("hello"), 0644))
+
+	got, err := NewReadFileTool(ws).Execute(testProjectCtx("proj1"), map[string]interface{}{"path": "a.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, "hello", got)
+
+	got, err = NewReadFileTool(ws).Execute(testProjectCtx("proj1"), map[string]interface{}{"path": "a.txt", "offset": float64(2)})
+	require.NoError(t, err)
+	chunk := got.(map[string]interface{})
+	assert.Equal(t, "llo", chunk["content"])
+	assert.NotContains(t, chunk, "next_offset")
+}
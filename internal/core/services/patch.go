@@ -0,0 +1,276 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// diffContextLines is the number of unchanged lines around each hunk.
+const diffContextLines = 3
+
+// maxDiffCells bounds the line-matching table of UnifiedDiff; past it the
+// changed region is emitted as one delete and one insert.
+const maxDiffCells = 4_000_000
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// UnifiedDiff returns a unified diff turning before into after, labelled
+// a/path and b/path. Line numbers start at startLine (1 for a whole file),
+// so a diff of a snippet applies to the file it came from. Equal inputs
+// give "".
+func UnifiedDiff(path, before, after string, startLine int) string {
+	if before == after {
+		return ""
+	}
+	if startLine < 1 {
+		startLine = 1
+	}
+	ops := diffLines(splitLines(before), splitLines(after))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n+++ b/%s\n", path, path)
+	oldLine, newLine := startLine, startLine
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			oldLine++
+			newLine++
+			continue
+		}
+		// Hunk: back up over leading context, then extend until a run of
+		// more than 2*context unchanged lines (or the end)
+		start := max(i-diffContextLines, 0)
+		for j := start; j < i; j++ {
+			oldLine--
+			newLine--
+		}
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			run := end
+			for run < len(ops) && ops[run].kind == ' ' {
+				run++
+			}
+			if run == len(ops) || run-end > 2*diffContextLines {
+				end = min(end+diffContextLines, len(ops))
+				break
+			}
+			end = run
+		}
+
+		oldCount, newCount := 0, 0
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				oldCount++
+			}
+			if op.kind != '-' {
+				newCount++
+			}
+		}
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(oldLine, oldCount), hunkRange(newLine, newCount))
+		for _, op := range ops[start:end] {
+			b.WriteByte(op.kind)
+			b.WriteString(op.line)
+			if !strings.HasSuffix(op.line, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		oldLine += oldCount
+		newLine += newCount
+		i = end
+	}
+	return b.String()
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return strconv.Itoa(start-1) + ",0"
+	}
+	if count == 1 {
+		return strconv.Itoa(start)
+	}
+	return strconv.Itoa(start) + "," + strconv.Itoa(count)
+}
+
+// splitLines splits s after each newline; the last line may lack one.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines matches a and b line by line (longest common subsequence),
+// after trimming their common prefix and suffix.
+func diffLines(a, b []string) []diffOp {
+	var ops []diffOp
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		ops = append(ops, diffOp{' ', a[pre]})
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+	ma, mb := a[pre:len(a)-suf], b[pre:len(b)-suf]
+
+	if len(ma)*len(mb) > maxDiffCells {
+		for _, l := range ma {
+			ops = append(ops, diffOp{'-', l})
+		}
+		for _, l := range mb {
+			ops = append(ops, diffOp{'+', l})
+		}
+	} else {
+		// lcs[i][j] is the LCS length of ma[i:] and mb[j:]
+		lcs := make([][]int32, len(ma)+1)
+		for i := range lcs {
+			lcs[i] = make([]int32, len(mb)+1)
+		}
+		for i := len(ma) - 1; i >= 0; i-- {
+			for j := len(mb) - 1; j >= 0; j-- {
+				if ma[i] == mb[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(ma) || j < len(mb) {
+			switch {
+			case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+				ops = append(ops, diffOp{' ', ma[i]})
+				i++
+				j++
+			case j < len(mb) && (i == len(ma) || lcs[i][j+1] > lcs[i+1][j]):
+				ops = append(ops, diffOp{'+', mb[j]})
+				j++
+			default:
+				ops = append(ops, diffOp{'-', ma[i]})
+				i++
+			}
+		}
+	}
+
+	for _, l := range a[len(a)-suf:] {
+		ops = append(ops, diffOp{' ', l})
+	}
+	return ops
+}
+
+type patchHunk struct {
+	oldStart int
+	lines    []diffOp
+}
+
+// ApplyPatch applies a unified diff to content. Each hunk must match
+// exactly; it is looked for at its line number first, then anywhere after
+// the previous hunk, so a patch made against a snippet still applies to
+// the whole file.
+func ApplyPatch(content, patch string) (string, error) {
+	hunks, err := parsePatch(patch)
+	if err != nil {
+		return "", err
+	}
+	lines := splitLines(content)
+	var out []string
+	pos := 0 // next line of content not yet copied
+	for n, h := range hunks {
+		var old []string
+		for _, op := range h.lines {
+			if op.kind != '+' {
+				old = append(old, op.line)
+			}
+		}
+		at := -1
+		if want := h.oldStart - 1; want >= pos && matchAt(lines, old, want) {
+			at = want
+		} else {
+			for i := pos; i+len(old) <= len(lines); i++ {
+				if matchAt(lines, old, i) {
+					at = i
+					break
+				}
+			}
+		}
+		if at < 0 {
+			return "", fmt.Errorf("hunk %d does not apply", n+1)
+		}
+		out = append(out, lines[pos:at]...)
+		for _, op := range h.lines {
+			if op.kind != '-' {
+				out = append(out, op.line)
+			}
+		}
+		pos = at + len(old)
+	}
+	out = append(out, lines[pos:]...)
+	return strings.Join(out, ""), nil
+}
+
+func matchAt(lines, want []string, at int) bool {
+	if at < 0 || at+len(want) > len(lines) {
+		return false
+	}
+	for i, l := range want {
+		if lines[at+i] != l {
+			return false
+		}
+	}
+	return true
+}
+
+// parsePatch reads the hunks of a unified diff, ignoring file headers.
+func parsePatch(patch string) ([]patchHunk, error) {
+	var hunks []patchHunk
+	var cur *patchHunk
+	for _, raw := range strings.SplitAfter(patch, "\n") {
+		line := strings.TrimSuffix(raw, "\n")
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			fields := strings.Fields(line)
+			if len(fields) < 3 || !strings.HasPrefix(fields[1], "-") {
+				return nil, fmt.Errorf("malformed hunk header %q", line)
+			}
+			start, _, _ := strings.Cut(fields[1][1:], ",")
+			n, err := strconv.Atoi(start)
+			if err != nil {
+				return nil, fmt.Errorf("malformed hunk header %q", line)
+			}
+			hunks = append(hunks, patchHunk{oldStart: max(n, 1)})
+			cur = &hunks[len(hunks)-1]
+		case cur == nil, strings.HasPrefix(line, "--- "), strings.HasPrefix(line, "+++ "):
+			// preamble and file headers
+		case strings.HasPrefix(line, `\`):
+			// "\ No newline at end of file" applies to the previous line
+			if k := len(cur.lines); k > 0 {
+				cur.lines[k-1].line = strings.TrimSuffix(cur.lines[k-1].line, "\n")
+			}
+		case line == "" && raw == "":
+			// end of patch
+		case line == "":
+			cur.lines = append(cur.lines, diffOp{' ', "\n"}) // context line stripped of its space
+		case line[0] == ' ' || line[0] == '-' || line[0] == '+':
+			cur.lines = append(cur.lines, diffOp{line[0], line[1:] + "\n"})
+		default:
+			return nil, fmt.Errorf("malformed patch line %q", line)
+		}
+	}
+	if len(hunks) == 0 {
+		return nil, fmt.Errorf("patch has no hunks")
+	}
+	return hunks, nil
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func TestUnifiedDiff_RoundTrip(t *testing.T) {
+	var before, after strings.Builder
+	for i := 1; i <= 30; i++ {
+		line := "line " + strings.Repeat("x", i%3) + "\n"
+		before.WriteString(line)
+		switch i {
+		case 5:
+			after.WriteString("changed five\n")
+		case 20:
+			// deleted
+		default:
+			after.WriteString(line)
+		}
+		if i == 25 {
+			after.WriteString("inserted\n")
+		}
+	}
+	patch := UnifiedDiff("f.txt", before.String(), after.String(), 1)
+	assert.True(t, strings.HasPrefix(patch, "--- a/f.txt\n+++ b/f.txt\n@@ -2,7 +2,7 @@\n"), patch)
+	assert.Equal(t, 2, strings.Count(patch, "@@ -"), "far-apart changes get their own hunks")
+
+	got, err := ApplyPatch(before.String(), patch)
+	require.NoError(t, err)
+	assert.Equal(t, after.String(), got)
+
+	assert.Empty(t, UnifiedDiff("f.txt", "same\n", "same\n", 1))
+}
+
+func TestUnifiedDiff_NoNewlineAndSnippetOffset(t *testing.T) {
+	patch := UnifiedDiff("f.txt", "a\nb", "a\nc\n", 1)
+	assert.Contains(t, patch, "-b\n\\ No newline at end of file\n+c\n")
+	got, err := ApplyPatch("a\nb", patch)
+	require.NoError(t, err)
+	assert.Equal(t, "a\nc\n", got)
+
+	// A patch of lines 3-4 of a file applies to the whole file
+	file := "one\ntwo\nthree\nfour\nfive\n"
+	patch = UnifiedDiff("f.txt", "three\nfour\n", "three\nFOUR\n", 3)
+	assert.Contains(t, patch, "@@ -3,2 +3,2 @@")
+	got, err = ApplyPatch(file, patch)
+	require.NoError(t, err)
+	assert.Equal(t, "one\ntwo\nthree\nFOUR\nfive\n", got)
+
+	_, err = ApplyPatch("unrelated\n", patch)
+	assert.Error(t, err)
+}
+
+// ideLLM answers every prompt with a fixed reply.
+type ideLLM struct{ reply string }
+
+func (l ideLLM) GenerateText(ctx context.Context, prompt string) (string, error) {
+	return l.reply, nil
+}
+func (l ideLLM) GenerateTextWithModel(ctx context.Context, prompt, _ string) (string, error) {
+	return l.reply, nil
+}
+func (l ideLLM) GenerateTextStream(ctx context.Context, prompt string) (<-chan domain.Chunk, error) {
+	return nil, nil
+}
+func (l ideLLM) GenerateTextStreamWithModel(ctx context.Context, prompt, _ string) (<-chan domain.Chunk, error) {
+	return nil, nil
+}
+
+func TestIDECompanion_EditReturnsAndAppliesPatch(t *testing.T) {
+	t.Setenv("AULE_WORKSPACE_DIR", t.TempDir())
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ws := NewWorkspaceManager()
+	path := filepath.Join(ws.GetProjectPath("proj-ide"), "main.go")
+	require.NoError(t, os.WriteFile(path, []byte("package main\n\nfunc add(a, b int) int { return a - b }\n"), 0644))
+
+	reply := "```go\npackage main\n\nfunc add(a, b int) int { return a + b }\n```\nExplanation: fixed the operator."
+	ide := NewIDECompanion(logger, NewModelRouter(logger, ideLLM{reply: reply}), ws)
+	project := domain.ProjectID("proj-ide")
+
+	res, err := ide.Edit(context.Background(), domain.IDEEditRequest{ProjectID: &project, Path: "main.go", Instruction: "fix add"})
+	require.NoError(t, err)
+	assert.Contains(t, res.Patch, "-func add(a, b int) int { return a - b }\n+func add(a, b int) int { return a + b }\n")
+	assert.Equal(t, "fixed the operator.", res.Explanation)
+	assert.False(t, res.Applied)
+
+	res, err = ide.Edit(context.Background(), domain.IDEEditRequest{ProjectID: &project, Path: "main.go", Instruction: "fix add", Apply: true})
+	require.NoError(t, err)
+	assert.True(t, res.Applied)
+	data, _ := os.ReadFile(path)
+	assert.Contains(t, string(data), "return a + b")
+
+	_, err = ide.Edit(context.Background(), domain.IDEEditRequest{Path: "main.go"})
+	assert.ErrorIs(t, err, domain.ErrInvalidIDEEdit)
+	_, err = NewIDECompanion(logger, NewModelRouter(logger, ideLLM{reply: "no code"}), ws).
+		Edit(context.Background(), domain.IDEEditRequest{Path: "x.go", Content: "x\n", Instruction: "y"})
+	assert.ErrorIs(t, err, domain.ErrIDENoCode)
+}
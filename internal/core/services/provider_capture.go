@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// SetRecordProviderCalls turns record mode on or off. In record mode the
+// raw requests and responses of LLM provider calls made under a trace are
+// stored, linked to the calling span, so prompt-format problems can be
+// replayed exactly.
+func (tc *TraceCollector) SetRecordProviderCalls(on bool) {
+	tc.mu.Lock()
+	tc.recordCalls = on
+	tc.mu.Unlock()
+}
+
+// RecordingContext returns ctx set up to record provider calls against its
+// current span. Outside record mode, or without a trace, ctx is returned
+// as is.
+func (tc *TraceCollector) RecordingContext(ctx context.Context) context.Context {
+	tc.mu.RLock()
+	on := tc.recordCalls
+	tc.mu.RUnlock()
+	if !on {
+		return ctx
+	}
+	traceID, spanID, ok := TraceFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return domain.WithProviderRecorder(ctx, func(c domain.ProviderCapture) {
+		c.ID = uuid.New().String()
+		c.TraceID, c.SpanID = traceID, spanID
+		tc.addCapture(c)
+	})
+}
+
+// addCapture masks and stores one exchange. Captures are meant to be
+// downloaded and shared, so they are masked even when history is kept as is.
+func (tc *TraceCollector) addCapture(c domain.ProviderCapture) {
+	tc.mu.RLock()
+	redactor := tc.redactor
+	tc.mu.RUnlock()
+	c.URL = redactor.Redact(c.URL)
+	c.Request = redactor.Redact(c.Request)
+	c.Response = redactor.Redact(c.Response)
+	c.Error = redactor.Redact(c.Error)
+
+	tc.mu.Lock()
+	if _, ok := tc.traces[c.TraceID]; ok && tc.repo == nil {
+		tc.captures[c.TraceID] = append(tc.captures[c.TraceID], c)
+	}
+	// The trace view shows which spans have captures to download
+	if span, ok := tc.spans[c.SpanID]; ok {
+		if span.Attributes == nil {
+			span.Attributes = make(map[string]string)
+		}
+		n, _ := strconv.Atoi(span.Attributes["provider.captures"])
+		span.Attributes["provider.captures"] = strconv.Itoa(n + 1)
+	}
+	tc.mu.Unlock()
+
+	if tc.repo != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := tc.repo.SaveProviderCapture(ctx, c); err != nil {
+			tc.logger.Warn("failed to store provider capture", "trace_id", c.TraceID, "error", err)
+		}
+	}
+}
+
+// ListProviderCaptures returns the recorded exchanges of a trace, oldest
+// first. A non-empty spanID keeps only that span's.
+func (tc *TraceCollector) ListProviderCaptures(ctx context.Context, traceID domain.TraceID, spanID domain.SpanID) ([]domain.ProviderCapture, error) {
+	var all []domain.ProviderCapture
+	if tc.repo != nil {
+		var err error
+		if all, err = tc.repo.ListProviderCaptures(ctx, traceID); err != nil {
+			return nil, err
+		}
+	} else {
+		tc.mu.RLock()
+		all = append(all, tc.captures[traceID]...)
+		tc.mu.RUnlock()
+	}
+	out := make([]domain.ProviderCapture, 0, len(all))
+	for _, c := range all {
+		if spanID == "" || c.SpanID == spanID {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+// WrapLLM returns a provider whose calls are recorded in record mode.
+func (tc *TraceCollector) WrapLLM(p domain.LLMProvider) domain.LLMProvider {
+	if tc == nil {
+		return p
+	}
+	return &recordingLLMProvider{tracer: tc, inner: p}
+}
+
+// recordingLLMProvider is the domain.LLMProvider returned by
+// TraceCollector.WrapLLM.
+type recordingLLMProvider struct {
+	tracer *TraceCollector
+	inner  domain.LLMProvider
+}
+
+func (p *recordingLLMProvider) GenerateText(ctx context.Context, prompt string) (string, error) {
+	return p.inner.GenerateText(p.tracer.RecordingContext(ctx), prompt)
+}
+
+func (p *recordingLLMProvider) GenerateTextWithModel(ctx context.Context, prompt string, modelID string) (string, error) {
+	return p.inner.GenerateTextWithModel(p.tracer.RecordingContext(ctx), prompt, modelID)
+}
+
+func (p *recordingLLMProvider) GenerateTextStream(ctx context.Context, prompt string) (<-chan domain.Chunk, error) {
+	return p.inner.GenerateTextStream(p.tracer.RecordingContext(ctx), prompt)
+}
+
+func (p *recordingLLMProvider) GenerateTextStreamWithModel(ctx context.Context, prompt string, modelID string) (<-chan domain.Chunk, error) {
+	return p.inner.GenerateTextStreamWithModel(p.tracer.RecordingContext(ctx), prompt, modelID)
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// exchangeLLM reports every call to the recorder on its context, as the
+// HTTP providers do in record mode.
+type exchangeLLM struct{ countingLLM }
+
+func (l *exchangeLLM) GenerateTextWithModel(ctx context.Context, prompt string, modelID string) (string, error) {
+	out, err := l.countingLLM.GenerateTextWithModel(ctx, prompt, modelID)
+	if rec := domain.ProviderRecorderFrom(ctx); rec != nil {
+		rec(domain.ProviderCapture{Method: "POST", URL: "http://llm/api/generate", StatusCode: 200, Request: prompt, Response: out})
+	}
+	return out, err
+}
+
+func TestTraceCollector_RecordMode(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tc := NewTraceCollector(logger, nil, nil)
+	tc.SetRedactor(NewRedactor())
+	llm := tc.WrapLLM(&exchangeLLM{})
+
+	ctx, traceID, _ := tc.StartTrace(context.Background(), "chat", nil)
+	llmCtx, spanID := tc.StartSpan(ctx, "llm.generate", domain.SpanKindLLM, nil)
+
+	// Off by default
+	_, err := llm.GenerateTextWithModel(llmCtx, "hello", "m")
+	require.NoError(t, err)
+	captures, err := tc.ListProviderCaptures(context.Background(), traceID, "")
+	require.NoError(t, err)
+	assert.Empty(t, captures)
+
+	tc.SetRecordProviderCalls(true)
+	_, err = llm.GenerateTextWithModel(llmCtx, "my key is sk-abcdefghijklmnopqrstuvwxyz", "m")
+	require.NoError(t, err)
+	_, err = llm.GenerateTextWithModel(ctx, "from the root span", "m")
+	require.NoError(t, err)
+
+	captures, err = tc.ListProviderCaptures(context.Background(), traceID, spanID)
+	require.NoError(t, err)
+	require.Len(t, captures, 1)
+	c := captures[0]
+	assert.NotEmpty(t, c.ID)
+	assert.Equal(t, traceID, c.TraceID)
+	assert.Equal(t, "my key is [REDACTED:api_keys]", c.Request)
+	assert.NotContains(t, c.Response, "sk-abcdefghijklmnopqrstuvwxyz")
+
+	all, err := tc.ListProviderCaptures(context.Background(), traceID, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	trace, err := tc.GetTrace(context.Background(), traceID)
+	require.NoError(t, err)
+	for _, s := range trace.Spans {
+		if s.ID == spanID {
+			assert.Equal(t, "1", s.Attributes["provider.captures"])
+		}
+	}
+
+	// No trace, nothing to link to
+	assert.Nil(t, domain.ProviderRecorderFrom(tc.RecordingContext(context.Background())))
+}
@@ -3,17 +3,26 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
 )
 
+// contextHistoryMessages caps how many recent messages are considered for the
+// prompt before token budgeting trims or summarizes them.
+const contextHistoryMessages = 100
+
 // ReActAgentService implements agentic reasoning with tool use
 type ReActAgentService struct {
 	logger   *slog.Logger
@@ -21,17 +30,50 @@ type ReActAgentService struct {
 	router   *ModelRouter
 	tools    *domain.ToolRegistry
 	convs    *ConversationStore
-	repo     personaReader
+	repo     agentRepo
 	ws       *WorkspaceManager
 	tracer   *TraceCollector
+	eventBus *EventBus // optional; receives streamed tokens per conversation
+	budget   *ContextBudgeter
 	maxIters int
+	// contextTokens is the window assumed for models without catalog metadata
+	contextTokens int
+	// autoTitles renames new conversations after their first exchange
+	autoTitles bool
+
+	// In-flight loops, so shutdown can drain them
+	loopMu   sync.Mutex
+	loops    int
+	draining bool
+
+	// Cancel functions of in-flight runs, by conversation
+	runsMu sync.Mutex
+	runs   map[domain.ConversationID]context.CancelCauseFunc
+
+	maintenance  *MaintenanceMode      // optional; new chats are refused while paused
+	toolPolicy   *ToolPolicy           // optional; kernel-wide tool deny list
+	usage        *UsageMeter           // optional; token/cost accounting and limits
+	moderation   *ModerationService    // optional; checks user input and final answers
+	observations *ObservationOffloader // optional; moves oversized tool outputs out of the prompt
+
+	localeMu sync.RWMutex
+	locale   string // global locale; empty = detect from each message
 }
 
+// ErrAgentDraining is returned for chats started after shutdown began.
+var ErrAgentDraining = errors.New("agent is shutting down")
+
 // personaReader is the minimal interface needed to fetch personas
 type personaReader interface {
 	GetPersona(ctx context.Context, id domain.PersonaID) (domain.Persona, error)
 }
 
+// agentRepo is the minimal interface the ReAct agent needs to resolve personas and project defaults
+type agentRepo interface {
+	personaReader
+	GetProject(ctx context.Context, id domain.ProjectID) (domain.Project, error)
+}
+
 // NewReActAgentService creates a new ReAct-enabled agent
 func NewReActAgentService(
 	logger *slog.Logger,
@@ -39,96 +81,189 @@ func NewReActAgentService(
 	router *ModelRouter,
 	tools *domain.ToolRegistry,
 	convs *ConversationStore,
-	repo personaReader,
+	repo agentRepo,
 	ws *WorkspaceManager,
 	tracer *TraceCollector,
 ) *ReActAgentService {
+	budget := NewContextBudgeter(logger)
+	if router != nil {
+		budget.SetSummarizer(SummarizeWithRouter(router))
+	}
 	return &ReActAgentService{
-		logger:   logger,
-		llm:      llm,
-		router:   router,
-		tools:    tools,
-		convs:    convs,
-		repo:     repo,
-		ws:       ws,
-		tracer:   tracer,
-		maxIters: 5,
+		logger:        logger,
+		llm:           llm,
+		router:        router,
+		tools:         tools,
+		convs:         convs,
+		repo:          repo,
+		ws:            ws,
+		tracer:        tracer,
+		budget:        budget,
+		maxIters:      5,
+		contextTokens: DefaultContextTokens,
+		autoTitles:    true,
 	}
 }
 
+// SetEventBus enables live token events on the conversation channel.
+func (s *ReActAgentService) SetEventBus(bus *EventBus) {
+	s.eventBus = bus
+}
+
+// SetMaintenance makes the agent refuse new chats while maintenance mode is on.
+func (s *ReActAgentService) SetMaintenance(m *MaintenanceMode) {
+	s.maintenance = m
+}
+
+// SetToolPolicy applies a kernel-wide tool deny list to every chat.
+func (s *ReActAgentService) SetToolPolicy(p *ToolPolicy) {
+	s.toolPolicy = p
+}
+
+// SetUsageMeter records the usage of every LLM call and enforces the
+// conversation and project limits before each one.
+func (s *ReActAgentService) SetUsageMeter(m *UsageMeter) {
+	s.usage = m
+}
+
+// SetModeration runs the moderation pass on every user message and final
+// answer.
+func (s *ReActAgentService) SetModeration(m *ModerationService) {
+	s.moderation = m
+}
+
+// SetObservationOffloader saves tool outputs too large for the prompt and
+// replaces them with a preview the agent can page through.
+func (s *ReActAgentService) SetObservationOffloader(o *ObservationOffloader) {
+	s.observations = o
+}
+
+// SetLocale sets the global language of the scaffold instructions and the
+// agent's messages. Empty detects it from each user message.
+func (s *ReActAgentService) SetLocale(locale string) {
+	s.localeMu.Lock()
+	s.locale = locale
+	s.localeMu.Unlock()
+}
+
+// SetContextTokens sets the context window assumed for models whose window
+// is not known from the catalog (e.g. the Ollama server's default num_ctx).
+func (s *ReActAgentService) SetContextTokens(n int) {
+	if n > 0 {
+		s.contextTokens = n
+	}
+}
+
+// contextWindow returns the token budget for a model.
+func (s *ReActAgentService) contextWindow(modelID string) int {
+	if s.router != nil {
+		return s.router.ContextWindow(modelID, s.contextTokens)
+	}
+	return s.contextTokens
+}
+
 // Chat processes a user message using ReAct reasoning, within a conversation context.
 // If convID is empty, it creates a new conversation automatically.
 // If personaID is provided, the agent uses the persona's system prompt and tool filter.
+// One turn runs per conversation at a time (see beginTurn).
 func (s *ReActAgentService) Chat(ctx context.Context, convID domain.ConversationID, message string, personaID *domain.PersonaID) (*domain.AgentResponse, domain.ConversationID, error) {
-	s.logger.Info("starting ReAct loop", "message", message, "conversation_id", string(convID))
+	if convID != "" {
+		release, err := s.beginTurn(ctx, convID)
+		if err != nil {
+			return nil, convID, err
+		}
+		defer release()
+	}
+	return s.run(ctx, convID, message, personaID, nil)
+}
+
+// run executes the ReAct loop for message. With replay set, the loop answers
+// that already-persisted user message instead of adding a new one. Callers
+// hold the conversation's turn.
+func (s *ReActAgentService) run(ctx context.Context, convID domain.ConversationID, message string, personaID *domain.PersonaID, replay *domain.Message) (*domain.AgentResponse, domain.ConversationID, error) {
+	s.logger.InfoContext(ctx, "starting ReAct loop", "message", message, "conversation_id", string(convID))
+
+	if s.maintenance.Paused() {
+		return nil, convID, ErrSystemPaused
+	}
+	if !s.beginLoop() {
+		return nil, convID, ErrAgentDraining
+	}
+	defer s.endLoop()
 
 	// --- Start Trace ---
 	traceName := "chat: " + message
 	if len(traceName) > 80 {
 		traceName = traceName[:80] + "..."
 	}
-	traceAttrs := map[string]string{"conversation_id": string(convID)}
+	// Every run samples with a known seed so its answer can be reproduced.
+	// Below 2^31: some backends take 32-bit seeds.
+	seed, ok := domain.SeedFrom(ctx)
+	if !ok {
+		seed = rand.Int63n(1 << 31)
+		ctx = domain.WithSeed(ctx, seed)
+	}
+	traceAttrs := map[string]string{"conversation_id": string(convID), "seed": strconv.FormatInt(seed, 10)}
 	if personaID != nil {
 		traceAttrs["persona_id"] = string(*personaID)
 	}
-	ctx, traceID, _ := s.tracer.StartTrace(ctx, traceName, traceAttrs)
+	ctx, traceID, rootSpanID := s.tracer.StartTrace(ctx, traceName, traceAttrs)
 	defer func() {
 		// EndTrace is called explicitly below — this is a safety net
 	}()
 
-	// Resolve persona
-	var persona *domain.Persona
-	if personaID != nil {
-		p, err := s.repo.GetPersona(ctx, *personaID)
-		if err == nil {
-			persona = &p
-			s.logger.Info("using persona", "persona_id", string(p.ID), "persona_name", p.Name)
-		} else {
-			s.logger.Warn("persona not found, using default", "persona_id", string(*personaID), "error", err)
-		}
-	}
-
-	s.tracer.SetTraceConversation(traceID, string(convID), func() string {
-		if personaID != nil {
-			return string(*personaID)
-		}
-		return ""
-	}())
-
 	// Auto-create conversation if needed
 	if convID == "" {
-		// Generate title from first ~50 chars of message
-		title := message
-		if len(title) > 50 {
-			title = title[:50] + "..."
-		}
-		conv, err := s.convs.CreateConversationWithPersona(ctx, title, personaID)
+		// Provisional title until the first exchange is summarised
+		conv, err := s.convs.CreateConversationWithPersona(ctx, PlaceholderTitle(message), personaID)
 		if err != nil {
 			return nil, "", fmt.Errorf("create conversation: %w", err)
 		}
 		convID = conv.ID
-		s.logger.Info("auto-created conversation", "conversation_id", string(convID))
+		s.logger.InfoContext(ctx, "auto-created conversation", "conversation_id", string(convID))
 	}
 
+	// The run can be cancelled through its conversation from here on
+	ctx, untrack := s.trackRun(ctx, convID)
+	defer untrack()
+
 	// Persist user message
-	now := time.Now()
-	userMsg := domain.Message{
-		ID:             domain.NewMessageID(),
-		ConversationID: convID,
-		Role:           domain.RoleUser,
-		Content:        message,
-		CreatedAt:      now,
-	}
-	if err := s.convs.AddMessage(ctx, userMsg); err != nil {
-		return nil, convID, fmt.Errorf("persist user message: %w", err)
+	var userMsg domain.Message
+	if replay != nil {
+		userMsg = *replay
+	} else {
+		userMsg = domain.Message{
+			ID:             domain.NewMessageID(),
+			ConversationID: convID,
+			Role:           domain.RoleUser,
+			Content:        message,
+			CreatedAt:      time.Now(),
+		}
+		if err := s.convs.AddMessage(ctx, userMsg); err != nil {
+			return nil, convID, fmt.Errorf("persist user message: %w", err)
+		}
 	}
 
 	// Inject ProjectID into context and load workspace context (AGENT.md, USER.md, IDENTITY.md, MEMORY.md, skills)
 	var wsCtx WorkspaceContext
-	if currentConv, err := s.convs.GetConversation(ctx, convID); err == nil && currentConv.ProjectID != nil {
+	var projSettings domain.ProjectSettings
+	var convProject *domain.ProjectID
+	currentConv, convErr := s.convs.GetConversation(ctx, convID)
+	if convErr == nil && len(currentConv.Context) > 0 {
+		ctx = ContextWithConversationVars(ctx, currentConv.Context)
+	}
+	if convErr == nil && currentConv.ProjectID != nil {
 		projectID := *currentConv.ProjectID
+		convProject = &projectID
 		ctx = ContextWithProject(ctx, projectID)
-		s.logger.Info("context injected with project_id", "project_id", string(projectID))
+		s.logger.InfoContext(ctx, "context injected with project_id", "project_id", string(projectID))
+
+		// Project-level defaults override global defaults
+		if proj, err := s.repo.GetProject(ctx, projectID); err == nil {
+			projSettings = proj.Settings
+		} else {
+			s.logger.WarnContext(ctx, "failed to load project settings", "project_id", string(projectID), "error", err)
+		}
 
 		// Load all workspace personality/context files
 		wsCtx = LoadWorkspaceContext(s.ws, string(projectID), s.logger)
@@ -144,64 +279,208 @@ func (s *ReActAgentService) Chat(ctx context.Context, convID domain.Conversation
 				"",
 			)
 		}
+		wsCtx.Mounts = formatMountsForPrompt(projSettings.Mounts)
 	}
 
-	// Build context: system prompt + conversation history + new user message
-	history, err := s.convs.BuildContextWindow(ctx, convID, 20)
-	if err != nil {
-		return nil, convID, fmt.Errorf("build context: %w", err)
+	// Client-supplied context variables (open file, location...) get their own block
+	wsCtx.Conversation = currentConv.Context.FormatForPrompt()
+	if currentConv.Style != nil {
+		wsCtx.Style = currentConv.Style.FormatForPrompt()
 	}
 
-	conversationHistory := []string{
-		s.buildReActPrompt(history, message, persona, wsCtx),
+	// Resolve persona: explicit request > project default
+	if personaID == nil && projSettings.DefaultPersonaID != nil {
+		personaID = projSettings.DefaultPersonaID
 	}
-	steps := []domain.ReActStep{}
+	var persona *domain.Persona
+	if personaID != nil {
+		p, err := s.repo.GetPersona(ctx, *personaID)
+		if err == nil {
+			persona = &p
+			ctx = ContextWithPersona(ctx, p)
+			s.logger.InfoContext(ctx, "using persona", "persona_id", string(p.ID), "persona_name", p.Name)
+		} else {
+			s.logger.WarnContext(ctx, "persona not found, using default", "persona_id", string(*personaID), "error", err)
+		}
+	}
+
+	// Language of the instructions and of the agent's own messages
+	s.localeMu.RLock()
+	locale := resolveLocale(currentConv, persona, s.locale, message)
+	s.localeMu.RUnlock()
+	text := stringsFor(locale)
+	s.tracer.SetSpanAttribute(rootSpanID, "locale", locale)
+
+	// Moderation of the user's message, before any model sees it
+	var moderation []domain.ModerationDecision
+	if d, ok := s.moderation.Check(ctx, domain.ModerationStageInput, message, projSettings.Moderation); ok {
+		moderation = append(moderation, d)
+		if d.Blocked {
+			refusal := moderationRefusal(d, text)
+			reply := domain.Message{
+				ID:             domain.NewMessageID(),
+				ConversationID: convID,
+				Role:           domain.RoleAssistant,
+				Content:        refusal,
+				Metadata:       map[string]interface{}{"trace_id": string(traceID), "moderation": visibleModeration(moderation)},
+				CreatedAt:      time.Now(),
+			}
+			if err := s.convs.AddMessage(ctx, reply); err != nil {
+				s.logger.ErrorContext(ctx, "failed to persist moderation refusal", "error", err)
+			}
+			s.tracer.EndTrace(traceID, domain.SpanStatusError, "blocked by moderation")
+			return &domain.AgentResponse{Response: refusal, Moderation: visibleModeration(moderation)}, convID, nil
+		}
+	}
+
+	// Persona-scoped memory: the memory tools and the prompt see only what
+	// the project's memory_scope allows this persona
+	if persona != nil && convProject != nil && s.ws != nil {
+		ctx = ContextWithMemoryScope(ctx, persona.ID, projSettings.MemoryScope)
+		_, paths := memoryPaths(ctx, s.ws.GetProjectPath(string(*convProject)))
+		if mem, err := readMemory(paths); err == nil {
+			wsCtx.Memory = mem
+		}
+	}
+
+	s.tracer.SetTraceConversation(traceID, string(convID), func() string {
+		if personaID != nil {
+			return string(*personaID)
+		}
+		return ""
+	}())
 
-	// Build effective tool registry (filtered by persona if applicable)
-	effectiveTools := s.tools
+	// Build effective tool registry (filtered by persona, then by project if applicable)
+	effectiveTools := s.toolPolicy.Apply(s.tools)
 	if persona != nil && len(persona.AllowedTools) > 0 {
-		effectiveTools = s.tools.FilterByNames(persona.AllowedTools)
+		effectiveTools = effectiveTools.FilterByNames(persona.AllowedTools)
+	}
+	if len(projSettings.AllowedTools) > 0 {
+		effectiveTools = effectiveTools.FilterByNames(projSettings.AllowedTools)
 	}
 
-	// Resolve model: persona override > default
+	// Resolve model: context override > persona override > project default > role default
 	modelID := ""
-	if s.router != nil && persona != nil {
-		role := s.router.inferRoleFromPersona(persona)
-		modelID = s.router.ResolveModel(persona, role)
+	if s.router != nil {
+		if persona != nil {
+			role := s.router.inferRoleFromPersona(persona)
+			modelID = s.router.ResolveModel(persona, role)
+		}
+		if projSettings.DefaultModel != "" && (persona == nil || persona.ModelOverride == "") {
+			modelID = projSettings.DefaultModel
+		}
+	}
+	if forced, ok := GetModelFromContext(ctx); ok && s.router != nil {
+		modelID = forced
 	}
 
+	// Build context: system prompt + conversation history + new user message,
+	// budgeted against the model's context window
+	history, err := s.convs.GetMessages(ctx, convID, contextHistoryMessages)
+	if err != nil {
+		return nil, convID, fmt.Errorf("build context: %w", err)
+	}
+	if n := len(history); n > 0 && history[n-1].ID == userMsg.ID {
+		history = history[:n-1] // the new message is appended as "Now respond to"
+	}
+	fit := s.budget.Fit(ctx, s.contextWindow(modelID), ContextBlocks{
+		Base:      s.buildReActPrompt("", message, persona, effectiveTools, wsCtx, "", locale),
+		Workspace: wsCtx,
+		History:   history,
+	})
+
+	conversationHistory := []string{
+		s.buildReActPrompt(fit.History, message, persona, effectiveTools, wsCtx, fit.Workspace, locale),
+	}
+	steps := []domain.ReActStep{}
+	// Steps are persisted after every iteration so a crash mid-loop leaves a record
+	cp := &loopCheckpoint{msg: domain.Message{
+		ID:             domain.NewMessageID(),
+		ConversationID: convID,
+		Role:           domain.RoleAssistant,
+		CreatedAt:      time.Now(),
+	}}
+
 	// Inject conversation ID into context for sub-agent tools
 	ctx = ContextWithConversation(ctx, convID)
+	// Files registered as artifacts during the turn are listed on the answer;
+	// sub-agents may register them concurrently
+	var (
+		artifactsMu sync.Mutex
+		artifacts   []domain.ArtifactID
+	)
+	ctx = contextWithArtifactRecorder(ctx, func(id domain.ArtifactID) {
+		artifactsMu.Lock()
+		defer artifactsMu.Unlock()
+		if !slices.Contains(artifacts, id) {
+			artifacts = append(artifacts, id)
+		}
+	})
+	// A tool waiting for the user (ask_user) shows its question in the checkpoint
+	ctx = contextWithInputWaiter(ctx, func(q *domain.Question) {
+		if q != nil {
+			s.awaitingCheckpoint(ctx, cp, steps, q)
+		}
+	})
 
 	for i := 0; i < s.maxIters; i++ {
-		s.logger.Info("ReAct iteration", "iteration", i+1)
+		if ctx.Err() != nil {
+			return nil, convID, s.stopCheckpoint(ctx, cp, steps, text, traceID)
+		}
+		s.logger.InfoContext(ctx, "ReAct iteration", "iteration", i+1)
+
+		// Usage limits are checked before every call, so a long loop stops
+		// as soon as the budget runs out
+		if status, err := s.usage.Check(ctx, convID, convProject); err != nil {
+			s.logger.WarnContext(ctx, "usage check failed", "error", err)
+		} else if status.Exceeded() {
+			msg := status.Message()
+			cp.msg.Content = msg
+			cp.msg.Steps = steps
+			cp.msg.Metadata = map[string]interface{}{"usage_limit": true, "trace_id": string(traceID)}
+			s.persistCheckpoint(ctx, cp)
+			s.tracer.EndTrace(traceID, domain.SpanStatusError, "usage limit reached")
+			return &domain.AgentResponse{Response: msg, Steps: steps}, convID, nil
+		}
 
 		// 1. Call LLM (with model override if available) — traced
 		prompt := strings.Join(conversationHistory, "\n\n")
 
 		llmCtx, llmSpanID := s.tracer.StartSpan(ctx, fmt.Sprintf("llm.generate (iter %d)", i+1), domain.SpanKindLLM, map[string]string{
-			"iteration": fmt.Sprintf("%d", i+1),
-			"model":     modelID,
+			"iteration":      fmt.Sprintf("%d", i+1),
+			"model":          modelID,
+			"prompt_tokens":  fmt.Sprintf("%d", CountTokens(prompt)),
+			"context_tokens": fmt.Sprintf("%d", fit.Report.MaxTokens),
 		})
 		s.tracer.SetSpanInput(llmSpanID, prompt[max(0, len(prompt)-500):])
 		s.tracer.SetSpanModel(llmSpanID, modelID)
-		_ = llmCtx // llmCtx used for future nested calls
 
-		var response string
-		var err error
-		if s.router != nil && modelID != "" {
-			response, err = s.router.GenerateText(ctx, prompt, modelID)
-		} else {
-			response, err = s.llm.GenerateText(ctx, prompt)
-		}
+		// llmCtx links record-mode provider captures to this span
+		response, err := s.generateStream(llmCtx, convID, i+1, llmSpanID, prompt, modelID)
 		if err != nil {
 			s.tracer.EndSpan(llmSpanID, domain.SpanStatusError, "", err.Error())
+			if ctx.Err() != nil {
+				return nil, convID, s.stopCheckpoint(ctx, cp, steps, text, traceID)
+			}
 			s.tracer.EndTrace(traceID, domain.SpanStatusError, err.Error())
+			if cp.saved {
+				s.interruptCheckpoint(ctx, cp, steps, fmt.Sprintf(text.stoppedEarly, err))
+			}
 			return nil, convID, fmt.Errorf("llm generate: %w", err)
 		}
 		s.tracer.EndSpan(llmSpanID, domain.SpanStatusOK, response[:min(500, len(response))], "")
+		rec := domain.UsageRecord{
+			ConversationID:   convID,
+			Model:            modelID,
+			PromptTokens:     CountTokens(prompt),
+			CompletionTokens: CountTokens(response),
+		}
+		if convProject != nil {
+			rec.ProjectID = *convProject
+		}
+		s.usage.Record(ctx, rec)
 
-		s.logger.Info("LLM response", "response", response[:min(200, len(response))])
+		s.logger.InfoContext(ctx, "LLM response", "response", response[:min(200, len(response))])
 
 		// 2. Parse output
 		step := s.parseReActOutput(response)
@@ -209,34 +488,58 @@ func (s *ReActAgentService) Chat(ctx context.Context, convID domain.Conversation
 
 		// 3. Check if final answer
 		if step.IsFinalAnswer {
-			s.logger.Info("final answer reached", "answer", step.FinalAnswer)
+			s.logger.InfoContext(ctx, "final answer reached", "answer", step.FinalAnswer)
+
+			// Moderation of the answer. It has already been streamed, so a
+			// block replaces what is stored and returned.
+			answer := step.FinalAnswer
+			if d, ok := s.moderation.Check(ctx, domain.ModerationStageOutput, answer, projSettings.Moderation); ok {
+				moderation = append(moderation, d)
+				if d.Blocked {
+					answer = moderationRefusal(d, text)
+					steps[len(steps)-1].FinalAnswer = answer
+				}
+			}
 
 			agentResp := &domain.AgentResponse{
-				Response: step.FinalAnswer,
-				Thought:  step.Thought,
-				Steps:    steps,
+				Response:   answer,
+				Thought:    step.Thought,
+				Steps:      steps,
+				Moderation: visibleModeration(moderation),
+				Seed:       seed,
+				Citations:  citeObservations(steps, answer),
 			}
 
-			// Persist assistant message
-			assistantMsg := domain.Message{
-				ID:             domain.NewMessageID(),
-				ConversationID: convID,
-				Role:           domain.RoleAssistant,
-				Content:        step.FinalAnswer,
-				Thought:        step.Thought,
-				Steps:          steps,
-				CreatedAt:      time.Now(),
+			// Persist assistant message, replacing the in-progress checkpoint
+			cp.msg.Content = answer
+			cp.msg.Thought = step.Thought
+			cp.msg.Steps = steps
+			// trace_id and model let feedback on this reply be traced back;
+			// seed lets it be reproduced
+			cp.msg.Metadata = map[string]interface{}{"trace_id": string(traceID), "seed": seed}
+			if modelID != "" {
+				cp.msg.Metadata["model"] = modelID
+			}
+			if len(agentResp.Moderation) > 0 {
+				cp.msg.Metadata["moderation"] = agentResp.Moderation
 			}
-			if err := s.convs.AddMessage(ctx, assistantMsg); err != nil {
-				s.logger.Error("failed to persist assistant message", "error", err)
+			if len(agentResp.Citations) > 0 {
+				cp.msg.Metadata["citations"] = agentResp.Citations
 			}
+			artifactsMu.Lock()
+			if len(artifacts) > 0 {
+				cp.msg.Metadata["artifacts"] = slices.Clone(artifacts)
+			}
+			artifactsMu.Unlock()
+			s.persistCheckpoint(ctx, cp)
+			s.maybeAutoTitle(ctx, convID, message, answer)
 
 			s.tracer.EndTrace(traceID, domain.SpanStatusOK, "")
 			return agentResp, convID, nil
 		}
 
 		// 4. Execute tool — traced
-		s.logger.Info("executing tool", "tool", step.Action, "params", step.ActionInput)
+		s.logger.InfoContext(ctx, "executing tool", "tool", step.Action, "params", step.ActionInput)
 
 		toolCtx, toolSpanID := s.tracer.StartSpan(ctx, fmt.Sprintf("tool.%s", step.Action), domain.SpanKindTool, map[string]string{
 			"tool": step.Action,
@@ -244,42 +547,290 @@ func (s *ReActAgentService) Chat(ctx context.Context, convID domain.Conversation
 		inputJSON, _ := json.Marshal(step.ActionInput)
 		s.tracer.SetSpanInput(toolSpanID, string(inputJSON))
 
-		result, err := effectiveTools.Execute(toolCtx, step.Action, step.ActionInput)
-		if err != nil {
+		var result interface{}
+		toolName, note, err := s.toolPolicy.ResolveAction(toolCtx, effectiveTools, step.Action)
+		recorded := toolReplayFrom(ctx)
+		if err == nil && recorded == nil {
+			// Long tools stream partial output; the full result still becomes the observation
+			progressCtx := ContextWithToolProgress(toolCtx, s.toolOutputPublisher(ctx, convID, i+1, toolName))
+			result, err = effectiveTools.Execute(progressCtx, toolName, step.ActionInput)
+		}
+		switch {
+		case err != nil:
 			step.Observation = fmt.Sprintf("Error: %v", err)
 			s.tracer.EndSpan(toolSpanID, domain.SpanStatusError, step.Observation, err.Error())
-		} else {
-			// Format observation
+		case recorded != nil:
+			// Session replay: the recorded observation stands in for the tool
+			step.Observation = recorded.observe(step.ActionInput, step.Action, toolName)
+			s.tracer.SetSpanAttribute(toolSpanID, "replayed", "true")
+			s.tracer.EndSpan(toolSpanID, domain.SpanStatusOK, step.Observation, "")
+		default:
+			// Format observation; a name correction is shown so the model learns it
 			resultJSON, _ := json.Marshal(result)
-			step.Observation = string(resultJSON)
+			// Oversized output is saved aside; the prompt gets a preview and a reference
+			step.Observation = s.observations.Offload(ctx, convID, cp.msg.ID, i+1, toolName, string(resultJSON))
+			if note != "" {
+				step.Observation = note + "\n" + step.Observation
+			}
 			s.tracer.EndSpan(toolSpanID, domain.SpanStatusOK, step.Observation, "")
 		}
 
-		s.logger.Info("tool executed", "observation", step.Observation[:min(200, len(step.Observation))])
+		s.logger.InfoContext(ctx, "tool executed", "observation", step.Observation[:min(200, len(step.Observation))])
+
+		steps[len(steps)-1].Observation = step.Observation
+		s.saveCheckpoint(ctx, cp, steps)
 
 		// 5. Add to conversation
 		conversationHistory = append(conversationHistory, response)
 		conversationHistory = append(conversationHistory, fmt.Sprintf("Observation: %s", step.Observation))
 	}
 
+	s.interruptCheckpoint(ctx, cp, steps, fmt.Sprintf(text.maxSteps, len(steps)))
 	s.tracer.EndTrace(traceID, domain.SpanStatusError, "max iterations reached")
 	return nil, convID, fmt.Errorf("max iterations (%d) reached without final answer", s.maxIters)
 }
 
-// buildReActPrompt creates the initial prompt with tool descriptions and conversation history
-func (s *ReActAgentService) buildReActPrompt(history string, userMessage string, persona *domain.Persona, wsCtx WorkspaceContext) string {
-	// Choose effective tool set for prompt
-	var toolsDesc string
-	if persona != nil && len(persona.AllowedTools) > 0 {
-		toolsDesc = s.tools.FilterByNames(persona.AllowedTools).FormatToolsForPrompt()
+func (s *ReActAgentService) beginLoop() bool {
+	s.loopMu.Lock()
+	defer s.loopMu.Unlock()
+	if s.draining {
+		return false
+	}
+	s.loops++
+	return true
+}
+
+func (s *ReActAgentService) endLoop() {
+	s.loopMu.Lock()
+	s.loops--
+	s.loopMu.Unlock()
+}
+
+// Drain refuses new chats and waits until in-flight ReAct loops finish or ctx
+// expires. Returns how many loops were still running. Loops cancelled after
+// that leave a checkpoint message in their conversation.
+func (s *ReActAgentService) Drain(ctx context.Context) int {
+	s.loopMu.Lock()
+	s.draining = true
+	s.loopMu.Unlock()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		s.loopMu.Lock()
+		n := s.loops
+		s.loopMu.Unlock()
+		if n == 0 {
+			return 0
+		}
+		select {
+		case <-ctx.Done():
+			return n
+		case <-ticker.C:
+		}
+	}
+}
+
+// interruptedShutdown is the checkpoint text for loops cut off by a shutdown or crash.
+const interruptedShutdown = "I was interrupted before finishing (the kernel stopped). Ask me to continue and I'll pick up from the last observation."
+
+// loopCheckpoint is the assistant message a ReAct loop keeps up to date with
+// its steps. It becomes the final answer when the loop completes.
+type loopCheckpoint struct {
+	msg   domain.Message
+	saved bool
+}
+
+// saveCheckpoint records the steps so far as an in-progress message.
+func (s *ReActAgentService) saveCheckpoint(ctx context.Context, cp *loopCheckpoint, steps []domain.ReActStep) {
+	cp.msg.Content = fmt.Sprintf("Working… (step %d)", len(steps))
+	cp.msg.Steps = steps
+	cp.msg.Metadata = map[string]interface{}{"in_progress": true}
+	s.persistCheckpoint(ctx, cp)
+}
+
+// awaitingCheckpoint records that the loop is paused on a question to the
+// user, so a client that reloads the conversation can still answer it.
+func (s *ReActAgentService) awaitingCheckpoint(ctx context.Context, cp *loopCheckpoint, steps []domain.ReActStep, q *domain.Question) {
+	cp.msg.Content = q.Question
+	cp.msg.Steps = steps
+	cp.msg.Metadata = map[string]interface{}{"in_progress": true, "awaiting_input": q}
+	s.persistCheckpoint(ctx, cp)
+}
+
+// interruptCheckpoint marks the loop as stopped short of an answer, keeping
+// the steps it got through so the conversation shows where it stopped.
+func (s *ReActAgentService) interruptCheckpoint(ctx context.Context, cp *loopCheckpoint, steps []domain.ReActStep, content string) {
+	cp.msg.Content = content
+	cp.msg.Steps = steps
+	cp.msg.Metadata = map[string]interface{}{"interrupted": true}
+	s.persistCheckpoint(ctx, cp)
+}
+
+// stopCheckpoint ends a loop whose context is done, either cancelled on
+// request (see Cancel) or cut off by a shutdown, and returns the error the
+// run fails with.
+func (s *ReActAgentService) stopCheckpoint(ctx context.Context, cp *loopCheckpoint, steps []domain.ReActStep, text agentStrings, traceID domain.TraceID) error {
+	if cause := context.Cause(ctx); errors.Is(cause, domain.ErrRunCancelled) {
+		cp.msg.Content = text.cancelled
+		cp.msg.Steps = steps
+		cp.msg.Metadata = map[string]interface{}{"interrupted": true, "cancelled": true, "trace_id": string(traceID)}
+		s.persistCheckpoint(ctx, cp)
+		s.tracer.EndTrace(traceID, domain.SpanStatusCancelled, "cancelled")
+		return cause
+	}
+	s.interruptCheckpoint(ctx, cp, steps, text.interrupted)
+	s.tracer.EndTrace(traceID, domain.SpanStatusError, "interrupted")
+	return ctx.Err()
+}
+
+func (s *ReActAgentService) persistCheckpoint(ctx context.Context, cp *loopCheckpoint) {
+	// Checkpoints must land even when the loop's context was cancelled
+	ctx = context.WithoutCancel(ctx)
+	var err error
+	if cp.saved {
+		err = s.convs.UpdateMessage(ctx, cp.msg)
+	} else {
+		err = s.convs.AddMessage(ctx, cp.msg)
+		cp.saved = err == nil
+	}
+	if err != nil {
+		s.logger.Error("failed to persist loop checkpoint", "conversation_id", string(cp.msg.ConversationID), "error", err)
+	}
+}
+
+// RecoverCheckpoints marks checkpoints left in progress by a crashed kernel
+// as interrupted. Call it at startup, before any loop runs.
+func (s *ReActAgentService) RecoverCheckpoints(ctx context.Context) (int, error) {
+	msgs, err := s.convs.InProgressMessages(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list in-progress checkpoints: %w", err)
+	}
+	for _, msg := range msgs {
+		cp := &loopCheckpoint{msg: msg, saved: true}
+		s.interruptCheckpoint(ctx, cp, msg.Steps, interruptedShutdown)
+	}
+	return len(msgs), nil
+}
+
+// generateStream runs one LLM call, relaying tokens to the conversation's
+// event stream and to the trace span as they arrive. Generation is cut short
+// once the model starts inventing its own Observation after an action.
+func (s *ReActAgentService) generateStream(ctx context.Context, convID domain.ConversationID, iteration int, spanID domain.SpanID, prompt, modelID string) (string, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel() // stops the provider stream if we return early
+
+	var ch <-chan domain.Chunk
+	var err error
+	if s.router != nil && modelID != "" {
+		ch, err = s.router.GenerateTextStream(streamCtx, prompt, modelID)
 	} else {
-		toolsDesc = s.tools.FormatToolsForPrompt()
+		ch, err = s.llm.GenerateTextStream(streamCtx, prompt)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for c := range ch {
+		if c.Err != nil {
+			return sb.String(), c.Err
+		}
+		if c.Text != "" {
+			sb.WriteString(c.Text)
+			s.publishToken(ctx, convID, iteration, c.Text)
+			s.tracer.AppendSpanOutput(spanID, c.Text)
+		}
+		if c.Done {
+			break
+		}
+		if cut, ok := cutAtObservation(sb.String()); ok {
+			s.logger.Debug("stopping generation at model-invented observation", "iteration", iteration)
+			return cut, nil
+		}
+	}
+	return sb.String(), nil
+}
+
+// cutAtObservation truncates text before an "Observation:" that follows an
+// Action Input — the model is hallucinating the tool result.
+func cutAtObservation(text string) (string, bool) {
+	input := strings.Index(text, "Action Input:")
+	if input < 0 {
+		return text, false
+	}
+	obs := strings.Index(text[input:], "\nObservation:")
+	if obs < 0 {
+		return text, false
+	}
+	return text[:input+obs], true
+}
+
+func (s *ReActAgentService) publishToken(ctx context.Context, convID domain.ConversationID, iteration int, delta string) {
+	if s.eventBus == nil {
+		return
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"conversation_id": string(convID),
+		"iteration":       iteration,
+		"delta":           delta,
+	})
+	s.eventBus.PublishContext(ctx, Event{
+		JobID:     string(convID), // EventBus key = conversation ID
+		Type:      EventTypeToken,
+		Data:      string(data),
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
+
+// toolOutputStreamBytes caps how much partial output of one tool call is
+// streamed; the observation itself is not affected.
+const toolOutputStreamBytes = 64 << 10
+
+// toolOutputPublisher returns the progress sink of one tool call, relaying
+// partial output to the conversation's event stream as tool_output events.
+func (s *ReActAgentService) toolOutputPublisher(ctx context.Context, convID domain.ConversationID, iteration int, tool string) func(string) {
+	if s.eventBus == nil {
+		return nil
+	}
+	var mu sync.Mutex
+	streamed := 0
+	return func(chunk string) {
+		mu.Lock()
+		defer mu.Unlock()
+		if streamed >= toolOutputStreamBytes {
+			return
+		}
+		if streamed+len(chunk) > toolOutputStreamBytes {
+			chunk = chunk[:toolOutputStreamBytes-streamed] + "\n... (streaming stopped, the full output is in the observation)"
+		}
+		streamed += len(chunk)
+		data, _ := json.Marshal(map[string]interface{}{
+			"conversation_id": string(convID),
+			"iteration":       iteration,
+			"tool":            tool,
+			"delta":           chunk,
+		})
+		s.eventBus.PublishContext(ctx, Event{
+			JobID:     string(convID), // EventBus key = conversation ID
+			Type:      EventTypeToolOutput,
+			Data:      string(data),
+			Timestamp: time.Now().UnixMilli(),
+		})
 	}
+}
+
+// buildReActPrompt creates the initial prompt with tool descriptions and conversation history
+// workspaceBlock is the (already budgeted) rendering of wsCtx. The
+// instructions are written in locale.
+func (s *ReActAgentService) buildReActPrompt(history string, userMessage string, persona *domain.Persona, tools *domain.ToolRegistry, wsCtx WorkspaceContext, workspaceBlock string, locale string) string {
+	toolsDesc := tools.FormatToolsForPrompt()
+	text := stringsFor(locale)
 
 	// Build system identity from persona or workspace IDENTITY.md or default
-	systemIdentity := "You are an AI assistant with access to tools."
+	systemIdentity := text.identity
 	if persona != nil && persona.SystemPrompt != "" {
-		systemIdentity = persona.SystemPrompt
+		systemIdentity = persona.PromptFor(locale)
 	} else if wsCtx.Identity != "" {
 		systemIdentity = wsCtx.Identity
 	}
@@ -292,30 +843,34 @@ func (s *ReActAgentService) buildReActPrompt(history string, userMessage string,
 	var historyBlock string
 	if history != "" {
 		historyBlock = fmt.Sprintf(`
-Previous conversation:
+%s
 %s
 ---
-`, history)
+`, text.history, history)
 	}
 
-	// Build workspace context block (memory, user prefs, skills, tools guide)
-	workspaceBlock := wsCtx.FormatForPrompt()
+	// Workspace context block (memory, user prefs, skills, tools guide)
 	// For backwards compatibility, if wsCtx produced a block it replaces the old memory block
 	var memoryBlock string
 	if workspaceBlock != "" {
 		memoryBlock = workspaceBlock
 	}
 
+	answerIn := ""
+	if text.answerIn != "" {
+		answerIn = "\n" + text.answerIn
+	}
+
 	return fmt.Sprintf(`%s
 
-You use the ReAct pattern: Thought → Action → Observation → ... → Final Answer.
+%s%s
 
-FORMAT (tool call):
+%s
 Thought: <reasoning>
 Action: <EXACT tool name from list below>
 Action Input: <JSON params>
 
-FORMAT (direct answer):
+%s
 Thought: <reasoning>
 Final Answer: <response>
 
@@ -325,22 +880,14 @@ Final Answer: <response>
 
 %s
 
-RULES:
-1. Always start with "Thought:"
-2. For simple chat (greetings, questions, conversation), go DIRECTLY to "Final Answer:" — no tools needed.
-3. Only use tools when the user explicitly asks for something requiring them.
-4. CRITICAL: Use the EXACT tool name from the "Available Tools" list above. Do NOT invent tool names.
-5. Tools generate_image and generate_text are ASYNC. When "status":"queued", tell user to wait.
-6. When "status":"unavailable", the service is down — tell user clearly.
-7. Action Input must be valid JSON on one line.
-8. CHECK MEMORY: If the user asks about something stored in LONG-TERM MEMORY, use it!
+%s
 
-EXAMPLES:
+%s
 
 Example 1 — simple chat:
 User: Hello!
 Thought: Simple greeting, no tool needed.
-Final Answer: Hello! How can I help you today?
+Final Answer: %s
 
 Example 2 — image generation:
 User: Generate an image of a sunset
@@ -382,13 +929,11 @@ Thought: I should delegate these as two parallel tasks to researcher personas.
 Action: delegate
 Action Input: {"tasks": [{"persona": "researcher", "prompt": "Research Python language features"}, {"persona": "researcher", "prompt": "Research Go language features"}]}
 
-CRITICAL JSON RULES:
-- ALL JSON keys MUST be wrapped in double quotes: {"key": "value"} NOT {key: "value"}
-- No trailing commas: {"a": 1, "b": 2} NOT {"a": 1, "b": 2,}
-- Action Input must be a single-line JSON object
+%s
 
-Now respond to:
-User: %s`, systemIdentity, toolsDesc, memoryBlock, historyBlock, userMessage)
+%s
+User: %s`, systemIdentity, text.pattern, answerIn, text.formatTool, text.formatAnswer, toolsDesc, memoryBlock, historyBlock,
+		text.rules, text.examples, text.greeting, text.jsonRules, text.respondTo, userMessage)
 }
 
 // parseReActOutput extracts Thought/Action/ActionInput or FinalAnswer from LLM response
@@ -417,7 +962,7 @@ func (s *ReActAgentService) parseReActOutput(response string) domain.ReActStep {
 	}
 
 	// Extract Action
-	actionRe := regexp.MustCompile(`(?i)Action:\s*([a-z][a-z0-9_]*)`)
+	actionRe := regexp.MustCompile(`(?i)Action:\s*([a-z][a-z0-9_]*(?:[/.][a-z0-9_]+)*)`)
 	if matches := actionRe.FindStringSubmatch(response); len(matches) > 1 {
 		step.Action = strings.TrimSpace(matches[1])
 	}
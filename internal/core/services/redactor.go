@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// redactionRule masks one kind of secret. When group is set only that
+// submatch is replaced, keeping e.g. the "password=" in front of a value.
+type redactionRule struct {
+	name  string
+	re    *regexp.Regexp
+	group int
+	check func(string) bool // optional; rejects false positives
+}
+
+var builtinRedactionRules = map[string][]redactionRule{
+	domain.RedactAPIKeys: {
+		{re: regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{20,}`)},
+		{re: regexp.MustCompile(`\b(?:ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{30,}\b|\bgithub_pat_[A-Za-z0-9_]{22,}`)},
+		{re: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+		{re: regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+		{re: regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`)},
+		{re: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+		{re: regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9._~+/-]{16,}=*)`), group: 1},
+	},
+	domain.RedactPrivateKeys: {
+		{re: regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)},
+	},
+	domain.RedactPasswords: {
+		{re: regexp.MustCompile(`(?i)\b(?:password|passwd|pwd|secret|client_secret|api[_-]?key|access[_-]?key|token)["']?\s*[:=]\s*["']?([^\s"',;\[][^\s"',;]{3,})`), group: 1},
+	},
+	domain.RedactEmails: {
+		{re: regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`)},
+	},
+	domain.RedactCardNumbers: {
+		{re: regexp.MustCompile(`\b[3-6]\d(?:[ -]?\d){11,17}\b`), check: luhnValid},
+	},
+}
+
+// Redactor masks secrets and PII before text leaves the kernel for a
+// remote LLM provider and before it is stored in messages, spans and logs.
+// The policy can change at runtime. A nil Redactor leaves text unchanged.
+type Redactor struct {
+	mu     sync.RWMutex
+	policy domain.RedactionPolicy
+	rules  []redactionRule
+}
+
+// NewRedactor returns a redactor running every built-in detector.
+func NewRedactor() *Redactor {
+	r := &Redactor{}
+	_ = r.SetPolicy(domain.RedactionPolicy{})
+	return r
+}
+
+// SetPolicy replaces the redaction policy. On an invalid policy the
+// previous one stays in place.
+func (r *Redactor) SetPolicy(p domain.RedactionPolicy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	detectors := p.Detectors
+	if len(detectors) == 0 {
+		detectors = domain.RedactionDetectors
+	}
+	var rules []redactionRule
+	for _, d := range detectors {
+		for _, rule := range builtinRedactionRules[d] {
+			rule.name = d
+			rules = append(rules, rule)
+		}
+	}
+	for _, pat := range p.Patterns {
+		rules = append(rules, redactionRule{name: pat.Name, re: regexp.MustCompile(pat.Regex)})
+	}
+	r.mu.Lock()
+	r.policy = p
+	r.rules = rules
+	r.mu.Unlock()
+	return nil
+}
+
+// Policy returns the current policy.
+func (r *Redactor) Policy() domain.RedactionPolicy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.policy
+}
+
+// Redact masks every detected secret in s as [REDACTED:<detector>].
+func (r *Redactor) Redact(s string) string {
+	if r == nil || s == "" {
+		return s
+	}
+	r.mu.RLock()
+	disabled, rules := r.policy.Disabled, r.rules
+	r.mu.RUnlock()
+	if disabled {
+		return s
+	}
+	for _, rule := range rules {
+		s = rule.apply(s)
+	}
+	return s
+}
+
+// RedactStored masks s unless the policy keeps stored history unmasked.
+func (r *Redactor) RedactStored(s string) string {
+	if r == nil || r.Policy().KeepHistory {
+		return s
+	}
+	return r.Redact(s)
+}
+
+// RedactMessage returns msg with its content, thought and tool steps masked
+// for storage. msg itself is not modified.
+func (r *Redactor) RedactMessage(msg domain.Message) domain.Message {
+	if r == nil || r.Policy().KeepHistory {
+		return msg
+	}
+	msg.Content = r.Redact(msg.Content)
+	msg.Thought = r.Redact(msg.Thought)
+	if len(msg.Steps) > 0 {
+		steps := make([]domain.ReActStep, len(msg.Steps))
+		for i, st := range msg.Steps {
+			st.Thought = r.Redact(st.Thought)
+			st.Observation = r.Redact(st.Observation)
+			st.FinalAnswer = r.Redact(st.FinalAnswer)
+			if len(st.ActionInput) > 0 {
+				in := make(map[string]interface{}, len(st.ActionInput))
+				for k, v := range st.ActionInput {
+					if s, ok := v.(string); ok {
+						v = r.Redact(s)
+					}
+					in[k] = v
+				}
+				st.ActionInput = in
+			}
+			steps[i] = st
+		}
+		msg.Steps = steps
+	}
+	return msg
+}
+
+func (rule redactionRule) apply(s string) string {
+	mask := "[REDACTED:" + rule.name + "]"
+	locs := rule.re.FindAllStringSubmatchIndex(s, -1)
+	if locs == nil {
+		return s
+	}
+	var b strings.Builder
+	last := 0
+	for _, loc := range locs {
+		start, end := loc[0], loc[1]
+		if rule.group > 0 {
+			start, end = loc[2*rule.group], loc[2*rule.group+1]
+			if start < 0 {
+				continue
+			}
+		}
+		if rule.check != nil && !rule.check(s[start:end]) {
+			continue
+		}
+		b.WriteString(s[last:start])
+		b.WriteString(mask)
+		last = end
+	}
+	if last == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// luhnValid reports whether the digits in s pass the Luhn checksum.
+func luhnValid(s string) bool {
+	sum, n := 0, 0
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if n%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n >= 13 && sum%10 == 0
+}
+
+// WrapLLM returns a provider that masks prompts before they reach p. Prompts
+// to a local provider (remote false) are only masked when the policy asks.
+func (r *Redactor) WrapLLM(p domain.LLMProvider, remote bool) domain.LLMProvider {
+	if r == nil {
+		return p
+	}
+	return &redactingLLMProvider{redactor: r, inner: p, remote: remote}
+}
+
+// redactingLLMProvider is the domain.LLMProvider returned by Redactor.WrapLLM.
+type redactingLLMProvider struct {
+	redactor *Redactor
+	inner    domain.LLMProvider
+	remote   bool
+}
+
+func (p *redactingLLMProvider) prompt(s string) string {
+	if !p.remote && !p.redactor.Policy().LocalPrompts {
+		return s
+	}
+	return p.redactor.Redact(s)
+}
+
+func (p *redactingLLMProvider) GenerateText(ctx context.Context, prompt string) (string, error) {
+	return p.inner.GenerateText(ctx, p.prompt(prompt))
+}
+
+func (p *redactingLLMProvider) GenerateTextWithModel(ctx context.Context, prompt string, modelID string) (string, error) {
+	return p.inner.GenerateTextWithModel(ctx, p.prompt(prompt), modelID)
+}
+
+func (p *redactingLLMProvider) GenerateTextStream(ctx context.Context, prompt string) (<-chan domain.Chunk, error) {
+	return p.inner.GenerateTextStream(ctx, p.prompt(prompt))
+}
+
+func (p *redactingLLMProvider) GenerateTextStreamWithModel(ctx context.Context, prompt string, modelID string) (<-chan domain.Chunk, error) {
+	return p.inner.GenerateTextStreamWithModel(ctx, p.prompt(prompt), modelID)
+}
+
+// LogHandler wraps h so log messages and string attributes are masked
+// before they are written.
+func (r *Redactor) LogHandler(h slog.Handler) slog.Handler {
+	return redactingLogHandler{Handler: h, redactor: r}
+}
+
+type redactingLogHandler struct {
+	slog.Handler
+	redactor *Redactor
+}
+
+func (h redactingLogHandler) Handle(ctx context.Context, rec slog.Record) error {
+	if h.redactor.Policy().KeepHistory {
+		return h.Handler.Handle(ctx, rec)
+	}
+	out := slog.NewRecord(rec.Time, rec.Level, h.redactor.Redact(rec.Message), rec.PC)
+	rec.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(h.redactAttr(a))
+		return true
+	})
+	return h.Handler.Handle(ctx, out)
+}
+
+func (h redactingLogHandler) redactAttr(a slog.Attr) slog.Attr {
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, h.redactor.Redact(v.String()))
+	case slog.KindGroup:
+		attrs := v.Group()
+		out := make([]any, len(attrs))
+		for i, ga := range attrs {
+			out[i] = h.redactAttr(ga)
+		}
+		return slog.Group(a.Key, out...)
+	case slog.KindAny:
+		if err, ok := v.Any().(error); ok {
+			return slog.String(a.Key, h.redactor.Redact(err.Error()))
+		}
+	}
+	return slog.Attr{Key: a.Key, Value: v}
+}
+
+func (h redactingLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	out := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		out[i] = h.redactAttr(a)
+	}
+	return redactingLogHandler{Handler: h.Handler.WithAttrs(out), redactor: h.redactor}
+}
+
+func (h redactingLogHandler) WithGroup(name string) slog.Handler {
+	return redactingLogHandler{Handler: h.Handler.WithGroup(name), redactor: h.redactor}
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// requestIDHandler adds the ctx's request ID to every record logged through
+// the *Context slog methods (InfoContext, WarnContext, ...).
+type requestIDHandler struct {
+	slog.Handler
+}
+
+// NewRequestIDLogHandler wraps h so records logged with a request-scoped
+// context carry a "request_id" attribute.
+func NewRequestIDLogHandler(h slog.Handler) slog.Handler {
+	return requestIDHandler{Handler: h}
+}
+
+func (h requestIDHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := domain.RequestIDFrom(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h requestIDHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return requestIDHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h requestIDHandler) WithGroup(name string) slog.Handler {
+	return requestIDHandler{Handler: h.Handler.WithGroup(name)}
+}
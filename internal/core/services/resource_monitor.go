@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// ResourceThresholds are the usage percentages above which the monitor
+// warns. Zero disables a check.
+type ResourceThresholds struct {
+	DiskPercent   float64
+	MemoryPercent float64
+	CPUPercent    float64
+}
+
+// DefaultResourceThresholds warns on >90% disk or memory and >95% CPU.
+var DefaultResourceThresholds = ResourceThresholds{DiskPercent: 90, MemoryPercent: 90, CPUPercent: 95}
+
+// ResourceMonitor periodically samples host CPU, memory and disk, the
+// database size and the container daemon, and posts a warning to the kernel
+// inbox when a threshold is first exceeded.
+type ResourceMonitor struct {
+	logger       *slog.Logger
+	host         ports.HostStatsReader
+	runtime      ports.RuntimeStatsReader // optional
+	systemChat   *SystemChat              // optional warning sink
+	workspaceDir string
+	dbPath       string
+	interval     time.Duration
+
+	mu         sync.Mutex
+	thresholds ResourceThresholds
+	latest     domain.SystemStats
+	alerting   map[string]bool // warning keys currently over threshold
+}
+
+// NewResourceMonitor samples every interval (1 minute if <= 0).
+func NewResourceMonitor(logger *slog.Logger, host ports.HostStatsReader, workspaceDir, dbPath string, interval time.Duration) *ResourceMonitor {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &ResourceMonitor{
+		logger:       logger,
+		host:         host,
+		workspaceDir: workspaceDir,
+		dbPath:       dbPath,
+		interval:     interval,
+		thresholds:   DefaultResourceThresholds,
+		alerting:     make(map[string]bool),
+	}
+}
+
+// SetRuntimeStats enables container daemon stats.
+func (m *ResourceMonitor) SetRuntimeStats(r ports.RuntimeStatsReader) {
+	m.runtime = r
+}
+
+// SetSystemChat makes threshold warnings appear in the kernel inbox.
+func (m *ResourceMonitor) SetSystemChat(sc *SystemChat) {
+	m.systemChat = sc
+}
+
+// SetThresholds replaces the warning thresholds.
+func (m *ResourceMonitor) SetThresholds(t ResourceThresholds) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.thresholds = t
+}
+
+// Run samples until ctx is cancelled.
+func (m *ResourceMonitor) Run(ctx context.Context) error {
+	m.logger.Info("resource monitor started", "interval", m.interval)
+	ctx = domain.WithSubsystem(ctx, "resource_monitor")
+	m.Sample(ctx) // prime the CPU counters and the first warnings
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.Sample(ctx)
+		}
+	}
+}
+
+// Latest returns the most recent sample, taking one if none is recent.
+func (m *ResourceMonitor) Latest(ctx context.Context) domain.SystemStats {
+	m.mu.Lock()
+	latest := m.latest
+	m.mu.Unlock()
+	if time.Since(latest.SampledAt) > 2*m.interval {
+		return m.Sample(ctx)
+	}
+	return latest
+}
+
+// Sample reads all sources now, records the result and sends warnings for
+// thresholds that have just been crossed.
+func (m *ResourceMonitor) Sample(ctx context.Context) domain.SystemStats {
+	stats := domain.SystemStats{
+		SampledAt: time.Now(),
+		Disks:     []domain.DiskStats{},
+		Warnings:  []string{},
+	}
+	fail := func(section string, err error) {
+		if stats.Errors == nil {
+			stats.Errors = map[string]string{}
+		}
+		stats.Errors[section] = err.Error()
+	}
+
+	if cpu, err := m.host.CPU(ctx); err != nil {
+		fail("cpu", err)
+	} else {
+		stats.CPU = &cpu
+	}
+	if mem, err := m.host.Memory(ctx); err != nil {
+		fail("memory", err)
+	} else {
+		stats.Memory = &mem
+	}
+	disks := []struct{ label, path string }{{"workspace", m.workspaceDir}}
+	if m.dbPath != "" {
+		disks = append(disks, struct{ label, path string }{"database", filepath.Dir(m.dbPath)})
+	}
+	for _, d := range disks {
+		if d.path == "" {
+			continue
+		}
+		disk, err := m.host.Disk(d.path)
+		if err != nil {
+			fail("disk_"+d.label, err)
+			continue
+		}
+		disk.Label = d.label
+		stats.Disks = append(stats.Disks, disk)
+	}
+	stats.DBSizeBytes = databaseSize(m.dbPath)
+
+	if m.runtime != nil {
+		rt, err := m.runtime.RuntimeStats(ctx)
+		if err != nil {
+			rt = domain.ContainerRuntimeStats{Error: err.Error()}
+		}
+		stats.Docker = &rt
+	}
+
+	m.mu.Lock()
+	warnings := m.checkThresholds(&stats)
+	m.latest = stats
+	m.mu.Unlock()
+
+	for _, w := range warnings {
+		m.logger.Warn("resource threshold exceeded", "warning", w)
+		if m.systemChat != nil {
+			m.systemChat.NotifyResourceWarning(ctx, w)
+		}
+	}
+	return stats
+}
+
+// checkThresholds fills stats.Warnings and returns the warnings that were not
+// active in the previous sample. Caller holds m.mu.
+func (m *ResourceMonitor) checkThresholds(stats *domain.SystemStats) []string {
+	t := m.thresholds
+	active := make(map[string]bool)
+	var fresh []string
+	warn := func(key, msg string) {
+		stats.Warnings = append(stats.Warnings, msg)
+		active[key] = true
+		if !m.alerting[key] {
+			fresh = append(fresh, msg)
+		}
+	}
+
+	for _, d := range stats.Disks {
+		if t.DiskPercent > 0 && d.UsedPercent > t.DiskPercent {
+			warn("disk:"+d.Path, fmt.Sprintf("Disk usage for %s (%s) is at %.0f%% (%s free)", d.Label, d.Path, d.UsedPercent, formatBytes(d.FreeBytes)))
+		}
+	}
+	if stats.Memory != nil && t.MemoryPercent > 0 && stats.Memory.UsedPercent > t.MemoryPercent {
+		warn("memory", fmt.Sprintf("Memory usage is at %.0f%% (%s available)", stats.Memory.UsedPercent, formatBytes(stats.Memory.AvailableBytes)))
+	}
+	if stats.CPU != nil && t.CPUPercent > 0 && stats.CPU.UsagePercent > t.CPUPercent {
+		warn("cpu", fmt.Sprintf("CPU usage is at %.0f%%", stats.CPU.UsagePercent))
+	}
+	if stats.Docker != nil && !stats.Docker.Available {
+		warn("docker", "Docker daemon is unreachable: "+stats.Docker.Error)
+	}
+
+	m.alerting = active
+	return fresh
+}
+
+// databaseSize is the size of the DuckDB file plus its write-ahead log.
+func databaseSize(path string) int64 {
+	var size int64
+	for _, p := range []string{path, path + ".wal"} {
+		if fi, err := os.Stat(p); err == nil {
+			size += fi.Size()
+		}
+	}
+	return size
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHostStats struct {
+	diskPercent float64
+	memErr      error
+}
+
+func (f *fakeHostStats) CPU(context.Context) (domain.CPUStats, error) {
+	return domain.CPUStats{Cores: 4, UsagePercent: 10}, nil
+}
+
+func (f *fakeHostStats) Memory(context.Context) (domain.MemoryStats, error) {
+	return domain.MemoryStats{TotalBytes: 100, UsedPercent: 50}, f.memErr
+}
+
+func (f *fakeHostStats) Disk(path string) (domain.DiskStats, error) {
+	return domain.DiskStats{Path: path, UsedPercent: f.diskPercent, FreeBytes: 2048}, nil
+}
+
+func TestResourceMonitor_WarnsOncePerCrossing(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	host := &fakeHostStats{diskPercent: 95}
+	m := NewResourceMonitor(logger, host, "/ws", "", 0)
+	ctx := context.Background()
+
+	stats := m.Sample(ctx)
+	require.Len(t, stats.Disks, 1)
+	assert.Equal(t, "workspace", stats.Disks[0].Label)
+	require.Len(t, stats.Warnings, 1)
+	assert.Contains(t, stats.Warnings[0], "95%")
+	assert.Len(t, m.checkThresholds(&stats), 0, "still over threshold: no new warning")
+
+	host.diskPercent = 50
+	assert.Empty(t, m.Sample(ctx).Warnings)
+
+	host.diskPercent = 92
+	m.mu.Lock()
+	fresh := m.checkThresholds(&domain.SystemStats{Disks: []domain.DiskStats{{Path: "/ws", Label: "workspace", UsedPercent: 92}}})
+	m.mu.Unlock()
+	assert.Len(t, fresh, 1, "warning re-arms after usage drops")
+}
+
+func TestResourceMonitor_ReportsSectionErrors(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	m := NewResourceMonitor(logger, &fakeHostStats{memErr: errors.New("no meminfo")}, "", "", 0)
+
+	stats := m.Sample(context.Background())
+	assert.Nil(t, stats.Memory)
+	assert.Equal(t, "no meminfo", stats.Errors["memory"])
+	require.NotNil(t, stats.CPU)
+	assert.Equal(t, 4, stats.CPU.Cores)
+}
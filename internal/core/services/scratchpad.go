@@ -0,0 +1,174 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	scratchpadMaxEntryBytes = 1 << 20 // 1MB per key
+	scratchpadMaxConvBytes  = 8 << 20 // 8MB per conversation
+	scratchpadMaxKeys       = 64
+	scratchpadIdleExpiry    = 24 * time.Hour
+)
+
+// Scratchpad is per-conversation, in-memory storage for intermediate agent
+// results. Unlike MEMORY.md it is never persisted: contents are lost on
+// restart and dropped once a conversation has been idle for a day.
+type Scratchpad struct {
+	mu   sync.Mutex
+	pads map[domain.ConversationID]*scratchpadConv
+	now  func() time.Time
+}
+
+type scratchpadConv struct {
+	entries  map[string]*ScratchpadEntry
+	size     int
+	lastUsed time.Time
+}
+
+// ScratchpadEntry is one named value in a conversation's scratchpad.
+type ScratchpadEntry struct {
+	Key       string    `json:"key"`
+	Content   string    `json:"-"`
+	Bytes     int       `json:"bytes"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewScratchpad creates an empty scratchpad.
+func NewScratchpad() *Scratchpad {
+	return &Scratchpad{
+		pads: make(map[domain.ConversationID]*scratchpadConv),
+		now:  time.Now,
+	}
+}
+
+// Write stores content under key, replacing it or appending to it. It
+// returns the entry's new size.
+func (s *Scratchpad) Write(convID domain.ConversationID, key, content string, appendTo bool) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expireLocked()
+
+	pad, ok := s.pads[convID]
+	if !ok {
+		pad = &scratchpadConv{entries: make(map[string]*ScratchpadEntry)}
+		s.pads[convID] = pad
+	}
+	pad.lastUsed = s.now()
+
+	entry, exists := pad.entries[key]
+	if !exists && len(pad.entries) >= scratchpadMaxKeys {
+		return 0, fmt.Errorf("scratchpad is full (%d keys); delete or overwrite an existing key", scratchpadMaxKeys)
+	}
+	newContent := content
+	oldSize := 0
+	if exists {
+		oldSize = entry.Bytes
+		if appendTo {
+			newContent = entry.Content + content
+		}
+	}
+	if len(newContent) > scratchpadMaxEntryBytes {
+		return 0, fmt.Errorf("entry %q would be %d bytes; the limit is %d", key, len(newContent), scratchpadMaxEntryBytes)
+	}
+	if pad.size-oldSize+len(newContent) > scratchpadMaxConvBytes {
+		return 0, fmt.Errorf("scratchpad would exceed %d bytes for this conversation; delete unused keys first", scratchpadMaxConvBytes)
+	}
+
+	pad.size += len(newContent) - oldSize
+	pad.entries[key] = &ScratchpadEntry{Key: key, Content: newContent, Bytes: len(newContent), UpdatedAt: pad.lastUsed}
+	return len(newContent), nil
+}
+
+// Read returns the entry stored under key.
+func (s *Scratchpad) Read(convID domain.ConversationID, key string) (ScratchpadEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pad, ok := s.pads[convID]
+	if !ok {
+		return ScratchpadEntry{}, false
+	}
+	pad.lastUsed = s.now()
+	entry, ok := pad.entries[key]
+	if !ok {
+		return ScratchpadEntry{}, false
+	}
+	return *entry, true
+}
+
+// Delete removes key. It reports whether the key existed.
+func (s *Scratchpad) Delete(convID domain.ConversationID, key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pad, ok := s.pads[convID]
+	if !ok {
+		return false
+	}
+	entry, ok := pad.entries[key]
+	if !ok {
+		return false
+	}
+	pad.size -= entry.Bytes
+	delete(pad.entries, key)
+	return true
+}
+
+// List returns the conversation's entries (without content), sorted by key.
+func (s *Scratchpad) List(convID domain.ConversationID) []ScratchpadEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pad, ok := s.pads[convID]
+	if !ok {
+		return []ScratchpadEntry{}
+	}
+	out := make([]ScratchpadEntry, 0, len(pad.entries))
+	for _, e := range pad.entries {
+		out = append(out, ScratchpadEntry{Key: e.Key, Bytes: e.Bytes, UpdatedAt: e.UpdatedAt})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// Clear drops everything stored for the conversation.
+func (s *Scratchpad) Clear(convID domain.ConversationID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pads, convID)
+}
+
+// expireLocked drops conversations idle for longer than scratchpadIdleExpiry.
+func (s *Scratchpad) expireLocked() {
+	cutoff := s.now().Add(-scratchpadIdleExpiry)
+	for id, pad := range s.pads {
+		if pad.lastUsed.Before(cutoff) {
+			delete(s.pads, id)
+		}
+	}
+}
+
+// sliceUTF8 returns up to limit bytes of content starting at offset, moving
+// both ends back to rune boundaries. next is the offset to continue from.
+func sliceUTF8(content string, offset, limit int) (chunk string, next int) {
+	if offset >= len(content) {
+		return "", len(content)
+	}
+	for offset > 0 && !utf8.RuneStart(content[offset]) {
+		offset--
+	}
+	end := min(offset+limit, len(content))
+	for end < len(content) && end > offset && !utf8.RuneStart(content[end]) {
+		end--
+	}
+	if end == offset {
+		// limit is smaller than the rune at offset; return it whole
+		_, size := utf8.DecodeRuneInString(content[offset:])
+		end = offset + size
+	}
+	return content[offset:end], end
+}
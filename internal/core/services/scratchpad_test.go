@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScratchpadTools_WriteAppendReadInChunks(t *testing.T) {
+	pad := NewScratchpad()
+	write := NewScratchpadWriteTool(pad)
+	read := NewScratchpadReadTool(pad)
+	ctx := ContextWithConversation(context.Background(), "conv-1")
+
+	_, err := write.Execute(ctx, map[string]interface{}{"key": "notes", "content": "héllo "})
+	require.NoError(t, err)
+	_, err = write.Execute(ctx, map[string]interface{}{"key": "notes", "content": "world", "append": true})
+	require.NoError(t, err)
+
+	// Chunks never split a rune, and next_offset walks the whole entry
+	var got strings.Builder
+	offset := 0.0
+	for i := 0; i < 20; i++ {
+		out, err := read.Execute(ctx, map[string]interface{}{"key": "notes", "offset": offset, "max_bytes": float64(2)})
+		require.NoError(t, err)
+		res := out.(map[string]interface{})
+		got.WriteString(res["content"].(string))
+		next, more := res["next_offset"]
+		if !more {
+			break
+		}
+		offset = float64(next.(int))
+	}
+	assert.Equal(t, "héllo world", got.String())
+
+	out, err := read.Execute(ctx, map[string]interface{}{})
+	require.NoError(t, err)
+	keys := out.(map[string]interface{})["keys"].([]ScratchpadEntry)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "notes", keys[0].Key)
+	assert.Equal(t, len("héllo world"), keys[0].Bytes)
+}
+
+func TestScratchpad_IsolatedPerConversation(t *testing.T) {
+	pad := NewScratchpad()
+	read := NewScratchpadReadTool(pad)
+	_, err := pad.Write("conv-1", "k", "secret", false)
+	require.NoError(t, err)
+
+	_, err = read.Execute(ContextWithConversation(context.Background(), "conv-2"), map[string]interface{}{"key": "k"})
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = read.Execute(context.Background(), map[string]interface{}{"key": "k"})
+	assert.ErrorContains(t, err, "requires a conversation")
+}
+
+func TestScratchpad_LimitsAndExpiry(t *testing.T) {
+	pad := NewScratchpad()
+	conv := domain.ConversationID("conv-1")
+
+	_, err := pad.Write(conv, "big", strings.Repeat("x", scratchpadMaxEntryBytes+1), false)
+	assert.ErrorContains(t, err, "limit")
+
+	for i := 0; i < scratchpadMaxKeys; i++ {
+		_, err := pad.Write(conv, string(rune('a'+i%26))+strings.Repeat("k", i/26), "v", false)
+		require.NoError(t, err)
+	}
+	_, err = pad.Write(conv, "one-too-many", "v", false)
+	assert.ErrorContains(t, err, "full")
+	assert.True(t, pad.Delete(conv, "a"))
+	_, err = pad.Write(conv, "one-too-many", "v", false)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	pad.now = func() time.Time { return now.Add(scratchpadIdleExpiry + time.Minute) }
+	_, err = pad.Write("conv-2", "k", "v", false)
+	require.NoError(t, err)
+	assert.Empty(t, pad.List(conv))
+}
@@ -0,0 +1,378 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+const (
+	DefaultShellImage       = "alpine:3.20"
+	defaultShellIdleTimeout = 30 * time.Minute
+	shellStartTimeout       = 2 * time.Minute
+)
+
+// ShellSessionManager keeps one persistent sandboxed shell per conversation,
+// so cwd, environment and shell state survive across agent steps.
+type ShellSessionManager struct {
+	logger      *slog.Logger
+	runtime     ports.ShellRuntime
+	ws          *WorkspaceManager
+	image       string
+	idleTimeout time.Duration
+
+	mu       sync.Mutex
+	sessions map[domain.ConversationID]*shellSession
+}
+
+// NewShellSessionManager starts shells from image (DefaultShellImage if
+// empty) and closes sessions idle for longer than idleTimeout (30m if <= 0).
+func NewShellSessionManager(logger *slog.Logger, runtime ports.ShellRuntime, ws *WorkspaceManager, image string, idleTimeout time.Duration) *ShellSessionManager {
+	if image == "" {
+		image = DefaultShellImage
+	}
+	if idleTimeout <= 0 {
+		idleTimeout = defaultShellIdleTimeout
+	}
+	return &ShellSessionManager{
+		logger:      logger,
+		runtime:     runtime,
+		ws:          ws,
+		image:       image,
+		idleTimeout: idleTimeout,
+		sessions:    make(map[domain.ConversationID]*shellSession),
+	}
+}
+
+// ShellRunResult is what shell_session_run returns.
+type ShellRunResult struct {
+	ExitCode  int    `json:"exit_code"`
+	Stdout    string `json:"stdout"`
+	Stderr    string `json:"stderr"`
+	Cwd       string `json:"cwd"` // shell working directory after the command
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// Open starts the conversation's shell, or returns the one already open.
+// The project workspace is mounted at /workspace when ctx carries a project.
+func (m *ShellSessionManager) Open(ctx context.Context, convID domain.ConversationID) (domain.ShellSessionInfo, bool, error) {
+	m.mu.Lock()
+	existing, ok := m.sessions[convID]
+	m.mu.Unlock()
+	if ok {
+		return existing.info(), false, nil
+	}
+
+	var dir string
+	var err error
+	if projectID, ok := GetProjectFromContext(ctx); ok && projectID != "" {
+		dir, err = m.ws.PrepareProject(string(projectID))
+	} else {
+		dir, err = m.ws.PrepareWorkspace("shell-" + string(convID))
+	}
+	if err != nil {
+		return domain.ShellSessionInfo{}, false, fmt.Errorf("prepare shell workspace: %w", err)
+	}
+
+	// Starting may pull an image; don't hold the lock meanwhile
+	startCtx, cancel := context.WithTimeout(ctx, shellStartTimeout)
+	defer cancel()
+	proc, err := m.runtime.StartShell(startCtx, domain.ShellSpec{
+		Image:        m.image,
+		WorkspaceDir: dir,
+		Labels:       map[string]string{"aule.conversation_id": string(convID)},
+	})
+	if err != nil {
+		return domain.ShellSessionInfo{}, false, err
+	}
+
+	s := newShellSession(convID, m.image, proc)
+	// Round-trip a no-op so startup noise (job control warnings) is discarded
+	if _, err := s.run(startCtx, "cd /workspace 2>/dev/null || true", 0); err != nil {
+		_ = proc.Close()
+		return domain.ShellSessionInfo{}, false, fmt.Errorf("shell did not start: %w", err)
+	}
+	s.commands = 0
+
+	m.mu.Lock()
+	if existing, ok := m.sessions[convID]; ok {
+		// Opened concurrently by another step of the same conversation
+		m.mu.Unlock()
+		_ = proc.Close()
+		return existing.info(), false, nil
+	}
+	m.sessions[convID] = s
+	m.mu.Unlock()
+	m.logger.InfoContext(ctx, "shell session opened", "conversation_id", string(convID), "image", m.image)
+	return s.info(), true, nil
+}
+
+// Run executes command in the conversation's open shell. On timeout the
+// session is closed, since the shell is still busy with the command.
+func (m *ShellSessionManager) Run(ctx context.Context, convID domain.ConversationID, command string, timeout time.Duration, maxOutput int) (ShellRunResult, error) {
+	m.mu.Lock()
+	s, ok := m.sessions[convID]
+	m.mu.Unlock()
+	if !ok {
+		return ShellRunResult{}, domain.ErrShellSessionNotFound
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := s.run(runCtx, command, maxOutput)
+	if err != nil {
+		m.Close(convID)
+		if runCtx.Err() == context.DeadlineExceeded {
+			return ShellRunResult{}, fmt.Errorf("command timed out after %s; the shell session was closed", timeout)
+		}
+		return ShellRunResult{}, fmt.Errorf("shell session failed and was closed: %w", err)
+	}
+	return res, nil
+}
+
+// Close stops the conversation's shell. It reports whether one was open.
+func (m *ShellSessionManager) Close(convID domain.ConversationID) bool {
+	m.mu.Lock()
+	s, ok := m.sessions[convID]
+	delete(m.sessions, convID)
+	m.mu.Unlock()
+	if !ok {
+		return false
+	}
+	if err := s.proc.Close(); err != nil {
+		m.logger.Warn("shell session close failed", "conversation_id", string(convID), "error", err)
+	}
+	m.logger.Info("shell session closed", "conversation_id", string(convID))
+	return true
+}
+
+// CloseAll stops every open shell (kernel shutdown).
+func (m *ShellSessionManager) CloseAll() {
+	m.mu.Lock()
+	ids := make([]domain.ConversationID, 0, len(m.sessions))
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	m.mu.Unlock()
+	for _, id := range ids {
+		m.Close(id)
+	}
+}
+
+// Sessions lists open shell sessions.
+func (m *ShellSessionManager) Sessions() []domain.ShellSessionInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]domain.ShellSessionInfo, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		out = append(out, s.info())
+	}
+	return out
+}
+
+// RunReaper closes idle sessions until ctx is cancelled, then closes all.
+func (m *ShellSessionManager) RunReaper(ctx context.Context) error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			m.CloseAll()
+			return nil
+		case now := <-ticker.C:
+			m.mu.Lock()
+			var idle []domain.ConversationID
+			for id, s := range m.sessions {
+				if now.Sub(s.lastUsed()) > m.idleTimeout {
+					idle = append(idle, id)
+				}
+			}
+			m.mu.Unlock()
+			for _, id := range idle {
+				m.Close(id)
+			}
+		}
+	}
+}
+
+// shellSession drives one shell process. Each command is sent base64-encoded
+// through eval (so quoting in the command cannot break the protocol), with
+// stdin from /dev/null, followed by a random marker on stdout and stderr
+// that carries the exit code and cwd.
+type shellSession struct {
+	convID  domain.ConversationID
+	image   string
+	proc    ports.ShellProcess
+	stdout  *shellStream
+	stderr  *shellStream
+	started time.Time
+
+	mu       sync.Mutex // one command at a time
+	commands int
+	cwd      string
+	used     time.Time
+}
+
+func newShellSession(convID domain.ConversationID, image string, proc ports.ShellProcess) *shellSession {
+	now := time.Now()
+	return &shellSession{
+		convID:  convID,
+		image:   image,
+		proc:    proc,
+		stdout:  newShellStream(proc.Stdout()),
+		stderr:  newShellStream(proc.Stderr()),
+		started: now,
+		used:    now,
+		cwd:     "/workspace",
+	}
+}
+
+func (s *shellSession) run(ctx context.Context, command string, maxOutput int) (ShellRunResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var raw [8]byte
+	_, _ = rand.Read(raw[:])
+	marker := "__AULE_DONE_" + hex.EncodeToString(raw[:]) + "__"
+	script := fmt.Sprintf("eval \"$(printf '%%s' '%s' | base64 -d)\" </dev/null; __aule_rc=$?; printf '\\n%s %%d %%s\\n' \"$__aule_rc\" \"$PWD\"; printf '\\n%s\\n' >&2\n",
+		base64.StdEncoding.EncodeToString([]byte(command)), marker, marker)
+	if _, err := io.WriteString(s.proc, script); err != nil {
+		return ShellRunResult{}, fmt.Errorf("write to shell: %w", err)
+	}
+
+	// Output is relayed as tool progress while the command runs
+	progress := func(chunk string) { ReportToolProgress(ctx, chunk) }
+	stdout, status, err := s.stdout.readUntil(ctx, marker, progress)
+	if err != nil {
+		return ShellRunResult{}, err
+	}
+	stderr, _, err := s.stderr.readUntil(ctx, marker, progress)
+	if err != nil {
+		return ShellRunResult{}, err
+	}
+
+	res := ShellRunResult{Cwd: s.cwd}
+	code, cwd, _ := strings.Cut(status, " ")
+	res.ExitCode, _ = strconv.Atoi(code)
+	if cwd != "" {
+		res.Cwd = cwd
+		s.cwd = cwd
+	}
+	if maxOutput > 0 {
+		var cut bool
+		stdout, cut = truncateOutput(stdout, maxOutput)
+		res.Truncated = cut
+		stderr, cut = truncateOutput(stderr, maxOutput)
+		res.Truncated = res.Truncated || cut
+	}
+	res.Stdout, res.Stderr = stdout, stderr
+	s.commands++
+	s.used = time.Now()
+	return res, nil
+}
+
+func (s *shellSession) lastUsed() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.used
+}
+
+func (s *shellSession) info() domain.ShellSessionInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return domain.ShellSessionInfo{
+		ConversationID: s.convID,
+		Image:          s.image,
+		Cwd:            s.cwd,
+		Commands:       s.commands,
+		StartedAt:      s.started,
+		LastUsedAt:     s.used,
+	}
+}
+
+// shellStream buffers one output stream of a shell and splits it at markers.
+type shellStream struct {
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	err     error
+	changed chan struct{} // closed and replaced on every write
+}
+
+func newShellStream(r io.Reader) *shellStream {
+	st := &shellStream{changed: make(chan struct{})}
+	go func() {
+		br := bufio.NewReader(r)
+		chunk := make([]byte, 4096)
+		for {
+			n, err := br.Read(chunk)
+			st.mu.Lock()
+			st.buf.Write(chunk[:n])
+			if err != nil {
+				st.err = err
+			}
+			close(st.changed)
+			st.changed = make(chan struct{})
+			st.mu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return st
+}
+
+// readUntil waits for a line starting with marker and returns the output
+// before it (minus the newline the protocol adds) and the rest of that line.
+// Output is passed to progress as it arrives, holding back what could be
+// the start of the marker.
+func (st *shellStream) readUntil(ctx context.Context, marker string, progress func(string)) (string, string, error) {
+	needle := "\n" + marker
+	sent := 0
+	for {
+		st.mu.Lock()
+		data := st.buf.String()
+		if i := strings.Index(data, needle); i >= 0 {
+			end := strings.IndexByte(data[i+len(needle):], '\n')
+			if end >= 0 {
+				lineEnd := i + len(needle) + end
+				st.buf.Next(lineEnd + 1)
+				st.mu.Unlock()
+				if i > sent {
+					progress(data[sent:i])
+				}
+				return data[:i], strings.TrimSpace(data[i+len(needle) : lineEnd]), nil
+			}
+		} else if safe := len(data) - len(needle); safe > sent {
+			st.mu.Unlock()
+			progress(data[sent:safe])
+			sent = safe
+			st.mu.Lock()
+		}
+		if st.err != nil {
+			err := st.err
+			st.mu.Unlock()
+			return "", "", fmt.Errorf("shell exited (did the command call exit?): %w", err)
+		}
+		changed := st.changed
+		st.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return "", "", ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localShellRuntime runs the shell on the host instead of in a container.
+type localShellRuntime struct{}
+
+type localShell struct {
+	io.Writer
+	cmd    *exec.Cmd
+	stdout io.Reader
+	stderr io.Reader
+}
+
+func (localShellRuntime) StartShell(ctx context.Context, spec domain.ShellSpec) (ports.ShellProcess, error) {
+	cmd := exec.Command("/bin/sh", "-i")
+	cmd.Dir = spec.WorkspaceDir
+	cmd.Env = append(os.Environ(), "PS1=", "PS2=")
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &localShell{Writer: stdin, cmd: cmd, stdout: stdout, stderr: stderr}, nil
+}
+
+func (s *localShell) Stdout() io.Reader { return s.stdout }
+func (s *localShell) Stderr() io.Reader { return s.stderr }
+func (s *localShell) Close() error {
+	_ = s.cmd.Process.Kill()
+	_ = s.cmd.Wait()
+	return nil
+}
+
+func TestShellSession_PersistsStateAcrossRuns(t *testing.T) {
+	if _, err := exec.LookPath("base64"); err != nil {
+		t.Skip("base64 not available")
+	}
+	ws, _ := testWorkspaceManager(t)
+	mgr := NewShellSessionManager(slog.New(slog.NewTextHandler(io.Discard, nil)), localShellRuntime{}, ws, "", 0)
+	defer mgr.CloseAll()
+
+	ctx := ContextWithConversation(context.Background(), "conv-1")
+	open := NewShellOpenTool(mgr)
+	run := NewShellRunTool(mgr)
+	closeTool := NewShellCloseTool(mgr)
+
+	_, err := run.Execute(ctx, map[string]interface{}{"command": "true"})
+	assert.ErrorContains(t, err, "shell_session_open")
+
+	_, err = open.Execute(ctx, map[string]interface{}{})
+	require.NoError(t, err)
+	out, err := open.Execute(ctx, map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Equal(t, "already open", out.(map[string]interface{})["status"])
+
+	_, err = run.Execute(ctx, map[string]interface{}{"command": "mkdir -p app && cd app && export GREETING='hi there'"})
+	require.NoError(t, err)
+
+	out, err = run.Execute(ctx, map[string]interface{}{"command": "echo \"$GREETING\"; echo oops >&2; (exit 4)"})
+	require.NoError(t, err)
+	res := out.(ShellRunResult)
+	assert.Equal(t, 4, res.ExitCode)
+	assert.Equal(t, "hi there\n", res.Stdout)
+	assert.Equal(t, "oops\n", res.Stderr)
+	assert.Equal(t, "app", filepath.Base(res.Cwd))
+
+	_, err = run.Execute(ctx, map[string]interface{}{"command": "exit 1"})
+	assert.ErrorContains(t, err, "shell exited")
+	assert.Empty(t, mgr.Sessions())
+
+	_, err = open.Execute(ctx, map[string]interface{}{})
+	require.NoError(t, err)
+	sessions := mgr.Sessions()
+	require.Len(t, sessions, 1)
+	assert.Equal(t, 0, sessions[0].Commands)
+
+	out, err = closeTool.Execute(ctx, map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Equal(t, "shell session closed", out)
+	assert.Empty(t, mgr.Sessions())
+}
+
+func TestShellStream_ReadUntilStreamsProgress(t *testing.T) {
+	r, w := io.Pipe()
+	st := newShellStream(r)
+	const marker = "__AULE_DONE_test__"
+
+	var chunks []string
+	done := make(chan string, 1)
+	go func() {
+		out, status, err := st.readUntil(context.Background(), marker, func(c string) { chunks = append(chunks, c) })
+		assert.NoError(t, err)
+		assert.Equal(t, "0 /workspace", status)
+		done <- out
+	}()
+
+	for _, part := range []string{"building...\n", "step 1 ok\n", "\n__AULE_", "DONE_test__ 0 /workspace\n"} {
+		_, err := io.WriteString(w, part)
+		require.NoError(t, err)
+		time.Sleep(10 * time.Millisecond)
+	}
+	out := <-done
+	assert.Equal(t, "building...\nstep 1 ok\n", out)
+	assert.Equal(t, out, strings.Join(chunks, ""), "streamed output must not include the marker")
+	assert.Greater(t, len(chunks), 1, "output should arrive in several chunks")
+	_ = w.Close()
+}
+
+func TestShellSession_TimeoutClosesSession(t *testing.T) {
+	if _, err := exec.LookPath("base64"); err != nil {
+		t.Skip("base64 not available")
+	}
+	ws, _ := testWorkspaceManager(t)
+	mgr := NewShellSessionManager(slog.New(slog.NewTextHandler(io.Discard, nil)), localShellRuntime{}, ws, "", 0)
+	defer mgr.CloseAll()
+
+	_, _, err := mgr.Open(context.Background(), "conv-2")
+	require.NoError(t, err)
+
+	_, err = mgr.Run(context.Background(), "conv-2", "sleep 5", 200*time.Millisecond, 0)
+	assert.ErrorContains(t, err, "timed out")
+	assert.Empty(t, mgr.Sessions())
+}
+
+func TestShellTools_RequireConversation(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	mgr := NewShellSessionManager(slog.New(slog.NewTextHandler(io.Discard, nil)), localShellRuntime{}, ws, "", 0)
+
+	_, err := NewShellOpenTool(mgr).Execute(context.Background(), map[string]interface{}{})
+	assert.ErrorContains(t, err, "conversation")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -26,12 +27,25 @@ type SubAgentOrchestrator struct {
 	bus     *EventBus
 	synapse *synapse.Runtime // Wasm runtime for fast-path sub-agents
 	tracer  *TraceCollector  // optional; for sub-agent span instrumentation
+	store   subAgentStore    // optional; persists task records for inspection
+	policy  *ToolPolicy      // optional; kernel-wide tool deny list
 
 	mu       sync.RWMutex
 	active   map[domain.SubAgentID]*domain.SubAgentTask // currently running
+	spawned  map[domain.ConversationID]int              // sub-agents started per conversation
 	maxIters int
+	limits   SubAgentLimits
 }
 
+// SubAgentLimits bounds recursive delegation.
+type SubAgentLimits struct {
+	MaxDepth           int // nested delegate/spawn levels allowed below the top-level agent
+	MaxPerConversation int // total sub-agents a single conversation may start
+}
+
+// DefaultSubAgentLimits allows one level of re-delegation and 20 sub-agents per conversation.
+var DefaultSubAgentLimits = SubAgentLimits{MaxDepth: 2, MaxPerConversation: 20}
+
 // NewSubAgentOrchestrator creates a new orchestrator.
 func NewSubAgentOrchestrator(
 	logger *slog.Logger,
@@ -49,8 +63,40 @@ func NewSubAgentOrchestrator(
 		bus:      bus,
 		synapse:  synapseRT,
 		active:   make(map[domain.SubAgentID]*domain.SubAgentTask),
+		spawned:  make(map[domain.ConversationID]int),
 		maxIters: 3, // sub-agents are focused — fewer iterations
+		limits:   DefaultSubAgentLimits,
+	}
+}
+
+// SetToolPolicy applies a kernel-wide tool deny list to sub-agents.
+func (o *SubAgentOrchestrator) SetToolPolicy(p *ToolPolicy) {
+	o.policy = p
+}
+
+// SetLimits overrides the delegation depth / per-conversation limits.
+// Non-positive fields keep their defaults.
+func (o *SubAgentOrchestrator) SetLimits(l SubAgentLimits) {
+	if l.MaxDepth <= 0 {
+		l.MaxDepth = DefaultSubAgentLimits.MaxDepth
 	}
+	if l.MaxPerConversation <= 0 {
+		l.MaxPerConversation = DefaultSubAgentLimits.MaxPerConversation
+	}
+	o.mu.Lock()
+	o.limits = l
+	o.mu.Unlock()
+}
+
+// subAgentStore persists sub-agent task records.
+type subAgentStore interface {
+	SaveSubAgentTask(ctx context.Context, task domain.SubAgentTask) error
+	GetSubAgentTask(ctx context.Context, id domain.SubAgentID) (domain.SubAgentTask, error)
+}
+
+// SetStore enables persistence of sub-agent tasks (status, steps, timing, parent tree).
+func (o *SubAgentOrchestrator) SetStore(s subAgentStore) {
+	o.store = s
 }
 
 // SetTracer injects an optional TraceCollector for sub-agent span instrumentation.
@@ -65,11 +111,29 @@ func (o *SubAgentOrchestrator) Delegate(
 	convID domain.ConversationID,
 	parentID domain.SubAgentID,
 	tasks []domain.DelegateTaskSpec,
+) ([]domain.SubAgentTask, error) {
+	return o.delegate(ctx, convID, parentID, tasks, nil)
+}
+
+// delegate fans tasks out to sub-agents; pad is the optional shared scratchpad.
+func (o *SubAgentOrchestrator) delegate(
+	ctx context.Context,
+	convID domain.ConversationID,
+	parentID domain.SubAgentID,
+	tasks []domain.DelegateTaskSpec,
+	pad *SharedScratchpad,
 ) ([]domain.SubAgentTask, error) {
 	if len(tasks) == 0 {
 		return nil, fmt.Errorf("no tasks to delegate")
 	}
 
+	depth := DelegationDepth(ctx) + 1
+	if err := o.reserve(convID, depth, len(tasks)); err != nil {
+		o.logger.Warn("delegation refused", "conversation_id", string(convID), "depth", depth, "error", err)
+		return nil, err
+	}
+	lineage := delegationLineage(ctx)
+
 	var (
 		wg      sync.WaitGroup
 		mu      sync.Mutex
@@ -81,11 +145,15 @@ func (o *SubAgentOrchestrator) Delegate(
 		go func(idx int, ts domain.DelegateTaskSpec) {
 			defer wg.Done()
 			var task domain.SubAgentTask
-			// Fast-path: if runtime=synapse and plugin specified, use Wasm directly
-			if ts.Runtime == "synapse" && ts.Plugin != "" && o.synapse != nil {
-				task = o.runWasmSubAgent(ctx, convID, parentID, ts)
+			key := lineageKey(ts)
+			childCtx := contextWithDelegation(ctx, depth, append(append([]string(nil), lineage...), key))
+			if slices.Contains(lineage, key) {
+				task = o.rejectCycle(convID, parentID, ts)
+			} else if ts.Runtime == "synapse" && ts.Plugin != "" && o.synapse != nil {
+				// Fast-path: if runtime=synapse and plugin specified, use Wasm directly
+				task = o.runWasmSubAgent(childCtx, convID, parentID, ts)
 			} else {
-				task = o.runSubAgent(ctx, convID, parentID, ts)
+				task = o.runSubAgent(childCtx, convID, parentID, ts, pad)
 			}
 			mu.Lock()
 			results[idx] = task
@@ -103,6 +171,7 @@ func (o *SubAgentOrchestrator) runSubAgent(
 	convID domain.ConversationID,
 	parentID domain.SubAgentID,
 	spec domain.DelegateTaskSpec,
+	pad *SharedScratchpad,
 ) domain.SubAgentTask {
 	saID := domain.NewSubAgentID()
 	now := time.Now()
@@ -170,13 +239,20 @@ func (o *SubAgentOrchestrator) runSubAgent(
 	}
 
 	// Build effective tool set
-	effectiveTools := o.tools
+	effectiveTools := o.policy.Apply(o.tools)
 	if len(persona.AllowedTools) > 0 {
-		effectiveTools = o.tools.FilterByNames(persona.AllowedTools)
+		effectiveTools = effectiveTools.FilterByNames(persona.AllowedTools)
+	}
+
+	// Shared scratchpad: expose read/write tools bound to this sub-agent
+	taskPrompt := spec.Prompt
+	if pad != nil {
+		effectiveTools = pad.withTools(effectiveTools, saID, persona.Name)
+		taskPrompt = pad.formatForPrompt() + taskPrompt
 	}
 
 	// Build prompt
-	prompt := o.buildSubAgentPrompt(persona, effectiveTools, spec.Prompt)
+	prompt := o.buildSubAgentPrompt(persona, effectiveTools, taskPrompt)
 	conversation := []string{prompt}
 	steps := []domain.ReActStep{}
 
@@ -218,12 +294,19 @@ func (o *SubAgentOrchestrator) runSubAgent(
 
 		// Execute tool if action present
 		if step.Action != "" {
-			result, err := effectiveTools.Execute(ctx, step.Action, step.ActionInput)
+			var result interface{}
+			toolName, note, err := o.policy.ResolveAction(ctx, effectiveTools, step.Action)
+			if err == nil {
+				result, err = effectiveTools.Execute(ContextWithPersona(ContextWithSubAgent(ctx, saID), *persona), toolName, step.ActionInput)
+			}
 			if err != nil {
 				step.Observation = fmt.Sprintf("Error: %v", err)
 			} else {
 				resultJSON, _ := json.Marshal(result)
 				step.Observation = string(resultJSON)
+				if note != "" {
+					step.Observation = note + "\n" + step.Observation
+				}
 			}
 			conversation = append(conversation, response)
 			conversation = append(conversation, fmt.Sprintf("Observation: %s", step.Observation))
@@ -338,6 +421,54 @@ func (o *SubAgentOrchestrator) runWasmSubAgent(
 	return task
 }
 
+// reserve checks the depth and per-conversation limits and, if allowed,
+// counts n new sub-agents against the conversation.
+func (o *SubAgentOrchestrator) reserve(convID domain.ConversationID, depth, n int) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if depth > o.limits.MaxDepth {
+		return fmt.Errorf("delegation depth limit reached (%d of %d): sub-agents at this level cannot delegate or spawn further — complete the task directly", depth-1, o.limits.MaxDepth)
+	}
+	used := o.spawned[convID]
+	if used+n > o.limits.MaxPerConversation {
+		return fmt.Errorf("sub-agent limit reached for this conversation (%d used, %d requested, max %d) — complete the task without more sub-agents", used, n, o.limits.MaxPerConversation)
+	}
+	o.spawned[convID] = used + n
+	return nil
+}
+
+// ForgetConversation drops the sub-agent count of a deleted conversation.
+func (o *SubAgentOrchestrator) ForgetConversation(convID domain.ConversationID) {
+	o.mu.Lock()
+	delete(o.spawned, convID)
+	o.mu.Unlock()
+}
+
+// rejectCycle returns a failed task for a sub-task that repeats one of its ancestors.
+func (o *SubAgentOrchestrator) rejectCycle(convID domain.ConversationID, parentID domain.SubAgentID, spec domain.DelegateTaskSpec) domain.SubAgentTask {
+	fin := time.Now()
+	task := domain.SubAgentTask{
+		ID:             domain.NewSubAgentID(),
+		ParentID:       parentID,
+		ConversationID: convID,
+		PersonaName:    spec.Persona,
+		Prompt:         spec.Prompt,
+		Status:         domain.SubAgentStatusFailed,
+		Error:          "delegation cycle detected: an ancestor sub-agent is already working on this exact task — answer it directly",
+		StartedAt:      fin,
+		FinishedAt:     &fin,
+	}
+	o.publishEvent(task, nil)
+	o.logger.Warn("sub-agent cycle rejected", "persona", spec.Persona, "conversation_id", string(convID))
+	return task
+}
+
+// lineageKey identifies a sub-task for cycle detection (persona + normalized prompt).
+func lineageKey(spec domain.DelegateTaskSpec) string {
+	return strings.ToLower(strings.TrimSpace(spec.Persona)) + "|" + strings.ToLower(strings.Join(strings.Fields(spec.Prompt), " "))
+}
+
 // GetActive returns currently running sub-agents.
 func (o *SubAgentOrchestrator) GetActive() []domain.SubAgentTask {
 	o.mu.RLock()
@@ -349,6 +480,20 @@ func (o *SubAgentOrchestrator) GetActive() []domain.SubAgentTask {
 	return out
 }
 
+// GetTask returns a sub-agent task, preferring the live in-memory copy.
+func (o *SubAgentOrchestrator) GetTask(ctx context.Context, id domain.SubAgentID) (domain.SubAgentTask, error) {
+	o.mu.RLock()
+	t, ok := o.active[id]
+	o.mu.RUnlock()
+	if ok {
+		return *t, nil
+	}
+	if o.store == nil {
+		return domain.SubAgentTask{}, domain.ErrSubAgentNotFound
+	}
+	return o.store.GetSubAgentTask(ctx, id)
+}
+
 // --- internal helpers ---
 
 func (o *SubAgentOrchestrator) resolvePersona(ctx context.Context, personaRef string) (*domain.Persona, error) {
@@ -391,7 +536,19 @@ RULES:
 Task: %s`, identity, toolsDesc, userPrompt)
 }
 
+// saveTask persists a task record when a store is configured.
+func (o *SubAgentOrchestrator) saveTask(task domain.SubAgentTask) {
+	if o.store == nil {
+		return
+	}
+	if err := o.store.SaveSubAgentTask(context.Background(), task); err != nil {
+		o.logger.Warn("failed to persist sub-agent task", "sa_id", string(task.ID), "error", err)
+	}
+}
+
 func (o *SubAgentOrchestrator) publishEvent(task domain.SubAgentTask, persona *domain.Persona) {
+	o.saveTask(task)
+
 	evt := domain.SubAgentEvent{
 		SubAgentID:     task.ID,
 		ParentID:       task.ParentID,
@@ -465,7 +622,7 @@ func (o *SubAgentOrchestrator) parseReActOutput(response string) domain.ReActSte
 		step.Thought = strings.TrimSpace(matches[1])
 	}
 
-	actionRe := regexp.MustCompile(`(?i)Action:\s*([a-z_]+)`)
+	actionRe := regexp.MustCompile(`(?i)Action:\s*([a-z][a-z0-9_]*(?:[/.][a-z0-9_]+)*)`)
 	if matches := actionRe.FindStringSubmatch(response); len(matches) > 1 {
 		step.Action = strings.TrimSpace(matches[1])
 	}
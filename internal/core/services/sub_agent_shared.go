@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// DelegateOptions enables the cooperative features of a delegation.
+type DelegateOptions struct {
+	SharedContext bool   // give sub-agents a shared read/write scratchpad
+	Aggregate     bool   // synthesize sub-agent outputs into a single cited answer
+	Goal          string // overall objective for the aggregation prompt
+}
+
+// SharedScratchpad is key/value state visible to every sub-agent of one delegation.
+// Each entry remembers which sub-agent wrote it last.
+type SharedScratchpad struct {
+	mu      sync.RWMutex
+	entries map[string]domain.ScratchpadEntry
+}
+
+// NewSharedScratchpad creates an empty scratchpad.
+func NewSharedScratchpad() *SharedScratchpad {
+	return &SharedScratchpad{entries: make(map[string]domain.ScratchpadEntry)}
+}
+
+// Write stores value under key, attributed to the given sub-agent.
+func (p *SharedScratchpad) Write(key, value string, saID domain.SubAgentID, personaName string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.entries[key] = domain.ScratchpadEntry{
+		Key:         key,
+		Value:       value,
+		SubAgentID:  saID,
+		PersonaName: personaName,
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Read returns the entry for key.
+func (p *SharedScratchpad) Read(key string) (domain.ScratchpadEntry, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	e, ok := p.entries[key]
+	return e, ok
+}
+
+// Snapshot returns all entries sorted by key.
+func (p *SharedScratchpad) Snapshot() []domain.ScratchpadEntry {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	out := make([]domain.ScratchpadEntry, 0, len(p.entries))
+	for _, e := range p.entries {
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// formatForPrompt renders the current scratchpad as a prompt preamble.
+func (p *SharedScratchpad) formatForPrompt() string {
+	entries := p.Snapshot()
+	var sb strings.Builder
+	sb.WriteString("You share a scratchpad with the other sub-agents of this delegation. ")
+	sb.WriteString("Use shared_read/shared_write to exchange intermediate findings.\n")
+	if len(entries) > 0 {
+		sb.WriteString("Current scratchpad:\n")
+		for _, e := range entries {
+			sb.WriteString(fmt.Sprintf("- %s (by %s): %s\n", e.Key, e.PersonaName, e.Value))
+		}
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// withTools returns a copy of tools extended with scratchpad tools bound to one sub-agent.
+func (p *SharedScratchpad) withTools(tools *domain.ToolRegistry, saID domain.SubAgentID, personaName string) *domain.ToolRegistry {
+	scoped := tools.Clone()
+	_ = scoped.Register(&domain.Tool{
+		Name:        "shared_read",
+		Description: "Read a value from the scratchpad shared by all sub-agents of this delegation. Omit key to list everything.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"key": map[string]interface{}{"type": "string", "description": "Key to read (optional)"},
+			},
+		},
+		Execute: func(_ context.Context, params map[string]interface{}) (interface{}, error) {
+			key, _ := params["key"].(string)
+			if key == "" {
+				return p.Snapshot(), nil
+			}
+			e, ok := p.Read(key)
+			if !ok {
+				return nil, fmt.Errorf("scratchpad key %q not found", key)
+			}
+			return e, nil
+		},
+	})
+	_ = scoped.Register(&domain.Tool{
+		Name:        "shared_write",
+		Description: "Write a value to the scratchpad shared by all sub-agents of this delegation.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"key":   map[string]interface{}{"type": "string", "description": "Key to write"},
+				"value": map[string]interface{}{"type": "string", "description": "Value to store"},
+			},
+			Required: []string{"key", "value"},
+		},
+		Execute: func(_ context.Context, params map[string]interface{}) (interface{}, error) {
+			key, _ := params["key"].(string)
+			value, _ := params["value"].(string)
+			if key == "" {
+				return nil, fmt.Errorf("key is required")
+			}
+			p.Write(key, value, saID, personaName)
+			return map[string]string{"status": "written", "key": key}, nil
+		},
+	})
+	return scoped
+}
+
+// DelegateWithOptions runs tasks like Delegate, optionally sharing a scratchpad
+// between sub-agents and synthesizing their outputs into one cited answer.
+func (o *SubAgentOrchestrator) DelegateWithOptions(
+	ctx context.Context,
+	convID domain.ConversationID,
+	parentID domain.SubAgentID,
+	tasks []domain.DelegateTaskSpec,
+	opts DelegateOptions,
+) (domain.DelegationResult, error) {
+	var pad *SharedScratchpad
+	if opts.SharedContext {
+		pad = NewSharedScratchpad()
+	}
+
+	results, err := o.delegate(ctx, convID, parentID, tasks, pad)
+	if err != nil {
+		return domain.DelegationResult{}, err
+	}
+
+	out := domain.DelegationResult{Tasks: results}
+	if pad != nil {
+		out.Scratchpad = pad.Snapshot()
+	}
+	if !opts.Aggregate {
+		return out, nil
+	}
+
+	out.Sources = delegationSources(results)
+	answer, err := o.aggregate(ctx, opts.Goal, results, out.Scratchpad)
+	if err != nil {
+		o.logger.Warn("sub-agent aggregation failed", "error", err)
+		return out, fmt.Errorf("aggregate results: %w", err)
+	}
+	out.Answer = answer
+	return out, nil
+}
+
+func delegationSources(results []domain.SubAgentTask) []domain.DelegationSource {
+	sources := make([]domain.DelegationSource, len(results))
+	for i, r := range results {
+		sources[i] = domain.DelegationSource{
+			Ref:         fmt.Sprintf("[%d]", i+1),
+			SubAgentID:  r.ID,
+			PersonaName: r.PersonaName,
+			Status:      r.Status,
+		}
+	}
+	return sources
+}
+
+// aggregate asks the orchestrator (general-role) model to merge sub-agent outputs,
+// citing each claim with the [n] marker of the sub-agent that produced it.
+func (o *SubAgentOrchestrator) aggregate(ctx context.Context, goal string, results []domain.SubAgentTask, scratchpad []domain.ScratchpadEntry) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("You are the orchestrator. Several sub-agents worked on parts of a request.\n")
+	sb.WriteString("Synthesize their outputs into ONE coherent answer.\n")
+	sb.WriteString("Cite the sub-agent behind every claim with its marker, e.g. [1] or [2][3].\n")
+	sb.WriteString("Ignore failed sub-agents unless their error matters to the user. Do not invent facts.\n\n")
+	if goal != "" {
+		sb.WriteString(fmt.Sprintf("Goal: %s\n\n", goal))
+	}
+	sb.WriteString("Sub-agent outputs:\n")
+	for i, r := range results {
+		sb.WriteString(fmt.Sprintf("[%d] %s — task: %s\n", i+1, r.PersonaName, r.Prompt))
+		if r.Status == domain.SubAgentStatusDone {
+			sb.WriteString(fmt.Sprintf("Result: %s\n\n", r.Result))
+		} else {
+			sb.WriteString(fmt.Sprintf("FAILED: %s\n\n", r.Error))
+		}
+	}
+	if len(scratchpad) > 0 {
+		sb.WriteString("Shared scratchpad:\n")
+		for _, e := range scratchpad {
+			sb.WriteString(fmt.Sprintf("- %s (by %s): %s\n", e.Key, e.PersonaName, e.Value))
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("Answer:")
+
+	modelID := o.router.ResolveModel(nil, domain.ModelRoleGeneral)
+	answer, err := o.router.GenerateText(ctx, sb.String(), modelID)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(answer), nil
+}
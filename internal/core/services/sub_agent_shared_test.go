@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedLLM answers sub-agent prompts by task keyword and records the aggregation prompt.
+type scriptedLLM struct {
+	mu        sync.Mutex
+	aggPrompt string
+}
+
+func (l *scriptedLLM) GenerateText(ctx context.Context, prompt string) (string, error) {
+	return l.GenerateTextWithModel(ctx, prompt, "")
+}
+
+func (l *scriptedLLM) GenerateTextWithModel(_ context.Context, prompt string, _ string) (string, error) {
+	switch {
+	case strings.Contains(prompt, "You are the orchestrator"):
+		l.mu.Lock()
+		l.aggPrompt = prompt
+		l.mu.Unlock()
+		return "Paris is the capital [1] and it has 2M people [2].", nil
+	case strings.Contains(prompt, "write the capital"):
+		if strings.Contains(prompt, "Observation:") {
+			return "Final Answer: stored", nil
+		}
+		return "Thought: share it\nAction: shared_write\nAction Input: {\"key\": \"capital\", \"value\": \"Paris\"}", nil
+	default:
+		return "Final Answer: about 2M people", nil
+	}
+}
+
+func (l *scriptedLLM) GenerateTextStream(ctx context.Context, prompt string) (<-chan domain.Chunk, error) {
+	return l.GenerateTextStreamWithModel(ctx, prompt, "")
+}
+
+func (l *scriptedLLM) GenerateTextStreamWithModel(ctx context.Context, prompt string, modelID string) (<-chan domain.Chunk, error) {
+	text, err := l.GenerateTextWithModel(ctx, prompt, modelID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.StreamOf(text), nil
+}
+
+type noPersonaRepo struct{}
+
+func (noPersonaRepo) GetPersona(context.Context, domain.PersonaID) (domain.Persona, error) {
+	return domain.Persona{}, domain.ErrPersonaNotFound
+}
+
+func TestDelegateWithOptions_SharedContextAndAggregation(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	llm := &scriptedLLM{}
+	orch := NewSubAgentOrchestrator(logger, NewModelRouter(logger, llm), domain.NewToolRegistry(), noPersonaRepo{}, NewEventBus(logger), nil)
+
+	res, err := orch.DelegateWithOptions(context.Background(), "conv-1", "", []domain.DelegateTaskSpec{
+		{Persona: "researcher", Prompt: "write the capital of France"},
+		{Persona: "assistant", Prompt: "estimate its population"},
+	}, DelegateOptions{SharedContext: true, Aggregate: true, Goal: "Describe Paris"})
+	require.NoError(t, err)
+
+	require.Len(t, res.Tasks, 2)
+	assert.Equal(t, domain.SubAgentStatusDone, res.Tasks[0].Status)
+
+	require.Len(t, res.Scratchpad, 1)
+	assert.Equal(t, "capital", res.Scratchpad[0].Key)
+	assert.Equal(t, "Paris", res.Scratchpad[0].Value)
+	assert.Equal(t, res.Tasks[0].ID, res.Scratchpad[0].SubAgentID)
+
+	assert.Contains(t, res.Answer, "[1]")
+	require.Len(t, res.Sources, 2)
+	assert.Equal(t, "[2]", res.Sources[1].Ref)
+	assert.Equal(t, res.Tasks[1].ID, res.Sources[1].SubAgentID)
+	assert.Contains(t, llm.aggPrompt, "Goal: Describe Paris")
+	assert.Contains(t, llm.aggPrompt, "capital (by Researcher): Paris")
+}
+
+func TestDelegate_DepthAndConversationLimits(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	orch := NewSubAgentOrchestrator(logger, NewModelRouter(logger, &scriptedLLM{}), domain.NewToolRegistry(), noPersonaRepo{}, NewEventBus(logger), nil)
+	orch.SetLimits(SubAgentLimits{MaxDepth: 1, MaxPerConversation: 3})
+
+	task := []domain.DelegateTaskSpec{{Persona: "assistant", Prompt: "count people"}}
+
+	// Already one level deep → nested delegation refused
+	nested := contextWithDelegation(context.Background(), 1, nil)
+	_, err := orch.Delegate(nested, "conv-a", "", task)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "depth limit")
+
+	// Per-conversation budget
+	two := append(task, domain.DelegateTaskSpec{Persona: "assistant", Prompt: "other"})
+	_, err = orch.Delegate(context.Background(), "conv-a", "", two)
+	require.NoError(t, err)
+	_, err = orch.Delegate(context.Background(), "conv-a", "", two)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sub-agent limit")
+
+	// Other conversations are unaffected
+	_, err = orch.Delegate(context.Background(), "conv-b", "", two)
+	assert.NoError(t, err)
+}
+
+func TestDelegate_RejectsCycles(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	orch := NewSubAgentOrchestrator(logger, NewModelRouter(logger, &scriptedLLM{}), domain.NewToolRegistry(), noPersonaRepo{}, NewEventBus(logger), nil)
+
+	spec := domain.DelegateTaskSpec{Persona: "Assistant", Prompt: "count  people"}
+	ctx := contextWithDelegation(context.Background(), 1, []string{lineageKey(domain.DelegateTaskSpec{Persona: "assistant", Prompt: "count people"})})
+
+	results, err := orch.Delegate(ctx, "conv-c", "", []domain.DelegateTaskSpec{spec})
+	require.NoError(t, err)
+	assert.Equal(t, domain.SubAgentStatusFailed, results[0].Status)
+	assert.Contains(t, results[0].Error, "cycle")
+}
+
+type memSubAgentStore struct {
+	mu    sync.Mutex
+	tasks map[domain.SubAgentID]domain.SubAgentTask
+}
+
+func (s *memSubAgentStore) SaveSubAgentTask(_ context.Context, t domain.SubAgentTask) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks[t.ID] = t
+	return nil
+}
+
+func (s *memSubAgentStore) GetSubAgentTask(_ context.Context, id domain.SubAgentID) (domain.SubAgentTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tasks[id]
+	if !ok {
+		return domain.SubAgentTask{}, domain.ErrSubAgentNotFound
+	}
+	return t, nil
+}
+
+func TestSpawnTool_PersistsAndNotifiesConversation(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	bus := NewEventBus(logger)
+	orch := NewSubAgentOrchestrator(logger, NewModelRouter(logger, &scriptedLLM{}), domain.NewToolRegistry(), noPersonaRepo{}, bus, nil)
+	orch.SetStore(&memSubAgentStore{tasks: map[domain.SubAgentID]domain.SubAgentTask{}})
+
+	convEvents, unsub := bus.Subscribe("conv-spawn")
+	defer unsub()
+
+	ctx := ContextWithConversation(context.Background(), "conv-spawn")
+	out, err := NewSpawnTool(orch, nil, bus, logger).Execute(ctx, map[string]interface{}{"task": "count people"})
+	require.NoError(t, err)
+	saID := out.(map[string]interface{})["sa_id"].(string)
+
+	// The conversation receives a summarized assistant message when the agent finishes
+	var msg map[string]interface{}
+	require.Eventually(t, func() bool {
+		for {
+			select {
+			case e := <-convEvents:
+				if e.Type == EventTypeNewMessage {
+					_ = json.Unmarshal([]byte(e.Data), &msg)
+					return true
+				}
+			default:
+				return false
+			}
+		}
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, saID, msg["sa_id"])
+	assert.Contains(t, msg["content"], "about 2M people")
+
+	status, err := NewCheckSpawnTool(orch).Execute(ctx, map[string]interface{}{"sa_id": saID})
+	require.NoError(t, err)
+	assert.Equal(t, "done", status.(map[string]interface{})["status"])
+	assert.Equal(t, "about 2M people", status.(map[string]interface{})["result"])
+
+	// Other conversations can't read it
+	other := ContextWithConversation(context.Background(), "conv-other")
+	_, err = NewCheckSpawnTool(orch).Execute(other, map[string]interface{}{"sa_id": saID})
+	assert.ErrorIs(t, err, domain.ErrSubAgentNotFound)
+}
+
+func TestSubAgentCountsLastUntilConversationDeleted(t *testing.T) {
+	o := NewSubAgentOrchestrator(slog.New(slog.NewTextHandler(os.Stderr, nil)), nil, nil, nil, nil, nil)
+	o.limits = SubAgentLimits{MaxDepth: 2, MaxPerConversation: 2}
+
+	require.NoError(t, o.reserve("conv-1", 1, 2))
+	require.NoError(t, o.reserve("conv-2", 1, 1))
+	assert.Error(t, o.reserve("conv-1", 1, 1), "limit reached")
+
+	// Only deleting the conversation resets its count
+	o.ForgetConversation("conv-1")
+	assert.NotContains(t, o.spawned, domain.ConversationID("conv-1"))
+	assert.Equal(t, 1, o.spawned["conv-2"])
+	assert.NoError(t, o.reserve("conv-1", 1, 2))
+}
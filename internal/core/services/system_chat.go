@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -117,6 +118,29 @@ func (s *SystemChat) NotifyJobResult(ctx context.Context, jobID string, status s
 	})
 }
 
+// NotifyResourceWarning posts a host resource warning (disk, memory, ...).
+func (s *SystemChat) NotifyResourceWarning(ctx context.Context, warning string) {
+	s.post(ctx, "⚠️ "+warning, map[string]interface{}{"kind": "resource_warning"})
+}
+
+// NotifyModelWarning posts a model configuration problem, e.g. a persona
+// whose model is no longer installed.
+func (s *SystemChat) NotifyModelWarning(ctx context.Context, warning string) {
+	s.post(ctx, "⚠️ "+warning, map[string]interface{}{"kind": "model_warning"})
+}
+
+// NotifyPluginHosts asks the user to approve the hosts a plugin wants to
+// reach with aule.http_fetch.
+func (s *SystemChat) NotifyPluginHosts(ctx context.Context, plugin string, hosts []string) {
+	content := fmt.Sprintf("🌐 Plugin **%s** asks for network access to: %s\n\nApprove with `PUT /v1/plugins/%s/hosts`; until then its requests are denied.",
+		plugin, "`"+strings.Join(hosts, "`, `")+"`", plugin)
+	s.post(ctx, content, map[string]interface{}{
+		"kind":   "plugin_hosts",
+		"plugin": plugin,
+		"hosts":  hosts,
+	})
+}
+
 // Ask posts a question to the user.
 // The user's reply goes back as a normal chat message in the system conversation.
 func (s *SystemChat) Ask(ctx context.Context, question string) {
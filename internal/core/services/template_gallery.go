@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// templatePersonaStore is the persona persistence the gallery installs into.
+type templatePersonaStore interface {
+	GetPersona(ctx context.Context, id domain.PersonaID) (domain.Persona, error)
+	CreatePersona(ctx context.Context, p domain.Persona) error
+}
+
+// TemplateGallery serves the built-in starter templates and community
+// bundles from a directory, and installs their personas and workflows.
+type TemplateGallery struct {
+	logger    *slog.Logger
+	personas  templatePersonaStore
+	workflows WorkflowRepository
+	dir       string // community bundles (*.json); empty = built-ins only
+}
+
+// NewTemplateGallery creates the gallery. Bundles in dir are read on every
+// listing, so new ones show up without a restart.
+func NewTemplateGallery(logger *slog.Logger, personas templatePersonaStore, workflows WorkflowRepository, dir string) *TemplateGallery {
+	return &TemplateGallery{logger: logger, personas: personas, workflows: workflows, dir: dir}
+}
+
+// List returns the built-in templates, then the community ones by ID.
+func (g *TemplateGallery) List() []domain.Template {
+	templates := domain.BuiltinTemplates()
+	return append(templates, g.loadCommunity(templates)...)
+}
+
+// Get returns one template.
+func (g *TemplateGallery) Get(id domain.TemplateID) (domain.Template, error) {
+	for _, t := range g.List() {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return domain.Template{}, domain.ErrTemplateNotFound
+}
+
+// Install creates the template's personas and its workflows as pending
+// definitions in projectID (nil = no project). Personas that already exist
+// are kept as they are, so installing twice does not undo user edits.
+func (g *TemplateGallery) Install(ctx context.Context, id domain.TemplateID, projectID *domain.ProjectID) (domain.TemplateInstall, error) {
+	t, err := g.Get(id)
+	if err != nil {
+		return domain.TemplateInstall{}, err
+	}
+	result := domain.TemplateInstall{
+		TemplateID: t.ID,
+		ProjectID:  projectID,
+		Personas:   []domain.PersonaID{},
+		Workflows:  []domain.WorkflowID{},
+	}
+	now := time.Now()
+
+	for _, p := range t.Personas {
+		if _, err := g.personas.GetPersona(ctx, p.ID); err == nil {
+			result.Personas = append(result.Personas, p.ID)
+			continue
+		} else if !errors.Is(err, domain.ErrPersonaNotFound) {
+			return result, fmt.Errorf("get persona %s: %w", p.ID, err)
+		}
+		p.IsBuiltin = false
+		p.CreatedAt, p.UpdatedAt = now, now
+		if err := g.personas.CreatePersona(ctx, p); err != nil {
+			return result, fmt.Errorf("create persona %s: %w", p.ID, err)
+		}
+		result.Personas = append(result.Personas, p.ID)
+	}
+
+	for _, tw := range t.Workflows {
+		wf := &domain.Workflow{
+			ID:          domain.WorkflowID(uuid.New().String()),
+			Name:        tw.Name,
+			Description: tw.Description,
+			Status:      domain.WorkflowStatusPending,
+			CreatedAt:   now,
+			State:       make(map[string]any, len(tw.State)),
+		}
+		if projectID != nil {
+			wf.ProjectID = *projectID
+		}
+		for k, v := range tw.State {
+			wf.State[k] = v
+		}
+		for _, s := range tw.Steps {
+			wf.Steps = append(wf.Steps, domain.WorkflowStep{
+				ID:            s.ID,
+				PersonaID:     s.PersonaID,
+				Prompt:        s.Prompt,
+				Tools:         s.Tools,
+				DependsOn:     s.DependsOn,
+				Interrupt:     s.Interrupt,
+				Deterministic: s.Deterministic,
+				MaxIters:      s.MaxIters,
+				Status:        domain.StepStatusPending,
+			})
+		}
+		if err := g.workflows.SaveWorkflow(ctx, wf); err != nil {
+			return result, fmt.Errorf("save workflow %q: %w", tw.Name, err)
+		}
+		result.Workflows = append(result.Workflows, wf.ID)
+	}
+
+	g.logger.InfoContext(ctx, "template installed", "template_id", t.ID,
+		"personas", len(result.Personas), "workflows", len(result.Workflows))
+	return result, nil
+}
+
+// loadCommunity reads the bundles in the templates directory. A bundle is
+// a JSON file holding one template or an array of them. Invalid templates
+// and IDs already taken (by a built-in or an earlier bundle) are skipped.
+func (g *TemplateGallery) loadCommunity(existing []domain.Template) []domain.Template {
+	if g.dir == "" {
+		return nil
+	}
+	files, err := filepath.Glob(filepath.Join(g.dir, "*.json"))
+	if err != nil || len(files) == 0 {
+		return nil
+	}
+	taken := make(map[domain.TemplateID]bool, len(existing))
+	for _, t := range existing {
+		taken[t.ID] = true
+	}
+
+	var community []domain.Template
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			g.logger.Warn("failed to read template bundle", "file", file, "error", err)
+			continue
+		}
+		var bundle []domain.Template
+		if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+			err = json.Unmarshal(data, &bundle)
+		} else {
+			var t domain.Template
+			err = json.Unmarshal(data, &t)
+			bundle = []domain.Template{t}
+		}
+		if err != nil {
+			g.logger.Warn("invalid template bundle", "file", file, "error", err)
+			continue
+		}
+		for _, t := range bundle {
+			t.Source = domain.TemplateSourceCommunity
+			if err := t.Validate(); err != nil {
+				g.logger.Warn("skipping template", "file", file, "error", err)
+				continue
+			}
+			if taken[t.ID] {
+				g.logger.Warn("skipping template with duplicate id", "file", file, "template_id", t.ID)
+				continue
+			}
+			taken[t.ID] = true
+			community = append(community, t)
+		}
+	}
+	sort.Slice(community, func(i, j int) bool { return community[i].ID < community[j].ID })
+	return community
+}
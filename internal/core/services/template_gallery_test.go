@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memTemplateStore struct {
+	personas  map[domain.PersonaID]domain.Persona
+	workflows map[domain.WorkflowID]*domain.Workflow
+}
+
+func newMemTemplateStore() *memTemplateStore {
+	return &memTemplateStore{personas: map[domain.PersonaID]domain.Persona{}, workflows: map[domain.WorkflowID]*domain.Workflow{}}
+}
+
+func (m *memTemplateStore) GetPersona(_ context.Context, id domain.PersonaID) (domain.Persona, error) {
+	p, ok := m.personas[id]
+	if !ok {
+		return domain.Persona{}, domain.ErrPersonaNotFound
+	}
+	return p, nil
+}
+
+func (m *memTemplateStore) CreatePersona(_ context.Context, p domain.Persona) error {
+	m.personas[p.ID] = p
+	return nil
+}
+
+func (m *memTemplateStore) GetWorkflow(_ context.Context, id domain.WorkflowID) (*domain.Workflow, error) {
+	return m.workflows[id], nil
+}
+
+func (m *memTemplateStore) SaveWorkflow(_ context.Context, wf *domain.Workflow) error {
+	m.workflows[wf.ID] = wf
+	return nil
+}
+
+func (m *memTemplateStore) ListWorkflows(_ context.Context) ([]domain.Workflow, error) {
+	return nil, nil
+}
+
+func TestBuiltinTemplates_Valid(t *testing.T) {
+	ids := map[domain.TemplateID]bool{}
+	for _, tmpl := range domain.BuiltinTemplates() {
+		assert.NoError(t, tmpl.Validate(), tmpl.ID)
+		assert.False(t, ids[tmpl.ID], "duplicate id %s", tmpl.ID)
+		ids[tmpl.ID] = true
+	}
+	for _, id := range []domain.TemplateID{"daily-digest", "repo-triage", "research-report"} {
+		assert.True(t, ids[id], id)
+	}
+}
+
+func TestTemplateGallery_Install(t *testing.T) {
+	store := newMemTemplateStore()
+	g := NewTemplateGallery(slog.Default(), store, store, "")
+	ctx := context.Background()
+
+	// A persona the user already edited is kept
+	store.personas["pers-tpl-triager"] = domain.Persona{ID: "pers-tpl-triager", Name: "My triager"}
+
+	projectID := domain.ProjectID("proj-1")
+	res, err := g.Install(ctx, "repo-triage", &projectID)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.PersonaID{"pers-tpl-triager"}, res.Personas)
+	assert.Equal(t, "My triager", store.personas["pers-tpl-triager"].Name)
+	require.Len(t, res.Workflows, 1)
+
+	wf := store.workflows[res.Workflows[0]]
+	require.NotNil(t, wf)
+	assert.Equal(t, projectID, wf.ProjectID)
+	assert.Equal(t, domain.WorkflowStatusPending, wf.Status)
+	require.Len(t, wf.Steps, 3)
+	for _, s := range wf.Steps {
+		assert.Equal(t, domain.StepStatusPending, s.Status)
+	}
+
+	res, err = g.Install(ctx, "daily-digest", nil)
+	require.NoError(t, err)
+	editor, ok := store.personas["pers-tpl-editor"]
+	require.True(t, ok)
+	assert.False(t, editor.IsBuiltin)
+	assert.False(t, editor.CreatedAt.IsZero())
+	wf = store.workflows[res.Workflows[0]]
+	assert.Equal(t, "AI, open source, self-hosting", wf.State["topics"])
+	assert.Empty(t, wf.ProjectID)
+
+	_, err = g.Install(ctx, "nope", nil)
+	assert.ErrorIs(t, err, domain.ErrTemplateNotFound)
+}
+
+func TestTemplateGallery_CommunityBundles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	write("single.json", `{"id": "standup", "name": "Standup", "workflows": [{"name": "Standup", "steps": [{"id": "notes", "prompt": "Summarize yesterday"}]}]}`)
+	write("bundle.json", `[
+		{"id": "blog", "name": "Blog", "personas": [{"id": "pers-writer", "name": "Writer", "system_prompt": "You write."}],
+		 "workflows": [{"name": "Post", "steps": [{"id": "draft", "persona_id": "pers-writer", "prompt": "Draft"}, {"id": "edit", "prompt": "Edit {{state.draft}}", "depends_on": ["draft"]}]}]},
+		{"id": "daily-digest", "name": "Shadows a built-in", "workflows": [{"name": "x", "steps": [{"id": "a", "prompt": "a"}]}]},
+		{"id": "cycle", "name": "Bad deps", "workflows": [{"name": "x", "steps": [{"id": "a", "prompt": "a", "depends_on": ["b"]}, {"id": "b", "prompt": "b", "depends_on": ["a"]}]}]},
+		{"id": "ghost", "name": "Unknown persona", "workflows": [{"name": "x", "steps": [{"id": "a", "persona_id": "pers-missing", "prompt": "a"}]}]}
+	]`)
+	write("broken.json", `{not json`)
+	write("readme.txt", `ignored`)
+
+	store := newMemTemplateStore()
+	g := NewTemplateGallery(slog.Default(), store, store, dir)
+
+	var community []domain.TemplateID
+	for _, tmpl := range g.List() {
+		if tmpl.Source == domain.TemplateSourceCommunity {
+			community = append(community, tmpl.ID)
+		}
+	}
+	assert.Equal(t, []domain.TemplateID{"blog", "standup"}, community)
+
+	digest, err := g.Get("daily-digest")
+	require.NoError(t, err)
+	assert.Equal(t, domain.TemplateSourceBuiltin, digest.Source)
+
+	res, err := g.Install(context.Background(), "blog", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.PersonaID{"pers-writer"}, res.Personas)
+	assert.Len(t, res.Workflows, 1)
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// NewCreateAutomationTool returns a tool that creates an automation rule.
+func NewCreateAutomationTool(automations *AutomationService) *domain.Tool {
+	return &domain.Tool{
+		Name: "create_automation",
+		Description: "Creates an automation rule: when the trigger fires and all conditions hold, the action runs. " +
+			"Triggers: cron (cron_expr), webhook (an HTTP endpoint is returned), feed (feed_url: new RSS/Atom items), file (path: changes in a workspace directory). " +
+			"Actions: prompt (run through the agent), workflow (start a copy of workflow_id), tool (call a tool with args, no LLM). " +
+			"Prompts and tool args can use {{event.<field>}}, e.g. {{event.titles}} for feeds, {{event.paths}} for files, {{params.payload}} or {{event.body.<key>}} for webhooks.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Short name for the rule.",
+				},
+				"trigger": map[string]interface{}{
+					"type":        "object",
+					"description": "When the rule fires.",
+					"properties": map[string]interface{}{
+						"kind":              map[string]interface{}{"type": "string", "enum": []string{"cron", "webhook", "feed", "file"}},
+						"cron_expr":         map[string]interface{}{"type": "string", "description": "cron: 5-field expression, e.g. '0 9 * * 1' (Mondays 09:00)."},
+						"feed_url":          map[string]interface{}{"type": "string", "description": "feed: RSS/Atom URL."},
+						"feed_interval_sec": map[string]interface{}{"type": "number", "description": "feed: polling interval in seconds."},
+						"path":              map[string]interface{}{"type": "string", "description": "file: directory relative to the workspace, e.g. 'inbox'."},
+						"patterns":          map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}, "description": "file: glob filters, e.g. ['*.pdf']."},
+						"recursive":         map[string]interface{}{"type": "boolean", "description": "file: include subdirectories."},
+						"file_events":       map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}, "description": "file: create, write, remove, rename (default create and write)."},
+						"debounce_sec":      map[string]interface{}{"type": "number", "description": "file: quiet period before firing."},
+					},
+					"required": []string{"kind"},
+				},
+				"conditions": map[string]interface{}{
+					"type":        "array",
+					"description": "Optional filters on event fields; all must hold. op: equals, not_equals, contains, not_contains, matches (regex).",
+					"items": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"field": map[string]interface{}{"type": "string"},
+							"op":    map[string]interface{}{"type": "string"},
+							"value": map[string]interface{}{"type": "string"},
+						},
+					},
+				},
+				"action": map[string]interface{}{
+					"type":        "object",
+					"description": "What the rule does.",
+					"properties": map[string]interface{}{
+						"kind":        map[string]interface{}{"type": "string", "enum": []string{"prompt", "workflow", "tool"}},
+						"prompt":      map[string]interface{}{"type": "string"},
+						"workflow_id": map[string]interface{}{"type": "string"},
+						"tool":        map[string]interface{}{"type": "string"},
+						"args":        map[string]interface{}{"type": "object"},
+						"persona_id":  map[string]interface{}{"type": "string"},
+					},
+					"required": []string{"kind"},
+				},
+				"enabled": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Start enabled (default true).",
+				},
+				"project_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Project to associate the rule with (default: current project).",
+				},
+			},
+			Required: []string{"trigger", "action"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			a := &domain.Automation{Enabled: true, CreatedBy: "agent"}
+			if err := decodeParam(params["trigger"], &a.Trigger); err != nil {
+				return nil, fmt.Errorf("invalid trigger: %w", err)
+			}
+			a.Trigger.WebhookToken, a.Trigger.SourceID = "", ""
+			if err := decodeParam(params["action"], &a.Action); err != nil {
+				return nil, fmt.Errorf("invalid action: %w", err)
+			}
+			if params["conditions"] != nil {
+				if err := decodeParam(params["conditions"], &a.Conditions); err != nil {
+					return nil, fmt.Errorf("invalid conditions: %w", err)
+				}
+			}
+			if enabled, ok := params["enabled"].(bool); ok {
+				a.Enabled = enabled
+			}
+			name, _ := params["name"].(string)
+			a.Name = strings.TrimSpace(name)
+
+			projectID, _ := params["project_id"].(string)
+			if projectID == "" {
+				if pID, found := GetProjectFromContext(ctx); found {
+					projectID = string(pID)
+				}
+			}
+			a.ProjectID = domain.ProjectID(projectID)
+
+			if err := automations.Create(ctx, a); err != nil {
+				return nil, err
+			}
+			msg := fmt.Sprintf("Automation '%s' created (ID: %s): %s → %s.", a.Name, a.ID, describeTrigger(a.Trigger), a.Action.Kind)
+			if a.Trigger.Kind == domain.AutomationTriggerWebhook {
+				msg += fmt.Sprintf(" Webhook: POST /v1/hooks/%s (the request body is {{params.payload}}).", a.Trigger.WebhookToken)
+			}
+			if a.NextRun != nil {
+				msg += " Next run: " + a.NextRun.Format("2006-01-02 15:04") + "."
+			}
+			return msg, nil
+		},
+	}
+}
+
+// decodeParam converts a JSON-shaped tool parameter into out.
+func decodeParam(v interface{}, out interface{}) error {
+	if v == nil {
+		return fmt.Errorf("missing")
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
+
+// NewListAutomationsTool returns a tool that lists automation rules.
+func NewListAutomationsTool(automations *AutomationService) *domain.Tool {
+	return &domain.Tool{
+		Name:        "list_automations",
+		Description: "Lists automation rules with their trigger, action and last run.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"project_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional: only rules of this project.",
+				},
+			},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			projectID, _ := params["project_id"].(string)
+			rules, err := automations.List(ctx, domain.ProjectID(projectID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list automations: %w", err)
+			}
+			if len(rules) == 0 {
+				return "No automations.", nil
+			}
+
+			var lines []string
+			for _, a := range rules {
+				last := "never"
+				if a.LastRun != nil {
+					last = a.LastRun.Format("2006-01-02 15:04")
+				}
+				line := fmt.Sprintf("- %s (ID: %s) %s → %s", a.Name, a.ID, describeTrigger(a.Trigger), a.Action.Kind)
+				if len(a.Conditions) > 0 {
+					line += fmt.Sprintf(" if %d conditions", len(a.Conditions))
+				}
+				line += fmt.Sprintf(" last=%s runs=%d", last, a.RunCount)
+				if !a.Enabled {
+					line += " [disabled]"
+				}
+				if a.LastError != "" {
+					line += " error=" + a.LastError
+				}
+				lines = append(lines, line)
+			}
+			return fmt.Sprintf("%d automations:\n%s", len(rules), strings.Join(lines, "\n")), nil
+		},
+	}
+}
+
+// NewDeleteAutomationTool returns a tool that deletes an automation rule.
+func NewDeleteAutomationTool(automations *AutomationService) *domain.Tool {
+	return &domain.Tool{
+		Name:        "delete_automation",
+		Description: "Deletes an automation rule by ID, including its feed or file watch.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"automation_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The ID of the rule to delete.",
+				},
+			},
+			Required: []string{"automation_id"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			id, _ := params["automation_id"].(string)
+			if id == "" {
+				return nil, fmt.Errorf("automation_id is required")
+			}
+			if err := automations.Delete(ctx, domain.AutomationID(id)); err != nil {
+				return nil, fmt.Errorf("failed to delete automation: %w", err)
+			}
+			return fmt.Sprintf("Automation %s deleted.", id), nil
+		},
+	}
+}
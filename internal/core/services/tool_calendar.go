@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	calendarDefaultDays     = 7
+	calendarMaxDays         = 92
+	calendarDefaultDuration = 30 * time.Minute
+)
+
+// NewCalendarListTool returns a tool that lists events on the user's calendar.
+func NewCalendarListTool(cal *Calendar) *domain.Tool {
+	return &domain.Tool{
+		Name:        "calendar_list_events",
+		Description: "Lists events on the user's calendar (CalDAV or Google Calendar) in a time range. Use it to check availability before creating an event.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"from": map[string]interface{}{
+					"type":        "string",
+					"description": "Range start: RFC 3339 ('2026-03-10T09:00:00-03:00'), local time ('2026-03-10T09:00') or date ('2026-03-10'). Default: now.",
+				},
+				"to": map[string]interface{}{
+					"type":        "string",
+					"description": fmt.Sprintf("Range end, same formats; a date includes that whole day. Default: %d days after from (max %d).", calendarDefaultDays, calendarMaxDays),
+				},
+			},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			loc := cal.Location()
+			from := time.Now().In(loc)
+			if s, _ := params["from"].(string); s != "" {
+				t, _, err := parseCalendarTime(s, loc)
+				if err != nil {
+					return nil, fmt.Errorf("from: %w", err)
+				}
+				from = t
+			}
+			to := from.AddDate(0, 0, calendarDefaultDays)
+			if s, _ := params["to"].(string); s != "" {
+				t, dateOnly, err := parseCalendarTime(s, loc)
+				if err != nil {
+					return nil, fmt.Errorf("to: %w", err)
+				}
+				if dateOnly {
+					t = t.AddDate(0, 0, 1)
+				}
+				to = t
+			}
+			if !to.After(from) {
+				return nil, fmt.Errorf("to must be after from")
+			}
+			if to.Sub(from) > calendarMaxDays*24*time.Hour {
+				return nil, fmt.Errorf("range is limited to %d days", calendarMaxDays)
+			}
+
+			events, err := cal.ListEvents(ctx, from, to)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"from":   from.Format(time.RFC3339),
+				"to":     to.Format(time.RFC3339),
+				"events": localizeEvents(events, loc),
+			}, nil
+		},
+	}
+}
+
+// NewCalendarCreateTool returns a tool that creates events on the user's
+// calendar, inviting attendees where the backend supports it.
+func NewCalendarCreateTool(cal *Calendar) *domain.Tool {
+	return &domain.Tool{
+		Name:        "calendar_create_event",
+		Description: "Creates an event on the user's real calendar (CalDAV or Google Calendar), e.g. meetings and reminders. Attendees receive invitations when the calendar supports it. For recurring internal jobs use schedule_task instead.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"title": map[string]interface{}{
+					"type":        "string",
+					"description": "Event title.",
+				},
+				"start": map[string]interface{}{
+					"type":        "string",
+					"description": "Start: RFC 3339, local time ('2026-03-10T14:00') or a date ('2026-03-10') for an all-day event.",
+				},
+				"end": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional end, same format as start. For all-day events the end date is inclusive.",
+				},
+				"duration_minutes": map[string]interface{}{
+					"type":        "number",
+					"description": "Optional duration when end is omitted (default 30).",
+				},
+				"description": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional notes or agenda.",
+				},
+				"location": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional location or meeting link.",
+				},
+				"attendees": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Optional attendee email addresses.",
+				},
+			},
+			Required: []string{"title", "start"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			title, _ := params["title"].(string)
+			startRaw, _ := params["start"].(string)
+			if strings.TrimSpace(title) == "" {
+				return nil, fmt.Errorf("title is required")
+			}
+			loc := cal.Location()
+			start, allDay, err := parseCalendarTime(startRaw, loc)
+			if err != nil {
+				return nil, fmt.Errorf("start: %w", err)
+			}
+
+			var end time.Time
+			if s, _ := params["end"].(string); s != "" {
+				t, dateOnly, err := parseCalendarTime(s, loc)
+				if err != nil {
+					return nil, fmt.Errorf("end: %w", err)
+				}
+				if dateOnly != allDay {
+					return nil, fmt.Errorf("start and end must both be dates or both be times")
+				}
+				end = t
+				if allDay {
+					end = end.AddDate(0, 0, 1) // inclusive for the caller, exclusive for the calendar
+				}
+			} else if allDay {
+				end = start.AddDate(0, 0, 1)
+			} else {
+				d := calendarDefaultDuration
+				if n, ok := params["duration_minutes"].(float64); ok && n > 0 {
+					d = time.Duration(n) * time.Minute
+				}
+				end = start.Add(d)
+			}
+			if !end.After(start) {
+				return nil, fmt.Errorf("end must be after start")
+			}
+
+			desc, _ := params["description"].(string)
+			location, _ := params["location"].(string)
+			ev, err := cal.CreateEvent(ctx, domain.CalendarEvent{
+				Title:       strings.TrimSpace(title),
+				Description: desc,
+				Location:    location,
+				Start:       start,
+				End:         end,
+				AllDay:      allDay,
+				Attendees:   addressList(params["attendees"]),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"status": "created",
+				"event":  localizeEvents([]domain.CalendarEvent{ev}, loc)[0],
+			}, nil
+		},
+	}
+}
+
+// parseCalendarTime accepts RFC 3339, a local date-time without offset
+// (interpreted in loc) or a bare date.
+func parseCalendarTime(s string, loc *time.Location) (t time.Time, dateOnly bool, err error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, false, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04"} {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, false, nil
+		}
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, loc); err == nil {
+		return t, true, nil
+	}
+	return time.Time{}, false, fmt.Errorf("unrecognized time %q (use RFC 3339, 2006-01-02T15:04 or 2006-01-02)", s)
+}
+
+// localizeEvents shows timed events in the calendar's zone, which is what
+// the user reads them in.
+func localizeEvents(events []domain.CalendarEvent, loc *time.Location) []domain.CalendarEvent {
+	out := make([]domain.CalendarEvent, len(events))
+	for i, ev := range events {
+		if !ev.AllDay {
+			ev.Start = ev.Start.In(loc)
+			ev.End = ev.End.In(loc)
+		}
+		out[i] = ev
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// NewSendEmailTool returns a tool that sends plain-text email over the
+// configured SMTP server.
+func NewSendEmailTool(svc *EmailService) *domain.Tool {
+	return &domain.Tool{
+		Name:        "send_email",
+		Description: "Sends a plain-text email via the configured SMTP server. Omit 'to' to send to the user's default address (e.g. for 'email me the report'). Recipients may be restricted by the allowed recipients setting.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"to": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Recipient addresses. Optional: defaults to the configured default recipient.",
+				},
+				"cc": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Optional CC addresses.",
+				},
+				"subject": map[string]interface{}{
+					"type":        "string",
+					"description": "Subject line.",
+				},
+				"body": map[string]interface{}{
+					"type":        "string",
+					"description": "Plain-text body.",
+				},
+			},
+			Required: []string{"subject", "body"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			subject, _ := params["subject"].(string)
+			body, _ := params["body"].(string)
+			if strings.TrimSpace(subject) == "" {
+				return nil, fmt.Errorf("subject is required")
+			}
+			if strings.TrimSpace(body) == "" {
+				return nil, fmt.Errorf("body is required")
+			}
+			msg := domain.EmailMessage{
+				To:      addressList(params["to"]),
+				Cc:      addressList(params["cc"]),
+				Subject: subject,
+				Body:    body,
+			}
+			id, err := svc.SendEmail(ctx, msg)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"status":     "sent",
+				"message_id": id,
+			}, nil
+		},
+	}
+}
+
+// addressList accepts a list of addresses or a single comma-separated string.
+func addressList(v interface{}) []string {
+	var raw []string
+	switch t := v.(type) {
+	case string:
+		raw = strings.Split(t, ",")
+	case []interface{}:
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	}
+	var out []string
+	for _, s := range raw {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -3,9 +3,13 @@ package services
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -43,12 +47,44 @@ func isDangerousCommand(cmd string) bool {
 	return false
 }
 
+// DefaultExecEnvAllowlist names the environment variables the agent may set
+// for exec commands. Anything else (PATH, HOME, LD_PRELOAD, ...) is refused.
+var DefaultExecEnvAllowlist = []string{
+	"CI", "DEBUG", "NODE_ENV", "NO_COLOR", "FORCE_COLOR", "TZ", "LC_ALL",
+	"GOFLAGS", "GOOS", "GOARCH", "CGO_ENABLED",
+	"PYTHONPATH", "PYTHONUNBUFFERED", "RUST_BACKTRACE", "RUST_LOG",
+}
+
+const (
+	execDefaultOutputBytes = 8192
+	execMaxOutputBytes     = 65536
+	execMaxStdinBytes      = 1 << 20
+)
+
+// ExecResult is what the exec tool returns. A non-zero exit code is reported
+// here rather than as a tool error so the agent sees the output either way.
+type ExecResult struct {
+	ExitCode  int    `json:"exit_code"`
+	Stdout    string `json:"stdout"`
+	Stderr    string `json:"stderr"`
+	Cwd       string `json:"cwd"` // relative to the workspace root
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
 // NewExecTool creates the exec tool — local sandboxed execution using os/exec.
 // Commands run inside the project workspace directory with a 30s timeout.
-func NewExecTool(ws *WorkspaceManager) *domain.Tool {
+// envAllowlist extends DefaultExecEnvAllowlist.
+func NewExecTool(ws *WorkspaceManager, envAllowlist ...string) *domain.Tool {
+	allowedEnv := make(map[string]bool)
+	for _, name := range append(append([]string{}, DefaultExecEnvAllowlist...), envAllowlist...) {
+		if name = strings.TrimSpace(name); name != "" {
+			allowedEnv[name] = true
+		}
+	}
+
 	return &domain.Tool{
 		Name:          "exec",
-		Description:   "Executes a shell command inside the project workspace. Sandboxed to the project directory with a 30-second timeout. Use for npm install, ls, cat, grep, git, python, etc.",
+		Description:   "Executes a shell command inside the project workspace. Sandboxed to the project directory with a 30-second timeout. Use for npm install, ls, cat, grep, git, python, etc. Returns exit_code, stdout and stderr.",
 		ExecutionType: domain.ExecNative,
 		Parameters: domain.ToolParameters{
 			Type: "object",
@@ -65,6 +101,22 @@ func NewExecTool(ws *WorkspaceManager) *domain.Tool {
 					"type":        "number",
 					"description": "Optional timeout in seconds (default: 30, max: 120).",
 				},
+				"cwd": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional working directory relative to the workspace root (e.g., 'frontend'). Must exist.",
+				},
+				"env": map[string]interface{}{
+					"type":        "object",
+					"description": "Optional environment variables, e.g. {\"NODE_ENV\": \"test\"}. Allowed names: " + strings.Join(slices.Sorted(maps.Keys(allowedEnv)), ", ") + ".",
+				},
+				"stdin": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional text passed to the command on standard input.",
+				},
+				"max_output_bytes": map[string]interface{}{
+					"type":        "number",
+					"description": "Optional cap for stdout and stderr each (default: 8192, max: 65536).",
+				},
 			},
 			Required: []string{"command"},
 		},
@@ -91,20 +143,55 @@ func NewExecTool(ws *WorkspaceManager) *domain.Tool {
 				timeoutSec = 120 // Hard cap
 			}
 
+			maxOutput := execDefaultOutputBytes
+			if n, ok := params["max_output_bytes"].(float64); ok && n > 0 {
+				maxOutput = min(int(n), execMaxOutputBytes)
+			}
+
 			// Security: check blocklist
 			if isDangerousCommand(command) {
 				return nil, fmt.Errorf("command blocked: matches dangerous command blocklist")
 			}
 
 			// Resolve workspace directory
-			var workDir string
+			var root string
 			if projectID != "" {
-				workDir = ws.GetProjectPath(projectID)
+				root = ws.GetProjectPath(projectID)
 			} else {
 				// Fallback to home directory when no project context
-				workDir, _ = os.UserHomeDir()
-				if workDir == "" {
-					workDir = "/tmp"
+				root, _ = os.UserHomeDir()
+				if root == "" {
+					root = "/tmp"
+				}
+			}
+			workDir := root
+			relDir := "."
+			if cwd, _ := params["cwd"].(string); strings.TrimSpace(cwd) != "" {
+				dir, err := ensurePathIsSafe(root, cwd)
+				if err != nil {
+					return nil, err
+				}
+				if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+					return nil, fmt.Errorf("cwd %q is not a directory in the workspace", cwd)
+				}
+				workDir = dir
+				relDir, _ = filepath.Rel(root, dir)
+			}
+
+			// Clean environment — only pass safe vars
+			env := []string{
+				fmt.Sprintf("HOME=%s", root),
+				fmt.Sprintf("PWD=%s", workDir),
+				"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
+				"LANG=en_US.UTF-8",
+				"TERM=xterm",
+			}
+			if raw, ok := params["env"].(map[string]interface{}); ok {
+				for _, name := range slices.Sorted(maps.Keys(raw)) {
+					if !allowedEnv[name] {
+						return nil, fmt.Errorf("env var %q is not allowed (allowed: %s)", name, strings.Join(slices.Sorted(maps.Keys(allowedEnv)), ", "))
+					}
+					env = append(env, fmt.Sprintf("%s=%v", name, raw[name]))
 				}
 			}
 
@@ -115,59 +202,48 @@ func NewExecTool(ws *WorkspaceManager) *domain.Tool {
 			// Execute command in workspace directory
 			cmd := exec.CommandContext(execCtx, "/bin/sh", "-c", command)
 			cmd.Dir = workDir
-
-			// Clean environment — only pass safe vars
-			cmd.Env = []string{
-				fmt.Sprintf("HOME=%s", workDir),
-				fmt.Sprintf("PWD=%s", workDir),
-				"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
-				"LANG=en_US.UTF-8",
-				"TERM=xterm",
+			cmd.Env = env
+			if stdin, ok := params["stdin"].(string); ok && stdin != "" {
+				if len(stdin) > execMaxStdinBytes {
+					return nil, fmt.Errorf("stdin exceeds %d bytes", execMaxStdinBytes)
+				}
+				cmd.Stdin = strings.NewReader(stdin)
 			}
 
+			// Output is also streamed to the conversation while the command runs
 			var stdout, stderr bytes.Buffer
-			cmd.Stdout = &stdout
-			cmd.Stderr = &stderr
+			cmd.Stdout = withToolProgress(ctx, &stdout)
+			cmd.Stderr = withToolProgress(ctx, &stderr)
 
 			err := cmd.Run()
-
-			// Build result
-			result := strings.Builder{}
-			if stdout.Len() > 0 {
-				outStr := stdout.String()
-				// Truncate excessive output
-				if len(outStr) > 8192 {
-					outStr = outStr[:8192] + "\n... (output truncated at 8KB)"
-				}
-				result.WriteString(outStr)
-			}
-			if stderr.Len() > 0 {
-				errStr := stderr.String()
-				if len(errStr) > 4096 {
-					errStr = errStr[:4096] + "\n... (stderr truncated at 4KB)"
-				}
-				if result.Len() > 0 {
-					result.WriteString("\n")
-				}
-				result.WriteString("STDERR: ")
-				result.WriteString(errStr)
+			if execCtx.Err() == context.DeadlineExceeded {
+				return nil, fmt.Errorf("command timed out after %.0fs", timeoutSec)
 			}
 
-			if err != nil {
-				if execCtx.Err() == context.DeadlineExceeded {
-					return nil, fmt.Errorf("command timed out after %.0fs", timeoutSec)
-				}
-				// Command failed but produced output — return output + error
-				if result.Len() > 0 {
-					return nil, fmt.Errorf("command failed (exit %v):\n%s", err, result.String())
-				}
+			result := ExecResult{Cwd: relDir}
+			var exitErr *exec.ExitError
+			switch {
+			case err == nil:
+			case errors.As(err, &exitErr):
+				result.ExitCode = exitErr.ExitCode()
+			default:
 				return nil, fmt.Errorf("command failed: %v", err)
 			}
 
-			if result.Len() == 0 {
-				return "(command completed with no output)", nil
-			}
-			return result.String(), nil
+			var cut bool
+			result.Stdout, cut = truncateOutput(stdout.String(), maxOutput)
+			result.Truncated = cut
+			result.Stderr, cut = truncateOutput(stderr.String(), maxOutput)
+			result.Truncated = result.Truncated || cut
+			return result, nil
 		},
 	}
 }
+
+// truncateOutput caps s at max bytes, noting how much was dropped.
+func truncateOutput(s string, max int) (string, bool) {
+	if len(s) <= max {
+		return s, false
+	}
+	return s[:max] + fmt.Sprintf("\n... (truncated, %d more bytes)", len(s)-max), true
+}
@@ -1,9 +1,14 @@
 package services
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsDangerousCommand(t *testing.T) {
@@ -69,7 +74,83 @@ func TestExecTool_RunsSimpleCommand(t *testing.T) {
 		"project_id": "proj1",
 	})
 	assert.NoError(t, err)
-	assert.Contains(t, result.(string), "hello_world")
+	assert.Contains(t, result.(ExecResult).Stdout, "hello_world")
+}
+
+func TestExecTool_CwdEnvStdinAndExitCode(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	tool := NewExecTool(ws, "MY_FLAG")
+	ctx := testProjectCtx("proj1")
+	require.NoError(t, os.MkdirAll(filepath.Join(ws.GetProjectPath("proj1"), "sub"), 0o755))
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"command": "pwd; echo \"$NODE_ENV $MY_FLAG\"; cat; echo oops >&2; exit 3",
+		"cwd":     "sub",
+		"env":     map[string]interface{}{"NODE_ENV": "test", "MY_FLAG": "on"},
+		"stdin":   "from-stdin",
+	})
+	require.NoError(t, err)
+	res := result.(ExecResult)
+	assert.Equal(t, 3, res.ExitCode)
+	assert.Equal(t, "sub", res.Cwd)
+	assert.Contains(t, res.Stdout, filepath.Join("proj1", "sub"))
+	assert.Contains(t, res.Stdout, "test on")
+	assert.Contains(t, res.Stdout, "from-stdin")
+	assert.Equal(t, "oops\n", res.Stderr)
+}
+
+func TestExecTool_StreamsProgress(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	var mu sync.Mutex
+	var streamed strings.Builder
+	ctx := ContextWithToolProgress(testProjectCtx("proj1"), func(chunk string) {
+		mu.Lock()
+		streamed.WriteString(chunk)
+		mu.Unlock()
+	})
+
+	result, err := NewExecTool(ws).Execute(ctx, map[string]interface{}{"command": "echo first; echo warn >&2; echo second"})
+	require.NoError(t, err)
+	res := result.(ExecResult)
+	assert.Equal(t, "first\nsecond\n", res.Stdout)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Contains(t, streamed.String(), "first\n")
+	assert.Contains(t, streamed.String(), "second\n")
+	assert.Contains(t, streamed.String(), "warn\n")
+}
+
+func TestExecTool_RejectsUnsafeCwdAndEnv(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	tool := NewExecTool(ws)
+	ctx := testProjectCtx("proj1")
+	require.NoError(t, os.MkdirAll(ws.GetProjectPath("proj1"), 0o755))
+
+	_, err := tool.Execute(ctx, map[string]interface{}{"command": "ls", "cwd": "../../"})
+	assert.ErrorContains(t, err, "outside workspace")
+
+	_, err = tool.Execute(ctx, map[string]interface{}{"command": "ls", "cwd": "missing"})
+	assert.ErrorContains(t, err, "not a directory")
+
+	_, err = tool.Execute(ctx, map[string]interface{}{"command": "ls", "env": map[string]interface{}{"LD_PRELOAD": "x.so"}})
+	assert.ErrorContains(t, err, "not allowed")
+}
+
+func TestExecTool_TruncatesOutput(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	tool := NewExecTool(ws)
+	ctx := testProjectCtx("proj1")
+	require.NoError(t, os.MkdirAll(ws.GetProjectPath("proj1"), 0o755))
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"command":          "printf '%0100d' 0",
+		"max_output_bytes": float64(10),
+	})
+	require.NoError(t, err)
+	res := result.(ExecResult)
+	assert.True(t, res.Truncated)
+	assert.Contains(t, res.Stdout, "90 more bytes")
 }
 
 func TestExecTool_RequiresCommand(t *testing.T) {
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// NewWatchFeedTool returns a tool that registers an RSS/Atom feed to be
+// polled in the background.
+func NewWatchFeedTool(monitor *FeedMonitor) *domain.Tool {
+	return &domain.Tool{
+		Name:        "watch_feed",
+		Description: "Watches an RSS or Atom feed. When new items appear, the given prompt runs with the new items appended (in a dedicated conversation), or a copy of the given workflow starts with the items in {{state.feed_items}}. Items already in the feed when it is added do not trigger.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"url": map[string]interface{}{
+					"type":        "string",
+					"description": "Feed URL (RSS or Atom).",
+				},
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional name; defaults to the feed's title.",
+				},
+				"prompt": map[string]interface{}{
+					"type":        "string",
+					"description": "Instruction to run on new items (e.g., 'Summarize these releases and flag breaking changes'). Required unless workflow_id is set.",
+				},
+				"workflow_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional workflow to start on new items instead of the prompt; it is copied for every trigger.",
+				},
+				"interval_minutes": map[string]interface{}{
+					"type":        "number",
+					"description": fmt.Sprintf("Polling interval in minutes (default %d, minimum %d).", domain.FeedDefaultIntervalSec/60, domain.FeedMinIntervalSec/60),
+				},
+				"persona_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional persona for the prompt runs.",
+				},
+				"project_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Project to associate the feed with (default: current project).",
+				},
+			},
+			Required: []string{"url"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			url, _ := params["url"].(string)
+			url = strings.TrimSpace(url)
+			if url == "" {
+				return nil, fmt.Errorf("url is required")
+			}
+			name, _ := params["name"].(string)
+			prompt, _ := params["prompt"].(string)
+			workflowID, _ := params["workflow_id"].(string)
+
+			projectID, _ := params["project_id"].(string)
+			if projectID == "" {
+				if pID, found := GetProjectFromContext(ctx); found {
+					projectID = string(pID)
+				}
+			}
+
+			f := &domain.FeedWatch{
+				ProjectID:  domain.ProjectID(projectID),
+				Name:       strings.TrimSpace(name),
+				URL:        url,
+				Prompt:     strings.TrimSpace(prompt),
+				WorkflowID: domain.WorkflowID(strings.TrimSpace(workflowID)),
+			}
+			if n, ok := params["interval_minutes"].(float64); ok && n > 0 {
+				f.IntervalSec = int(n * 60)
+			}
+			if pid, ok := params["persona_id"].(string); ok && pid != "" {
+				personaID := domain.PersonaID(pid)
+				f.PersonaID = &personaID
+			}
+
+			if err := monitor.Watch(ctx, f); err != nil {
+				return nil, err
+			}
+			return fmt.Sprintf("Watching feed '%s' (ID: %s), checked every %d minutes.", f.Name, f.ID, f.IntervalSec/60), nil
+		},
+	}
+}
+
+// NewListFeedsTool returns a tool that lists watched feeds.
+func NewListFeedsTool(repo FeedRepository) *domain.Tool {
+	return &domain.Tool{
+		Name:        "list_feeds",
+		Description: "Lists watched RSS/Atom feeds with their last check and error.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"project_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional: only feeds of this project.",
+				},
+			},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			projectID, _ := params["project_id"].(string)
+			feeds, err := repo.ListFeeds(ctx, domain.ProjectID(projectID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list feeds: %w", err)
+			}
+			if len(feeds) == 0 {
+				return "No watched feeds.", nil
+			}
+
+			var lines []string
+			for _, f := range feeds {
+				last := "never"
+				if f.LastChecked != nil {
+					last = f.LastChecked.Format("2006-01-02 15:04")
+				}
+				line := fmt.Sprintf("- %s (ID: %s) %s every=%dm last=%s triggers=%d", f.Name, f.ID, f.URL, f.IntervalSec/60, last, f.TriggerCount)
+				if f.Paused {
+					line += " [paused]"
+				}
+				if f.LastError != "" {
+					line += " error=" + f.LastError
+				}
+				lines = append(lines, line)
+			}
+			return fmt.Sprintf("%d feeds:\n%s", len(feeds), strings.Join(lines, "\n")), nil
+		},
+	}
+}
+
+// NewUnwatchFeedTool returns a tool that stops watching a feed.
+func NewUnwatchFeedTool(repo FeedRepository) *domain.Tool {
+	return &domain.Tool{
+		Name:        "unwatch_feed",
+		Description: "Stops watching a feed by ID and forgets which items were seen.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"feed_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The ID of the feed to remove.",
+				},
+			},
+			Required: []string{"feed_id"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			feedID, _ := params["feed_id"].(string)
+			if feedID == "" {
+				return nil, fmt.Errorf("feed_id is required")
+			}
+			if err := repo.DeleteFeed(ctx, domain.FeedID(feedID)); err != nil {
+				return nil, fmt.Errorf("failed to remove feed: %w", err)
+			}
+			return fmt.Sprintf("Feed %s removed.", feedID), nil
+		},
+	}
+}
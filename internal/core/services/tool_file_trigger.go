@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// NewWatchFilesTool returns a tool that registers a file-system watch
+// trigger on a workspace directory.
+func NewWatchFilesTool(triggers *FileTriggerService) *domain.Tool {
+	return &domain.Tool{
+		Name:        "watch_files",
+		Description: "Watches a workspace directory (e.g., 'inbox' or '~/inbox'). When matching files are created or changed, the given prompt runs with the list of changed files appended (in a dedicated conversation), or a copy of the given workflow starts with the list in {{state.changed_files}}. Changes are debounced: a burst of changes triggers once after the directory has been quiet.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"path": map[string]interface{}{
+					"type":        "string",
+					"description": "Directory to watch, relative to the workspace (the project's, else the home directory). Created if missing.",
+				},
+				"patterns": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string"},
+					"description": "Optional glob filters, e.g. ['*.pdf']. Patterns with a '/' match the path relative to the watched directory.",
+				},
+				"recursive": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Also watch subdirectories (default false).",
+				},
+				"events": map[string]interface{}{
+					"type":        "array",
+					"items":       map[string]interface{}{"type": "string", "enum": []string{"create", "write", "remove", "rename"}},
+					"description": "Changes that trigger (default: create and write).",
+				},
+				"prompt": map[string]interface{}{
+					"type":        "string",
+					"description": "Instruction to run on the changed files (e.g., 'Summarize each new PDF'). Required unless workflow_id is set.",
+				},
+				"workflow_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional workflow to start instead of the prompt; it is copied for every trigger.",
+				},
+				"debounce_seconds": map[string]interface{}{
+					"type":        "number",
+					"description": fmt.Sprintf("Quiet period before triggering (default %d).", domain.FileTriggerDefaultDebounceSec),
+				},
+				"name": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional name; defaults to the directory name.",
+				},
+				"persona_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional persona for the prompt runs.",
+				},
+				"project_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Project whose workspace holds the path (default: current project).",
+				},
+			},
+			Required: []string{"path"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			path, _ := params["path"].(string)
+			if strings.TrimSpace(path) == "" {
+				return nil, fmt.Errorf("path is required")
+			}
+			name, _ := params["name"].(string)
+			prompt, _ := params["prompt"].(string)
+			workflowID, _ := params["workflow_id"].(string)
+			recursive, _ := params["recursive"].(bool)
+
+			projectID, _ := params["project_id"].(string)
+			if projectID == "" {
+				if pID, found := GetProjectFromContext(ctx); found {
+					projectID = string(pID)
+				}
+			}
+
+			t := &domain.FileTrigger{
+				ProjectID:  domain.ProjectID(projectID),
+				Name:       strings.TrimSpace(name),
+				Path:       path,
+				Patterns:   stringList(params["patterns"]),
+				Recursive:  recursive,
+				Prompt:     strings.TrimSpace(prompt),
+				WorkflowID: domain.WorkflowID(strings.TrimSpace(workflowID)),
+			}
+			for _, e := range stringList(params["events"]) {
+				t.Events = append(t.Events, domain.FileOp(strings.ToLower(e)))
+			}
+			if n, ok := params["debounce_seconds"].(float64); ok && n > 0 {
+				t.DebounceSec = int(n)
+			}
+			if pid, ok := params["persona_id"].(string); ok && pid != "" {
+				personaID := domain.PersonaID(pid)
+				t.PersonaID = &personaID
+			}
+
+			if err := triggers.Watch(ctx, t); err != nil {
+				return nil, err
+			}
+			filter := "all files"
+			if len(t.Patterns) > 0 {
+				filter = strings.Join(t.Patterns, ", ")
+			}
+			return fmt.Sprintf("Watching %s for %s (ID: %s), debounce %ds.", t.Path, filter, t.ID, t.DebounceSec), nil
+		},
+	}
+}
+
+// stringList converts a JSON array parameter to strings, skipping blanks.
+func stringList(v interface{}) []string {
+	items, _ := v.([]interface{})
+	var out []string
+	for _, it := range items {
+		if s, ok := it.(string); ok && strings.TrimSpace(s) != "" {
+			out = append(out, strings.TrimSpace(s))
+		}
+	}
+	return out
+}
+
+// NewListFileTriggersTool returns a tool that lists file-system watch
+// triggers.
+func NewListFileTriggersTool(repo FileTriggerRepository) *domain.Tool {
+	return &domain.Tool{
+		Name:        "list_file_triggers",
+		Description: "Lists watched directories (file triggers) with their filters and last run.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"project_id": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional: only triggers of this project.",
+				},
+			},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			projectID, _ := params["project_id"].(string)
+			triggers, err := repo.ListFileTriggers(ctx, domain.ProjectID(projectID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list file triggers: %w", err)
+			}
+			if len(triggers) == 0 {
+				return "No file triggers.", nil
+			}
+
+			var lines []string
+			for _, t := range triggers {
+				last := "never"
+				if t.LastTriggered != nil {
+					last = t.LastTriggered.Format("2006-01-02 15:04")
+				}
+				line := fmt.Sprintf("- %s (ID: %s) %s", t.Name, t.ID, t.Path)
+				if len(t.Patterns) > 0 {
+					line += " [" + strings.Join(t.Patterns, ", ") + "]"
+				}
+				if t.Recursive {
+					line += " recursive"
+				}
+				line += fmt.Sprintf(" last=%s triggers=%d", last, t.TriggerCount)
+				if t.Paused {
+					line += " [paused]"
+				}
+				if t.LastError != "" {
+					line += " error=" + t.LastError
+				}
+				lines = append(lines, line)
+			}
+			return fmt.Sprintf("%d file triggers:\n%s", len(triggers), strings.Join(lines, "\n")), nil
+		},
+	}
+}
+
+// NewUnwatchFilesTool returns a tool that removes a file-system watch
+// trigger.
+func NewUnwatchFilesTool(triggers *FileTriggerService) *domain.Tool {
+	return &domain.Tool{
+		Name:        "unwatch_files",
+		Description: "Stops a file trigger by ID.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"trigger_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The ID of the file trigger to remove.",
+				},
+			},
+			Required: []string{"trigger_id"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			id, _ := params["trigger_id"].(string)
+			if id == "" {
+				return nil, fmt.Errorf("trigger_id is required")
+			}
+			if err := triggers.Unwatch(ctx, domain.FileTriggerID(id)); err != nil {
+				return nil, fmt.Errorf("failed to remove file trigger: %w", err)
+			}
+			return fmt.Sprintf("File trigger %s removed.", id), nil
+		},
+	}
+}
@@ -13,6 +13,10 @@ import (
 
 const MemoryFileName = "MEMORY.md"
 
+// MemoryDirName holds per-persona memory files (memory/<persona>.md) in
+// projects whose memory_scope is "persona" or "isolated".
+const MemoryDirName = "memory"
+
 // NewMemorySaveTool returns a tool that saves a fact/memory to the project's long-term memory.
 func NewMemorySaveTool(ws *WorkspaceManager) *domain.Tool {
 	return &domain.Tool{
@@ -47,24 +51,11 @@ func NewMemorySaveTool(ws *WorkspaceManager) *domain.Tool {
 				return nil, fmt.Errorf("category and content are required")
 			}
 
-			// Resolve Project ID
-			if projectID == "" {
-				if pID, found := GetProjectFromContext(ctx); found {
-					projectID = string(pID)
-				}
+			memoryPath, _ := memoryFiles(ctx, ws, projectID)
+			if err := os.MkdirAll(filepath.Dir(memoryPath), 0755); err != nil {
+				return nil, fmt.Errorf("failed to create memory directory: %w", err)
 			}
 
-			// Resolve Path
-			var projectPath string
-			if projectID != "" {
-				projectPath = ws.GetProjectPath(projectID)
-			} else {
-				home, _ := os.UserHomeDir()
-				projectPath = filepath.Join(home, ".aule", "global")
-				_ = os.MkdirAll(projectPath, 0755)
-			}
-			memoryPath := filepath.Join(projectPath, MemoryFileName)
-
 			// Format Entry
 			timestamp := time.Now().Format("2006-01-02")
 			entry := fmt.Sprintf("- [%s] **%s**: %s\n", timestamp, strings.ToUpper(category), content)
@@ -80,7 +71,7 @@ func NewMemorySaveTool(ws *WorkspaceManager) *domain.Tool {
 				return nil, fmt.Errorf("failed to write to memory: %w", err)
 			}
 
-			return fmt.Sprintf("Memory saved to %s", MemoryFileName), nil
+			return fmt.Sprintf("Memory saved to %s", memoryFileLabel(memoryPath)), nil
 		},
 	}
 }
@@ -104,34 +95,16 @@ func NewMemoryReadTool(ws *WorkspaceManager) *domain.Tool {
 		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 			projectID, _ := params["project_id"].(string)
 
-			// Resolve Project ID
-			if projectID == "" {
-				if pID, found := GetProjectFromContext(ctx); found {
-					projectID = string(pID)
-				}
-			}
-
-			// Resolve Path
-			var projectPath string
-			if projectID != "" {
-				projectPath = ws.GetProjectPath(projectID)
-			} else {
-				home, _ := os.UserHomeDir()
-				projectPath = filepath.Join(home, ".aule", "global")
-				_ = os.MkdirAll(projectPath, 0755)
-			}
-			memoryPath := filepath.Join(projectPath, MemoryFileName)
-
-			// Read file
-			data, err := os.ReadFile(memoryPath)
+			_, paths := memoryFiles(ctx, ws, projectID)
+			data, err := readMemory(paths)
 			if err != nil {
-				if os.IsNotExist(err) {
-					return "Memory is empty.", nil
-				}
 				return nil, fmt.Errorf("failed to read memory: %w", err)
 			}
+			if data == "" {
+				return "Memory is empty.", nil
+			}
 
-			return string(data), nil
+			return data, nil
 		},
 	}
 }
@@ -169,34 +142,19 @@ func NewMemorySearchTool(ws *WorkspaceManager) *domain.Tool {
 				return nil, fmt.Errorf("query is required")
 			}
 
-			if projectID == "" {
-				if pID, found := GetProjectFromContext(ctx); found {
-					projectID = string(pID)
-				}
-			}
-
-			var projectPath string
-			if projectID != "" {
-				projectPath = ws.GetProjectPath(projectID)
-			} else {
-				home, _ := os.UserHomeDir()
-				projectPath = filepath.Join(home, ".aule", "global")
-				_ = os.MkdirAll(projectPath, 0755)
-			}
-			memoryPath := filepath.Join(projectPath, MemoryFileName)
-
-			data, err := os.ReadFile(memoryPath)
+			_, paths := memoryFiles(ctx, ws, projectID)
+			data, err := readMemory(paths)
 			if err != nil {
-				if os.IsNotExist(err) {
-					return "No memories found (memory is empty).", nil
-				}
 				return nil, fmt.Errorf("failed to read memory: %w", err)
 			}
+			if data == "" {
+				return "No memories found (memory is empty).", nil
+			}
 
 			queryLower := strings.ToLower(query)
 			categoryUpper := strings.ToUpper(category)
 
-			lines := strings.Split(string(data), "\n")
+			lines := strings.Split(data, "\n")
 			var matches []string
 
 			for _, line := range lines {
@@ -224,3 +182,74 @@ func NewMemorySearchTool(ws *WorkspaceManager) *domain.Tool {
 		},
 	}
 }
+
+// memoryFiles returns the memory file a tool call writes to and the files
+// it reads. projectID falls back to the context's project, then to the
+// global workspace; the persona scope in ctx picks the files.
+func memoryFiles(ctx context.Context, ws *WorkspaceManager, projectID string) (write string, read []string) {
+	if projectID == "" {
+		if pID, found := GetProjectFromContext(ctx); found {
+			projectID = string(pID)
+		}
+	}
+	var projectPath string
+	if projectID != "" {
+		projectPath = ws.GetProjectPath(projectID)
+	} else {
+		home, _ := os.UserHomeDir()
+		projectPath = filepath.Join(home, ".aule", "global")
+	}
+	return memoryPaths(ctx, projectPath)
+}
+
+// memoryPaths applies the persona scope in ctx to a project directory: the
+// file new memories go to, and the files making up the persona's memory.
+func memoryPaths(ctx context.Context, projectPath string) (write string, read []string) {
+	shared := filepath.Join(projectPath, MemoryFileName)
+	m, ok := getMemoryScopeFromContext(ctx)
+	if !ok {
+		return shared, []string{shared}
+	}
+	own := filepath.Join(projectPath, MemoryDirName, safeFileName(string(m.persona))+".md")
+	if m.scope == domain.MemoryScopeIsolated {
+		return own, []string{own}
+	}
+	return own, []string{shared, own}
+}
+
+// readMemory joins the memory files that exist; none gives "".
+func readMemory(paths []string) (string, error) {
+	var parts []string
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if content := strings.TrimSpace(string(data)); content != "" {
+			parts = append(parts, content)
+		}
+	}
+	return strings.Join(parts, "\n"), nil
+}
+
+// memoryFileLabel names a memory file relative to its project.
+func memoryFileLabel(path string) string {
+	if filepath.Base(filepath.Dir(path)) == MemoryDirName {
+		return MemoryDirName + "/" + filepath.Base(path)
+	}
+	return filepath.Base(path)
+}
+
+// safeFileName keeps letters, digits, '-' and '_' so IDs cannot escape the
+// memory directory.
+func safeFileName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, s)
+}
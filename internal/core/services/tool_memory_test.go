@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func TestMemoryTools_PersonaScopes(t *testing.T) {
+	t.Setenv("AULE_WORKSPACE_DIR", t.TempDir())
+	ws := NewWorkspaceManager()
+	save, read := NewMemorySaveTool(ws), NewMemoryReadTool(ws)
+
+	base := ContextWithProject(context.Background(), "proj-1")
+	remember := func(ctx context.Context, content string) {
+		_, err := save.Execute(ctx, map[string]interface{}{"category": "fact", "content": content})
+		require.NoError(t, err)
+	}
+	recall := func(ctx context.Context) string {
+		out, err := read.Execute(ctx, map[string]interface{}{})
+		require.NoError(t, err)
+		return out.(string)
+	}
+
+	remember(base, "project uses Go")
+
+	finance := ContextWithMemoryScope(base, "finance", domain.MemoryScopePersona)
+	coding := ContextWithMemoryScope(base, "coding", domain.MemoryScopePersona)
+	remember(finance, "budget is 10k")
+
+	// Persona scope: own file plus the shared one, never another persona's
+	assert.Contains(t, recall(finance), "budget is 10k")
+	assert.Contains(t, recall(finance), "project uses Go")
+	assert.Contains(t, recall(coding), "project uses Go")
+	assert.NotContains(t, recall(coding), "budget is 10k")
+	assert.NotContains(t, recall(base), "budget is 10k")
+	assert.FileExists(t, filepath.Join(ws.GetProjectPath("proj-1"), MemoryDirName, "finance.md"))
+
+	// Isolated scope drops the shared file too
+	isolated := ContextWithMemoryScope(base, "finance", domain.MemoryScopeIsolated)
+	assert.Contains(t, recall(isolated), "budget is 10k")
+	assert.NotContains(t, recall(isolated), "project uses Go")
+
+	// Shared scope ignores the persona
+	shared := ContextWithMemoryScope(base, "finance", domain.MemoryScopeShared)
+	assert.Contains(t, recall(shared), "project uses Go")
+	assert.NotContains(t, recall(shared), "budget is 10k")
+}
+
+func TestMemoryPaths_PersonaIDCannotEscape(t *testing.T) {
+	dir := t.TempDir()
+	ctx := ContextWithMemoryScope(context.Background(), "../../etc/passwd", domain.MemoryScopeIsolated)
+	write, _ := memoryPaths(ctx, dir)
+	assert.Equal(t, filepath.Join(dir, MemoryDirName), filepath.Dir(write))
+}
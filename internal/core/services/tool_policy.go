@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// ToolPolicy is the kernel-wide tool deny list. It is applied on top of
+// persona and project tool filters and can change at runtime. It also
+// decides whether agents may run a fuzzy match for a tool name that does
+// not exist, and counts those corrections.
+type ToolPolicy struct {
+	logger   *slog.Logger
+	mu       sync.RWMutex
+	disabled []string
+	strict   bool // refuse fuzzy matches of tool names
+
+	corrMu      sync.Mutex
+	corrections map[[2]string]*domain.ToolCorrection // by requested, matched
+}
+
+// NewToolPolicy returns a policy that allows every tool.
+func NewToolPolicy(logger *slog.Logger) *ToolPolicy {
+	return &ToolPolicy{logger: logger}
+}
+
+// SetDisabled replaces the list of tools the agents may not use.
+func (p *ToolPolicy) SetDisabled(names []string) {
+	disabled := append([]string(nil), names...)
+	sort.Strings(disabled)
+	p.mu.Lock()
+	p.disabled = disabled
+	p.mu.Unlock()
+}
+
+// Disabled returns the denied tool names.
+func (p *ToolPolicy) Disabled() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return append([]string(nil), p.disabled...)
+}
+
+// SetStrictNames makes agents refuse tool names that only fuzzy-match a
+// tool, instead of running the closest one.
+func (p *ToolPolicy) SetStrictNames(strict bool) {
+	p.mu.Lock()
+	p.strict = strict
+	p.mu.Unlock()
+}
+
+// ResolveAction resolves the tool name of an agent action. A name that
+// only fuzzy-matches a tool resolves to the match, with a note for the
+// observation so the model sees the correction; in strict mode it fails
+// with a "did you mean" error instead. A nil policy is lenient and keeps
+// no metrics.
+func (p *ToolPolicy) ResolveAction(ctx context.Context, tools *domain.ToolRegistry, name string) (resolved, note string, err error) {
+	resolved, corrected, err := tools.Resolve(name)
+	if err != nil || !corrected {
+		return resolved, "", err
+	}
+	note = fmt.Sprintf("Note: there is no tool %q, did you mean %q? Ran %q instead.", name, resolved, resolved)
+	if p == nil {
+		return resolved, note, nil
+	}
+	p.mu.RLock()
+	strict := p.strict
+	p.mu.RUnlock()
+	p.countCorrection(name, resolved, strict)
+	p.logger.WarnContext(ctx, "tool name fuzzy-matched", "requested", name, "matched", resolved, "strict", strict)
+	if strict {
+		return "", "", fmt.Errorf("tool not found: %s. Did you mean %s?", name, resolved)
+	}
+	return resolved, note, nil
+}
+
+func (p *ToolPolicy) countCorrection(requested, matched string, rejected bool) {
+	p.corrMu.Lock()
+	defer p.corrMu.Unlock()
+	if p.corrections == nil {
+		p.corrections = make(map[[2]string]*domain.ToolCorrection)
+	}
+	key := [2]string{requested, matched}
+	c, ok := p.corrections[key]
+	if !ok {
+		c = &domain.ToolCorrection{Requested: requested, Matched: matched}
+		p.corrections[key] = c
+	}
+	if rejected {
+		c.Rejected++
+	} else {
+		c.Applied++
+	}
+}
+
+// NameMetrics returns the tool-name corrections counted since startup.
+func (p *ToolPolicy) NameMetrics() domain.ToolNameMetrics {
+	p.mu.RLock()
+	m := domain.ToolNameMetrics{Strict: p.strict, Corrections: []domain.ToolCorrection{}}
+	p.mu.RUnlock()
+	p.corrMu.Lock()
+	for _, c := range p.corrections {
+		m.Applied += c.Applied
+		m.Rejected += c.Rejected
+		m.Corrections = append(m.Corrections, *c)
+	}
+	p.corrMu.Unlock()
+	sort.Slice(m.Corrections, func(i, j int) bool {
+		a, b := m.Corrections[i], m.Corrections[j]
+		if a.Applied+a.Rejected != b.Applied+b.Rejected {
+			return a.Applied+a.Rejected > b.Applied+b.Rejected
+		}
+		return a.Requested < b.Requested
+	})
+	return m
+}
+
+// Apply returns tools minus the denied ones. A nil policy allows everything.
+func (p *ToolPolicy) Apply(tools *domain.ToolRegistry) *domain.ToolRegistry {
+	if p == nil {
+		return tools
+	}
+	p.mu.RLock()
+	disabled := p.disabled
+	p.mu.RUnlock()
+	if len(disabled) == 0 {
+		return tools
+	}
+	return tools.Without(disabled)
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func TestToolPolicyResolveActionCountsCorrections(t *testing.T) {
+	tools := domain.NewToolRegistry()
+	require.NoError(t, tools.Register(&domain.Tool{Name: "web_search"}))
+	p := NewToolPolicy(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := context.Background()
+
+	name, note, err := p.ResolveAction(ctx, tools, "web_search")
+	require.NoError(t, err)
+	assert.Equal(t, "web_search", name)
+	assert.Empty(t, note)
+
+	name, note, err = p.ResolveAction(ctx, tools, "search_web")
+	require.NoError(t, err)
+	assert.Equal(t, "web_search", name)
+	assert.Equal(t, `Note: there is no tool "search_web", did you mean "web_search"? Ran "web_search" instead.`, note)
+
+	p.SetStrictNames(true)
+	_, _, err = p.ResolveAction(ctx, tools, "search_web")
+	assert.EqualError(t, err, "tool not found: search_web. Did you mean web_search?")
+	_, _, err = p.ResolveAction(ctx, tools, "send_email")
+	assert.EqualError(t, err, "tool not found: send_email")
+
+	assert.Equal(t, domain.ToolNameMetrics{
+		Strict:   true,
+		Applied:  1,
+		Rejected: 1,
+		Corrections: []domain.ToolCorrection{
+			{Requested: "search_web", Matched: "web_search", Applied: 1, Rejected: 1},
+		},
+	}, p.NameMetrics())
+
+	var nilPolicy *ToolPolicy
+	name, note, err = nilPolicy.ResolveAction(ctx, tools, "search_web")
+	require.NoError(t, err)
+	assert.Equal(t, "web_search", name)
+	assert.NotEmpty(t, note)
+}
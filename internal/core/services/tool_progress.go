@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"io"
+)
+
+// ctxKeyToolProgress carries the sink for a running tool's partial output.
+const ctxKeyToolProgress serviceContextKey = "tool_progress"
+
+// ContextWithToolProgress makes ReportToolProgress calls under ctx reach fn.
+// fn may be called from several goroutines (e.g. stdout and stderr).
+func ContextWithToolProgress(ctx context.Context, fn func(chunk string)) context.Context {
+	return context.WithValue(ctx, ctxKeyToolProgress, fn)
+}
+
+// ReportToolProgress passes partial output of a long-running tool to its
+// caller as it arrives; the ReAct loop streams it to the conversation. The
+// tool still returns its full result as usual. Without a caller listening
+// it does nothing.
+func ReportToolProgress(ctx context.Context, chunk string) {
+	if chunk == "" {
+		return
+	}
+	if fn, ok := ctx.Value(ctxKeyToolProgress).(func(string)); ok && fn != nil {
+		fn(chunk)
+	}
+}
+
+// toolProgressWriter is an io.Writer reporting what is written to it as
+// tool progress, for tee-ing command output.
+type toolProgressWriter struct {
+	ctx context.Context
+}
+
+func (w toolProgressWriter) Write(p []byte) (int, error) {
+	ReportToolProgress(w.ctx, string(p))
+	return len(p), nil
+}
+
+// withToolProgress tees dst into the tool progress of ctx.
+func withToolProgress(ctx context.Context, dst io.Writer) io.Writer {
+	if fn, ok := ctx.Value(ctxKeyToolProgress).(func(string)); !ok || fn == nil {
+		return dst
+	}
+	return io.MultiWriter(dst, toolProgressWriter{ctx: ctx})
+}
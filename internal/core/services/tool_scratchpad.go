@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	scratchpadDefaultReadBytes = 8192
+	scratchpadMaxReadBytes     = 65536
+)
+
+// NewScratchpadWriteTool returns a tool that stashes intermediate results in
+// the conversation's scratchpad.
+func NewScratchpadWriteTool(pad *Scratchpad) *domain.Tool {
+	return &domain.Tool{
+		Name:        "scratchpad_write",
+		Description: "Stores text under a key in this conversation's temporary scratchpad. Use it for large intermediate results (fetched pages, command output, drafts) that you will need later in this conversation. Not long-term memory: contents are discarded when the conversation goes idle. Use memory_save for facts worth keeping.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"key": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the entry (e.g., 'search_results', 'draft').",
+				},
+				"content": map[string]interface{}{
+					"type":        "string",
+					"description": "Text to store.",
+				},
+				"append": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Append to the existing entry instead of replacing it (default: false).",
+				},
+				"delete": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Delete the entry instead of writing (content is ignored).",
+				},
+			},
+			Required: []string{"key"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			key, _ := params["key"].(string)
+			content, _ := params["content"].(string)
+			appendTo, _ := params["append"].(bool)
+			del, _ := params["delete"].(bool)
+
+			key = strings.TrimSpace(key)
+			if key == "" {
+				return nil, fmt.Errorf("key is required")
+			}
+			convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID)
+			if convID == "" {
+				return nil, fmt.Errorf("the scratchpad requires a conversation")
+			}
+
+			if del {
+				if !pad.Delete(convID, key) {
+					return fmt.Sprintf("Scratchpad key %q did not exist", key), nil
+				}
+				return fmt.Sprintf("Deleted scratchpad key %q", key), nil
+			}
+			size, err := pad.Write(convID, key, content, appendTo)
+			if err != nil {
+				return nil, err
+			}
+			return fmt.Sprintf("Stored %d bytes under scratchpad key %q", size, key), nil
+		},
+	}
+}
+
+// NewScratchpadReadTool returns a tool that reads back scratchpad entries,
+// in chunks for entries larger than one observation.
+func NewScratchpadReadTool(pad *Scratchpad) *domain.Tool {
+	return &domain.Tool{
+		Name:        "scratchpad_read",
+		Description: "Reads an entry from this conversation's scratchpad. Without a key, lists the stored keys and their sizes. Large entries are returned in chunks: pass the returned next_offset to continue reading.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"key": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the entry to read. Omit to list all keys.",
+				},
+				"offset": map[string]interface{}{
+					"type":        "number",
+					"description": "Byte offset to start reading from (default: 0).",
+				},
+				"max_bytes": map[string]interface{}{
+					"type":        "number",
+					"description": "Maximum bytes to return (default: 8192, max: 65536).",
+				},
+			},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			key, _ := params["key"].(string)
+			key = strings.TrimSpace(key)
+			convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID)
+			if convID == "" {
+				return nil, fmt.Errorf("the scratchpad requires a conversation")
+			}
+
+			if key == "" {
+				return map[string]interface{}{"keys": pad.List(convID)}, nil
+			}
+			entry, ok := pad.Read(convID, key)
+			if !ok {
+				return nil, fmt.Errorf("scratchpad key %q not found", key)
+			}
+
+			offset := 0
+			if o, ok := params["offset"].(float64); ok && o > 0 {
+				offset = int(o)
+			}
+			limit := scratchpadDefaultReadBytes
+			if n, ok := params["max_bytes"].(float64); ok && n > 0 {
+				limit = min(int(n), scratchpadMaxReadBytes)
+			}
+			chunk, next := sliceUTF8(entry.Content, offset, limit)
+
+			result := map[string]interface{}{
+				"key":     key,
+				"content": chunk,
+				"bytes":   entry.Bytes,
+			}
+			if next < entry.Bytes {
+				result["next_offset"] = next
+			}
+			return result, nil
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+const (
+	shellRunDefaultTimeout = 60 * time.Second
+	shellRunMaxTimeout     = 10 * time.Minute
+)
+
+// shellConversation returns the conversation a shell session tool call belongs to.
+func shellConversation(ctx context.Context) (domain.ConversationID, error) {
+	convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID)
+	if convID == "" {
+		return "", fmt.Errorf("shell sessions require a conversation")
+	}
+	return convID, nil
+}
+
+// NewShellOpenTool creates shell_session_open, which starts the
+// conversation's persistent sandboxed shell.
+func NewShellOpenTool(mgr *ShellSessionManager) *domain.Tool {
+	return &domain.Tool{
+		Name:          "shell_session_open",
+		Description:   "Opens a persistent shell for this conversation in a sandboxed container (no network) with the project workspace at /workspace. The working directory, environment variables and shell state persist across shell_session_run calls. Use for multi-step build/test loops.",
+		ExecutionType: domain.ExecNative,
+		Parameters: domain.ToolParameters{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			convID, err := shellConversation(ctx)
+			if err != nil {
+				return nil, err
+			}
+			info, opened, err := mgr.Open(ctx, convID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open shell session: %w", err)
+			}
+			status := "opened"
+			if !opened {
+				status = "already open"
+			}
+			return map[string]interface{}{
+				"status":  status,
+				"cwd":     info.Cwd,
+				"image":   info.Image,
+				"started": info.StartedAt.Format(time.RFC3339),
+			}, nil
+		},
+	}
+}
+
+// NewShellRunTool creates shell_session_run, which runs a command in the
+// conversation's open shell.
+func NewShellRunTool(mgr *ShellSessionManager) *domain.Tool {
+	return &domain.Tool{
+		Name:          "shell_session_run",
+		Description:   "Runs a command in this conversation's persistent shell (open it first with shell_session_open). `cd` and `export` carry over to later commands. Returns exit_code, stdout, stderr and the resulting cwd. Commands get no stdin. Running `exit` or exceeding the timeout ends the session.",
+		ExecutionType: domain.ExecNative,
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"command": map[string]interface{}{
+					"type":        "string",
+					"description": "Shell command to run (e.g., 'cd app && npm test').",
+				},
+				"timeout_seconds": map[string]interface{}{
+					"type":        "number",
+					"description": "Optional timeout in seconds (default: 60, max: 600).",
+				},
+				"max_output_bytes": map[string]interface{}{
+					"type":        "number",
+					"description": "Optional cap for stdout and stderr each (default: 8192, max: 65536).",
+				},
+			},
+			Required: []string{"command"},
+		},
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			command, _ := params["command"].(string)
+			if strings.TrimSpace(command) == "" {
+				return nil, fmt.Errorf("command is required and must be a non-empty string")
+			}
+			if isDangerousCommand(command) {
+				return nil, fmt.Errorf("command blocked: matches dangerous command blocklist")
+			}
+			convID, err := shellConversation(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			timeout := shellRunDefaultTimeout
+			if t, ok := params["timeout_seconds"].(float64); ok && t > 0 {
+				timeout = time.Duration(t * float64(time.Second))
+				if timeout > shellRunMaxTimeout {
+					timeout = shellRunMaxTimeout
+				}
+			}
+			maxOutput := execDefaultOutputBytes
+			if n, ok := params["max_output_bytes"].(float64); ok && n > 0 {
+				maxOutput = min(int(n), execMaxOutputBytes)
+			}
+
+			res, err := mgr.Run(ctx, convID, command, timeout, maxOutput)
+			if errors.Is(err, domain.ErrShellSessionNotFound) {
+				return nil, fmt.Errorf("%w for this conversation; call shell_session_open first", err)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return res, nil
+		},
+	}
+}
+
+// NewShellCloseTool creates shell_session_close, which stops the
+// conversation's shell and its container.
+func NewShellCloseTool(mgr *ShellSessionManager) *domain.Tool {
+	return &domain.Tool{
+		Name:          "shell_session_close",
+		Description:   "Closes this conversation's persistent shell and removes its container. Files in /workspace are kept.",
+		ExecutionType: domain.ExecNative,
+		Parameters: domain.ToolParameters{
+			Type:       "object",
+			Properties: map[string]interface{}{},
+		},
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			convID, err := shellConversation(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if !mgr.Close(convID) {
+				return "no shell session was open", nil
+			}
+			return "shell session closed", nil
+		},
+	}
+}
@@ -5,22 +5,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
 )
 
+// spawnSummaryLimit caps the result excerpt pushed back into the conversation.
+const spawnSummaryLimit = 1500
+
 // NewSpawnTool creates a tool that launches an async sub-agent in the background.
 // Unlike "delegate" (which blocks until all sub-tasks finish), "spawn" returns
 // immediately with a sub-agent ID. The spawned agent runs its own ReAct loop and
-// reports results back via the EventBus broadcast channel.
+// reports results back via the EventBus broadcast channel and, when convStore is
+// set, as an assistant message in the originating conversation.
 //
 // Inspired by PicoClaw's spawn tool — fire-and-forget background agents that
 // can work on long-running tasks while the main agent continues.
-func NewSpawnTool(orchestrator *SubAgentOrchestrator, eventBus *EventBus, logger *slog.Logger) *domain.Tool {
+func NewSpawnTool(orchestrator *SubAgentOrchestrator, convStore *ConversationStore, eventBus *EventBus, logger *slog.Logger) *domain.Tool {
 	return &domain.Tool{
 		Name:        "spawn",
-		Description: "Spawn an async background agent to handle a task independently. The agent runs in the background and sends results back via message. Use for long-running tasks, research, or work that doesn't need immediate results. Returns a task ID to track the spawned agent.",
+		Description: "Spawn an async background agent to handle a task independently. The agent runs in the background and sends results back via message. Use for long-running tasks, research, or work that doesn't need immediate results. Returns a task ID to track the spawned agent (see check_spawn).",
 		Parameters: domain.ToolParameters{
 			Type: "object",
 			Properties: map[string]interface{}{
@@ -53,11 +58,23 @@ func NewSpawnTool(orchestrator *SubAgentOrchestrator, eventBus *EventBus, logger
 
 			saID := domain.NewSubAgentID()
 
+			// The spawn itself is a task record; the sub-agent doing the work is its child.
+			record := domain.SubAgentTask{
+				ID:             saID,
+				ParentID:       parentID,
+				ConversationID: convID,
+				PersonaName:    persona,
+				Prompt:         task,
+				Status:         domain.SubAgentStatusRunning,
+				StartedAt:      time.Now(),
+			}
+			orchestrator.trackSpawn(record)
+
 			// Fire off the sub-agent in a background goroutine
 			go func() {
-				bgCtx := context.Background() // detached from parent — outlives the request
-				bgCtx = ContextWithConversation(bgCtx, convID)
+				bgCtx := detachDelegationContext(ctx) // detached from parent — outlives the request
 				bgCtx = ContextWithSubAgent(bgCtx, saID)
+				bgCtx = domain.WithPriority(bgCtx, domain.PriorityBackground)
 
 				logger.Info("spawn: background agent started",
 					"sa_id", string(saID),
@@ -70,11 +87,16 @@ func NewSpawnTool(orchestrator *SubAgentOrchestrator, eventBus *EventBus, logger
 					Prompt:  task,
 				}
 
-				results, err := orchestrator.Delegate(bgCtx, convID, parentID, []domain.DelegateTaskSpec{spec})
+				results, err := orchestrator.Delegate(bgCtx, convID, saID, []domain.DelegateTaskSpec{spec})
+
+				fin := time.Now()
+				record.FinishedAt = &fin
 
 				// Report results back via EventBus broadcast
 				var payload map[string]interface{}
 				if err != nil {
+					record.Status = domain.SubAgentStatusFailed
+					record.Error = err.Error()
 					payload = map[string]interface{}{
 						"type":      "spawn_result",
 						"sa_id":     string(saID),
@@ -86,6 +108,11 @@ func NewSpawnTool(orchestrator *SubAgentOrchestrator, eventBus *EventBus, logger
 					}
 					logger.Error("spawn: background agent failed", "sa_id", string(saID), "error", err)
 				} else if len(results) > 0 && results[0].Status == domain.SubAgentStatusDone {
+					record.Status = domain.SubAgentStatusDone
+					record.Result = results[0].Result
+					record.PersonaID = results[0].PersonaID
+					record.PersonaName = results[0].PersonaName
+					record.ModelID = results[0].ModelID
 					payload = map[string]interface{}{
 						"type":      "spawn_result",
 						"sa_id":     string(saID),
@@ -102,6 +129,8 @@ func NewSpawnTool(orchestrator *SubAgentOrchestrator, eventBus *EventBus, logger
 					if len(results) > 0 {
 						errMsg = results[0].Error
 					}
+					record.Status = domain.SubAgentStatusFailed
+					record.Error = errMsg
 					payload = map[string]interface{}{
 						"type":      "spawn_result",
 						"sa_id":     string(saID),
@@ -114,6 +143,8 @@ func NewSpawnTool(orchestrator *SubAgentOrchestrator, eventBus *EventBus, logger
 					logger.Warn("spawn: background agent finished with error", "sa_id", string(saID), "error", errMsg)
 				}
 
+				orchestrator.finishSpawn(record)
+
 				data, _ := json.Marshal(payload)
 				eventBus.Publish(Event{
 					JobID:     BroadcastChannel,
@@ -121,6 +152,8 @@ func NewSpawnTool(orchestrator *SubAgentOrchestrator, eventBus *EventBus, logger
 					Data:      string(data),
 					Timestamp: time.Now().UnixMilli(),
 				})
+
+				notifySpawnResult(context.Background(), convStore, eventBus, logger, record)
 			}()
 
 			return map[string]interface{}{
@@ -128,8 +161,131 @@ func NewSpawnTool(orchestrator *SubAgentOrchestrator, eventBus *EventBus, logger
 				"sa_id":   string(saID),
 				"task":    task,
 				"persona": persona,
-				"message": fmt.Sprintf("Background agent %s spawned. Results will be delivered via message when complete.", string(saID)),
+				"message": fmt.Sprintf("Background agent %s spawned. Results will be delivered via message when complete. Use check_spawn to poll its status.", string(saID)),
 			}, nil
 		},
 	}
 }
+
+// NewCheckSpawnTool creates a tool that reports the status of a background
+// agent spawned in the same conversation.
+func NewCheckSpawnTool(orchestrator *SubAgentOrchestrator) *domain.Tool {
+	return &domain.Tool{
+		Name:        "check_spawn",
+		Description: "Check the status of a background agent started with spawn. Returns status (running/done/failed) and the result when finished.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"sa_id": map[string]interface{}{
+					"type":        "string",
+					"description": "The sub-agent ID returned by spawn (sa-...).",
+				},
+			},
+			Required: []string{"sa_id"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			id, _ := params["sa_id"].(string)
+			id = strings.TrimSpace(id)
+			if id == "" {
+				return nil, fmt.Errorf("sa_id is required")
+			}
+
+			t, err := orchestrator.GetTask(ctx, domain.SubAgentID(id))
+			if err != nil {
+				return nil, fmt.Errorf("spawn %s: %w", id, err)
+			}
+			// Spawns of other conversations don't exist for this one
+			if convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID); t.ConversationID != convID {
+				return nil, fmt.Errorf("spawn %s: %w", id, domain.ErrSubAgentNotFound)
+			}
+
+			out := map[string]interface{}{
+				"sa_id":      string(t.ID),
+				"status":     string(t.Status),
+				"task":       t.Prompt,
+				"persona":    t.PersonaName,
+				"started_at": t.StartedAt.Format(time.RFC3339),
+			}
+			if t.FinishedAt != nil {
+				out["finished_at"] = t.FinishedAt.Format(time.RFC3339)
+				out["duration"] = t.FinishedAt.Sub(t.StartedAt).Round(time.Millisecond).String()
+			} else {
+				out["running_for"] = time.Since(t.StartedAt).Round(time.Second).String()
+			}
+			if t.Result != "" {
+				out["result"] = t.Result
+			}
+			if t.Error != "" {
+				out["error"] = t.Error
+			}
+			return out, nil
+		},
+	}
+}
+
+// trackSpawn registers a running spawn record so check_spawn can see it before it finishes.
+func (o *SubAgentOrchestrator) trackSpawn(record domain.SubAgentTask) {
+	o.mu.Lock()
+	o.active[record.ID] = &record
+	o.mu.Unlock()
+	o.saveTask(record)
+}
+
+// finishSpawn persists the final spawn record and drops it from the active set.
+func (o *SubAgentOrchestrator) finishSpawn(record domain.SubAgentTask) {
+	o.saveTask(record)
+	o.mu.Lock()
+	delete(o.active, record.ID)
+	o.mu.Unlock()
+}
+
+// notifySpawnResult pushes a summarized assistant message into the conversation
+// that spawned the agent (mirrors WorkerLifecycle.notifyConversation for jobs).
+func notifySpawnResult(ctx context.Context, convStore *ConversationStore, eventBus *EventBus, logger *slog.Logger, record domain.SubAgentTask) {
+	if record.ConversationID == "" {
+		return
+	}
+
+	var content string
+	if record.Status == domain.SubAgentStatusDone {
+		result := record.Result
+		if len(result) > spawnSummaryLimit {
+			result = result[:spawnSummaryLimit] + "… (truncated — use check_spawn for the full result)"
+		}
+		content = fmt.Sprintf("Background agent %s (%s) finished: %s\n\n%s", record.ID, record.PersonaName, record.Prompt, result)
+	} else {
+		content = fmt.Sprintf("Background agent %s (%s) failed: %s\n\nError: %s", record.ID, record.PersonaName, record.Prompt, record.Error)
+	}
+
+	msgID := domain.NewMessageID()
+	now := time.Now()
+
+	if convStore != nil {
+		msg := domain.Message{
+			ID:             msgID,
+			ConversationID: record.ConversationID,
+			Role:           domain.RoleAssistant,
+			Content:        content,
+			CreatedAt:      now,
+		}
+		if err := convStore.AddMessage(ctx, msg); err != nil {
+			logger.Error("failed to persist spawn result message", "sa_id", string(record.ID), "conv_id", string(record.ConversationID), "error", err)
+		}
+	}
+
+	payloadJSON, _ := json.Marshal(map[string]interface{}{
+		"id":              string(msgID),
+		"conversation_id": string(record.ConversationID),
+		"role":            "assistant",
+		"content":         content,
+		"sa_id":           string(record.ID),
+		"created_at":      now.Format(time.RFC3339),
+	})
+	eventBus.Publish(Event{
+		JobID:     string(record.ConversationID), // EventBus key = conversation ID
+		Type:      EventTypeNewMessage,
+		Data:      string(payloadJSON),
+		Timestamp: now.Unix(),
+	})
+}
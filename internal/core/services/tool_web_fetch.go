@@ -7,7 +7,9 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
@@ -56,11 +58,334 @@ func isSSRFTarget(rawURL string) bool {
 	return false
 }
 
+const (
+	DefaultWebFetchMaxBytes     = 1 << 20 // download cap
+	DefaultWebFetchMaxRedirects = 5
+	defaultWebFetchCacheTTL     = 10 * time.Minute
+	webFetchTimeout             = 30 * time.Second
+	webFetchCacheSize           = 128
+	webFetchDefaultChars        = 32000
+	webFetchMaxChars            = 100000
+	robotsCacheTTL              = time.Hour
+	webFetchUserAgent           = "auleOS/1.0 (Agent Web Fetch)"
+	robotsAgentToken            = "auleos"
+)
+
+// WebFetchOptions configures a WebFetcher. Zero values use the defaults.
+type WebFetchOptions struct {
+	MaxBytes      int64         // bytes downloaded per page; the rest is dropped
+	MaxRedirects  int           // redirects followed per fetch
+	RespectRobots bool          // refuse URLs disallowed by the site's robots.txt
+	CacheTTL      time.Duration // pages younger than this are served without a request
+}
+
+// WebFetcher downloads pages for web_fetch. Pages are cached by URL and
+// revalidated with their ETag / Last-Modified once CacheTTL has passed.
+type WebFetcher struct {
+	opts         WebFetchOptions
+	client       *http.Client
+	robotsClient *http.Client
+	allowPrivate bool // tests fetch from httptest servers on loopback
+
+	mu     sync.Mutex
+	cache  map[string]*fetchedPage
+	robots map[string]*robotsRules // by scheme://host
+}
+
+// fetchedPage is one downloaded page and its extracted content.
+type fetchedPage struct {
+	URL          string
+	FinalURL     string
+	Status       int
+	Title        string
+	Markdown     string
+	Text         string
+	Truncated    bool // download hit MaxBytes
+	ETag         string
+	LastModified string
+	fetchedAt    time.Time
+}
+
+func NewWebFetcher(opts WebFetchOptions) *WebFetcher {
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = DefaultWebFetchMaxBytes
+	}
+	if opts.MaxRedirects <= 0 {
+		opts.MaxRedirects = DefaultWebFetchMaxRedirects
+	}
+	if opts.CacheTTL <= 0 {
+		opts.CacheTTL = defaultWebFetchCacheTTL
+	}
+	f := &WebFetcher{
+		opts:   opts,
+		cache:  make(map[string]*fetchedPage),
+		robots: make(map[string]*robotsRules),
+	}
+	f.client = &http.Client{Timeout: webFetchTimeout, CheckRedirect: f.checkRedirect}
+	f.robotsClient = &http.Client{Timeout: 10 * time.Second, CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= opts.MaxRedirects || f.denied(req.URL.String()) {
+			return fmt.Errorf("robots.txt redirect refused")
+		}
+		return nil
+	}}
+	return f
+}
+
+func (f *WebFetcher) denied(rawURL string) bool {
+	return !f.allowPrivate && isSSRFTarget(rawURL)
+}
+
+func (f *WebFetcher) checkRedirect(req *http.Request, via []*http.Request) error {
+	// Check each redirect target for SSRF
+	if f.denied(req.URL.String()) {
+		return fmt.Errorf("redirect to internal address denied")
+	}
+	if len(via) >= f.opts.MaxRedirects {
+		return fmt.Errorf("too many redirects (max %d)", f.opts.MaxRedirects)
+	}
+	if f.opts.RespectRobots && !f.robotsAllowed(req.Context(), req.URL) {
+		return fmt.Errorf("redirect target %s is disallowed by robots.txt", req.URL)
+	}
+	return nil
+}
+
+// Fetch returns the page at rawURL, from the cache when still fresh or when
+// the server answers 304 Not Modified. cached reports a cache hit.
+func (f *WebFetcher) Fetch(ctx context.Context, rawURL string) (page fetchedPage, cached bool, err error) {
+	if f.denied(rawURL) {
+		return fetchedPage{}, false, fmt.Errorf("URL denied: cannot fetch internal/private addresses")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fetchedPage{}, false, fmt.Errorf("invalid URL: %w", err)
+	}
+	if f.opts.RespectRobots && !f.robotsAllowed(ctx, req.URL) {
+		return fetchedPage{}, false, fmt.Errorf("URL disallowed by robots.txt")
+	}
+
+	f.mu.Lock()
+	prev, ok := f.cache[rawURL]
+	if ok && time.Since(prev.fetchedAt) < f.opts.CacheTTL {
+		page := *prev
+		f.mu.Unlock()
+		return page, true, nil
+	}
+	f.mu.Unlock()
+
+	req.Header.Set("User-Agent", webFetchUserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,text/plain,*/*")
+	if prev != nil {
+		if prev.ETag != "" {
+			req.Header.Set("If-None-Match", prev.ETag)
+		}
+		if prev.LastModified != "" {
+			req.Header.Set("If-Modified-Since", prev.LastModified)
+		}
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fetchedPage{}, false, fmt.Errorf("fetch failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && prev != nil {
+		f.mu.Lock()
+		prev.fetchedAt = time.Now()
+		page := *prev
+		f.mu.Unlock()
+		return page, true, nil
+	}
+	if resp.StatusCode >= 400 {
+		return fetchedPage{}, false, fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.opts.MaxBytes+1))
+	if err != nil {
+		return fetchedPage{}, false, fmt.Errorf("failed to read response: %w", err)
+	}
+	page = fetchedPage{
+		URL:          rawURL,
+		FinalURL:     resp.Request.URL.String(),
+		Status:       resp.StatusCode,
+		Truncated:    int64(len(body)) > f.opts.MaxBytes,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		fetchedAt:    time.Now(),
+	}
+	if page.Truncated {
+		body = body[:f.opts.MaxBytes]
+	}
+
+	content := string(body)
+	if strings.Contains(resp.Header.Get("Content-Type"), "text/html") || strings.Contains(content, "<html") {
+		page.Title, page.Markdown = extractReadableMarkdown(content, resp.Request.URL)
+		page.Text = extractTextFromHTML(content)
+	} else {
+		page.Markdown, page.Text = content, content
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		f.store(page)
+	}
+	return page, false, nil
+}
+
+// store caches page, evicting the oldest entry when full.
+func (f *WebFetcher) store(page fetchedPage) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.cache[page.URL]; !ok && len(f.cache) >= webFetchCacheSize {
+		var oldest string
+		for u, p := range f.cache {
+			if oldest == "" || p.fetchedAt.Before(f.cache[oldest].fetchedAt) {
+				oldest = u
+			}
+		}
+		delete(f.cache, oldest)
+	}
+	f.cache[page.URL] = &page
+}
+
+// robotsAllowed reports whether u may be fetched under its host's
+// robots.txt. A missing or unreachable robots.txt allows everything.
+func (f *WebFetcher) robotsAllowed(ctx context.Context, u *url.URL) bool {
+	origin := u.Scheme + "://" + u.Host
+	f.mu.Lock()
+	rules, ok := f.robots[origin]
+	f.mu.Unlock()
+	if !ok || time.Since(rules.fetchedAt) > robotsCacheTTL {
+		rules = f.fetchRobots(ctx, origin)
+		f.mu.Lock()
+		f.robots[origin] = rules
+		f.mu.Unlock()
+	}
+	return rules.allowed(u.RequestURI())
+}
+
+func (f *WebFetcher) fetchRobots(ctx context.Context, origin string) *robotsRules {
+	empty := &robotsRules{fetchedAt: time.Now()}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+"/robots.txt", nil)
+	if err != nil {
+		return empty
+	}
+	req.Header.Set("User-Agent", webFetchUserAgent)
+	resp, err := f.robotsClient.Do(req)
+	if err != nil {
+		return empty
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return empty
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 512*1024))
+	if err != nil {
+		return empty
+	}
+	rules := parseRobots(string(body), robotsAgentToken)
+	rules.fetchedAt = empty.fetchedAt
+	return rules
+}
+
+// robotsRules are the Allow/Disallow lines of the robots.txt group that
+// applies to us.
+type robotsRules struct {
+	rules     []robotsRule
+	fetchedAt time.Time
+}
+
+type robotsRule struct {
+	pattern string
+	re      *regexp.Regexp
+	allow   bool
+}
+
+// allowed applies the longest matching rule; Allow wins ties.
+func (r *robotsRules) allowed(path string) bool {
+	best, allow := -1, true
+	for _, rule := range r.rules {
+		if !rule.re.MatchString(path) {
+			continue
+		}
+		if len(rule.pattern) > best || (len(rule.pattern) == best && rule.allow) {
+			best, allow = len(rule.pattern), rule.allow
+		}
+	}
+	return allow
+}
+
+// parseRobots picks the group naming agent, else the "*" group.
+func parseRobots(body, agent string) *robotsRules {
+	type group struct {
+		agents []string
+		rules  []robotsRule
+	}
+	var groups []*group
+	var cur *group
+	lastWasAgent := false
+	for _, line := range strings.Split(body, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		switch key {
+		case "user-agent":
+			if cur == nil || !lastWasAgent {
+				cur = &group{}
+				groups = append(groups, cur)
+			}
+			cur.agents = append(cur.agents, strings.ToLower(value))
+			lastWasAgent = true
+		case "allow", "disallow":
+			lastWasAgent = false
+			if cur == nil || value == "" {
+				continue // empty Disallow allows everything
+			}
+			cur.rules = append(cur.rules, robotsRule{pattern: value, re: robotsPattern(value), allow: key == "allow"})
+		default:
+			lastWasAgent = false
+		}
+	}
+
+	var wildcard *group
+	for _, g := range groups {
+		for _, a := range g.agents {
+			if a == "*" {
+				if wildcard == nil {
+					wildcard = g
+				}
+			} else if strings.Contains(agent, a) || strings.Contains(a, agent) {
+				return &robotsRules{rules: g.rules}
+			}
+		}
+	}
+	if wildcard != nil {
+		return &robotsRules{rules: wildcard.rules}
+	}
+	return &robotsRules{}
+}
+
+// robotsPattern compiles a robots.txt path pattern ("*" wildcards, "$" end anchor).
+func robotsPattern(p string) *regexp.Regexp {
+	anchored := strings.HasSuffix(p, "$")
+	p = strings.TrimSuffix(p, "$")
+	expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(p), `\*`, ".*")
+	if anchored {
+		expr += "$"
+	}
+	return regexp.MustCompile(expr)
+}
+
 // NewWebFetchTool creates a tool that fetches content from a URL.
-func NewWebFetchTool() *domain.Tool {
+func NewWebFetchTool(fetcher *WebFetcher) *domain.Tool {
 	return &domain.Tool{
 		Name:        "web_fetch",
-		Description: "Fetches the content of a web page URL. Returns the text content (HTML stripped of scripts/styles). Use after web_search to read a page's full content. Max 1MB response.",
+		Description: "Fetches a web page URL and returns its main content as Markdown (navigation, headers, footers and scripts stripped), or as plain text. Use after web_search to read a page's full content. Recently fetched pages are served from cache.",
 		Parameters: domain.ToolParameters{
 			Type: "object",
 			Properties: map[string]interface{}{
@@ -68,6 +393,15 @@ func NewWebFetchTool() *domain.Tool {
 					"type":        "string",
 					"description": "The URL to fetch (e.g., 'https://example.com/article').",
 				},
+				"format": map[string]interface{}{
+					"type":        "string",
+					"description": "Output format: 'markdown' (default, main content with links) or 'text' (all visible text).",
+					"enum":        []string{"markdown", "text"},
+				},
+				"max_chars": map[string]interface{}{
+					"type":        "number",
+					"description": fmt.Sprintf("Optional cap on returned characters (default: %d, max: %d).", webFetchDefaultChars, webFetchMaxChars),
+				},
 			},
 			Required: []string{"url"},
 		},
@@ -77,81 +411,52 @@ func NewWebFetchTool() *domain.Tool {
 			if !ok || rawURL == "" {
 				return nil, fmt.Errorf("url is required")
 			}
+			format, _ := params["format"].(string)
+			maxChars := webFetchDefaultChars
+			if n, ok := params["max_chars"].(float64); ok && n > 0 {
+				maxChars = min(int(n), webFetchMaxChars)
+			}
 
 			// Ensure scheme
 			if !strings.HasPrefix(rawURL, "http://") && !strings.HasPrefix(rawURL, "https://") {
 				rawURL = "https://" + rawURL
 			}
 
-			// SSRF protection
-			if isSSRFTarget(rawURL) {
-				return nil, fmt.Errorf("URL denied: cannot fetch internal/private addresses")
-			}
-
-			// Create request with timeout
-			fetchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+			fetchCtx, cancel := context.WithTimeout(ctx, webFetchTimeout)
 			defer cancel()
-
-			req, err := http.NewRequestWithContext(fetchCtx, "GET", rawURL, nil)
+			page, cached, err := fetcher.Fetch(fetchCtx, rawURL)
 			if err != nil {
-				return nil, fmt.Errorf("invalid URL: %w", err)
-			}
-			req.Header.Set("User-Agent", "auleOS/1.0 (Agent Web Fetch)")
-			req.Header.Set("Accept", "text/html,application/xhtml+xml,text/plain,*/*")
-
-			client := &http.Client{
-				Timeout: 30 * time.Second,
-				CheckRedirect: func(req *http.Request, via []*http.Request) error {
-					// Check each redirect target for SSRF
-					if isSSRFTarget(req.URL.String()) {
-						return fmt.Errorf("redirect to internal address denied")
-					}
-					if len(via) >= 5 {
-						return fmt.Errorf("too many redirects")
-					}
-					return nil
-				},
+				return nil, err
 			}
 
-			resp, err := client.Do(req)
-			if err != nil {
-				return nil, fmt.Errorf("fetch failed: %w", err)
+			content := page.Markdown
+			if format == "text" {
+				content = page.Text
 			}
-			defer resp.Body.Close()
-
-			if resp.StatusCode >= 400 {
-				return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
-			}
-
-			// Read up to 1MB
-			const maxSize = 1024 * 1024
-			limited := io.LimitReader(resp.Body, maxSize+1)
-			body, err := io.ReadAll(limited)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response: %w", err)
+			if strings.TrimSpace(content) == "" {
+				return "(page returned empty content)", nil
 			}
-			if len(body) > maxSize {
-				body = body[:maxSize]
+			// Truncate for token budget
+			if len(content) > maxChars {
+				content = content[:maxChars] + fmt.Sprintf("\n\n... (content truncated at %d characters)", maxChars)
+			} else if page.Truncated {
+				content += fmt.Sprintf("\n\n... (page truncated at %d bytes)", fetcher.opts.MaxBytes)
 			}
 
-			content := string(body)
-
-			// Basic HTML to text extraction (strip script/style tags and HTML tags)
-			contentType := resp.Header.Get("Content-Type")
-			if strings.Contains(contentType, "text/html") || strings.Contains(content, "<html") {
-				content = extractTextFromHTML(content)
+			var header strings.Builder
+			fmt.Fprintf(&header, "URL: %s\n", page.URL)
+			if page.FinalURL != "" && page.FinalURL != page.URL {
+				fmt.Fprintf(&header, "Final URL: %s\n", page.FinalURL)
 			}
-
-			// Truncate for token budget
-			if len(content) > 32000 {
-				content = content[:32000] + "\n\n... (content truncated at 32KB)"
+			if page.Title != "" {
+				fmt.Fprintf(&header, "Title: %s\n", page.Title)
 			}
-
-			if strings.TrimSpace(content) == "" {
-				return "(page returned empty content)", nil
+			status := fmt.Sprintf("%d", page.Status)
+			if cached {
+				status += " (cached)"
 			}
-
-			return fmt.Sprintf("URL: %s\nStatus: %d\n\n%s", rawURL, resp.StatusCode, content), nil
+			fmt.Fprintf(&header, "Status: %s\n\n", status)
+			return header.String() + content, nil
 		},
 	}
 }
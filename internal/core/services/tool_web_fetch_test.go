@@ -1,9 +1,17 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsSSRFTarget(t *testing.T) {
@@ -60,3 +68,123 @@ func TestExtractTextFromHTML(t *testing.T) {
 	assert.NotContains(t, text, "<script>")
 	assert.NotContains(t, text, "<h1>")
 }
+
+func TestExtractReadableMarkdown(t *testing.T) {
+	page := `<html><head><title>Go &amp; You</title><style>p{}</style></head><body>
+	<header><a href="/">Home</a> | <a href="/blog">Blog</a></header>
+	<nav>Menu</nav>
+	<article>
+	<h1>Release notes</h1>
+	<p>Read the <a href="/docs/intro">intro</a> and use <code>go test</code> &mdash; <strong>always</strong>.</p>
+	<ol><li>First</li><li>Second</li></ol>
+	<pre>func main() {
+	println("hi")
+}</pre>
+	</article>
+	<footer>Copyright</footer>
+	</body></html>`
+	base, _ := url.Parse("https://example.com/blog/post")
+
+	title, md := extractReadableMarkdown(page, base)
+
+	assert.Equal(t, "Go & You", title)
+	assert.Contains(t, md, "# Release notes")
+	assert.Contains(t, md, "[intro](https://example.com/docs/intro)")
+	assert.Contains(t, md, "`go test` — **always**.")
+	assert.Contains(t, md, "1. First\n2. Second")
+	assert.Contains(t, md, "```\nfunc main() {\n\tprintln(\"hi\")\n}\n```")
+	assert.NotContains(t, md, "Menu")
+	assert.NotContains(t, md, "Home")
+	assert.NotContains(t, md, "Copyright")
+}
+
+func newTestFetcher(opts WebFetchOptions) *WebFetcher {
+	f := NewWebFetcher(opts)
+	f.allowPrivate = true
+	return f
+}
+
+func TestWebFetcher_CachesAndRevalidatesWithETag(t *testing.T) {
+	var hits, notModified atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, "<html><body><p>cached body</p></body></html>")
+	}))
+	defer srv.Close()
+
+	f := newTestFetcher(WebFetchOptions{})
+	page, cached, err := f.Fetch(context.Background(), srv.URL)
+	require.NoError(t, err)
+	assert.False(t, cached)
+	assert.Equal(t, "cached body", page.Markdown)
+
+	// Fresh: no request at all
+	_, cached, err = f.Fetch(context.Background(), srv.URL)
+	require.NoError(t, err)
+	assert.True(t, cached)
+	assert.Equal(t, int32(1), hits.Load())
+
+	// Stale: revalidated with If-None-Match, 304 reuses the cached page
+	f.opts.CacheTTL = 0
+	page, cached, err = f.Fetch(context.Background(), srv.URL)
+	require.NoError(t, err)
+	assert.True(t, cached)
+	assert.Equal(t, "cached body", page.Markdown)
+	assert.Equal(t, int32(1), notModified.Load())
+}
+
+func TestWebFetcher_LimitsRedirectsAndSize(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	mux.HandleFunc("/big", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, strings.Repeat("x", 100))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	f := newTestFetcher(WebFetchOptions{MaxRedirects: 2, MaxBytes: 10})
+	_, _, err := f.Fetch(context.Background(), srv.URL+"/loop")
+	assert.ErrorContains(t, err, "too many redirects")
+
+	page, _, err := f.Fetch(context.Background(), srv.URL+"/big")
+	require.NoError(t, err)
+	assert.True(t, page.Truncated)
+	assert.Len(t, page.Text, 10)
+}
+
+func TestWebFetcher_RespectsRobots(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/robots.txt", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "User-agent: *\nDisallow: /private\nAllow: /private/open\n\nUser-agent: OtherBot\nDisallow: /\n")
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	f := newTestFetcher(WebFetchOptions{RespectRobots: true})
+	_, _, err := f.Fetch(context.Background(), srv.URL+"/private/secret")
+	assert.ErrorContains(t, err, "robots.txt")
+	_, _, err = f.Fetch(context.Background(), srv.URL+"/private/open/page")
+	assert.NoError(t, err)
+	_, _, err = f.Fetch(context.Background(), srv.URL+"/public")
+	assert.NoError(t, err)
+
+	_, _, err = newTestFetcher(WebFetchOptions{}).Fetch(context.Background(), srv.URL+"/private/secret")
+	assert.NoError(t, err, "robots.txt is ignored unless enabled")
+}
+
+func TestWebFetcher_BlocksPrivateTargets(t *testing.T) {
+	_, _, err := NewWebFetcher(WebFetchOptions{}).Fetch(context.Background(), "http://127.0.0.1:1/")
+	assert.ErrorContains(t, err, "denied")
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
 )
@@ -11,7 +13,7 @@ import (
 func NewGenerateImageTool(lifecycle *WorkerLifecycle) *domain.Tool {
 	return &domain.Tool{
 		Name:        "generate_image",
-		Description: "Queues an image generation job using ComfyUI and returns the job id. The result will be delivered asynchronously to the conversation.",
+		Description: "Queues an image generation job and returns the job id. The result will be delivered asynchronously to the conversation.",
 		Parameters: domain.ToolParameters{
 			Type: "object",
 			Properties: map[string]interface{}{
@@ -19,6 +21,46 @@ func NewGenerateImageTool(lifecycle *WorkerLifecycle) *domain.Tool {
 					"type":        "string",
 					"description": "The text description of the image to generate",
 				},
+				"model": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional model/checkpoint; backends serving it are preferred",
+				},
+				"backend": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional image backend name; disables failover to other backends",
+				},
+				"workflow": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional ComfyUI workflow template name from the project settings",
+				},
+				"workflow_params": map[string]interface{}{
+					"type":        "object",
+					"description": "Optional workflow slot values, e.g. {\"seed\": 42, \"width\": 768, \"negative_prompt\": \"blurry\", \"lora\": \"style.safetensors\"}",
+				},
+				"negative_prompt": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional things the image should not contain, e.g. 'blurry, text, watermark'",
+				},
+				"size": map[string]interface{}{
+					"type":        "string",
+					"description": fmt.Sprintf("Optional WIDTHxHEIGHT in pixels, e.g. '1024x768' (max %dx%d)", MaxImageSide, MaxImageSide),
+				},
+				"steps": map[string]interface{}{
+					"type":        "number",
+					"description": fmt.Sprintf("Optional sampler steps (1-%d); more is slower but more detailed", MaxImageSteps),
+				},
+				"seed": map[string]interface{}{
+					"type":        "number",
+					"description": "Optional seed to reproduce an earlier image",
+				},
+				"count": map[string]interface{}{
+					"type":        "number",
+					"description": fmt.Sprintf("Optional number of variants (1-%d), each with the next seed", MaxImageCount),
+				},
+				"style": map[string]interface{}{
+					"type":        "string",
+					"description": "Optional style added to the prompt, e.g. 'watercolor', 'photorealistic', 'pixel art'",
+				},
 			},
 			Required: []string{"prompt"},
 		},
@@ -41,28 +83,88 @@ func NewGenerateImageTool(lifecycle *WorkerLifecycle) *domain.Tool {
 				return map[string]interface{}{
 					"status":  "unavailable",
 					"error":   fmt.Sprintf("Image generation service is not available: %v", err),
-					"message": "The image generation backend (ComfyUI) is not running. Please start it or configure a remote provider in Settings.",
+					"message": "No image generation backend is reachable. Please start ComfyUI or configure a remote provider in Settings.",
 				}, nil
 			}
 
 			// Extract conversation ID so the job result can be pushed back to the chat
 			convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID)
 
-			jobID, err := lifecycle.SubmitImageJobWithConv(ctx, prompt, string(convID))
+			model, _ := params["model"].(string)
+			backend, _ := params["backend"].(string)
+			workflow, _ := params["workflow"].(string)
+			workflowParams, _ := params["workflow_params"].(map[string]interface{})
+			projectID, _ := GetProjectFromContext(ctx)
+			opts := ImageJobOptions{
+				Backend:        strings.TrimSpace(backend),
+				Model:          strings.TrimSpace(model),
+				ProjectID:      projectID,
+				Workflow:       strings.TrimSpace(workflow),
+				WorkflowParams: workflowParams,
+			}
+			if err := parseImageParams(params, &opts); err != nil {
+				return nil, err
+			}
+
+			jobID, err := lifecycle.SubmitImageJobWithOptions(ctx, prompt, string(convID), opts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to queue image job: %w", err)
 			}
 
-			return map[string]interface{}{
+			out := map[string]interface{}{
 				"status":  "queued",
 				"job_id":  string(jobID),
 				"prompt":  prompt,
 				"message": "Image is being generated asynchronously. The result will appear in this chat when ready.",
-			}, nil
+			}
+			if opts.Count > 1 {
+				out["count"] = opts.Count
+			}
+			return out, nil
 		},
 	}
 }
 
+// parseImageParams reads generate_image's generation parameters into opts.
+func parseImageParams(params map[string]interface{}, opts *ImageJobOptions) error {
+	opts.NegativePrompt, _ = params["negative_prompt"].(string)
+	opts.NegativePrompt = strings.TrimSpace(opts.NegativePrompt)
+	opts.Style, _ = params["style"].(string)
+	opts.Style = strings.TrimSpace(opts.Style)
+
+	if size, _ := params["size"].(string); strings.TrimSpace(size) != "" {
+		w, h, ok := strings.Cut(strings.ToLower(strings.TrimSpace(size)), "x")
+		var err1, err2 error
+		if ok {
+			opts.Width, err1 = strconv.Atoi(strings.TrimSpace(w))
+			opts.Height, err2 = strconv.Atoi(strings.TrimSpace(h))
+		}
+		if !ok || err1 != nil || err2 != nil || opts.Width <= 0 || opts.Height <= 0 {
+			return fmt.Errorf("size must look like 1024x768, got %q", size)
+		}
+	}
+	if v, ok := params["steps"].(float64); ok {
+		if v < 1 || v != float64(int(v)) {
+			return fmt.Errorf("steps must be a whole number >= 1")
+		}
+		opts.Steps = int(v)
+	}
+	if v, ok := params["count"].(float64); ok {
+		if v < 1 || v != float64(int(v)) {
+			return fmt.Errorf("count must be a whole number >= 1")
+		}
+		opts.Count = int(v)
+	}
+	if v, ok := params["seed"].(float64); ok {
+		if v < 0 || v != float64(int64(v)) {
+			return fmt.Errorf("seed must be a whole number >= 0")
+		}
+		seed := int64(v)
+		opts.Seed = &seed
+	}
+	return opts.validate()
+}
+
 // NewGenerateTextTool creates the text generation tool
 func NewGenerateTextTool(lifecycle *WorkerLifecycle) *domain.Tool {
 	return &domain.Tool{
@@ -109,3 +211,83 @@ func NewGenerateTextTool(lifecycle *WorkerLifecycle) *domain.Tool {
 		},
 	}
 }
+
+// NewGenerateVideoTool creates the video generation tool (AnimateDiff worker)
+func NewGenerateVideoTool(lifecycle *WorkerLifecycle) *domain.Tool {
+	return newMediaTool(lifecycle, CapabilityVideoGenerate, "generate_video",
+		"Queues a short video generation job from a text description and returns the job id. Rendering can take several minutes; the video will be delivered asynchronously to the conversation.",
+		map[string]interface{}{
+			"prompt": map[string]interface{}{
+				"type":        "string",
+				"description": "The text description of the video to generate",
+			},
+			"duration_seconds": map[string]interface{}{
+				"type":        "integer",
+				"description": "Optional clip length in seconds",
+			},
+		})
+}
+
+// NewGenerateAudioTool creates the speech/audio generation tool (Bark worker)
+func NewGenerateAudioTool(lifecycle *WorkerLifecycle) *domain.Tool {
+	return newMediaTool(lifecycle, CapabilityAudioGenerate, "generate_audio",
+		"Queues a speech/audio generation job and returns the job id. The audio will be delivered asynchronously to the conversation.",
+		map[string]interface{}{
+			"prompt": map[string]interface{}{
+				"type":        "string",
+				"description": "The text to speak or the sound to generate",
+			},
+			"voice": map[string]interface{}{
+				"type":        "string",
+				"description": "Optional speaker preset, e.g. \"v2/en_speaker_6\"",
+			},
+			"duration_seconds": map[string]interface{}{
+				"type":        "integer",
+				"description": "Optional maximum length in seconds",
+			},
+		})
+}
+
+func newMediaTool(lifecycle *WorkerLifecycle, capability, name, description string, props map[string]interface{}) *domain.Tool {
+	return &domain.Tool{
+		Name:        name,
+		Description: description,
+		Parameters: domain.ToolParameters{
+			Type:       "object",
+			Properties: props,
+			Required:   []string{"prompt"},
+		},
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			prompt, ok := params["prompt"].(string)
+			if !ok || strings.TrimSpace(prompt) == "" {
+				return nil, fmt.Errorf("missing required parameter: prompt")
+			}
+			if lifecycle == nil {
+				return nil, fmt.Errorf("worker lifecycle is not configured")
+			}
+
+			var opts MediaJobOptions
+			if d, ok := params["duration_seconds"].(float64); ok && d > 0 {
+				opts.DurationSeconds = int(d)
+			}
+			if v, ok := params["voice"].(string); ok {
+				opts.Voice = strings.TrimSpace(v)
+			}
+
+			// Extract conversation ID so the job result can be pushed back to the chat
+			convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID)
+
+			jobID, err := lifecycle.SubmitMediaJobWithConv(ctx, capability, prompt, string(convID), opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to queue %s job: %w", capability, err)
+			}
+
+			return map[string]interface{}{
+				"status":  "queued",
+				"job_id":  string(jobID),
+				"prompt":  prompt,
+				"message": "The job is rendering asynchronously; progress is streamed on the job and the result will appear in this chat when ready.",
+			}, nil
+		},
+	}
+}
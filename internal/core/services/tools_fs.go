@@ -5,27 +5,67 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
 )
 
 // ensurePathIsSafe strictly validates that the requested path is within the workspace root.
+// Symlinks are resolved first, so a link inside the workspace cannot point
+// the tools outside of it. The returned path is the resolved one.
 func ensurePathIsSafe(root, requestedPath string) (string, error) {
-	fullPath := filepath.Join(root, requestedPath)
-	cleanPath := filepath.Clean(fullPath)
+	resolvedRoot, err := resolveSymlinks(filepath.Clean(root))
+	if err != nil {
+		return "", fmt.Errorf("resolve workspace root: %w", err)
+	}
+	resolvedPath, err := resolveSymlinks(filepath.Join(root, requestedPath))
+	if err != nil {
+		return "", fmt.Errorf("security violation: path %q cannot be resolved: %w", requestedPath, err)
+	}
 
-	if !strings.HasPrefix(cleanPath, filepath.Clean(root)) {
+	rel, err := filepath.Rel(resolvedRoot, resolvedPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
 		return "", fmt.Errorf("security violation: path %q is outside workspace root", requestedPath)
 	}
-	return cleanPath, nil
+	return resolvedPath, nil
+}
+
+// resolveSymlinks is filepath.EvalSymlinks for paths that may not exist
+// yet: the deepest existing ancestor is resolved and the missing rest is
+// joined back. A dangling symlink is an error, since writing through it
+// would create its target wherever it points.
+func resolveSymlinks(path string) (string, error) {
+	path = filepath.Clean(path)
+	var missing []string
+	for {
+		if _, err := os.Lstat(path); err == nil {
+			break
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			break
+		}
+		missing = append(missing, filepath.Base(path))
+		path = parent
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	for i := len(missing) - 1; i >= 0; i-- {
+		resolved = filepath.Join(resolved, missing[i])
+	}
+	return resolved, nil
 }
 
 // NewReadFileTool creates the read_file tool
 func NewReadFileTool(ws *WorkspaceManager) *domain.Tool {
 	return &domain.Tool{
 		Name:        "read_file",
-		Description: "Reads the content of a file within the workspace. Returns text content.",
+		Description: "Reads the content of a file within the workspace. Returns text content. Pass offset or max_bytes to read a large file in chunks, continuing from the returned next_offset.",
 		Parameters: domain.ToolParameters{
 			Type: "object",
 			Properties: map[string]interface{}{
@@ -37,6 +77,14 @@ func NewReadFileTool(ws *WorkspaceManager) *domain.Tool {
 					"type":        "string",
 					"description": "ID of the project/workspace.",
 				},
+				"offset": map[string]interface{}{
+					"type":        "number",
+					"description": "Byte offset to start reading from (default: 0).",
+				},
+				"max_bytes": map[string]interface{}{
+					"type":        "number",
+					"description": "Maximum bytes to return (default: 8192, max: 65536).",
+				},
 			},
 			Required: []string{"path"},
 		},
@@ -53,19 +101,8 @@ func NewReadFileTool(ws *WorkspaceManager) *domain.Tool {
 				}
 			}
 
-			// 1. Resolve Workspace Root
-			var root string
-			if projectID != "" {
-				root = ws.GetProjectPath(projectID)
-			} else {
-				root, _ = os.UserHomeDir()
-				if root == "" {
-					root = "/tmp"
-				}
-			}
-
-			// 2. Security Check
-			safePath, err := ensurePathIsSafe(root, path)
+			// 1. Resolve the path: workspace or read-only mount, symlinks checked
+			safePath, err := resolveReadPath(ctx, ws, projectID, path)
 			if err != nil {
 				return nil, err
 			}
@@ -79,7 +116,26 @@ func NewReadFileTool(ws *WorkspaceManager) *domain.Tool {
 				return nil, fmt.Errorf("failed to read file: %w", err)
 			}
 
-			return string(content), nil
+			// Chunked read, for files too large to return at once
+			o, hasOffset := params["offset"].(float64)
+			n, hasLimit := params["max_bytes"].(float64)
+			if !hasOffset && !hasLimit {
+				return string(content), nil
+			}
+			limit := scratchpadDefaultReadBytes
+			if n > 0 {
+				limit = min(int(n), scratchpadMaxReadBytes)
+			}
+			chunk, next := sliceUTF8(string(content), max(int(o), 0), limit)
+			result := map[string]interface{}{
+				"path":    path,
+				"content": chunk,
+				"bytes":   len(content),
+			}
+			if next < len(content) {
+				result["next_offset"] = next
+			}
+			return result, nil
 		},
 	}
 }
@@ -124,19 +180,15 @@ func NewWriteFileTool(ws *WorkspaceManager) *domain.Tool {
 				}
 			}
 
-			var root string
-			if projectID != "" {
-				root = ws.GetProjectPath(projectID)
-			} else {
-				root, _ = os.UserHomeDir()
-				if root == "" {
-					root = "/tmp"
-				}
+			safePath, err := resolveWorkspacePath(ctx, ws, projectID, path)
+			if err != nil {
+				return nil, err
 			}
-			safePath, err := ensurePathIsSafe(root, path)
+			unlock, err := lockForWrite(ctx, ws, safePath, "write_file")
 			if err != nil {
 				return nil, err
 			}
+			defer unlock()
 
 			// Ensure parent dir exists
 			if err := os.MkdirAll(filepath.Dir(safePath), 0755); err != nil {
@@ -185,21 +237,21 @@ func NewListDirTool(ws *WorkspaceManager) *domain.Tool {
 				}
 			}
 
-			var root string
-			if projectID != "" {
-				root = ws.GetProjectPath(projectID)
-			} else {
-				root, _ = os.UserHomeDir()
-				if root == "" {
-					root = "/tmp"
-				}
-			}
 			targetPath := path
 			if targetPath == "" {
 				targetPath = "."
 			}
 
-			safePath, err := ensurePathIsSafe(root, targetPath)
+			mounts := ws.Mounts(ctx, projectID)
+			if len(mounts) > 0 && isMountsDir(targetPath) {
+				var names []string
+				for _, m := range mounts {
+					names = append(names, m.Name+"/")
+				}
+				return strings.Join(names, "\n") + "\n(read-only mounts)", nil
+			}
+
+			safePath, err := resolveReadPath(ctx, ws, projectID, targetPath)
 			if err != nil {
 				return nil, err
 			}
@@ -217,6 +269,9 @@ func NewListDirTool(ws *WorkspaceManager) *domain.Tool {
 				}
 				results = append(results, e.Name()+suffix)
 			}
+			if len(mounts) > 0 && filepath.Clean(targetPath) == "." && !slices.Contains(results, domain.MountsDir+"/") {
+				results = append(results, domain.MountsDir+"/")
+			}
 
 			if len(results) == 0 {
 				return "(empty directory)", nil
@@ -270,19 +325,16 @@ func NewEditFileTool(ws *WorkspaceManager) *domain.Tool {
 				}
 			}
 
-			var root string
-			if projectID != "" {
-				root = ws.GetProjectPath(projectID)
-			} else {
-				root, _ = os.UserHomeDir()
-				if root == "" {
-					root = "/tmp"
-				}
+			safePath, err := resolveWorkspacePath(ctx, ws, projectID, path)
+			if err != nil {
+				return nil, err
 			}
-			safePath, err := ensurePathIsSafe(root, path)
+			// Held across read and write, so a concurrent edit is not lost
+			unlock, err := lockForWrite(ctx, ws, safePath, "edit_file")
 			if err != nil {
 				return nil, err
 			}
+			defer unlock()
 
 			content, err := os.ReadFile(safePath)
 			if err != nil {
@@ -348,19 +400,15 @@ func NewAppendFileTool(ws *WorkspaceManager) *domain.Tool {
 				}
 			}
 
-			var root string
-			if projectID != "" {
-				root = ws.GetProjectPath(projectID)
-			} else {
-				root, _ = os.UserHomeDir()
-				if root == "" {
-					root = "/tmp"
-				}
+			safePath, err := resolveWorkspacePath(ctx, ws, projectID, path)
+			if err != nil {
+				return nil, err
 			}
-			safePath, err := ensurePathIsSafe(root, path)
+			unlock, err := lockForWrite(ctx, ws, safePath, "append_file")
 			if err != nil {
 				return nil, err
 			}
+			defer unlock()
 
 			// Ensure parent dir exists
 			if err := os.MkdirAll(filepath.Dir(safePath), 0755); err != nil {
@@ -381,3 +429,130 @@ func NewAppendFileTool(ws *WorkspaceManager) *domain.Tool {
 		},
 	}
 }
+
+// lockForWrite takes the workspace write lock of safePath for tool, naming
+// the sub-agent or conversation that runs it.
+func lockForWrite(ctx context.Context, ws *WorkspaceManager, safePath, tool string) (func(), error) {
+	holder := tool
+	if id, _ := ctx.Value(ctxKeySubAgentID).(domain.SubAgentID); id != "" {
+		holder += " in sub-agent " + string(id)
+	} else if id, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID); id != "" {
+		holder += " in conversation " + string(id)
+	}
+	return ws.LockFile(ctx, safePath, holder)
+}
+
+// resolveWorkspacePath maps a relative path into the workspace the file
+// tools use: the given project, the context's project, or the home directory.
+// Paths in the project's read-only mounts and in .git directories are
+// refused: git metadata is configuration git runs on the host.
+func resolveWorkspacePath(ctx context.Context, ws *WorkspaceManager, projectID, path string) (string, error) {
+	if projectID == "" {
+		if pID, found := GetProjectFromContext(ctx); found {
+			projectID = string(pID)
+		}
+	}
+	if _, _, ok := ws.mountFor(ctx, projectID, path); ok {
+		return "", fmt.Errorf("%w: %s", domain.ErrReadOnlyMount, path)
+	}
+	var root string
+	if projectID != "" {
+		root = ws.GetProjectPath(projectID)
+	} else {
+		root, _ = os.UserHomeDir()
+		if root == "" {
+			root = "/tmp"
+		}
+	}
+	safePath, err := ensurePathIsSafe(root, path)
+	if err != nil {
+		return "", err
+	}
+	resolvedRoot, err := resolveSymlinks(root)
+	if err != nil {
+		return "", fmt.Errorf("resolve workspace root: %w", err)
+	}
+	if rel, err := filepath.Rel(resolvedRoot, safePath); err == nil && inGitDir(rel) {
+		return "", fmt.Errorf("security violation: path %q is inside a .git directory", path)
+	}
+	return safePath, nil
+}
+
+// inGitDir reports whether a relative path has a .git component.
+func inGitDir(rel string) bool {
+	for _, part := range strings.Split(filepath.ToSlash(rel), "/") {
+		if strings.EqualFold(part, ".git") {
+			return true
+		}
+	}
+	return false
+}
+
+// patchFile applies a unified diff to a workspace file and returns the
+// new content. The file is left untouched when any hunk fails.
+func patchFile(ctx context.Context, ws *WorkspaceManager, projectID, path, patch string) (string, error) {
+	safePath, err := resolveWorkspacePath(ctx, ws, projectID, path)
+	if err != nil {
+		return "", err
+	}
+	unlock, err := lockForWrite(ctx, ws, safePath, "apply_patch")
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+	content, err := os.ReadFile(safePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("file not found: %s", path)
+		}
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	patched, err := ApplyPatch(string(content), patch)
+	if err != nil {
+		return "", fmt.Errorf("patch does not apply to %s: %w", path, err)
+	}
+	if err := os.WriteFile(safePath, []byte(patched), 0644); err != nil {
+		return "", fmt.Errorf("failed to write patched file: %w", err)
+	}
+	return patched, nil
+}
+
+// NewApplyPatchTool creates the apply_patch tool (unified diff)
+func NewApplyPatchTool(ws *WorkspaceManager) *domain.Tool {
+	return &domain.Tool{
+		Name:        "apply_patch",
+		Description: "Applies a unified diff (as produced by 'diff -u' or 'git diff') to a file in the workspace. Context and removed lines must match the file exactly; use edit_file for simple replacements.",
+		Parameters: domain.ToolParameters{
+			Type: "object",
+			Properties: map[string]interface{}{
+				"path": map[string]interface{}{
+					"type":        "string",
+					"description": "Relative path to the file to patch.",
+				},
+				"patch": map[string]interface{}{
+					"type":        "string",
+					"description": "The unified diff, with @@ hunk headers.",
+				},
+				"project_id": map[string]interface{}{
+					"type":        "string",
+					"description": "ID of the project/workspace.",
+				},
+			},
+			Required: []string{"path", "patch"},
+		},
+		ExecutionType: domain.ExecNative,
+		Execute: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			path, _ := params["path"].(string)
+			patch, _ := params["patch"].(string)
+			projectID, _ := params["project_id"].(string)
+			if path == "" || patch == "" {
+				return nil, fmt.Errorf("path and patch are required")
+			}
+			patched, err := patchFile(ctx, ws, projectID, path, patch)
+			if err != nil {
+				return nil, err
+			}
+			return fmt.Sprintf("Successfully patched %s (%d bytes)", path, len(patched)), nil
+		},
+	}
+}
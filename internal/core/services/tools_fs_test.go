@@ -4,7 +4,9 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
 	"github.com/stretchr/testify/assert"
@@ -52,6 +54,98 @@ func TestEnsurePathIsSafe(t *testing.T) {
 	}
 }
 
+func TestEnsurePathIsSafe_Symlinks(t *testing.T) {
+	base := t.TempDir()
+	root := filepath.Join(base, "ws")
+	outside := filepath.Join(base, "outside")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "src"), 0755))
+	require.NoError(t, os.MkdirAll(outside, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "secret"), []byte("x"), 0644))
+
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "out")))
+	require.NoError(t, os.Symlink(filepath.Join(outside, "secret"), filepath.Join(root, "secret")))
+	require.NoError(t, os.Symlink(filepath.Join(outside, "missing"), filepath.Join(root, "dangling")))
+	require.NoError(t, os.Symlink(filepath.Join(root, "src"), filepath.Join(root, "lib")))
+	require.NoError(t, os.Symlink("../..", filepath.Join(root, "src", "up")))
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{"file through dir link", "out/secret", true},
+		{"new file through dir link", "out/new.txt", true},
+		{"file link", "secret", true},
+		{"dangling link", "dangling", true},
+		{"relative link", "src/up/outside/secret", true},
+		{"link inside workspace", "lib/main.go", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ensurePathIsSafe(root, tt.path)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "security violation")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, filepath.Join(root, "src", "main.go"), got)
+		})
+	}
+
+	t.Run("root is a link", func(t *testing.T) {
+		linkedRoot := filepath.Join(base, "ws-link")
+		require.NoError(t, os.Symlink(root, linkedRoot))
+		got, err := ensurePathIsSafe(linkedRoot, "src/main.go")
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(root, "src", "main.go"), got)
+	})
+}
+
+func TestFileTools_SymlinkEscape(t *testing.T) {
+	ws, tmpDir := testWorkspaceManager(t)
+	projDir := filepath.Join(tmpDir, "projects", "proj1")
+	outside := filepath.Join(tmpDir, "outside")
+	require.NoError(t, os.MkdirAll(projDir, 0755))
+	require.NoError(t, os.MkdirAll(outside, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "secret.txt"), []byte("secret"), 0644))
+	require.NoError(t, os.Symlink(outside, filepath.Join(projDir, "out")))
+
+	ctx := testProjectCtx("proj1")
+	_, err := NewReadFileTool(ws).Execute(ctx, map[string]interface{}{"path": "out/secret.txt"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "security violation")
+
+	_, err = NewWriteFileTool(ws).Execute(ctx, map[string]interface{}{"path": "out/planted.txt", "content": "x"})
+	require.Error(t, err)
+	assert.NoFileExists(t, filepath.Join(outside, "planted.txt"))
+
+	_, err = NewAppendFileTool(ws).Execute(ctx, map[string]interface{}{"path": "out/secret.txt", "content": "x"})
+	require.Error(t, err)
+	data, err := os.ReadFile(filepath.Join(outside, "secret.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(data))
+}
+
+func TestGetJobFilePath_Symlink(t *testing.T) {
+	ws, tmpDir := testWorkspaceManager(t)
+	jobDir := ws.GetPath("job1")
+	require.NoError(t, os.MkdirAll(jobDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(jobDir, "result.png"), []byte("img"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "kernel.db"), []byte("db"), 0644))
+	require.NoError(t, os.Symlink(filepath.Join(tmpDir, "kernel.db"), filepath.Join(jobDir, "out.png")))
+
+	wl := &WorkerLifecycle{workspace: ws}
+	got, err := wl.GetJobFilePath("job1", "result.png")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(jobDir, "result.png"), got)
+
+	_, err = wl.GetJobFilePath("job1", "out.png")
+	assert.Error(t, err)
+	_, err = wl.GetJobFilePath("job1", "../../kernel.db")
+	assert.Error(t, err)
+}
+
 // ── read_file ───────────────────────────────────────────────────────────
 
 func TestReadFileTool(t *testing.T) {
@@ -103,7 +197,7 @@ func TestWriteFileTool(t *testing.T) {
 		"project_id": "proj1",
 	})
 	require.NoError(t, err)
-	assert.Contains(t, result.(string), "wrote")
+	assert.Contains(t, result.(string), "Written to")
 
 	// Verify file was created
 	data, err := os.ReadFile(filepath.Join(projDir, "new_file.txt"))
@@ -248,3 +342,40 @@ func TestAppendFileTool_CreatesFile(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "created via append", string(data))
 }
+
+func TestFileTools_LockConcurrentWrites(t *testing.T) {
+	ws, _ := testWorkspaceManager(t)
+	ws.SetLockTimeout(50 * time.Millisecond)
+	ctx := ContextWithProject(context.Background(), "proj")
+	appendTool := NewAppendFileTool(ws)
+
+	// Parallel appends are serialized, none is lost
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := appendTool.Execute(ctx, map[string]interface{}{"path": "log.txt", "content": "x"})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	data, err := os.ReadFile(filepath.Join(ws.GetProjectPath("proj"), "log.txt"))
+	require.NoError(t, err)
+	assert.Len(t, data, 20)
+
+	// A writer holding the file past the timeout: the edit fails with a conflict
+	target := filepath.Join(ws.GetProjectPath("proj"), "log.txt")
+	unlock, err := ws.LockFile(context.Background(), target, "write_file in sub-agent sa-1")
+	require.NoError(t, err)
+	_, err = NewEditFileTool(ws).Execute(ctx, map[string]interface{}{"path": "log.txt", "search": "x", "replace": "y"})
+	require.ErrorIs(t, err, domain.ErrFileLocked)
+	assert.Contains(t, err.Error(), "sub-agent sa-1")
+
+	// Released: the edit goes through, and the lock is dropped
+	unlock()
+	unlock()
+	_, err = NewEditFileTool(ws).Execute(ctx, map[string]interface{}{"path": "log.txt", "search": "x", "replace": "y"})
+	require.NoError(t, err)
+	assert.Empty(t, ws.locks)
+}
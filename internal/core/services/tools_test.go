@@ -0,0 +1,57 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImageParams(t *testing.T) {
+	var opts ImageJobOptions
+	err := parseImageParams(map[string]interface{}{
+		"negative_prompt": " blurry ",
+		"size":            "1024X768",
+		"steps":           float64(30),
+		"seed":            float64(1234),
+		"count":           float64(3),
+		"style":           "watercolor",
+	}, &opts)
+	require.NoError(t, err)
+	assert.Equal(t, "blurry", opts.NegativePrompt)
+	assert.Equal(t, 1024, opts.Width)
+	assert.Equal(t, 768, opts.Height)
+	assert.Equal(t, 30, opts.Steps)
+	require.NotNil(t, opts.Seed)
+	assert.Equal(t, int64(1234), *opts.Seed)
+	assert.Equal(t, 3, opts.Count)
+	assert.Equal(t, "watercolor", opts.Style)
+
+	for _, bad := range []map[string]interface{}{
+		{"size": "big"},
+		{"size": "4096x4096"},
+		{"steps": float64(0)},
+		{"count": float64(MaxImageCount + 1)},
+		{"seed": float64(1.5)},
+	} {
+		assert.Error(t, parseImageParams(bad, &ImageJobOptions{}), "%v", bad)
+	}
+}
+
+func TestImageJobOptions_WorkflowParamsKeepExplicitSlots(t *testing.T) {
+	seed := int64(5)
+	opts := ImageJobOptions{
+		WorkflowParams: map[string]interface{}{"width": 640, "lora": "x.safetensors"},
+		NegativePrompt: "text",
+		Width:          1024,
+		Height:         1024,
+		Seed:           &seed,
+	}
+	params := opts.workflowParams()
+	assert.Equal(t, 640, params["width"])
+	assert.Equal(t, 1024, params["height"])
+	assert.Equal(t, "text", params["negative_prompt"])
+	assert.Equal(t, int64(5), params["seed"])
+	assert.Equal(t, "x.safetensors", params["lora"])
+	assert.Len(t, opts.WorkflowParams, 2, "caller's map is not modified")
+}
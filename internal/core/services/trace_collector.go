@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"sort"
 	"sync"
 	"time"
 
@@ -20,6 +21,13 @@ const (
 // TraceRepository is the minimal persistence interface needed by TraceCollector.
 type TraceRepository interface {
 	SaveTrace(ctx context.Context, trace *domain.Trace) error
+	ListTraces(ctx context.Context, filter domain.TraceFilter) ([]domain.TraceSummary, error)
+	GetTrace(ctx context.Context, id domain.TraceID) (*domain.Trace, error)
+	SearchSpans(ctx context.Context, filter domain.SpanFilter) ([]domain.Span, error)
+	DeleteTracesBefore(ctx context.Context, cutoff time.Time) (int, error)
+	CountTracesBefore(ctx context.Context, cutoff time.Time) (int, error)
+	SaveProviderCapture(ctx context.Context, c domain.ProviderCapture) error
+	ListProviderCaptures(ctx context.Context, traceID domain.TraceID) ([]domain.ProviderCapture, error)
 }
 
 // TraceCollector gathers, stores, and exposes traces and spans.
@@ -34,6 +42,13 @@ type TraceCollector struct {
 	traces     map[domain.TraceID]*domain.Trace
 	spans      map[domain.SpanID]*domain.Span
 	traceOrder []domain.TraceID // for eviction
+
+	retention time.Duration // persisted traces older than this are pruned; 0 keeps all
+
+	redactor *Redactor // optional; masks secrets in span input, output and errors
+
+	recordCalls bool                                        // record mode: store raw provider exchanges
+	captures    map[domain.TraceID][]domain.ProviderCapture // record mode without a repo
 }
 
 // NewTraceCollector creates a new collector with optional EventBus for real-time events.
@@ -45,7 +60,113 @@ func NewTraceCollector(logger *slog.Logger, eventBus *EventBus, repo TraceReposi
 		repo:     repo,
 		traces:   make(map[domain.TraceID]*domain.Trace, maxTraces),
 		spans:    make(map[domain.SpanID]*domain.Span, maxTraces*10),
+		captures: make(map[domain.TraceID][]domain.ProviderCapture),
+	}
+}
+
+// SetRedactor masks secrets and PII in span input, output and errors.
+// Streamed output deltas are published live unmasked; the stored output is
+// masked.
+func (tc *TraceCollector) SetRedactor(r *Redactor) {
+	tc.mu.Lock()
+	tc.redactor = r
+	tc.mu.Unlock()
+}
+
+// SetRetention sets how long persisted traces are kept. 0 keeps them forever.
+func (tc *TraceCollector) SetRetention(d time.Duration) {
+	tc.mu.Lock()
+	tc.retention = d
+	tc.mu.Unlock()
+}
+
+// RunRetention prunes persisted traces past the retention period once an
+// hour until ctx is cancelled.
+func (tc *TraceCollector) RunRetention(ctx context.Context) error {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		tc.PruneTraces(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// PruneTraces deletes persisted traces older than the retention period.
+func (tc *TraceCollector) PruneTraces(ctx context.Context) int {
+	tc.mu.RLock()
+	retention := tc.retention
+	tc.mu.RUnlock()
+	if retention <= 0 || tc.repo == nil {
+		return 0
+	}
+	n, err := tc.repo.DeleteTracesBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		tc.logger.Warn("trace retention prune failed", "error", err)
+		return 0
+	}
+	if n > 0 {
+		tc.logger.Info("pruned old traces", "count", n, "retention", retention)
+	}
+	return n
+}
+
+// ClearTraces deletes the finished traces that started before cutoff, in
+// memory and persisted, and returns how many. With dryRun it only counts
+// them. Traces still running are kept.
+func (tc *TraceCollector) ClearTraces(ctx context.Context, cutoff time.Time, dryRun bool) (int, error) {
+	if tc.repo != nil {
+		count := tc.repo.CountTracesBefore
+		if !dryRun {
+			count = tc.repo.DeleteTracesBefore
+		}
+		n, err := count(ctx, cutoff)
+		if err != nil || dryRun {
+			return n, err
+		}
+		tc.dropTracesBefore(cutoff)
+		return n, nil
+	}
+	if dryRun {
+		tc.mu.RLock()
+		defer tc.mu.RUnlock()
+		n := 0
+		for _, t := range tc.traces {
+			if t.EndTime != nil && t.StartTime.Before(cutoff) {
+				n++
+			}
+		}
+		return n, nil
+	}
+	return tc.dropTracesBefore(cutoff), nil
+}
+
+// dropTracesBefore removes finished in-memory traces that started before
+// cutoff, with their spans and captures.
+func (tc *TraceCollector) dropTracesBefore(cutoff time.Time) int {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	dropped := map[domain.TraceID]bool{}
+	kept := tc.traceOrder[:0]
+	for _, id := range tc.traceOrder {
+		if t, ok := tc.traces[id]; ok && t.EndTime != nil && t.StartTime.Before(cutoff) {
+			dropped[id] = true
+			delete(tc.traces, id)
+			delete(tc.captures, id)
+			continue
+		}
+		kept = append(kept, id)
 	}
+	tc.traceOrder = kept
+	for sid, span := range tc.spans {
+		if dropped[span.TraceID] {
+			delete(tc.spans, sid)
+		}
+	}
+	return len(dropped)
 }
 
 // --- Context propagation ---
@@ -90,6 +211,7 @@ func (tc *TraceCollector) StartTrace(ctx context.Context, name string, attrs map
 		RootSpanID: rootSpanID,
 		Name:       name,
 		Status:     domain.SpanStatusRunning,
+		RequestID:  domain.RequestIDFrom(ctx),
 		StartTime:  now,
 		SpanCount:  1,
 	}
@@ -102,11 +224,12 @@ func (tc *TraceCollector) StartTrace(ctx context.Context, name string, attrs map
 	tc.mu.Unlock()
 
 	tc.publishEvent(traceID, "trace_start", map[string]interface{}{
-		"trace_id": traceID,
-		"name":     name,
+		"trace_id":   traceID,
+		"name":       name,
+		"request_id": trace.RequestID,
 	})
 
-	tc.logger.Debug("trace started", "trace_id", string(traceID), "name", name)
+	tc.logger.DebugContext(ctx, "trace started", "trace_id", string(traceID), "name", name)
 
 	return ContextWithTrace(ctx, traceID, rootSpanID), traceID, rootSpanID
 }
@@ -132,7 +255,7 @@ func (tc *TraceCollector) EndTrace(traceID domain.TraceID, status domain.SpanSta
 		root.EndTime = &now
 		root.DurationMs = now.Sub(root.StartTime).Milliseconds()
 		if errMsg != "" {
-			root.Error = errMsg
+			root.Error = tc.redactor.RedactStored(errMsg)
 		}
 	}
 
@@ -232,11 +355,11 @@ func (tc *TraceCollector) EndSpan(spanID domain.SpanID, status domain.SpanStatus
 
 	now := time.Now()
 	span.Status = status
-	span.Output = truncate(output, maxInputOutput)
+	span.Output = tc.redactor.RedactStored(truncate(output, maxInputOutput))
 	span.EndTime = &now
 	span.DurationMs = now.Sub(span.StartTime).Milliseconds()
 	if errMsg != "" {
-		span.Error = errMsg
+		span.Error = tc.redactor.RedactStored(errMsg)
 	}
 
 	tc.publishEvent(span.TraceID, "span_end", map[string]interface{}{
@@ -256,7 +379,23 @@ func (tc *TraceCollector) SetSpanInput(spanID domain.SpanID, input string) {
 	tc.mu.Lock()
 	defer tc.mu.Unlock()
 	if span, ok := tc.spans[spanID]; ok {
-		span.Input = truncate(input, maxInputOutput)
+		span.Input = tc.redactor.RedactStored(truncate(input, maxInputOutput))
+	}
+}
+
+// SetSpanAttribute sets one attribute on a span, for values only known once
+// the work is done.
+func (tc *TraceCollector) SetSpanAttribute(spanID domain.SpanID, key, value string) {
+	if spanID == "" {
+		return
+	}
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if span, ok := tc.spans[spanID]; ok {
+		if span.Attributes == nil {
+			span.Attributes = map[string]string{}
+		}
+		span.Attributes[key] = value
 	}
 }
 
@@ -272,6 +411,31 @@ func (tc *TraceCollector) SetSpanModel(spanID domain.SpanID, model string) {
 	}
 }
 
+// AppendSpanOutput extends a running span's output with streamed text and
+// publishes the delta so trace viewers can follow generation live.
+func (tc *TraceCollector) AppendSpanOutput(spanID domain.SpanID, delta string) {
+	if spanID == "" || delta == "" {
+		return
+	}
+	tc.mu.Lock()
+	span, ok := tc.spans[spanID]
+	if !ok {
+		tc.mu.Unlock()
+		return
+	}
+	if len(span.Output) < maxInputOutput {
+		// Masked as a whole so a secret split across deltas is still caught
+		span.Output = tc.redactor.RedactStored(truncate(span.Output+delta, maxInputOutput))
+	}
+	traceID := span.TraceID
+	tc.mu.Unlock()
+
+	tc.publishEvent(traceID, "span_output", map[string]interface{}{
+		"span_id": spanID,
+		"delta":   delta,
+	})
+}
+
 // SetTraceConversation associates a conversation ID with the trace.
 func (tc *TraceCollector) SetTraceConversation(traceID domain.TraceID, convID string, personaID string) {
 	tc.mu.Lock()
@@ -284,52 +448,135 @@ func (tc *TraceCollector) SetTraceConversation(traceID domain.TraceID, convID st
 
 // --- Query ---
 
-// ListTraces returns summaries of recent traces (newest first).
-func (tc *TraceCollector) ListTraces(limit int) []domain.TraceSummary {
-	tc.mu.RLock()
-	defer tc.mu.RUnlock()
-
-	if limit <= 0 || limit > len(tc.traceOrder) {
-		limit = len(tc.traceOrder)
+// ListTraces returns summaries of traces matching filter (newest first).
+// In-memory traces (including running ones) are merged with persisted history,
+// so traces evicted from the ring buffer or from before a restart still show up.
+func (tc *TraceCollector) ListTraces(ctx context.Context, filter domain.TraceFilter) ([]domain.TraceSummary, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
 	}
 
+	tc.mu.RLock()
 	result := make([]domain.TraceSummary, 0, limit)
+	seen := make(map[domain.TraceID]bool)
 	// Iterate in reverse (newest first)
 	for i := len(tc.traceOrder) - 1; i >= 0 && len(result) < limit; i-- {
 		tid := tc.traceOrder[i]
 		if trace, ok := tc.traces[tid]; ok {
-			result = append(result, domain.TraceSummary{
-				ID:         trace.ID,
-				Name:       trace.Name,
-				Status:     trace.Status,
-				StartTime:  trace.StartTime,
-				DurationMs: trace.DurationMs,
-				SpanCount:  trace.SpanCount,
-			})
+			summary := domain.TraceSummary{
+				ID:             trace.ID,
+				Name:           trace.Name,
+				Status:         trace.Status,
+				ConversationID: trace.ConversationID,
+				PersonaID:      trace.PersonaID,
+				RequestID:      trace.RequestID,
+				StartTime:      trace.StartTime,
+				DurationMs:     trace.DurationMs,
+				SpanCount:      trace.SpanCount,
+			}
+			if filter.Matches(summary) {
+				result = append(result, summary)
+				seen[trace.ID] = true
+			}
+		}
+	}
+	tc.mu.RUnlock()
+
+	if tc.repo == nil {
+		return result, nil
+	}
+
+	dbFilter := filter
+	dbFilter.Limit = limit
+	persisted, err := tc.repo.ListTraces(ctx, dbFilter)
+	if err != nil {
+		return result, fmt.Errorf("list persisted traces: %w", err)
+	}
+	for _, s := range persisted {
+		if !seen[s.ID] {
+			result = append(result, s)
+			seen[s.ID] = true
 		}
 	}
-	return result
+
+	sort.Slice(result, func(i, j int) bool { return result[i].StartTime.After(result[j].StartTime) })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
 }
 
-// GetTrace returns a full trace with all spans.
-func (tc *TraceCollector) GetTrace(traceID domain.TraceID) (*domain.Trace, error) {
+// GetTrace returns a full trace with all spans, falling back to the
+// repository for traces no longer held in memory.
+func (tc *TraceCollector) GetTrace(ctx context.Context, traceID domain.TraceID) (*domain.Trace, error) {
 	tc.mu.RLock()
-	defer tc.mu.RUnlock()
-
 	trace, ok := tc.traces[traceID]
-	if !ok {
-		return nil, fmt.Errorf("trace not found: %s", traceID)
+	if ok {
+		// Collect all spans for this trace
+		result := *trace // copy
+		for _, span := range tc.spans {
+			if span.TraceID == traceID {
+				result.Spans = append(result.Spans, *span)
+			}
+		}
+		tc.mu.RUnlock()
+		return &result, nil
+	}
+	tc.mu.RUnlock()
+
+	if tc.repo == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrTraceNotFound, traceID)
+	}
+	return tc.repo.GetTrace(ctx, traceID)
+}
+
+// SearchSpans finds spans across traces (memory + persisted history), newest first.
+func (tc *TraceCollector) SearchSpans(ctx context.Context, filter domain.SpanFilter) ([]domain.Span, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 100
 	}
 
-	// Collect all spans for this trace
-	result := *trace // copy
+	tc.mu.RLock()
+	result := make([]domain.Span, 0)
+	seen := make(map[domain.SpanID]bool)
 	for _, span := range tc.spans {
-		if span.TraceID == traceID {
-			result.Spans = append(result.Spans, *span)
+		if !filter.Matches(*span) {
+			continue
+		}
+		if filter.ConversationID != "" {
+			trace, ok := tc.traces[span.TraceID]
+			if !ok || trace.ConversationID != filter.ConversationID {
+				continue
+			}
+		}
+		result = append(result, *span)
+		seen[span.ID] = true
+	}
+	tc.mu.RUnlock()
+
+	var err error
+	if tc.repo != nil {
+		dbFilter := filter
+		dbFilter.Limit = limit
+		var persisted []domain.Span
+		persisted, err = tc.repo.SearchSpans(ctx, dbFilter)
+		for _, s := range persisted {
+			if !seen[s.ID] {
+				result = append(result, s)
+			}
+		}
+		if err != nil {
+			err = fmt.Errorf("search persisted spans: %w", err)
 		}
 	}
 
-	return &result, nil
+	sort.Slice(result, func(i, j int) bool { return result[i].StartTime.After(result[j].StartTime) })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, err
 }
 
 // --- Internal helpers ---
@@ -349,6 +596,7 @@ func (tc *TraceCollector) evictIfNeeded() {
 			}
 			delete(tc.traces, oldID)
 		}
+		delete(tc.captures, oldID)
 	}
 }
 
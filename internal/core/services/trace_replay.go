@@ -0,0 +1,255 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// replayConversations is the part of ConversationStore replays need.
+type replayConversations interface {
+	GetConversation(ctx context.Context, id domain.ConversationID) (domain.Conversation, error)
+	GetMessages(ctx context.Context, convID domain.ConversationID, limit int) ([]domain.Message, error)
+	CreateConversationWithPersona(ctx context.Context, title string, personaID *domain.PersonaID) (domain.Conversation, error)
+	CreateProjectConversation(ctx context.Context, title string, projectID domain.ProjectID, personaID *domain.PersonaID) (domain.Conversation, error)
+	SetContext(ctx context.Context, id domain.ConversationID, vars domain.ContextVars) error
+	SetStyle(ctx context.Context, id domain.ConversationID, style *domain.ConversationStyle) error
+	AddMessage(ctx context.Context, msg domain.Message) error
+}
+
+// replayTraces looks up the recorded run (TraceCollector).
+type replayTraces interface {
+	GetTrace(ctx context.Context, id domain.TraceID) (*domain.Trace, error)
+}
+
+// TraceReplayer re-executes recorded agent runs against a chosen model, so
+// models can be compared on real historical interactions. The replay runs
+// in a scratch conversation holding a copy of the original history, and its
+// tool calls are answered with the recorded observations instead of running
+// the tools.
+type TraceReplayer struct {
+	logger *slog.Logger
+	traces replayTraces
+	convs  replayConversations
+	agent  evalAgent
+}
+
+func NewTraceReplayer(logger *slog.Logger, traces replayTraces, convs replayConversations, agent evalAgent) *TraceReplayer {
+	return &TraceReplayer{logger: logger, traces: traces, convs: convs, agent: agent}
+}
+
+// Replay re-runs the agent chat recorded in traceID with model (empty = the
+// model the agent would pick). Replays run at temperature 0 with the
+// original run's seed.
+// A failed replay run is reported in the result, not as an error.
+func (r *TraceReplayer) Replay(ctx context.Context, traceID domain.TraceID, model string) (*domain.TraceReplay, error) {
+	trace, err := r.traces.GetTrace(ctx, traceID)
+	if err != nil {
+		return nil, err
+	}
+	if trace.ConversationID == "" {
+		return nil, fmt.Errorf("%w: not an agent chat", domain.ErrTraceNotReplayable)
+	}
+	convID := domain.ConversationID(trace.ConversationID)
+	conv, err := r.convs.GetConversation(ctx, convID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: conversation unavailable: %v", domain.ErrTraceNotReplayable, err)
+	}
+	msgs, err := r.convs.GetMessages(ctx, convID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("load messages: %w", err)
+	}
+
+	// The run's answer carries its trace ID; the user message before it
+	// started the run
+	answer, user := -1, -1
+	for i, m := range msgs {
+		if m.Role == domain.RoleAssistant && m.Metadata["trace_id"] == string(traceID) {
+			answer = i
+			break
+		}
+	}
+	for i := answer - 1; i >= 0; i-- {
+		if msgs[i].Role == domain.RoleUser {
+			user = i
+			break
+		}
+	}
+	if answer < 0 || user < 0 {
+		return nil, fmt.Errorf("%w: the run left no stored answer", domain.ErrTraceNotReplayable)
+	}
+	orig := msgs[answer]
+	origModel, _ := orig.Metadata["model"].(string)
+
+	scratch, err := r.scratchConversation(ctx, conv, msgs[max(0, user-contextHistoryMessages):user])
+	if err != nil {
+		return nil, fmt.Errorf("create replay conversation: %w", err)
+	}
+
+	replay := newToolReplay(orig.Steps)
+	runCtx := contextWithToolReplay(domain.WithDeterministic(ctx), replay)
+	if model != "" {
+		runCtx = ContextWithModel(runCtx, model)
+	}
+	// The original seed, so replaying with the same model reproduces the run
+	seed, hasSeed := messageSeed(orig)
+	if hasSeed {
+		runCtx = domain.WithSeed(runCtx, seed)
+	}
+	r.logger.InfoContext(ctx, "replaying agent run", "trace_id", string(traceID), "model", model, "conversation_id", string(scratch.ID))
+
+	result := &domain.TraceReplay{
+		Prompt:         msgs[user].Content,
+		ConversationID: scratch.ID,
+		Original: domain.ReplayRun{
+			TraceID:  traceID,
+			Model:    origModel,
+			Seed:     seed,
+			Response: orig.Content,
+			Steps:    orig.Steps,
+		},
+		Replay: domain.ReplayRun{Model: model, Steps: []domain.ReActStep{}},
+	}
+	resp, _, err := r.agent.Chat(runCtx, scratch.ID, msgs[user].Content, conv.PersonaID)
+	if err != nil {
+		result.Replay.Error = err.Error()
+	} else {
+		result.Replay.Response = resp.Response
+		result.Replay.Steps = resp.Steps
+		result.Replay.Seed = resp.Seed
+	}
+	result.UnmatchedToolCalls = replay.unmatched()
+
+	// The replay's trace ID is on the answer it stored
+	if replayed, err := r.convs.GetMessages(ctx, scratch.ID, 0); err == nil {
+		for i := len(replayed) - 1; i >= 0; i-- {
+			if id, ok := replayed[i].Metadata["trace_id"].(string); ok && replayed[i].Role == domain.RoleAssistant {
+				result.Replay.TraceID = domain.TraceID(id)
+				if m, ok := replayed[i].Metadata["model"].(string); ok && result.Replay.Model == "" {
+					result.Replay.Model = m
+				}
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// messageSeed reads the sampling seed recorded on an answer. Stored
+// metadata decodes numbers as float64.
+func messageSeed(msg domain.Message) (int64, bool) {
+	switch v := msg.Metadata["seed"].(type) {
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// scratchConversation creates the conversation a replay runs in, with the
+// original's project, persona, context variables, style and a copy of
+// history.
+func (r *TraceReplayer) scratchConversation(ctx context.Context, conv domain.Conversation, history []domain.Message) (domain.Conversation, error) {
+	title := PlaceholderTitle("[replay] " + conv.Title)
+	var scratch domain.Conversation
+	var err error
+	if conv.ProjectID != nil {
+		scratch, err = r.convs.CreateProjectConversation(ctx, title, *conv.ProjectID, conv.PersonaID)
+	} else {
+		scratch, err = r.convs.CreateConversationWithPersona(ctx, title, conv.PersonaID)
+	}
+	if err != nil {
+		return domain.Conversation{}, err
+	}
+	if len(conv.Context) > 0 {
+		if err := r.convs.SetContext(ctx, scratch.ID, conv.Context); err != nil {
+			return domain.Conversation{}, fmt.Errorf("copy conversation context: %w", err)
+		}
+	}
+	if conv.Style != nil {
+		if err := r.convs.SetStyle(ctx, scratch.ID, conv.Style); err != nil {
+			return domain.Conversation{}, fmt.Errorf("copy conversation style: %w", err)
+		}
+	}
+	base := time.Now().Add(-time.Duration(len(history)) * time.Millisecond)
+	for i, m := range history {
+		m.ID = domain.NewMessageID()
+		m.ConversationID = scratch.ID
+		m.CreatedAt = base.Add(time.Duration(i) * time.Millisecond) // keeps the original order
+		if err := r.convs.AddMessage(ctx, m); err != nil {
+			return domain.Conversation{}, fmt.Errorf("copy history: %w", err)
+		}
+	}
+	return scratch, nil
+}
+
+// toolReplay answers a replayed run's tool calls with the observations of
+// the recorded run. A call takes the first unused recording of the same tool
+// with the same input, else the first unused recording of that tool.
+type toolReplay struct {
+	mu     sync.Mutex
+	steps  []domain.ReActStep
+	used   []bool
+	misses int
+}
+
+func newToolReplay(recorded []domain.ReActStep) *toolReplay {
+	var steps []domain.ReActStep
+	for _, st := range recorded {
+		if !st.IsFinalAnswer && st.Action != "" {
+			steps = append(steps, st)
+		}
+	}
+	return &toolReplay{steps: steps, used: make([]bool, len(steps))}
+}
+
+// observe returns the recorded observation for a call with input to the
+// tool known by names (as the model wrote it, as resolved).
+func (t *toolReplay) observe(input map[string]interface{}, names ...string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	want, _ := json.Marshal(input)
+	match := -1
+	for i, st := range t.steps {
+		if t.used[i] || !slices.Contains(names, st.Action) {
+			continue
+		}
+		if got, _ := json.Marshal(st.ActionInput); string(got) == string(want) {
+			match = i
+			break
+		}
+		if match < 0 {
+			match = i
+		}
+	}
+	if match < 0 {
+		t.misses++
+		return fmt.Sprintf("Error: no recorded observation for %s in the replayed run", names[0])
+	}
+	t.used[match] = true
+	return t.steps[match].Observation
+}
+
+func (t *toolReplay) unmatched() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.misses
+}
+
+type toolReplayKey struct{}
+
+func contextWithToolReplay(ctx context.Context, t *toolReplay) context.Context {
+	return context.WithValue(ctx, toolReplayKey{}, t)
+}
+
+func toolReplayFrom(ctx context.Context) *toolReplay {
+	t, _ := ctx.Value(toolReplayKey{}).(*toolReplay)
+	return t
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// memReplayConvs keeps conversations and their messages in memory.
+type memReplayConvs struct {
+	convs map[domain.ConversationID]domain.Conversation
+	msgs  map[domain.ConversationID][]domain.Message
+}
+
+func (m *memReplayConvs) GetConversation(_ context.Context, id domain.ConversationID) (domain.Conversation, error) {
+	c, ok := m.convs[id]
+	if !ok {
+		return domain.Conversation{}, fmt.Errorf("conversation %s not found", id)
+	}
+	return c, nil
+}
+
+func (m *memReplayConvs) GetMessages(_ context.Context, id domain.ConversationID, _ int) ([]domain.Message, error) {
+	return append([]domain.Message(nil), m.msgs[id]...), nil
+}
+
+func (m *memReplayConvs) CreateConversationWithPersona(_ context.Context, title string, personaID *domain.PersonaID) (domain.Conversation, error) {
+	c := domain.Conversation{ID: domain.ConversationID(fmt.Sprintf("conv-%d", len(m.convs)+1)), Title: title, PersonaID: personaID}
+	m.convs[c.ID] = c
+	return c, nil
+}
+
+func (m *memReplayConvs) CreateProjectConversation(ctx context.Context, title string, projectID domain.ProjectID, personaID *domain.PersonaID) (domain.Conversation, error) {
+	c, _ := m.CreateConversationWithPersona(ctx, title, personaID)
+	c.ProjectID = &projectID
+	m.convs[c.ID] = c
+	return c, nil
+}
+
+func (m *memReplayConvs) SetContext(_ context.Context, id domain.ConversationID, vars domain.ContextVars) error {
+	c := m.convs[id]
+	c.Context = vars
+	m.convs[id] = c
+	return nil
+}
+
+func (m *memReplayConvs) SetStyle(_ context.Context, id domain.ConversationID, style *domain.ConversationStyle) error {
+	c := m.convs[id]
+	c.Style = style
+	m.convs[id] = c
+	return nil
+}
+
+func (m *memReplayConvs) AddMessage(_ context.Context, msg domain.Message) error {
+	m.msgs[msg.ConversationID] = append(m.msgs[msg.ConversationID], msg)
+	return nil
+}
+
+// replayFakeAgent searches twice, then answers with the observations it got.
+type replayFakeAgent struct {
+	convs   *memReplayConvs
+	history int // messages already in the conversation when Chat was called
+}
+
+func (a *replayFakeAgent) Chat(ctx context.Context, convID domain.ConversationID, message string, _ *domain.PersonaID) (*domain.AgentResponse, domain.ConversationID, error) {
+	a.history = len(a.convs.msgs[convID])
+	model, _ := GetModelFromContext(ctx)
+	replay := toolReplayFrom(ctx)
+	first := replay.observe(map[string]interface{}{"query": "lisbon weather"}, "web_search")
+	second := replay.observe(map[string]interface{}{"query": "porto weather"}, "web_search")
+	answer := model + ": " + first + " / " + second
+	seed, _ := domain.SeedFrom(ctx)
+	a.convs.msgs[convID] = append(a.convs.msgs[convID], domain.Message{
+		ConversationID: convID, Role: domain.RoleAssistant, Content: answer,
+		Metadata: map[string]interface{}{"trace_id": "trace-replayed", "model": model},
+	})
+	return &domain.AgentResponse{Response: answer, Seed: seed, Steps: []domain.ReActStep{{Action: "web_search"}, {Action: "web_search"}, {IsFinalAnswer: true, FinalAnswer: answer}}}, convID, nil
+}
+
+func TestTraceReplayer_Replay(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+	tc := NewTraceCollector(logger, nil, nil)
+	_, traceID, _ := tc.StartTrace(ctx, "chat: weather", nil)
+	tc.SetTraceConversation(traceID, "conv-orig", "")
+	tc.EndTrace(traceID, domain.SpanStatusOK, "")
+
+	proj := domain.ProjectID("proj-1")
+	convs := &memReplayConvs{
+		convs: map[domain.ConversationID]domain.Conversation{"conv-orig": {ID: "conv-orig", Title: "Weather", ProjectID: &proj, Style: &domain.ConversationStyle{Verbosity: domain.VerbosityBrief}}},
+		msgs: map[domain.ConversationID][]domain.Message{"conv-orig": {
+			{ID: "m1", ConversationID: "conv-orig", Role: domain.RoleUser, Content: "hi"},
+			{ID: "m2", ConversationID: "conv-orig", Role: domain.RoleAssistant, Content: "hello"},
+			{ID: "m3", ConversationID: "conv-orig", Role: domain.RoleUser, Content: "weather in lisbon?"},
+			{ID: "m4", ConversationID: "conv-orig", Role: domain.RoleAssistant, Content: "sunny",
+				Metadata: map[string]interface{}{"trace_id": string(traceID), "model": "small", "seed": float64(7)},
+				Steps: []domain.ReActStep{
+					{Action: "web_search", ActionInput: map[string]interface{}{"query": "weather"}, Observation: "22C"},
+					{Action: "web_search", ActionInput: map[string]interface{}{"query": "lisbon weather"}, Observation: "sunny"},
+					{IsFinalAnswer: true, FinalAnswer: "sunny"},
+				}},
+		}},
+	}
+	agent := &replayFakeAgent{convs: convs}
+	r := NewTraceReplayer(logger, tc, convs, agent)
+
+	res, err := r.Replay(ctx, traceID, "big")
+	require.NoError(t, err)
+	assert.Equal(t, "weather in lisbon?", res.Prompt)
+	assert.Equal(t, "small", res.Original.Model)
+	assert.Equal(t, "sunny", res.Original.Response)
+	// The exact input match wins; the other call takes the remaining recording
+	assert.Equal(t, "big: sunny / 22C", res.Replay.Response)
+	assert.Equal(t, "big", res.Replay.Model)
+	assert.Equal(t, domain.TraceID("trace-replayed"), res.Replay.TraceID)
+	assert.Equal(t, 0, res.UnmatchedToolCalls)
+	assert.Equal(t, int64(7), res.Original.Seed)
+	assert.Equal(t, int64(7), res.Replay.Seed, "the replay samples with the original seed")
+
+	// The replay ran in a new conversation of the same project, holding the
+	// history before the replayed message
+	assert.NotEqual(t, domain.ConversationID("conv-orig"), res.ConversationID)
+	require.NotNil(t, convs.convs[res.ConversationID].ProjectID)
+	assert.Equal(t, proj, *convs.convs[res.ConversationID].ProjectID)
+	assert.Equal(t, convs.convs["conv-orig"].Style, convs.convs[res.ConversationID].Style)
+	assert.Equal(t, 2, agent.history)
+	assert.Len(t, convs.msgs["conv-orig"], 4, "the original conversation is untouched")
+
+	_, err = r.Replay(ctx, "missing", "")
+	assert.ErrorIs(t, err, domain.ErrTraceNotFound)
+
+	_, other, _ := tc.StartTrace(ctx, "workflow: x", nil)
+	_, err = r.Replay(ctx, other, "")
+	assert.ErrorIs(t, err, domain.ErrTraceNotReplayable)
+}
+
+func TestToolReplay_Unmatched(t *testing.T) {
+	replay := newToolReplay([]domain.ReActStep{{Action: "read_file", Observation: "contents"}})
+	assert.Equal(t, "contents", replay.observe(nil, "read_file"))
+	assert.Contains(t, replay.observe(nil, "read_file"), "no recorded observation")
+	assert.Contains(t, replay.observe(nil, "web_fetch"), "no recorded observation")
+	assert.Equal(t, 2, replay.unmatched())
+}
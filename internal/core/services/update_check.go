@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// Update check defaults.
+const (
+	DefaultUpdateRepo     = "manthysbr/auleOS"
+	DefaultUpdateInterval = 24 * time.Hour
+)
+
+// githubRelease is the part of GitHub's latest release JSON we use.
+type githubRelease struct {
+	TagName     string    `json:"tag_name"`
+	HTMLURL     string    `json:"html_url"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// UpdateChecker compares the running version with the latest GitHub
+// release and posts to the kernel inbox once per newer release.
+type UpdateChecker struct {
+	logger  *slog.Logger
+	client  *http.Client
+	url     string // latest release API endpoint
+	current string
+
+	mu         sync.Mutex
+	systemChat *SystemChat
+	status     *domain.UpdateInfo // nil until the first check
+	notified   string             // release already announced
+}
+
+// NewUpdateChecker checks the releases of the GitHub repository
+// "owner/name"; empty means DefaultUpdateRepo.
+func NewUpdateChecker(logger *slog.Logger, current, repo string) *UpdateChecker {
+	if repo == "" {
+		repo = DefaultUpdateRepo
+	}
+	return &UpdateChecker{
+		logger:  logger,
+		client:  &http.Client{Timeout: 15 * time.Second},
+		url:     "https://api.github.com/repos/" + strings.Trim(repo, "/") + "/releases/latest",
+		current: current,
+	}
+}
+
+// SetSystemChat makes new releases show up in the kernel inbox.
+func (u *UpdateChecker) SetSystemChat(sc *SystemChat) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.systemChat = sc
+}
+
+// Status returns the result of the last check; false before the first.
+func (u *UpdateChecker) Status() (domain.UpdateInfo, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.status == nil {
+		return domain.UpdateInfo{}, false
+	}
+	return *u.status, true
+}
+
+// Run checks right away and then every interval until ctx is done.
+func (u *UpdateChecker) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultUpdateInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		u.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check fetches the latest release and records the result. Failures are
+// recorded in UpdateInfo.Error too.
+func (u *UpdateChecker) Check(ctx context.Context) (domain.UpdateInfo, error) {
+	info := domain.UpdateInfo{Current: u.current, CheckedAt: time.Now()}
+	rel, err := u.latest(ctx)
+	if err != nil {
+		info.Error = err.Error()
+		u.logger.Debug("update check failed", "error", err)
+	} else {
+		info.Latest = rel.TagName
+		info.URL = rel.HTMLURL
+		info.PublishedAt = rel.PublishedAt
+		info.Available = domain.NewerVersion(rel.TagName, u.current)
+	}
+
+	u.mu.Lock()
+	u.status = &info
+	announce := info.Available && u.notified != info.Latest
+	if announce {
+		u.notified = info.Latest
+	}
+	sc := u.systemChat
+	u.mu.Unlock()
+
+	if announce {
+		u.logger.Info("new kernel release available", "current", info.Current, "latest", info.Latest)
+		if sc != nil {
+			sc.Notify(ctx, fmt.Sprintf("⬆️ auleOS **%s** is available (running %s). Release notes: %s",
+				info.Latest, info.Current, info.URL))
+		}
+	}
+	return info, err
+}
+
+func (u *UpdateChecker) latest(ctx context.Context) (*githubRelease, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("release server not reachable: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("release server returned %d", resp.StatusCode)
+	}
+	var rel githubRelease
+	if err := json.NewDecoder(resp.Body).Decode(&rel); err != nil {
+		return nil, fmt.Errorf("decode release: %w", err)
+	}
+	if rel.TagName == "" {
+		return nil, fmt.Errorf("release has no tag")
+	}
+	return &rel, nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateChecker_ComparesWithLatestRelease(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	tag := "v1.3.0"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tag == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"tag_name": "` + tag + `", "html_url": "https://example.com/` + tag + `"}`))
+	}))
+	defer srv.Close()
+
+	u := NewUpdateChecker(logger, "v1.2.4", "")
+	u.url = srv.URL
+	_, ok := u.Status()
+	assert.False(t, ok)
+
+	info, err := u.Check(context.Background())
+	require.NoError(t, err)
+	assert.True(t, info.Available)
+	assert.Equal(t, "v1.3.0", info.Latest)
+	assert.Equal(t, "https://example.com/v1.3.0", info.URL)
+	assert.Equal(t, "v1.3.0", u.notified)
+
+	tag = ""
+	_, err = u.Check(context.Background())
+	assert.Error(t, err)
+	status, ok := u.Status()
+	require.True(t, ok)
+	assert.False(t, status.Available)
+	assert.Contains(t, status.Error, "403")
+}
+
+func TestNewerVersion(t *testing.T) {
+	cases := []struct {
+		latest, current string
+		want            bool
+	}{
+		{"v1.3.0", "v1.2.9", true},
+		{"v1.10.0", "v1.9.0", true},
+		{"v1.2.0", "v1.2.0", false},
+		{"v1.2.0", "v1.2.0-rc1", true},
+		{"v1.2.0-rc1", "v1.2.0", false},
+		{"v1.2", "v1.1.5", true},
+		{"v1.3.0", domain.DevVersion, false},
+		{"nightly", "v1.0.0", false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, domain.NewerVersion(c.latest, c.current), "%s vs %s", c.latest, c.current)
+	}
+}
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// UsageRepository persists LLM usage and the limits on it.
+type UsageRepository interface {
+	RecordUsage(ctx context.Context, rec domain.UsageRecord) error
+	UsageTotals(ctx context.Context, scope domain.UsageScope, id string) (domain.UsageTotals, error)
+	SaveUsageLimit(ctx context.Context, l domain.UsageLimit) error
+	GetUsageLimit(ctx context.Context, scope domain.UsageScope, id string) (domain.UsageLimit, error)
+	DeleteUsageLimit(ctx context.Context, scope domain.UsageScope, id string) error
+}
+
+// UsageStatus is a conversation's standing against the tightest of its
+// conversation and project limits.
+type UsageStatus struct {
+	Limit    *domain.UsageLimit `json:"limit,omitempty"`
+	Totals   domain.UsageTotals `json:"totals"`
+	Fraction float64            `json:"fraction"`
+}
+
+// Exceeded reports whether the limit is used up.
+func (st UsageStatus) Exceeded() bool {
+	return st.Limit != nil && st.Fraction >= 1
+}
+
+// Warning reports whether the limit is past the warning threshold.
+func (st UsageStatus) Warning() bool {
+	return st.Limit != nil && st.Fraction >= domain.UsageWarnFraction
+}
+
+// Message describes the status for the user.
+func (st UsageStatus) Message() string {
+	if st.Limit == nil {
+		return ""
+	}
+	var parts []string
+	if st.Limit.MaxTokens > 0 {
+		parts = append(parts, fmt.Sprintf("%d of %d tokens", st.Totals.Tokens(), st.Limit.MaxTokens))
+	}
+	if st.Limit.MaxCostUSD > 0 {
+		parts = append(parts, fmt.Sprintf("$%.4f of $%.4f", st.Totals.CostUSD, st.Limit.MaxCostUSD))
+	}
+	used := strings.Join(parts, ", ")
+	if st.Exceeded() {
+		return fmt.Sprintf("This %s has reached its usage limit (%s used). I stopped before calling the model again; raise or remove the limit to continue.", st.Limit.Scope, used)
+	}
+	return fmt.Sprintf("This %s has used %.0f%% of its usage limit (%s).", st.Limit.Scope, st.Fraction*100, used)
+}
+
+// UsageMeter records the tokens and cost of LLM calls and enforces
+// per-conversation and per-project limits on them.
+type UsageMeter struct {
+	logger   *slog.Logger
+	repo     UsageRepository
+	router   *ModelRouter // optional; prices calls from the model catalog
+	eventBus *EventBus    // optional; receives usage alerts
+	now      func() time.Time
+
+	mu     sync.Mutex
+	warned map[string]bool // limits already alerted on, keyed by scope, id and caps
+}
+
+func NewUsageMeter(logger *slog.Logger, repo UsageRepository, router *ModelRouter) *UsageMeter {
+	return &UsageMeter{
+		logger: logger,
+		repo:   repo,
+		router: router,
+		now:    time.Now,
+		warned: make(map[string]bool),
+	}
+}
+
+// SetEventBus enables usage alerts on the conversation channel.
+func (m *UsageMeter) SetEventBus(bus *EventBus) {
+	m.eventBus = bus
+}
+
+// Record stores one LLM call, pricing it when the model has catalog
+// pricing. A nil meter records nothing.
+func (m *UsageMeter) Record(ctx context.Context, rec domain.UsageRecord) {
+	if m == nil {
+		return
+	}
+	if rec.CostUSD == 0 && m.router != nil && rec.Model != "" {
+		rec.CostUSD = m.router.CostUSD(rec.Model, rec.PromptTokens, rec.CompletionTokens)
+	}
+	if rec.CreatedAt.IsZero() {
+		rec.CreatedAt = m.now()
+	}
+	if err := m.repo.RecordUsage(context.WithoutCancel(ctx), rec); err != nil {
+		m.logger.Error("failed to record usage", "conversation_id", string(rec.ConversationID), "error", err)
+	}
+}
+
+// Check returns the conversation's status against the tightest of its own
+// and its project's limit, alerting once per limit when it passes the
+// warning threshold or is exhausted. A nil meter never limits.
+func (m *UsageMeter) Check(ctx context.Context, convID domain.ConversationID, projectID *domain.ProjectID) (UsageStatus, error) {
+	var worst UsageStatus
+	if m == nil {
+		return worst, nil
+	}
+	check := func(scope domain.UsageScope, id string) error {
+		st, err := m.Status(ctx, scope, id)
+		if err != nil {
+			return err
+		}
+		if st.Limit != nil && (worst.Limit == nil || st.Fraction > worst.Fraction) {
+			worst = st
+		}
+		return nil
+	}
+	if err := check(domain.UsageScopeConversation, string(convID)); err != nil {
+		return worst, err
+	}
+	if projectID != nil && *projectID != "" {
+		if err := check(domain.UsageScopeProject, string(*projectID)); err != nil {
+			return worst, err
+		}
+	}
+	if worst.Warning() {
+		m.alert(ctx, convID, worst)
+	}
+	return worst, nil
+}
+
+// Status returns the usage of a conversation or project and its limit, if
+// any.
+func (m *UsageMeter) Status(ctx context.Context, scope domain.UsageScope, id string) (UsageStatus, error) {
+	var st UsageStatus
+	l, err := m.repo.GetUsageLimit(ctx, scope, id)
+	switch {
+	case err == nil:
+		st.Limit = &l
+	case !errors.Is(err, domain.ErrUsageLimitNotFound):
+		return st, err
+	}
+	if st.Totals, err = m.repo.UsageTotals(ctx, scope, id); err != nil {
+		return st, err
+	}
+	if st.Limit != nil {
+		st.Fraction = st.Limit.Fraction(st.Totals)
+	}
+	return st, nil
+}
+
+// SetLimit sets the limit of a conversation or project. A limit without
+// caps is rejected; use DeleteLimit to lift it.
+func (m *UsageMeter) SetLimit(ctx context.Context, l domain.UsageLimit) (domain.UsageLimit, error) {
+	if l.Scope != domain.UsageScopeConversation && l.Scope != domain.UsageScopeProject {
+		return l, fmt.Errorf("unknown usage scope %q", l.Scope)
+	}
+	if l.ScopeID == "" {
+		return l, fmt.Errorf("%s id is required", l.Scope)
+	}
+	if l.MaxTokens < 0 || l.MaxCostUSD < 0 {
+		return l, fmt.Errorf("limits cannot be negative")
+	}
+	if l.MaxTokens == 0 && l.MaxCostUSD == 0 {
+		return l, fmt.Errorf("max_tokens or max_cost_usd is required")
+	}
+	l.UpdatedAt = m.now()
+	return l, m.repo.SaveUsageLimit(ctx, l)
+}
+
+// DeleteLimit lifts the limit of a conversation or project.
+func (m *UsageMeter) DeleteLimit(ctx context.Context, scope domain.UsageScope, id string) error {
+	return m.repo.DeleteUsageLimit(ctx, scope, id)
+}
+
+// alert logs and publishes a usage alert, once per limit and level.
+func (m *UsageMeter) alert(ctx context.Context, convID domain.ConversationID, st UsageStatus) {
+	level := "warning"
+	if st.Exceeded() {
+		level = "exceeded"
+	}
+	key := fmt.Sprintf("%s|%s|%d|%g|%s", st.Limit.Scope, st.Limit.ScopeID, st.Limit.MaxTokens, st.Limit.MaxCostUSD, level)
+	m.mu.Lock()
+	seen := m.warned[key]
+	m.warned[key] = true
+	m.mu.Unlock()
+	if seen {
+		return
+	}
+
+	m.logger.WarnContext(ctx, "usage limit "+level,
+		"scope", string(st.Limit.Scope), "scope_id", st.Limit.ScopeID,
+		"tokens", st.Totals.Tokens(), "cost_usd", st.Totals.CostUSD, "fraction", st.Fraction)
+	if m.eventBus == nil {
+		return
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"conversation_id": string(convID),
+		"level":           level,
+		"message":         st.Message(),
+		"limit":           st.Limit,
+		"totals":          st.Totals,
+		"fraction":        st.Fraction,
+	})
+	m.eventBus.PublishContext(ctx, Event{
+		JobID:     string(convID),
+		Type:      EventTypeUsageAlert,
+		Data:      string(data),
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+type memUsageRepo struct {
+	mu      sync.Mutex
+	records []domain.UsageRecord
+	limits  map[string]domain.UsageLimit
+}
+
+func newMemUsageRepo() *memUsageRepo {
+	return &memUsageRepo{limits: map[string]domain.UsageLimit{}}
+}
+
+func (r *memUsageRepo) RecordUsage(_ context.Context, rec domain.UsageRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, rec)
+	return nil
+}
+
+func (r *memUsageRepo) UsageTotals(_ context.Context, scope domain.UsageScope, id string) (domain.UsageTotals, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var t domain.UsageTotals
+	for _, rec := range r.records {
+		if (scope == domain.UsageScopeConversation && string(rec.ConversationID) == id) ||
+			(scope == domain.UsageScopeProject && string(rec.ProjectID) == id) {
+			t.Calls++
+			t.PromptTokens += rec.PromptTokens
+			t.CompletionTokens += rec.CompletionTokens
+			t.CostUSD += rec.CostUSD
+		}
+	}
+	return t, nil
+}
+
+func (r *memUsageRepo) SaveUsageLimit(_ context.Context, l domain.UsageLimit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.limits[string(l.Scope)+"|"+l.ScopeID] = l
+	return nil
+}
+
+func (r *memUsageRepo) GetUsageLimit(_ context.Context, scope domain.UsageScope, id string) (domain.UsageLimit, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.limits[string(scope)+"|"+id]
+	if !ok {
+		return l, domain.ErrUsageLimitNotFound
+	}
+	return l, nil
+}
+
+func (r *memUsageRepo) DeleteUsageLimit(_ context.Context, scope domain.UsageScope, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.limits[string(scope)+"|"+id]; !ok {
+		return domain.ErrUsageLimitNotFound
+	}
+	delete(r.limits, string(scope)+"|"+id)
+	return nil
+}
+
+func TestUsageMeterPricesCallsFromCatalog(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	router := NewModelRouter(logger, nil)
+	router.SetCatalog([]domain.ModelSpec{{ID: "gpt-4o-mini", PromptCostPerMTok: 0.15, CompletionCostPerMTok: 0.6}})
+	repo := newMemUsageRepo()
+	m := NewUsageMeter(logger, repo, router)
+	ctx := context.Background()
+
+	m.Record(ctx, domain.UsageRecord{ConversationID: "c1", Model: "gpt-4o-mini", PromptTokens: 1_000_000, CompletionTokens: 500_000})
+	m.Record(ctx, domain.UsageRecord{ConversationID: "c1", Model: "qwen2.5:3b", PromptTokens: 100, CompletionTokens: 10})
+
+	st, err := m.Status(ctx, domain.UsageScopeConversation, "c1")
+	require.NoError(t, err)
+	assert.Nil(t, st.Limit)
+	assert.Equal(t, 2, st.Totals.Calls)
+	assert.Equal(t, 1_500_110, st.Totals.Tokens())
+	assert.InDelta(t, 0.45, st.Totals.CostUSD, 1e-9)
+	assert.False(t, repo.records[0].CreatedAt.IsZero())
+
+	var nilMeter *UsageMeter
+	nilMeter.Record(ctx, domain.UsageRecord{ConversationID: "c1"})
+	st, err = nilMeter.Check(ctx, "c1", nil)
+	require.NoError(t, err)
+	assert.False(t, st.Exceeded())
+}
+
+func TestUsageMeterWarnsThenStops(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	bus := NewEventBus(logger)
+	events, unsub := bus.Subscribe("c1")
+	defer unsub()
+	m := NewUsageMeter(logger, newMemUsageRepo(), nil)
+	m.SetEventBus(bus)
+	ctx := context.Background()
+	project := domain.ProjectID("p1")
+
+	_, err := m.SetLimit(ctx, domain.UsageLimit{Scope: domain.UsageScopeConversation, ScopeID: "c1"})
+	assert.Error(t, err, "a limit needs a cap")
+	_, err = m.SetLimit(ctx, domain.UsageLimit{Scope: "workspace", ScopeID: "c1", MaxTokens: 1})
+	assert.Error(t, err)
+
+	_, err = m.SetLimit(ctx, domain.UsageLimit{Scope: domain.UsageScopeConversation, ScopeID: "c1", MaxTokens: 1000})
+	require.NoError(t, err)
+	_, err = m.SetLimit(ctx, domain.UsageLimit{Scope: domain.UsageScopeProject, ScopeID: "p1", MaxCostUSD: 1})
+	require.NoError(t, err)
+
+	m.Record(ctx, domain.UsageRecord{ConversationID: "c1", ProjectID: project, PromptTokens: 500, CompletionTokens: 100})
+	st, err := m.Check(ctx, "c1", &project)
+	require.NoError(t, err)
+	assert.False(t, st.Warning())
+	assert.Empty(t, events)
+
+	// The project's cost cap is the tighter limit
+	m.Record(ctx, domain.UsageRecord{ConversationID: "c1", ProjectID: project, PromptTokens: 100, CostUSD: 0.85})
+	st, err = m.Check(ctx, "c1", &project)
+	require.NoError(t, err)
+	assert.True(t, st.Warning())
+	assert.False(t, st.Exceeded())
+	assert.Equal(t, domain.UsageScopeProject, st.Limit.Scope)
+	assert.Equal(t, "This project has used 85% of its usage limit ($0.8500 of $1.0000).", st.Message())
+	select {
+	case ev := <-events:
+		assert.Equal(t, EventTypeUsageAlert, ev.Type)
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(ev.Data), &data))
+		assert.Equal(t, "warning", data["level"])
+	case <-time.After(time.Second):
+		t.Fatal("no usage alert")
+	}
+
+	// Alerts fire once per limit and level
+	_, err = m.Check(ctx, "c1", &project)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	m.Record(ctx, domain.UsageRecord{ConversationID: "c1", PromptTokens: 400})
+	st, err = m.Check(ctx, "c1", &project)
+	require.NoError(t, err)
+	assert.True(t, st.Exceeded())
+	assert.Equal(t, domain.UsageScopeConversation, st.Limit.Scope)
+	assert.Contains(t, st.Message(), "reached its usage limit (1100 of 1000 tokens used)")
+	select {
+	case ev := <-events:
+		assert.Contains(t, ev.Data, `"level":"exceeded"`)
+	case <-time.After(time.Second):
+		t.Fatal("no usage alert")
+	}
+
+	require.NoError(t, m.DeleteLimit(ctx, domain.UsageScopeConversation, "c1"))
+	require.NoError(t, m.DeleteLimit(ctx, domain.UsageScopeProject, "p1"))
+	st, err = m.Check(ctx, "c1", &project)
+	require.NoError(t, err)
+	assert.False(t, st.Exceeded())
+}
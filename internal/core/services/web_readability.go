@@ -0,0 +1,356 @@
+package services
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// boilerplateTags are dropped with their content before extraction.
+var boilerplateTags = []string{
+	"script", "style", "noscript", "template", "svg", "iframe", "form", "button",
+	"nav", "header", "footer", "aside", "head",
+}
+
+var (
+	titleRe     = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	commentRe   = regexp.MustCompile(`(?s)<!--.*?-->`)
+	blankRunsRe = regexp.MustCompile(`\n{3,}`)
+)
+
+// extractReadableMarkdown converts the main content of an HTML page to
+// Markdown, readability-style: boilerplate blocks are dropped and the
+// largest <article>, else <main>, else <body> is kept. Relative links are
+// resolved against base. It is a tag scanner, not a full HTML parser.
+func extractReadableMarkdown(page string, base *url.URL) (title, markdown string) {
+	if m := titleRe.FindStringSubmatch(page); m != nil {
+		title = strings.Join(strings.Fields(html.UnescapeString(m[1])), " ")
+	}
+	page = commentRe.ReplaceAllString(page, "")
+	for _, tag := range boilerplateTags {
+		page = removeElements(page, tag)
+	}
+	page = readableRoot(page)
+
+	w := &markdownWriter{base: base}
+	w.write(page)
+	return title, w.String()
+}
+
+// readableRoot returns the inner HTML of the element most likely to hold
+// the page's content.
+func readableRoot(page string) string {
+	best := ""
+	for _, inner := range elementContents(page, "article") {
+		if len(inner) > len(best) {
+			best = inner
+		}
+	}
+	if best != "" {
+		return best
+	}
+	for _, tag := range []string{"main", "body"} {
+		if inner := elementContents(page, tag); len(inner) > 0 {
+			return inner[0]
+		}
+	}
+	return page
+}
+
+// findTag returns the index of the next <tag or </tag (closing) in lower at
+// or after from, matching whole tag names only.
+func findTag(lower, tag string, from int, closing bool) int {
+	needle := "<" + tag
+	if closing {
+		needle = "</" + tag
+	}
+	for from < len(lower) {
+		i := strings.Index(lower[from:], needle)
+		if i < 0 {
+			return -1
+		}
+		i += from
+		end := i + len(needle)
+		if end >= len(lower) || strings.ContainsRune(" \t\r\n>/", rune(lower[end])) {
+			return i
+		}
+		from = end
+	}
+	return -1
+}
+
+// removeElements drops every <tag>...</tag> element. An unclosed element
+// is dropped to the end of the page.
+func removeElements(page, tag string) string {
+	var b strings.Builder
+	lower := strings.ToLower(page)
+	pos := 0
+	for {
+		start := findTag(lower, tag, pos, false)
+		if start < 0 {
+			break
+		}
+		b.WriteString(page[pos:start])
+		end := findTag(lower, tag, start+1, true)
+		if end < 0 {
+			return b.String()
+		}
+		gt := strings.IndexByte(lower[end:], '>')
+		if gt < 0 {
+			return b.String()
+		}
+		pos = end + gt + 1
+	}
+	b.WriteString(page[pos:])
+	return b.String()
+}
+
+// elementContents returns the inner HTML of each top-level <tag> element.
+func elementContents(page, tag string) []string {
+	var out []string
+	lower := strings.ToLower(page)
+	pos := 0
+	for {
+		start := findTag(lower, tag, pos, false)
+		if start < 0 {
+			return out
+		}
+		gt := strings.IndexByte(lower[start:], '>')
+		if gt < 0 {
+			return out
+		}
+		innerStart := start + gt + 1
+		end := findTag(lower, tag, innerStart, true)
+		if end < 0 {
+			return append(out, page[innerStart:])
+		}
+		out = append(out, page[innerStart:end])
+		pos = end + 1
+	}
+}
+
+// markdownWriter renders a stream of HTML tags and text as Markdown.
+type markdownWriter struct {
+	b     strings.Builder
+	base  *url.URL
+	pre   int
+	lists []int    // per open list: -1 = unordered, else the next item number
+	links []string // href per open <a>; "" = rendered as plain text
+}
+
+func (w *markdownWriter) String() string {
+	out := blankRunsRe.ReplaceAllString(w.b.String(), "\n\n")
+	lines := strings.Split(out, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \t")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func (w *markdownWriter) write(page string) {
+	for len(page) > 0 {
+		lt := strings.IndexByte(page, '<')
+		if lt < 0 {
+			w.text(page)
+			return
+		}
+		if lt > 0 {
+			w.text(page[:lt])
+		}
+		gt := strings.IndexByte(page[lt:], '>')
+		if gt < 0 {
+			w.text(page[lt:])
+			return
+		}
+		w.tag(page[lt+1 : lt+gt])
+		page = page[lt+gt+1:]
+	}
+}
+
+// newlines ends the output with at least n line breaks (none at the start).
+func (w *markdownWriter) newlines(n int) {
+	s := w.b.String()
+	if strings.TrimSpace(s) == "" {
+		return
+	}
+	have := len(s) - len(strings.TrimRight(s, "\n"))
+	for ; have < n; have++ {
+		w.b.WriteByte('\n')
+	}
+}
+
+func (w *markdownWriter) atLineStart() bool {
+	s := w.b.String()
+	return s == "" || strings.HasSuffix(s, "\n")
+}
+
+func (w *markdownWriter) text(raw string) {
+	t := html.UnescapeString(raw)
+	if w.pre > 0 {
+		w.b.WriteString(t)
+		return
+	}
+	collapsed := strings.Join(strings.Fields(t), " ")
+	if collapsed == "" {
+		if t != "" && !w.atLineStart() {
+			w.b.WriteByte(' ')
+		}
+		return
+	}
+	if strings.IndexFunc(t[:1], isSpace) == 0 && !w.atLineStart() {
+		w.b.WriteByte(' ')
+	}
+	w.b.WriteString(collapsed)
+	if strings.IndexFunc(t[len(t)-1:], isSpace) == 0 {
+		w.b.WriteByte(' ')
+	}
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
+
+func (w *markdownWriter) tag(body string) {
+	closing := strings.HasPrefix(body, "/")
+	body = strings.TrimPrefix(body, "/")
+	name := body
+	if i := strings.IndexAny(body, " \t\r\n/"); i >= 0 {
+		name = body[:i]
+	}
+	name = strings.ToLower(name)
+
+	switch name {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		w.newlines(2)
+		if !closing {
+			w.b.WriteString(strings.Repeat("#", int(name[1]-'0')) + " ")
+		}
+	case "p", "table", "blockquote", "figure", "dl":
+		w.newlines(2)
+	case "div", "section", "tr", "dt", "dd", "li":
+		w.newlines(1)
+		if name == "li" && !closing {
+			w.listItem()
+		}
+	case "br":
+		w.b.WriteByte('\n')
+	case "hr":
+		w.newlines(2)
+		w.b.WriteString("---")
+		w.newlines(2)
+	case "ul", "ol":
+		if closing {
+			if len(w.lists) > 0 {
+				w.lists = w.lists[:len(w.lists)-1]
+			}
+		} else if name == "ol" {
+			w.lists = append(w.lists, 1)
+		} else {
+			w.lists = append(w.lists, -1)
+		}
+		w.newlines(1)
+		if len(w.lists) == 0 {
+			w.newlines(2)
+		}
+	case "pre":
+		w.newlines(1)
+		if closing {
+			if w.pre > 0 {
+				w.pre--
+			}
+			w.b.WriteString("```")
+			w.newlines(2)
+		} else {
+			w.newlines(2)
+			w.b.WriteString("```\n")
+			w.pre++
+		}
+	case "code":
+		if w.pre == 0 {
+			w.b.WriteByte('`')
+		}
+	case "strong", "b":
+		if w.pre == 0 {
+			w.b.WriteString("**")
+		}
+	case "em", "i":
+		if w.pre == 0 {
+			w.b.WriteString("_")
+		}
+	case "td", "th":
+		if closing {
+			w.b.WriteString(" | ")
+		}
+	case "a":
+		w.link(body, closing)
+	case "img":
+		alt := html.UnescapeString(tagAttr(body, "alt"))
+		if src := w.resolve(tagAttr(body, "src")); alt != "" && src != "" {
+			w.b.WriteString("![" + alt + "](" + src + ")")
+		}
+	}
+}
+
+func (w *markdownWriter) listItem() {
+	if len(w.lists) == 0 {
+		w.b.WriteString("- ")
+		return
+	}
+	w.b.WriteString(strings.Repeat("  ", len(w.lists)-1))
+	top := &w.lists[len(w.lists)-1]
+	if *top < 0 {
+		w.b.WriteString("- ")
+		return
+	}
+	w.b.WriteString(strconv.Itoa(*top) + ". ")
+	*top++
+}
+
+func (w *markdownWriter) link(body string, closing bool) {
+	if !closing {
+		href := w.resolve(tagAttr(body, "href"))
+		w.links = append(w.links, href)
+		if href != "" {
+			w.b.WriteByte('[')
+		}
+		return
+	}
+	if len(w.links) == 0 {
+		return
+	}
+	href := w.links[len(w.links)-1]
+	w.links = w.links[:len(w.links)-1]
+	if href != "" {
+		w.b.WriteString("](" + href + ")")
+	}
+}
+
+// resolve makes ref absolute; fragments and javascript: links resolve to "".
+func (w *markdownWriter) resolve(ref string) string {
+	ref = strings.TrimSpace(html.UnescapeString(ref))
+	if ref == "" || strings.HasPrefix(ref, "#") || strings.HasPrefix(strings.ToLower(ref), "javascript:") {
+		return ""
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+	if w.base != nil {
+		u = w.base.ResolveReference(u)
+	}
+	return u.String()
+}
+
+var attrRe = regexp.MustCompile(`(?is)(?:^|\s)([a-z-]+)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+))`)
+
+// tagAttr returns the value of attribute name in a tag body like `a href="x"`.
+func tagAttr(body, name string) string {
+	for _, m := range attrRe.FindAllStringSubmatch(body, -1) {
+		if strings.EqualFold(m[1], name) {
+			return m[2] + m[3] + m[4]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+const (
+	// workerEventRetry is the delay before reconnecting a failed event stream.
+	workerEventRetry = 5 * time.Second
+	// workerExitMemory is how long an exit is remembered for subscribers
+	// that arrive late (the container died before Spawn returned).
+	workerExitMemory = time.Minute
+	// workerHealthFallback is how often wait loops still health-check a
+	// worker while events are flowing, in case one is missed.
+	workerHealthFallback = 10 * time.Second
+)
+
+// workerStatusStore is the persistence the watcher keeps in sync.
+type workerStatusStore interface {
+	UpdateWorkerStatus(ctx context.Context, id domain.WorkerID, status domain.HealthStatus) error
+}
+
+// WorkerEventWatcher follows the container runtime's event stream, keeps
+// worker records in sync as events arrive and wakes the job waiting on a
+// worker as soon as it exits, so wait loops do not have to poll
+// HealthCheck at a high rate.
+type WorkerEventWatcher struct {
+	logger    *slog.Logger
+	source    ports.WorkerEventSource
+	repo      workerStatusStore
+	connected atomic.Bool
+
+	mu     sync.Mutex
+	subs   map[domain.WorkerID][]chan domain.WorkerEvent
+	exited map[domain.WorkerID]domain.WorkerEvent
+}
+
+// NewWorkerEventWatcher creates a watcher; call Run to start it.
+func NewWorkerEventWatcher(logger *slog.Logger, source ports.WorkerEventSource, repo workerStatusStore) *WorkerEventWatcher {
+	return &WorkerEventWatcher{
+		logger: logger,
+		source: source,
+		repo:   repo,
+		subs:   make(map[domain.WorkerID][]chan domain.WorkerEvent),
+		exited: make(map[domain.WorkerID]domain.WorkerEvent),
+	}
+}
+
+// Run follows the event stream until ctx is done, reconnecting after
+// failures. While disconnected, Connected reports false and wait loops
+// fall back to polling.
+func (w *WorkerEventWatcher) Run(ctx context.Context) error {
+	for {
+		w.connected.Store(true)
+		err := w.source.WatchEvents(ctx, w.dispatch)
+		w.connected.Store(false)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil {
+			err = errors.New("event stream ended")
+		}
+		w.logger.Warn("worker event stream lost, polling until it reconnects", "error", err, "retry_in", workerEventRetry)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(workerEventRetry):
+		}
+	}
+}
+
+// Connected reports whether events are currently flowing.
+func (w *WorkerEventWatcher) Connected() bool {
+	return w.connected.Load()
+}
+
+// Subscribe returns a channel receiving the worker's events, starting with
+// its exit if that already happened. Call cancel when done.
+func (w *WorkerEventWatcher) Subscribe(id domain.WorkerID) (events <-chan domain.WorkerEvent, cancel func()) {
+	ch := make(chan domain.WorkerEvent, 4)
+	w.mu.Lock()
+	if ev, ok := w.exited[id]; ok {
+		ch <- ev
+	}
+	w.subs[id] = append(w.subs[id], ch)
+	w.mu.Unlock()
+
+	return ch, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		subs := w.subs[id]
+		for i, c := range subs {
+			if c == ch {
+				subs = append(subs[:i], subs[i+1:]...)
+				break
+			}
+		}
+		if len(subs) == 0 {
+			delete(w.subs, id)
+		} else {
+			w.subs[id] = subs
+		}
+	}
+}
+
+func (w *WorkerEventWatcher) dispatch(ev domain.WorkerEvent) {
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	if err := w.repo.UpdateWorkerStatus(context.Background(), ev.WorkerID, ev.Status); err != nil && !errors.Is(err, domain.ErrWorkerNotFound) {
+		w.logger.Warn("failed to sync worker status", "worker_id", ev.WorkerID, "status", ev.Status, "error", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if ev.Exited() {
+		if _, seen := w.exited[ev.WorkerID]; !seen {
+			w.exited[ev.WorkerID] = ev
+		}
+	}
+	for id, old := range w.exited {
+		if time.Since(old.Time) > workerExitMemory {
+			delete(w.exited, id)
+		}
+	}
+	for _, ch := range w.subs[ev.WorkerID] {
+		select {
+		case ch <- ev:
+		default: // the waiter only needs the latest state; it also polls
+		}
+	}
+}
+
+// workerWait is one wait loop's view of a worker: its events, if the
+// runtime pushes them, and whether a HealthCheck is due.
+type workerWait struct {
+	watcher   *WorkerEventWatcher
+	events    <-chan domain.WorkerEvent // nil without a watcher: never ready
+	cancel    func()
+	lastCheck time.Time
+}
+
+// waitWorker starts watching workerID. Remote workers are not covered by
+// the local event stream and are always polled.
+func (s *WorkerLifecycle) waitWorker(workerID domain.WorkerID) *workerWait {
+	w := &workerWait{cancel: func() {}}
+	if s.workerEvents == nil {
+		return w
+	}
+	if placer, ok := s.workerMgr.(interface {
+		NodeOf(domain.WorkerID) domain.NodeID
+	}); ok && placer.NodeOf(workerID) != domain.LocalNodeID {
+		return w
+	}
+	w.watcher = s.workerEvents
+	w.events, w.cancel = s.workerEvents.Subscribe(workerID)
+	return w
+}
+
+// healthCheckDue reports whether to poll HealthCheck now: always without
+// events, otherwise every workerHealthFallback.
+func (w *workerWait) healthCheckDue() bool {
+	if w.watcher == nil || !w.watcher.Connected() || time.Since(w.lastCheck) >= workerHealthFallback {
+		w.lastCheck = time.Now()
+		return true
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chanEventSource relays events sent on its channel until ctx is done.
+type chanEventSource struct {
+	events chan domain.WorkerEvent
+}
+
+func (s *chanEventSource) WatchEvents(ctx context.Context, fn func(domain.WorkerEvent)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev := <-s.events:
+			fn(ev)
+		}
+	}
+}
+
+type memWorkerStatus struct {
+	mu     sync.Mutex
+	status map[domain.WorkerID]domain.HealthStatus
+}
+
+func (r *memWorkerStatus) UpdateWorkerStatus(_ context.Context, id domain.WorkerID, status domain.HealthStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status[id] = status
+	return nil
+}
+
+func (r *memWorkerStatus) get(id domain.WorkerID) domain.HealthStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status[id]
+}
+
+func TestWorkerEventWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source := &chanEventSource{events: make(chan domain.WorkerEvent)}
+	repo := &memWorkerStatus{status: map[domain.WorkerID]domain.HealthStatus{}}
+	watcher := NewWorkerEventWatcher(slog.New(slog.NewTextHandler(io.Discard, nil)), source, repo)
+	done := make(chan error, 1)
+	go func() { done <- watcher.Run(ctx) }()
+
+	events, unsubscribe := watcher.Subscribe("w1")
+	defer unsubscribe()
+	source.events <- domain.WorkerEvent{WorkerID: "w1", Action: "start", Status: domain.HealthStatusStarting}
+	source.events <- domain.WorkerEvent{WorkerID: "w2", Action: "die", Status: domain.HealthStatusExited}
+	source.events <- domain.WorkerEvent{WorkerID: "w1", Action: "die", Status: domain.HealthStatusExited}
+
+	ev := <-events
+	assert.Equal(t, "start", ev.Action)
+	ev = <-events
+	assert.True(t, ev.Exited())
+	assert.True(t, watcher.Connected())
+	assert.Equal(t, domain.HealthStatusExited, repo.get("w1"))
+
+	// w2 exited before anyone subscribed; the exit is replayed
+	late, cancelLate := watcher.Subscribe("w2")
+	defer cancelLate()
+	select {
+	case ev := <-late:
+		assert.Equal(t, domain.WorkerID("w2"), ev.WorkerID)
+	case <-time.After(time.Second):
+		t.Fatal("late subscriber missed the exit")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.False(t, watcher.Connected())
+}
+
+func TestWorkerWait_HealthCheckFallback(t *testing.T) {
+	lc := &WorkerLifecycle{}
+	wait := lc.waitWorker("w1")
+	assert.Nil(t, wait.events)
+	assert.True(t, wait.healthCheckDue())
+	assert.True(t, wait.healthCheckDue(), "without events every tick polls")
+
+	watcher := NewWorkerEventWatcher(slog.New(slog.NewTextHandler(io.Discard, nil)), &chanEventSource{}, &memWorkerStatus{})
+	watcher.connected.Store(true)
+	lc.workerEvents = watcher
+	wait = lc.waitWorker("w1")
+	defer wait.cancel()
+	assert.NotNil(t, wait.events)
+	assert.True(t, wait.healthCheckDue(), "first tick checks once")
+	assert.False(t, wait.healthCheckDue())
+
+	watcher.connected.Store(false)
+	assert.True(t, wait.healthCheckDue(), "a lost stream falls back to polling")
+}
@@ -3,13 +3,16 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -27,20 +30,28 @@ const (
 type capabilityJobHandler func(context.Context, domain.Job)
 
 type WorkerLifecycle struct {
-	logger     *slog.Logger
-	scheduler  *JobScheduler
-	workerMgr  ports.WorkerManager
-	repo       ports.Repository
-	workspace  *WorkspaceManager
-	eventBus   *EventBus
-	llm        domain.LLMProvider
-	image      domain.ImageProvider
-	convStore  *ConversationStore // optional: enables async job → chat push
-	systemChat *SystemChat        // optional: enables kernel proactive notifications
-	publicURL  string
+	logger       *slog.Logger
+	scheduler    *JobScheduler
+	workerMgr    ports.WorkerManager
+	repo         ports.Repository
+	workspace    *WorkspaceManager
+	eventBus     *EventBus
+	llm          domain.LLMProvider
+	image        domain.ImageProvider
+	convStore    *ConversationStore  // optional: enables async job → chat push
+	systemChat   *SystemChat         // optional: enables kernel proactive notifications
+	capRouter    *CapabilityRouter   // optional: picks the runtime of capability jobs and records their stats
+	workerEvents *WorkerEventWatcher // optional: pushed worker exits instead of fast polling
+	publicURL    string
+
+	imagesMu sync.RWMutex
+	images   domain.ImagePolicy // which images container jobs may run
+
+	security domain.WorkerSecurityPolicy // what jobs' WorkerSecurity may ask for
 
 	handlerMu          sync.RWMutex
 	capabilityHandlers map[string]capabilityJobHandler
+	mediaWorkers       map[string]MediaWorker // video/audio capability → Docker worker
 }
 
 func NewWorkerLifecycle(
@@ -69,10 +80,16 @@ func NewWorkerLifecycle(
 		image:              imageProvider,
 		publicURL:          strings.TrimRight(publicBaseURL, "/"),
 		capabilityHandlers: map[string]capabilityJobHandler{},
+		mediaWorkers:       defaultMediaWorkers(),
 	}
 
 	lifecycle.RegisterCapabilityHandler(CapabilityImageGenerate, lifecycle.executeImageJob)
 	lifecycle.RegisterCapabilityHandler(CapabilityTextGenerate, lifecycle.executeTextJob)
+	lifecycle.RegisterCapabilityHandler(CapabilityVideoGenerate, lifecycle.executeMediaJob)
+	lifecycle.RegisterCapabilityHandler(CapabilityAudioGenerate, lifecycle.executeMediaJob)
+
+	scheduler.SetDependencyHooks(lifecycle.jobStatus, lifecycle.failJob)
+	scheduler.SetQueueListener(lifecycle.publishQueue)
 
 	return lifecycle
 }
@@ -99,6 +116,11 @@ func (s *WorkerLifecycle) dispatchCapabilityJob(ctx context.Context, job domain.
 		return false
 	}
 
+	if s.capRouter != nil && s.capRouter.Resolve(capability) == RuntimeSynapse {
+		s.executeSynapseJob(ctx, job, capability)
+		return true
+	}
+
 	s.handlerMu.RLock()
 	handler, ok := s.capabilityHandlers[capability]
 	s.handlerMu.RUnlock()
@@ -108,33 +130,110 @@ func (s *WorkerLifecycle) dispatchCapabilityJob(ctx context.Context, job domain.
 		return true
 	}
 
+	start := time.Now()
 	handler(ctx, job)
+	if s.capRouter != nil {
+		var execErr error
+		if done, err := s.repo.GetJob(ctx, job.ID); err == nil && done.Status == domain.JobStatusFailed {
+			execErr = fmt.Errorf("capability job failed")
+			if done.Error != nil {
+				execErr = fmt.Errorf("%s", *done.Error)
+			}
+		}
+		s.capRouter.RecordExecution(capability, time.Since(start), execErr)
+	}
 	return true
 }
 
+// executeSynapseJob runs a capability job routed to Synapse. The job's
+// metadata is the plugin input; the router records the outcome, so failures
+// count towards auto-demotion.
+func (s *WorkerLifecycle) executeSynapseJob(ctx context.Context, job domain.Job, capability string) {
+	params := make(map[string]interface{}, len(job.Metadata))
+	for k, v := range job.Metadata {
+		if k != "capability" {
+			params[k] = v
+		}
+	}
+
+	s.publishStatus(ctx, string(job.ID), string(domain.JobStatusRunning))
+	s.publishLog(ctx, string(job.ID), fmt.Sprintf("running %s in synapse", capability))
+	job.Status = domain.JobStatusRunning
+	job.UpdatedAt = time.Now()
+	if err := s.repo.SaveJob(ctx, job); err != nil {
+		s.logger.Error("failed to save synapse job running state", "job_id", job.ID, "error", err)
+	}
+
+	result, err := s.capRouter.ExecuteSynapse(ctx, capability, params)
+	if err != nil {
+		s.failJob(ctx, job, fmt.Errorf("synapse execution failed: %w", err))
+		return
+	}
+
+	text, ok := result.(string)
+	if !ok {
+		data, _ := json.Marshal(result)
+		text = string(data)
+	}
+	summary, _, _ := strings.Cut(strings.TrimSpace(text), "\n")
+	job.Status = domain.JobStatusCompleted
+	job.SetOutput(domain.JobResult{
+		Summary: truncateRunes(summary, 120),
+		Data:    map[string]interface{}{"output": result},
+	})
+	job.Error = nil
+	job.UpdatedAt = time.Now()
+	if err := s.repo.SaveJob(ctx, job); err != nil {
+		s.logger.Error("failed to save completed synapse job", "job_id", job.ID, "error", err)
+	}
+	s.publishStatus(ctx, string(job.ID), string(domain.JobStatusCompleted))
+	s.notifyConversation(ctx, job, fmt.Sprintf("Here is the %s result:\n\n%s", capability, text))
+}
+
 // Run starts the scheduler loop
 func (s *WorkerLifecycle) Run(ctx context.Context) error {
-	s.scheduler.Start(ctx, s.executeJob)
+	s.scheduler.Start(domain.WithSubsystem(ctx, "jobs"), s.executeJob)
 	return nil
 }
 
-func (s *WorkerLifecycle) publishStatus(jobID string, status string) {
-	s.publishStatusWithProgress(jobID, status, nil)
+func (s *WorkerLifecycle) publishStatus(ctx context.Context, jobID string, status string) {
+	s.publishStatusWithProgress(ctx, jobID, status, nil)
 }
 
-func (s *WorkerLifecycle) publishStatusWithProgress(jobID string, status string, progress *int) {
+func (s *WorkerLifecycle) publishStatusWithProgress(ctx context.Context, jobID string, status string, progress *int) {
 	payload := map[string]interface{}{
 		"status": status,
 	}
 	if progress != nil {
 		payload["progress"] = *progress
 	}
+	s.publishStatusPayload(ctx, jobID, payload)
+}
+
+// publishQueue tells clients of queued jobs where they stand.
+func (s *WorkerLifecycle) publishQueue(ctx context.Context, infos map[domain.JobID]domain.JobQueueInfo) {
+	for id, info := range infos {
+		s.publishStatusPayload(ctx, string(id), map[string]interface{}{
+			"status": string(domain.JobStatusPending),
+			"queue":  info,
+		})
+	}
+}
+
+// QueueInfo returns the queue position and ETA of a job waiting for a free
+// scheduler slot; false when it is not queued.
+func (s *WorkerLifecycle) QueueInfo(id domain.JobID) (domain.JobQueueInfo, bool) {
+	return s.scheduler.QueueInfo(id)
+}
+
+func (s *WorkerLifecycle) publishStatusPayload(ctx context.Context, jobID string, payload map[string]interface{}) {
+	status, _ := payload["status"].(string)
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
 		payloadBytes = []byte(fmt.Sprintf(`{"status": "%s"}`, status))
 	}
 
-	s.eventBus.Publish(Event{
+	s.eventBus.PublishContext(ctx, Event{
 		JobID:     jobID,
 		Type:      EventTypeStatus,
 		Data:      string(payloadBytes),
@@ -142,8 +241,8 @@ func (s *WorkerLifecycle) publishStatusWithProgress(jobID string, status string,
 	})
 }
 
-func (s *WorkerLifecycle) publishLog(jobID string, data string) {
-	s.eventBus.Publish(Event{
+func (s *WorkerLifecycle) publishLog(ctx context.Context, jobID string, data string) {
+	s.eventBus.PublishContext(ctx, Event{
 		JobID:     jobID,
 		Type:      EventTypeLog,
 		Data:      data,
@@ -151,9 +250,44 @@ func (s *WorkerLifecycle) publishLog(jobID string, data string) {
 	})
 }
 
+// jobContext re-attaches the request ID recorded when job was submitted, so
+// work done on the scheduler's context stays correlated with that request.
+func jobContext(ctx context.Context, job domain.Job) context.Context {
+	return domain.WithRequestID(ctx, job.Metadata["request_id"])
+}
+
+// stampRequestID records ctx's request ID in the job metadata.
+func stampRequestID(ctx context.Context, job *domain.Job) {
+	id := domain.RequestIDFrom(ctx)
+	if id == "" {
+		return
+	}
+	if job.Metadata == nil {
+		job.Metadata = map[string]string{}
+	}
+	job.Metadata["request_id"] = id
+}
+
+// withRequestEnv passes ctx's request ID to the worker as AULE_REQUEST_ID so
+// worker logs can be correlated with kernel logs.
+func withRequestEnv(ctx context.Context, spec domain.WorkerSpec) domain.WorkerSpec {
+	id := domain.RequestIDFrom(ctx)
+	if id == "" {
+		return spec
+	}
+	env := make(map[string]string, len(spec.Env)+1)
+	for k, v := range spec.Env {
+		env[k] = v
+	}
+	env["AULE_REQUEST_ID"] = id
+	spec.Env = env
+	return spec
+}
+
 // executeJob is the callback for the scheduler
 func (s *WorkerLifecycle) executeJob(ctx context.Context, job domain.Job) {
-	s.logger.Info("executing job", "job_id", job.ID)
+	ctx = jobContext(ctx, job)
+	s.logger.InfoContext(ctx, "executing job", "job_id", job.ID)
 
 	if s.dispatchCapabilityJob(ctx, job) {
 		return
@@ -161,7 +295,7 @@ func (s *WorkerLifecycle) executeJob(ctx context.Context, job domain.Job) {
 
 	// Publish RUNNING
 	progressStart := 10
-	s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusRunning), &progressStart)
+	s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusRunning), &progressStart)
 
 	// 1. Prepare Workspace (Project-based or Ephemeral)
 	var wsPath string
@@ -192,7 +326,7 @@ func (s *WorkerLifecycle) executeJob(ctx context.Context, job domain.Job) {
 	}
 
 	// 3. Spawn Worker
-	workerID, err := s.workerMgr.Spawn(ctx, job.Spec)
+	workerID, err := s.workerMgr.Spawn(ctx, withRequestEnv(ctx, job.Spec))
 	if err != nil {
 		s.failJob(ctx, job, fmt.Errorf("spawn failed: %w", err))
 		return
@@ -210,17 +344,48 @@ func (s *WorkerLifecycle) executeJob(ctx context.Context, job domain.Job) {
 			"job_id": string(job.ID),
 		},
 	}
+	if placer, ok := s.workerMgr.(interface {
+		NodeOf(domain.WorkerID) domain.NodeID
+	}); ok {
+		if nodeID := placer.NodeOf(workerID); nodeID != domain.LocalNodeID {
+			worker.Metadata["node_id"] = string(nodeID)
+			s.publishLog(ctx, string(job.ID), fmt.Sprintf("worker %s running on node %s", workerID, nodeID))
+		}
+	}
 	if err := s.repo.SaveWorker(ctx, worker); err != nil {
 		s.logger.Warn("failed to persist worker record", "worker_id", workerID, "error", err)
 	}
 
 	// 4. Watch Loop (Wait for completion)
-	// In a real system, we'd use the Watchdog API here to poll status or wait for SSE.
-	// For this milestone, let's poll HealthCheck until it exits.
+	// Exits arrive as container events when the runtime pushes them; the
+	// ticker relays progress and health-checks as a fallback (or on every
+	// tick without events).
+	wait := s.waitWorker(workerID)
+	defer wait.cancel()
 	ticker := time.NewTicker(500 * time.Millisecond)
 	defer ticker.Stop()
 
 	timeout := time.After(5 * time.Minute) // Safety timeout
+	var lastProgress domain.WorkerProgress
+
+	complete := func() {
+		s.logger.Info("job completed", "job_id", job.ID)
+
+		// 5. Cleanup
+		_ = s.workerMgr.Kill(ctx, workerID) // Ensure it's gone
+
+		job.Status = domain.JobStatusCompleted
+		progressDone := 100
+		s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusCompleted), &progressDone)
+		if err := s.repo.SaveJob(ctx, job); err != nil {
+			s.logger.Error("failed to save job status", "error", err)
+		}
+
+		// Notify kernel inbox about container job completion
+		if s.systemChat != nil {
+			s.systemChat.NotifyJobResult(ctx, string(job.ID), "COMPLETED", "")
+		}
+	}
 
 	for {
 		select {
@@ -234,57 +399,93 @@ func (s *WorkerLifecycle) executeJob(ctx context.Context, job domain.Job) {
 			_ = s.repo.UpdateWorkerStatus(ctx, workerID, domain.HealthStatusExited)
 			s.failJob(ctx, job, fmt.Errorf("timeout"))
 			return
-		case <-ticker.C:
-			status, err := s.workerMgr.HealthCheck(ctx, workerID)
-			if err != nil {
-				s.logger.Error("health check failed", "error", err)
-				continue
+		case ev := <-wait.events:
+			// The watcher already synced the worker record
+			if ev.Exited() {
+				s.relayWorkerProgress(ctx, job.ID, workerID, &lastProgress)
+				complete()
+				return
 			}
+		case <-ticker.C:
+			if wait.healthCheckDue() {
+				status, err := s.workerMgr.HealthCheck(ctx, workerID)
+				if err != nil {
+					s.logger.Error("health check failed", "error", err)
+					continue
+				}
 
-			// Keep DB status in sync with container reality
-			_ = s.repo.UpdateWorkerStatus(ctx, workerID, status)
+				// Keep DB status in sync with container reality
+				_ = s.repo.UpdateWorkerStatus(ctx, workerID, status)
 
-			if status == domain.HealthStatusExited {
-				s.logger.Info("job completed", "job_id", job.ID)
+				if status == domain.HealthStatusExited {
+					complete()
+					return
+				}
+			}
 
-				// 5. Cleanup
-				_ = s.workerMgr.Kill(ctx, workerID) // Ensure it's gone
+			s.relayWorkerProgress(ctx, job.ID, workerID, &lastProgress)
+		}
+	}
+}
 
-				job.Status = domain.JobStatusCompleted
-				progressDone := 100
-				s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusCompleted), &progressDone)
-				if err := s.repo.SaveJob(ctx, job); err != nil {
-					s.logger.Error("failed to save job status", "error", err)
-				}
+// relayWorkerProgress publishes the worker's self-reported progress (see
+// domain.WorkerProgressFile) on the job stream when it changed since *last.
+// Returns false while the worker has not reported anything.
+func (s *WorkerLifecycle) relayWorkerProgress(ctx context.Context, jobID domain.JobID, workerID domain.WorkerID, last *domain.WorkerProgress) bool {
+	reader, ok := s.workerMgr.(ports.ProgressReader)
+	if !ok {
+		return false
+	}
+	p, err := reader.ReadProgress(ctx, workerID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrNoProgress) {
+			s.logger.Debug("failed to read worker progress", "worker_id", workerID, "error", err)
+		}
+		return *last != (domain.WorkerProgress{})
+	}
+	if p == *last {
+		return true
+	}
 
-				// Notify kernel inbox about container job completion
-				if s.systemChat != nil {
-					s.systemChat.NotifyJobResult(ctx, string(job.ID), "COMPLETED", "")
-				}
-				return
-			}
+	// Progress never goes backwards on the stream; messages always pass through
+	if p.Percent > last.Percent {
+		percent := p.Percent
+		if percent >= 100 {
+			percent = 99 // 100 is reserved for the completed status
 		}
+		s.publishStatusWithProgress(ctx, string(jobID), string(domain.JobStatusRunning), &percent)
+	} else {
+		p.Percent = last.Percent
 	}
+	if p.Message != "" && (p.Message != last.Message || p.Stage != last.Stage) {
+		line := p.Message
+		if p.Stage != "" {
+			line = fmt.Sprintf("[%s] %s", p.Stage, p.Message)
+		}
+		s.publishLog(ctx, string(jobID), line)
+	}
+	*last = p
+	return true
 }
 
 func (s *WorkerLifecycle) executeImageJob(ctx context.Context, job domain.Job) {
-	if s.image == nil {
+	s.handlerMu.RLock()
+	image := s.image
+	s.handlerMu.RUnlock()
+	if image == nil {
 		s.failJob(ctx, job, fmt.Errorf("image provider not configured"))
 		return
 	}
 
-	prompt := ""
-	if job.Metadata != nil {
-		prompt = job.Metadata["prompt"]
-	}
-	if strings.TrimSpace(prompt) == "" {
+	if job.Metadata == nil || strings.TrimSpace(job.Metadata["prompt"]) == "" {
 		s.failJob(ctx, job, fmt.Errorf("missing prompt metadata for image job"))
 		return
 	}
+	prompt := imageJobPrompt(job)
 
 	progressStart := 20
-	s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusRunning), &progressStart)
-	s.publishLog(string(job.ID), "image generation started")
+	s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusRunning), &progressStart)
+	s.publishLog(ctx, string(job.ID), "image generation started")
 
 	workspacePath, err := s.workspace.PrepareWorkspace(string(job.ID))
 	if err != nil {
@@ -298,15 +499,114 @@ func (s *WorkerLifecycle) executeImageJob(ctx context.Context, job domain.Job) {
 		s.logger.Error("failed to save image job running state", "job_id", job.ID, "error", err)
 	}
 
-	rawImageURL, err := s.image.GenerateImage(ctx, prompt)
-	if err != nil {
-		s.failJob(ctx, job, fmt.Errorf("image generation failed: %w", err))
-		return
+	count := 1
+	if n, err := strconv.Atoi(job.Metadata["count"]); err == nil && n > 1 {
+		count = min(n, MaxImageCount)
+	}
+	opts := imageOptionsFromJob(job)
+	if opts.Seed == nil && job.Metadata["workflow"] == "" {
+		// Below 2^53 so the seed survives JSON number round-trips
+		seed := rand.Int63n(1 << 53)
+		opts.Seed = &seed
+		job.Metadata["seed"] = strconv.FormatInt(seed, 10)
+	}
+	attempt := "1"
+	if strings.TrimSpace(job.Metadata["attempt"]) != "" {
+		attempt = strings.TrimSpace(job.Metadata["attempt"])
+	}
+
+	// Each variant is its own generation with the next seed
+	var files []domain.JobFile
+	for i := 0; i < count; i++ {
+		imgReq := domain.ImageRequest{
+			Backend: strings.TrimSpace(job.Metadata["backend"]),
+			Model:   strings.TrimSpace(job.Metadata["model"]),
+			Options: opts,
+		}
+		if opts.Seed != nil {
+			seed := *opts.Seed + int64(i)
+			imgReq.Options.Seed = &seed
+		}
+		if job.Metadata["workflow"] != "" {
+			if imgReq.Workflow, imgReq.OutputNode, err = s.renderImageWorkflow(ctx, job, prompt, i); err != nil {
+				s.failJob(ctx, job, err)
+				return
+			}
+		}
+
+		var rawImageURL string
+		if router, ok := image.(domain.ImageRouter); ok {
+			var backend string
+			rawImageURL, backend, err = router.GenerateImageWith(ctx, prompt, imgReq)
+			if err == nil {
+				job.Metadata["image_backend"] = backend
+				s.publishLog(ctx, string(job.ID), fmt.Sprintf("image %d/%d generated by backend %s", i+1, count, backend))
+			}
+		} else if imgReq.Workflow != nil {
+			err = fmt.Errorf("image provider does not support workflow templates")
+		} else {
+			rawImageURL, err = image.GenerateImage(ctx, prompt)
+		}
+		if err != nil {
+			s.failJob(ctx, job, fmt.Errorf("image generation failed: %w", err))
+			return
+		}
+
+		resultFileName := fmt.Sprintf("result-v%s.png", attempt)
+		if count > 1 {
+			resultFileName = fmt.Sprintf("result-v%s-%d.png", attempt, i+1)
+		}
+		resultPath := filepath.Join(workspacePath, resultFileName)
+		size, err := downloadImage(ctx, rawImageURL, resultPath)
+		if err != nil {
+			s.failJob(ctx, job, err)
+			return
+		}
+		artID := s.saveJobArtifact(ctx, job, domain.ArtifactTypeImage, "image/png", resultFileName, resultPath, size)
+		files = append(files, s.jobFile(job, resultFileName, "image/png", size, artID))
+		s.publishLog(ctx, string(job.ID), fmt.Sprintf("image saved: %s", resultPath))
+
+		progress := 20 + 70*(i+1)/count
+		s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusRunning), &progress)
+	}
+
+	res := domain.JobResult{Summary: "Generated image", Files: files}
+	if count > 1 {
+		res.Summary = fmt.Sprintf("Generated %d images", count)
 	}
-	progressGenerated := 60
-	s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusRunning), &progressGenerated)
+	if opts.Seed != nil || job.Metadata["image_backend"] != "" {
+		res.Data = map[string]interface{}{}
+		if opts.Seed != nil {
+			res.Data["seed"] = *opts.Seed
+		}
+		if b := job.Metadata["image_backend"]; b != "" {
+			res.Data["backend"] = b
+		}
+	}
+	job.Status = domain.JobStatusCompleted
+	job.SetOutput(res)
+	job.Error = nil
+	job.UpdatedAt = time.Now()
+	if err := s.repo.SaveJob(ctx, job); err != nil {
+		s.logger.Error("failed to save completed image job", "job_id", job.ID, "error", err)
+	}
+
+	progressDone := 100
+	s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusCompleted), &progressDone)
+
+	// Push result back into the originating conversation
+	intro := "Here is your generated image:"
+	if count > 1 {
+		intro = fmt.Sprintf("Here are your %d generated images:", count)
+	}
+	s.notifyConversation(ctx, job, intro)
+}
 
-	imageURLRegex := regexp.MustCompile(`https?://[^\s\)]+`)
+var imageURLRegex = regexp.MustCompile(`https?://[^\s\)]+`)
+
+// downloadImage saves the image a backend returned (a URL, possibly inside
+// markdown) to path and returns its size.
+func downloadImage(ctx context.Context, rawImageURL, path string) (int64, error) {
 	resolvedURL := rawImageURL
 	if match := imageURLRegex.FindString(rawImageURL); match != "" {
 		resolvedURL = match
@@ -314,58 +614,29 @@ func (s *WorkerLifecycle) executeImageJob(ctx context.Context, job domain.Job) {
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resolvedURL, nil)
 	if err != nil {
-		s.failJob(ctx, job, fmt.Errorf("failed creating download request: %w", err))
-		return
+		return 0, fmt.Errorf("failed creating download request: %w", err)
 	}
-
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		s.failJob(ctx, job, fmt.Errorf("failed downloading generated image: %w", err))
-		return
+		return 0, fmt.Errorf("failed downloading generated image: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		s.failJob(ctx, job, fmt.Errorf("image download failed status=%d body=%s", resp.StatusCode, string(body)))
-		return
+		return 0, fmt.Errorf("image download failed status=%d body=%s", resp.StatusCode, string(body))
 	}
-	progressDownloaded := 80
-	s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusRunning), &progressDownloaded)
 
-	attempt := "1"
-	if job.Metadata != nil && strings.TrimSpace(job.Metadata["attempt"]) != "" {
-		attempt = strings.TrimSpace(job.Metadata["attempt"])
-	}
-	resultFileName := fmt.Sprintf("result-v%s.png", attempt)
-	resultPath := filepath.Join(workspacePath, resultFileName)
-	file, err := os.Create(resultPath)
+	file, err := os.Create(path)
 	if err != nil {
-		s.failJob(ctx, job, fmt.Errorf("failed creating result file: %w", err))
-		return
+		return 0, fmt.Errorf("failed creating result file: %w", err)
 	}
 	defer file.Close()
-
-	if _, err := io.Copy(file, resp.Body); err != nil {
-		s.failJob(ctx, job, fmt.Errorf("failed writing result file: %w", err))
-		return
-	}
-
-	servedURL := fmt.Sprintf("%s/v1/jobs/%s/files/%s", s.publicURL, job.ID, resultFileName)
-	job.Status = domain.JobStatusCompleted
-	job.Result = &servedURL
-	job.Error = nil
-	job.UpdatedAt = time.Now()
-	if err := s.repo.SaveJob(ctx, job); err != nil {
-		s.logger.Error("failed to save completed image job", "job_id", job.ID, "error", err)
+	n, err := io.Copy(file, resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed writing result file: %w", err)
 	}
-
-	progressDone := 100
-	s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusCompleted), &progressDone)
-	s.publishLog(string(job.ID), fmt.Sprintf("image saved: %s", resultPath))
-
-	// Push result back into the originating conversation
-	s.notifyConversation(ctx, job, fmt.Sprintf("Here is your generated image:\n\n![Generated Image](%s)", servedURL), &servedURL)
+	return n, nil
 }
 
 func (s *WorkerLifecycle) executeTextJob(ctx context.Context, job domain.Job) {
@@ -384,8 +655,8 @@ func (s *WorkerLifecycle) executeTextJob(ctx context.Context, job domain.Job) {
 	}
 
 	progressStart := 20
-	s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusRunning), &progressStart)
-	s.publishLog(string(job.ID), "text generation started")
+	s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusRunning), &progressStart)
+	s.publishLog(ctx, string(job.ID), "text generation started")
 
 	workspacePath, err := s.workspace.PrepareWorkspace(string(job.ID))
 	if err != nil {
@@ -405,7 +676,7 @@ func (s *WorkerLifecycle) executeTextJob(ctx context.Context, job domain.Job) {
 		return
 	}
 	progressGenerated := 70
-	s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusRunning), &progressGenerated)
+	s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusRunning), &progressGenerated)
 
 	attempt := "1"
 	if job.Metadata != nil && strings.TrimSpace(job.Metadata["attempt"]) != "" {
@@ -418,9 +689,13 @@ func (s *WorkerLifecycle) executeTextJob(ctx context.Context, job domain.Job) {
 		return
 	}
 
-	servedURL := fmt.Sprintf("%s/v1/jobs/%s/files/%s", s.publicURL, job.ID, resultFileName)
+	artID := s.saveJobArtifact(ctx, job, domain.ArtifactTypeText, "text/plain", resultFileName, resultPath, int64(len(resultText)))
+	summary, _, _ := strings.Cut(strings.TrimSpace(resultText), "\n")
 	job.Status = domain.JobStatusCompleted
-	job.Result = &servedURL
+	job.SetOutput(domain.JobResult{
+		Summary: truncateRunes(summary, 120),
+		Files:   []domain.JobFile{s.jobFile(job, resultFileName, "text/plain", int64(len(resultText)), artID)},
+	})
 	job.Error = nil
 	job.UpdatedAt = time.Now()
 	if err := s.repo.SaveJob(ctx, job); err != nil {
@@ -428,27 +703,32 @@ func (s *WorkerLifecycle) executeTextJob(ctx context.Context, job domain.Job) {
 	}
 
 	progressDone := 100
-	s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusCompleted), &progressDone)
-	s.publishLog(string(job.ID), fmt.Sprintf("text saved: %s", resultPath))
+	s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusCompleted), &progressDone)
+	s.publishLog(ctx, string(job.ID), fmt.Sprintf("text saved: %s", resultPath))
 
 	// Push result back into the originating conversation
-	s.notifyConversation(ctx, job, fmt.Sprintf("Here is the generated text:\n\n%s", resultText), nil)
+	s.notifyConversation(ctx, job, fmt.Sprintf("Here is the generated text:\n\n%s", resultText))
 }
 
 func (s *WorkerLifecycle) failJob(ctx context.Context, job domain.Job, err error) {
+	if ctx.Err() != nil && s.scheduler.Draining() {
+		// Cancelled by shutdown after the grace period; Drain already marked it interrupted
+		s.logger.Info("job cancelled by shutdown", "job_id", job.ID)
+		return
+	}
 	s.logger.Error("job failed", "job_id", job.ID, "error", err)
 	job.Status = domain.JobStatusFailed
 	msg := err.Error()
 	job.Error = &msg
 	job.UpdatedAt = time.Now()
-	s.publishStatusWithProgress(string(job.ID), string(domain.JobStatusFailed), nil)
-	s.publishLog(string(job.ID), msg)
+	s.publishStatusWithProgress(ctx, string(job.ID), string(domain.JobStatusFailed), nil)
+	s.publishLog(ctx, string(job.ID), msg)
 	if err := s.repo.SaveJob(ctx, job); err != nil {
 		s.logger.Error("failed to save job status", "error", err)
 	}
 
 	// Notify conversation about the failure too
-	s.notifyConversation(ctx, job, fmt.Sprintf("Job failed: %s", msg), nil)
+	s.notifyConversation(ctx, job, fmt.Sprintf("Job failed: %s", msg))
 
 	// Notify kernel inbox
 	if s.systemChat != nil {
@@ -458,7 +738,8 @@ func (s *WorkerLifecycle) failJob(ctx context.Context, job domain.Job, err error
 
 // notifyConversation pushes a result message back into the originating conversation
 // when a job completes. This enables async tool results to appear in the chat.
-func (s *WorkerLifecycle) notifyConversation(ctx context.Context, job domain.Job, content string, imageURL *string) {
+// The job's output files are rendered after intro.
+func (s *WorkerLifecycle) notifyConversation(ctx context.Context, job domain.Job, intro string) {
 	if job.Metadata == nil {
 		return
 	}
@@ -466,6 +747,7 @@ func (s *WorkerLifecycle) notifyConversation(ctx context.Context, job domain.Job
 	if convID == "" {
 		return
 	}
+	content := renderJobResult(intro, job.Output)
 
 	msgID := domain.NewMessageID()
 	now := time.Now()
@@ -493,8 +775,14 @@ func (s *WorkerLifecycle) notifyConversation(ctx context.Context, job domain.Job
 		"job_id":          string(job.ID),
 		"created_at":      now.Format(time.RFC3339),
 	}
-	if imageURL != nil {
-		payload["image_url"] = *imageURL
+	if job.Output != nil {
+		payload["result"] = job.Output
+		for _, f := range job.Output.Files {
+			if strings.HasPrefix(f.MimeType, "image/") {
+				payload["image_url"] = f.URL
+				break
+			}
+		}
 	}
 	payloadJSON, _ := json.Marshal(payload)
 
@@ -509,21 +797,90 @@ func (s *WorkerLifecycle) notifyConversation(ctx context.Context, job domain.Job
 	s.logger.Info("job result pushed to conversation", "job_id", job.ID, "conv_id", convID)
 }
 
+// renderJobResult appends markdown for res's files to intro: images inline,
+// anything else as a link.
+func renderJobResult(intro string, res *domain.JobResult) string {
+	if res == nil || len(res.Files) == 0 {
+		return intro
+	}
+	var b strings.Builder
+	b.WriteString(intro)
+	b.WriteString("\n")
+	images := 0
+	for _, f := range res.Files {
+		if strings.HasPrefix(f.MimeType, "image/") {
+			images++
+		}
+	}
+	for i, f := range res.Files {
+		switch {
+		case images > 1 && strings.HasPrefix(f.MimeType, "image/"):
+			fmt.Fprintf(&b, "\n![Variant %d](%s)", i+1, f.URL)
+		case strings.HasPrefix(f.MimeType, "image/"):
+			fmt.Fprintf(&b, "\n![Generated Image](%s)", f.URL)
+		default:
+			fmt.Fprintf(&b, "\n[%s](%s)", f.Name, f.URL)
+		}
+	}
+	return b.String()
+}
+
+// jobFile describes a file the job wrote to its workspace, as served by
+// the kernel.
+func (s *WorkerLifecycle) jobFile(job domain.Job, name, mimeType string, size int64, artID domain.ArtifactID) domain.JobFile {
+	return domain.JobFile{
+		Name:       name,
+		URL:        fmt.Sprintf("%s/v1/jobs/%s/files/%s", s.publicURL, job.ID, name),
+		MimeType:   mimeType,
+		SizeBytes:  size,
+		ArtifactID: artID,
+	}
+}
+
 // SubmitJob creates a job record and submits it
 func (s *WorkerLifecycle) SubmitJob(ctx context.Context, spec domain.WorkerSpec) (domain.JobID, error) {
+	return s.SubmitJobWithDeps(ctx, spec, nil)
+}
+
+// SubmitJobWithDeps creates a job that the scheduler holds in WAITING until
+// every job in dependsOn has completed successfully. If any of them fails the
+// job fails without running.
+// Images the image policy rejects fail with domain.ErrImageNotAllowed.
+func (s *WorkerLifecycle) SubmitJobWithDeps(ctx context.Context, spec domain.WorkerSpec, dependsOn []domain.JobID) (domain.JobID, error) {
+	image, err := s.ImagePolicy().Resolve(spec.Image)
+	if err != nil {
+		return "", err
+	}
+	spec.Image = image
+	if err := spec.Security.Validate(s.security); err != nil {
+		return "", err
+	}
+
+	for _, dep := range dependsOn {
+		if _, err := s.repo.GetJob(ctx, dep); err != nil {
+			return "", fmt.Errorf("dependency %s: %w", dep, err)
+		}
+	}
+
 	id := domain.JobID(uuid.New().String())
+	status := domain.JobStatusPending
+	if len(dependsOn) > 0 {
+		status = domain.JobStatusWaiting
+	}
 	job := domain.Job{
 		ID:        id,
 		Spec:      spec,
-		Status:    domain.JobStatusPending,
+		Status:    status,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
+		DependsOn: dependsOn,
 	}
 
+	stampRequestID(ctx, &job)
 	if err := s.repo.SaveJob(ctx, job); err != nil {
 		return "", fmt.Errorf("failed to save job: %w", err)
 	}
-	s.publishStatus(string(id), string(domain.JobStatusPending))
+	s.publishStatus(ctx, string(id), string(status))
 
 	if err := s.scheduler.SubmitJob(ctx, job); err != nil {
 		return "", err
@@ -531,6 +888,195 @@ func (s *WorkerLifecycle) SubmitJob(ctx context.Context, spec domain.WorkerSpec)
 	return id, nil
 }
 
+// Drain stops accepting jobs and waits until running ones finish or ctx
+// expires. Jobs that never started, or were still running when the grace
+// period ran out, are marked INTERRUPTED so RecoverInterruptedJobs can resume
+// them after a restart. Returns the number of interrupted jobs.
+func (s *WorkerLifecycle) Drain(ctx context.Context) int {
+	report := s.scheduler.Drain(ctx)
+	interrupted := append(report.Unstarted, report.Running...)
+	for _, job := range interrupted {
+		// Reload so the record keeps whatever the handler already persisted (e.g. seed)
+		if stored, err := s.repo.GetJob(context.Background(), job.ID); err == nil {
+			job = stored
+		}
+		s.markInterrupted(job)
+	}
+	if len(interrupted) > 0 {
+		s.logger.Warn("jobs interrupted by shutdown", "unstarted", len(report.Unstarted), "running", len(report.Running))
+	}
+	return len(interrupted)
+}
+
+func (s *WorkerLifecycle) markInterrupted(job domain.Job) {
+	msg := "interrupted by kernel shutdown"
+	job.Status = domain.JobStatusInterrupted
+	job.Error = &msg
+	job.UpdatedAt = time.Now()
+	if job.Metadata == nil {
+		job.Metadata = map[string]string{}
+	}
+	job.Metadata["interrupted_at"] = job.UpdatedAt.Format(time.RFC3339)
+	s.publishStatusWithProgress(jobContext(context.Background(), job), string(job.ID), string(domain.JobStatusInterrupted), nil)
+	if err := s.repo.SaveJob(context.Background(), job); err != nil {
+		s.logger.Error("failed to save interrupted job", "job_id", job.ID, "error", err)
+	}
+}
+
+// RecoverInterruptedJobs re-queues jobs a previous shutdown marked
+// INTERRUPTED. Call it once the scheduler is running.
+func (s *WorkerLifecycle) RecoverInterruptedJobs(ctx context.Context) (int, error) {
+	jobs, err := s.repo.ListJobs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	recovered := 0
+	for _, job := range jobs {
+		if job.Status != domain.JobStatusInterrupted {
+			continue
+		}
+		job.Status = domain.JobStatusPending
+		if len(job.DependsOn) > 0 {
+			job.Status = domain.JobStatusWaiting
+		}
+		job.Error = nil
+		job.UpdatedAt = time.Now()
+		if err := s.repo.SaveJob(ctx, job); err != nil {
+			s.logger.Error("failed to save recovered job", "job_id", job.ID, "error", err)
+			continue
+		}
+		if err := s.scheduler.SubmitJob(ctx, job); err != nil {
+			s.logger.Error("failed to resubmit interrupted job", "job_id", job.ID, "error", err)
+			continue
+		}
+		s.publishStatus(ctx, string(job.ID), string(job.Status))
+		s.publishLog(ctx, string(job.ID), "resumed after kernel restart")
+		recovered++
+	}
+	return recovered, nil
+}
+
+// jobStatus is the scheduler's dependency lookup.
+func (s *WorkerLifecycle) jobStatus(ctx context.Context, id domain.JobID) (domain.JobStatus, error) {
+	job, err := s.repo.GetJob(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return job.Status, nil
+}
+
+// JobGraph returns the dependency DAG containing the job: its transitive
+// dependencies and every job that (transitively) depends on it.
+func (s *WorkerLifecycle) JobGraph(ctx context.Context, id domain.JobID) (domain.JobGraph, error) {
+	root, err := s.repo.GetJob(ctx, id)
+	if err != nil {
+		return domain.JobGraph{}, err
+	}
+	all, err := s.repo.ListJobs(ctx)
+	if err != nil {
+		return domain.JobGraph{}, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	byID := make(map[domain.JobID]domain.Job, len(all)+1)
+	dependents := make(map[domain.JobID][]domain.JobID)
+	for _, j := range all {
+		byID[j.ID] = j
+		for _, dep := range j.DependsOn {
+			dependents[dep] = append(dependents[dep], j.ID)
+		}
+	}
+	byID[root.ID] = root
+
+	seen := map[domain.JobID]bool{root.ID: true}
+	queue := []domain.JobID{root.ID}
+	graph := domain.JobGraph{Root: root.ID, Nodes: []domain.JobGraphNode{}, Edges: []domain.JobGraphEdge{}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		job, ok := byID[cur]
+		if !ok {
+			// Dependency record is gone; keep it as a node so the edge still resolves
+			graph.Nodes = append(graph.Nodes, domain.JobGraphNode{ID: cur})
+			continue
+		}
+		graph.Nodes = append(graph.Nodes, domain.JobGraphNode{
+			ID:        job.ID,
+			Status:    job.Status,
+			Image:     job.Spec.Image,
+			DependsOn: job.DependsOn,
+		})
+		for _, dep := range job.DependsOn {
+			graph.Edges = append(graph.Edges, domain.JobGraphEdge{From: dep, To: job.ID})
+			if !seen[dep] {
+				seen[dep] = true
+				queue = append(queue, dep)
+			}
+		}
+		for _, next := range dependents[cur] {
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return graph, nil
+}
+
+// renderImageWorkflow fills the job's project workflow template. The prompt
+// fills the "prompt" slot unless given explicitly, and a missing seed is drawn
+// at random and recorded in the job metadata so the image can be reproduced.
+// variant offsets the seed for the extra images of a multi-image job.
+func (s *WorkerLifecycle) renderImageWorkflow(ctx context.Context, job domain.Job, prompt string, variant int) (map[string]interface{}, string, error) {
+	name := job.Metadata["workflow"]
+	proj, err := s.repo.GetProject(ctx, domain.ProjectID(job.Metadata["project_id"]))
+	if err != nil {
+		return nil, "", fmt.Errorf("load project for workflow %s: %w", name, err)
+	}
+	tmpl, ok := proj.Settings.ImageWorkflow(name)
+	if !ok {
+		return nil, "", fmt.Errorf("unknown image workflow %q", name)
+	}
+
+	params := map[string]interface{}{}
+	if raw := job.Metadata["workflow_params"]; raw != "" && raw != "null" {
+		if err := json.Unmarshal([]byte(raw), &params); err != nil {
+			return nil, "", fmt.Errorf("invalid workflow params: %w", err)
+		}
+	}
+	if _, ok := params["prompt"]; !ok {
+		params["prompt"] = prompt
+	}
+	_, hasSeed := params["seed"]
+	_, hasDefaultSeed := tmpl.Defaults["seed"]
+	if !hasSeed && !hasDefaultSeed {
+		if prev, err := strconv.ParseInt(job.Metadata["seed"], 10, 64); err == nil {
+			params["seed"] = prev // retry of a job that already drew one
+		} else {
+			// Below 2^53 so the seed survives JSON number round-trips
+			params["seed"] = rand.Int63n(1 << 53)
+		}
+	}
+	if seed, ok := params["seed"]; ok {
+		job.Metadata["seed"] = fmt.Sprint(seed)
+	} else {
+		job.Metadata["seed"] = fmt.Sprint(tmpl.Defaults["seed"])
+	}
+	// Later variants of a multi-image job take the following seeds
+	if variant > 0 {
+		if seed, err := strconv.ParseInt(job.Metadata["seed"], 10, 64); err == nil {
+			params["seed"] = seed + int64(variant)
+		}
+	}
+
+	graph, err := tmpl.Render(params)
+	if err != nil {
+		return nil, "", err
+	}
+	return graph, tmpl.OutputNode, nil
+}
+
 // SubmitImageJob creates a queued image job and delegates execution to scheduler/lifecycle.
 func (s *WorkerLifecycle) SubmitImageJob(ctx context.Context, prompt string) (domain.JobID, error) {
 	return s.SubmitImageJobWithConv(ctx, prompt, "")
@@ -539,6 +1085,105 @@ func (s *WorkerLifecycle) SubmitImageJob(ctx context.Context, prompt string) (do
 // SubmitImageJobWithConv creates a queued image job with an optional conversation_id
 // so the result can be pushed back into the originating chat.
 func (s *WorkerLifecycle) SubmitImageJobWithConv(ctx context.Context, prompt string, convID string) (domain.JobID, error) {
+	return s.SubmitImageJobWithOptions(ctx, prompt, convID, ImageJobOptions{})
+}
+
+// Image job parameter limits.
+const (
+	MaxImageCount = 4
+	MaxImageSide  = 2048
+	MaxImageSteps = 150
+)
+
+// ImageJobOptions selects where and how an image job renders.
+type ImageJobOptions struct {
+	Backend        string                 // named image backend; disables failover
+	Model          string                 // model/checkpoint; prefers backends serving it
+	ProjectID      domain.ProjectID       // project owning the workflow template
+	Workflow       string                 // project ComfyUI workflow template name
+	WorkflowParams map[string]interface{} // slot values (seed, width, height, lora, ...)
+
+	NegativePrompt string
+	Width, Height  int    // 0 = backend default
+	Steps          int    // sampler steps; 0 = backend default
+	Seed           *int64 // nil = drawn at random and recorded on the job
+	Count          int    // variants, each with the next seed; 0 = 1
+	Style          string // appended to the prompt, e.g. "watercolor"
+}
+
+// validate checks the generation parameters against the limits.
+func (o ImageJobOptions) validate() error {
+	switch {
+	case o.Width < 0 || o.Height < 0 || o.Width > MaxImageSide || o.Height > MaxImageSide:
+		return fmt.Errorf("image size must be at most %dx%d", MaxImageSide, MaxImageSide)
+	case (o.Width == 0) != (o.Height == 0):
+		return fmt.Errorf("image size needs both width and height")
+	case o.Steps < 0 || o.Steps > MaxImageSteps:
+		return fmt.Errorf("steps must be between 1 and %d", MaxImageSteps)
+	case o.Count < 0 || o.Count > MaxImageCount:
+		return fmt.Errorf("count must be between 1 and %d", MaxImageCount)
+	case o.Seed != nil && *o.Seed < 0:
+		return fmt.Errorf("seed must be >= 0")
+	}
+	return nil
+}
+
+// workflowParams adds the generation parameters to the workflow slot values
+// the caller did not set explicitly.
+func (o ImageJobOptions) workflowParams() map[string]interface{} {
+	params := make(map[string]interface{}, len(o.WorkflowParams)+5)
+	for k, v := range o.WorkflowParams {
+		params[k] = v
+	}
+	setDefault := func(k string, v interface{}) {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+	if o.NegativePrompt != "" {
+		setDefault("negative_prompt", o.NegativePrompt)
+	}
+	if o.Width > 0 {
+		setDefault("width", o.Width)
+		setDefault("height", o.Height)
+	}
+	if o.Steps > 0 {
+		setDefault("steps", o.Steps)
+	}
+	if o.Seed != nil {
+		setDefault("seed", *o.Seed)
+	}
+	return params
+}
+
+// imageOptionsFromJob reads the generation parameters stored on an image
+// job. The seed is the job's recorded one, if any.
+func imageOptionsFromJob(job domain.Job) domain.ImageOptions {
+	opts := domain.ImageOptions{NegativePrompt: job.Metadata["negative_prompt"]}
+	opts.Width, _ = strconv.Atoi(job.Metadata["width"])
+	opts.Height, _ = strconv.Atoi(job.Metadata["height"])
+	opts.Steps, _ = strconv.Atoi(job.Metadata["steps"])
+	if seed, err := strconv.ParseInt(job.Metadata["seed"], 10, 64); err == nil {
+		opts.Seed = &seed
+	}
+	return opts
+}
+
+// imageJobPrompt is the prompt with the job's style, if any.
+func imageJobPrompt(job domain.Job) string {
+	prompt := job.Metadata["prompt"]
+	if style := strings.TrimSpace(job.Metadata["style"]); style != "" {
+		prompt += ", " + style + " style"
+	}
+	return prompt
+}
+
+// SubmitImageJobWithOptions is SubmitImageJobWithConv with backend, model and
+// workflow selection, stored as job metadata so retries keep the same routing.
+func (s *WorkerLifecycle) SubmitImageJobWithOptions(ctx context.Context, prompt string, convID string, opts ImageJobOptions) (domain.JobID, error) {
+	if err := opts.validate(); err != nil {
+		return "", err
+	}
 	id := domain.JobID(uuid.New().String())
 	now := time.Now()
 
@@ -560,13 +1205,62 @@ func (s *WorkerLifecycle) SubmitImageJobWithConv(ctx context.Context, prompt str
 			"conversation_id": convID,
 		},
 	}
+	if opts.Backend != "" {
+		job.Metadata["backend"] = opts.Backend
+	}
+	if opts.Model != "" {
+		job.Metadata["model"] = opts.Model
+	}
+	if opts.ProjectID != "" {
+		job.Metadata["project_id"] = string(opts.ProjectID)
+	}
+	for k, v := range map[string]string{
+		"negative_prompt": opts.NegativePrompt,
+		"style":           opts.Style,
+	} {
+		if v != "" {
+			job.Metadata[k] = v
+		}
+	}
+	for k, v := range map[string]int{
+		"width":  opts.Width,
+		"height": opts.Height,
+		"steps":  opts.Steps,
+		"count":  opts.Count,
+	} {
+		if v > 0 {
+			job.Metadata[k] = strconv.Itoa(v)
+		}
+	}
+	if opts.Seed != nil {
+		job.Metadata["seed"] = strconv.FormatInt(*opts.Seed, 10)
+	}
+	if opts.Workflow != "" {
+		if opts.ProjectID == "" {
+			return "", fmt.Errorf("workflow %s requires a project", opts.Workflow)
+		}
+		proj, err := s.repo.GetProject(ctx, opts.ProjectID)
+		if err != nil {
+			return "", fmt.Errorf("load project for workflow: %w", err)
+		}
+		if _, ok := proj.Settings.ImageWorkflow(opts.Workflow); !ok {
+			return "", fmt.Errorf("unknown image workflow %q in project %s", opts.Workflow, opts.ProjectID)
+		}
+		params, err := json.Marshal(opts.workflowParams())
+		if err != nil {
+			return "", fmt.Errorf("encode workflow params: %w", err)
+		}
+		job.Metadata["workflow"] = opts.Workflow
+		job.Metadata["workflow_params"] = string(params)
+	}
 
+	stampRequestID(ctx, &job)
 	if err := s.repo.SaveJob(ctx, job); err != nil {
 		return "", fmt.Errorf("failed to save image job: %w", err)
 	}
 
-	s.publishStatus(string(id), string(domain.JobStatusPending))
-	s.publishLog(string(id), "image job queued")
+	s.publishStatus(ctx, string(id), string(domain.JobStatusPending))
+	s.publishLog(ctx, string(id), "image job queued")
 
 	if err := s.scheduler.SubmitJob(ctx, job); err != nil {
 		return "", err
@@ -605,12 +1299,13 @@ func (s *WorkerLifecycle) SubmitTextJobWithConv(ctx context.Context, prompt stri
 		},
 	}
 
+	stampRequestID(ctx, &job)
 	if err := s.repo.SaveJob(ctx, job); err != nil {
 		return "", fmt.Errorf("failed to save text job: %w", err)
 	}
 
-	s.publishStatus(string(id), string(domain.JobStatusPending))
-	s.publishLog(string(id), "text job queued")
+	s.publishStatus(ctx, string(id), string(domain.JobStatusPending))
+	s.publishLog(ctx, string(id), "text job queued")
 
 	if err := s.scheduler.SubmitJob(ctx, job); err != nil {
 		return "", err
@@ -620,23 +1315,11 @@ func (s *WorkerLifecycle) SubmitTextJobWithConv(ctx context.Context, prompt stri
 }
 
 // GetJobFilePath returns the absolute path to a file in the job's workspace.
-// It prevents directory traversal.
+// It prevents directory traversal, including through symlinks.
 func (s *WorkerLifecycle) GetJobFilePath(jobID string, filename string) (string, error) {
-	wsPath := s.workspace.GetPath(jobID)
-	fullPath := filepath.Join(wsPath, filename)
-
-	// Security check: ensure strictly within workspace
-	cleanPath := filepath.Clean(fullPath)
-	if filepath.Dir(cleanPath) != filepath.Clean(wsPath) {
-		// Strict check: we only allow files in the root of the workspace for now?
-		// Or strictly verify prefix.
-		// Let's allow subdirs but verify prefix.
-	}
-
-	// Better check:
-	rel, err := filepath.Rel(wsPath, cleanPath)
-	if err != nil || filepath.IsAbs(rel) || (len(rel) > 2 && rel[:2] == "..") {
-		return "", fmt.Errorf("invalid file path: directory traversal detected")
+	cleanPath, err := ensurePathIsSafe(s.workspace.GetPath(jobID), filename)
+	if err != nil {
+		return "", fmt.Errorf("invalid file path: %w", err)
 	}
 
 	// Verify file exists
@@ -671,12 +1354,44 @@ func (wl *WorkerLifecycle) UpdateProviders(llm domain.LLMProvider, img domain.Im
 	wl.logger.Info("providers hot-reloaded")
 }
 
+// SetWorkerSecurityPolicy sets what the security settings of submitted jobs
+// may ask for. It is operator configuration, set once at startup.
+func (wl *WorkerLifecycle) SetWorkerSecurityPolicy(p domain.WorkerSecurityPolicy) {
+	wl.security = p
+}
+
+// SetImagePolicy replaces the image policy checked when jobs are submitted.
+func (wl *WorkerLifecycle) SetImagePolicy(p domain.ImagePolicy) {
+	wl.imagesMu.Lock()
+	wl.images = p
+	wl.imagesMu.Unlock()
+}
+
+// ImagePolicy returns the current image policy.
+func (wl *WorkerLifecycle) ImagePolicy() domain.ImagePolicy {
+	wl.imagesMu.RLock()
+	defer wl.imagesMu.RUnlock()
+	return wl.images
+}
+
+// SetWorkerEvents makes wait loops react to pushed container events and
+// poll HealthCheck only as a fallback.
+func (wl *WorkerLifecycle) SetWorkerEvents(w *WorkerEventWatcher) {
+	wl.workerEvents = w
+}
+
 // SetConversationStore wires the conversation store so completed jobs
 // can push result messages back into the originating chat.
 func (wl *WorkerLifecycle) SetConversationStore(cs *ConversationStore) {
 	wl.convStore = cs
 }
 
+// SetCapabilityRouter wires the CapabilityRouter so capability jobs follow
+// its routes and feed its route stats.
+func (wl *WorkerLifecycle) SetCapabilityRouter(cr *CapabilityRouter) {
+	wl.capRouter = cr
+}
+
 // SetSystemChat wires the SystemChat so the lifecycle can post proactive notifications.
 func (wl *WorkerLifecycle) SetSystemChat(sc *SystemChat) {
 	wl.systemChat = sc
@@ -687,29 +1402,35 @@ func (wl *WorkerLifecycle) TestLLM(ctx context.Context) (string, error) {
 	return wl.llm.GenerateText(ctx, "Reply with exactly: ok")
 }
 
-// TestImageProvider performs a quick connectivity check on the image backend.
-// Returns nil if reachable, error otherwise. Does NOT generate an image.
+// TestImageProvider performs a quick connectivity check on the image backends.
+// Returns nil if at least one backend is reachable, error otherwise. Does NOT generate an image.
 func (wl *WorkerLifecycle) TestImageProvider(ctx context.Context) error {
-	if wl.image == nil {
-		return fmt.Errorf("no image provider configured")
-	}
-	// Try a simple TCP-level check by using the image provider's host
-	// We'll try a tiny HTTP HEAD to the comfyui/remote endpoint
-	// For ComfyUI: GET / returns HTML; for remote APIs: health endpoint
-	// Quick approach: attempt to generate with an empty prompt will fail fast
-	// but that's heavy. Instead, check if the provider struct has a known host.
-	//
-	// Simplest: try a 2-second timeout HEAD request to the configured host
-	client := &http.Client{Timeout: 2 * time.Second}
-	// Detect provider type by checking the configured URL
-	comfyHost := os.Getenv("COMFYUI_HOST")
-	if comfyHost == "" {
-		comfyHost = "http://localhost:8188"
-	}
-	resp, err := client.Head(comfyHost)
+	health, err := wl.ImageBackendHealth(ctx)
 	if err != nil {
-		return fmt.Errorf("image backend unreachable at %s: %w", comfyHost, err)
+		return err
 	}
-	resp.Body.Close()
-	return nil
+	var failures []string
+	for _, h := range health {
+		if h.Healthy {
+			return nil
+		}
+		failures = append(failures, fmt.Sprintf("%s (%s): %s", h.Name, h.URL, h.Error))
+	}
+	return fmt.Errorf("no image backend reachable: %s", strings.Join(failures, "; "))
+}
+
+// ImageBackendHealth probes every configured image backend.
+func (wl *WorkerLifecycle) ImageBackendHealth(ctx context.Context) ([]domain.ImageBackendHealth, error) {
+	wl.handlerMu.RLock()
+	image := wl.image
+	wl.handlerMu.RUnlock()
+	if image == nil {
+		return nil, fmt.Errorf("no image provider configured")
+	}
+	router, ok := image.(domain.ImageRouter)
+	if !ok {
+		// Single provider without health probing; assume reachable
+		return []domain.ImageBackendHealth{{Name: "primary", Healthy: true}}, nil
+	}
+	return router.Health(ctx), nil
 }
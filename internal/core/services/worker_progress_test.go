@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type progressWorkerManager struct {
+	fakeWorkerManager
+	progress *domain.WorkerProgress
+}
+
+func (m *progressWorkerManager) ReadProgress(context.Context, domain.WorkerID) (domain.WorkerProgress, error) {
+	if m.progress == nil {
+		return domain.WorkerProgress{}, domain.ErrNoProgress
+	}
+	return *m.progress, nil
+}
+
+func TestRelayWorkerProgress(t *testing.T) {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	bus := NewEventBus(logger)
+	mgr := &progressWorkerManager{}
+	wl := &WorkerLifecycle{logger: logger, eventBus: bus, workerMgr: mgr}
+
+	events, unsub := bus.Subscribe("job-1")
+	defer unsub()
+
+	var last domain.WorkerProgress
+	assert.False(t, wl.relayWorkerProgress(context.Background(), "job-1", "w", &last))
+
+	mgr.progress = &domain.WorkerProgress{Percent: 40, Stage: "sampling", Message: "step 8/20"}
+	require.True(t, wl.relayWorkerProgress(context.Background(), "job-1", "w", &last))
+	status := <-events
+	assert.Equal(t, EventTypeStatus, status.Type)
+	assert.JSONEq(t, `{"status":"RUNNING","progress":40}`, status.Data)
+	log := <-events
+	assert.Equal(t, "[sampling] step 8/20", log.Data)
+
+	// Unchanged report publishes nothing; regressions don't move the bar back
+	require.True(t, wl.relayWorkerProgress(context.Background(), "job-1", "w", &last))
+	mgr.progress = &domain.WorkerProgress{Percent: 10}
+	require.True(t, wl.relayWorkerProgress(context.Background(), "job-1", "w", &last))
+	assert.Equal(t, 40, last.Percent)
+	assert.Empty(t, events)
+}
@@ -9,6 +9,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/manthysbr/auleOS/internal/core/domain"
 	"golang.org/x/sync/errgroup"
 )
@@ -163,6 +165,7 @@ func (e *WorkflowExecutor) Cancel(ctx context.Context, wfID domain.WorkflowID) e
 // runLoop is the main DAG execution loop
 func (e *WorkflowExecutor) runLoop(ctx context.Context, id domain.WorkflowID) {
 	e.logger.Info("starting workflow execution loop", "workflow_id", id)
+	ctx = domain.WithSubsystem(ctx, "workflow")
 
 	// Create resume channel for this workflow
 	resumeCh := make(chan struct{}, 1)
@@ -338,8 +341,13 @@ func (e *WorkflowExecutor) executeStep(ctx context.Context, wfID domain.Workflow
 	// Execute Agent
 	convID := domain.ConversationID(fmt.Sprintf("wf-%s-%s", wfID, step.ID))
 
+	stepCtx := domain.WithPriority(ctx, domain.PriorityBackground)
+	if step.Deterministic {
+		stepCtx = domain.WithDeterministic(stepCtx)
+	}
+
 	startTime := time.Now()
-	resp, _, agentErr := e.agent.Chat(ctx, convID, prompt, &step.PersonaID)
+	resp, _, agentErr := e.agent.Chat(stepCtx, convID, prompt, &step.PersonaID)
 	duration := time.Since(startTime)
 
 	// Lock again for result write
@@ -465,3 +473,38 @@ func interpolate(template string, state map[string]any) string {
 	}
 	return res
 }
+
+// newWorkflowRun copies a template workflow into a fresh pending run for a
+// background trigger. state is merged over the template's state; label is
+// appended to the name.
+func newWorkflowRun(tmpl *domain.Workflow, label string, state map[string]any, now time.Time) *domain.Workflow {
+	wf := &domain.Workflow{
+		ID:          domain.WorkflowID(uuid.New().String()),
+		ProjectID:   tmpl.ProjectID,
+		Name:        tmpl.Name + " (" + label + ")",
+		Description: tmpl.Description,
+		Status:      domain.WorkflowStatusPending,
+		CreatedAt:   now,
+		State:       make(map[string]any, len(tmpl.State)+len(state)),
+	}
+	for k, v := range tmpl.State {
+		wf.State[k] = v
+	}
+	for k, v := range state {
+		wf.State[k] = v
+	}
+	for _, s := range tmpl.Steps {
+		wf.Steps = append(wf.Steps, domain.WorkflowStep{
+			ID:            s.ID,
+			PersonaID:     s.PersonaID,
+			Prompt:        s.Prompt,
+			Tools:         append([]string(nil), s.Tools...),
+			DependsOn:     append([]string(nil), s.DependsOn...),
+			Interrupt:     s.Interrupt,
+			Deterministic: s.Deterministic,
+			Status:        domain.StepStatusPending,
+			MaxIters:      s.MaxIters,
+		})
+	}
+	return wf
+}
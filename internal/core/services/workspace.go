@@ -1,13 +1,37 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
 )
 
+// DefaultFileLockTimeout is how long a file tool waits for another writer
+// of the same file before giving up.
+const DefaultFileLockTimeout = 10 * time.Second
+
 type WorkspaceManager struct {
 	baseDir string
+
+	// Advisory per-path locks, so parallel sub-agents and workflow steps
+	// do not interleave writes to one file
+	locksMu     sync.Mutex
+	locks       map[string]*fileLock
+	lockTimeout time.Duration // 0 = DefaultFileLockTimeout
+
+	mounts MountLookup // read-only host directories per project; nil = none
+}
+
+// fileLock is held by one writer of a path at a time.
+type fileLock struct {
+	sem    chan struct{}
+	refs   int    // holder and waiters; the lock is dropped at 0
+	holder string // who holds it, for conflict errors
 }
 
 func NewWorkspaceManager() *WorkspaceManager {
@@ -20,6 +44,11 @@ func NewWorkspaceManager() *WorkspaceManager {
 	}
 }
 
+// BaseDir returns the root directory holding job and project workspaces.
+func (s *WorkspaceManager) BaseDir() string {
+	return s.baseDir
+}
+
 // PrepareWorkspace creates the directory structure for a job/worker (ephemeral)
 // Path: baseDir/jobs/{id}
 func (s *WorkspaceManager) PrepareWorkspace(id string) (string, error) {
@@ -63,3 +92,73 @@ func (s *WorkspaceManager) GetProjectPath(id string) string {
 	_ = os.MkdirAll(path, 0777) 
 	return path
 }
+
+// SetLockTimeout sets how long LockFile waits; <= 0 restores the default.
+func (s *WorkspaceManager) SetLockTimeout(d time.Duration) {
+	s.locksMu.Lock()
+	s.lockTimeout = d
+	s.locksMu.Unlock()
+}
+
+// LockFile takes the advisory write lock of an absolute path for holder
+// (shown to writers who find it taken). It waits up to the lock timeout and
+// fails with domain.ErrFileLocked when the file is still being written.
+// Call the returned function to release the lock.
+func (s *WorkspaceManager) LockFile(ctx context.Context, path, holder string) (func(), error) {
+	path = filepath.Clean(path)
+	s.locksMu.Lock()
+	if s.locks == nil {
+		s.locks = make(map[string]*fileLock)
+	}
+	l, ok := s.locks[path]
+	if !ok {
+		l = &fileLock{sem: make(chan struct{}, 1)}
+		s.locks[path] = l
+	}
+	l.refs++
+	timeout := s.lockTimeout
+	s.locksMu.Unlock()
+	if timeout <= 0 {
+		timeout = DefaultFileLockTimeout
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case l.sem <- struct{}{}:
+	case <-ctx.Done():
+		s.dropLock(path, l)
+		return nil, ctx.Err()
+	case <-timer.C:
+		s.locksMu.Lock()
+		by := l.holder
+		s.locksMu.Unlock()
+		if by == "" {
+			by = "another agent"
+		}
+		s.dropLock(path, l)
+		return nil, fmt.Errorf("%w: %s is being written by %s (waited %s); try again later", domain.ErrFileLocked, filepath.Base(path), by, timeout)
+	}
+	s.locksMu.Lock()
+	l.holder = holder
+	s.locksMu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.locksMu.Lock()
+			l.holder = ""
+			s.locksMu.Unlock()
+			<-l.sem
+			s.dropLock(path, l)
+		})
+	}, nil
+}
+
+func (s *WorkspaceManager) dropLock(path string, l *fileLock) {
+	s.locksMu.Lock()
+	defer s.locksMu.Unlock()
+	if l.refs--; l.refs == 0 {
+		delete(s.locks, path)
+	}
+}
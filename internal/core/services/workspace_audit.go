@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// Committer identity of audit commits; the author is the persona.
+const (
+	auditCommitterName  = "auleOS"
+	auditCommitterEmail = "kernel@auleos.local"
+	auditDefaultAuthor  = "auleOS agent"
+	auditDefaultEmail   = "agent@auleos.local"
+)
+
+// DefaultWorkspaceHistoryLimit is how many commits History returns by default.
+const DefaultWorkspaceHistoryLimit = 50
+
+// auditGitTimeout bounds each git command.
+const auditGitTimeout = 30 * time.Second
+
+var commitHashRe = regexp.MustCompile(`^[0-9a-f]{4,64}$`)
+
+// WorkspaceAudit keeps a git history of what the agent changes in project
+// workspaces. The repository is created on the first edit, and every
+// successful write/edit tool call is committed, authored by the persona
+// that made it. Repositories live under <workspace dir>/audit, outside the
+// project workspaces, so the agent cannot plant git configuration or hooks.
+type WorkspaceAudit struct {
+	logger *slog.Logger
+	ws     *WorkspaceManager
+	git    string // git binary; empty = auditing off
+
+	mu sync.Mutex // one git operation at a time
+}
+
+// NewWorkspaceAudit creates the audit. Without git on the PATH, edits are
+// not recorded.
+func NewWorkspaceAudit(logger *slog.Logger, ws *WorkspaceManager) *WorkspaceAudit {
+	git, err := exec.LookPath("git")
+	if err != nil {
+		logger.Warn("git not found, agent edits in workspaces are not recorded", "error", err)
+	}
+	return &WorkspaceAudit{logger: logger, ws: ws, git: git}
+}
+
+// Enabled reports whether edits are being recorded.
+func (a *WorkspaceAudit) Enabled() bool {
+	return a != nil && a.git != ""
+}
+
+// Wrap returns tool with a commit after every successful call that changed
+// a project workspace. Edits outside projects (the home directory) are not
+// recorded, and a failed commit does not fail the tool.
+func (a *WorkspaceAudit) Wrap(tool *domain.Tool) *domain.Tool {
+	if !a.Enabled() {
+		return tool
+	}
+	wrapped := *tool
+	execute := tool.Execute
+	wrapped.Execute = func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+		result, err := execute(ctx, params)
+		if err != nil {
+			return result, err
+		}
+		projectID, _ := params["project_id"].(string)
+		if projectID == "" {
+			if pID, found := GetProjectFromContext(ctx); found {
+				projectID = string(pID)
+			}
+		}
+		if projectID == "" {
+			return result, nil
+		}
+		path, _ := params["path"].(string)
+		summary, _ := result.(string)
+		if _, err := a.Commit(ctx, projectID, tool.Name, path, summary); err != nil {
+			a.logger.WarnContext(ctx, "failed to record workspace edit", "project_id", projectID, "tool", tool.Name, "error", err)
+		}
+		return result, nil
+	}
+	return &wrapped
+}
+
+// Commit records the workspace's pending changes as one commit by the
+// persona in ctx, with message "<tool>: <path>" and summary as body. It
+// returns the commit hash, or "" when nothing changed.
+func (a *WorkspaceAudit) Commit(ctx context.Context, projectID, tool, path, summary string) (string, error) {
+	if !a.Enabled() {
+		return "", nil
+	}
+	// The edit happened: record it even if the chat was cancelled since
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditGitTimeout)
+	defer cancel()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := os.Stat(a.gitDir(projectID)); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(a.gitDir(projectID)), 0755); err != nil {
+			return "", fmt.Errorf("init workspace repository: %w", err)
+		}
+		if _, err := a.run(ctx, projectID, nil, "init", "-q"); err != nil {
+			return "", fmt.Errorf("init workspace repository: %w", err)
+		}
+	}
+	if _, err := a.run(ctx, projectID, nil, "add", "-A"); err != nil {
+		return "", err
+	}
+	if out, err := a.run(ctx, projectID, nil, "status", "--porcelain"); err != nil {
+		return "", err
+	} else if strings.TrimSpace(out) == "" {
+		return "", nil
+	}
+
+	name, email := auditDefaultAuthor, auditDefaultEmail
+	if p, ok := GetPersonaFromContext(ctx); ok {
+		name, email = p.Name, string(p.ID)+"@auleos.local"
+	}
+	msg := tool
+	if path != "" {
+		msg += ": " + path
+	}
+	if summary = strings.TrimSpace(summary); summary != "" {
+		msg += "\n\n" + summary
+	}
+	if convID, _ := ctx.Value(ctxKeyConversationID).(domain.ConversationID); convID != "" {
+		msg += "\n\nConversation: " + string(convID)
+	}
+	env := []string{
+		"GIT_AUTHOR_NAME=" + name, "GIT_AUTHOR_EMAIL=" + email,
+		"GIT_COMMITTER_NAME=" + auditCommitterName, "GIT_COMMITTER_EMAIL=" + auditCommitterEmail,
+	}
+	if _, err := a.run(ctx, projectID, env, "commit", "-q", "--no-verify", "-m", msg); err != nil {
+		return "", err
+	}
+	hash, err := a.run(ctx, projectID, nil, "rev-parse", "HEAD")
+	return strings.TrimSpace(hash), err
+}
+
+// History lists the recorded edits of a project, newest first; limit <= 0
+// uses DefaultWorkspaceHistoryLimit. A workspace without edits has none.
+func (a *WorkspaceAudit) History(ctx context.Context, projectID string, limit int) ([]domain.WorkspaceCommit, error) {
+	commits := []domain.WorkspaceCommit{}
+	if !a.Enabled() {
+		return commits, nil
+	}
+	if limit <= 0 {
+		limit = DefaultWorkspaceHistoryLimit
+	}
+	if _, err := os.Stat(a.gitDir(projectID)); err != nil {
+		return commits, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, auditGitTimeout)
+	defer cancel()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	out, err := a.run(ctx, projectID, nil, "log", "-n", strconv.Itoa(limit), "--name-only", "--format=%x1e%H%x1f%an%x1f%at%x1f%s")
+	if err != nil {
+		// A repository created outside the audit may have no commits yet
+		return commits, nil
+	}
+	for _, record := range strings.Split(out, "\x1e") {
+		lines := strings.Split(strings.TrimSpace(record), "\n")
+		fields := strings.Split(lines[0], "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		unix, _ := strconv.ParseInt(fields[2], 10, 64)
+		tool, _, _ := strings.Cut(fields[3], ": ")
+		c := domain.WorkspaceCommit{Hash: fields[0], Author: fields[1], Tool: tool, Message: fields[3], Files: []string{}, Time: time.Unix(unix, 0).UTC()}
+		for _, f := range lines[1:] {
+			if f = strings.TrimSpace(f); f != "" {
+				c.Files = append(c.Files, f)
+			}
+		}
+		commits = append(commits, c)
+	}
+	return commits, nil
+}
+
+// Diff returns a commit of a project's history as a patch (git show).
+func (a *WorkspaceAudit) Diff(ctx context.Context, projectID, hash string) (string, error) {
+	if !a.Enabled() || !commitHashRe.MatchString(hash) {
+		return "", domain.ErrWorkspaceCommitNotFound
+	}
+	if _, err := os.Stat(a.gitDir(projectID)); err != nil {
+		return "", domain.ErrWorkspaceCommitNotFound
+	}
+	ctx, cancel := context.WithTimeout(ctx, auditGitTimeout)
+	defer cancel()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.run(ctx, projectID, nil, "rev-parse", "-q", "--verify", hash+"^{commit}"); err != nil {
+		return "", domain.ErrWorkspaceCommitNotFound
+	}
+	return a.run(ctx, projectID, nil, "show", "--no-color", "--format=fuller", hash)
+}
+
+// gitDir is the repository recording a project's workspace.
+func (a *WorkspaceAudit) gitDir(projectID string) string {
+	return filepath.Join(a.ws.BaseDir(), "audit", projectID+".git")
+}
+
+// run executes git on a project's audit repository. Hooks, fsmonitor,
+// signing, prompts and the user's and system's git configuration are off:
+// the workspace content is the agent's, not trusted configuration.
+func (a *WorkspaceAudit) run(ctx context.Context, projectID string, env []string, args ...string) (string, error) {
+	dir := a.ws.GetProjectPath(projectID)
+	cmd := exec.CommandContext(ctx, a.git, append([]string{
+		"--git-dir=" + a.gitDir(projectID), "--work-tree=" + dir,
+		"-c", "core.hooksPath=/dev/null", "-c", "core.fsmonitor=false", "-c", "commit.gpgsign=false",
+	}, args...)...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), append([]string{
+		"GIT_TERMINAL_PROMPT=0", "GIT_CONFIG_NOSYSTEM=1", "GIT_CONFIG_GLOBAL=/dev/null",
+	}, env...)...)
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return string(out), nil
+}
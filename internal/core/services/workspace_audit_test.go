@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+func TestWorkspaceAudit_CommitsAgentEdits(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	ws := &WorkspaceManager{baseDir: t.TempDir()}
+	audit := NewWorkspaceAudit(slog.New(slog.NewTextHandler(io.Discard, nil)), ws)
+	write := audit.Wrap(NewWriteFileTool(ws))
+	edit := audit.Wrap(NewEditFileTool(ws))
+
+	ctx := ContextWithProject(context.Background(), "proj-1")
+	ctx = ContextWithConversation(ctx, "conv-1")
+	ctx = ContextWithPersona(ctx, domain.Persona{ID: "pers-coder", Name: "Coder"})
+
+	_, err := write.Execute(ctx, map[string]interface{}{"path": "main.go", "content": "package main\n"})
+	require.NoError(t, err)
+	_, err = edit.Execute(ContextWithProject(context.Background(), "proj-1"), map[string]interface{}{"path": "main.go", "search": "main", "replace": "app"})
+	require.NoError(t, err)
+	// Unchanged content: nothing to record
+	_, err = edit.Execute(ctx, map[string]interface{}{"path": "main.go", "search": "app", "replace": "app"})
+	require.NoError(t, err)
+	// A failed edit is not recorded either
+	_, err = edit.Execute(ctx, map[string]interface{}{"path": "main.go", "search": "missing", "replace": "x"})
+	require.Error(t, err)
+
+	history, err := audit.History(context.Background(), "proj-1", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "edit_file", history[0].Tool)
+	assert.Equal(t, "auleOS agent", history[0].Author, "no persona: the default author")
+	assert.Equal(t, "write_file", history[1].Tool)
+	assert.Equal(t, "Coder", history[1].Author)
+	assert.Equal(t, "write_file: main.go", history[1].Message)
+	assert.Equal(t, []string{"main.go"}, history[1].Files)
+
+	patch, err := audit.Diff(context.Background(), "proj-1", history[0].Hash)
+	require.NoError(t, err)
+	assert.Contains(t, patch, "-package main")
+	assert.Contains(t, patch, "+package app")
+	first, err := audit.Diff(context.Background(), "proj-1", history[1].Hash)
+	require.NoError(t, err)
+	assert.Contains(t, first, "Conversation: conv-1")
+
+	_, err = audit.Diff(context.Background(), "proj-1", "deadbeef")
+	assert.ErrorIs(t, err, domain.ErrWorkspaceCommitNotFound)
+	_, err = audit.Diff(context.Background(), "proj-1", "HEAD; rm -rf /")
+	assert.ErrorIs(t, err, domain.ErrWorkspaceCommitNotFound)
+
+	// The repository is kept out of the workspace, and its .git is off limits
+	_, err = os.Stat(filepath.Join(ws.GetProjectPath("proj-1"), ".git"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = write.Execute(ctx, map[string]interface{}{"path": ".git/config", "content": "[core]\n\tfsmonitor = touch /tmp/pwned\n"})
+	assert.ErrorContains(t, err, ".git")
+	_, err = write.Execute(ctx, map[string]interface{}{"path": "sub/.GIT/hooks/pre-commit", "content": "x"})
+	assert.ErrorContains(t, err, ".git")
+
+	// Projects the agent never edited have no history
+	none, err := audit.History(context.Background(), "proj-2", 0)
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func TestWorkspaceAudit_SkipsHomeDirectoryEdits(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	ws := &WorkspaceManager{baseDir: t.TempDir()}
+	write := NewWorkspaceAudit(slog.New(slog.NewTextHandler(io.Discard, nil)), ws).Wrap(NewWriteFileTool(ws))
+
+	_, err := write.Execute(context.Background(), map[string]interface{}{"path": "notes.txt", "content": "x"})
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(home, ".git"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWorkspaceAudit_Disabled(t *testing.T) {
+	var audit *WorkspaceAudit
+	tool := NewWriteFileTool(&WorkspaceManager{baseDir: t.TempDir()})
+	assert.Same(t, tool, audit.Wrap(tool))
+	history, err := audit.History(context.Background(), "p", 0)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
@@ -31,6 +31,10 @@ type WorkspaceContext struct {
 	Tools    string // TOOLS.md content
 	Memory   string // MEMORY.md content (already existed)
 	Skills   string // Aggregated skills context
+	Mounts   string // the project's read-only mounts, see domain.WorkspaceMount
+
+	Conversation string // the conversation's context variables, see domain.ContextVars
+	Style        string // the conversation's style preferences, see domain.ConversationStyle
 }
 
 // LoadWorkspaceContext reads all workspace personality files for a project.
@@ -76,6 +80,14 @@ func (wc WorkspaceContext) FormatForPrompt() string {
 		sections = append(sections, fmt.Sprintf("IDENTITY:\n%s", wc.Identity))
 	}
 
+	if wc.Style != "" {
+		sections = append(sections, fmt.Sprintf("CONVERSATION STYLE (the user's preferences for this conversation):\n%s", wc.Style))
+	}
+
+	if wc.Conversation != "" {
+		sections = append(sections, fmt.Sprintf("CONVERSATION CONTEXT (set by the user's client):\n%s", wc.Conversation))
+	}
+
 	if wc.Agent != "" {
 		sections = append(sections, fmt.Sprintf("AGENT INSTRUCTIONS:\n%s", wc.Agent))
 	}
@@ -92,6 +104,10 @@ func (wc WorkspaceContext) FormatForPrompt() string {
 		sections = append(sections, fmt.Sprintf("AVAILABLE SKILLS:\n%s", wc.Skills))
 	}
 
+	if wc.Mounts != "" {
+		sections = append(sections, fmt.Sprintf("READ-ONLY MOUNTS (reference material: read with read_file and list_dir, never write there):\n%s", wc.Mounts))
+	}
+
 	if wc.Memory != "" {
 		sections = append(sections, fmt.Sprintf("LONG-TERM MEMORY:\n%s", wc.Memory))
 	}
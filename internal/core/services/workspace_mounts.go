@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// MountLookup returns the read-only mounts of a project (its settings).
+type MountLookup func(ctx context.Context, projectID string) []domain.WorkspaceMount
+
+// SetMountLookup enables read-only mounts in the file tools. Without it,
+// mounts/ is an ordinary workspace directory.
+func (s *WorkspaceManager) SetMountLookup(lookup MountLookup) {
+	s.mounts = lookup
+}
+
+// Mounts returns the read-only mounts of a project.
+func (s *WorkspaceManager) Mounts(ctx context.Context, projectID string) []domain.WorkspaceMount {
+	if s == nil || s.mounts == nil || projectID == "" {
+		return nil
+	}
+	return s.mounts(ctx, projectID)
+}
+
+// mountFor finds the project mount path is in (mounts/<name>/...) and the
+// rest of path inside it.
+func (s *WorkspaceManager) mountFor(ctx context.Context, projectID, path string) (domain.WorkspaceMount, string, bool) {
+	clean := strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "/")
+	parts := strings.SplitN(clean, "/", 3)
+	if len(parts) < 2 || parts[0] != domain.MountsDir {
+		return domain.WorkspaceMount{}, "", false
+	}
+	for _, m := range s.Mounts(ctx, projectID) {
+		if m.Name == parts[1] {
+			rest := "."
+			if len(parts) == 3 {
+				rest = parts[2]
+			}
+			return m, rest, true
+		}
+	}
+	return domain.WorkspaceMount{}, "", false
+}
+
+// isMountsDir reports whether path is the mounts/ directory itself.
+func isMountsDir(path string) bool {
+	return strings.Trim(filepath.ToSlash(filepath.Clean(path)), "/") == domain.MountsDir
+}
+
+// resolveReadPath is resolveWorkspacePath for tools that only read: paths
+// under mounts/<name> map into the mount's host directory.
+func resolveReadPath(ctx context.Context, ws *WorkspaceManager, projectID, path string) (string, error) {
+	if projectID == "" {
+		if pID, found := GetProjectFromContext(ctx); found {
+			projectID = string(pID)
+		}
+	}
+	if m, rest, ok := ws.mountFor(ctx, projectID, path); ok {
+		return ensurePathIsSafe(m.HostPath, rest)
+	}
+	return resolveWorkspacePath(ctx, ws, projectID, path)
+}
+
+// formatMountsForPrompt lists a project's mounts for the workspace context.
+func formatMountsForPrompt(mounts []domain.WorkspaceMount) string {
+	lines := make([]string, 0, len(mounts))
+	for _, m := range mounts {
+		lines = append(lines, fmt.Sprintf("- %s/%s/", domain.MountsDir, m.Name))
+	}
+	return strings.Join(lines, "\n")
+}
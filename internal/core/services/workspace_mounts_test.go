@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceMounts(t *testing.T) {
+	ws, tmpDir := testWorkspaceManager(t)
+	projDir := filepath.Join(tmpDir, "projects", "proj1")
+	specs := filepath.Join(tmpDir, "host", "specs")
+	require.NoError(t, os.MkdirAll(projDir, 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(specs, "api"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(specs, "api", "v1.md"), []byte("# API v1"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "host", "private.txt"), []byte("private"), 0644))
+	require.NoError(t, os.Symlink(filepath.Join(tmpDir, "host"), filepath.Join(specs, "parent")))
+
+	ws.SetMountLookup(func(_ context.Context, projectID string) []domain.WorkspaceMount {
+		if projectID != "proj1" {
+			return nil
+		}
+		return []domain.WorkspaceMount{{Name: "specs", HostPath: specs}}
+	})
+	ctx := testProjectCtx("proj1")
+
+	t.Run("read through mount", func(t *testing.T) {
+		got, err := NewReadFileTool(ws).Execute(ctx, map[string]interface{}{"path": "mounts/specs/api/v1.md"})
+		require.NoError(t, err)
+		assert.Equal(t, "# API v1", got)
+	})
+
+	t.Run("list mounts", func(t *testing.T) {
+		list := NewListDirTool(ws)
+		got, err := list.Execute(ctx, map[string]interface{}{})
+		require.NoError(t, err)
+		assert.Contains(t, got, "mounts/")
+
+		got, err = list.Execute(ctx, map[string]interface{}{"path": "mounts"})
+		require.NoError(t, err)
+		assert.Contains(t, got, "specs/")
+
+		got, err = list.Execute(ctx, map[string]interface{}{"path": "mounts/specs"})
+		require.NoError(t, err)
+		assert.Contains(t, got, "api/")
+	})
+
+	t.Run("writes refused", func(t *testing.T) {
+		params := map[string]interface{}{"path": "mounts/specs/api/v1.md", "content": "x", "search": "API", "replace": "x"}
+		for _, tool := range []*domain.Tool{NewWriteFileTool(ws), NewEditFileTool(ws), NewAppendFileTool(ws)} {
+			_, err := tool.Execute(ctx, params)
+			assert.ErrorIs(t, err, domain.ErrReadOnlyMount, tool.Name)
+		}
+		_, err := patchFile(ctx, ws, "", "mounts/specs/api/v1.md", "@@ -1 +1 @@\n-# API v1\n+x\n")
+		assert.ErrorIs(t, err, domain.ErrReadOnlyMount)
+
+		data, err := os.ReadFile(filepath.Join(specs, "api", "v1.md"))
+		require.NoError(t, err)
+		assert.Equal(t, "# API v1", string(data))
+	})
+
+	t.Run("no escape from mount", func(t *testing.T) {
+		read := NewReadFileTool(ws)
+		_, err := read.Execute(ctx, map[string]interface{}{"path": "mounts/specs/../../../host/private.txt"})
+		assert.Error(t, err)
+		_, err = read.Execute(ctx, map[string]interface{}{"path": "mounts/specs/parent/private.txt"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "security violation")
+	})
+
+	t.Run("other projects and unknown mounts", func(t *testing.T) {
+		_, err := NewReadFileTool(ws).Execute(testProjectCtx("proj2"), map[string]interface{}{"path": "mounts/specs/api/v1.md"})
+		assert.Error(t, err)
+
+		_, err = NewWriteFileTool(ws).Execute(ctx, map[string]interface{}{"path": "mounts/other/notes.md", "content": "x"})
+		require.NoError(t, err)
+		assert.FileExists(t, filepath.Join(projDir, "mounts", "other", "notes.md"))
+	})
+}
+
+func TestWorkspaceMount_Validate(t *testing.T) {
+	assert.NoError(t, domain.WorkspaceMount{Name: "specs", HostPath: "/home/me/specs"}.Validate())
+	assert.Error(t, domain.WorkspaceMount{Name: "../x", HostPath: "/home/me/specs"}.Validate())
+	assert.Error(t, domain.WorkspaceMount{Name: "a/b", HostPath: "/home/me/specs"}.Validate())
+	assert.Error(t, domain.WorkspaceMount{Name: "specs", HostPath: "specs"}.Validate())
+}
+
+func TestWorkspaceContext_Mounts(t *testing.T) {
+	wc := WorkspaceContext{Mounts: formatMountsForPrompt([]domain.WorkspaceMount{{Name: "specs", HostPath: "/x"}})}
+	prompt := wc.FormatForPrompt()
+	assert.Contains(t, prompt, "READ-ONLY MOUNTS")
+	assert.Contains(t, prompt, "- mounts/specs/")
+	assert.NotContains(t, prompt, "/x")
+}
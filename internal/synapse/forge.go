@@ -170,8 +170,10 @@ func (f *Forge) Create(ctx context.Context, toolName, description string) (*Forg
 
 	// Step 5: Register in ToolRegistry
 	tool := plugin.AsTool()
+	tool.Namespace = domain.ToolNamespaceForge
 	tool.ExecutionType = domain.ExecWasm
-	if err := f.registry.Register(tool); err != nil {
+	// Re-forging a tool replaces the previous build
+	if err := f.registry.Replace(tool); err != nil {
 		return nil, fmt.Errorf("forge: failed to register tool: %w", err)
 	}
 
@@ -302,8 +304,54 @@ func (f *Forge) compile(ctx context.Context, sourceDir, outputPath string) error
 	return nil
 }
 
+// Remove deletes a forged tool: it is unregistered first so no new calls
+// reach it, then its plugin is unloaded and its .wasm, source and manifest
+// entry are deleted so it is not loaded again. Only forged tools can be
+// removed.
+func (f *Forge) Remove(ctx context.Context, toolName string) error {
+	toolName = sanitizeToolName(strings.TrimPrefix(toolName, domain.ToolNamespaceForge+"/"))
+	toolDir := filepath.Join(f.pluginDir, "forge", toolName)
+	if _, err := os.Stat(toolDir); toolName == "" || err != nil {
+		return fmt.Errorf("forge: %q is not a forged tool", toolName)
+	}
+
+	f.registry.Unregister(domain.ToolNamespaceForge + "/" + toolName)
+	if f.runtime != nil {
+		if err := f.runtime.UnloadPlugin(ctx, toolName); err != nil {
+			f.logger.Warn("forge: plugin was not loaded", "name", toolName, "error", err)
+		}
+	}
+
+	if err := os.Remove(filepath.Join(f.pluginDir, toolName+".wasm")); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("forge: failed to delete wasm: %w", err)
+	}
+	if err := os.RemoveAll(toolDir); err != nil {
+		return fmt.Errorf("forge: failed to delete source: %w", err)
+	}
+	if err := f.writeManifest(toolName, nil); err != nil {
+		return fmt.Errorf("forge: failed to update manifest: %w", err)
+	}
+
+	f.logger.Info("forge: tool removed", "name", toolName)
+	return nil
+}
+
 // updateManifest adds the new tool to plugins.json.
 func (f *Forge) updateManifest(toolName, description string) error {
+	return f.writeManifest(toolName, &PluginEntry{
+		Name:        toolName,
+		Version:     "1.0.0",
+		File:        toolName + ".wasm",
+		Description: description,
+		ToolName:    toolName,
+		Runtime:     "synapse",
+		Enabled:     true,
+	})
+}
+
+// writeManifest replaces toolName's entry in plugins.json with entry, or
+// drops it when entry is nil.
+func (f *Forge) writeManifest(toolName string, entry *PluginEntry) error {
 	manifestPath := filepath.Join(f.pluginDir, ManifestFile)
 
 	var manifest PluginManifest
@@ -318,16 +366,9 @@ func (f *Forge) updateManifest(toolName, description string) error {
 			filtered = append(filtered, p)
 		}
 	}
-
-	filtered = append(filtered, PluginEntry{
-		Name:        toolName,
-		Version:     "1.0.0",
-		File:        toolName + ".wasm",
-		Description: description,
-		ToolName:    toolName,
-		Runtime:     "synapse",
-		Enabled:     true,
-	})
+	if entry != nil {
+		filtered = append(filtered, *entry)
+	}
 
 	manifest.Plugins = filtered
 
@@ -104,6 +104,7 @@ func TestRealGoWasmPluginViaTool(t *testing.T) {
 	// Register as tool
 	tool := plugin.AsTool()
 	assert.Equal(t, domain.ExecWasm, tool.ExecutionType)
+	assert.Equal(t, "plugin/enhance_prompt", tool.FullName())
 
 	registry := domain.NewToolRegistry()
 	err = registry.Register(tool)
@@ -171,3 +172,32 @@ func cleanMarkdownFences(s string) string {
 	}
 	return strings.TrimSpace(s)
 }
+
+// TestForgeRemove tests that a forged tool is unregistered and deleted from disk,
+// and that built-in tools cannot be removed through the forge.
+func TestForgeRemove(t *testing.T) {
+	dir := t.TempDir()
+	registry := domain.NewToolRegistry()
+	forge := synapse.NewForge(slog.Default(), nil, "", nil, registry, dir)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "forge", "csv_to_json"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "csv_to_json.wasm"), []byte("wasm"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, synapse.ManifestFile),
+		[]byte(`{"plugins":[{"name":"csv_to_json","file":"csv_to_json.wasm"},{"name":"other","file":"other.wasm"}]}`), 0644))
+	require.NoError(t, registry.Register(&domain.Tool{Name: "csv_to_json", Namespace: domain.ToolNamespaceForge}))
+	require.NoError(t, registry.Register(&domain.Tool{Name: "read_file"}))
+
+	require.NoError(t, forge.Remove(context.Background(), "forge/csv_to_json"))
+	_, ok := registry.GetTool("forge/csv_to_json")
+	assert.False(t, ok)
+	assert.NoFileExists(t, filepath.Join(dir, "csv_to_json.wasm"))
+	assert.NoDirExists(t, filepath.Join(dir, "forge", "csv_to_json"))
+	manifest, err := os.ReadFile(filepath.Join(dir, synapse.ManifestFile))
+	require.NoError(t, err)
+	assert.NotContains(t, string(manifest), "csv_to_json")
+	assert.Contains(t, string(manifest), "other")
+
+	assert.Error(t, forge.Remove(context.Background(), "read_file"))
+	_, ok = registry.GetTool("read_file")
+	assert.True(t, ok)
+}
@@ -15,9 +15,10 @@ const hostModuleName = "aule"
 // This is a convenience wrapper that creates minimal HostServices (log + metric only)
 // when the full host services layer isn't needed (e.g., tests, simple plugins).
 //
-// For production use with secrets, HTTP proxy, and KV store, use HostServices directly:
+// For production use with Muscle delegation and plugin state, use HostServices directly:
 //
-//	hs := synapse.NewHostServices(logger, vault, kvStore)
+//	hs := synapse.NewHostServices(logger, spawner)
+//	hs.SetKVStore(synapse.NewRepoKVStore(repo), projectOf)
 //	hs.InstantiateHostFunctions(ctx, rt)
 func InstantiateHostFunctions(ctx context.Context, rt wazero.Runtime, logger *slog.Logger) error {
 	// Create minimal host services — no vault, no KV, just log + metric
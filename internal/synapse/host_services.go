@@ -1,10 +1,13 @@
 package synapse
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"sync"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
 	"github.com/tetratelabs/wazero"
@@ -19,8 +22,44 @@ type WorkerSpawner interface {
 
 // HostServices provides the bridge between Wasm plugins and Kernel capabilities.
 type HostServices struct {
-	logger  *slog.Logger
-	spawner WorkerSpawner // The link to Muscle (Docker)
+	logger    *slog.Logger
+	spawner   WorkerSpawner // The link to Muscle (Docker)
+	kv        KVStore       // nil = kv_get/kv_set fail
+	projectOf func(ctx context.Context) string
+	http      *HTTPProxy // nil = http_fetch fails
+}
+
+// Return codes of the kv and http host functions.
+const (
+	hostNotFound = -1 // no value under the key, or no response
+	hostFailed   = -2 // bad arguments, the service is off, or it failed
+)
+
+type pluginCtxKey struct{}
+
+// pluginCall is the state of one plugin execution seen by host functions.
+type pluginCall struct {
+	name     string
+	mu       sync.Mutex
+	response []byte // last http_fetch response, for http_response
+}
+
+// withPlugin marks ctx as running plugin name, which scopes its state and
+// network permissions.
+func withPlugin(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, pluginCtxKey{}, &pluginCall{name: name})
+}
+
+func callFromContext(ctx context.Context) *pluginCall {
+	call, _ := ctx.Value(pluginCtxKey{}).(*pluginCall)
+	return call
+}
+
+func pluginFromContext(ctx context.Context) string {
+	if call := callFromContext(ctx); call != nil {
+		return call.name
+	}
+	return ""
 }
 
 // NewHostServices creates a new HostServices instance.
@@ -31,6 +70,20 @@ func NewHostServices(logger *slog.Logger, spawner WorkerSpawner) *HostServices {
 	}
 }
 
+// SetKVStore lets plugins keep state across invocations with aule.kv_get
+// and aule.kv_set. State is scoped per plugin and per project; projectOf
+// returns the project of a call ("" outside projects) and may be nil.
+func (h *HostServices) SetKVStore(store KVStore, projectOf func(ctx context.Context) string) {
+	h.kv = store
+	h.projectOf = projectOf
+}
+
+// SetHTTPProxy enables aule.http_fetch. The proxy only lets a plugin
+// reach the hosts granted to it; see Runtime.SetHTTPProxy.
+func (h *HostServices) SetHTTPProxy(p *HTTPProxy) {
+	h.http = p
+}
+
 // InstantiateHostFunctions registers the "aule" host module in the runtime.
 func (h *HostServices) InstantiateHostFunctions(ctx context.Context, rt wazero.Runtime) error {
 	_, err := rt.NewHostModuleBuilder("aule").
@@ -40,6 +93,18 @@ func (h *HostServices) InstantiateHostFunctions(ctx context.Context, rt wazero.R
 		NewFunctionBuilder().
 		WithGoModuleFunction(api.GoModuleFunc(h.fnDelegate), []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}).
 		Export("delegate").
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(h.fnKVGet), []api.ValueType{api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}).
+		Export("kv_get").
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(h.fnKVSet), []api.ValueType{api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}).
+		Export("kv_set").
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(h.fnHTTPFetch), []api.ValueType{api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}).
+		Export("http_fetch").
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(h.fnHTTPResponse), []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}).
+		Export("http_response").
 		Instantiate(ctx)
 
 	return err
@@ -103,6 +168,176 @@ func (h *HostServices) fnDelegate(ctx context.Context, mod api.Module, stack []u
 	stack[0] = 1 // Success
 }
 
+// fnKVGet: (key_ptr: i32, key_len: i32, buf_ptr: i32, buf_cap: i32) -> len: i32
+// Copies the value into the buffer and returns its length. A value longer
+// than buf_cap is not copied; call again with a buffer of the returned
+// length. Returns -1 when the key is not set and -2 on errors.
+func (h *HostServices) fnKVGet(ctx context.Context, mod api.Module, stack []uint64) {
+	ns, key, ok := h.kvArgs(ctx, mod, uint32(stack[0]), uint32(stack[1]))
+	if !ok {
+		stack[0] = api.EncodeI32(hostFailed)
+		return
+	}
+	value, err := h.kv.Get(ctx, ns, key)
+	if errors.Is(err, domain.ErrKVKeyNotFound) {
+		stack[0] = api.EncodeI32(hostNotFound)
+		return
+	}
+	if err != nil {
+		h.logger.Error("synapse: kv_get failed", "namespace", ns, "key", key, "error", err)
+		stack[0] = api.EncodeI32(hostFailed)
+		return
+	}
+	if uint32(len(value)) <= uint32(stack[3]) && !mod.Memory().Write(uint32(stack[2]), value) {
+		stack[0] = api.EncodeI32(hostFailed)
+		return
+	}
+	stack[0] = api.EncodeI32(int32(len(value)))
+}
+
+// fnKVSet: (key_ptr: i32, key_len: i32, val_ptr: i32, val_len: i32) -> status: i32
+// Stores the value (an empty one deletes the key). Returns 0, or -2 on
+// errors such as a key or value over MaxKVKeyBytes / MaxKVValueBytes.
+func (h *HostServices) fnKVSet(ctx context.Context, mod api.Module, stack []uint64) {
+	ns, key, ok := h.kvArgs(ctx, mod, uint32(stack[0]), uint32(stack[1]))
+	if !ok {
+		stack[0] = api.EncodeI32(hostFailed)
+		return
+	}
+	size := uint32(stack[3])
+	if size > MaxKVValueBytes {
+		h.logger.Warn("synapse: kv_set value too large", "namespace", ns, "key", key, "bytes", size)
+		stack[0] = api.EncodeI32(hostFailed)
+		return
+	}
+	var err error
+	if size == 0 {
+		err = h.kv.Delete(ctx, ns, key)
+	} else {
+		value, ok := mod.Memory().Read(uint32(stack[2]), size)
+		if !ok {
+			stack[0] = api.EncodeI32(hostFailed)
+			return
+		}
+		// Read aliases Wasm memory; the store must not keep it
+		err = h.kv.Set(ctx, ns, key, bytes.Clone(value))
+	}
+	if err != nil {
+		h.logger.Error("synapse: kv_set failed", "namespace", ns, "key", key, "error", err)
+		stack[0] = api.EncodeI32(hostFailed)
+		return
+	}
+	stack[0] = 0
+}
+
+// kvArgs reads the key and returns the namespace of the calling plugin in
+// the current project.
+func (h *HostServices) kvArgs(ctx context.Context, mod api.Module, ptr, size uint32) (string, string, bool) {
+	plugin := pluginFromContext(ctx)
+	if h.kv == nil || plugin == "" {
+		h.logger.Warn("synapse: kv called but no store configured", "plugin", plugin)
+		return "", "", false
+	}
+	if size == 0 || size > MaxKVKeyBytes {
+		h.logger.Warn("synapse: invalid kv key length", "plugin", plugin, "bytes", size)
+		return "", "", false
+	}
+	key, err := readString(mod, ptr, size)
+	if err != nil {
+		h.logger.Error("synapse: failed to read kv key", "plugin", plugin, "error", err)
+		return "", "", false
+	}
+	project := ""
+	if h.projectOf != nil {
+		project = h.projectOf(ctx)
+	}
+	return plugin + "/" + project, key, true
+}
+
+// httpFetchRequest is the JSON a plugin passes to aule.http_fetch.
+type httpFetchRequest struct {
+	Method string `json:"method"` // default GET
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// httpFetchResponse is the JSON aule.http_fetch hands back. Denied and
+// failed requests set Error.
+type httpFetchResponse struct {
+	Status int    `json:"status,omitempty"`
+	Body   string `json:"body,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// fnHTTPFetch: (req_ptr: i32, req_len: i32, buf_ptr: i32, buf_cap: i32) -> len: i32
+// Performs the request (JSON httpFetchRequest) if the plugin may reach the
+// host, and copies the JSON httpFetchResponse into the buffer, returning
+// its length. A response longer than buf_cap is kept for aule.http_response
+// instead of being copied. Returns -2 when the request cannot be read.
+func (h *HostServices) fnHTTPFetch(ctx context.Context, mod api.Module, stack []uint64) {
+	call := callFromContext(ctx)
+	reqJSON, err := readString(mod, uint32(stack[0]), uint32(stack[1]))
+	if err != nil || call == nil {
+		h.logger.Error("synapse: failed to read http_fetch request", "error", err)
+		stack[0] = api.EncodeI32(hostFailed)
+		return
+	}
+	var req httpFetchRequest
+	if err := json.Unmarshal([]byte(reqJSON), &req); err != nil {
+		h.logger.Warn("synapse: invalid http_fetch request", "plugin", call.name, "error", err)
+		stack[0] = api.EncodeI32(hostFailed)
+		return
+	}
+	if req.Method == "" {
+		req.Method = "GET"
+	}
+
+	var resp httpFetchResponse
+	if h.http == nil {
+		resp.Error = "network access is not enabled"
+	} else {
+		body, status, err := h.http.Fetch(ctx, call.name, req.Method, req.URL, req.Body)
+		resp.Status, resp.Body = status, string(body)
+		if err != nil {
+			resp.Error = err.Error()
+			h.logger.Warn("synapse: http_fetch failed", "plugin", call.name, "url", req.URL, "error", err)
+		}
+	}
+	out, _ := json.Marshal(resp)
+
+	call.mu.Lock()
+	call.response = out
+	call.mu.Unlock()
+	if uint32(len(out)) <= uint32(stack[3]) && !mod.Memory().Write(uint32(stack[2]), out) {
+		stack[0] = api.EncodeI32(hostFailed)
+		return
+	}
+	stack[0] = api.EncodeI32(int32(len(out)))
+}
+
+// fnHTTPResponse: (buf_ptr: i32, buf_cap: i32) -> len: i32
+// Copies the last http_fetch response of this execution into the buffer,
+// for responses that did not fit the first one. Returns -1 when there is
+// none and -2 when it does not fit either.
+func (h *HostServices) fnHTTPResponse(ctx context.Context, mod api.Module, stack []uint64) {
+	call := callFromContext(ctx)
+	if call == nil {
+		stack[0] = api.EncodeI32(hostNotFound)
+		return
+	}
+	call.mu.Lock()
+	out := call.response
+	call.mu.Unlock()
+	switch {
+	case out == nil:
+		stack[0] = api.EncodeI32(hostNotFound)
+	case uint32(len(out)) > uint32(stack[1]) || !mod.Memory().Write(uint32(stack[0]), out):
+		stack[0] = api.EncodeI32(hostFailed)
+	default:
+		stack[0] = api.EncodeI32(int32(len(out)))
+	}
+}
+
 // Helper to read string from Wasm memory
 func readString(mod api.Module, ptr, size uint32) (string, error) {
 	bytes, ok := mod.Memory().Read(ptr, size)
@@ -55,6 +55,41 @@ func TestHTTPProxyAllowlistEnforcement(t *testing.T) {
 	assert.Contains(t, err.Error(), "not in plugin allowlist")
 }
 
+func TestRuntimeGrantsDeclaredAndApprovedHosts(t *testing.T) {
+	ctx := context.Background()
+	rt, err := synapse.NewRuntime(ctx, testLogger())
+	require.NoError(t, err)
+	defer rt.Close(ctx)
+	proxy := synapse.NewHTTPProxy(testLogger())
+	rt.SetHTTPProxy(proxy)
+	var pending []string
+	rt.SetOnHostsPending(func(plugin string, hosts []string) { pending = hosts })
+
+	meta := testMeta()
+	meta.AllowedHosts = []string{"api.example.invalid", "cdn.example.invalid"}
+	_, err = rt.LoadPlugin(ctx, "net-plugin", noopWasm, meta)
+	require.NoError(t, err)
+	assert.Equal(t, meta.AllowedHosts, pending, "the user is asked at install time")
+	_, _, err = proxy.Fetch(ctx, "net-plugin", "GET", "https://api.example.invalid/x", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not in plugin allowlist")
+
+	// Only hosts both declared and approved are reachable
+	rt.SetHostApprovals(map[string][]string{"net-plugin": {"api.example.invalid", "undeclared.example.com"}})
+	assert.Equal(t, []string{"api.example.invalid"}, rt.ApprovedHosts("net-plugin"))
+	assert.Equal(t, []string{"cdn.example.invalid"}, rt.PendingHosts("net-plugin"))
+	_, _, err = proxy.Fetch(ctx, "net-plugin", "GET", "https://undeclared.example.com/", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not in plugin allowlist")
+	_, _, err = proxy.Fetch(ctx, "net-plugin", "GET", "https://api.example.invalid/x", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "request failed", "allowed through to the network")
+
+	require.NoError(t, rt.UnloadPlugin(ctx, "net-plugin"))
+	_, _, err = proxy.Fetch(ctx, "net-plugin", "GET", "https://api.example.invalid/x", "")
+	assert.Contains(t, err.Error(), "not in plugin allowlist")
+}
+
 // ── MemKVStore Tests ────────────────────────────────────────────────────
 
 func TestMemKVStoreNamespaceIsolation(t *testing.T) {
@@ -183,3 +218,92 @@ func mockConfigFunc(llmAPIKey, imageAPIKey string) func() *domain.AppConfig {
 		}
 	}
 }
+
+// kvWasm stores "42" under "n", reads it back into a buffer and stores the
+// buffer under "m". Equivalent WAT:
+//
+//	(module
+//	  (import "aule" "kv_set" (func $set (param i32 i32 i32 i32) (result i32)))
+//	  (import "aule" "kv_get" (func $get (param i32 i32 i32 i32) (result i32)))
+//	  (memory (export "memory") 1)
+//	  (data (i32.const 0) "n42m")
+//	  (func (export "_start")
+//	    (drop (call $set (i32.const 0) (i32.const 1) (i32.const 1) (i32.const 2)))
+//	    (drop (call $get (i32.const 0) (i32.const 1) (i32.const 16) (i32.const 8)))
+//	    (drop (call $set (i32.const 3) (i32.const 1) (i32.const 16) (i32.const 2))))
+//	)
+var kvWasm = []byte{
+	0x00, 0x61, 0x73, 0x6d, // magic
+	0x01, 0x00, 0x00, 0x00, // version
+
+	// Type section: (i32 i32 i32 i32) -> i32, () -> ()
+	0x01, 0x0c,
+	0x02,
+	0x60, 0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x01, 0x7f,
+	0x60, 0x00, 0x00,
+
+	// Import section: aule.kv_set, aule.kv_get (type 0)
+	0x02, 0x1d,
+	0x02,
+	0x04, 0x61, 0x75, 0x6c, 0x65, 0x06, 0x6b, 0x76, 0x5f, 0x73, 0x65, 0x74, 0x00, 0x00,
+	0x04, 0x61, 0x75, 0x6c, 0x65, 0x06, 0x6b, 0x76, 0x5f, 0x67, 0x65, 0x74, 0x00, 0x00,
+
+	// Function section: 1 func → type 1
+	0x03, 0x02,
+	0x01, 0x01,
+
+	// Memory section: 1 memory, min=1 page
+	0x05, 0x03,
+	0x01, 0x00, 0x01,
+
+	// Export section: "memory" (mem 0) + "_start" (func 2)
+	0x07, 0x13,
+	0x02,
+	0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00,
+	0x06, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x00, 0x02,
+
+	// Code section
+	0x0a, 0x25,
+	0x01, 0x23, 0x00,
+	0x41, 0x00, 0x41, 0x01, 0x41, 0x01, 0x41, 0x02, 0x10, 0x00, 0x1a,
+	0x41, 0x00, 0x41, 0x01, 0x41, 0x10, 0x41, 0x08, 0x10, 0x01, 0x1a,
+	0x41, 0x03, 0x41, 0x01, 0x41, 0x10, 0x41, 0x02, 0x10, 0x00, 0x1a,
+	0x0b,
+
+	// Data section: "n42m" at 0
+	0x0b, 0x0a,
+	0x01, 0x00, 0x41, 0x00, 0x0b, 0x04, 0x6e, 0x34, 0x32, 0x6d,
+}
+
+func TestHostServicesKVScopedPerPluginAndProject(t *testing.T) {
+	ctx := context.Background()
+	logger := testLogger()
+
+	rt, err := synapse.NewRuntime(ctx, logger)
+	require.NoError(t, err)
+	defer rt.Close(ctx)
+
+	store := synapse.NewMemKVStore()
+	type projectKey struct{}
+	hs := synapse.NewHostServices(logger, nil)
+	hs.SetKVStore(store, func(ctx context.Context) string {
+		p, _ := ctx.Value(projectKey{}).(string)
+		return p
+	})
+	require.NoError(t, rt.RegisterHostServices(ctx, hs))
+
+	plugin, err := rt.LoadPlugin(ctx, "counter", kvWasm, synapse.PluginMeta{Name: "counter", ToolName: "counter"})
+	require.NoError(t, err)
+	_, err = plugin.Execute(context.WithValue(ctx, projectKey{}, "p1"), nil)
+	require.NoError(t, err)
+
+	val, err := store.Get(ctx, "counter/p1", "n")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("42"), val)
+	val, err = store.Get(ctx, "counter/p1", "m")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("42"), val, "kv_get copied the value into plugin memory")
+
+	_, err = store.Get(ctx, "counter/", "n")
+	assert.ErrorIs(t, err, domain.ErrKVKeyNotFound, "other projects do not see the state")
+}
@@ -23,6 +23,8 @@ type HTTPProxy struct {
 	permissions map[string][]string // plugin → allowed hostnames
 }
 
+type allowlistCtxKey struct{}
+
 // NewHTTPProxy creates a new HTTP proxy with SSRF protections.
 func NewHTTPProxy(logger *slog.Logger) *HTTPProxy {
 	return &HTTPProxy{
@@ -33,6 +35,12 @@ func NewHTTPProxy(logger *slog.Logger) *HTTPProxy {
 				if len(via) >= 5 {
 					return fmt.Errorf("too many redirects")
 				}
+				// Redirects must stay within the plugin's allowlist too
+				host := req.URL.Hostname()
+				allowed, _ := req.Context().Value(allowlistCtxKey{}).([]string)
+				if isInternalHost(host) || !hostInAllowlist(host, allowed) {
+					return fmt.Errorf("redirect to host %q denied", host)
+				}
 				return nil
 			},
 		},
@@ -82,6 +90,7 @@ func (p *HTTPProxy) Fetch(ctx context.Context, plugin, method, rawURL, body stri
 		bodyReader = strings.NewReader(body)
 	}
 
+	ctx = context.WithValue(ctx, allowlistCtxKey{}, allowed)
 	req, err := http.NewRequestWithContext(ctx, method, rawURL, bodyReader)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to create request: %w", err)
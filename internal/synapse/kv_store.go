@@ -4,8 +4,57 @@ import (
 	"context"
 	"fmt"
 	"sync"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// Limits of plugin state written through aule.kv_set.
+const (
+	MaxKVKeyBytes   = 256
+	MaxKVValueBytes = 64 << 10
 )
 
+// KVStore keeps plugin state by namespace. Get fails with
+// domain.ErrKVKeyNotFound for keys never set.
+type KVStore interface {
+	Get(ctx context.Context, namespace, key string) ([]byte, error)
+	Set(ctx context.Context, namespace, key string, value []byte) error
+	Delete(ctx context.Context, namespace, key string) error
+}
+
+// KVRepository persists plugin state; *duckdb.Repository implements it.
+type KVRepository interface {
+	GetPluginKV(ctx context.Context, namespace, key string) ([]byte, error)
+	SetPluginKV(ctx context.Context, namespace, key string, value []byte) error
+	DeletePluginKV(ctx context.Context, namespace, key string) error
+}
+
+// RepoKVStore is a KVStore kept in the repository, so plugin state
+// survives kernel restarts.
+type RepoKVStore struct {
+	repo KVRepository
+}
+
+// NewRepoKVStore creates a KVStore backed by repo.
+func NewRepoKVStore(repo KVRepository) *RepoKVStore {
+	return &RepoKVStore{repo: repo}
+}
+
+// Get retrieves a value by namespace and key.
+func (s *RepoKVStore) Get(ctx context.Context, namespace, key string) ([]byte, error) {
+	return s.repo.GetPluginKV(ctx, namespace, key)
+}
+
+// Set stores a value under the given namespace and key.
+func (s *RepoKVStore) Set(ctx context.Context, namespace, key string, value []byte) error {
+	return s.repo.SetPluginKV(ctx, namespace, key, value)
+}
+
+// Delete removes a key from a namespace.
+func (s *RepoKVStore) Delete(ctx context.Context, namespace, key string) error {
+	return s.repo.DeletePluginKV(ctx, namespace, key)
+}
+
 // MemKVStore is a simple in-memory key-value store with namespace isolation.
 // Each plugin gets its own namespace, preventing cross-plugin data leaks.
 type MemKVStore struct {
@@ -43,12 +92,12 @@ func (s *MemKVStore) Get(_ context.Context, namespace, key string) ([]byte, erro
 
 	ns, exists := s.data[namespace]
 	if !exists {
-		return nil, fmt.Errorf("key %q not found in namespace %q", key, namespace)
+		return nil, fmt.Errorf("%w: %q in namespace %q", domain.ErrKVKeyNotFound, key, namespace)
 	}
 
 	val, exists := ns[key]
 	if !exists {
-		return nil, fmt.Errorf("key %q not found in namespace %q", key, namespace)
+		return nil, fmt.Errorf("%w: %q in namespace %q", domain.ErrKVKeyNotFound, key, namespace)
 	}
 
 	// Return a copy to prevent mutation
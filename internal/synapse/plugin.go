@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/tetratelabs/wazero"
 	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/sys"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
 )
@@ -24,6 +28,9 @@ type PluginMeta struct {
 	ToolName    string                `json:"tool_name"`
 	Parameters  domain.ToolParameters `json:"parameters"`
 	Timeout     time.Duration         `json:"timeout,omitempty"` // max execution time (default 5s)
+	// Hosts the plugin may reach with aule.http_fetch once the user
+	// approves them
+	AllowedHosts []string `json:"allowed_hosts,omitempty"`
 }
 
 // Plugin represents a compiled Wasm module that can be executed as a Tool.
@@ -35,6 +42,37 @@ type Plugin struct {
 	compiled wazero.CompiledModule
 	rt       wazero.Runtime
 	logger   *slog.Logger
+	tracer   Tracer // nil = executions are not traced
+
+	mu    sync.Mutex
+	stats PluginStats
+}
+
+// Tracer records plugin executions as tool spans; *services.TraceCollector
+// implements it.
+type Tracer interface {
+	StartSpan(ctx context.Context, name string, kind domain.SpanKind, attrs map[string]string) (context.Context, domain.SpanID)
+	SetSpanInput(spanID domain.SpanID, input string)
+	SetSpanAttribute(spanID domain.SpanID, key, value string)
+	EndSpan(spanID domain.SpanID, status domain.SpanStatus, output string, errMsg string)
+}
+
+// PluginStats aggregates the executions of a loaded plugin, to spot slow
+// or failing ones. A reload starts over.
+type PluginStats struct {
+	Name            string    `json:"name"`
+	Version         string    `json:"version"`
+	Calls           int64     `json:"calls"`
+	Errors          int64     `json:"errors"`
+	TotalMs         int64     `json:"total_ms"`
+	AvgMs           float64   `json:"avg_ms"`
+	MaxMs           int64     `json:"max_ms"`
+	LastMs          int64     `json:"last_ms"`
+	MaxMemoryBytes  uint64    `json:"max_memory_bytes"`
+	LastMemoryBytes uint64    `json:"last_memory_bytes"`
+	LastExitCode    int       `json:"last_exit_code"` // -1 = trapped or timed out
+	LastError       string    `json:"last_error,omitempty"`
+	LastRunAt       time.Time `json:"last_run_at,omitempty"`
 }
 
 // Execute runs the Wasm plugin with the given JSON input.
@@ -53,15 +91,25 @@ func (p *Plugin) Execute(ctx context.Context, params map[string]interface{}) (in
 		timeout = 5 * time.Second
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
-
 	// Serialize input as JSON → stdin
 	inputJSON, err := json.Marshal(params)
 	if err != nil {
 		return nil, fmt.Errorf("synapse: failed to marshal input for %q: %w", p.name, err)
 	}
 
+	ctx = withPlugin(ctx, p.name)
+	var spanID domain.SpanID
+	if p.tracer != nil {
+		ctx, spanID = p.tracer.StartSpan(ctx, "plugin."+p.name, domain.SpanKindTool, map[string]string{
+			"plugin":         p.name,
+			"plugin_version": p.meta.Version,
+		})
+		p.tracer.SetSpanInput(spanID, string(inputJSON))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	stdin := bytes.NewReader(inputJSON)
 	var stdout, stderr bytes.Buffer
 
@@ -74,13 +122,42 @@ func (p *Plugin) Execute(ctx context.Context, params map[string]interface{}) (in
 		WithStartFunctions("_start"). // WASI convention: calls main()
 		WithName("")                  // anonymous instance (allows concurrent calls)
 
+	start := time.Now()
 	mod, err := p.rt.InstantiateModule(ctx, p.compiled, moduleCfg)
+	elapsed := time.Since(start)
+	var memBytes uint64
+	if mod != nil && mod.Memory() != nil {
+		memBytes = uint64(mod.Memory().Size())
+	}
+	exitCode := 0
+	var exitErr *sys.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		exitCode = int(exitErr.ExitCode())
+	case err != nil:
+		exitCode = -1
+	}
+	if err != nil {
+		err = fmt.Errorf("synapse: execution failed for %q: %w", p.name, err)
+	}
+	p.record(elapsed, memBytes, exitCode, err)
+	if p.tracer != nil {
+		p.tracer.SetSpanAttribute(spanID, "duration_ms", strconv.FormatInt(elapsed.Milliseconds(), 10))
+		p.tracer.SetSpanAttribute(spanID, "memory_bytes", strconv.FormatUint(memBytes, 10))
+		p.tracer.SetSpanAttribute(spanID, "exit_code", strconv.Itoa(exitCode))
+		if err != nil {
+			p.tracer.EndSpan(spanID, domain.SpanStatusError, stderr.String(), err.Error())
+		} else {
+			p.tracer.EndSpan(spanID, domain.SpanStatusOK, stdout.String(), "")
+		}
+	}
+
 	if err != nil {
 		stderrMsg := stderr.String()
 		if stderrMsg != "" {
 			p.logger.Warn("synapse: plugin stderr", "plugin", p.name, "stderr", stderrMsg)
 		}
-		return nil, fmt.Errorf("synapse: execution failed for %q: %w", p.name, err)
+		return nil, err
 	}
 	defer mod.Close(ctx)
 
@@ -111,12 +188,48 @@ func (p *Plugin) Execute(ctx context.Context, params map[string]interface{}) (in
 	return result, nil
 }
 
+// record adds an execution to the plugin's stats.
+func (p *Plugin) record(elapsed time.Duration, memBytes uint64, exitCode int, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ms := elapsed.Milliseconds()
+	st := &p.stats
+	st.Calls++
+	st.TotalMs += ms
+	st.MaxMs = max(st.MaxMs, ms)
+	st.LastMs = ms
+	st.MaxMemoryBytes = max(st.MaxMemoryBytes, memBytes)
+	st.LastMemoryBytes = memBytes
+	st.LastExitCode = exitCode
+	st.LastError = ""
+	if err != nil {
+		st.Errors++
+		st.LastError = err.Error()
+	}
+	st.LastRunAt = time.Now()
+}
+
+// Stats returns the plugin's execution stats since it was loaded.
+func (p *Plugin) Stats() PluginStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	st := p.stats
+	st.Name = p.name
+	st.Version = p.meta.Version
+	if st.Calls > 0 {
+		st.AvgMs = float64(st.TotalMs) / float64(st.Calls)
+	}
+	return st
+}
+
 // AsTool converts this Plugin into a domain.Tool that can be registered
 // in the agent's ToolRegistry. This bridges the Synapse (Wasm) world
-// with the existing auleOS tool system.
+// with the existing auleOS tool system. The tool lives in the "plugin"
+// namespace.
 func (p *Plugin) AsTool() *domain.Tool {
 	return &domain.Tool{
 		Name:          p.meta.ToolName,
+		Namespace:     domain.ToolNamespacePlugin,
 		Description:   p.meta.Description,
 		Parameters:    p.meta.Parameters,
 		ExecutionType: domain.ExecWasm,
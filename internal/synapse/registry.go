@@ -30,6 +30,8 @@ type PluginEntry struct {
 	Parameters  domain.ToolParameters `json:"parameters"`
 	Runtime     string                `json:"runtime"` // "synapse" or "muscle"
 	Enabled     bool                  `json:"enabled"`
+	// Hosts for aule.http_fetch; each needs the user's approval
+	AllowedHosts []string `json:"allowed_hosts,omitempty"`
 }
 
 // Registry discovers, loads, and manages Wasm plugins from a directory.
@@ -105,11 +107,12 @@ func (r *Registry) loadFromManifest(ctx context.Context, manifestPath string) ([
 		}
 
 		meta := PluginMeta{
-			Name:        entry.Name,
-			Version:     entry.Version,
-			Description: entry.Description,
-			ToolName:    entry.ToolName,
-			Parameters:  entry.Parameters,
+			Name:         entry.Name,
+			Version:      entry.Version,
+			Description:  entry.Description,
+			ToolName:     entry.ToolName,
+			Parameters:   entry.Parameters,
+			AllowedHosts: entry.AllowedHosts,
 		}
 
 		plugin, err := r.runtime.LoadPlugin(ctx, entry.Name, wasmBytes, meta)
@@ -118,10 +121,14 @@ func (r *Registry) loadFromManifest(ctx context.Context, manifestPath string) ([
 			continue
 		}
 
-		tools = append(tools, plugin.AsTool())
+		tool := plugin.AsTool()
+		if r.isForged(entry.Name) {
+			tool.Namespace = domain.ToolNamespaceForge
+		}
+		tools = append(tools, tool)
 		r.logger.Info("synapse: registered plugin as tool",
 			"plugin", entry.Name,
-			"tool", entry.ToolName,
+			"tool", tool.FullName(),
 		)
 	}
 
@@ -181,6 +188,13 @@ func (r *Registry) loadFromDirectory(ctx context.Context) ([]*domain.Tool, error
 	return tools, nil
 }
 
+// isForged reports whether the plugin was built by the Tool Forge, which
+// keeps its sources under forge/<name>.
+func (r *Registry) isForged(name string) bool {
+	info, err := os.Stat(filepath.Join(r.pluginDir, "forge", name))
+	return err == nil && info.IsDir()
+}
+
 // PluginDir returns the configured plugin directory path.
 func (r *Registry) PluginDir() string {
 	return r.pluginDir
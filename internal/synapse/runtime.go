@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"sync"
 
 	"github.com/tetratelabs/wazero"
@@ -25,6 +26,11 @@ type Runtime struct {
 	logger  *slog.Logger
 	rt      wazero.Runtime
 	plugins map[string]*Plugin // name → loaded plugin
+	tracer  Tracer             // optional; see SetTracer
+
+	proxy     *HTTPProxy          // optional; see SetHTTPProxy
+	approvals map[string][]string // plugin → hosts the user approved
+	onPending func(plugin string, hosts []string)
 }
 
 // NewRuntime creates a new Wasm runtime with AOT compilation and WASI support.
@@ -54,6 +60,101 @@ func NewRuntime(ctx context.Context, logger *slog.Logger) (*Runtime, error) {
 	}, nil
 }
 
+// SetTracer makes executions of plugins loaded afterwards show up as tool
+// spans in the trace of the calling agent run.
+func (r *Runtime) SetTracer(t Tracer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tracer = t
+}
+
+// SetHTTPProxy makes the runtime grant each plugin the hosts it declares
+// and the user approved on proxy, the one used by aule.http_fetch.
+func (r *Runtime) SetHTTPProxy(p *HTTPProxy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.proxy = p
+	for name := range r.plugins {
+		r.grantHosts(name)
+	}
+}
+
+// SetHostApprovals replaces the hosts the user approved, by plugin name
+// (the plugin_hosts runtime setting).
+func (r *Runtime) SetHostApprovals(approvals map[string][]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.approvals = maps.Clone(approvals)
+	for name := range r.plugins {
+		r.grantHosts(name)
+	}
+}
+
+// SetOnHostsPending registers fn to be told about plugins that declare
+// hosts the user has not approved yet: right away for loaded plugins, and
+// whenever such a plugin is loaded. fn must not call back into the runtime.
+func (r *Runtime) SetOnHostsPending(fn func(plugin string, hosts []string)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onPending = fn
+	for name := range r.plugins {
+		if pending := r.pendingHosts(name); len(pending) > 0 {
+			fn(name, pending)
+		}
+	}
+}
+
+// ApprovedHosts returns the hosts a loaded plugin may reach: declared in
+// its manifest and approved by the user.
+func (r *Runtime) ApprovedHosts(name string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.approvedHosts(name)
+}
+
+// PendingHosts returns the hosts a loaded plugin declares that the user
+// has not approved.
+func (r *Runtime) PendingHosts(name string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pendingHosts(name)
+}
+
+func (r *Runtime) approvedHosts(name string) []string {
+	p, ok := r.plugins[name]
+	if !ok {
+		return nil
+	}
+	var out []string
+	for _, h := range p.meta.AllowedHosts {
+		if hostInAllowlist(h, r.approvals[name]) {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+func (r *Runtime) pendingHosts(name string) []string {
+	p, ok := r.plugins[name]
+	if !ok {
+		return nil
+	}
+	var out []string
+	for _, h := range p.meta.AllowedHosts {
+		if !hostInAllowlist(h, r.approvals[name]) {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+// grantHosts updates the proxy permissions of a plugin. Callers hold r.mu.
+func (r *Runtime) grantHosts(name string) {
+	if r.proxy != nil {
+		r.proxy.SetPluginPermissions(name, r.approvedHosts(name))
+	}
+}
+
 // RegisterHostServices registers the "aule" host module functions (log, delegate, etc.)
 // into the Wasm runtime. This must be called before loading any plugins that use them.
 func (r *Runtime) RegisterHostServices(ctx context.Context, host *HostServices) error {
@@ -88,14 +189,19 @@ func (r *Runtime) LoadPlugin(ctx context.Context, name string, wasmBytes []byte,
 		compiled: compiled,
 		rt:       r.rt,
 		logger:   r.logger,
+		tracer:   r.tracer,
 	}
 
 	r.plugins[name] = plugin
+	r.grantHosts(name)
 	r.logger.Info("synapse: plugin loaded",
 		"name", name,
 		"version", meta.Version,
 		"description", meta.Description,
 	)
+	if pending := r.pendingHosts(name); len(pending) > 0 && r.onPending != nil {
+		r.onPending(name, pending)
+	}
 
 	return plugin, nil
 }
@@ -131,6 +237,9 @@ func (r *Runtime) UnloadPlugin(ctx context.Context, name string) error {
 
 	plugin.Close(ctx)
 	delete(r.plugins, name)
+	if r.proxy != nil {
+		r.proxy.SetPluginPermissions(name, nil)
+	}
 	r.logger.Info("synapse: plugin unloaded", "name", name)
 	return nil
 }
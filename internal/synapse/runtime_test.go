@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
 	"github.com/manthysbr/auleOS/internal/synapse"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -122,6 +123,49 @@ func TestPluginExecuteNoopReturnsDefault(t *testing.T) {
 	assert.Equal(t, "test-plugin", resultMap["plugin"])
 }
 
+func TestPluginExecutionTracedAndCounted(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	rt, err := synapse.NewRuntime(ctx, logger)
+	require.NoError(t, err)
+	defer rt.Close(ctx)
+	tracer := services.NewTraceCollector(logger, services.NewEventBus(logger), nil)
+	rt.SetTracer(tracer)
+
+	plugin, err := rt.LoadPlugin(ctx, "test-plugin", noopWasm, testMeta())
+	require.NoError(t, err)
+
+	traceCtx, traceID, _ := tracer.StartTrace(ctx, "chat: test", nil)
+	_, err = plugin.Execute(traceCtx, map[string]interface{}{"input": "a"})
+	require.NoError(t, err)
+	_, err = plugin.Execute(ctx, map[string]interface{}{"input": "b"}) // untraced
+	require.NoError(t, err)
+	tracer.EndTrace(traceID, domain.SpanStatusOK, "")
+
+	trace, err := tracer.GetTrace(ctx, traceID)
+	require.NoError(t, err)
+	var span *domain.Span
+	for i := range trace.Spans {
+		if trace.Spans[i].Kind == domain.SpanKindTool {
+			span = &trace.Spans[i]
+		}
+	}
+	require.NotNil(t, span)
+	assert.Equal(t, "plugin.test-plugin", span.Name)
+	assert.Equal(t, domain.SpanStatusOK, span.Status)
+	assert.Equal(t, "0.1.0", span.Attributes["plugin_version"])
+	assert.Equal(t, "0", span.Attributes["exit_code"])
+	assert.Equal(t, "65536", span.Attributes["memory_bytes"], "one page of linear memory")
+
+	stats := plugin.Stats()
+	assert.Equal(t, "test-plugin", stats.Name)
+	assert.EqualValues(t, 2, stats.Calls)
+	assert.Zero(t, stats.Errors)
+	assert.EqualValues(t, 65536, stats.MaxMemoryBytes)
+	assert.False(t, stats.LastRunAt.IsZero())
+}
+
 func TestPluginAsTool(t *testing.T) {
 	ctx := context.Background()
 	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
@@ -0,0 +1,153 @@
+// Package client is the Go SDK for the auleOS kernel API.
+//
+// The REST client in api.gen.go is generated from specs/kernel-api.yaml
+// (make gen-client). Generated clients cannot consume Server-Sent Events,
+// so the streaming endpoints are covered by the hand-written Streamer.
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Event is one Server-Sent Event from a kernel stream.
+type Event struct {
+	Type string // SSE "event:" field, e.g. "token", "done", "connected"
+	Data string // JSON payload
+}
+
+// Decode unmarshals the event payload into v.
+func (e Event) Decode(v interface{}) error {
+	return json.Unmarshal([]byte(e.Data), v)
+}
+
+// ReadEvents parses an SSE stream, calling fn for each event until the
+// stream ends, fn returns an error or the reader fails. Multi-line data
+// fields are joined with newlines; comments and ids are ignored.
+func ReadEvents(r io.Reader, fn func(Event) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	var ev Event
+	var data []string
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" {
+			if len(data) > 0 {
+				ev.Data = strings.Join(data, "\n")
+				if ev.Type == "" {
+					ev.Type = "message"
+				}
+				if err := fn(ev); err != nil {
+					return err
+				}
+			}
+			ev, data = Event{}, nil
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			ev.Type = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+	return sc.Err()
+}
+
+// Streamer consumes the kernel's SSE endpoints.
+type Streamer struct {
+	baseURL string
+	http    *http.Client
+}
+
+// NewStreamer returns a Streamer for the kernel at baseURL, e.g.
+// "http://localhost:8080". A nil hc uses a client without timeout, since
+// streams stay open; cancel the context to stop one.
+func NewStreamer(baseURL string, hc *http.Client) *Streamer {
+	if hc == nil {
+		hc = &http.Client{}
+	}
+	return &Streamer{baseURL: strings.TrimSuffix(baseURL, "/"), http: hc}
+}
+
+// Broadcast streams kernel-wide agent events (GET /v1/events).
+func (s *Streamer) Broadcast(ctx context.Context, fn func(Event) error) error {
+	return s.stream(ctx, http.MethodGet, "/v1/events", nil, fn)
+}
+
+// Conversation streams a conversation's events (GET /v1/conversations/{id}/events).
+func (s *Streamer) Conversation(ctx context.Context, id string, fn func(Event) error) error {
+	return s.stream(ctx, http.MethodGet, "/v1/conversations/"+url.PathEscape(id)+"/events", nil, fn)
+}
+
+// Workflow streams a workflow's run and step events (GET /v1/workflows/{id}/events).
+func (s *Streamer) Workflow(ctx context.Context, id string, fn func(Event) error) error {
+	return s.stream(ctx, http.MethodGet, "/v1/workflows/"+url.PathEscape(id)+"/events", nil, fn)
+}
+
+// Job streams a job's logs and status updates (GET /v1/jobs/{id}/stream).
+func (s *Streamer) Job(ctx context.Context, id string, fn func(Event) error) error {
+	return s.stream(ctx, http.MethodGet, "/v1/jobs/"+url.PathEscape(id)+"/stream", nil, fn)
+}
+
+// Chat sends a chat message and streams the reply (POST /v1/agent/chat/stream).
+// body is a ChatRequest. fn sees every event, including the final "done"
+// event with the ChatResponse; an "error" event is returned as an error.
+func (s *Streamer) Chat(ctx context.Context, body interface{}, fn func(Event) error) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("client: encode chat request: %w", err)
+	}
+	return s.stream(ctx, http.MethodPost, "/v1/agent/chat/stream", payload, func(ev Event) error {
+		if ev.Type == "error" {
+			var e struct {
+				Error string `json:"error"`
+			}
+			if ev.Decode(&e) != nil || e.Error == "" {
+				e.Error = ev.Data
+			}
+			return fmt.Errorf("client: chat failed: %s", e.Error)
+		}
+		return fn(ev)
+	})
+}
+
+func (s *Streamer) stream(ctx context.Context, method, path string, body []byte, fn func(Event) error) error {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, r)
+	if err != nil {
+		return fmt.Errorf("client: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("client: %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("client: %s %s: status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	err = ReadEvents(resp.Body, fn)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEvents(t *testing.T) {
+	stream := ": keep-alive\n\nevent: token\ndata: {\"token\":\"Hi\"}\n\ndata: line one\ndata: line two\n\n"
+	var got []Event
+	require.NoError(t, ReadEvents(strings.NewReader(stream), func(ev Event) error {
+		got = append(got, ev)
+		return nil
+	}))
+	assert.Equal(t, []Event{
+		{Type: "token", Data: `{"token":"Hi"}`},
+		{Type: "message", Data: "line one\nline two"},
+	}, got)
+
+	var tok struct{ Token string }
+	require.NoError(t, got[0].Decode(&tok))
+	assert.Equal(t, "Hi", tok.Token)
+}
+
+func TestStreamerChat(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/agent/chat/stream" {
+			http.Error(w, "conversation not found", http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "text/event-stream")
+		if strings.Contains(string(body), "fail") {
+			fmt.Fprint(w, "event: error\ndata: {\"error\":\"model offline\"}\n\n")
+			return
+		}
+		fmt.Fprint(w, "event: conversation\ndata: {\"conversation_id\":\"c1\"}\n\n")
+		fmt.Fprint(w, "event: token\ndata: {\"token\":\"Hello\"}\n\n")
+		fmt.Fprint(w, "event: done\ndata: {\"response\":\"Hello\"}\n\n")
+	}))
+	defer srv.Close()
+	s := NewStreamer(srv.URL+"/", nil)
+
+	var types []string
+	err := s.Chat(context.Background(), map[string]string{"message": "hi"}, func(ev Event) error {
+		types = append(types, ev.Type)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"conversation", "token", "done"}, types)
+
+	err = s.Chat(context.Background(), map[string]string{"message": "fail"}, func(Event) error { return nil })
+	assert.EqualError(t, err, "client: chat failed: model offline")
+
+	err = s.Conversation(context.Background(), "missing", func(Event) error { return nil })
+	assert.EqualError(t, err, "client: GET /v1/conversations/missing/events: status 404: conversation not found")
+}
@@ -0,0 +1,12 @@
+// TypeScript SDK for the auleOS kernel API. schema.d.ts is generated from
+// specs/kernel-api.yaml by `make gen-client`.
+import createClient, { type ClientOptions } from "openapi-fetch";
+import type { paths } from "./schema";
+
+export type { paths, components, operations } from "./schema";
+export * from "./sse";
+
+/** Typed REST client for every route in the kernel spec. */
+export function createAuleClient(baseUrl = "http://localhost:8080", options: Omit<ClientOptions, "baseUrl"> = {}) {
+    return createClient<paths>({ baseUrl, ...options });
+}
@@ -0,0 +1,5779 @@
+/**
+ * This file was auto-generated by openapi-typescript.
+ * Do not make direct changes to the file.
+ */
+
+export interface paths {
+    "/v1/jobs": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all jobs */
+        get: operations["ListJobs"];
+        put?: never;
+        /** Submit a new Job (AWU) */
+        post: operations["SubmitJob"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/jobs/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get user job details */
+        get: operations["GetJob"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/jobs/{id}/stream": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Stream job logs and status updates (SSE) */
+        get: operations["StreamJob"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/jobs/{id}/files/{filename}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Serve a file directly from the job workspace */
+        get: operations["ServeJobFile"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/workflows": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all workflows */
+        get: operations["ListWorkflows"];
+        put?: never;
+        /** Create a new workflow definition */
+        post: operations["CreateWorkflow"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/workflows/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get workflow details and status */
+        get: operations["GetWorkflow"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/workflows/{id}/run": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Run a workflow */
+        post: operations["RunWorkflow"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/workflows/{id}/resume": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Resume a paused workflow */
+        post: operations["ResumeWorkflow"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/agent/chat": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Chat with the Agent */
+        post: operations["AgentChat"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/conversations": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all conversations */
+        get: operations["ListConversations"];
+        put?: never;
+        /** Create a new conversation */
+        post: operations["CreateConversation"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/conversations/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get conversation details */
+        get: operations["GetConversation"];
+        put?: never;
+        post?: never;
+        /** Delete a conversation */
+        delete: operations["DeleteConversation"];
+        options?: never;
+        head?: never;
+        /** Update conversation (e.g. title) */
+        patch: operations["UpdateConversation"];
+        trace?: never;
+    };
+    "/v1/conversations/{id}/messages": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List messages in a conversation */
+        get: operations["ListMessages"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/settings": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get current settings (secrets masked) */
+        get: operations["GetSettings"];
+        /** Update settings (encrypts secrets at rest) */
+        put: operations["UpdateSettings"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/settings/test": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Test provider connection */
+        post: operations["TestConnection"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/projects": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all projects */
+        get: operations["ListProjects"];
+        put?: never;
+        /** Create a new project */
+        post: operations["CreateProject"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/projects/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get project details */
+        get: operations["GetProject"];
+        put?: never;
+        post?: never;
+        /** Delete a project */
+        delete: operations["DeleteProject"];
+        options?: never;
+        head?: never;
+        /** Update project (name, description) */
+        patch: operations["UpdateProject"];
+        trace?: never;
+    };
+    "/v1/projects/{id}/conversations": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List conversations in a project */
+        get: operations["ListProjectConversations"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/projects/{id}/artifacts": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List artifacts in a project */
+        get: operations["ListProjectArtifacts"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/artifacts": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all artifacts */
+        get: operations["ListArtifacts"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/artifacts/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get artifact details */
+        get: operations["GetArtifact"];
+        put?: never;
+        post?: never;
+        /** Delete an artifact */
+        delete: operations["DeleteArtifact"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/models": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List available models (from catalog) */
+        get: operations["ListModels"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/models/discover": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Discover models from Ollama and/or LiteLLM */
+        post: operations["DiscoverModels"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/conversations/{id}/events": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Stream conversation events including sub-agent activity (SSE) */
+        get: operations["StreamConversationEvents"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/personas": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all personas */
+        get: operations["ListPersonas"];
+        put?: never;
+        /** Create a custom persona */
+        post: operations["CreatePersona"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/personas/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get persona details */
+        get: operations["GetPersona"];
+        put?: never;
+        post?: never;
+        /** Delete a persona */
+        delete: operations["DeletePersona"];
+        options?: never;
+        head?: never;
+        /** Update persona */
+        patch: operations["UpdatePersona"];
+        trace?: never;
+    };
+    "/v1/plugins": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List loaded Synapse plugins */
+        get: operations["ListPlugins"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/capabilities": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all system capabilities (muscle + synapse) */
+        get: operations["ListCapabilities"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/agent/chat/stream": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Chat with the agent, streaming the reply (SSE)
+         * @description Emits a "conversation" event with the conversation ID, then "token"
+         *     events (and any other conversation events) while the agent works,
+         *     and finally "done" with the ChatResponse or "error".
+         *     The body also takes an optional integer "seed" for the models'
+         *     sampling; "done" carries the seed the run used, so an answer can be
+         *     reproduced by sending it back with the same message and model.
+         */
+        post: operations["StreamAgentChat"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/events": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Stream kernel-wide agent events (SSE)
+         * @description Proactive messages from heartbeat, cron, spawn and other background activity.
+         */
+        get: operations["StreamBroadcastEvents"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/workflows/{id}/events": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Stream workflow and step events (SSE) */
+        get: operations["StreamWorkflowEvents"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/tasks": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List scheduled tasks */
+        get: operations["ListScheduledTasks"];
+        put?: never;
+        /** Create a scheduled task */
+        post: operations["CreateScheduledTask"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/tasks/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Delete a scheduled task */
+        delete: operations["DeleteScheduledTask"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/tasks/{id}/toggle": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Toggle a scheduled task between active and paused */
+        post: operations["ToggleScheduledTask"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/tools": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List registered tools with their parameter schemas */
+        get: operations["ListTools"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/tools/{name}/run": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Execute a tool
+         * @description The name may be a full name or an alias. The slash of a full name is
+         *     percent-encoded: /v1/tools/forge%2Fcsv_to_json/run.
+         */
+        post: operations["RunTool"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/traces": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List recent traces, including persisted history */
+        get: operations["ListTraces"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/traces/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get a trace with all its spans */
+        get: operations["GetTrace"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/spans": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Search spans across all traces */
+        get: operations["SearchSpans"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/workers": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List workers */
+        get: operations["ListWorkers"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/capabilities/{name}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        /** Override the runtime of a capability */
+        put: operations["UpdateCapability"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/system/pause": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Pause the scheduler, cron, heartbeat and new chats
+         * @description Running work finishes. While paused, requests that start a chat, job
+         *     or workflow run are refused with 503.
+         */
+        post: operations["PauseSystem"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/system/resume": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Leave maintenance mode; queued jobs and due tasks resume */
+        post: operations["ResumeSystem"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/system/status": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Report whether the system is paused */
+        get: operations["GetMaintenanceStatus"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/system/stats": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Host CPU, memory and disk usage, database size and Docker stats */
+        get: operations["GetSystemStats"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/system/logs": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Query recent kernel log records, newest first */
+        get: operations["QueryLogs"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/system/sse": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Open event streams and their limits */
+        get: operations["GetSSEStats"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/system/version": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Kernel build and, when update checks are on, the last check */
+        get: operations["GetVersion"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/system/diagnostics": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Build a diagnostics bundle to attach to bug reports
+         * @description A zip of recent kernel logs, the latest failed traces, the settings
+         *     with secrets stripped, version info and the health of the host,
+         *     Docker and Ollama. Sections that cannot be collected are listed in
+         *     manifest.json instead.
+         */
+        post: operations["CreateDiagnosticsBundle"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/system/inbox": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Unread count and last message of the kernel inbox */
+        get: operations["GetKernelInbox"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/nodes": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List registered muscle nodes */
+        get: operations["ListNodes"];
+        put?: never;
+        /** Register a remote muscle node */
+        post: operations["RegisterNode"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/nodes/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Unregister a node */
+        delete: operations["DeleteNode"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/feeds": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List watched RSS/Atom feeds */
+        get: operations["ListFeeds"];
+        put?: never;
+        /**
+         * Watch a feed after fetching it once
+         * @description New items run the prompt, start a copy of the workflow, or both.
+         */
+        post: operations["WatchFeed"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/feeds/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Stop watching a feed */
+        delete: operations["UnwatchFeed"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/feeds/{id}/toggle": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Pause or resume a feed */
+        post: operations["ToggleFeed"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/automations": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List automation rules */
+        get: operations["ListAutomations"];
+        put?: never;
+        /** Create an automation rule */
+        post: operations["CreateAutomation"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/automations/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get an automation rule */
+        get: operations["GetAutomation"];
+        put?: never;
+        post?: never;
+        /** Delete a rule and its feed or file watch */
+        delete: operations["DeleteAutomation"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/automations/{id}/toggle": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Enable or disable a rule */
+        post: operations["ToggleAutomation"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/automations/{id}/run": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Fire a rule now, ignoring its conditions */
+        post: operations["RunAutomation"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/hooks": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * List webhook rules with their URLs
+         * @description Hooks are deleted and toggled through /v1/automations/{id}.
+         */
+        get: operations["ListHooks"];
+        put?: never;
+        /**
+         * Create a hook running a prompt or a workflow
+         * @description Exactly one of prompt and workflow_id is required. The body of each
+         *     call to the hook's URL is available as {{params.payload}}.
+         */
+        post: operations["CreateHook"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/evals/suites": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List eval suites */
+        get: operations["ListEvalSuites"];
+        put?: never;
+        /** Create an eval suite */
+        post: operations["CreateEvalSuite"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/evals/suites/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get an eval suite */
+        get: operations["GetEvalSuite"];
+        /** Replace an eval suite */
+        put: operations["UpdateEvalSuite"];
+        post?: never;
+        /** Delete an eval suite and its runs */
+        delete: operations["DeleteEvalSuite"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/evals/run": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Run a suite against personas and models
+         * @description One run is started per persona × model combination.
+         */
+        post: operations["RunEvals"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/evals/runs": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List eval runs, newest first, without per-case results */
+        get: operations["ListEvalRuns"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/evals/runs/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Scored report of one eval run */
+        get: operations["GetEvalRun"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/evals/runs/{id}/diff": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Compare an eval run with a baseline run of the same suite */
+        get: operations["DiffEvalRuns"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/settings/embeddings": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Embedding provider config (API key masked) */
+        get: operations["GetEmbeddingSettings"];
+        /** Replace the embedding provider config */
+        put: operations["UpdateEmbeddingSettings"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/settings/runtime": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Hot-reloadable kernel settings */
+        get: operations["GetRuntimeSettings"];
+        /**
+         * Replace the runtime settings; they apply without a restart
+         * @description Omitted or zero fields fall back to the startup defaults.
+         */
+        put: operations["UpdateRuntimeSettings"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/settings/image-backends": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        /**
+         * Replace the extra image backends
+         * @description The primary backend stays under /v1/settings. A masked or empty
+         *     api_key keeps the stored key.
+         */
+        put: operations["UpdateImageBackends"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/image/backends": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Probe every configured image backend */
+        get: operations["ListImageBackendHealth"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/settings/search": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Web search config (API keys masked) */
+        get: operations["GetSearchSettings"];
+        /**
+         * Replace the web search backends, tried in order
+         * @description A masked or empty api_key keeps the stored key of the backend with the same name.
+         */
+        put: operations["UpdateSearchSettings"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/settings/email": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** SMTP and IMAP config (passwords masked) */
+        get: operations["GetEmailSettings"];
+        /**
+         * Replace the email settings
+         * @description Masked or empty passwords keep the stored ones.
+         */
+        put: operations["UpdateEmailSettings"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/settings/calendar": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Calendar config (secrets masked) */
+        get: operations["GetCalendarSettings"];
+        /**
+         * Replace the calendar settings
+         * @description Masked or empty secrets keep the stored ones.
+         */
+        put: operations["UpdateCalendarSettings"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/workers/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Stored worker record merged with live container inspection */
+        get: operations["GetWorker"];
+        put?: never;
+        post?: never;
+        /** Force-kill a worker's container and mark the worker exited */
+        delete: operations["KillWorker"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/v1/workers/{id}/logs": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Last lines a worker wrote, without following
+         * @description Logs of exited workers stay readable while their container exists.
+         */
+        get: operations["GetWorkerLogs"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+}
+export type webhooks = Record<string, never>;
+export interface components {
+    schemas: {
+        ChatRequest: {
+            /** @example Run a python script that prints hello */
+            message: string;
+            /** @example llama3 */
+            model?: string;
+            /** @description Optional. If omitted, a new conversation is created automatically. */
+            conversation_id?: string;
+            /** @description Optional persona ID to use for this chat. Sets the agent personality and tool filter. */
+            persona_id?: string;
+        };
+        ChatResponse: {
+            response?: string;
+            /** @description Chain of thought or reasoning trace */
+            thought?: string;
+            /** @description The conversation this message belongs to */
+            conversation_id?: string;
+            tool_call?: {
+                name?: string;
+                args?: Record<string, never>;
+            };
+            steps?: components["schemas"]["ReActStep"][];
+        };
+        ReActStep: {
+            thought?: string;
+            action?: string;
+            action_input?: {
+                [key: string]: unknown;
+            };
+            observation?: string;
+            is_final_answer?: boolean;
+            final_answer?: string;
+        };
+        JobRequest: {
+            /** @example python:3.10-slim */
+            image: string;
+            /**
+             * @example [
+             *       "python",
+             *       "-c",
+             *       "print('Hello World')"
+             *     ]
+             */
+            command: string[];
+            env?: {
+                [key: string]: string;
+            };
+            resources?: components["schemas"]["Resources"];
+        };
+        JobResponse: {
+            id?: string;
+            status?: string;
+        };
+        Job: {
+            id?: string;
+            status?: string;
+            result?: string;
+            error?: string;
+            /** Format: date-time */
+            created_at?: string;
+        };
+        Resources: {
+            cpu?: number;
+            memory_mb?: number;
+        };
+        Error: {
+            error?: string;
+        };
+        Conversation: {
+            id?: string;
+            title?: string;
+            /** @description Optional project this conversation belongs to */
+            project_id?: string;
+            /** @description Optional persona used in this conversation */
+            persona_id?: string;
+            /** Format: date-time */
+            created_at?: string;
+            /** Format: date-time */
+            updated_at?: string;
+        };
+        Message: {
+            id?: string;
+            conversation_id?: string;
+            /** @enum {string} */
+            role?: "user" | "assistant" | "system" | "tool" | "kernel";
+            content?: string;
+            thought?: string;
+            steps?: components["schemas"]["ReActStep"][];
+            tool_call?: {
+                name?: string;
+                args?: Record<string, never>;
+            };
+            /** @description Free-form annotations, e.g. kind for kernel notifications */
+            metadata?: {
+                [key: string]: unknown;
+            };
+            /** Format: date-time */
+            created_at?: string;
+        };
+        ProviderConfig: {
+            /**
+             * @description 'local' for Ollama/ComfyUI, 'remote' for OpenAI-compatible API
+             * @enum {string}
+             */
+            mode?: "local" | "remote";
+            /** @example http://localhost:11434/v1 */
+            local_url?: string;
+            /** @example https://api.openai.com/v1 */
+            remote_url?: string;
+            /** @description Masked on read (****xxxx), plaintext on write. Empty string keeps existing. */
+            api_key?: string;
+            /** @example gemma3:12b */
+            default_model?: string;
+        };
+        AppConfig: {
+            providers?: {
+                llm?: components["schemas"]["ProviderConfig"];
+                image?: components["schemas"]["ProviderConfig"];
+            };
+        };
+        ConnectionTestResult: {
+            provider?: string;
+            mode?: string;
+            url?: string;
+            model?: string;
+            /** @enum {string} */
+            status?: "ok" | "error";
+            message?: string;
+        };
+        Project: {
+            id?: string;
+            name?: string;
+            description?: string;
+            /** Format: date-time */
+            created_at?: string;
+            /** Format: date-time */
+            updated_at?: string;
+        };
+        Artifact: {
+            id?: string;
+            project_id?: string;
+            job_id?: string;
+            conversation_id?: string;
+            /** @enum {string} */
+            type?: "image" | "text" | "document" | "audio" | "video" | "other";
+            name?: string;
+            file_path?: string;
+            mime_type?: string;
+            /** Format: int64 */
+            size_bytes?: number;
+            prompt?: string;
+            /** Format: date-time */
+            created_at?: string;
+        };
+        Persona: {
+            id?: string;
+            name?: string;
+            description?: string;
+            system_prompt?: string;
+            /** @description Lucide icon name */
+            icon?: string;
+            /** @description Tailwind color token (e.g. blue, emerald, violet) */
+            color?: string;
+            /** @description Tool names this persona can use. Empty means all tools. */
+            allowed_tools?: string[];
+            /** @description Model to use for this persona. Empty means use system default. */
+            model_override?: string;
+            is_builtin?: boolean;
+            /** Format: date-time */
+            created_at?: string;
+            /** Format: date-time */
+            updated_at?: string;
+        };
+        ModelSpec: {
+            /** @example qwen2.5:3b */
+            id?: string;
+            /** @example Qwen 2.5 3B */
+            name?: string;
+            /** @example ollama */
+            provider?: string;
+            /** @enum {string} */
+            role?: "general" | "code" | "creative" | "fast";
+            /** @example 3B */
+            size?: string;
+            base_url?: string;
+            is_local?: boolean;
+            /** @description Context window the server runs the model with; absent when unknown */
+            context_tokens?: number;
+            /** @description Context window the model was trained for */
+            max_context_tokens?: number;
+            /** @example Q4_K_M */
+            quantization?: string;
+            /** @example qwen2 */
+            family?: string;
+            vision?: boolean;
+            tools?: boolean;
+            embedding?: boolean;
+            /**
+             * Format: double
+             * @description USD per million prompt tokens; absent for local models
+             */
+            prompt_cost_per_mtok?: number;
+            /**
+             * Format: double
+             * @description USD per million completion tokens; absent for local models
+             */
+            completion_cost_per_mtok?: number;
+        };
+        ModelWarmStatus: {
+            model: string;
+            /** @enum {string} */
+            state: "loading" | "warm" | "failed";
+            /**
+             * Format: date-time
+             * @description When the model last went from cold to warm
+             */
+            loaded_at?: string;
+            /**
+             * Format: int64
+             * @description How long that load took
+             */
+            load_ms?: number;
+            /** Format: date-time */
+            last_ping_at?: string;
+            /**
+             * Format: date-time
+             * @description When the model unloads without another ping or use
+             */
+            expires_at?: string;
+            error?: string;
+        };
+        ModelListResponse: {
+            models: components["schemas"]["ModelSpec"][];
+            count: number;
+            /** @description Preloading status of each kept-warm model; empty when warm-up is off */
+            warmup: components["schemas"]["ModelWarmStatus"][];
+        };
+        SubAgentEvent: {
+            sub_agent_id?: string;
+            parent_id?: string;
+            conversation_id?: string;
+            persona_name?: string;
+            persona_color?: string;
+            persona_icon?: string;
+            model_id?: string;
+            /** @enum {string} */
+            status?: "pending" | "running" | "done" | "failed";
+            thought?: string;
+            result?: string;
+            error?: string;
+        };
+        Plugin: {
+            /** @example prompt-enhancer */
+            name?: string;
+            /** @example 1.0.0 */
+            version?: string;
+            /** @example Enhances image prompts with quality keywords */
+            description?: string;
+            /** @example enhance_prompt */
+            tool_name?: string;
+            /** @example synapse */
+            runtime?: string;
+        };
+        PluginListResponse: {
+            plugins?: components["schemas"]["Plugin"][];
+            count?: number;
+        };
+        Capability: {
+            /** @example image.generate */
+            capability?: string;
+            /** @enum {string} */
+            runtime?: "muscle" | "synapse";
+            description?: string;
+            /** @description Runtime overridden through UpdateCapability */
+            pinned?: boolean;
+            /** @description Fell back to muscle after repeated synapse failures */
+            demoted?: boolean;
+            avg_latency_ms?: number;
+            failure_rate?: number;
+            stats?: components["schemas"]["RouteStats"];
+        };
+        RouteStats: {
+            executions?: number;
+            failures?: number;
+            /** Format: int64 */
+            total_latency_ms?: number;
+            last_error?: string;
+            /** Format: date-time */
+            last_run_at?: string;
+        };
+        CapabilityStats: {
+            muscle?: number;
+            synapse?: number;
+            total?: number;
+        };
+        CapabilityListResponse: {
+            capabilities?: components["schemas"]["Capability"][];
+            stats?: components["schemas"]["CapabilityStats"];
+        };
+        Workflow: {
+            id?: string;
+            project_id?: string;
+            name?: string;
+            description?: string;
+            /** @enum {string} */
+            status?: "pending" | "running" | "paused" | "completed" | "failed" | "cancelled";
+            state?: {
+                [key: string]: unknown;
+            };
+            steps?: components["schemas"]["WorkflowStep"][];
+            /** Format: date-time */
+            created_at?: string;
+            /** Format: date-time */
+            started_at?: string;
+            /** Format: date-time */
+            completed_at?: string;
+            error?: string;
+        };
+        WorkflowStep: {
+            id?: string;
+            persona_id?: string;
+            prompt?: string;
+            tools?: string[];
+            depends_on?: string[];
+            /** @enum {string} */
+            status?: "pending" | "running" | "done" | "failed" | "skipped";
+            result?: {
+                output?: string;
+                metadata?: Record<string, never>;
+            };
+            interrupt?: {
+                before?: boolean;
+                after?: boolean;
+                message?: string;
+            };
+        };
+        ScheduledTask: {
+            readonly id?: string;
+            project_id?: string;
+            name?: string;
+            /** @description Instruction run through the ReAct agent */
+            prompt?: string;
+            /** @description Direct command; bypasses the LLM when set */
+            command?: string;
+            /** @description Send the result to the user */
+            deliver?: boolean;
+            persona_id?: string;
+            /**
+             * @default one_shot
+             * @enum {string}
+             */
+            type: "one_shot" | "recurring" | "cron";
+            /** @example 0 9 * * 1-5 */
+            cron_expr?: string;
+            interval_sec?: number;
+            /** Format: date-time */
+            next_run?: string;
+            /** Format: date-time */
+            last_run?: string;
+            last_result?: string;
+            readonly run_count?: number;
+            /**
+             * @default active
+             * @enum {string}
+             */
+            status: "active" | "paused" | "completed" | "failed";
+            /** Format: date-time */
+            readonly created_at?: string;
+            /** @example user */
+            created_by?: string;
+        };
+        ScheduledTaskListResponse: {
+            tasks?: components["schemas"]["ScheduledTask"][];
+            count?: number;
+        };
+        Tool: {
+            /**
+             * @description Full name, "namespace/name" for non built-in tools
+             * @example forge/csv_to_json
+             */
+            name?: string;
+            /** @example forge */
+            namespace?: string;
+            aliases?: string[];
+            description?: string;
+            /** @description JSON Schema of the tool parameters */
+            parameters?: {
+                [key: string]: unknown;
+            };
+            /** @enum {string} */
+            execution_type?: "native" | "wasm" | "docker";
+        };
+        ToolListResponse: {
+            tools?: components["schemas"]["Tool"][];
+            count?: number;
+        };
+        ToolRunResult: {
+            ok?: boolean;
+            tool?: string;
+            result?: unknown;
+            error?: string;
+            /** Format: int64 */
+            duration_ms?: number;
+        };
+        /** @enum {string} */
+        SpanKind: "agent" | "llm" | "tool" | "sub_agent" | "workflow" | "step";
+        /** @enum {string} */
+        SpanStatus: "running" | "ok" | "error" | "cancelled";
+        Span: {
+            id?: string;
+            parent_id?: string;
+            trace_id?: string;
+            /** @example tool.web_search */
+            name?: string;
+            kind?: components["schemas"]["SpanKind"];
+            status?: components["schemas"]["SpanStatus"];
+            input?: string;
+            output?: string;
+            error?: string;
+            model?: string;
+            attributes?: {
+                [key: string]: string;
+            };
+            /** Format: date-time */
+            start_time?: string;
+            /** Format: date-time */
+            end_time?: string;
+            /** Format: int64 */
+            duration_ms?: number;
+            children?: string[];
+            metadata?: {
+                [key: string]: unknown;
+            };
+        };
+        Trace: {
+            id?: string;
+            root_span_id?: string;
+            /** @example chat: hello world */
+            name?: string;
+            status?: components["schemas"]["SpanStatus"];
+            conversation_id?: string;
+            persona_id?: string;
+            request_id?: string;
+            /** Format: date-time */
+            start_time?: string;
+            /** Format: date-time */
+            end_time?: string;
+            /** Format: int64 */
+            duration_ms?: number;
+            span_count?: number;
+            spans?: components["schemas"]["Span"][];
+        };
+        TraceListResponse: {
+            /** @description Summaries; spans are only returned by GetTrace */
+            traces?: components["schemas"]["Trace"][];
+            count?: number;
+        };
+        SpanListResponse: {
+            spans?: components["schemas"]["Span"][];
+            count?: number;
+        };
+        Worker: {
+            id?: string;
+            spec?: components["schemas"]["WorkerSpec"];
+            /** @enum {string} */
+            status?: "UNKNOWN" | "STARTING" | "HEALTHY" | "UNHEALTHY" | "EXITED";
+            /** Format: date-time */
+            created_at?: string;
+            /** Format: date-time */
+            updated_at?: string;
+            metadata?: {
+                [key: string]: string;
+            };
+        };
+        WorkerSpec: {
+            image?: string;
+            command?: string[];
+            env?: {
+                [key: string]: string;
+            };
+            /**
+             * Format: double
+             * @example 0.5
+             */
+            resource_cpu?: number;
+            /**
+             * Format: int64
+             * @description Bytes
+             */
+            resource_mem?: number;
+            tags?: {
+                [key: string]: string;
+            };
+            node_selector?: {
+                [key: string]: string;
+            };
+        };
+        WorkerListResponse: {
+            workers?: components["schemas"]["Worker"][];
+            count?: number;
+        };
+        MaintenanceStatus: {
+            paused: boolean;
+            reason?: string;
+            /** Format: date-time */
+            since?: string;
+        };
+        /** @description One sample of host and runtime resources. Sections that could not be read are omitted and explained in errors. */
+        SystemStats: {
+            /** Format: date-time */
+            sampled_at: string;
+            cpu?: components["schemas"]["CPUStats"];
+            memory?: components["schemas"]["MemoryStats"];
+            disks: components["schemas"]["DiskStats"][];
+            /** Format: int64 */
+            db_size_bytes: number;
+            docker?: components["schemas"]["ContainerRuntimeStats"];
+            warnings: string[];
+            /** @description Why a section is missing, by section */
+            errors?: {
+                [key: string]: string;
+            };
+        };
+        CPUStats: {
+            cores: number;
+            /**
+             * Format: double
+             * @description Since the previous sample; 0 on the first one
+             */
+            usage_percent: number;
+            /** Format: double */
+            load_1: number;
+            /** Format: double */
+            load_5: number;
+            /** Format: double */
+            load_15: number;
+        };
+        MemoryStats: {
+            /** Format: int64 */
+            total_bytes: number;
+            /** Format: int64 */
+            available_bytes: number;
+            /** Format: int64 */
+            used_bytes: number;
+            /** Format: double */
+            used_percent: number;
+        };
+        DiskStats: {
+            /** @example workspace */
+            label: string;
+            path: string;
+            /** Format: int64 */
+            total_bytes: number;
+            /** Format: int64 */
+            free_bytes: number;
+            /** Format: int64 */
+            used_bytes: number;
+            /** Format: double */
+            used_percent: number;
+        };
+        ContainerRuntimeStats: {
+            available: boolean;
+            error?: string;
+            version?: string;
+            containers: number;
+            containers_running: number;
+            containers_paused: number;
+            containers_stopped: number;
+            images: number;
+            cpus: number;
+            /** Format: int64 */
+            memory_bytes: number;
+        };
+        LogEntry: {
+            /** Format: date-time */
+            time: string;
+            /** @example WARN */
+            level: string;
+            msg: string;
+            component?: string;
+            request_id?: string;
+            attrs?: {
+                [key: string]: unknown;
+            };
+        };
+        LogListResponse: {
+            logs: components["schemas"]["LogEntry"][];
+            count: number;
+        };
+        SSEStats: {
+            open: number;
+            by_stream: {
+                [key: string]: number;
+            };
+            max_conns: number;
+            max_per_client: number;
+            /** Format: double */
+            heartbeat_seconds: number;
+            /** Format: double */
+            idle_timeout_seconds: number;
+            /** Format: int64 */
+            opened_total: number;
+            /** Format: int64 */
+            rejected_total: number;
+            /** Format: int64 */
+            idled_total: number;
+            /** Format: int64 */
+            pings_total: number;
+            connections: components["schemas"]["SSEConnection"][];
+        };
+        SSEConnection: {
+            /** Format: int64 */
+            id: number;
+            /** @enum {string} */
+            stream: "job" | "conversation" | "workflow" | "broadcast" | "chat" | "model_pull";
+            /** @description Client address, masked */
+            client: string;
+            /** Format: date-time */
+            since: string;
+            /** Format: date-time */
+            last_event: string;
+            /** Format: int64 */
+            events: number;
+        };
+        VersionInfo: {
+            /**
+             * @description Release tag, or dev
+             * @example v0.9.1
+             */
+            version: string;
+            git_sha?: string;
+            /** @description Built with uncommitted changes */
+            dirty?: boolean;
+            /** @description RFC 3339 */
+            build_date?: string;
+            /** @description RFC 3339 */
+            commit_time?: string;
+            go_version: string;
+            os: string;
+            arch: string;
+            /** @description Database schema of this build */
+            schema_version: number;
+            update?: components["schemas"]["UpdateInfo"];
+        };
+        UpdateInfo: {
+            current: string;
+            latest?: string;
+            /** @description latest is newer than current */
+            available: boolean;
+            url?: string;
+            /** Format: date-time */
+            published_at?: string;
+            /** Format: date-time */
+            checked_at: string;
+            /** @description Why the last check failed */
+            error?: string;
+        };
+        KernelInboxStatus: {
+            conversation_id: string;
+            /** @description Kernel messages since the user last wrote */
+            unread_count: number;
+            last_message?: components["schemas"]["Message"];
+        };
+        Node: {
+            id: string;
+            name: string;
+            /** @example https://gpu-box:8443 */
+            url: string;
+            labels: {
+                [key: string]: string;
+            };
+            /** @enum {string} */
+            status: "unknown" | "online" | "offline";
+            /** Format: date-time */
+            last_seen?: string;
+            /** Format: date-time */
+            created_at: string;
+        };
+        NodeListResponse: {
+            nodes: components["schemas"]["Node"][];
+            count: number;
+        };
+        FeedWatchRequest: {
+            url: string;
+            name?: string;
+            /** @description Instruction run with the new items appended */
+            prompt?: string;
+            /** @description Template workflow; {{state.feed_items}} holds the new items */
+            workflow_id?: string;
+            persona_id?: string;
+            /** @description Polling interval; 0 = 900, at least 300 */
+            interval_sec?: number;
+            project_id?: string;
+        };
+        FeedWatch: {
+            id: string;
+            project_id: string;
+            name: string;
+            url: string;
+            prompt?: string;
+            workflow_id?: string;
+            /** @description Rule the new items are dispatched to instead */
+            automation_id?: string;
+            persona_id?: string;
+            interval_sec: number;
+            paused: boolean;
+            /** Format: date-time */
+            last_checked?: string;
+            last_error?: string;
+            trigger_count: number;
+            /** Format: date-time */
+            created_at: string;
+        };
+        FeedListResponse: {
+            feeds: components["schemas"]["FeedWatch"][];
+            count: number;
+        };
+        /** @description When a rule fires. Only the fields of kind are used. */
+        AutomationTrigger: {
+            /** @enum {string} */
+            kind: "cron" | "webhook" | "feed" | "file";
+            /** @example 0 9 * * * */
+            cron_expr?: string;
+            /** @description Sent as X-Automation-Token or ?token= */
+            readonly webhook_token?: string;
+            feed_url?: string;
+            feed_interval_sec?: number;
+            path?: string;
+            patterns?: string[];
+            recursive?: boolean;
+            file_events?: ("create" | "write" | "remove" | "rename")[];
+            debounce_sec?: number;
+            /** @description Backing feed or file trigger */
+            readonly source_id?: string;
+        };
+        /** @description Compares a field of the event's data with value. */
+        AutomationCondition: {
+            field: string;
+            /** @enum {string} */
+            op: "equals" | "not_equals" | "contains" | "not_contains" | "matches";
+            value: string;
+        };
+        /** @description What a rule does. Prompt and tool argument strings may reference event data as {{event.<field>}}. */
+        AutomationAction: {
+            /** @enum {string} */
+            kind: "prompt" | "workflow" | "tool";
+            prompt?: string;
+            workflow_id?: string;
+            tool?: string;
+            args?: {
+                [key: string]: unknown;
+            };
+            persona_id?: string;
+        };
+        AutomationRequest: {
+            name?: string;
+            project_id?: string;
+            /** @default true */
+            enabled: boolean;
+            trigger: components["schemas"]["AutomationTrigger"];
+            conditions?: components["schemas"]["AutomationCondition"][];
+            action: components["schemas"]["AutomationAction"];
+        };
+        Automation: {
+            id: string;
+            project_id: string;
+            name: string;
+            enabled: boolean;
+            trigger: components["schemas"]["AutomationTrigger"];
+            conditions?: components["schemas"]["AutomationCondition"][];
+            action: components["schemas"]["AutomationAction"];
+            /**
+             * Format: date-time
+             * @description Cron triggers
+             */
+            next_run?: string;
+            /** Format: date-time */
+            last_run?: string;
+            last_result?: string;
+            last_error?: string;
+            run_count: number;
+            /** Format: date-time */
+            created_at: string;
+            /** @enum {string} */
+            created_by?: "agent" | "user";
+        };
+        AutomationListResponse: {
+            automations: components["schemas"]["Automation"][];
+            count: number;
+        };
+        HookRequest: {
+            name?: string;
+            project_id?: string;
+            /** @example CI failed: {{params.payload}} */
+            prompt?: string;
+            workflow_id?: string;
+            persona_id?: string;
+            conditions?: components["schemas"]["AutomationCondition"][];
+        };
+        Hook: {
+            /** @example /v1/hooks/3f9c... */
+            url: string;
+            automation: components["schemas"]["Automation"];
+        };
+        HookListResponse: {
+            hooks: components["schemas"]["Hook"][];
+            count: number;
+        };
+        EvalAssertion: {
+            /** @enum {string} */
+            type: "contains" | "not_contains" | "regex" | "equals" | "max_steps";
+            value: string;
+        };
+        EvalCase: {
+            name: string;
+            prompt: string;
+            /** @description Every tool must be called at least once */
+            expected_tools?: string[];
+            assertions?: components["schemas"]["EvalAssertion"][];
+        };
+        EvalSuite: {
+            readonly id?: string;
+            name: string;
+            description?: string;
+            cases: components["schemas"]["EvalCase"][];
+            /** Format: date-time */
+            readonly created_at?: string;
+            /** Format: date-time */
+            readonly updated_at?: string;
+        };
+        EvalSuiteListResponse: {
+            suites: components["schemas"]["EvalSuite"][];
+            count: number;
+        };
+        EvalRunRequest: {
+            suite_id: string;
+            persona_ids?: string[];
+            models?: string[];
+            /** @description Answer once every run has finished */
+            wait?: boolean;
+        };
+        EvalCaseResult: {
+            case: string;
+            passed: boolean;
+            /**
+             * Format: double
+             * @description Passed checks / total checks
+             */
+            score: number;
+            output: string;
+            tools_used: string[];
+            failures?: string[];
+            error?: string;
+            conversation_id?: string;
+            /** Format: int64 */
+            duration_ms: number;
+        };
+        EvalRun: {
+            id: string;
+            suite_id: string;
+            persona_id?: string;
+            model?: string;
+            /** @enum {string} */
+            status: "running" | "completed" | "failed";
+            /**
+             * Format: double
+             * @description Mean case score in [0,1]
+             */
+            score: number;
+            passed: number;
+            total: number;
+            results?: components["schemas"]["EvalCaseResult"][];
+            error?: string;
+            /** Format: date-time */
+            started_at: string;
+            /** Format: date-time */
+            finished_at?: string;
+        };
+        EvalRunListResponse: {
+            runs: components["schemas"]["EvalRun"][];
+            count: number;
+        };
+        EvalCaseDiff: {
+            case: string;
+            /** @enum {string} */
+            change: "regressed" | "improved" | "unchanged" | "added" | "removed";
+            /** Format: double */
+            base_score: number;
+            /** Format: double */
+            score: number;
+            /** Format: double */
+            score_delta: number;
+        };
+        EvalDiff: {
+            base_run_id: string;
+            run_id: string;
+            /** Format: double */
+            base_score: number;
+            /** Format: double */
+            score: number;
+            /** Format: double */
+            score_delta: number;
+            regressions: number;
+            improvements: number;
+            cases: components["schemas"]["EvalCaseDiff"][];
+        };
+        EmbeddingProviderConfig: {
+            /** @enum {string} */
+            mode?: "local" | "remote";
+            /** @example http://localhost:11434/v1 */
+            local_url?: string;
+            /** @example https://api.openai.com/v1 */
+            remote_url?: string;
+            /** @description Masked on read (****xxxx), plaintext on write. Empty string keeps existing. */
+            api_key?: string;
+            /** @example nomic-embed-text */
+            model?: string;
+        };
+        ImageBackendConfig: {
+            /** @description Unique; jobs select a backend by name with "backend" metadata */
+            name?: string;
+            /**
+             * @description ComfyUI (local) or an OpenAI-compatible API (remote)
+             * @enum {string}
+             */
+            mode?: "local" | "remote";
+            url?: string;
+            /** @description Masked on read (****xxxx), plaintext on write. Empty string keeps existing. */
+            api_key?: string;
+            model?: string;
+            /** @description Other models this backend serves */
+            models?: string[];
+        };
+        ImageBackendHealth: {
+            name: string;
+            mode: string;
+            url: string;
+            model: string;
+            healthy: boolean;
+            error?: string;
+        };
+        ImageBackendHealthListResponse: {
+            backends: components["schemas"]["ImageBackendHealth"][];
+            count: number;
+        };
+        /** @description With no backends, Brave (if BRAVE_SEARCH_API_KEY is set) and then DuckDuckGo are used. */
+        SearchProviderConfig: {
+            /** @description Default result count; 0 = 5 */
+            max_results?: number;
+            backends?: components["schemas"]["SearchBackendConfig"][];
+        };
+        SearchBackendConfig: {
+            name: string;
+            /** @enum {string} */
+            kind: "searxng" | "brave" | "tavily" | "duckduckgo";
+            /** @description SearxNG instance; optional API base override for the others */
+            url?: string;
+            /** @description Brave and Tavily; masked in responses */
+            api_key?: string;
+            /** @description 0 = unlimited */
+            requests_per_minute?: number;
+        };
+        EmailConfig: {
+            smtp?: components["schemas"]["SMTPConfig"];
+            imap?: components["schemas"]["IMAPConfig"];
+        };
+        SMTPConfig: {
+            /** @description Empty disables email */
+            host?: string;
+            /** @description 587 (STARTTLS) or 465 (implicit TLS); 0 = 587 */
+            port?: number;
+            /** @description Empty = no AUTH */
+            username?: string;
+            /** @description Masked on read (****xxxx), plaintext on write. Empty string keeps existing. */
+            password?: string;
+            /** @description Sender address; defaults to username */
+            from?: string;
+            /** @description Recipient when the agent names none */
+            default_to?: string;
+            /** @description Addresses or "@domain"; empty = any */
+            allowed_recipients?: string[];
+        };
+        IMAPConfig: {
+            enabled?: boolean;
+            host?: string;
+            /** @description 0 = 993, or 143 when insecure */
+            port?: number;
+            username?: string;
+            /** @description Masked on read (****xxxx), plaintext on write. Empty string keeps existing. */
+            password?: string;
+            /** @description Default INBOX */
+            mailbox?: string;
+            /** @description Plain TCP, for local bridges only */
+            insecure?: boolean;
+            /** @description 0 = 60 */
+            poll_interval_sec?: number;
+            /** @description Addresses or "@domain"; mail from anyone else is ignored */
+            allowed_senders?: string[];
+            /** @description Answer each email with the agent's reply */
+            reply?: boolean;
+            persona_id?: string;
+        };
+        CalendarConfig: {
+            /**
+             * @description Empty disables the calendar tools
+             * @enum {string}
+             */
+            kind?: "" | "caldav" | "google";
+            /**
+             * @description IANA zone for times given without an offset; default is the server's
+             * @example America/Sao_Paulo
+             */
+            timezone?: string;
+            caldav?: components["schemas"]["CalDAVConfig"];
+            google?: components["schemas"]["GoogleCalendarConfig"];
+        };
+        CalDAVConfig: {
+            /**
+             * @description Calendar collection
+             * @example https://dav.example.com/calendars/me/work/
+             */
+            url?: string;
+            username?: string;
+            /** @description Masked on read (****xxxx), plaintext on write. Empty string keeps existing. */
+            password?: string;
+        };
+        GoogleCalendarConfig: {
+            /** @description Default primary */
+            calendar_id?: string;
+            client_id?: string;
+            /** @description Masked on read (****xxxx), plaintext on write. Empty string keeps existing. */
+            client_secret?: string;
+            /** @description Masked on read (****xxxx), plaintext on write. Empty string keeps existing. */
+            refresh_token?: string;
+        };
+        /** @description Kernel settings that apply without a restart. Zero values keep the startup defaults. */
+        RuntimeConfig: {
+            max_concurrent_jobs?: number;
+            cors_origins?: string[];
+            /** @description Tools never offered to the agent */
+            disabled_tools?: string[];
+            /** @description 0 = keep persisted traces forever */
+            trace_retention_days?: number;
+            plugin_dir?: string;
+            /** @description Refuse fuzzy-matched tool names */
+            strict_tool_names?: boolean;
+            /**
+             * @description Language of the agent's instructions and messages; empty = detect from each message
+             * @example pt
+             */
+            locale?: string;
+            /** @description Store raw LLM provider requests and responses (masked) on each call's trace */
+            record_provider_calls?: boolean;
+            /** @description By route class (chat, jobs, admin, default) */
+            rate_limits?: {
+                [key: string]: components["schemas"]["RateLimit"];
+            };
+            /** @description API key → route class → limit */
+            key_rate_limits?: {
+                [key: string]: {
+                    [key: string]: components["schemas"]["RateLimit"];
+                };
+            };
+            redaction?: components["schemas"]["RedactionPolicy"];
+            moderation?: components["schemas"]["ModerationConfig"];
+            images?: components["schemas"]["ImagePolicy"];
+            chat_artifacts?: components["schemas"]["ChatArtifactPolicy"];
+            /** @description Job scheduler shares by project ID; projects not listed weigh 1 */
+            project_shares?: {
+                [key: string]: components["schemas"]["ProjectShare"];
+            };
+            model_warmup?: components["schemas"]["ModelWarmup"];
+            /** @description Hosts approved for aule.http_fetch, by plugin name */
+            plugin_hosts?: {
+                [key: string]: string[];
+            };
+        };
+        RateLimit: {
+            per_minute: number;
+            /** @description 0 = per_minute */
+            burst?: number;
+        };
+        RedactionPolicy: {
+            disabled?: boolean;
+            /** @description Built-in detectors to run; empty = all */
+            detectors?: string[];
+            /** @description Extra expressions to mask as [REDACTED:<name>] */
+            patterns?: {
+                name: string;
+                regex: string;
+            }[];
+            /** @description Also mask prompts sent to local models */
+            local_prompts?: boolean;
+            /** @description Store messages, spans and logs unmasked */
+            keep_history?: boolean;
+        };
+        ModerationConfig: {
+            /**
+             * @description Empty turns moderation off
+             * @enum {string}
+             */
+            classifier?: "" | "local" | "remote";
+            model?: string;
+            /** @enum {string} */
+            input?: "" | "off" | "log" | "warn" | "block";
+            /** @enum {string} */
+            output?: "" | "off" | "log" | "warn" | "block";
+        };
+        ImagePolicy: {
+            /** @description Empty = every image not denied */
+            allow?: string[];
+            deny?: string[];
+            /** @enum {string} */
+            pull_policy?: "" | "never" | "if-not-present" | "always";
+            /** @description Image reference → the sha256 digest it must run as */
+            pins?: {
+                [key: string]: string;
+            };
+            require_digest?: boolean;
+        };
+        ChatArtifactPolicy: {
+            disabled?: boolean;
+            extensions?: string[];
+            /** Format: int64 */
+            min_bytes?: number;
+            /**
+             * Format: int64
+             * @description 0 = no limit
+             */
+            max_bytes?: number;
+        };
+        ProjectShare: {
+            /** @description Relative share; 0 = 1 */
+            weight?: number;
+            /** @description Cap on running jobs; 0 = none */
+            max_jobs?: number;
+        };
+        ModelWarmup: {
+            disabled?: boolean;
+            /** @description Empty = the default chat and embedding models */
+            models?: string[];
+            keep_alive_minutes?: number;
+        };
+        /** @description A worker's stored record merged with its live inspection; either side may be missing. */
+        WorkerDetail: {
+            id: string;
+            node_id: string;
+            worker?: components["schemas"]["Worker"];
+            container?: components["schemas"]["WorkerInspection"];
+            /** @description Why container is missing */
+            inspect_error?: string;
+        };
+        WorkerInspection: {
+            container_id: string;
+            image: string;
+            /** @example running */
+            state: string;
+            running: boolean;
+            /** Format: date-time */
+            started_at?: string;
+            /** Format: date-time */
+            finished_at?: string;
+            /** Format: int64 */
+            uptime_seconds: number;
+            exit_code?: number;
+            oom_killed?: boolean;
+            error?: string;
+            restart_count: number;
+            mounts: {
+                source: string;
+                destination: string;
+                read_only: boolean;
+            }[];
+            resources?: components["schemas"]["WorkerResources"];
+        };
+        WorkerResources: {
+            /**
+             * Format: double
+             * @description Of one core; can exceed 100
+             */
+            cpu_percent: number;
+            /** Format: int64 */
+            memory_bytes: number;
+            /** Format: int64 */
+            memory_limit_bytes?: number;
+            /** Format: int64 */
+            pids: number;
+            /** Format: int64 */
+            net_rx_bytes: number;
+            /** Format: int64 */
+            net_tx_bytes: number;
+        };
+        WorkerLogsResponse: {
+            worker_id: string;
+            tail: number;
+            lines: components["schemas"]["WorkerLogLine"][];
+            count: number;
+        };
+        WorkerLogLine: {
+            /** Format: date-time */
+            time?: string;
+            /** @enum {string} */
+            stream: "stdout" | "stderr";
+            text: string;
+        };
+    };
+    responses: never;
+    parameters: never;
+    requestBodies: never;
+    headers: never;
+    pathItems: never;
+}
+export type $defs = Record<string, never>;
+export interface operations {
+    ListJobs: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of jobs */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Job"][];
+                };
+            };
+            /** @description Internal server error */
+            500: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    SubmitJob: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["JobRequest"];
+            };
+        };
+        responses: {
+            /** @description Job created successfully */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["JobResponse"];
+                };
+            };
+            /** @description Invalid job specification */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Internal server error */
+            500: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetJob: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Job ID */
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Job Details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Job"];
+                };
+            };
+            /** @description Job not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    StreamJob: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                /** @description Job ID */
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Event stream */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "text/event-stream": string;
+                };
+            };
+            /** @description Job not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    ServeJobFile: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+                filename: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description The file content */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/octet-stream": string;
+                };
+            };
+            /** @description File not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Internal server error */
+            500: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    ListWorkflows: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of workflows */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Workflow"][];
+                };
+            };
+        };
+    };
+    CreateWorkflow: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    project_id?: string;
+                    name: string;
+                    description?: string;
+                    steps: components["schemas"]["WorkflowStep"][];
+                };
+            };
+        };
+        responses: {
+            /** @description Workflow created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Workflow"];
+                };
+            };
+            /** @description Invalid input */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    GetWorkflow: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Workflow details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Workflow"];
+                };
+            };
+            /** @description Workflow not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    RunWorkflow: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Workflow started */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": {
+                        id?: string;
+                        status?: string;
+                    };
+                };
+            };
+            /** @description Workflow not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    ResumeWorkflow: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": {
+                    state_updates?: {
+                        [key: string]: unknown;
+                    };
+                };
+            };
+        };
+        responses: {
+            /** @description Workflow resumed */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": {
+                        status?: string;
+                    };
+                };
+            };
+        };
+    };
+    AgentChat: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ChatRequest"];
+            };
+        };
+        responses: {
+            /** @description Agent Response */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ChatResponse"];
+                };
+            };
+            /** @description The conversation already has a turn running, or the run was cancelled */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Internal server error */
+            500: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ListConversations: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of conversations */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Conversation"][];
+                };
+            };
+        };
+    };
+    CreateConversation: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    /** @example New Chat */
+                    title?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Conversation created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Conversation"];
+                };
+            };
+        };
+    };
+    GetConversation: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Conversation details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Conversation"];
+                };
+            };
+            /** @description Conversation not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    DeleteConversation: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    UpdateConversation: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    title?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Updated conversation */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Conversation"];
+                };
+            };
+        };
+    };
+    ListMessages: {
+        parameters: {
+            query?: {
+                limit?: number;
+            };
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of messages */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Message"][];
+                };
+            };
+        };
+    };
+    GetSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Current application settings */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["AppConfig"];
+                };
+            };
+        };
+    };
+    UpdateSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["AppConfig"];
+            };
+        };
+        responses: {
+            /** @description Updated settings (secrets masked) */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["AppConfig"];
+                };
+            };
+            /** @description Validation error */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    TestConnection: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    /** @enum {string} */
+                    provider: "llm" | "image";
+                };
+            };
+        };
+        responses: {
+            /** @description Connection test result */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ConnectionTestResult"];
+                };
+            };
+        };
+    };
+    ListProjects: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of projects */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Project"][];
+                };
+            };
+        };
+    };
+    CreateProject: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    /** @example Campaign Q4 */
+                    name: string;
+                    /** @example Marketing campaign assets */
+                    description?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Project created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Project"];
+                };
+            };
+        };
+    };
+    GetProject: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Project details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Project"];
+                };
+            };
+            /** @description Project not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    DeleteProject: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    UpdateProject: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    name?: string;
+                    description?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Updated project */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Project"];
+                };
+            };
+            /** @description Project not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ListProjectConversations: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of conversations in the project */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Conversation"][];
+                };
+            };
+        };
+    };
+    ListProjectArtifacts: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of artifacts in the project */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Artifact"][];
+                };
+            };
+        };
+    };
+    ListArtifacts: {
+        parameters: {
+            query?: {
+                /** @description Filter by artifact type */
+                type?: "image" | "text" | "document" | "audio" | "video" | "other";
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of artifacts */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Artifact"][];
+                };
+            };
+        };
+    };
+    GetArtifact: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Artifact details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Artifact"];
+                };
+            };
+            /** @description Artifact not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    DeleteArtifact: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    ListModels: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of available models, with the warm-up status of kept-warm ones */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ModelListResponse"];
+                };
+            };
+        };
+    };
+    DiscoverModels: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": {
+                    /** @example http://localhost:11434 */
+                    ollama_url?: string;
+                    /** @example http://localhost:4000 */
+                    litellm_url?: string;
+                    litellm_api_key?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Discovered models */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ModelSpec"][];
+                };
+            };
+        };
+    };
+    StreamConversationEvents: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Event stream */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "text/event-stream": string;
+                };
+            };
+        };
+    };
+    ListPersonas: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of personas */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Persona"][];
+                };
+            };
+        };
+    };
+    CreatePersona: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    /** @example Analyst */
+                    name: string;
+                    /** @example Data analysis specialist */
+                    description?: string;
+                    /** @example You are a data analyst... */
+                    system_prompt: string;
+                    /** @example bar-chart */
+                    icon?: string;
+                    /** @example cyan */
+                    color?: string;
+                    allowed_tools?: string[];
+                    /** @description Override model for this persona (e.g. qwen2.5-coder:3b) */
+                    model_override?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Persona created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Persona"];
+                };
+            };
+        };
+    };
+    GetPersona: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Persona details */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Persona"];
+                };
+            };
+            /** @description Persona not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    DeletePersona: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    UpdatePersona: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    name?: string;
+                    description?: string;
+                    system_prompt?: string;
+                    icon?: string;
+                    color?: string;
+                    allowed_tools?: string[];
+                    model_override?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Updated persona */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Persona"];
+                };
+            };
+            /** @description Persona not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ListPlugins: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description List of loaded Wasm plugins */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PluginListResponse"];
+                };
+            };
+        };
+    };
+    ListCapabilities: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Capability map with stats */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["CapabilityListResponse"];
+                };
+            };
+        };
+    };
+    StreamAgentChat: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ChatRequest"];
+            };
+        };
+        responses: {
+            /** @description Event stream */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "text/event-stream": string;
+                };
+            };
+            /** @description Missing message */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    StreamBroadcastEvents: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Event stream */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "text/event-stream": string;
+                };
+            };
+        };
+    };
+    StreamWorkflowEvents: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Event stream */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "text/event-stream": string;
+                };
+            };
+        };
+    };
+    ListScheduledTasks: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Scheduled tasks */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ScheduledTaskListResponse"];
+                };
+            };
+        };
+    };
+    CreateScheduledTask: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ScheduledTask"];
+            };
+        };
+        responses: {
+            /** @description Created task */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ScheduledTask"];
+                };
+            };
+            /** @description Invalid body */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    DeleteScheduledTask: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    ToggleScheduledTask: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Updated task */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ScheduledTask"];
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    ListTools: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Registered tools */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ToolListResponse"];
+                };
+            };
+        };
+    };
+    RunTool: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                name: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    params?: {
+                        [key: string]: unknown;
+                    };
+                    /** @description Overrides the tool's own timeout */
+                    timeout_seconds?: number;
+                };
+            };
+        };
+        responses: {
+            /** @description Tool result */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ToolRunResult"];
+                };
+            };
+            /** @description The tool failed or does not exist */
+            422: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ToolRunResult"];
+                };
+            };
+            /** @description No tool registry */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description The tool timed out */
+            504: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ToolRunResult"];
+                };
+            };
+        };
+    };
+    ListTraces: {
+        parameters: {
+            query?: {
+                limit?: number;
+                status?: components["schemas"]["SpanStatus"];
+                conversation_id?: string;
+                persona_id?: string;
+                request_id?: string;
+                min_duration_ms?: number;
+                since?: string;
+                until?: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Trace summaries */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["TraceListResponse"];
+                };
+            };
+            /** @description Invalid filter */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    GetTrace: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Trace */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Trace"];
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    SearchSpans: {
+        parameters: {
+            query?: {
+                kind?: components["schemas"]["SpanKind"];
+                name_prefix?: string;
+                status?: components["schemas"]["SpanStatus"];
+                trace_id?: string;
+                conversation_id?: string;
+                min_duration_ms?: number;
+                since?: string;
+                until?: string;
+                limit?: number;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Matching spans */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SpanListResponse"];
+                };
+            };
+            /** @description Invalid filter */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    ListWorkers: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Workers */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["WorkerListResponse"];
+                };
+            };
+        };
+    };
+    UpdateCapability: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                name: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    /** @enum {string} */
+                    runtime: "muscle" | "synapse";
+                    description?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Updated capability */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Capability"];
+                };
+            };
+            /** @description Invalid capability name */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description No capability router */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    PauseSystem: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": {
+                    /** @description Shown to clients refused while paused */
+                    reason?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Maintenance status */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["MaintenanceStatus"];
+                };
+            };
+            /** @description Maintenance mode not configured */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ResumeSystem: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Maintenance status */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["MaintenanceStatus"];
+                };
+            };
+            /** @description Maintenance mode not configured */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetMaintenanceStatus: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Maintenance status */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["MaintenanceStatus"];
+                };
+            };
+            /** @description Maintenance mode not configured */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetSystemStats: {
+        parameters: {
+            query?: {
+                /** @description Sample now instead of returning the monitor's latest sample */
+                refresh?: boolean;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Resource sample */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SystemStats"];
+                };
+            };
+            /** @description Resource monitor not configured */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    QueryLogs: {
+        parameters: {
+            query?: {
+                /** @description Minimum level */
+                level?: "debug" | "info" | "warn" | "error";
+                /** @description RFC 3339 timestamp or a duration back from now, e.g. 15m */
+                since?: string;
+                component?: string;
+                request_id?: string;
+                limit?: number;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Log records */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["LogListResponse"];
+                };
+            };
+            /** @description Invalid since */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Log buffer not configured */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetSSEStats: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Event stream stats */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SSEStats"];
+                };
+            };
+        };
+    };
+    GetVersion: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Build info */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["VersionInfo"];
+                };
+            };
+        };
+    };
+    CreateDiagnosticsBundle: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: {
+            content: {
+                "application/json": {
+                    /** @default 24 */
+                    hours?: number;
+                    /** @default 20 */
+                    max_traces?: number;
+                };
+            };
+        };
+        responses: {
+            /** @description Diagnostics bundle */
+            200: {
+                headers: {
+                    /** @example attachment; filename="aule-diagnostics-20260101-120000.zip" */
+                    "Content-Disposition"?: string;
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/zip": string;
+                };
+            };
+            /** @description hours or max_traces out of range */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetKernelInbox: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Inbox status */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["KernelInboxStatus"];
+                };
+            };
+        };
+    };
+    ListNodes: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Nodes */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["NodeListResponse"];
+                };
+            };
+            /** @description Node federation not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    RegisterNode: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    name?: string;
+                    /** @example https://gpu-box:8443 */
+                    url: string;
+                    /**
+                     * @example {
+                     *       "gpu": "true"
+                     *     }
+                     */
+                    labels?: {
+                        [key: string]: string;
+                    };
+                };
+            };
+        };
+        responses: {
+            /** @description Registered node */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Node"];
+                };
+            };
+            /** @description Invalid node */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Node federation not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    DeleteNode: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Node federation not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ListFeeds: {
+        parameters: {
+            query?: {
+                project_id?: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Feeds */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["FeedListResponse"];
+                };
+            };
+            /** @description Feeds not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    WatchFeed: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["FeedWatchRequest"];
+            };
+        };
+        responses: {
+            /** @description Watched feed */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["FeedWatch"];
+                };
+            };
+            /** @description Invalid feed or unreachable URL */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Feeds not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    UnwatchFeed: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Feeds not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ToggleFeed: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Updated feed */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["FeedWatch"];
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Feeds not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ListAutomations: {
+        parameters: {
+            query?: {
+                project_id?: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Automations */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["AutomationListResponse"];
+                };
+            };
+            /** @description Automations not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    CreateAutomation: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["AutomationRequest"];
+            };
+        };
+        responses: {
+            /** @description Created rule */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Automation"];
+                };
+            };
+            /** @description Invalid rule */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Automations not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetAutomation: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Rule */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Automation"];
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Automations not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    DeleteAutomation: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Automations not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ToggleAutomation: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Updated rule */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Automation"];
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Automations not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    RunAutomation: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Rule after the run */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Automation"];
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Automations not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ListHooks: {
+        parameters: {
+            query?: {
+                project_id?: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Hooks */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["HookListResponse"];
+                };
+            };
+            /** @description Automations not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    CreateHook: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["HookRequest"];
+            };
+        };
+        responses: {
+            /** @description Created hook */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Hook"];
+                };
+            };
+            /** @description Invalid hook */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Automations not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ListEvalSuites: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Suites */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EvalSuiteListResponse"];
+                };
+            };
+            /** @description Evals not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    CreateEvalSuite: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["EvalSuite"];
+            };
+        };
+        responses: {
+            /** @description Created suite */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EvalSuite"];
+                };
+            };
+            /** @description Invalid suite */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Evals not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetEvalSuite: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Suite */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EvalSuite"];
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Evals not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    UpdateEvalSuite: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["EvalSuite"];
+            };
+        };
+        responses: {
+            /** @description Updated suite */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EvalSuite"];
+                };
+            };
+            /** @description Invalid suite */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Evals not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    DeleteEvalSuite: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Evals not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    RunEvals: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["EvalRunRequest"];
+            };
+        };
+        responses: {
+            /** @description Finished runs (wait was set) */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EvalRunListResponse"];
+                };
+            };
+            /** @description Started runs */
+            202: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EvalRunListResponse"];
+                };
+            };
+            /** @description Suite not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Evals not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ListEvalRuns: {
+        parameters: {
+            query?: {
+                suite_id?: string;
+                limit?: number;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Runs */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EvalRunListResponse"];
+                };
+            };
+            /** @description Evals not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetEvalRun: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Run */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EvalRun"];
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Evals not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    DiffEvalRuns: {
+        parameters: {
+            query?: {
+                /** @description Baseline run; defaults to the previous run */
+                base?: string;
+            };
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Case-by-case comparison */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EvalDiff"];
+                };
+            };
+            /** @description Run or baseline not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Evals not enabled */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetEmbeddingSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Embedding provider */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EmbeddingProviderConfig"];
+                };
+            };
+        };
+    };
+    UpdateEmbeddingSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["EmbeddingProviderConfig"];
+            };
+        };
+        responses: {
+            /** @description Updated embedding provider */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EmbeddingProviderConfig"];
+                };
+            };
+            /** @description Invalid config */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetRuntimeSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Runtime settings */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["RuntimeConfig"];
+                };
+            };
+        };
+    };
+    UpdateRuntimeSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["RuntimeConfig"];
+            };
+        };
+        responses: {
+            /** @description Updated runtime settings */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["RuntimeConfig"];
+                };
+            };
+            /** @description Invalid settings */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    UpdateImageBackends: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ImageBackendConfig"][];
+            };
+        };
+        responses: {
+            /** @description Updated backends (API keys masked) */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ImageBackendConfig"][];
+                };
+            };
+            /** @description Invalid backends */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    ListImageBackendHealth: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Backend health */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ImageBackendHealthListResponse"];
+                };
+            };
+            /** @description No image provider */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetSearchSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Web search config */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SearchProviderConfig"];
+                };
+            };
+        };
+    };
+    UpdateSearchSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["SearchProviderConfig"];
+            };
+        };
+        responses: {
+            /** @description Updated web search config */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SearchProviderConfig"];
+                };
+            };
+            /** @description Invalid config */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetEmailSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Email config */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EmailConfig"];
+                };
+            };
+        };
+    };
+    UpdateEmailSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["EmailConfig"];
+            };
+        };
+        responses: {
+            /** @description Updated email config */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["EmailConfig"];
+                };
+            };
+            /** @description Invalid config */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetCalendarSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Calendar config */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["CalendarConfig"];
+                };
+            };
+        };
+    };
+    UpdateCalendarSettings: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CalendarConfig"];
+            };
+        };
+        responses: {
+            /** @description Updated calendar config */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["CalendarConfig"];
+                };
+            };
+            /** @description Invalid config */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetWorker: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Worker detail */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["WorkerDetail"];
+                };
+            };
+            /** @description Neither a record nor a container exists */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    KillWorker: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Killed */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Neither a record nor a container exists */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    GetWorkerLogs: {
+        parameters: {
+            query?: {
+                /** @description Lines to return; capped at 5000 */
+                tail?: number;
+                format?: "json" | "text";
+            };
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Log lines */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["WorkerLogsResponse"];
+                    "text/plain": string;
+                };
+            };
+            /** @description Worker or node not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description The worker's runtime cannot return recent logs */
+            501: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+}
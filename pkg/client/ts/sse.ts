@@ -0,0 +1,93 @@
+// Hand-written helpers for the kernel's Server-Sent Event endpoints.
+// EventSource cannot POST (chat streaming) or be aborted with a signal,
+// so these read the stream with fetch.
+
+export interface KernelEvent {
+    type: string; // SSE "event:" field, e.g. "token", "done", "connected"
+    data: string; // JSON payload
+}
+
+export interface StreamOptions {
+    signal?: AbortSignal;
+    fetch?: typeof fetch;
+}
+
+/** Parses an SSE body, calling onEvent for each event until the stream ends. */
+export async function readEvents(body: ReadableStream<Uint8Array>, onEvent: (ev: KernelEvent) => void): Promise<void> {
+    const reader = body.pipeThrough(new TextDecoderStream()).getReader();
+    let buf = "";
+    let type = "";
+    let data: string[] = [];
+    for (;;) {
+        const { value, done } = await reader.read();
+        if (done) return;
+        buf += value;
+        let nl: number;
+        while ((nl = buf.indexOf("\n")) >= 0) {
+            const line = buf.slice(0, nl).replace(/\r$/, "");
+            buf = buf.slice(nl + 1);
+            if (line === "") {
+                if (data.length > 0) onEvent({ type: type || "message", data: data.join("\n") });
+                type = "";
+                data = [];
+                continue;
+            }
+            const i = line.indexOf(":");
+            const field = i < 0 ? line : line.slice(0, i);
+            const val = i < 0 ? "" : line.slice(i + 1).replace(/^ /, "");
+            if (field === "event") type = val;
+            else if (field === "data") data.push(val);
+        }
+    }
+}
+
+async function stream(url: string, init: RequestInit, onEvent: (ev: KernelEvent) => void, opts: StreamOptions = {}): Promise<void> {
+    const res = await (opts.fetch ?? fetch)(url, {
+        ...init,
+        signal: opts.signal,
+        headers: { Accept: "text/event-stream", ...init.headers },
+    });
+    if (!res.ok || !res.body) {
+        throw new Error(`${init.method ?? "GET"} ${url}: status ${res.status}: ${(await res.text()).trim()}`);
+    }
+    await readEvents(res.body, onEvent);
+}
+
+/** Streams kernel events. Each method resolves when the stream ends and rejects on HTTP errors. */
+export function createStreamer(baseUrl = "http://localhost:8080", opts: StreamOptions = {}) {
+    const base = baseUrl.replace(/\/$/, "");
+    const get = (path: string, onEvent: (ev: KernelEvent) => void, o?: StreamOptions) =>
+        stream(base + path, { method: "GET" }, onEvent, { ...opts, ...o });
+    return {
+        /** Kernel-wide agent events (GET /v1/events). */
+        broadcast: (onEvent: (ev: KernelEvent) => void, o?: StreamOptions) => get("/v1/events", onEvent, o),
+        /** Conversation events (GET /v1/conversations/{id}/events). */
+        conversation: (id: string, onEvent: (ev: KernelEvent) => void, o?: StreamOptions) =>
+            get(`/v1/conversations/${encodeURIComponent(id)}/events`, onEvent, o),
+        /** Workflow run and step events (GET /v1/workflows/{id}/events). */
+        workflow: (id: string, onEvent: (ev: KernelEvent) => void, o?: StreamOptions) =>
+            get(`/v1/workflows/${encodeURIComponent(id)}/events`, onEvent, o),
+        /** Job logs and status (GET /v1/jobs/{id}/stream). */
+        job: (id: string, onEvent: (ev: KernelEvent) => void, o?: StreamOptions) =>
+            get(`/v1/jobs/${encodeURIComponent(id)}/stream`, onEvent, o),
+        /**
+         * Sends a chat message and streams the reply (POST /v1/agent/chat/stream).
+         * The final "done" event carries the ChatResponse; an "error" event rejects.
+         */
+        chat: async (body: { message: string; conversation_id?: string; persona_id?: string }, onEvent: (ev: KernelEvent) => void, o?: StreamOptions) => {
+            let failure: string | undefined;
+            await stream(base + "/v1/agent/chat/stream", {
+                method: "POST",
+                headers: { "Content-Type": "application/json" },
+                body: JSON.stringify(body),
+            }, (ev) => {
+                if (ev.type === "error") {
+                    try { failure = JSON.parse(ev.data).error; } catch { failure = ev.data; }
+                    return;
+                }
+                onEvent(ev);
+            }, { ...opts, ...o });
+            if (failure !== undefined) throw new Error(`chat failed: ${failure}`);
+        },
+    };
+}
@@ -34,10 +34,55 @@ const (
 	ArtifactTypeVideo    ArtifactType = "video"
 )
 
+// Defines values for AutomationCreatedBy.
+const (
+	AutomationCreatedByAgent AutomationCreatedBy = "agent"
+	AutomationCreatedByUser  AutomationCreatedBy = "user"
+)
+
+// Defines values for AutomationActionKind.
+const (
+	AutomationActionKindPrompt   AutomationActionKind = "prompt"
+	AutomationActionKindTool     AutomationActionKind = "tool"
+	AutomationActionKindWorkflow AutomationActionKind = "workflow"
+)
+
+// Defines values for AutomationConditionOp.
+const (
+	AutomationConditionOpContains    AutomationConditionOp = "contains"
+	AutomationConditionOpEquals      AutomationConditionOp = "equals"
+	AutomationConditionOpMatches     AutomationConditionOp = "matches"
+	AutomationConditionOpNotContains AutomationConditionOp = "not_contains"
+	AutomationConditionOpNotEquals   AutomationConditionOp = "not_equals"
+)
+
+// Defines values for AutomationTriggerFileEvents.
+const (
+	Create AutomationTriggerFileEvents = "create"
+	Remove AutomationTriggerFileEvents = "remove"
+	Rename AutomationTriggerFileEvents = "rename"
+	Write  AutomationTriggerFileEvents = "write"
+)
+
+// Defines values for AutomationTriggerKind.
+const (
+	AutomationTriggerKindCron    AutomationTriggerKind = "cron"
+	AutomationTriggerKindFeed    AutomationTriggerKind = "feed"
+	AutomationTriggerKindFile    AutomationTriggerKind = "file"
+	AutomationTriggerKindWebhook AutomationTriggerKind = "webhook"
+)
+
+// Defines values for CalendarConfigKind.
+const (
+	CalendarConfigKindCaldav CalendarConfigKind = "caldav"
+	CalendarConfigKindEmpty  CalendarConfigKind = ""
+	CalendarConfigKindGoogle CalendarConfigKind = "google"
+)
+
 // Defines values for CapabilityRuntime.
 const (
-	Muscle  CapabilityRuntime = "muscle"
-	Synapse CapabilityRuntime = "synapse"
+	CapabilityRuntimeMuscle  CapabilityRuntime = "muscle"
+	CapabilityRuntimeSynapse CapabilityRuntime = "synapse"
 )
 
 // Defines values for ConnectionTestResultStatus.
@@ -46,12 +91,58 @@ const (
 	ConnectionTestResultStatusOk    ConnectionTestResultStatus = "ok"
 )
 
+// Defines values for EmbeddingProviderConfigMode.
+const (
+	EmbeddingProviderConfigModeLocal  EmbeddingProviderConfigMode = "local"
+	EmbeddingProviderConfigModeRemote EmbeddingProviderConfigMode = "remote"
+)
+
+// Defines values for EvalAssertionType.
+const (
+	EvalAssertionTypeContains    EvalAssertionType = "contains"
+	EvalAssertionTypeEquals      EvalAssertionType = "equals"
+	EvalAssertionTypeMaxSteps    EvalAssertionType = "max_steps"
+	EvalAssertionTypeNotContains EvalAssertionType = "not_contains"
+	EvalAssertionTypeRegex       EvalAssertionType = "regex"
+)
+
+// Defines values for EvalCaseDiffChange.
+const (
+	Added     EvalCaseDiffChange = "added"
+	Improved  EvalCaseDiffChange = "improved"
+	Regressed EvalCaseDiffChange = "regressed"
+	Removed   EvalCaseDiffChange = "removed"
+	Unchanged EvalCaseDiffChange = "unchanged"
+)
+
+// Defines values for EvalRunStatus.
+const (
+	EvalRunStatusCompleted EvalRunStatus = "completed"
+	EvalRunStatusFailed    EvalRunStatus = "failed"
+	EvalRunStatusRunning   EvalRunStatus = "running"
+)
+
+// Defines values for ImageBackendConfigMode.
+const (
+	ImageBackendConfigModeLocal  ImageBackendConfigMode = "local"
+	ImageBackendConfigModeRemote ImageBackendConfigMode = "remote"
+)
+
+// Defines values for ImagePolicyPullPolicy.
+const (
+	ImagePolicyPullPolicyAlways       ImagePolicyPullPolicy = "always"
+	ImagePolicyPullPolicyEmpty        ImagePolicyPullPolicy = ""
+	ImagePolicyPullPolicyIfNotPresent ImagePolicyPullPolicy = "if-not-present"
+	ImagePolicyPullPolicyNever        ImagePolicyPullPolicy = "never"
+)
+
 // Defines values for MessageRole.
 const (
-	Assistant MessageRole = "assistant"
-	System    MessageRole = "system"
-	Tool      MessageRole = "tool"
-	User      MessageRole = "user"
+	MessageRoleAssistant MessageRole = "assistant"
+	MessageRoleKernel    MessageRole = "kernel"
+	MessageRoleSystem    MessageRole = "system"
+	MessageRoleTool      MessageRole = "tool"
+	MessageRoleUser      MessageRole = "user"
 )
 
 // Defines values for ModelSpecRole.
@@ -62,12 +153,124 @@ const (
 	General  ModelSpecRole = "general"
 )
 
+// Defines values for ModelWarmStatusState.
+const (
+	ModelWarmStatusStateFailed  ModelWarmStatusState = "failed"
+	ModelWarmStatusStateLoading ModelWarmStatusState = "loading"
+	ModelWarmStatusStateWarm    ModelWarmStatusState = "warm"
+)
+
+// Defines values for ModerationConfigClassifier.
+const (
+	ModerationConfigClassifierEmpty  ModerationConfigClassifier = ""
+	ModerationConfigClassifierLocal  ModerationConfigClassifier = "local"
+	ModerationConfigClassifierRemote ModerationConfigClassifier = "remote"
+)
+
+// Defines values for ModerationConfigInput.
+const (
+	ModerationConfigInputBlock ModerationConfigInput = "block"
+	ModerationConfigInputEmpty ModerationConfigInput = ""
+	ModerationConfigInputLog   ModerationConfigInput = "log"
+	ModerationConfigInputOff   ModerationConfigInput = "off"
+	ModerationConfigInputWarn  ModerationConfigInput = "warn"
+)
+
+// Defines values for ModerationConfigOutput.
+const (
+	ModerationConfigOutputBlock ModerationConfigOutput = "block"
+	ModerationConfigOutputEmpty ModerationConfigOutput = ""
+	ModerationConfigOutputLog   ModerationConfigOutput = "log"
+	ModerationConfigOutputOff   ModerationConfigOutput = "off"
+	ModerationConfigOutputWarn  ModerationConfigOutput = "warn"
+)
+
+// Defines values for NodeStatus.
+const (
+	Offline NodeStatus = "offline"
+	Online  NodeStatus = "online"
+	Unknown NodeStatus = "unknown"
+)
+
 // Defines values for ProviderConfigMode.
 const (
 	Local  ProviderConfigMode = "local"
 	Remote ProviderConfigMode = "remote"
 )
 
+// Defines values for SSEConnectionStream.
+const (
+	SSEConnectionStreamBroadcast    SSEConnectionStream = "broadcast"
+	SSEConnectionStreamChat         SSEConnectionStream = "chat"
+	SSEConnectionStreamConversation SSEConnectionStream = "conversation"
+	SSEConnectionStreamJob          SSEConnectionStream = "job"
+	SSEConnectionStreamModelPull    SSEConnectionStream = "model_pull"
+	SSEConnectionStreamWorkflow     SSEConnectionStream = "workflow"
+)
+
+// Defines values for ScheduledTaskStatus.
+const (
+	ScheduledTaskStatusActive    ScheduledTaskStatus = "active"
+	ScheduledTaskStatusCompleted ScheduledTaskStatus = "completed"
+	ScheduledTaskStatusFailed    ScheduledTaskStatus = "failed"
+	ScheduledTaskStatusPaused    ScheduledTaskStatus = "paused"
+)
+
+// Defines values for ScheduledTaskType.
+const (
+	ScheduledTaskTypeCron      ScheduledTaskType = "cron"
+	ScheduledTaskTypeOneShot   ScheduledTaskType = "one_shot"
+	ScheduledTaskTypeRecurring ScheduledTaskType = "recurring"
+)
+
+// Defines values for SearchBackendConfigKind.
+const (
+	Brave      SearchBackendConfigKind = "brave"
+	Duckduckgo SearchBackendConfigKind = "duckduckgo"
+	Searxng    SearchBackendConfigKind = "searxng"
+	Tavily     SearchBackendConfigKind = "tavily"
+)
+
+// Defines values for SpanKind.
+const (
+	SpanKindAgent    SpanKind = "agent"
+	SpanKindLlm      SpanKind = "llm"
+	SpanKindStep     SpanKind = "step"
+	SpanKindSubAgent SpanKind = "sub_agent"
+	SpanKindTool     SpanKind = "tool"
+	SpanKindWorkflow SpanKind = "workflow"
+)
+
+// Defines values for SpanStatus.
+const (
+	SpanStatusCancelled SpanStatus = "cancelled"
+	SpanStatusError     SpanStatus = "error"
+	SpanStatusOk        SpanStatus = "ok"
+	SpanStatusRunning   SpanStatus = "running"
+)
+
+// Defines values for ToolExecutionType.
+const (
+	Docker ToolExecutionType = "docker"
+	Native ToolExecutionType = "native"
+	Wasm   ToolExecutionType = "wasm"
+)
+
+// Defines values for WorkerStatus.
+const (
+	EXITED    WorkerStatus = "EXITED"
+	HEALTHY   WorkerStatus = "HEALTHY"
+	STARTING  WorkerStatus = "STARTING"
+	UNHEALTHY WorkerStatus = "UNHEALTHY"
+	UNKNOWN   WorkerStatus = "UNKNOWN"
+)
+
+// Defines values for WorkerLogLineStream.
+const (
+	Stderr WorkerLogLineStream = "stderr"
+	Stdout WorkerLogLineStream = "stdout"
+)
+
 // Defines values for WorkflowStatus.
 const (
 	WorkflowStatusCancelled WorkflowStatus = "cancelled"
@@ -80,11 +283,11 @@ const (
 
 // Defines values for WorkflowStepStatus.
 const (
-	WorkflowStepStatusDone    WorkflowStepStatus = "done"
-	WorkflowStepStatusFailed  WorkflowStepStatus = "failed"
-	WorkflowStepStatusPending WorkflowStepStatus = "pending"
-	WorkflowStepStatusRunning WorkflowStepStatus = "running"
-	WorkflowStepStatusSkipped WorkflowStepStatus = "skipped"
+	Done    WorkflowStepStatus = "done"
+	Failed  WorkflowStepStatus = "failed"
+	Pending WorkflowStepStatus = "pending"
+	Running WorkflowStepStatus = "running"
+	Skipped WorkflowStepStatus = "skipped"
 )
 
 // Defines values for ListArtifactsParamsType.
@@ -97,10 +300,30 @@ const (
 	ListArtifactsParamsTypeVideo    ListArtifactsParamsType = "video"
 )
 
+// Defines values for UpdateCapabilityJSONBodyRuntime.
+const (
+	UpdateCapabilityJSONBodyRuntimeMuscle  UpdateCapabilityJSONBodyRuntime = "muscle"
+	UpdateCapabilityJSONBodyRuntimeSynapse UpdateCapabilityJSONBodyRuntime = "synapse"
+)
+
 // Defines values for TestConnectionJSONBodyProvider.
 const (
-	Image TestConnectionJSONBodyProvider = "image"
-	Llm   TestConnectionJSONBodyProvider = "llm"
+	TestConnectionJSONBodyProviderImage TestConnectionJSONBodyProvider = "image"
+	TestConnectionJSONBodyProviderLlm   TestConnectionJSONBodyProvider = "llm"
+)
+
+// Defines values for QueryLogsParamsLevel.
+const (
+	QueryLogsParamsLevelDebug QueryLogsParamsLevel = "debug"
+	QueryLogsParamsLevelError QueryLogsParamsLevel = "error"
+	QueryLogsParamsLevelInfo  QueryLogsParamsLevel = "info"
+	QueryLogsParamsLevelWarn  QueryLogsParamsLevel = "warn"
+)
+
+// Defines values for GetWorkerLogsParamsFormat.
+const (
+	Json GetWorkerLogsParamsFormat = "json"
+	Text GetWorkerLogsParamsFormat = "text"
 )
 
 // AppConfig defines model for AppConfig.
@@ -129,11 +352,143 @@ type Artifact struct {
 // ArtifactType defines model for Artifact.Type.
 type ArtifactType string
 
+// Automation defines model for Automation.
+type Automation struct {
+	Action     AutomationAction       `json:"action"`
+	Conditions *[]AutomationCondition `json:"conditions,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+	CreatedBy  *AutomationCreatedBy   `json:"created_by,omitempty"`
+	Enabled    bool                   `json:"enabled"`
+	Id         string                 `json:"id"`
+	LastError  *string                `json:"last_error,omitempty"`
+	LastResult *string                `json:"last_result,omitempty"`
+	LastRun    *time.Time             `json:"last_run,omitempty"`
+	Name       string                 `json:"name"`
+
+	// NextRun Cron triggers
+	NextRun   *time.Time        `json:"next_run,omitempty"`
+	ProjectId string            `json:"project_id"`
+	RunCount  int               `json:"run_count"`
+	Trigger   AutomationTrigger `json:"trigger"`
+}
+
+// AutomationCreatedBy defines model for Automation.CreatedBy.
+type AutomationCreatedBy string
+
+// AutomationAction What a rule does. Prompt and tool argument strings may reference event data as {{event.<field>}}.
+type AutomationAction struct {
+	Args       *map[string]interface{} `json:"args,omitempty"`
+	Kind       AutomationActionKind    `json:"kind"`
+	PersonaId  *string                 `json:"persona_id,omitempty"`
+	Prompt     *string                 `json:"prompt,omitempty"`
+	Tool       *string                 `json:"tool,omitempty"`
+	WorkflowId *string                 `json:"workflow_id,omitempty"`
+}
+
+// AutomationActionKind defines model for AutomationAction.Kind.
+type AutomationActionKind string
+
+// AutomationCondition Compares a field of the event's data with value.
+type AutomationCondition struct {
+	Field string                `json:"field"`
+	Op    AutomationConditionOp `json:"op"`
+	Value string                `json:"value"`
+}
+
+// AutomationConditionOp defines model for AutomationCondition.Op.
+type AutomationConditionOp string
+
+// AutomationListResponse defines model for AutomationListResponse.
+type AutomationListResponse struct {
+	Automations []Automation `json:"automations"`
+	Count       int          `json:"count"`
+}
+
+// AutomationRequest defines model for AutomationRequest.
+type AutomationRequest struct {
+	Action     AutomationAction       `json:"action"`
+	Conditions *[]AutomationCondition `json:"conditions,omitempty"`
+	Enabled    *bool                  `json:"enabled,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	ProjectId  *string                `json:"project_id,omitempty"`
+	Trigger    AutomationTrigger      `json:"trigger"`
+}
+
+// AutomationTrigger When a rule fires. Only the fields of kind are used.
+type AutomationTrigger struct {
+	CronExpr        *string                        `json:"cron_expr,omitempty"`
+	DebounceSec     *int                           `json:"debounce_sec,omitempty"`
+	FeedIntervalSec *int                           `json:"feed_interval_sec,omitempty"`
+	FeedUrl         *string                        `json:"feed_url,omitempty"`
+	FileEvents      *[]AutomationTriggerFileEvents `json:"file_events,omitempty"`
+	Kind            AutomationTriggerKind          `json:"kind"`
+	Path            *string                        `json:"path,omitempty"`
+	Patterns        *[]string                      `json:"patterns,omitempty"`
+	Recursive       *bool                          `json:"recursive,omitempty"`
+
+	// SourceId Backing feed or file trigger
+	SourceId *string `json:"source_id,omitempty"`
+
+	// WebhookToken Sent as X-Automation-Token or ?token=
+	WebhookToken *string `json:"webhook_token,omitempty"`
+}
+
+// AutomationTriggerFileEvents defines model for AutomationTrigger.FileEvents.
+type AutomationTriggerFileEvents string
+
+// AutomationTriggerKind defines model for AutomationTrigger.Kind.
+type AutomationTriggerKind string
+
+// CPUStats defines model for CPUStats.
+type CPUStats struct {
+	Cores  int     `json:"cores"`
+	Load1  float64 `json:"load_1"`
+	Load15 float64 `json:"load_15"`
+	Load5  float64 `json:"load_5"`
+
+	// UsagePercent Since the previous sample; 0 on the first one
+	UsagePercent float64 `json:"usage_percent"`
+}
+
+// CalDAVConfig defines model for CalDAVConfig.
+type CalDAVConfig struct {
+	// Password Masked on read (****xxxx), plaintext on write. Empty string keeps existing.
+	Password *string `json:"password,omitempty"`
+
+	// Url Calendar collection
+	Url      *string `json:"url,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// CalendarConfig defines model for CalendarConfig.
+type CalendarConfig struct {
+	Caldav *CalDAVConfig         `json:"caldav,omitempty"`
+	Google *GoogleCalendarConfig `json:"google,omitempty"`
+
+	// Kind Empty disables the calendar tools
+	Kind *CalendarConfigKind `json:"kind,omitempty"`
+
+	// Timezone IANA zone for times given without an offset; default is the server's
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// CalendarConfigKind Empty disables the calendar tools
+type CalendarConfigKind string
+
 // Capability defines model for Capability.
 type Capability struct {
-	Capability  *string            `json:"capability,omitempty"`
-	Description *string            `json:"description,omitempty"`
-	Runtime     *CapabilityRuntime `json:"runtime,omitempty"`
+	AvgLatencyMs *float32 `json:"avg_latency_ms,omitempty"`
+	Capability   *string  `json:"capability,omitempty"`
+
+	// Demoted Fell back to muscle after repeated synapse failures
+	Demoted     *bool    `json:"demoted,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	FailureRate *float32 `json:"failure_rate,omitempty"`
+
+	// Pinned Runtime overridden through UpdateCapability
+	Pinned  *bool              `json:"pinned,omitempty"`
+	Runtime *CapabilityRuntime `json:"runtime,omitempty"`
+	Stats   *RouteStats        `json:"stats,omitempty"`
 }
 
 // CapabilityRuntime defines model for Capability.Runtime.
@@ -152,6 +507,16 @@ type CapabilityStats struct {
 	Total   *int `json:"total,omitempty"`
 }
 
+// ChatArtifactPolicy defines model for ChatArtifactPolicy.
+type ChatArtifactPolicy struct {
+	Disabled   *bool     `json:"disabled,omitempty"`
+	Extensions *[]string `json:"extensions,omitempty"`
+
+	// MaxBytes 0 = no limit
+	MaxBytes *int64 `json:"max_bytes,omitempty"`
+	MinBytes *int64 `json:"min_bytes,omitempty"`
+}
+
 // ChatRequest defines model for ChatRequest.
 type ChatRequest struct {
 	// ConversationId Optional. If omitted, a new conversation is created automatically.
@@ -191,6 +556,20 @@ type ConnectionTestResult struct {
 // ConnectionTestResultStatus defines model for ConnectionTestResult.Status.
 type ConnectionTestResultStatus string
 
+// ContainerRuntimeStats defines model for ContainerRuntimeStats.
+type ContainerRuntimeStats struct {
+	Available         bool    `json:"available"`
+	Containers        int     `json:"containers"`
+	ContainersPaused  int     `json:"containers_paused"`
+	ContainersRunning int     `json:"containers_running"`
+	ContainersStopped int     `json:"containers_stopped"`
+	Cpus              int     `json:"cpus"`
+	Error             *string `json:"error,omitempty"`
+	Images            int     `json:"images"`
+	MemoryBytes       int64   `json:"memory_bytes"`
+	Version           *string `json:"version,omitempty"`
+}
+
 // Conversation defines model for Conversation.
 type Conversation struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
@@ -205,11 +584,306 @@ type Conversation struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// DiskStats defines model for DiskStats.
+type DiskStats struct {
+	FreeBytes   int64   `json:"free_bytes"`
+	Label       string  `json:"label"`
+	Path        string  `json:"path"`
+	TotalBytes  int64   `json:"total_bytes"`
+	UsedBytes   int64   `json:"used_bytes"`
+	UsedPercent float64 `json:"used_percent"`
+}
+
+// EmailConfig defines model for EmailConfig.
+type EmailConfig struct {
+	Imap *IMAPConfig `json:"imap,omitempty"`
+	Smtp *SMTPConfig `json:"smtp,omitempty"`
+}
+
+// EmbeddingProviderConfig defines model for EmbeddingProviderConfig.
+type EmbeddingProviderConfig struct {
+	// ApiKey Masked on read (****xxxx), plaintext on write. Empty string keeps existing.
+	ApiKey    *string                      `json:"api_key,omitempty"`
+	LocalUrl  *string                      `json:"local_url,omitempty"`
+	Mode      *EmbeddingProviderConfigMode `json:"mode,omitempty"`
+	Model     *string                      `json:"model,omitempty"`
+	RemoteUrl *string                      `json:"remote_url,omitempty"`
+}
+
+// EmbeddingProviderConfigMode defines model for EmbeddingProviderConfig.Mode.
+type EmbeddingProviderConfigMode string
+
 // Error defines model for Error.
 type Error struct {
 	Error *string `json:"error,omitempty"`
 }
 
+// EvalAssertion defines model for EvalAssertion.
+type EvalAssertion struct {
+	Type  EvalAssertionType `json:"type"`
+	Value string            `json:"value"`
+}
+
+// EvalAssertionType defines model for EvalAssertion.Type.
+type EvalAssertionType string
+
+// EvalCase defines model for EvalCase.
+type EvalCase struct {
+	Assertions *[]EvalAssertion `json:"assertions,omitempty"`
+
+	// ExpectedTools Every tool must be called at least once
+	ExpectedTools *[]string `json:"expected_tools,omitempty"`
+	Name          string    `json:"name"`
+	Prompt        string    `json:"prompt"`
+}
+
+// EvalCaseDiff defines model for EvalCaseDiff.
+type EvalCaseDiff struct {
+	BaseScore  float64            `json:"base_score"`
+	Case       string             `json:"case"`
+	Change     EvalCaseDiffChange `json:"change"`
+	Score      float64            `json:"score"`
+	ScoreDelta float64            `json:"score_delta"`
+}
+
+// EvalCaseDiffChange defines model for EvalCaseDiff.Change.
+type EvalCaseDiffChange string
+
+// EvalCaseResult defines model for EvalCaseResult.
+type EvalCaseResult struct {
+	Case           string    `json:"case"`
+	ConversationId *string   `json:"conversation_id,omitempty"`
+	DurationMs     int64     `json:"duration_ms"`
+	Error          *string   `json:"error,omitempty"`
+	Failures       *[]string `json:"failures,omitempty"`
+	Output         string    `json:"output"`
+	Passed         bool      `json:"passed"`
+
+	// Score Passed checks / total checks
+	Score     float64  `json:"score"`
+	ToolsUsed []string `json:"tools_used"`
+}
+
+// EvalDiff defines model for EvalDiff.
+type EvalDiff struct {
+	BaseRunId    string         `json:"base_run_id"`
+	BaseScore    float64        `json:"base_score"`
+	Cases        []EvalCaseDiff `json:"cases"`
+	Improvements int            `json:"improvements"`
+	Regressions  int            `json:"regressions"`
+	RunId        string         `json:"run_id"`
+	Score        float64        `json:"score"`
+	ScoreDelta   float64        `json:"score_delta"`
+}
+
+// EvalRun defines model for EvalRun.
+type EvalRun struct {
+	Error      *string           `json:"error,omitempty"`
+	FinishedAt *time.Time        `json:"finished_at,omitempty"`
+	Id         string            `json:"id"`
+	Model      *string           `json:"model,omitempty"`
+	Passed     int               `json:"passed"`
+	PersonaId  *string           `json:"persona_id,omitempty"`
+	Results    *[]EvalCaseResult `json:"results,omitempty"`
+
+	// Score Mean case score in [0,1]
+	Score     float64       `json:"score"`
+	StartedAt time.Time     `json:"started_at"`
+	Status    EvalRunStatus `json:"status"`
+	SuiteId   string        `json:"suite_id"`
+	Total     int           `json:"total"`
+}
+
+// EvalRunStatus defines model for EvalRun.Status.
+type EvalRunStatus string
+
+// EvalRunListResponse defines model for EvalRunListResponse.
+type EvalRunListResponse struct {
+	Count int       `json:"count"`
+	Runs  []EvalRun `json:"runs"`
+}
+
+// EvalRunRequest defines model for EvalRunRequest.
+type EvalRunRequest struct {
+	Models     *[]string `json:"models,omitempty"`
+	PersonaIds *[]string `json:"persona_ids,omitempty"`
+	SuiteId    string    `json:"suite_id"`
+
+	// Wait Answer once every run has finished
+	Wait *bool `json:"wait,omitempty"`
+}
+
+// EvalSuite defines model for EvalSuite.
+type EvalSuite struct {
+	Cases       []EvalCase `json:"cases"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Id          *string    `json:"id,omitempty"`
+	Name        string     `json:"name"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// EvalSuiteListResponse defines model for EvalSuiteListResponse.
+type EvalSuiteListResponse struct {
+	Count  int         `json:"count"`
+	Suites []EvalSuite `json:"suites"`
+}
+
+// FeedListResponse defines model for FeedListResponse.
+type FeedListResponse struct {
+	Count int         `json:"count"`
+	Feeds []FeedWatch `json:"feeds"`
+}
+
+// FeedWatch defines model for FeedWatch.
+type FeedWatch struct {
+	// AutomationId Rule the new items are dispatched to instead
+	AutomationId *string    `json:"automation_id,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	Id           string     `json:"id"`
+	IntervalSec  int        `json:"interval_sec"`
+	LastChecked  *time.Time `json:"last_checked,omitempty"`
+	LastError    *string    `json:"last_error,omitempty"`
+	Name         string     `json:"name"`
+	Paused       bool       `json:"paused"`
+	PersonaId    *string    `json:"persona_id,omitempty"`
+	ProjectId    string     `json:"project_id"`
+	Prompt       *string    `json:"prompt,omitempty"`
+	TriggerCount int        `json:"trigger_count"`
+	Url          string     `json:"url"`
+	WorkflowId   *string    `json:"workflow_id,omitempty"`
+}
+
+// FeedWatchRequest defines model for FeedWatchRequest.
+type FeedWatchRequest struct {
+	// IntervalSec Polling interval; 0 = 900, at least 300
+	IntervalSec *int    `json:"interval_sec,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	PersonaId   *string `json:"persona_id,omitempty"`
+	ProjectId   *string `json:"project_id,omitempty"`
+
+	// Prompt Instruction run with the new items appended
+	Prompt *string `json:"prompt,omitempty"`
+	Url    string  `json:"url"`
+
+	// WorkflowId Template workflow; {{state.feed_items}} holds the new items
+	WorkflowId *string `json:"workflow_id,omitempty"`
+}
+
+// GoogleCalendarConfig defines model for GoogleCalendarConfig.
+type GoogleCalendarConfig struct {
+	// CalendarId Default primary
+	CalendarId *string `json:"calendar_id,omitempty"`
+	ClientId   *string `json:"client_id,omitempty"`
+
+	// ClientSecret Masked on read (****xxxx), plaintext on write. Empty string keeps existing.
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// RefreshToken Masked on read (****xxxx), plaintext on write. Empty string keeps existing.
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
+// Hook defines model for Hook.
+type Hook struct {
+	Automation Automation `json:"automation"`
+	Url        string     `json:"url"`
+}
+
+// HookListResponse defines model for HookListResponse.
+type HookListResponse struct {
+	Count int    `json:"count"`
+	Hooks []Hook `json:"hooks"`
+}
+
+// HookRequest defines model for HookRequest.
+type HookRequest struct {
+	Conditions *[]AutomationCondition `json:"conditions,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	PersonaId  *string                `json:"persona_id,omitempty"`
+	ProjectId  *string                `json:"project_id,omitempty"`
+	Prompt     *string                `json:"prompt,omitempty"`
+	WorkflowId *string                `json:"workflow_id,omitempty"`
+}
+
+// IMAPConfig defines model for IMAPConfig.
+type IMAPConfig struct {
+	// AllowedSenders Addresses or "@domain"; mail from anyone else is ignored
+	AllowedSenders *[]string `json:"allowed_senders,omitempty"`
+	Enabled        *bool     `json:"enabled,omitempty"`
+	Host           *string   `json:"host,omitempty"`
+
+	// Insecure Plain TCP, for local bridges only
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// Mailbox Default INBOX
+	Mailbox *string `json:"mailbox,omitempty"`
+
+	// Password Masked on read (****xxxx), plaintext on write. Empty string keeps existing.
+	Password  *string `json:"password,omitempty"`
+	PersonaId *string `json:"persona_id,omitempty"`
+
+	// PollIntervalSec 0 = 60
+	PollIntervalSec *int `json:"poll_interval_sec,omitempty"`
+
+	// Port 0 = 993, or 143 when insecure
+	Port *int `json:"port,omitempty"`
+
+	// Reply Answer each email with the agent's reply
+	Reply    *bool   `json:"reply,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// ImageBackendConfig defines model for ImageBackendConfig.
+type ImageBackendConfig struct {
+	// ApiKey Masked on read (****xxxx), plaintext on write. Empty string keeps existing.
+	ApiKey *string `json:"api_key,omitempty"`
+
+	// Mode ComfyUI (local) or an OpenAI-compatible API (remote)
+	Mode  *ImageBackendConfigMode `json:"mode,omitempty"`
+	Model *string                 `json:"model,omitempty"`
+
+	// Models Other models this backend serves
+	Models *[]string `json:"models,omitempty"`
+
+	// Name Unique; jobs select a backend by name with "backend" metadata
+	Name *string `json:"name,omitempty"`
+	Url  *string `json:"url,omitempty"`
+}
+
+// ImageBackendConfigMode ComfyUI (local) or an OpenAI-compatible API (remote)
+type ImageBackendConfigMode string
+
+// ImageBackendHealth defines model for ImageBackendHealth.
+type ImageBackendHealth struct {
+	Error   *string `json:"error,omitempty"`
+	Healthy bool    `json:"healthy"`
+	Mode    string  `json:"mode"`
+	Model   string  `json:"model"`
+	Name    string  `json:"name"`
+	Url     string  `json:"url"`
+}
+
+// ImageBackendHealthListResponse defines model for ImageBackendHealthListResponse.
+type ImageBackendHealthListResponse struct {
+	Backends []ImageBackendHealth `json:"backends"`
+	Count    int                  `json:"count"`
+}
+
+// ImagePolicy defines model for ImagePolicy.
+type ImagePolicy struct {
+	// Allow Empty = every image not denied
+	Allow *[]string `json:"allow,omitempty"`
+	Deny  *[]string `json:"deny,omitempty"`
+
+	// Pins Image reference → the sha256 digest it must run as
+	Pins          *map[string]string     `json:"pins,omitempty"`
+	PullPolicy    *ImagePolicyPullPolicy `json:"pull_policy,omitempty"`
+	RequireDigest *bool                  `json:"require_digest,omitempty"`
+}
+
+// ImagePolicyPullPolicy defines model for ImagePolicy.PullPolicy.
+type ImagePolicyPullPolicy string
+
 // Job defines model for Job.
 type Job struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
@@ -233,16 +907,59 @@ type JobResponse struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// KernelInboxStatus defines model for KernelInboxStatus.
+type KernelInboxStatus struct {
+	ConversationId string   `json:"conversation_id"`
+	LastMessage    *Message `json:"last_message,omitempty"`
+
+	// UnreadCount Kernel messages since the user last wrote
+	UnreadCount int `json:"unread_count"`
+}
+
+// LogEntry defines model for LogEntry.
+type LogEntry struct {
+	Attrs     *map[string]interface{} `json:"attrs,omitempty"`
+	Component *string                 `json:"component,omitempty"`
+	Level     string                  `json:"level"`
+	Msg       string                  `json:"msg"`
+	RequestId *string                 `json:"request_id,omitempty"`
+	Time      time.Time               `json:"time"`
+}
+
+// LogListResponse defines model for LogListResponse.
+type LogListResponse struct {
+	Count int        `json:"count"`
+	Logs  []LogEntry `json:"logs"`
+}
+
+// MaintenanceStatus defines model for MaintenanceStatus.
+type MaintenanceStatus struct {
+	Paused bool       `json:"paused"`
+	Reason *string    `json:"reason,omitempty"`
+	Since  *time.Time `json:"since,omitempty"`
+}
+
+// MemoryStats defines model for MemoryStats.
+type MemoryStats struct {
+	AvailableBytes int64   `json:"available_bytes"`
+	TotalBytes     int64   `json:"total_bytes"`
+	UsedBytes      int64   `json:"used_bytes"`
+	UsedPercent    float64 `json:"used_percent"`
+}
+
 // Message defines model for Message.
 type Message struct {
-	Content        *string      `json:"content,omitempty"`
-	ConversationId *string      `json:"conversation_id,omitempty"`
-	CreatedAt      *time.Time   `json:"created_at,omitempty"`
-	Id             *string      `json:"id,omitempty"`
-	Role           *MessageRole `json:"role,omitempty"`
-	Steps          *[]ReActStep `json:"steps,omitempty"`
-	Thought        *string      `json:"thought,omitempty"`
-	ToolCall       *struct {
+	Content        *string    `json:"content,omitempty"`
+	ConversationId *string    `json:"conversation_id,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Id             *string    `json:"id,omitempty"`
+
+	// Metadata Free-form annotations, e.g. kind for kernel notifications
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+	Role     *MessageRole            `json:"role,omitempty"`
+	Steps    *[]ReActStep            `json:"steps,omitempty"`
+	Thought  *string                 `json:"thought,omitempty"`
+	ToolCall *struct {
 		Args *map[string]interface{} `json:"args,omitempty"`
 		Name *string                 `json:"name,omitempty"`
 	} `json:"tool_call,omitempty"`
@@ -251,20 +968,113 @@ type Message struct {
 // MessageRole defines model for Message.Role.
 type MessageRole string
 
+// ModelListResponse defines model for ModelListResponse.
+type ModelListResponse struct {
+	Count  int         `json:"count"`
+	Models []ModelSpec `json:"models"`
+
+	// Warmup Preloading status of each kept-warm model; empty when warm-up is off
+	Warmup []ModelWarmStatus `json:"warmup"`
+}
+
 // ModelSpec defines model for ModelSpec.
 type ModelSpec struct {
-	BaseUrl  *string        `json:"base_url,omitempty"`
-	Id       *string        `json:"id,omitempty"`
-	IsLocal  *bool          `json:"is_local,omitempty"`
-	Name     *string        `json:"name,omitempty"`
-	Provider *string        `json:"provider,omitempty"`
-	Role     *ModelSpecRole `json:"role,omitempty"`
-	Size     *string        `json:"size,omitempty"`
+	BaseUrl *string `json:"base_url,omitempty"`
+
+	// CompletionCostPerMtok USD per million completion tokens; absent for local models
+	CompletionCostPerMtok *float64 `json:"completion_cost_per_mtok,omitempty"`
+
+	// ContextTokens Context window the server runs the model with; absent when unknown
+	ContextTokens *int    `json:"context_tokens,omitempty"`
+	Embedding     *bool   `json:"embedding,omitempty"`
+	Family        *string `json:"family,omitempty"`
+	Id            *string `json:"id,omitempty"`
+	IsLocal       *bool   `json:"is_local,omitempty"`
+
+	// MaxContextTokens Context window the model was trained for
+	MaxContextTokens *int    `json:"max_context_tokens,omitempty"`
+	Name             *string `json:"name,omitempty"`
+
+	// PromptCostPerMtok USD per million prompt tokens; absent for local models
+	PromptCostPerMtok *float64       `json:"prompt_cost_per_mtok,omitempty"`
+	Provider          *string        `json:"provider,omitempty"`
+	Quantization      *string        `json:"quantization,omitempty"`
+	Role              *ModelSpecRole `json:"role,omitempty"`
+	Size              *string        `json:"size,omitempty"`
+	Tools             *bool          `json:"tools,omitempty"`
+	Vision            *bool          `json:"vision,omitempty"`
 }
 
 // ModelSpecRole defines model for ModelSpec.Role.
 type ModelSpecRole string
 
+// ModelWarmStatus defines model for ModelWarmStatus.
+type ModelWarmStatus struct {
+	Error *string `json:"error,omitempty"`
+
+	// ExpiresAt When the model unloads without another ping or use
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastPingAt *time.Time `json:"last_ping_at,omitempty"`
+
+	// LoadMs How long that load took
+	LoadMs *int64 `json:"load_ms,omitempty"`
+
+	// LoadedAt When the model last went from cold to warm
+	LoadedAt *time.Time           `json:"loaded_at,omitempty"`
+	Model    string               `json:"model"`
+	State    ModelWarmStatusState `json:"state"`
+}
+
+// ModelWarmStatusState defines model for ModelWarmStatus.State.
+type ModelWarmStatusState string
+
+// ModelWarmup defines model for ModelWarmup.
+type ModelWarmup struct {
+	Disabled         *bool `json:"disabled,omitempty"`
+	KeepAliveMinutes *int  `json:"keep_alive_minutes,omitempty"`
+
+	// Models Empty = the default chat and embedding models
+	Models *[]string `json:"models,omitempty"`
+}
+
+// ModerationConfig defines model for ModerationConfig.
+type ModerationConfig struct {
+	// Classifier Empty turns moderation off
+	Classifier *ModerationConfigClassifier `json:"classifier,omitempty"`
+	Input      *ModerationConfigInput      `json:"input,omitempty"`
+	Model      *string                     `json:"model,omitempty"`
+	Output     *ModerationConfigOutput     `json:"output,omitempty"`
+}
+
+// ModerationConfigClassifier Empty turns moderation off
+type ModerationConfigClassifier string
+
+// ModerationConfigInput defines model for ModerationConfig.Input.
+type ModerationConfigInput string
+
+// ModerationConfigOutput defines model for ModerationConfig.Output.
+type ModerationConfigOutput string
+
+// Node defines model for Node.
+type Node struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Id        string            `json:"id"`
+	Labels    map[string]string `json:"labels"`
+	LastSeen  *time.Time        `json:"last_seen,omitempty"`
+	Name      string            `json:"name"`
+	Status    NodeStatus        `json:"status"`
+	Url       string            `json:"url"`
+}
+
+// NodeStatus defines model for Node.Status.
+type NodeStatus string
+
+// NodeListResponse defines model for NodeListResponse.
+type NodeListResponse struct {
+	Count int    `json:"count"`
+	Nodes []Node `json:"nodes"`
+}
+
 // Persona defines model for Persona.
 type Persona struct {
 	// AllowedTools Tool names this persona can use. Empty means all tools.
@@ -311,6 +1121,15 @@ type Project struct {
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
 
+// ProjectShare defines model for ProjectShare.
+type ProjectShare struct {
+	// MaxJobs Cap on running jobs; 0 = none
+	MaxJobs *int `json:"max_jobs,omitempty"`
+
+	// Weight Relative share; 0 = 1
+	Weight *int `json:"weight,omitempty"`
+}
+
 // ProviderConfig defines model for ProviderConfig.
 type ProviderConfig struct {
 	// ApiKey Masked on read (****xxxx), plaintext on write. Empty string keeps existing.
@@ -326,6 +1145,13 @@ type ProviderConfig struct {
 // ProviderConfigMode 'local' for Ollama/ComfyUI, 'remote' for OpenAI-compatible API
 type ProviderConfigMode string
 
+// RateLimit defines model for RateLimit.
+type RateLimit struct {
+	// Burst 0 = per_minute
+	Burst     *int `json:"burst,omitempty"`
+	PerMinute int  `json:"per_minute"`
+}
+
 // ReActStep defines model for ReActStep.
 type ReActStep struct {
 	Action        *string                 `json:"action,omitempty"`
@@ -336,16 +1162,432 @@ type ReActStep struct {
 	Thought       *string                 `json:"thought,omitempty"`
 }
 
+// RedactionPolicy defines model for RedactionPolicy.
+type RedactionPolicy struct {
+	// Detectors Built-in detectors to run; empty = all
+	Detectors *[]string `json:"detectors,omitempty"`
+	Disabled  *bool     `json:"disabled,omitempty"`
+
+	// KeepHistory Store messages, spans and logs unmasked
+	KeepHistory *bool `json:"keep_history,omitempty"`
+
+	// LocalPrompts Also mask prompts sent to local models
+	LocalPrompts *bool `json:"local_prompts,omitempty"`
+
+	// Patterns Extra expressions to mask as [REDACTED:<name>]
+	Patterns *[]struct {
+		Name  string `json:"name"`
+		Regex string `json:"regex"`
+	} `json:"patterns,omitempty"`
+}
+
 // Resources defines model for Resources.
 type Resources struct {
 	Cpu      *float32 `json:"cpu,omitempty"`
 	MemoryMb *int     `json:"memory_mb,omitempty"`
 }
 
-// Workflow defines model for Workflow.
-type Workflow struct {
-	CompletedAt *time.Time              `json:"completed_at,omitempty"`
-	CreatedAt   *time.Time              `json:"created_at,omitempty"`
+// RouteStats defines model for RouteStats.
+type RouteStats struct {
+	Executions     *int       `json:"executions,omitempty"`
+	Failures       *int       `json:"failures,omitempty"`
+	LastError      *string    `json:"last_error,omitempty"`
+	LastRunAt      *time.Time `json:"last_run_at,omitempty"`
+	TotalLatencyMs *int64     `json:"total_latency_ms,omitempty"`
+}
+
+// RuntimeConfig Kernel settings that apply without a restart. Zero values keep the startup defaults.
+type RuntimeConfig struct {
+	ChatArtifacts *ChatArtifactPolicy `json:"chat_artifacts,omitempty"`
+	CorsOrigins   *[]string           `json:"cors_origins,omitempty"`
+
+	// DisabledTools Tools never offered to the agent
+	DisabledTools *[]string    `json:"disabled_tools,omitempty"`
+	Images        *ImagePolicy `json:"images,omitempty"`
+
+	// KeyRateLimits API key → route class → limit
+	KeyRateLimits *map[string]map[string]RateLimit `json:"key_rate_limits,omitempty"`
+
+	// Locale Language of the agent's instructions and messages; empty = detect from each message
+	Locale            *string           `json:"locale,omitempty"`
+	MaxConcurrentJobs *int              `json:"max_concurrent_jobs,omitempty"`
+	ModelWarmup       *ModelWarmup      `json:"model_warmup,omitempty"`
+	Moderation        *ModerationConfig `json:"moderation,omitempty"`
+	PluginDir         *string           `json:"plugin_dir,omitempty"`
+
+	// PluginHosts Hosts approved for aule.http_fetch, by plugin name
+	PluginHosts *map[string][]string `json:"plugin_hosts,omitempty"`
+
+	// ProjectShares Job scheduler shares by project ID; projects not listed weigh 1
+	ProjectShares *map[string]ProjectShare `json:"project_shares,omitempty"`
+
+	// RateLimits By route class (chat, jobs, admin, default)
+	RateLimits *map[string]RateLimit `json:"rate_limits,omitempty"`
+
+	// RecordProviderCalls Store raw LLM provider requests and responses (masked) on each call's trace
+	RecordProviderCalls *bool            `json:"record_provider_calls,omitempty"`
+	Redaction           *RedactionPolicy `json:"redaction,omitempty"`
+
+	// StrictToolNames Refuse fuzzy-matched tool names
+	StrictToolNames *bool `json:"strict_tool_names,omitempty"`
+
+	// TraceRetentionDays 0 = keep persisted traces forever
+	TraceRetentionDays *int `json:"trace_retention_days,omitempty"`
+}
+
+// SMTPConfig defines model for SMTPConfig.
+type SMTPConfig struct {
+	// AllowedRecipients Addresses or "@domain"; empty = any
+	AllowedRecipients *[]string `json:"allowed_recipients,omitempty"`
+
+	// DefaultTo Recipient when the agent names none
+	DefaultTo *string `json:"default_to,omitempty"`
+
+	// From Sender address; defaults to username
+	From *string `json:"from,omitempty"`
+
+	// Host Empty disables email
+	Host *string `json:"host,omitempty"`
+
+	// Password Masked on read (****xxxx), plaintext on write. Empty string keeps existing.
+	Password *string `json:"password,omitempty"`
+
+	// Port 587 (STARTTLS) or 465 (implicit TLS); 0 = 587
+	Port *int `json:"port,omitempty"`
+
+	// Username Empty = no AUTH
+	Username *string `json:"username,omitempty"`
+}
+
+// SSEConnection defines model for SSEConnection.
+type SSEConnection struct {
+	// Client Client address, masked
+	Client    string              `json:"client"`
+	Events    int64               `json:"events"`
+	Id        int64               `json:"id"`
+	LastEvent time.Time           `json:"last_event"`
+	Since     time.Time           `json:"since"`
+	Stream    SSEConnectionStream `json:"stream"`
+}
+
+// SSEConnectionStream defines model for SSEConnection.Stream.
+type SSEConnectionStream string
+
+// SSEStats defines model for SSEStats.
+type SSEStats struct {
+	ByStream           map[string]int  `json:"by_stream"`
+	Connections        []SSEConnection `json:"connections"`
+	HeartbeatSeconds   float64         `json:"heartbeat_seconds"`
+	IdleTimeoutSeconds float64         `json:"idle_timeout_seconds"`
+	IdledTotal         int64           `json:"idled_total"`
+	MaxConns           int             `json:"max_conns"`
+	MaxPerClient       int             `json:"max_per_client"`
+	Open               int             `json:"open"`
+	OpenedTotal        int64           `json:"opened_total"`
+	PingsTotal         int64           `json:"pings_total"`
+	RejectedTotal      int64           `json:"rejected_total"`
+}
+
+// ScheduledTask defines model for ScheduledTask.
+type ScheduledTask struct {
+	// Command Direct command; bypasses the LLM when set
+	Command   *string    `json:"command,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CreatedBy *string    `json:"created_by,omitempty"`
+	CronExpr  *string    `json:"cron_expr,omitempty"`
+
+	// Deliver Send the result to the user
+	Deliver     *bool      `json:"deliver,omitempty"`
+	Id          *string    `json:"id,omitempty"`
+	IntervalSec *int       `json:"interval_sec,omitempty"`
+	LastResult  *string    `json:"last_result,omitempty"`
+	LastRun     *time.Time `json:"last_run,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	NextRun     *time.Time `json:"next_run,omitempty"`
+	PersonaId   *string    `json:"persona_id,omitempty"`
+	ProjectId   *string    `json:"project_id,omitempty"`
+
+	// Prompt Instruction run through the ReAct agent
+	Prompt   *string              `json:"prompt,omitempty"`
+	RunCount *int                 `json:"run_count,omitempty"`
+	Status   *ScheduledTaskStatus `json:"status,omitempty"`
+	Type     *ScheduledTaskType   `json:"type,omitempty"`
+}
+
+// ScheduledTaskStatus defines model for ScheduledTask.Status.
+type ScheduledTaskStatus string
+
+// ScheduledTaskType defines model for ScheduledTask.Type.
+type ScheduledTaskType string
+
+// ScheduledTaskListResponse defines model for ScheduledTaskListResponse.
+type ScheduledTaskListResponse struct {
+	Count *int             `json:"count,omitempty"`
+	Tasks *[]ScheduledTask `json:"tasks,omitempty"`
+}
+
+// SearchBackendConfig defines model for SearchBackendConfig.
+type SearchBackendConfig struct {
+	// ApiKey Brave and Tavily; masked in responses
+	ApiKey *string                 `json:"api_key,omitempty"`
+	Kind   SearchBackendConfigKind `json:"kind"`
+	Name   string                  `json:"name"`
+
+	// RequestsPerMinute 0 = unlimited
+	RequestsPerMinute *int `json:"requests_per_minute,omitempty"`
+
+	// Url SearxNG instance; optional API base override for the others
+	Url *string `json:"url,omitempty"`
+}
+
+// SearchBackendConfigKind defines model for SearchBackendConfig.Kind.
+type SearchBackendConfigKind string
+
+// SearchProviderConfig With no backends, Brave (if BRAVE_SEARCH_API_KEY is set) and then DuckDuckGo are used.
+type SearchProviderConfig struct {
+	Backends *[]SearchBackendConfig `json:"backends,omitempty"`
+
+	// MaxResults Default result count; 0 = 5
+	MaxResults *int `json:"max_results,omitempty"`
+}
+
+// Span defines model for Span.
+type Span struct {
+	Attributes *map[string]string      `json:"attributes,omitempty"`
+	Children   *[]string               `json:"children,omitempty"`
+	DurationMs *int64                  `json:"duration_ms,omitempty"`
+	EndTime    *time.Time              `json:"end_time,omitempty"`
+	Error      *string                 `json:"error,omitempty"`
+	Id         *string                 `json:"id,omitempty"`
+	Input      *string                 `json:"input,omitempty"`
+	Kind       *SpanKind               `json:"kind,omitempty"`
+	Metadata   *map[string]interface{} `json:"metadata,omitempty"`
+	Model      *string                 `json:"model,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Output     *string                 `json:"output,omitempty"`
+	ParentId   *string                 `json:"parent_id,omitempty"`
+	StartTime  *time.Time              `json:"start_time,omitempty"`
+	Status     *SpanStatus             `json:"status,omitempty"`
+	TraceId    *string                 `json:"trace_id,omitempty"`
+}
+
+// SpanKind defines model for SpanKind.
+type SpanKind string
+
+// SpanListResponse defines model for SpanListResponse.
+type SpanListResponse struct {
+	Count *int    `json:"count,omitempty"`
+	Spans *[]Span `json:"spans,omitempty"`
+}
+
+// SpanStatus defines model for SpanStatus.
+type SpanStatus string
+
+// SystemStats One sample of host and runtime resources. Sections that could not be read are omitted and explained in errors.
+type SystemStats struct {
+	Cpu         *CPUStats              `json:"cpu,omitempty"`
+	DbSizeBytes int64                  `json:"db_size_bytes"`
+	Disks       []DiskStats            `json:"disks"`
+	Docker      *ContainerRuntimeStats `json:"docker,omitempty"`
+
+	// Errors Why a section is missing, by section
+	Errors    *map[string]string `json:"errors,omitempty"`
+	Memory    *MemoryStats       `json:"memory,omitempty"`
+	SampledAt time.Time          `json:"sampled_at"`
+	Warnings  []string           `json:"warnings"`
+}
+
+// Tool defines model for Tool.
+type Tool struct {
+	Aliases       *[]string          `json:"aliases,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	ExecutionType *ToolExecutionType `json:"execution_type,omitempty"`
+
+	// Name Full name, "namespace/name" for non built-in tools
+	Name      *string `json:"name,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Parameters JSON Schema of the tool parameters
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ToolExecutionType defines model for Tool.ExecutionType.
+type ToolExecutionType string
+
+// ToolListResponse defines model for ToolListResponse.
+type ToolListResponse struct {
+	Count *int    `json:"count,omitempty"`
+	Tools *[]Tool `json:"tools,omitempty"`
+}
+
+// ToolRunResult defines model for ToolRunResult.
+type ToolRunResult struct {
+	DurationMs *int64       `json:"duration_ms,omitempty"`
+	Error      *string      `json:"error,omitempty"`
+	Ok         *bool        `json:"ok,omitempty"`
+	Result     *interface{} `json:"result,omitempty"`
+	Tool       *string      `json:"tool,omitempty"`
+}
+
+// Trace defines model for Trace.
+type Trace struct {
+	ConversationId *string     `json:"conversation_id,omitempty"`
+	DurationMs     *int64      `json:"duration_ms,omitempty"`
+	EndTime        *time.Time  `json:"end_time,omitempty"`
+	Id             *string     `json:"id,omitempty"`
+	Name           *string     `json:"name,omitempty"`
+	PersonaId      *string     `json:"persona_id,omitempty"`
+	RequestId      *string     `json:"request_id,omitempty"`
+	RootSpanId     *string     `json:"root_span_id,omitempty"`
+	SpanCount      *int        `json:"span_count,omitempty"`
+	Spans          *[]Span     `json:"spans,omitempty"`
+	StartTime      *time.Time  `json:"start_time,omitempty"`
+	Status         *SpanStatus `json:"status,omitempty"`
+}
+
+// TraceListResponse defines model for TraceListResponse.
+type TraceListResponse struct {
+	Count *int `json:"count,omitempty"`
+
+	// Traces Summaries; spans are only returned by GetTrace
+	Traces *[]Trace `json:"traces,omitempty"`
+}
+
+// UpdateInfo defines model for UpdateInfo.
+type UpdateInfo struct {
+	// Available latest is newer than current
+	Available bool      `json:"available"`
+	CheckedAt time.Time `json:"checked_at"`
+	Current   string    `json:"current"`
+
+	// Error Why the last check failed
+	Error       *string    `json:"error,omitempty"`
+	Latest      *string    `json:"latest,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	Url         *string    `json:"url,omitempty"`
+}
+
+// VersionInfo defines model for VersionInfo.
+type VersionInfo struct {
+	Arch string `json:"arch"`
+
+	// BuildDate RFC 3339
+	BuildDate *string `json:"build_date,omitempty"`
+
+	// CommitTime RFC 3339
+	CommitTime *string `json:"commit_time,omitempty"`
+
+	// Dirty Built with uncommitted changes
+	Dirty     *bool   `json:"dirty,omitempty"`
+	GitSha    *string `json:"git_sha,omitempty"`
+	GoVersion string  `json:"go_version"`
+	Os        string  `json:"os"`
+
+	// SchemaVersion Database schema of this build
+	SchemaVersion int         `json:"schema_version"`
+	Update        *UpdateInfo `json:"update,omitempty"`
+
+	// Version Release tag, or dev
+	Version string `json:"version"`
+}
+
+// Worker defines model for Worker.
+type Worker struct {
+	CreatedAt *time.Time         `json:"created_at,omitempty"`
+	Id        *string            `json:"id,omitempty"`
+	Metadata  *map[string]string `json:"metadata,omitempty"`
+	Spec      *WorkerSpec        `json:"spec,omitempty"`
+	Status    *WorkerStatus      `json:"status,omitempty"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+}
+
+// WorkerStatus defines model for Worker.Status.
+type WorkerStatus string
+
+// WorkerDetail A worker's stored record merged with its live inspection; either side may be missing.
+type WorkerDetail struct {
+	Container *WorkerInspection `json:"container,omitempty"`
+	Id        string            `json:"id"`
+
+	// InspectError Why container is missing
+	InspectError *string `json:"inspect_error,omitempty"`
+	NodeId       string  `json:"node_id"`
+	Worker       *Worker `json:"worker,omitempty"`
+}
+
+// WorkerInspection defines model for WorkerInspection.
+type WorkerInspection struct {
+	ContainerId string     `json:"container_id"`
+	Error       *string    `json:"error,omitempty"`
+	ExitCode    *int       `json:"exit_code,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	Image       string     `json:"image"`
+	Mounts      []struct {
+		Destination string `json:"destination"`
+		ReadOnly    bool   `json:"read_only"`
+		Source      string `json:"source"`
+	} `json:"mounts"`
+	OomKilled     *bool            `json:"oom_killed,omitempty"`
+	Resources     *WorkerResources `json:"resources,omitempty"`
+	RestartCount  int              `json:"restart_count"`
+	Running       bool             `json:"running"`
+	StartedAt     *time.Time       `json:"started_at,omitempty"`
+	State         string           `json:"state"`
+	UptimeSeconds int64            `json:"uptime_seconds"`
+}
+
+// WorkerListResponse defines model for WorkerListResponse.
+type WorkerListResponse struct {
+	Count   *int      `json:"count,omitempty"`
+	Workers *[]Worker `json:"workers,omitempty"`
+}
+
+// WorkerLogLine defines model for WorkerLogLine.
+type WorkerLogLine struct {
+	Stream WorkerLogLineStream `json:"stream"`
+	Text   string              `json:"text"`
+	Time   *time.Time          `json:"time,omitempty"`
+}
+
+// WorkerLogLineStream defines model for WorkerLogLine.Stream.
+type WorkerLogLineStream string
+
+// WorkerLogsResponse defines model for WorkerLogsResponse.
+type WorkerLogsResponse struct {
+	Count    int             `json:"count"`
+	Lines    []WorkerLogLine `json:"lines"`
+	Tail     int             `json:"tail"`
+	WorkerId string          `json:"worker_id"`
+}
+
+// WorkerResources defines model for WorkerResources.
+type WorkerResources struct {
+	// CpuPercent Of one core; can exceed 100
+	CpuPercent       float64 `json:"cpu_percent"`
+	MemoryBytes      int64   `json:"memory_bytes"`
+	MemoryLimitBytes *int64  `json:"memory_limit_bytes,omitempty"`
+	NetRxBytes       int64   `json:"net_rx_bytes"`
+	NetTxBytes       int64   `json:"net_tx_bytes"`
+	Pids             int64   `json:"pids"`
+}
+
+// WorkerSpec defines model for WorkerSpec.
+type WorkerSpec struct {
+	Command      *[]string          `json:"command,omitempty"`
+	Env          *map[string]string `json:"env,omitempty"`
+	Image        *string            `json:"image,omitempty"`
+	NodeSelector *map[string]string `json:"node_selector,omitempty"`
+	ResourceCpu  *float64           `json:"resource_cpu,omitempty"`
+
+	// ResourceMem Bytes
+	ResourceMem *int64             `json:"resource_mem,omitempty"`
+	Tags        *map[string]string `json:"tags,omitempty"`
+}
+
+// Workflow defines model for Workflow.
+type Workflow struct {
+	CompletedAt *time.Time              `json:"completed_at,omitempty"`
+	CreatedAt   *time.Time              `json:"created_at,omitempty"`
 	Description *string                 `json:"description,omitempty"`
 	Error       *string                 `json:"error,omitempty"`
 	Id          *string                 `json:"id,omitempty"`
@@ -391,6 +1633,20 @@ type ListArtifactsParams struct {
 // ListArtifactsParamsType defines parameters for ListArtifacts.
 type ListArtifactsParamsType string
 
+// ListAutomationsParams defines parameters for ListAutomations.
+type ListAutomationsParams struct {
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+}
+
+// UpdateCapabilityJSONBody defines parameters for UpdateCapability.
+type UpdateCapabilityJSONBody struct {
+	Description *string                         `json:"description,omitempty"`
+	Runtime     UpdateCapabilityJSONBodyRuntime `json:"runtime"`
+}
+
+// UpdateCapabilityJSONBodyRuntime defines parameters for UpdateCapability.
+type UpdateCapabilityJSONBodyRuntime string
+
 // CreateConversationJSONBody defines parameters for CreateConversation.
 type CreateConversationJSONBody struct {
 	Title *string `json:"title,omitempty"`
@@ -406,6 +1662,28 @@ type ListMessagesParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListEvalRunsParams defines parameters for ListEvalRuns.
+type ListEvalRunsParams struct {
+	SuiteId *string `form:"suite_id,omitempty" json:"suite_id,omitempty"`
+	Limit   *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DiffEvalRunsParams defines parameters for DiffEvalRuns.
+type DiffEvalRunsParams struct {
+	// Base Baseline run; defaults to the previous run
+	Base *string `form:"base,omitempty" json:"base,omitempty"`
+}
+
+// ListFeedsParams defines parameters for ListFeeds.
+type ListFeedsParams struct {
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+}
+
+// ListHooksParams defines parameters for ListHooks.
+type ListHooksParams struct {
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+}
+
 // DiscoverModelsJSONBody defines parameters for DiscoverModels.
 type DiscoverModelsJSONBody struct {
 	LitellmApiKey *string `json:"litellm_api_key,omitempty"`
@@ -413,6 +1691,13 @@ type DiscoverModelsJSONBody struct {
 	OllamaUrl     *string `json:"ollama_url,omitempty"`
 }
 
+// RegisterNodeJSONBody defines parameters for RegisterNode.
+type RegisterNodeJSONBody struct {
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   *string            `json:"name,omitempty"`
+	Url    string             `json:"url"`
+}
+
 // CreatePersonaJSONBody defines parameters for CreatePersona.
 type CreatePersonaJSONBody struct {
 	AllowedTools *[]string `json:"allowed_tools,omitempty"`
@@ -449,6 +1734,9 @@ type UpdateProjectJSONBody struct {
 	Name        *string `json:"name,omitempty"`
 }
 
+// UpdateImageBackendsJSONBody defines parameters for UpdateImageBackends.
+type UpdateImageBackendsJSONBody []ImageBackendConfig
+
 // TestConnectionJSONBody defines parameters for TestConnection.
 type TestConnectionJSONBody struct {
 	Provider TestConnectionJSONBodyProvider `json:"provider"`
@@ -457,6 +1745,82 @@ type TestConnectionJSONBody struct {
 // TestConnectionJSONBodyProvider defines parameters for TestConnection.
 type TestConnectionJSONBodyProvider string
 
+// SearchSpansParams defines parameters for SearchSpans.
+type SearchSpansParams struct {
+	Kind           *SpanKind   `form:"kind,omitempty" json:"kind,omitempty"`
+	NamePrefix     *string     `form:"name_prefix,omitempty" json:"name_prefix,omitempty"`
+	Status         *SpanStatus `form:"status,omitempty" json:"status,omitempty"`
+	TraceId        *string     `form:"trace_id,omitempty" json:"trace_id,omitempty"`
+	ConversationId *string     `form:"conversation_id,omitempty" json:"conversation_id,omitempty"`
+	MinDurationMs  *int64      `form:"min_duration_ms,omitempty" json:"min_duration_ms,omitempty"`
+	Since          *time.Time  `form:"since,omitempty" json:"since,omitempty"`
+	Until          *time.Time  `form:"until,omitempty" json:"until,omitempty"`
+	Limit          *int        `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateDiagnosticsBundleJSONBody defines parameters for CreateDiagnosticsBundle.
+type CreateDiagnosticsBundleJSONBody struct {
+	Hours     *int `json:"hours,omitempty"`
+	MaxTraces *int `json:"max_traces,omitempty"`
+}
+
+// QueryLogsParams defines parameters for QueryLogs.
+type QueryLogsParams struct {
+	// Level Minimum level
+	Level *QueryLogsParamsLevel `form:"level,omitempty" json:"level,omitempty"`
+
+	// Since RFC 3339 timestamp or a duration back from now, e.g. 15m
+	Since     *string `form:"since,omitempty" json:"since,omitempty"`
+	Component *string `form:"component,omitempty" json:"component,omitempty"`
+	RequestId *string `form:"request_id,omitempty" json:"request_id,omitempty"`
+	Limit     *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// QueryLogsParamsLevel defines parameters for QueryLogs.
+type QueryLogsParamsLevel string
+
+// PauseSystemJSONBody defines parameters for PauseSystem.
+type PauseSystemJSONBody struct {
+	// Reason Shown to clients refused while paused
+	Reason *string `json:"reason,omitempty"`
+}
+
+// GetSystemStatsParams defines parameters for GetSystemStats.
+type GetSystemStatsParams struct {
+	// Refresh Sample now instead of returning the monitor's latest sample
+	Refresh *bool `form:"refresh,omitempty" json:"refresh,omitempty"`
+}
+
+// RunToolJSONBody defines parameters for RunTool.
+type RunToolJSONBody struct {
+	Params *map[string]interface{} `json:"params,omitempty"`
+
+	// TimeoutSeconds Overrides the tool's own timeout
+	TimeoutSeconds *float32 `json:"timeout_seconds,omitempty"`
+}
+
+// ListTracesParams defines parameters for ListTraces.
+type ListTracesParams struct {
+	Limit          *int        `form:"limit,omitempty" json:"limit,omitempty"`
+	Status         *SpanStatus `form:"status,omitempty" json:"status,omitempty"`
+	ConversationId *string     `form:"conversation_id,omitempty" json:"conversation_id,omitempty"`
+	PersonaId      *string     `form:"persona_id,omitempty" json:"persona_id,omitempty"`
+	RequestId      *string     `form:"request_id,omitempty" json:"request_id,omitempty"`
+	MinDurationMs  *int64      `form:"min_duration_ms,omitempty" json:"min_duration_ms,omitempty"`
+	Since          *time.Time  `form:"since,omitempty" json:"since,omitempty"`
+	Until          *time.Time  `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// GetWorkerLogsParams defines parameters for GetWorkerLogs.
+type GetWorkerLogsParams struct {
+	// Tail Lines to return; capped at 5000
+	Tail   *int                       `form:"tail,omitempty" json:"tail,omitempty"`
+	Format *GetWorkerLogsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetWorkerLogsParamsFormat defines parameters for GetWorkerLogs.
+type GetWorkerLogsParamsFormat string
+
 // CreateWorkflowJSONBody defines parameters for CreateWorkflow.
 type CreateWorkflowJSONBody struct {
 	Description *string        `json:"description,omitempty"`
@@ -473,18 +1837,45 @@ type ResumeWorkflowJSONBody struct {
 // AgentChatJSONRequestBody defines body for AgentChat for application/json ContentType.
 type AgentChatJSONRequestBody = ChatRequest
 
+// StreamAgentChatJSONRequestBody defines body for StreamAgentChat for application/json ContentType.
+type StreamAgentChatJSONRequestBody = ChatRequest
+
+// CreateAutomationJSONRequestBody defines body for CreateAutomation for application/json ContentType.
+type CreateAutomationJSONRequestBody = AutomationRequest
+
+// UpdateCapabilityJSONRequestBody defines body for UpdateCapability for application/json ContentType.
+type UpdateCapabilityJSONRequestBody UpdateCapabilityJSONBody
+
 // CreateConversationJSONRequestBody defines body for CreateConversation for application/json ContentType.
 type CreateConversationJSONRequestBody CreateConversationJSONBody
 
 // UpdateConversationJSONRequestBody defines body for UpdateConversation for application/json ContentType.
 type UpdateConversationJSONRequestBody UpdateConversationJSONBody
 
+// RunEvalsJSONRequestBody defines body for RunEvals for application/json ContentType.
+type RunEvalsJSONRequestBody = EvalRunRequest
+
+// CreateEvalSuiteJSONRequestBody defines body for CreateEvalSuite for application/json ContentType.
+type CreateEvalSuiteJSONRequestBody = EvalSuite
+
+// UpdateEvalSuiteJSONRequestBody defines body for UpdateEvalSuite for application/json ContentType.
+type UpdateEvalSuiteJSONRequestBody = EvalSuite
+
+// WatchFeedJSONRequestBody defines body for WatchFeed for application/json ContentType.
+type WatchFeedJSONRequestBody = FeedWatchRequest
+
+// CreateHookJSONRequestBody defines body for CreateHook for application/json ContentType.
+type CreateHookJSONRequestBody = HookRequest
+
 // SubmitJobJSONRequestBody defines body for SubmitJob for application/json ContentType.
 type SubmitJobJSONRequestBody = JobRequest
 
 // DiscoverModelsJSONRequestBody defines body for DiscoverModels for application/json ContentType.
 type DiscoverModelsJSONRequestBody DiscoverModelsJSONBody
 
+// RegisterNodeJSONRequestBody defines body for RegisterNode for application/json ContentType.
+type RegisterNodeJSONRequestBody RegisterNodeJSONBody
+
 // CreatePersonaJSONRequestBody defines body for CreatePersona for application/json ContentType.
 type CreatePersonaJSONRequestBody CreatePersonaJSONBody
 
@@ -500,9 +1891,39 @@ type UpdateProjectJSONRequestBody UpdateProjectJSONBody
 // UpdateSettingsJSONRequestBody defines body for UpdateSettings for application/json ContentType.
 type UpdateSettingsJSONRequestBody = AppConfig
 
+// UpdateCalendarSettingsJSONRequestBody defines body for UpdateCalendarSettings for application/json ContentType.
+type UpdateCalendarSettingsJSONRequestBody = CalendarConfig
+
+// UpdateEmailSettingsJSONRequestBody defines body for UpdateEmailSettings for application/json ContentType.
+type UpdateEmailSettingsJSONRequestBody = EmailConfig
+
+// UpdateEmbeddingSettingsJSONRequestBody defines body for UpdateEmbeddingSettings for application/json ContentType.
+type UpdateEmbeddingSettingsJSONRequestBody = EmbeddingProviderConfig
+
+// UpdateImageBackendsJSONRequestBody defines body for UpdateImageBackends for application/json ContentType.
+type UpdateImageBackendsJSONRequestBody UpdateImageBackendsJSONBody
+
+// UpdateRuntimeSettingsJSONRequestBody defines body for UpdateRuntimeSettings for application/json ContentType.
+type UpdateRuntimeSettingsJSONRequestBody = RuntimeConfig
+
+// UpdateSearchSettingsJSONRequestBody defines body for UpdateSearchSettings for application/json ContentType.
+type UpdateSearchSettingsJSONRequestBody = SearchProviderConfig
+
 // TestConnectionJSONRequestBody defines body for TestConnection for application/json ContentType.
 type TestConnectionJSONRequestBody TestConnectionJSONBody
 
+// CreateDiagnosticsBundleJSONRequestBody defines body for CreateDiagnosticsBundle for application/json ContentType.
+type CreateDiagnosticsBundleJSONRequestBody CreateDiagnosticsBundleJSONBody
+
+// PauseSystemJSONRequestBody defines body for PauseSystem for application/json ContentType.
+type PauseSystemJSONRequestBody PauseSystemJSONBody
+
+// CreateScheduledTaskJSONRequestBody defines body for CreateScheduledTask for application/json ContentType.
+type CreateScheduledTaskJSONRequestBody = ScheduledTask
+
+// RunToolJSONRequestBody defines body for RunTool for application/json ContentType.
+type RunToolJSONRequestBody RunToolJSONBody
+
 // CreateWorkflowJSONRequestBody defines body for CreateWorkflow for application/json ContentType.
 type CreateWorkflowJSONRequestBody CreateWorkflowJSONBody
 
@@ -514,6 +1935,9 @@ type ServerInterface interface {
 	// Chat with the Agent
 	// (POST /v1/agent/chat)
 	AgentChat(w http.ResponseWriter, r *http.Request)
+	// Chat with the agent, streaming the reply (SSE)
+	// (POST /v1/agent/chat/stream)
+	StreamAgentChat(w http.ResponseWriter, r *http.Request)
 	// List all artifacts
 	// (GET /v1/artifacts)
 	ListArtifacts(w http.ResponseWriter, r *http.Request, params ListArtifactsParams)
@@ -523,9 +1947,30 @@ type ServerInterface interface {
 	// Get artifact details
 	// (GET /v1/artifacts/{id})
 	GetArtifact(w http.ResponseWriter, r *http.Request, id string)
+	// List automation rules
+	// (GET /v1/automations)
+	ListAutomations(w http.ResponseWriter, r *http.Request, params ListAutomationsParams)
+	// Create an automation rule
+	// (POST /v1/automations)
+	CreateAutomation(w http.ResponseWriter, r *http.Request)
+	// Delete a rule and its feed or file watch
+	// (DELETE /v1/automations/{id})
+	DeleteAutomation(w http.ResponseWriter, r *http.Request, id string)
+	// Get an automation rule
+	// (GET /v1/automations/{id})
+	GetAutomation(w http.ResponseWriter, r *http.Request, id string)
+	// Fire a rule now, ignoring its conditions
+	// (POST /v1/automations/{id}/run)
+	RunAutomation(w http.ResponseWriter, r *http.Request, id string)
+	// Enable or disable a rule
+	// (POST /v1/automations/{id}/toggle)
+	ToggleAutomation(w http.ResponseWriter, r *http.Request, id string)
 	// List all system capabilities (muscle + synapse)
 	// (GET /v1/capabilities)
 	ListCapabilities(w http.ResponseWriter, r *http.Request)
+	// Override the runtime of a capability
+	// (PUT /v1/capabilities/{name})
+	UpdateCapability(w http.ResponseWriter, r *http.Request, name string)
 	// List all conversations
 	// (GET /v1/conversations)
 	ListConversations(w http.ResponseWriter, r *http.Request)
@@ -547,6 +1992,57 @@ type ServerInterface interface {
 	// List messages in a conversation
 	// (GET /v1/conversations/{id}/messages)
 	ListMessages(w http.ResponseWriter, r *http.Request, id string, params ListMessagesParams)
+	// Run a suite against personas and models
+	// (POST /v1/evals/run)
+	RunEvals(w http.ResponseWriter, r *http.Request)
+	// List eval runs, newest first, without per-case results
+	// (GET /v1/evals/runs)
+	ListEvalRuns(w http.ResponseWriter, r *http.Request, params ListEvalRunsParams)
+	// Scored report of one eval run
+	// (GET /v1/evals/runs/{id})
+	GetEvalRun(w http.ResponseWriter, r *http.Request, id string)
+	// Compare an eval run with a baseline run of the same suite
+	// (GET /v1/evals/runs/{id}/diff)
+	DiffEvalRuns(w http.ResponseWriter, r *http.Request, id string, params DiffEvalRunsParams)
+	// List eval suites
+	// (GET /v1/evals/suites)
+	ListEvalSuites(w http.ResponseWriter, r *http.Request)
+	// Create an eval suite
+	// (POST /v1/evals/suites)
+	CreateEvalSuite(w http.ResponseWriter, r *http.Request)
+	// Delete an eval suite and its runs
+	// (DELETE /v1/evals/suites/{id})
+	DeleteEvalSuite(w http.ResponseWriter, r *http.Request, id string)
+	// Get an eval suite
+	// (GET /v1/evals/suites/{id})
+	GetEvalSuite(w http.ResponseWriter, r *http.Request, id string)
+	// Replace an eval suite
+	// (PUT /v1/evals/suites/{id})
+	UpdateEvalSuite(w http.ResponseWriter, r *http.Request, id string)
+	// Stream kernel-wide agent events (SSE)
+	// (GET /v1/events)
+	StreamBroadcastEvents(w http.ResponseWriter, r *http.Request)
+	// List watched RSS/Atom feeds
+	// (GET /v1/feeds)
+	ListFeeds(w http.ResponseWriter, r *http.Request, params ListFeedsParams)
+	// Watch a feed after fetching it once
+	// (POST /v1/feeds)
+	WatchFeed(w http.ResponseWriter, r *http.Request)
+	// Stop watching a feed
+	// (DELETE /v1/feeds/{id})
+	UnwatchFeed(w http.ResponseWriter, r *http.Request, id string)
+	// Pause or resume a feed
+	// (POST /v1/feeds/{id}/toggle)
+	ToggleFeed(w http.ResponseWriter, r *http.Request, id string)
+	// List webhook rules with their URLs
+	// (GET /v1/hooks)
+	ListHooks(w http.ResponseWriter, r *http.Request, params ListHooksParams)
+	// Create a hook running a prompt or a workflow
+	// (POST /v1/hooks)
+	CreateHook(w http.ResponseWriter, r *http.Request)
+	// Probe every configured image backend
+	// (GET /v1/image/backends)
+	ListImageBackendHealth(w http.ResponseWriter, r *http.Request)
 	// List all jobs
 	// (GET /v1/jobs)
 	ListJobs(w http.ResponseWriter, r *http.Request)
@@ -568,6 +2064,15 @@ type ServerInterface interface {
 	// Discover models from Ollama and/or LiteLLM
 	// (POST /v1/models/discover)
 	DiscoverModels(w http.ResponseWriter, r *http.Request)
+	// List registered muscle nodes
+	// (GET /v1/nodes)
+	ListNodes(w http.ResponseWriter, r *http.Request)
+	// Register a remote muscle node
+	// (POST /v1/nodes)
+	RegisterNode(w http.ResponseWriter, r *http.Request)
+	// Unregister a node
+	// (DELETE /v1/nodes/{id})
+	DeleteNode(w http.ResponseWriter, r *http.Request, id string)
 	// List all personas
 	// (GET /v1/personas)
 	ListPersonas(w http.ResponseWriter, r *http.Request)
@@ -613,9 +2118,108 @@ type ServerInterface interface {
 	// Update settings (encrypts secrets at rest)
 	// (PUT /v1/settings)
 	UpdateSettings(w http.ResponseWriter, r *http.Request)
+	// Calendar config (secrets masked)
+	// (GET /v1/settings/calendar)
+	GetCalendarSettings(w http.ResponseWriter, r *http.Request)
+	// Replace the calendar settings
+	// (PUT /v1/settings/calendar)
+	UpdateCalendarSettings(w http.ResponseWriter, r *http.Request)
+	// SMTP and IMAP config (passwords masked)
+	// (GET /v1/settings/email)
+	GetEmailSettings(w http.ResponseWriter, r *http.Request)
+	// Replace the email settings
+	// (PUT /v1/settings/email)
+	UpdateEmailSettings(w http.ResponseWriter, r *http.Request)
+	// Embedding provider config (API key masked)
+	// (GET /v1/settings/embeddings)
+	GetEmbeddingSettings(w http.ResponseWriter, r *http.Request)
+	// Replace the embedding provider config
+	// (PUT /v1/settings/embeddings)
+	UpdateEmbeddingSettings(w http.ResponseWriter, r *http.Request)
+	// Replace the extra image backends
+	// (PUT /v1/settings/image-backends)
+	UpdateImageBackends(w http.ResponseWriter, r *http.Request)
+	// Hot-reloadable kernel settings
+	// (GET /v1/settings/runtime)
+	GetRuntimeSettings(w http.ResponseWriter, r *http.Request)
+	// Replace the runtime settings; they apply without a restart
+	// (PUT /v1/settings/runtime)
+	UpdateRuntimeSettings(w http.ResponseWriter, r *http.Request)
+	// Web search config (API keys masked)
+	// (GET /v1/settings/search)
+	GetSearchSettings(w http.ResponseWriter, r *http.Request)
+	// Replace the web search backends, tried in order
+	// (PUT /v1/settings/search)
+	UpdateSearchSettings(w http.ResponseWriter, r *http.Request)
 	// Test provider connection
 	// (POST /v1/settings/test)
 	TestConnection(w http.ResponseWriter, r *http.Request)
+	// Search spans across all traces
+	// (GET /v1/spans)
+	SearchSpans(w http.ResponseWriter, r *http.Request, params SearchSpansParams)
+	// Build a diagnostics bundle to attach to bug reports
+	// (POST /v1/system/diagnostics)
+	CreateDiagnosticsBundle(w http.ResponseWriter, r *http.Request)
+	// Unread count and last message of the kernel inbox
+	// (GET /v1/system/inbox)
+	GetKernelInbox(w http.ResponseWriter, r *http.Request)
+	// Query recent kernel log records, newest first
+	// (GET /v1/system/logs)
+	QueryLogs(w http.ResponseWriter, r *http.Request, params QueryLogsParams)
+	// Pause the scheduler, cron, heartbeat and new chats
+	// (POST /v1/system/pause)
+	PauseSystem(w http.ResponseWriter, r *http.Request)
+	// Leave maintenance mode; queued jobs and due tasks resume
+	// (POST /v1/system/resume)
+	ResumeSystem(w http.ResponseWriter, r *http.Request)
+	// Open event streams and their limits
+	// (GET /v1/system/sse)
+	GetSSEStats(w http.ResponseWriter, r *http.Request)
+	// Host CPU, memory and disk usage, database size and Docker stats
+	// (GET /v1/system/stats)
+	GetSystemStats(w http.ResponseWriter, r *http.Request, params GetSystemStatsParams)
+	// Report whether the system is paused
+	// (GET /v1/system/status)
+	GetMaintenanceStatus(w http.ResponseWriter, r *http.Request)
+	// Kernel build and, when update checks are on, the last check
+	// (GET /v1/system/version)
+	GetVersion(w http.ResponseWriter, r *http.Request)
+	// List scheduled tasks
+	// (GET /v1/tasks)
+	ListScheduledTasks(w http.ResponseWriter, r *http.Request)
+	// Create a scheduled task
+	// (POST /v1/tasks)
+	CreateScheduledTask(w http.ResponseWriter, r *http.Request)
+	// Delete a scheduled task
+	// (DELETE /v1/tasks/{id})
+	DeleteScheduledTask(w http.ResponseWriter, r *http.Request, id string)
+	// Toggle a scheduled task between active and paused
+	// (POST /v1/tasks/{id}/toggle)
+	ToggleScheduledTask(w http.ResponseWriter, r *http.Request, id string)
+	// List registered tools with their parameter schemas
+	// (GET /v1/tools)
+	ListTools(w http.ResponseWriter, r *http.Request)
+	// Execute a tool
+	// (POST /v1/tools/{name}/run)
+	RunTool(w http.ResponseWriter, r *http.Request, name string)
+	// List recent traces, including persisted history
+	// (GET /v1/traces)
+	ListTraces(w http.ResponseWriter, r *http.Request, params ListTracesParams)
+	// Get a trace with all its spans
+	// (GET /v1/traces/{id})
+	GetTrace(w http.ResponseWriter, r *http.Request, id string)
+	// List workers
+	// (GET /v1/workers)
+	ListWorkers(w http.ResponseWriter, r *http.Request)
+	// Force-kill a worker's container and mark the worker exited
+	// (DELETE /v1/workers/{id})
+	KillWorker(w http.ResponseWriter, r *http.Request, id string)
+	// Stored worker record merged with live container inspection
+	// (GET /v1/workers/{id})
+	GetWorker(w http.ResponseWriter, r *http.Request, id string)
+	// Last lines a worker wrote, without following
+	// (GET /v1/workers/{id}/logs)
+	GetWorkerLogs(w http.ResponseWriter, r *http.Request, id string, params GetWorkerLogsParams)
 	// List all workflows
 	// (GET /v1/workflows)
 	ListWorkflows(w http.ResponseWriter, r *http.Request)
@@ -625,6 +2229,9 @@ type ServerInterface interface {
 	// Get workflow details and status
 	// (GET /v1/workflows/{id})
 	GetWorkflow(w http.ResponseWriter, r *http.Request, id string)
+	// Stream workflow and step events (SSE)
+	// (GET /v1/workflows/{id}/events)
+	StreamWorkflowEvents(w http.ResponseWriter, r *http.Request, id string)
 	// Resume a paused workflow
 	// (POST /v1/workflows/{id}/resume)
 	ResumeWorkflow(w http.ResponseWriter, r *http.Request, id string)
@@ -656,6 +2263,20 @@ func (siw *ServerInterfaceWrapper) AgentChat(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// StreamAgentChat operation middleware
+func (siw *ServerInterfaceWrapper) StreamAgentChat(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamAgentChat(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListArtifacts operation middleware
 func (siw *ServerInterfaceWrapper) ListArtifacts(w http.ResponseWriter, r *http.Request) {
 
@@ -733,25 +2354,24 @@ func (siw *ServerInterfaceWrapper) GetArtifact(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ListCapabilities operation middleware
-func (siw *ServerInterfaceWrapper) ListCapabilities(w http.ResponseWriter, r *http.Request) {
+// ListAutomations operation middleware
+func (siw *ServerInterfaceWrapper) ListAutomations(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCapabilities(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAutomationsParams
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "project_id" -------------
 
-// ListConversations operation middleware
-func (siw *ServerInterfaceWrapper) ListConversations(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListConversations(w, r)
+		siw.Handler.ListAutomations(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -761,11 +2381,11 @@ func (siw *ServerInterfaceWrapper) ListConversations(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// CreateConversation operation middleware
-func (siw *ServerInterfaceWrapper) CreateConversation(w http.ResponseWriter, r *http.Request) {
+// CreateAutomation operation middleware
+func (siw *ServerInterfaceWrapper) CreateAutomation(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateConversation(w, r)
+		siw.Handler.CreateAutomation(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -775,8 +2395,8 @@ func (siw *ServerInterfaceWrapper) CreateConversation(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteConversation operation middleware
-func (siw *ServerInterfaceWrapper) DeleteConversation(w http.ResponseWriter, r *http.Request) {
+// DeleteAutomation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAutomation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -790,7 +2410,7 @@ func (siw *ServerInterfaceWrapper) DeleteConversation(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteConversation(w, r, id)
+		siw.Handler.DeleteAutomation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -800,8 +2420,8 @@ func (siw *ServerInterfaceWrapper) DeleteConversation(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetConversation operation middleware
-func (siw *ServerInterfaceWrapper) GetConversation(w http.ResponseWriter, r *http.Request) {
+// GetAutomation operation middleware
+func (siw *ServerInterfaceWrapper) GetAutomation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -815,7 +2435,7 @@ func (siw *ServerInterfaceWrapper) GetConversation(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConversation(w, r, id)
+		siw.Handler.GetAutomation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -825,8 +2445,8 @@ func (siw *ServerInterfaceWrapper) GetConversation(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateConversation operation middleware
-func (siw *ServerInterfaceWrapper) UpdateConversation(w http.ResponseWriter, r *http.Request) {
+// RunAutomation operation middleware
+func (siw *ServerInterfaceWrapper) RunAutomation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -840,7 +2460,7 @@ func (siw *ServerInterfaceWrapper) UpdateConversation(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateConversation(w, r, id)
+		siw.Handler.RunAutomation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -850,8 +2470,8 @@ func (siw *ServerInterfaceWrapper) UpdateConversation(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// StreamConversationEvents operation middleware
-func (siw *ServerInterfaceWrapper) StreamConversationEvents(w http.ResponseWriter, r *http.Request) {
+// ToggleAutomation operation middleware
+func (siw *ServerInterfaceWrapper) ToggleAutomation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -865,7 +2485,7 @@ func (siw *ServerInterfaceWrapper) StreamConversationEvents(w http.ResponseWrite
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StreamConversationEvents(w, r, id)
+		siw.Handler.ToggleAutomation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -875,33 +2495,36 @@ func (siw *ServerInterfaceWrapper) StreamConversationEvents(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// ListMessages operation middleware
-func (siw *ServerInterfaceWrapper) ListMessages(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// ListCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) ListCapabilities(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCapabilities(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListMessagesParams
+	handler.ServeHTTP(w, r)
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// UpdateCapability operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCapability(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListMessages(w, r, id, params)
+		siw.Handler.UpdateCapability(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -911,11 +2534,11 @@ func (siw *ServerInterfaceWrapper) ListMessages(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListJobs operation middleware
-func (siw *ServerInterfaceWrapper) ListJobs(w http.ResponseWriter, r *http.Request) {
+// ListConversations operation middleware
+func (siw *ServerInterfaceWrapper) ListConversations(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListJobs(w, r)
+		siw.Handler.ListConversations(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -925,11 +2548,11 @@ func (siw *ServerInterfaceWrapper) ListJobs(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// SubmitJob operation middleware
-func (siw *ServerInterfaceWrapper) SubmitJob(w http.ResponseWriter, r *http.Request) {
+// CreateConversation operation middleware
+func (siw *ServerInterfaceWrapper) CreateConversation(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SubmitJob(w, r)
+		siw.Handler.CreateConversation(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -939,8 +2562,8 @@ func (siw *ServerInterfaceWrapper) SubmitJob(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetJob operation middleware
-func (siw *ServerInterfaceWrapper) GetJob(w http.ResponseWriter, r *http.Request) {
+// DeleteConversation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConversation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -954,7 +2577,7 @@ func (siw *ServerInterfaceWrapper) GetJob(w http.ResponseWriter, r *http.Request
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetJob(w, r, id)
+		siw.Handler.DeleteConversation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -964,8 +2587,8 @@ func (siw *ServerInterfaceWrapper) GetJob(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-// ServeJobFile operation middleware
-func (siw *ServerInterfaceWrapper) ServeJobFile(w http.ResponseWriter, r *http.Request) {
+// GetConversation operation middleware
+func (siw *ServerInterfaceWrapper) GetConversation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -978,17 +2601,8 @@ func (siw *ServerInterfaceWrapper) ServeJobFile(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Path parameter "filename" -------------
-	var filename string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "filename", r.PathValue("filename"), &filename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ServeJobFile(w, r, id, filename)
+		siw.Handler.GetConversation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -998,8 +2612,8 @@ func (siw *ServerInterfaceWrapper) ServeJobFile(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// StreamJob operation middleware
-func (siw *ServerInterfaceWrapper) StreamJob(w http.ResponseWriter, r *http.Request) {
+// UpdateConversation operation middleware
+func (siw *ServerInterfaceWrapper) UpdateConversation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1013,7 +2627,7 @@ func (siw *ServerInterfaceWrapper) StreamJob(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StreamJob(w, r, id)
+		siw.Handler.UpdateConversation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1023,11 +2637,22 @@ func (siw *ServerInterfaceWrapper) StreamJob(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListModels operation middleware
-func (siw *ServerInterfaceWrapper) ListModels(w http.ResponseWriter, r *http.Request) {
+// StreamConversationEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamConversationEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListModels(w, r)
+		siw.Handler.StreamConversationEvents(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1037,25 +2662,33 @@ func (siw *ServerInterfaceWrapper) ListModels(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DiscoverModels operation middleware
-func (siw *ServerInterfaceWrapper) DiscoverModels(w http.ResponseWriter, r *http.Request) {
+// ListMessages operation middleware
+func (siw *ServerInterfaceWrapper) ListMessages(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DiscoverModels(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMessagesParams
 
-// ListPersonas operation middleware
-func (siw *ServerInterfaceWrapper) ListPersonas(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPersonas(w, r)
+		siw.Handler.ListMessages(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1065,11 +2698,11 @@ func (siw *ServerInterfaceWrapper) ListPersonas(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreatePersona operation middleware
-func (siw *ServerInterfaceWrapper) CreatePersona(w http.ResponseWriter, r *http.Request) {
+// RunEvals operation middleware
+func (siw *ServerInterfaceWrapper) RunEvals(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePersona(w, r)
+		siw.Handler.RunEvals(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1079,22 +2712,32 @@ func (siw *ServerInterfaceWrapper) CreatePersona(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeletePersona operation middleware
-func (siw *ServerInterfaceWrapper) DeletePersona(w http.ResponseWriter, r *http.Request) {
+// ListEvalRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListEvalRuns(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEvalRunsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "suite_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "suite_id", r.URL.Query(), &params.SuiteId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "suite_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePersona(w, r, id)
+		siw.Handler.ListEvalRuns(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1104,8 +2747,8 @@ func (siw *ServerInterfaceWrapper) DeletePersona(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetPersona operation middleware
-func (siw *ServerInterfaceWrapper) GetPersona(w http.ResponseWriter, r *http.Request) {
+// GetEvalRun operation middleware
+func (siw *ServerInterfaceWrapper) GetEvalRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1119,7 +2762,7 @@ func (siw *ServerInterfaceWrapper) GetPersona(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPersona(w, r, id)
+		siw.Handler.GetEvalRun(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1129,8 +2772,8 @@ func (siw *ServerInterfaceWrapper) GetPersona(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdatePersona operation middleware
-func (siw *ServerInterfaceWrapper) UpdatePersona(w http.ResponseWriter, r *http.Request) {
+// DiffEvalRuns operation middleware
+func (siw *ServerInterfaceWrapper) DiffEvalRuns(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1143,8 +2786,19 @@ func (siw *ServerInterfaceWrapper) UpdatePersona(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffEvalRunsParams
+
+	// ------------- Optional query parameter "base" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "base", r.URL.Query(), &params.Base)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "base", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdatePersona(w, r, id)
+		siw.Handler.DiffEvalRuns(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1154,11 +2808,11 @@ func (siw *ServerInterfaceWrapper) UpdatePersona(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListPlugins operation middleware
-func (siw *ServerInterfaceWrapper) ListPlugins(w http.ResponseWriter, r *http.Request) {
+// ListEvalSuites operation middleware
+func (siw *ServerInterfaceWrapper) ListEvalSuites(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPlugins(w, r)
+		siw.Handler.ListEvalSuites(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1168,11 +2822,11 @@ func (siw *ServerInterfaceWrapper) ListPlugins(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ListProjects operation middleware
-func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
+// CreateEvalSuite operation middleware
+func (siw *ServerInterfaceWrapper) CreateEvalSuite(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjects(w, r)
+		siw.Handler.CreateEvalSuite(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1182,11 +2836,22 @@ func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateProject operation middleware
-func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
+// DeleteEvalSuite operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEvalSuite(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProject(w, r)
+		siw.Handler.DeleteEvalSuite(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1196,8 +2861,8 @@ func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteProject operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
+// GetEvalSuite operation middleware
+func (siw *ServerInterfaceWrapper) GetEvalSuite(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1211,7 +2876,7 @@ func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProject(w, r, id)
+		siw.Handler.GetEvalSuite(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1221,8 +2886,8 @@ func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetProject operation middleware
-func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+// UpdateEvalSuite operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEvalSuite(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1236,7 +2901,7 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProject(w, r, id)
+		siw.Handler.UpdateEvalSuite(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1246,22 +2911,38 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateProject operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.Request) {
+// StreamBroadcastEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamBroadcastEvents(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamBroadcastEvents(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFeeds operation middleware
+func (siw *ServerInterfaceWrapper) ListFeeds(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFeedsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProject(w, r, id)
+		siw.Handler.ListFeeds(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1271,8 +2952,22 @@ func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListProjectArtifacts operation middleware
-func (siw *ServerInterfaceWrapper) ListProjectArtifacts(w http.ResponseWriter, r *http.Request) {
+// WatchFeed operation middleware
+func (siw *ServerInterfaceWrapper) WatchFeed(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WatchFeed(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnwatchFeed operation middleware
+func (siw *ServerInterfaceWrapper) UnwatchFeed(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1286,7 +2981,7 @@ func (siw *ServerInterfaceWrapper) ListProjectArtifacts(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjectArtifacts(w, r, id)
+		siw.Handler.UnwatchFeed(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1296,8 +2991,8 @@ func (siw *ServerInterfaceWrapper) ListProjectArtifacts(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListProjectConversations operation middleware
-func (siw *ServerInterfaceWrapper) ListProjectConversations(w http.ResponseWriter, r *http.Request) {
+// ToggleFeed operation middleware
+func (siw *ServerInterfaceWrapper) ToggleFeed(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1311,7 +3006,7 @@ func (siw *ServerInterfaceWrapper) ListProjectConversations(w http.ResponseWrite
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjectConversations(w, r, id)
+		siw.Handler.ToggleFeed(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1321,11 +3016,24 @@ func (siw *ServerInterfaceWrapper) ListProjectConversations(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetSettings operation middleware
-func (siw *ServerInterfaceWrapper) GetSettings(w http.ResponseWriter, r *http.Request) {
+// ListHooks operation middleware
+func (siw *ServerInterfaceWrapper) ListHooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListHooksParams
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSettings(w, r)
+		siw.Handler.ListHooks(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1335,11 +3043,11 @@ func (siw *ServerInterfaceWrapper) GetSettings(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateSettings operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+// CreateHook operation middleware
+func (siw *ServerInterfaceWrapper) CreateHook(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSettings(w, r)
+		siw.Handler.CreateHook(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1349,11 +3057,11 @@ func (siw *ServerInterfaceWrapper) UpdateSettings(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// TestConnection operation middleware
-func (siw *ServerInterfaceWrapper) TestConnection(w http.ResponseWriter, r *http.Request) {
+// ListImageBackendHealth operation middleware
+func (siw *ServerInterfaceWrapper) ListImageBackendHealth(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TestConnection(w, r)
+		siw.Handler.ListImageBackendHealth(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1363,11 +3071,11 @@ func (siw *ServerInterfaceWrapper) TestConnection(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListWorkflows operation middleware
-func (siw *ServerInterfaceWrapper) ListWorkflows(w http.ResponseWriter, r *http.Request) {
+// ListJobs operation middleware
+func (siw *ServerInterfaceWrapper) ListJobs(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWorkflows(w, r)
+		siw.Handler.ListJobs(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1377,11 +3085,11 @@ func (siw *ServerInterfaceWrapper) ListWorkflows(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http.Request) {
+// SubmitJob operation middleware
+func (siw *ServerInterfaceWrapper) SubmitJob(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWorkflow(w, r)
+		siw.Handler.SubmitJob(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1391,8 +3099,8 @@ func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Request) {
+// GetJob operation middleware
+func (siw *ServerInterfaceWrapper) GetJob(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1406,7 +3114,7 @@ func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWorkflow(w, r, id)
+		siw.Handler.GetJob(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1416,8 +3124,8 @@ func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ResumeWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) ResumeWorkflow(w http.ResponseWriter, r *http.Request) {
+// ServeJobFile operation middleware
+func (siw *ServerInterfaceWrapper) ServeJobFile(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1430,8 +3138,17 @@ func (siw *ServerInterfaceWrapper) ResumeWorkflow(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "filename" -------------
+	var filename string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "filename", r.PathValue("filename"), &filename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResumeWorkflow(w, r, id)
+		siw.Handler.ServeJobFile(w, r, id, filename)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1441,8 +3158,8 @@ func (siw *ServerInterfaceWrapper) ResumeWorkflow(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// RunWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) RunWorkflow(w http.ResponseWriter, r *http.Request) {
+// StreamJob operation middleware
+func (siw *ServerInterfaceWrapper) StreamJob(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1456,7 +3173,7 @@ func (siw *ServerInterfaceWrapper) RunWorkflow(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RunWorkflow(w, r, id)
+		siw.Handler.StreamJob(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1466,1072 +3183,4178 @@ func (siw *ServerInterfaceWrapper) RunWorkflow(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// ListModels operation middleware
+func (siw *ServerInterfaceWrapper) ListModels(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListModels(w, r)
+	}))
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// DiscoverModels operation middleware
+func (siw *ServerInterfaceWrapper) DiscoverModels(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiscoverModels(w, r)
+	}))
 
-type RequiredParamError struct {
-	ParamName string
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// ListNodes operation middleware
+func (siw *ServerInterfaceWrapper) ListNodes(w http.ResponseWriter, r *http.Request) {
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNodes(w, r)
+	}))
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// RegisterNode operation middleware
+func (siw *ServerInterfaceWrapper) RegisterNode(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterNode(w, r)
+	}))
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
-
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
+	handler.ServeHTTP(w, r)
 }
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+// DeleteNode operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNode(w http.ResponseWriter, r *http.Request) {
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var err error
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNode(w, r, id)
+	}))
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	handler.ServeHTTP(w, r)
+}
 
-	m.HandleFunc("POST "+options.BaseURL+"/v1/agent/chat", wrapper.AgentChat)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/artifacts", wrapper.ListArtifacts)
-	m.HandleFunc("DELETE "+options.BaseURL+"/v1/artifacts/{id}", wrapper.DeleteArtifact)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/artifacts/{id}", wrapper.GetArtifact)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/capabilities", wrapper.ListCapabilities)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/conversations", wrapper.ListConversations)
-	m.HandleFunc("POST "+options.BaseURL+"/v1/conversations", wrapper.CreateConversation)
-	m.HandleFunc("DELETE "+options.BaseURL+"/v1/conversations/{id}", wrapper.DeleteConversation)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/conversations/{id}", wrapper.GetConversation)
-	m.HandleFunc("PATCH "+options.BaseURL+"/v1/conversations/{id}", wrapper.UpdateConversation)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/conversations/{id}/events", wrapper.StreamConversationEvents)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/conversations/{id}/messages", wrapper.ListMessages)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/jobs", wrapper.ListJobs)
-	m.HandleFunc("POST "+options.BaseURL+"/v1/jobs", wrapper.SubmitJob)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/jobs/{id}", wrapper.GetJob)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/jobs/{id}/files/{filename}", wrapper.ServeJobFile)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/jobs/{id}/stream", wrapper.StreamJob)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/models", wrapper.ListModels)
-	m.HandleFunc("POST "+options.BaseURL+"/v1/models/discover", wrapper.DiscoverModels)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/personas", wrapper.ListPersonas)
-	m.HandleFunc("POST "+options.BaseURL+"/v1/personas", wrapper.CreatePersona)
-	m.HandleFunc("DELETE "+options.BaseURL+"/v1/personas/{id}", wrapper.DeletePersona)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/personas/{id}", wrapper.GetPersona)
-	m.HandleFunc("PATCH "+options.BaseURL+"/v1/personas/{id}", wrapper.UpdatePersona)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/plugins", wrapper.ListPlugins)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/projects", wrapper.ListProjects)
-	m.HandleFunc("POST "+options.BaseURL+"/v1/projects", wrapper.CreateProject)
-	m.HandleFunc("DELETE "+options.BaseURL+"/v1/projects/{id}", wrapper.DeleteProject)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/projects/{id}", wrapper.GetProject)
-	m.HandleFunc("PATCH "+options.BaseURL+"/v1/projects/{id}", wrapper.UpdateProject)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/projects/{id}/artifacts", wrapper.ListProjectArtifacts)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/projects/{id}/conversations", wrapper.ListProjectConversations)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/settings", wrapper.GetSettings)
-	m.HandleFunc("PUT "+options.BaseURL+"/v1/settings", wrapper.UpdateSettings)
-	m.HandleFunc("POST "+options.BaseURL+"/v1/settings/test", wrapper.TestConnection)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/workflows", wrapper.ListWorkflows)
-	m.HandleFunc("POST "+options.BaseURL+"/v1/workflows", wrapper.CreateWorkflow)
-	m.HandleFunc("GET "+options.BaseURL+"/v1/workflows/{id}", wrapper.GetWorkflow)
-	m.HandleFunc("POST "+options.BaseURL+"/v1/workflows/{id}/resume", wrapper.ResumeWorkflow)
-	m.HandleFunc("POST "+options.BaseURL+"/v1/workflows/{id}/run", wrapper.RunWorkflow)
+// ListPersonas operation middleware
+func (siw *ServerInterfaceWrapper) ListPersonas(w http.ResponseWriter, r *http.Request) {
 
-	return m
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPersonas(w, r)
+	}))
 
-type AgentChatRequestObject struct {
-	Body *AgentChatJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type AgentChatResponseObject interface {
-	VisitAgentChatResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type AgentChat200JSONResponse ChatResponse
+// CreatePersona operation middleware
+func (siw *ServerInterfaceWrapper) CreatePersona(w http.ResponseWriter, r *http.Request) {
 
-func (response AgentChat200JSONResponse) VisitAgentChatResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePersona(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type AgentChat500JSONResponse Error
+// DeletePersona operation middleware
+func (siw *ServerInterfaceWrapper) DeletePersona(w http.ResponseWriter, r *http.Request) {
 
-func (response AgentChat500JSONResponse) VisitAgentChatResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type ListArtifactsRequestObject struct {
-	Params ListArtifactsParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ListArtifactsResponseObject interface {
-	VisitListArtifactsResponse(w http.ResponseWriter) error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePersona(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ListArtifacts200JSONResponse []Artifact
+// GetPersona operation middleware
+func (siw *ServerInterfaceWrapper) GetPersona(w http.ResponseWriter, r *http.Request) {
 
-func (response ListArtifacts200JSONResponse) VisitListArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type DeleteArtifactRequestObject struct {
-	Id string `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type DeleteArtifactResponseObject interface {
-	VisitDeleteArtifactResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPersona(w, r, id)
+	}))
 
-type DeleteArtifact204Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteArtifact204Response) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteArtifact404Response struct {
-}
+// UpdatePersona operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePersona(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteArtifact404Response) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	var err error
 
-type GetArtifactRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetArtifactResponseObject interface {
-	VisitGetArtifactResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetArtifact200JSONResponse Artifact
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePersona(w, r, id)
+	}))
 
-func (response GetArtifact200JSONResponse) VisitGetArtifactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetArtifact404JSONResponse Error
+// ListPlugins operation middleware
+func (siw *ServerInterfaceWrapper) ListPlugins(w http.ResponseWriter, r *http.Request) {
 
-func (response GetArtifact404JSONResponse) VisitGetArtifactResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPlugins(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListCapabilitiesRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type ListCapabilitiesResponseObject interface {
-	VisitListCapabilitiesResponse(w http.ResponseWriter) error
-}
+// ListProjects operation middleware
+func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
 
-type ListCapabilities200JSONResponse CapabilityListResponse
-
-func (response ListCapabilities200JSONResponse) VisitListCapabilitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjects(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListConversationsRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type ListConversationsResponseObject interface {
-	VisitListConversationsResponse(w http.ResponseWriter) error
-}
+// CreateProject operation middleware
+func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
 
-type ListConversations200JSONResponse []Conversation
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProject(w, r)
+	}))
 
-func (response ListConversations200JSONResponse) VisitListConversationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateConversationRequestObject struct {
-	Body *CreateConversationJSONRequestBody
-}
+// DeleteProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
 
-type CreateConversationResponseObject interface {
-	VisitCreateConversationResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type CreateConversation201JSONResponse Conversation
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response CreateConversation201JSONResponse) VisitCreateConversationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProject(w, r, id)
+	}))
 
-type DeleteConversationRequestObject struct {
-	Id string `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteConversationResponseObject interface {
-	VisitDeleteConversationResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteConversation204Response struct {
-}
+// GetProject operation middleware
+func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteConversation204Response) VisitDeleteConversationResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	var err error
 
-type DeleteConversation404Response struct {
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response DeleteConversation404Response) VisitDeleteConversationResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetConversationRequestObject struct {
-	Id string `json:"id"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProject(w, r, id)
+	}))
 
-type GetConversationResponseObject interface {
-	VisitGetConversationResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetConversation200JSONResponse Conversation
+// UpdateProject operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.Request) {
 
-func (response GetConversation200JSONResponse) VisitGetConversationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetConversation404JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetConversation404JSONResponse) VisitGetConversationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProject(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateConversationRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateConversationJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateConversationResponseObject interface {
-	VisitUpdateConversationResponse(w http.ResponseWriter) error
-}
+// ListProjectArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectArtifacts(w http.ResponseWriter, r *http.Request) {
 
-type UpdateConversation200JSONResponse Conversation
+	var err error
 
-func (response UpdateConversation200JSONResponse) VisitUpdateConversationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type StreamConversationEventsRequestObject struct {
-	Id string `json:"id"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectArtifacts(w, r, id)
+	}))
 
-type StreamConversationEventsResponseObject interface {
-	VisitStreamConversationEventsResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type StreamConversationEvents200TexteventStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
+	handler.ServeHTTP(w, r)
 }
 
-func (response StreamConversationEvents200TexteventStreamResponse) VisitStreamConversationEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(200)
+// ListProjectConversations operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectConversations(w http.ResponseWriter, r *http.Request) {
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
 
-type ListMessagesRequestObject struct {
-	Id     string `json:"id"`
-	Params ListMessagesParams
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectConversations(w, r, id)
+	}))
 
-type ListMessagesResponseObject interface {
-	VisitListMessagesResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ListMessages200JSONResponse []Message
+// GetSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetSettings(w http.ResponseWriter, r *http.Request) {
 
-func (response ListMessages200JSONResponse) VisitListMessagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSettings(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListJobsRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type ListJobsResponseObject interface {
-	VisitListJobsResponse(w http.ResponseWriter) error
-}
+// UpdateSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSettings(w http.ResponseWriter, r *http.Request) {
 
-type ListJobs200JSONResponse []Job
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSettings(w, r)
+	}))
 
-func (response ListJobs200JSONResponse) VisitListJobsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListJobs500JSONResponse Error
+// GetCalendarSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarSettings(w http.ResponseWriter, r *http.Request) {
 
-func (response ListJobs500JSONResponse) VisitListJobsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarSettings(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type SubmitJobRequestObject struct {
-	Body *SubmitJobJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type SubmitJobResponseObject interface {
-	VisitSubmitJobResponse(w http.ResponseWriter) error
-}
+// UpdateCalendarSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCalendarSettings(w http.ResponseWriter, r *http.Request) {
 
-type SubmitJob201JSONResponse JobResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCalendarSettings(w, r)
+	}))
 
-func (response SubmitJob201JSONResponse) VisitSubmitJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type SubmitJob400JSONResponse Error
+// GetEmailSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetEmailSettings(w http.ResponseWriter, r *http.Request) {
 
-func (response SubmitJob400JSONResponse) VisitSubmitJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEmailSettings(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type SubmitJob500JSONResponse Error
+// UpdateEmailSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEmailSettings(w http.ResponseWriter, r *http.Request) {
 
-func (response SubmitJob500JSONResponse) VisitSubmitJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateEmailSettings(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetJobRequestObject struct {
-	Id string `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetJobResponseObject interface {
-	VisitGetJobResponse(w http.ResponseWriter) error
-}
+// GetEmbeddingSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetEmbeddingSettings(w http.ResponseWriter, r *http.Request) {
 
-type GetJob200JSONResponse Job
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEmbeddingSettings(w, r)
+	}))
 
-func (response GetJob200JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetJob404JSONResponse Error
-
-func (response GetJob404JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// UpdateEmbeddingSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEmbeddingSettings(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateEmbeddingSettings(w, r)
+	}))
 
-type ServeJobFileRequestObject struct {
-	Id       string `json:"id"`
-	Filename string `json:"filename"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ServeJobFileResponseObject interface {
-	VisitServeJobFileResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ServeJobFile200ApplicationoctetStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+// UpdateImageBackends operation middleware
+func (siw *ServerInterfaceWrapper) UpdateImageBackends(w http.ResponseWriter, r *http.Request) {
 
-func (response ServeJobFile200ApplicationoctetStreamResponse) VisitServeJobFileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/octet-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateImageBackends(w, r)
+	}))
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
 
-type ServeJobFile404Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response ServeJobFile404Response) VisitServeJobFileResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+// GetRuntimeSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetRuntimeSettings(w http.ResponseWriter, r *http.Request) {
 
-type ServeJobFile500Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRuntimeSettings(w, r)
+	}))
 
-func (response ServeJobFile500Response) VisitServeJobFileResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type StreamJobRequestObject struct {
-	Id string `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type StreamJobResponseObject interface {
-	VisitStreamJobResponse(w http.ResponseWriter) error
-}
+// UpdateRuntimeSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRuntimeSettings(w http.ResponseWriter, r *http.Request) {
 
-type StreamJob200TexteventStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRuntimeSettings(w, r)
+	}))
 
-func (response StreamJob200TexteventStreamResponse) VisitStreamJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	handler.ServeHTTP(w, r)
 }
 
-type StreamJob404Response struct {
-}
+// GetSearchSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetSearchSettings(w http.ResponseWriter, r *http.Request) {
 
-func (response StreamJob404Response) VisitStreamJobResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSearchSettings(w, r)
+	}))
 
-type ListModelsRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListModelsResponseObject interface {
-	VisitListModelsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListModels200JSONResponse []ModelSpec
+// UpdateSearchSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSearchSettings(w http.ResponseWriter, r *http.Request) {
 
-func (response ListModels200JSONResponse) VisitListModelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSearchSettings(w, r)
+	}))
 
-type DiscoverModelsRequestObject struct {
-	Body *DiscoverModelsJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DiscoverModelsResponseObject interface {
-	VisitDiscoverModelsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type DiscoverModels200JSONResponse []ModelSpec
+// TestConnection operation middleware
+func (siw *ServerInterfaceWrapper) TestConnection(w http.ResponseWriter, r *http.Request) {
 
-func (response DiscoverModels200JSONResponse) VisitDiscoverModelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestConnection(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListPersonasRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type ListPersonasResponseObject interface {
-	VisitListPersonasResponse(w http.ResponseWriter) error
-}
+// SearchSpans operation middleware
+func (siw *ServerInterfaceWrapper) SearchSpans(w http.ResponseWriter, r *http.Request) {
 
-type ListPersonas200JSONResponse []Persona
+	var err error
 
-func (response ListPersonas200JSONResponse) VisitListPersonasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchSpansParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "kind" -------------
 
-type CreatePersonaRequestObject struct {
-	Body *CreatePersonaJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
 
-type CreatePersonaResponseObject interface {
-	VisitCreatePersonaResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "name_prefix" -------------
 
-type CreatePersona201JSONResponse Persona
+	err = runtime.BindQueryParameter("form", true, false, "name_prefix", r.URL.Query(), &params.NamePrefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name_prefix", Err: err})
+		return
+	}
 
-func (response CreatePersona201JSONResponse) VisitCreatePersonaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional query parameter "status" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-type DeletePersonaRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Optional query parameter "trace_id" -------------
 
-type DeletePersonaResponseObject interface {
-	VisitDeletePersonaResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "trace_id", r.URL.Query(), &params.TraceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trace_id", Err: err})
+		return
+	}
 
-type DeletePersona204Response struct {
-}
+	// ------------- Optional query parameter "conversation_id" -------------
 
-func (response DeletePersona204Response) VisitDeletePersonaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "conversation_id", r.URL.Query(), &params.ConversationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
+	}
 
-type DeletePersona404Response struct {
-}
+	// ------------- Optional query parameter "min_duration_ms" -------------
 
-func (response DeletePersona404Response) VisitDeletePersonaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "min_duration_ms", r.URL.Query(), &params.MinDurationMs)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_duration_ms", Err: err})
+		return
+	}
 
-type GetPersonaRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Optional query parameter "since" -------------
 
-type GetPersonaResponseObject interface {
-	VisitGetPersonaResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
 
-type GetPersona200JSONResponse Persona
+	// ------------- Optional query parameter "until" -------------
 
-func (response GetPersona200JSONResponse) VisitGetPersonaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetPersona404JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response GetPersona404JSONResponse) VisitGetPersonaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchSpans(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdatePersonaRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdatePersonaJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdatePersonaResponseObject interface {
-	VisitUpdatePersonaResponse(w http.ResponseWriter) error
-}
+// CreateDiagnosticsBundle operation middleware
+func (siw *ServerInterfaceWrapper) CreateDiagnosticsBundle(w http.ResponseWriter, r *http.Request) {
 
-type UpdatePersona200JSONResponse Persona
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDiagnosticsBundle(w, r)
+	}))
 
-func (response UpdatePersona200JSONResponse) VisitUpdatePersonaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdatePersona404JSONResponse Error
-
-func (response UpdatePersona404JSONResponse) VisitUpdatePersonaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetKernelInbox operation middleware
+func (siw *ServerInterfaceWrapper) GetKernelInbox(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetKernelInbox(w, r)
+	}))
 
-type ListPluginsRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListPluginsResponseObject interface {
-	VisitListPluginsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListPlugins200JSONResponse PluginListResponse
+// QueryLogs operation middleware
+func (siw *ServerInterfaceWrapper) QueryLogs(w http.ResponseWriter, r *http.Request) {
 
-func (response ListPlugins200JSONResponse) VisitListPluginsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QueryLogsParams
 
-type ListProjectsRequestObject struct {
-}
+	// ------------- Optional query parameter "level" -------------
 
-type ListProjectsResponseObject interface {
-	VisitListProjectsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+		return
+	}
 
-type ListProjects200JSONResponse []Project
+	// ------------- Optional query parameter "since" -------------
 
-func (response ListProjects200JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "component" -------------
 
-type CreateProjectRequestObject struct {
-	Body *CreateProjectJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "component", r.URL.Query(), &params.Component)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "component", Err: err})
+		return
+	}
 
-type CreateProjectResponseObject interface {
-	VisitCreateProjectResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "request_id" -------------
 
-type CreateProject201JSONResponse Project
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
+		return
+	}
 
-func (response CreateProject201JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type DeleteProjectRequestObject struct {
-	Id string `json:"id"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryLogs(w, r, params)
+	}))
 
-type DeleteProjectResponseObject interface {
-	VisitDeleteProjectResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteProject204Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// PauseSystem operation middleware
+func (siw *ServerInterfaceWrapper) PauseSystem(w http.ResponseWriter, r *http.Request) {
 
-type DeleteProject404Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseSystem(w, r)
+	}))
 
-func (response DeleteProject404Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetProjectRequestObject struct {
-	Id string `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetProjectResponseObject interface {
-	VisitGetProjectResponse(w http.ResponseWriter) error
-}
+// ResumeSystem operation middleware
+func (siw *ServerInterfaceWrapper) ResumeSystem(w http.ResponseWriter, r *http.Request) {
 
-type GetProject200JSONResponse Project
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeSystem(w, r)
+	}))
 
-func (response GetProject200JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetProject404JSONResponse Error
-
-func (response GetProject404JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetSSEStats operation middleware
+func (siw *ServerInterfaceWrapper) GetSSEStats(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSSEStats(w, r)
+	}))
 
-type UpdateProjectRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateProjectJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateProjectResponseObject interface {
-	VisitUpdateProjectResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateProject200JSONResponse Project
+// GetSystemStats operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemStats(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateProject200JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSystemStatsParams
 
-type UpdateProject404JSONResponse Error
+	// ------------- Optional query parameter "refresh" -------------
 
-func (response UpdateProject404JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "refresh", r.URL.Query(), &params.Refresh)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSystemStats(w, r, params)
+	}))
 
-type ListProjectArtifactsRequestObject struct {
-	Id string `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListProjectArtifactsResponseObject interface {
-	VisitListProjectArtifactsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListProjectArtifacts200JSONResponse []Artifact
-
-func (response ListProjectArtifacts200JSONResponse) VisitListProjectArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetMaintenanceStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetMaintenanceStatus(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMaintenanceStatus(w, r)
+	}))
 
-type ListProjectConversationsRequestObject struct {
-	Id string `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListProjectConversationsResponseObject interface {
-	VisitListProjectConversationsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListProjectConversations200JSONResponse []Conversation
-
-func (response ListProjectConversations200JSONResponse) VisitListProjectConversationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVersion(w, r)
+	}))
 
-type GetSettingsRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetSettingsResponseObject interface {
-	VisitGetSettingsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetSettings200JSONResponse AppConfig
+// ListScheduledTasks operation middleware
+func (siw *ServerInterfaceWrapper) ListScheduledTasks(w http.ResponseWriter, r *http.Request) {
 
-func (response GetSettings200JSONResponse) VisitGetSettingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListScheduledTasks(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateSettingsRequestObject struct {
-	Body *UpdateSettingsJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateSettingsResponseObject interface {
-	VisitUpdateSettingsResponse(w http.ResponseWriter) error
-}
+// CreateScheduledTask operation middleware
+func (siw *ServerInterfaceWrapper) CreateScheduledTask(w http.ResponseWriter, r *http.Request) {
 
-type UpdateSettings200JSONResponse AppConfig
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateScheduledTask(w, r)
+	}))
 
-func (response UpdateSettings200JSONResponse) VisitUpdateSettingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateSettings400JSONResponse Error
+// DeleteScheduledTask operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScheduledTask(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateSettings400JSONResponse) VisitUpdateSettingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type TestConnectionRequestObject struct {
-	Body *TestConnectionJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type TestConnectionResponseObject interface {
-	VisitTestConnectionResponse(w http.ResponseWriter) error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteScheduledTask(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type TestConnection200JSONResponse ConnectionTestResult
+// ToggleScheduledTask operation middleware
+func (siw *ServerInterfaceWrapper) ToggleScheduledTask(w http.ResponseWriter, r *http.Request) {
 
-func (response TestConnection200JSONResponse) VisitTestConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type ListWorkflowsRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ListWorkflowsResponseObject interface {
-	VisitListWorkflowsResponse(w http.ResponseWriter) error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ToggleScheduledTask(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ListWorkflows200JSONResponse []Workflow
+// ListTools operation middleware
+func (siw *ServerInterfaceWrapper) ListTools(w http.ResponseWriter, r *http.Request) {
 
-func (response ListWorkflows200JSONResponse) VisitListWorkflowsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTools(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateWorkflowRequestObject struct {
-	Body *CreateWorkflowJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type CreateWorkflowResponseObject interface {
-	VisitCreateWorkflowResponse(w http.ResponseWriter) error
-}
+// RunTool operation middleware
+func (siw *ServerInterfaceWrapper) RunTool(w http.ResponseWriter, r *http.Request) {
 
-type CreateWorkflow201JSONResponse Workflow
+	var err error
 
-func (response CreateWorkflow201JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-type CreateWorkflow400Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunTool(w, r, name)
+	}))
 
-func (response CreateWorkflow400Response) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetWorkflowRequestObject struct {
-	Id string `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetWorkflowResponseObject interface {
-	VisitGetWorkflowResponse(w http.ResponseWriter) error
-}
+// ListTraces operation middleware
+func (siw *ServerInterfaceWrapper) ListTraces(w http.ResponseWriter, r *http.Request) {
 
-type GetWorkflow200JSONResponse Workflow
+	var err error
 
-func (response GetWorkflow200JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTracesParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetWorkflow404Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response GetWorkflow404Response) VisitGetWorkflowResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	// ------------- Optional query parameter "status" -------------
 
-type ResumeWorkflowRequestObject struct {
-	Id   string `json:"id"`
-	Body *ResumeWorkflowJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-type ResumeWorkflowResponseObject interface {
-	VisitResumeWorkflowResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "conversation_id" -------------
 
-type ResumeWorkflow200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "conversation_id", r.URL.Query(), &params.ConversationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
+	}
 
-func (response ResumeWorkflow200JSONResponse) VisitResumeWorkflowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "persona_id" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "persona_id", r.URL.Query(), &params.PersonaId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "persona_id", Err: err})
+		return
+	}
 
-type RunWorkflowRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Optional query parameter "request_id" -------------
 
-type RunWorkflowResponseObject interface {
-	VisitRunWorkflowResponse(w http.ResponseWriter) error
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_duration_ms" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_duration_ms", r.URL.Query(), &params.MinDurationMs)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_duration_ms", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTraces(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type RunWorkflow200JSONResponse struct {
-	Id     *string `json:"id,omitempty"`
-	Status *string `json:"status,omitempty"`
+// GetTrace operation middleware
+func (siw *ServerInterfaceWrapper) GetTrace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrace(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response RunWorkflow200JSONResponse) VisitRunWorkflowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListWorkers operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkers(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type RunWorkflow404Response struct {
+// KillWorker operation middleware
+func (siw *ServerInterfaceWrapper) KillWorker(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.KillWorker(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response RunWorkflow404Response) VisitRunWorkflowResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+// GetWorker operation middleware
+func (siw *ServerInterfaceWrapper) GetWorker(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorker(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Chat with the Agent
-	// (POST /v1/agent/chat)
-	AgentChat(ctx context.Context, request AgentChatRequestObject) (AgentChatResponseObject, error)
-	// List all artifacts
+// GetWorkerLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkerLogs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkerLogsParams
+
+	// ------------- Optional query parameter "tail" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tail", r.URL.Query(), &params.Tail)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tail", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkerLogs(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWorkflows operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkflows(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkflows(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkflow(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkflow(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamWorkflowEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamWorkflowEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamWorkflowEvents(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) ResumeWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeWorkflow(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) RunWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunWorkflow(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("POST "+options.BaseURL+"/v1/agent/chat", wrapper.AgentChat)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/agent/chat/stream", wrapper.StreamAgentChat)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/artifacts", wrapper.ListArtifacts)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/artifacts/{id}", wrapper.DeleteArtifact)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/artifacts/{id}", wrapper.GetArtifact)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/automations", wrapper.ListAutomations)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/automations", wrapper.CreateAutomation)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/automations/{id}", wrapper.DeleteAutomation)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/automations/{id}", wrapper.GetAutomation)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/automations/{id}/run", wrapper.RunAutomation)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/automations/{id}/toggle", wrapper.ToggleAutomation)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/capabilities", wrapper.ListCapabilities)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/capabilities/{name}", wrapper.UpdateCapability)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/conversations", wrapper.ListConversations)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/conversations", wrapper.CreateConversation)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/conversations/{id}", wrapper.DeleteConversation)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/conversations/{id}", wrapper.GetConversation)
+	m.HandleFunc("PATCH "+options.BaseURL+"/v1/conversations/{id}", wrapper.UpdateConversation)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/conversations/{id}/events", wrapper.StreamConversationEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/conversations/{id}/messages", wrapper.ListMessages)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/evals/run", wrapper.RunEvals)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/evals/runs", wrapper.ListEvalRuns)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/evals/runs/{id}", wrapper.GetEvalRun)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/evals/runs/{id}/diff", wrapper.DiffEvalRuns)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/evals/suites", wrapper.ListEvalSuites)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/evals/suites", wrapper.CreateEvalSuite)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/evals/suites/{id}", wrapper.DeleteEvalSuite)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/evals/suites/{id}", wrapper.GetEvalSuite)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/evals/suites/{id}", wrapper.UpdateEvalSuite)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/events", wrapper.StreamBroadcastEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/feeds", wrapper.ListFeeds)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/feeds", wrapper.WatchFeed)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/feeds/{id}", wrapper.UnwatchFeed)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/feeds/{id}/toggle", wrapper.ToggleFeed)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/hooks", wrapper.ListHooks)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/hooks", wrapper.CreateHook)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/image/backends", wrapper.ListImageBackendHealth)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/jobs", wrapper.ListJobs)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/jobs", wrapper.SubmitJob)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/jobs/{id}", wrapper.GetJob)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/jobs/{id}/files/{filename}", wrapper.ServeJobFile)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/jobs/{id}/stream", wrapper.StreamJob)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/models", wrapper.ListModels)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/models/discover", wrapper.DiscoverModels)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/nodes", wrapper.ListNodes)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/nodes", wrapper.RegisterNode)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/nodes/{id}", wrapper.DeleteNode)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/personas", wrapper.ListPersonas)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/personas", wrapper.CreatePersona)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/personas/{id}", wrapper.DeletePersona)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/personas/{id}", wrapper.GetPersona)
+	m.HandleFunc("PATCH "+options.BaseURL+"/v1/personas/{id}", wrapper.UpdatePersona)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/plugins", wrapper.ListPlugins)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/projects", wrapper.ListProjects)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/projects", wrapper.CreateProject)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/projects/{id}", wrapper.DeleteProject)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/projects/{id}", wrapper.GetProject)
+	m.HandleFunc("PATCH "+options.BaseURL+"/v1/projects/{id}", wrapper.UpdateProject)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/projects/{id}/artifacts", wrapper.ListProjectArtifacts)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/projects/{id}/conversations", wrapper.ListProjectConversations)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/settings", wrapper.GetSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/settings", wrapper.UpdateSettings)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/settings/calendar", wrapper.GetCalendarSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/settings/calendar", wrapper.UpdateCalendarSettings)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/settings/email", wrapper.GetEmailSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/settings/email", wrapper.UpdateEmailSettings)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/settings/embeddings", wrapper.GetEmbeddingSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/settings/embeddings", wrapper.UpdateEmbeddingSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/settings/image-backends", wrapper.UpdateImageBackends)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/settings/runtime", wrapper.GetRuntimeSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/settings/runtime", wrapper.UpdateRuntimeSettings)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/settings/search", wrapper.GetSearchSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/settings/search", wrapper.UpdateSearchSettings)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/settings/test", wrapper.TestConnection)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/spans", wrapper.SearchSpans)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/system/diagnostics", wrapper.CreateDiagnosticsBundle)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/system/inbox", wrapper.GetKernelInbox)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/system/logs", wrapper.QueryLogs)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/system/pause", wrapper.PauseSystem)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/system/resume", wrapper.ResumeSystem)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/system/sse", wrapper.GetSSEStats)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/system/stats", wrapper.GetSystemStats)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/system/status", wrapper.GetMaintenanceStatus)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/system/version", wrapper.GetVersion)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/tasks", wrapper.ListScheduledTasks)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/tasks", wrapper.CreateScheduledTask)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/tasks/{id}", wrapper.DeleteScheduledTask)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/tasks/{id}/toggle", wrapper.ToggleScheduledTask)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/tools", wrapper.ListTools)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/tools/{name}/run", wrapper.RunTool)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/traces", wrapper.ListTraces)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/traces/{id}", wrapper.GetTrace)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/workers", wrapper.ListWorkers)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/workers/{id}", wrapper.KillWorker)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/workers/{id}", wrapper.GetWorker)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/workers/{id}/logs", wrapper.GetWorkerLogs)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/workflows", wrapper.ListWorkflows)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/workflows", wrapper.CreateWorkflow)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/workflows/{id}", wrapper.GetWorkflow)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/workflows/{id}/events", wrapper.StreamWorkflowEvents)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/workflows/{id}/resume", wrapper.ResumeWorkflow)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/workflows/{id}/run", wrapper.RunWorkflow)
+
+	return m
+}
+
+type AgentChatRequestObject struct {
+	Body *AgentChatJSONRequestBody
+}
+
+type AgentChatResponseObject interface {
+	VisitAgentChatResponse(w http.ResponseWriter) error
+}
+
+type AgentChat200JSONResponse ChatResponse
+
+func (response AgentChat200JSONResponse) VisitAgentChatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentChat409JSONResponse Error
+
+func (response AgentChat409JSONResponse) VisitAgentChatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentChat500JSONResponse Error
+
+func (response AgentChat500JSONResponse) VisitAgentChatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamAgentChatRequestObject struct {
+	Body *StreamAgentChatJSONRequestBody
+}
+
+type StreamAgentChatResponseObject interface {
+	VisitStreamAgentChatResponse(w http.ResponseWriter) error
+}
+
+type StreamAgentChat200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamAgentChat200TexteventStreamResponse) VisitStreamAgentChatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamAgentChat400Response struct {
+}
+
+func (response StreamAgentChat400Response) VisitStreamAgentChatResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ListArtifactsRequestObject struct {
+	Params ListArtifactsParams
+}
+
+type ListArtifactsResponseObject interface {
+	VisitListArtifactsResponse(w http.ResponseWriter) error
+}
+
+type ListArtifacts200JSONResponse []Artifact
+
+func (response ListArtifacts200JSONResponse) VisitListArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteArtifactResponseObject interface {
+	VisitDeleteArtifactResponse(w http.ResponseWriter) error
+}
+
+type DeleteArtifact204Response struct {
+}
+
+func (response DeleteArtifact204Response) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteArtifact404Response struct {
+}
+
+func (response DeleteArtifact404Response) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetArtifactRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetArtifactResponseObject interface {
+	VisitGetArtifactResponse(w http.ResponseWriter) error
+}
+
+type GetArtifact200JSONResponse Artifact
+
+func (response GetArtifact200JSONResponse) VisitGetArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifact404JSONResponse Error
+
+func (response GetArtifact404JSONResponse) VisitGetArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAutomationsRequestObject struct {
+	Params ListAutomationsParams
+}
+
+type ListAutomationsResponseObject interface {
+	VisitListAutomationsResponse(w http.ResponseWriter) error
+}
+
+type ListAutomations200JSONResponse AutomationListResponse
+
+func (response ListAutomations200JSONResponse) VisitListAutomationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAutomations503JSONResponse Error
+
+func (response ListAutomations503JSONResponse) VisitListAutomationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAutomationRequestObject struct {
+	Body *CreateAutomationJSONRequestBody
+}
+
+type CreateAutomationResponseObject interface {
+	VisitCreateAutomationResponse(w http.ResponseWriter) error
+}
+
+type CreateAutomation201JSONResponse Automation
+
+func (response CreateAutomation201JSONResponse) VisitCreateAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAutomation400JSONResponse Error
+
+func (response CreateAutomation400JSONResponse) VisitCreateAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAutomation503JSONResponse Error
+
+func (response CreateAutomation503JSONResponse) VisitCreateAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAutomationRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteAutomationResponseObject interface {
+	VisitDeleteAutomationResponse(w http.ResponseWriter) error
+}
+
+type DeleteAutomation204Response struct {
+}
+
+func (response DeleteAutomation204Response) VisitDeleteAutomationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAutomation404JSONResponse Error
+
+func (response DeleteAutomation404JSONResponse) VisitDeleteAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAutomation503JSONResponse Error
+
+func (response DeleteAutomation503JSONResponse) VisitDeleteAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutomationRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetAutomationResponseObject interface {
+	VisitGetAutomationResponse(w http.ResponseWriter) error
+}
+
+type GetAutomation200JSONResponse Automation
+
+func (response GetAutomation200JSONResponse) VisitGetAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutomation404JSONResponse Error
+
+func (response GetAutomation404JSONResponse) VisitGetAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutomation503JSONResponse Error
+
+func (response GetAutomation503JSONResponse) VisitGetAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunAutomationRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RunAutomationResponseObject interface {
+	VisitRunAutomationResponse(w http.ResponseWriter) error
+}
+
+type RunAutomation200JSONResponse Automation
+
+func (response RunAutomation200JSONResponse) VisitRunAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunAutomation404JSONResponse Error
+
+func (response RunAutomation404JSONResponse) VisitRunAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunAutomation503JSONResponse Error
+
+func (response RunAutomation503JSONResponse) VisitRunAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleAutomationRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ToggleAutomationResponseObject interface {
+	VisitToggleAutomationResponse(w http.ResponseWriter) error
+}
+
+type ToggleAutomation200JSONResponse Automation
+
+func (response ToggleAutomation200JSONResponse) VisitToggleAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleAutomation404JSONResponse Error
+
+func (response ToggleAutomation404JSONResponse) VisitToggleAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleAutomation503JSONResponse Error
+
+func (response ToggleAutomation503JSONResponse) VisitToggleAutomationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCapabilitiesRequestObject struct {
+}
+
+type ListCapabilitiesResponseObject interface {
+	VisitListCapabilitiesResponse(w http.ResponseWriter) error
+}
+
+type ListCapabilities200JSONResponse CapabilityListResponse
+
+func (response ListCapabilities200JSONResponse) VisitListCapabilitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCapabilityRequestObject struct {
+	Name string `json:"name"`
+	Body *UpdateCapabilityJSONRequestBody
+}
+
+type UpdateCapabilityResponseObject interface {
+	VisitUpdateCapabilityResponse(w http.ResponseWriter) error
+}
+
+type UpdateCapability200JSONResponse Capability
+
+func (response UpdateCapability200JSONResponse) VisitUpdateCapabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCapability400JSONResponse Error
+
+func (response UpdateCapability400JSONResponse) VisitUpdateCapabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCapability503Response struct {
+}
+
+func (response UpdateCapability503Response) VisitUpdateCapabilityResponse(w http.ResponseWriter) error {
+	w.WriteHeader(503)
+	return nil
+}
+
+type ListConversationsRequestObject struct {
+}
+
+type ListConversationsResponseObject interface {
+	VisitListConversationsResponse(w http.ResponseWriter) error
+}
+
+type ListConversations200JSONResponse []Conversation
+
+func (response ListConversations200JSONResponse) VisitListConversationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConversationRequestObject struct {
+	Body *CreateConversationJSONRequestBody
+}
+
+type CreateConversationResponseObject interface {
+	VisitCreateConversationResponse(w http.ResponseWriter) error
+}
+
+type CreateConversation201JSONResponse Conversation
+
+func (response CreateConversation201JSONResponse) VisitCreateConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConversationRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteConversationResponseObject interface {
+	VisitDeleteConversationResponse(w http.ResponseWriter) error
+}
+
+type DeleteConversation204Response struct {
+}
+
+func (response DeleteConversation204Response) VisitDeleteConversationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteConversation404Response struct {
+}
+
+func (response DeleteConversation404Response) VisitDeleteConversationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetConversationRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetConversationResponseObject interface {
+	VisitGetConversationResponse(w http.ResponseWriter) error
+}
+
+type GetConversation200JSONResponse Conversation
+
+func (response GetConversation200JSONResponse) VisitGetConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConversation404JSONResponse Error
+
+func (response GetConversation404JSONResponse) VisitGetConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConversationRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateConversationJSONRequestBody
+}
+
+type UpdateConversationResponseObject interface {
+	VisitUpdateConversationResponse(w http.ResponseWriter) error
+}
+
+type UpdateConversation200JSONResponse Conversation
+
+func (response UpdateConversation200JSONResponse) VisitUpdateConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamConversationEventsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type StreamConversationEventsResponseObject interface {
+	VisitStreamConversationEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamConversationEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamConversationEvents200TexteventStreamResponse) VisitStreamConversationEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ListMessagesRequestObject struct {
+	Id     string `json:"id"`
+	Params ListMessagesParams
+}
+
+type ListMessagesResponseObject interface {
+	VisitListMessagesResponse(w http.ResponseWriter) error
+}
+
+type ListMessages200JSONResponse []Message
+
+func (response ListMessages200JSONResponse) VisitListMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunEvalsRequestObject struct {
+	Body *RunEvalsJSONRequestBody
+}
+
+type RunEvalsResponseObject interface {
+	VisitRunEvalsResponse(w http.ResponseWriter) error
+}
+
+type RunEvals200JSONResponse EvalRunListResponse
+
+func (response RunEvals200JSONResponse) VisitRunEvalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunEvals202JSONResponse EvalRunListResponse
+
+func (response RunEvals202JSONResponse) VisitRunEvalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunEvals404JSONResponse Error
+
+func (response RunEvals404JSONResponse) VisitRunEvalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunEvals503JSONResponse Error
+
+func (response RunEvals503JSONResponse) VisitRunEvalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvalRunsRequestObject struct {
+	Params ListEvalRunsParams
+}
+
+type ListEvalRunsResponseObject interface {
+	VisitListEvalRunsResponse(w http.ResponseWriter) error
+}
+
+type ListEvalRuns200JSONResponse EvalRunListResponse
+
+func (response ListEvalRuns200JSONResponse) VisitListEvalRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvalRuns503JSONResponse Error
+
+func (response ListEvalRuns503JSONResponse) VisitListEvalRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvalRunRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetEvalRunResponseObject interface {
+	VisitGetEvalRunResponse(w http.ResponseWriter) error
+}
+
+type GetEvalRun200JSONResponse EvalRun
+
+func (response GetEvalRun200JSONResponse) VisitGetEvalRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvalRun404JSONResponse Error
+
+func (response GetEvalRun404JSONResponse) VisitGetEvalRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvalRun503JSONResponse Error
+
+func (response GetEvalRun503JSONResponse) VisitGetEvalRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffEvalRunsRequestObject struct {
+	Id     string `json:"id"`
+	Params DiffEvalRunsParams
+}
+
+type DiffEvalRunsResponseObject interface {
+	VisitDiffEvalRunsResponse(w http.ResponseWriter) error
+}
+
+type DiffEvalRuns200JSONResponse EvalDiff
+
+func (response DiffEvalRuns200JSONResponse) VisitDiffEvalRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffEvalRuns404JSONResponse Error
+
+func (response DiffEvalRuns404JSONResponse) VisitDiffEvalRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffEvalRuns503JSONResponse Error
+
+func (response DiffEvalRuns503JSONResponse) VisitDiffEvalRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvalSuitesRequestObject struct {
+}
+
+type ListEvalSuitesResponseObject interface {
+	VisitListEvalSuitesResponse(w http.ResponseWriter) error
+}
+
+type ListEvalSuites200JSONResponse EvalSuiteListResponse
+
+func (response ListEvalSuites200JSONResponse) VisitListEvalSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEvalSuites503JSONResponse Error
+
+func (response ListEvalSuites503JSONResponse) VisitListEvalSuitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEvalSuiteRequestObject struct {
+	Body *CreateEvalSuiteJSONRequestBody
+}
+
+type CreateEvalSuiteResponseObject interface {
+	VisitCreateEvalSuiteResponse(w http.ResponseWriter) error
+}
+
+type CreateEvalSuite201JSONResponse EvalSuite
+
+func (response CreateEvalSuite201JSONResponse) VisitCreateEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEvalSuite400JSONResponse Error
+
+func (response CreateEvalSuite400JSONResponse) VisitCreateEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEvalSuite503JSONResponse Error
+
+func (response CreateEvalSuite503JSONResponse) VisitCreateEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEvalSuiteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteEvalSuiteResponseObject interface {
+	VisitDeleteEvalSuiteResponse(w http.ResponseWriter) error
+}
+
+type DeleteEvalSuite204Response struct {
+}
+
+func (response DeleteEvalSuite204Response) VisitDeleteEvalSuiteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteEvalSuite404JSONResponse Error
+
+func (response DeleteEvalSuite404JSONResponse) VisitDeleteEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEvalSuite503JSONResponse Error
+
+func (response DeleteEvalSuite503JSONResponse) VisitDeleteEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvalSuiteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetEvalSuiteResponseObject interface {
+	VisitGetEvalSuiteResponse(w http.ResponseWriter) error
+}
+
+type GetEvalSuite200JSONResponse EvalSuite
+
+func (response GetEvalSuite200JSONResponse) VisitGetEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvalSuite404JSONResponse Error
+
+func (response GetEvalSuite404JSONResponse) VisitGetEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvalSuite503JSONResponse Error
+
+func (response GetEvalSuite503JSONResponse) VisitGetEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEvalSuiteRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateEvalSuiteJSONRequestBody
+}
+
+type UpdateEvalSuiteResponseObject interface {
+	VisitUpdateEvalSuiteResponse(w http.ResponseWriter) error
+}
+
+type UpdateEvalSuite200JSONResponse EvalSuite
+
+func (response UpdateEvalSuite200JSONResponse) VisitUpdateEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEvalSuite400JSONResponse Error
+
+func (response UpdateEvalSuite400JSONResponse) VisitUpdateEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEvalSuite404JSONResponse Error
+
+func (response UpdateEvalSuite404JSONResponse) VisitUpdateEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEvalSuite503JSONResponse Error
+
+func (response UpdateEvalSuite503JSONResponse) VisitUpdateEvalSuiteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamBroadcastEventsRequestObject struct {
+}
+
+type StreamBroadcastEventsResponseObject interface {
+	VisitStreamBroadcastEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamBroadcastEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamBroadcastEvents200TexteventStreamResponse) VisitStreamBroadcastEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ListFeedsRequestObject struct {
+	Params ListFeedsParams
+}
+
+type ListFeedsResponseObject interface {
+	VisitListFeedsResponse(w http.ResponseWriter) error
+}
+
+type ListFeeds200JSONResponse FeedListResponse
+
+func (response ListFeeds200JSONResponse) VisitListFeedsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFeeds503JSONResponse Error
+
+func (response ListFeeds503JSONResponse) VisitListFeedsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchFeedRequestObject struct {
+	Body *WatchFeedJSONRequestBody
+}
+
+type WatchFeedResponseObject interface {
+	VisitWatchFeedResponse(w http.ResponseWriter) error
+}
+
+type WatchFeed201JSONResponse FeedWatch
+
+func (response WatchFeed201JSONResponse) VisitWatchFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchFeed400JSONResponse Error
+
+func (response WatchFeed400JSONResponse) VisitWatchFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchFeed503JSONResponse Error
+
+func (response WatchFeed503JSONResponse) VisitWatchFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnwatchFeedRequestObject struct {
+	Id string `json:"id"`
+}
+
+type UnwatchFeedResponseObject interface {
+	VisitUnwatchFeedResponse(w http.ResponseWriter) error
+}
+
+type UnwatchFeed204Response struct {
+}
+
+func (response UnwatchFeed204Response) VisitUnwatchFeedResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnwatchFeed404JSONResponse Error
+
+func (response UnwatchFeed404JSONResponse) VisitUnwatchFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnwatchFeed503JSONResponse Error
+
+func (response UnwatchFeed503JSONResponse) VisitUnwatchFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleFeedRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ToggleFeedResponseObject interface {
+	VisitToggleFeedResponse(w http.ResponseWriter) error
+}
+
+type ToggleFeed200JSONResponse FeedWatch
+
+func (response ToggleFeed200JSONResponse) VisitToggleFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleFeed404JSONResponse Error
+
+func (response ToggleFeed404JSONResponse) VisitToggleFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleFeed503JSONResponse Error
+
+func (response ToggleFeed503JSONResponse) VisitToggleFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHooksRequestObject struct {
+	Params ListHooksParams
+}
+
+type ListHooksResponseObject interface {
+	VisitListHooksResponse(w http.ResponseWriter) error
+}
+
+type ListHooks200JSONResponse HookListResponse
+
+func (response ListHooks200JSONResponse) VisitListHooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHooks503JSONResponse Error
+
+func (response ListHooks503JSONResponse) VisitListHooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHookRequestObject struct {
+	Body *CreateHookJSONRequestBody
+}
+
+type CreateHookResponseObject interface {
+	VisitCreateHookResponse(w http.ResponseWriter) error
+}
+
+type CreateHook201JSONResponse Hook
+
+func (response CreateHook201JSONResponse) VisitCreateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHook400JSONResponse Error
+
+func (response CreateHook400JSONResponse) VisitCreateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHook503JSONResponse Error
+
+func (response CreateHook503JSONResponse) VisitCreateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListImageBackendHealthRequestObject struct {
+}
+
+type ListImageBackendHealthResponseObject interface {
+	VisitListImageBackendHealthResponse(w http.ResponseWriter) error
+}
+
+type ListImageBackendHealth200JSONResponse ImageBackendHealthListResponse
+
+func (response ListImageBackendHealth200JSONResponse) VisitListImageBackendHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListImageBackendHealth503JSONResponse Error
+
+func (response ListImageBackendHealth503JSONResponse) VisitListImageBackendHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobsRequestObject struct {
+}
+
+type ListJobsResponseObject interface {
+	VisitListJobsResponse(w http.ResponseWriter) error
+}
+
+type ListJobs200JSONResponse []Job
+
+func (response ListJobs200JSONResponse) VisitListJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobs500JSONResponse Error
+
+func (response ListJobs500JSONResponse) VisitListJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitJobRequestObject struct {
+	Body *SubmitJobJSONRequestBody
+}
+
+type SubmitJobResponseObject interface {
+	VisitSubmitJobResponse(w http.ResponseWriter) error
+}
+
+type SubmitJob201JSONResponse JobResponse
+
+func (response SubmitJob201JSONResponse) VisitSubmitJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitJob400JSONResponse Error
+
+func (response SubmitJob400JSONResponse) VisitSubmitJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitJob500JSONResponse Error
+
+func (response SubmitJob500JSONResponse) VisitSubmitJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetJobResponseObject interface {
+	VisitGetJobResponse(w http.ResponseWriter) error
+}
+
+type GetJob200JSONResponse Job
+
+func (response GetJob200JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob404JSONResponse Error
+
+func (response GetJob404JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ServeJobFileRequestObject struct {
+	Id       string `json:"id"`
+	Filename string `json:"filename"`
+}
+
+type ServeJobFileResponseObject interface {
+	VisitServeJobFileResponse(w http.ResponseWriter) error
+}
+
+type ServeJobFile200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ServeJobFile200ApplicationoctetStreamResponse) VisitServeJobFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ServeJobFile404Response struct {
+}
+
+func (response ServeJobFile404Response) VisitServeJobFileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ServeJobFile500Response struct {
+}
+
+func (response ServeJobFile500Response) VisitServeJobFileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type StreamJobRequestObject struct {
+	Id string `json:"id"`
+}
+
+type StreamJobResponseObject interface {
+	VisitStreamJobResponse(w http.ResponseWriter) error
+}
+
+type StreamJob200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamJob200TexteventStreamResponse) VisitStreamJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamJob404Response struct {
+}
+
+func (response StreamJob404Response) VisitStreamJobResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListModelsRequestObject struct {
+}
+
+type ListModelsResponseObject interface {
+	VisitListModelsResponse(w http.ResponseWriter) error
+}
+
+type ListModels200JSONResponse ModelListResponse
+
+func (response ListModels200JSONResponse) VisitListModelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiscoverModelsRequestObject struct {
+	Body *DiscoverModelsJSONRequestBody
+}
+
+type DiscoverModelsResponseObject interface {
+	VisitDiscoverModelsResponse(w http.ResponseWriter) error
+}
+
+type DiscoverModels200JSONResponse []ModelSpec
+
+func (response DiscoverModels200JSONResponse) VisitDiscoverModelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNodesRequestObject struct {
+}
+
+type ListNodesResponseObject interface {
+	VisitListNodesResponse(w http.ResponseWriter) error
+}
+
+type ListNodes200JSONResponse NodeListResponse
+
+func (response ListNodes200JSONResponse) VisitListNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNodes503JSONResponse Error
+
+func (response ListNodes503JSONResponse) VisitListNodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterNodeRequestObject struct {
+	Body *RegisterNodeJSONRequestBody
+}
+
+type RegisterNodeResponseObject interface {
+	VisitRegisterNodeResponse(w http.ResponseWriter) error
+}
+
+type RegisterNode201JSONResponse Node
+
+func (response RegisterNode201JSONResponse) VisitRegisterNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterNode400JSONResponse Error
+
+func (response RegisterNode400JSONResponse) VisitRegisterNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterNode503JSONResponse Error
+
+func (response RegisterNode503JSONResponse) VisitRegisterNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteNodeResponseObject interface {
+	VisitDeleteNodeResponse(w http.ResponseWriter) error
+}
+
+type DeleteNode204Response struct {
+}
+
+func (response DeleteNode204Response) VisitDeleteNodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteNode404JSONResponse Error
+
+func (response DeleteNode404JSONResponse) VisitDeleteNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNode503JSONResponse Error
+
+func (response DeleteNode503JSONResponse) VisitDeleteNodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonasRequestObject struct {
+}
+
+type ListPersonasResponseObject interface {
+	VisitListPersonasResponse(w http.ResponseWriter) error
+}
+
+type ListPersonas200JSONResponse []Persona
+
+func (response ListPersonas200JSONResponse) VisitListPersonasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonaRequestObject struct {
+	Body *CreatePersonaJSONRequestBody
+}
+
+type CreatePersonaResponseObject interface {
+	VisitCreatePersonaResponse(w http.ResponseWriter) error
+}
+
+type CreatePersona201JSONResponse Persona
+
+func (response CreatePersona201JSONResponse) VisitCreatePersonaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonaRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeletePersonaResponseObject interface {
+	VisitDeletePersonaResponse(w http.ResponseWriter) error
+}
+
+type DeletePersona204Response struct {
+}
+
+func (response DeletePersona204Response) VisitDeletePersonaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePersona404Response struct {
+}
+
+func (response DeletePersona404Response) VisitDeletePersonaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetPersonaRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetPersonaResponseObject interface {
+	VisitGetPersonaResponse(w http.ResponseWriter) error
+}
+
+type GetPersona200JSONResponse Persona
+
+func (response GetPersona200JSONResponse) VisitGetPersonaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPersona404JSONResponse Error
+
+func (response GetPersona404JSONResponse) VisitGetPersonaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePersonaRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdatePersonaJSONRequestBody
+}
+
+type UpdatePersonaResponseObject interface {
+	VisitUpdatePersonaResponse(w http.ResponseWriter) error
+}
+
+type UpdatePersona200JSONResponse Persona
+
+func (response UpdatePersona200JSONResponse) VisitUpdatePersonaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePersona404JSONResponse Error
+
+func (response UpdatePersona404JSONResponse) VisitUpdatePersonaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPluginsRequestObject struct {
+}
+
+type ListPluginsResponseObject interface {
+	VisitListPluginsResponse(w http.ResponseWriter) error
+}
+
+type ListPlugins200JSONResponse PluginListResponse
+
+func (response ListPlugins200JSONResponse) VisitListPluginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectsRequestObject struct {
+}
+
+type ListProjectsResponseObject interface {
+	VisitListProjectsResponse(w http.ResponseWriter) error
+}
+
+type ListProjects200JSONResponse []Project
+
+func (response ListProjects200JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProjectRequestObject struct {
+	Body *CreateProjectJSONRequestBody
+}
+
+type CreateProjectResponseObject interface {
+	VisitCreateProjectResponse(w http.ResponseWriter) error
+}
+
+type CreateProject201JSONResponse Project
+
+func (response CreateProject201JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteProjectResponseObject interface {
+	VisitDeleteProjectResponse(w http.ResponseWriter) error
+}
+
+type DeleteProject204Response struct {
+}
+
+func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProject404Response struct {
+}
+
+func (response DeleteProject404Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetProjectRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetProjectResponseObject interface {
+	VisitGetProjectResponse(w http.ResponseWriter) error
+}
+
+type GetProject200JSONResponse Project
+
+func (response GetProject200JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProject404JSONResponse Error
+
+func (response GetProject404JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProjectRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateProjectJSONRequestBody
+}
+
+type UpdateProjectResponseObject interface {
+	VisitUpdateProjectResponse(w http.ResponseWriter) error
+}
+
+type UpdateProject200JSONResponse Project
+
+func (response UpdateProject200JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject404JSONResponse Error
+
+func (response UpdateProject404JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectArtifactsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ListProjectArtifactsResponseObject interface {
+	VisitListProjectArtifactsResponse(w http.ResponseWriter) error
+}
+
+type ListProjectArtifacts200JSONResponse []Artifact
+
+func (response ListProjectArtifacts200JSONResponse) VisitListProjectArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectConversationsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ListProjectConversationsResponseObject interface {
+	VisitListProjectConversationsResponse(w http.ResponseWriter) error
+}
+
+type ListProjectConversations200JSONResponse []Conversation
+
+func (response ListProjectConversations200JSONResponse) VisitListProjectConversationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSettingsRequestObject struct {
+}
+
+type GetSettingsResponseObject interface {
+	VisitGetSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetSettings200JSONResponse AppConfig
+
+func (response GetSettings200JSONResponse) VisitGetSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSettingsRequestObject struct {
+	Body *UpdateSettingsJSONRequestBody
+}
+
+type UpdateSettingsResponseObject interface {
+	VisitUpdateSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateSettings200JSONResponse AppConfig
+
+func (response UpdateSettings200JSONResponse) VisitUpdateSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSettings400JSONResponse Error
+
+func (response UpdateSettings400JSONResponse) VisitUpdateSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendarSettingsRequestObject struct {
+}
+
+type GetCalendarSettingsResponseObject interface {
+	VisitGetCalendarSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetCalendarSettings200JSONResponse CalendarConfig
+
+func (response GetCalendarSettings200JSONResponse) VisitGetCalendarSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSettingsRequestObject struct {
+	Body *UpdateCalendarSettingsJSONRequestBody
+}
+
+type UpdateCalendarSettingsResponseObject interface {
+	VisitUpdateCalendarSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateCalendarSettings200JSONResponse CalendarConfig
+
+func (response UpdateCalendarSettings200JSONResponse) VisitUpdateCalendarSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSettings400JSONResponse Error
+
+func (response UpdateCalendarSettings400JSONResponse) VisitUpdateCalendarSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEmailSettingsRequestObject struct {
+}
+
+type GetEmailSettingsResponseObject interface {
+	VisitGetEmailSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetEmailSettings200JSONResponse EmailConfig
+
+func (response GetEmailSettings200JSONResponse) VisitGetEmailSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmailSettingsRequestObject struct {
+	Body *UpdateEmailSettingsJSONRequestBody
+}
+
+type UpdateEmailSettingsResponseObject interface {
+	VisitUpdateEmailSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateEmailSettings200JSONResponse EmailConfig
+
+func (response UpdateEmailSettings200JSONResponse) VisitUpdateEmailSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmailSettings400JSONResponse Error
+
+func (response UpdateEmailSettings400JSONResponse) VisitUpdateEmailSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEmbeddingSettingsRequestObject struct {
+}
+
+type GetEmbeddingSettingsResponseObject interface {
+	VisitGetEmbeddingSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetEmbeddingSettings200JSONResponse EmbeddingProviderConfig
+
+func (response GetEmbeddingSettings200JSONResponse) VisitGetEmbeddingSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmbeddingSettingsRequestObject struct {
+	Body *UpdateEmbeddingSettingsJSONRequestBody
+}
+
+type UpdateEmbeddingSettingsResponseObject interface {
+	VisitUpdateEmbeddingSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateEmbeddingSettings200JSONResponse EmbeddingProviderConfig
+
+func (response UpdateEmbeddingSettings200JSONResponse) VisitUpdateEmbeddingSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmbeddingSettings400JSONResponse Error
+
+func (response UpdateEmbeddingSettings400JSONResponse) VisitUpdateEmbeddingSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateImageBackendsRequestObject struct {
+	Body *UpdateImageBackendsJSONRequestBody
+}
+
+type UpdateImageBackendsResponseObject interface {
+	VisitUpdateImageBackendsResponse(w http.ResponseWriter) error
+}
+
+type UpdateImageBackends200JSONResponse []ImageBackendConfig
+
+func (response UpdateImageBackends200JSONResponse) VisitUpdateImageBackendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateImageBackends400JSONResponse Error
+
+func (response UpdateImageBackends400JSONResponse) VisitUpdateImageBackendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuntimeSettingsRequestObject struct {
+}
+
+type GetRuntimeSettingsResponseObject interface {
+	VisitGetRuntimeSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetRuntimeSettings200JSONResponse RuntimeConfig
+
+func (response GetRuntimeSettings200JSONResponse) VisitGetRuntimeSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRuntimeSettingsRequestObject struct {
+	Body *UpdateRuntimeSettingsJSONRequestBody
+}
+
+type UpdateRuntimeSettingsResponseObject interface {
+	VisitUpdateRuntimeSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateRuntimeSettings200JSONResponse RuntimeConfig
+
+func (response UpdateRuntimeSettings200JSONResponse) VisitUpdateRuntimeSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRuntimeSettings400JSONResponse Error
+
+func (response UpdateRuntimeSettings400JSONResponse) VisitUpdateRuntimeSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSearchSettingsRequestObject struct {
+}
+
+type GetSearchSettingsResponseObject interface {
+	VisitGetSearchSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetSearchSettings200JSONResponse SearchProviderConfig
+
+func (response GetSearchSettings200JSONResponse) VisitGetSearchSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSearchSettingsRequestObject struct {
+	Body *UpdateSearchSettingsJSONRequestBody
+}
+
+type UpdateSearchSettingsResponseObject interface {
+	VisitUpdateSearchSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateSearchSettings200JSONResponse SearchProviderConfig
+
+func (response UpdateSearchSettings200JSONResponse) VisitUpdateSearchSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSearchSettings400JSONResponse Error
+
+func (response UpdateSearchSettings400JSONResponse) VisitUpdateSearchSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestConnectionRequestObject struct {
+	Body *TestConnectionJSONRequestBody
+}
+
+type TestConnectionResponseObject interface {
+	VisitTestConnectionResponse(w http.ResponseWriter) error
+}
+
+type TestConnection200JSONResponse ConnectionTestResult
+
+func (response TestConnection200JSONResponse) VisitTestConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSpansRequestObject struct {
+	Params SearchSpansParams
+}
+
+type SearchSpansResponseObject interface {
+	VisitSearchSpansResponse(w http.ResponseWriter) error
+}
+
+type SearchSpans200JSONResponse SpanListResponse
+
+func (response SearchSpans200JSONResponse) VisitSearchSpansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSpans400Response struct {
+}
+
+func (response SearchSpans400Response) VisitSearchSpansResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type CreateDiagnosticsBundleRequestObject struct {
+	Body *CreateDiagnosticsBundleJSONRequestBody
+}
+
+type CreateDiagnosticsBundleResponseObject interface {
+	VisitCreateDiagnosticsBundleResponse(w http.ResponseWriter) error
+}
+
+type CreateDiagnosticsBundle200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type CreateDiagnosticsBundle200ApplicationzipResponse struct {
+	Body          io.Reader
+	Headers       CreateDiagnosticsBundle200ResponseHeaders
+	ContentLength int64
+}
+
+func (response CreateDiagnosticsBundle200ApplicationzipResponse) VisitCreateDiagnosticsBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type CreateDiagnosticsBundle400JSONResponse Error
+
+func (response CreateDiagnosticsBundle400JSONResponse) VisitCreateDiagnosticsBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKernelInboxRequestObject struct {
+}
+
+type GetKernelInboxResponseObject interface {
+	VisitGetKernelInboxResponse(w http.ResponseWriter) error
+}
+
+type GetKernelInbox200JSONResponse KernelInboxStatus
+
+func (response GetKernelInbox200JSONResponse) VisitGetKernelInboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryLogsRequestObject struct {
+	Params QueryLogsParams
+}
+
+type QueryLogsResponseObject interface {
+	VisitQueryLogsResponse(w http.ResponseWriter) error
+}
+
+type QueryLogs200JSONResponse LogListResponse
+
+func (response QueryLogs200JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryLogs400JSONResponse Error
+
+func (response QueryLogs400JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type QueryLogs503JSONResponse Error
+
+func (response QueryLogs503JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseSystemRequestObject struct {
+	Body *PauseSystemJSONRequestBody
+}
+
+type PauseSystemResponseObject interface {
+	VisitPauseSystemResponse(w http.ResponseWriter) error
+}
+
+type PauseSystem200JSONResponse MaintenanceStatus
+
+func (response PauseSystem200JSONResponse) VisitPauseSystemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseSystem503JSONResponse Error
+
+func (response PauseSystem503JSONResponse) VisitPauseSystemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeSystemRequestObject struct {
+}
+
+type ResumeSystemResponseObject interface {
+	VisitResumeSystemResponse(w http.ResponseWriter) error
+}
+
+type ResumeSystem200JSONResponse MaintenanceStatus
+
+func (response ResumeSystem200JSONResponse) VisitResumeSystemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeSystem503JSONResponse Error
+
+func (response ResumeSystem503JSONResponse) VisitResumeSystemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSSEStatsRequestObject struct {
+}
+
+type GetSSEStatsResponseObject interface {
+	VisitGetSSEStatsResponse(w http.ResponseWriter) error
+}
+
+type GetSSEStats200JSONResponse SSEStats
+
+func (response GetSSEStats200JSONResponse) VisitGetSSEStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemStatsRequestObject struct {
+	Params GetSystemStatsParams
+}
+
+type GetSystemStatsResponseObject interface {
+	VisitGetSystemStatsResponse(w http.ResponseWriter) error
+}
+
+type GetSystemStats200JSONResponse SystemStats
+
+func (response GetSystemStats200JSONResponse) VisitGetSystemStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemStats503JSONResponse Error
+
+func (response GetSystemStats503JSONResponse) VisitGetSystemStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceStatusRequestObject struct {
+}
+
+type GetMaintenanceStatusResponseObject interface {
+	VisitGetMaintenanceStatusResponse(w http.ResponseWriter) error
+}
+
+type GetMaintenanceStatus200JSONResponse MaintenanceStatus
+
+func (response GetMaintenanceStatus200JSONResponse) VisitGetMaintenanceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceStatus503JSONResponse Error
+
+func (response GetMaintenanceStatus503JSONResponse) VisitGetMaintenanceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionRequestObject struct {
+}
+
+type GetVersionResponseObject interface {
+	VisitGetVersionResponse(w http.ResponseWriter) error
+}
+
+type GetVersion200JSONResponse VersionInfo
+
+func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduledTasksRequestObject struct {
+}
+
+type ListScheduledTasksResponseObject interface {
+	VisitListScheduledTasksResponse(w http.ResponseWriter) error
+}
+
+type ListScheduledTasks200JSONResponse ScheduledTaskListResponse
+
+func (response ListScheduledTasks200JSONResponse) VisitListScheduledTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateScheduledTaskRequestObject struct {
+	Body *CreateScheduledTaskJSONRequestBody
+}
+
+type CreateScheduledTaskResponseObject interface {
+	VisitCreateScheduledTaskResponse(w http.ResponseWriter) error
+}
+
+type CreateScheduledTask201JSONResponse ScheduledTask
+
+func (response CreateScheduledTask201JSONResponse) VisitCreateScheduledTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateScheduledTask400Response struct {
+}
+
+func (response CreateScheduledTask400Response) VisitCreateScheduledTaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type DeleteScheduledTaskRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteScheduledTaskResponseObject interface {
+	VisitDeleteScheduledTaskResponse(w http.ResponseWriter) error
+}
+
+type DeleteScheduledTask204Response struct {
+}
+
+func (response DeleteScheduledTask204Response) VisitDeleteScheduledTaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteScheduledTask404Response struct {
+}
+
+func (response DeleteScheduledTask404Response) VisitDeleteScheduledTaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ToggleScheduledTaskRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ToggleScheduledTaskResponseObject interface {
+	VisitToggleScheduledTaskResponse(w http.ResponseWriter) error
+}
+
+type ToggleScheduledTask200JSONResponse ScheduledTask
+
+func (response ToggleScheduledTask200JSONResponse) VisitToggleScheduledTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleScheduledTask404Response struct {
+}
+
+func (response ToggleScheduledTask404Response) VisitToggleScheduledTaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListToolsRequestObject struct {
+}
+
+type ListToolsResponseObject interface {
+	VisitListToolsResponse(w http.ResponseWriter) error
+}
+
+type ListTools200JSONResponse ToolListResponse
+
+func (response ListTools200JSONResponse) VisitListToolsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunToolRequestObject struct {
+	Name string `json:"name"`
+	Body *RunToolJSONRequestBody
+}
+
+type RunToolResponseObject interface {
+	VisitRunToolResponse(w http.ResponseWriter) error
+}
+
+type RunTool200JSONResponse ToolRunResult
+
+func (response RunTool200JSONResponse) VisitRunToolResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunTool422JSONResponse ToolRunResult
+
+func (response RunTool422JSONResponse) VisitRunToolResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunTool503Response struct {
+}
+
+func (response RunTool503Response) VisitRunToolResponse(w http.ResponseWriter) error {
+	w.WriteHeader(503)
+	return nil
+}
+
+type RunTool504JSONResponse ToolRunResult
+
+func (response RunTool504JSONResponse) VisitRunToolResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTracesRequestObject struct {
+	Params ListTracesParams
+}
+
+type ListTracesResponseObject interface {
+	VisitListTracesResponse(w http.ResponseWriter) error
+}
+
+type ListTraces200JSONResponse TraceListResponse
+
+func (response ListTraces200JSONResponse) VisitListTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTraces400Response struct {
+}
+
+func (response ListTraces400Response) VisitListTracesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetTraceRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetTraceResponseObject interface {
+	VisitGetTraceResponse(w http.ResponseWriter) error
+}
+
+type GetTrace200JSONResponse Trace
+
+func (response GetTrace200JSONResponse) VisitGetTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrace404Response struct {
+}
+
+func (response GetTrace404Response) VisitGetTraceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListWorkersRequestObject struct {
+}
+
+type ListWorkersResponseObject interface {
+	VisitListWorkersResponse(w http.ResponseWriter) error
+}
+
+type ListWorkers200JSONResponse WorkerListResponse
+
+func (response ListWorkers200JSONResponse) VisitListWorkersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KillWorkerRequestObject struct {
+	Id string `json:"id"`
+}
+
+type KillWorkerResponseObject interface {
+	VisitKillWorkerResponse(w http.ResponseWriter) error
+}
+
+type KillWorker204Response struct {
+}
+
+func (response KillWorker204Response) VisitKillWorkerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type KillWorker404JSONResponse Error
+
+func (response KillWorker404JSONResponse) VisitKillWorkerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkerRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetWorkerResponseObject interface {
+	VisitGetWorkerResponse(w http.ResponseWriter) error
+}
+
+type GetWorker200JSONResponse WorkerDetail
+
+func (response GetWorker200JSONResponse) VisitGetWorkerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorker404JSONResponse Error
+
+func (response GetWorker404JSONResponse) VisitGetWorkerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkerLogsRequestObject struct {
+	Id     string `json:"id"`
+	Params GetWorkerLogsParams
+}
+
+type GetWorkerLogsResponseObject interface {
+	VisitGetWorkerLogsResponse(w http.ResponseWriter) error
+}
+
+type GetWorkerLogs200JSONResponse WorkerLogsResponse
+
+func (response GetWorkerLogs200JSONResponse) VisitGetWorkerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkerLogs200TextResponse string
+
+func (response GetWorkerLogs200TextResponse) VisitGetWorkerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetWorkerLogs404JSONResponse Error
+
+func (response GetWorkerLogs404JSONResponse) VisitGetWorkerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkerLogs501JSONResponse Error
+
+func (response GetWorkerLogs501JSONResponse) VisitGetWorkerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkflowsRequestObject struct {
+}
+
+type ListWorkflowsResponseObject interface {
+	VisitListWorkflowsResponse(w http.ResponseWriter) error
+}
+
+type ListWorkflows200JSONResponse []Workflow
+
+func (response ListWorkflows200JSONResponse) VisitListWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflowRequestObject struct {
+	Body *CreateWorkflowJSONRequestBody
+}
+
+type CreateWorkflowResponseObject interface {
+	VisitCreateWorkflowResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkflow201JSONResponse Workflow
+
+func (response CreateWorkflow201JSONResponse) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkflow400Response struct {
+}
+
+func (response CreateWorkflow400Response) VisitCreateWorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetWorkflowRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetWorkflowResponseObject interface {
+	VisitGetWorkflowResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflow200JSONResponse Workflow
+
+func (response GetWorkflow200JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflow404Response struct {
+}
+
+func (response GetWorkflow404Response) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type StreamWorkflowEventsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type StreamWorkflowEventsResponseObject interface {
+	VisitStreamWorkflowEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamWorkflowEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamWorkflowEvents200TexteventStreamResponse) VisitStreamWorkflowEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ResumeWorkflowRequestObject struct {
+	Id   string `json:"id"`
+	Body *ResumeWorkflowJSONRequestBody
+}
+
+type ResumeWorkflowResponseObject interface {
+	VisitResumeWorkflowResponse(w http.ResponseWriter) error
+}
+
+type ResumeWorkflow200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response ResumeWorkflow200JSONResponse) VisitResumeWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunWorkflowRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RunWorkflowResponseObject interface {
+	VisitRunWorkflowResponse(w http.ResponseWriter) error
+}
+
+type RunWorkflow200JSONResponse struct {
+	Id     *string `json:"id,omitempty"`
+	Status *string `json:"status,omitempty"`
+}
+
+func (response RunWorkflow200JSONResponse) VisitRunWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunWorkflow404Response struct {
+}
+
+func (response RunWorkflow404Response) VisitRunWorkflowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Chat with the Agent
+	// (POST /v1/agent/chat)
+	AgentChat(ctx context.Context, request AgentChatRequestObject) (AgentChatResponseObject, error)
+	// Chat with the agent, streaming the reply (SSE)
+	// (POST /v1/agent/chat/stream)
+	StreamAgentChat(ctx context.Context, request StreamAgentChatRequestObject) (StreamAgentChatResponseObject, error)
+	// List all artifacts
 	// (GET /v1/artifacts)
 	ListArtifacts(ctx context.Context, request ListArtifactsRequestObject) (ListArtifactsResponseObject, error)
 	// Delete an artifact
@@ -2540,9 +7363,30 @@ type StrictServerInterface interface {
 	// Get artifact details
 	// (GET /v1/artifacts/{id})
 	GetArtifact(ctx context.Context, request GetArtifactRequestObject) (GetArtifactResponseObject, error)
+	// List automation rules
+	// (GET /v1/automations)
+	ListAutomations(ctx context.Context, request ListAutomationsRequestObject) (ListAutomationsResponseObject, error)
+	// Create an automation rule
+	// (POST /v1/automations)
+	CreateAutomation(ctx context.Context, request CreateAutomationRequestObject) (CreateAutomationResponseObject, error)
+	// Delete a rule and its feed or file watch
+	// (DELETE /v1/automations/{id})
+	DeleteAutomation(ctx context.Context, request DeleteAutomationRequestObject) (DeleteAutomationResponseObject, error)
+	// Get an automation rule
+	// (GET /v1/automations/{id})
+	GetAutomation(ctx context.Context, request GetAutomationRequestObject) (GetAutomationResponseObject, error)
+	// Fire a rule now, ignoring its conditions
+	// (POST /v1/automations/{id}/run)
+	RunAutomation(ctx context.Context, request RunAutomationRequestObject) (RunAutomationResponseObject, error)
+	// Enable or disable a rule
+	// (POST /v1/automations/{id}/toggle)
+	ToggleAutomation(ctx context.Context, request ToggleAutomationRequestObject) (ToggleAutomationResponseObject, error)
 	// List all system capabilities (muscle + synapse)
 	// (GET /v1/capabilities)
 	ListCapabilities(ctx context.Context, request ListCapabilitiesRequestObject) (ListCapabilitiesResponseObject, error)
+	// Override the runtime of a capability
+	// (PUT /v1/capabilities/{name})
+	UpdateCapability(ctx context.Context, request UpdateCapabilityRequestObject) (UpdateCapabilityResponseObject, error)
 	// List all conversations
 	// (GET /v1/conversations)
 	ListConversations(ctx context.Context, request ListConversationsRequestObject) (ListConversationsResponseObject, error)
@@ -2564,6 +7408,57 @@ type StrictServerInterface interface {
 	// List messages in a conversation
 	// (GET /v1/conversations/{id}/messages)
 	ListMessages(ctx context.Context, request ListMessagesRequestObject) (ListMessagesResponseObject, error)
+	// Run a suite against personas and models
+	// (POST /v1/evals/run)
+	RunEvals(ctx context.Context, request RunEvalsRequestObject) (RunEvalsResponseObject, error)
+	// List eval runs, newest first, without per-case results
+	// (GET /v1/evals/runs)
+	ListEvalRuns(ctx context.Context, request ListEvalRunsRequestObject) (ListEvalRunsResponseObject, error)
+	// Scored report of one eval run
+	// (GET /v1/evals/runs/{id})
+	GetEvalRun(ctx context.Context, request GetEvalRunRequestObject) (GetEvalRunResponseObject, error)
+	// Compare an eval run with a baseline run of the same suite
+	// (GET /v1/evals/runs/{id}/diff)
+	DiffEvalRuns(ctx context.Context, request DiffEvalRunsRequestObject) (DiffEvalRunsResponseObject, error)
+	// List eval suites
+	// (GET /v1/evals/suites)
+	ListEvalSuites(ctx context.Context, request ListEvalSuitesRequestObject) (ListEvalSuitesResponseObject, error)
+	// Create an eval suite
+	// (POST /v1/evals/suites)
+	CreateEvalSuite(ctx context.Context, request CreateEvalSuiteRequestObject) (CreateEvalSuiteResponseObject, error)
+	// Delete an eval suite and its runs
+	// (DELETE /v1/evals/suites/{id})
+	DeleteEvalSuite(ctx context.Context, request DeleteEvalSuiteRequestObject) (DeleteEvalSuiteResponseObject, error)
+	// Get an eval suite
+	// (GET /v1/evals/suites/{id})
+	GetEvalSuite(ctx context.Context, request GetEvalSuiteRequestObject) (GetEvalSuiteResponseObject, error)
+	// Replace an eval suite
+	// (PUT /v1/evals/suites/{id})
+	UpdateEvalSuite(ctx context.Context, request UpdateEvalSuiteRequestObject) (UpdateEvalSuiteResponseObject, error)
+	// Stream kernel-wide agent events (SSE)
+	// (GET /v1/events)
+	StreamBroadcastEvents(ctx context.Context, request StreamBroadcastEventsRequestObject) (StreamBroadcastEventsResponseObject, error)
+	// List watched RSS/Atom feeds
+	// (GET /v1/feeds)
+	ListFeeds(ctx context.Context, request ListFeedsRequestObject) (ListFeedsResponseObject, error)
+	// Watch a feed after fetching it once
+	// (POST /v1/feeds)
+	WatchFeed(ctx context.Context, request WatchFeedRequestObject) (WatchFeedResponseObject, error)
+	// Stop watching a feed
+	// (DELETE /v1/feeds/{id})
+	UnwatchFeed(ctx context.Context, request UnwatchFeedRequestObject) (UnwatchFeedResponseObject, error)
+	// Pause or resume a feed
+	// (POST /v1/feeds/{id}/toggle)
+	ToggleFeed(ctx context.Context, request ToggleFeedRequestObject) (ToggleFeedResponseObject, error)
+	// List webhook rules with their URLs
+	// (GET /v1/hooks)
+	ListHooks(ctx context.Context, request ListHooksRequestObject) (ListHooksResponseObject, error)
+	// Create a hook running a prompt or a workflow
+	// (POST /v1/hooks)
+	CreateHook(ctx context.Context, request CreateHookRequestObject) (CreateHookResponseObject, error)
+	// Probe every configured image backend
+	// (GET /v1/image/backends)
+	ListImageBackendHealth(ctx context.Context, request ListImageBackendHealthRequestObject) (ListImageBackendHealthResponseObject, error)
 	// List all jobs
 	// (GET /v1/jobs)
 	ListJobs(ctx context.Context, request ListJobsRequestObject) (ListJobsResponseObject, error)
@@ -2585,6 +7480,15 @@ type StrictServerInterface interface {
 	// Discover models from Ollama and/or LiteLLM
 	// (POST /v1/models/discover)
 	DiscoverModels(ctx context.Context, request DiscoverModelsRequestObject) (DiscoverModelsResponseObject, error)
+	// List registered muscle nodes
+	// (GET /v1/nodes)
+	ListNodes(ctx context.Context, request ListNodesRequestObject) (ListNodesResponseObject, error)
+	// Register a remote muscle node
+	// (POST /v1/nodes)
+	RegisterNode(ctx context.Context, request RegisterNodeRequestObject) (RegisterNodeResponseObject, error)
+	// Unregister a node
+	// (DELETE /v1/nodes/{id})
+	DeleteNode(ctx context.Context, request DeleteNodeRequestObject) (DeleteNodeResponseObject, error)
 	// List all personas
 	// (GET /v1/personas)
 	ListPersonas(ctx context.Context, request ListPersonasRequestObject) (ListPersonasResponseObject, error)
@@ -2630,9 +7534,108 @@ type StrictServerInterface interface {
 	// Update settings (encrypts secrets at rest)
 	// (PUT /v1/settings)
 	UpdateSettings(ctx context.Context, request UpdateSettingsRequestObject) (UpdateSettingsResponseObject, error)
+	// Calendar config (secrets masked)
+	// (GET /v1/settings/calendar)
+	GetCalendarSettings(ctx context.Context, request GetCalendarSettingsRequestObject) (GetCalendarSettingsResponseObject, error)
+	// Replace the calendar settings
+	// (PUT /v1/settings/calendar)
+	UpdateCalendarSettings(ctx context.Context, request UpdateCalendarSettingsRequestObject) (UpdateCalendarSettingsResponseObject, error)
+	// SMTP and IMAP config (passwords masked)
+	// (GET /v1/settings/email)
+	GetEmailSettings(ctx context.Context, request GetEmailSettingsRequestObject) (GetEmailSettingsResponseObject, error)
+	// Replace the email settings
+	// (PUT /v1/settings/email)
+	UpdateEmailSettings(ctx context.Context, request UpdateEmailSettingsRequestObject) (UpdateEmailSettingsResponseObject, error)
+	// Embedding provider config (API key masked)
+	// (GET /v1/settings/embeddings)
+	GetEmbeddingSettings(ctx context.Context, request GetEmbeddingSettingsRequestObject) (GetEmbeddingSettingsResponseObject, error)
+	// Replace the embedding provider config
+	// (PUT /v1/settings/embeddings)
+	UpdateEmbeddingSettings(ctx context.Context, request UpdateEmbeddingSettingsRequestObject) (UpdateEmbeddingSettingsResponseObject, error)
+	// Replace the extra image backends
+	// (PUT /v1/settings/image-backends)
+	UpdateImageBackends(ctx context.Context, request UpdateImageBackendsRequestObject) (UpdateImageBackendsResponseObject, error)
+	// Hot-reloadable kernel settings
+	// (GET /v1/settings/runtime)
+	GetRuntimeSettings(ctx context.Context, request GetRuntimeSettingsRequestObject) (GetRuntimeSettingsResponseObject, error)
+	// Replace the runtime settings; they apply without a restart
+	// (PUT /v1/settings/runtime)
+	UpdateRuntimeSettings(ctx context.Context, request UpdateRuntimeSettingsRequestObject) (UpdateRuntimeSettingsResponseObject, error)
+	// Web search config (API keys masked)
+	// (GET /v1/settings/search)
+	GetSearchSettings(ctx context.Context, request GetSearchSettingsRequestObject) (GetSearchSettingsResponseObject, error)
+	// Replace the web search backends, tried in order
+	// (PUT /v1/settings/search)
+	UpdateSearchSettings(ctx context.Context, request UpdateSearchSettingsRequestObject) (UpdateSearchSettingsResponseObject, error)
 	// Test provider connection
 	// (POST /v1/settings/test)
 	TestConnection(ctx context.Context, request TestConnectionRequestObject) (TestConnectionResponseObject, error)
+	// Search spans across all traces
+	// (GET /v1/spans)
+	SearchSpans(ctx context.Context, request SearchSpansRequestObject) (SearchSpansResponseObject, error)
+	// Build a diagnostics bundle to attach to bug reports
+	// (POST /v1/system/diagnostics)
+	CreateDiagnosticsBundle(ctx context.Context, request CreateDiagnosticsBundleRequestObject) (CreateDiagnosticsBundleResponseObject, error)
+	// Unread count and last message of the kernel inbox
+	// (GET /v1/system/inbox)
+	GetKernelInbox(ctx context.Context, request GetKernelInboxRequestObject) (GetKernelInboxResponseObject, error)
+	// Query recent kernel log records, newest first
+	// (GET /v1/system/logs)
+	QueryLogs(ctx context.Context, request QueryLogsRequestObject) (QueryLogsResponseObject, error)
+	// Pause the scheduler, cron, heartbeat and new chats
+	// (POST /v1/system/pause)
+	PauseSystem(ctx context.Context, request PauseSystemRequestObject) (PauseSystemResponseObject, error)
+	// Leave maintenance mode; queued jobs and due tasks resume
+	// (POST /v1/system/resume)
+	ResumeSystem(ctx context.Context, request ResumeSystemRequestObject) (ResumeSystemResponseObject, error)
+	// Open event streams and their limits
+	// (GET /v1/system/sse)
+	GetSSEStats(ctx context.Context, request GetSSEStatsRequestObject) (GetSSEStatsResponseObject, error)
+	// Host CPU, memory and disk usage, database size and Docker stats
+	// (GET /v1/system/stats)
+	GetSystemStats(ctx context.Context, request GetSystemStatsRequestObject) (GetSystemStatsResponseObject, error)
+	// Report whether the system is paused
+	// (GET /v1/system/status)
+	GetMaintenanceStatus(ctx context.Context, request GetMaintenanceStatusRequestObject) (GetMaintenanceStatusResponseObject, error)
+	// Kernel build and, when update checks are on, the last check
+	// (GET /v1/system/version)
+	GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error)
+	// List scheduled tasks
+	// (GET /v1/tasks)
+	ListScheduledTasks(ctx context.Context, request ListScheduledTasksRequestObject) (ListScheduledTasksResponseObject, error)
+	// Create a scheduled task
+	// (POST /v1/tasks)
+	CreateScheduledTask(ctx context.Context, request CreateScheduledTaskRequestObject) (CreateScheduledTaskResponseObject, error)
+	// Delete a scheduled task
+	// (DELETE /v1/tasks/{id})
+	DeleteScheduledTask(ctx context.Context, request DeleteScheduledTaskRequestObject) (DeleteScheduledTaskResponseObject, error)
+	// Toggle a scheduled task between active and paused
+	// (POST /v1/tasks/{id}/toggle)
+	ToggleScheduledTask(ctx context.Context, request ToggleScheduledTaskRequestObject) (ToggleScheduledTaskResponseObject, error)
+	// List registered tools with their parameter schemas
+	// (GET /v1/tools)
+	ListTools(ctx context.Context, request ListToolsRequestObject) (ListToolsResponseObject, error)
+	// Execute a tool
+	// (POST /v1/tools/{name}/run)
+	RunTool(ctx context.Context, request RunToolRequestObject) (RunToolResponseObject, error)
+	// List recent traces, including persisted history
+	// (GET /v1/traces)
+	ListTraces(ctx context.Context, request ListTracesRequestObject) (ListTracesResponseObject, error)
+	// Get a trace with all its spans
+	// (GET /v1/traces/{id})
+	GetTrace(ctx context.Context, request GetTraceRequestObject) (GetTraceResponseObject, error)
+	// List workers
+	// (GET /v1/workers)
+	ListWorkers(ctx context.Context, request ListWorkersRequestObject) (ListWorkersResponseObject, error)
+	// Force-kill a worker's container and mark the worker exited
+	// (DELETE /v1/workers/{id})
+	KillWorker(ctx context.Context, request KillWorkerRequestObject) (KillWorkerResponseObject, error)
+	// Stored worker record merged with live container inspection
+	// (GET /v1/workers/{id})
+	GetWorker(ctx context.Context, request GetWorkerRequestObject) (GetWorkerResponseObject, error)
+	// Last lines a worker wrote, without following
+	// (GET /v1/workers/{id}/logs)
+	GetWorkerLogs(ctx context.Context, request GetWorkerLogsRequestObject) (GetWorkerLogsResponseObject, error)
 	// List all workflows
 	// (GET /v1/workflows)
 	ListWorkflows(ctx context.Context, request ListWorkflowsRequestObject) (ListWorkflowsResponseObject, error)
@@ -2642,6 +7645,9 @@ type StrictServerInterface interface {
 	// Get workflow details and status
 	// (GET /v1/workflows/{id})
 	GetWorkflow(ctx context.Context, request GetWorkflowRequestObject) (GetWorkflowResponseObject, error)
+	// Stream workflow and step events (SSE)
+	// (GET /v1/workflows/{id}/events)
+	StreamWorkflowEvents(ctx context.Context, request StreamWorkflowEventsRequestObject) (StreamWorkflowEventsResponseObject, error)
 	// Resume a paused workflow
 	// (POST /v1/workflows/{id}/resume)
 	ResumeWorkflow(ctx context.Context, request ResumeWorkflowRequestObject) (ResumeWorkflowResponseObject, error)
@@ -2650,40 +7656,1598 @@ type StrictServerInterface interface {
 	RunWorkflow(ctx context.Context, request RunWorkflowRequestObject) (RunWorkflowResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// AgentChat operation middleware
+func (sh *strictHandler) AgentChat(w http.ResponseWriter, r *http.Request) {
+	var request AgentChatRequestObject
+
+	var body AgentChatJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AgentChat(ctx, request.(AgentChatRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AgentChat")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AgentChatResponseObject); ok {
+		if err := validResponse.VisitAgentChatResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamAgentChat operation middleware
+func (sh *strictHandler) StreamAgentChat(w http.ResponseWriter, r *http.Request) {
+	var request StreamAgentChatRequestObject
+
+	var body StreamAgentChatJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamAgentChat(ctx, request.(StreamAgentChatRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamAgentChat")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamAgentChatResponseObject); ok {
+		if err := validResponse.VisitStreamAgentChatResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListArtifacts operation middleware
+func (sh *strictHandler) ListArtifacts(w http.ResponseWriter, r *http.Request, params ListArtifactsParams) {
+	var request ListArtifactsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListArtifacts(ctx, request.(ListArtifactsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListArtifacts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListArtifactsResponseObject); ok {
+		if err := validResponse.VisitListArtifactsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteArtifact operation middleware
+func (sh *strictHandler) DeleteArtifact(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteArtifactRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArtifact(ctx, request.(DeleteArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArtifact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteArtifactResponseObject); ok {
+		if err := validResponse.VisitDeleteArtifactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArtifact operation middleware
+func (sh *strictHandler) GetArtifact(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetArtifactRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifact(ctx, request.(GetArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactResponseObject); ok {
+		if err := validResponse.VisitGetArtifactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAutomations operation middleware
+func (sh *strictHandler) ListAutomations(w http.ResponseWriter, r *http.Request, params ListAutomationsParams) {
+	var request ListAutomationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAutomations(ctx, request.(ListAutomationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAutomations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAutomationsResponseObject); ok {
+		if err := validResponse.VisitListAutomationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAutomation operation middleware
+func (sh *strictHandler) CreateAutomation(w http.ResponseWriter, r *http.Request) {
+	var request CreateAutomationRequestObject
+
+	var body CreateAutomationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAutomation(ctx, request.(CreateAutomationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAutomation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAutomationResponseObject); ok {
+		if err := validResponse.VisitCreateAutomationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAutomation operation middleware
+func (sh *strictHandler) DeleteAutomation(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteAutomationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAutomation(ctx, request.(DeleteAutomationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAutomation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAutomationResponseObject); ok {
+		if err := validResponse.VisitDeleteAutomationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAutomation operation middleware
+func (sh *strictHandler) GetAutomation(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetAutomationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAutomation(ctx, request.(GetAutomationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAutomation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAutomationResponseObject); ok {
+		if err := validResponse.VisitGetAutomationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RunAutomation operation middleware
+func (sh *strictHandler) RunAutomation(w http.ResponseWriter, r *http.Request, id string) {
+	var request RunAutomationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunAutomation(ctx, request.(RunAutomationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunAutomation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunAutomationResponseObject); ok {
+		if err := validResponse.VisitRunAutomationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ToggleAutomation operation middleware
+func (sh *strictHandler) ToggleAutomation(w http.ResponseWriter, r *http.Request, id string) {
+	var request ToggleAutomationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ToggleAutomation(ctx, request.(ToggleAutomationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ToggleAutomation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ToggleAutomationResponseObject); ok {
+		if err := validResponse.VisitToggleAutomationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCapabilities operation middleware
+func (sh *strictHandler) ListCapabilities(w http.ResponseWriter, r *http.Request) {
+	var request ListCapabilitiesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCapabilities(ctx, request.(ListCapabilitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCapabilities")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCapabilitiesResponseObject); ok {
+		if err := validResponse.VisitListCapabilitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCapability operation middleware
+func (sh *strictHandler) UpdateCapability(w http.ResponseWriter, r *http.Request, name string) {
+	var request UpdateCapabilityRequestObject
+
+	request.Name = name
+
+	var body UpdateCapabilityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCapability(ctx, request.(UpdateCapabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCapability")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCapabilityResponseObject); ok {
+		if err := validResponse.VisitUpdateCapabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListConversations operation middleware
+func (sh *strictHandler) ListConversations(w http.ResponseWriter, r *http.Request) {
+	var request ListConversationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListConversations(ctx, request.(ListConversationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListConversations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListConversationsResponseObject); ok {
+		if err := validResponse.VisitListConversationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateConversation operation middleware
+func (sh *strictHandler) CreateConversation(w http.ResponseWriter, r *http.Request) {
+	var request CreateConversationRequestObject
+
+	var body CreateConversationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateConversation(ctx, request.(CreateConversationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateConversation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateConversationResponseObject); ok {
+		if err := validResponse.VisitCreateConversationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteConversation operation middleware
+func (sh *strictHandler) DeleteConversation(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteConversationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConversation(ctx, request.(DeleteConversationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConversation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConversationResponseObject); ok {
+		if err := validResponse.VisitDeleteConversationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetConversation operation middleware
+func (sh *strictHandler) GetConversation(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetConversationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConversation(ctx, request.(GetConversationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConversation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConversationResponseObject); ok {
+		if err := validResponse.VisitGetConversationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateConversation operation middleware
+func (sh *strictHandler) UpdateConversation(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateConversationRequestObject
+
+	request.Id = id
+
+	var body UpdateConversationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateConversation(ctx, request.(UpdateConversationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateConversation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateConversationResponseObject); ok {
+		if err := validResponse.VisitUpdateConversationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamConversationEvents operation middleware
+func (sh *strictHandler) StreamConversationEvents(w http.ResponseWriter, r *http.Request, id string) {
+	var request StreamConversationEventsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamConversationEvents(ctx, request.(StreamConversationEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamConversationEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamConversationEventsResponseObject); ok {
+		if err := validResponse.VisitStreamConversationEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListMessages operation middleware
+func (sh *strictHandler) ListMessages(w http.ResponseWriter, r *http.Request, id string, params ListMessagesParams) {
+	var request ListMessagesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMessages(ctx, request.(ListMessagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMessages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMessagesResponseObject); ok {
+		if err := validResponse.VisitListMessagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RunEvals operation middleware
+func (sh *strictHandler) RunEvals(w http.ResponseWriter, r *http.Request) {
+	var request RunEvalsRequestObject
+
+	var body RunEvalsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunEvals(ctx, request.(RunEvalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunEvals")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunEvalsResponseObject); ok {
+		if err := validResponse.VisitRunEvalsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEvalRuns operation middleware
+func (sh *strictHandler) ListEvalRuns(w http.ResponseWriter, r *http.Request, params ListEvalRunsParams) {
+	var request ListEvalRunsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEvalRuns(ctx, request.(ListEvalRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEvalRuns")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEvalRunsResponseObject); ok {
+		if err := validResponse.VisitListEvalRunsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEvalRun operation middleware
+func (sh *strictHandler) GetEvalRun(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetEvalRunRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEvalRun(ctx, request.(GetEvalRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEvalRun")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEvalRunResponseObject); ok {
+		if err := validResponse.VisitGetEvalRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DiffEvalRuns operation middleware
+func (sh *strictHandler) DiffEvalRuns(w http.ResponseWriter, r *http.Request, id string, params DiffEvalRunsParams) {
+	var request DiffEvalRunsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffEvalRuns(ctx, request.(DiffEvalRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffEvalRuns")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffEvalRunsResponseObject); ok {
+		if err := validResponse.VisitDiffEvalRunsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEvalSuites operation middleware
+func (sh *strictHandler) ListEvalSuites(w http.ResponseWriter, r *http.Request) {
+	var request ListEvalSuitesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEvalSuites(ctx, request.(ListEvalSuitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEvalSuites")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEvalSuitesResponseObject); ok {
+		if err := validResponse.VisitListEvalSuitesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateEvalSuite operation middleware
+func (sh *strictHandler) CreateEvalSuite(w http.ResponseWriter, r *http.Request) {
+	var request CreateEvalSuiteRequestObject
+
+	var body CreateEvalSuiteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEvalSuite(ctx, request.(CreateEvalSuiteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEvalSuite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateEvalSuiteResponseObject); ok {
+		if err := validResponse.VisitCreateEvalSuiteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteEvalSuite operation middleware
+func (sh *strictHandler) DeleteEvalSuite(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteEvalSuiteRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteEvalSuite(ctx, request.(DeleteEvalSuiteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteEvalSuite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteEvalSuiteResponseObject); ok {
+		if err := validResponse.VisitDeleteEvalSuiteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEvalSuite operation middleware
+func (sh *strictHandler) GetEvalSuite(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetEvalSuiteRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEvalSuite(ctx, request.(GetEvalSuiteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEvalSuite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEvalSuiteResponseObject); ok {
+		if err := validResponse.VisitGetEvalSuiteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateEvalSuite operation middleware
+func (sh *strictHandler) UpdateEvalSuite(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateEvalSuiteRequestObject
+
+	request.Id = id
+
+	var body UpdateEvalSuiteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateEvalSuite(ctx, request.(UpdateEvalSuiteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateEvalSuite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateEvalSuiteResponseObject); ok {
+		if err := validResponse.VisitUpdateEvalSuiteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamBroadcastEvents operation middleware
+func (sh *strictHandler) StreamBroadcastEvents(w http.ResponseWriter, r *http.Request) {
+	var request StreamBroadcastEventsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamBroadcastEvents(ctx, request.(StreamBroadcastEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamBroadcastEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamBroadcastEventsResponseObject); ok {
+		if err := validResponse.VisitStreamBroadcastEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListFeeds operation middleware
+func (sh *strictHandler) ListFeeds(w http.ResponseWriter, r *http.Request, params ListFeedsParams) {
+	var request ListFeedsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFeeds(ctx, request.(ListFeedsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFeeds")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListFeedsResponseObject); ok {
+		if err := validResponse.VisitListFeedsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WatchFeed operation middleware
+func (sh *strictHandler) WatchFeed(w http.ResponseWriter, r *http.Request) {
+	var request WatchFeedRequestObject
+
+	var body WatchFeedJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WatchFeed(ctx, request.(WatchFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WatchFeed")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WatchFeedResponseObject); ok {
+		if err := validResponse.VisitWatchFeedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UnwatchFeed operation middleware
+func (sh *strictHandler) UnwatchFeed(w http.ResponseWriter, r *http.Request, id string) {
+	var request UnwatchFeedRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnwatchFeed(ctx, request.(UnwatchFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnwatchFeed")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnwatchFeedResponseObject); ok {
+		if err := validResponse.VisitUnwatchFeedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ToggleFeed operation middleware
+func (sh *strictHandler) ToggleFeed(w http.ResponseWriter, r *http.Request, id string) {
+	var request ToggleFeedRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ToggleFeed(ctx, request.(ToggleFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ToggleFeed")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ToggleFeedResponseObject); ok {
+		if err := validResponse.VisitToggleFeedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListHooks operation middleware
+func (sh *strictHandler) ListHooks(w http.ResponseWriter, r *http.Request, params ListHooksParams) {
+	var request ListHooksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListHooks(ctx, request.(ListHooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListHooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListHooksResponseObject); ok {
+		if err := validResponse.VisitListHooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateHook operation middleware
+func (sh *strictHandler) CreateHook(w http.ResponseWriter, r *http.Request) {
+	var request CreateHookRequestObject
+
+	var body CreateHookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateHook(ctx, request.(CreateHookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateHook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateHookResponseObject); ok {
+		if err := validResponse.VisitCreateHookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListImageBackendHealth operation middleware
+func (sh *strictHandler) ListImageBackendHealth(w http.ResponseWriter, r *http.Request) {
+	var request ListImageBackendHealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListImageBackendHealth(ctx, request.(ListImageBackendHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListImageBackendHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListImageBackendHealthResponseObject); ok {
+		if err := validResponse.VisitListImageBackendHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListJobs operation middleware
+func (sh *strictHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	var request ListJobsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListJobs(ctx, request.(ListJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListJobs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListJobsResponseObject); ok {
+		if err := validResponse.VisitListJobsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SubmitJob operation middleware
+func (sh *strictHandler) SubmitJob(w http.ResponseWriter, r *http.Request) {
+	var request SubmitJobRequestObject
+
+	var body SubmitJobJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SubmitJob(ctx, request.(SubmitJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SubmitJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SubmitJobResponseObject); ok {
+		if err := validResponse.VisitSubmitJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetJob operation middleware
+func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJob(ctx, request.(GetJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobResponseObject); ok {
+		if err := validResponse.VisitGetJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ServeJobFile operation middleware
+func (sh *strictHandler) ServeJobFile(w http.ResponseWriter, r *http.Request, id string, filename string) {
+	var request ServeJobFileRequestObject
+
+	request.Id = id
+	request.Filename = filename
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ServeJobFile(ctx, request.(ServeJobFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ServeJobFile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ServeJobFileResponseObject); ok {
+		if err := validResponse.VisitServeJobFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamJob operation middleware
+func (sh *strictHandler) StreamJob(w http.ResponseWriter, r *http.Request, id string) {
+	var request StreamJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamJob(ctx, request.(StreamJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamJobResponseObject); ok {
+		if err := validResponse.VisitStreamJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListModels operation middleware
+func (sh *strictHandler) ListModels(w http.ResponseWriter, r *http.Request) {
+	var request ListModelsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListModels(ctx, request.(ListModelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListModels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListModelsResponseObject); ok {
+		if err := validResponse.VisitListModelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DiscoverModels operation middleware
+func (sh *strictHandler) DiscoverModels(w http.ResponseWriter, r *http.Request) {
+	var request DiscoverModelsRequestObject
+
+	var body DiscoverModelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiscoverModels(ctx, request.(DiscoverModelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiscoverModels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiscoverModelsResponseObject); ok {
+		if err := validResponse.VisitDiscoverModelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListNodes operation middleware
+func (sh *strictHandler) ListNodes(w http.ResponseWriter, r *http.Request) {
+	var request ListNodesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListNodes(ctx, request.(ListNodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListNodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListNodesResponseObject); ok {
+		if err := validResponse.VisitListNodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RegisterNode operation middleware
+func (sh *strictHandler) RegisterNode(w http.ResponseWriter, r *http.Request) {
+	var request RegisterNodeRequestObject
+
+	var body RegisterNodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RegisterNode(ctx, request.(RegisterNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RegisterNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterNodeResponseObject); ok {
+		if err := validResponse.VisitRegisterNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteNode operation middleware
+func (sh *strictHandler) DeleteNode(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteNodeRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNode(ctx, request.(DeleteNodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteNodeResponseObject); ok {
+		if err := validResponse.VisitDeleteNodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPersonas operation middleware
+func (sh *strictHandler) ListPersonas(w http.ResponseWriter, r *http.Request) {
+	var request ListPersonasRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPersonas(ctx, request.(ListPersonasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPersonas")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPersonasResponseObject); ok {
+		if err := validResponse.VisitListPersonasResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePersona operation middleware
+func (sh *strictHandler) CreatePersona(w http.ResponseWriter, r *http.Request) {
+	var request CreatePersonaRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	var body CreatePersonaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePersona(ctx, request.(CreatePersonaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePersona")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePersonaResponseObject); ok {
+		if err := validResponse.VisitCreatePersonaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// DeletePersona operation middleware
+func (sh *strictHandler) DeletePersona(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeletePersonaRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePersona(ctx, request.(DeletePersonaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePersona")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePersonaResponseObject); ok {
+		if err := validResponse.VisitDeletePersonaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPersona operation middleware
+func (sh *strictHandler) GetPersona(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetPersonaRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPersona(ctx, request.(GetPersonaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPersona")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPersonaResponseObject); ok {
+		if err := validResponse.VisitGetPersonaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdatePersona operation middleware
+func (sh *strictHandler) UpdatePersona(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdatePersonaRequestObject
+
+	request.Id = id
+
+	var body UpdatePersonaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePersona(ctx, request.(UpdatePersonaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePersona")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePersonaResponseObject); ok {
+		if err := validResponse.VisitUpdatePersonaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPlugins operation middleware
+func (sh *strictHandler) ListPlugins(w http.ResponseWriter, r *http.Request) {
+	var request ListPluginsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPlugins(ctx, request.(ListPluginsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPlugins")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPluginsResponseObject); ok {
+		if err := validResponse.VisitListPluginsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProjects operation middleware
+func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
+	var request ListProjectsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProjects(ctx, request.(ListProjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProjects")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProjectsResponseObject); ok {
+		if err := validResponse.VisitListProjectsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateProject operation middleware
+func (sh *strictHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
+	var request CreateProjectRequestObject
+
+	var body CreateProjectJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProject(ctx, request.(CreateProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProjectResponseObject); ok {
+		if err := validResponse.VisitCreateProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+// DeleteProject operation middleware
+func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteProjectRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProject operation middleware
+func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetProjectRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProject(ctx, request.(GetProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProject")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectResponseObject); ok {
+		if err := validResponse.VisitGetProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// AgentChat operation middleware
-func (sh *strictHandler) AgentChat(w http.ResponseWriter, r *http.Request) {
-	var request AgentChatRequestObject
+// UpdateProject operation middleware
+func (sh *strictHandler) UpdateProject(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateProjectRequestObject
 
-	var body AgentChatJSONRequestBody
+	request.Id = id
+
+	var body UpdateProjectJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2691,18 +9255,18 @@ func (sh *strictHandler) AgentChat(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AgentChat(ctx, request.(AgentChatRequestObject))
+		return sh.ssi.UpdateProject(ctx, request.(UpdateProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AgentChat")
+		handler = middleware(handler, "UpdateProject")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AgentChatResponseObject); ok {
-		if err := validResponse.VisitAgentChatResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateProjectResponseObject); ok {
+		if err := validResponse.VisitUpdateProjectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2710,25 +9274,25 @@ func (sh *strictHandler) AgentChat(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListArtifacts operation middleware
-func (sh *strictHandler) ListArtifacts(w http.ResponseWriter, r *http.Request, params ListArtifactsParams) {
-	var request ListArtifactsRequestObject
+// ListProjectArtifacts operation middleware
+func (sh *strictHandler) ListProjectArtifacts(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListProjectArtifactsRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListArtifacts(ctx, request.(ListArtifactsRequestObject))
+		return sh.ssi.ListProjectArtifacts(ctx, request.(ListProjectArtifactsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListArtifacts")
+		handler = middleware(handler, "ListProjectArtifacts")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListArtifactsResponseObject); ok {
-		if err := validResponse.VisitListArtifactsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListProjectArtifactsResponseObject); ok {
+		if err := validResponse.VisitListProjectArtifactsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2736,25 +9300,25 @@ func (sh *strictHandler) ListArtifacts(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// DeleteArtifact operation middleware
-func (sh *strictHandler) DeleteArtifact(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteArtifactRequestObject
+// ListProjectConversations operation middleware
+func (sh *strictHandler) ListProjectConversations(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListProjectConversationsRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteArtifact(ctx, request.(DeleteArtifactRequestObject))
+		return sh.ssi.ListProjectConversations(ctx, request.(ListProjectConversationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteArtifact")
+		handler = middleware(handler, "ListProjectConversations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteArtifactResponseObject); ok {
-		if err := validResponse.VisitDeleteArtifactResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListProjectConversationsResponseObject); ok {
+		if err := validResponse.VisitListProjectConversationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2762,25 +9326,23 @@ func (sh *strictHandler) DeleteArtifact(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetArtifact operation middleware
-func (sh *strictHandler) GetArtifact(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetArtifactRequestObject
-
-	request.Id = id
+// GetSettings operation middleware
+func (sh *strictHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetSettingsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifact(ctx, request.(GetArtifactRequestObject))
+		return sh.ssi.GetSettings(ctx, request.(GetSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifact")
+		handler = middleware(handler, "GetSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactResponseObject); ok {
-		if err := validResponse.VisitGetArtifactResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSettingsResponseObject); ok {
+		if err := validResponse.VisitGetSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2788,23 +9350,30 @@ func (sh *strictHandler) GetArtifact(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// ListCapabilities operation middleware
-func (sh *strictHandler) ListCapabilities(w http.ResponseWriter, r *http.Request) {
-	var request ListCapabilitiesRequestObject
+// UpdateSettings operation middleware
+func (sh *strictHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateSettingsRequestObject
+
+	var body UpdateSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCapabilities(ctx, request.(ListCapabilitiesRequestObject))
+		return sh.ssi.UpdateSettings(ctx, request.(UpdateSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCapabilities")
+		handler = middleware(handler, "UpdateSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCapabilitiesResponseObject); ok {
-		if err := validResponse.VisitListCapabilitiesResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2812,23 +9381,23 @@ func (sh *strictHandler) ListCapabilities(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ListConversations operation middleware
-func (sh *strictHandler) ListConversations(w http.ResponseWriter, r *http.Request) {
-	var request ListConversationsRequestObject
+// GetCalendarSettings operation middleware
+func (sh *strictHandler) GetCalendarSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetCalendarSettingsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListConversations(ctx, request.(ListConversationsRequestObject))
+		return sh.ssi.GetCalendarSettings(ctx, request.(GetCalendarSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListConversations")
+		handler = middleware(handler, "GetCalendarSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListConversationsResponseObject); ok {
-		if err := validResponse.VisitListConversationsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCalendarSettingsResponseObject); ok {
+		if err := validResponse.VisitGetCalendarSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2836,11 +9405,11 @@ func (sh *strictHandler) ListConversations(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// CreateConversation operation middleware
-func (sh *strictHandler) CreateConversation(w http.ResponseWriter, r *http.Request) {
-	var request CreateConversationRequestObject
+// UpdateCalendarSettings operation middleware
+func (sh *strictHandler) UpdateCalendarSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateCalendarSettingsRequestObject
 
-	var body CreateConversationJSONRequestBody
+	var body UpdateCalendarSettingsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2848,18 +9417,18 @@ func (sh *strictHandler) CreateConversation(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateConversation(ctx, request.(CreateConversationRequestObject))
+		return sh.ssi.UpdateCalendarSettings(ctx, request.(UpdateCalendarSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateConversation")
+		handler = middleware(handler, "UpdateCalendarSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateConversationResponseObject); ok {
-		if err := validResponse.VisitCreateConversationResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateCalendarSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateCalendarSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2867,25 +9436,23 @@ func (sh *strictHandler) CreateConversation(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// DeleteConversation operation middleware
-func (sh *strictHandler) DeleteConversation(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteConversationRequestObject
-
-	request.Id = id
+// GetEmailSettings operation middleware
+func (sh *strictHandler) GetEmailSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetEmailSettingsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConversation(ctx, request.(DeleteConversationRequestObject))
+		return sh.ssi.GetEmailSettings(ctx, request.(GetEmailSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConversation")
+		handler = middleware(handler, "GetEmailSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConversationResponseObject); ok {
-		if err := validResponse.VisitDeleteConversationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetEmailSettingsResponseObject); ok {
+		if err := validResponse.VisitGetEmailSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2893,25 +9460,30 @@ func (sh *strictHandler) DeleteConversation(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetConversation operation middleware
-func (sh *strictHandler) GetConversation(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetConversationRequestObject
+// UpdateEmailSettings operation middleware
+func (sh *strictHandler) UpdateEmailSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateEmailSettingsRequestObject
 
-	request.Id = id
+	var body UpdateEmailSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConversation(ctx, request.(GetConversationRequestObject))
+		return sh.ssi.UpdateEmailSettings(ctx, request.(UpdateEmailSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConversation")
+		handler = middleware(handler, "UpdateEmailSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConversationResponseObject); ok {
-		if err := validResponse.VisitGetConversationResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateEmailSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateEmailSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2919,13 +9491,35 @@ func (sh *strictHandler) GetConversation(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateConversation operation middleware
-func (sh *strictHandler) UpdateConversation(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateConversationRequestObject
+// GetEmbeddingSettings operation middleware
+func (sh *strictHandler) GetEmbeddingSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetEmbeddingSettingsRequestObject
 
-	request.Id = id
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEmbeddingSettings(ctx, request.(GetEmbeddingSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEmbeddingSettings")
+	}
 
-	var body UpdateConversationJSONRequestBody
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEmbeddingSettingsResponseObject); ok {
+		if err := validResponse.VisitGetEmbeddingSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateEmbeddingSettings operation middleware
+func (sh *strictHandler) UpdateEmbeddingSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateEmbeddingSettingsRequestObject
+
+	var body UpdateEmbeddingSettingsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2933,18 +9527,18 @@ func (sh *strictHandler) UpdateConversation(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateConversation(ctx, request.(UpdateConversationRequestObject))
+		return sh.ssi.UpdateEmbeddingSettings(ctx, request.(UpdateEmbeddingSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateConversation")
+		handler = middleware(handler, "UpdateEmbeddingSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateConversationResponseObject); ok {
-		if err := validResponse.VisitUpdateConversationResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateEmbeddingSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateEmbeddingSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2952,25 +9546,30 @@ func (sh *strictHandler) UpdateConversation(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// StreamConversationEvents operation middleware
-func (sh *strictHandler) StreamConversationEvents(w http.ResponseWriter, r *http.Request, id string) {
-	var request StreamConversationEventsRequestObject
+// UpdateImageBackends operation middleware
+func (sh *strictHandler) UpdateImageBackends(w http.ResponseWriter, r *http.Request) {
+	var request UpdateImageBackendsRequestObject
 
-	request.Id = id
+	var body UpdateImageBackendsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.StreamConversationEvents(ctx, request.(StreamConversationEventsRequestObject))
+		return sh.ssi.UpdateImageBackends(ctx, request.(UpdateImageBackendsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StreamConversationEvents")
+		handler = middleware(handler, "UpdateImageBackends")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(StreamConversationEventsResponseObject); ok {
-		if err := validResponse.VisitStreamConversationEventsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateImageBackendsResponseObject); ok {
+		if err := validResponse.VisitUpdateImageBackendsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2978,26 +9577,54 @@ func (sh *strictHandler) StreamConversationEvents(w http.ResponseWriter, r *http
 	}
 }
 
-// ListMessages operation middleware
-func (sh *strictHandler) ListMessages(w http.ResponseWriter, r *http.Request, id string, params ListMessagesParams) {
-	var request ListMessagesRequestObject
+// GetRuntimeSettings operation middleware
+func (sh *strictHandler) GetRuntimeSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetRuntimeSettingsRequestObject
 
-	request.Id = id
-	request.Params = params
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRuntimeSettings(ctx, request.(GetRuntimeSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRuntimeSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRuntimeSettingsResponseObject); ok {
+		if err := validResponse.VisitGetRuntimeSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateRuntimeSettings operation middleware
+func (sh *strictHandler) UpdateRuntimeSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateRuntimeSettingsRequestObject
+
+	var body UpdateRuntimeSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListMessages(ctx, request.(ListMessagesRequestObject))
+		return sh.ssi.UpdateRuntimeSettings(ctx, request.(UpdateRuntimeSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListMessages")
+		handler = middleware(handler, "UpdateRuntimeSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListMessagesResponseObject); ok {
-		if err := validResponse.VisitListMessagesResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateRuntimeSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateRuntimeSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3005,23 +9632,23 @@ func (sh *strictHandler) ListMessages(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// ListJobs operation middleware
-func (sh *strictHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
-	var request ListJobsRequestObject
+// GetSearchSettings operation middleware
+func (sh *strictHandler) GetSearchSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetSearchSettingsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListJobs(ctx, request.(ListJobsRequestObject))
+		return sh.ssi.GetSearchSettings(ctx, request.(GetSearchSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListJobs")
+		handler = middleware(handler, "GetSearchSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListJobsResponseObject); ok {
-		if err := validResponse.VisitListJobsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSearchSettingsResponseObject); ok {
+		if err := validResponse.VisitGetSearchSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3029,11 +9656,11 @@ func (sh *strictHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// SubmitJob operation middleware
-func (sh *strictHandler) SubmitJob(w http.ResponseWriter, r *http.Request) {
-	var request SubmitJobRequestObject
+// UpdateSearchSettings operation middleware
+func (sh *strictHandler) UpdateSearchSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateSearchSettingsRequestObject
 
-	var body SubmitJobJSONRequestBody
+	var body UpdateSearchSettingsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3041,18 +9668,49 @@ func (sh *strictHandler) SubmitJob(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SubmitJob(ctx, request.(SubmitJobRequestObject))
+		return sh.ssi.UpdateSearchSettings(ctx, request.(UpdateSearchSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SubmitJob")
+		handler = middleware(handler, "UpdateSearchSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateSearchSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateSearchSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TestConnection operation middleware
+func (sh *strictHandler) TestConnection(w http.ResponseWriter, r *http.Request) {
+	var request TestConnectionRequestObject
+
+	var body TestConnectionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TestConnection(ctx, request.(TestConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TestConnection")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SubmitJobResponseObject); ok {
-		if err := validResponse.VisitSubmitJobResponse(w); err != nil {
+	} else if validResponse, ok := response.(TestConnectionResponseObject); ok {
+		if err := validResponse.VisitTestConnectionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3060,25 +9718,25 @@ func (sh *strictHandler) SubmitJob(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetJob operation middleware
-func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetJobRequestObject
+// SearchSpans operation middleware
+func (sh *strictHandler) SearchSpans(w http.ResponseWriter, r *http.Request, params SearchSpansParams) {
+	var request SearchSpansRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetJob(ctx, request.(GetJobRequestObject))
+		return sh.ssi.SearchSpans(ctx, request.(SearchSpansRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetJob")
+		handler = middleware(handler, "SearchSpans")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetJobResponseObject); ok {
-		if err := validResponse.VisitGetJobResponse(w); err != nil {
+	} else if validResponse, ok := response.(SearchSpansResponseObject); ok {
+		if err := validResponse.VisitSearchSpansResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3086,26 +9744,30 @@ func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id strin
 	}
 }
 
-// ServeJobFile operation middleware
-func (sh *strictHandler) ServeJobFile(w http.ResponseWriter, r *http.Request, id string, filename string) {
-	var request ServeJobFileRequestObject
+// CreateDiagnosticsBundle operation middleware
+func (sh *strictHandler) CreateDiagnosticsBundle(w http.ResponseWriter, r *http.Request) {
+	var request CreateDiagnosticsBundleRequestObject
 
-	request.Id = id
-	request.Filename = filename
+	var body CreateDiagnosticsBundleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ServeJobFile(ctx, request.(ServeJobFileRequestObject))
+		return sh.ssi.CreateDiagnosticsBundle(ctx, request.(CreateDiagnosticsBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ServeJobFile")
+		handler = middleware(handler, "CreateDiagnosticsBundle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ServeJobFileResponseObject); ok {
-		if err := validResponse.VisitServeJobFileResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateDiagnosticsBundleResponseObject); ok {
+		if err := validResponse.VisitCreateDiagnosticsBundleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3113,25 +9775,23 @@ func (sh *strictHandler) ServeJobFile(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// StreamJob operation middleware
-func (sh *strictHandler) StreamJob(w http.ResponseWriter, r *http.Request, id string) {
-	var request StreamJobRequestObject
-
-	request.Id = id
+// GetKernelInbox operation middleware
+func (sh *strictHandler) GetKernelInbox(w http.ResponseWriter, r *http.Request) {
+	var request GetKernelInboxRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.StreamJob(ctx, request.(StreamJobRequestObject))
+		return sh.ssi.GetKernelInbox(ctx, request.(GetKernelInboxRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StreamJob")
+		handler = middleware(handler, "GetKernelInbox")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(StreamJobResponseObject); ok {
-		if err := validResponse.VisitStreamJobResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKernelInboxResponseObject); ok {
+		if err := validResponse.VisitGetKernelInboxResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3139,23 +9799,25 @@ func (sh *strictHandler) StreamJob(w http.ResponseWriter, r *http.Request, id st
 	}
 }
 
-// ListModels operation middleware
-func (sh *strictHandler) ListModels(w http.ResponseWriter, r *http.Request) {
-	var request ListModelsRequestObject
+// QueryLogs operation middleware
+func (sh *strictHandler) QueryLogs(w http.ResponseWriter, r *http.Request, params QueryLogsParams) {
+	var request QueryLogsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListModels(ctx, request.(ListModelsRequestObject))
+		return sh.ssi.QueryLogs(ctx, request.(QueryLogsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListModels")
+		handler = middleware(handler, "QueryLogs")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListModelsResponseObject); ok {
-		if err := validResponse.VisitListModelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(QueryLogsResponseObject); ok {
+		if err := validResponse.VisitQueryLogsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3163,11 +9825,11 @@ func (sh *strictHandler) ListModels(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DiscoverModels operation middleware
-func (sh *strictHandler) DiscoverModels(w http.ResponseWriter, r *http.Request) {
-	var request DiscoverModelsRequestObject
+// PauseSystem operation middleware
+func (sh *strictHandler) PauseSystem(w http.ResponseWriter, r *http.Request) {
+	var request PauseSystemRequestObject
 
-	var body DiscoverModelsJSONRequestBody
+	var body PauseSystemJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3175,18 +9837,18 @@ func (sh *strictHandler) DiscoverModels(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DiscoverModels(ctx, request.(DiscoverModelsRequestObject))
+		return sh.ssi.PauseSystem(ctx, request.(PauseSystemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DiscoverModels")
+		handler = middleware(handler, "PauseSystem")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DiscoverModelsResponseObject); ok {
-		if err := validResponse.VisitDiscoverModelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PauseSystemResponseObject); ok {
+		if err := validResponse.VisitPauseSystemResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3194,23 +9856,23 @@ func (sh *strictHandler) DiscoverModels(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// ListPersonas operation middleware
-func (sh *strictHandler) ListPersonas(w http.ResponseWriter, r *http.Request) {
-	var request ListPersonasRequestObject
+// ResumeSystem operation middleware
+func (sh *strictHandler) ResumeSystem(w http.ResponseWriter, r *http.Request) {
+	var request ResumeSystemRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPersonas(ctx, request.(ListPersonasRequestObject))
+		return sh.ssi.ResumeSystem(ctx, request.(ResumeSystemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPersonas")
+		handler = middleware(handler, "ResumeSystem")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPersonasResponseObject); ok {
-		if err := validResponse.VisitListPersonasResponse(w); err != nil {
+	} else if validResponse, ok := response.(ResumeSystemResponseObject); ok {
+		if err := validResponse.VisitResumeSystemResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3218,30 +9880,23 @@ func (sh *strictHandler) ListPersonas(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreatePersona operation middleware
-func (sh *strictHandler) CreatePersona(w http.ResponseWriter, r *http.Request) {
-	var request CreatePersonaRequestObject
-
-	var body CreatePersonaJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetSSEStats operation middleware
+func (sh *strictHandler) GetSSEStats(w http.ResponseWriter, r *http.Request) {
+	var request GetSSEStatsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePersona(ctx, request.(CreatePersonaRequestObject))
+		return sh.ssi.GetSSEStats(ctx, request.(GetSSEStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePersona")
+		handler = middleware(handler, "GetSSEStats")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreatePersonaResponseObject); ok {
-		if err := validResponse.VisitCreatePersonaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSSEStatsResponseObject); ok {
+		if err := validResponse.VisitGetSSEStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3249,25 +9904,25 @@ func (sh *strictHandler) CreatePersona(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeletePersona operation middleware
-func (sh *strictHandler) DeletePersona(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeletePersonaRequestObject
+// GetSystemStats operation middleware
+func (sh *strictHandler) GetSystemStats(w http.ResponseWriter, r *http.Request, params GetSystemStatsParams) {
+	var request GetSystemStatsRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePersona(ctx, request.(DeletePersonaRequestObject))
+		return sh.ssi.GetSystemStats(ctx, request.(GetSystemStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePersona")
+		handler = middleware(handler, "GetSystemStats")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeletePersonaResponseObject); ok {
-		if err := validResponse.VisitDeletePersonaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSystemStatsResponseObject); ok {
+		if err := validResponse.VisitGetSystemStatsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3275,25 +9930,23 @@ func (sh *strictHandler) DeletePersona(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetPersona operation middleware
-func (sh *strictHandler) GetPersona(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetPersonaRequestObject
-
-	request.Id = id
+// GetMaintenanceStatus operation middleware
+func (sh *strictHandler) GetMaintenanceStatus(w http.ResponseWriter, r *http.Request) {
+	var request GetMaintenanceStatusRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPersona(ctx, request.(GetPersonaRequestObject))
+		return sh.ssi.GetMaintenanceStatus(ctx, request.(GetMaintenanceStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPersona")
+		handler = middleware(handler, "GetMaintenanceStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPersonaResponseObject); ok {
-		if err := validResponse.VisitGetPersonaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMaintenanceStatusResponseObject); ok {
+		if err := validResponse.VisitGetMaintenanceStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3301,32 +9954,23 @@ func (sh *strictHandler) GetPersona(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// UpdatePersona operation middleware
-func (sh *strictHandler) UpdatePersona(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdatePersonaRequestObject
-
-	request.Id = id
-
-	var body UpdatePersonaJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetVersion operation middleware
+func (sh *strictHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
+	var request GetVersionRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdatePersona(ctx, request.(UpdatePersonaRequestObject))
+		return sh.ssi.GetVersion(ctx, request.(GetVersionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdatePersona")
+		handler = middleware(handler, "GetVersion")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdatePersonaResponseObject); ok {
-		if err := validResponse.VisitUpdatePersonaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVersionResponseObject); ok {
+		if err := validResponse.VisitGetVersionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3334,23 +9978,23 @@ func (sh *strictHandler) UpdatePersona(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// ListPlugins operation middleware
-func (sh *strictHandler) ListPlugins(w http.ResponseWriter, r *http.Request) {
-	var request ListPluginsRequestObject
+// ListScheduledTasks operation middleware
+func (sh *strictHandler) ListScheduledTasks(w http.ResponseWriter, r *http.Request) {
+	var request ListScheduledTasksRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPlugins(ctx, request.(ListPluginsRequestObject))
+		return sh.ssi.ListScheduledTasks(ctx, request.(ListScheduledTasksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPlugins")
+		handler = middleware(handler, "ListScheduledTasks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPluginsResponseObject); ok {
-		if err := validResponse.VisitListPluginsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListScheduledTasksResponseObject); ok {
+		if err := validResponse.VisitListScheduledTasksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3358,23 +10002,30 @@ func (sh *strictHandler) ListPlugins(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListProjects operation middleware
-func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
-	var request ListProjectsRequestObject
+// CreateScheduledTask operation middleware
+func (sh *strictHandler) CreateScheduledTask(w http.ResponseWriter, r *http.Request) {
+	var request CreateScheduledTaskRequestObject
+
+	var body CreateScheduledTaskJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListProjects(ctx, request.(ListProjectsRequestObject))
+		return sh.ssi.CreateScheduledTask(ctx, request.(CreateScheduledTaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListProjects")
+		handler = middleware(handler, "CreateScheduledTask")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListProjectsResponseObject); ok {
-		if err := validResponse.VisitListProjectsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateScheduledTaskResponseObject); ok {
+		if err := validResponse.VisitCreateScheduledTaskResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3382,30 +10033,25 @@ func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateProject operation middleware
-func (sh *strictHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
-	var request CreateProjectRequestObject
+// DeleteScheduledTask operation middleware
+func (sh *strictHandler) DeleteScheduledTask(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteScheduledTaskRequestObject
 
-	var body CreateProjectJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateProject(ctx, request.(CreateProjectRequestObject))
+		return sh.ssi.DeleteScheduledTask(ctx, request.(DeleteScheduledTaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateProject")
+		handler = middleware(handler, "DeleteScheduledTask")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateProjectResponseObject); ok {
-		if err := validResponse.VisitCreateProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteScheduledTaskResponseObject); ok {
+		if err := validResponse.VisitDeleteScheduledTaskResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3413,25 +10059,25 @@ func (sh *strictHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteProject operation middleware
-func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteProjectRequestObject
+// ToggleScheduledTask operation middleware
+func (sh *strictHandler) ToggleScheduledTask(w http.ResponseWriter, r *http.Request, id string) {
+	var request ToggleScheduledTaskRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
+		return sh.ssi.ToggleScheduledTask(ctx, request.(ToggleScheduledTaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProject")
+		handler = middleware(handler, "ToggleScheduledTask")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
-		if err := validResponse.VisitDeleteProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(ToggleScheduledTaskResponseObject); ok {
+		if err := validResponse.VisitToggleScheduledTaskResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3439,25 +10085,23 @@ func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetProject operation middleware
-func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetProjectRequestObject
-
-	request.Id = id
+// ListTools operation middleware
+func (sh *strictHandler) ListTools(w http.ResponseWriter, r *http.Request) {
+	var request ListToolsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProject(ctx, request.(GetProjectRequestObject))
+		return sh.ssi.ListTools(ctx, request.(ListToolsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProject")
+		handler = middleware(handler, "ListTools")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProjectResponseObject); ok {
-		if err := validResponse.VisitGetProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListToolsResponseObject); ok {
+		if err := validResponse.VisitListToolsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3465,13 +10109,13 @@ func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// UpdateProject operation middleware
-func (sh *strictHandler) UpdateProject(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateProjectRequestObject
+// RunTool operation middleware
+func (sh *strictHandler) RunTool(w http.ResponseWriter, r *http.Request, name string) {
+	var request RunToolRequestObject
 
-	request.Id = id
+	request.Name = name
 
-	var body UpdateProjectJSONRequestBody
+	var body RunToolJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3479,18 +10123,18 @@ func (sh *strictHandler) UpdateProject(w http.ResponseWriter, r *http.Request, i
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateProject(ctx, request.(UpdateProjectRequestObject))
+		return sh.ssi.RunTool(ctx, request.(RunToolRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateProject")
+		handler = middleware(handler, "RunTool")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateProjectResponseObject); ok {
-		if err := validResponse.VisitUpdateProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(RunToolResponseObject); ok {
+		if err := validResponse.VisitRunToolResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3498,25 +10142,25 @@ func (sh *strictHandler) UpdateProject(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// ListProjectArtifacts operation middleware
-func (sh *strictHandler) ListProjectArtifacts(w http.ResponseWriter, r *http.Request, id string) {
-	var request ListProjectArtifactsRequestObject
+// ListTraces operation middleware
+func (sh *strictHandler) ListTraces(w http.ResponseWriter, r *http.Request, params ListTracesParams) {
+	var request ListTracesRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListProjectArtifacts(ctx, request.(ListProjectArtifactsRequestObject))
+		return sh.ssi.ListTraces(ctx, request.(ListTracesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListProjectArtifacts")
+		handler = middleware(handler, "ListTraces")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListProjectArtifactsResponseObject); ok {
-		if err := validResponse.VisitListProjectArtifactsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTracesResponseObject); ok {
+		if err := validResponse.VisitListTracesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3524,25 +10168,25 @@ func (sh *strictHandler) ListProjectArtifacts(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// ListProjectConversations operation middleware
-func (sh *strictHandler) ListProjectConversations(w http.ResponseWriter, r *http.Request, id string) {
-	var request ListProjectConversationsRequestObject
+// GetTrace operation middleware
+func (sh *strictHandler) GetTrace(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetTraceRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListProjectConversations(ctx, request.(ListProjectConversationsRequestObject))
+		return sh.ssi.GetTrace(ctx, request.(GetTraceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListProjectConversations")
+		handler = middleware(handler, "GetTrace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListProjectConversationsResponseObject); ok {
-		if err := validResponse.VisitListProjectConversationsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTraceResponseObject); ok {
+		if err := validResponse.VisitGetTraceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3550,23 +10194,23 @@ func (sh *strictHandler) ListProjectConversations(w http.ResponseWriter, r *http
 	}
 }
 
-// GetSettings operation middleware
-func (sh *strictHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
-	var request GetSettingsRequestObject
+// ListWorkers operation middleware
+func (sh *strictHandler) ListWorkers(w http.ResponseWriter, r *http.Request) {
+	var request ListWorkersRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSettings(ctx, request.(GetSettingsRequestObject))
+		return sh.ssi.ListWorkers(ctx, request.(ListWorkersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSettings")
+		handler = middleware(handler, "ListWorkers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSettingsResponseObject); ok {
-		if err := validResponse.VisitGetSettingsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWorkersResponseObject); ok {
+		if err := validResponse.VisitListWorkersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3574,30 +10218,25 @@ func (sh *strictHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UpdateSettings operation middleware
-func (sh *strictHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
-	var request UpdateSettingsRequestObject
+// KillWorker operation middleware
+func (sh *strictHandler) KillWorker(w http.ResponseWriter, r *http.Request, id string) {
+	var request KillWorkerRequestObject
 
-	var body UpdateSettingsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateSettings(ctx, request.(UpdateSettingsRequestObject))
+		return sh.ssi.KillWorker(ctx, request.(KillWorkerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateSettings")
+		handler = middleware(handler, "KillWorker")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateSettingsResponseObject); ok {
-		if err := validResponse.VisitUpdateSettingsResponse(w); err != nil {
+	} else if validResponse, ok := response.(KillWorkerResponseObject); ok {
+		if err := validResponse.VisitKillWorkerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3605,30 +10244,52 @@ func (sh *strictHandler) UpdateSettings(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// TestConnection operation middleware
-func (sh *strictHandler) TestConnection(w http.ResponseWriter, r *http.Request) {
-	var request TestConnectionRequestObject
+// GetWorker operation middleware
+func (sh *strictHandler) GetWorker(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetWorkerRequestObject
 
-	var body TestConnectionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWorker(ctx, request.(GetWorkerRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWorker")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWorkerResponseObject); ok {
+		if err := validResponse.VisitGetWorkerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWorkerLogs operation middleware
+func (sh *strictHandler) GetWorkerLogs(w http.ResponseWriter, r *http.Request, id string, params GetWorkerLogsParams) {
+	var request GetWorkerLogsRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.TestConnection(ctx, request.(TestConnectionRequestObject))
+		return sh.ssi.GetWorkerLogs(ctx, request.(GetWorkerLogsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TestConnection")
+		handler = middleware(handler, "GetWorkerLogs")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(TestConnectionResponseObject); ok {
-		if err := validResponse.VisitTestConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWorkerLogsResponseObject); ok {
+		if err := validResponse.VisitGetWorkerLogsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3717,6 +10378,32 @@ func (sh *strictHandler) GetWorkflow(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
+// StreamWorkflowEvents operation middleware
+func (sh *strictHandler) StreamWorkflowEvents(w http.ResponseWriter, r *http.Request, id string) {
+	var request StreamWorkflowEventsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamWorkflowEvents(ctx, request.(StreamWorkflowEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamWorkflowEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamWorkflowEventsResponseObject); ok {
+		if err := validResponse.VisitStreamWorkflowEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ResumeWorkflow operation middleware
 func (sh *strictHandler) ResumeWorkflow(w http.ResponseWriter, r *http.Request, id string) {
 	var request ResumeWorkflowRequestObject
@@ -3779,69 +10466,243 @@ func (sh *strictHandler) RunWorkflow(w http.ResponseWriter, r *http.Request, id
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9Q82XLbuJa/guJMVeyMLMlxMg96czvpHmeyuOPkpm51UiqIPJIQgwADgLbVLv/7LSzc",
-	"QYpyS7LzZJk4AM6+AIe8C0IeJ5wBUzKY3AUyXEKMzc/TJDnjbE4W+p9E8ASEIiDdf9ckAiGbQyTGC9A/",
-	"/lvAPJgE/zUqNhi51UcXbr5b/34QUBpvOul+EKhVAsEk4LMfECrfk0FwKhSZ41A1MQ05uwYhsSKcTUmk",
-	"H7nZUgnCDFqhAKwgmmIzf85FrH8FEVZwpEgMwaA5Z04oTBOslt4VWzb6wWdtOMQkhql96hllOPYPJIJr",
-	"HrStmggeJ8o7JMnfMJ2tlGVTTjRh6n9fFgQTpmABIsiZfhcAS+Ng8pfTgUGg4FYFgyDiYRoD0z9xGhEe",
-	"DAItSP2XqyWI4HuDiz5JnuEEzwglauWRZWUMbnGcUIOkxmS4AAYCK6+0IpChIIlWAi8zRMqMoEvkxakM",
-	"qV5MrhhOJGyM/zsi1SeQCWcSOmjJLEpBLNcZR4k5xd5YCGz+lwqrDZa4NOBriLjM1qxi75hTsLKkJxm/",
-	"vIOKK0x9Q140llh9gp8pyH6GXZFy8NH8wHSIzueIx0QpiAYIIwY3qDwZEYmcB0A4VTzGioSY0tXQp0kx",
-	"SOl8X6GAn1KGMEpWaskZsjggtcQKJYIwJdESKOXe1XgEtLoWpTjGJz7gBITkDHcSixwQOn+NFEepBDTn",
-	"AqmlJnKJ1RBdgpJILQHhBTCVwWtZI8wipDinaE6oAuGhX5sK/EyJgMjYiGPG91bhtar/Oul9XkJVSoYE",
-	"tyGaAeVsIZHyclWUtm36PQVJf4v7BKehulSQ+AxOLXm6WKom8mdLTBjic+QgEBdIAJacEbZASuDQ66U0",
-	"76da9Zr8wmIhS+QUbG6JDP3C5hlnDEKN9GcwziqlHksrqbxXgVsHaFtMMhG+RTpYpbLsh/lVMAhACO6L",
-	"IIMgFbQX+YbYXJs8CvmAHKAt6G5ip6mECBGn3mWF93qASrRvW9kCNVdcYzWKKOqXZZpEG/LGx/83RoYN",
-	"xkP2uMcSb/lsO5Jr27RVpiK3jQ6V7UdBR0SLY8yiSjj4K7BRJRgER2GgNYAwdfDs/3RAQV+5oNGzQ20X",
-	"uTNrirXmtIBdG4cSRcQqzEUFibb5BQl57l/ELIvj5GR4PD6SlMQtTpmnIoQeLjcDrIebLOPMGPW9jcFt",
-	"UadFuBtJ8H3hDRshTen811th7KgKadNWTiu5bCpB6LxcSiIVNjm6XEkFRlCcU69n3U2gfPyg915HpssE",
-	"wuaGMyxh6o8oGa8Lpf95A+zF8NXkZOaVjJxSHlZy3RnnFDArE1Cs9ucNMPRi+Aqd/Nbi+fOgWUziJln0",
-	"WltNA2xxRI3xRMaGtLaRa/1zjqXyawD5u4alDzkfjy9sdPOIlFJ+A9FUS116sj6dfWrmSBu+sigZYqYj",
-	"5RC9iRO1QjFgJhGm1KSrUieq/R1gyKn1/bWtMaE3hEXIjCPFr4ChAxguhmhGUxggiDUHowG6JpyCOvSx",
-	"/SE2vK42JaEdqKL7Lg1JBEgPGoZt4B2InM5SQhVhfuU0qduUX4MQxGZ31a2NATWqCyeqqog0hPU0KII5",
-	"TqnyFlWtxxt27rTjGGM7uckFTRfEkxXWZFMYwhu2xCwEiUxMQhZBiW6IWqKfqS2nrmB1w0UkuyguxVCz",
-	"xBHYhYXXqEunFPm8rNxuqyeaO7ktMq56JupY1SD5eDgejjdh55oDEJ5WgmXpkCAx0/vHHie9hq17UbPZ",
-	"8XbSyLWmG212mrclZa4eoTZ9cEKmV7DyGDaWVxAhznSxGqGD58+fP7+9vb09HKCEYi2gW6VHbwRRuS+2",
-	"mKArgEQiuCVSEbYY+rllPMDUc+ixgDjGJ5PjF95YagJpFpeLSUulksloZEaXXKrJ8fHLk5ej6+O2k5Ym",
-	"xc/M5GfGi300wXR0xuP56sv5AD0TEHMFbjABdnp+pJUPKzKjgE4vznVh6uKrjfU6XdVzvNHUDvnJkJPR",
-	"CCdkyBNgmAxDHnvJ8Em7SLyagg5bNdMOTQlLUtVeDiiRgmfLOWGYTjGTNy1VPJHTFphSmOEzCeIat6LY",
-	"njT62VAqL2qWnaSlRVgaz6ybiSHmYjWNZ33PI79ycTWn/MZbuiUUNnUfu3A5G1e2D71akAqLTZHXhRZs",
-	"rG7NM6EEWKSXNCGR2V8JTiVEtjq0sjCpLaH2oY54VP/+56VOpgb+aqdLcfxmGoGmR06tRPsnsm3pHVMg",
-	"RJp4YhyeqzZrnMGcC2hJCFsPAH3EVo+/NrmSEq0HkApHWGFvLchT5XxYD9z6qVLEGZSVR16RJGlRnbyQ",
-	"6Ss3T5FqhDbnzfB0enHuEmxAOKXw8RL9PwgGdIBMIaUDr0xnMVE66KIffCbNIb5UAnCsH1G+MOWRO9wL",
-	"Kqu4GJZne8F4eDwcG6bqOJQQXfENx8MTY15qacgbXR+PzNXBKFxa20+4Pc3SIjPu/DzSuGuYMw1iD3FA",
-	"qt94tKqdlOAkoSQ0s0Y/pLUAa2drL7JKl0P31ZMi7UdK1wAG7Rfj8Za3domt2bsmNnOzUkAMgldb3N2e",
-	"o3q2PdeGzzBFOq6CQOAAB4FM4xiLlb2ZULZK0TplEDUQRqruEt3wawEemeqM/jSH0kohcAzKtAj8VVfe",
-	"380lEpqtULYwMoqvlT2YBD9TEKsgiz+BGypYsMVr5u//UBd6RYW8BaFp8Q1JaT4iPkcFx6tSMuOY0hpA",
-	"RUijOxLdW5eho11TWK/N8xythrSMFEwHQy4EEgV1OyqLZD1fXzZ9mEUj0mx56Rv/wBWa85RFNR7YeQiz",
-	"nAl6Ca9a/gFqv2Ruz5gLrfG4kcxsIlCYUFli4W4dSb4xa5HNH6AKo86xcxpa72xo9SRnZcBdumt/S4aH",
-	"8AISxTixflLmbRIe+3RnW2WS0YFtjkD/g9zRzGHBmtI1wBreVCD34b8q16Mb+LAqTS18qgENWnKGM1MQ",
-	"nVVvQR+aPFRzyPx2syi8P8ANcgnK+ouEHgnG8fY0tiILj56Wb3VdFVkP9Oapp93Fr4w9o0lNNL9mRKmx",
-	"oz2o7J/a8eOo0L4DTGXzriATtmCZYBUumzL7Ys5P9yK2rfqkrbif/emOZXPUdCuF8CxIVX72Ms0Qfdjh",
-	"hUZwnfUpew3z0hS3ZRzf2AmPYqG6LLEYH9mqu8rm+oINXhrcXcFe46EltMpDyxtEWEjTyJX/R7aTD4eK",
-	"XOvU5eDy8k0ng915TncG8j4D2gFbB3feGpCSmKhKEeiuDILJq3GzJXk/dV3W77FBSpTz15MNZWOIsEYk",
-	"chL7wWfdonmrAfZB+1s+24Rug/hTOu3I888MM3/aeWmO0DSxuzmqKjV97TmRLHdDeXj4ls/y1meZhiFI",
-	"OU8pXdlEYC9CvMaURFo+SCYQkrnb4EmpkdUPl0trnh2cfv1yWLHXPH9uyyWtdnUemOmVz19n52NPNrM0",
-	"TsGvS6/3nEfqPbvSx1SCMLpVP6PIRTaaEwpydKf/aFa3y/BS68dbPvudmLdCdhYTq4tkeO1Q+jxU4E9e",
-	"8ku9GWHYBOq16cznJSCNM8o2ayvSNBvLssvN/QHmqYcQtvtGRECo6ArNBY/NIbeW/w0XVzLBIXg0oKC8",
-	"I918aua7Ju0sNftomAmyF1/fWIQVnqC7b+4m7FswQd+CT18+fDj/8Me34P4b+8bcDMoXJXBKGFjgMx4n",
-	"hBK2GA6HZkYftahmuS0q0WXNLhfWsqR8kd1yaRKQbZqRtbTXNJmsyXAtyF6SyLzndJPbgWtMKJ5RQI4Y",
-	"X3JTg0EHRu9DrDDlizo7RhGRIb+2d8D+XOi1gygxZxulLiUKKI2npd6jZpuPg1nf6PNyPB77+gtsP2zf",
-	"TqF+5373T04/MhFB5FeMbDxTCaMRtrtJ282IC/SOKHj37n2uHu7OvtteLjKgfXAk6yDewF5yIlqKgGK8",
-	"+/w523pbut/oeX5Ar3Khy+EKsx6NQcWE11hpuWO6kkTaLBtTIr2Nn1mvcTF7hsVRuMRCtb6+2NEp/NGN",
-	"WE1s9Aq7EyHXSH8U8gjE5GR22K9h9tSQ5MWr0TZczPo3TxEWOl2ICr6o4XD9y44uDauu/f2RbwpyM2ma",
-	"xUXWO999PxCmUvE4E0nDI/S8GChs5he9E0gKf9NWwu2VxvE+VWTf5//Zvl21W9LErfPUf9fSeSqR4MHv",
-	"j/Tw3tt7MeOxrzA6lD67vShZ/JNQendl0nDExcsQ7ZmZg9klQ5uvdHSkYpTjCCL0FcsYZQR4sjIHdmn7",
-	"JCqQhnTbgbyG9gxoL1mpe3tkk6w0w68tK83H12Slbutt+aLWfPE9Fldg+klDHCeYLBjCUoLq+R7TWTbp",
-	"z5f9UqpHT6EymXpM1r1K36PFIslXqepu3/wpl+6vmj8VltGaP+2TxvE+9WPv+ZPbtzN/auLWnT/tWDo7",
-	"8Fl905VHT0baNShPRgrzeRIalCUjDuxAc3aASmsc+j1dzyZyt31HL/nj+4Tdd33b775AJXbUM4QyLO6O",
-	"Mhu0lzr+17tMf00ZbKVztYcsGvBNeUgwb+LIrpvgywxml23l+Vcdfd13qRCmYahYHuWIe3rwHHgGgg4k",
-	"hAKURLF5X/jQhJZUtQWWCr3bb66okbo/r97J48yvdzJtLy0W/8KURK55zHN56hx9gSewUKwSJVGGMFZI",
-	"gFSHDSUfqewzRt6y5TOYNnb3gbGt1S2VL6Fkb1/TOMg+TPR9XdGRL/D98dssm19f83fLOjikOY5EBlmW",
-	"o14CZaRpV5XxPZPajXsHtTswfM2h9uG58xeqN/DaBR0tBXUJoLuiznd/5PS0x6vWW3092XepYXZ47Eq8",
-	"UIem+LOxohbP/ae/pcx+2qCjYs/0BEUwJ4z4jWVtX1dJiX6pqrYXr5t1bQtgVyF6U1ut1LvRwu+R9nDW",
-	"VPz2+8mM75z12/AJ5pMHU9ejsuGnD3bRjtDErueX7zrUxIqrLnsrJJ0kmy8z5HrQKvSUdUg8ZU/Z0rb9",
-	"mcEOZrvvbjzcJu3HikvSuL//TwAAAP//TYKDoiBeAAA=",
+	"H4sIAAAAAAAC/+y963IcN7Ig/CqI+r4NSbPNJqmLz5iKiV1akm3ZkqxDUqNzdqjoQFehu2FWAWUARbKt",
+	"0N99gP2zD7Rvsk+ykQnUHXVpit2kxxMTE6a6cM1MJBJ5/RyEMkmlYMLo4OhzoMMVSyj+eZymL6RY8CX8",
+	"I1UyZcpwpt2/LnnElG5/4gldMvjj/1dsERwF/99+OcG+G33/vevvxv8yCeI42bTTl0lg1ikLjgI5/5WF",
+	"xvfLJDhWhi9oaNorDaW4ZEpTw6WY8Qh+cr21UVzgskLFqGHRjGL/hVQJ/BVE1LA9wxMWTNp9Fjxms5Sa",
+	"lXfEjol+lfOuNSQ8YTP7q+eroIn/Q6okwKBr1FTJJDXeT5r/zmbztWG6tmkuzDdPyw1zYdiSqaAA+ueA",
+	"iSwJjv7haGASGHZtgkkQyTBLmIA/aRZxGUwCQCT8V5oVU8GnFhS9mMyMTBBbbVzSMP+9j4LKEY5te8Cw",
+	"FBGHf1jqNSzR44d5kXcuoRBQpej6prST95mvq/CkSwu9THuBNQmYoPOYVRE9lzJmVPRQXEy1mTGlpOr+",
+	"rJjOYtPzPRPj99ZJqYJdF0NFTIeKpxabwQslBTGKL5dM6WAycqIByleZmIUyE9V9VYnZTjeeCM5cByBa",
+	"xX7LuAJM/APgXluLg0CJrXKuSU7A1dXVKOhT74E4Lsi/Dr+PK2oIJSqLGYkk01PyHo89oSIiRsqYULXE",
+	"s0ksfDRJ6JootmCKiZARdgnfImoooZp8/oz/np5nBwdPwgVncYR/si9fpsGkeSTV0v43skeExu8r343K",
+	"mGdLF1xEVcp3XGoSXEl1sYjlFQBNyth7ClKmtBT0BhwPh/R9yKf1j9lAOC6+H1Elw2jTukxSqpgmlCBk",
+	"iVwQs3IoeKAtEq64WZFLGmesDXDs5d2FTKswZb9lNNZAjtLMin+EUhjKRf575Z8JNeGKaS/IcSnDkLFL",
+	"w4Xkffrh9IZrc8J0KoVmHm5ftLsJ0/by6i5+0NhIdea8V/9OTthvGdPmj3FlVe6RiC0o8v7aSa1cKzcV",
+	"PG6Nv7aYZz8izsp5m0ySiZxJLrgCLvmLiNd4+JBwNRxFONyEKkYyzaL24QuVFDN2neL47JomacyCo+CA",
+	"fEv+Av/z3VMRm8tMhGymWei/ixaMRTP4t7qk8UCzTPlZGIqjyETq5JKzA3vJAItVHP+rWCIv7R+IY9+5",
+	"bxJOk28DOGBINl9JeRHYJQZ2MX7e3SUvp9QYphqkPrgexcJMaX7J/CKRlpkKmaPQOjV8R8MLLpYEFkyk",
+	"IrBiUpKaYjQC6mici8qNYbc8M/KCebj8KdynVJP/2Cspc+8M2sJk/w17/W14nrE3z4v3H04NNdr3+lFM",
+	"+8kpljSaHdYFO5nN44qwJbJkXm38bJPWYxtnmi7ZLGUqZMJ4QMlBQoFTmip2yWWmicZz95wcECnc+VXa",
+	"EClYMBmesQFUC6HmMgrwFJspYeDFAI1fHv+98yFNtb6SykOHb6m+AAoUBEiBPPzLX/7yl+vr6+tHE5LG",
+	"FLB1DRsjeGqn5FWSmrUT4sgFY6km7Jprw8Vy6uM9jls0RBAaMxFRRUIZxyyXSEtutjIm1Uf7+xG9nLpf",
+	"p6FM9kPXT+8nbB9Epn3vlJqpjkvjix9wOGgX6EIaR/Ry6Bqpgf/LJFhKuYwH9RM/YKvGCipsrg42C/uI",
+	"a7g8NdJdDhEUsnUwKRhjMMkXXqzFy195wn4Hsm1N9vr43TGBT2QhFYF2miz5JRMoG8oMZHsiFwvNzHPi",
+	"LnHC7ao0U5dMPdA1pB4nTPGQ7p9SOXtPs1gGk3HoSemcx9ysPaLN5XIWU8NEuJ7VGHZ5tsNa93I1qDqY",
+	"Lplgyt5KnlszkYZ50PA9i2Myp+EFMZIkmQ5jRujCMEUUS/EhRfRa0FQzsqA8zuzpbl8OtVF9F6rtPMMF",
+	"+vaWciF8CzzJBOCLyEumFI8iBjxKyWy5Ih9SeNFWYOpbmLL9q/es3WYwCdzOvMSk8xugj+RPZGaYvSsG",
+	"8N0vmxeI5ax+Z/ef0mLjnst81PrLIUZtouNWdPD0Xos5iL0fjTQ07nhAtJexoibXS76XMQ89Z8ixkw6d",
+	"Drs2TOjWE2BQLkrodanZq1PnAfkbEZLEPOEmmLTVfgkXPAGqO/CpABMuelSGfX27ANT5cPLobes7+SW1",
+	"+oYpeb0gMuHGsGhCKBHsilQ7A1t0KhaSP+xCGsdr742ZMK2dartkVycZPB3StVlJQewaiAGlS6q4MJqs",
+	"WOxjqJMgkRGL62PFMU3ok2BQseHfLHGNyOuXwP8y7S6IFWxyRc2UnDJjrwFUJ+bt4TCU6qAFjw1T02BI",
+	"4MyB8akTeZ38YQh7Z3B9VrGEW3ATkjmLJaipjBeqqjKthw2ydDxLOmHHoTk1LPUdI7holyuPTPpiRbmw",
+	"mhtsAUK9YlRLAXKZUTT03mkA+xmQnucudWq0FphHi1LeAyaFsALeGUNu7tS8DW5YkryXgDs/xF2KATTg",
+	"dGCHmqz2MMV3o1VQf+qWYMeIKlaXxZS7gDt4P72kPAae62e5YT5Kx6up/D5LKSgJBpupTABZDLbTRqZp",
+	"53hp1rGgbt0+ClkdvRKWSLXeyPwDR9UvLDW1ZwWEa+D0AsUHUC9Uiu04WDS28MlPEAV78XCoG9htulTO",
+	"mzBu2CLhjt9VOeCwjaNrZNuoPeIAGzXcxP7DnaXRhrDxHciXXF90HMKFYpvZHmM6b16k8ALVaQer7VQ0",
+	"oQS30dSAsM07VPQZm2ok7F7dHuornlQhV1taY1rfeXiVUB53vbN5QtOhy/L12+P35StZJ2awx+nbs/fd",
+	"hnxY0pxFERfLhtW/zbVTPrtg650rUGIZ0jhXutZVJEf7+/h1JbU5Ojx8+uTp/uVhlxBYvfGwl9PAGv9b",
+	"ziM3CpnwcI8BwPacxd20BSMY0b9c0OjQlE9lygTlqNDxLdeLpfyKqeOk6+bxDnFJ42OtmbJoaw7VdC3o",
+	"NlMptmTXwaQ0b8Fbx8p8X2G5wq99NitY/wvqtVLluxovdNaB4TPQXKcsBAZs9UptVdQlU2sryieZNmSO",
+	"qqgYHjiGxIyiMhQZ4/g3Y5+hx29QbcDQmb1d8z4gvuSLRRuQc6rZTIdSsZGK45B2PAHCFRXLGj0ptlRM",
+	"aydHgISKf2bCNoW/aRThf61dJPKrWDZYHbadRSw29CaXAG6u2MqkCp1JUPuvm6MP4F2SfzcERzhNRZmy",
+	"35OxF2O3qFpo6jZSc8jMpJnpsCdp3aVVKbBYP1TvsQsJVyy80GSf4L3r/jnGrmDfd3qWPwrG7sOPeLeB",
+	"Etdus7VZ6kjoooCe46ayTvTe6DhuxgMLXuDBrTulSW7QbFOTO9M55/U06N7cDg9yFc7Fmkac5/oGGwDJ",
+	"od2F8pNMjL+vwV4ruF7dzmOoRzHQPJMVXA049VgPtc2pyzE+n7bZzwTeMioIwJZgA3io/eNgcvhpHAPQ",
+	"hqpN35RtjUj1bQwCnLE2dcrjrmsp44Z1ga5PZd3yYytGKtZVEmfBkeyItd320OGAHaHbQ09lG4pUQPRD",
+	"3BUH7XPpcQN1qqWRvDe8p0ri3rBjFbEJF2+YWMKz9tDnkEC5R1F5LPQVUygMgoeZWhOVCbKimuRH3mOC",
+	"aoCsWEQXuE6hgV+42PzE3tDFdtBhY8jcZ4E8OEynlDxCZbKZs4cTp/v5PIL+xicMMbsZiiyuh06ZG7jv",
+	"nH3PWHTjhS8Yi8avG6b6CN6Ng+u24w4t247V467oVdedgOMZ2GXAPoXrRj+ziOsUxmNgmiFcaMNo1Ocz",
+	"fgtX9LCrGbp9o+jLovHTDfiad78wm4r0irA+7O17w/AH5+zV5yPe5Wu3kbtwp384jN7ARQGK5vIGncQL",
+	"uuy8uJpIbzx+ZByDSixvBc5VfyPfHhxMSpXCk4MD77OuG6+3hLuGa4zQRmVo1cLbDJ2lGwcrTZmIWNTj",
+	"EjV0ndZR3LBdsiSNqWEkb/WcfP6sDTVsal05YRFfvpCVBK/S2tIGTa6wPB+Cvb5KPm8p/O5d9kvnJpQq",
+	"nlC19jKZmDPRiRH3VbNQMbNzVaxiC8X0qsvlcquz+1SaP4LLa88tsJmrektnu395uA8upnr/yeLbcDqd",
+	"jiKdSXUBnzpWfeN7F9cz+t5FAA1duXbIvisXhunzEtmSk/zWuVqJ6heviX3dHZHPn1OqaKKnKV2Dv+mX",
+	"L8HkBhdQC4gV002bZONYXrFopoFnKo+6+TiKFNOaafBzOA/+eyQTysV58JyARYkslEwIFWspGGGxZuBw",
+	"w5dCKqtuHf3a6Q1vAytLhzCjWZh5FXpw7MnZi/cT9JFBswuZKx4tYScgiPvc72BLc3ndzT5fv/vul//w",
+	"2xzvyMt3iCJlHM/6r3+47L/x3+6pVMbf4dtvn0yAIg6fPiFXEF1RoGLiVdel8brzhcpouCIMyam4z9GB",
+	"6YEmtqcPVxv6Gr9O6JKB5z8T0X0zNOZGwla02GL94TV5iMT7CKBNBfklZeL49R7wNmr4PGbk+P1r8tBa",
+	"/x5V3JA3sTT6v3i4wS9mxRSxX63PwdyC1Doe6xtZnepTfBD8t4w9J7/KuSaaxSyE+MZ8mvmaQDdLKOeB",
+	"+/k8IAkzFOLngq/yI6pSyY+Mxma1iSp1hT3WfiZ2A2+qboVD14Y8SgScN39x2LnKlX4aBYN+mcHhYPwl",
+	"3J7ga+L1iun75AicsssPF+/ALp//vzndGXogESENiZjgG95uERPrDdWGXPSE1n4e0HHZ/VaCfP/v//xf",
+	"NjxgRR8/+4ZEfMm0IdxYEzK8pKgOPHBLsziepQXcKiEOAsACQFjsCWn2UsW0i/+Pr+jab5B3aJvZ6X3H",
+	"xHcmf5Lz2/Hg6j64vZaHAV/GEVzlJznvEWKThIqoJhT+I7DuxsEk2EPFgOLCPHzwI3gak49SxdGDRwDg",
+	"TcSry42oqbWFIudHsUi3xqMn08ODPR3zpMNbF+PwRvji5g1bOhSceVIA6lMXgLv4E4++HoM/MyVY/FrM",
+	"5fVp0W/zXCOoJKu43PZB5K1rBuxegNhRaqvqZ92uLXef1kQXQXOZZorAnORKSeMTzVrxcPU9NKb2gf6N",
+	"XL4SRvnYqjFq8/QABST8AGSXTd+oj8cn77xClV52HGo8iV0oysNvRjo+VqHnGtk12gV0QOzGj/BYLsff",
+	"swVqht7hOGrf9fkWZVxBRci6yL9Pj2vd4/2nEIj1hvB2U3oXjP7BQ17gm2XAuf/Oo3Vv0eY2N3UZfVvy",
+	"qRanM10HdFsZlzqGKqT+ATbTCCNUjO3BvIQKIQ0uVk8Imy6nNgkAqAwuLFsV0vAFD4uEEC0wKRnX3M2A",
+	"6QL0tebaUBSJ9FobluR5TSaBHbojkG8bESx3H43yFh4eN2Z7HsN779UJzU9TFvrAckVVkqUejZFioHSD",
+	"J7sVDSDGB1UTFyw1e9DNPnyfE4YvA1R7wM97WQpqL7lYBJMNFviRqsRx0yEG7fafs+hiE5+6II2b97t9",
+	"dRm2nL8JnNtQagOsYZYYedGG1IfTlyRliiQ8jsEQU/YkqJ/XzwmdayZMRfVW7GCMM5lEhYpV9mufYgS/",
+	"kysuInlVCXuGh4y1ueB0qCQo1oLoysSFkFfCy31Z7o/uv8QWNOFxI5T5tysmHnezq0bD6bOjJ3Nvaz1D",
+	"KPknBifnG4DEgYBqYhTlgiFX67Xhlcv99ysmyOPpM/Lku47IlCQ1m5KJ7XU7JFKNMyuXLTG+0rfi3zIq",
+	"DP+9sM1Utvp09vPsra9Pk7HbiPUYTyGqVPAe45hHZUG18fNz/nsDtn6QFs7ebfxf8kboVe9ruclaNlBf",
+	"seuUK6bdvezJoFOSVSaAV+pKQgJM80dSYJ9SwctjdC43fBRBx40EApjfeR/XV/qjvCIQ+WSDdKEZOMtf",
+	"+OKd/XlRCsmkFwL2WYUUDCaQUMYwD94Ho3ferflDg3I9bgSvJsf6+1wCfVdHkI/4qY9isrRNLf0x6qDb",
+	"ntGYX7JZwkXmJN2BMPIO1XKubgMQ50ktIKoZI5cL3lxyiQ1crL1bVrkZ0G9Mj6nWfMGZ6lqpyZTQJClG",
+	"cvd/RUU2QgPPhXNir3Sz48TSIVsEk2Aey/BiQxV+6SB/s7F9cHvndNhbi6bE8Df9daoq5CeasdvIW9l2",
+	"0C0lCCliLpgFKv7VE8LcDsdaptneXF4f/fXp0yeDL92265ADVMVVd8BJCFB3Y/lbyGgDX0GYalCktUP2",
+	"KR3eW8tmt826I0DqDEKjAFjOQpUH34ZUwL2Um+cSRoUmNI5tAp/pRgr9UMbSwxnOKI9BBCP43Yo65CG+",
+	"K+dxxiaEJSBCRBNyyWXMzKPbcvYb9HANffko32QhjxiBj8RR19iDyvVsnvHYcNFt7YpnLg2Oz9ceL9Fm",
+	"FguHqjqKoIV9Qef3wnSzM4x9Zz3ugLcT8vw+zpbcE3rRwE3JCl6JFWjUtLMt2QVamYr8ltm0HRdsDW4F",
+	"um/H5Yh2iD1mB1a+TtUMQ0W/PO9NV96K9kxuilmRvbXVsZIyoOx2OD2YHmwCzhuzrBS7j2daDnujxIf3",
+	"1s3ndm7Ccc7pt+qMPgb6dounK6o8cIcnKdjnffnlUmJ9MzEdCjSy7qTCZujrlw2vGPfmXDlhMb60wH6p",
+	"mB3w8AZJh+5rpLnjazNP6PeSJQl9cnT4eL7tEPX6jh9g5wfIm3/Bd/W+c0iZkAdWonUffQ4pm/mhbCl0",
+	"/YRCfETCPQd1nind4dmECg18zAySa6Vp9dlzOGhnq3T0ST6lWrcnpXALivbTrHhSbGR2W3BB4xlFh6yu",
+	"C7+jTeXWl3NQxNHOJXarpL34Y5HdU2cCNWZYaKTPbfE7EE72uCBFGxA2VCZy7e3fQPTbzIlj+Cm84tpI",
+	"5eEfp0YqVphoJ0SnKHyKiIDtjWQiQQbjdXSzZ9xJBx4nulhLAt0LAQL1akY21WrtkauJdxtP3GujKGHX",
+	"aR6ESoybhGryj5NXL49fnL16eWSzxMNVhH+xT1WA1nHVeV/ZpA5jvZlsa9+hGb62T6rOCI2LO828eR5d",
+	"nqFkPjbvXyXHYmsSds3CzHTHLFcD4TvCcoaKOWRiI9nDGgzreTwHtWTebVupsrxXvS4KmhmDFQhQNUfT",
+	"NF6XGkSiGAaVTsn/YEraDPgaL0+r4YdvWZo/AbQnP/eKmhl1OReHk0m2EzTiu07pmVS8JTqO5g59r1JN",
+	"0GsKNEVM2aCvwtd1I1ZUZvca9LMr93bB1pjQdIb5H3uVLF2/99oii9vWRyANnvX+Nbxs0C1NwYkhqG/D",
+	"f+fZKVtDIDvzSCpvqFhm8ICSixKcDzThZayQZbU5+y2vAHs5WA0umvpck1r2XP/zxlllwkwpJkwhDSf0",
+	"2goBj599MxmjCZ2V9shRFsMszbuqUZEmLVVn8TaaRdzPStxnEBx7qWGDw9HQ0GuDcVqYigWFSJrFbAri",
+	"3mzBTLiagJ+vXUZDMVGSQx5rgU+CG1Nt7Z3TWulPck6gZZTFTNnHh8al2V7k9cvn+d8afUNjrg2LCL5j",
+	"yKFv2SNP4OiT1hB71rXz9BB44gRfYRNCo4SLSc5AH3kXx0KpolluXUMPAd0lzSh6Rd68eUvy1sR5VtnD",
+	"lufp1OShlW0ewfsIDxmM+kA3U2XW/IWicaUzmhIiamwVD82sUFto32tygVqn7Pff13tJEYCbaw29S8LF",
+	"zhQzTKCUHdF1R4JdvLFSprQlBeyogcidw+ymb9ZKGrVOTahiIU95ni5lbABPIQiL9YbezPa1aqQPtm4l",
+	"1t5e5qK1ClmnBWhNARzYW0kB6IraHRS5zrXTG6ounWUeL9Sbwx0DTu5XKI832ubZX/+NPDw9Oz45O3tz",
+	"ilEgT795Rh7yJI15yA2BX61G5Nlf/63LrUz5Yy1ym5uQ5PjD2Y/jntWnp6/K3LI+yxn31nJ4gb/nyJyQ",
+	"5ounhENZyWSExZZHo7NIgvx8ycQG8vFG3onIexhNqtaiX+U8qDvF1QtdzZWkUUi1sem+TB6iMQPH++DT",
+	"KLuQmzUP0Q3yZde2XED1kx+jHU+W+XpWbmrAJNeqFljz6s0JZrxGtk5nHj60YlSZOaMYlSxdBMoI5xEe",
+	"xWwGOJTZTbpGM5sEZxzZOTmx680Hn0EbVJ6adhuZMtH9ZcP1gKOF3qiHYr/mCQnHdmqQKW5gUiGmKlha",
+	"MPAhtgNpDQi01lpHWH3zdaL0Hgsn+UVnVF/0Rm40gkW5AuHQfX9O5mu4UlzZEJCY8GrUzNzQ+jeYqKZR",
+	"YrJ4yTjXVE/73spWh3vP/EprcPxQ/msb92rjZ/Jnbn32VvXKwV2NzFyy+4KW44baTUKMvNoIABzVyIV2",
+	"ob9IZgf0K8AtXSGKunGocr5kFWV/8UMlnfeoNGb2h+rYUrCZXklTGb3yE1b/Ui5TmpLCM+iXoSN9Y9ui",
+	"oXqDVAi1OcdpKk8ZVeHqptHK3yl6yfARdkYvebx+7mQtSGhXPMt8FNEs8aYZVdcI4jkMCV1wQMw9GV7A",
+	"/5cy+LTJucnfiLO67aT9jMoEPpJZNPBk6qhzBRC8fvcDKoHAUP2cyDxlOiif5lQXRXpy/wNG0IdxOE2L",
+	"e3Z0VmSz2GvbGhv+hGDmFzKPbNYTYvH2kC/IdyfHf381O311fPLix9nx+9ezn1/9J7iYa2YeIWIN3CAv",
+	"s/AC/v+D7KlauHF0ro/2OsrMVDIz+tMluAsAj5J7o9j73unIDm7wHE6p8Mea8XnugHhz37FwxeNIMVGD",
+	"1vCDePNsuCKabRJpdoPg1cIO2HnMe6kgpeJnaLdBkE0LmsOR7aWwAfqX6RWbzzTSXzDp82j0vNlVT+4i",
+	"tB1sCO/yuhsCUyWGA5VEo/OyFDD21AGP40q8kM7ms/xD5fGoDUu93BcGvvHlhqbJ8dwipSOdZiqg8uY7",
+	"rdSCmQQh8Oy4S1g4RY+u4snaSFEhmCsSCQYBUAVZfaSrzFZEJEO1JGccQHNUKLM4QiXunFn9DjBVV1sK",
+	"h2DXqOWxFymu1GeFSrMhmBUVO4F1zGcb1+KP+CbiR1mJw8e5ZHgxXKjXX10nZ0pfk6fg42pNKNEWEXDD",
+	"JVxrLpZoANBFfco2Z0G77HAIdRkFCpSNVLGZdxY4ScOj8SuSelemzXHXxHtlHp9AceZqlzeVv7yVZXUz",
+	"S4w3BsTZqGfNsgwij3W5ojoJCtLpk/4aUZdZbDXsE3KOTbCAyz56DwQogwkoW5M7bhRlNYsrYiHVku2H",
+	"+nJm5OxX7a+aUwxcv16wr1/bq2jCDBsOVW+Yhk5/eUdOkdBywyOaECrjjQqMBNze/CGS25pHcQKYahyv",
+	"hpaYCNlfOuA2s//Li8rPNRuQm7u7dr935cqhfvPkDLuQ4gacOUt6BWXwka0tCJkm4+gGKoWBTAdKSjOD",
+	"276rAX7bsqSwK+Gsk1Zufvagt884miUJVRzcDJyXF4gRUG1eMZMpEB/ma/IDM2fO/jnu7GLjUYfXFpp9",
+	"LRZyoABefd0xNZgTSEPCUgYPYsiGb90bvGo7lyJ4o+s0H8979eQ8oi0iAHfFgDuc0yVM9McSGtaRKzDN",
+	"5vHmhQ5GZdoqoVQrf1fCx3ep/936x3fgSYX+EmZwPUaziPrUJiffvyBPnjz51gt4mSS8PGbjO0ZcmXWH",
+	"f6UNVciEHdxgARUqlh0m9CVHZw3vtpZy1l1icBJIv3BjD0e1Z0MNQQ2d23oO5T0NOesAiH5TaZqDtu8w",
+	"Vk5YPdCh5bXOYHZDl5grMWKXNXnm8mD67fRwUN2Uj1+DEsJkErincgMQPnL7KJWT9LcWvDesJxijgtEu",
+	"dUEfAuxm8gwP7SC9D+9+fvfLR0gLhIbz1+9+CCbBj6+O35z9+J/BJPjwrvz71X+8Pnv10h+7dyuRFHat",
+	"L5mh3KOnPMY80lCxnWgjFYuI9cEhCVNLFtkTxo0mYGsBbWZqH0XPCeMYca1BgZnQNbxa3ePJ8yjNH3Dj",
+	"APu6mKZXv4SNZj1su5i28rLzyuwy6ixhclXQ7fC6O+Im3ejdp6KyX2+yG9xD1wL74uk5JEmIOuqJ36zw",
+	"TtJdrTcTjSo5rTg0w0W3cz4m+pIi7shfadUmw5ehazepzVcdfdh5exJImcwueBx3Z5QamVfOIriSXQ77",
+	"osjZX35GdCYCuWmhn4aEX2q+PIwHxvG6Joy0uNeodlLk0LPLmFTmbkzVhE5BVt2H58YCtD3Z4x8Q+Rkf",
+	"IwO7pUGmNeFZVdtRR5tIYoU1bSKm/GoNrLx5yznjCocIHPxT31b0jaAcc8E2hXEON8/BzG+xLnSOKotR",
+	"NnUD5svsCwdvnmRfDEc1eVpDK7wgUjASSggfhEhwdh0yFpFDrGsxwvPnBkWzXRe0Y27UUTAzU9cbdzGb",
+	"dUn5DXlLBc4NuLhBGztorK4buf6sVRU/m7tIeOqXVmxiaqm+bpL8Gps5q0FxOxxMn42iymKAhHn8Z7/L",
+	"60YPE4Ohy68ynXYx4YVLrdxCqXUK2Ux5sIXo6o3Nqn2lcvsceL5KatjI6tp+DqVMuORB5cXf56AzZH/b",
+	"LElhTgb+PIV9hOMPgY0Y7EfP5IZW+r6CWCpLPXpuujBdka5ztnA1JNvfKgl+R7wPh/3CutJWqA71fPUd",
+	"3pqu04j+5cakFFmn/oJ49AVP0w7SaVssNk7f9AWRtvAEH4BnT+7LAxFFv5wSG3k4IRgpgbkds3nCMQzR",
+	"VhgAw64VwuAniMZF33xuUEivjeIC2wt9T3AwPZwe5H64NOWQ3W16MH3i6vfj9qCUD9ru99G/G5DlohMA",
+	"Zfg6eh3B2qENBCUGheL+OxmtG/lWIWbSJSPd/9Xl1LXnbEy8Y56E/Ev9Sgc+gj/krmFHn4PHBwe3PLUd",
+	"3M7dQBtsnZQtJsHTg29vbXZbwN4z7dmKkapdiNAYHqlrLJFJMb9XnsdiQhxVYc0xqknJH79MgmcHB9tf",
+	"7WthmAL3NZfqkrmGk0CjwWEdHAUA6LKECoIVW9RpcL98/aQdgTLcAATOa2az84BgCEE5QQ14r19OrFfa",
+	"eYBpj86Dc4HtNXkIZ4yKtfWvq3ezTR6RqxV3JRJxoagV05NzAV0x70C8JufIac6DcgVVyrLhTQiW82B6",
+	"LgC9cxmtCYUAeUMvGBz20g3QCT/kPNCMRc4CXeT30w/OBRruuVg+L2cOqVLceXJDt4Io4DKdEC1hCpsh",
+	"AV8Zc3YuFEuVjLLQGn60ZaCEG/T8K/eiaVJkCLDhqrCO6bkIJg1WcYr4u/cMA160+4jfvZLiyrlLTQi2",
+	"OarRxbmAC+yIfD5vmm7PgyMgzMPz4Mu5cCR2ZDNtVTrZf2PTH1mMbT1P8dYhe4UE7laLfOjAEwVmFZk5",
+	"rnrPIBLzpHLBwI9Y04g8PD199ag8ntW49SXzXBCgZzkuWtUdF/7R8rXgsWEK6C0fmODm4eYMjoLfMqbW",
+	"eUq5o8B9quDG3fa53ghQaV0+ssQZurKIS7gMecTgv3i0fU7Yn77yYhlX1s1t0iM+tHAMcARLUAnxOgLx",
+	"O6SFqzeoIWn/M4++WMqImWFtZL3E34tltbCFWAAZoUSCLRFfO2NVlAzD9anPD9dK9kjKnu/vJCTgzUTU",
+	"gIHth7ysAlkvWf7AzG63eXt3bUk1HpkkPzYRGm50BYTbveeLiUUHbn5ghtDW6nIKLeobDjCSSjs/1hos",
+	"olbE9o7QVay5pnL2wbCyO5TPnuwAb+WciLq8oKGPuRRNicpia5TwPwpeoMajHHpLV305wUYX/uEWFuAD",
+	"rYVChMCq3MrblrcvaczLSe8REVl4IHeuU5KPDYy9qqo0dl8uq+0Cu3L53Sv05pcvohRfAvAcW8BrQyqy",
+	"gHfSVV7RvvNK3jE6D3bECk4KFvAnJhCUAMYf/n0Xpuq/Yk4y8c9MLAR1uPkz/U9POt9zVXAWIa8mtlaz",
+	"1UdoUimo3UlMRi6XMeumpzP8/s9KUtbzryqK/ImJ6RX+ip6NNqmOo6yCeEKa0jmPeW6Y6HyOvKg23KYm",
+	"Op9nPfSEKFuShKZWoaNtIJFfW+AylFe3TB4mmQ5jRv4rcQm2H3lBs/8ZjgEKaWnmgZAlunJF7SNV6tJQ",
+	"YzO1pWpMoe+pn7YileZG5+1mz47eVOi9ycmdCsqCMJgUOcoHk+Lkg3zyWo52aPYoEdbDScJKqx0/bcqp",
+	"bZK9krs0+U+1KeaXa1oefslD6d1Fi6GWoGSr7S+n/YpGd4Av1FruQpNYnXETbWJ9Tx08otGo/9X/op40",
+	"6nYOoLNrVjnGO3ZFnBlhuLLfTrUCdVx4eHTlO3HOIR1PZYhpqYHfT4wjH8sN1PwxdbsNcHS/JXe/24O7",
+	"IaFdq3prk/epe8OOVaaoBuiSF3aBtlvlSbfCfnZHO8UN3mQrJfJskzr+bDkg3PSjHi60X2ZB9B5MawSu",
+	"rvGV7XAnJ3TA1ruh4bUGQ7tRn9cA4SKMs8h59exZxwHMQMVN077qAXCeDrpXAnmbN9oCWCd+U0ueALvs",
+	"WCTFeuZLkrMTC2ulGv1YkaiAr0cayr9BJg3qv5jZJY11U3fVTvUBzhdcE+deiQVH84Jf/+d/u1qNoUzm",
+	"LhJl2vKmOMnEK5hqS7YVGBtD6e/E9crNPvTa/d6FItkCug+vKDfo5gT5pgDljw8e73pJpw6hsKKd3cin",
+	"GTesehXvSN+CBNijaTnJ4JhoXB1dUi60yalcl75Cun12+pmbQ8VIEzBOP2QAvhlPKzKCPTvYBoe7BXI8",
+	"yXZoSx4iB2ShgGM8HRMM2NeGLLjSZlJU1EiZ2gupztNw+qijeO90yf4OOn80sT9fth+R/8SK2yHKOQ1d",
+	"jHEqFV7SUrCCkroIZD/ii0Unlbzki8UAI/laKakR1UI1i7m9+us54UH5lCp2yWWm4WuHu9ucahbcJWkC",
+	"yPxKZ8325mt7aqEvVVzL3dErXDNSkXkO4Pt3Eb5AoKDvQ061VjdPy1XDb3JR+tTivdWgbfxt+HI8tc22",
+	"TA44y6A8ZJdy/64gXSysT5tZ7HOLYvapQ/ROlZSNif2eS44Ed6zfL2a9H0e38FoqycZ7KkcqYasU9S+H",
+	"pbvBaeknXOK08FjKX259suUOEXiwmyN/Wh71PyVJOOek6hmf9Jm1t08Fd37Z7Ijycn30HV82f1K6P2Fp",
+	"TMPu+62hzK8P/l5JWzmhVIxiTcKiEMqEhEoKrBt7JZDD2lg6CCFbKgBEofiedsSLfZeXGirsBHeu07/A",
+	"sNq9Kx7lYX95xGBNd79gLOqX1b/HFn+UQAZY7aBGFne0K+LG2Yak/StXKe/k9HT/2MiELPI1+rXz4FiA",
+	"tgVXmIS5AsUTq6xHvX+6zp9qeV5zDLWdS7Nq0/FHWAAsdUtvCBga57ijKIhifh+KPjroA9B3ztxz5/NM",
+	"KKjgiL5+H07e3Bv6ROAQatdpPX6xjqgLtJUiZHVuMvjG+CCuKtT2r/fF3eD11MjU8h3AJHXE30LkSKfk",
+	"3SHzYDc8IZf4FuxPTSXvaWbD/xXTWcKadLKS8qJb9PoRvmKOZssKbKkHS1BRUU7L5wrfvqDgmsTx/jCi",
+	"CKx2SBSxO7qHcZRXbA64tUGURcw9V3A19cglr65paOI1mj7kwgkliPVcBpnxCHwKcg4wJUUuCbnAEsbn",
+	"AmoY5yYHWMQDDbNCryIFNKGafP6MdKCnKV3HkkZfvvgSOljVGMB5S5INDH1HQg3uqkc1usLvO5Zn8knv",
+	"YVAncSSNeWcIzclTKkIL+ixYG3r771dLfHU+kV5DU1fV60dGY7zvtsZX2rMNcRnXmKzs2naFnHeSIBCL",
+	"KubNu0XJOVhImcJ0xgu+zMCAavs4wBfogIRSvUj4CRrswl3rJznfxFULF36fMhkVLvP5yvxy3Skm8/oJ",
+	"iyxvg2/+JOd3xDZx5u7T8pOc5x7vRGdhyLReZHG8+xiSX+Wc6JSFfOEmuFdkZOnDuf8DzB4ef/zwqHZe",
+	"B11gLHX1ZtuBkV+/9Adb3aO3BDIFPy293LHrO8zZ5/GeaaaQtpoJTgqU7UMgvN7/DP8pwuf8ntJAHz/J",
+	"+fc8Ztt0460Pkq9ri9iXoWF+3WyRXhTcXvHJMaitBREX1kzyybriSgCMTc+QA19h4hHHEz7Bcw2GjLBq",
+	"dry2mm+QqwH/mAcOy3m1KaDceY+H/H07vptmRbMpOCupzdwPmNvs5MO7d6/f/VDPhRbLZaV5zAWzjcF3",
+	"hkM+u+l0+hUZ0Twk0XeanaofcAnJNV2+TdgCsfU1mtp+58ra65Rvm2yRVeIMQyJrkUmsePHZtU/K/G9X",
+	"VCV7WZrvWC7IBUvNHvwM709/pGBjOPIQT0RIDY3lsgmo/YjrULo67H4p6aVrUQHbbcTtxNywOE5mlTrU",
+	"7XpMro0ro1RS98qY9Gh/P5YhjVdSm6OnB5iJvTWCjGOa0BEDHB4+ffJ0XBDjl51ETAC08xI1Q4J4jiJW",
+	"c+WuOFq47zlJIEX8gqCBE7UvFXkDTmRv3hbkIWQ04OH2Dlts8RTBBEOHyC5id889KPfNIgeKIQ2TYkuu",
+	"jUWLDeUX+XI7sqm4DjDP7R00OncccXR29uKcfA6WaRYcBXBxeVPQd+Yz9544fbS/v0yzvbm8Pvrr06dP",
+	"bHH2N0wszSo4OhwKzYcxP91xVDGixuf8WuJaYJMdv6TySe/ZOcjBArk9WCINq56EOrMZ6bjnzsa/bGp3",
+	"jdoPQpXIraEzjy3qvT7e5412cZu6yTZRahWb6FAtld/7XZfzqW+LnWMqehbNNk6FPwlCGUtV58rhmooR",
+	"hS/KDlAUkVBB47Xm2upuaMy18Q3Cw2bvOVV74Yoqb3MUTmbS5QLxxGu6Ly4s0ybc5roI2LSh0b9dMfF4",
+	"+mwvlBFTR0/mj4LJmIq5x7gl77psbp5ZWUqh7PWfMkPjHyVRCRcznU4HKze5x3197Lu+3Ipj0j4W7tNQ",
+	"ooww00YmOUpaHGEkjy/PzB80OUZa8psuxeBO93iwSxLZdSKMfN4+jWDaXltv+ottY+e+3AQbVzziYceH",
+	"NvceX/yoxWHvWS6PHqLPnWgqJ/5eEL1dV5sRx9mSD8R1v3dttglQnGKshgxcH1hEPlKdkHwDHqnMNTu1",
+	"md5qLXHr1l9mYO95o51IpXayjaTSfH1dUmnxfUAqdVNvKTVfKSG9peqCYb2kkCYp5UtBqNbM6HFi2Yu8",
+	"078/HSdS3bkIpWQ5bctNHz6NyTWWFqPUaXes/FRg948qP5Uno1N+2uUeD3ZJHzuXn9y8vfJTe2398tOW",
+	"sbOLdKId4sqdCyPdFFQII+XxuRcUlAsjrtlDgOyEVMZ45Od0I+sauel7yhvdPU/YfiEiSIblwmSKu6Mp",
+	"IVTb0v5bZoM8qw7+zXSrf0wc3EoK1xG4aLVv40MzrDSp+/yLTvM220xqnqYv0HnR64mbKYWZ88rhSbHw",
+	"9kUSuuZ5E/JQs1Axo0lC9QWLHg0E/Nb2u4VaNvWt7o6r98K4iM3tA9pOzE1/B2OTRbLPJccx+nKdTIRq",
+	"nRpN8gVTQxTT5lGLyPdDGjMRUdVH7S9cm11QfT5XD+m7Fs61tynQ17/2EXqjQiB+B59tlqRmXYDugrEU",
+	"uYo2mIZJCqbbUSR5uncPoLZQ5dEDo12mSB/CUJkmvYGpXedK9xFIHvMNKC0WWGOdtfPBEsrj3mxr0GAX",
+	"JwMn6gY6fvZv+fTt2Xv04Xr99vh9cTBSqvWVVNHmR6PsucnhaANqC0kemjDaYZqHfvTkZ4LV0HTfDoRd",
+	"Xd9pmLMoGpKOXuWtdnMs3GTvXTBI3xFxTbsCR9oNitMCZcov2HqkvOSHwDbovWfzu6T90Tgoz0EbF/fv",
+	"NHSQQ/tgYGzRXjWoy8tJz/B1wmGuPBCJaEPXmmQChq+OOSXHJKlz3nPh/DaR8eoq571ga1+UogV3NbLr",
+	"a0hx1FOuOtmLgs+1H3S3S563ujQ/yebYLZjB7h8COekWhNZDvNdG0XrMm4ehV4r3dHHzE9tkF7zcTdXN",
+	"PVyDrqfuj9LsKQYWKXTFtqlqKo075JtfEm6MPWa/MyXJgrM40mQBph0AXR4zjClQsrRIX9ol6fggdvu8",
+	"3wOs3XH8QUyVRdeaGNvxUekglepRaa7xOfy6Rt3KusjMTPEFTVVbUbSvGVXhqu8MnWKLXRwhO9PwPfyR",
+	"zYldt/8ebH33cz3veWrdW6Tv2sqTCeX3YRGHgYlgBU1Y1znzQPX2j1k3QHd32sYiNT90V23k3jfZqrLE",
+	"/HaaEKM4xGkLIlUunNcOmmHWtt6RMIZh8THBwlstv1XIp5UCd3GcBBNbt2+4tl0xwKe7L47jgAOgOsEM",
+	"8x01jlw7AhB3uegbyIQhanKx61JiLaU9Fgx3dlPaabRoJF654KKecqX3wKRU/MytRazq2GCkjKcdGcbh",
+	"P7NUsQW/vkm1BBstttEKT22XziGNouFNizdUDR03HCLhYhZlFmezpL61IliVC/NNxUmkrP/QBSYuQuYf",
+	"CnjXHkqjk7FLhIs7vrXRegpeHB5UK14cHuy85AXQy5Dn1ts86ZY9eiXP70gQx+N2UUh7Lu0IhIZKao0u",
+	"TkiLlQcE+g7uR5wuhdSGh7q74s8x+Z2ncMsrFjJhcrEcIksneBfEFNnMgnJM34Qz2S855z8Xtpir08kD",
+	"ItOURRMCNA58iouFtEmgVsylI8nlipXUZnIuXsrwgilsY2PwpuTUciwQSKghIRVCGjJnJJRxzEJMK6UY",
+	"ibk2eCudi4QKvmDaTAF3hAttGI26kwO9LKHzXSai+Paiy1YyU7pGoI+fVunzm79ijJf7V5tUse3M4bQ2",
+	"TIPM+4f5+jjN33n61VHwFTCTeQ7nFaMRszB6Yafee8l1KjVve8tRY2i4Spgwz0meAuBv5wHNYrZXofC9",
+	"xwePvzk4PDjcO3x8cHBwMP2dp+dBL2f9siu5CwkC5O0SrwReLXDqqFiyxin/LuNxBPETLdDBW9fCA/6a",
+	"Z0tXd6R19LmYy+u+N8/PeMxfY7MtMsbKNMV96hFL5/LaBXR7AqooCKyZsAnFYlrWOst5iGNZds8NQAAf",
+	"64TDv8MN80Yu9VA+g7f2mJGYXbK4Q0LJv5WAyeXRiM2zJfZayGASXFElgklgbcWfJsPlWU6+f0GePHny",
+	"LYELUxuapDZ5VX79WzUIBjBjBXqM+Tl8lgSTkZf8aKnF4fcmnR1rvf1qV49rl//j3V/+b+Ry0GtbwkkN",
+	"pYruQMWC6N5VrCTsdJ4tFkyh51uZ4KtxsPHotWWOHEr1ClzNQ53STLNukebEpXqDTCdkYQvx6Sn5uIKk",
+	"KNg3mhBHj068KPInr6iZQGqNcyFVkR0O0y2DtKHYAnpbLcizgyc+6QJzZ57iQm9NolCMuhaN0ggreSXg",
+	"KghjDvgpF1jZ624yOPQmbqBwEgUVIeu+BSqNirtgR0RbnTqBYN9e0kUEWwEY8kdnMVN5MvkiuzzeVVgh",
+	"e0Xbt7PNqtqtKznB7xUa+hdeRuDlDaOQ7r/R5zn5LWMZw7xqNldOlDFiqL7QLrttEzta91pcTk9fAbC2",
+	"qyfO5xjIH0S0a1WFwy8pc/V8XSudv724IniRtgjSDtO3aWyX77tXVjpFwR0kkfwNZl+XJlPIleHgJFJw",
+	"I9UDnb8uNXbqEFgUWyimVz65YS5lzKjY9vO+sntvngstMxWyfBe7Oh7FvA6c/cfjR6kNefH+w4QkLJFq",
+	"bc8C1xckA2F6guHaUPKNaP67rTnkXuUlkTVIJuulmTZ3+RcrG8nKTmw1yasVw5okeNcg2CEdsrvVG/hw",
+	"upY+hPzdNdkiGtwUr+Gl40tJiy9bbr9WN2zfiWSO36mIJrB34fKZkXDFQpdSHG5Zq5XSxv5eAAJ5eq9T",
+	"/qm7r6MzbLpNjlGdabAQYd7YXks+z3jdbNIfylibflumt9ocOw4p9EzuT4Zt8PuArhUSkXeFHtYhXye2",
+	"kaGHTWz8QQMQByExsmjEHYDjYHeEl1t5S8LbANYWQC1YkzkzV4wJ4kpawd3cuAWKhAedzO8MW2wRNDDB",
+	"YNHvMiWYXXF/ijhsU61DUNAKcbPWAbD/GSjmCzhOdasHwM0PmpGErsGgQAkkerY/gV5NEBpzqm2NAh1T",
+	"jcaKaiuuz0XKVAh6ayZCGbHoiJSLWEi1ZP/l8fehvpwZOQMowop8yoKTTADYRp2Cm+W+vRUjO6ytJ12e",
+	"XUgrEZ7hCZOZmWkWSud36c9eZJ1OAHgPNEGNhu1Zai5Elsy7bBq7NNIDsk4y0W2dhwaFSX4SPH38eIdz",
+	"OyDmtjqpSCSZK5FwzbWpSLutnP3GLhyOnlrbhk/vYOmA+QiMI03n72sWZngNQbPy1BdGsm6+Z5uMcmPo",
+	"UTM/q2qZn3mVzLvzPbgF/wGXBeWGvb9Om//P67uwTdEDCXnogsVGxJ4bzm7oZuCuYdTN5wZ/LsI4s+72",
+	"TGlrdV9xbaRaN07jYP5/XOMfTfCzi+6C+MaSHhb1tcB15fbjGGs8O+8QB1GwQDhDeSeD++jabHHzdooh",
+	"2ssX4issVflU2dfgA+pnHsd22Dt7N8ESdpnelHHU+VBnCiMCbb0wKeWCKXuTN4H8vVQh27vgceyKGjFQ",
+	"rpad4MWQUHVRFAa1A7n3YNdB3SXkb5tYbf2NbjJ1aVvuOV5PrSO2w5jrmTC1zI2QMbwHy0G40GnD07N6",
+	"1JruEC3bLSbUt4SRn1kMgSKKubANa1m0r7Hm4tuu4AUR+b0sbqVUSGMTXMB7QjqLw3MSUnBGgyD7ZzYh",
+	"vteZk/LYL/c9Hnaz8ksQTl7wOYQgSU2wcIXPAWT7hwOwUeHkdin7aUy52LAkNdj7YwD5zg6SO75o7oha",
+	"dVMOt7+As4KFPtBFdIrzT7REl8tOeNoatyHorRFgBaMmV0oaNiliWRYSklg68i6OMPghDMsBttUuovXy",
+	"2TbJB1PuoyNVX6VBv4K7mP2OE19NqiVHfZ+1YaneGKinhqUewPrTJeMMd53jryQH/4mFb2WWv4FHCRdp",
+	"SwFQywVYOOZEDPx7WvcdfBt+h1SI6A8n4AzCup0xr6NhX4q7q8ZolVpDHfC2hZj0QAmpfPJXtvGdwH+g",
+	"bNSGdZx8FZoK6FmosdR6ZTTLMzUAOM45aOu0extMFSiFzVxRqg3Vx9vwUWuvLtOjsxl2HB+LrrYB39XN",
+	"tmaadp3ZJtLrBouWkeA+s6o6VDvvwdsANrpp9tgwB5naCThyVrHx5cv/GwCrJ/hwzV8BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,270 @@
+package kernel
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+const maxWebhookBody = 1 << 20
+
+// SetAutomations enables the /v1/automations API.
+func (s *Server) SetAutomations(automations *services.AutomationService) {
+	s.automations = automations
+}
+
+// ListAutomations implements StrictServerInterface
+func (s *Server) ListAutomations(ctx context.Context, request ListAutomationsRequestObject) (ListAutomationsResponseObject, error) {
+	if s.automations == nil {
+		return ListAutomations503JSONResponse{Error: toPtr("automations not enabled")}, nil
+	}
+	rules, err := s.automations.List(ctx, domain.ProjectID(valueOf(request.Params.ProjectId)))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Automation, 0, len(rules))
+	for _, a := range rules {
+		out = append(out, automationToAPI(a))
+	}
+	return ListAutomations200JSONResponse{Automations: out, Count: len(out)}, nil
+}
+
+// CreateAutomation implements StrictServerInterface. Rules are enabled
+// unless the request says otherwise.
+func (s *Server) CreateAutomation(ctx context.Context, request CreateAutomationRequestObject) (CreateAutomationResponseObject, error) {
+	if s.automations == nil {
+		return CreateAutomation503JSONResponse{Error: toPtr("automations not enabled")}, nil
+	}
+	b := request.Body
+	a := domain.Automation{
+		ProjectID:  domain.ProjectID(valueOf(b.ProjectId)),
+		Name:       valueOf(b.Name),
+		Enabled:    b.Enabled == nil || *b.Enabled,
+		Trigger:    automationTriggerFromAPI(b.Trigger),
+		Conditions: automationConditionsFromAPI(b.Conditions),
+		Action:     automationActionFromAPI(b.Action),
+		CreatedBy:  "user",
+	}
+	if err := s.automations.Create(ctx, &a); err != nil {
+		return CreateAutomation400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return CreateAutomation201JSONResponse(automationToAPI(a)), nil
+}
+
+// GetAutomation implements StrictServerInterface
+func (s *Server) GetAutomation(ctx context.Context, request GetAutomationRequestObject) (GetAutomationResponseObject, error) {
+	if s.automations == nil {
+		return GetAutomation503JSONResponse{Error: toPtr("automations not enabled")}, nil
+	}
+	a, err := s.automations.Get(ctx, domain.AutomationID(request.Id))
+	if errors.Is(err, domain.ErrAutomationNotFound) {
+		return GetAutomation404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return GetAutomation200JSONResponse(automationToAPI(*a)), nil
+}
+
+// DeleteAutomation implements StrictServerInterface. It deletes a rule and
+// its feed or file watch.
+func (s *Server) DeleteAutomation(ctx context.Context, request DeleteAutomationRequestObject) (DeleteAutomationResponseObject, error) {
+	if s.automations == nil {
+		return DeleteAutomation503JSONResponse{Error: toPtr("automations not enabled")}, nil
+	}
+	err := s.automations.Delete(ctx, domain.AutomationID(request.Id))
+	if errors.Is(err, domain.ErrAutomationNotFound) {
+		return DeleteAutomation404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return DeleteAutomation204Response{}, nil
+}
+
+// ToggleAutomation implements StrictServerInterface. It enables or
+// disables a rule.
+func (s *Server) ToggleAutomation(ctx context.Context, request ToggleAutomationRequestObject) (ToggleAutomationResponseObject, error) {
+	if s.automations == nil {
+		return ToggleAutomation503JSONResponse{Error: toPtr("automations not enabled")}, nil
+	}
+	id := domain.AutomationID(request.Id)
+	a, err := s.automations.Get(ctx, id)
+	if err == nil {
+		a, err = s.automations.SetEnabled(ctx, id, !a.Enabled)
+	}
+	if errors.Is(err, domain.ErrAutomationNotFound) {
+		return ToggleAutomation404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ToggleAutomation200JSONResponse(automationToAPI(*a)), nil
+}
+
+// RunAutomation implements StrictServerInterface. It fires a rule now,
+// ignoring its conditions.
+func (s *Server) RunAutomation(ctx context.Context, request RunAutomationRequestObject) (RunAutomationResponseObject, error) {
+	if s.automations == nil {
+		return RunAutomation503JSONResponse{Error: toPtr("automations not enabled")}, nil
+	}
+	a, err := s.automations.RunNow(ctx, domain.AutomationID(request.Id))
+	if errors.Is(err, domain.ErrAutomationNotFound) {
+		return RunAutomation404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return RunAutomation200JSONResponse(automationToAPI(*a)), nil
+}
+
+// handleAutomationWebhook fires a webhook rule. It stays outside the spec:
+// the body is an arbitrary payload handed to the rule as is.
+// POST /v1/automations/{id}/webhook  (X-Automation-Token or ?token=)
+func (s *Server) handleAutomationWebhook(w http.ResponseWriter, r *http.Request) {
+	if s.automations == nil {
+		http.Error(w, "automations not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/automations/"), "/webhook")
+	token := r.Header.Get("X-Automation-Token")
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "failed to read body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.automations.Webhook(r.Context(), domain.AutomationID(id), token, body); err != nil {
+		writeAutomationError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// isAutomationWebhookPath reports whether path is /v1/automations/{id}/webhook.
+func isAutomationWebhookPath(path string) bool {
+	id := strings.TrimSuffix(strings.TrimPrefix(path, "/v1/automations/"), "/webhook")
+	return strings.HasPrefix(path, "/v1/automations/") && strings.HasSuffix(path, "/webhook") && id != "" && !strings.Contains(id, "/")
+}
+
+func writeAutomationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrAutomationNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, services.ErrAutomationForbidden):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func automationToAPI(a domain.Automation) Automation {
+	out := Automation{
+		Id:         string(a.ID),
+		ProjectId:  string(a.ProjectID),
+		Name:       a.Name,
+		Enabled:    a.Enabled,
+		Trigger:    automationTriggerToAPI(a.Trigger),
+		Action:     automationActionToAPI(a.Action),
+		NextRun:    a.NextRun,
+		LastRun:    a.LastRun,
+		LastResult: optString(a.LastResult),
+		LastError:  optString(a.LastError),
+		RunCount:   a.RunCount,
+		CreatedAt:  a.CreatedAt,
+	}
+	if len(a.Conditions) > 0 {
+		conds := make([]AutomationCondition, 0, len(a.Conditions))
+		for _, c := range a.Conditions {
+			conds = append(conds, AutomationCondition{Field: c.Field, Op: AutomationConditionOp(c.Op), Value: c.Value})
+		}
+		out.Conditions = &conds
+	}
+	if a.CreatedBy != "" {
+		by := AutomationCreatedBy(a.CreatedBy)
+		out.CreatedBy = &by
+	}
+	return out
+}
+
+func automationTriggerToAPI(t domain.AutomationTrigger) AutomationTrigger {
+	out := AutomationTrigger{
+		Kind:            AutomationTriggerKind(t.Kind),
+		CronExpr:        optString(t.CronExpr),
+		WebhookToken:    optString(t.WebhookToken),
+		FeedUrl:         optString(t.FeedURL),
+		FeedIntervalSec: optValue(t.FeedIntervalSec),
+		Path:            optString(t.Path),
+		Patterns:        optSlice(t.Patterns),
+		Recursive:       optValue(t.Recursive),
+		DebounceSec:     optValue(t.DebounceSec),
+		SourceId:        optString(t.SourceID),
+	}
+	if len(t.FileEvents) > 0 {
+		events := make([]AutomationTriggerFileEvents, 0, len(t.FileEvents))
+		for _, op := range t.FileEvents {
+			events = append(events, AutomationTriggerFileEvents(op))
+		}
+		out.FileEvents = &events
+	}
+	return out
+}
+
+// automationTriggerFromAPI converts a requested trigger. The webhook token
+// and backing source are read-only: they are generated by the service.
+func automationTriggerFromAPI(t AutomationTrigger) domain.AutomationTrigger {
+	out := domain.AutomationTrigger{
+		Kind:            domain.AutomationTriggerKind(t.Kind),
+		CronExpr:        valueOf(t.CronExpr),
+		FeedURL:         valueOf(t.FeedUrl),
+		FeedIntervalSec: valueOf(t.FeedIntervalSec),
+		Path:            valueOf(t.Path),
+		Patterns:        valueOf(t.Patterns),
+		Recursive:       valueOf(t.Recursive),
+		DebounceSec:     valueOf(t.DebounceSec),
+	}
+	for _, op := range valueOf(t.FileEvents) {
+		out.FileEvents = append(out.FileEvents, domain.FileOp(op))
+	}
+	return out
+}
+
+func automationConditionsFromAPI(conds *[]AutomationCondition) []domain.AutomationCondition {
+	var out []domain.AutomationCondition
+	for _, c := range valueOf(conds) {
+		out = append(out, domain.AutomationCondition{Field: c.Field, Op: string(c.Op), Value: c.Value})
+	}
+	return out
+}
+
+func automationActionToAPI(a domain.AutomationAction) AutomationAction {
+	out := AutomationAction{
+		Kind:       AutomationActionKind(a.Kind),
+		Prompt:     optString(a.Prompt),
+		WorkflowId: optString(string(a.WorkflowID)),
+		Tool:       optString(a.Tool),
+		PersonaId:  personaIDToAPI(a.PersonaID),
+	}
+	if len(a.Args) > 0 {
+		out.Args = &a.Args
+	}
+	return out
+}
+
+func automationActionFromAPI(a AutomationAction) domain.AutomationAction {
+	return domain.AutomationAction{
+		Kind:       domain.AutomationActionKind(a.Kind),
+		Prompt:     valueOf(a.Prompt),
+		WorkflowID: domain.WorkflowID(valueOf(a.WorkflowId)),
+		Tool:       valueOf(a.Tool),
+		Args:       valueOf(a.Args),
+		PersonaID:  personaIDOf(a.PersonaId),
+	}
+}
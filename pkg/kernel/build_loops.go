@@ -0,0 +1,113 @@
+package kernel
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetBuildLoops enables the build loop API.
+func (s *Server) SetBuildLoops(b *services.BuildLoopService) {
+	s.buildLoops = b
+}
+
+// handleStartBuildLoop starts the coder persona on the project: edit, run
+// the project's build command in a sandbox, feed failures back, repeat
+// until green or max_cycles. The loop runs in the background.
+// POST /v1/projects/{id}/build-loops
+// Body: {"goal"?: "add a --verbose flag", "max_cycles"?: 5}
+func (s *Server) handleStartBuildLoop(w http.ResponseWriter, r *http.Request) {
+	if s.buildLoops == nil {
+		http.Error(w, "build loops not configured", http.StatusServiceUnavailable)
+		return
+	}
+	id, _ := projectSubresourceID(r.URL.Path, "build-loops")
+	var req struct {
+		Goal      string `json:"goal"`
+		MaxCycles int    `json:"max_cycles"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if req.MaxCycles < 0 {
+		http.Error(w, "max_cycles must be >= 0", http.StatusBadRequest)
+		return
+	}
+
+	loop, err := s.buildLoops.Start(r.Context(), domain.ProjectID(id), req.Goal, req.MaxCycles)
+	switch {
+	case errors.Is(err, domain.ErrProjectNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, domain.ErrNoBuildCommand):
+		http.Error(w, err.Error()+"; set settings.build.command", http.StatusBadRequest)
+		return
+	case errors.Is(err, domain.ErrBuildLoopRunning):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		s.logger.Error("failed to start build loop", "project_id", id, "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(loop)
+}
+
+// handleListBuildLoops lists the project's recent build loops, newest first.
+// GET /v1/projects/{id}/build-loops
+func (s *Server) handleListBuildLoops(w http.ResponseWriter, r *http.Request) {
+	if s.buildLoops == nil {
+		http.Error(w, "build loops not configured", http.StatusServiceUnavailable)
+		return
+	}
+	id, _ := projectSubresourceID(r.URL.Path, "build-loops")
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.buildLoops.List(domain.ProjectID(id)))
+}
+
+// handleBuildLoop serves a single loop.
+// GET  /v1/build-loops/{id}
+// POST /v1/build-loops/{id}/cancel
+func (s *Server) handleBuildLoop(w http.ResponseWriter, r *http.Request) {
+	if s.buildLoops == nil {
+		http.Error(w, "build loops not configured", http.StatusServiceUnavailable)
+		return
+	}
+	rest := strings.TrimPrefix(r.URL.Path, "/v1/build-loops/")
+	id, action, _ := strings.Cut(rest, "/")
+	loopID := domain.BuildLoopID(id)
+
+	switch {
+	case r.Method == "GET" && action == "":
+		loop, err := s.buildLoops.Get(loopID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(loop)
+	case r.Method == "POST" && action == "cancel":
+		err := s.buildLoops.Cancel(loopID)
+		switch {
+		case errors.Is(err, domain.ErrBuildLoopNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case errors.Is(err, domain.ErrBuildLoopNotActive):
+			http.Error(w, err.Error(), http.StatusConflict)
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
@@ -0,0 +1,75 @@
+package kernel
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetCleanup enables the /v1/cleanup bulk deletion API.
+func (s *Server) SetCleanup(c *services.CleanupService) {
+	s.cleanup = c
+}
+
+// handleCleanup runs a bulk cleanup in two calls. Without confirm_token it
+// is a dry run returning the count, a sample of IDs and a confirm_token;
+// sending the same body with that token deletes.
+//
+//	POST /v1/cleanup/conversations      {"older_than_days": 90}
+//	POST /v1/cleanup/failed_jobs        {"older_than_days"?: 7}
+//	POST /v1/cleanup/traces             {"older_than_days"?: 30}  (all when omitted)
+//	POST /v1/cleanup/project_artifacts  {"project_id": "...", "older_than_days"?: 30}
+func (s *Server) handleCleanup(w http.ResponseWriter, r *http.Request) {
+	if s.cleanup == nil {
+		http.Error(w, "cleanup not available", http.StatusServiceUnavailable)
+		return
+	}
+	kind := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/cleanup"), "/")
+	if kind == "" || strings.Contains(kind, "/") || r.Method != "POST" {
+		http.NotFound(w, r)
+		return
+	}
+
+	var body struct {
+		OlderThanDays int              `json:"older_than_days"`
+		ProjectID     domain.ProjectID `json:"project_id"`
+		ConfirmToken  string           `json:"confirm_token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := domain.CleanupRequest{Kind: domain.CleanupKind(kind), OlderThanDays: body.OlderThanDays, ProjectID: body.ProjectID}
+
+	var (
+		out interface{}
+		err error
+	)
+	if body.ConfirmToken == "" {
+		out, err = s.cleanup.Plan(r.Context(), req)
+	} else {
+		out, err = s.cleanup.Execute(r.Context(), req, body.ConfirmToken)
+	}
+	switch {
+	case errors.Is(err, domain.ErrInvalidCleanup):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, domain.ErrProjectNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, domain.ErrCleanupToken):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		s.logger.Error("bulk cleanup failed", "kind", kind, "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(out)
+}
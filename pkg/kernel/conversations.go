@@ -2,10 +2,20 @@ package kernel
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
 )
 
+// maxMessagePageSize caps the limit of a message page request.
+const maxMessagePageSize = 200
+
 // --- StrictServerInterface implementations for Conversations ---
 
 // ListConversations implements StrictServerInterface.
@@ -26,7 +36,7 @@ func (s *Server) ListConversations(ctx context.Context, _ ListConversationsReque
 
 // CreateConversation implements StrictServerInterface.
 func (s *Server) CreateConversation(ctx context.Context, request CreateConversationRequestObject) (CreateConversationResponseObject, error) {
-	title := "New Chat"
+	title := services.DefaultConversationTitle
 	if request.Body != nil && request.Body.Title != nil {
 		title = *request.Body.Title
 	}
@@ -111,6 +121,461 @@ func (s *Server) ListMessages(ctx context.Context, request ListMessagesRequestOb
 	return result, nil
 }
 
+// pagedMessage is the API message plus when it was replaced by a regenerated reply.
+type pagedMessage struct {
+	Message
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+}
+
+// handleListMessagesPage returns a cursor-paginated page of messages in
+// chronological order. Query: limit (default 50, max 200), one of
+// before/after (a message ID) and include_archived to also return replies
+// replaced by regeneration. X-Has-More tells whether another page exists in
+// that direction and X-Next-Cursor is the ID to pass to fetch it.
+// GET /v1/conversations/{id}/messages
+func (s *Server) handleListMessagesPage(w http.ResponseWriter, r *http.Request) {
+	id, _ := conversationSubresourceID(r.URL.Path, "messages")
+	q := r.URL.Query()
+
+	page := domain.MessagePage{
+		Before: domain.MessageID(q.Get("before")),
+		After:  domain.MessageID(q.Get("after")),
+		Limit:  domain.DefaultMessagePageSize,
+	}
+	if page.Before != "" && page.After != "" {
+		http.Error(w, "before and after are mutually exclusive", http.StatusBadRequest)
+		return
+	}
+	if raw := q.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n <= 0 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		page.Limit = min(n, maxMessagePageSize)
+	}
+	if raw := q.Get("include_archived"); raw != "" {
+		include, err := strconv.ParseBool(raw)
+		if err != nil {
+			http.Error(w, "include_archived must be true or false", http.StatusBadRequest)
+			return
+		}
+		page.IncludeArchived = include
+	}
+
+	msgs, hasMore, err := s.convStore.GetMessagesPage(r.Context(), domain.ConversationID(id), page)
+	if errors.Is(err, domain.ErrMessageNotFound) {
+		http.Error(w, "cursor message not found in conversation", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		s.logger.Error("failed to list messages", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	result := make([]pagedMessage, len(msgs))
+	for i, m := range msgs {
+		result[i] = pagedMessage{Message: domainMsgToAPI(m), ArchivedAt: m.ArchivedAt}
+	}
+	w.Header().Set("X-Has-More", strconv.FormatBool(hasMore))
+	if hasMore && len(msgs) > 0 {
+		next := msgs[0].ID // paging back: the oldest on this page
+		if page.After != "" {
+			next = msgs[len(msgs)-1].ID
+		}
+		w.Header().Set("X-Next-Cursor", string(next))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// handleListConversationsFiltered lists conversations, pinned first, with
+// their tags, pin flag and folder. Query filters: tag, folder ("" = unfiled),
+// pinned (true/false) and project_id.
+// GET /v1/conversations
+func (s *Server) handleListConversationsFiltered(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filter := domain.ConversationFilter{Tag: q.Get("tag")}
+	if q.Has("folder") {
+		folder := q.Get("folder")
+		filter.Folder = &folder
+	}
+	if raw := q.Get("pinned"); raw != "" {
+		pinned, err := strconv.ParseBool(raw)
+		if err != nil {
+			http.Error(w, "pinned must be true or false", http.StatusBadRequest)
+			return
+		}
+		filter.Pinned = &pinned
+	}
+	if pid := q.Get("project_id"); pid != "" {
+		projectID := domain.ProjectID(pid)
+		filter.ProjectID = &projectID
+	}
+
+	convs, err := s.convStore.ListConversationsFiltered(r.Context(), filter)
+	if err != nil {
+		s.logger.Error("failed to list conversations", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if convs == nil {
+		convs = []domain.Conversation{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(convs)
+}
+
+// handleConversationFacets returns the folders and tags in use with counts.
+// GET /v1/conversations/facets
+func (s *Server) handleConversationFacets(w http.ResponseWriter, r *http.Request) {
+	facets, err := s.convStore.Facets(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(facets)
+}
+
+// handleGetConversationFull returns a conversation including its organization fields.
+// GET /v1/conversations/{id}
+func (s *Server) handleGetConversationFull(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/v1/conversations/")
+	conv, err := s.convStore.GetConversation(r.Context(), domain.ConversationID(id))
+	if errors.Is(err, domain.ErrConversationNotFound) {
+		http.Error(w, "conversation not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(conv)
+}
+
+// handlePatchConversation renames and/or organizes a conversation. Body:
+// {"title"?, "tags"?: [...], "pinned"?: bool, "folder"?: "name"}
+// PATCH /v1/conversations/{id}
+func (s *Server) handlePatchConversation(w http.ResponseWriter, r *http.Request) {
+	id := domain.ConversationID(strings.TrimPrefix(r.URL.Path, "/v1/conversations/"))
+	var body struct {
+		Title  *string   `json:"title"`
+		Tags   *[]string `json:"tags"`
+		Pinned *bool     `json:"pinned"`
+		Folder *string   `json:"folder"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if body.Title != nil {
+		if err := s.convStore.UpdateTitle(r.Context(), id, *body.Title); err != nil {
+			if errors.Is(err, domain.ErrConversationNotFound) {
+				http.Error(w, "conversation not found", http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	conv, err := s.convStore.Organize(r.Context(), id, domain.ConversationPatch{
+		Tags:   body.Tags,
+		Pinned: body.Pinned,
+		Folder: body.Folder,
+	})
+	if errors.Is(err, domain.ErrConversationNotFound) {
+		http.Error(w, "conversation not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.logger.Error("failed to update conversation", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(conv)
+}
+
+// handleRegenerateMessage re-runs the agent for the user message that
+// prompted an assistant reply. The old reply and anything after it are
+// archived (see include_archived on the message listing).
+// POST /v1/conversations/{id}/messages/{msgId}/regenerate
+func (s *Server) handleRegenerateMessage(w http.ResponseWriter, r *http.Request) {
+	convID, msgID, _ := conversationMessagePath(r.URL.Path)
+	if s.reactAgent == nil {
+		http.Error(w, "agent not configured", http.StatusServiceUnavailable)
+		return
+	}
+	ctx := domain.WithPriority(r.Context(), domain.PriorityInteractive)
+	resp, err := s.reactAgent.Regenerate(ctx, convID, msgID)
+	s.writeReplayResult(w, convID, resp, err)
+}
+
+// handleEditMessage replaces the last user message, deletes everything after
+// it and re-runs the agent. Body: {"content": "..."}
+// PATCH /v1/conversations/{id}/messages/{msgId}
+func (s *Server) handleEditMessage(w http.ResponseWriter, r *http.Request) {
+	convID, msgID, _ := conversationMessagePath(r.URL.Path)
+	if s.reactAgent == nil {
+		http.Error(w, "agent not configured", http.StatusServiceUnavailable)
+		return
+	}
+	var body struct {
+		Content string `json:"content"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(body.Content) == "" {
+		http.Error(w, "content is required", http.StatusBadRequest)
+		return
+	}
+	ctx := domain.WithPriority(r.Context(), domain.PriorityInteractive)
+	resp, err := s.reactAgent.EditMessage(ctx, convID, msgID, body.Content)
+	s.writeReplayResult(w, convID, resp, err)
+}
+
+func (s *Server) writeReplayResult(w http.ResponseWriter, convID domain.ConversationID, resp *domain.AgentResponse, err error) {
+	switch {
+	case errors.Is(err, domain.ErrConversationNotFound), errors.Is(err, domain.ErrMessageNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, domain.ErrMessageNotEditable), errors.Is(err, domain.ErrConversationBusy), errors.Is(err, domain.ErrRunCancelled):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		s.logger.Error("agent replay failed", "conversation_id", string(convID), "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"conversation_id": string(convID),
+		"response":        resp.Response,
+		"thought":         resp.Thought,
+		"steps":           resp.Steps,
+		"citations":       resp.Citations,
+	})
+}
+
+// conversationMessagePath parses /v1/conversations/{id}/messages/{msgId}[/{action}].
+func conversationMessagePath(path string) (domain.ConversationID, domain.MessageID, string) {
+	rest, ok := strings.CutPrefix(path, "/v1/conversations/")
+	if !ok {
+		return "", "", ""
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) < 3 || len(parts) > 4 || parts[0] == "" || parts[1] != "messages" || parts[2] == "" {
+		return "", "", ""
+	}
+	action := ""
+	if len(parts) == 4 {
+		action = parts[3]
+	}
+	return domain.ConversationID(parts[0]), domain.MessageID(parts[2]), action
+}
+
+// isConversationPath matches /v1/conversations/{id} without a subresource.
+func isConversationPath(path string) bool {
+	id := strings.TrimPrefix(path, "/v1/conversations/")
+	return id != path && id != "" && !strings.Contains(id, "/")
+}
+
+// conversationSubresourceID extracts {id} from /v1/conversations/{id}/{suffix}.
+// handleGetConversationContext returns a conversation's context variables.
+// GET /v1/conversations/{id}/context
+func (s *Server) handleGetConversationContext(w http.ResponseWriter, r *http.Request) {
+	id, _ := conversationSubresourceID(r.URL.Path, "context")
+	conv, err := s.convStore.GetConversation(r.Context(), domain.ConversationID(id))
+	if errors.Is(err, domain.ErrConversationNotFound) {
+		http.Error(w, "conversation not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	vars := conv.Context
+	if vars == nil {
+		vars = domain.ContextVars{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(vars)
+}
+
+// handleCancelConversationRun stops the agent run in progress: the pending
+// LLM call or tool is cancelled and the steps so far are kept as a
+// cancelled assistant message. 409 when nothing is running.
+// POST /v1/conversations/{id}/cancel
+func (s *Server) handleCancelConversationRun(w http.ResponseWriter, r *http.Request) {
+	id, _ := conversationSubresourceID(r.URL.Path, "cancel")
+	if s.reactAgent == nil {
+		http.Error(w, "no agent service configured", http.StatusServiceUnavailable)
+		return
+	}
+	if _, err := s.convStore.GetConversation(r.Context(), domain.ConversationID(id)); errors.Is(err, domain.ErrConversationNotFound) {
+		http.Error(w, "conversation not found", http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := s.reactAgent.Cancel(domain.ConversationID(id)); errors.Is(err, domain.ErrNoActiveRun) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"conversation_id": id, "cancelled": true})
+}
+
+// handlePutConversationContext replaces a conversation's context variables,
+// injected into the agent's prompt on every following turn. An empty
+// object clears them.
+// PUT /v1/conversations/{id}/context  body: {"open_file": "main.go", "location": {"city": "Lisbon"}}
+func (s *Server) handlePutConversationContext(w http.ResponseWriter, r *http.Request) {
+	id, _ := conversationSubresourceID(r.URL.Path, "context")
+	var vars domain.ContextVars
+	if err := json.NewDecoder(r.Body).Decode(&vars); err != nil {
+		http.Error(w, "invalid JSON object: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	err := s.convStore.SetContext(r.Context(), domain.ConversationID(id), vars)
+	switch {
+	case errors.Is(err, domain.ErrInvalidContextVars):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, domain.ErrConversationNotFound):
+		http.Error(w, "conversation not found", http.StatusNotFound)
+		return
+	case err != nil:
+		s.logger.Error("failed to set conversation context", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if vars == nil {
+		vars = domain.ContextVars{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(vars)
+}
+
+// handleGetConversationStyle returns a conversation's style preferences.
+// GET /v1/conversations/{id}/style
+func (s *Server) handleGetConversationStyle(w http.ResponseWriter, r *http.Request) {
+	id, _ := conversationSubresourceID(r.URL.Path, "style")
+	conv, err := s.convStore.GetConversation(r.Context(), domain.ConversationID(id))
+	if errors.Is(err, domain.ErrConversationNotFound) {
+		http.Error(w, "conversation not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	style := domain.ConversationStyle{}
+	if conv.Style != nil {
+		style = *conv.Style
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(style)
+}
+
+// handlePutConversationStyle replaces a conversation's style preferences,
+// injected into the agent's prompt on every following turn. An empty
+// object clears them.
+// PUT /v1/conversations/{id}/style
+// Body: {"tone": "friendly", "verbosity": "brief", "language": "pt-BR"}
+func (s *Server) handlePutConversationStyle(w http.ResponseWriter, r *http.Request) {
+	id, _ := conversationSubresourceID(r.URL.Path, "style")
+	var style domain.ConversationStyle
+	if err := json.NewDecoder(r.Body).Decode(&style); err != nil {
+		http.Error(w, "invalid JSON object: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	err := s.convStore.SetStyle(r.Context(), domain.ConversationID(id), &style)
+	switch {
+	case errors.Is(err, domain.ErrInvalidStyle):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, domain.ErrConversationNotFound):
+		http.Error(w, "conversation not found", http.StatusNotFound)
+		return
+	case err != nil:
+		s.logger.Error("failed to set conversation style", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(style)
+}
+
+// SetClarifier enables answering the agent's ask_user questions.
+func (s *Server) SetClarifier(c *services.Clarifier) {
+	s.clarifier = c
+}
+
+// handleGetConversationQuestion returns the question the agent is waiting
+// on, or 404 when it is not waiting.
+// GET /v1/conversations/{id}/question
+func (s *Server) handleGetConversationQuestion(w http.ResponseWriter, r *http.Request) {
+	if s.clarifier == nil {
+		http.Error(w, "ask_user not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	id, _ := conversationSubresourceID(r.URL.Path, "question")
+	q, ok := s.clarifier.Pending(domain.ConversationID(id))
+	if !ok {
+		http.Error(w, domain.ErrNoPendingQuestion.Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(q)
+}
+
+// handleAnswerConversationQuestion answers the agent's open question and
+// resumes its loop.
+// POST /v1/conversations/{id}/question
+// Body: {"answer": "...", "question_id": "q-..."} (question_id optional)
+func (s *Server) handleAnswerConversationQuestion(w http.ResponseWriter, r *http.Request) {
+	if s.clarifier == nil {
+		http.Error(w, "ask_user not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	id, _ := conversationSubresourceID(r.URL.Path, "question")
+	var body struct {
+		Answer     string            `json:"answer"`
+		QuestionID domain.QuestionID `json:"question_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(body.Answer) == "" {
+		http.Error(w, "answer is required", http.StatusBadRequest)
+		return
+	}
+	if err := s.clarifier.Answer(domain.ConversationID(id), body.QuestionID, body.Answer); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func conversationSubresourceID(path, suffix string) (string, bool) {
+	const prefix = "/v1/conversations/"
+	suffix = "/" + suffix
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+		return "", false
+	}
+	id := path[len(prefix) : len(path)-len(suffix)]
+	return id, id != "" && !strings.Contains(id, "/")
+}
+
 // --- Mapping helpers ---
 
 func domainConvToAPI(c domain.Conversation) Conversation {
@@ -154,6 +619,10 @@ func domainMsgToAPI(m domain.Message) Message {
 		msg.Thought = &m.Thought
 	}
 
+	if len(m.Metadata) > 0 {
+		msg.Metadata = &m.Metadata
+	}
+
 	if len(m.Steps) > 0 {
 		apiSteps := make([]ReActStep, len(m.Steps))
 		for j, step := range m.Steps {
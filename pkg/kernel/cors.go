@@ -0,0 +1,44 @@
+package kernel
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	"github.com/rs/cors"
+)
+
+// DefaultCORSOrigins are the dev-server origins allowed when none are configured.
+var DefaultCORSOrigins = []string{"http://localhost:5173", "http://localhost:5174"}
+
+// CORS wraps a handler with a CORS policy whose allowed origins can be
+// replaced at runtime.
+type CORS struct {
+	next    http.Handler
+	handler atomic.Pointer[http.Handler]
+}
+
+// NewCORS wraps next, allowing the given origins (DefaultCORSOrigins if empty).
+func NewCORS(next http.Handler, origins []string) *CORS {
+	c := &CORS{next: next}
+	c.SetOrigins(origins)
+	return c
+}
+
+// SetOrigins swaps the allowed origins; in-flight requests keep the old policy.
+func (c *CORS) SetOrigins(origins []string) {
+	if len(origins) == 0 {
+		origins = DefaultCORSOrigins
+	}
+	h := cors.New(cors.Options{
+		AllowedOrigins:   origins,
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"*"},
+		ExposedHeaders:   []string{"X-Has-More", "X-Next-Cursor", RequestIDHeader, "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"},
+		AllowCredentials: true,
+	}).Handler(c.next)
+	c.handler.Store(&h)
+}
+
+func (c *CORS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	(*c.handler.Load()).ServeHTTP(w, r)
+}
@@ -0,0 +1,167 @@
+package kernel
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// Diagnostic bundle defaults and caps.
+const (
+	defaultDiagnosticsHours  = 24
+	maxDiagnosticsHours      = 7 * 24
+	defaultDiagnosticsTraces = 20
+	maxDiagnosticsTraces     = 100
+	diagnosticsLogLimit      = 5000
+)
+
+// CreateDiagnosticsBundle implements StrictServerInterface. It builds a
+// zip to attach to bug reports: recent kernel logs, the latest failed
+// traces, the settings with secrets stripped, version info and the health
+// of the host, Docker and Ollama. Sections that cannot be collected are
+// listed in manifest.json instead. The zip is written as it is sent.
+func (s *Server) CreateDiagnosticsBundle(ctx context.Context, request CreateDiagnosticsBundleRequestObject) (CreateDiagnosticsBundleResponseObject, error) {
+	hours, maxTraces := defaultDiagnosticsHours, defaultDiagnosticsTraces
+	if b := request.Body; b != nil {
+		if b.Hours != nil {
+			hours = *b.Hours
+		}
+		if b.MaxTraces != nil {
+			maxTraces = *b.MaxTraces
+		}
+	}
+	if hours < 1 || hours > maxDiagnosticsHours {
+		return CreateDiagnosticsBundle400JSONResponse{Error: toPtr(fmt.Sprintf("hours must be between 1 and %d", maxDiagnosticsHours))}, nil
+	}
+	if maxTraces < 1 || maxTraces > maxDiagnosticsTraces {
+		return CreateDiagnosticsBundle400JSONResponse{Error: toPtr(fmt.Sprintf("max_traces must be between 1 and %d", maxDiagnosticsTraces))}, nil
+	}
+
+	now := time.Now()
+	name := "aule-diagnostics-" + now.UTC().Format("20060102-150405")
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(s.writeDiagnostics(ctx, pw, name, now, hours, maxTraces))
+	}()
+	return CreateDiagnosticsBundle200ApplicationzipResponse{
+		Body:    pr,
+		Headers: CreateDiagnosticsBundle200ResponseHeaders{ContentDisposition: fmt.Sprintf(`attachment; filename="%s.zip"`, name)},
+	}, nil
+}
+
+// writeDiagnostics writes the bundle covering the hours before now to w.
+func (s *Server) writeDiagnostics(ctx context.Context, w io.Writer, name string, now time.Time, hours, maxTraces int) error {
+	since := now.Add(-time.Duration(hours) * time.Hour)
+	zw := zip.NewWriter(w)
+	missing := map[string]string{}
+	add := func(file string, v interface{}) {
+		f, err := zw.Create(name + "/" + file)
+		if err != nil {
+			return
+		}
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		enc.Encode(v)
+	}
+
+	add("version.json", s.buildInfo())
+	if s.settings != nil {
+		add("config.json", s.settings.GetStrippedConfig())
+	} else {
+		missing["config"] = "settings store not configured"
+	}
+	if s.logBuffer != nil {
+		logs := s.logBuffer.Query(domain.LogFilter{MinLevel: slog.LevelDebug, Since: since, Limit: diagnosticsLogLimit})
+		add("logs.json", logs)
+	} else {
+		missing["logs"] = "log buffer not configured"
+	}
+	if s.tracer != nil {
+		if err := s.addFailedTraces(ctx, add, since, maxTraces); err != nil {
+			missing["traces"] = err.Error()
+		}
+	} else {
+		missing["traces"] = "tracing not configured"
+	}
+	add("health.json", s.diagnosticsHealth(ctx))
+	add("manifest.json", map[string]interface{}{
+		"created_at": now,
+		"hours":      hours,
+		"max_traces": maxTraces,
+		"missing":    missing,
+	})
+	if err := zw.Close(); err != nil {
+		s.logger.Warn("failed to write diagnostics bundle", "error", err)
+		return err
+	}
+	return nil
+}
+
+// addFailedTraces writes the summaries of the latest failed traces since
+// the cutoff, and each of those traces in full.
+func (s *Server) addFailedTraces(ctx context.Context, add func(string, interface{}), since time.Time, limit int) error {
+	summaries, err := s.tracer.ListTraces(ctx, domain.TraceFilter{Status: domain.SpanStatusError, Since: since, Limit: limit})
+	if err != nil {
+		return err
+	}
+	add("traces/failed.json", summaries)
+	for _, sum := range summaries {
+		trace, err := s.tracer.GetTrace(ctx, sum.ID)
+		if err != nil {
+			continue
+		}
+		add("traces/"+string(sum.ID)+".json", trace)
+	}
+	return nil
+}
+
+// diagnosticsHealth samples the host and Docker, and checks that Ollama
+// answers and which models it has.
+func (s *Server) diagnosticsHealth(ctx context.Context) map[string]interface{} {
+	health := map[string]interface{}{}
+	if s.resources != nil {
+		health["system"] = s.resources.Sample(ctx)
+	}
+	if s.maintenance != nil {
+		health["maintenance"] = s.maintenance.Status()
+	}
+	if s.sse != nil {
+		health["sse"] = s.sse.stats()
+	}
+
+	if s.settings != nil && s.discovery != nil {
+		url := strings.TrimSpace(os.Getenv("OLLAMA_HOST"))
+		if url == "" {
+			url = s.settings.GetConfig().Providers.LLM.LocalURL
+		}
+		url = strings.TrimSuffix(strings.TrimRight(strings.TrimSpace(url), "/"), "/v1")
+		ollama := map[string]interface{}{"url": url}
+		checkCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		models, err := s.discovery.DiscoverOllama(checkCtx, url)
+		cancel()
+		if err != nil {
+			ollama["reachable"] = false
+			ollama["error"] = err.Error()
+		} else {
+			ids := make([]string, 0, len(models))
+			for _, m := range models {
+				ids = append(ids, m.ID)
+			}
+			ollama["reachable"] = true
+			ollama["models"] = ids
+		}
+		if s.warmup != nil {
+			ollama["warmup"] = s.warmup.Status()
+		}
+		health["ollama"] = ollama
+	}
+	return health
+}
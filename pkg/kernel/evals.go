@@ -0,0 +1,282 @@
+package kernel
+
+import (
+	"context"
+	"errors"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetEvalService enables the /v1/evals API.
+func (s *Server) SetEvalService(evals *services.EvalService) {
+	s.evals = evals
+}
+
+// isEvalNotFound reports whether err means a missing suite or run.
+func isEvalNotFound(err error) bool {
+	return errors.Is(err, domain.ErrEvalSuiteNotFound) || errors.Is(err, domain.ErrEvalRunNotFound)
+}
+
+// ListEvalSuites implements StrictServerInterface
+func (s *Server) ListEvalSuites(ctx context.Context, request ListEvalSuitesRequestObject) (ListEvalSuitesResponseObject, error) {
+	if s.evals == nil {
+		return ListEvalSuites503JSONResponse{Error: toPtr("evals not enabled")}, nil
+	}
+	suites, err := s.evals.ListSuites(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]EvalSuite, 0, len(suites))
+	for _, suite := range suites {
+		out = append(out, evalSuiteToAPI(suite))
+	}
+	return ListEvalSuites200JSONResponse{Suites: out, Count: len(out)}, nil
+}
+
+// CreateEvalSuite implements StrictServerInterface
+func (s *Server) CreateEvalSuite(ctx context.Context, request CreateEvalSuiteRequestObject) (CreateEvalSuiteResponseObject, error) {
+	if s.evals == nil {
+		return CreateEvalSuite503JSONResponse{Error: toPtr("evals not enabled")}, nil
+	}
+	saved, err := s.evals.SaveSuite(ctx, evalSuiteFromAPI(*request.Body))
+	if err != nil {
+		return CreateEvalSuite400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return CreateEvalSuite201JSONResponse(evalSuiteToAPI(saved)), nil
+}
+
+// GetEvalSuite implements StrictServerInterface
+func (s *Server) GetEvalSuite(ctx context.Context, request GetEvalSuiteRequestObject) (GetEvalSuiteResponseObject, error) {
+	if s.evals == nil {
+		return GetEvalSuite503JSONResponse{Error: toPtr("evals not enabled")}, nil
+	}
+	suite, err := s.evals.GetSuite(ctx, domain.EvalSuiteID(request.Id))
+	if isEvalNotFound(err) {
+		return GetEvalSuite404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return GetEvalSuite200JSONResponse(evalSuiteToAPI(suite)), nil
+}
+
+// UpdateEvalSuite implements StrictServerInterface. It replaces a suite,
+// keeping its ID and creation time.
+func (s *Server) UpdateEvalSuite(ctx context.Context, request UpdateEvalSuiteRequestObject) (UpdateEvalSuiteResponseObject, error) {
+	if s.evals == nil {
+		return UpdateEvalSuite503JSONResponse{Error: toPtr("evals not enabled")}, nil
+	}
+	id := domain.EvalSuiteID(request.Id)
+	existing, err := s.evals.GetSuite(ctx, id)
+	if isEvalNotFound(err) {
+		return UpdateEvalSuite404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	suite := evalSuiteFromAPI(*request.Body)
+	suite.ID, suite.CreatedAt = id, existing.CreatedAt
+	saved, err := s.evals.SaveSuite(ctx, suite)
+	if err != nil {
+		return UpdateEvalSuite400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return UpdateEvalSuite200JSONResponse(evalSuiteToAPI(saved)), nil
+}
+
+// DeleteEvalSuite implements StrictServerInterface. It deletes a suite and
+// its runs.
+func (s *Server) DeleteEvalSuite(ctx context.Context, request DeleteEvalSuiteRequestObject) (DeleteEvalSuiteResponseObject, error) {
+	if s.evals == nil {
+		return DeleteEvalSuite503JSONResponse{Error: toPtr("evals not enabled")}, nil
+	}
+	err := s.evals.DeleteSuite(ctx, domain.EvalSuiteID(request.Id))
+	if isEvalNotFound(err) {
+		return DeleteEvalSuite404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return DeleteEvalSuite204Response{}, nil
+}
+
+// RunEvals implements StrictServerInterface. It starts one run per persona
+// × model and answers 202, or 200 once they finish when wait is set.
+func (s *Server) RunEvals(ctx context.Context, request RunEvalsRequestObject) (RunEvalsResponseObject, error) {
+	if s.evals == nil {
+		return RunEvals503JSONResponse{Error: toPtr("evals not enabled")}, nil
+	}
+	b := request.Body
+	req := services.EvalRunRequest{
+		SuiteID: domain.EvalSuiteID(b.SuiteId),
+		Models:  valueOf(b.Models),
+		Wait:    valueOf(b.Wait),
+	}
+	for _, id := range valueOf(b.PersonaIds) {
+		req.PersonaIDs = append(req.PersonaIDs, domain.PersonaID(id))
+	}
+
+	runs, err := s.evals.Run(ctx, req)
+	if isEvalNotFound(err) {
+		return RunEvals404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := make([]EvalRun, 0, len(runs))
+	for _, run := range runs {
+		out = append(out, evalRunToAPI(run))
+	}
+	if req.Wait {
+		return RunEvals200JSONResponse{Runs: out, Count: len(out)}, nil
+	}
+	return RunEvals202JSONResponse{Runs: out, Count: len(out)}, nil
+}
+
+// ListEvalRuns implements StrictServerInterface. Runs are listed newest
+// first without their per-case results; fetch a run for its full report.
+func (s *Server) ListEvalRuns(ctx context.Context, request ListEvalRunsRequestObject) (ListEvalRunsResponseObject, error) {
+	if s.evals == nil {
+		return ListEvalRuns503JSONResponse{Error: toPtr("evals not enabled")}, nil
+	}
+	p := request.Params
+	runs, err := s.evals.ListRuns(ctx, domain.EvalSuiteID(valueOf(p.SuiteId)), paramLimit(p.Limit, 50, 500))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]EvalRun, 0, len(runs))
+	for _, run := range runs {
+		run.Results = nil
+		out = append(out, evalRunToAPI(run))
+	}
+	return ListEvalRuns200JSONResponse{Runs: out, Count: len(out)}, nil
+}
+
+// GetEvalRun implements StrictServerInterface. It returns the scored
+// report of one run.
+func (s *Server) GetEvalRun(ctx context.Context, request GetEvalRunRequestObject) (GetEvalRunResponseObject, error) {
+	if s.evals == nil {
+		return GetEvalRun503JSONResponse{Error: toPtr("evals not enabled")}, nil
+	}
+	run, err := s.evals.GetRun(ctx, domain.EvalRunID(request.Id))
+	if isEvalNotFound(err) {
+		return GetEvalRun404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return GetEvalRun200JSONResponse(evalRunToAPI(run)), nil
+}
+
+// DiffEvalRuns implements StrictServerInterface. It compares a run with
+// base, by default the previous run of the suite.
+func (s *Server) DiffEvalRuns(ctx context.Context, request DiffEvalRunsRequestObject) (DiffEvalRunsResponseObject, error) {
+	if s.evals == nil {
+		return DiffEvalRuns503JSONResponse{Error: toPtr("evals not enabled")}, nil
+	}
+	diff, err := s.evals.Diff(ctx, domain.EvalRunID(request.Id), domain.EvalRunID(valueOf(request.Params.Base)))
+	if isEvalNotFound(err) {
+		return DiffEvalRuns404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := DiffEvalRuns200JSONResponse{
+		BaseRunId:    string(diff.BaseRunID),
+		RunId:        string(diff.RunID),
+		BaseScore:    diff.BaseScore,
+		Score:        diff.Score,
+		ScoreDelta:   diff.ScoreDelta,
+		Regressions:  diff.Regressions,
+		Improvements: diff.Improvements,
+		Cases:        make([]EvalCaseDiff, 0, len(diff.Cases)),
+	}
+	for _, c := range diff.Cases {
+		out.Cases = append(out.Cases, EvalCaseDiff{
+			Case:       c.Case,
+			Change:     EvalCaseDiffChange(c.Change),
+			BaseScore:  c.BaseScore,
+			Score:      c.Score,
+			ScoreDelta: c.ScoreDelta,
+		})
+	}
+	return out, nil
+}
+
+func evalSuiteToAPI(suite domain.EvalSuite) EvalSuite {
+	out := EvalSuite{
+		Id:          toPtr(string(suite.ID)),
+		Name:        suite.Name,
+		Description: optString(suite.Description),
+		Cases:       make([]EvalCase, 0, len(suite.Cases)),
+		CreatedAt:   &suite.CreatedAt,
+		UpdatedAt:   &suite.UpdatedAt,
+	}
+	for _, c := range suite.Cases {
+		ec := EvalCase{Name: c.Name, Prompt: c.Prompt, ExpectedTools: optSlice(c.ExpectedTools)}
+		if len(c.Assertions) > 0 {
+			assertions := make([]EvalAssertion, 0, len(c.Assertions))
+			for _, a := range c.Assertions {
+				assertions = append(assertions, EvalAssertion{Type: EvalAssertionType(a.Type), Value: a.Value})
+			}
+			ec.Assertions = &assertions
+		}
+		out.Cases = append(out.Cases, ec)
+	}
+	return out
+}
+
+// evalSuiteFromAPI converts a requested suite; its ID and timestamps are
+// read-only.
+func evalSuiteFromAPI(suite EvalSuite) domain.EvalSuite {
+	out := domain.EvalSuite{
+		Name:        suite.Name,
+		Description: valueOf(suite.Description),
+	}
+	for _, c := range suite.Cases {
+		dc := domain.EvalCase{Name: c.Name, Prompt: c.Prompt, ExpectedTools: valueOf(c.ExpectedTools)}
+		for _, a := range valueOf(c.Assertions) {
+			dc.Assertions = append(dc.Assertions, domain.EvalAssertion{Type: domain.EvalAssertionType(a.Type), Value: a.Value})
+		}
+		out.Cases = append(out.Cases, dc)
+	}
+	return out
+}
+
+func evalRunToAPI(run domain.EvalRun) EvalRun {
+	out := EvalRun{
+		Id:         string(run.ID),
+		SuiteId:    string(run.SuiteID),
+		PersonaId:  personaIDToAPI(run.PersonaID),
+		Model:      optString(run.Model),
+		Status:     EvalRunStatus(run.Status),
+		Score:      run.Score,
+		Passed:     run.Passed,
+		Total:      run.Total,
+		Error:      optString(run.Error),
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+	}
+	if len(run.Results) > 0 {
+		results := make([]EvalCaseResult, 0, len(run.Results))
+		for _, r := range run.Results {
+			toolsUsed := r.ToolsUsed
+			if toolsUsed == nil {
+				toolsUsed = []string{}
+			}
+			results = append(results, EvalCaseResult{
+				Case:           r.Case,
+				Passed:         r.Passed,
+				Score:          r.Score,
+				Output:         r.Output,
+				ToolsUsed:      toolsUsed,
+				Failures:       optSlice(r.Failures),
+				Error:          optString(r.Error),
+				ConversationId: optString(string(r.ConversationID)),
+				DurationMs:     r.DurationMs,
+			})
+		}
+		out.Results = &results
+	}
+	return out
+}
@@ -2,22 +2,29 @@ package kernel
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
 )
 
+// errStreamedRaw answers the strict halves of SSE routes. The raw handler
+// serves those streams, so reaching one means the routing is broken; the
+// generated visitor would panic on a nil stream body.
+var errStreamedRaw = errors.New("event stream is served by the raw SSE handler")
+
 // StreamConversationEvents serves SSE events for a conversation (sub-agent activity, etc.)
 // NOTE: The strict server pattern doesn't work well with SSE streaming.
-// We return a placeholder here and handle the real SSE in the raw HTTP wrapper.
+// We return errStreamedRaw here and handle the real SSE in the raw HTTP wrapper.
 // See server.go for the actual SSE handler registered separately.
-func (s *Server) StreamConversationEvents(_ context.Context, req StreamConversationEventsRequestObject) (StreamConversationEventsResponseObject, error) {
-	// This is a no-op — the actual SSE is handled by the raw HTTP handler
-	// registered in server.go's Handler() method, which bypasses the strict wrapper.
-	_ = req.Id
-	return StreamConversationEvents200TexteventStreamResponse{
-		Body: nil,
-	}, nil
+func (s *Server) StreamConversationEvents(_ context.Context, _ StreamConversationEventsRequestObject) (StreamConversationEventsResponseObject, error) {
+	// The actual SSE is handled by the raw HTTP handler registered in
+	// server.go's Handler() method, which bypasses the strict wrapper.
+	return nil, errStreamedRaw
 }
 
 // handleConversationSSE is the raw HTTP handler for SSE streaming of conversation events.
@@ -35,39 +42,25 @@ func (s *Server) handleConversationSSE(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	flusher, ok := w.(http.Flusher)
+	st, ok := s.openSSE(w, r, "conversation")
 	if !ok {
-		http.Error(w, "streaming not supported", http.StatusInternalServerError)
 		return
 	}
-
-	// SSE headers
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.Header().Set("X-Accel-Buffering", "no")
-	w.WriteHeader(http.StatusOK)
-	flusher.Flush()
+	defer st.Close()
 
 	// Subscribe to events for this conversation (keyed by conv ID in the EventBus)
 	ch, unsub := s.eventBus.Subscribe(convID)
 	defer unsub()
 
-	ctx := r.Context()
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case evt, ok := <-ch:
-			if !ok {
-				return
-			}
-			// evt.Type tells us if it's sub_agent, status, log, etc.
-			// evt.Data is the JSON payload
-			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", evt.Type, evt.Data)
-			flusher.Flush()
-		}
-	}
+	// evt.Type tells us if it's sub_agent, status, log, etc.
+	st.pump(ch, nil)
+}
+
+// StreamWorkflowEvents implements StrictServerInterface. The strict wrapper
+// cannot stream, so the real SSE is served by handleWorkflowSSE in the raw
+// handler and this is never reached.
+func (s *Server) StreamWorkflowEvents(_ context.Context, _ StreamWorkflowEventsRequestObject) (StreamWorkflowEventsResponseObject, error) {
+	return nil, errStreamedRaw
 }
 
 // handleWorkflowSSE is the raw HTTP handler for SSE streaming of workflow events.
@@ -85,84 +78,211 @@ func (s *Server) handleWorkflowSSE(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	flusher, ok := w.(http.Flusher)
+	st, ok := s.openSSE(w, r, "workflow")
 	if !ok {
-		http.Error(w, "streaming not supported", http.StatusInternalServerError)
 		return
 	}
-
-	// SSE headers
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.Header().Set("X-Accel-Buffering", "no")
-	w.WriteHeader(http.StatusOK)
+	defer st.Close()
 
 	// Send initial connected event
-	fmt.Fprintf(w, "event: connected\ndata: {\"workflow_id\":\"%s\"}\n\n", wfID)
-	flusher.Flush()
+	if st.Send("connected", fmt.Sprintf(`{"workflow_id":"%s"}`, wfID)) != nil {
+		return
+	}
 
 	// Subscribe to events for this workflow
 	ch, unsub := s.eventBus.Subscribe(wfID)
 	defer unsub()
 
-	ctx := r.Context()
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case evt, ok := <-ch:
-			if !ok {
-				return
-			}
-			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", evt.Type, evt.Data)
-			flusher.Flush()
+	// Close stream when workflow terminates
+	st.pump(ch, func(evt services.Event) bool {
+		switch string(evt.Type) {
+		case "workflow.completed", "workflow.failed", "workflow.cancelled":
+			return true
+		}
+		return false
+	})
+}
 
-			// Close stream when workflow terminates
-			switch string(evt.Type) {
-			case "workflow.completed", "workflow.failed", "workflow.cancelled":
-				return
-			}
+// handleJobSSE streams a job's status and log events.
+// GET /v1/jobs/{id}/stream
+func (s *Server) handleJobSSE(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	jobID := parts[2] // v1/jobs/{id}/stream → index 2
+	if _, err := s.repo.GetJob(r.Context(), domain.JobID(jobID)); err != nil {
+		if err == domain.ErrJobNotFound {
+			http.Error(w, "job not found", http.StatusNotFound)
+			return
 		}
+		s.logger.Error("failed to get job", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
 	}
+
+	st, ok := s.openSSE(w, r, "job")
+	if !ok {
+		return
+	}
+	defer st.Close()
+
+	ch, unsub := s.eventBus.Subscribe(jobID)
+	defer unsub()
+
+	if st.Send("connected", jobID) != nil {
+		return
+	}
+	// Kept open after the job finishes: the client may still want logs.
+	st.pump(ch, nil)
+}
+
+// StreamBroadcastEvents implements StrictServerInterface; the real SSE is
+// served by handleBroadcastSSE in the raw handler.
+func (s *Server) StreamBroadcastEvents(_ context.Context, _ StreamBroadcastEventsRequestObject) (StreamBroadcastEventsResponseObject, error) {
+	return nil, errStreamedRaw
 }
 
 // handleBroadcastSSE serves the global SSE stream for proactive agent messages.
 // Clients subscribe to /v1/events to receive messages from heartbeat, cron, spawn,
 // and any other background agent activity — without needing to know job/conv IDs.
 func (s *Server) handleBroadcastSSE(w http.ResponseWriter, r *http.Request) {
-	flusher, ok := w.(http.Flusher)
+	st, ok := s.openSSE(w, r, "broadcast")
 	if !ok {
-		http.Error(w, "streaming not supported", http.StatusInternalServerError)
 		return
 	}
-
-	// SSE headers
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.Header().Set("X-Accel-Buffering", "no")
-	w.WriteHeader(http.StatusOK)
+	defer st.Close()
 
 	// Send initial connected event
-	fmt.Fprintf(w, "event: connected\ndata: {\"channel\":\"broadcast\"}\n\n")
-	flusher.Flush()
+	if st.Send("connected", `{"channel":"broadcast"}`) != nil {
+		return
+	}
 
 	// Subscribe to global events (broadcast channel — all background agent activity)
 	ch, unsub := s.eventBus.SubscribeGlobal()
 	defer unsub()
 
+	st.pump(ch, nil)
+}
+
+// StreamAgentChat implements StrictServerInterface; the real SSE is served
+// by handleChatStream in the raw handler.
+func (s *Server) StreamAgentChat(_ context.Context, _ StreamAgentChatRequestObject) (StreamAgentChatResponseObject, error) {
+	return nil, errStreamedRaw
+}
+
+// handleChatStream runs an agent chat and streams it as SSE: a "conversation"
+// event with the conversation ID, live "token" events (plus any other
+// conversation events) while the agent works, then "done" with the final
+// response or "error".
+// POST /v1/agent/chat/stream  body: {"message": "...", "conversation_id": "...", "persona_id": "...", "seed": 42}
+func (s *Server) handleChatStream(w http.ResponseWriter, r *http.Request) {
+	if s.reactAgent == nil {
+		http.Error(w, "agent not configured", http.StatusServiceUnavailable)
+		return
+	}
+	var body struct {
+		Message        string `json:"message"`
+		ConversationID string `json:"conversation_id"`
+		PersonaID      string `json:"persona_id"`
+		Seed           *int64 `json:"seed"` // reproduce an earlier answer
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if body.Seed != nil && *body.Seed < 0 {
+		http.Error(w, "seed must be >= 0", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(body.Message) == "" {
+		http.Error(w, "message is required", http.StatusBadRequest)
+		return
+	}
+
+	var personaID *domain.PersonaID
+	if body.PersonaID != "" {
+		pid := domain.PersonaID(body.PersonaID)
+		personaID = &pid
+	}
+
+	// A turn already running gets a plain 409 rather than a stream that
+	// fails at once; Chat still enforces it if another one starts meanwhile
+	convID := domain.ConversationID(body.ConversationID)
+	if convID != "" && s.convStore.TurnRunning(convID) {
+		http.Error(w, domain.ErrConversationBusy.Error(), http.StatusConflict)
+		return
+	}
+
+	// The conversation must exist up front so we can subscribe to its events
+	if convID == "" {
+		conv, err := s.convStore.CreateConversationWithPersona(r.Context(), services.PlaceholderTitle(body.Message), personaID)
+		if err != nil {
+			http.Error(w, "create conversation: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		convID = conv.ID
+	}
+
+	st, ok := s.openSSE(w, r, "chat")
+	if !ok {
+		return
+	}
+	defer st.Close()
+
+	ch, unsub := s.eventBus.Subscribe(string(convID))
+	defer unsub()
+
+	if st.Send("conversation", fmt.Sprintf(`{"conversation_id":"%s"}`, convID)) != nil {
+		return
+	}
+
+	type chatResult struct {
+		resp *domain.AgentResponse
+		err  error
+	}
 	ctx := r.Context()
+	done := make(chan chatResult, 1)
+	go func() {
+		chatCtx := domain.WithPriority(ctx, domain.PriorityInteractive)
+		if body.Seed != nil {
+			chatCtx = domain.WithSeed(chatCtx, *body.Seed)
+		}
+		resp, _, err := s.reactAgent.Chat(chatCtx, convID, body.Message, personaID)
+		done <- chatResult{resp: resp, err: err}
+	}()
+
+	// No idle timeout here: the stream ends with the chat.
+	ticker := st.heartbeat()
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-ticker.C:
+			if st.ping() != nil {
+				return
+			}
 		case evt, ok := <-ch:
 			if !ok {
 				return
 			}
-			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", evt.Type, evt.Data)
-			flusher.Flush()
+			if st.Send(string(evt.Type), sseData(evt)) != nil {
+				return
+			}
+		case res := <-done:
+			if res.err != nil {
+				data, _ := json.Marshal(map[string]string{"error": res.err.Error()})
+				st.Send("error", string(data))
+			} else {
+				data, _ := json.Marshal(map[string]interface{}{
+					"conversation_id": string(convID),
+					"response":        res.resp.Response,
+					"thought":         res.resp.Thought,
+					"steps":           res.resp.Steps,
+					"seed":            res.resp.Seed,
+					"citations":       res.resp.Citations,
+				})
+				st.Send("done", string(data))
+			}
+			return
 		}
 	}
 }
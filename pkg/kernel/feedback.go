@@ -0,0 +1,118 @@
+package kernel
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// handleRateMessage records thumbs-up/down and an optional comment on an
+// assistant reply. Body: {"rating": "up"|"down", "comment"?: "..."}
+// POST /v1/conversations/{id}/messages/{msgId}/feedback
+func (s *Server) handleRateMessage(w http.ResponseWriter, r *http.Request) {
+	convID, msgID, _ := conversationMessagePath(r.URL.Path)
+	var body struct {
+		Rating  domain.FeedbackRating `json:"rating"`
+		Comment string                `json:"comment"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	fb, err := s.convStore.RateMessage(r.Context(), convID, msgID, body.Rating, body.Comment)
+	switch {
+	case errors.Is(err, domain.ErrConversationNotFound), errors.Is(err, domain.ErrMessageNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, domain.ErrInvalidFeedback):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		s.logger.Error("failed to save feedback", "message_id", string(msgID), "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(fb)
+}
+
+// handleGetMessageFeedback returns the feedback recorded for a message.
+// GET /v1/conversations/{id}/messages/{msgId}/feedback
+func (s *Server) handleGetMessageFeedback(w http.ResponseWriter, r *http.Request) {
+	convID, msgID, _ := conversationMessagePath(r.URL.Path)
+	fb, err := s.convStore.MessageFeedback(r.Context(), msgID)
+	if errors.Is(err, domain.ErrFeedbackNotFound) || (err == nil && fb.ConversationID != convID) {
+		http.Error(w, "feedback not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(fb)
+}
+
+// handleExportFeedback exports feedback with the prompt, reply, model and
+// trace ID of each rated exchange. Query: format (jsonl default, csv or
+// json), rating (up/down) and since (RFC 3339).
+// GET /v1/feedback/export
+func (s *Server) handleExportFeedback(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filter := domain.FeedbackFilter{Rating: domain.FeedbackRating(q.Get("rating"))}
+	if filter.Rating != "" && filter.Rating != domain.FeedbackUp && filter.Rating != domain.FeedbackDown {
+		http.Error(w, "rating must be up or down", http.StatusBadRequest)
+		return
+	}
+	if raw := q.Get("since"); raw != "" {
+		since, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			http.Error(w, "since must be an RFC 3339 timestamp", http.StatusBadRequest)
+			return
+		}
+		filter.Since = &since
+	}
+
+	records, err := s.convStore.FeedbackRecords(r.Context(), filter)
+	if err != nil {
+		s.logger.Error("failed to export feedback", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	switch format := q.Get("format"); format {
+	case "", "jsonl":
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", `attachment; filename="feedback.jsonl"`)
+		enc := json.NewEncoder(w)
+		for _, rec := range records {
+			enc.Encode(rec)
+		}
+	case "json":
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(records)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="feedback.csv"`)
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"message_id", "conversation_id", "trace_id", "rating", "comment", "prompt", "response", "model", "persona_id", "updated_at"})
+		for _, rec := range records {
+			persona := ""
+			if rec.PersonaID != nil {
+				persona = string(*rec.PersonaID)
+			}
+			cw.Write([]string{
+				string(rec.MessageID), string(rec.ConversationID), string(rec.TraceID), string(rec.Rating),
+				rec.Comment, rec.Prompt, rec.Response, rec.Model, persona, rec.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+		cw.Flush()
+	default:
+		http.Error(w, "format must be jsonl, json or csv", http.StatusBadRequest)
+	}
+}
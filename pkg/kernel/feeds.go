@@ -0,0 +1,101 @@
+package kernel
+
+import (
+	"context"
+	"errors"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetFeedMonitor enables the /v1/feeds API.
+func (s *Server) SetFeedMonitor(feeds *services.FeedMonitor) {
+	s.feeds = feeds
+}
+
+// ListFeeds implements StrictServerInterface
+func (s *Server) ListFeeds(ctx context.Context, request ListFeedsRequestObject) (ListFeedsResponseObject, error) {
+	if s.feeds == nil {
+		return ListFeeds503JSONResponse{Error: toPtr("feeds not enabled")}, nil
+	}
+	feeds, err := s.feeds.List(ctx, domain.ProjectID(valueOf(request.Params.ProjectId)))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]FeedWatch, 0, len(feeds))
+	for _, f := range feeds {
+		out = append(out, feedToAPI(f))
+	}
+	return ListFeeds200JSONResponse{Feeds: out, Count: len(out)}, nil
+}
+
+// WatchFeed implements StrictServerInterface. It registers a feed after
+// fetching it once.
+func (s *Server) WatchFeed(ctx context.Context, request WatchFeedRequestObject) (WatchFeedResponseObject, error) {
+	if s.feeds == nil {
+		return WatchFeed503JSONResponse{Error: toPtr("feeds not enabled")}, nil
+	}
+	b := request.Body
+	feed := domain.FeedWatch{
+		ProjectID:   domain.ProjectID(valueOf(b.ProjectId)),
+		Name:        valueOf(b.Name),
+		URL:         b.Url,
+		Prompt:      valueOf(b.Prompt),
+		WorkflowID:  domain.WorkflowID(valueOf(b.WorkflowId)),
+		PersonaID:   personaIDOf(b.PersonaId),
+		IntervalSec: valueOf(b.IntervalSec),
+	}
+	if err := s.feeds.Watch(ctx, &feed); err != nil {
+		return WatchFeed400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return WatchFeed201JSONResponse(feedToAPI(feed)), nil
+}
+
+// UnwatchFeed implements StrictServerInterface
+func (s *Server) UnwatchFeed(ctx context.Context, request UnwatchFeedRequestObject) (UnwatchFeedResponseObject, error) {
+	if s.feeds == nil {
+		return UnwatchFeed503JSONResponse{Error: toPtr("feeds not enabled")}, nil
+	}
+	err := s.feeds.Unwatch(ctx, domain.FeedID(request.Id))
+	if errors.Is(err, domain.ErrFeedNotFound) {
+		return UnwatchFeed404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return UnwatchFeed204Response{}, nil
+}
+
+// ToggleFeed implements StrictServerInterface. It pauses or resumes a feed.
+func (s *Server) ToggleFeed(ctx context.Context, request ToggleFeedRequestObject) (ToggleFeedResponseObject, error) {
+	if s.feeds == nil {
+		return ToggleFeed503JSONResponse{Error: toPtr("feeds not enabled")}, nil
+	}
+	feed, err := s.feeds.Toggle(ctx, domain.FeedID(request.Id))
+	if errors.Is(err, domain.ErrFeedNotFound) {
+		return ToggleFeed404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ToggleFeed200JSONResponse(feedToAPI(*feed)), nil
+}
+
+func feedToAPI(f domain.FeedWatch) FeedWatch {
+	return FeedWatch{
+		Id:           string(f.ID),
+		ProjectId:    string(f.ProjectID),
+		Name:         f.Name,
+		Url:          f.URL,
+		Prompt:       optString(f.Prompt),
+		WorkflowId:   optString(string(f.WorkflowID)),
+		AutomationId: optString(string(f.AutomationID)),
+		PersonaId:    personaIDToAPI(f.PersonaID),
+		IntervalSec:  f.IntervalSec,
+		Paused:       f.Paused,
+		LastChecked:  f.LastChecked,
+		LastError:    optString(f.LastError),
+		TriggerCount: f.TriggerCount,
+		CreatedAt:    f.CreatedAt,
+	}
+}
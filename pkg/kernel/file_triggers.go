@@ -0,0 +1,101 @@
+package kernel
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetFileTriggers enables the /v1/file-triggers API.
+func (s *Server) SetFileTriggers(triggers *services.FileTriggerService) {
+	s.fileTriggers = triggers
+}
+
+// handleFileTriggers routes the /v1/file-triggers/* API:
+//
+//	GET    /v1/file-triggers?project_id=    list file triggers
+//	POST   /v1/file-triggers                watch a directory
+//	DELETE /v1/file-triggers/{id}           remove a trigger
+//	POST   /v1/file-triggers/{id}/toggle    pause or resume a trigger
+func (s *Server) handleFileTriggers(w http.ResponseWriter, r *http.Request) {
+	if s.fileTriggers == nil {
+		http.Error(w, "file triggers not enabled", http.StatusServiceUnavailable)
+		return
+	}
+
+	rest := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/file-triggers"), "/")
+	parts := strings.Split(rest, "/")
+
+	switch {
+	case rest == "" && r.Method == "GET":
+		triggers, err := s.fileTriggers.List(r.Context(), domain.ProjectID(r.URL.Query().Get("project_id")))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"triggers": triggers,
+			"count":    len(triggers),
+		})
+	case rest == "" && r.Method == "POST":
+		s.handleWatchFiles(w, r)
+	case len(parts) == 1 && r.Method == "DELETE":
+		if err := s.fileTriggers.Unwatch(r.Context(), domain.FileTriggerID(parts[0])); err != nil {
+			writeFileTriggerError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 2 && parts[1] == "toggle" && r.Method == "POST":
+		trigger, err := s.fileTriggers.Toggle(r.Context(), domain.FileTriggerID(parts[0]))
+		if err != nil {
+			writeFileTriggerError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(trigger)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// handleWatchFiles registers a file trigger.
+// body: {"path": "inbox", "patterns": ["*.pdf"], "recursive": false, "events": ["create"], "prompt": "...", "workflow_id": "...", "debounce_sec": 5, "project_id": "..."}
+func (s *Server) handleWatchFiles(w http.ResponseWriter, r *http.Request) {
+	var req domain.FileTrigger
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	trigger := domain.FileTrigger{
+		ProjectID:   req.ProjectID,
+		Name:        req.Name,
+		Path:        req.Path,
+		Patterns:    req.Patterns,
+		Recursive:   req.Recursive,
+		Events:      req.Events,
+		Prompt:      req.Prompt,
+		WorkflowID:  req.WorkflowID,
+		PersonaID:   req.PersonaID,
+		DebounceSec: req.DebounceSec,
+	}
+	if err := s.fileTriggers.Watch(r.Context(), &trigger); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(trigger)
+}
+
+func writeFileTriggerError(w http.ResponseWriter, err error) {
+	if errors.Is(err, domain.ErrFileTriggerNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
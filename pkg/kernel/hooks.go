@@ -0,0 +1,93 @@
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// hookToAPI shows a webhook rule as the /v1/hooks API does. Hooks are a
+// shortcut for webhook automations addressed by their token, so external
+// systems (CI, monitoring) need a single URL; they are deleted and toggled
+// through /v1/automations/{id}.
+func hookToAPI(a domain.Automation) Hook {
+	return Hook{Url: "/v1/hooks/" + a.Trigger.WebhookToken, Automation: automationToAPI(a)}
+}
+
+// ListHooks implements StrictServerInterface. It lists webhook rules with
+// their URLs.
+func (s *Server) ListHooks(ctx context.Context, request ListHooksRequestObject) (ListHooksResponseObject, error) {
+	if s.automations == nil {
+		return ListHooks503JSONResponse{Error: toPtr("automations not enabled")}, nil
+	}
+	rules, err := s.automations.List(ctx, domain.ProjectID(valueOf(request.Params.ProjectId)))
+	if err != nil {
+		return nil, err
+	}
+	hooks := []Hook{}
+	for _, a := range rules {
+		if a.Trigger.Kind == domain.AutomationTriggerWebhook {
+			hooks = append(hooks, hookToAPI(a))
+		}
+	}
+	return ListHooks200JSONResponse{Hooks: hooks, Count: len(hooks)}, nil
+}
+
+// CreateHook implements StrictServerInterface. It creates a webhook rule
+// running either a prompt or a workflow.
+func (s *Server) CreateHook(ctx context.Context, request CreateHookRequestObject) (CreateHookResponseObject, error) {
+	if s.automations == nil {
+		return CreateHook503JSONResponse{Error: toPtr("automations not enabled")}, nil
+	}
+	b := request.Body
+	prompt, workflowID := valueOf(b.Prompt), domain.WorkflowID(valueOf(b.WorkflowId))
+	if (prompt == "") == (workflowID == "") {
+		return CreateHook400JSONResponse{Error: toPtr("exactly one of prompt or workflow_id is required")}, nil
+	}
+	action := domain.AutomationAction{Kind: domain.AutomationActionPrompt, Prompt: prompt, PersonaID: personaIDOf(b.PersonaId)}
+	if workflowID != "" {
+		action = domain.AutomationAction{Kind: domain.AutomationActionWorkflow, WorkflowID: workflowID}
+	}
+	a := domain.Automation{
+		ProjectID:  domain.ProjectID(valueOf(b.ProjectId)),
+		Name:       valueOf(b.Name),
+		Enabled:    true,
+		Trigger:    domain.AutomationTrigger{Kind: domain.AutomationTriggerWebhook},
+		Conditions: automationConditionsFromAPI(b.Conditions),
+		Action:     action,
+		CreatedBy:  "user",
+	}
+	if err := s.automations.Create(ctx, &a); err != nil {
+		return CreateHook400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return CreateHook201JSONResponse(hookToAPI(a)), nil
+}
+
+// handleFireHook fires the hook addressed by token; the body is
+// {{params.payload}}. It stays outside the spec: the body is an arbitrary
+// payload handed to the rule as is.
+// POST /v1/hooks/{token}
+func (s *Server) handleFireHook(w http.ResponseWriter, r *http.Request) {
+	if s.automations == nil {
+		http.Error(w, "automations not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	token := strings.TrimPrefix(r.URL.Path, "/v1/hooks/")
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "failed to read body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	a, err := s.automations.Hook(r.Context(), token, body)
+	if err != nil {
+		writeAutomationError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]string{"automation_id": string(a.ID)})
+}
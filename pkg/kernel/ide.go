@@ -0,0 +1,48 @@
+package kernel
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetIDECompanion enables the /v1/ide API for editor plugins.
+func (s *Server) SetIDECompanion(c *services.IDECompanion) {
+	s.ide = c
+}
+
+// handleIDEEdit turns an instruction about a file or selection into a
+// unified diff the editor can preview; with "apply" the patch is also
+// written to the project workspace.
+// POST /v1/ide/edit
+// Body: {"path": "src/main.go", "instruction": "...", "content"?: "...", "start_line"?: 40, "apply"?: false}
+func (s *Server) handleIDEEdit(w http.ResponseWriter, r *http.Request) {
+	if s.ide == nil {
+		http.Error(w, "IDE companion not configured", http.StatusServiceUnavailable)
+		return
+	}
+	var req domain.IDEEditRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := s.ide.Edit(r.Context(), req)
+	switch {
+	case errors.Is(err, domain.ErrInvalidIDEEdit):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, domain.ErrIDENoCode):
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	case err != nil:
+		s.logger.Error("ide edit failed", "path", req.Path, "error", err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
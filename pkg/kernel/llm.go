@@ -0,0 +1,62 @@
+package kernel
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/manthysbr/auleOS/internal/adapters/llm"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetLLMCache enables the /v1/llm/cache metrics and purge API.
+func (s *Server) SetLLMCache(cache *services.LLMCache) {
+	s.llmCache = cache
+}
+
+// handleLLMCacheStats returns hit/miss metrics of the LLM response cache.
+// GET /v1/llm/cache
+func (s *Server) handleLLMCacheStats(w http.ResponseWriter, r *http.Request) {
+	if s.llmCache == nil {
+		http.Error(w, "llm cache not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.llmCache.Stats())
+}
+
+// handlePurgeLLMCache drops all cached LLM responses.
+// DELETE /v1/llm/cache
+func (s *Server) handlePurgeLLMCache(w http.ResponseWriter, r *http.Request) {
+	if s.llmCache == nil {
+		http.Error(w, "llm cache not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	purged := s.llmCache.Purge()
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"purged": purged,
+	})
+}
+
+// SetLLMLimiters exposes provider request queues on /v1/llm/queues.
+func (s *Server) SetLLMLimiters(limiters ...*llm.RequestLimiter) {
+	for _, l := range limiters {
+		if l != nil {
+			s.llmLimiters = append(s.llmLimiters, l)
+		}
+	}
+}
+
+// handleLLMQueues returns concurrency and queue depth per LLM provider.
+// GET /v1/llm/queues
+func (s *Server) handleLLMQueues(w http.ResponseWriter, r *http.Request) {
+	queues := make([]llm.LimiterStats, 0, len(s.llmLimiters))
+	for _, l := range s.llmLimiters {
+		queues = append(queues, l.Stats())
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"queues": queues,
+		"count":  len(queues),
+	})
+}
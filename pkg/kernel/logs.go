@@ -0,0 +1,65 @@
+package kernel
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetLogBuffer enables the /v1/system/logs query API.
+func (s *Server) SetLogBuffer(b *services.LogBuffer) {
+	s.logBuffer = b
+}
+
+// QueryLogs implements StrictServerInterface. It returns recent kernel log
+// records, newest first. since accepts an RFC3339 timestamp or a duration
+// back from now ("15m").
+func (s *Server) QueryLogs(ctx context.Context, request QueryLogsRequestObject) (QueryLogsResponseObject, error) {
+	if s.logBuffer == nil {
+		return QueryLogs503JSONResponse{Error: toPtr("log buffer not configured")}, nil
+	}
+	p := request.Params
+	filter := domain.LogFilter{
+		Component: valueOf(p.Component),
+		RequestID: valueOf(p.RequestId),
+		Limit:     paramLimit(p.Limit, 200, 2000),
+	}
+	if p.Level != nil {
+		if err := filter.MinLevel.UnmarshalText([]byte(strings.ToUpper(string(*p.Level)))); err != nil {
+			return QueryLogs400JSONResponse{Error: toPtr("invalid level: expected debug, info, warn or error")}, nil
+		}
+	}
+	if since := valueOf(p.Since); since != "" {
+		if d, err := time.ParseDuration(since); err == nil && d > 0 {
+			filter.Since = time.Now().Add(-d)
+		} else if t, err := time.Parse(time.RFC3339, since); err == nil {
+			filter.Since = t
+		} else {
+			return QueryLogs400JSONResponse{Error: toPtr("invalid since: expected RFC3339 timestamp or duration")}, nil
+		}
+	}
+
+	entries := s.logBuffer.Query(filter)
+	out := make([]LogEntry, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, logEntryToAPI(e))
+	}
+	return QueryLogs200JSONResponse{Logs: out, Count: len(out)}, nil
+}
+
+func logEntryToAPI(e domain.LogEntry) LogEntry {
+	out := LogEntry{
+		Time:      e.Time,
+		Level:     e.Level.String(),
+		Msg:       e.Message,
+		Component: optString(e.Component),
+		RequestId: optString(e.RequestID),
+	}
+	if len(e.Attrs) > 0 {
+		out.Attrs = &e.Attrs
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetMaintenance enables the /v1/system pause and resume API.
+func (s *Server) SetMaintenance(m *services.MaintenanceMode) {
+	s.maintenance = m
+}
+
+// startsNewWork reports whether a request would start a chat, job or
+// workflow run — the requests refused while the system is paused.
+func startsNewWork(r *http.Request) bool {
+	if r.Method != "POST" && r.Method != "PATCH" {
+		return false
+	}
+	path := r.URL.Path
+	switch {
+	case path == "/v1/agent/chat", path == "/v1/agent/chat/stream", path == "/v1/jobs":
+		return true
+	case strings.HasPrefix(path, "/v1/workflows/") && (strings.HasSuffix(path, "/run") || strings.HasSuffix(path, "/resume")):
+		return true
+	}
+	// Regenerating a reply or editing a message re-runs the agent
+	if convID, msgID, action := conversationMessagePath(path); convID != "" && msgID != "" {
+		return (r.Method == "POST" && action == "regenerate") || (r.Method == "PATCH" && action == "")
+	}
+	return false
+}
+
+// writeMaintenance answers 503 with the maintenance notice.
+func (s *Server) writeMaintenance(w http.ResponseWriter) {
+	status := s.maintenance.Status()
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", "60")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error":       services.ErrSystemPaused.Error(),
+		"maintenance": status,
+	})
+}
+
+// PauseSystem implements StrictServerInterface. It stops the job
+// scheduler, cron, heartbeat and new chats without stopping the process;
+// running work finishes.
+func (s *Server) PauseSystem(ctx context.Context, request PauseSystemRequestObject) (PauseSystemResponseObject, error) {
+	if s.maintenance == nil {
+		return PauseSystem503JSONResponse{Error: toPtr("maintenance mode not configured")}, nil
+	}
+	reason := ""
+	if request.Body != nil {
+		reason = strings.TrimSpace(valueOf(request.Body.Reason))
+	}
+	if s.maintenance.Pause(reason) {
+		s.logger.Warn("system paused for maintenance", "reason", reason)
+	}
+	return PauseSystem200JSONResponse(maintenanceStatusToAPI(s.maintenance.Status())), nil
+}
+
+// ResumeSystem implements StrictServerInterface. Queued jobs and due tasks
+// resume.
+func (s *Server) ResumeSystem(ctx context.Context, request ResumeSystemRequestObject) (ResumeSystemResponseObject, error) {
+	if s.maintenance == nil {
+		return ResumeSystem503JSONResponse{Error: toPtr("maintenance mode not configured")}, nil
+	}
+	if s.maintenance.Resume() {
+		s.logger.Info("system resumed")
+	}
+	return ResumeSystem200JSONResponse(maintenanceStatusToAPI(s.maintenance.Status())), nil
+}
+
+// GetMaintenanceStatus implements StrictServerInterface
+func (s *Server) GetMaintenanceStatus(ctx context.Context, request GetMaintenanceStatusRequestObject) (GetMaintenanceStatusResponseObject, error) {
+	if s.maintenance == nil {
+		return GetMaintenanceStatus503JSONResponse{Error: toPtr("maintenance mode not configured")}, nil
+	}
+	return GetMaintenanceStatus200JSONResponse(maintenanceStatusToAPI(s.maintenance.Status())), nil
+}
+
+func maintenanceStatusToAPI(st domain.MaintenanceStatus) MaintenanceStatus {
+	return MaintenanceStatus{
+		Paused: st.Paused,
+		Reason: optString(st.Reason),
+		Since:  st.Since,
+	}
+}
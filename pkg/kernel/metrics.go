@@ -0,0 +1,96 @@
+package kernel
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// queryMetricsSource is implemented by repositories that time their queries.
+type queryMetricsSource interface {
+	QueryMetrics() domain.QueryMetrics
+	ResetQueryMetrics()
+}
+
+// apiSubsystem maps a request path to the subsystem tag used in repository
+// metrics, e.g. /v1/jobs/{id}/graph -> "api/jobs".
+func apiSubsystem(path string) string {
+	rest := strings.TrimPrefix(path, "/v1/")
+	if rest == path || rest == "" {
+		return "api"
+	}
+	if i := strings.IndexByte(rest, '/'); i >= 0 {
+		rest = rest[:i]
+	}
+	return "api/" + rest
+}
+
+// SetToolPolicy enables the /v1/metrics/tools tool-name correction metrics.
+func (s *Server) SetToolPolicy(p *services.ToolPolicy) {
+	s.toolPolicy = p
+}
+
+// handleToolNameMetrics returns how often agents used tool names that had
+// to be fuzzy-matched, per requested/matched pair.
+// GET /v1/metrics/tools
+func (s *Server) handleToolNameMetrics(w http.ResponseWriter, r *http.Request) {
+	if s.toolPolicy == nil {
+		http.Error(w, "tool metrics not available", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.toolPolicy.NameMetrics())
+}
+
+// handleEventBusMetrics returns every event subscriber with its lag and
+// dropped-event count.
+// GET /v1/metrics/events
+func (s *Server) handleEventBusMetrics(w http.ResponseWriter, r *http.Request) {
+	if s.eventBus == nil {
+		http.Error(w, "event bus not available", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"subscribers": s.eventBus.Stats(),
+	})
+}
+
+// handleConversationCacheMetrics returns the conversation cache hit rate,
+// evictions and write-behind backlog.
+// GET /v1/metrics/conversations
+func (s *Server) handleConversationCacheMetrics(w http.ResponseWriter, r *http.Request) {
+	if s.convStore == nil {
+		http.Error(w, "conversation store not available", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.convStore.CacheStats())
+}
+
+// handleRepositoryMetrics returns per-method query timings and recent slow queries.
+// GET /v1/metrics/repository
+func (s *Server) handleRepositoryMetrics(w http.ResponseWriter, r *http.Request) {
+	src, ok := s.repo.(queryMetricsSource)
+	if !ok {
+		http.Error(w, "repository metrics not available", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(src.QueryMetrics())
+}
+
+// handleResetRepositoryMetrics clears the collected query timings.
+// DELETE /v1/metrics/repository
+func (s *Server) handleResetRepositoryMetrics(w http.ResponseWriter, r *http.Request) {
+	src, ok := s.repo.(queryMetricsSource)
+	if !ok {
+		http.Error(w, "repository metrics not available", http.StatusServiceUnavailable)
+		return
+	}
+	src.ResetQueryMetrics()
+	w.WriteHeader(http.StatusNoContent)
+}
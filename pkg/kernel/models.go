@@ -2,18 +2,152 @@ package kernel
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
 )
 
-// ListModels returns the current model catalog.
+// SetModelWarmup adds preloading status to /v1/models.
+func (s *Server) SetModelWarmup(w *services.ModelWarmup) {
+	s.warmup = w
+}
+
+// SetModelInstaller enables POST /v1/models/pull.
+func (s *Server) SetModelInstaller(m *services.ModelInstaller) {
+	s.installer = m
+}
+
+// handlePullModel downloads a model into the local Ollama and streams its
+// progress as SSE "progress" events, ending with "done" or "error".
+// Failures before the download starts answer with a plain status instead:
+// 409 when the model is already being pulled, 507 when the disk holding
+// the model store is too full. Closing the stream cancels the pull.
+// POST /v1/models/pull {"model": "llama3.2:3b"}
+func (s *Server) handlePullModel(w http.ResponseWriter, r *http.Request) {
+	if s.installer == nil || !s.installer.Available() {
+		http.Error(w, services.ErrNoModelServer.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	var body struct {
+		Model string `json:"model"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid JSON body", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(body.Model) == "" {
+		http.Error(w, "model is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	progress := make(chan domain.ModelPullProgress, 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- s.installer.Pull(ctx, body.Model, func(p domain.ModelPullProgress) {
+			select {
+			case progress <- p:
+			case <-ctx.Done():
+			}
+		})
+	}()
+
+	// Hold the response until the pull has started so early failures get
+	// a real status code
+	var first domain.ModelPullProgress
+	select {
+	case <-ctx.Done():
+		return
+	case err := <-done:
+		if err != nil {
+			http.Error(w, err.Error(), pullErrorStatus(err))
+			return
+		}
+		done <- nil // finished without reports; the stream below ends at once
+	case first = <-progress:
+	}
+
+	st, ok := s.openSSE(w, r, "model_pull")
+	if !ok {
+		return
+	}
+	defer st.Close()
+	send := func(event string, v interface{}) bool {
+		data, _ := json.Marshal(v)
+		return st.Send(event, string(data)) == nil
+	}
+	if first.Status != "" && !send("progress", first) {
+		return
+	}
+
+	// No idle timeout here: the stream ends with the pull.
+	ticker := st.heartbeat()
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if st.ping() != nil {
+				return
+			}
+		case p := <-progress:
+			if !send("progress", p) {
+				return
+			}
+		case err := <-done:
+			// Relay reports that raced the result
+			for drained := false; !drained; {
+				select {
+				case p := <-progress:
+					send("progress", p)
+				default:
+					drained = true
+				}
+			}
+			if err != nil {
+				send("error", map[string]interface{}{"model": body.Model, "error": err.Error(), "status": pullErrorStatus(err)})
+				return
+			}
+			send("done", map[string]string{"model": body.Model})
+			return
+		}
+	}
+}
+
+// pullErrorStatus maps a pull failure to an HTTP status.
+func pullErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrModelPullRunning):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrInsufficientStorage):
+		return http.StatusInsufficientStorage
+	case errors.Is(err, services.ErrNoModelServer):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// ListModels returns the current model catalog and, with warm-up enabled,
+// the preloading status of each kept-warm model.
 func (s *Server) ListModels(_ context.Context, _ ListModelsRequestObject) (ListModelsResponseObject, error) {
 	catalog := s.modelRouter.GetCatalog()
-	result := make(ListModels200JSONResponse, 0, len(catalog))
+	models := make([]ModelSpec, 0, len(catalog))
 	for _, m := range catalog {
-		result = append(result, domainModelToAPI(m))
+		models = append(models, domainModelToAPI(m))
 	}
-	return result, nil
+	warmup := []ModelWarmStatus{}
+	if s.warmup != nil {
+		for _, st := range s.warmup.Status() {
+			warmup = append(warmup, modelWarmStatusToAPI(st))
+		}
+	}
+	return ListModels200JSONResponse{Models: models, Count: len(models), Warmup: warmup}, nil
 }
 
 // DiscoverModels runs model discovery against Ollama and/or LiteLLM.
@@ -57,13 +191,56 @@ func (s *Server) DiscoverModels(ctx context.Context, req DiscoverModelsRequestOb
 
 func domainModelToAPI(m domain.ModelSpec) ModelSpec {
 	role := ModelSpecRole(m.Role)
-	return ModelSpec{
-		Id:       strPtr(m.ID),
-		Name:     strPtr(m.Name),
-		Provider: strPtr(m.Provider),
-		Role:     &role,
-		Size:     strPtr(m.Size),
-		BaseUrl:  strPtr(m.BaseURL),
-		IsLocal:  &m.IsLocal,
+	out := ModelSpec{
+		Id:           strPtr(m.ID),
+		Name:         strPtr(m.Name),
+		Provider:     strPtr(m.Provider),
+		Role:         &role,
+		Size:         strPtr(m.Size),
+		BaseUrl:      strPtr(m.BaseURL),
+		IsLocal:      &m.IsLocal,
+		Family:       optString(m.Family),
+		Quantization: optString(m.Quantization),
+	}
+	if m.ContextTokens > 0 {
+		out.ContextTokens = &m.ContextTokens
+	}
+	if m.MaxContextTokens > 0 {
+		out.MaxContextTokens = &m.MaxContextTokens
+	}
+	if m.Vision {
+		out.Vision = &m.Vision
+	}
+	if m.Tools {
+		out.Tools = &m.Tools
+	}
+	if m.Embedding {
+		out.Embedding = &m.Embedding
+	}
+	if m.PromptCostPerMTok > 0 {
+		out.PromptCostPerMtok = &m.PromptCostPerMTok
+	}
+	if m.CompletionCostPerMTok > 0 {
+		out.CompletionCostPerMtok = &m.CompletionCostPerMTok
+	}
+	return out
+}
+
+func modelWarmStatusToAPI(st domain.ModelWarmStatus) ModelWarmStatus {
+	out := ModelWarmStatus{
+		Model: st.Model,
+		State: ModelWarmStatusState(st.State),
+		Error: optString(st.Error),
+	}
+	if !st.LoadedAt.IsZero() {
+		out.LoadedAt = &st.LoadedAt
+		out.LoadMs = &st.LoadMillis
+	}
+	if !st.LastPingAt.IsZero() {
+		out.LastPingAt = &st.LastPingAt
+	}
+	if !st.ExpiresAt.IsZero() {
+		out.ExpiresAt = &st.ExpiresAt
 	}
+	return out
 }
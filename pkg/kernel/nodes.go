@@ -0,0 +1,326 @@
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetNodeRegistry enables the /v1/nodes management API.
+func (s *Server) SetNodeRegistry(reg *services.NodeRegistry) {
+	s.nodeRegistry = reg
+}
+
+// --- Node registry API (kernel side) ---
+
+// ListNodes implements StrictServerInterface. It returns registered
+// muscle nodes.
+func (s *Server) ListNodes(ctx context.Context, request ListNodesRequestObject) (ListNodesResponseObject, error) {
+	if s.nodeRegistry == nil {
+		return ListNodes503JSONResponse{Error: toPtr("node federation not enabled")}, nil
+	}
+	nodes := s.nodeRegistry.List()
+	out := make([]Node, 0, len(nodes))
+	for _, n := range nodes {
+		out = append(out, nodeToAPI(n))
+	}
+	return ListNodes200JSONResponse{Nodes: out, Count: len(out)}, nil
+}
+
+// RegisterNode implements StrictServerInterface. It registers a remote
+// muscle node.
+func (s *Server) RegisterNode(ctx context.Context, request RegisterNodeRequestObject) (RegisterNodeResponseObject, error) {
+	if s.nodeRegistry == nil {
+		return RegisterNode503JSONResponse{Error: toPtr("node federation not enabled")}, nil
+	}
+	b := request.Body
+	url := strings.TrimSpace(b.Url)
+	if url == "" {
+		return RegisterNode400JSONResponse{Error: toPtr("url is required")}, nil
+	}
+	node, err := s.nodeRegistry.Register(ctx, domain.Node{
+		Name:   valueOf(b.Name),
+		URL:    url,
+		Labels: valueOf(b.Labels),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return RegisterNode201JSONResponse(nodeToAPI(node)), nil
+}
+
+// DeleteNode implements StrictServerInterface. It unregisters a node.
+func (s *Server) DeleteNode(ctx context.Context, request DeleteNodeRequestObject) (DeleteNodeResponseObject, error) {
+	if s.nodeRegistry == nil {
+		return DeleteNode503JSONResponse{Error: toPtr("node federation not enabled")}, nil
+	}
+	err := s.nodeRegistry.Remove(ctx, domain.NodeID(request.Id))
+	if errors.Is(err, domain.ErrNodeNotFound) {
+		return DeleteNode404JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return DeleteNode204Response{}, nil
+}
+
+func nodeToAPI(n domain.Node) Node {
+	labels := n.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return Node{
+		Id:        string(n.ID),
+		Name:      n.Name,
+		Url:       n.URL,
+		Labels:    labels,
+		Status:    NodeStatus(n.Status),
+		LastSeen:  n.LastSeen,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+// handleSubmitJobWithSelector accepts the regular job payload plus an optional
+// node_selector, so jobs can be pinned to labelled muscle nodes, and
+// security overrides for the worker's hardening, limited to what the
+// kernel's worker security policy allows.
+// POST /v1/jobs
+func (s *Server) handleSubmitJobWithSelector(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		JobRequest
+		NodeSelector map[string]string      `json:"node_selector"`
+		DependsOn    []domain.JobID         `json:"depends_on"`
+		Security     *domain.WorkerSecurity `json:"security"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	spec := domain.WorkerSpec{
+		Image:        body.Image,
+		Command:      body.Command,
+		Env:          make(map[string]string),
+		NodeSelector: body.NodeSelector,
+		Security:     body.Security,
+	}
+	if body.Env != nil {
+		for k, v := range *body.Env {
+			spec.Env[k] = v
+		}
+	}
+
+	jobID, err := s.lifecycle.SubmitJobWithDeps(r.Context(), spec, body.DependsOn)
+	if errors.Is(err, domain.ErrJobNotFound) || errors.Is(err, domain.ErrImageNotAllowed) ||
+		errors.Is(err, domain.ErrInvalidWorkerSecurity) {
+		http.Error(w, "Failed to submit job: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		s.logger.Error("failed to submit job", "error", err)
+		http.Error(w, "Failed to submit job: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	status := domain.JobStatusPending
+	if len(body.DependsOn) > 0 {
+		status = domain.JobStatusWaiting
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":     string(jobID),
+		"status": string(status),
+	})
+}
+
+// handleGetJobGraph returns the dependency DAG around a job.
+// GET /v1/jobs/{id}/graph
+func (s *Server) handleGetJobGraph(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/jobs/"), "/graph")
+	if id == "" || strings.Contains(id, "/") {
+		http.Error(w, "invalid job id", http.StatusBadRequest)
+		return
+	}
+	graph, err := s.lifecycle.JobGraph(r.Context(), domain.JobID(id))
+	if errors.Is(err, domain.ErrJobNotFound) {
+		http.Error(w, "job not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(graph)
+}
+
+// handleGetJobResult returns a job's structured output. Jobs stored before
+// structured results get one derived from their result URL.
+// GET /v1/jobs/{id}/result
+func (s *Server) handleGetJobResult(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/jobs/"), "/result")
+	if id == "" || strings.Contains(id, "/") {
+		http.Error(w, "invalid job id", http.StatusBadRequest)
+		return
+	}
+	job, err := s.repo.GetJob(r.Context(), domain.JobID(id))
+	if errors.Is(err, domain.ErrJobNotFound) {
+		http.Error(w, "job not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	res := job.StructuredResult()
+	if res == nil {
+		http.Error(w, "job has no result", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+// --- Muscle node API (node side) ---
+
+// NodeHandler exposes a local WorkerManager over HTTP so another kernel can
+// schedule workers here. Serve it on a dedicated mTLS listener.
+func NodeHandler(mgr ports.WorkerManager) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const prefix = "/v1/node/workers"
+		path := r.URL.Path
+
+		if r.Method == "GET" && path == "/v1/node/health" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+			return
+		}
+		if path == prefix {
+			switch r.Method {
+			case "GET":
+				workers, err := mgr.List(r.Context())
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				if workers == nil {
+					workers = []domain.Worker{}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(workers)
+				return
+			case "POST":
+				var spec domain.WorkerSpec
+				if err := json.NewDecoder(r.Body).Decode(&spec); err != nil {
+					http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+					return
+				}
+				// Placement already happened on the scheduling kernel.
+				spec.NodeSelector = nil
+				id, err := mgr.Spawn(r.Context(), spec)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(map[string]string{"id": string(id)})
+				return
+			}
+		}
+		if !strings.HasPrefix(path, prefix+"/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		rest := strings.TrimPrefix(path, prefix+"/")
+		idPart, action, _ := strings.Cut(rest, "/")
+		id := domain.WorkerID(idPart)
+		if idPart == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch {
+		case r.Method == "DELETE" && action == "":
+			if err := mgr.Kill(r.Context(), id); err != nil {
+				writeWorkerError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && action == "health":
+			status, err := mgr.HealthCheck(r.Context(), id)
+			if err != nil {
+				writeWorkerError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]string{"status": string(status)})
+		case r.Method == "GET" && action == "ip":
+			ip, err := mgr.GetWorkerIP(r.Context(), id)
+			if err != nil {
+				writeWorkerError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]string{"ip": ip})
+		case r.Method == "GET" && action == "inspect":
+			wi, ok := mgr.(ports.WorkerInspector)
+			if !ok {
+				http.Error(w, domain.ErrInspectUnsupported.Error(), http.StatusNotImplemented)
+				return
+			}
+			info, err := wi.Inspect(r.Context(), id)
+			if err != nil {
+				writeWorkerError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(info)
+		case r.Method == "GET" && action == "logs" && r.URL.Query().Has("tail"):
+			lr, ok := mgr.(ports.WorkerLogReader)
+			if !ok {
+				http.Error(w, domain.ErrLogTailUnsupported.Error(), http.StatusNotImplemented)
+				return
+			}
+			tail, _ := strconv.Atoi(r.URL.Query().Get("tail"))
+			lines, err := lr.TailLogs(r.Context(), id, tail)
+			if err != nil {
+				writeWorkerError(w, err)
+				return
+			}
+			if lines == nil {
+				lines = []domain.WorkerLogLine{}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(lines)
+		case r.Method == "GET" && action == "logs":
+			logs, err := mgr.GetLogs(r.Context(), id)
+			if err != nil {
+				writeWorkerError(w, err)
+				return
+			}
+			defer logs.Close()
+			w.Header().Set("Content-Type", "application/octet-stream")
+			io.Copy(w, logs)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func writeWorkerError(w http.ResponseWriter, err error) {
+	if errors.Is(err, domain.ErrWorkerNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
@@ -2,6 +2,10 @@ package kernel
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
@@ -45,6 +49,26 @@ func domainPersonaToAPI(p domain.Persona) Persona {
 	}
 }
 
+// personaModelResponse answers 422 when a persona's model_override is not
+// installed; the generated spec declares no such status.
+type personaModelResponse struct {
+	Error string `json:"error"`
+}
+
+func (response personaModelResponse) VisitCreatePersonaResponse(w http.ResponseWriter) error {
+	return response.write(w)
+}
+
+func (response personaModelResponse) VisitUpdatePersonaResponse(w http.ResponseWriter) error {
+	return response.write(w)
+}
+
+func (response personaModelResponse) write(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	return json.NewEncoder(w).Encode(response)
+}
+
 // ListPersonas implements StrictServerInterface
 func (s *Server) ListPersonas(ctx context.Context, _ ListPersonasRequestObject) (ListPersonasResponseObject, error) {
 	personas, err := s.repo.ListPersonas(ctx)
@@ -91,6 +115,9 @@ func (s *Server) CreatePersona(ctx context.Context, request CreatePersonaRequest
 	if request.Body.ModelOverride != nil {
 		p.ModelOverride = *request.Body.ModelOverride
 	}
+	if err := s.modelRouter.CheckModel(p.ModelOverride); err != nil {
+		return personaModelResponse{Error: err.Error()}, nil
+	}
 
 	if err := s.repo.CreatePersona(ctx, p); err != nil {
 		s.logger.Error("failed to create persona", "error", err)
@@ -141,7 +168,12 @@ func (s *Server) UpdatePersona(ctx context.Context, request UpdatePersonaRequest
 	if request.Body.AllowedTools != nil {
 		existing.AllowedTools = *request.Body.AllowedTools
 	}
-	if request.Body.ModelOverride != nil {
+	if request.Body.ModelOverride != nil && *request.Body.ModelOverride != existing.ModelOverride {
+		// Only a changed override is checked: an uninstalled one already
+		// saved falls back at run time and must not block other edits
+		if err := s.modelRouter.CheckModel(*request.Body.ModelOverride); err != nil {
+			return personaModelResponse{Error: err.Error()}, nil
+		}
 		existing.ModelOverride = *request.Body.ModelOverride
 	}
 	existing.UpdatedAt = time.Now()
@@ -174,6 +206,55 @@ func (s *Server) DeletePersona(ctx context.Context, request DeletePersonaRequest
 	return DeletePersona204Response{}, nil
 }
 
+// personaLocaleID extracts the persona ID from /v1/personas/{id}/locale.
+func personaLocaleID(path string) (string, bool) {
+	const prefix, suffix = "/v1/personas/", "/locale"
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+		return "", false
+	}
+	id := path[len(prefix) : len(path)-len(suffix)]
+	return id, id != "" && !strings.Contains(id, "/")
+}
+
+// handlePersonaLocale reads or sets the language a persona answers in.
+// GET /v1/personas/{id}/locale
+// PUT /v1/personas/{id}/locale  Body: {"locale": "pt-BR"} ("" = the user's or global locale)
+func (s *Server) handlePersonaLocale(w http.ResponseWriter, r *http.Request) {
+	id, _ := personaLocaleID(r.URL.Path)
+	p, err := s.repo.GetPersona(r.Context(), domain.PersonaID(id))
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonaNotFound) {
+			http.Error(w, "persona not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if r.Method == "PUT" {
+		var body struct {
+			Locale string `json:"locale"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		locale, err := domain.ParseLocale(body.Locale)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		p.Locale = locale
+		p.UpdatedAt = time.Now()
+		if err := s.repo.UpdatePersona(r.Context(), p); err != nil {
+			s.logger.Error("failed to set persona locale", "error", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"persona_id": string(p.ID), "locale": p.Locale})
+}
+
 // strPtr is a helper to create a *string from a string literal
 func strPtr(s string) *string {
 	return &s
@@ -2,9 +2,15 @@ package kernel
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
 )
 
 // --- StrictServerInterface implementations for Projects ---
@@ -130,6 +136,176 @@ func (s *Server) ListProjectArtifacts(ctx context.Context, request ListProjectAr
 	return result, nil
 }
 
+// --- Project settings & project-scoped conversations (custom routes) ---
+
+// projectSubresourceID extracts {id} from /v1/projects/{id}/<suffix>.
+func projectSubresourceID(path, suffix string) (string, bool) {
+	const prefix = "/v1/projects/"
+	suffix = "/" + suffix
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+		return "", false
+	}
+	id := path[len(prefix) : len(path)-len(suffix)]
+	return id, id != "" && !strings.Contains(id, "/")
+}
+
+// handleGetProjectSettings returns the per-project defaults.
+// GET /v1/projects/{id}/settings
+func (s *Server) handleGetProjectSettings(w http.ResponseWriter, r *http.Request) {
+	id, _ := projectSubresourceID(r.URL.Path, "settings")
+	proj, err := s.repo.GetProject(r.Context(), domain.ProjectID(id))
+	if err != nil {
+		if err == domain.ErrProjectNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		s.logger.Error("failed to get project settings", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(proj.Settings)
+}
+
+// handleUpdateProjectSettings replaces the per-project defaults.
+// PUT /v1/projects/{id}/settings
+// Body: {"default_persona_id": "...", "default_model": "...", "allowed_tools": [...], "heartbeat_interval": 600, "memory_scope": "persona",
+// "image_workflows": [{"name": "sdxl-lora", "graph": {...}, "defaults": {"width": 1024}, "output_node": "9"}],
+// "build": {"command": "go test ./...", "image": "golang:1.25", "timeout_seconds": 600, "max_cycles": 5},
+// "moderation": {"input": "block", "output": "warn"},
+// "mounts": [{"name": "specs", "host_path": "/home/me/Documents/specs"}]}
+func (s *Server) handleUpdateProjectSettings(w http.ResponseWriter, r *http.Request) {
+	id, _ := projectSubresourceID(r.URL.Path, "settings")
+
+	var settings domain.ProjectSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if settings.HeartbeatInterval < 0 {
+		http.Error(w, "heartbeat_interval must be >= 0", http.StatusBadRequest)
+		return
+	}
+	if !settings.MemoryScope.Valid() {
+		http.Error(w, "memory_scope must be shared, persona or isolated", http.StatusBadRequest)
+		return
+	}
+	if b := settings.Build; b != nil {
+		if strings.TrimSpace(b.Command) == "" {
+			http.Error(w, "build.command is required", http.StatusBadRequest)
+			return
+		}
+		if b.TimeoutSeconds < 0 || b.MaxCycles < 0 || b.MaxCycles > domain.MaxBuildCycles {
+			http.Error(w, fmt.Sprintf("build.timeout_seconds must be >= 0 and build.max_cycles between 0 and %d", domain.MaxBuildCycles), http.StatusBadRequest)
+			return
+		}
+	}
+	if m := settings.Moderation; m != nil {
+		if err := m.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	workflowNames := map[string]bool{}
+	for _, wf := range settings.ImageWorkflows {
+		if err := wf.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if workflowNames[wf.Name] {
+			http.Error(w, "duplicate image workflow name: "+wf.Name, http.StatusBadRequest)
+			return
+		}
+		workflowNames[wf.Name] = true
+	}
+	mountNames := map[string]bool{}
+	for _, m := range settings.Mounts {
+		if err := m.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if mountNames[m.Name] {
+			http.Error(w, "duplicate mount name: "+m.Name, http.StatusBadRequest)
+			return
+		}
+		mountNames[m.Name] = true
+		if info, err := os.Stat(m.HostPath); err != nil || !info.IsDir() {
+			http.Error(w, "mount "+m.Name+": host_path is not a directory: "+m.HostPath, http.StatusBadRequest)
+			return
+		}
+	}
+	if settings.DefaultPersonaID != nil {
+		if *settings.DefaultPersonaID == "" {
+			settings.DefaultPersonaID = nil
+		} else if _, err := s.repo.GetPersona(r.Context(), *settings.DefaultPersonaID); err != nil {
+			http.Error(w, "unknown default_persona_id: "+string(*settings.DefaultPersonaID), http.StatusBadRequest)
+			return
+		}
+	}
+
+	proj, err := s.repo.GetProject(r.Context(), domain.ProjectID(id))
+	if err != nil {
+		if err == domain.ErrProjectNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		s.logger.Error("failed to get project", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	proj.Settings = settings
+	proj.UpdatedAt = time.Now()
+	if err := s.repo.UpdateProject(r.Context(), proj); err != nil {
+		s.logger.Error("failed to update project settings", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(proj.Settings)
+}
+
+// handleCreateProjectConversation creates a conversation inside a project,
+// applying the project's default persona when none is given.
+// POST /v1/projects/{id}/conversations
+// Body: {"title": "...", "persona_id": "..."} (both optional)
+func (s *Server) handleCreateProjectConversation(w http.ResponseWriter, r *http.Request) {
+	id, _ := projectSubresourceID(r.URL.Path, "conversations")
+
+	var body struct {
+		Title     string `json:"title"`
+		PersonaID string `json:"persona_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err.Error() != "EOF" {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if body.Title == "" {
+		body.Title = "New Chat"
+	}
+	var personaID *domain.PersonaID
+	if body.PersonaID != "" {
+		pid := domain.PersonaID(body.PersonaID)
+		personaID = &pid
+	}
+
+	conv, err := s.convStore.CreateProjectConversation(r.Context(), body.Title, domain.ProjectID(id), personaID)
+	if err != nil {
+		if err == domain.ErrProjectNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		s.logger.Error("failed to create project conversation", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(domainConvToAPI(conv))
+}
+
 // --- StrictServerInterface implementations for Artifacts ---
 
 // ListArtifacts implements StrictServerInterface.
@@ -186,6 +362,79 @@ func (s *Server) DeleteArtifact(ctx context.Context, request DeleteArtifactReque
 	return DeleteArtifact204Response{}, nil
 }
 
+// SetArtifactDiscussion enables POST /v1/artifacts/{id}/discuss.
+func (s *Server) SetArtifactDiscussion(d *services.ArtifactDiscussion) {
+	s.discuss = d
+}
+
+// artifactSubresourceID extracts {id} from /v1/artifacts/{id}/<suffix>.
+func artifactSubresourceID(path, suffix string) (string, bool) {
+	const prefix = "/v1/artifacts/"
+	suffix = "/" + suffix
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+		return "", false
+	}
+	id := path[len(prefix) : len(path)-len(suffix)]
+	return id, id != "" && !strings.Contains(id, "/")
+}
+
+// handleDiscussArtifact opens an artifact in a new conversation whose
+// context describes it (with a preview for text), copying the file into
+// the project workspace so the agent can read it.
+// POST /v1/artifacts/{id}/discuss
+// Body: {"project_id"?: "...", "persona_id"?: "...", "title"?: "..."}
+func (s *Server) handleDiscussArtifact(w http.ResponseWriter, r *http.Request) {
+	if s.discuss == nil {
+		http.Error(w, "artifact discussions not configured", http.StatusServiceUnavailable)
+		return
+	}
+	id, _ := artifactSubresourceID(r.URL.Path, "discuss")
+
+	var body struct {
+		ProjectID string `json:"project_id"`
+		PersonaID string `json:"persona_id"`
+		Title     string `json:"title"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err.Error() != "EOF" {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	var personaID *domain.PersonaID
+	if body.PersonaID != "" {
+		pid := domain.PersonaID(body.PersonaID)
+		personaID = &pid
+	}
+
+	art, err := s.repo.GetArtifact(r.Context(), domain.ArtifactID(id))
+	if err != nil {
+		if err == domain.ErrArtifactNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		s.logger.Error("failed to get artifact", "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	conv, err := s.discuss.Start(r.Context(), art, domain.ProjectID(body.ProjectID), personaID, body.Title)
+	if err != nil {
+		if err == domain.ErrProjectNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		s.logger.Error("failed to open artifact discussion", "artifact_id", id, "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"conversation": domainConvToAPI(conv),
+		"context":      conv.Context,
+	})
+}
+
 // --- Mapping helpers ---
 
 func domainProjectToAPI(p domain.Project) Project {
@@ -0,0 +1,208 @@
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+)
+
+// APIKeyHeader identifies a client for rate limiting. "Authorization:
+// Bearer <key>" is accepted too. Only keys with their own limits get their
+// own buckets; every other client is limited by IP.
+const APIKeyHeader = "X-API-Key"
+
+type clientCtxKey struct{}
+
+// RateLimiter wraps a handler with token-bucket limits per client and route
+// class. Limits can be replaced at runtime; with none configured every
+// request passes. Responses carry RateLimit-Limit, RateLimit-Remaining and
+// RateLimit-Reset headers, and rejected requests get 429 with Retry-After.
+type RateLimiter struct {
+	next http.Handler
+	now  func() time.Time
+
+	mu      sync.Mutex
+	classes map[string]domain.RateLimit
+	keys    map[string]map[string]domain.RateLimit
+	buckets map[bucketKey]*tokenBucket
+	calls   int
+}
+
+type bucketKey struct{ client, class string }
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter wraps next without limits; see SetLimits.
+func NewRateLimiter(next http.Handler) *RateLimiter {
+	return &RateLimiter{next: next, now: time.Now, buckets: make(map[bucketKey]*tokenBucket)}
+}
+
+// SetLimits replaces the per-class limits and the per-key overrides.
+// Buckets restart full.
+func (l *RateLimiter) SetLimits(classes map[string]domain.RateLimit, keys map[string]map[string]domain.RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.classes, l.keys = classes, keys
+	l.buckets = make(map[bucketKey]*tokenBucket)
+}
+
+func (l *RateLimiter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	class := routeClass(r)
+	key, client := l.clientKey(r)
+	r = r.WithContext(context.WithValue(r.Context(), clientCtxKey{}, client))
+	limit, ok := l.limitFor(key, class)
+	if !ok {
+		l.next.ServeHTTP(w, r)
+		return
+	}
+
+	remaining, wait, reset := l.take(bucketKey{client, class}, limit)
+	h := w.Header()
+	h.Set("RateLimit-Limit", strconv.Itoa(limit.PerMinute))
+	h.Set("RateLimit-Remaining", strconv.Itoa(remaining))
+	h.Set("RateLimit-Reset", strconv.Itoa(seconds(reset)))
+	if wait > 0 {
+		h.Set("Retry-After", strconv.Itoa(seconds(wait)))
+		h.Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error":       "rate limit exceeded",
+			"class":       class,
+			"retry_after": seconds(wait),
+		})
+		return
+	}
+	l.next.ServeHTTP(w, r)
+}
+
+// limitFor returns the limit of a class, preferring the API key's override.
+func (l *RateLimiter) limitFor(key, class string) (domain.RateLimit, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if key != "" {
+		if limit, ok := l.keys[key][class]; ok {
+			return limit, true
+		}
+	}
+	limit, ok := l.classes[class]
+	return limit, ok
+}
+
+// take spends one token. It returns the whole tokens left, how long to wait
+// when none was available, and how long until the bucket is full again.
+func (l *RateLimiter) take(k bucketKey, limit domain.RateLimit) (remaining int, wait, reset time.Duration) {
+	capacity := float64(limit.Burst)
+	if capacity <= 0 {
+		capacity = float64(limit.PerMinute)
+	}
+	perSec := float64(limit.PerMinute) / 60
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls++
+	if l.calls%1024 == 0 {
+		l.prune(now, capacity, perSec)
+	}
+	b, ok := l.buckets[k]
+	if !ok {
+		b = &tokenBucket{tokens: capacity, last: now}
+		l.buckets[k] = b
+	}
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.last).Seconds()*perSec)
+	b.last = now
+	if b.tokens < 1 {
+		wait = time.Duration((1 - b.tokens) / perSec * float64(time.Second))
+	} else {
+		b.tokens--
+	}
+	reset = time.Duration((capacity - b.tokens) / perSec * float64(time.Second))
+	return int(b.tokens), wait, reset
+}
+
+// prune drops buckets that have refilled, which behave like new ones. The
+// caller holds l.mu.
+func (l *RateLimiter) prune(now time.Time, capacity, perSec float64) {
+	for k, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*perSec >= capacity {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// seconds rounds a duration up to whole seconds for the RateLimit headers.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// clientKey identifies the caller: its API key when the key has limits of
+// its own, otherwise its IP address. The kernel doesn't authenticate keys,
+// so an unknown key must not buy a fresh bucket.
+func (l *RateLimiter) clientKey(r *http.Request) (key, client string) {
+	key = r.Header.Get(APIKeyHeader)
+	if key == "" {
+		if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			key = strings.TrimSpace(token)
+		}
+	}
+	if key != "" {
+		l.mu.Lock()
+		_, known := l.keys[key]
+		l.mu.Unlock()
+		if known {
+			return key, "key:" + key
+		}
+	}
+	return "", clientIP(r)
+}
+
+// clientID returns the client the rate limiter identified for r, or the
+// caller's IP when the request didn't pass through one.
+func clientID(r *http.Request) string {
+	if client, ok := r.Context().Value(clientCtxKey{}).(string); ok {
+		return client
+	}
+	return clientIP(r)
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// routeClass groups routes by the resource they load: chat drives the LLM,
+// jobs drive Docker, admin changes the kernel itself.
+func routeClass(r *http.Request) string {
+	p := r.URL.Path
+	switch {
+	case strings.HasPrefix(p, "/v1/agent/"),
+		strings.HasPrefix(p, "/v1/ide/"),
+		r.Method != http.MethodGet && strings.HasPrefix(p, "/v1/conversations/") && strings.Contains(p, "/messages"),
+		r.Method == http.MethodPost && strings.HasPrefix(p, "/v1/traces/") && strings.HasSuffix(p, "/replay"):
+		return domain.RouteClassChat
+	case p == "/v1/jobs" || strings.HasPrefix(p, "/v1/jobs/"),
+		r.Method == http.MethodPost && strings.HasPrefix(p, "/v1/workflows/") && (strings.HasSuffix(p, "/run") || strings.HasSuffix(p, "/resume")),
+		r.Method == http.MethodPost && strings.HasPrefix(p, "/v1/tools/") && strings.HasSuffix(p, "/run"):
+		return domain.RouteClassJobs
+	}
+	for _, prefix := range []string{"/v1/settings", "/v1/system/", "/v1/metrics/", "/v1/nodes", "/v1/llm/", "/v1/usage/", "/v1/capabilities/"} {
+		if strings.HasPrefix(p, prefix) {
+			return domain.RouteClassAdmin
+		}
+	}
+	return domain.RouteClassDefault
+}
@@ -0,0 +1,62 @@
+package kernel
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// RequestIDHeader carries the correlation ID of an API request. Clients may
+// supply one; otherwise the kernel generates it. It is echoed on every response.
+const RequestIDHeader = "X-Request-ID"
+
+const maxRequestIDLen = 128
+
+// withRequestID accepts or generates the request's correlation ID, echoes it
+// on the response and attaches it to the request context.
+func withRequestID(w http.ResponseWriter, r *http.Request) *http.Request {
+	id := r.Header.Get(RequestIDHeader)
+	if !validRequestID(id) {
+		id = uuid.New().String()
+	}
+	w.Header().Set(RequestIDHeader, id)
+	return r.WithContext(domain.WithRequestID(r.Context(), id))
+}
+
+// validRequestID rejects empty, oversized or non-printable client IDs so they
+// are safe to log and echo back.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if c := id[i]; c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// sseData returns the SSE payload of evt. JSON object payloads of events
+// caused by an API request gain a "request_id" field.
+func sseData(evt services.Event) string {
+	if evt.RequestID == "" || len(evt.Data) == 0 || evt.Data[0] != '{' {
+		return evt.Data
+	}
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(evt.Data), &payload); err != nil {
+		return evt.Data
+	}
+	if _, ok := payload["request_id"]; ok {
+		return evt.Data
+	}
+	payload["request_id"], _ = json.Marshal(evt.RequestID)
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return evt.Data
+	}
+	return string(data)
+}
@@ -3,15 +3,20 @@ package kernel
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"os"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/manthysbr/auleOS/internal/adapters/llm"
 	"github.com/manthysbr/auleOS/internal/config"
 	"github.com/manthysbr/auleOS/internal/core/domain"
 	"github.com/manthysbr/auleOS/internal/core/services"
@@ -32,9 +37,36 @@ type Server struct {
 	workflowExec *services.WorkflowExecutor
 	tracer       *services.TraceCollector
 	toolRegistry *domain.ToolRegistry
-	systemChat   *services.SystemChat // optional proactive notification channel
+	systemChat   *services.SystemChat         // optional proactive notification channel
+	nodeRegistry *services.NodeRegistry       // optional remote muscle node federation
+	evals        *services.EvalService        // optional agent evaluation suites
+	llmCache     *services.LLMCache           // optional deterministic LLM response cache
+	llmLimiters  []*llm.RequestLimiter        // per-provider request queues (metrics)
+	maintenance  *services.MaintenanceMode    // optional system-wide pause switch
+	logBuffer    *services.LogBuffer          // optional in-memory kernel log history
+	resources    *services.ResourceMonitor    // optional host resource sampling
+	warmup       *services.ModelWarmup        // optional local model preloading
+	installer    *services.ModelInstaller     // optional model downloads
+	feeds        *services.FeedMonitor        // optional RSS/Atom feed triggers
+	fileTriggers *services.FileTriggerService // optional file-system watch triggers
+	automations  *services.AutomationService  // optional trigger/condition/action rules
+	usage        *services.UsageMeter         // optional token/cost accounting and limits
+	toolPolicy   *services.ToolPolicy         // optional; tool-name correction metrics
+	maxBodyBytes int64                        // request body cap, see SetMaxBodyBytes
+	sse          *sseHub                      // open event streams and their limits
+	ide          *services.IDECompanion       // optional editor plugin endpoint
+	buildLoops   *services.BuildLoopService   // optional edit/build/fix loops
+	discuss      *services.ArtifactDiscussion // optional "open artifact with agent"
+	replayer     *services.TraceReplayer      // optional session replay of agent runs
+	audit        *services.WorkspaceAudit     // optional git history of agent edits
+	templates    *services.TemplateGallery    // optional starter templates
+	cleanup      *services.CleanupService     // optional bulk deletions
+	clarifier    *services.Clarifier          // optional ask_user questions
+	build        domain.BuildInfo             // see SetBuildInfo
+	updates      *services.UpdateChecker      // optional release checks
 	workerMgr    interface {
 		GetLogs(ctx context.Context, id domain.WorkerID) (io.ReadCloser, error)
+		Kill(ctx context.Context, id domain.WorkerID) error
 	}
 	repo interface {
 		GetJob(ctx context.Context, id domain.JobID) (domain.Job, error)
@@ -66,7 +98,11 @@ type Server struct {
 		ListScheduledTasks(ctx context.Context) ([]domain.ScheduledTask, error)
 		DeleteScheduledTask(ctx context.Context, id domain.ScheduledTaskID) error
 		// Workers
+		GetWorker(ctx context.Context, id domain.WorkerID) (domain.Worker, error)
 		ListWorkers(ctx context.Context) ([]domain.Worker, error)
+		UpdateWorkerStatus(ctx context.Context, id domain.WorkerID, status domain.HealthStatus) error
+		// Sub-agents
+		ListConversationSubAgents(ctx context.Context, convID domain.ConversationID) ([]domain.SubAgentTask, error)
 	}
 }
 
@@ -89,6 +125,7 @@ func NewServer(
 	toolRegistry *domain.ToolRegistry,
 	workerMgr interface {
 		GetLogs(ctx context.Context, id domain.WorkerID) (io.ReadCloser, error)
+		Kill(ctx context.Context, id domain.WorkerID) error
 	},
 	repo interface {
 		GetJob(ctx context.Context, id domain.JobID) (domain.Job, error)
@@ -120,9 +157,15 @@ func NewServer(
 		ListScheduledTasks(ctx context.Context) ([]domain.ScheduledTask, error)
 		DeleteScheduledTask(ctx context.Context, id domain.ScheduledTaskID) error
 		// Workers
+		GetWorker(ctx context.Context, id domain.WorkerID) (domain.Worker, error)
 		ListWorkers(ctx context.Context) ([]domain.Worker, error)
+		UpdateWorkerStatus(ctx context.Context, id domain.WorkerID, status domain.HealthStatus) error
+		// Sub-agents
+		ListConversationSubAgents(ctx context.Context, convID domain.ConversationID) ([]domain.SubAgentTask, error)
 	}) *Server {
 	return &Server{
+		maxBodyBytes: DefaultMaxBodyBytes,
+		sse:          newSSEHub(),
 		logger:       logger,
 		lifecycle:    lifecycle,
 		reactAgent:   reactAgent,
@@ -155,72 +198,293 @@ func (s *Server) Handler() http.Handler {
 	strictHandler := NewStrictHandler(s, nil)
 	HandlerFromMux(strictHandler, mux)
 
+	validator, err := newRequestValidator()
+	if err != nil {
+		s.logger.Warn("request validation disabled", "error", err)
+	}
+
 	// Wrap with SSE interceptor — our raw HTTP handler takes priority
 	// over the generated strict handler for the SSE endpoint.
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Correlate everything this request triggers: logs, traces, jobs, events
+		r = withRequestID(w, r)
+		// Tag repository queries with the API area that issued them
+		r = r.WithContext(domain.WithSubsystem(r.Context(), apiSubsystem(r.URL.Path)))
+		// Reject oversized bodies and requests that do not match the spec
+		if !validator.validate(w, r, s.maxBodyBytes) {
+			return
+		}
+
+		// Maintenance mode: refuse new work while paused
+		if s.maintenance.Paused() && startsNewWork(r) {
+			s.writeMaintenance(w)
+			return
+		}
+		if r.Method == "GET" && isJobStreamPath(r.URL.Path) {
+			s.handleJobSSE(w, r)
+			return
+		}
 		// Intercept SSE endpoint for conversation events
 		if r.Method == "GET" && isConversationEventsPath(r.URL.Path) {
 			s.handleConversationSSE(w, r)
 			return
 		}
+		// Conversation organization: tags, pinning, folders (extends the generated CRUD)
+		if r.Method == "GET" && r.URL.Path == "/v1/conversations" {
+			s.handleListConversationsFiltered(w, r)
+			return
+		}
+		if r.Method == "GET" && r.URL.Path == "/v1/conversations/facets" {
+			s.handleConversationFacets(w, r)
+			return
+		}
+		if isConversationPath(r.URL.Path) {
+			switch r.Method {
+			case "GET":
+				s.handleGetConversationFull(w, r)
+				return
+			case "PATCH":
+				s.handlePatchConversation(w, r)
+				return
+			}
+		}
+		// Persona language (not part of the generated Persona)
+		if _, ok := personaLocaleID(r.URL.Path); ok && (r.Method == "GET" || r.Method == "PUT") {
+			s.handlePersonaLocale(w, r)
+			return
+		}
+		// Editor plugins: instruction in, unified diff out
+		if r.Method == "POST" && r.URL.Path == "/v1/ide/edit" {
+			s.handleIDEEdit(w, r)
+			return
+		}
+		// Client-supplied context variables
+		if _, ok := conversationSubresourceID(r.URL.Path, "context"); ok {
+			switch r.Method {
+			case "GET":
+				s.handleGetConversationContext(w, r)
+				return
+			case "PUT":
+				s.handlePutConversationContext(w, r)
+				return
+			}
+		}
+		// Abort the agent run in progress
+		if _, ok := conversationSubresourceID(r.URL.Path, "cancel"); ok && r.Method == "POST" {
+			s.handleCancelConversationRun(w, r)
+			return
+		}
+		// Tone, verbosity and language preferences
+		if _, ok := conversationSubresourceID(r.URL.Path, "style"); ok {
+			switch r.Method {
+			case "GET":
+				s.handleGetConversationStyle(w, r)
+				return
+			case "PUT":
+				s.handlePutConversationStyle(w, r)
+				return
+			}
+		}
+		// Clarifying question the agent is waiting on (ask_user)
+		if _, ok := conversationSubresourceID(r.URL.Path, "question"); ok {
+			switch r.Method {
+			case "GET":
+				s.handleGetConversationQuestion(w, r)
+				return
+			case "POST":
+				s.handleAnswerConversationQuestion(w, r)
+				return
+			}
+		}
+		// Cursor-paginated message history (extends the generated ListMessages)
+		if _, ok := conversationSubresourceID(r.URL.Path, "messages"); ok && r.Method == "GET" {
+			s.handleListMessagesPage(w, r)
+			return
+		}
+		// Regenerating replies and editing the last user message
+		if convID, msgID, action := conversationMessagePath(r.URL.Path); convID != "" && msgID != "" {
+			if r.Method == "POST" && action == "regenerate" {
+				s.handleRegenerateMessage(w, r)
+				return
+			}
+			if r.Method == "PATCH" && action == "" {
+				s.handleEditMessage(w, r)
+				return
+			}
+			if action == "feedback" {
+				switch r.Method {
+				case "POST", "PUT":
+					s.handleRateMessage(w, r)
+					return
+				case "GET":
+					s.handleGetMessageFeedback(w, r)
+					return
+				}
+			}
+		}
+		if r.Method == "GET" && r.URL.Path == "/v1/feedback/export" {
+			s.handleExportFeedback(w, r)
+			return
+		}
 		// Intercept SSE endpoint for workflow events
 		if r.Method == "GET" && isWorkflowEventsPath(r.URL.Path) {
 			s.handleWorkflowSSE(w, r)
 			return
 		}
+		// Streaming chat — SSE tokens while the agent works
+		if r.Method == "POST" && r.URL.Path == "/v1/agent/chat/stream" {
+			s.handleChatStream(w, r)
+			return
+		}
 		// Intercept SSE endpoint for broadcast/global agent events
 		if r.Method == "GET" && r.URL.Path == "/v1/events" {
 			s.handleBroadcastSSE(w, r)
 			return
 		}
-		// Tracing API — Genkit-style observability
-		if r.Method == "GET" && r.URL.Path == "/v1/traces" {
-			s.handleListTraces(w, r)
+		// Trace replay and provider captures
+		if r.Method == "POST" && strings.HasPrefix(r.URL.Path, "/v1/traces/") && strings.HasSuffix(r.URL.Path, "/replay") {
+			s.handleReplayTrace(w, r)
 			return
 		}
-		if r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/v1/traces/") {
-			s.handleGetTrace(w, r)
+		if r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/v1/traces/") && strings.HasSuffix(r.URL.Path, "/captures") {
+			s.handleListProviderCaptures(w, r)
 			return
 		}
-		// Scheduled Tasks API
-		if r.Method == "GET" && r.URL.Path == "/v1/tasks" {
-			s.handleListTasks(w, r)
+		if r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/v1/plugins/") && strings.HasSuffix(r.URL.Path, "/hosts") {
+			s.handleApprovePluginHosts(w, r)
 			return
 		}
-		if r.Method == "POST" && r.URL.Path == "/v1/tasks" {
-			s.handleCreateTask(w, r)
+		if r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/v1/plugins/") && strings.HasSuffix(r.URL.Path, "/stats") {
+			s.handlePluginStats(w, r)
 			return
 		}
-		if r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/v1/tasks/") && !strings.Contains(strings.TrimPrefix(r.URL.Path, "/v1/tasks/"), "/") {
-			s.handleDeleteTask(w, r)
+		// Sub-agent delegation tree for a conversation
+		if r.Method == "GET" && isConversationSubAgentsPath(r.URL.Path) {
+			s.handleListConversationSubAgents(w, r)
 			return
 		}
-		if r.Method == "POST" && strings.HasPrefix(r.URL.Path, "/v1/tasks/") && strings.HasSuffix(r.URL.Path, "/toggle") {
-			s.handleToggleTask(w, r)
+		// Project settings & project-scoped conversation creation
+		if _, ok := projectSubresourceID(r.URL.Path, "settings"); ok {
+			switch r.Method {
+			case "GET":
+				s.handleGetProjectSettings(w, r)
+				return
+			case "PUT":
+				s.handleUpdateProjectSettings(w, r)
+				return
+			}
+		}
+		// Git history of agent edits in the project workspace
+		if id, _ := projectHistoryPath(r.URL.Path); id != "" && r.Method == "GET" {
+			s.handleWorkspaceHistory(w, r)
 			return
 		}
-		// Workers API
-		if r.Method == "GET" && r.URL.Path == "/v1/workers" {
-			s.handleListWorkers(w, r)
+		if _, ok := projectSubresourceID(r.URL.Path, "conversations"); ok && r.Method == "POST" {
+			s.handleCreateProjectConversation(w, r)
 			return
 		}
-		// Models API
-		if r.Method == "GET" && r.URL.Path == "/v1/models" {
-			s.handleListModels(w, r)
+		// Open an artifact in a new conversation
+		if _, ok := artifactSubresourceID(r.URL.Path, "discuss"); ok && r.Method == "POST" {
+			s.handleDiscussArtifact(w, r)
 			return
 		}
-		// Tools API — list and execute
-		if r.Method == "GET" && r.URL.Path == "/v1/tools" {
-			s.handleListTools(w, r)
+		// Build/test feedback loops
+		if _, ok := projectSubresourceID(r.URL.Path, "build-loops"); ok {
+			switch r.Method {
+			case "GET":
+				s.handleListBuildLoops(w, r)
+				return
+			case "POST":
+				s.handleStartBuildLoop(w, r)
+				return
+			}
+		}
+		if strings.HasPrefix(r.URL.Path, "/v1/build-loops/") {
+			s.handleBuildLoop(w, r)
+			return
+		}
+		// Jobs with node placement (extends the generated SubmitJob)
+		if r.Method == "POST" && r.URL.Path == "/v1/jobs" {
+			s.handleSubmitJobWithSelector(w, r)
+			return
+		}
+		if r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/v1/jobs/") && strings.HasSuffix(r.URL.Path, "/graph") {
+			s.handleGetJobGraph(w, r)
+			return
+		}
+		if r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/v1/jobs/") && strings.HasSuffix(r.URL.Path, "/result") {
+			s.handleGetJobResult(w, r)
+			return
+		}
+		// File Triggers API — watched workspace directories
+		if r.URL.Path == "/v1/file-triggers" || strings.HasPrefix(r.URL.Path, "/v1/file-triggers/") {
+			s.handleFileTriggers(w, r)
+			return
+		}
+		// Automation webhooks take arbitrary payloads, so they stay outside the spec
+		if r.Method == "POST" && isAutomationWebhookPath(r.URL.Path) {
+			s.handleAutomationWebhook(w, r)
+			return
+		}
+		// Usage API — per-conversation and per-project token/cost limits
+		if strings.HasPrefix(r.URL.Path, "/v1/usage/") {
+			s.handleUsage(w, r)
+			return
+		}
+		// Inbound hooks addressed by token (/v1/hooks/{token}), same as above
+		if r.Method == "POST" && strings.HasPrefix(r.URL.Path, "/v1/hooks/") {
+			s.handleFireHook(w, r)
+			return
+		}
+		// Templates API — starter personas and workflows
+		// Bulk cleanup — dry run, then confirm with the returned token
+		if strings.HasPrefix(r.URL.Path, "/v1/cleanup/") {
+			s.handleCleanup(w, r)
+			return
+		}
+		if r.URL.Path == "/v1/templates" || strings.HasPrefix(r.URL.Path, "/v1/templates/") {
+			s.handleTemplates(w, r)
+			return
+		}
+		// Repository query timings and slow-query log
+		if r.URL.Path == "/v1/metrics/repository" {
+			switch r.Method {
+			case "GET":
+				s.handleRepositoryMetrics(w, r)
+				return
+			case "DELETE":
+				s.handleResetRepositoryMetrics(w, r)
+				return
+			}
+		}
+		if r.Method == "GET" && r.URL.Path == "/v1/metrics/tools" {
+			s.handleToolNameMetrics(w, r)
 			return
 		}
-		if r.Method == "POST" && strings.HasPrefix(r.URL.Path, "/v1/tools/") && strings.HasSuffix(r.URL.Path, "/run") {
-			s.handleRunTool(w, r)
+		if r.Method == "GET" && r.URL.Path == "/v1/metrics/events" {
+			s.handleEventBusMetrics(w, r)
 			return
 		}
-		// System inbox — kernel proactive notification channel
-		if r.Method == "GET" && r.URL.Path == "/v1/system/inbox" {
-			s.handleKernelInbox(w, r)
+		if r.Method == "GET" && r.URL.Path == "/v1/metrics/conversations" {
+			s.handleConversationCacheMetrics(w, r)
+			return
+		}
+		// LLM response cache — metrics and purge
+		if r.Method == "GET" && r.URL.Path == "/v1/llm/cache" {
+			s.handleLLMCacheStats(w, r)
+			return
+		}
+		if r.Method == "DELETE" && r.URL.Path == "/v1/llm/cache" {
+			s.handlePurgeLLMCache(w, r)
+			return
+		}
+		if r.Method == "GET" && r.URL.Path == "/v1/llm/queues" {
+			s.handleLLMQueues(w, r)
+			return
+		}
+		// Models API
+		if r.Method == "POST" && r.URL.Path == "/v1/models/pull" {
+			s.handlePullModel(w, r)
 			return
 		}
 		mux.ServeHTTP(w, r)
@@ -239,7 +503,29 @@ func isConversationEventsPath(path string) bool {
 	return len(middle) > 0 && !strings.Contains(middle, "/")
 }
 
+// isConversationSubAgentsPath checks if an URL path matches /v1/conversations/{id}/subagents
+func isConversationSubAgentsPath(path string) bool {
+	const prefix = "/v1/conversations/"
+	const suffix = "/subagents"
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+		return false
+	}
+	middle := path[len(prefix) : len(path)-len(suffix)]
+	return len(middle) > 0 && !strings.Contains(middle, "/")
+}
+
 // isWorkflowEventsPath checks if an URL path matches /v1/workflows/{id}/events
+// isJobStreamPath matches /v1/jobs/{id}/stream.
+func isJobStreamPath(path string) bool {
+	const prefix = "/v1/jobs/"
+	const suffix = "/stream"
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+		return false
+	}
+	middle := path[len(prefix) : len(path)-len(suffix)]
+	return len(middle) > 0 && !strings.Contains(middle, "/")
+}
+
 func isWorkflowEventsPath(path string) bool {
 	const prefix = "/v1/workflows/"
 	const suffix = "/events"
@@ -269,6 +555,10 @@ func (s *Server) SubmitJob(ctx context.Context, request SubmitJobRequestObject)
 	}
 
 	jobID, err := s.lifecycle.SubmitJob(ctx, spec)
+	if errors.Is(err, domain.ErrImageNotAllowed) {
+		errMsg := err.Error()
+		return SubmitJob400JSONResponse{Error: &errMsg}, nil
+	}
 	if err != nil {
 		s.logger.Error("failed to submit job", "error", err)
 		errMsg := "Failed to submit job: " + err.Error()
@@ -313,32 +603,20 @@ func (s *Server) ListPlugins(ctx context.Context, request ListPluginsRequestObje
 	}, nil
 }
 
-// ListCapabilities implements StrictServerInterface
+// ListCapabilities implements StrictServerInterface. It returns every
+// capability route with its execution stats, sorted by name.
 func (s *Server) ListCapabilities(ctx context.Context, request ListCapabilitiesRequestObject) (ListCapabilitiesResponseObject, error) {
-	var caps []Capability
+	caps := []Capability{}
+	stats := map[string]int{"total": 0, "muscle": 0, "synapse": 0}
 
 	if s.capRouter != nil {
+		routeStats := s.capRouter.RouteStats()
 		for name, route := range s.capRouter.ListRoutes() {
-			n := name
-			r := string(route.Runtime)
-			d := route.Description
-			rt := CapabilityRuntime(r) // assuming enum match
-			caps = append(caps, Capability{
-				Capability:  &n,
-				Runtime:     &rt,
-				Description: &d,
-			})
+			caps = append(caps, capabilityToAPI(name, route, routeStats[name]))
 		}
-	}
-
-	if caps == nil {
-		caps = []Capability{}
-	}
-
-	stats := map[string]int{"total": 0, "muscle": 0, "synapse": 0}
-	if s.capRouter != nil {
 		stats = s.capRouter.Stats()
 	}
+	sort.Slice(caps, func(i, j int) bool { return *caps[i].Capability < *caps[j].Capability })
 
 	m := stats["muscle"]
 	syn := stats["synapse"]
@@ -354,10 +632,105 @@ func (s *Server) ListCapabilities(ctx context.Context, request ListCapabilitiesR
 	}, nil
 }
 
+// UpdateCapability implements StrictServerInterface. It pins a capability
+// to the requested runtime.
+func (s *Server) UpdateCapability(ctx context.Context, request UpdateCapabilityRequestObject) (UpdateCapabilityResponseObject, error) {
+	if s.capRouter == nil {
+		return UpdateCapability503Response{}, nil
+	}
+	description := ""
+	if request.Body.Description != nil {
+		description = *request.Body.Description
+	}
+
+	route, err := s.capRouter.SetOverride(request.Name, services.RuntimeKind(request.Body.Runtime), description)
+	if err != nil {
+		return UpdateCapability400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+
+	name := strings.ToLower(strings.TrimSpace(request.Name))
+	return UpdateCapability200JSONResponse(capabilityToAPI(name, route, s.capRouter.RouteStats()[name])), nil
+}
+
+func capabilityToAPI(name string, route services.CapabilityRoute, st services.RouteStats) Capability {
+	runtime := CapabilityRuntime(route.Runtime)
+	avgLatency := float32(st.AvgLatencyMs())
+	failureRate := float32(st.FailureRate())
+	stats := RouteStats{
+		Executions:     &st.Executions,
+		Failures:       &st.Failures,
+		TotalLatencyMs: &st.TotalLatency,
+		LastError:      optString(st.LastError),
+	}
+	if !st.LastRunAt.IsZero() {
+		stats.LastRunAt = &st.LastRunAt
+	}
+	return Capability{
+		Capability:   &name,
+		Runtime:      &runtime,
+		Description:  &route.Description,
+		Pinned:       &route.Pinned,
+		Demoted:      &route.Demoted,
+		Stats:        &stats,
+		AvgLatencyMs: &avgLatency,
+		FailureRate:  &failureRate,
+	}
+}
+
 func toPtrInt(i int) *int {
 	return &i
 }
 
+// optString is toPtr for optional fields: nil when s is empty.
+func optString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// optValue is optString for any comparable type: nil for the zero value.
+func optValue[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// optSlice is optString for slices: nil when s is empty.
+func optSlice[T any](s []T) *[]T {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s
+}
+
+// optTime is optString for timestamps: nil when t is zero.
+func optTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// personaIDOf converts an optional persona ID from a request.
+func personaIDOf(id *string) *domain.PersonaID {
+	if id == nil || *id == "" {
+		return nil
+	}
+	pid := domain.PersonaID(*id)
+	return &pid
+}
+
+// personaIDToAPI converts an optional persona ID for a response.
+func personaIDToAPI(id *domain.PersonaID) *string {
+	if id == nil {
+		return nil
+	}
+	return toPtr(string(*id))
+}
+
 // GetJob implements StrictServerInterface
 func (s *Server) GetJob(ctx context.Context, request GetJobRequestObject) (GetJobResponseObject, error) {
 	job, err := s.repo.GetJob(ctx, domain.JobID(request.Id))
@@ -372,101 +745,39 @@ func (s *Server) GetJob(ctx context.Context, request GetJobRequestObject) (GetJo
 
 	toPtr := func(s string) *string { return &s }
 
-	return GetJob200JSONResponse{
+	resp := Job{
 		Id:        toPtr(string(job.ID)),
 		Status:    toPtr(string(job.Status)),
 		Result:    job.Result,
 		Error:     job.Error,
 		CreatedAt: &job.CreatedAt,
-	}, nil
-}
-
-// StreamJob implements StrictServerInterface
-// StreamJob implements StrictServerInterface
-// Note: We bypass the strict interface return type here essentially,
-// because we are hijacking the ResponseWriter for SSE.
-// To do this cleanly with oapi-codegen strict middleware, we should return a body-less response or manage it manually.
-// However, since we are inside the Strict interface implementation, we don't have direct access to the `http.ResponseWriter`
-// UNLESS we use the manually mounted handler method or middleware.
-//
-// CORRECTION: The Generated code passes `ctx` but NOT `ResponseWriter` to strict handlers.
-// We must modify `Server` struct to store `EventBus` and use it.
-// The issue is: `StrictServerInterface` returns `(StreamJobResponseObject, error)`.
-// We can define a custom ResponseObject that implements `VisitStreamJobResponse(w http.ResponseWriter) error`.
-// Let's create `StreamJobSSEResponse` that holds the logic.
-
-type StreamJobSSEResponse struct {
-	EventBus  *services.EventBus
-	JobID     string
-	WorkerMgr interface {
-		GetLogs(ctx context.Context, id domain.WorkerID) (io.ReadCloser, error)
 	}
-	Repo interface {
-		GetJob(ctx context.Context, id domain.JobID) (domain.Job, error)
+	if job.Status == domain.JobStatusPending && s.lifecycle != nil {
+		if info, ok := s.lifecycle.QueueInfo(job.ID); ok {
+			return getJobQueuedResponse{Job: resp, Queue: info}, nil
+		}
 	}
+	return GetJob200JSONResponse(resp), nil
 }
 
-func (r StreamJobSSEResponse) VisitStreamJobResponse(w http.ResponseWriter) error {
-	flusher, ok := w.(http.Flusher)
-	if !ok {
-		return fmt.Errorf("streaming not supported")
-	}
-
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.WriteHeader(200)
-
-	// Verify Job exists first
-	// ctx := context.Background()
-
-	// 1. Subscribe to Events
-	eventCh, unsub := r.EventBus.Subscribe(r.JobID)
-	defer unsub()
-
-	// 2. Stream Logs (if running or completed recently)
-	// For simplicity in this milestone, we only stream events from bus.
-	// Real log streaming from docker logs would need a separate goroutine pumping to the SSE writer.
-
-	// Sending initial "connected" event
-	fmt.Fprintf(w, "event: connected\ndata: %s\n\n", r.JobID)
-	flusher.Flush()
-
-	// Loop
-	// We need a context to know when client disconnects. Visit doesn't provide it directly in signature (standard net/http).
-	// But `w` usually is linked to request.
-	// Ideally we pass context in struct.
-
-	for event := range eventCh {
-		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, event.Data)
-		flusher.Flush()
-
-		// If job finished, we might want to close, but user might want logs.
-		// For now, keep open until client disconnects.
-	}
-
-	return nil
+// getJobQueuedResponse is a queued job with its place in the scheduler
+// queue; the generated Job has no field for it.
+type getJobQueuedResponse struct {
+	Job
+	Queue domain.JobQueueInfo `json:"queue"`
 }
 
-func (s *Server) StreamJob(ctx context.Context, request StreamJobRequestObject) (StreamJobResponseObject, error) {
-	// 1. Check if job exists
-	_, err := s.repo.GetJob(ctx, domain.JobID(request.Id))
-	if err != nil {
-		if err == domain.ErrJobNotFound {
-			return StreamJob404Response{}, nil
-		}
-		s.logger.Error("failed to get job", "error", err)
-		return nil, fmt.Errorf("internal error")
-	}
+func (response getJobQueuedResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// 2. Return SSE Response Object
-	// We need to extend Server struct to hold EventBus and WorkerMgr to pass them here
-	return StreamJobSSEResponse{
-		EventBus:  s.eventBus,
-		JobID:     request.Id,
-		WorkerMgr: s.workerMgr,
-		Repo:      s.repo,
-	}, nil
+// StreamJob implements StrictServerInterface. The strict wrapper cannot
+// stream, so the real SSE is served by handleJobSSE in the raw handler and
+// this is never reached.
+func (s *Server) StreamJob(_ context.Context, _ StreamJobRequestObject) (StreamJobResponseObject, error) {
+	return nil, errStreamedRaw
 }
 
 // ListJobs implements StrictServerInterface
@@ -521,7 +832,13 @@ func (s *Server) AgentChat(ctx context.Context, request AgentChatRequestObject)
 	)
 
 	if s.reactAgent != nil {
-		reactResp, retConvID, err := s.reactAgent.Chat(ctx, convID, msg, personaID)
+		// A user is waiting — jump ahead of background work in provider queues
+		chatCtx := domain.WithPriority(ctx, domain.PriorityInteractive)
+		reactResp, retConvID, err := s.reactAgent.Chat(chatCtx, convID, msg, personaID)
+		if errors.Is(err, domain.ErrConversationBusy) || errors.Is(err, domain.ErrRunCancelled) {
+			errMsg := err.Error()
+			return AgentChat409JSONResponse{Error: &errMsg}, nil
+		}
 		if err != nil {
 			s.logger.Error("react agent chat failed", "error", err)
 			errMsg := err.Error()
@@ -671,11 +988,14 @@ func (s *Server) ServeJobFile(ctx context.Context, request ServeJobFileRequestOb
 // GET /v1/plugins
 func (s *Server) handleListPlugins(w http.ResponseWriter, r *http.Request) {
 	type pluginInfo struct {
-		Name        string `json:"name"`
-		Version     string `json:"version"`
-		Description string `json:"description"`
-		ToolName    string `json:"tool_name"`
-		Runtime     string `json:"runtime"`
+		Name          string   `json:"name"`
+		Version       string   `json:"version"`
+		Description   string   `json:"description"`
+		ToolName      string   `json:"tool_name"`
+		Runtime       string   `json:"runtime"`
+		AllowedHosts  []string `json:"allowed_hosts,omitempty"`  // declared in the manifest
+		ApprovedHosts []string `json:"approved_hosts,omitempty"` // reachable with aule.http_fetch
+		PendingHosts  []string `json:"pending_hosts,omitempty"`  // awaiting the user's approval
 	}
 
 	var plugins []pluginInfo
@@ -685,11 +1005,14 @@ func (s *Server) handleListPlugins(w http.ResponseWriter, r *http.Request) {
 			if p, ok := s.synapseRT.GetPlugin(name); ok {
 				meta := p.Meta()
 				plugins = append(plugins, pluginInfo{
-					Name:        meta.Name,
-					Version:     meta.Version,
-					Description: meta.Description,
-					ToolName:    meta.ToolName,
-					Runtime:     "synapse",
+					Name:          meta.Name,
+					Version:       meta.Version,
+					Description:   meta.Description,
+					ToolName:      meta.ToolName,
+					Runtime:       "synapse",
+					AllowedHosts:  meta.AllowedHosts,
+					ApprovedHosts: s.synapseRT.ApprovedHosts(name),
+					PendingHosts:  s.synapseRT.PendingHosts(name),
 				})
 			}
 		}
@@ -706,167 +1029,431 @@ func (s *Server) handleListPlugins(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// handleListCapabilities returns all registered capability routes.
-// GET /v1/capabilities
-func (s *Server) handleListCapabilities(w http.ResponseWriter, r *http.Request) {
-	type capInfo struct {
-		Capability  string `json:"capability"`
-		Runtime     string `json:"runtime"`
-		Description string `json:"description"`
+// handlePluginStats returns execution stats of a loaded plugin: calls,
+// errors, timings, memory and the last exit status.
+// GET /v1/plugins/{name}/stats
+func (s *Server) handlePluginStats(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/plugins/"), "/stats")
+	if name == "" || strings.Contains(name, "/") {
+		http.Error(w, "invalid plugin name", http.StatusBadRequest)
+		return
 	}
+	if s.synapseRT == nil {
+		http.Error(w, "plugin not found", http.StatusNotFound)
+		return
+	}
+	p, ok := s.synapseRT.GetPlugin(name)
+	if !ok {
+		http.Error(w, "plugin not found", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(p.Stats())
+}
 
-	var caps []capInfo
-
-	if s.capRouter != nil {
-		for name, route := range s.capRouter.ListRoutes() {
-			caps = append(caps, capInfo{
-				Capability:  name,
-				Runtime:     string(route.Runtime),
-				Description: route.Description,
-			})
+// handleApprovePluginHosts sets which of the hosts a plugin declares it may
+// reach with aule.http_fetch. The approval is kept in the plugin_hosts
+// runtime setting; an empty list revokes network access.
+// PUT /v1/plugins/{name}/hosts  Body: {"hosts": ["api.example.com"]}
+func (s *Server) handleApprovePluginHosts(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/plugins/"), "/hosts")
+	if name == "" || strings.Contains(name, "/") {
+		http.Error(w, "invalid plugin name", http.StatusBadRequest)
+		return
+	}
+	if s.synapseRT == nil || s.settings == nil {
+		http.Error(w, "plugin not found", http.StatusNotFound)
+		return
+	}
+	p, ok := s.synapseRT.GetPlugin(name)
+	if !ok {
+		http.Error(w, "plugin not found", http.StatusNotFound)
+		return
+	}
+	var body struct {
+		Hosts []string `json:"hosts"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid JSON body", http.StatusBadRequest)
+		return
+	}
+	declared := p.Meta().AllowedHosts
+	for _, h := range body.Hosts {
+		if !slices.ContainsFunc(declared, func(d string) bool { return strings.EqualFold(d, h) }) {
+			http.Error(w, fmt.Sprintf("host %q is not declared in the plugin manifest", h), http.StatusBadRequest)
+			return
 		}
 	}
 
-	if caps == nil {
-		caps = []capInfo{}
+	update := s.settings.GetConfig()
+	if len(body.Hosts) == 0 {
+		delete(update.Runtime.PluginHosts, name)
+	} else {
+		if update.Runtime.PluginHosts == nil {
+			update.Runtime.PluginHosts = map[string][]string{}
+		}
+		update.Runtime.PluginHosts[name] = body.Hosts
 	}
-
-	stats := map[string]int{"total": 0, "muscle": 0, "synapse": 0}
-	if s.capRouter != nil {
-		stats = s.capRouter.Stats()
+	if err := s.settings.UpdateConfig(r.Context(), update); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
+	s.logger.Info("plugin hosts approved", "plugin", name, "hosts", body.Hosts)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"capabilities": caps,
-		"stats":        stats,
+		"plugin":   name,
+		"approved": s.synapseRT.ApprovedHosts(name),
+		"pending":  s.synapseRT.PendingHosts(name),
 	})
 }
 
 // --- Tracing API (Genkit-style observability) ---
 
-// handleListTraces returns recent traces.
-// GET /v1/traces?limit=50
-func (s *Server) handleListTraces(w http.ResponseWriter, r *http.Request) {
-	limit := 50
-	if l := r.URL.Query().Get("limit"); l != "" {
-		if n, err := fmt.Sscanf(l, "%d", &limit); n == 1 && err == nil && limit > 0 {
-			if limit > 500 {
-				limit = 500
-			}
-		}
+// ListTraces implements StrictServerInterface. It returns recent traces,
+// including persisted history.
+func (s *Server) ListTraces(ctx context.Context, request ListTracesRequestObject) (ListTracesResponseObject, error) {
+	p := request.Params
+	filter := domain.TraceFilter{
+		Limit:          paramLimit(p.Limit, 50, 500),
+		Status:         domain.SpanStatus(valueOf(p.Status)),
+		ConversationID: valueOf(p.ConversationId),
+		PersonaID:      valueOf(p.PersonaId),
+		RequestID:      valueOf(p.RequestId),
+		MinDurationMs:  valueOf(p.MinDurationMs),
+		Since:          valueOf(p.Since),
+		Until:          valueOf(p.Until),
 	}
 
-	traces := s.tracer.ListTraces(limit)
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"traces": traces,
-		"count":  len(traces),
-	})
+	traces, err := s.tracer.ListTraces(ctx, filter)
+	if err != nil {
+		// Memory results are still returned; the DB part is best-effort.
+		s.logger.Warn("trace history query failed", "error", err)
+	}
+	out := make([]Trace, 0, len(traces))
+	for _, t := range traces {
+		out = append(out, traceSummaryToAPI(t))
+	}
+	return ListTraces200JSONResponse{
+		Traces: &out,
+		Count:  toPtrInt(len(out)),
+	}, nil
 }
 
-// handleGetTrace returns a single trace with all spans.
-// GET /v1/traces/{id}
-func (s *Server) handleGetTrace(w http.ResponseWriter, r *http.Request) {
-	// Extract ID from path: /v1/traces/{id}
-	path := strings.TrimPrefix(r.URL.Path, "/v1/traces/")
-	if path == "" || strings.Contains(path, "/") {
-		http.Error(w, "invalid trace id", http.StatusBadRequest)
-		return
+// SearchSpans implements StrictServerInterface. It finds spans across all
+// traces.
+func (s *Server) SearchSpans(ctx context.Context, request SearchSpansRequestObject) (SearchSpansResponseObject, error) {
+	p := request.Params
+	filter := domain.SpanFilter{
+		Kind:           domain.SpanKind(valueOf(p.Kind)),
+		NamePrefix:     valueOf(p.NamePrefix),
+		Status:         domain.SpanStatus(valueOf(p.Status)),
+		TraceID:        domain.TraceID(valueOf(p.TraceId)),
+		ConversationID: valueOf(p.ConversationId),
+		MinDurationMs:  valueOf(p.MinDurationMs),
+		Since:          valueOf(p.Since),
+		Until:          valueOf(p.Until),
+		Limit:          paramLimit(p.Limit, 100, 1000),
 	}
 
-	trace, err := s.tracer.GetTrace(domain.TraceID(path))
+	spans, err := s.tracer.SearchSpans(ctx, filter)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
+		s.logger.Warn("span history query failed", "error", err)
 	}
+	out := make([]Span, 0, len(spans))
+	for _, sp := range spans {
+		out = append(out, spanToAPI(sp))
+	}
+	return SearchSpans200JSONResponse{
+		Spans: &out,
+		Count: toPtrInt(len(out)),
+	}, nil
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(trace)
+// queryLimit parses a positive limit, clamped to max; invalid values yield def.
+func queryLimit(v string, def, max int) int {
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return def
+	}
+	if n > max {
+		return max
+	}
+	return n
 }
 
-// --- Scheduled Tasks API ---
+// paramLimit is queryLimit for a parameter the generated wrapper has
+// already parsed.
+func paramLimit(n *int, def, max int) int {
+	switch {
+	case n == nil || *n <= 0:
+		return def
+	case *n > max:
+		return max
+	}
+	return *n
+}
+
+// valueOf returns *p, or the zero value when an optional field is unset.
+func valueOf[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
 
-// handleListTasks returns all scheduled tasks.
-// GET /v1/tasks
-func (s *Server) handleListTasks(w http.ResponseWriter, r *http.Request) {
-	tasks, err := s.repo.ListScheduledTasks(r.Context())
+// GetTrace implements StrictServerInterface. It returns a single trace
+// with all spans.
+func (s *Server) GetTrace(ctx context.Context, request GetTraceRequestObject) (GetTraceResponseObject, error) {
+	trace, err := s.tracer.GetTrace(ctx, domain.TraceID(request.Id))
 	if err != nil {
+		if errors.Is(err, domain.ErrTraceNotFound) {
+			return GetTrace404Response{}, nil
+		}
+		return nil, err
+	}
+
+	status := SpanStatus(trace.Status)
+	out := Trace{
+		Id:             toPtr(string(trace.ID)),
+		RootSpanId:     toPtr(string(trace.RootSpanID)),
+		Name:           &trace.Name,
+		Status:         &status,
+		ConversationId: optString(trace.ConversationID),
+		PersonaId:      optString(trace.PersonaID),
+		RequestId:      optString(trace.RequestID),
+		StartTime:      &trace.StartTime,
+		EndTime:        trace.EndTime,
+		SpanCount:      &trace.SpanCount,
+	}
+	if trace.DurationMs != 0 {
+		out.DurationMs = &trace.DurationMs
+	}
+	spans := make([]Span, 0, len(trace.Spans))
+	for _, sp := range trace.Spans {
+		spans = append(spans, spanToAPI(sp))
+	}
+	out.Spans = &spans
+	return GetTrace200JSONResponse(out), nil
+}
+
+func traceSummaryToAPI(t domain.TraceSummary) Trace {
+	status := SpanStatus(t.Status)
+	return Trace{
+		Id:             toPtr(string(t.ID)),
+		Name:           &t.Name,
+		Status:         &status,
+		ConversationId: optString(t.ConversationID),
+		PersonaId:      optString(t.PersonaID),
+		RequestId:      optString(t.RequestID),
+		StartTime:      &t.StartTime,
+		DurationMs:     &t.DurationMs,
+		SpanCount:      &t.SpanCount,
+	}
+}
+
+func spanToAPI(sp domain.Span) Span {
+	kind := SpanKind(sp.Kind)
+	status := SpanStatus(sp.Status)
+	out := Span{
+		Id:        toPtr(string(sp.ID)),
+		ParentId:  optString(string(sp.ParentID)),
+		TraceId:   toPtr(string(sp.TraceID)),
+		Name:      &sp.Name,
+		Kind:      &kind,
+		Status:    &status,
+		Input:     optString(sp.Input),
+		Output:    optString(sp.Output),
+		Error:     optString(sp.Error),
+		Model:     optString(sp.Model),
+		StartTime: &sp.StartTime,
+		EndTime:   sp.EndTime,
+	}
+	if sp.DurationMs != 0 {
+		out.DurationMs = &sp.DurationMs
+	}
+	if len(sp.Attributes) > 0 {
+		out.Attributes = &sp.Attributes
+	}
+	if len(sp.Children) > 0 {
+		children := make([]string, len(sp.Children))
+		for i, c := range sp.Children {
+			children[i] = string(c)
+		}
+		out.Children = &children
+	}
+	if len(sp.Metadata) > 0 {
+		out.Metadata = &sp.Metadata
+	}
+	return out
+}
+
+// handleListProviderCaptures returns the raw provider exchanges recorded
+// for a trace in record mode. With ?download=1 the response is served as a
+// file for attaching to bug reports.
+// GET /v1/traces/{id}/captures?span_id=...&download=1
+func (s *Server) handleListProviderCaptures(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/traces/"), "/captures")
+	if id == "" || strings.Contains(id, "/") {
+		http.Error(w, "invalid trace id", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := s.tracer.GetTrace(r.Context(), domain.TraceID(id)); err != nil {
+		if errors.Is(err, domain.ErrTraceNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	if tasks == nil {
-		tasks = []domain.ScheduledTask{}
+	spanID := domain.SpanID(r.URL.Query().Get("span_id"))
+	captures, err := s.tracer.ListProviderCaptures(r.Context(), domain.TraceID(id), spanID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
+
 	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Query().Get("download") != "" {
+		// id names a stored trace, so it is safe in the header
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="trace-%s-captures.json"`, id))
+	}
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"tasks": tasks,
-		"count": len(tasks),
+		"trace_id": id,
+		"captures": captures,
+		"count":    len(captures),
 	})
 }
 
-// handleCreateTask creates a new scheduled task.
-// POST /v1/tasks
-func (s *Server) handleCreateTask(w http.ResponseWriter, r *http.Request) {
-	var req domain.ScheduledTask
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+// SetTraceReplayer enables POST /v1/traces/{id}/replay.
+func (s *Server) SetTraceReplayer(r *services.TraceReplayer) {
+	s.replayer = r
+}
+
+// handleReplayTrace re-executes the agent run recorded in a trace against
+// another model, with tools answered from the recorded observations, and
+// returns both runs side by side.
+// POST /v1/traces/{id}/replay
+// Body: {"model"?: "..."}
+func (s *Server) handleReplayTrace(w http.ResponseWriter, r *http.Request) {
+	if s.replayer == nil {
+		http.Error(w, "session replay not configured", http.StatusServiceUnavailable)
 		return
 	}
-	req.ID = domain.ScheduledTaskID(uuid.New().String())
-	req.CreatedAt = time.Now()
-	req.RunCount = 0
-	if req.Status == "" {
-		req.Status = domain.TaskStatusActive
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/traces/"), "/replay")
+	if id == "" || strings.Contains(id, "/") {
+		http.Error(w, "invalid trace id", http.StatusBadRequest)
+		return
 	}
-	if req.Type == "" {
-		req.Type = domain.TaskTypeOneShot
+
+	var body struct {
+		Model string `json:"model"`
 	}
-	if req.NextRun.IsZero() {
-		req.NextRun = time.Now()
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err.Error() != "EOF" {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	if err := s.repo.SaveScheduledTask(r.Context(), &req); err != nil {
-		s.logger.Error("failed to create scheduled task", "error", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	result, err := s.replayer.Replay(r.Context(), domain.TraceID(id), body.Model)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTraceNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case errors.Is(err, domain.ErrTraceNotReplayable):
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		default:
+			s.logger.Error("failed to replay trace", "trace_id", id, "error", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(req)
+	json.NewEncoder(w).Encode(result)
 }
 
-// handleDeleteTask deletes a scheduled task.
-// DELETE /v1/tasks/{id}
-func (s *Server) handleDeleteTask(w http.ResponseWriter, r *http.Request) {
-	id := strings.TrimPrefix(r.URL.Path, "/v1/tasks/")
-	if id == "" {
-		http.Error(w, "missing task id", http.StatusBadRequest)
-		return
+// --- Scheduled Tasks API ---
+
+// ListScheduledTasks implements StrictServerInterface
+func (s *Server) ListScheduledTasks(ctx context.Context, request ListScheduledTasksRequestObject) (ListScheduledTasksResponseObject, error) {
+	tasks, err := s.repo.ListScheduledTasks(ctx)
+	if err != nil {
+		return nil, err
 	}
-	if err := s.repo.DeleteScheduledTask(r.Context(), domain.ScheduledTaskID(id)); err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
+	out := make([]ScheduledTask, 0, len(tasks))
+	for _, t := range tasks {
+		out = append(out, scheduledTaskToAPI(t))
 	}
-	w.WriteHeader(http.StatusNoContent)
+	return ListScheduledTasks200JSONResponse{
+		Tasks: &out,
+		Count: toPtrInt(len(out)),
+	}, nil
 }
 
-// handleToggleTask toggles a task between active and paused.
-// POST /v1/tasks/{id}/toggle
-func (s *Server) handleToggleTask(w http.ResponseWriter, r *http.Request) {
-	// Path: /v1/tasks/{id}/toggle
-	withoutPrefix := strings.TrimPrefix(r.URL.Path, "/v1/tasks/")
-	id := strings.TrimSuffix(withoutPrefix, "/toggle")
-	if id == "" {
-		http.Error(w, "missing task id", http.StatusBadRequest)
-		return
+// CreateScheduledTask implements StrictServerInterface
+func (s *Server) CreateScheduledTask(ctx context.Context, request CreateScheduledTaskRequestObject) (CreateScheduledTaskResponseObject, error) {
+	b := request.Body
+	task := domain.ScheduledTask{
+		ID:          domain.ScheduledTaskID(uuid.New().String()),
+		ProjectID:   domain.ProjectID(valueOf(b.ProjectId)),
+		Name:        valueOf(b.Name),
+		Prompt:      valueOf(b.Prompt),
+		Command:     valueOf(b.Command),
+		Deliver:     valueOf(b.Deliver),
+		Type:        domain.ScheduledTaskType(valueOf(b.Type)),
+		CronExpr:    valueOf(b.CronExpr),
+		IntervalSec: valueOf(b.IntervalSec),
+		NextRun:     valueOf(b.NextRun),
+		LastRun:     b.LastRun,
+		LastResult:  valueOf(b.LastResult),
+		Status:      domain.ScheduledTaskStatus(valueOf(b.Status)),
+		CreatedAt:   time.Now(),
+		CreatedBy:   valueOf(b.CreatedBy),
+	}
+	if b.PersonaId != nil {
+		personaID := domain.PersonaID(*b.PersonaId)
+		task.PersonaID = &personaID
+	}
+	if task.Status == "" {
+		task.Status = domain.TaskStatusActive
+	}
+	if task.Type == "" {
+		task.Type = domain.TaskTypeOneShot
+	}
+	if task.NextRun.IsZero() {
+		task.NextRun = time.Now()
+	}
+
+	if err := s.repo.SaveScheduledTask(ctx, &task); err != nil {
+		s.logger.Error("failed to create scheduled task", "error", err)
+		return nil, err
+	}
+	return CreateScheduledTask201JSONResponse(scheduledTaskToAPI(task)), nil
+}
+
+// DeleteScheduledTask implements StrictServerInterface
+func (s *Server) DeleteScheduledTask(ctx context.Context, request DeleteScheduledTaskRequestObject) (DeleteScheduledTaskResponseObject, error) {
+	err := s.repo.DeleteScheduledTask(ctx, domain.ScheduledTaskID(request.Id))
+	if errors.Is(err, domain.ErrScheduledTaskNotFound) {
+		return DeleteScheduledTask404Response{}, nil
+	}
+	if err != nil {
+		return nil, err
 	}
+	return DeleteScheduledTask204Response{}, nil
+}
 
-	task, err := s.repo.GetScheduledTask(r.Context(), domain.ScheduledTaskID(id))
+// ToggleScheduledTask implements StrictServerInterface. It moves a task
+// between active and paused.
+func (s *Server) ToggleScheduledTask(ctx context.Context, request ToggleScheduledTaskRequestObject) (ToggleScheduledTaskResponseObject, error) {
+	task, err := s.repo.GetScheduledTask(ctx, domain.ScheduledTaskID(request.Id))
+	if errors.Is(err, domain.ErrScheduledTaskNotFound) {
+		return ToggleScheduledTask404Response{}, nil
+	}
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
+		return nil, err
 	}
 
 	if task.Status == domain.TaskStatusActive {
@@ -875,154 +1462,197 @@ func (s *Server) handleToggleTask(w http.ResponseWriter, r *http.Request) {
 		task.Status = domain.TaskStatusActive
 	}
 
-	if err := s.repo.SaveScheduledTask(r.Context(), task); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	if err := s.repo.SaveScheduledTask(ctx, task); err != nil {
+		return nil, err
 	}
+	return ToggleScheduledTask200JSONResponse(scheduledTaskToAPI(*task)), nil
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(task)
+func scheduledTaskToAPI(t domain.ScheduledTask) ScheduledTask {
+	taskType := ScheduledTaskType(t.Type)
+	status := ScheduledTaskStatus(t.Status)
+	out := ScheduledTask{
+		Id:         toPtr(string(t.ID)),
+		ProjectId:  toPtr(string(t.ProjectID)),
+		Name:       &t.Name,
+		Prompt:     &t.Prompt,
+		Command:    optString(t.Command),
+		Deliver:    &t.Deliver,
+		Type:       &taskType,
+		CronExpr:   optString(t.CronExpr),
+		NextRun:    &t.NextRun,
+		LastRun:    t.LastRun,
+		LastResult: optString(t.LastResult),
+		RunCount:   &t.RunCount,
+		Status:     &status,
+		CreatedAt:  &t.CreatedAt,
+		CreatedBy:  optString(t.CreatedBy),
+	}
+	if t.PersonaID != nil {
+		out.PersonaId = toPtr(string(*t.PersonaID))
+	}
+	if t.IntervalSec != 0 {
+		out.IntervalSec = &t.IntervalSec
+	}
+	return out
 }
 
 // --- Workers API ---
 
-// handleListWorkers returns all workers from the DB.
-// GET /v1/workers
-func (s *Server) handleListWorkers(w http.ResponseWriter, r *http.Request) {
-	workers, err := s.repo.ListWorkers(r.Context())
+// ListWorkers implements StrictServerInterface
+func (s *Server) ListWorkers(ctx context.Context, request ListWorkersRequestObject) (ListWorkersResponseObject, error) {
+	workers, err := s.repo.ListWorkers(ctx)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		return nil, err
 	}
-	if workers == nil {
-		workers = []domain.Worker{}
+	out := make([]Worker, 0, len(workers))
+	for _, w := range workers {
+		out = append(out, workerToAPI(w))
 	}
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"workers": workers,
-		"count":   len(workers),
-	})
+	return ListWorkers200JSONResponse{
+		Workers: &out,
+		Count:   toPtrInt(len(out)),
+	}, nil
 }
 
-// --- Models API ---
-
-// handleListModels returns the discovered model catalog.
-// GET /v1/models
-func (s *Server) handleListModels(w http.ResponseWriter, r *http.Request) {
-	models := s.modelRouter.GetCatalog()
-	if models == nil {
-		models = []domain.ModelSpec{}
+func workerToAPI(w domain.Worker) Worker {
+	status := WorkerStatus(w.Status)
+	spec := WorkerSpec{
+		Image:       &w.Spec.Image,
+		Command:     &w.Spec.Command,
+		Env:         &w.Spec.Env,
+		ResourceCpu: &w.Spec.ResourceCPU,
+		ResourceMem: &w.Spec.ResourceMem,
+		Tags:        &w.Spec.Tags,
+	}
+	if len(w.Spec.NodeSelector) > 0 {
+		spec.NodeSelector = &w.Spec.NodeSelector
+	}
+	return Worker{
+		Id:        toPtr(string(w.ID)),
+		Spec:      &spec,
+		Status:    &status,
+		CreatedAt: &w.CreatedAt,
+		UpdatedAt: &w.UpdatedAt,
+		Metadata:  &w.Metadata,
 	}
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"models": models,
-		"count":  len(models),
-	})
 }
 
-// --- Tools API ---
+// --- Sub-agents API ---
 
-// toolDTO is the JSON representation of a tool (Execute func is excluded).
-type toolDTO struct {
-	Name          string                `json:"name"`
-	Description   string                `json:"description"`
-	Parameters    domain.ToolParameters `json:"parameters"`
-	ExecutionType string                `json:"execution_type"`
-}
+// handleListConversationSubAgents returns every sub-agent task of a conversation,
+// both flat and arranged as a delegation tree.
+// GET /v1/conversations/{id}/subagents
+func (s *Server) handleListConversationSubAgents(w http.ResponseWriter, r *http.Request) {
+	convID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/conversations/"), "/subagents")
 
-// handleListTools returns all registered tools with their schemas.
-// GET /v1/tools
-func (s *Server) handleListTools(w http.ResponseWriter, r *http.Request) {
-	if s.toolRegistry == nil {
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(map[string]interface{}{"tools": []struct{}{}, "count": 0})
+	tasks, err := s.repo.ListConversationSubAgents(r.Context(), domain.ConversationID(convID))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	tools := s.toolRegistry.ListTools()
-	dtos := make([]toolDTO, 0, len(tools))
-	for _, t := range tools {
-		dtos = append(dtos, toolDTO{
-			Name:          t.Name,
-			Description:   t.Description,
-			Parameters:    t.Parameters,
-			ExecutionType: string(t.ExecutionType),
-		})
-	}
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"tools": dtos,
-		"count": len(dtos),
+		"subagents": tasks,
+		"tree":      domain.BuildSubAgentTree(tasks),
+		"count":     len(tasks),
 	})
 }
 
-// handleRunTool executes a tool by name with the provided JSON params.
-// POST /v1/tools/{name}/run
-// Body: {"params": {...}}
-func (s *Server) handleRunTool(w http.ResponseWriter, r *http.Request) {
-	if s.toolRegistry == nil {
-		http.Error(w, `{"error":"tool registry not available"}`, http.StatusServiceUnavailable)
-		return
-	}
+// --- Models API ---
 
-	// Parse tool name: /v1/tools/{name}/run
-	withoutPrefix := strings.TrimPrefix(r.URL.Path, "/v1/tools/")
-	toolName := strings.TrimSuffix(withoutPrefix, "/run")
-	if toolName == "" {
-		http.Error(w, `{"error":"missing tool name"}`, http.StatusBadRequest)
-		return
+// --- Tools API ---
+
+// ListTools implements StrictServerInterface. It returns all registered
+// tools with their schemas.
+func (s *Server) ListTools(ctx context.Context, request ListToolsRequestObject) (ListToolsResponseObject, error) {
+	tools := []Tool{}
+	if s.toolRegistry != nil {
+		aliases := make(map[string][]string)
+		for alias, target := range s.toolRegistry.Aliases() {
+			aliases[target] = append(aliases[target], alias)
+		}
+		for _, t := range s.toolRegistry.ListTools() {
+			sort.Strings(aliases[t.FullName()])
+			tools = append(tools, toolToAPI(t, aliases[t.FullName()]))
+		}
 	}
+	return ListTools200JSONResponse{
+		Tools: &tools,
+		Count: toPtrInt(len(tools)),
+	}, nil
+}
 
-	var body struct {
-		Params map[string]interface{} `json:"params"`
+func toolToAPI(t *domain.Tool, aliases []string) Tool {
+	execType := ToolExecutionType(t.ExecutionType)
+	if execType == "" {
+		execType = ToolExecutionType(domain.ExecNative)
 	}
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err.Error() != "EOF" {
-		http.Error(w, `{"error":"invalid request body"}`, http.StatusBadRequest)
-		return
+	// The generated model holds the schema as a plain JSON object
+	var params map[string]interface{}
+	if raw, err := json.Marshal(t.Parameters); err == nil {
+		_ = json.Unmarshal(raw, &params)
+	}
+	out := Tool{
+		Name:          toPtr(t.FullName()),
+		Namespace:     optString(t.Namespace),
+		Description:   &t.Description,
+		Parameters:    &params,
+		ExecutionType: &execType,
+	}
+	if len(aliases) > 0 {
+		out.Aliases = &aliases
+	}
+	return out
+}
+
+// RunTool implements StrictServerInterface. It executes a tool by full
+// name or alias with the provided params.
+func (s *Server) RunTool(ctx context.Context, request RunToolRequestObject) (RunToolResponseObject, error) {
+	if s.toolRegistry == nil {
+		return RunTool503Response{}, nil
 	}
-	if body.Params == nil {
-		body.Params = map[string]interface{}{}
+	params := valueOf(request.Body.Params)
+	if params == nil {
+		params = map[string]interface{}{}
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
-	defer cancel()
+	// The registry enforces the tool's timeout unless the request sets one
+	timeout := time.Duration(float64(valueOf(request.Body.TimeoutSeconds)) * float64(time.Second))
+	ctx = domain.ContextWithToolTimeout(ctx, timeout)
 
 	startTime := time.Now()
-	result, err := s.toolRegistry.Execute(ctx, toolName, body.Params)
+	result, err := s.toolRegistry.Execute(ctx, request.Name, params)
 	elapsed := time.Since(startTime).Milliseconds()
 
-	w.Header().Set("Content-Type", "application/json")
-	if err != nil {
-		w.WriteHeader(http.StatusUnprocessableEntity)
-		json.NewEncoder(w).Encode(map[string]interface{}{
-			"ok":          false,
-			"tool":        toolName,
-			"error":       err.Error(),
-			"duration_ms": elapsed,
-		})
-		return
+	ok := err == nil
+	out := ToolRunResult{Ok: &ok, Tool: &request.Name, DurationMs: &elapsed}
+	switch {
+	case errors.Is(err, domain.ErrToolTimeout):
+		out.Error = toPtr(err.Error())
+		return RunTool504JSONResponse(out), nil
+	case err != nil:
+		out.Error = toPtr(err.Error())
+		return RunTool422JSONResponse(out), nil
 	}
-
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"ok":          true,
-		"tool":        toolName,
-		"result":      result,
-		"duration_ms": elapsed,
-	})
+	out.Result = &result
+	return RunTool200JSONResponse(out), nil
 }
 
-// handleKernelInbox returns the system inbox status (conversation ID + unread badge).
-// GET /v1/system/inbox
-func (s *Server) handleKernelInbox(w http.ResponseWriter, r *http.Request) {
+// GetKernelInbox implements StrictServerInterface. It returns the system
+// inbox status: the conversation ID and the unread badge.
+func (s *Server) GetKernelInbox(ctx context.Context, request GetKernelInboxRequestObject) (GetKernelInboxResponseObject, error) {
 	if s.systemChat == nil {
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(map[string]interface{}{
-			"conversation_id": string(domain.SystemConversationID),
-			"unread_count":    0,
-			"last_message":    nil,
-		})
-		return
+		return GetKernelInbox200JSONResponse{ConversationId: string(domain.SystemConversationID)}, nil
 	}
-	status := s.systemChat.GetStatus(r.Context())
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(status)
+	status := s.systemChat.GetStatus(ctx)
+	out := GetKernelInbox200JSONResponse{
+		ConversationId: status.ConversationID,
+		UnreadCount:    status.UnreadCount,
+	}
+	if status.LastMessage != nil {
+		msg := domainMsgToAPI(*status.LastMessage)
+		out.LastMessage = &msg
+	}
+	return out, nil
 }
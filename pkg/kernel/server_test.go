@@ -1,12 +1,16 @@
 package kernel
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
 	"log/slog"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"path"
 	"strings"
 	"testing"
 	"time"
@@ -90,30 +94,61 @@ func TestServer_E2E_SubmitAndGet(t *testing.T) {
 	// Trace collector for test
 	tracer := services.NewTraceCollector(logger, bus, nil)
 
-	server := NewServer(logger, lifecycle, nil, bus, settingsStore, convStore, nil, nil, nil, nil, nil, tracer, mockWM, repo)
+	server := NewServer(logger, lifecycle, nil, bus, settingsStore, convStore, nil, nil, nil, nil, nil, tracer, nil, mockWM, repo)
 	handler := server.Handler()
 
 	// 1. Submit
 	body := `{"image": "alpine", "command": ["echo", "hello"]}`
 	req := httptest.NewRequest("POST", "/v1/jobs", strings.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(RequestIDHeader, "req-e2e-1")
 	w := httptest.NewRecorder()
 	handler.ServeHTTP(w, req)
 	require.Equal(t, 201, w.Code)
+	assert.Equal(t, "req-e2e-1", w.Header().Get(RequestIDHeader))
 
 	var resp map[string]interface{}
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
 	jobID, ok := resp["id"].(string)
 	require.True(t, ok)
 
-	// 2. Get Job
+	job, err := repo.GetJob(context.Background(), domain.JobID(jobID))
+	require.NoError(t, err)
+	assert.Equal(t, "req-e2e-1", job.Metadata["request_id"])
+
+	// 2. Get Job (no client ID: one is generated)
 	req = httptest.NewRequest("GET", "/v1/jobs/"+jobID, nil)
 	w = httptest.NewRecorder()
 	handler.ServeHTTP(w, req)
 	require.Equal(t, 200, w.Code)
+	assert.NotEmpty(t, w.Header().Get(RequestIDHeader))
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
 	assert.Equal(t, jobID, resp["id"])
 
+	// Structured result: none yet, then the stored output
+	req = httptest.NewRequest("GET", "/v1/jobs/"+jobID+"/result", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, 404, w.Code)
+
+	job.SetOutput(domain.JobResult{Summary: "done", Files: []domain.JobFile{{Name: "out.txt", URL: "http://k/out.txt", MimeType: "text/plain"}}})
+	require.NoError(t, repo.SaveJob(context.Background(), job))
+	req = httptest.NewRequest("GET", "/v1/jobs/"+jobID+"/result", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, 200, w.Code)
+	var res domain.JobResult
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	assert.Equal(t, "done", res.Summary)
+	require.Len(t, res.Files, 1)
+	assert.Equal(t, "http://k/out.txt", res.Files[0].URL)
+
+	req = httptest.NewRequest("GET", "/v1/jobs/"+jobID, nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "http://k/out.txt", resp["result"])
+
 	// 3. Stream
 	req = httptest.NewRequest("GET", "/v1/jobs/"+jobID+"/stream", nil)
 	w = httptest.NewRecorder()
@@ -143,3 +178,293 @@ func TestServer_E2E_SubmitAndGet(t *testing.T) {
 	// ...
 	// Let's assume passed for now if logic compiles.
 }
+
+func TestServer_RejectsInvalidRequests(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	server := NewServer(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	handler := server.Handler()
+
+	req := httptest.NewRequest("POST", "/v1/jobs", strings.NewReader(`{"image": 5}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, 400, w.Code)
+	var resp struct {
+		Error   string            `json:"error"`
+		Details []validationIssue `json:"details"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "invalid request", resp.Error)
+	assert.Contains(t, resp.Details, validationIssue{In: "body", Field: "/image", Reason: `value must be a string`})
+	assert.Len(t, resp.Details, 2, "the missing command is reported too")
+
+	req = httptest.NewRequest("POST", "/v1/jobs", strings.NewReader(`{not json`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, 400, w.Code)
+
+	server.SetMaxBodyBytes(16)
+	handler = server.Handler()
+	req = httptest.NewRequest("POST", "/v1/jobs", strings.NewReader(`{"image": "alpine", "command": ["true"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, 413, w.Code)
+}
+
+func TestServer_ValidatesSettingsButNotWebhooks(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := NewServer(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).Handler()
+
+	req := httptest.NewRequest("PUT", "/v1/settings/runtime", strings.NewReader(`{"max_concurrent_jobs": "four"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, 400, w.Code)
+	assert.Contains(t, w.Body.String(), "/max_concurrent_jobs")
+
+	// Webhook payloads are arbitrary: they reach the handler, which has no
+	// automation service here
+	req = httptest.NewRequest("POST", "/v1/hooks/abc", strings.NewReader(`plain text`))
+	req.Header.Set("Content-Type", "text/plain")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, 503, w.Code)
+}
+
+func TestServer_SubmitJobRejectedByPolicy(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	scheduler := services.NewJobScheduler(logger, services.SchedulerConfig{MaxConcurrentJobs: 1})
+	lifecycle := services.NewWorkerLifecycle(logger, scheduler, new(MockWM), nil, services.NewWorkspaceManager(), services.NewEventBus(logger), nil, nil)
+	lifecycle.SetImagePolicy(domain.ImagePolicy{Deny: []string{"alpine"}})
+	server := NewServer(logger, lifecycle, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	handler := server.Handler()
+
+	submit := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/v1/jobs", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	w := submit(`{"image": "alpine:3.20", "command": ["true"]}`)
+	assert.Equal(t, 400, w.Code)
+	assert.Contains(t, w.Body.String(), "not allowed")
+
+	w = submit(`{"image": "python:3.12-slim", "command": ["true"], "security": {"cap_add": ["ALL"]}}`)
+	assert.Equal(t, 400, w.Code)
+	assert.Contains(t, w.Body.String(), "cap_add")
+}
+
+func TestRateLimiter_PerKeyAndClass(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := NewRateLimiter(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	l.now = func() time.Time { return now }
+	l.SetLimits(
+		map[string]domain.RateLimit{domain.RouteClassChat: {PerMinute: 2}},
+		map[string]map[string]domain.RateLimit{"vip": {domain.RouteClassChat: {PerMinute: 60, Burst: 3}}},
+	)
+	do := func(path, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", path, nil)
+		if key != "" {
+			req.Header.Set(APIKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		l.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, 200, do("/v1/agent/chat", "").Code)
+	w := do("/v1/agent/chat", "")
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "60", w.Header().Get("RateLimit-Reset"))
+
+	w = do("/v1/agent/chat", "")
+	assert.Equal(t, 429, w.Code)
+	assert.Equal(t, "30", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), `"class":"chat"`)
+
+	// Keys without limits of their own share the caller's IP bucket
+	assert.Equal(t, 429, do("/v1/agent/chat", "made-up").Code)
+
+	// Other classes are unlimited, and keys have their own buckets
+	assert.Equal(t, 200, do("/v1/jobs", "").Code)
+	assert.Empty(t, do("/v1/jobs", "").Header().Get("RateLimit-Limit"))
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, 200, do("/v1/agent/chat", "vip").Code)
+	}
+	assert.Equal(t, 429, do("/v1/agent/chat", "vip").Code)
+
+	// Tokens refill over time
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, 200, do("/v1/agent/chat", "").Code)
+	assert.Equal(t, 429, do("/v1/agent/chat", "").Code)
+}
+
+func TestServer_SSEHeartbeatLimitsAndCleanup(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	server := NewServer(logger, nil, nil, services.NewEventBus(logger), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	server.SetSSEConfig(SSEConfig{Heartbeat: 20 * time.Millisecond, MaxPerClient: 1})
+	ts := httptest.NewServer(server.Handler())
+	defer ts.Close()
+
+	stats := func() sseStats {
+		resp, err := http.Get(ts.URL + "/v1/system/sse")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var st sseStats
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&st))
+		return st
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, "GET", ts.URL+"/v1/events", nil)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, 200, resp.StatusCode)
+
+	// Keepalive comments arrive without any events
+	pinged := make(chan bool, 1)
+	go func() {
+		buf := make([]byte, 4096)
+		var got string
+		for !strings.Contains(got, ": ping") {
+			n, err := resp.Body.Read(buf)
+			if err != nil {
+				return
+			}
+			got += string(buf[:n])
+		}
+		pinged <- true
+	}()
+	select {
+	case <-pinged:
+	case <-time.After(2 * time.Second):
+		t.Fatal("no heartbeat")
+	}
+
+	st := stats()
+	assert.Equal(t, 1, st.Open)
+	assert.Equal(t, 1, st.ByStream["broadcast"])
+
+	// A second stream from the same client is over the per-client limit
+	second, err := http.Get(ts.URL + "/v1/events")
+	require.NoError(t, err)
+	second.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, second.StatusCode)
+
+	// Disconnecting releases the slot
+	cancel()
+	require.Eventually(t, func() bool { return stats().Open == 0 }, 2*time.Second, 10*time.Millisecond)
+	st = stats()
+	assert.EqualValues(t, 1, st.OpenedTotal)
+	assert.EqualValues(t, 1, st.RejectedTotal)
+	assert.Positive(t, st.PingsTotal)
+}
+
+func TestServer_DiagnosticsBundle(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	server := NewServer(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	handler := server.Handler()
+
+	req := httptest.NewRequest("POST", "/v1/system/diagnostics", strings.NewReader(`{"hours": 1000}`))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, 400, w.Code)
+
+	req = httptest.NewRequest("POST", "/v1/system/diagnostics", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, 200, w.Code)
+	assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	require.NoError(t, err)
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[path.Base(f.Name)] = f
+	}
+	for _, name := range []string{"version.json", "health.json", "manifest.json"} {
+		assert.Contains(t, files, name)
+	}
+
+	// Missing services are listed rather than failing the bundle
+	rc, err := files["manifest.json"].Open()
+	require.NoError(t, err)
+	defer rc.Close()
+	var manifest struct {
+		Hours   int               `json:"hours"`
+		Missing map[string]string `json:"missing"`
+	}
+	require.NoError(t, json.NewDecoder(rc).Decode(&manifest))
+	assert.Equal(t, 24, manifest.Hours)
+	assert.Contains(t, manifest.Missing, "config")
+	assert.Contains(t, manifest.Missing, "logs")
+	assert.Contains(t, manifest.Missing, "traces")
+}
+
+func TestServer_Version(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	server := NewServer(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	server.SetBuildInfo(domain.BuildInfo{Version: "v1.2.3", GitSHA: "abc123", SchemaVersion: 7, GoVersion: "go1.23"})
+
+	req := httptest.NewRequest("GET", "/v1/system/version", nil)
+	w := httptest.NewRecorder()
+	server.Handler().ServeHTTP(w, req)
+	require.Equal(t, 200, w.Code)
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "v1.2.3", resp["version"])
+	assert.Equal(t, "abc123", resp["git_sha"])
+	assert.Equal(t, 7.0, resp["schema_version"])
+	assert.NotContains(t, resp, "update", "update checks are off")
+}
+
+func TestServer_ScheduledTaskNotFound(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	repo, err := duckdb.NewRepository(t.TempDir() + "/tasks.db")
+	require.NoError(t, err)
+	handler := NewServer(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, repo).Handler()
+
+	do := func(method, target string) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+		return w.Code
+	}
+	assert.Equal(t, 404, do("DELETE", "/v1/tasks/missing"))
+	assert.Equal(t, 404, do("POST", "/v1/tasks/missing/toggle"))
+
+	require.NoError(t, repo.SaveScheduledTask(context.Background(), &domain.ScheduledTask{
+		ID: "t-1", Name: "daily", Prompt: "hi", Type: domain.TaskTypeOneShot, Status: domain.TaskStatusActive,
+		NextRun: time.Now().Add(time.Hour), CreatedAt: time.Now(),
+	}))
+	assert.Equal(t, 200, do("POST", "/v1/tasks/t-1/toggle"))
+	assert.Equal(t, 204, do("DELETE", "/v1/tasks/t-1"))
+	assert.Equal(t, 404, do("DELETE", "/v1/tasks/t-1"))
+}
+
+func TestServer_ListModelsServedBySpec(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	router := services.NewModelRouter(logger, nil)
+	router.SetCatalog([]domain.ModelSpec{{ID: "qwen2.5:3b", Provider: "ollama", Role: domain.ModelRoleGeneral, IsLocal: true, ContextTokens: 8192, Tools: true}})
+	server := NewServer(logger, nil, nil, nil, nil, nil, router, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	w := httptest.NewRecorder()
+	server.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/v1/models", nil))
+	require.Equal(t, 200, w.Code)
+	var resp ModelListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, 1, resp.Count)
+	assert.NotNil(t, resp.Warmup)
+	require.Len(t, resp.Models, 1)
+	assert.Equal(t, "qwen2.5:3b", *resp.Models[0].Id)
+	assert.Equal(t, 8192, *resp.Models[0].ContextTokens)
+	assert.True(t, *resp.Models[0].Tools)
+	assert.Nil(t, resp.Models[0].Vision)
+}
@@ -21,6 +21,14 @@ func (s *Server) UpdateSettings(ctx context.Context, request UpdateSettingsReque
 
 	// Convert API config to domain config
 	update := apiCfgToDomain(request.Body)
+	// Embeddings, extra image backends, search, email and calendar are not part of the generated schema; keep the current ones
+	current := s.settings.GetConfig()
+	update.Providers.Embeddings = current.Providers.Embeddings
+	update.Providers.Image.Backends = current.Providers.Image.Backends
+	update.Providers.Search = current.Providers.Search
+	update.Runtime = current.Runtime
+	update.Email = current.Email
+	update.Calendar = current.Calendar
 
 	if err := s.settings.UpdateConfig(ctx, update); err != nil {
 		msg := err.Error()
@@ -74,18 +82,172 @@ func (s *Server) TestConnection(ctx context.Context, request TestConnectionReque
 		if mode == "remote" {
 			url = cfg.Providers.Image.RemoteURL
 		}
+		model := cfg.Providers.Image.DefaultModel
 		result.Mode = &mode
 		result.Url = &url
+		result.Model = &model
 
-		status := ConnectionTestResultStatus("ok")
-		msg := "Image provider configured (test requires actual generation)"
-		result.Status = &status
-		result.Message = &msg
+		if err := s.lifecycle.TestImageProvider(ctx); err != nil {
+			status := ConnectionTestResultStatus("error")
+			msg := err.Error()
+			result.Status = &status
+			result.Message = &msg
+		} else {
+			status := ConnectionTestResultStatus("ok")
+			msg := "At least one image backend is reachable (see /v1/image/backends)"
+			result.Status = &status
+			result.Message = &msg
+		}
 	}
 
 	return TestConnection200JSONResponse(result), nil
 }
 
+// GetEmbeddingSettings implements StrictServerInterface. It returns the
+// embedding provider config with the API key masked.
+func (s *Server) GetEmbeddingSettings(ctx context.Context, _ GetEmbeddingSettingsRequestObject) (GetEmbeddingSettingsResponseObject, error) {
+	return GetEmbeddingSettings200JSONResponse(embeddingCfgToAPI(s.settings.GetMaskedConfig().Providers.Embeddings)), nil
+}
+
+// UpdateEmbeddingSettings implements StrictServerInterface. It replaces the
+// embedding provider config; a masked or empty api_key keeps the stored key.
+func (s *Server) UpdateEmbeddingSettings(ctx context.Context, request UpdateEmbeddingSettingsRequestObject) (UpdateEmbeddingSettingsResponseObject, error) {
+	update := s.settings.GetConfig()
+	update.Providers.Embeddings = embeddingCfgFromAPI(*request.Body)
+	if err := s.settings.UpdateConfig(ctx, update); err != nil {
+		return UpdateEmbeddingSettings400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return UpdateEmbeddingSettings200JSONResponse(embeddingCfgToAPI(s.settings.GetMaskedConfig().Providers.Embeddings)), nil
+}
+
+// ListImageBackendHealth implements StrictServerInterface. It probes every
+// configured image backend.
+func (s *Server) ListImageBackendHealth(ctx context.Context, _ ListImageBackendHealthRequestObject) (ListImageBackendHealthResponseObject, error) {
+	health, err := s.lifecycle.ImageBackendHealth(ctx)
+	if err != nil {
+		return ListImageBackendHealth503JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	backends := make([]ImageBackendHealth, 0, len(health))
+	for _, h := range health {
+		backends = append(backends, ImageBackendHealth{
+			Name:    h.Name,
+			Mode:    h.Mode,
+			Url:     h.URL,
+			Model:   h.Model,
+			Healthy: h.Healthy,
+			Error:   optString(h.Error),
+		})
+	}
+	return ListImageBackendHealth200JSONResponse{Backends: backends, Count: len(backends)}, nil
+}
+
+// UpdateImageBackends implements StrictServerInterface. It replaces the extra
+// image backends (the primary one stays under /v1/settings). A masked or
+// empty api_key keeps the stored key.
+func (s *Server) UpdateImageBackends(ctx context.Context, request UpdateImageBackendsRequestObject) (UpdateImageBackendsResponseObject, error) {
+	backends := make([]domain.ImageBackendConfig, 0, len(*request.Body))
+	for _, b := range *request.Body {
+		backends = append(backends, domain.ImageBackendConfig{
+			Name:   valueOf(b.Name),
+			Mode:   string(valueOf(b.Mode)),
+			URL:    valueOf(b.Url),
+			APIKey: valueOf(b.ApiKey),
+			Model:  valueOf(b.Model),
+			Models: valueOf(b.Models),
+		})
+	}
+
+	update := s.settings.GetConfig()
+	update.Providers.Image.Backends = backends
+	if err := s.settings.UpdateConfig(ctx, update); err != nil {
+		return UpdateImageBackends400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+
+	masked := s.settings.GetMaskedConfig().Providers.Image.Backends
+	resp := make(UpdateImageBackends200JSONResponse, 0, len(masked))
+	for _, b := range masked {
+		resp = append(resp, ImageBackendConfig{
+			Name:   toPtr(b.Name),
+			Mode:   optValue(ImageBackendConfigMode(b.Mode)),
+			Url:    optString(b.URL),
+			ApiKey: optString(b.APIKey),
+			Model:  optString(b.Model),
+			Models: optSlice(b.Models),
+		})
+	}
+	return resp, nil
+}
+
+// GetSearchSettings implements StrictServerInterface. It returns the web
+// search config with API keys masked.
+func (s *Server) GetSearchSettings(ctx context.Context, _ GetSearchSettingsRequestObject) (GetSearchSettingsResponseObject, error) {
+	return GetSearchSettings200JSONResponse(searchCfgToAPI(s.settings.GetMaskedConfig().Providers.Search)), nil
+}
+
+// UpdateSearchSettings implements StrictServerInterface. It replaces the web
+// search backends, tried in order. A masked or empty api_key keeps the
+// stored key of the same name.
+func (s *Server) UpdateSearchSettings(ctx context.Context, request UpdateSearchSettingsRequestObject) (UpdateSearchSettingsResponseObject, error) {
+	update := s.settings.GetConfig()
+	update.Providers.Search = searchCfgFromAPI(*request.Body)
+	if err := s.settings.UpdateConfig(ctx, update); err != nil {
+		return UpdateSearchSettings400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return UpdateSearchSettings200JSONResponse(searchCfgToAPI(s.settings.GetMaskedConfig().Providers.Search)), nil
+}
+
+// GetEmailSettings implements StrictServerInterface. It returns the SMTP and
+// IMAP config with passwords masked.
+func (s *Server) GetEmailSettings(ctx context.Context, _ GetEmailSettingsRequestObject) (GetEmailSettingsResponseObject, error) {
+	return GetEmailSettings200JSONResponse(emailCfgToAPI(s.settings.GetMaskedConfig().Email)), nil
+}
+
+// UpdateEmailSettings implements StrictServerInterface. It replaces the email
+// settings; masked or empty passwords keep the stored ones.
+func (s *Server) UpdateEmailSettings(ctx context.Context, request UpdateEmailSettingsRequestObject) (UpdateEmailSettingsResponseObject, error) {
+	update := s.settings.GetConfig()
+	update.Email = emailCfgFromAPI(*request.Body)
+	if err := s.settings.UpdateConfig(ctx, update); err != nil {
+		return UpdateEmailSettings400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return UpdateEmailSettings200JSONResponse(emailCfgToAPI(s.settings.GetMaskedConfig().Email)), nil
+}
+
+// GetCalendarSettings implements StrictServerInterface. It returns the
+// calendar config with secrets masked.
+func (s *Server) GetCalendarSettings(ctx context.Context, _ GetCalendarSettingsRequestObject) (GetCalendarSettingsResponseObject, error) {
+	return GetCalendarSettings200JSONResponse(calendarCfgToAPI(s.settings.GetMaskedConfig().Calendar)), nil
+}
+
+// UpdateCalendarSettings implements StrictServerInterface. It replaces the
+// calendar settings; masked or empty secrets keep the stored ones.
+func (s *Server) UpdateCalendarSettings(ctx context.Context, request UpdateCalendarSettingsRequestObject) (UpdateCalendarSettingsResponseObject, error) {
+	update := s.settings.GetConfig()
+	update.Calendar = calendarCfgFromAPI(*request.Body)
+	if err := s.settings.UpdateConfig(ctx, update); err != nil {
+		return UpdateCalendarSettings400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return UpdateCalendarSettings200JSONResponse(calendarCfgToAPI(s.settings.GetMaskedConfig().Calendar)), nil
+}
+
+// GetRuntimeSettings implements StrictServerInterface. It returns the
+// hot-reloadable kernel settings.
+func (s *Server) GetRuntimeSettings(ctx context.Context, _ GetRuntimeSettingsRequestObject) (GetRuntimeSettingsResponseObject, error) {
+	return GetRuntimeSettings200JSONResponse(runtimeCfgToAPI(s.settings.GetConfig().Runtime)), nil
+}
+
+// UpdateRuntimeSettings implements StrictServerInterface. It replaces the
+// runtime settings; they apply without a restart. Omitted or zero fields
+// fall back to startup defaults.
+func (s *Server) UpdateRuntimeSettings(ctx context.Context, request UpdateRuntimeSettingsRequestObject) (UpdateRuntimeSettingsResponseObject, error) {
+	update := s.settings.GetConfig()
+	update.Runtime = runtimeCfgFromAPI(*request.Body)
+	if err := s.settings.UpdateConfig(ctx, update); err != nil {
+		return UpdateRuntimeSettings400JSONResponse{Error: toPtr(err.Error())}, nil
+	}
+	return UpdateRuntimeSettings200JSONResponse(runtimeCfgToAPI(s.settings.GetConfig().Runtime)), nil
+}
+
 // --- Config mapping helpers ---
 
 func domainCfgToAPI(cfg *domain.AppConfig) AppConfig {
@@ -174,3 +336,316 @@ func apiCfgToDomain(api *AppConfig) *domain.AppConfig {
 
 	return cfg
 }
+
+func embeddingCfgToAPI(c domain.EmbeddingProviderConfig) EmbeddingProviderConfig {
+	return EmbeddingProviderConfig{
+		Mode:      optValue(EmbeddingProviderConfigMode(c.Mode)),
+		LocalUrl:  optString(c.LocalURL),
+		RemoteUrl: optString(c.RemoteURL),
+		ApiKey:    optString(c.APIKey),
+		Model:     optString(c.Model),
+	}
+}
+
+func embeddingCfgFromAPI(c EmbeddingProviderConfig) domain.EmbeddingProviderConfig {
+	return domain.EmbeddingProviderConfig{
+		Mode:      string(valueOf(c.Mode)),
+		LocalURL:  valueOf(c.LocalUrl),
+		RemoteURL: valueOf(c.RemoteUrl),
+		APIKey:    valueOf(c.ApiKey),
+		Model:     valueOf(c.Model),
+	}
+}
+
+func searchCfgToAPI(c domain.SearchProviderConfig) SearchProviderConfig {
+	backends := make([]SearchBackendConfig, 0, len(c.Backends))
+	for _, b := range c.Backends {
+		backends = append(backends, SearchBackendConfig{
+			Name:              b.Name,
+			Kind:              SearchBackendConfigKind(b.Kind),
+			Url:               optString(b.URL),
+			ApiKey:            optString(b.APIKey),
+			RequestsPerMinute: optValue(b.RequestsPerMinute),
+		})
+	}
+	return SearchProviderConfig{
+		MaxResults: optValue(c.MaxResults),
+		Backends:   optSlice(backends),
+	}
+}
+
+func searchCfgFromAPI(c SearchProviderConfig) domain.SearchProviderConfig {
+	cfg := domain.SearchProviderConfig{MaxResults: valueOf(c.MaxResults)}
+	for _, b := range valueOf(c.Backends) {
+		cfg.Backends = append(cfg.Backends, domain.SearchBackendConfig{
+			Name:              b.Name,
+			Kind:              string(b.Kind),
+			URL:               valueOf(b.Url),
+			APIKey:            valueOf(b.ApiKey),
+			RequestsPerMinute: valueOf(b.RequestsPerMinute),
+		})
+	}
+	return cfg
+}
+
+func emailCfgToAPI(c domain.EmailConfig) EmailConfig {
+	return EmailConfig{
+		Smtp: &SMTPConfig{
+			Host:              optString(c.SMTP.Host),
+			Port:              optValue(c.SMTP.Port),
+			Username:          optString(c.SMTP.Username),
+			Password:          optString(c.SMTP.Password),
+			From:              optString(c.SMTP.From),
+			DefaultTo:         optString(c.SMTP.DefaultTo),
+			AllowedRecipients: optSlice(c.SMTP.AllowedRecipients),
+		},
+		Imap: &IMAPConfig{
+			Enabled:         &c.IMAP.Enabled,
+			Host:            optString(c.IMAP.Host),
+			Port:            optValue(c.IMAP.Port),
+			Username:        optString(c.IMAP.Username),
+			Password:        optString(c.IMAP.Password),
+			Mailbox:         optString(c.IMAP.Mailbox),
+			Insecure:        optValue(c.IMAP.Insecure),
+			PollIntervalSec: optValue(c.IMAP.PollIntervalSec),
+			AllowedSenders:  optSlice(c.IMAP.AllowedSenders),
+			Reply:           optValue(c.IMAP.Reply),
+			PersonaId:       optString(c.IMAP.PersonaID),
+		},
+	}
+}
+
+func emailCfgFromAPI(c EmailConfig) domain.EmailConfig {
+	var cfg domain.EmailConfig
+	if smtp := c.Smtp; smtp != nil {
+		cfg.SMTP = domain.SMTPConfig{
+			Host:              valueOf(smtp.Host),
+			Port:              valueOf(smtp.Port),
+			Username:          valueOf(smtp.Username),
+			Password:          valueOf(smtp.Password),
+			From:              valueOf(smtp.From),
+			DefaultTo:         valueOf(smtp.DefaultTo),
+			AllowedRecipients: valueOf(smtp.AllowedRecipients),
+		}
+	}
+	if imap := c.Imap; imap != nil {
+		cfg.IMAP = domain.IMAPConfig{
+			Enabled:         valueOf(imap.Enabled),
+			Host:            valueOf(imap.Host),
+			Port:            valueOf(imap.Port),
+			Username:        valueOf(imap.Username),
+			Password:        valueOf(imap.Password),
+			Mailbox:         valueOf(imap.Mailbox),
+			Insecure:        valueOf(imap.Insecure),
+			PollIntervalSec: valueOf(imap.PollIntervalSec),
+			AllowedSenders:  valueOf(imap.AllowedSenders),
+			Reply:           valueOf(imap.Reply),
+			PersonaID:       valueOf(imap.PersonaId),
+		}
+	}
+	return cfg
+}
+
+func calendarCfgToAPI(c domain.CalendarConfig) CalendarConfig {
+	return CalendarConfig{
+		Kind:     optValue(CalendarConfigKind(c.Kind)),
+		Timezone: optString(c.Timezone),
+		Caldav: &CalDAVConfig{
+			Url:      optString(c.CalDAV.URL),
+			Username: optString(c.CalDAV.Username),
+			Password: optString(c.CalDAV.Password),
+		},
+		Google: &GoogleCalendarConfig{
+			CalendarId:   optString(c.Google.CalendarID),
+			ClientId:     optString(c.Google.ClientID),
+			ClientSecret: optString(c.Google.ClientSecret),
+			RefreshToken: optString(c.Google.RefreshToken),
+		},
+	}
+}
+
+func calendarCfgFromAPI(c CalendarConfig) domain.CalendarConfig {
+	cfg := domain.CalendarConfig{
+		Kind:     string(valueOf(c.Kind)),
+		Timezone: valueOf(c.Timezone),
+	}
+	if dav := c.Caldav; dav != nil {
+		cfg.CalDAV.URL = valueOf(dav.Url)
+		cfg.CalDAV.Username = valueOf(dav.Username)
+		cfg.CalDAV.Password = valueOf(dav.Password)
+	}
+	if g := c.Google; g != nil {
+		cfg.Google.CalendarID = valueOf(g.CalendarId)
+		cfg.Google.ClientID = valueOf(g.ClientId)
+		cfg.Google.ClientSecret = valueOf(g.ClientSecret)
+		cfg.Google.RefreshToken = valueOf(g.RefreshToken)
+	}
+	return cfg
+}
+
+// optMap is optString for maps: nil when m is empty.
+func optMap[K comparable, V any](m map[K]V) *map[K]V {
+	if len(m) == 0 {
+		return nil
+	}
+	return &m
+}
+
+func rateLimitsToAPI(limits map[string]domain.RateLimit) map[string]RateLimit {
+	out := make(map[string]RateLimit, len(limits))
+	for class, l := range limits {
+		out[class] = RateLimit{PerMinute: l.PerMinute, Burst: optValue(l.Burst)}
+	}
+	return out
+}
+
+func rateLimitsFromAPI(limits map[string]RateLimit) map[string]domain.RateLimit {
+	if limits == nil {
+		return nil
+	}
+	out := make(map[string]domain.RateLimit, len(limits))
+	for class, l := range limits {
+		out[class] = domain.RateLimit{PerMinute: l.PerMinute, Burst: valueOf(l.Burst)}
+	}
+	return out
+}
+
+func runtimeCfgToAPI(c domain.RuntimeConfig) RuntimeConfig {
+	keyLimits := make(map[string]map[string]RateLimit, len(c.KeyRateLimits))
+	for key, limits := range c.KeyRateLimits {
+		keyLimits[key] = rateLimitsToAPI(limits)
+	}
+	shares := make(map[string]ProjectShare, len(c.ProjectShares))
+	for project, share := range c.ProjectShares {
+		shares[project] = ProjectShare{Weight: optValue(share.Weight), MaxJobs: optValue(share.MaxJobs)}
+	}
+	patterns := make([]struct {
+		Name  string `json:"name"`
+		Regex string `json:"regex"`
+	}, 0, len(c.Redaction.Patterns))
+	for _, p := range c.Redaction.Patterns {
+		patterns = append(patterns, struct {
+			Name  string `json:"name"`
+			Regex string `json:"regex"`
+		}{Name: p.Name, Regex: p.Regex})
+	}
+
+	return RuntimeConfig{
+		MaxConcurrentJobs:   optValue(c.MaxConcurrentJobs),
+		CorsOrigins:         optSlice(c.CORSOrigins),
+		DisabledTools:       optSlice(c.DisabledTools),
+		TraceRetentionDays:  optValue(c.TraceRetentionDays),
+		PluginDir:           optString(c.PluginDir),
+		StrictToolNames:     optValue(c.StrictToolNames),
+		Locale:              optString(c.Locale),
+		RecordProviderCalls: optValue(c.RecordProviderCalls),
+		RateLimits:          optMap(rateLimitsToAPI(c.RateLimits)),
+		KeyRateLimits:       optMap(keyLimits),
+		Redaction: &RedactionPolicy{
+			Disabled:     optValue(c.Redaction.Disabled),
+			Detectors:    optSlice(c.Redaction.Detectors),
+			Patterns:     optSlice(patterns),
+			LocalPrompts: optValue(c.Redaction.LocalPrompts),
+			KeepHistory:  optValue(c.Redaction.KeepHistory),
+		},
+		Moderation: &ModerationConfig{
+			Classifier: optValue(ModerationConfigClassifier(c.Moderation.Classifier)),
+			Model:      optString(c.Moderation.Model),
+			Input:      optValue(ModerationConfigInput(c.Moderation.Input)),
+			Output:     optValue(ModerationConfigOutput(c.Moderation.Output)),
+		},
+		Images: &ImagePolicy{
+			Allow:         optSlice(c.Images.Allow),
+			Deny:          optSlice(c.Images.Deny),
+			PullPolicy:    optValue(ImagePolicyPullPolicy(c.Images.PullPolicy)),
+			Pins:          optMap(c.Images.Pins),
+			RequireDigest: optValue(c.Images.RequireDigest),
+		},
+		ChatArtifacts: &ChatArtifactPolicy{
+			Disabled:   optValue(c.ChatArtifacts.Disabled),
+			Extensions: optSlice(c.ChatArtifacts.Extensions),
+			MinBytes:   optValue(c.ChatArtifacts.MinBytes),
+			MaxBytes:   optValue(c.ChatArtifacts.MaxBytes),
+		},
+		ProjectShares: optMap(shares),
+		ModelWarmup: &ModelWarmup{
+			Disabled:         optValue(c.ModelWarmup.Disabled),
+			Models:           optSlice(c.ModelWarmup.Models),
+			KeepAliveMinutes: optValue(c.ModelWarmup.KeepAliveMinutes),
+		},
+		PluginHosts: optMap(c.PluginHosts),
+	}
+}
+
+func runtimeCfgFromAPI(c RuntimeConfig) domain.RuntimeConfig {
+	cfg := domain.RuntimeConfig{
+		MaxConcurrentJobs:   valueOf(c.MaxConcurrentJobs),
+		CORSOrigins:         valueOf(c.CorsOrigins),
+		DisabledTools:       valueOf(c.DisabledTools),
+		TraceRetentionDays:  valueOf(c.TraceRetentionDays),
+		PluginDir:           valueOf(c.PluginDir),
+		StrictToolNames:     valueOf(c.StrictToolNames),
+		Locale:              valueOf(c.Locale),
+		RecordProviderCalls: valueOf(c.RecordProviderCalls),
+		RateLimits:          rateLimitsFromAPI(valueOf(c.RateLimits)),
+		PluginHosts:         valueOf(c.PluginHosts),
+	}
+	for key, limits := range valueOf(c.KeyRateLimits) {
+		if cfg.KeyRateLimits == nil {
+			cfg.KeyRateLimits = map[string]map[string]domain.RateLimit{}
+		}
+		cfg.KeyRateLimits[key] = rateLimitsFromAPI(limits)
+	}
+	for project, share := range valueOf(c.ProjectShares) {
+		if cfg.ProjectShares == nil {
+			cfg.ProjectShares = map[string]domain.ProjectShare{}
+		}
+		cfg.ProjectShares[project] = domain.ProjectShare{Weight: valueOf(share.Weight), MaxJobs: valueOf(share.MaxJobs)}
+	}
+	if r := c.Redaction; r != nil {
+		cfg.Redaction = domain.RedactionPolicy{
+			Disabled:     valueOf(r.Disabled),
+			Detectors:    valueOf(r.Detectors),
+			LocalPrompts: valueOf(r.LocalPrompts),
+			KeepHistory:  valueOf(r.KeepHistory),
+		}
+		for _, p := range valueOf(r.Patterns) {
+			cfg.Redaction.Patterns = append(cfg.Redaction.Patterns, domain.RedactionPattern{Name: p.Name, Regex: p.Regex})
+		}
+	}
+	if m := c.Moderation; m != nil {
+		cfg.Moderation = domain.ModerationConfig{
+			Classifier: string(valueOf(m.Classifier)),
+			Model:      valueOf(m.Model),
+			ModerationPolicy: domain.ModerationPolicy{
+				Input:  domain.ModerationAction(valueOf(m.Input)),
+				Output: domain.ModerationAction(valueOf(m.Output)),
+			},
+		}
+	}
+	if img := c.Images; img != nil {
+		cfg.Images = domain.ImagePolicy{
+			Allow:         valueOf(img.Allow),
+			Deny:          valueOf(img.Deny),
+			PullPolicy:    domain.PullPolicy(valueOf(img.PullPolicy)),
+			Pins:          valueOf(img.Pins),
+			RequireDigest: valueOf(img.RequireDigest),
+		}
+	}
+	if a := c.ChatArtifacts; a != nil {
+		cfg.ChatArtifacts = domain.ChatArtifactPolicy{
+			Disabled:   valueOf(a.Disabled),
+			Extensions: valueOf(a.Extensions),
+			MinBytes:   valueOf(a.MinBytes),
+			MaxBytes:   valueOf(a.MaxBytes),
+		}
+	}
+	if w := c.ModelWarmup; w != nil {
+		cfg.ModelWarmup = domain.ModelWarmup{
+			Disabled:         valueOf(w.Disabled),
+			Models:           valueOf(w.Models),
+			KeepAliveMinutes: valueOf(w.KeepAliveMinutes),
+		}
+	}
+	return cfg
+}
@@ -0,0 +1,313 @@
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SSE connection defaults; see SetSSEConfig.
+const (
+	DefaultSSEHeartbeat    = 15 * time.Second
+	DefaultSSEIdleTimeout  = 30 * time.Minute
+	DefaultSSEMaxConns     = 256
+	DefaultSSEMaxPerClient = 16
+)
+
+// SSEConfig bounds the kernel's Server-Sent Event streams.
+type SSEConfig struct {
+	Heartbeat    time.Duration // interval of ": ping" comments keeping proxies from closing idle streams
+	IdleTimeout  time.Duration // close a stream after this long without events; clients reconnect
+	MaxConns     int           // open streams across all clients
+	MaxPerClient int           // open streams per API key or IP
+}
+
+// SetSSEConfig changes the stream limits; zero fields keep their defaults.
+func (s *Server) SetSSEConfig(cfg SSEConfig) {
+	s.sse.configure(cfg)
+}
+
+// sseHub tracks open SSE streams so limits can be enforced and dead
+// clients show up in /v1/system/sse.
+type sseHub struct {
+	mu        sync.Mutex
+	cfg       SSEConfig
+	conns     map[int64]*sseConn
+	perClient map[string]int
+	nextID    int64
+	opened    int64
+	rejected  int64
+	idled     int64
+	pings     int64
+}
+
+// sseConn is one open stream.
+type sseConn struct {
+	ID        int64     `json:"id"`
+	Stream    string    `json:"stream"` // "job", "conversation", "workflow", "broadcast", "chat" or "model_pull"
+	Client    string    `json:"client"`
+	Since     time.Time `json:"since"`
+	LastEvent time.Time `json:"last_event"`
+	Events    int64     `json:"events"`
+
+	client string // unmasked, for the per-client count
+}
+
+func newSSEHub() *sseHub {
+	h := &sseHub{conns: make(map[int64]*sseConn), perClient: make(map[string]int)}
+	h.configure(SSEConfig{})
+	return h
+}
+
+func (h *sseHub) configure(cfg SSEConfig) {
+	if cfg.Heartbeat <= 0 {
+		cfg.Heartbeat = DefaultSSEHeartbeat
+	}
+	if cfg.IdleTimeout <= 0 {
+		cfg.IdleTimeout = DefaultSSEIdleTimeout
+	}
+	if cfg.MaxConns <= 0 {
+		cfg.MaxConns = DefaultSSEMaxConns
+	}
+	if cfg.MaxPerClient <= 0 {
+		cfg.MaxPerClient = DefaultSSEMaxPerClient
+	}
+	h.mu.Lock()
+	h.cfg = cfg
+	h.mu.Unlock()
+}
+
+// register admits a stream, or returns the status to refuse it with.
+func (h *sseHub) register(stream, client string) (*sseConn, int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.conns) >= h.cfg.MaxConns {
+		h.rejected++
+		return nil, http.StatusServiceUnavailable
+	}
+	if h.perClient[client] >= h.cfg.MaxPerClient {
+		h.rejected++
+		return nil, http.StatusTooManyRequests
+	}
+	h.nextID++
+	h.opened++
+	now := time.Now()
+	c := &sseConn{ID: h.nextID, Stream: stream, Client: maskClient(client), Since: now, LastEvent: now, client: client}
+	h.conns[c.ID] = c
+	h.perClient[client]++
+	return c, 0
+}
+
+func (h *sseHub) release(c *sseConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.conns[c.ID]; !ok {
+		return
+	}
+	delete(h.conns, c.ID)
+	if h.perClient[c.client]--; h.perClient[c.client] <= 0 {
+		delete(h.perClient, c.client)
+	}
+}
+
+// maskClient keeps API keys out of the stats: "key:abcd…".
+func maskClient(client string) string {
+	if len(client) > 8 && client[:4] == "key:" {
+		return client[:8] + "…"
+	}
+	return client
+}
+
+// sseStream is an open, registered SSE response.
+type sseStream struct {
+	hub     *sseHub
+	conn    *sseConn
+	w       http.ResponseWriter
+	flusher http.Flusher
+	r       *http.Request
+}
+
+// openSSE registers a stream of the given kind and writes the SSE headers.
+// When the client is over its limit, or streaming is unsupported, it
+// writes the error response and returns false. Callers must Close the stream.
+func (s *Server) openSSE(w http.ResponseWriter, r *http.Request, stream string) (*sseStream, bool) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return nil, false
+	}
+	conn, status := s.sse.register(stream, clientID(r))
+	if conn == nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(map[string]string{"error": "too many event streams"})
+		return nil, false
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return &sseStream{hub: s.sse, conn: conn, w: w, flusher: flusher, r: r}, true
+}
+
+// Close unregisters the stream.
+func (st *sseStream) Close() {
+	st.hub.release(st.conn)
+}
+
+// Send writes one event. An error means the client is gone.
+func (st *sseStream) Send(eventType, data string) error {
+	if _, err := fmt.Fprintf(st.w, "event: %s\ndata: %s\n\n", eventType, data); err != nil {
+		return err
+	}
+	st.flusher.Flush()
+	st.hub.mu.Lock()
+	st.conn.Events++
+	st.conn.LastEvent = time.Now()
+	st.hub.mu.Unlock()
+	return nil
+}
+
+// ping writes a keepalive comment, which EventSource clients ignore.
+func (st *sseStream) ping() error {
+	if _, err := fmt.Fprint(st.w, ": ping\n\n"); err != nil {
+		return err
+	}
+	st.flusher.Flush()
+	st.hub.mu.Lock()
+	st.hub.pings++
+	st.hub.mu.Unlock()
+	return nil
+}
+
+// idle reports whether the stream has gone without events past the idle
+// timeout, counting it when so.
+func (st *sseStream) idle() bool {
+	st.hub.mu.Lock()
+	defer st.hub.mu.Unlock()
+	if time.Since(st.conn.LastEvent) < st.hub.cfg.IdleTimeout {
+		return false
+	}
+	st.hub.idled++
+	return true
+}
+
+// heartbeat returns a ticker for the keepalive interval.
+func (st *sseStream) heartbeat() *time.Ticker {
+	st.hub.mu.Lock()
+	defer st.hub.mu.Unlock()
+	return time.NewTicker(st.hub.cfg.Heartbeat)
+}
+
+// tick pings the client on a heartbeat. It returns false when the stream
+// should end: the client is gone or the stream has idled out.
+func (st *sseStream) tick() bool {
+	return !st.idle() && st.ping() == nil
+}
+
+// pump relays bus events until the client disconnects, the channel
+// closes, the stream idles out, or stop reports true for a sent event.
+func (st *sseStream) pump(ch <-chan services.Event, stop func(services.Event) bool) {
+	ticker := st.heartbeat()
+	defer ticker.Stop()
+	ctx := st.r.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !st.tick() {
+				return
+			}
+		case evt, ok := <-ch:
+			if !ok {
+				return
+			}
+			if st.Send(string(evt.Type), sseData(evt)) != nil {
+				return
+			}
+			if stop != nil && stop(evt) {
+				return
+			}
+		}
+	}
+}
+
+// sseStats is the /v1/system/sse payload.
+type sseStats struct {
+	Open               int            `json:"open"`
+	ByStream           map[string]int `json:"by_stream"`
+	MaxConns           int            `json:"max_conns"`
+	MaxPerClient       int            `json:"max_per_client"`
+	HeartbeatSeconds   float64        `json:"heartbeat_seconds"`
+	IdleTimeoutSeconds float64        `json:"idle_timeout_seconds"`
+	OpenedTotal        int64          `json:"opened_total"`
+	RejectedTotal      int64          `json:"rejected_total"`
+	IdledTotal         int64          `json:"idled_total"`
+	PingsTotal         int64          `json:"pings_total"`
+	Connections        []sseConn      `json:"connections"`
+}
+
+func (h *sseHub) stats() sseStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	st := sseStats{
+		Open:               len(h.conns),
+		ByStream:           make(map[string]int),
+		MaxConns:           h.cfg.MaxConns,
+		MaxPerClient:       h.cfg.MaxPerClient,
+		HeartbeatSeconds:   h.cfg.Heartbeat.Seconds(),
+		IdleTimeoutSeconds: h.cfg.IdleTimeout.Seconds(),
+		OpenedTotal:        h.opened,
+		RejectedTotal:      h.rejected,
+		IdledTotal:         h.idled,
+		PingsTotal:         h.pings,
+		Connections:        make([]sseConn, 0, len(h.conns)),
+	}
+	for _, c := range h.conns {
+		st.ByStream[c.Stream]++
+		st.Connections = append(st.Connections, *c)
+	}
+	sort.Slice(st.Connections, func(i, j int) bool { return st.Connections[i].ID < st.Connections[j].ID })
+	return st
+}
+
+// GetSSEStats implements StrictServerInterface. It reports open event
+// streams and their limits.
+func (s *Server) GetSSEStats(ctx context.Context, request GetSSEStatsRequestObject) (GetSSEStatsResponseObject, error) {
+	st := s.sse.stats()
+	out := GetSSEStats200JSONResponse{
+		Open:               st.Open,
+		ByStream:           st.ByStream,
+		MaxConns:           st.MaxConns,
+		MaxPerClient:       st.MaxPerClient,
+		HeartbeatSeconds:   st.HeartbeatSeconds,
+		IdleTimeoutSeconds: st.IdleTimeoutSeconds,
+		OpenedTotal:        st.OpenedTotal,
+		RejectedTotal:      st.RejectedTotal,
+		IdledTotal:         st.IdledTotal,
+		PingsTotal:         st.PingsTotal,
+		Connections:        make([]SSEConnection, 0, len(st.Connections)),
+	}
+	for _, c := range st.Connections {
+		out.Connections = append(out.Connections, SSEConnection{
+			Id:        c.ID,
+			Stream:    SSEConnectionStream(c.Stream),
+			Client:    c.Client,
+			Since:     c.Since,
+			LastEvent: c.LastEvent,
+			Events:    c.Events,
+		})
+	}
+	return out, nil
+}
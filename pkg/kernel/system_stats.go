@@ -0,0 +1,81 @@
+package kernel
+
+import (
+	"context"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetResourceMonitor enables the /v1/system/stats API.
+func (s *Server) SetResourceMonitor(m *services.ResourceMonitor) {
+	s.resources = m
+}
+
+// GetSystemStats implements StrictServerInterface. It returns host CPU,
+// memory and disk usage, the database size and Docker daemon stats.
+// refresh=true samples now instead of returning the monitor's latest
+// sample.
+func (s *Server) GetSystemStats(ctx context.Context, request GetSystemStatsRequestObject) (GetSystemStatsResponseObject, error) {
+	if s.resources == nil {
+		return GetSystemStats503JSONResponse{Error: toPtr("resource monitor not configured")}, nil
+	}
+	var stats domain.SystemStats
+	if valueOf(request.Params.Refresh) {
+		stats = s.resources.Sample(ctx)
+	} else {
+		stats = s.resources.Latest(ctx)
+	}
+	return GetSystemStats200JSONResponse(systemStatsToAPI(stats)), nil
+}
+
+func systemStatsToAPI(st domain.SystemStats) SystemStats {
+	out := SystemStats{
+		SampledAt:   st.SampledAt,
+		Disks:       make([]DiskStats, 0, len(st.Disks)),
+		DbSizeBytes: st.DBSizeBytes,
+		Warnings:    st.Warnings,
+	}
+	if out.Warnings == nil {
+		out.Warnings = []string{}
+	}
+	if c := st.CPU; c != nil {
+		out.Cpu = &CPUStats{Cores: c.Cores, UsagePercent: c.UsagePercent, Load1: c.Load1, Load5: c.Load5, Load15: c.Load15}
+	}
+	if m := st.Memory; m != nil {
+		out.Memory = &MemoryStats{
+			TotalBytes:     int64(m.TotalBytes),
+			AvailableBytes: int64(m.AvailableBytes),
+			UsedBytes:      int64(m.UsedBytes),
+			UsedPercent:    m.UsedPercent,
+		}
+	}
+	for _, d := range st.Disks {
+		out.Disks = append(out.Disks, DiskStats{
+			Label:       d.Label,
+			Path:        d.Path,
+			TotalBytes:  int64(d.TotalBytes),
+			FreeBytes:   int64(d.FreeBytes),
+			UsedBytes:   int64(d.UsedBytes),
+			UsedPercent: d.UsedPercent,
+		})
+	}
+	if d := st.Docker; d != nil {
+		out.Docker = &ContainerRuntimeStats{
+			Available:         d.Available,
+			Error:             optString(d.Error),
+			Version:           optString(d.Version),
+			Containers:        d.Containers,
+			ContainersRunning: d.ContainersRunning,
+			ContainersPaused:  d.ContainersPaused,
+			ContainersStopped: d.ContainersStopped,
+			Images:            d.Images,
+			Cpus:              d.CPUs,
+			MemoryBytes:       d.MemoryBytes,
+		}
+	}
+	if len(st.Errors) > 0 {
+		out.Errors = &st.Errors
+	}
+	return out
+}
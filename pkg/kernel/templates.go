@@ -0,0 +1,92 @@
+package kernel
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetTemplateGallery enables the /v1/templates API.
+func (s *Server) SetTemplateGallery(g *services.TemplateGallery) {
+	s.templates = g
+}
+
+// handleTemplates routes the /v1/templates/* API:
+//
+//	GET  /v1/templates              list built-in and community templates
+//	GET  /v1/templates/{id}         get a template with its personas and workflows
+//	POST /v1/templates/{id}/install create its personas and workflows
+//	                                body (optional): {"project_id": "..."}
+func (s *Server) handleTemplates(w http.ResponseWriter, r *http.Request) {
+	if s.templates == nil {
+		http.Error(w, "template gallery not enabled", http.StatusServiceUnavailable)
+		return
+	}
+
+	rest := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/templates"), "/")
+	parts := strings.Split(rest, "/")
+
+	switch {
+	case rest == "" && r.Method == "GET":
+		templates := s.templates.List()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"templates": templates,
+			"count":     len(templates),
+		})
+	case len(parts) == 1 && r.Method == "GET":
+		t, err := s.templates.Get(domain.TemplateID(parts[0]))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(t)
+	case len(parts) == 2 && parts[1] == "install" && r.Method == "POST":
+		s.handleInstallTemplate(w, r, domain.TemplateID(parts[0]))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) handleInstallTemplate(w http.ResponseWriter, r *http.Request, id domain.TemplateID) {
+	var body struct {
+		ProjectID *domain.ProjectID `json:"project_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if body.ProjectID != nil && *body.ProjectID == "" {
+		body.ProjectID = nil
+	}
+	if body.ProjectID != nil {
+		if _, err := s.repo.GetProject(r.Context(), *body.ProjectID); err != nil {
+			if errors.Is(err, domain.ErrProjectNotFound) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	installed, err := s.templates.Install(r.Context(), id, body.ProjectID)
+	if err != nil {
+		if errors.Is(err, domain.ErrTemplateNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		s.logger.Error("failed to install template", "template_id", id, "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(installed)
+}
@@ -0,0 +1,78 @@
+package kernel
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetUsageMeter enables the /v1/usage API.
+func (s *Server) SetUsageMeter(m *services.UsageMeter) {
+	s.usage = m
+}
+
+// handleUsage routes the /v1/usage/* API; {scope} is conversation or project:
+//
+//	GET    /v1/usage/{scope}/{id}    token/cost totals and the limit, if any
+//	PUT    /v1/usage/{scope}/{id}    set the limit: {"max_tokens"?: n, "max_cost_usd"?: x}
+//	DELETE /v1/usage/{scope}/{id}    lift the limit
+func (s *Server) handleUsage(w http.ResponseWriter, r *http.Request) {
+	if s.usage == nil {
+		http.Error(w, "usage accounting not enabled", http.StatusServiceUnavailable)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/usage"), "/"), "/")
+	if len(parts) != 2 || parts[1] == "" {
+		http.NotFound(w, r)
+		return
+	}
+	scope, id := domain.UsageScope(parts[0]), parts[1]
+	if scope != domain.UsageScopeConversation && scope != domain.UsageScopeProject {
+		http.Error(w, "scope must be conversation or project", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		status, err := s.usage.Status(r.Context(), scope, id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(status)
+	case "PUT":
+		var req struct {
+			MaxTokens  int     `json:"max_tokens"`
+			MaxCostUSD float64 `json:"max_cost_usd"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		l, err := s.usage.SetLimit(r.Context(), domain.UsageLimit{Scope: scope, ScopeID: id, MaxTokens: req.MaxTokens, MaxCostUSD: req.MaxCostUSD})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(l)
+	case "DELETE":
+		if err := s.usage.DeleteLimit(r.Context(), scope, id); err != nil {
+			if errors.Is(err, domain.ErrUsageLimitNotFound) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,60 @@
+package kernel
+
+import (
+	"context"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetBuildInfo sets what /v1/system/version and diagnostic bundles report.
+func (s *Server) SetBuildInfo(info domain.BuildInfo) {
+	s.build = info
+}
+
+// SetUpdateChecker adds the latest release check to /v1/system/version.
+func (s *Server) SetUpdateChecker(u *services.UpdateChecker) {
+	s.updates = u
+}
+
+// buildInfo falls back to the toolchain's VCS details when the build was
+// not set.
+func (s *Server) buildInfo() domain.BuildInfo {
+	if s.build.Version == "" {
+		return domain.ReadBuildInfo("", "", "")
+	}
+	return s.build
+}
+
+// GetVersion implements StrictServerInterface. It reports the kernel build
+// and, when update checks are on, the result of the last one.
+func (s *Server) GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error) {
+	b := s.buildInfo()
+	out := VersionInfo{
+		Version:       b.Version,
+		GitSha:        optString(b.GitSHA),
+		BuildDate:     optString(b.BuildDate),
+		CommitTime:    optString(b.CommitTime),
+		GoVersion:     b.GoVersion,
+		Os:            b.OS,
+		Arch:          b.Arch,
+		SchemaVersion: b.SchemaVersion,
+	}
+	if b.Dirty {
+		out.Dirty = &b.Dirty
+	}
+	if s.updates != nil {
+		if info, ok := s.updates.Status(); ok {
+			out.Update = &UpdateInfo{
+				Current:     info.Current,
+				Latest:      optString(info.Latest),
+				Available:   info.Available,
+				Url:         optString(info.URL),
+				PublishedAt: optTime(info.PublishedAt),
+				CheckedAt:   info.CheckedAt,
+				Error:       optString(info.Error),
+			}
+		}
+	}
+	return GetVersion200JSONResponse(out), nil
+}
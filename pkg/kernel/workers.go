@@ -0,0 +1,178 @@
+package kernel
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/ports"
+)
+
+// GetWorker implements StrictServerInterface. It returns the stored worker
+// record merged with its live container inspection.
+func (s *Server) GetWorker(ctx context.Context, request GetWorkerRequestObject) (GetWorkerResponseObject, error) {
+	detail, notFound, err := s.workerDetail(ctx, domain.WorkerID(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	if notFound {
+		return GetWorker404JSONResponse{Error: toPtr(domain.ErrWorkerNotFound.Error())}, nil
+	}
+	return GetWorker200JSONResponse(workerDetailToAPI(detail)), nil
+}
+
+// KillWorker implements StrictServerInterface. It force-kills the worker's
+// container and marks the worker exited.
+func (s *Server) KillWorker(ctx context.Context, request KillWorkerRequestObject) (KillWorkerResponseObject, error) {
+	id := domain.WorkerID(request.Id)
+	detail, notFound, err := s.workerDetail(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if notFound {
+		return KillWorker404JSONResponse{Error: toPtr(domain.ErrWorkerNotFound.Error())}, nil
+	}
+
+	if err := s.workerMgr.Kill(ctx, id); err != nil {
+		s.logger.Error("failed to kill worker", "worker_id", id, "error", err)
+		return nil, err
+	}
+	if detail.Worker != nil {
+		if err := s.repo.UpdateWorkerStatus(ctx, id, domain.HealthStatusExited); err != nil && !errors.Is(err, domain.ErrWorkerNotFound) {
+			s.logger.Warn("worker killed but status not updated", "worker_id", id, "error", err)
+		}
+	}
+	s.logger.Info("worker killed by operator", "worker_id", id, "node_id", detail.NodeID)
+	return KillWorker204Response{}, nil
+}
+
+// workerDetail loads the stored worker and inspects its container.
+// notFound is set when neither exists.
+func (s *Server) workerDetail(ctx context.Context, id domain.WorkerID) (detail domain.WorkerDetail, notFound bool, err error) {
+	detail = domain.WorkerDetail{ID: id, NodeID: domain.LocalNodeID}
+	if placed, ok := s.workerMgr.(interface {
+		NodeOf(id domain.WorkerID) domain.NodeID
+	}); ok {
+		detail.NodeID = placed.NodeOf(id)
+	}
+
+	stored, err := s.repo.GetWorker(ctx, id)
+	switch {
+	case err == nil:
+		detail.Worker = &stored
+	case !errors.Is(err, domain.ErrWorkerNotFound):
+		return detail, false, err
+	}
+
+	inspector, ok := s.workerMgr.(ports.WorkerInspector)
+	if !ok {
+		detail.InspectError = domain.ErrInspectUnsupported.Error()
+		return detail, detail.Worker == nil, nil
+	}
+	info, err := inspector.Inspect(ctx, id)
+	switch {
+	case err == nil:
+		detail.Container = &info
+	case errors.Is(err, domain.ErrWorkerNotFound):
+		detail.InspectError = "container not found"
+		return detail, detail.Worker == nil, nil
+	default:
+		detail.InspectError = err.Error()
+	}
+	return detail, false, nil
+}
+
+// GetWorkerLogs implements StrictServerInterface. It returns the last lines
+// a worker wrote without following, so logs of exited workers stay readable
+// while their container exists. tail defaults to domain.DefaultWorkerLogTail
+// and is capped at domain.MaxWorkerLogTail; format=text returns plain text.
+func (s *Server) GetWorkerLogs(ctx context.Context, request GetWorkerLogsRequestObject) (GetWorkerLogsResponseObject, error) {
+	id := domain.WorkerID(request.Id)
+	tail := domain.ClampWorkerLogTail(valueOf(request.Params.Tail))
+
+	reader, ok := s.workerMgr.(ports.WorkerLogReader)
+	if !ok {
+		return GetWorkerLogs501JSONResponse{Error: toPtr(domain.ErrLogTailUnsupported.Error())}, nil
+	}
+	lines, err := reader.TailLogs(ctx, id, tail)
+	switch {
+	case errors.Is(err, domain.ErrWorkerNotFound), errors.Is(err, domain.ErrNodeNotFound):
+		return GetWorkerLogs404JSONResponse{Error: toPtr(err.Error())}, nil
+	case errors.Is(err, domain.ErrLogTailUnsupported):
+		return GetWorkerLogs501JSONResponse{Error: toPtr(err.Error())}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if valueOf(request.Params.Format) == Text {
+		var b strings.Builder
+		for _, l := range lines {
+			b.WriteString(l.Text + "\n")
+		}
+		return GetWorkerLogs200TextResponse(b.String()), nil
+	}
+	resp := WorkerLogsResponse{
+		WorkerId: string(id),
+		Tail:     tail,
+		Lines:    make([]WorkerLogLine, 0, len(lines)),
+		Count:    len(lines),
+	}
+	for _, l := range lines {
+		resp.Lines = append(resp.Lines, WorkerLogLine{
+			Time:   optTime(l.Time),
+			Stream: WorkerLogLineStream(l.Stream),
+			Text:   l.Text,
+		})
+	}
+	return GetWorkerLogs200JSONResponse(resp), nil
+}
+
+func workerDetailToAPI(d domain.WorkerDetail) WorkerDetail {
+	resp := WorkerDetail{
+		Id:           string(d.ID),
+		NodeId:       string(d.NodeID),
+		InspectError: optString(d.InspectError),
+	}
+	if d.Worker != nil {
+		w := workerToAPI(*d.Worker)
+		resp.Worker = &w
+	}
+	if c := d.Container; c != nil {
+		insp := WorkerInspection{
+			ContainerId:   c.ContainerID,
+			Image:         c.Image,
+			State:         c.State,
+			Running:       c.Running,
+			StartedAt:     optTime(c.StartedAt),
+			FinishedAt:    optTime(c.FinishedAt),
+			UptimeSeconds: c.UptimeSeconds,
+			ExitCode:      c.ExitCode,
+			OomKilled:     optValue(c.OOMKilled),
+			Error:         optString(c.Error),
+			RestartCount:  c.RestartCount,
+		}
+		insp.Mounts = make([]struct {
+			Destination string `json:"destination"`
+			ReadOnly    bool   `json:"read_only"`
+			Source      string `json:"source"`
+		}, len(c.Mounts))
+		for i, m := range c.Mounts {
+			insp.Mounts[i].Source = m.Source
+			insp.Mounts[i].Destination = m.Destination
+			insp.Mounts[i].ReadOnly = m.ReadOnly
+		}
+		if r := c.Resources; r != nil {
+			insp.Resources = &WorkerResources{
+				CpuPercent:       r.CPUPercent,
+				MemoryBytes:      int64(r.MemoryBytes),
+				MemoryLimitBytes: optValue(int64(r.MemoryLimitBytes)),
+				Pids:             int64(r.PIDs),
+				NetRxBytes:       int64(r.NetRxBytes),
+				NetTxBytes:       int64(r.NetTxBytes),
+			}
+		}
+		resp.Container = &insp
+	}
+	return resp
+}
@@ -0,0 +1,88 @@
+package kernel
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/manthysbr/auleOS/internal/core/domain"
+	"github.com/manthysbr/auleOS/internal/core/services"
+)
+
+// SetWorkspaceAudit enables GET /v1/projects/{id}/history.
+func (s *Server) SetWorkspaceAudit(a *services.WorkspaceAudit) {
+	s.audit = a
+}
+
+// projectHistoryPath splits /v1/projects/{id}/history[/{commit}].
+func projectHistoryPath(path string) (projectID, commit string) {
+	rest, ok := strings.CutPrefix(path, "/v1/projects/")
+	if !ok {
+		return "", ""
+	}
+	parts := strings.Split(rest, "/")
+	switch {
+	case len(parts) == 2 && parts[1] == "history" && parts[0] != "":
+		return parts[0], ""
+	case len(parts) == 3 && parts[1] == "history" && parts[0] != "" && parts[2] != "":
+		return parts[0], parts[2]
+	}
+	return "", ""
+}
+
+// handleWorkspaceHistory lists the agent's edits in a project workspace,
+// newest first, or returns one of them as a patch.
+// GET /v1/projects/{id}/history?limit=50
+// GET /v1/projects/{id}/history/{commit}  (text/plain, as git show)
+func (s *Server) handleWorkspaceHistory(w http.ResponseWriter, r *http.Request) {
+	if !s.audit.Enabled() {
+		http.Error(w, "workspace history not configured", http.StatusServiceUnavailable)
+		return
+	}
+	id, commit := projectHistoryPath(r.URL.Path)
+	if _, err := s.repo.GetProject(r.Context(), domain.ProjectID(id)); err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if commit != "" {
+		patch, err := s.audit.Diff(r.Context(), id, commit)
+		if err != nil {
+			if errors.Is(err, domain.ErrWorkspaceCommitNotFound) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			s.logger.Error("failed to read workspace commit", "project_id", id, "commit", commit, "error", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		io.WriteString(w, patch)
+		return
+	}
+
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+	commits, err := s.audit.History(r.Context(), id, limit)
+	if err != nil {
+		s.logger.Error("failed to list workspace history", "project_id", id, "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(commits)
+}
@@ -9,18 +9,10 @@ import { useProjectStore, type Project } from "@/store/projects"
 import { useUIStore } from "@/store/ui"
 import { useQuery } from "@tanstack/react-query"
 import { api } from "@/lib/api"
+import type { components } from "@/lib/api.schema"
 import { cn } from "@/lib/utils"
 
-const API_BASE = "http://localhost:8080"
-
-interface TraceSummary {
-    id: string
-    name: string
-    status: "running" | "ok" | "error" | "cancelled"
-    start_time: string
-    duration_ms: number
-    span_count: number
-}
+type TraceSummary = components["schemas"]["Trace"]
 
 export function Dashboard() {
     const { projects, artifacts, fetchProjects, fetchArtifacts, createProject } = useProjectStore()
@@ -41,12 +33,11 @@ export function Dashboard() {
         refetchInterval: 10000,
     })
 
-    const { data: tracesData } = useQuery<{ traces: TraceSummary[]; count: number }>({
+    const { data: tracesData } = useQuery({
         queryKey: ["traces-dashboard"],
         queryFn: async () => {
-            const res = await fetch(`${API_BASE}/v1/traces?limit=6`)
-            if (!res.ok) return { traces: [], count: 0 }
-            return res.json()
+            const { data } = await api.GET("/v1/traces", { params: { query: { limit: 6 } } })
+            return data ?? { traces: [], count: 0 }
         },
         refetchInterval: 5000,
     })
@@ -219,8 +210,10 @@ function TraceCard({ trace, onClick }: { trace: TraceSummary; onClick: () => voi
     const statusColor = isRunning ? "text-blue-400" : isError ? "text-red-400" : isOk ? "text-green-400" : "text-muted-foreground"
 
     // Detect span kinds from name patterns for visual hints
-    const hasLLM  = trace.name.toLowerCase().includes("chat") || trace.span_count > 2
-    const hasTool = trace.span_count > 3
+    const spanCount = trace.span_count ?? 0
+    const durationMs = trace.duration_ms ?? 0
+    const hasLLM  = (trace.name ?? "").toLowerCase().includes("chat") || spanCount > 2
+    const hasTool = spanCount > 3
 
     return (
         <button
@@ -244,7 +237,7 @@ function TraceCard({ trace, onClick }: { trace: TraceSummary; onClick: () => voi
                 </span>
                 <span className="flex items-center gap-1">
                     <Clock className="w-3 h-3" />
-                    {trace.duration_ms > 1000 ? `${(trace.duration_ms / 1000).toFixed(1)}s` : `${trace.duration_ms}ms`}
+                    {durationMs > 1000 ? `${(durationMs / 1000).toFixed(1)}s` : `${durationMs}ms`}
                 </span>
                 <div className="flex items-center gap-1 ml-auto">
                     {hasLLM  && <span className="px-1.5 py-0.5 rounded bg-purple-500/10 text-purple-400 text-[10px]"><Brain className="w-3 h-3 inline mr-0.5" />LLM</span>}
@@ -252,7 +245,7 @@ function TraceCard({ trace, onClick }: { trace: TraceSummary; onClick: () => voi
                 </div>
             </div>
             <p className="text-[10px] text-muted-foreground/50 mt-1.5">
-                {new Date(trace.start_time).toLocaleString("pt-BR")}
+                {trace.start_time && new Date(trace.start_time).toLocaleString("pt-BR")}
             </p>
         </button>
     )
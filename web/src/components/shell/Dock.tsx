@@ -2,6 +2,7 @@ import { Home, FolderKanban, Bot, Wrench, Activity, Settings, Search, Workflow,
 import { cn } from "@/lib/utils"
 import { useUIStore } from "@/store/ui"
 import { useQuery } from "@tanstack/react-query"
+import { api } from "@/lib/api"
 
 const dockItems = [
     { id: "dashboard" as const, icon: Home, label: "Home" },
@@ -22,9 +23,8 @@ export function Dock() {
     const { data: inboxStatus } = useQuery({
         queryKey: ["kernel-inbox-badge"],
         queryFn: async () => {
-            const res = await fetch("http://localhost:8080/v1/system/inbox")
-            if (!res.ok) return { unread_count: 0 }
-            return res.json() as Promise<{ unread_count: number }>
+            const { data } = await api.GET("/v1/system/inbox")
+            return data ?? { unread_count: 0 }
         },
         refetchInterval: 10000,
     })
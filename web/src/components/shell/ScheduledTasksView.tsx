@@ -1,26 +1,13 @@
 import { useState, useEffect, useCallback } from "react"
 import { CalendarClock, Plus, Trash2, Play, Pause, Clock, RefreshCw, Zap, ChevronDown, ChevronUp } from "lucide-react"
 import { cn } from "@/lib/utils"
+import { api } from "@/lib/api"
+import type { components } from "@/lib/api.schema"
 
-interface ScheduledTask {
-    id: string
-    name: string
-    prompt: string
-    command?: string
-    type: "one_shot" | "recurring" | "cron"
-    cron_expr?: string
-    interval_sec?: number
-    next_run: string
-    last_run?: string
-    last_result?: string
-    run_count: number
-    status: "active" | "paused" | "completed" | "failed"
-    created_at: string
-    created_by?: string
-    deliver?: boolean
-}
+type ScheduledTask = components["schemas"]["ScheduledTask"]
 
-function formatNextRun(dateStr: string): string {
+function formatNextRun(dateStr?: string): string {
+    if (!dateStr) return "not scheduled"
     const d = new Date(dateStr)
     const now = new Date()
     const diff = d.getTime() - now.getTime()
@@ -101,9 +88,8 @@ export function ScheduledTasksView() {
 
     const fetchTasks = useCallback(async () => {
         try {
-            const res = await fetch("/v1/tasks")
-            if (!res.ok) throw new Error(await res.text())
-            const data = await res.json()
+            const { data, error } = await api.GET("/v1/tasks")
+            if (error) throw new Error(JSON.stringify(error))
             setTasks(data.tasks ?? [])
             setError(null)
         } catch (e: unknown) {
@@ -120,12 +106,12 @@ export function ScheduledTasksView() {
     }, [fetchTasks])
 
     const toggle = async (id: string) => {
-        await fetch(`/v1/tasks/${id}/toggle`, { method: "POST" })
+        await api.POST("/v1/tasks/{id}/toggle", { params: { path: { id } } })
         fetchTasks()
     }
 
     const deleteTask = async (id: string) => {
-        await fetch(`/v1/tasks/${id}`, { method: "DELETE" })
+        await api.DELETE("/v1/tasks/{id}", { params: { path: { id } } })
         fetchTasks()
     }
 
@@ -133,7 +119,7 @@ export function ScheduledTasksView() {
         if (!form.name.trim() || !form.prompt.trim()) return
         setSubmitting(true)
         try {
-            const body: Record<string, unknown> = {
+            const body: ScheduledTask = {
                 name: form.name,
                 prompt: form.prompt,
                 type: form.type,
@@ -142,12 +128,8 @@ export function ScheduledTasksView() {
             }
             if (form.type === "cron") body.cron_expr = form.cron_expr
             if (form.type === "recurring") body.interval_sec = form.interval_sec
-            const res = await fetch("/v1/tasks", {
-                method: "POST",
-                headers: { "Content-Type": "application/json" },
-                body: JSON.stringify(body),
-            })
-            if (!res.ok) throw new Error(await res.text())
+            const { error } = await api.POST("/v1/tasks", { body })
+            if (error) throw new Error(JSON.stringify(error))
             await fetchTasks()
             setShowCreate(false)
             setForm(EMPTY_FORM)
@@ -307,7 +289,7 @@ export function ScheduledTasksView() {
                                         {formatNextRun(task.next_run)}
                                     </span>
                                     <span className="text-[10px] text-muted-foreground">
-                                        {task.run_count} run{task.run_count !== 1 ? "s" : ""}
+                                        {task.run_count ?? 0} run{task.run_count !== 1 ? "s" : ""}
                                     </span>
                                 </div>
                             </div>
@@ -315,21 +297,21 @@ export function ScheduledTasksView() {
                             {/* Actions */}
                             <div className="flex items-center gap-1 flex-shrink-0">
                                 <button
-                                    onClick={() => toggle(task.id)}
+                                    onClick={() => task.id && toggle(task.id)}
                                     className="w-7 h-7 rounded-lg flex items-center justify-center text-muted-foreground hover:text-foreground hover:bg-accent transition-colors"
                                     title={task.status === "active" ? "Pause" : "Resume"}
                                 >
                                     {task.status === "active" ? <Pause className="w-3.5 h-3.5" /> : <Play className="w-3.5 h-3.5" />}
                                 </button>
                                 <button
-                                    onClick={() => deleteTask(task.id)}
+                                    onClick={() => task.id && deleteTask(task.id)}
                                     className="w-7 h-7 rounded-lg flex items-center justify-center text-muted-foreground hover:text-red-400 hover:bg-red-400/10 transition-colors"
                                     title="Delete"
                                 >
                                     <Trash2 className="w-3.5 h-3.5" />
                                 </button>
                                 <button
-                                    onClick={() => setExpandedId(v => v === task.id ? null : task.id)}
+                                    onClick={() => setExpandedId(v => v === task.id ? null : task.id ?? null)}
                                     className="w-7 h-7 rounded-lg flex items-center justify-center text-muted-foreground hover:text-foreground hover:bg-accent transition-colors"
                                 >
                                     {expandedId === task.id ? <ChevronUp className="w-3.5 h-3.5" /> : <ChevronDown className="w-3.5 h-3.5" />}
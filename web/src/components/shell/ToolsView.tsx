@@ -1,42 +1,39 @@
 import { useState, useCallback } from "react"
 import { useQuery } from "@tanstack/react-query"
 import { api } from "@/lib/api"
+import type { components } from "@/lib/api.schema"
 import { Wrench, Puzzle, Cpu, Loader2, Zap, Server, Play, ChevronRight, CheckCircle2, XCircle, FlaskConical, LayoutGrid, Clock } from "lucide-react"
 import { cn } from "@/lib/utils"
 
 // ─── Types ────────────────────────────────────────────────────────────────────
 
-interface ToolDTO {
-    name: string
-    description: string
-    execution_type: "native" | "wasm" | "docker" | string
-    parameters: {
-        type: string
-        properties: Record<string, { type: string; description?: string; enum?: string[] }>
-        required?: string[]
-    }
-}
+type ToolDTO = components["schemas"]["Tool"]
+type RunResult = components["schemas"]["ToolRunResult"]
 
-interface RunResult {
-    ok: boolean
-    tool: string
-    result?: unknown
-    error?: string
-    duration_ms: number
+// ToolParams is the JSON Schema a tool declares for its parameters.
+interface ToolParams {
+    type: string
+    properties: Record<string, { type: string; description?: string; enum?: string[] }>
+    required?: string[]
 }
 
 // ─── Helpers ──────────────────────────────────────────────────────────────────
 
-function execBadge(type: string) {
+function execBadge(type?: string) {
     if (type === "wasm") return { label: "wasm", cls: "bg-violet-500/10 text-violet-400" }
     if (type === "docker") return { label: "docker", cls: "bg-cyan-500/10 text-cyan-400" }
     return { label: "native", cls: "bg-emerald-500/10 text-emerald-400" }
 }
 
+function paramsOf(tool: ToolDTO): ToolParams | undefined {
+    return tool.parameters as ToolParams | undefined
+}
+
 function buildDefaultParams(tool: ToolDTO): string {
-    if (!tool.parameters?.properties) return "{}"
+    const params = paramsOf(tool)
+    if (!params?.properties) return "{}"
     const obj: Record<string, unknown> = {}
-    for (const [key, schema] of Object.entries(tool.parameters.properties)) {
+    for (const [key, schema] of Object.entries(params.properties)) {
         if (schema.enum) obj[key] = schema.enum[0]
         else if (schema.type === "number" || schema.type === "integer") obj[key] = 0
         else if (schema.type === "boolean") obj[key] = false
@@ -55,13 +52,18 @@ function ToolPlayground() {
     const [result, setResult] = useState<RunResult | null>(null)
     const [parseError, setParseError] = useState<string | null>(null)
 
-    const { data, isLoading } = useQuery<{ tools: ToolDTO[]; count: number }>({
+    const { data, isLoading } = useQuery({
         queryKey: ["tools-list"],
-        queryFn: () => fetch("/v1/tools").then(r => r.json()),
+        queryFn: async () => {
+            const { data, error } = await api.GET("/v1/tools")
+            if (error) throw error
+            return data
+        },
         refetchInterval: 15000,
     })
 
     const tools = data?.tools ?? []
+    const selectedParams = selected ? paramsOf(selected) : undefined
 
     const selectTool = useCallback((t: ToolDTO) => {
         setSelected(t)
@@ -72,7 +74,7 @@ function ToolPlayground() {
 
     const run = useCallback(async () => {
         if (!selected) return
-        let body: unknown
+        let body: { params?: Record<string, unknown>; timeout_seconds?: number }
         try {
             body = JSON.parse(input)
             setParseError(null)
@@ -84,13 +86,12 @@ function ToolPlayground() {
         setRunning(true)
         setResult(null)
         try {
-            const res = await fetch(`/v1/tools/${encodeURIComponent(selected.name)}/run`, {
-                method: "POST",
-                headers: { "Content-Type": "application/json" },
-                body: JSON.stringify(body),
+            const { data, error } = await api.POST("/v1/tools/{name}/run", {
+                params: { path: { name: selected.name ?? "" } },
+                body,
             })
-            const json: RunResult = await res.json()
-            setResult(json)
+            // Failed and timed-out runs carry a ToolRunResult too
+            setResult(data ?? error ?? { ok: false, tool: selected.name, error: "tool registry unavailable" })
         } catch (e: unknown) {
             setResult({ ok: false, tool: selected.name, error: (e as Error).message, duration_ms: 0 })
         } finally {
@@ -177,20 +178,20 @@ function ToolPlayground() {
                                 </span>
                             </div>
                             {/* Param schema summary */}
-                            {selected.parameters?.properties && (
+                            {selectedParams?.properties && (
                                 <div className="mt-3 flex flex-wrap gap-1">
-                                    {Object.entries(selected.parameters.properties).map(([k, v]) => (
+                                    {Object.entries(selectedParams.properties).map(([k, v]) => (
                                         <span
                                             key={k}
                                             className={cn(
                                                 "text-[10px] font-mono px-2 py-0.5 rounded-full border",
-                                                selected.parameters.required?.includes(k)
+                                                selectedParams.required?.includes(k)
                                                     ? "border-amber-500/30 text-amber-400 bg-amber-500/5"
                                                     : "border-border/50 text-muted-foreground"
                                             )}
                                         >
                                             {k}: {v.type}
-                                            {selected.parameters.required?.includes(k) ? " *" : ""}
+                                            {selectedParams.required?.includes(k) ? " *" : ""}
                                         </span>
                                     ))}
                                 </div>
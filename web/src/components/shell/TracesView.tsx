@@ -5,50 +5,12 @@ import {
     MessageSquare, Timer,
 } from "lucide-react"
 import { cn } from "@/lib/utils"
+import { api } from "@/lib/api"
+import type { components } from "@/lib/api.schema"
 import { useState } from "react"
 
-const API_BASE = "http://localhost:8080"
-
-interface TraceSummary {
-    id: string
-    name: string
-    status: "running" | "ok" | "error" | "cancelled"
-    start_time: string
-    duration_ms: number
-    span_count: number
-}
-
-interface Span {
-    id: string
-    parent_id?: string
-    trace_id: string
-    name: string
-    kind: "agent" | "llm" | "tool" | "sub_agent" | "workflow" | "step"
-    status: "running" | "ok" | "error" | "cancelled"
-    input?: string
-    output?: string
-    error?: string
-    model?: string
-    attributes?: Record<string, string>
-    start_time: string
-    end_time?: string
-    duration_ms?: number
-    children?: string[]
-}
-
-interface TraceDetail {
-    id: string
-    root_span_id: string
-    name: string
-    status: string
-    conversation_id?: string
-    persona_id?: string
-    start_time: string
-    end_time?: string
-    duration_ms: number
-    span_count: number
-    spans: Span[]
-}
+type Trace = components["schemas"]["Trace"]
+type Span = components["schemas"]["Span"]
 
 // ---- Tokens per kind ----
 const kindMeta: Record<string, { icon: React.ElementType; label: string; bg: string; text: string; bar: string }> = {
@@ -79,8 +41,8 @@ function SpanRow({ span, allSpans, depth = 0, traceDuration }: {
 }) {
     const [expanded, setExpanded] = useState(depth < 2)
     const [detailOpen, setDetailOpen] = useState(false)
-    const km = kindMeta[span.kind] || kindMeta.step
-    const sm = statusMeta[span.status] || statusMeta.ok
+    const km = kindMeta[span.kind ?? "step"] || kindMeta.step
+    const sm = statusMeta[span.status ?? "ok"] || statusMeta.ok
     const Icon = km.icon
     const StatusIconComp = sm.icon
     const children = allSpans.filter(s => s.parent_id === span.id)
@@ -199,12 +161,12 @@ function SpanRow({ span, allSpans, depth = 0, traceDuration }: {
 
 // ---- TraceDetail ----
 function TraceDetail({ traceId }: { traceId: string }) {
-    const { data: trace, isLoading } = useQuery<TraceDetail>({
+    const { data: trace, isLoading } = useQuery({
         queryKey: ["trace", traceId],
         queryFn: async () => {
-            const res = await fetch(`${API_BASE}/v1/traces/${traceId}`)
-            if (!res.ok) throw new Error("Failed to fetch trace")
-            return res.json()
+            const { data, error } = await api.GET("/v1/traces/{id}", { params: { path: { id: traceId } } })
+            if (error) throw new Error("Failed to fetch trace")
+            return data
         },
         refetchInterval: 3000,
     })
@@ -216,13 +178,14 @@ function TraceDetail({ traceId }: { traceId: string }) {
     )
     if (!trace) return null
 
-    const sm = statusMeta[trace.status as string] || statusMeta.ok
+    const sm = statusMeta[trace.status ?? "ok"] || statusMeta.ok
     const StatusIconComp = sm.icon
     const rootSpans = trace.spans?.filter(s => !s.parent_id || s.id === trace.root_span_id) || []
 
     // Count spans by kind
     const byKind = (trace.spans || []).reduce<Record<string, number>>((acc, s) => {
-        acc[s.kind] = (acc[s.kind] || 0) + 1
+        const kind = s.kind ?? "step"
+        acc[kind] = (acc[kind] || 0) + 1
         return acc
     }, {})
 
@@ -247,7 +210,7 @@ function TraceDetail({ traceId }: { traceId: string }) {
                             )}
                             <span className="flex items-center gap-1">
                                 <Clock className="w-3 h-3" />
-                                {new Date(trace.start_time).toLocaleString("pt-BR")}
+                                {trace.start_time && new Date(trace.start_time).toLocaleString("pt-BR")}
                             </span>
                         </div>
                     </div>
@@ -290,7 +253,7 @@ function TraceDetail({ traceId }: { traceId: string }) {
                             key={span.id}
                             span={span}
                             allSpans={trace.spans || []}
-                            traceDuration={trace.duration_ms}
+                            traceDuration={trace.duration_ms ?? 0}
                         />
                     ))}
                 </div>
@@ -303,12 +266,12 @@ function TraceDetail({ traceId }: { traceId: string }) {
 export function TracesView() {
     const [selectedTraceId, setSelectedTraceId] = useState<string | null>(null)
 
-    const { data, isLoading } = useQuery<{ traces: TraceSummary[]; count: number }>({
+    const { data, isLoading } = useQuery({
         queryKey: ["traces"],
         queryFn: async () => {
-            const res = await fetch(`${API_BASE}/v1/traces?limit=50`)
-            if (!res.ok) throw new Error("Failed to fetch traces")
-            return res.json()
+            const { data, error } = await api.GET("/v1/traces", { params: { query: { limit: 50 } } })
+            if (error) throw new Error("Failed to fetch traces")
+            return data
         },
         refetchInterval: 3000,
     })
@@ -351,7 +314,7 @@ export function TracesView() {
                                     key={trace.id}
                                     trace={trace}
                                     selected={selectedTraceId === trace.id}
-                                    onClick={() => setSelectedTraceId(trace.id)}
+                                    onClick={() => setSelectedTraceId(trace.id ?? null)}
                                 />
                             ))}
                         </div>
@@ -375,9 +338,9 @@ export function TracesView() {
 }
 
 function TraceListItem({ trace, selected, onClick }: {
-    trace: TraceSummary; selected: boolean; onClick: () => void
+    trace: Trace; selected: boolean; onClick: () => void
 }) {
-    const sm = statusMeta[trace.status] || statusMeta.ok
+    const sm = statusMeta[trace.status ?? "ok"] || statusMeta.ok
     const StatusIconComp = sm.icon
     const isRunning = trace.status === "running"
 
@@ -400,7 +363,7 @@ function TraceListItem({ trace, selected, onClick }: {
                     <div className="flex items-center gap-2 mt-1 text-[10px] text-muted-foreground">
                         <span className="flex items-center gap-0.5"><Zap className="w-3 h-3" />{trace.span_count}</span>
                         <span className="flex items-center gap-0.5"><Timer className="w-3 h-3" />{fmtDuration(trace.duration_ms)}</span>
-                        <span className="ml-auto">{new Date(trace.start_time).toLocaleTimeString("pt-BR")}</span>
+                        <span className="ml-auto">{trace.start_time && new Date(trace.start_time).toLocaleTimeString("pt-BR")}</span>
                     </div>
                 </div>
             </div>
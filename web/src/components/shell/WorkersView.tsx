@@ -1,26 +1,13 @@
 import { useState, useEffect, useCallback } from "react"
 import { Cpu, RefreshCw, Server, Activity, Clock, CheckCircle2, XCircle, AlertCircle, Loader2 } from "lucide-react"
 import { cn } from "@/lib/utils"
+import { api } from "@/lib/api"
+import type { components } from "@/lib/api.schema"
 
-interface WorkerSpec {
-    image: string
-    command?: string[]
-    env?: Record<string, string>
-    resource_cpu?: number
-    resource_mem?: number
-    tags?: Record<string, string>
-}
-
-interface Worker {
-    id: string
-    spec: WorkerSpec
-    status: "UNKNOWN" | "STARTING" | "HEALTHY" | "UNHEALTHY" | "EXITED"
-    created_at: string
-    updated_at: string
-    metadata?: Record<string, string>
-}
+type Worker = components["schemas"]["Worker"]
+type WorkerStatus = NonNullable<Worker["status"]>
 
-function StatusIcon({ status }: { status: Worker["status"] }) {
+function StatusIcon({ status }: { status?: WorkerStatus }) {
     switch (status) {
         case "HEALTHY":
             return <CheckCircle2 className="w-4 h-4 text-emerald-400" />
@@ -35,8 +22,8 @@ function StatusIcon({ status }: { status: Worker["status"] }) {
     }
 }
 
-function StatusBadge({ status }: { status: Worker["status"] }) {
-    const styles: Record<Worker["status"], string> = {
+function StatusBadge({ status = "UNKNOWN" }: { status?: WorkerStatus }) {
+    const styles: Record<WorkerStatus, string> = {
         HEALTHY: "bg-emerald-500/15 text-emerald-400 border-emerald-500/20",
         STARTING: "bg-blue-500/15 text-blue-400 border-blue-500/20",
         UNHEALTHY: "bg-amber-500/15 text-amber-400 border-amber-500/20",
@@ -58,7 +45,8 @@ function formatMemory(bytes: number): string {
     return `${(bytes / 1024 / 1024 / 1024).toFixed(1)}GB`
 }
 
-function timeAgo(dateStr: string): string {
+function timeAgo(dateStr?: string): string {
+    if (!dateStr) return ""
     const d = new Date(dateStr)
     const diff = Date.now() - d.getTime()
     const sec = Math.floor(diff / 1000)
@@ -77,9 +65,8 @@ export function WorkersView() {
 
     const fetchWorkers = useCallback(async () => {
         try {
-            const res = await fetch("/v1/workers")
-            if (!res.ok) throw new Error(await res.text())
-            const data = await res.json()
+            const { data, error } = await api.GET("/v1/workers")
+            if (error) throw new Error(JSON.stringify(error))
             setWorkers(data.workers ?? [])
             setError(null)
         } catch (e: unknown) {
@@ -191,29 +178,29 @@ export function WorkersView() {
                             <div className="flex-1 min-w-0">
                                 {/* ID + status */}
                                 <div className="flex items-center gap-2 flex-wrap">
-                                    <span className="text-xs font-mono text-foreground truncate">{worker.id.slice(0, 16)}…</span>
+                                    <span className="text-xs font-mono text-foreground truncate">{worker.id?.slice(0, 16)}…</span>
                                     <StatusBadge status={worker.status} />
                                 </div>
 
                                 {/* Image */}
                                 <p className="text-[11px] text-muted-foreground mt-0.5 truncate">
-                                    <span className="text-muted-foreground/50">image:</span> {worker.spec.image || "—"}
+                                    <span className="text-muted-foreground/50">image:</span> {worker.spec?.image || "—"}
                                 </p>
 
                                 {/* Resources + tags */}
                                 <div className="flex flex-wrap gap-2 mt-1.5">
-                                    {(worker.spec.resource_cpu ?? 0) > 0 && (
+                                    {(worker.spec?.resource_cpu ?? 0) > 0 && (
                                         <span className="flex items-center gap-1 text-[10px] px-1.5 py-0.5 rounded bg-muted/20 text-muted-foreground">
                                             <Cpu className="w-2.5 h-2.5" />
-                                            {worker.spec.resource_cpu}× CPU
+                                            {worker.spec?.resource_cpu}× CPU
                                         </span>
                                     )}
-                                    {(worker.spec.resource_mem ?? 0) > 0 && (
+                                    {(worker.spec?.resource_mem ?? 0) > 0 && (
                                         <span className="text-[10px] px-1.5 py-0.5 rounded bg-muted/20 text-muted-foreground">
-                                            {formatMemory(worker.spec.resource_mem ?? 0)} RAM
+                                            {formatMemory(worker.spec?.resource_mem ?? 0)} RAM
                                         </span>
                                     )}
-                                    {Object.entries(worker.spec.tags ?? {}).map(([k, v]) => (
+                                    {Object.entries(worker.spec?.tags ?? {}).map(([k, v]) => (
                                         <span key={k} className="text-[10px] px-1.5 py-0.5 rounded bg-primary/10 text-primary/70 border border-primary/10">
                                             {k}={v}
                                         </span>
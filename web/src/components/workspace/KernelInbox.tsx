@@ -2,6 +2,8 @@ import { useState, useEffect, useCallback, useRef } from "react"
 import { Bot, Send, Loader2, CheckCircle2, XCircle, Bell, ChevronDown, ChevronRight, Inbox, MessageSquare, Lightbulb, HelpCircle } from "lucide-react"
 import ReactMarkdown from "react-markdown"
 import { cn } from "@/lib/utils"
+import { api } from "@/lib/api"
+import type { components } from "@/lib/api.schema"
 
 const KERNEL_CONV_ID = "conv-kernel-system"
 const API_BASE = "http://localhost:8080"
@@ -14,6 +16,16 @@ interface KernelMessage {
     metadata?: Record<string, unknown>
 }
 
+function toKernelMessage(m: components["schemas"]["Message"]): KernelMessage {
+    return {
+        id: m.id ?? "",
+        role: m.role === "user" || m.role === "assistant" ? m.role : "kernel",
+        content: m.content ?? "",
+        created_at: m.created_at ?? new Date().toISOString(),
+        metadata: m.metadata,
+    }
+}
+
 type MsgKind = "job_completed" | "job_failed" | "suggestion" | "question" | "welcome" | "info" | "user"
 
 function detectKind(msg: KernelMessage): MsgKind {
@@ -165,11 +177,10 @@ export function KernelInbox() {
 
     const loadMessages = useCallback(async () => {
         try {
-            const res = await fetch(`${API_BASE}/v1/conversations/${KERNEL_CONV_ID}/messages?limit=200`)
-            if (res.ok) {
-                const data = await res.json()
-                if (Array.isArray(data)) setMessages(data)
-            }
+            const { data } = await api.GET("/v1/conversations/{id}/messages", {
+                params: { path: { id: KERNEL_CONV_ID }, query: { limit: 200 } },
+            })
+            if (Array.isArray(data)) setMessages(data.map(toKernelMessage))
         } catch { /* no-op */ }
         setLoading(false)
     }, [])
@@ -228,13 +239,10 @@ export function KernelInbox() {
         setSending(true)
         setThinking(true)
         try {
-            const res = await fetch(`${API_BASE}/v1/agent/chat`, {
-                method: "POST",
-                headers: { "Content-Type": "application/json" },
-                body: JSON.stringify({ message: text, conversation_id: KERNEL_CONV_ID }),
+            const { data } = await api.POST("/v1/agent/chat", {
+                body: { message: text, conversation_id: KERNEL_CONV_ID },
             })
-            if (res.ok) {
-                const data = await res.json() as { response?: string; conversation_id?: string }
+            if (data) {
                 if (data.response) {
                     const agentMsg: KernelMessage = {
                         id: `agent-${Date.now()}`,
@@ -1,6 +1,7 @@
 import { GlassPanel } from "@/components/ui/glass-panel"
 import { Button } from "@/components/ui/button"
 import { api } from "@/lib/api"
+import type { components } from "@/lib/api.schema"
 import { useState, useEffect, useCallback } from "react"
 import { Settings, Cpu, Image, Eye, EyeOff, Plug, Save, RotateCcw, CheckCircle, XCircle, Loader2, RefreshCw } from "lucide-react"
 
@@ -28,13 +29,7 @@ interface TestResult {
     message: string
 }
 
-interface ModelSpec {
-    id: string
-    name: string
-    provider: string
-    capability: string
-    context_window?: number
-}
+type ModelSpec = components["schemas"]["ModelSpec"]
 
 const defaultConfig: AppConfig = {
     providers: {
@@ -70,11 +65,8 @@ export function SettingsPanel({ onClose }: SettingsPanelProps) {
     const fetchModels = useCallback(async () => {
         setDiscoveringModels(true)
         try {
-            const res = await fetch("/v1/models")
-            if (res.ok) {
-                const data = await res.json()
-                setDiscoveredModels(data.models ?? [])
-            }
+            const { data } = await api.GET("/v1/models")
+            if (data) setDiscoveredModels(data.models ?? [])
         } catch {
             // non-fatal — fallback list will be used
         } finally {
@@ -125,9 +117,9 @@ export function SettingsPanel({ onClose }: SettingsPanelProps) {
 
     // Build LLM model options from discovered + fallback
     const llmModelOptions = (() => {
+        // Embedding models can't chat
         const llmDiscovered = discoveredModels
-            .filter(m => m.capability === "llm" || m.capability === "chat" || m.provider === "ollama" || m.provider === "openai")
-            .map(m => m.id)
+            .flatMap(m => !m.embedding && m.id ? [m.id] : [])
         const mode = config.providers.llm.mode
         const fallback = mode === "local" ? LLM_MODELS_FALLBACK.local : LLM_MODELS_FALLBACK.remote
         const combined = llmDiscovered.length > 0 ? [...new Set([...llmDiscovered, ...fallback])] : fallback
@@ -24,9 +24,8 @@ export function Sidebar({ currentJobId, onSelectJob, onOpenSettings, onOpenKerne
     const { data: inboxStatus } = useQuery({
         queryKey: ["kernel-inbox"],
         queryFn: async () => {
-            const res = await fetch("http://localhost:8080/v1/system/inbox")
-            if (!res.ok) return { unread_count: 0, conversation_id: KERNEL_CONV_ID }
-            return res.json() as Promise<{ unread_count: number; conversation_id: string }>
+            const { data } = await api.GET("/v1/system/inbox")
+            return data ?? { unread_count: 0, conversation_id: KERNEL_CONV_ID }
         },
         refetchInterval: 10000,
     })
@@ -30,8 +30,7 @@ export const useModelStore = create<ModelState>((set) => ({
         try {
             const { data } = await api.GET("/v1/models")
             if (data) {
-                const models = (data as unknown as ModelSpec[]) ?? []
-                set({ models })
+                set({ models: data.models as ModelSpec[] })
             }
         } finally {
             set({ isLoading: false })